@@ -0,0 +1,32 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+// parseBlockedNodes parses the hex encoded public keys of the nodes we never
+// route payments through, forward HTLCs to or from, accept gossip from, or
+// connect with.
+func parseBlockedNodes(nodes []string) (map[routing.Vertex]struct{}, error) {
+	blockedNodes := make(map[routing.Vertex]struct{})
+	for _, node := range nodes {
+		pubKeyBytes, err := hex.DecodeString(node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blocked node %v: %v",
+				node, err)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid blocked node %v: %v",
+				node, err)
+		}
+
+		blockedNodes[routing.NewVertex(pubKey)] = struct{}{}
+	}
+
+	return blockedNodes, nil
+}
@@ -0,0 +1,248 @@
+package blockfetch
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/build"
+)
+
+const (
+	// DefaultFetchTimeout is the default duration we'll wait for a single
+	// peer to complete the handshake and serve the requested block.
+	DefaultFetchTimeout = 30 * time.Second
+
+	// requiredServices are the services a peer must signal for us to
+	// fetch blocks from it. As we need the witness data of the blocks, the
+	// peer must serve witness blocks in addition to historical blocks.
+	requiredServices = wire.SFNodeNetwork | wire.SFNodeWitness
+)
+
+var (
+	// ErrNoPeers is returned when a block was pruned by the chain backend,
+	// but there are no peers to fetch it from.
+	ErrNoPeers = errors.New("no peers to fetch pruned block from")
+)
+
+// GetBlockFunc fetches the block with the given hash from the chain backend.
+type GetBlockFunc func(*chainhash.Hash) (*wire.MsgBlock, error)
+
+// Config houses the parameters required to fetch blocks from P2P peers.
+type Config struct {
+	// ChainParams are the parameters of the chain the blocks are fetched
+	// for.
+	ChainParams *chaincfg.Params
+
+	// Peers returns the addresses of the P2P peers to fetch the blocks
+	// pruned by the chain backend from, such as the outbound peers of the
+	// chain backend itself.
+	Peers func() ([]string, error)
+
+	// Dial connects to the given address over the given network.
+	Dial func(network, address string) (net.Conn, error)
+
+	// FetchTimeout is the duration we'll wait for a single peer to
+	// complete the handshake and serve the requested block, before moving
+	// on to the next peer.
+	FetchTimeout time.Duration
+}
+
+// Fetcher fetches blocks from the chain backend, and falls back to fetching
+// them from P2P peers if the backend pruned them. This allows lnd to rescan
+// the chain for the outputs of channels opened long ago, even when running
+// against a pruned node.
+type Fetcher struct {
+	cfg *Config
+}
+
+// New creates a new Fetcher from the given config.
+func New(cfg *Config) *Fetcher {
+	return &Fetcher{
+		cfg: cfg,
+	}
+}
+
+// GetBlock returns the block with the given hash as fetched by getBlock from
+// the chain backend. If the chain backend pruned the block, it's fetched from
+// P2P peers instead.
+func (f *Fetcher) GetBlock(hash *chainhash.Hash,
+	getBlock GetBlockFunc) (*wire.MsgBlock, error) {
+
+	block, err := getBlock(hash)
+	if err == nil || !IsPrunedErr(err) || f.cfg.Peers == nil {
+		return block, err
+	}
+
+	log.Debugf("Block %v was pruned by the chain backend, fetching it "+
+		"from peers", hash)
+
+	block, p2pErr := f.fetchFromPeers(hash)
+	if p2pErr != nil {
+		return nil, fmt.Errorf("%v, and unable to fetch it from "+
+			"peers: %v", err, p2pErr)
+	}
+
+	return block, nil
+}
+
+// IsPrunedErr returns true if the given error was returned by the chain
+// backend because the requested block was pruned.
+func IsPrunedErr(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "pruned data")
+}
+
+// fetchFromPeers fetches the block with the given hash from the first of our
+// peers that serves it.
+func (f *Fetcher) fetchFromPeers(hash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	addrs, err := f.cfg.Peers()
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, ErrNoPeers
+	}
+
+	for _, addr := range addrs {
+		block, err := f.fetchFromPeer(addr, hash)
+		if err != nil {
+			log.Debugf("Unable to fetch block %v from peer %v: %v",
+				hash, addr, err)
+			continue
+		}
+
+		log.Infof("Fetched pruned block %v from peer %v", hash, addr)
+
+		return block, nil
+	}
+
+	return nil, fmt.Errorf("none of %d peers served the block",
+		len(addrs))
+}
+
+// fetchFromPeer connects to the peer at the given address, and requests the
+// block with the given hash from it.
+func (f *Fetcher) fetchFromPeer(addr string,
+	hash *chainhash.Hash) (*wire.MsgBlock, error) {
+
+	var (
+		verAck   = make(chan struct{}, 1)
+		blocks   = make(chan *wire.MsgBlock, 1)
+		notFound = make(chan struct{}, 1)
+	)
+
+	peerCfg := &peer.Config{
+		UserAgentName:    "lnd",
+		UserAgentVersion: build.Version(),
+		ChainParams:      f.cfg.ChainParams,
+		DisableRelayTx:   true,
+		Listeners: peer.MessageListeners{
+			OnVerAck: func(*peer.Peer, *wire.MsgVerAck) {
+				select {
+				case verAck <- struct{}{}:
+				default:
+				}
+			},
+			OnBlock: func(_ *peer.Peer, msg *wire.MsgBlock,
+				_ []byte) {
+
+				if msg.BlockHash() != *hash {
+					return
+				}
+
+				select {
+				case blocks <- msg:
+				default:
+				}
+			},
+			OnNotFound: func(*peer.Peer, *wire.MsgNotFound) {
+				select {
+				case notFound <- struct{}{}:
+				default:
+				}
+			},
+		},
+	}
+
+	p, err := peer.NewOutboundPeer(peerCfg, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := f.cfg.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	p.AssociateConnection(conn)
+	defer func() {
+		p.Disconnect()
+		p.WaitForDisconnect()
+	}()
+
+	timeout := time.After(f.cfg.FetchTimeout)
+
+	select {
+	case <-verAck:
+	case <-timeout:
+		return nil, errors.New("handshake timed out")
+	}
+
+	if p.Services()&requiredServices != requiredServices {
+		return nil, fmt.Errorf("peer doesn't serve witness blocks, "+
+			"services=%v", p.Services())
+	}
+
+	getData := wire.NewMsgGetData()
+	err = getData.AddInvVect(
+		wire.NewInvVect(wire.InvTypeWitnessBlock, hash),
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.QueueMessage(getData, nil)
+
+	select {
+	case block := <-blocks:
+		if err := validateBlock(block); err != nil {
+			return nil, err
+		}
+
+		return block, nil
+
+	case <-notFound:
+		return nil, errors.New("block not found")
+
+	case <-timeout:
+		return nil, errors.New("block request timed out")
+	}
+}
+
+// validateBlock ensures the transactions of the given block, which we already
+// know to have the requested hash, are the ones committed to by its header.
+// Otherwise, a peer could feed us made up transactions for a real block.
+func validateBlock(block *wire.MsgBlock) error {
+	if len(block.Transactions) == 0 {
+		return errors.New("block has no transactions")
+	}
+
+	blk := btcutil.NewBlock(block)
+	merkles := blockchain.BuildMerkleTreeStore(blk.Transactions(), false)
+	merkleRoot := merkles[len(merkles)-1]
+	if !block.Header.MerkleRoot.IsEqual(merkleRoot) {
+		return fmt.Errorf("block merkle root is invalid, header "+
+			"commits to %v, transactions to %v",
+			block.Header.MerkleRoot, merkleRoot)
+	}
+
+	return blockchain.ValidateWitnessCommitment(blk)
+}
@@ -0,0 +1,124 @@
+package blockfetch
+
+import (
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/peer"
+	"github.com/btcsuite/btcd/wire"
+)
+
+var (
+	testParams = &chaincfg.MainNetParams
+
+	testBlock = testParams.GenesisBlock
+
+	errPruned = errors.New("-1: Block not available (pruned data)")
+)
+
+// serveBlock creates an inbound peer on the given connection, which serves
+// the test block upon request.
+func serveBlock(t *testing.T, conn net.Conn) {
+	t.Helper()
+
+	var p *peer.Peer
+	p = peer.NewInboundPeer(&peer.Config{
+		ChainParams: testParams,
+		Services:    requiredServices,
+		Listeners: peer.MessageListeners{
+			OnGetData: func(_ *peer.Peer, msg *wire.MsgGetData) {
+				for _, inv := range msg.InvList {
+					if inv.Hash != testBlock.BlockHash() {
+						continue
+					}
+					p.QueueMessage(testBlock, nil)
+				}
+			},
+		},
+	})
+	p.AssociateConnection(conn)
+}
+
+// TestFetcherGetBlock asserts that blocks are fetched from the chain backend,
+// and only from our peers if the backend pruned them.
+func TestFetcherGetBlock(t *testing.T) {
+	t.Parallel()
+
+	var dialed []string
+	f := New(&Config{
+		ChainParams: testParams,
+		Peers: func() ([]string, error) {
+			return []string{"offline:8333", "online:8333"}, nil
+		},
+		Dial: func(_, addr string) (net.Conn, error) {
+			dialed = append(dialed, addr)
+			if addr == "offline:8333" {
+				return nil, errors.New("connection refused")
+			}
+
+			local, remote := net.Pipe()
+			serveBlock(t, remote)
+
+			return local, nil
+		},
+		FetchTimeout: 5 * time.Second,
+	})
+
+	hash := testBlock.BlockHash()
+
+	// Errors other than the block being pruned should be returned as is,
+	// without querying our peers.
+	errUnknown := errors.New("-5: Block not found")
+	_, err := f.GetBlock(&hash, func(*chainhash.Hash) (*wire.MsgBlock,
+		error) {
+
+		return nil, errUnknown
+	})
+	if err != errUnknown {
+		t.Fatalf("expected %v, got %v", errUnknown, err)
+	}
+	if len(dialed) != 0 {
+		t.Fatalf("expected no peers to be dialed, dialed %v", dialed)
+	}
+
+	// A pruned block should be fetched from the first peer serving it.
+	block, err := f.GetBlock(&hash, func(*chainhash.Hash) (*wire.MsgBlock,
+		error) {
+
+		return nil, errPruned
+	})
+	if err != nil {
+		t.Fatalf("unable to fetch pruned block: %v", err)
+	}
+	if block.BlockHash() != hash {
+		t.Fatalf("expected block %v, got %v", hash, block.BlockHash())
+	}
+	if len(dialed) != 2 {
+		t.Fatalf("expected both peers to be dialed, dialed %v", dialed)
+	}
+}
+
+// TestValidateBlock asserts that a block whose transactions don't match the
+// merkle root of its header is rejected.
+func TestValidateBlock(t *testing.T) {
+	t.Parallel()
+
+	if err := validateBlock(testBlock); err != nil {
+		t.Fatalf("unable to validate block: %v", err)
+	}
+
+	// Tampering with the coinbase transaction should invalidate the
+	// block, even though its hash remains the same.
+	tamperedBlock := *testBlock
+	coinbase := testBlock.Transactions[0].Copy()
+	coinbase.TxOut[0].Value++
+	tamperedBlock.Transactions = []*wire.MsgTx{coinbase}
+
+	if err := validateBlock(&tamperedBlock); err == nil {
+		t.Fatal("expected tampered block to be invalid")
+	}
+}
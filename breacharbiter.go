@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/binary"
 	"errors"
+	"fmt"
 	"io"
 	"sync"
 	"sync/atomic"
@@ -18,9 +19,11 @@ import (
 
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/health"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 var (
@@ -78,7 +81,7 @@ type BreachConfig struct {
 	// Estimator is used by the breach arbiter to determine an appropriate
 	// fee level when generating, signing, and broadcasting sweep
 	// transactions.
-	Estimator lnwallet.FeeEstimator
+	Estimator chainfee.Estimator
 
 	// GenSweepScript generates the receiving scripts for swept outputs.
 	GenSweepScript func() ([]byte, error)
@@ -106,6 +109,10 @@ type BreachConfig struct {
 	// breached channels. This is used in conjunction with DB to recover
 	// from crashes, restarts, or other failures.
 	Store RetributionStore
+
+	// Health is the registry through which the breach arbiter recovers
+	// from panics. If nil, panics aren't recovered from.
+	Health *health.Registry
 }
 
 // breachArbiter is a special subsystem which is responsible for watching and
@@ -122,17 +129,37 @@ type breachArbiter struct {
 
 	cfg *BreachConfig
 
+	// stagedJustice holds the justice transactions crafted for breaches
+	// detected within the mempool, keyed by the channel point of the
+	// breached channel. They're used once the breach confirms, rather than
+	// crafting the justice transaction from scratch.
+	//
+	// NOTE: This map MUST be accessed with the breach arbiter's mutex held.
+	stagedJustice map[wire.OutPoint]*stagedJusticeTx
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 	sync.Mutex
 }
 
+// stagedJusticeTx is a signed justice transaction crafted for a breach
+// transaction that has yet to confirm.
+type stagedJusticeTx struct {
+	// commitHash is the hash of the breach transaction.
+	commitHash chainhash.Hash
+
+	// tx is the justice transaction sweeping all outputs of the breach
+	// transaction.
+	tx *wire.MsgTx
+}
+
 // newBreachArbiter creates a new instance of a breachArbiter initialized with
 // its dependent objects.
 func newBreachArbiter(cfg *BreachConfig) *breachArbiter {
 	return &breachArbiter{
-		cfg:  cfg,
-		quit: make(chan struct{}),
+		cfg:           cfg,
+		stagedJustice: make(map[wire.OutPoint]*stagedJusticeTx),
+		quit:          make(chan struct{}),
 	}
 }
 
@@ -215,6 +242,8 @@ func (b *breachArbiter) Start() error {
 	}
 
 	// Start watching the remaining active channels!
+	b.cfg.Health.Register("breacharbiter")
+
 	b.wg.Add(1)
 	go b.contractObserver()
 
@@ -243,6 +272,58 @@ func (b *breachArbiter) IsBreached(chanPoint *wire.OutPoint) (bool, error) {
 	return b.cfg.Store.IsBreached(chanPoint)
 }
 
+// StageBreach crafts and signs the justice transaction for a breach that was
+// detected within the mempool, before it confirms. Once the breach is handed
+// off after confirming, the staged justice transaction is broadcast right
+// away, rather than being crafted from scratch.
+func (b *breachArbiter) StageBreach(chanPoint wire.OutPoint,
+	breachRet *lnwallet.BreachRetribution) error {
+
+	retInfo := newRetributionInfo(&chanPoint, breachRet)
+	justiceTx, err := b.createJusticeTx(retInfo)
+	if err != nil {
+		return fmt.Errorf("unable to create justice tx: %v", err)
+	}
+
+	brarLog.Infof("Staged justice tx %v for unconfirmed breach of "+
+		"ChannelPoint(%v) by tx %v", justiceTx.TxHash(), chanPoint,
+		retInfo.commitHash)
+
+	b.Lock()
+	b.stagedJustice[chanPoint] = &stagedJusticeTx{
+		commitHash: retInfo.commitHash,
+		tx:         justiceTx,
+	}
+	b.Unlock()
+
+	return nil
+}
+
+// popStagedJustice returns the justice transaction staged for the given
+// breach, if any. It's removed from the staged set, as it can only be
+// broadcast once.
+func (b *breachArbiter) popStagedJustice(
+	breachInfo *retributionInfo) *wire.MsgTx {
+
+	b.Lock()
+	defer b.Unlock()
+
+	staged, ok := b.stagedJustice[breachInfo.chanPoint]
+	if !ok {
+		return nil
+	}
+	delete(b.stagedJustice, breachInfo.chanPoint)
+
+	// The breach transaction that confirmed may not be the one that was
+	// detected within the mempool, in which case the staged justice
+	// transaction is invalid.
+	if staged.commitHash != breachInfo.commitHash {
+		return nil
+	}
+
+	return staged.tx
+}
+
 // contractObserver is the primary goroutine for the breachArbiter. This
 // goroutine is responsible for handling breach events coming from the
 // contractcourt on the ContractBreaches channel. If a channel breach is
@@ -253,6 +334,14 @@ func (b *breachArbiter) IsBreached(chanPoint *wire.OutPoint) (bool, error) {
 // NOTE: This MUST be run as a goroutine.
 func (b *breachArbiter) contractObserver() {
 	defer b.wg.Done()
+	defer b.cfg.Health.Recover("breacharbiter", func(restart bool) {
+		if !restart {
+			return
+		}
+
+		b.wg.Add(1)
+		go b.contractObserver()
+	})
 
 	brarLog.Infof("Starting contract observer, watching for breaches.")
 
@@ -464,6 +553,11 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 
 	defer b.wg.Done()
 
+	// We don't recover from panics here, as a stopped retribution would
+	// let the counterparty keep the funds it attempted to steal. Instead,
+	// the daemon crashes and resumes the retribution from the retribution
+	// store once restarted.
+
 	// TODO(roasbeef): state needs to be checkpointed here
 	var breachConfHeight uint32
 	select {
@@ -503,13 +597,18 @@ func (b *breachArbiter) exactRetribution(confChan *chainntnfs.ConfirmationEvent,
 	// txid.
 justiceTxBroadcast:
 	if finalTx == nil {
-		// With the breach transaction confirmed, we now create the
-		// justice tx which will claim ALL the funds within the
-		// channel.
-		finalTx, err = b.createJusticeTx(breachInfo)
-		if err != nil {
-			brarLog.Errorf("unable to create justice tx: %v", err)
-			return
+		// If the breach was detected within the mempool, the justice
+		// tx was already staged. Otherwise, with the breach
+		// transaction confirmed, we now create the justice tx which
+		// will claim ALL the funds within the channel.
+		finalTx = b.popStagedJustice(breachInfo)
+		if finalTx == nil {
+			finalTx, err = b.createJusticeTx(breachInfo)
+			if err != nil {
+				brarLog.Errorf("unable to create justice "+
+					"tx: %v", err)
+				return
+			}
 		}
 
 		// Persist our finalized justice transaction before making an
@@ -609,6 +708,28 @@ justiceTxBroadcast:
 			return
 		}
 
+		// The justice transaction could still be reorged out of the
+		// chain, which would put the revoked outputs up for grabs
+		// again. We'll therefore hold on to the retribution info
+		// until it's buried deep enough.
+		reorged, err := b.waitForJusticeFinality(confChan, finalTx)
+		switch {
+		case err != nil:
+			if err != errBrarShuttingDown {
+				brarLog.Errorf("unable to wait for finality of "+
+					"justice tx %v: %v", justiceTXID, err)
+			}
+			return
+
+		// If a conflicting transaction confirmed in its place, we'll
+		// go back to crafting a new justice transaction, which will
+		// first wait for the spends of the revoked outputs.
+		case reorged:
+			confChan.Cancel()
+			finalTx = nil
+			goto justiceTxBroadcast
+		}
+
 		// Justice has been carried out; we can safely delete the
 		// retribution info from the database.
 		err = b.cfg.Store.Remove(&breachInfo.chanPoint)
@@ -628,6 +749,59 @@ justiceTxBroadcast:
 	}
 }
 
+// waitForJusticeFinality waits for the given confirmed justice transaction to
+// be buried deep enough that it can no longer be reorged out of the chain. If
+// it is reorged out, it is rebroadcast. True is returned if the justice
+// transaction can no longer confirm, as a conflicting transaction confirmed in
+// its place.
+func (b *breachArbiter) waitForJusticeFinality(
+	confChan *chainntnfs.ConfirmationEvent,
+	justiceTx *wire.MsgTx) (bool, error) {
+
+	justiceTXID := justiceTx.TxHash()
+	for {
+		select {
+		// The justice transaction has been reconfirmed after a reorg.
+		case _, ok := <-confChan.Confirmed:
+			if !ok {
+				return false, errBrarShuttingDown
+			}
+
+			brarLog.Infof("Justice tx %v has been reconfirmed",
+				justiceTXID)
+
+		case reorgDepth, ok := <-confChan.NegativeConf:
+			if !ok {
+				return false, errBrarShuttingDown
+			}
+
+			brarLog.Warnf("Justice tx %v has been reorged out of "+
+				"the chain (depth=%v), rebroadcasting it",
+				justiceTXID, reorgDepth)
+
+			err := b.cfg.PublishTransaction(justiceTx)
+			switch {
+			case err == lnwallet.ErrDoubleSpend:
+				return true, nil
+
+			case err != nil:
+				brarLog.Errorf("unable to rebroadcast justice "+
+					"tx %v: %v", justiceTXID, err)
+			}
+
+		case _, ok := <-confChan.Done:
+			if !ok {
+				return false, errBrarShuttingDown
+			}
+
+			return false, nil
+
+		case <-b.quit:
+			return false, errBrarShuttingDown
+		}
+	}
+}
+
 // handleBreachHandoff handles a new breach event, by writing it to disk, then
 // notifies the breachArbiter contract observer goroutine that a channel's
 // contract has been breached by the prior counterparty. Once notified the
@@ -640,6 +814,9 @@ justiceTxBroadcast:
 func (b *breachArbiter) handleBreachHandoff(breachEvent *ContractBreachEvent) {
 	defer b.wg.Done()
 
+	// Like exactRetribution, we don't recover from panics here, as the
+	// breach would otherwise go unpunished.
+
 	chanPoint := breachEvent.ChanPoint
 	brarLog.Debugf("Handling breach handoff for ChannelPoint(%v)",
 		chanPoint)
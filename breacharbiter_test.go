@@ -30,6 +30,7 @@ import (
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/shachain"
 )
@@ -1267,6 +1268,98 @@ func TestBreachSecondLevelTransfer(t *testing.T) {
 	}
 }
 
+// TestBreachStagedJustice tests that the justice transaction staged for a
+// breach detected within the mempool is broadcast once the breach confirms,
+// rather than crafting a new one.
+func TestBreachStagedJustice(t *testing.T) {
+	brar, alice, _, bobClose, contractBreaches,
+		cleanUpChans, cleanUpArb := initBreachedState(t)
+	defer cleanUpChans()
+	defer cleanUpArb()
+
+	var (
+		height    = bobClose.ChanSnapshot.CommitHeight
+		chanPoint = alice.ChanPoint
+		publTx    = make(chan *wire.MsgTx)
+	)
+
+	brar.cfg.PublishTransaction = func(tx *wire.MsgTx) error {
+		publTx <- tx
+		return nil
+	}
+
+	// Stage the breach as if it was detected within the mempool, in which
+	// case there's no breach height yet.
+	stagedRetribution, err := lnwallet.NewBreachRetribution(
+		alice.State(), height, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to create breach retribution: %v", err)
+	}
+	err = brar.StageBreach(*chanPoint, stagedRetribution)
+	if err != nil {
+		t.Fatalf("unable to stage breach: %v", err)
+	}
+
+	brar.Lock()
+	staged, ok := brar.stagedJustice[*chanPoint]
+	brar.Unlock()
+	if !ok {
+		t.Fatalf("justice tx wasn't staged")
+	}
+	stagedHash := staged.tx.TxHash()
+
+	// Crafting a new justice transaction should no longer be possible,
+	// ensuring that the staged one is used.
+	brar.cfg.GenSweepScript = func() ([]byte, error) {
+		return nil, fmt.Errorf("justice tx already staged")
+	}
+
+	// Notify the breach arbiter about the breach once it confirmed.
+	retribution, err := lnwallet.NewBreachRetribution(
+		alice.State(), height, 1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create breach retribution: %v", err)
+	}
+	breach := &ContractBreachEvent{
+		ChanPoint:         *chanPoint,
+		ProcessACK:        make(chan error, 1),
+		BreachRetribution: retribution,
+	}
+	contractBreaches <- breach
+
+	select {
+	case err := <-breach.ProcessACK:
+		if err != nil {
+			t.Fatalf("handoff failed: %v", err)
+		}
+	case <-time.After(time.Second * 15):
+		t.Fatalf("breach arbiter didn't send ack back")
+	}
+
+	notifier := brar.cfg.Notifier.(*mockSpendNotifier)
+	notifier.confChannel <- &chainntnfs.TxConfirmation{}
+
+	// The staged justice transaction should be published.
+	select {
+	case tx := <-publTx:
+		if tx.TxHash() != stagedHash {
+			t.Fatalf("expected staged justice tx %v to be "+
+				"published, got %v", stagedHash, tx.TxHash())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("tx was not published")
+	}
+
+	brar.Lock()
+	_, ok = brar.stagedJustice[*chanPoint]
+	brar.Unlock()
+	if ok {
+		t.Fatalf("staged justice tx wasn't removed")
+	}
+}
+
 // assertArbiterBreach checks that the breach arbiter has persisted the breach
 // information for a particular channel.
 func assertArbiterBreach(t *testing.T, brar *breachArbiter,
@@ -1337,7 +1430,7 @@ func createTestArbiter(t *testing.T, contractBreaches chan *ContractBreachEvent,
 	ba := newBreachArbiter(&BreachConfig{
 		CloseLink:          func(_ *wire.OutPoint, _ htlcswitch.ChannelCloseType) {},
 		DB:                 db,
-		Estimator:          lnwallet.NewStaticFeeEstimator(12500, 0),
+		Estimator:          chainfee.NewStaticEstimator(12500, 0),
 		GenSweepScript:     func() ([]byte, error) { return nil, nil },
 		ContractBreaches:   contractBreaches,
 		Signer:             signer,
@@ -1460,7 +1553,7 @@ func createInitChannels(revocationWindow int) (*lnwallet.LightningChannel, *lnwa
 
 	aliceCommitTx, bobCommitTx, err := lnwallet.CreateCommitmentTxns(channelBal,
 		channelBal, &aliceCfg, &bobCfg, aliceCommitPoint, bobCommitPoint,
-		*fundingTxIn)
+		*fundingTxIn, channeldb.SingleFunder)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -1477,7 +1570,7 @@ func createInitChannels(revocationWindow int) (*lnwallet.LightningChannel, *lnwa
 		return nil, nil, nil, err
 	}
 
-	estimator := lnwallet.NewStaticFeeEstimator(12500, 0)
+	estimator := chainfee.NewStaticEstimator(12500, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		return nil, nil, nil, err
@@ -1694,11 +1787,8 @@ func forceStateTransition(chanA, chanB *lnwallet.LightningChannel) error {
 // TODO(bvu): Refactor when dynamic fee estimation is added.
 // TODO(conner) remove code duplication
 func calcStaticFee(numHTLCs int) btcutil.Amount {
-	const (
-		commitWeight = btcutil.Amount(724)
-		htlcWeight   = 172
-		feePerKw     = btcutil.Amount(24/4) * 1000
-	)
-	return feePerKw * (commitWeight +
-		btcutil.Amount(htlcWeight*numHTLCs)) / 1000
+	const feePerKw = chainfee.SatPerKWeight(24 / 4 * 1000)
+
+	commitWeight := lnwallet.CommitWeight(numHTLCs, channeldb.SingleFunder)
+	return feePerKw.FeeForWeight(commitWeight)
 }
@@ -2,6 +2,7 @@ package brontide
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"math"
 	"net"
@@ -28,10 +29,72 @@ type Conn struct {
 // A compile-time assertion to ensure that Conn meets the net.Conn interface.
 var _ net.Conn = (*Conn)(nil)
 
+// HandshakeStage denotes a step of the handshake carried out by the initiator
+// of a connection.
+type HandshakeStage uint8
+
+const (
+	// HandshakeStageTCP is the stage in which the TCP connection to the
+	// remote peer is established.
+	HandshakeStageTCP HandshakeStage = iota
+
+	// HandshakeStageActOne is the stage in which the first act of the
+	// handshake is generated and sent to the remote peer.
+	HandshakeStageActOne
+
+	// HandshakeStageActTwo is the stage in which the second act of the
+	// handshake is received from the remote peer and validated. A remote
+	// peer that isn't in possession of the static key we're dialing will
+	// fail to respond during this stage.
+	HandshakeStageActTwo
+
+	// HandshakeStageActThree is the stage in which the final act of the
+	// handshake is generated and sent to the remote peer.
+	HandshakeStageActThree
+)
+
+// String returns a human readable description of the handshake stage.
+func (s HandshakeStage) String() string {
+	switch s {
+	case HandshakeStageTCP:
+		return "tcp"
+
+	case HandshakeStageActOne:
+		return "act one"
+
+	case HandshakeStageActTwo:
+		return "act two"
+
+	case HandshakeStageActThree:
+		return "act three"
+
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(s))
+	}
+}
+
+// HandshakeError is the error returned by Dial when the connection to the
+// remote peer couldn't be established. Along with the underlying error, it
+// records the stage of the handshake at which the attempt failed.
+type HandshakeError struct {
+	// Stage is the stage of the handshake that failed.
+	Stage HandshakeStage
+
+	// Err is the underlying error.
+	Err error
+}
+
+// Error returns the message of the underlying error.
+//
+// NOTE: This is part of the error interface.
+func (e *HandshakeError) Error() string {
+	return e.Err.Error()
+}
+
 // Dial attempts to establish an encrypted+authenticated connection with the
 // remote peer located at address which has remotePub as its long-term static
 // public key. In the case of a handshake failure, the connection is closed and
-// a non-nil error is returned.
+// a non-nil *HandshakeError is returned.
 func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 	dialer func(string, string) (net.Conn, error)) (*Conn, error) {
 	ipAddr := netAddr.Address.String()
@@ -39,7 +102,7 @@ func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 	var err error
 	conn, err = dialer("tcp", ipAddr)
 	if err != nil {
-		return nil, err
+		return nil, &HandshakeError{Stage: HandshakeStageTCP, Err: err}
 	}
 
 	b := &Conn{
@@ -47,15 +110,20 @@ func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 		noise: NewBrontideMachine(true, localPriv, netAddr.IdentityKey),
 	}
 
+	// fail closes the connection and wraps the passed error, such that the
+	// caller is able to tell at which stage the handshake failed.
+	fail := func(stage HandshakeStage, err error) (*Conn, error) {
+		b.conn.Close()
+		return nil, &HandshakeError{Stage: stage, Err: err}
+	}
+
 	// Initiate the handshake by sending the first act to the receiver.
 	actOne, err := b.noise.GenActOne()
 	if err != nil {
-		b.conn.Close()
-		return nil, err
+		return fail(HandshakeStageActOne, err)
 	}
 	if _, err := conn.Write(actOne[:]); err != nil {
-		b.conn.Close()
-		return nil, err
+		return fail(HandshakeStageActOne, err)
 	}
 
 	// We'll ensure that we get ActTwo from the remote peer in a timely
@@ -63,8 +131,7 @@ func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 	// connection.
 	err = conn.SetReadDeadline(time.Now().Add(handshakeReadTimeout))
 	if err != nil {
-		b.conn.Close()
-		return nil, err
+		return fail(HandshakeStageActTwo, err)
 	}
 
 	// If the first act was successful (we know that address is actually
@@ -73,32 +140,27 @@ func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 	// secrecy.
 	var actTwo [ActTwoSize]byte
 	if _, err := io.ReadFull(conn, actTwo[:]); err != nil {
-		b.conn.Close()
-		return nil, err
+		return fail(HandshakeStageActTwo, err)
 	}
 	if err := b.noise.RecvActTwo(actTwo); err != nil {
-		b.conn.Close()
-		return nil, err
+		return fail(HandshakeStageActTwo, err)
 	}
 
 	// Finally, complete the handshake by sending over our encrypted static
 	// key and execute the final ECDH operation.
 	actThree, err := b.noise.GenActThree()
 	if err != nil {
-		b.conn.Close()
-		return nil, err
+		return fail(HandshakeStageActThree, err)
 	}
 	if _, err := conn.Write(actThree[:]); err != nil {
-		b.conn.Close()
-		return nil, err
+		return fail(HandshakeStageActThree, err)
 	}
 
 	// We'll reset the deadline as it's no longer critical beyond the
 	// initial handshake.
 	err = conn.SetReadDeadline(time.Time{})
 	if err != nil {
-		b.conn.Close()
-		return nil, err
+		return fail(HandshakeStageActThree, err)
 	}
 
 	return b, nil
@@ -3,6 +3,7 @@ package brontide
 import (
 	"bytes"
 	"encoding/hex"
+	"errors"
 	"io"
 	"math"
 	"net"
@@ -140,6 +141,68 @@ func TestConnectionCorrectness(t *testing.T) {
 	}
 }
 
+// TestDialHandshakeStage ensures that Dial reports the stage at which a
+// failed connection attempt broke down.
+func TestDialHandshakeStage(t *testing.T) {
+	localPriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	listener, netAddr, err := makeListener()
+	if err != nil {
+		t.Fatalf("unable to create listener: %v", err)
+	}
+	defer listener.Close()
+
+	assertStage := func(err error, stage HandshakeStage) {
+		t.Helper()
+
+		hsErr, ok := err.(*HandshakeError)
+		if !ok {
+			t.Fatalf("expected HandshakeError, got %T: %v", err,
+				err)
+		}
+		if hsErr.Stage != stage {
+			t.Fatalf("expected failure at stage %v, got %v",
+				stage, hsErr.Stage)
+		}
+	}
+
+	// A dialer that fails to establish the connection should result in a
+	// failure at the TCP stage.
+	dialErr := errors.New("dial failed")
+	failingDialer := func(string, string) (net.Conn, error) {
+		return nil, dialErr
+	}
+	_, err = Dial(localPriv, netAddr, failingDialer)
+	assertStage(err, HandshakeStageTCP)
+	if err.(*HandshakeError).Err != dialErr {
+		t.Fatalf("expected underlying dial error, got: %v", err)
+	}
+
+	// Dialing the listener with a static key it doesn't possess should
+	// result in a failure while awaiting the second act, as the listener
+	// won't be able to decrypt our first act.
+	wrongPriv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	wrongAddr := &lnwire.NetAddress{
+		IdentityKey: wrongPriv.PubKey(),
+		Address:     netAddr.Address,
+	}
+	_, err = Dial(localPriv, wrongAddr, net.Dial)
+	assertStage(err, HandshakeStageActTwo)
+
+	// Finally, dialing with the correct static key should succeed.
+	conn, err := Dial(localPriv, netAddr, net.Dial)
+	if err != nil {
+		t.Fatalf("unable to dial listener: %v", err)
+	}
+	conn.Close()
+}
+
 // TestConecurrentHandshakes verifies the listener's ability to not be blocked
 // by other pending handshakes. This is tested by opening multiple tcp
 // connections with the listener, without completing any of the brontide acts.
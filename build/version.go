@@ -15,6 +15,10 @@ import (
 // the -ldflags during compilation.
 var Commit string
 
+// RawTags stores the comma separated build tags of this build, this should be
+// set using the -ldflags during compilation.
+var RawTags string
+
 // semanticAlphabet
 const semanticAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz-"
 
@@ -51,6 +55,15 @@ func Version() string {
 	return version
 }
 
+// Tags returns the build tags of this build.
+func Tags() []string {
+	if RawTags == "" {
+		return nil
+	}
+
+	return strings.Split(RawTags, ",")
+}
+
 // normalizeVerString returns the passed string stripped of all characters which
 // are not valid according to the semantic versioning guidelines for pre-release
 // version and build metadata strings.  In particular they MUST only contain
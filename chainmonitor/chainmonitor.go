@@ -0,0 +1,280 @@
+package chainmonitor
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// Backend describes a chain backend whose progress can be monitored.
+type Backend struct {
+	// Name is a human readable name of the backend, such as its host.
+	Name string
+
+	// BestHeight returns the height of the best block known to the
+	// backend.
+	BestHeight func() (int32, error)
+}
+
+// Config houses the parameters of the chain backend monitor.
+type Config struct {
+	// Active is the chain backend currently in use.
+	Active Backend
+
+	// Fallback is the chain backend to fail over to in case the active one
+	// stalls, if any. See RequestShutdown.
+	Fallback *Backend
+
+	// StallTimeout is the duration after which the active backend is
+	// considered stalled if its best block hasn't changed.
+	StallTimeout time.Duration
+
+	// Ticker determines how often the backends are polled. The time of
+	// each tick is used as the current time.
+	Ticker ticker.Ticker
+
+	// RequestShutdown is called once the active backend stalled while the
+	// fallback backend made progress beyond it. The monitor doesn't switch
+	// backends itself, as the chain backend can't be swapped out while
+	// we're running. Instead, we shut down, and the backend furthest ahead
+	// is selected once we're restarted. It's called at most once.
+	RequestShutdown func()
+}
+
+// StalledEvent is sent when the active chain backend hasn't returned a new
+// block within the stall timeout.
+type StalledEvent struct {
+	// Backend is the name of the stalled backend.
+	Backend string
+
+	// Height is the height of the last block returned by the backend.
+	Height int32
+
+	// Since is the time at which the backend last made progress.
+	Since time.Time
+}
+
+// RecoveredEvent is sent when a stalled chain backend returns a new block.
+type RecoveredEvent struct {
+	// Backend is the name of the recovered backend.
+	Backend string
+
+	// Height is the height of the new block returned by the backend.
+	Height int32
+}
+
+// FailoverEvent is sent when we shut down because the active chain backend
+// stalled while the fallback backend made progress, such that we fail over to
+// the fallback backend once restarted.
+type FailoverEvent struct {
+	// From is the name of the stalled backend.
+	From string
+
+	// To is the name of the backend we're failing over to.
+	To string
+
+	// Height is the height of the last block returned by the stalled
+	// backend.
+	Height int32
+
+	// FallbackHeight is the height of the best block known to the
+	// fallback backend.
+	FallbackHeight int32
+}
+
+// Monitor periodically checks whether the active chain backend still returns
+// new blocks. If it doesn't within the stall timeout, the subscribers of its
+// events are notified, and, if a fallback backend is configured that's ahead
+// of the active one, a shutdown is requested to fail over to it once
+// restarted.
+type Monitor struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *Config
+
+	ntfnServer *subscribe.Server
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new chain backend monitor.
+func New(cfg *Config) *Monitor {
+	return &Monitor{
+		cfg:        cfg,
+		ntfnServer: subscribe.NewServer(),
+		quit:       make(chan struct{}),
+	}
+}
+
+// Start starts the monitor and the goroutine polling the chain backends.
+func (m *Monitor) Start() error {
+	if !atomic.CompareAndSwapUint32(&m.started, 0, 1) {
+		return nil
+	}
+
+	if m.cfg.StallTimeout <= 0 {
+		return errors.New("stall timeout must be positive")
+	}
+
+	log.Infof("Chain backend monitor starting, stall_timeout=%v",
+		m.cfg.StallTimeout)
+
+	if err := m.ntfnServer.Start(); err != nil {
+		return err
+	}
+
+	m.cfg.Ticker.Resume()
+
+	m.wg.Add(1)
+	go m.monitor(time.Now())
+
+	return nil
+}
+
+// Stop signals the monitor for a graceful shutdown.
+func (m *Monitor) Stop() error {
+	if !atomic.CompareAndSwapUint32(&m.stopped, 0, 1) {
+		return nil
+	}
+
+	close(m.quit)
+	m.wg.Wait()
+
+	m.cfg.Ticker.Stop()
+
+	return m.ntfnServer.Stop()
+}
+
+// SubscribeChainBackendEvents returns a subscribe.Client that will receive
+// the events of the monitored chain backends.
+func (m *Monitor) SubscribeChainBackendEvents() (*subscribe.Client, error) {
+	return m.ntfnServer.Subscribe()
+}
+
+// monitor polls the chain backends on every tick, and sends the events
+// resulting from their progress, or the lack thereof.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *Monitor) monitor(startTime time.Time) {
+	defer m.wg.Done()
+
+	var (
+		lastHeight   int32
+		lastProgress = startTime
+		stalled      bool
+		failedOver   bool
+	)
+
+	// We'll query the initial height of the active backend, such that a
+	// backend that never returns a block is detected as stalled as well.
+	if height, err := m.cfg.Active.BestHeight(); err == nil {
+		lastHeight = height
+	} else {
+		log.Warnf("Unable to query best height of chain backend %v: "+
+			"%v", m.cfg.Active.Name, err)
+	}
+
+	for {
+		var now time.Time
+		select {
+		case now = <-m.cfg.Ticker.Ticks():
+		case <-m.quit:
+			return
+		}
+
+		height, err := m.cfg.Active.BestHeight()
+		if err != nil {
+			log.Warnf("Unable to query best height of chain "+
+				"backend %v: %v", m.cfg.Active.Name, err)
+		}
+
+		// If the backend made progress since our last check, we'll
+		// mark it as such, and notify our subscribers if it was
+		// considered stalled before.
+		if err == nil && height > lastHeight {
+			lastHeight = height
+			lastProgress = now
+
+			if stalled {
+				stalled = false
+
+				log.Infof("Chain backend %v recovered at "+
+					"height %v", m.cfg.Active.Name, height)
+
+				m.sendUpdate(RecoveredEvent{
+					Backend: m.cfg.Active.Name,
+					Height:  height,
+				})
+			}
+
+			continue
+		}
+
+		if now.Sub(lastProgress) < m.cfg.StallTimeout {
+			continue
+		}
+
+		if !stalled {
+			stalled = true
+
+			log.Warnf("Chain backend %v hasn't returned a new "+
+				"block since %v, stalled at height %v",
+				m.cfg.Active.Name, lastProgress, lastHeight)
+
+			m.sendUpdate(StalledEvent{
+				Backend: m.cfg.Active.Name,
+				Height:  lastHeight,
+				Since:   lastProgress,
+			})
+		}
+
+		if m.cfg.Fallback == nil || failedOver {
+			continue
+		}
+
+		// We'll only fail over if the fallback backend is actually
+		// ahead of the stalled one, as it may just be that no blocks
+		// were mined in the meantime.
+		fallbackHeight, err := m.cfg.Fallback.BestHeight()
+		if err != nil {
+			log.Warnf("Unable to query best height of fallback "+
+				"chain backend %v: %v", m.cfg.Fallback.Name,
+				err)
+			continue
+		}
+		if fallbackHeight <= lastHeight {
+			continue
+		}
+
+		failedOver = true
+
+		log.Warnf("Requesting shutdown to fail over from stalled "+
+			"chain backend %v at height %v to %v at height %v",
+			m.cfg.Active.Name, lastHeight, m.cfg.Fallback.Name,
+			fallbackHeight)
+
+		m.sendUpdate(FailoverEvent{
+			From:           m.cfg.Active.Name,
+			To:             m.cfg.Fallback.Name,
+			Height:         lastHeight,
+			FallbackHeight: fallbackHeight,
+		})
+
+		if m.cfg.RequestShutdown != nil {
+			m.cfg.RequestShutdown()
+		}
+	}
+}
+
+// sendUpdate sends the given event to all subscribers.
+func (m *Monitor) sendUpdate(event interface{}) {
+	if err := m.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send chain backend update: %v", err)
+	}
+}
@@ -0,0 +1,144 @@
+package chainmonitor
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// mockBackend is a chain backend whose best height is set by the test.
+type mockBackend struct {
+	height int32 // To be used atomically.
+}
+
+func (b *mockBackend) bestHeight() (int32, error) {
+	return atomic.LoadInt32(&b.height), nil
+}
+
+func (b *mockBackend) setHeight(height int32) {
+	atomic.StoreInt32(&b.height, height)
+}
+
+// receiveEvent waits for the next event sent to the given client.
+func receiveEvent(t *testing.T, client *subscribe.Client) interface{} {
+	t.Helper()
+
+	select {
+	case event := <-client.Updates():
+		return event
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no event received")
+		return nil
+	}
+}
+
+// assertNoEvent asserts that no event is sent to the given client.
+func assertNoEvent(t *testing.T, client *subscribe.Client) {
+	t.Helper()
+
+	select {
+	case event := <-client.Updates():
+		t.Fatalf("unexpected event: %v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// TestMonitorStallAndFailover asserts that a stalled backend is reported,
+// that it's reported as recovered once it makes progress again, and that we
+// only fail over once the fallback backend is ahead of it.
+func TestMonitorStallAndFailover(t *testing.T) {
+	t.Parallel()
+
+	const stallTimeout = time.Minute
+
+	active := &mockBackend{height: 100}
+	fallback := &mockBackend{height: 100}
+	failovers := make(chan struct{}, 2)
+	tick := ticker.NewForce(time.Hour)
+
+	m := New(&Config{
+		Active: Backend{
+			Name:       "active",
+			BestHeight: active.bestHeight,
+		},
+		Fallback: &Backend{
+			Name:       "fallback",
+			BestHeight: fallback.bestHeight,
+		},
+		StallTimeout: stallTimeout,
+		Ticker:       tick,
+		RequestShutdown: func() {
+			failovers <- struct{}{}
+		},
+	})
+	if err := m.Start(); err != nil {
+		t.Fatalf("unable to start monitor: %v", err)
+	}
+	defer m.Stop()
+
+	client, err := m.SubscribeChainBackendEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer client.Cancel()
+
+	start := time.Now()
+
+	// Within the stall timeout, nothing should be reported.
+	tick.Force <- start.Add(stallTimeout / 2)
+	assertNoEvent(t, client)
+
+	// Once the stall timeout has passed without a new block, the backend
+	// should be reported as stalled. As the fallback isn't ahead, we
+	// shouldn't fail over.
+	tick.Force <- start.Add(2 * stallTimeout)
+	event := receiveEvent(t, client)
+	stalledEvent, ok := event.(StalledEvent)
+	if !ok || stalledEvent.Backend != "active" ||
+		stalledEvent.Height != 100 {
+
+		t.Fatalf("unexpected event: %v", event)
+	}
+
+	// A new block should mark the backend as recovered.
+	active.setHeight(101)
+	tick.Force <- start.Add(3 * stallTimeout)
+	event = receiveEvent(t, client)
+	if _, ok := event.(RecoveredEvent); !ok {
+		t.Fatalf("expected recovered event, got %v", event)
+	}
+
+	// Now we'll let the active backend stall again, while the fallback
+	// makes progress beyond it, which should make us fail over.
+	fallback.setHeight(102)
+	tick.Force <- start.Add(5 * stallTimeout)
+	event = receiveEvent(t, client)
+	if _, ok := event.(StalledEvent); !ok {
+		t.Fatalf("expected stalled event, got %v", event)
+	}
+	event = receiveEvent(t, client)
+	failoverEvent, ok := event.(FailoverEvent)
+	if !ok || failoverEvent.From != "active" ||
+		failoverEvent.To != "fallback" ||
+		failoverEvent.Height != 101 ||
+		failoverEvent.FallbackHeight != 102 {
+
+		t.Fatalf("unexpected event: %v", event)
+	}
+
+	select {
+	case <-failovers:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("shutdown not requested")
+	}
+
+	// We should fail over at most once.
+	tick.Force <- start.Add(6 * stallTimeout)
+	assertNoEvent(t, client)
+	if len(failovers) != 0 {
+		t.Fatalf("shutdown requested more than once")
+	}
+}
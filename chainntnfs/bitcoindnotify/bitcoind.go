@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -15,6 +16,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcwallet/chain"
+	"github.com/lightningnetwork/lnd/blockfetch"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/queue"
 )
@@ -23,6 +25,17 @@ const (
 	// notifierType uniquely identifies this concrete implementation of the
 	// ChainNotifier interface.
 	notifierType = "bitcoind"
+
+	// blockPollInterval is the interval at which we poll bitcoind for its
+	// best block, as a fallback in case block notifications are missed
+	// over ZMQ.
+	blockPollInterval = time.Minute
+
+	// spendRescanBatchWindow is the time we wait for more historical spend
+	// dispatches to be requested before rescanning the chain, such that
+	// the registrations made at once, e.g. on startup, are rescanned in a
+	// single pass over the chain.
+	spendRescanBatchWindow = 100 * time.Millisecond
 )
 
 var (
@@ -68,6 +81,15 @@ type BitcoindNotifier struct {
 
 	bestBlock chainntnfs.BlockEpoch
 
+	// pendingSpendRescans are the historical spend dispatches waiting to
+	// be rescanned in the next batch.
+	pendingSpendRescans   []*chainntnfs.HistoricalSpendDispatch
+	pendingSpendRescansMu sync.Mutex
+
+	// spendRescanSignal is signaled when a historical spend dispatch is
+	// queued for the next batch.
+	spendRescanSignal chan struct{}
+
 	// spendHintCache is a cache used to query and update the latest height
 	// hints for an outpoint. Each height hint represents the earliest
 	// height at which the outpoint could have been spent within the chain.
@@ -78,6 +100,14 @@ type BitcoindNotifier struct {
 	// which the transaction could have confirmed within the chain.
 	confirmHintCache chainntnfs.ConfirmHintCache
 
+	// memNotifier dispatches the notifications of spends detected within
+	// the backend's mempool.
+	memNotifier *chainntnfs.MempoolNotifier
+
+	// blockFetcher is used to fetch the blocks pruned by bitcoind from
+	// its peers.
+	blockFetcher *blockfetch.Fetcher
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -86,12 +116,17 @@ type BitcoindNotifier struct {
 // time.
 var _ chainntnfs.ChainNotifier = (*BitcoindNotifier)(nil)
 
+// Ensure BitcoindNotifier implements the MempoolWatcher interface at compile
+// time.
+var _ chainntnfs.MempoolWatcher = (*BitcoindNotifier)(nil)
+
 // New returns a new BitcoindNotifier instance. This function assumes the
 // bitcoind node detailed in the passed configuration is already running, and
 // willing to accept RPC requests and new zmq clients.
 func New(chainConn *chain.BitcoindConn, chainParams *chaincfg.Params,
 	spendHintCache chainntnfs.SpendHintCache,
-	confirmHintCache chainntnfs.ConfirmHintCache) *BitcoindNotifier {
+	confirmHintCache chainntnfs.ConfirmHintCache,
+	blockFetcher *blockfetch.Fetcher) *BitcoindNotifier {
 
 	notifier := &BitcoindNotifier{
 		chainParams: chainParams,
@@ -101,9 +136,15 @@ func New(chainConn *chain.BitcoindConn, chainParams *chaincfg.Params,
 
 		blockEpochClients: make(map[uint64]*blockEpochRegistration),
 
+		spendRescanSignal: make(chan struct{}, 1),
+
 		spendHintCache:   spendHintCache,
 		confirmHintCache: confirmHintCache,
 
+		memNotifier: chainntnfs.NewMempoolNotifier(),
+
+		blockFetcher: blockFetcher,
+
 		quit: make(chan struct{}),
 	}
 
@@ -144,8 +185,9 @@ func (b *BitcoindNotifier) Start() error {
 		Hash:   currentHash,
 	}
 
-	b.wg.Add(1)
+	b.wg.Add(2)
 	go b.notificationDispatcher()
+	go b.spendRescanBatcher()
 
 	return nil
 }
@@ -173,6 +215,7 @@ func (b *BitcoindNotifier) Stop() error {
 		close(epochClient.epochChan)
 	}
 	b.txNotifier.TearDown()
+	b.memNotifier.TearDown()
 
 	return nil
 }
@@ -187,6 +230,9 @@ type blockNtfn struct {
 // notificationDispatcher is the primary goroutine which handles client
 // notification registrations, as well as notification dispatches.
 func (b *BitcoindNotifier) notificationDispatcher() {
+	pollTicker := time.NewTicker(blockPollInterval)
+	defer pollTicker.Stop()
+
 out:
 	for {
 		select {
@@ -224,18 +270,34 @@ out:
 				// included in the active chain. We'll do this
 				// in a goroutine to prevent blocking
 				// potentially long rescans.
-				//
-				// TODO(wilmer): add retry logic if rescan fails?
 				b.wg.Add(1)
 				go func() {
 					defer b.wg.Done()
 
-					confDetails, _, err := b.historicalConfDetails(
-						msg.ConfRequest,
-						msg.StartHeight, msg.EndHeight,
+					// If the rescan fails, we'll retry it as
+					// the clients waiting on it would
+					// otherwise never be notified.
+					var confDetails *chainntnfs.TxConfirmation
+					dispatch := func() error {
+						var err error
+						confDetails, _, err = b.historicalConfDetails(
+							msg.ConfRequest,
+							msg.StartHeight,
+							msg.EndHeight,
+						)
+						return err
+					}
+					err := chainntnfs.RetryHistoricalDispatch(
+						dispatch, b.quit,
 					)
 					if err != nil {
-						chainntnfs.Log.Error(err)
+						chainntnfs.Log.Errorf("Rescan to "+
+							"determine the conf "+
+							"details of %v within "+
+							"range %d-%d failed: %v",
+							msg.ConfRequest,
+							msg.StartHeight,
+							msg.EndHeight, err)
 						return
 					}
 
@@ -255,26 +317,11 @@ out:
 				}()
 
 			case *chainntnfs.HistoricalSpendDispatch:
-				// In order to ensure we don't block the caller
-				// on what may be a long rescan, we'll launch a
-				// goroutine to do so in the background.
-				//
-				// TODO(wilmer): add retry logic if rescan fails?
-				b.wg.Add(1)
-				go func() {
-					defer b.wg.Done()
-
-					err := b.dispatchSpendDetailsManually(msg)
-					if err != nil {
-						chainntnfs.Log.Errorf("Rescan to "+
-							"determine the spend "+
-							"details of %v within "+
-							"range %d-%d failed: %v",
-							msg.SpendRequest,
-							msg.StartHeight,
-							msg.EndHeight, err)
-					}
-				}()
+				// The rescan is batched with the other ones
+				// requested around the same time, which is done
+				// in the background in order to ensure we don't
+				// block the caller on what may be a long rescan.
+				b.queueSpendRescan(msg)
 
 			case *blockEpochRegistration:
 				chainntnfs.Log.Infof("New block epoch subscription")
@@ -315,9 +362,26 @@ out:
 				msg.errorChan <- nil
 			}
 
+		case <-pollTicker.C:
+			if err := b.pollBestBlock(); err != nil {
+				chainntnfs.Log.Errorf("Unable to catch up with "+
+					"polled best block: %v", err)
+			}
+
 		case ntfn := <-b.chainConn.Notifications():
 			switch item := ntfn.(type) {
 			case chain.BlockConnected:
+				// If we've already caught up past this block
+				// by polling, there's nothing left to do.
+				if item.Height <= b.bestBlock.Height &&
+					b.bestBlockInMainChain() {
+
+					chainntnfs.Log.Debugf("Skipping "+
+						"connected block %v (height=%d)",
+						item.Hash, item.Height)
+					continue
+				}
+
 				blockHeader, err :=
 					b.chainConn.GetBlockHeader(&item.Hash)
 				if err != nil {
@@ -368,6 +432,16 @@ out:
 				continue
 
 			case chain.BlockDisconnected:
+				// Likewise, if we've already rewound the chain
+				// by polling, there's nothing left to do.
+				if b.bestBlockInMainChain() {
+					chainntnfs.Log.Debugf("Skipping "+
+						"disconnected block %v "+
+						"(height=%d)", item.Hash,
+						item.Height)
+					continue
+				}
+
 				if item.Height != b.bestBlock.Height {
 					chainntnfs.Log.Infof("Missed disconnected" +
 						"blocks, attempting to catch up")
@@ -388,11 +462,14 @@ out:
 				b.bestBlock = newBestBlock
 
 			case chain.RelevantTx:
-				// We only care about notifying on confirmed
-				// spends, so if this is a mempool spend, we can
-				// ignore it and wait for the spend to appear in
-				// on-chain.
+				// Mempool spends are only dispatched to the
+				// clients of the mempool notifier, as the
+				// txNotifier only cares about confirmed
+				// spends.
 				if item.Block == nil {
+					b.memNotifier.ProcessTx(
+						&item.TxRecord.MsgTx,
+					)
 					continue
 				}
 
@@ -414,6 +491,55 @@ out:
 	b.wg.Done()
 }
 
+// pollBestBlock queries bitcoind for its best block, and catches up with it if
+// it differs from ours, handling a reorg if necessary. This allows us to
+// recover from block notifications missed over ZMQ, which would otherwise go
+// unnoticed until the next block is notified.
+func (b *BitcoindNotifier) pollBestBlock() error {
+	bestHash, bestHeight, err := b.chainConn.GetBestBlock()
+	if err != nil {
+		return fmt.Errorf("unable to get best block: %v", err)
+	}
+
+	if *bestHash == *b.bestBlock.Hash {
+		return nil
+	}
+
+	chainntnfs.Log.Infof("Best block %v (height=%d) wasn't notified, "+
+		"attempting to catch up", bestHash, bestHeight)
+
+	newBestBlock, missedBlocks, err := chainntnfs.HandleMissedBlocks(
+		b.chainConn, b.txNotifier, b.bestBlock, bestHeight+1, true,
+	)
+
+	// Set the bestBlock here in case a chain rewind occurred, as the
+	// missed blocks are connected on top of it.
+	b.bestBlock = newBestBlock
+	if err != nil {
+		return err
+	}
+
+	for _, block := range missedBlocks {
+		if err := b.handleBlockConnected(block); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// bestBlockInMainChain returns true if our best block is still part of
+// bitcoind's main chain. This allows us to detect block notifications that were
+// already handled by polling, before they were delivered.
+func (b *BitcoindNotifier) bestBlockInMainChain() bool {
+	hash, err := b.chainConn.GetBlockHash(int64(b.bestBlock.Height))
+	if err != nil {
+		return false
+	}
+
+	return *hash == *b.bestBlock.Hash
+}
+
 // historicalConfDetails looks up whether a confirmation request (txid/output
 // script) has already been included in a block in the active chain and, if so,
 // returns details about said block.
@@ -582,7 +708,7 @@ func (b *BitcoindNotifier) confDetailsManually(confRequest chainntnfs.ConfReques
 					"with height %d", height)
 		}
 
-		block, err := b.chainConn.GetBlock(blockHash)
+		block, err := b.getBlock(blockHash)
 		if err != nil {
 			return nil, chainntnfs.TxNotFoundManually,
 				fmt.Errorf("unable to get block with hash "+
@@ -611,6 +737,14 @@ func (b *BitcoindNotifier) confDetailsManually(confRequest chainntnfs.ConfReques
 	return nil, chainntnfs.TxNotFoundManually, nil
 }
 
+// getBlock fetches the block with the given hash from bitcoind, or from its
+// peers if bitcoind pruned it.
+func (b *BitcoindNotifier) getBlock(
+	blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
+
+	return b.blockFetcher.GetBlock(blockHash, b.chainConn.GetBlock)
+}
+
 // handleBlockConnected applies a chain update for a new block. Any watched
 // transactions included this block will processed to either send notifications
 // now or after numConfirmations confs.
@@ -618,7 +752,7 @@ func (b *BitcoindNotifier) handleBlockConnected(block chainntnfs.BlockEpoch) err
 	// First, we'll fetch the raw block as we'll need to gather all the
 	// transactions to determine whether any are relevant to our registered
 	// clients.
-	rawBlock, err := b.chainConn.GetBlock(block.Hash)
+	rawBlock, err := b.getBlock(block.Hash)
 	if err != nil {
 		return fmt.Errorf("unable to get block: %v", err)
 	}
@@ -823,20 +957,117 @@ func (b *BitcoindNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	return ntfn.Event, nil
 }
 
-// disaptchSpendDetailsManually attempts to manually scan the chain within the
-// given height range for a transaction that spends the given outpoint/output
-// script. If one is found, it's spending details are sent to the TxNotifier,
-// which will then dispatch the notification to all of its clients.
+// queueSpendRescan queues the given historical spend dispatch to be rescanned
+// in the next batch.
+func (b *BitcoindNotifier) queueSpendRescan(
+	dispatch *chainntnfs.HistoricalSpendDispatch) {
+
+	b.pendingSpendRescansMu.Lock()
+	b.pendingSpendRescans = append(b.pendingSpendRescans, dispatch)
+	b.pendingSpendRescansMu.Unlock()
+
+	select {
+	case b.spendRescanSignal <- struct{}{}:
+	default:
+	}
+}
+
+// spendRescanBatcher rescans the chain for the historical spend dispatches
+// queued by the notification dispatcher. The dispatches requested while a
+// rescan is in progress, or within spendRescanBatchWindow of each other, are
+// rescanned together, such that each block is only fetched once per batch
+// rather than once per registration.
+//
+// NOTE: This MUST be run as a goroutine.
+func (b *BitcoindNotifier) spendRescanBatcher() {
+	defer b.wg.Done()
+
+	for {
+		select {
+		case <-b.spendRescanSignal:
+		case <-b.quit:
+			return
+		}
+
+		// Give the clients registering at once a chance to have their
+		// dispatches included in this batch.
+		select {
+		case <-time.After(spendRescanBatchWindow):
+		case <-b.quit:
+			return
+		}
+
+		b.pendingSpendRescansMu.Lock()
+		batch := b.pendingSpendRescans
+		b.pendingSpendRescans = nil
+		b.pendingSpendRescansMu.Unlock()
+
+		if len(batch) == 0 {
+			continue
+		}
+
+		err := b.dispatchSpendDetailsManually(batch)
+		if err != nil && err != chainntnfs.ErrChainNotifierShuttingDown {
+			chainntnfs.Log.Errorf("Rescan to determine the spend "+
+				"details of %d requests failed: %v",
+				len(batch), err)
+		}
+	}
+}
+
+// dispatchSpendDetailsManually attempts to manually scan the chain for a
+// transaction that spends each of the given outpoints/output scripts, within
+// their own height range. The blocks are scanned once for all of them. If one
+// is found, its spending details are sent to the TxNotifier, which will then
+// dispatch the notification to all of its clients. The TxNotifier is also
+// notified of the ones that weren't found, so that it can begin updating their
+// spend hints.
+//
+// A failure to process one of the requests doesn't affect the others within
+// the batch, so such failures are logged rather than returned. An error is
+// only returned if the rescan was aborted as a whole.
 func (b *BitcoindNotifier) dispatchSpendDetailsManually(
-	historicalDispatchDetails *chainntnfs.HistoricalSpendDispatch) error {
+	batch []*chainntnfs.HistoricalSpendDispatch) error {
+
+	chainntnfs.Log.Debugf("Rescanning the chain for the spend details of "+
+		"%d requests", len(batch))
+
+	// We'll scan the blocks within the union of the height ranges of all
+	// requests.
+	var startHeight, endHeight uint32
+	pending := make(map[*chainntnfs.HistoricalSpendDispatch]struct{})
+	for i, dispatch := range batch {
+		if i == 0 || dispatch.StartHeight < startHeight {
+			startHeight = dispatch.StartHeight
+		}
+		if dispatch.EndHeight > endHeight {
+			endHeight = dispatch.EndHeight
+		}
+		pending[dispatch] = struct{}{}
+	}
+
+	// failDispatch removes a request we're unable to process any further
+	// from the set of pending ones.
+	failDispatch := func(dispatch *chainntnfs.HistoricalSpendDispatch,
+		err error) {
+
+		chainntnfs.Log.Errorf("Rescan to determine the spend details "+
+			"of %v within range %d-%d failed: %v",
+			dispatch.SpendRequest, dispatch.StartHeight,
+			dispatch.EndHeight, err)
 
-	spendRequest := historicalDispatchDetails.SpendRequest
-	startHeight := historicalDispatchDetails.StartHeight
-	endHeight := historicalDispatchDetails.EndHeight
+		delete(pending, dispatch)
+	}
 
-	// Begin scanning blocks at every height to determine if the outpoint
-	// was spent.
+	// Begin scanning blocks at every height to determine if the
+	// outpoints/output scripts were spent.
 	for height := endHeight; height >= startHeight && height > 0; height-- {
+		// Once the spends of all requests have been found, there's no
+		// need to scan any further.
+		if len(pending) == 0 {
+			return nil
+		}
+
 		// Ensure we haven't been requested to shut down before
 		// processing the next height.
 		select {
@@ -845,47 +1076,141 @@ func (b *BitcoindNotifier) dispatchSpendDetailsManually(
 		default:
 		}
 
-		// First, we'll fetch the block for the current height.
-		blockHash, err := b.chainConn.GetBlockHash(int64(height))
-		if err != nil {
-			return fmt.Errorf("unable to retrieve hash for block "+
-				"with height %d: %v", height, err)
-		}
-		block, err := b.chainConn.GetBlock(blockHash)
+		// First, we'll fetch the block for the current height. If we're
+		// unable to, then the requests whose range includes it can't
+		// be completed, but the others can still proceed.
+		block, err := b.fetchBlockByHeight(height)
 		if err != nil {
-			return fmt.Errorf("unable to retrieve block with hash "+
-				"%v: %v", blockHash, err)
+			for dispatch := range pending {
+				if height < dispatch.StartHeight ||
+					height > dispatch.EndHeight {
+
+					continue
+				}
+
+				failDispatch(dispatch, err)
+			}
+
+			continue
 		}
 
-		// Then, we'll manually go over every input in every transaction
-		// in it and determine whether it spends the request in
-		// question. If we find one, we'll dispatch the spend details.
-		for _, tx := range block.Transactions {
-			matches, inputIdx, err := spendRequest.MatchesTx(tx)
+		for dispatch := range pending {
+			if height < dispatch.StartHeight ||
+				height > dispatch.EndHeight {
+
+				continue
+			}
+
+			details, err := findSpend(
+				block, dispatch.SpendRequest, height,
+			)
 			if err != nil {
-				return err
+				failDispatch(dispatch, err)
+				continue
 			}
-			if !matches {
+			if details == nil {
 				continue
 			}
 
-			txHash := tx.TxHash()
-			details := &chainntnfs.SpendDetail{
-				SpentOutPoint:     &tx.TxIn[inputIdx].PreviousOutPoint,
-				SpenderTxHash:     &txHash,
-				SpendingTx:        tx,
-				SpenderInputIndex: inputIdx,
-				SpendingHeight:    int32(height),
-			}
+			delete(pending, dispatch)
 
-			return b.txNotifier.UpdateSpendDetails(
-				historicalDispatchDetails.SpendRequest,
-				details,
+			err = b.txNotifier.UpdateSpendDetails(
+				dispatch.SpendRequest, details,
 			)
+			if err != nil {
+				failDispatch(dispatch, err)
+			}
+		}
+	}
+
+	// The remaining requests weren't spent within their range, so we'll
+	// let the txNotifier know in order to begin updating their spend
+	// hints.
+	for dispatch := range pending {
+		chainntnfs.Log.Debugf("Spend of %v not found within range "+
+			"%d-%d", dispatch.SpendRequest, dispatch.StartHeight,
+			dispatch.EndHeight)
+
+		err := b.txNotifier.UpdateSpendDetails(
+			dispatch.SpendRequest, nil,
+		)
+		if err != nil {
+			failDispatch(dispatch, err)
+		}
+	}
+
+	return nil
+}
+
+// fetchBlockByHeight retrieves the block at the given height from the backend
+// node.
+func (b *BitcoindNotifier) fetchBlockByHeight(
+	height uint32) (*wire.MsgBlock, error) {
+
+	blockHash, err := b.chainConn.GetBlockHash(int64(height))
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve hash for block "+
+			"with height %d: %v", height, err)
+	}
+	block, err := b.getBlock(blockHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve block with hash "+
+			"%v: %v", blockHash, err)
+	}
+
+	return block, nil
+}
+
+// findSpend manually goes over every input in every transaction of the given
+// block and determines whether it spends the given request. If one does, its
+// spending details are returned.
+func findSpend(block *wire.MsgBlock, spendRequest chainntnfs.SpendRequest,
+	height uint32) (*chainntnfs.SpendDetail, error) {
+
+	for _, tx := range block.Transactions {
+		matches, inputIdx, err := spendRequest.MatchesTx(tx)
+		if err != nil {
+			return nil, err
 		}
+		if !matches {
+			continue
+		}
+
+		txHash := tx.TxHash()
+		return &chainntnfs.SpendDetail{
+			SpentOutPoint:     &tx.TxIn[inputIdx].PreviousOutPoint,
+			SpenderTxHash:     &txHash,
+			SpendingTx:        tx,
+			SpenderInputIndex: inputIdx,
+			SpendingHeight:    int32(height),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// RegisterMempoolSpendNtfn registers an intent to be notified once the target
+// outpoint is spent by a transaction within the mempool of bitcoind.
+//
+// NOTE: This is part of the chainntnfs.MempoolWatcher interface.
+func (b *BitcoindNotifier) RegisterMempoolSpendNtfn(
+	outpoint *wire.OutPoint) (*chainntnfs.MempoolSpendEvent, error) {
+
+	ntfn, err := b.memNotifier.RegisterSpend(*outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll then request the backend to notify us of the transactions
+	// spending the outpoint, which includes the ones accepted into its
+	// mempool.
+	ops := []*wire.OutPoint{outpoint}
+	if err := b.chainConn.NotifySpent(ops); err != nil {
+		ntfn.Cancel()
+		return nil, err
 	}
 
-	return ErrTransactionNotFound
+	return ntfn, nil
 }
 
 // RegisterConfirmationsNtfn registers an intent to be notified once the target
@@ -11,6 +11,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/integration/rpctest"
 	"github.com/btcsuite/btcwallet/chain"
+	"github.com/lightningnetwork/lnd/blockfetch"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 )
@@ -58,7 +59,7 @@ func setUpNotifier(t *testing.T, bitcoindConn *chain.BitcoindConn,
 
 	notifier := New(
 		bitcoindConn, chainntnfs.NetParams, spendHintCache,
-		confirmHintCache,
+		confirmHintCache, blockfetch.New(&blockfetch.Config{}),
 	)
 	if err := notifier.Start(); err != nil {
 		t.Fatalf("unable to start notifier: %v", err)
@@ -279,3 +280,107 @@ func TestHistoricalConfDetailsNoTxIndex(t *testing.T) {
 			"scanning the chain, but did not")
 	}
 }
+
+// TestDispatchSpendDetailsBatchFailure ensures that a request within a batch
+// of historical spend rescans that fails to be processed doesn't prevent the
+// spends of the other requests within the batch from being dispatched.
+func TestDispatchSpendDetailsBatchFailure(t *testing.T) {
+	miner, tearDown := chainntnfs.NewMiner(t, nil, true, 25)
+	defer tearDown()
+
+	bitcoindConn, cleanUp := chainntnfs.NewBitcoindBackend(
+		t, miner.P2PAddress(), false,
+	)
+	defer cleanUp()
+
+	hintCache := initHintCache(t)
+
+	notifier := setUpNotifier(t, bitcoindConn, hintCache, hintCache)
+	defer notifier.Stop()
+
+	heightHint := syncNotifierWithMiner(t, notifier, miner)
+
+	// We'll create three outputs and spend all of them within the same
+	// block.
+	spendRequests := make([]chainntnfs.SpendRequest, 3)
+	for i := range spendRequests {
+		outpoint, output, privKey := chainntnfs.CreateSpendableOutput(
+			t, miner,
+		)
+		spendTx := chainntnfs.CreateSpendTx(
+			t, outpoint, output, privKey,
+		)
+		spendTxHash, err := miner.Node.SendRawTransaction(spendTx, true)
+		if err != nil {
+			t.Fatalf("unable to broadcast tx: %v", err)
+		}
+		err = chainntnfs.WaitForMempoolTx(miner, spendTxHash)
+		if err != nil {
+			t.Fatalf("tx not relayed to miner: %v", err)
+		}
+
+		spendRequests[i], err = chainntnfs.NewSpendRequest(
+			outpoint, output.PkScript,
+		)
+		if err != nil {
+			t.Fatalf("unable to create spend request: %v", err)
+		}
+	}
+	if _, err := miner.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	currentHeight := syncNotifierWithMiner(t, notifier, miner)
+
+	// Only the first and last requests are registered with the
+	// TxNotifier, so the spend details of the one in between can't be
+	// delivered.
+	var (
+		events []*chainntnfs.SpendEvent
+		batch  []*chainntnfs.HistoricalSpendDispatch
+	)
+	for i, spendRequest := range spendRequests {
+		if i == 1 {
+			dispatch := &chainntnfs.HistoricalSpendDispatch{
+				SpendRequest: spendRequest,
+				StartHeight:  heightHint,
+				EndHeight:    currentHeight,
+			}
+			batch = append(batch, dispatch)
+			continue
+		}
+
+		ntfn := &chainntnfs.SpendNtfn{
+			SpendID:      uint64(i),
+			SpendRequest: spendRequest,
+			Event:        chainntnfs.NewSpendEvent(nil),
+			HeightHint:   heightHint,
+		}
+		dispatch, _, err := notifier.txNotifier.RegisterSpend(ntfn)
+		if err != nil {
+			t.Fatalf("unable to register spend: %v", err)
+		}
+		if dispatch == nil {
+			t.Fatalf("expected historical dispatch for %v",
+				spendRequest)
+		}
+		dispatch.EndHeight = currentHeight
+
+		events = append(events, ntfn.Event)
+		batch = append(batch, dispatch)
+	}
+
+	// Rescanning the batch shouldn't fail, even though one of its requests
+	// can't be processed.
+	if err := notifier.dispatchSpendDetailsManually(batch); err != nil {
+		t.Fatalf("unable to dispatch spend details: %v", err)
+	}
+
+	// The spends of the other requests should still be dispatched.
+	for i, event := range events {
+		select {
+		case <-event.Spend:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("spend notification %d not dispatched", i)
+		}
+	}
+}
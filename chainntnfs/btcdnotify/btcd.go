@@ -85,6 +85,10 @@ type BtcdNotifier struct {
 	// which the transaction could have confirmed within the chain.
 	confirmHintCache chainntnfs.ConfirmHintCache
 
+	// memNotifier dispatches the notifications of spends detected within
+	// the backend's mempool.
+	memNotifier *chainntnfs.MempoolNotifier
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -92,6 +96,9 @@ type BtcdNotifier struct {
 // Ensure BtcdNotifier implements the ChainNotifier interface at compile time.
 var _ chainntnfs.ChainNotifier = (*BtcdNotifier)(nil)
 
+// Ensure BtcdNotifier implements the MempoolWatcher interface at compile time.
+var _ chainntnfs.MempoolWatcher = (*BtcdNotifier)(nil)
+
 // New returns a new BtcdNotifier instance. This function assumes the btcd node
 // detailed in the passed configuration is already running, and willing to
 // accept new websockets clients.
@@ -113,6 +120,8 @@ func New(config *rpcclient.ConnConfig, chainParams *chaincfg.Params,
 		spendHintCache:   spendHintCache,
 		confirmHintCache: confirmHintCache,
 
+		memNotifier: chainntnfs.NewMempoolNotifier(),
+
 		quit: make(chan struct{}),
 	}
 
@@ -202,6 +211,7 @@ func (b *BtcdNotifier) Stop() error {
 		close(epochClient.epochChan)
 	}
 	b.txNotifier.TearDown()
+	b.memNotifier.TearDown()
 
 	return nil
 }
@@ -293,18 +303,34 @@ out:
 				// has already confirmed in the active chain.
 				// We'll do this in a goroutine to prevent
 				// blocking potentially long rescans.
-				//
-				// TODO(wilmer): add retry logic if rescan fails?
 				b.wg.Add(1)
 				go func() {
 					defer b.wg.Done()
 
-					confDetails, _, err := b.historicalConfDetails(
-						msg.ConfRequest,
-						msg.StartHeight, msg.EndHeight,
+					// If the rescan fails, we'll retry it as
+					// the clients waiting on it would
+					// otherwise never be notified.
+					var confDetails *chainntnfs.TxConfirmation
+					dispatch := func() error {
+						var err error
+						confDetails, _, err = b.historicalConfDetails(
+							msg.ConfRequest,
+							msg.StartHeight,
+							msg.EndHeight,
+						)
+						return err
+					}
+					err := chainntnfs.RetryHistoricalDispatch(
+						dispatch, b.quit,
 					)
 					if err != nil {
-						chainntnfs.Log.Error(err)
+						chainntnfs.Log.Errorf("Rescan to "+
+							"determine the conf "+
+							"details of %v within "+
+							"range %d-%d failed: %v",
+							msg.ConfRequest,
+							msg.StartHeight,
+							msg.EndHeight, err)
 						return
 					}
 
@@ -436,10 +462,11 @@ out:
 		case item := <-b.txUpdates.ChanOut():
 			newSpend := item.(*txUpdate)
 
-			// We only care about notifying on confirmed spends, so
-			// if this is a mempool spend, we can ignore it and wait
-			// for the spend to appear in on-chain.
+			// Mempool spends are only dispatched to the clients
+			// of the mempool notifier, as the txNotifier only
+			// cares about confirmed spends.
 			if newSpend.details == nil {
+				b.memNotifier.ProcessTx(newSpend.tx.MsgTx())
 				continue
 			}
 
@@ -927,6 +954,30 @@ func (b *BtcdNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
 	return ntfn.Event, nil
 }
 
+// RegisterMempoolSpendNtfn registers an intent to be notified once the target
+// outpoint is spent by a transaction within the mempool of btcd.
+//
+// NOTE: This is part of the chainntnfs.MempoolWatcher interface.
+func (b *BtcdNotifier) RegisterMempoolSpendNtfn(
+	outpoint *wire.OutPoint) (*chainntnfs.MempoolSpendEvent, error) {
+
+	ntfn, err := b.memNotifier.RegisterSpend(*outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll then request the backend to notify us of the transactions
+	// spending the outpoint, which includes the ones accepted into its
+	// mempool.
+	ops := []*wire.OutPoint{outpoint}
+	if err := b.chainConn.NotifySpent(ops); err != nil {
+		ntfn.Cancel()
+		return nil, err
+	}
+
+	return ntfn, nil
+}
+
 // RegisterConfirmationsNtfn registers an intent to be notified once the target
 // txid/output script has reached numConfs confirmations on-chain. When
 // intending to be notified of the confirmation of an output script, a nil txid
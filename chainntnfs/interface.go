@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcjson"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -11,6 +12,15 @@ import (
 )
 
 var (
+	// HistoricalDispatchAttempts is the number of times a historical
+	// dispatch that failed, for instance because the backend was
+	// temporarily unreachable, is attempted before giving up.
+	HistoricalDispatchAttempts = 5
+
+	// HistoricalDispatchBackoff is the delay before retrying a failed
+	// historical dispatch, which doubles after each attempt.
+	HistoricalDispatchBackoff = 5 * time.Second
+
 	// ErrChainNotifierShuttingDown is used when we are trying to
 	// measure a spend notification when notifier is already stopped.
 	ErrChainNotifierShuttingDown = errors.New("chain notifier shutting down")
@@ -143,6 +153,24 @@ type ChainNotifier interface {
 	Stop() error
 }
 
+// MempoolWatcher is an optional interface that can be implemented by a
+// ChainNotifier whose backend exposes its mempool. It allows spends to be
+// detected as soon as the spending transaction is accepted into the mempool,
+// rather than once it confirms.
+type MempoolWatcher interface {
+	// RegisterMempoolSpendNtfn registers an intent to be notified once the
+	// target outpoint is spent by a transaction within the backend's
+	// mempool. Spends that were already in the mempool at the time of
+	// registration aren't guaranteed to be detected.
+	//
+	// NOTE: The SpendingHeight of the dispatched SpendDetail is always
+	// zero, as the spending transaction is unconfirmed. Callers that need
+	// the spend to be confirmed MUST still register for it through
+	// RegisterSpendNtfn.
+	RegisterMempoolSpendNtfn(outpoint *wire.OutPoint) (*MempoolSpendEvent,
+		error)
+}
+
 // TxConfirmation carries some additional block-level details of the exact
 // block that specified transactions was confirmed within.
 type TxConfirmation struct {
@@ -280,6 +308,26 @@ func NewSpendEvent(cancel func()) *SpendEvent {
 	}
 }
 
+// MempoolSpendEvent encapsulates a notification of an outpoint being spent
+// within the mempool. Its field 'Spend' will be sent upon once a transaction
+// spending the outpoint passed into RegisterMempoolSpendNtfn is accepted into
+// the mempool.
+//
+// NOTE: If the caller wishes to cancel their registered spend notification,
+// the Cancel closure MUST be called.
+type MempoolSpendEvent struct {
+	// Spend is a receive only channel which will be sent upon once the
+	// target outpoint has been spent within the mempool.
+	//
+	// NOTE: This channel must be buffered.
+	Spend chan *SpendDetail
+
+	// Cancel is a closure that should be executed by the caller in the case
+	// that they wish to prematurely abandon their registered spend
+	// notification.
+	Cancel func()
+}
+
 // BlockEpoch represents metadata concerning each new block connected to the
 // main chain.
 type BlockEpoch struct {
@@ -458,6 +506,14 @@ func GetClientMissedBlocks(chainConn ChainConn, clientBestBlock *BlockEpoch,
 		}
 	}
 
+	// The client's best block may be ahead of ours if it was fetched from
+	// the chain backend before we processed the latest blocks. In that
+	// case, the client hasn't missed any blocks, and will be notified of
+	// the ones we process from now on.
+	if startingHeight >= notifierBestHeight {
+		return nil, nil
+	}
+
 	// We want to start dispatching historical notifications from the block
 	// right after the client's best block, to avoid a redundant notification.
 	missedBlocks, err := getMissedBlocks(
@@ -583,3 +639,31 @@ func getMissedBlocks(chainConn ChainConn, startingHeight,
 
 	return missedBlocks, nil
 }
+
+// RetryHistoricalDispatch runs the given historical dispatch, retrying it with
+// an exponential backoff if it fails, as otherwise the clients waiting on it
+// would never be notified of a confirmation or spend that happened before
+// their registration. It gives up once HistoricalDispatchAttempts attempts
+// have failed, returning the last error, or once the quit channel is closed.
+func RetryHistoricalDispatch(dispatch func() error,
+	quit <-chan struct{}) error {
+
+	backoff := HistoricalDispatchBackoff
+	for attempt := 1; ; attempt++ {
+		err := dispatch()
+		if err == nil || attempt >= HistoricalDispatchAttempts {
+			return err
+		}
+
+		Log.Warnf("Historical dispatch attempt %d failed, retrying "+
+			"in %v: %v", attempt, backoff, err)
+
+		select {
+		case <-time.After(backoff):
+			backoff *= 2
+
+		case <-quit:
+			return ErrChainNotifierShuttingDown
+		}
+	}
+}
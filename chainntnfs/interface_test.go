@@ -19,6 +19,7 @@ import (
 	"github.com/btcsuite/btcwallet/chain"
 	_ "github.com/btcsuite/btcwallet/walletdb/bdb" // Required to auto-register the boltdb walletdb implementation.
 	"github.com/lightninglabs/neutrino"
+	"github.com/lightningnetwork/lnd/blockfetch"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/chainntnfs/bitcoindnotify"
 	"github.com/lightningnetwork/lnd/chainntnfs/btcdnotify"
@@ -1919,6 +1920,7 @@ func TestInterfaces(t *testing.T) {
 				return bitcoindnotify.New(
 					bitcoindConn, chainntnfs.NetParams,
 					hintCache, hintCache,
+					blockfetch.New(&blockfetch.Config{}),
 				), nil
 			}
 
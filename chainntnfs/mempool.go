@@ -0,0 +1,157 @@
+package chainntnfs
+
+import (
+	"sync"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// MempoolNotifier is a helper for ChainNotifier backends implementing the
+// MempoolWatcher interface. It keeps track of the outpoints clients wish to
+// be notified of being spent within the mempool, and dispatches the
+// notifications for the unconfirmed transactions handed to it by the
+// backend.
+type MempoolNotifier struct {
+	// spendClientCounter is used to assign a unique ID to each client.
+	spendClientCounter uint64
+
+	// spendNotifications is the set of clients awaiting a mempool spend of
+	// each outpoint, keyed by their unique ID.
+	spendNotifications map[wire.OutPoint]map[uint64]*MempoolSpendEvent
+
+	// stopped denotes whether the notifier has been torn down, after
+	// which no further notifications are dispatched.
+	stopped bool
+
+	sync.Mutex
+}
+
+// NewMempoolNotifier creates a new MempoolNotifier.
+func NewMempoolNotifier() *MempoolNotifier {
+	return &MempoolNotifier{
+		spendNotifications: make(
+			map[wire.OutPoint]map[uint64]*MempoolSpendEvent,
+		),
+	}
+}
+
+// RegisterSpend registers an intent to be notified once the given outpoint is
+// spent by a transaction handed to ProcessTx.
+func (m *MempoolNotifier) RegisterSpend(
+	outpoint wire.OutPoint) (*MempoolSpendEvent, error) {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if m.stopped {
+		return nil, ErrTxNotifierExiting
+	}
+
+	spendID := m.spendClientCounter
+	m.spendClientCounter++
+
+	event := &MempoolSpendEvent{
+		Spend: make(chan *SpendDetail, 1),
+		Cancel: func() {
+			m.cancelSpend(outpoint, spendID)
+		},
+	}
+
+	clients, ok := m.spendNotifications[outpoint]
+	if !ok {
+		clients = make(map[uint64]*MempoolSpendEvent)
+		m.spendNotifications[outpoint] = clients
+	}
+	clients[spendID] = event
+
+	Log.Debugf("New mempool spend subscription: spend_id=%d, outpoint=%v",
+		spendID, outpoint)
+
+	return event, nil
+}
+
+// cancelSpend cancels the mempool spend notification of the client with the
+// given ID.
+func (m *MempoolNotifier) cancelSpend(outpoint wire.OutPoint, spendID uint64) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.stopped {
+		return
+	}
+
+	clients, ok := m.spendNotifications[outpoint]
+	if !ok {
+		return
+	}
+	event, ok := clients[spendID]
+	if !ok {
+		return
+	}
+
+	Log.Debugf("Canceling mempool spend notification: spend_id=%d, "+
+		"outpoint=%v", spendID, outpoint)
+
+	close(event.Spend)
+	delete(clients, spendID)
+	if len(clients) == 0 {
+		delete(m.spendNotifications, outpoint)
+	}
+}
+
+// ProcessTx dispatches a notification to the clients awaiting a mempool spend
+// of any of the outpoints spent by the given unconfirmed transaction. Each
+// client is only notified of the first such spend, as later spends can only
+// replace it.
+func (m *MempoolNotifier) ProcessTx(tx *wire.MsgTx) {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.stopped {
+		return
+	}
+
+	txHash := tx.TxHash()
+	for i, txIn := range tx.TxIn {
+		clients, ok := m.spendNotifications[txIn.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+
+		details := &SpendDetail{
+			SpentOutPoint:     &txIn.PreviousOutPoint,
+			SpenderTxHash:     &txHash,
+			SpendingTx:        tx,
+			SpenderInputIndex: uint32(i),
+		}
+
+		Log.Infof("Dispatching mempool spend notification for "+
+			"outpoint=%v, spending_tx=%v", txIn.PreviousOutPoint,
+			txHash)
+
+		for _, event := range clients {
+			select {
+			case event.Spend <- details:
+			default:
+			}
+		}
+	}
+}
+
+// TearDown closes the notification channels of all clients, after which no
+// further notifications are dispatched.
+func (m *MempoolNotifier) TearDown() {
+	m.Lock()
+	defer m.Unlock()
+
+	if m.stopped {
+		return
+	}
+	m.stopped = true
+
+	for _, clients := range m.spendNotifications {
+		for _, event := range clients {
+			close(event.Spend)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package chainntnfs_test
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// TestMempoolNotifierSpendDispatch ensures that clients are notified of the
+// mempool spends of the outpoints they registered for, and no longer once
+// they've canceled their registration.
+func TestMempoolNotifierSpendDispatch(t *testing.T) {
+	t.Parallel()
+
+	n := chainntnfs.NewMempoolNotifier()
+
+	op1 := wire.OutPoint{Index: 1}
+	op2 := wire.OutPoint{Index: 2}
+	ntfn1, err := n.RegisterSpend(op1)
+	if err != nil {
+		t.Fatalf("unable to register spend ntfn: %v", err)
+	}
+	ntfn2, err := n.RegisterSpend(op2)
+	if err != nil {
+		t.Fatalf("unable to register spend ntfn: %v", err)
+	}
+
+	// A transaction spending the first outpoint should only be dispatched
+	// to the first client.
+	spendTx := wire.NewMsgTx(2)
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: wire.OutPoint{Index: 3}})
+	spendTx.AddTxIn(&wire.TxIn{PreviousOutPoint: op1})
+	n.ProcessTx(spendTx)
+
+	select {
+	case details := <-ntfn1.Spend:
+		spendTxHash := spendTx.TxHash()
+		if *details.SpenderTxHash != spendTxHash {
+			t.Fatalf("expected spender %v, got %v", spendTxHash,
+				details.SpenderTxHash)
+		}
+		if details.SpenderInputIndex != 1 {
+			t.Fatalf("expected spender input index 1, got %v",
+				details.SpenderInputIndex)
+		}
+		if details.SpendingHeight != 0 {
+			t.Fatalf("expected no spending height, got %v",
+				details.SpendingHeight)
+		}
+	default:
+		t.Fatal("expected to receive mempool spend notification")
+	}
+
+	select {
+	case <-ntfn2.Spend:
+		t.Fatal("received unexpected mempool spend notification")
+	default:
+	}
+
+	// Once the second client cancels its registration, its notification
+	// channel should be closed, and spends of the second outpoint should
+	// no longer be dispatched.
+	ntfn2.Cancel()
+	if _, ok := <-ntfn2.Spend; ok {
+		t.Fatal("expected mempool spend channel to be closed")
+	}
+
+	spendTx2 := wire.NewMsgTx(2)
+	spendTx2.AddTxIn(&wire.TxIn{PreviousOutPoint: op2})
+	n.ProcessTx(spendTx2)
+
+	// Tearing down the notifier should close the remaining notification
+	// channels, and prevent new registrations.
+	n.TearDown()
+	if _, ok := <-ntfn1.Spend; ok {
+		t.Fatal("expected mempool spend channel to be closed")
+	}
+	if _, err := n.RegisterSpend(op1); err != chainntnfs.ErrTxNotifierExiting {
+		t.Fatalf("expected ErrTxNotifierExiting, got %v", err)
+	}
+}
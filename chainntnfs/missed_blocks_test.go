@@ -0,0 +1,147 @@
+package chainntnfs_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcjson"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// mockChainConn is a ChainConn backed by a chain of blocks with distinct
+// hashes, where the block at each height builds upon the previous one.
+type mockChainConn struct {
+	hashes []chainhash.Hash
+}
+
+func newMockChainConn(height int32) *mockChainConn {
+	hashes := make([]chainhash.Hash, height+1)
+	for i := range hashes {
+		hashes[i] = chainhash.Hash{byte(i), 0x01}
+	}
+
+	return &mockChainConn{hashes: hashes}
+}
+
+func (m *mockChainConn) height(hash *chainhash.Hash) (int32, error) {
+	for height, h := range m.hashes {
+		if h == *hash {
+			return int32(height), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown block %v", hash)
+}
+
+func (m *mockChainConn) GetBlockHeader(
+	hash *chainhash.Hash) (*wire.BlockHeader, error) {
+
+	height, err := m.height(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	header := &wire.BlockHeader{}
+	if height > 0 {
+		header.PrevBlock = m.hashes[height-1]
+	}
+
+	return header, nil
+}
+
+func (m *mockChainConn) GetBlockHeaderVerbose(
+	hash *chainhash.Hash) (*btcjson.GetBlockHeaderVerboseResult, error) {
+
+	height, err := m.height(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	return &btcjson.GetBlockHeaderVerboseResult{Height: height}, nil
+}
+
+func (m *mockChainConn) GetBlockHash(height int64) (*chainhash.Hash, error) {
+	if height < 0 || height >= int64(len(m.hashes)) {
+		return nil, fmt.Errorf("no block at height %v", height)
+	}
+
+	return &m.hashes[height], nil
+}
+
+// TestGetClientMissedBlocks asserts that a client registering with its best
+// known block is caught up on the blocks it missed, and isn't rejected when
+// its best block is ahead of the notifier's, as happens when it was fetched
+// from the chain backend before the notifier processed the latest blocks.
+func TestGetClientMissedBlocks(t *testing.T) {
+	t.Parallel()
+
+	const backendHeight = 10
+	chainConn := newMockChainConn(backendHeight)
+
+	testCases := []struct {
+		name               string
+		clientHeight       int32
+		notifierBestHeight int32
+		expectedHeights    []int32
+	}{
+		{
+			name:               "client behind notifier",
+			clientHeight:       5,
+			notifierBestHeight: 8,
+			expectedHeights:    []int32{6, 7, 8},
+		},
+		{
+			name:               "client at notifier tip",
+			clientHeight:       8,
+			notifierBestHeight: 8,
+		},
+		{
+			name:               "client ahead of notifier",
+			clientHeight:       10,
+			notifierBestHeight: 8,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			clientBestBlock := &chainntnfs.BlockEpoch{
+				Hash:   &chainConn.hashes[test.clientHeight],
+				Height: test.clientHeight,
+			}
+
+			for _, storesReorgs := range []bool{false, true} {
+				missedBlocks, err := chainntnfs.GetClientMissedBlocks(
+					chainConn, clientBestBlock,
+					test.notifierBestHeight, storesReorgs,
+				)
+				if err != nil {
+					t.Fatalf("unable to get missed blocks: %v",
+						err)
+				}
+
+				if len(missedBlocks) != len(test.expectedHeights) {
+					t.Fatalf("expected %d missed blocks, "+
+						"got %d", len(test.expectedHeights),
+						len(missedBlocks))
+				}
+				for i, block := range missedBlocks {
+					height := test.expectedHeights[i]
+					if block.Height != height ||
+						*block.Hash != chainConn.hashes[height] {
+
+						t.Fatalf("expected block %v at "+
+							"height %v, got %v at "+
+							"height %v",
+							chainConn.hashes[height],
+							height, block.Hash,
+							block.Height)
+					}
+				}
+			}
+		})
+	}
+}
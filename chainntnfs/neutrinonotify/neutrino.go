@@ -309,12 +309,30 @@ out:
 				go func() {
 					defer n.wg.Done()
 
-					confDetails, err := n.historicalConfDetails(
-						msg.ConfRequest,
-						msg.StartHeight, msg.EndHeight,
+					// If the rescan fails, we'll retry it as
+					// the clients waiting on it would
+					// otherwise never be notified.
+					var confDetails *chainntnfs.TxConfirmation
+					dispatch := func() error {
+						var err error
+						confDetails, err = n.historicalConfDetails(
+							msg.ConfRequest,
+							msg.StartHeight,
+							msg.EndHeight,
+						)
+						return err
+					}
+					err := chainntnfs.RetryHistoricalDispatch(
+						dispatch, n.quit,
 					)
 					if err != nil {
-						chainntnfs.Log.Error(err)
+						chainntnfs.Log.Errorf("Rescan to "+
+							"determine the conf "+
+							"details of %v within "+
+							"range %d-%d failed: %v",
+							msg.ConfRequest,
+							msg.StartHeight,
+							msg.EndHeight, err)
 						return
 					}
 
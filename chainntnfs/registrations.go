@@ -0,0 +1,794 @@
+package chainntnfs
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+// RegistrationType denotes the type of a notification registration.
+type RegistrationType uint8
+
+const (
+	// ConfRegistration is a registration for the confirmation of a
+	// transaction or output script.
+	ConfRegistration RegistrationType = iota
+
+	// SpendRegistration is a registration for the spend of an outpoint or
+	// output script.
+	SpendRegistration
+)
+
+// String returns a human readable version of the registration type.
+func (t RegistrationType) String() string {
+	switch t {
+	case ConfRegistration:
+		return "conf"
+	case SpendRegistration:
+		return "spend"
+	default:
+		return "unknown"
+	}
+}
+
+// Registration describes a live notification registration of a client of the
+// chain notifier.
+type Registration struct {
+	// Client is the name of the client, usually a subsystem, that made the
+	// registration.
+	Client string
+
+	// Type is the type of the registration.
+	Type RegistrationType
+
+	// Request is the txid/outpoint or output script the registration is
+	// for.
+	Request string
+
+	// NumConfs is the number of confirmations requested. This is only
+	// set for confirmation registrations.
+	NumConfs uint32
+
+	// HeightHint is the height hint the registration was made with.
+	HeightHint uint32
+
+	// RegisteredAt is the time the registration was first made.
+	RegisteredAt time.Time
+
+	// NumSubscribers is the number of times the client registered for the
+	// same notification. All of them share a single registration with the
+	// chain notifier.
+	NumSubscribers uint32
+
+	// Dispatched is true if the confirmation or spend has been dispatched
+	// and hasn't been reorged out of the chain since.
+	Dispatched bool
+}
+
+// confKey identifies the confirmation registrations of a client. The height
+// hint is part of the key, as a registration with an earlier hint may need to
+// rescan blocks the existing registration won't.
+type confKey struct {
+	client     string
+	request    ConfRequest
+	numConfs   uint32
+	heightHint uint32
+}
+
+// spendKey identifies the spend registrations of a client. Like confKey, it
+// includes the height hint of the registrations.
+type spendKey struct {
+	client     string
+	request    SpendRequest
+	heightHint uint32
+}
+
+// numConfsLeft, reorgDepth, spendReorg and ntfnDone are the notifications
+// queued for the subscribers of a registration, besides the confirmations and
+// spends themselves.
+type (
+	numConfsLeft uint32
+	reorgDepth   int32
+	spendReorg   struct{}
+	ntfnDone     struct{}
+)
+
+// subscriber queues the notifications of a registration for one of its
+// subscribers, and delivers them to the subscriber's event in order. As the
+// queue is unbounded, a slow subscriber doesn't miss any notifications, nor
+// does it hold up the other subscribers of the registration.
+type subscriber struct {
+	ntfns *queue.ConcurrentQueue
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newSubscriber creates a new subscriber, which hands the notifications that
+// are queued for it to the passed deliver closure. The closure returns false
+// once no more notifications should be delivered.
+func newSubscriber(deliver func(ntfn interface{},
+	quit <-chan struct{}) bool) *subscriber {
+
+	s := &subscriber{
+		ntfns: queue.NewConcurrentQueue(1),
+		quit:  make(chan struct{}),
+	}
+	s.ntfns.Start()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		defer s.ntfns.Stop()
+
+		for {
+			select {
+			case ntfn := <-s.ntfns.ChanOut():
+				if !deliver(ntfn, s.quit) {
+					return
+				}
+
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+
+	return s
+}
+
+// notify queues the passed notification for the subscriber.
+//
+// NOTE: This must not be called once the subscriber is stopped.
+func (s *subscriber) notify(ntfn interface{}) {
+	s.ntfns.ChanIn() <- ntfn
+}
+
+// stop stops delivering notifications to the subscriber. Once it returns, the
+// channels of the subscriber's event are no longer sent on.
+func (s *subscriber) stop() {
+	close(s.quit)
+	s.wg.Wait()
+	s.ntfns.Stop()
+}
+
+// confSubscriber is a subscriber of a confirmation registration.
+type confSubscriber struct {
+	*subscriber
+
+	event *ConfirmationEvent
+}
+
+// newConfSubscriber creates a new subscriber delivering the notifications of
+// a confirmation registration to the passed event.
+func newConfSubscriber(event *ConfirmationEvent) *confSubscriber {
+	deliver := func(ntfn interface{}, quit <-chan struct{}) bool {
+		switch n := ntfn.(type) {
+		case *TxConfirmation:
+			select {
+			case event.Confirmed <- n:
+			case <-quit:
+				return false
+			}
+
+		case numConfsLeft:
+			select {
+			case event.Updates <- uint32(n):
+			case <-quit:
+				return false
+			}
+
+		case reorgDepth:
+			select {
+			case event.NegativeConf <- int32(n):
+			case <-quit:
+				return false
+			}
+
+		case ntfnDone:
+			select {
+			case event.Done <- struct{}{}:
+			case <-quit:
+			}
+
+			return false
+		}
+
+		return true
+	}
+
+	return &confSubscriber{
+		subscriber: newSubscriber(deliver),
+		event:      event,
+	}
+}
+
+// spendSubscriber is a subscriber of a spend registration.
+type spendSubscriber struct {
+	*subscriber
+
+	event *SpendEvent
+}
+
+// newSpendSubscriber creates a new subscriber delivering the notifications of
+// a spend registration to the passed event.
+func newSpendSubscriber(event *SpendEvent) *spendSubscriber {
+	deliver := func(ntfn interface{}, quit <-chan struct{}) bool {
+		switch n := ntfn.(type) {
+		case *SpendDetail:
+			select {
+			case event.Spend <- n:
+			case <-quit:
+				return false
+			}
+
+		case spendReorg:
+			select {
+			case event.Reorg <- struct{}{}:
+			case <-quit:
+				return false
+			}
+
+		case ntfnDone:
+			select {
+			case event.Done <- struct{}{}:
+			case <-quit:
+			}
+
+			return false
+		}
+
+		return true
+	}
+
+	return &spendSubscriber{
+		subscriber: newSubscriber(deliver),
+		event:      event,
+	}
+}
+
+// confRegistration is a confirmation registration with the chain notifier,
+// shared by all of the identical registrations of a client.
+type confRegistration struct {
+	info Registration
+
+	// event is the event returned by the chain notifier.
+	event *ConfirmationEvent
+
+	// subscribers deliver notifications to the events returned to the
+	// client, indexed by their subscriber ID.
+	subscribers map[uint64]*confSubscriber
+
+	// conf is the confirmation that was dispatched, if any, which is
+	// delivered to subsequent subscribers.
+	conf *TxConfirmation
+}
+
+// spendRegistration is a spend registration with the chain notifier, shared by
+// all of the identical registrations of a client.
+type spendRegistration struct {
+	info Registration
+
+	// event is the event returned by the chain notifier.
+	event *SpendEvent
+
+	// subscribers deliver notifications to the events returned to the
+	// client, indexed by their subscriber ID.
+	subscribers map[uint64]*spendSubscriber
+
+	// spend is the spend that was dispatched, if any, which is delivered
+	// to subsequent subscribers.
+	spend *SpendDetail
+}
+
+// RegistrationTracker keeps track of the live confirmation and spend
+// registrations of each client of a chain notifier, which allows them to be
+// listed in order to debug missed notifications. Identical registrations of a
+// client, made with the same height hint, are coalesced into a single
+// registration with the chain notifier, so that a subsystem restarting and
+// registering again doesn't pile up registrations, while still handing out a
+// distinct event for each of them.
+type RegistrationTracker struct {
+	nextSubscriberID uint64
+
+	confs  map[confKey]*confRegistration
+	spends map[spendKey]*spendRegistration
+
+	mu sync.Mutex
+}
+
+// NewRegistrationTracker creates a new RegistrationTracker.
+func NewRegistrationTracker() *RegistrationTracker {
+	return &RegistrationTracker{
+		confs:  make(map[confKey]*confRegistration),
+		spends: make(map[spendKey]*spendRegistration),
+	}
+}
+
+// Track returns a ChainNotifier backed by the given one, whose confirmation
+// and spend registrations are tracked under the given client name.
+func (t *RegistrationTracker) Track(client string,
+	notifier ChainNotifier) ChainNotifier {
+
+	return &trackedNotifier{
+		ChainNotifier: notifier,
+		client:        client,
+		tracker:       t,
+	}
+}
+
+// Registrations returns the live registrations of all clients, sorted by
+// client and registration time.
+func (t *RegistrationTracker) Registrations() []Registration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	registrations := make(
+		[]Registration, 0, len(t.confs)+len(t.spends),
+	)
+	for _, reg := range t.confs {
+		info := reg.info
+		info.NumSubscribers = uint32(len(reg.subscribers))
+		info.Dispatched = reg.conf != nil
+		registrations = append(registrations, info)
+	}
+	for _, reg := range t.spends {
+		info := reg.info
+		info.NumSubscribers = uint32(len(reg.subscribers))
+		info.Dispatched = reg.spend != nil
+		registrations = append(registrations, info)
+	}
+
+	sort.Slice(registrations, func(i, j int) bool {
+		if registrations[i].Client != registrations[j].Client {
+			return registrations[i].Client < registrations[j].Client
+		}
+
+		return registrations[i].RegisteredAt.Before(
+			registrations[j].RegisteredAt,
+		)
+	})
+
+	return registrations
+}
+
+// trackedNotifier is a ChainNotifier whose confirmation and spend registrations
+// are tracked by a RegistrationTracker under the name of its client.
+type trackedNotifier struct {
+	ChainNotifier
+
+	client  string
+	tracker *RegistrationTracker
+}
+
+// A compile-time check to ensure trackedNotifier implements the ChainNotifier
+// interface.
+var _ ChainNotifier = (*trackedNotifier)(nil)
+
+// RegisterConfirmationsNtfn registers an intent to be notified once the target
+// txid/output script has reached numConfs confirmations on-chain. If the client
+// already registered for the same notification with the same height hint, the
+// existing registration with the backing chain notifier is shared.
+//
+// NOTE: This is part of the ChainNotifier interface.
+func (n *trackedNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	pkScript []byte, numConfs, heightHint uint32) (*ConfirmationEvent,
+	error) {
+
+	confRequest, err := NewConfRequest(txid, pkScript)
+	if err != nil {
+		return nil, err
+	}
+	key := confKey{
+		client:     n.client,
+		request:    confRequest,
+		numConfs:   numConfs,
+		heightHint: heightHint,
+	}
+
+	t := n.tracker
+	t.mu.Lock()
+	reg, ok := t.confs[key]
+	t.mu.Unlock()
+
+	// If this is the first time the client registers for this
+	// notification, we'll register it with the backing chain notifier.
+	// This is done without holding the lock, as the chain notifier may
+	// need to dispatch notifications to other registrations in order to
+	// process ours.
+	if !ok {
+		event, err := n.ChainNotifier.RegisterConfirmationsNtfn(
+			txid, pkScript, numConfs, heightHint,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		t.mu.Lock()
+		reg, ok = t.confs[key]
+		if !ok {
+			reg = &confRegistration{
+				info: Registration{
+					Client:       n.client,
+					Type:         ConfRegistration,
+					Request:      confRequest.String(),
+					NumConfs:     numConfs,
+					HeightHint:   heightHint,
+					RegisteredAt: time.Now(),
+				},
+				event: event,
+				subscribers: make(
+					map[uint64]*confSubscriber,
+				),
+			}
+			t.confs[key] = reg
+
+			go t.forwardConfs(key, reg)
+		}
+		t.mu.Unlock()
+
+		// If the same registration was made concurrently, we'll
+		// cancel ours in favor of it.
+		if ok {
+			event.Cancel()
+		}
+	}
+
+	// The registration may have been torn down in the meantime, in which
+	// case we'll make a new one.
+	t.mu.Lock()
+	if t.confs[key] != reg {
+		t.mu.Unlock()
+
+		return n.RegisterConfirmationsNtfn(
+			txid, pkScript, numConfs, heightHint,
+		)
+	}
+	defer t.mu.Unlock()
+
+	if len(reg.subscribers) > 0 {
+		Log.Debugf("Client %v registered again for confirmation of "+
+			"%v, sharing existing registration", n.client,
+			confRequest)
+	}
+
+	t.nextSubscriberID++
+	subscriberID := t.nextSubscriberID
+
+	event := NewConfirmationEvent(numConfs, func() {
+		t.cancelConf(key, reg, subscriberID)
+	})
+	sub := newConfSubscriber(event)
+	reg.subscribers[subscriberID] = sub
+
+	// If the confirmation was already dispatched, we'll deliver it to the
+	// new subscriber right away.
+	if reg.conf != nil {
+		sub.notify(reg.conf)
+	}
+
+	return event, nil
+}
+
+// cancelConf cancels the given subscriber of a confirmation registration. The
+// registration with the chain notifier is canceled once it has no subscribers
+// left.
+func (t *RegistrationTracker) cancelConf(key confKey, reg *confRegistration,
+	subscriberID uint64) {
+
+	t.mu.Lock()
+	sub, ok := reg.subscribers[subscriberID]
+	if !ok {
+		t.mu.Unlock()
+		return
+	}
+
+	sub.stop()
+	close(sub.event.Confirmed)
+	close(sub.event.Updates)
+	close(sub.event.NegativeConf)
+	close(sub.event.Done)
+	delete(reg.subscribers, subscriberID)
+
+	cancelRegistration := len(reg.subscribers) == 0 && t.confs[key] == reg
+	if cancelRegistration {
+		delete(t.confs, key)
+	}
+	t.mu.Unlock()
+
+	if cancelRegistration {
+		reg.event.Cancel()
+	}
+}
+
+// forwardConfs forwards the notifications of a confirmation registration to
+// all of its subscribers, until the registration is done or torn down.
+//
+// NOTE: This MUST be run as a goroutine.
+func (t *RegistrationTracker) forwardConfs(key confKey,
+	reg *confRegistration) {
+
+	for {
+		select {
+		case conf, ok := <-reg.event.Confirmed:
+			if !ok {
+				t.removeConf(key, reg)
+				return
+			}
+
+			t.mu.Lock()
+			reg.conf = conf
+			for _, sub := range reg.subscribers {
+				sub.notify(conf)
+			}
+			t.mu.Unlock()
+
+		case confsLeft, ok := <-reg.event.Updates:
+			if !ok {
+				t.removeConf(key, reg)
+				return
+			}
+
+			t.mu.Lock()
+			for _, sub := range reg.subscribers {
+				sub.notify(numConfsLeft(confsLeft))
+			}
+			t.mu.Unlock()
+
+		case depth, ok := <-reg.event.NegativeConf:
+			if !ok {
+				t.removeConf(key, reg)
+				return
+			}
+
+			t.mu.Lock()
+			reg.conf = nil
+			for _, sub := range reg.subscribers {
+				sub.notify(reorgDepth(depth))
+			}
+			t.mu.Unlock()
+
+		case _, ok := <-reg.event.Done:
+			if !ok {
+				t.removeConf(key, reg)
+				return
+			}
+
+			// No more notifications will be dispatched for this
+			// registration, so we'll stop tracking it.
+			t.mu.Lock()
+			for _, sub := range reg.subscribers {
+				sub.notify(ntfnDone{})
+			}
+			if t.confs[key] == reg {
+				delete(t.confs, key)
+			}
+			t.mu.Unlock()
+
+			return
+		}
+	}
+}
+
+// removeConf stops tracking a confirmation registration that was torn down by
+// the chain notifier, closing the channels of its subscribers.
+func (t *RegistrationTracker) removeConf(key confKey, reg *confRegistration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for subscriberID, sub := range reg.subscribers {
+		sub.stop()
+		close(sub.event.Confirmed)
+		close(sub.event.Updates)
+		close(sub.event.NegativeConf)
+		close(sub.event.Done)
+		delete(reg.subscribers, subscriberID)
+	}
+
+	if t.confs[key] == reg {
+		delete(t.confs, key)
+	}
+}
+
+// RegisterSpendNtfn registers an intent to be notified once the target
+// outpoint/output script has been spent by a transaction on-chain. If the
+// client already registered for the same notification with the same height
+// hint, the existing registration with the backing chain notifier is shared.
+//
+// NOTE: This is part of the ChainNotifier interface.
+func (n *trackedNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*SpendEvent, error) {
+
+	spendRequest, err := NewSpendRequest(outpoint, pkScript)
+	if err != nil {
+		return nil, err
+	}
+	key := spendKey{
+		client:     n.client,
+		request:    spendRequest,
+		heightHint: heightHint,
+	}
+
+	t := n.tracker
+	t.mu.Lock()
+	reg, ok := t.spends[key]
+	t.mu.Unlock()
+
+	// As with confirmations, the first registration of the client is made
+	// with the backing chain notifier without holding the lock.
+	if !ok {
+		event, err := n.ChainNotifier.RegisterSpendNtfn(
+			outpoint, pkScript, heightHint,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		t.mu.Lock()
+		reg, ok = t.spends[key]
+		if !ok {
+			reg = &spendRegistration{
+				info: Registration{
+					Client:       n.client,
+					Type:         SpendRegistration,
+					Request:      spendRequest.String(),
+					HeightHint:   heightHint,
+					RegisteredAt: time.Now(),
+				},
+				event:       event,
+				subscribers: make(map[uint64]*spendSubscriber),
+			}
+			t.spends[key] = reg
+
+			go t.forwardSpends(key, reg)
+		}
+		t.mu.Unlock()
+
+		if ok {
+			event.Cancel()
+		}
+	}
+
+	t.mu.Lock()
+	if t.spends[key] != reg {
+		t.mu.Unlock()
+
+		return n.RegisterSpendNtfn(outpoint, pkScript, heightHint)
+	}
+	defer t.mu.Unlock()
+
+	if len(reg.subscribers) > 0 {
+		Log.Debugf("Client %v registered again for spend of %v, "+
+			"sharing existing registration", n.client,
+			spendRequest)
+	}
+
+	t.nextSubscriberID++
+	subscriberID := t.nextSubscriberID
+
+	event := NewSpendEvent(func() {
+		t.cancelSpend(key, reg, subscriberID)
+	})
+	sub := newSpendSubscriber(event)
+	reg.subscribers[subscriberID] = sub
+
+	// If the spend was already dispatched, we'll deliver it to the new
+	// subscriber right away.
+	if reg.spend != nil {
+		sub.notify(reg.spend)
+	}
+
+	return event, nil
+}
+
+// cancelSpend cancels the given subscriber of a spend registration. The
+// registration with the chain notifier is canceled once it has no subscribers
+// left.
+func (t *RegistrationTracker) cancelSpend(key spendKey,
+	reg *spendRegistration, subscriberID uint64) {
+
+	t.mu.Lock()
+	sub, ok := reg.subscribers[subscriberID]
+	if !ok {
+		t.mu.Unlock()
+		return
+	}
+
+	sub.stop()
+	close(sub.event.Spend)
+	close(sub.event.Reorg)
+	close(sub.event.Done)
+	delete(reg.subscribers, subscriberID)
+
+	cancelRegistration := len(reg.subscribers) == 0 && t.spends[key] == reg
+	if cancelRegistration {
+		delete(t.spends, key)
+	}
+	t.mu.Unlock()
+
+	if cancelRegistration {
+		reg.event.Cancel()
+	}
+}
+
+// forwardSpends forwards the notifications of a spend registration to all of
+// its subscribers, until the registration is done or torn down.
+//
+// NOTE: This MUST be run as a goroutine.
+func (t *RegistrationTracker) forwardSpends(key spendKey,
+	reg *spendRegistration) {
+
+	for {
+		select {
+		case spend, ok := <-reg.event.Spend:
+			if !ok {
+				t.removeSpend(key, reg)
+				return
+			}
+
+			t.mu.Lock()
+			reg.spend = spend
+			for _, sub := range reg.subscribers {
+				sub.notify(spend)
+			}
+			t.mu.Unlock()
+
+		case _, ok := <-reg.event.Reorg:
+			if !ok {
+				t.removeSpend(key, reg)
+				return
+			}
+
+			t.mu.Lock()
+			reg.spend = nil
+			for _, sub := range reg.subscribers {
+				sub.notify(spendReorg{})
+			}
+			t.mu.Unlock()
+
+		case _, ok := <-reg.event.Done:
+			if !ok {
+				t.removeSpend(key, reg)
+				return
+			}
+
+			// No more notifications will be dispatched for this
+			// registration, so we'll stop tracking it.
+			t.mu.Lock()
+			for _, sub := range reg.subscribers {
+				sub.notify(ntfnDone{})
+			}
+			if t.spends[key] == reg {
+				delete(t.spends, key)
+			}
+			t.mu.Unlock()
+
+			return
+		}
+	}
+}
+
+// removeSpend stops tracking a spend registration that was torn down by the
+// chain notifier, closing the channels of its subscribers.
+func (t *RegistrationTracker) removeSpend(key spendKey,
+	reg *spendRegistration) {
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for subscriberID, sub := range reg.subscribers {
+		sub.stop()
+		close(sub.event.Spend)
+		close(sub.event.Reorg)
+		close(sub.event.Done)
+		delete(reg.subscribers, subscriberID)
+	}
+
+	if t.spends[key] == reg {
+		delete(t.spends, key)
+	}
+}
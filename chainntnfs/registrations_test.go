@@ -0,0 +1,362 @@
+package chainntnfs_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+)
+
+// mockRegistrationNotifier is a ChainNotifier that hands out events for its
+// confirmation and spend registrations, keeping track of the ones that are
+// still live.
+type mockRegistrationNotifier struct {
+	chainntnfs.ChainNotifier
+
+	mu            sync.Mutex
+	confEvents    []*chainntnfs.ConfirmationEvent
+	spendEvents   []*chainntnfs.SpendEvent
+	liveConfs     int
+	liveSpends    int
+	registrations int
+}
+
+func (m *mockRegistrationNotifier) RegisterConfirmationsNtfn(
+	txid *chainhash.Hash, pkScript []byte, numConfs,
+	heightHint uint32) (*chainntnfs.ConfirmationEvent, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.registrations++
+	m.liveConfs++
+
+	var once sync.Once
+	event := chainntnfs.NewConfirmationEvent(numConfs, func() {
+		once.Do(func() {
+			m.mu.Lock()
+			m.liveConfs--
+			m.mu.Unlock()
+		})
+	})
+	m.confEvents = append(m.confEvents, event)
+
+	return event, nil
+}
+
+func (m *mockRegistrationNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	pkScript []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.registrations++
+	m.liveSpends++
+
+	var once sync.Once
+	event := chainntnfs.NewSpendEvent(func() {
+		once.Do(func() {
+			m.mu.Lock()
+			m.liveSpends--
+			m.mu.Unlock()
+		})
+	})
+	m.spendEvents = append(m.spendEvents, event)
+
+	return event, nil
+}
+
+func (m *mockRegistrationNotifier) stats() (int, int, int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.registrations, m.liveConfs, m.liveSpends
+}
+
+// findRegistration returns the confirmation registration of the given client
+// for the given number of confirmations.
+func findRegistration(t *testing.T, tracker *chainntnfs.RegistrationTracker,
+	client string, numConfs uint32) chainntnfs.Registration {
+
+	for _, reg := range tracker.Registrations() {
+		if reg.Client == client && reg.NumConfs == numConfs {
+			return reg
+		}
+	}
+
+	t.Fatalf("registration of %v for %v confs not found", client, numConfs)
+	return chainntnfs.Registration{}
+}
+
+// TestRegistrationTrackerConfDedup asserts that identical confirmation
+// registrations of a client share a single registration with the chain
+// notifier, that the confirmation is delivered to all of them, including late
+// ones, and that the registration is canceled once all of them are.
+func TestRegistrationTrackerConfDedup(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockRegistrationNotifier{}
+	tracker := chainntnfs.NewRegistrationTracker()
+	client := tracker.Track("test", notifier)
+	otherClient := tracker.Track("other", notifier)
+
+	txid := chainhash.Hash{1}
+	conf1, err := client.RegisterConfirmationsNtfn(
+		&txid, testRawScript, 1, 10,
+	)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	conf2, err := client.RegisterConfirmationsNtfn(
+		&txid, testRawScript, 1, 10,
+	)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+
+	// A different number of confirmations or a different client must
+	// result in a distinct registration.
+	if _, err := client.RegisterConfirmationsNtfn(
+		&txid, testRawScript, 3, 10,
+	); err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	if _, err := otherClient.RegisterConfirmationsNtfn(
+		&txid, testRawScript, 1, 10,
+	); err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+
+	if registrations, _, _ := notifier.stats(); registrations != 3 {
+		t.Fatalf("expected 3 registrations with the notifier, got %v",
+			registrations)
+	}
+
+	registrations := tracker.Registrations()
+	if len(registrations) != 3 {
+		t.Fatalf("expected 3 tracked registrations, got %v",
+			len(registrations))
+	}
+	if registrations[0].Client != "other" {
+		t.Fatalf("expected registrations sorted by client")
+	}
+	reg := findRegistration(t, tracker, "test", 1)
+	if reg.NumSubscribers != 2 {
+		t.Fatalf("expected 2 subscribers, got %v", reg.NumSubscribers)
+	}
+
+	// Dispatching the confirmation should deliver it to both subscribers.
+	txConf := &chainntnfs.TxConfirmation{BlockHeight: 11}
+	notifier.confEvents[0].Confirmed <- txConf
+	for _, conf := range []*chainntnfs.ConfirmationEvent{conf1, conf2} {
+		select {
+		case c := <-conf.Confirmed:
+			if c != txConf {
+				t.Fatalf("unexpected confirmation")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("confirmation not delivered")
+		}
+	}
+
+	// A subscriber registering after the confirmation was dispatched
+	// should receive it right away.
+	conf3, err := client.RegisterConfirmationsNtfn(
+		&txid, testRawScript, 1, 10,
+	)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	select {
+	case c := <-conf3.Confirmed:
+		if c != txConf {
+			t.Fatalf("unexpected confirmation")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("confirmation not delivered")
+	}
+	if !findRegistration(t, tracker, "test", 1).Dispatched {
+		t.Fatalf("expected registration to be dispatched")
+	}
+
+	// The registration with the notifier should only be canceled once
+	// all of its subscribers are.
+	conf1.Cancel()
+	conf2.Cancel()
+	if _, liveConfs, _ := notifier.stats(); liveConfs != 3 {
+		t.Fatalf("expected 3 live registrations, got %v", liveConfs)
+	}
+	conf3.Cancel()
+	if _, liveConfs, _ := notifier.stats(); liveConfs != 2 {
+		t.Fatalf("expected 2 live registrations, got %v", liveConfs)
+	}
+	if len(tracker.Registrations()) != 2 {
+		t.Fatalf("expected canceled registration to be removed")
+	}
+
+	if _, ok := <-conf1.Confirmed; ok {
+		t.Fatalf("expected canceled event to be closed")
+	}
+	if _, ok := <-conf1.Done; ok {
+		t.Fatalf("expected canceled event's done channel to be closed")
+	}
+}
+
+// TestRegistrationTrackerConfHeightHint asserts that confirmation
+// registrations of a client with different height hints aren't coalesced.
+func TestRegistrationTrackerConfHeightHint(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockRegistrationNotifier{}
+	tracker := chainntnfs.NewRegistrationTracker()
+	client := tracker.Track("test", notifier)
+
+	txid := chainhash.Hash{1}
+	for _, heightHint := range []uint32{10, 5, 10} {
+		if _, err := client.RegisterConfirmationsNtfn(
+			&txid, testRawScript, 1, heightHint,
+		); err != nil {
+			t.Fatalf("unable to register: %v", err)
+		}
+	}
+
+	if registrations, _, _ := notifier.stats(); registrations != 2 {
+		t.Fatalf("expected 2 registrations with the notifier, got %v",
+			registrations)
+	}
+
+	registrations := tracker.Registrations()
+	if len(registrations) != 2 {
+		t.Fatalf("expected 2 tracked registrations, got %v",
+			len(registrations))
+	}
+	for _, reg := range registrations {
+		expected := uint32(1)
+		if reg.HeightHint == 10 {
+			expected = 2
+		}
+		if reg.NumSubscribers != expected {
+			t.Fatalf("expected %v subscribers for height hint %v, "+
+				"got %v", expected, reg.HeightHint,
+				reg.NumSubscribers)
+		}
+	}
+}
+
+// TestRegistrationTrackerSlowSubscriber asserts that notifications are queued
+// for a subscriber that doesn't read them right away, rather than dropped.
+func TestRegistrationTrackerSlowSubscriber(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockRegistrationNotifier{}
+	tracker := chainntnfs.NewRegistrationTracker()
+	client := tracker.Track("test", notifier)
+
+	txid := chainhash.Hash{1}
+	conf, err := client.RegisterConfirmationsNtfn(
+		&txid, testRawScript, 1, 10,
+	)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+
+	// Dispatch a confirmation, have it reorged out, and dispatch it
+	// again, all without the subscriber reading any of them.
+	txConf1 := &chainntnfs.TxConfirmation{BlockHeight: 11}
+	txConf2 := &chainntnfs.TxConfirmation{BlockHeight: 12}
+	event := notifier.confEvents[0]
+	event.Confirmed <- txConf1
+	event.NegativeConf <- 1
+	event.Confirmed <- txConf2
+
+	for _, txConf := range []*chainntnfs.TxConfirmation{txConf1, txConf2} {
+		select {
+		case c := <-conf.Confirmed:
+			if c != txConf {
+				t.Fatalf("expected confirmation at height %v, "+
+					"got %v", txConf.BlockHeight,
+					c.BlockHeight)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("confirmation not delivered")
+		}
+	}
+
+	select {
+	case depth := <-conf.NegativeConf:
+		if depth != 1 {
+			t.Fatalf("expected reorg depth 1, got %v", depth)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("negative confirmation not delivered")
+	}
+
+	conf.Cancel()
+}
+
+// TestRegistrationTrackerSpendDone asserts that identical spend registrations
+// of a client share a single registration with the chain notifier, and that
+// the registration is no longer tracked once the notifier is done with it.
+func TestRegistrationTrackerSpendDone(t *testing.T) {
+	t.Parallel()
+
+	notifier := &mockRegistrationNotifier{}
+	tracker := chainntnfs.NewRegistrationTracker()
+	client := tracker.Track("test", notifier)
+
+	outpoint := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+	spend1, err := client.RegisterSpendNtfn(&outpoint, testRawScript, 10)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	spend2, err := client.RegisterSpendNtfn(&outpoint, testRawScript, 10)
+	if err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+
+	if registrations, _, _ := notifier.stats(); registrations != 1 {
+		t.Fatalf("expected 1 registration with the notifier, got %v",
+			registrations)
+	}
+
+	spendDetail := &chainntnfs.SpendDetail{SpendingHeight: 11}
+	notifier.spendEvents[0].Spend <- spendDetail
+	for _, spend := range []*chainntnfs.SpendEvent{spend1, spend2} {
+		select {
+		case s := <-spend.Spend:
+			if s != spendDetail {
+				t.Fatalf("unexpected spend")
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("spend not delivered")
+		}
+	}
+
+	notifier.spendEvents[0].Done <- struct{}{}
+	for _, spend := range []*chainntnfs.SpendEvent{spend1, spend2} {
+		select {
+		case <-spend.Done:
+		case <-time.After(time.Second):
+			t.Fatalf("done not delivered")
+		}
+	}
+
+	if len(tracker.Registrations()) != 0 {
+		t.Fatalf("expected done registration to be removed")
+	}
+
+	// Registering again should result in a new registration with the
+	// notifier.
+	if _, err := client.RegisterSpendNtfn(
+		&outpoint, testRawScript, 10,
+	); err != nil {
+		t.Fatalf("unable to register: %v", err)
+	}
+	if registrations, _, _ := notifier.stats(); registrations != 2 {
+		t.Fatalf("expected 2 registrations with the notifier, got %v",
+			registrations)
+	}
+}
@@ -2,8 +2,10 @@ package chainntnfs_test
 
 import (
 	"bytes"
+	"errors"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
@@ -494,6 +496,78 @@ func TestTxNotifierHistoricalConfDispatch(t *testing.T) {
 	}
 }
 
+// TestTxNotifierRetryHistoricalConfDispatch tests that a historical
+// confirmation dispatch that fails is retried, and that the confirmation found
+// by the retry is delivered to the registered notification.
+func TestTxNotifierRetryHistoricalConfDispatch(t *testing.T) {
+	// The backoff is shortened for the duration of the test, so it can't
+	// run in parallel with other tests.
+	defaultBackoff := chainntnfs.HistoricalDispatchBackoff
+	chainntnfs.HistoricalDispatchBackoff = time.Millisecond
+	defer func() {
+		chainntnfs.HistoricalDispatchBackoff = defaultBackoff
+	}()
+
+	const numConfs uint32 = 1
+
+	hintCache := newMockHintCache()
+	n := chainntnfs.NewTxNotifier(
+		10, chainntnfs.ReorgSafetyLimit, hintCache, hintCache,
+	)
+
+	// Register a transaction that confirmed before the TxNotifier's
+	// starting height, which requires a historical dispatch.
+	tx := wire.MsgTx{Version: 1}
+	ntfn := chainntnfs.ConfNtfn{
+		ConfID:           0,
+		ConfRequest:      chainntnfs.ConfRequest{TxID: tx.TxHash()},
+		NumConfirmations: numConfs,
+		Event:            chainntnfs.NewConfirmationEvent(numConfs, nil),
+	}
+	dispatch, _, err := n.RegisterConf(&ntfn)
+	if err != nil {
+		t.Fatalf("unable to register ntfn: %v", err)
+	}
+	if dispatch == nil {
+		t.Fatal("expected historical dispatch")
+	}
+
+	// The first attempt of the dispatch fails, as if the backend was
+	// unreachable, while the second one finds the confirmation.
+	txConf := chainntnfs.TxConfirmation{
+		BlockHash:   &chainntnfs.ZeroHash,
+		BlockHeight: 9,
+		TxIndex:     1,
+		Tx:          &tx,
+	}
+	attempts := 0
+	historicalDispatch := func() error {
+		attempts++
+		if attempts == 1 {
+			return errors.New("backend unreachable")
+		}
+
+		return n.UpdateConfDetails(dispatch.ConfRequest, &txConf)
+	}
+
+	quit := make(chan struct{})
+	err = chainntnfs.RetryHistoricalDispatch(historicalDispatch, quit)
+	if err != nil {
+		t.Fatalf("unable to dispatch historical conf: %v", err)
+	}
+	if attempts != 2 {
+		t.Fatalf("expected 2 dispatch attempts, got %d", attempts)
+	}
+
+	// The confirmation found by the retry should have been delivered.
+	select {
+	case conf := <-ntfn.Event.Confirmed:
+		assertConfDetails(t, conf, &txConf)
+	default:
+		t.Fatal("expected confirmation to be dispatched")
+	}
+}
+
 // TestTxNotifierFutureSpendDispatch tests that the TxNotifier dispatches
 // registered notifications when an outpoint is spent after registration.
 func TestTxNotifierFutureSpendDispatch(t *testing.T) {
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"math/big"
+	"time"
+
 	"github.com/btcsuite/btcd/chaincfg"
 	bitcoinCfg "github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -77,6 +80,56 @@ var regTestNetParams = bitcoinNetParams{
 	CoinType: keychain.CoinTypeTestnet,
 }
 
+// bitcoinSigNetParams contains parameters specific to the default signet test
+// network.
+var bitcoinSigNetParams = bitcoinNetParams{
+	Params: newSigNetParams(),
+
+	// As for the other networks, this is the port btcd would use, which
+	// is converted back to bitcoind's RPC port of 38332.
+	rpcPort:  "38334",
+	CoinType: keychain.CoinTypeTestnet,
+}
+
+// newSigNetParams returns the chain parameters of the default signet. As the
+// chaincfg package we depend on predates signet, they're derived from the
+// parameters of testnet, with which signet shares its base58 address
+// encoding. Blocks on signet are signed rather than just mined, which isn't validated
+// here, so signet is only supported by backends that validate blocks
+// themselves.
+func newSigNetParams() *bitcoinCfg.Params {
+	params := bitcoinCfg.TestNet3Params
+
+	// The genesis block of signet only differs from the one of testnet in
+	// its header.
+	genesisBlock := *params.GenesisBlock
+	genesisBlock.Header.Timestamp = time.Unix(1598918400, 0)
+	genesisBlock.Header.Bits = 0x1e0377ae
+	genesisBlock.Header.Nonce = 52613770
+	genesisHash := genesisBlock.BlockHash()
+
+	params.Name = "signet"
+
+	// The bech32 human-readable part is also used as the network prefix
+	// of invoices, which must be "lntbs" on signet, so that signet
+	// invoices can't be mistaken for testnet ones.
+	params.Bech32HRPSegwit = "tbs"
+	params.Net = bitcoinWire.BitcoinNet(0x40cf030a)
+	params.DefaultPort = "38333"
+	params.DNSSeeds = []chaincfg.DNSSeed{
+		{Host: "seed.signet.bitcoin.sprovoost.nl", HasFiltering: false},
+	}
+	params.GenesisBlock = &genesisBlock
+	params.GenesisHash = &genesisHash
+	params.PowLimit = new(big.Int).Lsh(big.NewInt(0x0377ae), 216)
+	params.PowLimitBits = 0x1e0377ae
+	params.ReduceMinDifficulty = false
+	params.MinDiffReductionTime = 0
+	params.Checkpoints = nil
+
+	return &params
+}
+
 // applyLitecoinParams applies the relevant chain configuration parameters that
 // differ for litecoin to the chain parameters typed for btcsuite derivation.
 // This function is used in place of using something like interface{} to
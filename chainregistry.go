@@ -19,6 +19,8 @@ import (
 	"github.com/btcsuite/btcwallet/wallet"
 	"github.com/btcsuite/btcwallet/walletdb"
 	"github.com/lightninglabs/neutrino"
+	"github.com/lightningnetwork/lnd/blockfetch"
+	"github.com/lightningnetwork/lnd/chainmonitor"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/chainntnfs/bitcoindnotify"
 	"github.com/lightningnetwork/lnd/chainntnfs/btcdnotify"
@@ -29,6 +31,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing/chainview"
 )
@@ -47,11 +50,11 @@ const (
 
 	// defaultBitcoinStaticFeePerKW is the fee rate of 50 sat/vbyte
 	// expressed in sat/kw.
-	defaultBitcoinStaticFeePerKW = lnwallet.SatPerKWeight(12500)
+	defaultBitcoinStaticFeePerKW = chainfee.SatPerKWeight(12500)
 
 	// defaultLitecoinStaticFeePerKW is the fee rate of 200 sat/vbyte
 	// expressed in sat/kw.
-	defaultLitecoinStaticFeePerKW = lnwallet.SatPerKWeight(50000)
+	defaultLitecoinStaticFeePerKW = chainfee.SatPerKWeight(50000)
 
 	// btcToLtcConversionRate is a fixed ratio used in order to scale up
 	// payments when running on the Litecoin chain.
@@ -104,7 +107,7 @@ func (c chainCode) String() string {
 type chainControl struct {
 	chainIO lnwallet.BlockChainIO
 
-	feeEstimator lnwallet.FeeEstimator
+	feeEstimator chainfee.Estimator
 
 	signer input.Signer
 
@@ -121,6 +124,13 @@ type chainControl struct {
 	wallet *lnwallet.LightningWallet
 
 	routingPolicy htlcswitch.ForwardingPolicy
+
+	// backendName is the name of the chain backend in use.
+	backendName string
+
+	// standbyBackend is the chain backend that's monitored as the one to
+	// fail over to in case the active one stalls, if any.
+	standbyBackend *chainmonitor.Backend
 }
 
 // newChainControlFromConfig attempts to create a chainControl instance
@@ -151,7 +161,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			FeeRate:       cfg.Bitcoin.FeeRate,
 			TimeLockDelta: cfg.Bitcoin.TimeLockDelta,
 		}
-		cc.feeEstimator = lnwallet.NewStaticFeeEstimator(
+		cc.feeEstimator = chainfee.NewStaticEstimator(
 			defaultBitcoinStaticFeePerKW, 0,
 		)
 	case litecoinChain:
@@ -161,7 +171,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			FeeRate:       cfg.Litecoin.FeeRate,
 			TimeLockDelta: cfg.Litecoin.TimeLockDelta,
 		}
-		cc.feeEstimator = lnwallet.NewStaticFeeEstimator(
+		cc.feeEstimator = chainfee.NewStaticEstimator(
 			defaultLitecoinStaticFeePerKW, 0,
 		)
 	default:
@@ -179,6 +189,8 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		FeeEstimator:   cc.feeEstimator,
 		CoinType:       activeNetParams.CoinType,
 		Wallet:         wallet,
+
+		ResetWalletTransactions: cfg.ResetWalletTransactions,
 	}
 
 	var (
@@ -246,8 +258,9 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 				return ips, nil
 			},
 		}
-		neutrino.MaxPeers = 8
-		neutrino.BanDuration = 5 * time.Second
+		neutrino.MaxPeers = cfg.NeutrinoMode.MaxPeers
+		neutrino.BanDuration = cfg.NeutrinoMode.BanDuration
+		neutrino.BanThreshold = cfg.NeutrinoMode.BanThreshold
 		svc, err := neutrino.NewChainService(config)
 		if err != nil {
 			nodeDatabase.Close()
@@ -275,6 +288,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		walletConfig.ChainSource = chain.NewNeutrinoClient(
 			activeNetParams.Params, svc,
 		)
+		cc.backendName = "neutrino"
 	case "bitcoind", "litecoind":
 		var bitcoindMode *bitcoindConfig
 		switch {
@@ -284,37 +298,45 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			bitcoindMode = cfg.LitecoindMode
 		}
 		// Otherwise, we'll be speaking directly via RPC and ZMQ to a
-		// bitcoind node. If the specified host for the btcd/ltcd RPC
-		// server already has a port specified, then we use that
-		// directly. Otherwise, we assume the default port according to
-		// the selected chain parameters.
-		var bitcoindHost string
-		if strings.Contains(bitcoindMode.RPCHost, ":") {
-			bitcoindHost = bitcoindMode.RPCHost
-		} else {
-			// The RPC ports specified in chainparams.go assume
-			// btcd, which picks a different port so that btcwallet
-			// can use the same RPC port as bitcoind. We convert
-			// this back to the btcwallet/bitcoind port.
-			rpcPort, err := strconv.Atoi(activeNetParams.rpcPort)
+		// bitcoind node.
+		bitcoindHost, err := bitcoindRPCHost(cfg, bitcoindMode)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		// If a fallback bitcoind is configured, we'll use whichever of
+		// the two is furthest ahead, while monitoring the other one as
+		// our standby.
+		if cfg.Bitcoin.Active && cfg.BitcoindFallback.RPCHost != "" {
+			fallbackHost, err := bitcoindRPCHost(
+				cfg, cfg.BitcoindFallback,
+			)
 			if err != nil {
 				return nil, nil, err
 			}
-			rpcPort -= 2
-			bitcoindHost = fmt.Sprintf("%v:%d",
-				bitcoindMode.RPCHost, rpcPort)
-			if cfg.Bitcoin.Active && cfg.Bitcoin.RegTest {
-				conn, err := net.Dial("tcp", bitcoindHost)
-				if err != nil || conn == nil {
-					rpcPort = 18443
-					bitcoindHost = fmt.Sprintf("%v:%d",
-						bitcoindMode.RPCHost,
-						rpcPort)
-				} else {
-					conn.Close()
-				}
+
+			primary := bitcoindBackend{bitcoindHost, bitcoindMode}
+			fallback := bitcoindBackend{
+				fallbackHost, cfg.BitcoindFallback,
 			}
+			active, standby, client, err := selectBitcoind(
+				primary, fallback,
+			)
+			if err != nil {
+				return nil, nil, err
+			}
+			bitcoindHost, bitcoindMode = active.host, active.mode
+
+			cc.standbyBackend = &chainmonitor.Backend{
+				Name: standby.host,
+				BestHeight: func() (int32, error) {
+					height, err := client.GetBlockCount()
+					return int32(height), err
+				},
+			}
+			cleanUp = client.Shutdown
 		}
+		cc.backendName = bitcoindHost
 
 		// Establish the connection to bitcoind and create the clients
 		// required for our relevant subsystems.
@@ -333,10 +355,40 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 				"bitcoind: %v", err)
 		}
 
+		// In case bitcoind is pruned, we'll fetch the blocks it no
+		// longer has from its outbound peers, such that we can still
+		// rescan for the outputs of channels opened long ago.
+		peerClient, err := bitcoindBackend{
+			bitcoindHost, bitcoindMode,
+		}.newRPCClient()
+		if err != nil {
+			return nil, nil, err
+		}
+		standbyCleanUp := cleanUp
+		cleanUp = func() {
+			peerClient.Shutdown()
+			if standbyCleanUp != nil {
+				standbyCleanUp()
+			}
+		}
+
+		blockFetcher := blockfetch.New(&blockfetch.Config{
+			ChainParams: activeNetParams.Params,
+			Peers: func() ([]string, error) {
+				return bitcoindOutboundPeers(peerClient)
+			},
+			Dial:         cfg.net.Dial,
+			FetchTimeout: blockfetch.DefaultFetchTimeout,
+		})
+		walletConfig.BlockFetcher = blockFetcher
+
 		cc.chainNotifier = bitcoindnotify.New(
 			bitcoindConn, activeNetParams.Params, hintCache, hintCache,
+			blockFetcher,
+		)
+		cc.chainView = chainview.NewBitcoindFilteredChainView(
+			bitcoindConn, blockFetcher,
 		)
-		cc.chainView = chainview.NewBitcoindFilteredChainView(bitcoindConn)
 		walletConfig.ChainSource = bitcoindConn.NewBitcoindClient()
 
 		// If we're not in regtest mode, then we'll attempt to use a
@@ -357,8 +409,8 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			// if we're using bitcoind as a backend, then we can
 			// use live fee estimates, rather than a statically
 			// coded value.
-			fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
-			cc.feeEstimator, err = lnwallet.NewBitcoindFeeEstimator(
+			fallBackFeeRate := chainfee.SatPerKVByte(25 * 1000)
+			cc.feeEstimator, err = chainfee.NewBitcoindEstimator(
 				*rpcConfig, fallBackFeeRate.FeePerKWeight(),
 			)
 			if err != nil {
@@ -374,8 +426,8 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			// if we're using litecoind as a backend, then we can
 			// use live fee estimates, rather than a statically
 			// coded value.
-			fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
-			cc.feeEstimator, err = lnwallet.NewBitcoindFeeEstimator(
+			fallBackFeeRate := chainfee.SatPerKVByte(25 * 1000)
+			cc.feeEstimator, err = chainfee.NewBitcoindEstimator(
 				*rpcConfig, fallBackFeeRate.FeePerKWeight(),
 			)
 			if err != nil {
@@ -467,6 +519,7 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 		}
 
 		walletConfig.ChainSource = chainRPC
+		cc.backendName = btcdHost
 
 		// If we're not in simnet or regtest mode, then we'll attempt
 		// to use a proper fee estimator for testnet.
@@ -479,8 +532,8 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			// if we're using btcd as a backend, then we can use
 			// live fee estimates, rather than a statically coded
 			// value.
-			fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
-			cc.feeEstimator, err = lnwallet.NewBtcdFeeEstimator(
+			fallBackFeeRate := chainfee.SatPerKVByte(25 * 1000)
+			cc.feeEstimator, err = chainfee.NewBtcdEstimator(
 				*rpcConfig, fallBackFeeRate.FeePerKWeight(),
 			)
 			if err != nil {
@@ -495,6 +548,36 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			homeChainConfig.Node)
 	}
 
+	// If the user specified a fee estimation web API, then it takes
+	// precedence over the estimator of the chosen backend. We'll fall back
+	// to the default static fee rate of the primary chain until the API
+	// has been queried successfully.
+	if cfg.FeeURL != "" {
+		ltndLog.Infof("Initializing web API fee estimator with url %v",
+			cfg.FeeURL)
+
+		if err := cc.feeEstimator.Stop(); err != nil {
+			return nil, nil, err
+		}
+
+		fallBackFeeRate := defaultBitcoinStaticFeePerKW
+		if registeredChains.PrimaryChain() == litecoinChain {
+			fallBackFeeRate = defaultLitecoinStaticFeePerKW
+		}
+
+		cc.feeEstimator = chainfee.NewWebAPIEstimator(
+			chainfee.SparseConfFeeSource{URL: cfg.FeeURL},
+			fallBackFeeRate,
+		)
+		if err := cc.feeEstimator.Start(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	// Now that the fee estimator has been selected, we'll make sure the
+	// wallet uses the same one as the rest of the daemon.
+	walletConfig.FeeEstimator = cc.feeEstimator
+
 	wc, err := btcwallet.New(*walletConfig)
 	if err != nil {
 		fmt.Printf("unable to create wallet controller: %v\n", err)
@@ -520,18 +603,32 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 	)
 	cc.keyRing = keyRing
 
+	// The coin selection strategy has already been validated when
+	// loading the config, but we'll parse it again to obtain the typed
+	// value.
+	coinSelectionStrategy, err := lnwallet.ParseCoinSelectionStrategy(
+		cfg.CoinSelectionStrategy,
+	)
+	if err != nil {
+		if cleanUp != nil {
+			cleanUp()
+		}
+		return nil, nil, err
+	}
+
 	// Create, and start the lnwallet, which handles the core payment
 	// channel logic, and exposes control via proxy state machines.
 	walletCfg := lnwallet.Config{
-		Database:           chanDB,
-		Notifier:           cc.chainNotifier,
-		WalletController:   wc,
-		Signer:             cc.signer,
-		FeeEstimator:       cc.feeEstimator,
-		SecretKeyRing:      keyRing,
-		ChainIO:            cc.chainIO,
-		DefaultConstraints: channelConstraints,
-		NetParams:          *activeNetParams.Params,
+		Database:              chanDB,
+		Notifier:              cc.chainNotifier,
+		WalletController:      wc,
+		Signer:                cc.signer,
+		FeeEstimator:          cc.feeEstimator,
+		SecretKeyRing:         keyRing,
+		ChainIO:               cc.chainIO,
+		DefaultConstraints:    channelConstraints,
+		NetParams:             *activeNetParams.Params,
+		CoinSelectionStrategy: coinSelectionStrategy,
 	}
 	lnWallet, err := lnwallet.NewLightningWallet(walletCfg)
 	if err != nil {
@@ -556,6 +653,129 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 	return cc, cleanUp, nil
 }
 
+// bitcoindRPCHost returns the host:port at which the RPC server of the given
+// bitcoind is reachable. If the configured host already has a port specified,
+// then we use that directly. Otherwise, we assume the default port according
+// to the selected chain parameters.
+func bitcoindRPCHost(cfg *config, mode *bitcoindConfig) (string, error) {
+	if strings.Contains(mode.RPCHost, ":") {
+		return mode.RPCHost, nil
+	}
+
+	// The RPC ports specified in chainparams.go assume btcd, which picks a
+	// different port so that btcwallet can use the same RPC port as
+	// bitcoind. We convert this back to the btcwallet/bitcoind port.
+	rpcPort, err := strconv.Atoi(activeNetParams.rpcPort)
+	if err != nil {
+		return "", err
+	}
+	rpcPort -= 2
+	bitcoindHost := fmt.Sprintf("%v:%d", mode.RPCHost, rpcPort)
+	if cfg.Bitcoin.Active && cfg.Bitcoin.RegTest {
+		conn, err := net.Dial("tcp", bitcoindHost)
+		if err != nil || conn == nil {
+			rpcPort = 18443
+			bitcoindHost = fmt.Sprintf("%v:%d", mode.RPCHost,
+				rpcPort)
+		} else {
+			conn.Close()
+		}
+	}
+
+	return bitcoindHost, nil
+}
+
+// bitcoindBackend is a bitcoind that can be used as chain backend.
+type bitcoindBackend struct {
+	// host is the host:port at which its RPC server is reachable.
+	host string
+
+	// mode is its configuration.
+	mode *bitcoindConfig
+}
+
+// newRPCClient creates an HTTP POST mode RPC client for the bitcoind, which is
+// used to query it outside of the chain backend.
+func (b bitcoindBackend) newRPCClient() (*rpcclient.Client, error) {
+	return rpcclient.New(&rpcclient.ConnConfig{
+		Host:                 b.host,
+		User:                 b.mode.RPCUser,
+		Pass:                 b.mode.RPCPass,
+		DisableConnectOnNew:  true,
+		DisableAutoReconnect: false,
+		DisableTLS:           true,
+		HTTPPostMode:         true,
+	}, nil)
+}
+
+// selectBitcoind selects the bitcoind to use as chain backend out of the
+// primary and fallback ones, returning the active one, the standby one and an
+// RPC client to the standby one. The fallback is only used if it's ahead of
+// the primary, or if the primary is unreachable. As the chain backend monitor
+// shuts us down once the active backend stalls while the standby makes
+// progress, this is how we fail over upon restarting.
+func selectBitcoind(primary, fallback bitcoindBackend) (bitcoindBackend,
+	bitcoindBackend, *rpcclient.Client, error) {
+
+	primaryClient, err := primary.newRPCClient()
+	if err != nil {
+		return bitcoindBackend{}, bitcoindBackend{}, nil, err
+	}
+	fallbackClient, err := fallback.newRPCClient()
+	if err != nil {
+		primaryClient.Shutdown()
+		return bitcoindBackend{}, bitcoindBackend{}, nil, err
+	}
+
+	primaryHeight, primaryErr := primaryClient.GetBlockCount()
+	fallbackHeight, fallbackErr := fallbackClient.GetBlockCount()
+
+	switch {
+	// If the fallback is unreachable, we'll stick to the primary.
+	case fallbackErr != nil:
+		ltndLog.Warnf("Unable to query fallback bitcoind %v: %v",
+			fallback.host, fallbackErr)
+
+	// Likewise if the fallback isn't ahead of the primary.
+	case primaryErr == nil && fallbackHeight <= primaryHeight:
+		ltndLog.Debugf("Using primary bitcoind %v at height %v",
+			primary.host, primaryHeight)
+
+	// Otherwise, we'll fail over to the fallback.
+	default:
+		ltndLog.Warnf("Using fallback bitcoind %v at height %v, as "+
+			"primary bitcoind %v is behind or unreachable "+
+			"(height=%v, err=%v)", fallback.host, fallbackHeight,
+			primary.host, primaryHeight, primaryErr)
+
+		fallbackClient.Shutdown()
+		return fallback, primary, primaryClient, nil
+	}
+
+	primaryClient.Shutdown()
+	return primary, fallback, fallbackClient, nil
+}
+
+// bitcoindOutboundPeers returns the addresses of the outbound peers of the
+// bitcoind the given client is connected to. The addresses of inbound peers
+// are skipped, as they're not the ones they're listening on.
+func bitcoindOutboundPeers(client *rpcclient.Client) ([]string, error) {
+	peers, err := client.GetPeerInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	addrs := make([]string, 0, len(peers))
+	for _, peer := range peers {
+		if peer.Inbound {
+			continue
+		}
+		addrs = append(addrs, peer.Addr)
+	}
+
+	return addrs, nil
+}
+
 var (
 	// bitcoinTestnetGenesis is the genesis hash of Bitcoin's testnet
 	// chain.
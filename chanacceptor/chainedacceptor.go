@@ -0,0 +1,77 @@
+package chanacceptor
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ChainedAcceptor represents a conjunction of ChannelAcceptor results.
+type ChainedAcceptor struct {
+	// acceptors is a map of ChannelAcceptors that will be evaluated when
+	// the ChainedAcceptor's Accept method is called.
+	acceptors    map[uint64]ChannelAcceptor
+	acceptorsMtx sync.RWMutex
+
+	acceptorID uint64 // To be used atomically.
+}
+
+// NewChainedAcceptor initializes a ChainedAcceptor.
+func NewChainedAcceptor() *ChainedAcceptor {
+	return &ChainedAcceptor{
+		acceptors: make(map[uint64]ChannelAcceptor),
+	}
+}
+
+// AddAcceptor adds a ChannelAcceptor to this ChainedAcceptor, returning the
+// ID it can later be removed by.
+func (c *ChainedAcceptor) AddAcceptor(acceptor ChannelAcceptor) uint64 {
+	id := atomic.AddUint64(&c.acceptorID, 1)
+
+	c.acceptorsMtx.Lock()
+	c.acceptors[id] = acceptor
+	c.acceptorsMtx.Unlock()
+
+	return id
+}
+
+// RemoveAcceptor removes a ChannelAcceptor from this ChainedAcceptor given
+// an ID.
+func (c *ChainedAcceptor) RemoveAcceptor(id uint64) {
+	c.acceptorsMtx.Lock()
+	delete(c.acceptors, id)
+	c.acceptorsMtx.Unlock()
+}
+
+// Accept evaluates the results of all ChannelAcceptors in the acceptors map
+// and returns the conjunction of all these predicates. The channel is rejected
+// as soon as any of the acceptors rejects it. Otherwise, if several acceptors
+// override the same parameter, then the most conservative value is used.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (c *ChainedAcceptor) Accept(
+	req *ChannelAcceptRequest) *ChannelAcceptResponse {
+
+	c.acceptorsMtx.RLock()
+	defer c.acceptorsMtx.RUnlock()
+
+	result := NewChannelAcceptResponse(0, 0)
+	for _, acceptor := range c.acceptors {
+		resp := acceptor.Accept(req)
+		if resp.RejectChannel() {
+			return resp
+		}
+
+		if resp.MinAcceptDepth > result.MinAcceptDepth {
+			result.MinAcceptDepth = resp.MinAcceptDepth
+		}
+		if resp.CsvDelay > result.CsvDelay {
+			result.CsvDelay = resp.CsvDelay
+		}
+	}
+
+	return result
+}
+
+// A compile-time constraint to ensure ChainedAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*ChainedAcceptor)(nil)
@@ -0,0 +1,75 @@
+package chanacceptor
+
+import (
+	"errors"
+	"testing"
+)
+
+// mockAcceptor is a ChannelAcceptor that always returns the same response.
+type mockAcceptor struct {
+	resp *ChannelAcceptResponse
+}
+
+// Accept returns the mockAcceptor's canned response.
+func (m *mockAcceptor) Accept(*ChannelAcceptRequest) *ChannelAcceptResponse {
+	return m.resp
+}
+
+// TestChainedAcceptor asserts that the ChainedAcceptor rejects a channel as
+// soon as one of its acceptors does, and otherwise uses the most conservative
+// of the overridden parameters.
+func TestChainedAcceptor(t *testing.T) {
+	t.Parallel()
+
+	chained := NewChainedAcceptor()
+	req := &ChannelAcceptRequest{}
+
+	// Without any acceptor, the channel should be accepted using the
+	// default parameters.
+	resp := chained.Accept(req)
+	if resp.RejectChannel() {
+		t.Fatalf("expected channel to be accepted")
+	}
+	if resp.MinAcceptDepth != 0 || resp.CsvDelay != 0 {
+		t.Fatalf("expected no overrides, got %+v", resp)
+	}
+
+	chained.AddAcceptor(&mockAcceptor{
+		resp: NewChannelAcceptResponse(3, 144),
+	})
+	chained.AddAcceptor(&mockAcceptor{
+		resp: NewChannelAcceptResponse(6, 100),
+	})
+
+	resp = chained.Accept(req)
+	if resp.RejectChannel() {
+		t.Fatalf("expected channel to be accepted")
+	}
+	if resp.MinAcceptDepth != 6 {
+		t.Fatalf("expected min accept depth 6, got %v",
+			resp.MinAcceptDepth)
+	}
+	if resp.CsvDelay != 144 {
+		t.Fatalf("expected csv delay 144, got %v", resp.CsvDelay)
+	}
+
+	// Adding an acceptor that rejects the channel should make the chained
+	// acceptor reject it, with the same reason.
+	errRejected := errors.New("rejected")
+	id := chained.AddAcceptor(&mockAcceptor{
+		resp: NewChannelRejectResponse(errRejected),
+	})
+
+	resp = chained.Accept(req)
+	if resp.ChanAcceptError != errRejected {
+		t.Fatalf("expected channel to be rejected, got %+v", resp)
+	}
+
+	// Once it is removed, the channel should be accepted again.
+	chained.RemoveAcceptor(id)
+
+	resp = chained.Accept(req)
+	if resp.RejectChannel() {
+		t.Fatalf("expected channel to be accepted")
+	}
+}
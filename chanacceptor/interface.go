@@ -0,0 +1,77 @@
+package chanacceptor
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ChannelAcceptRequest is a struct containing the requesting node's public key
+// along with the lnwire.OpenChannel message that they sent when requesting an
+// inbound channel. This information is provided to each acceptor so that they
+// can each leverage their own decision-making with this information.
+type ChannelAcceptRequest struct {
+	// Node is the public key of the node requesting to open a channel.
+	Node *btcec.PublicKey
+
+	// OpenChanMsg is the actual OpenChannel protocol message that the peer
+	// sent to us.
+	OpenChanMsg *lnwire.OpenChannel
+
+	// Anchors is true if the proposed channel will use the anchor outputs
+	// commitment format.
+	Anchors bool
+}
+
+// ChannelAcceptResponse is the answer of a ChannelAcceptor to a
+// ChannelAcceptRequest. Besides accepting or rejecting the channel, it allows
+// the acceptor to override some of the parameters we'll require from the
+// initiator.
+type ChannelAcceptResponse struct {
+	// ChanAcceptError is the reason the channel was rejected for, which
+	// will be relayed to the initiator. If the channel was accepted, this
+	// value will be nil.
+	ChanAcceptError error
+
+	// MinAcceptDepth is the number of confirmations we'll require before
+	// considering the channel open. If zero, then the default number of
+	// confirmations will be required.
+	MinAcceptDepth uint16
+
+	// CsvDelay is the CSV delay we'll require on the initiator's funds in
+	// case of a unilateral close. If zero, then the default delay will be
+	// required.
+	CsvDelay uint16
+}
+
+// NewChannelAcceptResponse returns a response accepting the channel, with the
+// given parameter overrides.
+func NewChannelAcceptResponse(minAcceptDepth,
+	csvDelay uint16) *ChannelAcceptResponse {
+
+	return &ChannelAcceptResponse{
+		MinAcceptDepth: minAcceptDepth,
+		CsvDelay:       csvDelay,
+	}
+}
+
+// NewChannelRejectResponse returns a response rejecting the channel for the
+// given reason.
+func NewChannelRejectResponse(reason error) *ChannelAcceptResponse {
+	return &ChannelAcceptResponse{
+		ChanAcceptError: reason,
+	}
+}
+
+// RejectChannel returns a boolean indicating whether the channel should be
+// rejected.
+func (c *ChannelAcceptResponse) RejectChannel() bool {
+	return c.ChanAcceptError != nil
+}
+
+// ChannelAcceptor is an interface that represents a predicate on the data
+// contained in ChannelAcceptRequest.
+type ChannelAcceptor interface {
+	// Accept evaluates the given request, returning whether the channel
+	// should be accepted, and the parameters to require if so.
+	Accept(req *ChannelAcceptRequest) *ChannelAcceptResponse
+}
@@ -0,0 +1,109 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// bannedPeersBucket stores the peers we've banned, keyed by their
+	// compressed identity public key.
+	bannedPeersBucket = []byte("banned-peers")
+)
+
+// BannedPeer is a peer we've banned for misbehaving. We refuse all connections
+// with banned peers.
+type BannedPeer struct {
+	// PubKey is the compressed identity public key of the peer.
+	PubKey [33]byte
+
+	// BannedAt is the time at which the peer was banned.
+	BannedAt time.Time
+
+	// Reason describes why the peer was banned.
+	Reason string
+}
+
+// BanPeer persists the given banned peer, replacing any prior ban of the same
+// peer.
+func (d *DB) BanPeer(peer *BannedPeer) error {
+	return d.Update(func(tx *bbolt.Tx) error {
+		bannedPeers, err := tx.CreateBucketIfNotExists(
+			bannedPeersBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := serializeBannedPeer(&b, peer); err != nil {
+			return err
+		}
+
+		return bannedPeers.Put(peer.PubKey[:], b.Bytes())
+	})
+}
+
+// UnbanPeer removes the ban of the given peer. If the peer isn't banned,
+// ErrPeerNotBanned is returned.
+func (d *DB) UnbanPeer(pubKey [33]byte) error {
+	return d.Update(func(tx *bbolt.Tx) error {
+		bannedPeers := tx.Bucket(bannedPeersBucket)
+		if bannedPeers == nil || bannedPeers.Get(pubKey[:]) == nil {
+			return ErrPeerNotBanned
+		}
+
+		return bannedPeers.Delete(pubKey[:])
+	})
+}
+
+// FetchBannedPeers returns all peers we've banned.
+func (d *DB) FetchBannedPeers() ([]*BannedPeer, error) {
+	var peers []*BannedPeer
+	err := d.View(func(tx *bbolt.Tx) error {
+		bannedPeers := tx.Bucket(bannedPeersBucket)
+		if bannedPeers == nil {
+			return nil
+		}
+
+		return bannedPeers.ForEach(func(k, v []byte) error {
+			peer, err := deserializeBannedPeer(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+			copy(peer.PubKey[:], k)
+
+			peers = append(peers, peer)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return peers, nil
+}
+
+func serializeBannedPeer(w io.Writer, peer *BannedPeer) error {
+	return WriteElements(
+		w, uint64(peer.BannedAt.Unix()), []byte(peer.Reason),
+	)
+}
+
+func deserializeBannedPeer(r io.Reader) (*BannedPeer, error) {
+	var (
+		bannedAt uint64
+		reason   []byte
+	)
+	if err := ReadElements(r, &bannedAt, &reason); err != nil {
+		return nil, err
+	}
+
+	return &BannedPeer{
+		BannedAt: time.Unix(int64(bannedAt), 0),
+		Reason:   string(reason),
+	}, nil
+}
@@ -0,0 +1,80 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+// TestBannedPeers asserts that banned peers are persisted, that banning a peer
+// again replaces its prior ban, and that peers can be unbanned.
+func TestBannedPeers(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	// Initially, no peers should be banned.
+	peers, err := cdb.FetchBannedPeers()
+	if err != nil {
+		t.Fatalf("unable to fetch banned peers: %v", err)
+	}
+	if len(peers) != 0 {
+		t.Fatalf("expected no banned peers, got %v", len(peers))
+	}
+
+	peer1 := &BannedPeer{
+		PubKey:   [33]byte{2, 1},
+		BannedAt: time.Unix(1000, 0),
+		Reason:   "invalid signature",
+	}
+	peer2 := &BannedPeer{
+		PubKey:   [33]byte{3, 2},
+		BannedAt: time.Unix(2000, 0),
+		Reason:   "invalid funding output",
+	}
+	for _, peer := range []*BannedPeer{peer1, peer2} {
+		if err := cdb.BanPeer(peer); err != nil {
+			t.Fatalf("unable to ban peer: %v", err)
+		}
+	}
+
+	// Banning the first peer again should replace its prior ban.
+	peer1.BannedAt = time.Unix(3000, 0)
+	peer1.Reason = "invalid channel update"
+	if err := cdb.BanPeer(peer1); err != nil {
+		t.Fatalf("unable to ban peer: %v", err)
+	}
+
+	peers, err = cdb.FetchBannedPeers()
+	if err != nil {
+		t.Fatalf("unable to fetch banned peers: %v", err)
+	}
+	expected := []*BannedPeer{peer1, peer2}
+	if !reflect.DeepEqual(peers, expected) {
+		t.Fatalf("banned peers don't match: expected %v, got %v",
+			expected, peers)
+	}
+
+	// Once unbanned, only the second peer should remain banned. Unbanning
+	// the first peer again should fail, as it's no longer banned.
+	if err := cdb.UnbanPeer(peer1.PubKey); err != nil {
+		t.Fatalf("unable to unban peer: %v", err)
+	}
+	if err := cdb.UnbanPeer(peer1.PubKey); err != ErrPeerNotBanned {
+		t.Fatalf("expected ErrPeerNotBanned, got: %v", err)
+	}
+
+	peers, err = cdb.FetchBannedPeers()
+	if err != nil {
+		t.Fatalf("unable to fetch banned peers: %v", err)
+	}
+	expected = []*BannedPeer{peer2}
+	if !reflect.DeepEqual(peers, expected) {
+		t.Fatalf("banned peers don't match: expected %v, got %v",
+			expected, peers)
+	}
+}
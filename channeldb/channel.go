@@ -128,8 +128,36 @@ const (
 	// funds towards the total capacity of the channel. The channel may be
 	// funded symmetrically or asymmetrically.
 	DualFunder = 1
+
+	// AnchorOutputsBit is a bit that, when set, indicates that the channel
+	// uses the anchor outputs commitment format. Commitment transactions
+	// of such channels carry an additional small output for each party
+	// that can be spent to bump the fee of the commitment using CPFP.
+	//
+	// NOTE: This is a private, experimental format rather than
+	// option_anchor_outputs. Its to_remote and HTLC outputs carry no
+	// 1-block CSV, and its second-level HTLC transactions are signed with
+	// SIGHASH_ALL. It's only negotiated between dev builds.
+	AnchorOutputsBit ChannelType = 1 << 1
 )
 
+// IsSingleFunder returns true if the channel type is one of the known single
+// funder variants.
+func (c ChannelType) IsSingleFunder() bool {
+	return c&DualFunder == 0
+}
+
+// IsDualFunder returns true if the ChannelType has the DualFunder bit set.
+func (c ChannelType) IsDualFunder() bool {
+	return c&DualFunder == DualFunder
+}
+
+// HasAnchors returns true if this channel type has anchor outputs on its
+// commitment.
+func (c ChannelType) HasAnchors() bool {
+	return c&AnchorOutputsBit == AnchorOutputsBit
+}
+
 // ChannelConstraints represents a set of constraints meant to allow a node to
 // limit their exposure, enact flow control and ensure that all HTLCs are
 // economically relevant. This struct will be mirrored for both sides of the
@@ -264,7 +292,9 @@ type ChannelCommitment struct {
 	// current set of commitment transactions. The fee amount is persisted
 	// with the channel in order to allow the fee amount to be removed and
 	// recalculated with each channel state update, including updates that
-	// happen after a system restart.
+	// happen after a system restart. For channels using anchor outputs,
+	// this also includes the value of the two anchor outputs, as these
+	// are paid for by the initiator as well.
 	CommitFee btcutil.Amount
 
 	// FeePerKw is the min satoshis/kilo-weight that should be paid within
@@ -325,16 +355,21 @@ var (
 	// has been restored, and doesn't have all the fields a typical channel
 	// will have.
 	ChanStatusRestored ChannelStatus = 1 << 3
+
+	// ChanStatusLocalCloseInitiator indicates that we initiated the
+	// cooperative close of the channel.
+	ChanStatusLocalCloseInitiator ChannelStatus = 1 << 4
 )
 
 // chanStatusStrings maps a ChannelStatus to a human friendly string that
 // describes that status.
 var chanStatusStrings = map[ChannelStatus]string{
-	ChanStatusDefault:           "ChanStatusDefault",
-	ChanStatusBorked:            "ChanStatusBorked",
-	ChanStatusCommitBroadcasted: "ChanStatusCommitBroadcasted",
-	ChanStatusLocalDataLoss:     "ChanStatusLocalDataLoss",
-	ChanStatusRestored:          "ChanStatusRestored",
+	ChanStatusDefault:             "ChanStatusDefault",
+	ChanStatusBorked:              "ChanStatusBorked",
+	ChanStatusCommitBroadcasted:   "ChanStatusCommitBroadcasted",
+	ChanStatusLocalDataLoss:       "ChanStatusLocalDataLoss",
+	ChanStatusRestored:            "ChanStatusRestored",
+	ChanStatusLocalCloseInitiator: "ChanStatusLocalCloseInitiator",
 }
 
 // orderedChanStatusFlags is an in-order list of all that channel status flags.
@@ -344,6 +379,7 @@ var orderedChanStatusFlags = []ChannelStatus{
 	ChanStatusCommitBroadcasted,
 	ChanStatusLocalDataLoss,
 	ChanStatusRestored,
+	ChanStatusLocalCloseInitiator,
 }
 
 // String returns a human-readable representation of the ChannelStatus.
@@ -502,6 +538,17 @@ type OpenChannel struct {
 	// for which we are the initiator.
 	FundingTxn *wire.MsgTx
 
+	// LocalShutdownScript is the script that we committed to pay out to
+	// when the channel is cooperatively closed, as negotiated with the
+	// remote party using option_upfront_shutdown_script. If empty, no
+	// upfront shutdown script was set by us.
+	LocalShutdownScript lnwire.DeliveryAddress
+
+	// RemoteShutdownScript is the script the remote party committed to
+	// pay out to when the channel is cooperatively closed. If empty, the
+	// remote party is free to choose any address upon closure.
+	RemoteShutdownScript lnwire.DeliveryAddress
+
 	// TODO(roasbeef): eww
 	Db *DB
 
@@ -558,6 +605,39 @@ func (c *OpenChannel) hasChanStatus(status ChannelStatus) bool {
 	return c.chanStatus&status == status
 }
 
+// RefreshChanStatus updates the in-memory channel status using the latest
+// value observed on disk.
+func (c *OpenChannel) RefreshChanStatus() error {
+	c.Lock()
+	defer c.Unlock()
+
+	var status ChannelStatus
+	err := c.Db.View(func(tx *bbolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		status = channel.chanStatus
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.chanStatus = status
+
+	return nil
+}
+
 // RefreshShortChanID updates the in-memory short channel ID using the latest
 // value observed on disk.
 func (c *OpenChannel) RefreshShortChanID() error {
@@ -2135,6 +2215,67 @@ func (c *OpenChannel) RemoteRevocationStore() (shachain.Store, error) {
 	return c.RevocationStore, nil
 }
 
+// VerifyRevocationState checks the consistency of the revocation state of the
+// remote party stored on disk. The revocation store must hold a secret for
+// each of the remote commitments revoked so far, all of which must be
+// derivable from it, as those are needed to deliver justice should the remote
+// party broadcast a revoked state. The number of secrets verified is
+// returned.
+func (c *OpenChannel) VerifyRevocationState() (uint64, error) {
+	// Restored channels have no commitments, and therefore no revocation
+	// state to verify.
+	if c.HasChanStatus(ChanStatusRestored) {
+		return 0, ErrNoRevocationsFound
+	}
+
+	// We'll read both the remote commitment and revocation store within
+	// the same transaction, so that they're consistent with each other.
+	// They're read into a scratch channel so the state of this channel is
+	// left untouched.
+	var state OpenChannel
+	err := c.Db.View(func(tx *bbolt.Tx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		if err := fetchChanCommitments(chanBucket, &state); err != nil {
+			return err
+		}
+
+		return fetchChanRevocationState(chanBucket, &state)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	store := state.RevocationStore
+	if err := store.Verify(); err != nil {
+		return 0, fmt.Errorf("corrupted revocation store: %v", err)
+	}
+
+	// Each remote commitment below the current one has been revoked, so
+	// we expect as many secrets as the current remote commitment height.
+	numSecrets := store.NextIndex()
+	remoteHeight := state.RemoteCommitment.CommitHeight
+	if numSecrets != remoteHeight {
+		return 0, fmt.Errorf("revocation store holds %v secrets, "+
+			"expected %v for remote commitment height %v",
+			numSecrets, remoteHeight, remoteHeight)
+	}
+
+	for i := uint64(0); i < numSecrets; i++ {
+		if _, err := store.LookUp(i); err != nil {
+			return 0, fmt.Errorf("unable to derive secret #%v: %v",
+				i, err)
+		}
+	}
+
+	return numSecrets, nil
+}
+
 func putChannelCloseSummary(tx *bbolt.Tx, chanID []byte,
 	summary *ChannelCloseSummary, lastChanState *OpenChannel) error {
 
@@ -2335,7 +2476,7 @@ func putChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	}
 
 	// For single funder channels that we initiated, write the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	if channel.ChanType.IsSingleFunder() && channel.IsInitiator {
 		if err := WriteElement(&w, channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2348,6 +2489,16 @@ func putChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
+	// The upfront shutdown scripts are written at the end of the channel
+	// info, such that channels written before their addition can still
+	// be read.
+	if err := WriteElements(&w,
+		[]byte(channel.LocalShutdownScript),
+		[]byte(channel.RemoteShutdownScript),
+	); err != nil {
+		return err
+	}
+
 	return chanBucket.Put(chanInfoKey, w.Bytes())
 }
 
@@ -2455,7 +2606,7 @@ func fetchChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 	}
 
 	// For single funder channels that we initiated, read the funding txn.
-	if channel.ChanType == SingleFunder && channel.IsInitiator {
+	if channel.ChanType.IsSingleFunder() && channel.IsInitiator {
 		if err := ReadElement(r, &channel.FundingTxn); err != nil {
 			return err
 		}
@@ -2468,6 +2619,24 @@ func fetchChanInfo(chanBucket *bbolt.Bucket, channel *OpenChannel) error {
 		return err
 	}
 
+	// Channels stored before the addition of upfront shutdown scripts
+	// won't have them at the end of the channel info, so we'll treat an
+	// EOF as both scripts being absent.
+	var localScript, remoteScript []byte
+	err := ReadElements(r, &localScript, &remoteScript)
+	switch {
+	case err == io.EOF:
+	case err != nil:
+		return err
+	default:
+		if len(localScript) != 0 {
+			channel.LocalShutdownScript = localScript
+		}
+		if len(remoteScript) != 0 {
+			channel.RemoteShutdownScript = remoteScript
+		}
+	}
+
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
 	return nil
@@ -241,6 +241,8 @@ func createTestChannelState(cdb *DB) (*OpenChannel, error) {
 		Db:                      cdb,
 		Packager:                NewChannelPackager(chanID),
 		FundingTxn:              testTx,
+		LocalShutdownScript:     bytes.Repeat([]byte{2}, 22),
+		RemoteShutdownScript:    bytes.Repeat([]byte{3}, 34),
 	}, nil
 }
 
@@ -1026,3 +1028,62 @@ func TestRefreshShortChanID(t *testing.T) {
 			pendingChannel.Packager.(*ChannelPackager).source)
 	}
 }
+
+// TestVerifyRevocationState asserts that the revocation state of a channel is
+// only considered valid if the revocation store holds a secret for each
+// revoked remote commitment.
+func TestVerifyRevocationState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		remoteHeight uint64
+		valid        bool
+	}{
+		{
+			// The test channel's revocation store holds a single
+			// secret, that of the first remote commitment.
+			name:         "valid state",
+			remoteHeight: 1,
+			valid:        true,
+		},
+		{
+			name:         "missing secret",
+			remoteHeight: 2,
+		},
+		{
+			name:         "unexpected secret",
+			remoteHeight: 0,
+		},
+	}
+
+	for _, testCase := range testCases {
+		cdb, cleanUp, err := makeTestDB()
+		if err != nil {
+			t.Fatalf("unable to make test database: %v", err)
+		}
+		defer cleanUp()
+
+		state, err := createTestChannelState(cdb)
+		if err != nil {
+			t.Fatalf("unable to create channel state: %v", err)
+		}
+		state.RemoteCommitment.CommitHeight = testCase.remoteHeight
+		if err := state.FullSync(); err != nil {
+			t.Fatalf("unable to save channel state: %v", err)
+		}
+
+		numSecrets, err := state.VerifyRevocationState()
+		switch {
+		case testCase.valid && err != nil:
+			t.Fatalf("%v: unexpected error: %v", testCase.name, err)
+
+		case testCase.valid && numSecrets != 1:
+			t.Fatalf("%v: expected 1 secret, got %v",
+				testCase.name, numSecrets)
+
+		case !testCase.valid && err == nil:
+			t.Fatalf("%v: expected error", testCase.name)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package channeldb
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"io"
@@ -18,6 +19,30 @@ import (
 // outPointSize is the size of a serialized outpoint on disk.
 const outPointSize = 36
 
+// storedUpdateAddSize is the size of the payload of a serialized
+// UpdateAddHTLC message on disk. As messages are stored without a length
+// prefix, this is used to prevent the decoding of its optional TLV stream
+// from consuming any data stored after it.
+const storedUpdateAddSize = 32 + 8 + 8 + 32 + 4 + lnwire.OnionPacketSize
+
+// readMessage reads a message written by WriteElement from the passed reader.
+func readMessage(r io.Reader) (lnwire.Message, error) {
+	var mType [2]byte
+	if _, err := io.ReadFull(r, mType[:]); err != nil {
+		return nil, err
+	}
+
+	payload := r
+	msgType := lnwire.MessageType(binary.BigEndian.Uint16(mType[:]))
+	if msgType == lnwire.MsgUpdateAddHTLC {
+		payload = io.LimitReader(r, storedUpdateAddSize)
+	}
+
+	return lnwire.ReadMessage(
+		io.MultiReader(bytes.NewReader(mType[:]), payload), 0,
+	)
+}
+
 // writeOutpoint writes an outpoint to the passed writer using the minimal
 // amount of bytes possible.
 func writeOutpoint(w io.Writer, o *wire.OutPoint) error {
@@ -158,6 +183,15 @@ func WriteElement(w io.Writer, element interface{}) error {
 		}
 
 	case lnwire.Message:
+		// The endorsement signal of an HTLC is only relevant while it
+		// is being forwarded, so we don't persist the TLV stream that
+		// carries it.
+		if add, ok := e.(*lnwire.UpdateAddHTLC); ok && add.Endorsed {
+			addCopy := *add
+			addCopy.Endorsed = false
+			e = &addCopy
+		}
+
 		if _, err := lnwire.WriteMessage(w, e, 0); err != nil {
 			return err
 		}
@@ -350,7 +384,7 @@ func ReadElement(r io.Reader, element interface{}) error {
 		*e = bytes
 
 	case *lnwire.Message:
-		msg, err := lnwire.ReadMessage(r, 0)
+		msg, err := readMessage(r)
 		if err != nil {
 			return err
 		}
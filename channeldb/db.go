@@ -114,6 +114,10 @@ var bufPool = &sync.Pool{
 type DB struct {
 	*bbolt.DB
 	dbPath string
+
+	// graphCache is an in-memory copy of the channel graph, which is kept
+	// up to date as the graph is modified.
+	graphCache *graphCache
 }
 
 // Open opens an existing channeldb. Any necessary schemas migrations due to
@@ -143,6 +147,14 @@ func Open(dbPath string) (*DB, error) {
 		return nil, err
 	}
 
+	// With the database up to date, we'll load the channel graph into
+	// memory to speed up path finding.
+	chanDB.graphCache = newGraphCache(chanDB)
+	if err := chanDB.graphCache.load(); err != nil {
+		bdb.Close()
+		return nil, err
+	}
+
 	return chanDB, nil
 }
 
@@ -155,7 +167,7 @@ func (d *DB) Path() string {
 // database. The deletion is done in a single transaction, therefore this
 // operation is fully atomic.
 func (d *DB) Wipe() error {
-	return d.Update(func(tx *bbolt.Tx) error {
+	err := d.Update(func(tx *bbolt.Tx) error {
 		err := tx.DeleteBucket(openChannelBucket)
 		if err != nil && err != bbolt.ErrBucketNotFound {
 			return err
@@ -195,6 +207,13 @@ func (d *DB) Wipe() error {
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	d.graphCache.reset()
+
+	return nil
 }
 
 // createChannelDB creates and initializes a fresh version of channeldb. In
@@ -977,6 +996,9 @@ func (d *DB) RestoreChannelShells(channelShells ...*ChannelShell) error {
 			if err != nil {
 				return err
 			}
+			tx.OnCommit(func() {
+				d.graphCache.updatePolicy(&chanEdge)
+			})
 		}
 
 		return nil
@@ -79,6 +79,14 @@ var (
 	// can't be found.
 	ErrEdgeNotFound = fmt.Errorf("edge not found")
 
+	// ErrZombieEdge is returned when an edge for the target chanID can't
+	// be found as it has been marked as a zombie.
+	ErrZombieEdge = fmt.Errorf("edge marked as zombie")
+
+	// ErrZombieEdgeNotFound is returned when an edge that was expected to
+	// be a zombie isn't found within the zombie index.
+	ErrZombieEdgeNotFound = fmt.Errorf("zombie edge not found")
+
 	// ErrEdgeAlreadyExist is returned when edge with specific
 	// channel id can't be added because it already exist.
 	ErrEdgeAlreadyExist = fmt.Errorf("edge already exist")
@@ -108,6 +116,15 @@ var (
 	// channel with a channel point that is already present in the
 	// database.
 	ErrChanAlreadyExists = fmt.Errorf("channel already exists")
+
+	// ErrChainHashMismatch is returned when the database is opened for a
+	// chain other than the one it was created for.
+	ErrChainHashMismatch = fmt.Errorf("channel db belongs to a different " +
+		"chain")
+
+	// ErrPeerNotBanned is returned when attempting to unban a peer that
+	// isn't banned.
+	ErrPeerNotBanned = fmt.Errorf("peer not banned")
 )
 
 // ErrTooManyExtraOpaqueBytes creates an error which should be returned if the
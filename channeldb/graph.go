@@ -106,6 +106,17 @@ var (
 	// maps: outPoint -> chanID
 	channelPointBucket = []byte("chan-index")
 
+	// zombieBucket is a sub-bucket of the main edgeBucket bucket
+	// responsible for maintaining an index of zombie channels. Each entry
+	// exists within the bucket as follows:
+	//
+	// maps: chanID -> pubKey1 || pubKey2
+	//
+	// The chanID represents the channel ID of the edge that is marked as a
+	// zombie and is used as the key, which maps to the public keys of the
+	// edge's participants.
+	zombieBucket = []byte("zombie-index")
+
 	// graphMetaBucket is a top-level bucket which stores various meta-deta
 	// related to the on-disk channel graph. Data stored in this bucket
 	// includes the block to which the graph has been synced to, the total
@@ -342,7 +353,14 @@ func (c *ChannelGraph) SetSourceNode(node *LightningNode) error {
 
 		// Finally, we commit the information of the lightning node
 		// itself.
-		return addLightningNode(tx, node)
+		if err := addLightningNode(tx, node); err != nil {
+			return err
+		}
+
+		tx.OnCommit(func() {
+			c.db.graphCache.addNode(node)
+		})
+		return nil
 	})
 }
 
@@ -356,7 +374,14 @@ func (c *ChannelGraph) SetSourceNode(node *LightningNode) error {
 // TODO(roasbeef): also need sig of announcement
 func (c *ChannelGraph) AddLightningNode(node *LightningNode) error {
 	return c.db.Update(func(tx *bbolt.Tx) error {
-		return addLightningNode(tx, node)
+		if err := addLightningNode(tx, node); err != nil {
+			return err
+		}
+
+		tx.OnCommit(func() {
+			c.db.graphCache.addNode(node)
+		})
+		return nil
 	})
 }
 
@@ -473,7 +498,14 @@ func (c *ChannelGraph) deleteLightningNode(nodes *bbolt.Bucket,
 	byteOrder.PutUint64(indexKey[:8], updateUnix)
 	copy(indexKey[8:], compressedPubKey)
 
-	return nodeUpdateIndex.Delete(indexKey[:])
+	if err := nodeUpdateIndex.Delete(indexKey[:]); err != nil {
+		return err
+	}
+
+	nodes.Tx().OnCommit(func() {
+		c.db.graphCache.removeNode(node.PubKeyBytes)
+	})
+	return nil
 }
 
 // AddChannelEdge adds a new (undirected, blank) edge to the graph database. An
@@ -581,7 +613,15 @@ func (c *ChannelGraph) addChannelEdge(tx *bbolt.Tx, edge *ChannelEdgeInfo) error
 	if err := writeOutpoint(&b, &edge.ChannelPoint); err != nil {
 		return err
 	}
-	return chanIndex.Put(b.Bytes(), chanKey[:])
+	if err := chanIndex.Put(b.Bytes(), chanKey[:]); err != nil {
+		return err
+	}
+
+	txID := tx.ID()
+	tx.OnCommit(func() {
+		c.db.graphCache.addChannel(edge, txID)
+	})
+	return nil
 }
 
 // HasChannelEdge returns true if the database knows of a channel edge with the
@@ -672,7 +712,16 @@ func (c *ChannelGraph) UpdateChannelEdge(edge *ChannelEdgeInfo) error {
 			return ErrEdgeNotFound
 		}
 
-		return putChanEdgeInfo(edgeIndex, edge, chanKey)
+		err := putChanEdgeInfo(edgeIndex, edge, chanKey)
+		if err != nil {
+			return err
+		}
+
+		txID := tx.ID()
+		tx.OnCommit(func() {
+			c.db.graphCache.addChannel(edge, txID)
+		})
+		return nil
 	})
 }
 
@@ -750,7 +799,7 @@ func (c *ChannelGraph) PruneGraph(spentOutputs []*wire.OutPoint,
 			// will be returned if that outpoint isn't known to be
 			// a channel. If no error is returned, then a channel
 			// was successfully pruned.
-			err = delChannelByEdge(
+			err = c.delChannelByEdge(
 				edges, edgeIndex, chanIndex, nodes, chanPoint,
 			)
 			if err != nil && err != ErrEdgeNotFound {
@@ -975,7 +1024,7 @@ func (c *ChannelGraph) DisconnectBlockAtHeight(height uint32) ([]*ChannelEdgeInf
 			if err != nil {
 				return err
 			}
-			err = delChannelByEdge(
+			err = c.delChannelByEdge(
 				edges, edgeIndex, chanIndex, nodes, &edgeInfo.ChannelPoint,
 			)
 			if err != nil && err != ErrEdgeNotFound {
@@ -1095,7 +1144,7 @@ func (c *ChannelGraph) DeleteChannelEdge(chanPoint *wire.OutPoint) error {
 			return ErrGraphNodeNotFound
 		}
 
-		return delChannelByEdge(
+		return c.delChannelByEdge(
 			edges, edgeIndex, chanIndex, nodes, chanPoint,
 		)
 	})
@@ -1380,13 +1429,24 @@ func (c *ChannelGraph) FilterKnownChanIDs(chanIDs []uint64) ([]uint64, error) {
 
 		// We'll run through the set of chanIDs and collate only the
 		// set of channel that are unable to be found within our db.
+		// Zombie channels are considered known, as we don't want to
+		// query them again only to prune them once more.
+		zombieIndex := edges.Bucket(zombieBucket)
+
 		var cidBytes [8]byte
 		for _, cid := range chanIDs {
 			byteOrder.PutUint64(cidBytes[:], cid)
 
-			if v := edgeIndex.Get(cidBytes[:]); v == nil {
-				newChanIDs = append(newChanIDs, cid)
+			if v := edgeIndex.Get(cidBytes[:]); v != nil {
+				continue
+			}
+			if zombieIndex != nil &&
+				zombieIndex.Get(cidBytes[:]) != nil {
+
+				continue
 			}
+
+			newChanIDs = append(newChanIDs, cid)
 		}
 
 		return nil
@@ -1567,8 +1627,10 @@ func delEdgeUpdateIndexEntry(edgesBucket *bbolt.Bucket, chanID uint64,
 	return nil
 }
 
-func delChannelByEdge(edges *bbolt.Bucket, edgeIndex *bbolt.Bucket,
-	chanIndex *bbolt.Bucket, nodes *bbolt.Bucket, chanPoint *wire.OutPoint) error {
+func (c *ChannelGraph) delChannelByEdge(edges *bbolt.Bucket,
+	edgeIndex *bbolt.Bucket, chanIndex *bbolt.Bucket, nodes *bbolt.Bucket,
+	chanPoint *wire.OutPoint) error {
+
 	var b bytes.Buffer
 	if err := writeOutpoint(&b, chanPoint); err != nil {
 		return err
@@ -1631,7 +1693,16 @@ func delChannelByEdge(edges *bbolt.Bucket, edgeIndex *bbolt.Bucket,
 	if err := edgeIndex.Delete(chanID); err != nil {
 		return err
 	}
-	return chanIndex.Delete(b.Bytes())
+	if err := chanIndex.Delete(b.Bytes()); err != nil {
+		return err
+	}
+
+	tx := edges.Tx()
+	txID := tx.ID()
+	tx.OnCommit(func() {
+		c.db.graphCache.removeChannel(cid, txID)
+	})
+	return nil
 }
 
 // UpdateEdgePolicy updates the edge routing policy for a single directed edge
@@ -1643,7 +1714,14 @@ func delChannelByEdge(edges *bbolt.Bucket, edgeIndex *bbolt.Bucket,
 // the nodes on either side of the channel.
 func (c *ChannelGraph) UpdateEdgePolicy(edge *ChannelEdgePolicy) error {
 	return c.db.Update(func(tx *bbolt.Tx) error {
-		return updateEdgePolicy(tx, edge)
+		if err := updateEdgePolicy(tx, edge); err != nil {
+			return err
+		}
+
+		tx.OnCommit(func() {
+			c.db.graphCache.updatePolicy(edge)
+		})
+		return nil
 	})
 }
 
@@ -2602,7 +2680,30 @@ func (c *ChannelGraph) FetchChannelEdgesByID(chanID uint64) (*ChannelEdgeInfo, *
 
 		byteOrder.PutUint64(channelID[:], chanID)
 
+		// If the edge can't be found, it may have been pruned as a
+		// zombie. In that case, we'll return the public keys of its
+		// participants along with ErrZombieEdge, which allows callers
+		// to validate updates that would resurrect the channel.
 		edge, err := fetchChanEdgeInfo(edgeIndex, channelID[:])
+		if err == ErrEdgeNotFound {
+			zombieIndex := edges.Bucket(zombieBucket)
+			if zombieIndex == nil {
+				return err
+			}
+
+			isZombie, pubKey1, pubKey2 := isZombieEdge(
+				zombieIndex, chanID,
+			)
+			if !isZombie {
+				return err
+			}
+
+			edgeInfo = &ChannelEdgeInfo{
+				NodeKey1Bytes: pubKey1,
+				NodeKey2Bytes: pubKey2,
+			}
+			return ErrZombieEdge
+		}
 		if err != nil {
 			return err
 		}
@@ -2620,6 +2721,9 @@ func (c *ChannelGraph) FetchChannelEdgesByID(chanID uint64) (*ChannelEdgeInfo, *
 		policy2 = e2
 		return nil
 	})
+	if err == ErrZombieEdge {
+		return edgeInfo, nil, nil, err
+	}
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -3397,62 +3501,79 @@ func serializeChanEdgePolicy(w io.Writer, edge *ChannelEdgePolicy,
 func deserializeChanEdgePolicy(r io.Reader,
 	nodes *bbolt.Bucket) (*ChannelEdgePolicy, error) {
 
+	// Deserialize the policy. Note that in case an optional field is not
+	// found, both an error and a populated policy object are returned.
+	edge, pub, deserializeErr := deserializeChanEdgePolicyRaw(r)
+	if deserializeErr != nil &&
+		deserializeErr != ErrEdgePolicyOptionalFieldNotFound {
+
+		return nil, deserializeErr
+	}
+
+	node, err := fetchLightningNode(nodes, pub[:])
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch node: %x, %v",
+			pub[:], err)
+	}
+	edge.Node = &node
+
+	return edge, deserializeErr
+}
+
+// deserializeChanEdgePolicyRaw deserializes a routing policy without fetching
+// the node it points to, whose public key is returned instead.
+func deserializeChanEdgePolicyRaw(r io.Reader) (*ChannelEdgePolicy, [33]byte,
+	error) {
+
+	var pub [33]byte
 	edge := &ChannelEdgePolicy{}
 
 	var err error
 	edge.SigBytes, err = wire.ReadVarBytes(r, 0, 80, "sig")
 	if err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 
 	if err := binary.Read(r, byteOrder, &edge.ChannelID); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 
 	var scratch [8]byte
 	if _, err := r.Read(scratch[:]); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	unix := int64(byteOrder.Uint64(scratch[:]))
 	edge.LastUpdate = time.Unix(unix, 0)
 
 	if err := binary.Read(r, byteOrder, &edge.MessageFlags); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	if err := binary.Read(r, byteOrder, &edge.ChannelFlags); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	if err := binary.Read(r, byteOrder, &edge.TimeLockDelta); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 
 	var n uint64
 	if err := binary.Read(r, byteOrder, &n); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	edge.MinHTLC = lnwire.MilliSatoshi(n)
 
 	if err := binary.Read(r, byteOrder, &n); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	edge.FeeBaseMSat = lnwire.MilliSatoshi(n)
 
 	if err := binary.Read(r, byteOrder, &n); err != nil {
-		return nil, err
+		return nil, pub, err
 	}
 	edge.FeeProportionalMillionths = lnwire.MilliSatoshi(n)
 
-	var pub [33]byte
 	if _, err := r.Read(pub[:]); err != nil {
-		return nil, err
-	}
-
-	node, err := fetchLightningNode(nodes, pub[:])
-	if err != nil {
-		return nil, fmt.Errorf("unable to fetch node: %x, %v",
-			pub[:], err)
+		return nil, pub, err
 	}
-	edge.Node = &node
 
 	// We'll try and see if there are any opaque bytes left, if not, then
 	// we'll ignore the EOF error and return the edge as is.
@@ -3463,7 +3584,7 @@ func deserializeChanEdgePolicy(r io.Reader,
 	case err == io.ErrUnexpectedEOF:
 	case err == io.EOF:
 	case err != nil:
-		return nil, err
+		return nil, pub, err
 	}
 
 	// See if optional fields are present.
@@ -3476,7 +3597,7 @@ func deserializeChanEdgePolicy(r io.Reader,
 		// stored before this field was validated. We'll return the
 		// edge along with an error.
 		if len(opq) < 8 {
-			return edge, ErrEdgePolicyOptionalFieldNotFound
+			return edge, pub, ErrEdgePolicyOptionalFieldNotFound
 		}
 
 		maxHtlc := byteOrder.Uint64(opq[:8])
@@ -3486,5 +3607,132 @@ func deserializeChanEdgePolicy(r io.Reader,
 		edge.ExtraOpaqueData = opq[8:]
 	}
 
-	return edge, nil
+	return edge, pub, nil
+}
+
+// MarkEdgeZombie marks an edge as a zombie within the graph's zombie index.
+// The public keys should represent the node public keys of the two parties
+// involved in the edge.
+func (c *ChannelGraph) MarkEdgeZombie(chanID uint64, pubKey1,
+	pubKey2 [33]byte) error {
+
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		edges, err := tx.CreateBucketIfNotExists(edgeBucket)
+		if err != nil {
+			return err
+		}
+		zombieIndex, err := edges.CreateBucketIfNotExists(zombieBucket)
+		if err != nil {
+			return err
+		}
+
+		var k [8]byte
+		byteOrder.PutUint64(k[:], chanID)
+
+		var v [66]byte
+		copy(v[:33], pubKey1[:])
+		copy(v[33:], pubKey2[:])
+
+		return zombieIndex.Put(k[:], v[:])
+	})
+}
+
+// MarkEdgeLive clears an edge from the graph's zombie index, allowing it to be
+// added to the graph again. If the edge isn't a zombie, then
+// ErrZombieEdgeNotFound is returned.
+func (c *ChannelGraph) MarkEdgeLive(chanID uint64) error {
+	return c.db.Update(func(tx *bbolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return ErrZombieEdgeNotFound
+		}
+		zombieIndex := edges.Bucket(zombieBucket)
+		if zombieIndex == nil {
+			return ErrZombieEdgeNotFound
+		}
+
+		var k [8]byte
+		byteOrder.PutUint64(k[:], chanID)
+
+		if zombieIndex.Get(k[:]) == nil {
+			return ErrZombieEdgeNotFound
+		}
+
+		return zombieIndex.Delete(k[:])
+	})
+}
+
+// IsZombieEdge returns whether the edge is considered zombie. If it is a
+// zombie, then the two node public keys corresponding to this edge are also
+// returned.
+func (c *ChannelGraph) IsZombieEdge(chanID uint64) (bool, [33]byte, [33]byte,
+	error) {
+
+	var (
+		isZombie         bool
+		pubKey1, pubKey2 [33]byte
+	)
+
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+		zombieIndex := edges.Bucket(zombieBucket)
+		if zombieIndex == nil {
+			return nil
+		}
+
+		isZombie, pubKey1, pubKey2 = isZombieEdge(zombieIndex, chanID)
+		return nil
+	})
+	if err != nil {
+		return false, [33]byte{}, [33]byte{}, err
+	}
+
+	return isZombie, pubKey1, pubKey2, nil
+}
+
+// isZombieEdge returns whether an entry exists for the given channel in the
+// zombie index. If an entry exists, then the two node public keys
+// corresponding to this edge are also returned.
+func isZombieEdge(zombieIndex *bbolt.Bucket,
+	chanID uint64) (bool, [33]byte, [33]byte) {
+
+	var k [8]byte
+	byteOrder.PutUint64(k[:], chanID)
+
+	v := zombieIndex.Get(k[:])
+	if len(v) != 66 {
+		return false, [33]byte{}, [33]byte{}
+	}
+
+	var pubKey1, pubKey2 [33]byte
+	copy(pubKey1[:], v[:33])
+	copy(pubKey2[:], v[33:])
+
+	return true, pubKey1, pubKey2
+}
+
+// NumZombies returns the current number of zombie channels in the graph.
+func (c *ChannelGraph) NumZombies() (uint64, error) {
+	var numZombies uint64
+	err := c.db.View(func(tx *bbolt.Tx) error {
+		edges := tx.Bucket(edgeBucket)
+		if edges == nil {
+			return nil
+		}
+		zombieIndex := edges.Bucket(zombieBucket)
+		if zombieIndex == nil {
+			return nil
+		}
+
+		numZombies = uint64(zombieIndex.Stats().KeyN)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numZombies, nil
 }
@@ -0,0 +1,554 @@
+package channeldb
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// tombstoneExpiry is the time after which the tombstone of a removed channel
+// is pruned from the graph cache. Modifications only reach the cache out of
+// order while their transactions are committing concurrently, so a
+// tombstone isn't needed anymore long before it expires.
+const tombstoneExpiry = time.Minute
+
+// tombstone marks a channel as removed from the graph cache.
+type tombstone struct {
+	// txID is the ID of the database transaction that removed the
+	// channel.
+	txID int
+
+	// removedAt is the time at which the channel was removed from the
+	// cache.
+	removedAt time.Time
+}
+
+// cachedChannel is the in-memory representation of a channel within the
+// graph cache. Once created, a cachedChannel is never modified. Instead, it
+// is replaced as a whole, such that callers can safely hold on to the objects
+// it references.
+type cachedChannel struct {
+	// info is the static information of the channel.
+	info *ChannelEdgeInfo
+
+	// policy1 is the routing policy of the first node of the channel, or
+	// nil if it is unknown.
+	policy1 *ChannelEdgePolicy
+
+	// policy2 is the routing policy of the second node of the channel, or
+	// nil if it is unknown.
+	policy2 *ChannelEdgePolicy
+}
+
+// graphCache is an in-memory copy of the nodes and channels of the channel
+// graph. It allows path finding to traverse the graph without having to
+// deserialize every edge it explores from the database. The cache is loaded
+// when the database is opened, and is kept up to date as the graph is
+// modified.
+//
+// Modifications are only applied to the cache once the database transaction
+// making them has committed. As the transactions of concurrent writers
+// release the database before their modifications are applied, these may
+// reach the cache out of order. Outdated nodes and routing policies are
+// therefore ignored based on their last update, while removed channels are
+// tombstoned such that they can't be resurrected by an older transaction.
+// Tombstones are pruned once they expire, so they don't accumulate over the
+// lifetime of the database.
+type graphCache struct {
+	db *DB
+
+	// nodes maps the public key of each node to its latest state.
+	nodes map[[33]byte]*LightningNode
+
+	// channels maps the channel ID of each channel to its edge info and
+	// routing policies.
+	channels map[uint64]*cachedChannel
+
+	// nodeChannels maps the public key of each node to the IDs of the
+	// channels it is a party of.
+	nodeChannels map[[33]byte]map[uint64]struct{}
+
+	// removedChannels maps the IDs of the channels recently removed from
+	// the graph to their tombstones.
+	removedChannels map[uint64]tombstone
+
+	mtx sync.RWMutex
+}
+
+// newGraphCache creates a new, empty graph cache for the passed database.
+func newGraphCache(db *DB) *graphCache {
+	return &graphCache{
+		db:              db,
+		nodes:           make(map[[33]byte]*LightningNode),
+		channels:        make(map[uint64]*cachedChannel),
+		nodeChannels:    make(map[[33]byte]map[uint64]struct{}),
+		removedChannels: make(map[uint64]tombstone),
+	}
+}
+
+// cachedPolicy is a routing policy read from the database, together with the
+// public keys of the nodes it points from and to.
+type cachedPolicy struct {
+	policy   *ChannelEdgePolicy
+	fromNode [33]byte
+	toNode   [33]byte
+}
+
+// load populates the cache from the database. The nodes, channels and routing
+// policies of the graph are read in parallel, each within their own database
+// transaction, after which they are linked together.
+func (c *graphCache) load() error {
+	var (
+		nodes    []*LightningNode
+		infos    []*ChannelEdgeInfo
+		policies []cachedPolicy
+		wg       sync.WaitGroup
+	)
+
+	errChan := make(chan error, 3)
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+
+		errChan <- c.db.View(func(tx *bbolt.Tx) error {
+			bucket := tx.Bucket(nodeBucket)
+			if bucket == nil {
+				return nil
+			}
+
+			return bucket.ForEach(func(k, v []byte) error {
+				// Skip the source key, whose value is a
+				// public key, as well as any nested buckets.
+				if bytes.Equal(k, sourceKey) || len(k) != 33 {
+					return nil
+				}
+
+				node, err := deserializeLightningNode(
+					bytes.NewReader(v),
+				)
+				if err != nil {
+					return err
+				}
+				node.db = c.db
+
+				nodes = append(nodes, &node)
+				return nil
+			})
+		})
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		errChan <- c.db.View(func(tx *bbolt.Tx) error {
+			edges := tx.Bucket(edgeBucket)
+			if edges == nil {
+				return nil
+			}
+			edgeIndex := edges.Bucket(edgeIndexBucket)
+			if edgeIndex == nil {
+				return nil
+			}
+
+			return edgeIndex.ForEach(func(_, v []byte) error {
+				info, err := deserializeChanEdgeInfo(
+					bytes.NewReader(v),
+				)
+				if err != nil {
+					return err
+				}
+				info.db = c.db
+
+				infos = append(infos, &info)
+				return nil
+			})
+		})
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		errChan <- c.db.View(func(tx *bbolt.Tx) error {
+			edges := tx.Bucket(edgeBucket)
+			if edges == nil {
+				return nil
+			}
+
+			return edges.ForEach(func(k, v []byte) error {
+				// The policies are keyed by the public key of
+				// the node they originate from, followed by
+				// the channel ID. Any other keys belong to the
+				// nested indexes of the bucket.
+				if len(k) != 33+8 || v == nil ||
+					bytes.Equal(v, unknownPolicy) {
+
+					return nil
+				}
+
+				r := bytes.NewReader(v)
+				p, to, err := deserializeChanEdgePolicyRaw(r)
+				switch {
+				// Policies that are missing an optional field
+				// are treated as unknown, just like when they
+				// are read from the database directly.
+				case err == ErrEdgePolicyOptionalFieldNotFound:
+					return nil
+
+				case err != nil:
+					return err
+				}
+				p.db = c.db
+
+				var fromNode [33]byte
+				copy(fromNode[:], k[:33])
+
+				policies = append(policies, cachedPolicy{
+					policy:   p,
+					fromNode: fromNode,
+					toNode:   to,
+				})
+				return nil
+			})
+		})
+	}()
+
+	wg.Wait()
+	close(errChan)
+
+	for err := range errChan {
+		if err != nil {
+			return err
+		}
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	for _, node := range nodes {
+		c.nodes[node.PubKeyBytes] = node
+	}
+
+	for _, info := range infos {
+		c.addChannelLocked(info)
+	}
+
+	for _, p := range policies {
+		channel, ok := c.channels[p.policy.ChannelID]
+		if !ok {
+			continue
+		}
+
+		// A policy pointing to a node we don't know of can't be
+		// read from the database either, so we'll skip it.
+		toNode, ok := c.nodes[p.toNode]
+		if !ok {
+			continue
+		}
+		p.policy.Node = toNode
+
+		switch p.fromNode {
+		case channel.info.NodeKey1Bytes:
+			channel.policy1 = p.policy
+		case channel.info.NodeKey2Bytes:
+			channel.policy2 = p.policy
+		}
+	}
+
+	log.Debugf("Loaded %v nodes and %v channels into graph cache",
+		len(c.nodes), len(c.channels))
+
+	return nil
+}
+
+// reset removes all nodes and channels from the cache.
+func (c *graphCache) reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.nodes = make(map[[33]byte]*LightningNode)
+	c.channels = make(map[uint64]*cachedChannel)
+	c.nodeChannels = make(map[[33]byte]map[uint64]struct{})
+	c.removedChannels = make(map[uint64]tombstone)
+}
+
+// addNode adds the passed node to the cache, or replaces its previous state.
+// The node is ignored if the cache already holds a more recent state of it.
+func (c *graphCache) addNode(node *LightningNode) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	prev, ok := c.nodes[node.PubKeyBytes]
+	if ok && prev.LastUpdate.After(node.LastUpdate) {
+		return
+	}
+
+	n := *node
+	n.db = c.db
+	c.nodes[n.PubKeyBytes] = &n
+
+	// The routing policies that point to this node reference its previous
+	// state, so we'll replace them with copies pointing to the new one.
+	for chanID := range c.nodeChannels[n.PubKeyBytes] {
+		channel := *c.channels[chanID]
+
+		if channel.info.NodeKey1Bytes == n.PubKeyBytes &&
+			channel.policy2 != nil {
+
+			policy := *channel.policy2
+			policy.Node = &n
+			channel.policy2 = &policy
+		}
+		if channel.info.NodeKey2Bytes == n.PubKeyBytes &&
+			channel.policy1 != nil {
+
+			policy := *channel.policy1
+			policy.Node = &n
+			channel.policy1 = &policy
+		}
+
+		c.channels[chanID] = &channel
+	}
+}
+
+// removeNode removes the node with the passed public key from the cache.
+func (c *graphCache) removeNode(nodePub [33]byte) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	delete(c.nodes, nodePub)
+}
+
+// addChannel adds the passed channel to the cache. If the channel is already
+// known, only its edge info is replaced, while its routing policies are kept.
+// The channel is ignored if it was removed by a later transaction than the
+// one with the passed ID that added it.
+func (c *graphCache) addChannel(info *ChannelEdgeInfo, txID int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if removed, ok := c.removedChannels[info.ChannelID]; ok {
+		if removed.txID > txID {
+			return
+		}
+		delete(c.removedChannels, info.ChannelID)
+	}
+
+	i := *info
+	i.db = c.db
+	c.addChannelLocked(&i)
+}
+
+// addChannelLocked adds the passed channel to the cache, creating shell nodes
+// for its parties if they aren't known yet.
+//
+// NOTE: This method must be called with the cache's mutex held.
+func (c *graphCache) addChannelLocked(info *ChannelEdgeInfo) {
+	channel := &cachedChannel{
+		info: info,
+	}
+	if prev, ok := c.channels[info.ChannelID]; ok {
+		channel.policy1 = prev.policy1
+		channel.policy2 = prev.policy2
+	}
+	c.channels[info.ChannelID] = channel
+
+	for _, nodePub := range [][33]byte{info.NodeKey1Bytes,
+		info.NodeKey2Bytes} {
+
+		if _, ok := c.nodes[nodePub]; !ok {
+			c.nodes[nodePub] = &LightningNode{
+				PubKeyBytes: nodePub,
+				db:          c.db,
+			}
+		}
+
+		chans, ok := c.nodeChannels[nodePub]
+		if !ok {
+			chans = make(map[uint64]struct{})
+			c.nodeChannels[nodePub] = chans
+		}
+		chans[info.ChannelID] = struct{}{}
+	}
+}
+
+// removeChannel removes the channel with the passed ID from the cache, and
+// tombstones it with the ID of the transaction that removed it. Any expired
+// tombstones are pruned along the way.
+func (c *graphCache) removeChannel(chanID uint64, txID int) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	now := time.Now()
+	c.pruneTombstonesLocked(now)
+
+	removed, ok := c.removedChannels[chanID]
+	if !ok || txID > removed.txID {
+		c.removedChannels[chanID] = tombstone{
+			txID:      txID,
+			removedAt: now,
+		}
+	}
+
+	channel, ok := c.channels[chanID]
+	if !ok {
+		return
+	}
+	delete(c.channels, chanID)
+
+	for _, nodePub := range [][33]byte{channel.info.NodeKey1Bytes,
+		channel.info.NodeKey2Bytes} {
+
+		chans := c.nodeChannels[nodePub]
+		delete(chans, chanID)
+		if len(chans) == 0 {
+			delete(c.nodeChannels, nodePub)
+		}
+	}
+}
+
+// pruneTombstonesLocked removes the tombstones that have expired as of the
+// passed time.
+//
+// NOTE: This method must be called with the cache's mutex held.
+func (c *graphCache) pruneTombstonesLocked(now time.Time) {
+	for chanID, removed := range c.removedChannels {
+		if now.Sub(removed.removedAt) >= tombstoneExpiry {
+			delete(c.removedChannels, chanID)
+		}
+	}
+}
+
+// updatePolicy sets the passed routing policy for the direction of the
+// channel indicated by its flags. Policies of unknown channels, as well as
+// policies older than the one already known, are ignored.
+func (c *graphCache) updatePolicy(policy *ChannelEdgePolicy) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	prev, ok := c.channels[policy.ChannelID]
+	if !ok {
+		return
+	}
+	channel := *prev
+
+	p := *policy
+	p.db = c.db
+
+	toNode := channel.info.NodeKey2Bytes
+	isNode1 := p.ChannelFlags&lnwire.ChanUpdateDirection == 0
+	if !isNode1 {
+		toNode = channel.info.NodeKey1Bytes
+	}
+	p.Node = c.nodes[toNode]
+
+	prevPolicy := channel.policy2
+	if isNode1 {
+		prevPolicy = channel.policy1
+	}
+	if prevPolicy != nil && prevPolicy.LastUpdate.After(p.LastUpdate) {
+		return
+	}
+
+	if isNode1 {
+		channel.policy1 = &p
+	} else {
+		channel.policy2 = &p
+	}
+
+	c.channels[policy.ChannelID] = &channel
+}
+
+// forEachNode executes the passed callback for each node within the cache.
+// The callback is executed without holding the cache's mutex, so it may
+// safely access the graph itself.
+func (c *graphCache) forEachNode(cb func(*LightningNode) error) error {
+	c.mtx.RLock()
+	nodes := make([]*LightningNode, 0, len(c.nodes))
+	for _, node := range c.nodes {
+		nodes = append(nodes, node)
+	}
+	c.mtx.RUnlock()
+
+	for _, node := range nodes {
+		if err := cb(node); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// forEachNodeChannel executes the passed callback for each channel of the
+// node with the passed public key. The callback is passed the edge info of
+// the channel, the outgoing and incoming routing policies from the point of
+// view of the node, and the node on the other end of the channel. Like
+// forEachNode, the callback is executed without holding the cache's mutex.
+func (c *graphCache) forEachNodeChannel(nodePub [33]byte,
+	cb func(*ChannelEdgeInfo, *ChannelEdgePolicy, *ChannelEdgePolicy,
+		*LightningNode) error) error {
+
+	type channelWithPeer struct {
+		channel *cachedChannel
+		peer    *LightningNode
+	}
+
+	c.mtx.RLock()
+	chans := make([]channelWithPeer, 0, len(c.nodeChannels[nodePub]))
+	for chanID := range c.nodeChannels[nodePub] {
+		channel := c.channels[chanID]
+
+		peerPub := channel.info.NodeKey1Bytes
+		if peerPub == nodePub {
+			peerPub = channel.info.NodeKey2Bytes
+		}
+
+		peer, ok := c.nodes[peerPub]
+		if !ok {
+			continue
+		}
+
+		chans = append(chans, channelWithPeer{
+			channel: channel,
+			peer:    peer,
+		})
+	}
+	c.mtx.RUnlock()
+
+	for _, ch := range chans {
+		outPolicy, inPolicy := ch.channel.policy1, ch.channel.policy2
+		if ch.channel.info.NodeKey2Bytes == nodePub {
+			outPolicy, inPolicy = inPolicy, outPolicy
+		}
+
+		err := cb(ch.channel.info, outPolicy, inPolicy, ch.peer)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ForEachCachedNode iterates through all nodes of the graph, executing the
+// passed callback with each node encountered. Unlike ForEachNode, the nodes
+// are read from the in-memory graph cache rather than the database. The
+// returned nodes must not be modified.
+func (c *ChannelGraph) ForEachCachedNode(cb func(*LightningNode) error) error {
+	return c.db.graphCache.forEachNode(cb)
+}
+
+// ForEachCachedNodeChannel iterates through all channels of the node with the
+// passed public key, using the in-memory graph cache. For each channel, the
+// callback is passed its edge info, the outgoing and incoming routing policies
+// from the point of view of the node, which are nil if unknown, and the node
+// on the other end of the channel. The returned objects must not be modified.
+func (c *ChannelGraph) ForEachCachedNodeChannel(nodePub [33]byte,
+	cb func(*ChannelEdgeInfo, *ChannelEdgePolicy, *ChannelEdgePolicy,
+		*LightningNode) error) error {
+
+	return c.db.graphCache.forEachNodeChannel(nodePub, cb)
+}
@@ -0,0 +1,362 @@
+package channeldb
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/coreos/bbolt"
+)
+
+// compareOptionalEdgePolicies compares two edge policies that may be nil.
+func compareOptionalEdgePolicies(a, b *ChannelEdgePolicy) error {
+	switch {
+	case a == nil && b == nil:
+		return nil
+
+	case a == nil || b == nil:
+		return fmt.Errorf("expected policy %v, got %v", a, b)
+	}
+
+	return compareEdgePolicies(a, b)
+}
+
+// assertGraphCacheConsistent asserts that the nodes and channels within the
+// graph cache match those stored in the database.
+func assertGraphCacheConsistent(t *testing.T, graph *ChannelGraph) {
+	var numNodes int
+	err := graph.ForEachNode(nil, func(tx *bbolt.Tx,
+		node *LightningNode) error {
+
+		numNodes++
+
+		type dbChannel struct {
+			peer            [33]byte
+			outEdge, inEdge *ChannelEdgePolicy
+		}
+		dbChannels := make(map[uint64]dbChannel)
+		err := node.ForEachChannel(tx, func(_ *bbolt.Tx,
+			info *ChannelEdgeInfo,
+			outEdge, inEdge *ChannelEdgePolicy) error {
+
+			peer := info.NodeKey1Bytes
+			if peer == node.PubKeyBytes {
+				peer = info.NodeKey2Bytes
+			}
+
+			dbChannels[info.ChannelID] = dbChannel{
+				peer:    peer,
+				outEdge: outEdge,
+				inEdge:  inEdge,
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		var numChannels int
+		err = graph.ForEachCachedNodeChannel(node.PubKeyBytes, func(
+			info *ChannelEdgeInfo,
+			outEdge, inEdge *ChannelEdgePolicy,
+			peer *LightningNode) error {
+
+			numChannels++
+
+			dbChan, ok := dbChannels[info.ChannelID]
+			if !ok {
+				return fmt.Errorf("unexpected channel %v in "+
+					"cache", info.ChannelID)
+			}
+			if peer.PubKeyBytes != dbChan.peer {
+				return fmt.Errorf("expected peer %x, got %x",
+					dbChan.peer, peer.PubKeyBytes)
+			}
+
+			err := compareOptionalEdgePolicies(
+				dbChan.outEdge, outEdge,
+			)
+			if err != nil {
+				return err
+			}
+
+			return compareOptionalEdgePolicies(
+				dbChan.inEdge, inEdge,
+			)
+		})
+		if err != nil {
+			return err
+		}
+
+		if numChannels != len(dbChannels) {
+			return fmt.Errorf("expected %v channels for node "+
+				"%x, got %v", len(dbChannels),
+				node.PubKeyBytes, numChannels)
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("graph cache inconsistent: %v", err)
+	}
+
+	var numCachedNodes int
+	err = graph.ForEachCachedNode(func(*LightningNode) error {
+		numCachedNodes++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to iterate cached nodes: %v", err)
+	}
+	if numCachedNodes != numNodes {
+		t.Fatalf("expected %v cached nodes, got %v", numNodes,
+			numCachedNodes)
+	}
+}
+
+// TestGraphCache asserts that the graph cache is kept up to date as the graph
+// is modified, and that it is loaded from the database when it is opened.
+func TestGraphCache(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "graphcache")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+
+	graph := db.ChannelGraph()
+
+	sourceNode, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create source node: %v", err)
+	}
+	if err := graph.SetSourceNode(sourceNode); err != nil {
+		t.Fatalf("unable to set source node: %v", err)
+	}
+
+	var nodes []*LightningNode
+	for i := 0; i < 3; i++ {
+		node, err := createTestVertex(db)
+		if err != nil {
+			t.Fatalf("unable to create node: %v", err)
+		}
+		if err := graph.AddLightningNode(node); err != nil {
+			t.Fatalf("unable to add node: %v", err)
+		}
+		nodes = append(nodes, node)
+	}
+
+	// We'll connect the nodes in a chain, where the policies of both
+	// directions of the first channel are known, but only one of the
+	// second.
+	edgeInfo1, policy1, policy2 := createChannelEdge(db, nodes[0], nodes[1])
+	edgeInfo2, policy3, _ := createChannelEdge(db, nodes[1], nodes[2])
+	edgeInfo2.ChannelPoint.Index++
+
+	for _, edgeInfo := range []*ChannelEdgeInfo{edgeInfo1, edgeInfo2} {
+		if err := graph.AddChannelEdge(edgeInfo); err != nil {
+			t.Fatalf("unable to add edge: %v", err)
+		}
+	}
+	assertGraphCacheConsistent(t, graph)
+
+	for _, policy := range []*ChannelEdgePolicy{policy1, policy2, policy3} {
+		if err := graph.UpdateEdgePolicy(policy); err != nil {
+			t.Fatalf("unable to update policy: %v", err)
+		}
+	}
+	assertGraphCacheConsistent(t, graph)
+
+	// An updated node announcement should be reflected by the policies
+	// pointing to the node.
+	nodes[1].Alias = "updated"
+	if err := graph.AddLightningNode(nodes[1]); err != nil {
+		t.Fatalf("unable to update node: %v", err)
+	}
+	assertGraphCacheConsistent(t, graph)
+
+	// The same goes for updated policies.
+	policy1.TimeLockDelta++
+	if err := graph.UpdateEdgePolicy(policy1); err != nil {
+		t.Fatalf("unable to update policy: %v", err)
+	}
+	assertGraphCacheConsistent(t, graph)
+
+	// Once the first channel is deleted and the graph pruned, the first
+	// node should no longer be part of the cache either.
+	if err := graph.DeleteChannelEdge(&edgeInfo1.ChannelPoint); err != nil {
+		t.Fatalf("unable to delete edge: %v", err)
+	}
+	if err := graph.PruneGraphNodes(); err != nil {
+		t.Fatalf("unable to prune graph nodes: %v", err)
+	}
+	assertGraphCacheConsistent(t, graph)
+
+	// Finally, after a restart, the cache should be loaded from the
+	// database.
+	db.Close()
+	db, err = Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	assertGraphCacheConsistent(t, db.ChannelGraph())
+}
+
+// TestGraphCacheRollback asserts that the graph cache isn't modified by
+// database transactions that are rolled back.
+func TestGraphCacheRollback(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "graphcache")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	db, err := Open(tempDir)
+	if err != nil {
+		t.Fatalf("unable to open db: %v", err)
+	}
+	defer db.Close()
+
+	graph := db.ChannelGraph()
+
+	node1, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create node: %v", err)
+	}
+	node2, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create node: %v", err)
+	}
+	edgeInfo, _, _ := createChannelEdge(db, node1, node2)
+
+	// We'll add a channel, along with its nodes, within a transaction that
+	// is rolled back afterwards.
+	errRollback := fmt.Errorf("rollback")
+	err = db.Update(func(tx *bbolt.Tx) error {
+		if err := addLightningNode(tx, node1); err != nil {
+			return err
+		}
+		if err := graph.addChannelEdge(tx, edgeInfo); err != nil {
+			return err
+		}
+
+		return errRollback
+	})
+	if err != errRollback {
+		t.Fatalf("expected rollback, got %v", err)
+	}
+
+	// Neither the node nor the channel should have made it into the
+	// cache.
+	assertGraphCacheConsistent(t, graph)
+	if _, ok := db.graphCache.channels[edgeInfo.ChannelID]; ok {
+		t.Fatalf("rolled back channel found in cache")
+	}
+}
+
+// TestGraphCacheOutOfOrder asserts that modifications reaching the graph
+// cache out of order don't replace more recent state.
+func TestGraphCacheOutOfOrder(t *testing.T) {
+	t.Parallel()
+
+	cache := newGraphCache(nil)
+
+	node1, err := createTestVertex(nil)
+	if err != nil {
+		t.Fatalf("unable to create node: %v", err)
+	}
+	node2, err := createTestVertex(nil)
+	if err != nil {
+		t.Fatalf("unable to create node: %v", err)
+	}
+	edgeInfo, policy1, _ := createChannelEdge(nil, node1, node2)
+
+	// An older node announcement shouldn't replace a newer one.
+	cache.addNode(node1)
+	oldNode := *node1
+	oldNode.Alias = "old"
+	oldNode.LastUpdate = node1.LastUpdate.Add(-time.Second)
+	cache.addNode(&oldNode)
+	if cache.nodes[node1.PubKeyBytes].Alias != node1.Alias {
+		t.Fatalf("node replaced by older announcement")
+	}
+
+	// The same goes for routing policies.
+	cache.addChannel(edgeInfo, 1)
+	cache.updatePolicy(policy1)
+	oldPolicy := *policy1
+	oldPolicy.TimeLockDelta++
+	oldPolicy.LastUpdate = policy1.LastUpdate.Add(-time.Second)
+	cache.updatePolicy(&oldPolicy)
+	channel := cache.channels[edgeInfo.ChannelID]
+	if channel.policy1.TimeLockDelta != policy1.TimeLockDelta {
+		t.Fatalf("policy replaced by older update")
+	}
+
+	// A channel removed by a later transaction than the one adding it
+	// shouldn't be resurrected.
+	cache.removeChannel(edgeInfo.ChannelID, 3)
+	cache.addChannel(edgeInfo, 2)
+	if _, ok := cache.channels[edgeInfo.ChannelID]; ok {
+		t.Fatalf("removed channel resurrected by older transaction")
+	}
+
+	// Once the channel is added again by a later transaction, it should
+	// be part of the cache again.
+	cache.addChannel(edgeInfo, 4)
+	if _, ok := cache.channels[edgeInfo.ChannelID]; !ok {
+		t.Fatalf("re-added channel not found in cache")
+	}
+	if _, ok := cache.removedChannels[edgeInfo.ChannelID]; ok {
+		t.Fatalf("tombstone of re-added channel not removed")
+	}
+}
+
+// TestGraphCachePruneTombstones asserts that the tombstones of removed
+// channels are pruned once they expire.
+func TestGraphCachePruneTombstones(t *testing.T) {
+	t.Parallel()
+
+	cache := newGraphCache(nil)
+
+	cache.removeChannel(1, 1)
+	cache.removeChannel(2, 2)
+
+	// Backdate the first tombstone such that it has expired by the time
+	// the next channel is removed.
+	expired := cache.removedChannels[1]
+	expired.removedAt = expired.removedAt.Add(-tombstoneExpiry)
+	cache.removedChannels[1] = expired
+
+	cache.removeChannel(3, 3)
+
+	if _, ok := cache.removedChannels[1]; ok {
+		t.Fatalf("expired tombstone not pruned")
+	}
+	for _, chanID := range []uint64{2, 3} {
+		if _, ok := cache.removedChannels[chanID]; !ok {
+			t.Fatalf("tombstone of channel %v pruned before "+
+				"expiring", chanID)
+		}
+	}
+
+	// Once its tombstone is pruned, the channel can be added by any
+	// transaction again.
+	edgeInfo := &ChannelEdgeInfo{ChannelID: 1}
+	cache.addChannel(edgeInfo, 0)
+	if _, ok := cache.channels[edgeInfo.ChannelID]; !ok {
+		t.Fatalf("channel with pruned tombstone not added")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -99,7 +100,13 @@ func TestInvoiceWorkflow(t *testing.T) {
 	// now have the settled bit toggle to true and a non-default
 	// SettledDate
 	payAmt := fakeInvoice.Terms.Value * 2
-	if _, err := db.SettleInvoice(paymentHash, payAmt); err != nil {
+	htlc := InvoiceHTLC{
+		ChanID:       lnwire.NewShortChanIDFromInt(1),
+		HtlcID:       2,
+		Amt:          payAmt,
+		AcceptHeight: 100,
+	}
+	if _, err := db.SettleInvoice(paymentHash, htlc); err != nil {
 		t.Fatalf("unable to settle invoice: %v", err)
 	}
 	dbInvoice2, err := db.LookupInvoice(paymentHash)
@@ -124,6 +131,22 @@ func TestInvoiceWorkflow(t *testing.T) {
 			dbInvoice2.SettleIndex)
 	}
 
+	// The HTLC that settled the invoice should have been recorded, with
+	// its resolve time matching the settle date.
+	if len(dbInvoice2.Htlcs) != 1 {
+		t.Fatalf("expected 1 htlc, got %v", len(dbInvoice2.Htlcs))
+	}
+	dbHtlc := dbInvoice2.Htlcs[0]
+	if !dbHtlc.ResolveTime.Equal(dbInvoice2.SettleDate) {
+		t.Fatalf("wrong htlc resolve time: expected %v, got %v",
+			dbInvoice2.SettleDate, dbHtlc.ResolveTime)
+	}
+	dbHtlc.ResolveTime = time.Time{}
+	if !reflect.DeepEqual(dbHtlc, htlc) {
+		t.Fatalf("wrong htlc: expected %v, got %v", spew.Sdump(htlc),
+			spew.Sdump(dbHtlc))
+	}
+
 	// Attempt to insert generated above again, this should fail as
 	// duplicates are rejected by the processing logic.
 	if _, err := db.AddInvoice(fakeInvoice); err != ErrDuplicateInvoice {
@@ -260,7 +283,7 @@ func TestInvoiceAddTimeSeries(t *testing.T) {
 			invoice.Terms.PaymentPreimage[:],
 		)
 
-		_, err := db.SettleInvoice(paymentHash, 0)
+		_, err := db.SettleInvoice(paymentHash, InvoiceHTLC{})
 		if err != nil {
 			t.Fatalf("unable to settle invoice: %v", err)
 		}
@@ -340,7 +363,8 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 
 	// With the invoice in the DB, we'll now attempt to settle the invoice.
 	payHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
-	dbInvoice, err := db.SettleInvoice(payHash, amt)
+	htlc := InvoiceHTLC{Amt: amt}
+	dbInvoice, err := db.SettleInvoice(payHash, htlc)
 	if err != nil {
 		t.Fatalf("unable to settle invoice: %v", err)
 	}
@@ -351,6 +375,8 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 	invoice.Terms.State = ContractSettled
 	invoice.AmtPaid = amt
 	invoice.SettleDate = dbInvoice.SettleDate
+	htlc.ResolveTime = dbInvoice.SettleDate
+	invoice.Htlcs = []InvoiceHTLC{htlc}
 
 	// We should get back the exact same invoice that we just inserted.
 	if !reflect.DeepEqual(dbInvoice, invoice) {
@@ -360,7 +386,7 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 
 	// If we try to settle the invoice again, then we should get the very
 	// same invoice back, but with an error this time.
-	dbInvoice, err = db.SettleInvoice(payHash, amt)
+	dbInvoice, err = db.SettleInvoice(payHash, htlc)
 	if err != ErrInvoiceAlreadySettled {
 		t.Fatalf("expected ErrInvoiceAlreadySettled")
 	}
@@ -370,6 +396,7 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 	}
 
 	invoice.SettleDate = dbInvoice.SettleDate
+	invoice.Htlcs[0].ResolveTime = dbInvoice.Htlcs[0].ResolveTime
 	if !reflect.DeepEqual(dbInvoice, invoice) {
 		t.Fatalf("wrong invoice after second settle, expected %v got %v",
 			spew.Sdump(invoice), spew.Sdump(dbInvoice))
@@ -404,7 +431,9 @@ func TestQueryInvoices(t *testing.T) {
 		// We'll only settle half of all invoices created.
 		if i%2 == 0 {
 			paymentHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
-			if _, err := db.SettleInvoice(paymentHash, i); err != nil {
+			htlc := InvoiceHTLC{Amt: i}
+			_, err := db.SettleInvoice(paymentHash, htlc)
+			if err != nil {
 				t.Fatalf("unable to settle invoice: %v", err)
 			}
 		}
@@ -645,3 +674,75 @@ func TestQueryInvoices(t *testing.T) {
 		}
 	}
 }
+
+// TestUpdateInvoicePaymentRequest ensures that the payment request of an open
+// invoice can be replaced, while settled and canceled invoices are left
+// untouched.
+func TestUpdateInvoicePaymentRequest(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	// Updating the payment request of an unknown invoice should fail.
+	var unknownHash lntypes.Hash
+	_, err = db.UpdateInvoicePaymentRequest(unknownHash, []byte("payreq"))
+	if err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got: %v", err)
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(1000)
+	invoice, err := randInvoice(amt)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+	payHash := lntypes.Hash(
+		sha256.Sum256(invoice.Terms.PaymentPreimage[:]),
+	)
+
+	// A payment request exceeding the maximum size should be rejected.
+	tooLarge := make([]byte, MaxPaymentRequestSize+1)
+	_, err = db.UpdateInvoicePaymentRequest(payHash, tooLarge)
+	if err == nil {
+		t.Fatalf("expected oversized payment request to be rejected")
+	}
+
+	// Now, we'll update the payment request of the open invoice. Only the
+	// payment request should have been modified.
+	newPayReq := []byte("updated payreq")
+	dbInvoice, err := db.UpdateInvoicePaymentRequest(payHash, newPayReq)
+	if err != nil {
+		t.Fatalf("unable to update payment request: %v", err)
+	}
+
+	invoice.PaymentRequest = newPayReq
+	if !reflect.DeepEqual(dbInvoice, invoice) {
+		t.Fatalf("wrong invoice after update, expected %v got %v",
+			spew.Sdump(invoice), spew.Sdump(dbInvoice))
+	}
+
+	storedInvoice, err := db.LookupInvoice(payHash)
+	if err != nil {
+		t.Fatalf("unable to lookup invoice: %v", err)
+	}
+	if !reflect.DeepEqual(&storedInvoice, invoice) {
+		t.Fatalf("wrong invoice stored, expected %v got %v",
+			spew.Sdump(invoice), spew.Sdump(storedInvoice))
+	}
+
+	// Once the invoice is canceled, its payment request can no longer be
+	// updated.
+	if _, err := db.CancelInvoice(payHash); err != nil {
+		t.Fatalf("unable to cancel invoice: %v", err)
+	}
+	_, err = db.UpdateInvoicePaymentRequest(payHash, []byte("payreq"))
+	if err != ErrInvoiceAlreadyCanceled {
+		t.Fatalf("expected ErrInvoiceAlreadyCanceled, got: %v", err)
+	}
+}
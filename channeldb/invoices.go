@@ -59,6 +59,14 @@ var (
 	//   settleIndexNo => invoiceKey
 	settleIndexBucket = []byte("invoice-settle-index")
 
+	// invoiceHtlcBucket is the name of the sub-bucket within the
+	// invoiceBucket which stores the set of HTLCs that paid each invoice.
+	// The HTLCs are kept apart from the invoice itself, as the invoice
+	// serialization is also embedded within outgoing payment records.
+	//
+	// maps: invoiceKey => htlcs
+	invoiceHtlcBucket = []byte("invoice-htlcs")
+
 	// ErrInvoiceAlreadySettled is returned when the invoice is already
 	// settled.
 	ErrInvoiceAlreadySettled = errors.New("invoice already settled")
@@ -82,6 +90,10 @@ const (
 	// TODO(halseth): determine the max length payment request when field
 	// lengths are final.
 	MaxPaymentRequestSize = 4096
+
+	// MaxInvoiceHTLCs is the maximum number of HTLCs that can be recorded
+	// for a single invoice.
+	MaxInvoiceHTLCs = 1024
 )
 
 // ContractState describes the state the invoice is in.
@@ -130,6 +142,27 @@ type ContractTerm struct {
 	State ContractState
 }
 
+// InvoiceHTLC contains the details of an HTLC that paid an invoice.
+type InvoiceHTLC struct {
+	// ChanID is the short channel ID of the channel the HTLC was received
+	// on.
+	ChanID lnwire.ShortChannelID
+
+	// HtlcID is the index of the HTLC within the channel it was received
+	// on.
+	HtlcID uint64
+
+	// Amt is the amount paid by the HTLC.
+	Amt lnwire.MilliSatoshi
+
+	// AcceptHeight is the block height at which the HTLC was accepted.
+	AcceptHeight uint32
+
+	// ResolveTime is the time at which the HTLC was used to settle the
+	// invoice.
+	ResolveTime time.Time
+}
+
 // Invoice is a payment invoice generated by a payee in order to request
 // payment for some good or service. The inclusion of invoices within Lightning
 // creates a payment work flow for merchants very similar to that of the
@@ -193,6 +226,10 @@ type Invoice struct {
 	// that the invoice originally didn't specify an amount, or the sender
 	// overpaid.
 	AmtPaid lnwire.MilliSatoshi
+
+	// Htlcs is the set of HTLCs that paid this invoice, which merchants
+	// can use to reconcile the payments they received.
+	Htlcs []InvoiceHTLC
 }
 
 func validateInvoice(i *Invoice) error {
@@ -210,6 +247,11 @@ func validateInvoice(i *Invoice) error {
 			"provided was %v", MaxPaymentRequestSize,
 			len(i.PaymentRequest))
 	}
+	if len(i.Htlcs) > MaxInvoiceHTLCs {
+		return fmt.Errorf("max number of htlcs is %v, and invoice "+
+			"with %v htlcs was provided", MaxInvoiceHTLCs,
+			len(i.Htlcs))
+	}
 	return nil
 }
 
@@ -418,6 +460,11 @@ func (d *DB) FetchAllInvoices(pendingOnly bool) ([]Invoice, error) {
 				return err
 			}
 
+			invoice.Htlcs, err = fetchInvoiceHtlcs(k, invoiceB)
+			if err != nil {
+				return err
+			}
+
 			if pendingOnly &&
 				invoice.Terms.State == ContractSettled {
 
@@ -608,11 +655,12 @@ func (d *DB) QueryInvoices(q InvoiceQuery) (InvoiceSlice, error) {
 }
 
 // SettleInvoice attempts to mark an invoice corresponding to the passed
-// payment hash as fully settled. If an invoice matching the passed payment
-// hash doesn't existing within the database, then the action will fail with a
-// "not found" error.
+// payment hash as fully settled by the given HTLC, which is recorded along
+// with the invoice. If an invoice matching the passed payment hash doesn't
+// existing within the database, then the action will fail with a "not found"
+// error.
 func (d *DB) SettleInvoice(paymentHash [32]byte,
-	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
+	htlc InvoiceHTLC) (*Invoice, error) {
 
 	var settledInvoice *Invoice
 	err := d.Update(func(tx *bbolt.Tx) error {
@@ -641,7 +689,7 @@ func (d *DB) SettleInvoice(paymentHash [32]byte,
 		}
 
 		settledInvoice, err = settleInvoice(
-			invoices, settleIndex, invoiceNum, amtPaid,
+			invoices, settleIndex, invoiceNum, htlc,
 		)
 
 		return err
@@ -681,6 +729,51 @@ func (d *DB) CancelInvoice(paymentHash lntypes.Hash) (*Invoice, error) {
 	return canceledInvoice, err
 }
 
+// UpdateInvoicePaymentRequest replaces the payment request of the open
+// invoice corresponding to the passed payment hash. This can be used to
+// re-issue an invoice with updated parameters, such as its routing hints,
+// without altering its payment hash. The updated invoice is returned. If the
+// invoice has already been settled or canceled, then its payment request can
+// no longer be updated.
+func (d *DB) UpdateInvoicePaymentRequest(paymentHash lntypes.Hash,
+	paymentRequest []byte) (*Invoice, error) {
+
+	if len(paymentRequest) > MaxPaymentRequestSize {
+		return nil, fmt.Errorf("max length of payment request is %v, "+
+			"length provided was %v", MaxPaymentRequestSize,
+			len(paymentRequest))
+	}
+
+	var updatedInvoice *Invoice
+	err := d.Update(func(tx *bbolt.Tx) error {
+		invoices, err := tx.CreateBucketIfNotExists(invoiceBucket)
+		if err != nil {
+			return err
+		}
+		invoiceIndex, err := invoices.CreateBucketIfNotExists(
+			invoiceIndexBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Check the invoice index to see if an invoice paying to this
+		// hash exists within the DB.
+		invoiceNum := invoiceIndex.Get(paymentHash[:])
+		if invoiceNum == nil {
+			return ErrInvoiceNotFound
+		}
+
+		updatedInvoice, err = updateInvoicePaymentRequest(
+			invoices, invoiceNum, paymentRequest,
+		)
+
+		return err
+	})
+
+	return updatedInvoice, err
+}
+
 // InvoicesSettledSince can be used by callers to catch up any settled invoices
 // they missed within the settled invoice time series. We'll return all known
 // settled invoice that have a settle index higher than the passed
@@ -856,6 +949,71 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 	return nil
 }
 
+// serializeInvoiceHtlcs serializes the HTLCs that paid an invoice, prefixed by
+// their number.
+func serializeInvoiceHtlcs(w io.Writer, htlcs []InvoiceHTLC) error {
+	if err := WriteElement(w, uint16(len(htlcs))); err != nil {
+		return err
+	}
+
+	for _, htlc := range htlcs {
+		err := WriteElements(
+			w, htlc.ChanID, htlc.HtlcID, htlc.Amt, htlc.AcceptHeight,
+		)
+		if err != nil {
+			return err
+		}
+
+		resolveBytes, err := htlc.ResolveTime.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if err := wire.WriteVarBytes(w, 0, resolveBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeInvoiceHtlcs deserializes the HTLCs that paid an invoice, as
+// serialized by serializeInvoiceHtlcs.
+func deserializeInvoiceHtlcs(r io.Reader) ([]InvoiceHTLC, error) {
+	var numHtlcs uint16
+	if err := ReadElement(r, &numHtlcs); err != nil {
+		return nil, err
+	}
+	if numHtlcs > MaxInvoiceHTLCs {
+		return nil, fmt.Errorf("invoice has %v htlcs, max is %v",
+			numHtlcs, MaxInvoiceHTLCs)
+	}
+
+	var htlcs []InvoiceHTLC
+	for i := uint16(0); i < numHtlcs; i++ {
+		var htlc InvoiceHTLC
+		err := ReadElements(
+			r, &htlc.ChanID, &htlc.HtlcID, &htlc.Amt,
+			&htlc.AcceptHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		resolveBytes, err := wire.ReadVarBytes(r, 0, 300, "resolved")
+		if err != nil {
+			return nil, err
+		}
+		err = htlc.ResolveTime.UnmarshalBinary(resolveBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		htlcs = append(htlcs, htlc)
+	}
+
+	return htlcs, nil
+}
+
 func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
 	invoiceBytes := invoices.Get(invoiceNum)
 	if invoiceBytes == nil {
@@ -863,8 +1021,54 @@ func fetchInvoice(invoiceNum []byte, invoices *bbolt.Bucket) (Invoice, error) {
 	}
 
 	invoiceReader := bytes.NewReader(invoiceBytes)
+	invoice, err := deserializeInvoice(invoiceReader)
+	if err != nil {
+		return invoice, err
+	}
+
+	invoice.Htlcs, err = fetchInvoiceHtlcs(invoiceNum, invoices)
+	if err != nil {
+		return invoice, err
+	}
 
-	return deserializeInvoice(invoiceReader)
+	return invoice, nil
+}
+
+// fetchInvoiceHtlcs retrieves the set of HTLCs that paid the invoice with the
+// given key. Invoices that haven't been paid yet, as well as those settled
+// before HTLCs were recorded, have no entry and return an empty set.
+func fetchInvoiceHtlcs(invoiceNum []byte,
+	invoices *bbolt.Bucket) ([]InvoiceHTLC, error) {
+
+	htlcBucket := invoices.Bucket(invoiceHtlcBucket)
+	if htlcBucket == nil {
+		return nil, nil
+	}
+
+	htlcBytes := htlcBucket.Get(invoiceNum)
+	if htlcBytes == nil {
+		return nil, nil
+	}
+
+	return deserializeInvoiceHtlcs(bytes.NewReader(htlcBytes))
+}
+
+// putInvoiceHtlcs stores the set of HTLCs that paid the invoice with the given
+// key, replacing any that were stored before.
+func putInvoiceHtlcs(invoices *bbolt.Bucket, invoiceNum []byte,
+	htlcs []InvoiceHTLC) error {
+
+	htlcBucket, err := invoices.CreateBucketIfNotExists(invoiceHtlcBucket)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := serializeInvoiceHtlcs(&buf, htlcs); err != nil {
+		return err
+	}
+
+	return htlcBucket.Put(invoiceNum, buf.Bytes())
 }
 
 func deserializeInvoice(r io.Reader) (Invoice, error) {
@@ -929,7 +1133,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 }
 
 func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
-	amtPaid lnwire.MilliSatoshi) (*Invoice, error) {
+	htlc InvoiceHTLC) (*Invoice, error) {
 
 	invoice, err := fetchInvoice(invoiceNum, invoices)
 	if err != nil {
@@ -957,11 +1161,14 @@ func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 		return nil, err
 	}
 
-	invoice.AmtPaid = amtPaid
+	invoice.AmtPaid = htlc.Amt
 	invoice.Terms.State = ContractSettled
 	invoice.SettleDate = time.Now()
 	invoice.SettleIndex = nextSettleSeqNo
 
+	htlc.ResolveTime = invoice.SettleDate
+	invoice.Htlcs = append(invoice.Htlcs, htlc)
+
 	var buf bytes.Buffer
 	if err := serializeInvoice(&buf, &invoice); err != nil {
 		return nil, err
@@ -971,6 +1178,11 @@ func settleInvoice(invoices, settleIndex *bbolt.Bucket, invoiceNum []byte,
 		return nil, err
 	}
 
+	err = putInvoiceHtlcs(invoices, invoiceNum, invoice.Htlcs)
+	if err != nil {
+		return nil, err
+	}
+
 	return &invoice, nil
 }
 
@@ -1002,3 +1214,32 @@ func cancelInvoice(invoices *bbolt.Bucket, invoiceNum []byte) (
 
 	return &invoice, nil
 }
+
+func updateInvoicePaymentRequest(invoices *bbolt.Bucket, invoiceNum []byte,
+	paymentRequest []byte) (*Invoice, error) {
+
+	invoice, err := fetchInvoice(invoiceNum, invoices)
+	if err != nil {
+		return nil, err
+	}
+
+	switch invoice.Terms.State {
+	case ContractSettled:
+		return &invoice, ErrInvoiceAlreadySettled
+	case ContractCanceled:
+		return &invoice, ErrInvoiceAlreadyCanceled
+	}
+
+	invoice.PaymentRequest = paymentRequest
+
+	var buf bytes.Buffer
+	if err := serializeInvoice(&buf, &invoice); err != nil {
+		return nil, err
+	}
+
+	if err := invoices.Put(invoiceNum[:], buf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	return &invoice, nil
+}
@@ -1,6 +1,11 @@
 package channeldb
 
-import "github.com/coreos/bbolt"
+import (
+	"bytes"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/coreos/bbolt"
+)
 
 var (
 	// metaBucket stores all the meta information concerning the state of
@@ -10,6 +15,10 @@ var (
 	// dbVersionKey is a boltdb key and it's used for storing/retrieving
 	// current database version.
 	dbVersionKey = []byte("dbp")
+
+	// chainHashKey is a boltdb key and it's used for storing/retrieving the
+	// genesis hash of the chain the database belongs to.
+	chainHashKey = []byte("chain-hash")
 )
 
 // Meta structure holds the database meta information.
@@ -76,3 +85,28 @@ func putDbVersion(metaBucket *bbolt.Bucket, meta *Meta) error {
 	byteOrder.PutUint32(scratch, meta.DbVersionNumber)
 	return metaBucket.Put(dbVersionKey, scratch)
 }
+
+// CheckChainHash ensures that the database belongs to the chain with the
+// given genesis hash. The first time it's called, the genesis hash is stored,
+// such that opening the database for another network later on, for example
+// as a result of passing the wrong network flag, is refused rather than
+// mixing the state of both networks.
+func (d *DB) CheckChainHash(chainHash chainhash.Hash) error {
+	return d.Update(func(tx *bbolt.Tx) error {
+		metaBucket, err := tx.CreateBucketIfNotExists(metaBucket)
+		if err != nil {
+			return err
+		}
+
+		storedHash := metaBucket.Get(chainHashKey)
+		if storedHash == nil {
+			return metaBucket.Put(chainHashKey, chainHash[:])
+		}
+
+		if !bytes.Equal(storedHash, chainHash[:]) {
+			return ErrChainHashMismatch
+		}
+
+		return nil
+	})
+}
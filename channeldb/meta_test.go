@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"testing"
 
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/coreos/bbolt"
 	"github.com/go-errors/errors"
 )
@@ -427,3 +428,33 @@ func TestMigrationReversion(t *testing.T) {
 			"want: %v, got: %v", ErrDBReversion, err)
 	}
 }
+
+// TestCheckChainHash asserts that the chain hash of the database is stored
+// the first time it's checked, and that checking it against the genesis hash
+// of any other chain fails from then on.
+func TestCheckChainHash(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+
+	testNetHash := *chaincfg.TestNet3Params.GenesisHash
+	if err := cdb.CheckChainHash(testNetHash); err != nil {
+		t.Fatalf("unable to check chain hash: %v", err)
+	}
+
+	// Checking the same chain hash again should succeed.
+	if err := cdb.CheckChainHash(testNetHash); err != nil {
+		t.Fatalf("unable to check chain hash: %v", err)
+	}
+
+	// Checking the chain hash of another network should fail.
+	mainNetHash := *chaincfg.MainNetParams.GenesisHash
+	err = cdb.CheckChainHash(mainNetHash)
+	if err != ErrChainHashMismatch {
+		t.Fatalf("expected ErrChainHashMismatch, got %v", err)
+	}
+}
@@ -2,12 +2,14 @@ package channeldb
 
 import (
 	"bytes"
+	"encoding/binary"
 	"fmt"
 	"math/rand"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/wire"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -133,6 +135,69 @@ func TestOutgoingPaymentSerialization(t *testing.T) {
 	}
 }
 
+// TestOutgoingPaymentLegacyDecoding asserts that payments written with the
+// original serialization format can still be decoded, and that serializing a
+// payment doesn't alter that format.
+func TestOutgoingPaymentLegacyDecoding(t *testing.T) {
+	t.Parallel()
+
+	fakePayment := makeFakePayment()
+	inv := &fakePayment.Invoice
+
+	// Manually construct the original encoding of the payment: the
+	// invoice, without any of the HTLCs that paid it, followed by the
+	// fee, path, time lock and preimage of the payment.
+	var b bytes.Buffer
+	for _, field := range [][]byte{inv.Memo, inv.Receipt, inv.PaymentRequest} {
+		if err := wire.WriteVarBytes(&b, 0, field); err != nil {
+			t.Fatalf("unable to write field: %v", err)
+		}
+	}
+	for _, date := range []time.Time{inv.CreationDate, inv.SettleDate} {
+		dateBytes, err := date.MarshalBinary()
+		if err != nil {
+			t.Fatalf("unable to marshal date: %v", err)
+		}
+		if err := wire.WriteVarBytes(&b, 0, dateBytes); err != nil {
+			t.Fatalf("unable to write date: %v", err)
+		}
+	}
+	b.Write(inv.Terms.PaymentPreimage[:])
+	binary.Write(&b, byteOrder, uint64(inv.Terms.Value))
+	binary.Write(&b, byteOrder, inv.Terms.State)
+	binary.Write(&b, byteOrder, inv.AddIndex)
+	binary.Write(&b, byteOrder, inv.SettleIndex)
+	binary.Write(&b, byteOrder, int64(inv.AmtPaid))
+	binary.Write(&b, byteOrder, uint64(fakePayment.Fee))
+	binary.Write(&b, byteOrder, uint32(len(fakePayment.Path)))
+	for _, hop := range fakePayment.Path {
+		b.Write(hop[:])
+	}
+	binary.Write(&b, byteOrder, fakePayment.TimeLockLength)
+	b.Write(fakePayment.PaymentPreimage[:])
+	legacyBytes := b.Bytes()
+
+	var newBytes bytes.Buffer
+	if err := serializeOutgoingPayment(&newBytes, fakePayment); err != nil {
+		t.Fatalf("unable to serialize outgoing payment: %v", err)
+	}
+	if !bytes.Equal(newBytes.Bytes(), legacyBytes) {
+		t.Fatalf("payment serialization changed: expected %x, got %x",
+			legacyBytes, newBytes.Bytes())
+	}
+
+	newPayment, err := deserializeOutgoingPayment(
+		bytes.NewReader(legacyBytes),
+	)
+	if err != nil {
+		t.Fatalf("unable to deserialize legacy payment: %v", err)
+	}
+	if !reflect.DeepEqual(fakePayment, newPayment) {
+		t.Fatalf("legacy payment mismatch: expected %v, got %v",
+			spew.Sdump(fakePayment), spew.Sdump(newPayment))
+	}
+}
+
 func TestOutgoingPaymentWorkflow(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package channelnotifier
 import (
 	"sync/atomic"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/subscribe"
@@ -20,6 +21,17 @@ type ChannelNotifier struct {
 	chanDB *channeldb.DB
 }
 
+// PendingOpenChannelEvent represents a new event where a new channel has
+// entered a pending open state, as its funding transaction has been
+// negotiated, but hasn't confirmed yet.
+type PendingOpenChannelEvent struct {
+	// ChannelPoint is the channelpoint for the new channel.
+	ChannelPoint *wire.OutPoint
+
+	// PendingChannel is the channel that is pending open.
+	PendingChannel *channeldb.OpenChannel
+}
+
 // OpenChannelEvent represents a new event where a channel goes from pending
 // open to open.
 type OpenChannelEvent struct {
@@ -45,6 +57,28 @@ type ClosedChannelEvent struct {
 	CloseSummary *channeldb.ChannelCloseSummary
 }
 
+// RemoteCloseChannelEvent represents a new event where a close not initiated
+// by us (a cooperative close initiated by the remote party, a remote force
+// close, or a breach) has been detected spending the funding output. It is
+// dispatched as soon as the spend is seen, before the channel is marked fully
+// closed, so that clients can learn of the close prior to it gaining any
+// confirmations.
+type RemoteCloseChannelEvent struct {
+	// CloseSummary is the pending summary of the channel close. The close
+	// type, the settled balance we expect to receive, and the closing txid
+	// are all populated.
+	CloseSummary *channeldb.ChannelCloseSummary
+}
+
+// PendingCloseChannelEvent represents a new event where we've broadcast a
+// closing transaction for a channel, either cooperatively or by force closing
+// it, which hasn't confirmed yet.
+type PendingCloseChannelEvent struct {
+	// CloseSummary is the pending summary of the channel close. The close
+	// type and the closing txid are populated.
+	CloseSummary *channeldb.ChannelCloseSummary
+}
+
 // New creates a new channel notifier. The ChannelNotifier gets channel
 // events from peers and from the chain arbitrator, and dispatches them to
 // its clients.
@@ -85,6 +119,21 @@ func (c *ChannelNotifier) SubscribeChannelEvents() (*subscribe.Client, error) {
 	return c.ntfnServer.Subscribe()
 }
 
+// NotifyPendingOpenChannelEvent notifies the channelEventNotifier goroutine
+// that a new channel is pending.
+func (c *ChannelNotifier) NotifyPendingOpenChannelEvent(chanPoint wire.OutPoint,
+	pendingChan *channeldb.OpenChannel) {
+
+	event := PendingOpenChannelEvent{
+		ChannelPoint:   &chanPoint,
+		PendingChannel: pendingChan,
+	}
+	if err := c.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send pending open channel update: %v",
+			err)
+	}
+}
+
 // NotifyOpenChannelEvent notifies the channelEventNotifier goroutine that a
 // channel has gone from pending open to open.
 func (c *ChannelNotifier) NotifyOpenChannelEvent(chanPoint wire.OutPoint) {
@@ -118,6 +167,45 @@ func (c *ChannelNotifier) NotifyClosedChannelEvent(chanPoint wire.OutPoint) {
 	}
 }
 
+// NotifyPendingCloseChannelEvent notifies the channelEventNotifier goroutine
+// that we've broadcast a closing transaction of the given close type for a
+// channel.
+func (c *ChannelNotifier) NotifyPendingCloseChannelEvent(
+	channel *channeldb.OpenChannel, closingTxid chainhash.Hash,
+	closeType channeldb.ClosureType) {
+
+	summary := &channeldb.ChannelCloseSummary{
+		ChanPoint:   channel.FundingOutpoint,
+		ShortChanID: channel.ShortChanID(),
+		ChainHash:   channel.ChainHash,
+		ClosingTXID: closingTxid,
+		RemotePub:   channel.IdentityPub,
+		Capacity:    channel.Capacity,
+		CloseType:   closeType,
+		IsPending:   true,
+	}
+
+	// Send the pending close event to all channel event subscribers.
+	event := PendingCloseChannelEvent{CloseSummary: summary}
+	if err := c.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send pending close channel update: %v",
+			err)
+	}
+}
+
+// NotifyRemoteCloseEvent notifies the channelEventNotifier goroutine that a
+// closing transaction for a channel has been detected for a close that wasn't
+// initiated by us.
+func (c *ChannelNotifier) NotifyRemoteCloseEvent(
+	summary *channeldb.ChannelCloseSummary) {
+
+	// Send the remote close event to all channel event subscribers.
+	event := RemoteCloseChannelEvent{CloseSummary: summary}
+	if err := c.ntfnServer.SendUpdate(event); err != nil {
+		log.Warnf("Unable to send remote close channel update: %v", err)
+	}
+}
+
 // NotifyActiveChannelEvent notifies the channelEventNotifier goroutine that a
 // channel is active.
 func (c *ChannelNotifier) NotifyActiveChannelEvent(chanPoint wire.OutPoint) {
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+// chanReopenRequest describes a channel that should be opened with the same
+// peer once the cooperative close of one of our channels has confirmed. This
+// allows channels to be resized, or to have their fee rate renegotiated, with
+// a single command.
+type chanReopenRequest struct {
+	// chanPoint is the channel point of the channel being closed.
+	chanPoint wire.OutPoint
+
+	// peer is the public key of the peer to reopen the channel with.
+	peer *btcec.PublicKey
+
+	// localAmt is the amount to commit to the new channel. If zero, the
+	// balance returned to us by the close is committed, minus the fee of
+	// the funding transaction.
+	localAmt btcutil.Amount
+
+	// private indicates whether the new channel should be announced to
+	// the network.
+	private bool
+
+	// feePref is the fee preference used for the funding transaction of
+	// the new channel.
+	feePref sweep.FeePreference
+}
+
+// chanReopenerConfig houses the dependencies of the chanReopener.
+type chanReopenerConfig struct {
+	// ChainHash is the hash of the chain the channels are opened on.
+	ChainHash chainhash.Hash
+
+	// SubscribeChannelEvents returns a subscription to the channel events
+	// dispatched by the channel notifier.
+	SubscribeChannelEvents func() (*subscribe.Client, error)
+
+	// NotifyWhenOnline sends the peer with the given public key over the
+	// passed channel once it is connected to us.
+	NotifyWhenOnline func(peerKey *btcec.PublicKey,
+		peerChan chan<- lnpeer.Peer)
+
+	// OpenChannel initiates the funding workflow of a new channel.
+	OpenChannel func(req *openChanReq) (chan *lnrpc.OpenStatusUpdate,
+		chan error)
+
+	// FeeEstimator is used to determine the fee rate of the funding
+	// transaction of the new channels.
+	FeeEstimator chainfee.Estimator
+}
+
+// chanReopener automatically reopens a channel with the same peer after a
+// cooperative close that was requested for the channel to be reopened. The
+// new channel is opened once the closing transaction has confirmed, such that
+// the funds returned to us by the close can be reused.
+//
+// NOTE: The pending reopen requests are only kept in memory, so they're lost
+// if we restart before the closing transaction has confirmed.
+type chanReopener struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg chanReopenerConfig
+
+	// pending maps the channel points of the channels being closed to
+	// their reopen request.
+	pending    map[wire.OutPoint]*chanReopenRequest
+	pendingMtx sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newChanReopener creates a new chanReopener from the given config.
+func newChanReopener(cfg chanReopenerConfig) *chanReopener {
+	return &chanReopener{
+		cfg:     cfg,
+		pending: make(map[wire.OutPoint]*chanReopenRequest),
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start subscribes to the channel events, and starts reopening the channels
+// whose close confirms.
+func (c *chanReopener) Start() error {
+	var err error
+	c.started.Do(func() {
+		var sub *subscribe.Client
+		sub, err = c.cfg.SubscribeChannelEvents()
+		if err != nil {
+			return
+		}
+
+		c.wg.Add(1)
+		go c.eventHandler(sub)
+	})
+
+	return err
+}
+
+// Stop stops the chanReopener, dropping all pending reopen requests.
+func (c *chanReopener) Stop() {
+	c.stopped.Do(func() {
+		close(c.quit)
+		c.wg.Wait()
+	})
+}
+
+// RegisterReopen registers a request to reopen a channel once the given
+// channel's cooperative close has confirmed.
+func (c *chanReopener) RegisterReopen(req *chanReopenRequest) error {
+	c.pendingMtx.Lock()
+	defer c.pendingMtx.Unlock()
+
+	if _, ok := c.pending[req.chanPoint]; ok {
+		return fmt.Errorf("reopen of channel %v already registered",
+			req.chanPoint)
+	}
+	c.pending[req.chanPoint] = req
+
+	return nil
+}
+
+// CancelReopen cancels the reopen request of the given channel, if any. This
+// should be called if the channel fails to be closed.
+func (c *chanReopener) CancelReopen(chanPoint wire.OutPoint) {
+	c.pendingMtx.Lock()
+	delete(c.pending, chanPoint)
+	c.pendingMtx.Unlock()
+}
+
+// eventHandler waits for the close of the channels with a pending reopen
+// request to confirm, and reopens them.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (c *chanReopener) eventHandler(sub *subscribe.Client) {
+	defer c.wg.Done()
+	defer sub.Cancel()
+
+	for {
+		select {
+		case e := <-sub.Updates():
+			event, ok := e.(channelnotifier.ClosedChannelEvent)
+			if !ok {
+				continue
+			}
+
+			summary := event.CloseSummary
+
+			c.pendingMtx.Lock()
+			req, ok := c.pending[summary.ChanPoint]
+			delete(c.pending, summary.ChanPoint)
+			c.pendingMtx.Unlock()
+
+			if !ok {
+				continue
+			}
+
+			// We'll only reopen the channel after a cooperative
+			// close, as the funds are otherwise time locked.
+			if summary.CloseType != channeldb.CooperativeClose {
+				srvrLog.Infof("Not reopening ChannelPoint(%v) "+
+					"closed with type %v", req.chanPoint,
+					summary.CloseType)
+				continue
+			}
+
+			c.wg.Add(1)
+			go c.reopen(req, summary.SettledBalance)
+
+		case <-sub.Quit():
+			return
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// reopen opens a new channel according to the given reopen request, once the
+// peer is online. settledBalance is the balance returned to us by the close
+// of the previous channel.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (c *chanReopener) reopen(req *chanReopenRequest,
+	settledBalance btcutil.Amount) {
+
+	defer c.wg.Done()
+
+	feeRate, err := sweep.DetermineFeePerKw(c.cfg.FeeEstimator, req.feePref)
+	if err != nil {
+		srvrLog.Errorf("Unable to reopen ChannelPoint(%v): unable to "+
+			"determine fee rate: %v", req.chanPoint, err)
+		return
+	}
+
+	// If no amount was specified, we'll reuse the funds returned to us by
+	// the close. As those are held in a single output, we'll leave enough
+	// of it to pay for a funding transaction spending it.
+	localAmt := req.localAmt
+	if localAmt == 0 {
+		var weightEstimate input.TxWeightEstimator
+		weightEstimate.AddP2WKHInput()
+		weightEstimate.AddP2WSHOutput()
+		weightEstimate.AddP2WKHOutput()
+
+		fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+		localAmt = settledBalance - fee
+	}
+
+	if localAmt < minChanFundingSize || localAmt > maxFundingAmount {
+		srvrLog.Errorf("Unable to reopen ChannelPoint(%v): invalid "+
+			"channel size %v", req.chanPoint, localAmt)
+		return
+	}
+
+	// The peer may have disconnected after the close, so we'll wait for
+	// it to come back online.
+	peerChan := make(chan lnpeer.Peer, 1)
+	c.cfg.NotifyWhenOnline(req.peer, peerChan)
+
+	select {
+	case <-peerChan:
+	case <-c.quit:
+		return
+	}
+
+	srvrLog.Infof("Reopening ChannelPoint(%v) with peer %x: amt=%v, "+
+		"fee_rate=%v", req.chanPoint, req.peer.SerializeCompressed(),
+		localAmt, feeRate)
+
+	updateChan, errChan := c.cfg.OpenChannel(&openChanReq{
+		targetPubkey:    req.peer,
+		chainHash:       c.cfg.ChainHash,
+		localFundingAmt: localAmt,
+		fundingFeePerKw: feeRate,
+		private:         req.private,
+		minConfs:        1,
+	})
+
+	// We'll only wait for the funding transaction to be broadcast, the
+	// rest of the funding flow being handled by the funding manager.
+	select {
+	case update := <-updateChan:
+		pending := update.GetChanPending()
+		if pending == nil {
+			return
+		}
+
+		txid, err := chainhash.NewHash(pending.Txid)
+		if err != nil {
+			return
+		}
+		srvrLog.Infof("Reopened ChannelPoint(%v) as ChannelPoint(%v:%d)",
+			req.chanPoint, txid, pending.OutputIndex)
+
+	case err := <-errChan:
+		srvrLog.Errorf("Unable to reopen ChannelPoint(%v): %v",
+			req.chanPoint, err)
+
+	case <-c.quit:
+	}
+}
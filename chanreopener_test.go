@@ -0,0 +1,154 @@
+// +build !rpctest
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+// TestChanReopener asserts that the chanReopener only reopens the channels it
+// was requested to once their cooperative close confirms, reusing the funds
+// returned by the close if no amount was specified.
+func TestChanReopener(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = chainfee.SatPerKWeight(1000)
+
+	ntfnServer := subscribe.NewServer()
+	if err := ntfnServer.Start(); err != nil {
+		t.Fatalf("unable to start notification server: %v", err)
+	}
+	defer ntfnServer.Stop()
+
+	openReqs := make(chan *openChanReq, 1)
+	reopener := newChanReopener(chanReopenerConfig{
+		SubscribeChannelEvents: ntfnServer.Subscribe,
+		NotifyWhenOnline: func(_ *btcec.PublicKey,
+			peerChan chan<- lnpeer.Peer) {
+
+			peerChan <- nil
+		},
+		OpenChannel: func(req *openChanReq) (
+			chan *lnrpc.OpenStatusUpdate, chan error) {
+
+			openReqs <- req
+			return make(chan *lnrpc.OpenStatusUpdate),
+				make(chan error)
+		},
+		FeeEstimator: chainfee.NewStaticEstimator(feeRate, 0),
+	})
+	if err := reopener.Start(); err != nil {
+		t.Fatalf("unable to start reopener: %v", err)
+	}
+	defer reopener.Stop()
+
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	peer := privKey.PubKey()
+
+	chanPoint := func(i uint32) wire.OutPoint {
+		return wire.OutPoint{Index: i}
+	}
+
+	register := func(i uint32, amt btcutil.Amount) {
+		err := reopener.RegisterReopen(&chanReopenRequest{
+			chanPoint: chanPoint(i),
+			peer:      peer,
+			localAmt:  amt,
+			private:   true,
+			feePref:   sweep.FeePreference{FeeRate: feeRate},
+		})
+		if err != nil {
+			t.Fatalf("unable to register reopen: %v", err)
+		}
+	}
+
+	sendClose := func(i uint32, closeType channeldb.ClosureType) {
+		err := ntfnServer.SendUpdate(channelnotifier.ClosedChannelEvent{
+			CloseSummary: &channeldb.ChannelCloseSummary{
+				ChanPoint:      chanPoint(i),
+				CloseType:      closeType,
+				SettledBalance: 500000,
+			},
+		})
+		if err != nil {
+			t.Fatalf("unable to send close event: %v", err)
+		}
+	}
+
+	assertReopen := func(expectedAmt btcutil.Amount) {
+		t.Helper()
+
+		select {
+		case req := <-openReqs:
+			if !req.targetPubkey.IsEqual(peer) {
+				t.Fatalf("channel reopened with wrong peer")
+			}
+			if req.localFundingAmt != expectedAmt {
+				t.Fatalf("expected channel of %v, got %v",
+					expectedAmt, req.localFundingAmt)
+			}
+			if req.fundingFeePerKw != feeRate {
+				t.Fatalf("expected fee rate %v, got %v",
+					feeRate, req.fundingFeePerKw)
+			}
+			if !req.private {
+				t.Fatalf("expected private channel")
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("channel not reopened")
+		}
+	}
+
+	// Registering the same channel twice should fail.
+	register(0, 0)
+	err = reopener.RegisterReopen(&chanReopenRequest{
+		chanPoint: chanPoint(0),
+		peer:      peer,
+	})
+	if err == nil {
+		t.Fatalf("expected registering reopen twice to fail")
+	}
+
+	// Channels we weren't requested to reopen, or that were force closed,
+	// or whose reopen was canceled, shouldn't be reopened. We'll then send
+	// the cooperative close of the registered channel, which should be
+	// the first one reopened, reusing the funds returned by the close.
+	register(1, 0)
+	register(2, 0)
+	reopener.CancelReopen(chanPoint(2))
+
+	sendClose(3, channeldb.CooperativeClose)
+	sendClose(1, channeldb.LocalForceClose)
+	sendClose(2, channeldb.CooperativeClose)
+	sendClose(0, channeldb.CooperativeClose)
+
+	var weightEstimate input.TxWeightEstimator
+	weightEstimate.AddP2WKHInput()
+	weightEstimate.AddP2WSHOutput()
+	weightEstimate.AddP2WKHOutput()
+	fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+
+	assertReopen(500000 - fee)
+
+	// Finally, a channel reopened with a specific amount should use it.
+	register(4, 200000)
+	sendClose(4, channeldb.CooperativeClose)
+	assertReopen(200000)
+}
@@ -152,7 +152,7 @@ var sendCoinsCommand = cli.Command{
 	Send amt coins in satoshis to the BASE58 encoded bitcoin address addr.
 
 	Fees used when sending the transaction can be specified via the --conf_target, or
-	--sat_per_byte optional flags.
+	--sat_per_vbyte optional flags.
 
 	Positional arguments and flags can be used interchangeably but not at the same time!
 	`,
@@ -180,14 +180,59 @@ var sendCoinsCommand = cli.Command{
 		},
 		cli.Int64Flag{
 			Name: "sat_per_byte",
-			Usage: "(optional) a manual fee expressed in " +
+			Usage: "Deprecated, use sat_per_vbyte instead. " +
+				"(optional) a manual fee expressed in " +
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/vbyte that should be used when crafting " +
+				"the transaction",
+		},
+		txLabelFlag,
+		minConfsFlag,
 	},
 	Action: actionDecorator(sendCoins),
 }
 
+var (
+	txLabelFlag = cli.StringFlag{
+		Name:  "label",
+		Usage: "(optional) a label for the transaction",
+	}
+
+	minConfsFlag = cli.Uint64Flag{
+		Name: "min_confs",
+		Usage: "(optional) the minimum number of confirmations " +
+			"each one of the inputs used for the transaction " +
+			"must satisfy, 0 allowing unconfirmed inputs to be " +
+			"used",
+		Value: 1,
+	}
+)
+
+// checkFeeFlags ensures that at most one of the fee related flags of the
+// on-chain send commands is set.
+func checkFeeFlags(ctx *cli.Context) error {
+	var numSet int
+	for _, flag := range []string{
+		"conf_target", "sat_per_byte", "sat_per_vbyte",
+	} {
+		if ctx.IsSet(flag) {
+			numSet++
+		}
+	}
+
+	if numSet > 1 {
+		return fmt.Errorf("only one of conf_target, sat_per_byte " +
+			"and sat_per_vbyte should be set")
+	}
+
+	return nil
+}
+
 func sendCoins(ctx *cli.Context) error {
 	var (
 		addr string
@@ -201,9 +246,8 @@ func sendCoins(ctx *cli.Context) error {
 		return nil
 	}
 
-	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
-		return fmt.Errorf("either conf_target or sat_per_byte should be " +
-			"set, but not both")
+	if err := checkFeeFlags(ctx); err != nil {
+		return err
 	}
 
 	switch {
@@ -237,12 +281,17 @@ func sendCoins(ctx *cli.Context) error {
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	minConfs := int32(ctx.Uint64("min_confs"))
 	req := &lnrpc.SendCoinsRequest{
-		Addr:       addr,
-		Amount:     amt,
-		TargetConf: int32(ctx.Int64("conf_target")),
-		SatPerByte: ctx.Int64("sat_per_byte"),
-		SendAll:    ctx.Bool("sweepall"),
+		Addr:             addr,
+		Amount:           amt,
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerByte:       ctx.Int64("sat_per_byte"),
+		SendAll:          ctx.Bool("sweepall"),
+		SatPerVbyte:      ctx.Uint64("sat_per_vbyte"),
+		Label:            ctx.String("label"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -378,7 +427,7 @@ var sendManyCommand = cli.Command{
 	Name:      "sendmany",
 	Category:  "On-chain",
 	Usage:     "Send bitcoin on-chain to multiple addresses.",
-	ArgsUsage: "send-json-string [--conf_target=N] [--sat_per_byte=P]",
+	ArgsUsage: "send-json-string [--conf_target=N] [--sat_per_vbyte=P]",
 	Description: `
 	Create and broadcast a transaction paying the specified amount(s) to the passed address(es).
 
@@ -395,9 +444,19 @@ var sendManyCommand = cli.Command{
 		},
 		cli.Int64Flag{
 			Name: "sat_per_byte",
-			Usage: "(optional) a manual fee expressed in sat/byte that should be " +
-				"used when crafting the transaction",
+			Usage: "Deprecated, use sat_per_vbyte instead. " +
+				"(optional) a manual fee expressed in " +
+				"sat/byte that should be used when crafting " +
+				"the transaction",
 		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/vbyte that should be used when crafting " +
+				"the transaction",
+		},
+		txLabelFlag,
+		minConfsFlag,
 	},
 	Action: actionDecorator(sendMany),
 }
@@ -410,19 +469,23 @@ func sendMany(ctx *cli.Context) error {
 		return err
 	}
 
-	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
-		return fmt.Errorf("either conf_target or sat_per_byte should be " +
-			"set, but not both")
+	if err := checkFeeFlags(ctx); err != nil {
+		return err
 	}
 
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
+	minConfs := int32(ctx.Uint64("min_confs"))
 	txid, err := client.SendMany(ctxb, &lnrpc.SendManyRequest{
-		AddrToAmount: amountToAddr,
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		AddrToAmount:     amountToAddr,
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		SatPerByte:       ctx.Int64("sat_per_byte"),
+		SatPerVbyte:      ctx.Uint64("sat_per_vbyte"),
+		Label:            ctx.String("label"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
 	})
 	if err != nil {
 		return err
@@ -478,6 +541,50 @@ func connectPeer(ctx *cli.Context) error {
 	return nil
 }
 
+var checkPeerCommand = cli.Command{
+	Name:     "checkpeer",
+	Category: "Peers",
+	Usage: "Check whether a connection to a remote peer can be " +
+		"established.",
+	ArgsUsage: "<pubkey>@host",
+	Description: `
+	Attempt to connect to the target peer, carry out the transport
+	handshake and exchange init messages with it, without establishing a
+	full peer connection. The response reports the stage at which the
+	attempt failed, if it did, along with the time in microseconds each
+	stage took. This is useful to debug why a connection to a peer can't
+	be established. Peers we're currently connected to can't be checked.`,
+	Action: actionDecorator(checkPeer),
+}
+
+func checkPeer(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	targetAddress := ctx.Args().First()
+	splitAddr := strings.Split(targetAddress, "@")
+	if len(splitAddr) != 2 {
+		return fmt.Errorf("target address expected in format: " +
+			"pubkey@host:port")
+	}
+
+	req := &lnrpc.CheckPeerConnectivityRequest{
+		Addr: &lnrpc.LightningAddress{
+			Pubkey: splitAddr[0],
+			Host:   splitAddr[1],
+		},
+	}
+
+	resp, err := client.CheckPeerConnectivity(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var disconnectCommand = cli.Command{
 	Name:      "disconnect",
 	Category:  "Peers",
@@ -521,6 +628,72 @@ func disconnectPeer(ctx *cli.Context) error {
 	return nil
 }
 
+var drainPeerCommand = cli.Command{
+	Name:      "drainpeer",
+	Category:  "Peers",
+	Usage:     "Drain the channels with a peer of their pending HTLCs.",
+	ArgsUsage: "<pubkey>",
+	Description: `
+	Stops forwarding new HTLCs to or from the channels with the given peer,
+	while letting their in-flight HTLCs resolve. The number of HTLCs
+	pending on each channel is printed each time it changes, and the
+	command returns once all channels with the peer are quiescent. At that
+	point, they can be closed, or lnd restarted, without failing payments.
+
+	The draining state is lost on restart. Use the --resume flag to resume
+	forwarding HTLCs through the channels with the peer.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "node_key",
+			Usage: "The hex-encoded compressed public key of " +
+				"the peer to drain the channels of",
+		},
+		cli.BoolFlag{
+			Name: "resume",
+			Usage: "stop draining the peer, resuming forwarding " +
+				"through its channels",
+		},
+	},
+	Action: actionDecorator(drainPeer),
+}
+
+func drainPeer(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var pubKey string
+	switch {
+	case ctx.IsSet("node_key"):
+		pubKey = ctx.String("node_key")
+	case ctx.Args().Present():
+		pubKey = ctx.Args().First()
+	default:
+		return fmt.Errorf("must specify target public key")
+	}
+
+	req := &lnrpc.DrainPeerRequest{
+		PubKey: pubKey,
+		Resume: ctx.Bool("resume"),
+	}
+
+	stream, err := client.DrainPeer(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
+
 // TODO(roasbeef): change default number of confirmations
 var openChannelCommand = cli.Command{
 	Name:     "openchannel",
@@ -608,6 +781,20 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
+		cli.StringFlag{
+			Name: "close_address",
+			Usage: "(optional) an address to enforce payout of our " +
+				"funds to on cooperative close. Note that if this " +
+				"value is set on channel open, you will *not* be " +
+				"able to cooperatively close to a different address.",
+		},
+		cli.StringFlag{
+			Name: "pending_chan_id",
+			Usage: "(optional) a hex-encoded, unique identifier of " +
+				"32 random bytes for the funding flow, which can " +
+				"be used to cancel it using cancelfunding before " +
+				"the funding transaction is broadcast",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -633,6 +820,17 @@ func openChannel(ctx *cli.Context) error {
 		MinHtlcMsat:    ctx.Int64("min_htlc_msat"),
 		RemoteCsvDelay: uint32(ctx.Uint64("remote_csv_delay")),
 		MinConfs:       int32(ctx.Uint64("min_confs")),
+		CloseAddress:   ctx.String("close_address"),
+	}
+
+	if ctx.IsSet("pending_chan_id") {
+		req.PendingChanId, err = hex.DecodeString(
+			ctx.String("pending_chan_id"),
+		)
+		if err != nil {
+			return fmt.Errorf("unable to decode pending chan id: %v",
+				err)
+		}
 	}
 
 	switch {
@@ -768,6 +966,123 @@ func openChannel(ctx *cli.Context) error {
 	}
 }
 
+var cancelFundingCommand = cli.Command{
+	Name:      "cancelfunding",
+	Category:  "Channels",
+	Usage:     "Cancel a pending channel open.",
+	ArgsUsage: "pending_chan_id",
+	Description: `
+	Cancel the funding flow of a channel we initiated, identified by the
+	pending channel ID that was passed to openchannel. The funding flow can
+	only be canceled as long as the funding transaction hasn't been
+	broadcast yet.`,
+	Action: actionDecorator(cancelFunding),
+}
+
+func cancelFunding(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if !ctx.Args().Present() {
+		return cli.ShowCommandHelp(ctx, "cancelfunding")
+	}
+
+	pendingChanID, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to decode pending chan id: %v", err)
+	}
+
+	req := &lnrpc.FundingTransitionMsg{
+		Cancel: &lnrpc.FundingCancel{
+			PendingChanId: pendingChanID,
+		},
+	}
+
+	resp, err := client.FundingStateStep(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var bumpFundingFeeCommand = cli.Command{
+	Name:      "bumpfundingfee",
+	Category:  "Channels",
+	Usage:     "Bump the fee of an unconfirmed funding transaction.",
+	ArgsUsage: "funding_txid [output_index]",
+	Description: `
+	Bump the fee of the unconfirmed funding transaction of a pending
+	channel we initiated, by spending its change output in a child
+	transaction that pays for the fee of both. The fee rate to bump to can
+	be specified either as a confirmation target or as a manual fee rate.
+	Subsequent calls chain further children, taking the fee already paid
+	into account.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of the " +
+				"funding transaction",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks the funding " +
+				"transaction should confirm in",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that the funding transaction should " +
+				"be bumped to",
+		},
+	},
+	Action: actionDecorator(bumpFundingFee),
+}
+
+func bumpFundingFee(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "bumpfundingfee")
+		return nil
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should be " +
+			"set, but not both")
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.FundingTransitionMsg{
+		Bump: &lnrpc.FundingBump{
+			ChanPoint:  channelPoint,
+			TargetConf: int32(ctx.Int64("conf_target")),
+			SatPerByte: ctx.Int64("sat_per_byte"),
+		},
+	}
+
+	resp, err := client.FundingStateStep(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 // TODO(roasbeef): also allow short relative channel ID.
 
 var closeChannelCommand = cli.Command{
@@ -787,6 +1102,12 @@ var closeChannelCommand = cli.Command{
 	--sat_per_byte arguments. This will be the starting value used during
 	fee negotiation. This is optional.
 
+	A cooperatively closed channel can be reopened with the same peer once
+	the closing transaction has confirmed (--reopen), which allows resizing
+	a channel with a single command. The new channel reuses the funds
+	returned by the close, unless another amount is specified via the
+	--reopen_local_amt argument.
+
 	To view which funding_txids/output_indexes can be used for a channel close,
 	see the channel_point values within the listchannels command output.
 	The format for a channel_point is 'funding_txid:output_index'.`,
@@ -827,6 +1148,17 @@ var closeChannelCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
+		cli.BoolFlag{
+			Name: "reopen",
+			Usage: "reopen a channel with the same peer once the " +
+				"cooperative closure has confirmed",
+		},
+		cli.Int64Flag{
+			Name: "reopen_local_amt",
+			Usage: "(optional) the number of satoshis to commit " +
+				"to the reopened channel, defaults to the " +
+				"funds returned by the close",
+		},
 	},
 	Action: actionDecorator(closeChannel),
 }
@@ -848,10 +1180,12 @@ func closeChannel(ctx *cli.Context) error {
 
 	// TODO(roasbeef): implement time deadline within server
 	req := &lnrpc.CloseChannelRequest{
-		ChannelPoint: channelPoint,
-		Force:        ctx.Bool("force"),
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		ChannelPoint:   channelPoint,
+		Force:          ctx.Bool("force"),
+		TargetConf:     int32(ctx.Int64("conf_target")),
+		SatPerByte:     ctx.Int64("sat_per_byte"),
+		Reopen:         ctx.Bool("reopen"),
+		ReopenLocalAmt: ctx.Int64("reopen_local_amt"),
 	}
 
 	// After parsing the request, we'll spin up a goroutine that will
@@ -1709,6 +2043,7 @@ var getInfoCommand = cli.Command{
 type chain struct {
 	Chain   string `json:"chain"`
 	Network string `json:"network"`
+	Synced  bool   `json:"synced"`
 }
 
 func getInfo(ctx *cli.Context) error {
@@ -1727,28 +2062,35 @@ func getInfo(ctx *cli.Context) error {
 		chains[i] = chain{
 			Chain:   c.Chain,
 			Network: c.Network,
+			Synced:  c.Synced,
 		}
 	}
 
 	// We print a struct that mimics the proto definition of GetInfoResponse
 	// but has a better ordering for the same list of fields.
 	printJSON(struct {
-		Version             string   `json:"version"`
-		IdentityPubkey      string   `json:"identity_pubkey"`
-		Alias               string   `json:"alias"`
-		NumPendingChannels  uint32   `json:"num_pending_channels"`
-		NumActiveChannels   uint32   `json:"num_active_channels"`
-		NumInactiveChannels uint32   `json:"num_inactive_channels"`
-		NumPeers            uint32   `json:"num_peers"`
-		BlockHeight         uint32   `json:"block_height"`
-		BlockHash           string   `json:"block_hash"`
-		BestHeaderTimestamp int64    `json:"best_header_timestamp"`
-		SyncedToChain       bool     `json:"synced_to_chain"`
-		Testnet             bool     `json:"testnet"`
-		Chains              []chain  `json:"chains"`
-		Uris                []string `json:"uris"`
+		Version             string           `json:"version"`
+		CommitHash          string           `json:"commit_hash"`
+		BuildTags           []string         `json:"build_tags"`
+		IdentityPubkey      string           `json:"identity_pubkey"`
+		Alias               string           `json:"alias"`
+		NumPendingChannels  uint32           `json:"num_pending_channels"`
+		NumActiveChannels   uint32           `json:"num_active_channels"`
+		NumInactiveChannels uint32           `json:"num_inactive_channels"`
+		NumPeers            uint32           `json:"num_peers"`
+		BlockHeight         uint32           `json:"block_height"`
+		BlockHash           string           `json:"block_hash"`
+		BestHeaderTimestamp int64            `json:"best_header_timestamp"`
+		SyncedToChain       bool             `json:"synced_to_chain"`
+		SyncedToGraph       bool             `json:"synced_to_graph"`
+		Testnet             bool             `json:"testnet"`
+		Chains              []chain          `json:"chains"`
+		Uris                []string         `json:"uris"`
+		Features            []*lnrpc.Feature `json:"features"`
 	}{
 		Version:             resp.Version,
+		CommitHash:          resp.CommitHash,
+		BuildTags:           resp.BuildTags,
 		IdentityPubkey:      resp.IdentityPubkey,
 		Alias:               resp.Alias,
 		NumPendingChannels:  resp.NumPendingChannels,
@@ -1759,13 +2101,105 @@ func getInfo(ctx *cli.Context) error {
 		BlockHash:           resp.BlockHash,
 		BestHeaderTimestamp: resp.BestHeaderTimestamp,
 		SyncedToChain:       resp.SyncedToChain,
+		SyncedToGraph:       resp.SyncedToGraph,
 		Testnet:             resp.Testnet,
 		Chains:              chains,
 		Uris:                resp.Uris,
+		Features:            resp.Features,
 	})
 	return nil
 }
 
+var getRecoveryInfoCommand = cli.Command{
+	Name:  "getrecoveryinfo",
+	Usage: "Display information about an ongoing recovery attempt.",
+	Description: `
+	Display information about an ongoing wallet recovery attempt: whether
+	the wallet was started in recovery mode, whether the recovery has
+	finished, and the progress made so far, ranging from 0 to 1.
+
+	A recovery is started by providing a non-zero recovery window when
+	restoring a wallet from its seed, or when unlocking it. Starting lnd
+	with --reset-wallet-transactions forces a rescan of the chain from the
+	wallet's birthday.`,
+	Action: actionDecorator(getRecoveryInfo),
+}
+
+func getRecoveryInfo(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetRecoveryInfoRequest{}
+	resp, err := client.GetRecoveryInfo(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var getHealthCommand = cli.Command{
+	Name:  "gethealth",
+	Usage: "Display the health of lnd's subsystems.",
+	Description: `
+	Display the health of the subsystems that recover from panics, such as
+	the gossiper, the breach arbiter and the channel links. A subsystem
+	that panicked is reported as degraded, along with the number of times
+	it panicked, the number of times it was restarted and the diagnostics
+	of its last panic.`,
+	Action: actionDecorator(getHealth),
+}
+
+func getHealth(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetHealthRequest{}
+	resp, err := client.GetHealth(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var chainBackendEventsCommand = cli.Command{
+	Name:  "chainbackendevents",
+	Usage: "Stream the events of the chain backend monitor.",
+	Description: `
+	Stream the events of the chain backend monitor, which are sent when
+	the active chain backend stalls, when it recovers, and when lnd fails
+	over to the fallback backend. The command runs until interrupted.`,
+	Action: actionDecorator(chainBackendEvents),
+}
+
+func chainBackendEvents(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ChainBackendEventSubscription{}
+	stream, err := client.SubscribeChainBackendEvents(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
+
 var pendingChannelsCommand = cli.Command{
 	Name:     "pendingchannels",
 	Category: "Channels",
@@ -1886,7 +2320,7 @@ func closedChannels(ctx *cli.Context) error {
 		LocalForce:      ctx.Bool("local_force"),
 		RemoteForce:     ctx.Bool("remote_force"),
 		Breach:          ctx.Bool("breach"),
-		FundingCanceled: ctx.Bool("funding_cancelled"),
+		FundingCanceled: ctx.Bool("funding_canceled"),
 		Abandoned:       ctx.Bool("abandoned"),
 	}
 
@@ -1968,6 +2402,16 @@ var sendPaymentCommand = cli.Command{
 				"use for the first hop of the payment",
 			Value: 0,
 		},
+		cli.StringFlag{
+			Name: "last_hop",
+			Usage: "pubkey of the last hop (penultimate node in " +
+				"the path) to route through for this payment",
+		},
+		cli.Uint64Flag{
+			Name: "cltv_limit",
+			Usage: "the maximum number of blocks the funds of " +
+				"the payment may be locked up for",
+		},
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -2051,6 +2495,11 @@ func sendPayment(ctx *cli.Context) error {
 		return err
 	}
 
+	lastHop, err := hex.DecodeString(ctx.String("last_hop"))
+	if err != nil {
+		return fmt.Errorf("unable to decode last hop: %v", err)
+	}
+
 	// If a payment request was provided, we can exit early since all of the
 	// details of the payment are encoded within the request.
 	if ctx.IsSet("pay_req") {
@@ -2065,6 +2514,8 @@ func sendPayment(ctx *cli.Context) error {
 			Amt:            ctx.Int64("amt"),
 			FeeLimit:       feeLimit,
 			OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+			LastHopPubkey:  lastHop,
+			CltvLimit:      uint32(ctx.Uint64("cltv_limit")),
 		}
 
 		return sendPaymentRequest(client, req)
@@ -2106,9 +2557,12 @@ func sendPayment(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.SendRequest{
-		Dest:     destNode,
-		Amt:      amount,
-		FeeLimit: feeLimit,
+		Dest:           destNode,
+		Amt:            amount,
+		FeeLimit:       feeLimit,
+		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		LastHopPubkey:  lastHop,
+		CltvLimit:      uint32(ctx.Uint64("cltv_limit")),
 	}
 
 	if ctx.Bool("debug_send") && (ctx.IsSet("payment_hash") || args.Present()) {
@@ -2217,6 +2671,16 @@ var payInvoiceCommand = cli.Command{
 				"use for the first hop of the payment",
 			Value: 0,
 		},
+		cli.StringFlag{
+			Name: "last_hop",
+			Usage: "pubkey of the last hop (penultimate node in " +
+				"the path) to route through for this payment",
+		},
+		cli.Uint64Flag{
+			Name: "cltv_limit",
+			Usage: "the maximum number of blocks the funds of " +
+				"the payment may be locked up for",
+		},
 		cli.BoolFlag{
 			Name:  "force, f",
 			Usage: "will skip payment request confirmation",
@@ -2245,6 +2709,11 @@ func payInvoice(ctx *cli.Context) error {
 		return err
 	}
 
+	lastHop, err := hex.DecodeString(ctx.String("last_hop"))
+	if err != nil {
+		return fmt.Errorf("unable to decode last hop: %v", err)
+	}
+
 	if !ctx.Bool("force") {
 		err = confirmPayReq(ctx, client, payReq)
 		if err != nil {
@@ -2257,6 +2726,8 @@ func payInvoice(ctx *cli.Context) error {
 		Amt:            ctx.Int64("amt"),
 		FeeLimit:       feeLimit,
 		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		LastHopPubkey:  lastHop,
+		CltvLimit:      uint32(ctx.Uint64("cltv_limit")),
 	}
 	return sendPaymentRequest(client, req)
 }
@@ -2592,6 +3063,74 @@ func lookupInvoice(ctx *cli.Context) error {
 	return nil
 }
 
+var refreshInvoiceHintsCommand = cli.Command{
+	Name:     "refreshinvoicehints",
+	Category: "Payments",
+	Usage: "Re-issue an open invoice with fresh routing hints for " +
+		"private channels.",
+	ArgsUsage: "rhash",
+	Description: `
+	Re-issue the payment request of an open, unexpired invoice with a
+	fresh set of routing hints for our private channels. This is useful
+	once the routing hints of an invoice have gone stale, as reported by
+	the stale_hints field of the invoice, due to a private channel being
+	closed or its routing policy being changed. The payment hash and all
+	other terms of the invoice remain unchanged, only a new payment
+	request is issued.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "rhash",
+			Usage: "the 32 byte payment hash of the invoice to refresh, " +
+				"the hash should be a hex-encoded string",
+		},
+	},
+	Action: actionDecorator(refreshInvoiceHints),
+}
+
+func refreshInvoiceHints(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		rHash []byte
+		err   error
+	)
+
+	switch {
+	case ctx.IsSet("rhash"):
+		rHash, err = hex.DecodeString(ctx.String("rhash"))
+	case ctx.Args().Present():
+		rHash, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("rhash argument missing")
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to decode rhash argument: %v", err)
+	}
+
+	req := &lnrpc.PaymentHash{
+		RHash: rHash,
+	}
+
+	resp, err := client.RefreshInvoiceHints(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		RHash    string `json:"r_hash"`
+		PayReq   string `json:"pay_req"`
+		AddIndex uint64 `json:"add_index"`
+	}{
+		RHash:    hex.EncodeToString(resp.RHash),
+		PayReq:   resp.PaymentRequest,
+		AddIndex: resp.AddIndex,
+	})
+
+	return nil
+}
+
 var listInvoicesCommand = cli.Command{
 	Name:     "listinvoices",
 	Category: "Payments",
@@ -2747,6 +3286,104 @@ func listPayments(ctx *cli.Context) error {
 	return nil
 }
 
+var exportPaymentProofCommand = cli.Command{
+	Name:     "exportpaymentproof",
+	Category: "Payments",
+	Usage:    "Export the proof of a settled payment.",
+	Description: `
+	Export the proof of a settled payment, which can be verified offline by
+	a third party with verifypaymentproof. The proof consists of the payment
+	request signed by the payee, which commits to the payment hash, along
+	with the preimage of that hash which the payee only reveals on
+	settlement. Only payments made to a payment request can be proven.`,
+	ArgsUsage: "payment_hash",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "payment_hash",
+			Usage: "the hash of the settled payment",
+		},
+	},
+	Action: actionDecorator(exportPaymentProof),
+}
+
+func exportPaymentProof(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var paymentHash string
+	switch {
+	case ctx.IsSet("payment_hash"):
+		paymentHash = ctx.String("payment_hash")
+	case ctx.Args().Present():
+		paymentHash = ctx.Args().First()
+	default:
+		return fmt.Errorf("payment hash argument missing")
+	}
+
+	req := &lnrpc.ExportPaymentProofRequest{
+		PaymentHash: paymentHash,
+	}
+
+	proof, err := client.ExportPaymentProof(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(proof)
+	return nil
+}
+
+var verifyPaymentProofCommand = cli.Command{
+	Name:     "verifypaymentproof",
+	Category: "Payments",
+	Usage:    "Verify the proof of a payment.",
+	Description: `
+	Verify a payment proof in the format output by exportpaymentproof. The
+	proof can either be passed as a positional argument, or be read from
+	stdin by passing '-'. The verification doesn't rely on any state of the
+	node, so the proof of any payment can be verified.`,
+	ArgsUsage: "proof",
+	Action:    actionDecorator(verifyPaymentProof),
+}
+
+func verifyPaymentProof(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var jsonProof string
+	switch {
+	case ctx.Args().Present() && ctx.Args().First() != "-":
+		jsonProof = ctx.Args().First()
+
+	case ctx.Args().Present() && ctx.Args().First() == "-":
+		b, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		if len(b) == 0 {
+			return fmt.Errorf("payment proof is empty")
+		}
+
+		jsonProof = string(b)
+
+	default:
+		return fmt.Errorf("payment proof argument missing")
+	}
+
+	proof := &lnrpc.PaymentProof{}
+	if err := jsonpb.UnmarshalString(jsonProof, proof); err != nil {
+		return fmt.Errorf("unable to unmarshal payment proof: %v", err)
+	}
+
+	resp, err := client.VerifyPaymentProof(context.Background(), proof)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var getChanInfoCommand = cli.Command{
 	Name:     "getchaninfo",
 	Category: "Channels",
@@ -2877,6 +3514,44 @@ var queryRoutesCommand = cli.Command{
 			Usage: "(optional) number of blocks the last hop has to reveal " +
 				"the preimage",
 		},
+		cli.StringSliceFlag{
+			Name: "ignore_node",
+			Usage: "(optional) the hex-encoded public key of a " +
+				"node the routes must not pass through, can " +
+				"be specified multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: "ignore_edge",
+			Usage: "(optional) a channel the routes must not use " +
+				"in the given direction, formatted as " +
+				"chan_id:direction, with a direction of 1 " +
+				"meaning the channel is used from the node " +
+				"with the larger public key, can be specified " +
+				"multiple times",
+		},
+		cli.StringFlag{
+			Name: "pay_req",
+			Usage: "(optional) a payment request whose routing " +
+				"hints are used to reach the destination " +
+				"through private channels",
+		},
+		cli.Uint64Flag{
+			Name: "outgoing_chan_id",
+			Usage: "(optional) short channel id of the outgoing " +
+				"channel the routes must take to the first hop",
+		},
+		cli.StringFlag{
+			Name: "last_hop",
+			Usage: "(optional) the hex-encoded public key of the " +
+				"last hop the routes must reach the " +
+				"destination through",
+		},
+		cli.Uint64Flag{
+			Name: "cltv_limit",
+			Usage: "(optional) the maximum number of blocks the " +
+				"total time lock of the routes may exceed the " +
+				"current height by",
+		},
 	},
 	Action: actionDecorator(queryRoutes),
 }
@@ -2921,12 +3596,58 @@ func queryRoutes(ctx *cli.Context) error {
 		return err
 	}
 
+	lastHop, err := hex.DecodeString(ctx.String("last_hop"))
+	if err != nil {
+		return fmt.Errorf("unable to decode last hop: %v", err)
+	}
+
 	req := &lnrpc.QueryRoutesRequest{
 		PubKey:         dest,
 		Amt:            amt,
 		FeeLimit:       feeLimit,
 		NumRoutes:      int32(ctx.Int("num_max_routes")),
 		FinalCltvDelta: int32(ctx.Int("final_cltv_delta")),
+		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		LastHopPubkey:  lastHop,
+		CltvLimit:      uint32(ctx.Uint64("cltv_limit")),
+	}
+
+	for _, node := range ctx.StringSlice("ignore_node") {
+		pubKey, err := hex.DecodeString(node)
+		if err != nil {
+			return fmt.Errorf("unable to decode ignored node: %v",
+				err)
+		}
+		req.IgnoredNodes = append(req.IgnoredNodes, pubKey)
+	}
+
+	for _, edge := range ctx.StringSlice("ignore_edge") {
+		parts := strings.Split(edge, ":")
+		if len(parts) != 2 || (parts[1] != "0" && parts[1] != "1") {
+			return fmt.Errorf("ignored edge %v must be formatted "+
+				"as chan_id:direction", edge)
+		}
+
+		chanID, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode ignored edge: %v",
+				err)
+		}
+
+		req.IgnoredEdges = append(req.IgnoredEdges, &lnrpc.EdgeLocator{
+			ChannelId:        chanID,
+			DirectionReverse: parts[1] == "1",
+		})
+	}
+
+	if ctx.IsSet("pay_req") {
+		payReq, err := client.DecodePayReq(ctxb, &lnrpc.PayReqString{
+			PayReq: ctx.String("pay_req"),
+		})
+		if err != nil {
+			return err
+		}
+		req.RouteHints = payReq.RouteHints
 	}
 
 	route, err := client.QueryRoutes(ctxb, req)
@@ -3002,6 +3723,58 @@ func debugLevel(ctx *cli.Context) error {
 	return nil
 }
 
+var verifyChanStateCommand = cli.Command{
+	Name:     "verifychanstate",
+	Category: "Channels",
+	Usage:    "Verify the revocation state of open channels.",
+	Description: `
+	Verifies that all the revocation secrets revealed by the remote party
+	of a channel can be derived from our revocation store, as these are
+	required to punish the broadcast of a revoked commitment. If no channel
+	point is specified, all open channels are verified.
+
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "[funding_txid [output_index]]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of " +
+				"the funding transaction",
+		},
+	},
+	Action: actionDecorator(verifyChanState),
+}
+
+func verifyChanState(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.VerifyChanStateRequest{}
+
+	// Only a single channel is verified if its channel point was
+	// provided.
+	if ctx.NArg() != 0 || ctx.NumFlags() != 0 {
+		channelPoint, err := parseChannelPoint(ctx)
+		if err != nil {
+			return err
+		}
+		req.ChannelPoint = channelPoint
+	}
+
+	resp, err := client.VerifyChanState(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var decodePayReqCommand = cli.Command{
 	Name:        "decodepayreq",
 	Category:    "Payments",
@@ -3252,6 +4025,18 @@ var updateChannelPolicyCommand = cli.Command{
 			Usage: "the CLTV delta that will be applied to all " +
 				"forwarded HTLCs",
 		},
+		cli.Uint64Flag{
+			Name: "min_htlc_msat",
+			Usage: "if set, the min HTLC size that will be " +
+				"applied to all forwarded HTLCs. If unset, " +
+				"the min HTLC is left unchanged.",
+		},
+		cli.Uint64Flag{
+			Name: "max_htlc_msat",
+			Usage: "if set, the max HTLC size that will be " +
+				"applied to all forwarded HTLCs. If unset, " +
+				"the max HTLC is left unchanged.",
+		},
 		cli.StringFlag{
 			Name: "chan_point",
 			Usage: "The channel whose fee policy should be " +
@@ -3353,6 +4138,12 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		BaseFeeMsat:   baseFee,
 		FeeRate:       feeRate,
 		TimeLockDelta: uint32(timeLockDelta),
+		MaxHtlcMsat:   ctx.Uint64("max_htlc_msat"),
+	}
+
+	if ctx.IsSet("min_htlc_msat") {
+		req.MinHtlcMsat = ctx.Uint64("min_htlc_msat")
+		req.MinHtlcMsatSpecified = true
 	}
 
 	if chanPoint != nil {
@@ -3374,6 +4165,131 @@ func updateChannelPolicy(ctx *cli.Context) error {
 	return nil
 }
 
+var listFeeClampsCommand = cli.Command{
+	Name:     "feeclamps",
+	Category: "On-chain",
+	Usage: "Display the fee rate bounds of each consumer of the fee " +
+		"estimator.",
+	Description: `
+	Returns the minimum and maximum fee rates, in sat/vbyte, within which
+	the fee rates estimated for each consumer of the fee estimator are
+	clamped. A zero bound means the fee rates aren't clamped on that side.
+	Fee clamps can be updated using the updatefeeclamp command.`,
+	Action: actionDecorator(listFeeClamps),
+}
+
+func listFeeClamps(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListFeeClampsRequest{}
+	resp, err := client.ListFeeClamps(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateFeeClampCommand = cli.Command{
+	Name:     "updatefeeclamp",
+	Category: "On-chain",
+	Usage: "Update the fee rate bounds of a consumer of the fee " +
+		"estimator.",
+	ArgsUsage: "consumer min_sat_per_vbyte max_sat_per_vbyte",
+	Description: `
+	Updates the minimum and maximum fee rates, in sat/vbyte, within which
+	the fee rates estimated for the given consumer of the fee estimator
+	are clamped. A zero bound disables clamping on that side. The consumer
+	is one of: funding, coop_close, sweep, justice or commit.
+
+	The new bounds apply immediately, but are lost on restart.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "consumer",
+			Usage: "the consumer of the fee estimator to update " +
+				"the bounds of (funding|coop_close|sweep|" +
+				"justice|commit)",
+		},
+		cli.Uint64Flag{
+			Name:  "min_sat_per_vbyte",
+			Usage: "the minimum fee rate in sat/vbyte",
+		},
+		cli.Uint64Flag{
+			Name:  "max_sat_per_vbyte",
+			Usage: "the maximum fee rate in sat/vbyte",
+		},
+	},
+	Action: actionDecorator(updateFeeClamp),
+}
+
+func updateFeeClamp(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		consumerStr string
+		minFeeRate  uint64
+		maxFeeRate  uint64
+		err         error
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("consumer"):
+		consumerStr = ctx.String("consumer")
+	case args.Present():
+		consumerStr = args.First()
+		args = args.Tail()
+	default:
+		return fmt.Errorf("consumer argument missing")
+	}
+
+	consumer, ok := lnrpc.FeeConsumer_value[strings.ToUpper(consumerStr)]
+	if !ok {
+		return fmt.Errorf("unknown fee consumer: %v", consumerStr)
+	}
+
+	switch {
+	case ctx.IsSet("min_sat_per_vbyte"):
+		minFeeRate = ctx.Uint64("min_sat_per_vbyte")
+	case args.Present():
+		minFeeRate, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode "+
+				"min_sat_per_vbyte: %v", err)
+		}
+		args = args.Tail()
+	}
+
+	switch {
+	case ctx.IsSet("max_sat_per_vbyte"):
+		maxFeeRate = ctx.Uint64("max_sat_per_vbyte")
+	case args.Present():
+		maxFeeRate, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode "+
+				"max_sat_per_vbyte: %v", err)
+		}
+	}
+
+	req := &lnrpc.FeeClamp{
+		Consumer:       lnrpc.FeeConsumer(consumer),
+		MinSatPerVbyte: minFeeRate,
+		MaxSatPerVbyte: maxFeeRate,
+	}
+	resp, err := client.UpdateFeeClamp(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var forwardingHistoryCommand = cli.Command{
 	Name:      "fwdinghistory",
 	Category:  "Payments",
@@ -3488,3 +4404,245 @@ func forwardingHistory(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var switchStatsCommand = cli.Command{
+	Name:     "switchstats",
+	Category: "Payments",
+	Usage:    "Query the performance counters of the htlcswitch.",
+	Description: `
+	Query the runtime performance counters of the htlcswitch. For each
+	link, the number of HTLCs forwarded and commitment signatures sent are
+	returned, along with their average rate per second since the link's
+	counters were created. Additionally, percentiles of the end-to-end
+	latency of settled HTLCs are returned in microseconds.`,
+	Action: actionDecorator(switchStats),
+}
+
+func switchStats(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SwitchStatsRequest{}
+	resp, err := client.SwitchStats(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listHtlcsCommand = cli.Command{
+	Name:     "listhtlcs",
+	Category: "Payments",
+	Usage:    "List the HTLCs in flight across all open channels.",
+	Description: `
+	List every HTLC in flight across all open channels. For each HTLC, the
+	switch circuit it's part of is returned along with its state in the
+	commitment state machine of its channel, which can be used to diagnose
+	why a payment appears to be stuck.`,
+	Action: actionDecorator(listHtlcs),
+}
+
+func listHtlcs(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListHtlcsRequest{}
+	resp, err := client.ListHtlcs(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var updateNodeAnnouncementCommand = cli.Command{
+	Name:     "updatenodeannouncement",
+	Category: "Peers",
+	Usage:    "Update the alias, color and addresses of the node.",
+	Description: `
+	Update the alias, color and advertised addresses of our node
+	announcement, then sign and broadcast the new announcement to the
+	network. The changes aren't persisted across restarts, as the
+	announcement is then rebuilt from the configuration.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "alias",
+			Usage: "(optional) the new alias of the node",
+		},
+		cli.StringFlag{
+			Name: "color",
+			Usage: "(optional) the new color of the node, in the " +
+				"form #RRGGBB",
+		},
+		cli.StringSliceFlag{
+			Name: "add_address",
+			Usage: "(optional) an address, in the form host:port, " +
+				"to start advertising, can be specified " +
+				"multiple times",
+		},
+		cli.StringSliceFlag{
+			Name: "remove_address",
+			Usage: "(optional) an address, in the form host:port, " +
+				"to stop advertising, can be specified " +
+				"multiple times",
+		},
+	},
+	Action: actionDecorator(updateNodeAnnouncement),
+}
+
+func updateNodeAnnouncement(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.UpdateNodeAnnouncementRequest{
+		Alias:           ctx.String("alias"),
+		Color:           ctx.String("color"),
+		AddAddresses:    ctx.StringSlice("add_address"),
+		RemoveAddresses: ctx.StringSlice("remove_address"),
+	}
+	if req.Alias == "" && req.Color == "" && len(req.AddAddresses) == 0 &&
+		len(req.RemoveAddresses) == 0 {
+
+		return cli.ShowCommandHelp(ctx, "updatenodeannouncement")
+	}
+
+	resp, err := client.UpdateNodeAnnouncement(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listBannedPeersCommand = cli.Command{
+	Name:     "listbannedpeers",
+	Category: "Peers",
+	Usage:    "List the peers banned for sending invalid gossip.",
+	Description: `
+	List the peers we've banned for sending us too many gossip messages
+	failing signature validation, along with when and why they were banned.
+	All connections with banned peers are refused until their ban expires.`,
+	Action: actionDecorator(listBannedPeers),
+}
+
+func listBannedPeers(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListBannedPeersRequest{}
+	resp, err := client.ListBannedPeers(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var stateCommand = cli.Command{
+	Name:     "state",
+	Category: "Startup",
+	Usage:    "Get the current state of lnd.",
+	Description: `
+	Get the current state of lnd: whether the wallet still has to be created
+	or unlocked, or how far lnd has progressed through its startup. This
+	doesn't require a macaroon, and is available before the wallet is
+	unlocked.
+
+	If --follow is set, every state change is printed until lnd shuts
+	down.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "follow",
+			Usage: "keep printing the state of lnd as it changes",
+		},
+	},
+	Action: actionDecorator(getState),
+}
+
+func getState(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getStateServiceClient(ctx)
+	defer cleanUp()
+
+	if !ctx.Bool("follow") {
+		resp, err := client.GetState(ctxb, &lnrpc.GetStateRequest{})
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+		return nil
+	}
+
+	stream, err := client.SubscribeState(
+		ctxb, &lnrpc.SubscribeStateRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(resp)
+	}
+}
+
+var unbanPeerCommand = cli.Command{
+	Name:      "unbanpeer",
+	Category:  "Peers",
+	Usage:     "Lift the ban of a peer banned for sending invalid gossip.",
+	ArgsUsage: "pubkey",
+	Description: `
+	Lift the ban of a peer we've banned for sending us too many invalid
+	gossip messages, allowing us to connect with it again.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pubkey",
+			Usage: "the identity pubkey of the peer to unban",
+		},
+	},
+	Action: actionDecorator(unbanPeer),
+}
+
+func unbanPeer(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var pubKey string
+	switch {
+	case ctx.IsSet("pubkey"):
+		pubKey = ctx.String("pubkey")
+	case ctx.Args().Present():
+		pubKey = ctx.Args().First()
+	default:
+		return fmt.Errorf("pubkey argument missing")
+	}
+
+	req := &lnrpc.UnbanPeerRequest{
+		PubKey: pubKey,
+	}
+	resp, err := client.UnbanPeer(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
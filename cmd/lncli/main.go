@@ -23,6 +23,7 @@ import (
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/encoding/gzip"
 )
 
 const (
@@ -58,6 +59,16 @@ func getWalletUnlockerClient(ctx *cli.Context) (lnrpc.WalletUnlockerClient, func
 	return lnrpc.NewWalletUnlockerClient(conn), cleanUp
 }
 
+func getStateServiceClient(ctx *cli.Context) (lnrpc.StateClient, func()) {
+	conn := getClientConn(ctx, true)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return lnrpc.NewStateClient(conn), cleanUp
+}
+
 func getClient(ctx *cli.Context) (lnrpc.LightningClient, func()) {
 	conn := getClientConn(ctx, false)
 
@@ -131,6 +142,17 @@ func getClientConn(ctx *cli.Context, skipMacaroons bool) *grpc.ClientConn {
 		// Now we append the macaroon credentials to the dial options.
 		cred := macaroons.NewMacaroonCredential(constrainedMac)
 		opts = append(opts, grpc.WithPerRPCCredentials(cred))
+
+		// If a confirmation macaroon was specified, we'll send it along
+		// as the second factor required by destructive calls. It's only
+		// valid for a short time, so that it can't be reused by an
+		// attacker later on.
+		if ctx.GlobalString("confirmmacaroonpath") != "" {
+			confirmCred := getConfirmCredential(ctx)
+			opts = append(
+				opts, grpc.WithPerRPCCredentials(confirmCred),
+			)
+		}
 	}
 
 	// We need to use a custom dialer so we can also connect to unix sockets
@@ -139,6 +161,14 @@ func getClientConn(ctx *cli.Context, skipMacaroons bool) *grpc.ClientConn {
 	opts = append(opts, grpc.WithDialer(genericDialer))
 	opts = append(opts, grpc.WithDefaultCallOptions(maxMsgRecvSize))
 
+	// If requested, we'll ask lnd to compress its responses, which is
+	// worthwhile for large ones over slow links.
+	if ctx.GlobalBool("compress") {
+		opts = append(opts, grpc.WithDefaultCallOptions(
+			grpc.UseCompressor(gzip.Name),
+		))
+	}
+
 	conn, err := grpc.Dial(ctx.GlobalString("rpcserver"), opts...)
 	if err != nil {
 		fatal(fmt.Errorf("unable to connect to RPC server: %v", err))
@@ -147,6 +177,35 @@ func getClientConn(ctx *cli.Context, skipMacaroons bool) *grpc.ClientConn {
 	return conn
 }
 
+// getConfirmCredential reads the confirmation macaroon specified on the
+// command line, and returns it as a credential valid for the configured
+// confirmation timeout.
+func getConfirmCredential(ctx *cli.Context) credentials.PerRPCCredentials {
+	macPath := cleanAndExpandPath(ctx.GlobalString("confirmmacaroonpath"))
+	macBytes, err := ioutil.ReadFile(macPath)
+	if err != nil {
+		fatal(fmt.Errorf("unable to read confirmation macaroon: %v",
+			err))
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err = mac.UnmarshalBinary(macBytes); err != nil {
+		fatal(fmt.Errorf("unable to decode confirmation macaroon: %v",
+			err))
+	}
+
+	constrainedMac, err := macaroons.AddConstraints(
+		mac, macaroons.TimeoutConstraint(
+			ctx.GlobalInt64("confirmtimeout"),
+		),
+	)
+	if err != nil {
+		fatal(err)
+	}
+
+	return macaroons.NewConfirmMacaroonCredential(constrainedMac)
+}
+
 // extractPathArgs parses the TLS certificate and macaroon paths from the
 // command.
 func extractPathArgs(ctx *cli.Context) (string, string, error) {
@@ -162,7 +221,7 @@ func extractPathArgs(ctx *cli.Context) (string, string, error) {
 
 	network := strings.ToLower(ctx.GlobalString("network"))
 	switch network {
-	case "mainnet", "testnet", "regtest", "simnet":
+	case "mainnet", "testnet", "regtest", "simnet", "signet":
 	default:
 		return "", "", fmt.Errorf("unknown network: %v", network)
 	}
@@ -251,6 +310,26 @@ func main() {
 			Name:  "macaroonip",
 			Usage: "if set, lock macaroon to specific IP address",
 		},
+		cli.StringFlag{
+			Name: "confirmmacaroonpath",
+			Usage: "path to the confirmation macaroon required by " +
+				"destructive calls if lnd runs with " +
+				"--confirmdestructive",
+		},
+		cli.BoolFlag{
+			Name: "compress",
+			Usage: "request responses compressed with gzip, " +
+				"useful for large responses such as the ones " +
+				"of describegraph and fwdinghistory over slow " +
+				"links",
+		},
+		cli.Int64Flag{
+			Name:  "confirmtimeout",
+			Value: 60,
+			Usage: "validity time of the confirmation macaroon in " +
+				"seconds, lnd rejects confirmation macaroons " +
+				"valid for more than 300 seconds",
+		},
 	}
 	app.Commands = []cli.Command{
 		createCommand,
@@ -262,7 +341,11 @@ func main() {
 		listUnspentCommand,
 		connectCommand,
 		disconnectCommand,
+		drainPeerCommand,
+		checkPeerCommand,
 		openChannelCommand,
+		cancelFundingCommand,
+		bumpFundingFeeCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
@@ -270,22 +353,29 @@ func main() {
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
+		getRecoveryInfoCommand,
+		getHealthCommand,
+		chainBackendEventsCommand,
 		pendingChannelsCommand,
 		sendPaymentCommand,
 		payInvoiceCommand,
 		sendToRouteCommand,
 		addInvoiceCommand,
 		lookupInvoiceCommand,
+		refreshInvoiceHintsCommand,
 		listInvoicesCommand,
 		listChannelsCommand,
 		closedChannelsCommand,
 		listPaymentsCommand,
+		exportPaymentProofCommand,
+		verifyPaymentProofCommand,
 		describeGraphCommand,
 		getChanInfoCommand,
 		getNodeInfoCommand,
 		queryRoutesCommand,
 		getNetworkInfoCommand,
 		debugLevelCommand,
+		verifyChanStateCommand,
 		decodePayReqCommand,
 		listChainTxnsCommand,
 		stopCommand,
@@ -293,7 +383,15 @@ func main() {
 		verifyMessageCommand,
 		feeReportCommand,
 		updateChannelPolicyCommand,
+		listFeeClampsCommand,
+		updateFeeClampCommand,
 		forwardingHistoryCommand,
+		switchStatsCommand,
+		listHtlcsCommand,
+		updateNodeAnnouncementCommand,
+		listBannedPeersCommand,
+		unbanPeerCommand,
+		stateCommand,
 	}
 
 	// Add any extra autopilot commands determined by build flags.
@@ -22,9 +22,13 @@ import (
 	"github.com/btcsuite/btcutil"
 	flags "github.com/jessevdk/go-flags"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/tor"
@@ -57,6 +61,23 @@ const (
 	defaultMaxLogFileSize           = 10
 	defaultMinBackoff               = time.Second
 	defaultMaxBackoff               = time.Hour
+	defaultCoinSelectionStrategy    = "largest"
+	defaultAcceptorTimeout          = 15 * time.Second
+
+	// defaultChainStallTimeout is the default duration after which the
+	// chain backend is considered stalled if it hasn't returned a new
+	// block. It's chosen such that it's rarely exceeded by the natural
+	// variance of block intervals.
+	defaultChainStallTimeout = time.Hour
+
+	// defaultGossipBanDuration is the default duration for which peers
+	// sending us too many invalid gossip messages are banned.
+	defaultGossipBanDuration = 24 * time.Hour
+
+	// maxChannelCommitInterval is the maximum duration a channel may be
+	// configured to wait for more updates before signing a new
+	// commitment.
+	maxChannelCommitInterval = time.Hour
 
 	defaultTorSOCKSPort            = 9050
 	defaultTorDNSHost              = "soa.nodes.lightning.directory"
@@ -67,6 +88,15 @@ const (
 
 	defaultBroadcastDelta = 10
 
+	defaultNeutrinoMaxPeers     = 8
+	defaultNeutrinoBanDuration  = 5 * time.Second
+	defaultNeutrinoBanThreshold = 100
+
+	// defaultChanReservePercent is the default channel reserve, expressed
+	// as a percentage of the channel capacity, that we require the remote
+	// party to keep.
+	defaultChanReservePercent = 1
+
 	// minTimeLockDelta is the minimum timelock we require for incoming
 	// HTLCs on our channels.
 	minTimeLockDelta = 4
@@ -108,6 +138,7 @@ type chainConfig struct {
 	TestNet3 bool `long:"testnet" description:"Use the test network"`
 	SimNet   bool `long:"simnet" description:"Use the simulation test network"`
 	RegTest  bool `long:"regtest" description:"Use the regression test network"`
+	SigNet   bool `long:"signet" description:"Use the signet test network"`
 
 	DefaultNumChanConfs int                 `long:"defaultchanconfs" description:"The default number of confirmations a channel must have before it's considered open. If this is not set, we will scale the value according to the channel size."`
 	DefaultRemoteDelay  int                 `long:"defaultremotedelay" description:"The default number of blocks we will require our channel counterparty to wait before accessing its funds in case of unilateral close. If this is not set, we will scale the value according to the channel size."`
@@ -154,6 +185,38 @@ type autoPilotConfig struct {
 	MinConfs       int32              `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
 }
 
+type chanPolicyConfig struct {
+	MaxCsvDelay           uint16 `long:"maxcsvdelay" description:"The largest CSV delay that the remote party of an incoming or outgoing channel may require on our funds in case of a unilateral close. Channels proposed with a larger delay are rejected."`
+	MaxReservePercent     uint32 `long:"maxreservepercent" description:"The largest channel reserve that the remote party may require us to keep, expressed as a percentage of the channel capacity. Channels proposed with a larger reserve are rejected."`
+	MinMaxInFlightPercent uint32 `long:"minmaxinflightpercent" description:"The smallest limit on the total value of our HTLCs in flight that the remote party may impose on us, expressed as a percentage of the channel capacity. Channels proposed with a smaller limit are rejected."`
+	ReservePercent        uint32 `long:"reservepercent" description:"The channel reserve we require the remote party to keep, expressed as a percentage of the channel capacity. The dust limit is used instead if it's larger."`
+}
+
+type feeClampConfig struct {
+	FundingMin   uint64 `long:"fundingmin" description:"The minimum fee rate in sat/vbyte estimated for funding transactions"`
+	FundingMax   uint64 `long:"fundingmax" description:"The maximum fee rate in sat/vbyte estimated for funding transactions"`
+	CoopCloseMin uint64 `long:"coopclosemin" description:"The minimum fee rate in sat/vbyte estimated for cooperative close transactions"`
+	CoopCloseMax uint64 `long:"coopclosemax" description:"The maximum fee rate in sat/vbyte estimated for cooperative close transactions"`
+	SweepMin     uint64 `long:"sweepmin" description:"The minimum fee rate in sat/vbyte estimated for sweep transactions"`
+	SweepMax     uint64 `long:"sweepmax" description:"The maximum fee rate in sat/vbyte estimated for sweep transactions"`
+	JusticeMin   uint64 `long:"justicemin" description:"The minimum fee rate in sat/vbyte estimated for justice transactions"`
+	JusticeMax   uint64 `long:"justicemax" description:"The maximum fee rate in sat/vbyte estimated for justice transactions"`
+	CommitMin    uint64 `long:"commitmin" description:"The minimum fee rate in sat/vbyte estimated for commitment transactions"`
+	CommitMax    uint64 `long:"commitmax" description:"The maximum fee rate in sat/vbyte estimated for commitment transactions"`
+}
+
+type htlcSwitchConfig struct {
+	MaxAddsPerSecond   float64 `long:"max-adds-per-second" description:"The sustained rate at which each peer may add HTLCs to be forwarded through our node. HTLCs exceeding it are failed back. Set to 0 to disable the limit."`
+	AddBurst           int     `long:"add-burst" description:"The number of HTLC adds a peer may send in a burst, beyond max-adds-per-second."`
+	MaxPendingForwards int     `long:"max-pending-forwards" description:"The maximum number of HTLCs being forwarded through our node at any time, across all channels. HTLCs exceeding it are failed back. Set to 0 to disable the limit."`
+}
+
+type featureConfig struct {
+	Advertise     []uint16 `long:"advertise" description:"An additional feature bit to advertise to all peers within our init message, e.g. to experiment with a protocol extension. Unknown even bits will cause peers to disconnect from us. Can be specified multiple times."`
+	PeerAdvertise []string `long:"peeradvertise" description:"An additional feature bit to advertise only to a specific peer, in the form <pubkey>:<bit>. Can be specified multiple times."`
+	Require       []uint16 `long:"require" description:"A feature bit the remote peer must advertise before we open a channel with it or accept one from it. Either bit of the feature pair satisfies the requirement. Can be specified multiple times."`
+}
+
 type torConfig struct {
 	Active          bool   `long:"active" description:"Allow outbound and inbound connections to be routed through Tor"`
 	SOCKS           string `long:"socks" description:"The host:port that Tor's exposed SOCKS5 proxy is listening on"`
@@ -187,6 +250,10 @@ type config struct {
 	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
 	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
 
+	ConfirmMacPath       string `long:"confirmmacaroonpath" description:"Path to write the confirmation macaroon, required as a second authentication factor for destructive RPCs if confirmdestructive is set, if it doesn't exist. Must be set along with confirmdestructive, and must not be within the directory of the admin macaroon"`
+	ConfirmDestructive   bool   `long:"confirmdestructive" description:"Require the confirmation macaroon as a second authentication factor for destructive RPCs: force closing and abandoning channels, deleting payments, and sending more than confirmsendthreshold"`
+	ConfirmSendThreshold int64  `long:"confirmsendthreshold" description:"The amount in satoshis above which on-chain and off-chain sends require the confirmation macaroon if confirmdestructive is set"`
+
 	// We'll parse these 'raw' string arguments into real net.Addrs in the
 	// loadConfig function. We need to expose the 'raw' strings so the
 	// command line library can access them.
@@ -215,40 +282,83 @@ type config struct {
 	UnsafeReplay       bool `long:"unsafe-replay" description:"Causes a link to replay the adds on its commitment txn after starting up, this enables testing of the sphinx replay logic."`
 	MaxPendingChannels int  `long:"maxpendingchannels" description:"The maximum number of incoming pending channels permitted per peer."`
 
+	AcceptorTimeout time.Duration `long:"acceptortimeout" description:"Time after which an RPC channel acceptor will time out and reject the channel if it hasn't yet received a response. Valid time units are {s, m, h}."`
+
 	Bitcoin      *chainConfig    `group:"Bitcoin" namespace:"bitcoin"`
 	BtcdMode     *btcdConfig     `group:"btcd" namespace:"btcd"`
 	BitcoindMode *bitcoindConfig `group:"bitcoind" namespace:"bitcoind"`
 	NeutrinoMode *neutrinoConfig `group:"neutrino" namespace:"neutrino"`
 
+	BitcoindFallback *bitcoindConfig `group:"bitcoind-fallback" namespace:"bitcoind-fallback"`
+
 	Litecoin      *chainConfig    `group:"Litecoin" namespace:"litecoin"`
 	LtcdMode      *btcdConfig     `group:"ltcd" namespace:"ltcd"`
 	LitecoindMode *bitcoindConfig `group:"litecoind" namespace:"litecoind"`
 
 	Autopilot *autoPilotConfig `group:"Autopilot" namespace:"autopilot"`
 
+	ChanPolicy *chanPolicyConfig `group:"chanpolicy" namespace:"chanpolicy"`
+
+	FeeClamps *feeClampConfig `group:"feeclamps" namespace:"feeclamps"`
+
+	HtlcSwitch *htlcSwitchConfig `group:"htlcswitch" namespace:"htlcswitch"`
+
+	Features *featureConfig `group:"features" namespace:"features"`
+
 	Tor *torConfig `group:"Tor" namespace:"tor"`
 
 	SubRPCServers *subRPCServerConfigs `group:"subrpc"`
 
 	Hodl *hodl.Config `group:"hodl" namespace:"hodl"`
 
+	ExperimentalProtocol *lncfg.ExperimentalProtocol `group:"experimental" namespace:"experimental"`
+
 	NoNetBootstrap bool `long:"nobootstrap" description:"If true, then automatic network bootstrapping will not be attempted."`
 
 	NoSeedBackup bool `long:"noseedbackup" description:"If true, NO SEED WILL BE EXPOSED AND THE WALLET WILL BE ENCRYPTED USING THE DEFAULT PASSPHRASE -- EVER. THIS FLAG IS ONLY FOR TESTING AND IS BEING DEPRECATED."`
 
+	WalletUnlockPasswordFile string `long:"wallet-unlock-password-file" description:"The full path to a file containing the password to unlock the wallet with on startup, allowing unattended restarts. Trailing newlines are ignored. If the wallet doesn't exist yet, it still has to be created over RPC. If the wallet can't be unlocked with the password from the file, it has to be unlocked over RPC instead."`
+
+	ResetWalletTransactions bool `long:"reset-wallet-transactions" description:"Removes all transaction history from the on-chain wallet on startup, forcing a full chain rescan starting at the wallet's birthday. Combined with a recovery window, this allows a wallet restored from seed to find all of its on-chain funds. Should be disabled again after a successful rescan to avoid rescanning on every restart of lnd."`
+
 	TrickleDelay             int           `long:"trickledelay" description:"Time in milliseconds between each release of announcements to the network"`
 	ChanEnableTimeout        time.Duration `long:"chan-enable-timeout" description:"The duration that a peer connection must be stable before attempting to send a channel update to reenable or cancel a pending disables of the peer's channels on the network (default: 19m)."`
 	ChanDisableTimeout       time.Duration `long:"chan-disable-timeout" description:"The duration that must elapse after first detecting that an already active channel is actually inactive and sending channel update disabling it to the network. The pending disable can be canceled if the peer reconnects and becomes stable for chan-enable-timeout before the disable update is sent. (default: 20m)"`
 	ChanStatusSampleInterval time.Duration `long:"chan-status-sample-interval" description:"The polling interval between attempts to detect if an active channel has become inactive due to its peer going offline. (default: 1m)"`
 
+	ChannelCommitInterval  time.Duration `long:"channel-commit-interval" description:"The maximum duration a channel waits for more updates to batch into a single commitment before signing the pending ones. A longer interval reduces the number of signatures and disk writes per HTLC under load, at the cost of added latency. Valid time units are {ms, s, m, h}. Maximum 1 hour."`
+	ChannelCommitBatchSize uint32        `long:"channel-commit-batch-size" description:"The number of pending updates after which a channel signs a new commitment without waiting for the channel-commit-interval to elapse."`
+
+	DustThreshold uint64 `long:"dust-threshold" description:"The maximum total value in satoshis of dust HTLCs a channel's commitment may hold. Dust HTLCs don't have an output of their own, and their value is lost to miners if the channel is force closed with them in flight. New dust HTLCs exceeding this threshold are failed. Set to 0 to disable the limit."`
+
+	TimeLockDeltaGracePeriod uint32 `long:"timelockdelta-grace-period" description:"The number of blocks following an increase of a channel's time lock delta during which HTLCs conforming to the previous time lock delta are still forwarded, as senders may be using a stale channel update. Set to 0 to disable the grace period."`
+
 	Alias       string `long:"alias" description:"The node alias. Used as a moniker by peers and intelligence services"`
 	Color       string `long:"color" description:"The color of the node in hex format (i.e. '#3399FF'). Used to customize node appearance in intelligence services"`
 	MinChanSize int64  `long:"minchansize" description:"The smallest channel size (in satoshis) that we should accept. Incoming channels smaller than this will be rejected"`
 
 	NoChanUpdates bool `long:"nochanupdates" description:"If specified, lnd will not request real-time channel updates from connected peers. This option should be used by routing nodes to save bandwidth."`
 
+	NumGraphSyncPeers int `long:"numgraphsyncpeers" description:"The number of peers from which we'll request real-time channel updates. Our active sync peers are periodically rotated, while all other peers only reconcile their known channels with us upon connecting."`
+
+	MaxInvalidGossip uint32 `long:"maxinvalidgossip" description:"The number of gossip messages failing signature validation we'll accept from a peer before banning it. Banned peers are disconnected and any further connections with them are refused. Peers we have channels with are never banned. Set to 0 to never ban peers."`
+
+	GossipBanDuration time.Duration `long:"gossipbanduration" description:"The duration for which peers are banned for sending too many invalid gossip messages. Set to 0 to ban peers until they're unbanned through the UnbanPeer RPC."`
+
 	RejectPush bool `long:"rejectpush" description:"If true, lnd will not accept channel opening requests with non-zero push amounts. This should prevent accidental pushes to merchant nodes."`
 
+	BlockedNodes []string `long:"blockednode" description:"The hex encoded public key of a node to never route payments through, forward HTLCs to or from, accept gossip from, or connect with. A blocked node we have channels with stays connected, but only to update and close those channels. Can be specified multiple times."`
+
+	CoinSelectionStrategy string `long:"coinselectionstrategy" description:"The strategy used to order the wallet's unspent outputs when selecting coins to fund channels. One of {largest, random, smallest}."`
+
+	EnableUpfrontShutdown bool `long:"enable-upfront-shutdown" description:"If true, option upfront shutdown script will be enabled. If peers that we open channels with support this feature, we will automatically set the script to which cooperative closes should be paid out to on channel open. This offers the partial protection of a channel peer disconnecting from us if cooperative close is attempted with a different script."`
+
+	WatchMempool bool `long:"watchmempool" description:"If true, revoked commitment transactions broadcast by our channel peers are detected as soon as they enter the mempool of the chain backend, allowing the justice transaction to be prepared before they confirm. Not supported by the neutrino backend."`
+
+	ChainStallTimeout time.Duration `long:"chainstalltimeout" description:"The duration after which the chain backend is considered stalled if it hasn't returned a new block. If a fallback bitcoind is configured and ahead of the stalled backend, lnd shuts down gracefully to fail over to it once restarted. Set to 0 to disable monitoring. Valid time units are {s, m, h}."`
+
+	FeeURL string `long:"feeurl" description:"Optional URL for external fee estimation. If no URL is specified, the method for fee estimation will depend on the chosen backend and network. The API is expected to return fee rates in sat/kb for a set of confirmation targets, e.g. {\"fee_by_block_target\": {\"2\": 20000, \"6\": 10000}}."`
+
 	net tor.Net
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -263,6 +373,7 @@ type config struct {
 // 	3) Load configuration file overwriting defaults with any specified options
 // 	4) Parse CLI options and overwrite/add any specified options
 func loadConfig() (*config, error) {
+	defaultThrottle := htlcswitch.DefaultThrottleConfig()
 	defaultCfg := config{
 		LndDir:         defaultLndDir,
 		ConfigFile:     defaultConfigFile,
@@ -289,6 +400,7 @@ func loadConfig() (*config, error) {
 			Dir:     defaultBitcoindDir,
 			RPCHost: defaultRPCHost,
 		},
+		BitcoindFallback: &bitcoindConfig{},
 		Litecoin: &chainConfig{
 			MinHTLC:       defaultLitecoinMinHTLCMSat,
 			BaseFee:       defaultLitecoinBaseFeeMSat,
@@ -305,12 +417,19 @@ func loadConfig() (*config, error) {
 			Dir:     defaultLitecoindDir,
 			RPCHost: defaultRPCHost,
 		},
+		NeutrinoMode: &neutrinoConfig{
+			MaxPeers:     defaultNeutrinoMaxPeers,
+			BanDuration:  defaultNeutrinoBanDuration,
+			BanThreshold: defaultNeutrinoBanThreshold,
+		},
 		MaxPendingChannels: defaultMaxPendingChannels,
+		AcceptorTimeout:    defaultAcceptorTimeout,
 		NoSeedBackup:       defaultNoSeedBackup,
 		MinBackoff:         defaultMinBackoff,
 		MaxBackoff:         defaultMaxBackoff,
 		SubRPCServers: &subRPCServerConfigs{
-			SignRPC: &signrpc.Config{},
+			SignRPC:   &signrpc.Config{},
+			RouterRPC: routerrpc.DefaultConfig(),
 		},
 		Autopilot: &autoPilotConfig{
 			MaxChannels:    5,
@@ -322,12 +441,35 @@ func loadConfig() (*config, error) {
 			},
 		},
 		TrickleDelay:             defaultTrickleDelay,
+		NumGraphSyncPeers:        discovery.DefaultNumActiveSyncers,
+		MaxInvalidGossip:         discovery.DefaultMaxInvalidAnnouncements,
+		GossipBanDuration:        defaultGossipBanDuration,
 		ChanStatusSampleInterval: defaultChanStatusSampleInterval,
+		ChainStallTimeout:        defaultChainStallTimeout,
 		ChanEnableTimeout:        defaultChanEnableTimeout,
 		ChanDisableTimeout:       defaultChanDisableTimeout,
+		ChannelCommitInterval:    htlcswitch.DefaultBatchInterval,
+		ChannelCommitBatchSize:   htlcswitch.DefaultBatchSize,
+		DustThreshold: uint64(
+			htlcswitch.DefaultDustThreshold.ToSatoshis(),
+		),
+		TimeLockDeltaGracePeriod: htlcswitch.DefaultTimeLockDeltaGracePeriod,
 		Alias:                    defaultAlias,
 		Color:                    defaultColor,
 		MinChanSize:              int64(minChanFundingSize),
+		CoinSelectionStrategy:    defaultCoinSelectionStrategy,
+		ChanPolicy: &chanPolicyConfig{
+			MaxCsvDelay:       lnwallet.DefaultMaxCsvDelay,
+			MaxReservePercent: lnwallet.DefaultMaxChanReservePercent,
+			ReservePercent:    defaultChanReservePercent,
+		},
+		FeeClamps: &feeClampConfig{},
+		HtlcSwitch: &htlcSwitchConfig{
+			MaxAddsPerSecond:   defaultThrottle.MaxAddsPerSecond,
+			AddBurst:           defaultThrottle.AddBurst,
+			MaxPendingForwards: defaultThrottle.MaxPendingForwards,
+		},
+		Features: &featureConfig{},
 		Tor: &torConfig{
 			SOCKS:   defaultTorSOCKS,
 			DNS:     defaultTorDNS,
@@ -424,6 +566,10 @@ func loadConfig() (*config, error) {
 	cfg.AdminMacPath = cleanAndExpandPath(cfg.AdminMacPath)
 	cfg.ReadMacPath = cleanAndExpandPath(cfg.ReadMacPath)
 	cfg.InvoiceMacPath = cleanAndExpandPath(cfg.InvoiceMacPath)
+	cfg.ConfirmMacPath = cleanAndExpandPath(cfg.ConfirmMacPath)
+	cfg.WalletUnlockPasswordFile = cleanAndExpandPath(
+		cfg.WalletUnlockPasswordFile,
+	)
 	cfg.LogDir = cleanAndExpandPath(cfg.LogDir)
 	cfg.BtcdMode.Dir = cleanAndExpandPath(cfg.BtcdMode.Dir)
 	cfg.LtcdMode.Dir = cleanAndExpandPath(cfg.LtcdMode.Dir)
@@ -464,6 +610,60 @@ func loadConfig() (*config, error) {
 		return nil, err
 	}
 
+	// Ensure that the channel policy percentages are sane. We don't allow
+	// requiring a reserve larger than what the default policy of the
+	// remote party would accept, as our channels would be rejected.
+	if cfg.ChanPolicy.MaxReservePercent > 100 {
+		str := "%s: chanpolicy.maxreservepercent must be at most 100"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.ChanPolicy.MinMaxInFlightPercent > 100 {
+		str := "%s: chanpolicy.minmaxinflightpercent must be at most 100"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	maxReservePercent := uint32(lnwallet.DefaultMaxChanReservePercent)
+	if cfg.ChanPolicy.ReservePercent > maxReservePercent {
+		str := "%s: chanpolicy.reservepercent must be at most %v"
+		err := fmt.Errorf(str, funcName, maxReservePercent)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// The HTLC throttle must allow at least one add at a time when it's
+	// enabled.
+	switch {
+	case cfg.HtlcSwitch.MaxAddsPerSecond < 0:
+		str := "%s: htlcswitch.max-adds-per-second must not be negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+
+	case cfg.HtlcSwitch.MaxAddsPerSecond > 0 && cfg.HtlcSwitch.AddBurst < 1:
+		str := "%s: htlcswitch.add-burst must be at least 1"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+
+	case cfg.HtlcSwitch.MaxPendingForwards < 0:
+		str := "%s: htlcswitch.max-pending-forwards must not be negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Ensure that the coin selection strategy is one we know of.
+	if _, err := lnwallet.ParseCoinSelectionStrategy(
+		cfg.CoinSelectionStrategy,
+	); err != nil {
+		err := fmt.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Ensure that the specified values for the min and max channel size
 	// don't are within the bounds of the normal chan size constraints.
 	if cfg.Autopilot.MinChannelSize < int64(minChanFundingSize) {
@@ -578,6 +778,10 @@ func loadConfig() (*config, error) {
 			str := "%s: regnet mode for litecoin not currently supported"
 			return nil, fmt.Errorf(str, funcName)
 		}
+		if cfg.Litecoin.SigNet {
+			str := "%s: signet mode for litecoin not currently supported"
+			return nil, fmt.Errorf(str, funcName)
+		}
 
 		if cfg.Litecoin.TimeLockDelta < minTimeLockDelta {
 			return nil, fmt.Errorf("timelockdelta must be at least %v",
@@ -685,10 +889,14 @@ func loadConfig() (*config, error) {
 			numNets++
 			activeNetParams = bitcoinSimNetParams
 		}
+		if cfg.Bitcoin.SigNet {
+			numNets++
+			activeNetParams = bitcoinSigNetParams
+		}
 		if numNets > 1 {
-			str := "%s: The mainnet, testnet, regtest, and " +
-				"simnet params can't be used together -- " +
-				"choose one of the four"
+			str := "%s: The mainnet, testnet, regtest, simnet, and " +
+				"signet params can't be used together -- " +
+				"choose one of the five"
 			err := fmt.Errorf(str, funcName)
 			return nil, err
 		}
@@ -697,8 +905,8 @@ func loadConfig() (*config, error) {
 		// know how to initialize the daemon.
 		if numNets == 0 {
 			str := "%s: either --bitcoin.mainnet, or " +
-				"bitcoin.testnet, bitcoin.simnet, or bitcoin.regtest " +
-				"must be specified"
+				"bitcoin.testnet, bitcoin.simnet, bitcoin.regtest, " +
+				"or bitcoin.signet must be specified"
 			err := fmt.Errorf(str, funcName)
 			return nil, err
 		}
@@ -717,6 +925,15 @@ func loadConfig() (*config, error) {
 			return nil, err
 		}
 
+		// Only bitcoind validates the signatures of signet blocks, so
+		// it's the only backend we support for signet.
+		if cfg.Bitcoin.SigNet && cfg.Bitcoin.Node != "bitcoind" {
+			str := "%s: signet is only supported by the bitcoind " +
+				"backend"
+			err := fmt.Errorf(str, funcName)
+			return nil, err
+		}
+
 		if cfg.Bitcoin.TimeLockDelta < minTimeLockDelta {
 			return nil, fmt.Errorf("timelockdelta must be at least %v",
 				minTimeLockDelta)
@@ -746,8 +963,25 @@ func loadConfig() (*config, error) {
 					"credentials for bitcoind: %v", err)
 				return nil, err
 			}
+
+			if cfg.BitcoindFallback.RPCHost != "" {
+				err := validateBitcoindFallback(
+					cfg.BitcoindFallback,
+				)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v",
+						funcName, err)
+				}
+			}
 		case "neutrino":
-			// No need to get RPC parameters.
+			// No need to get RPC parameters, but we'll make sure the
+			// peers we were told to use are valid.
+			err := validateNeutrinoConfig(
+				cfg.NeutrinoMode, activeNetParams.DefaultPort,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", funcName, err)
+			}
 
 		default:
 			str := "%s: only btcd, bitcoind, and neutrino mode " +
@@ -764,6 +998,40 @@ func loadConfig() (*config, error) {
 		registeredChains.RegisterPrimaryChain(bitcoinChain)
 	}
 
+	// A fallback chain backend is only supported for bitcoind.
+	if cfg.BitcoindFallback.RPCHost != "" &&
+		(!cfg.Bitcoin.Active || cfg.Bitcoin.Node != "bitcoind") {
+
+		str := "%s: bitcoind-fallback requires bitcoin.node=bitcoind"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.ChainStallTimeout < 0 {
+		str := "%s: chainstalltimeout must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// The commitment batching parameters must allow updates to be signed
+	// eventually.
+	if cfg.ChannelCommitInterval <= 0 ||
+		cfg.ChannelCommitInterval > maxChannelCommitInterval {
+
+		str := "%s: channel-commit-interval must be positive and at " +
+			"most %v"
+		err := fmt.Errorf(str, funcName, maxChannelCommitInterval)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.ChannelCommitBatchSize == 0 {
+		str := "%s: channel-commit-batch-size must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Ensure that the user didn't attempt to specify negative values for
 	// any of the autopilot params.
 	if cfg.Autopilot.MaxChannels < 0 {
@@ -902,6 +1170,41 @@ func loadConfig() (*config, error) {
 		return nil, err
 	}
 
+	// The confirmation macaroon required for destructive RPCs is a
+	// macaroon itself, so it can't be used with macaroons disabled.
+	if cfg.ConfirmDestructive && cfg.NoMacaroons {
+		return nil, fmt.Errorf("confirmdestructive cannot be used " +
+			"with no-macaroons")
+	}
+
+	// The confirmation macaroon is only a second factor if it isn't
+	// stored along with the other macaroons, so there's no default path
+	// for it.
+	if cfg.ConfirmDestructive {
+		if cfg.ConfirmMacPath == "" {
+			return nil, fmt.Errorf("confirmdestructive requires " +
+				"confirmmacaroonpath to be set")
+		}
+		if filepath.Dir(cfg.ConfirmMacPath) ==
+			filepath.Dir(cfg.AdminMacPath) {
+
+			return nil, fmt.Errorf("confirmmacaroonpath must not " +
+				"be within the directory of the admin macaroon")
+		}
+	}
+
+	if cfg.ConfirmSendThreshold < 0 {
+		return nil, fmt.Errorf("confirmsendthreshold must be " +
+			"non-negative")
+	}
+
+	// With noseedbackup, the wallet is always unlocked with the default
+	// password, so a password file would be ignored.
+	if cfg.NoSeedBackup && cfg.WalletUnlockPasswordFile != "" {
+		return nil, fmt.Errorf("wallet-unlock-password-file cannot " +
+			"be used with noseedbackup")
+	}
+
 	// Add default port to all RPC listener addresses if needed and remove
 	// duplicate addresses.
 	cfg.RPCListeners, err = lncfg.NormalizeAddresses(
@@ -983,6 +1286,11 @@ func loadConfig() (*config, error) {
 			"minbackoff")
 	}
 
+	// Ensure that the router RPC's mission control parameters are sane.
+	if err := cfg.SubRPCServers.RouterRPC.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Finally, ensure that the user's color is correctly formatted,
 	// otherwise the server will not be able to start after the unlocking
 	// the wallet.
@@ -1344,6 +1652,8 @@ func extractBitcoindRPCParams(bitcoindConfigPath string) (string, string, string
 		chainDir = "/testnet4/"
 	case "regtest":
 		chainDir = "/regtest/"
+	case "signet":
+		chainDir = "/signet/"
 	}
 
 	cookie, err := ioutil.ReadFile(dataDir + chainDir + ".cookie")
@@ -1396,6 +1706,63 @@ func checkZMQOptions(zmqBlockHost, zmqTxHost string) error {
 	return nil
 }
 
+// validateBitcoindFallback ensures the config of the fallback bitcoind is
+// complete. Unlike for the primary bitcoind, its credentials and ZMQ options
+// can't be read from a local bitcoin.conf, as it's expected to run on another
+// host.
+func validateBitcoindFallback(cfg *bitcoindConfig) error {
+	if cfg.RPCUser == "" || cfg.RPCPass == "" {
+		return errors.New("bitcoind-fallback.rpcuser and " +
+			"bitcoind-fallback.rpcpass must be set")
+	}
+	if cfg.ZMQPubRawBlock == "" || cfg.ZMQPubRawTx == "" {
+		return errors.New("bitcoind-fallback.zmqpubrawblock and " +
+			"bitcoind-fallback.zmqpubrawtx must be set")
+	}
+
+	return checkZMQOptions(cfg.ZMQPubRawBlock, cfg.ZMQPubRawTx)
+}
+
+// validateNeutrinoConfig ensures the neutrino config is sane, and adds the
+// default port of the active network to the peers specified without one.
+func validateNeutrinoConfig(cfg *neutrinoConfig, defaultPort string) error {
+	// Connecting only to the specified peers and adding peers to the ones
+	// found through DNS seeds are exclusive.
+	if len(cfg.ConnectPeers) > 0 && len(cfg.AddPeers) > 0 {
+		return errors.New("neutrino.connect and neutrino.addpeer " +
+			"cannot be used together")
+	}
+
+	if cfg.MaxPeers < 1 {
+		return errors.New("neutrino.maxpeers must be positive")
+	}
+
+	if cfg.BanDuration < time.Second {
+		return errors.New("neutrino.banduration must be at least " +
+			"1 second")
+	}
+
+	if cfg.BanThreshold == 0 {
+		return errors.New("neutrino.banthreshold must be positive")
+	}
+
+	normalize := func(addrs []string) []string {
+		normalized := make([]string, 0, len(addrs))
+		for _, addr := range addrs {
+			if _, _, err := net.SplitHostPort(addr); err != nil {
+				addr = net.JoinHostPort(addr, defaultPort)
+			}
+			normalized = append(normalized, addr)
+		}
+
+		return normalized
+	}
+	cfg.ConnectPeers = normalize(cfg.ConnectPeers)
+	cfg.AddPeers = normalize(cfg.AddPeers)
+
+	return nil
+}
+
 // normalizeNetwork returns the common name of a network type used to create
 // file paths. This allows differently versioned networks to use the same path.
 func normalizeNetwork(network string) string {
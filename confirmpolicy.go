@@ -0,0 +1,97 @@
+package main
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// newConfirmationChecker returns a macaroons.ConfirmationChecker flagging the
+// destructive requests which require the confirmation macaroon as a second
+// authentication factor: force closing and abandoning channels, deleting
+// payments, and sending more than sendThreshold either on-chain or off-chain.
+func newConfirmationChecker(
+	sendThreshold btcutil.Amount) macaroons.ConfirmationChecker {
+
+	return func(req interface{}) bool {
+		switch r := req.(type) {
+		case *lnrpc.CloseChannelRequest:
+			return r.Force
+
+		case *lnrpc.AbandonChannelRequest,
+			*lnrpc.DeleteAllPaymentsRequest:
+
+			return true
+
+		case *lnrpc.SendCoinsRequest:
+			return r.SendAll ||
+				btcutil.Amount(r.Amount) > sendThreshold
+
+		case *lnrpc.SendManyRequest:
+			var total btcutil.Amount
+			for _, amt := range r.AddrToAmount {
+				total += btcutil.Amount(amt)
+			}
+			return total > sendThreshold
+
+		case *walletrpc.SendOutputsRequest:
+			var total btcutil.Amount
+			for _, output := range r.Outputs {
+				total += btcutil.Amount(output.Value)
+			}
+			return total > sendThreshold
+
+		case *lnrpc.SendRequest:
+			amt := paymentAmount(r.Amt, r.PaymentRequest)
+			return amt > sendThreshold
+
+		case *routerrpc.PaymentRequest:
+			return paymentAmount(0, r.PayReq) > sendThreshold
+
+		case *lnrpc.SendToRouteRequest:
+			routes := append([]*lnrpc.Route{r.Route}, r.Routes...)
+			for _, route := range routes {
+				if route == nil {
+					continue
+				}
+
+				amt := lnwire.MilliSatoshi(route.TotalAmtMsat)
+				if amt.ToSatoshis() > sendThreshold {
+					return true
+				}
+			}
+			return false
+
+		case *routerrpc.SendToRouteRequest:
+			amt := lnwire.MilliSatoshi(r.TotalAmtMsat)
+			return amt.ToSatoshis() > sendThreshold
+
+		default:
+			return false
+		}
+	}
+}
+
+// paymentAmount returns the amount sent by a payment, given the amount and
+// the payment request specified by the request sending it. If the payment
+// request can't be decoded, then the payment is treated as sending the
+// maximum amount, such that it requires confirmation.
+func paymentAmount(amt int64, payReq string) btcutil.Amount {
+	if amt != 0 || payReq == "" {
+		return btcutil.Amount(amt)
+	}
+
+	invoice, err := zpay32.Decode(payReq, activeNetParams.Params)
+	if err != nil {
+		return btcutil.MaxSatoshi
+	}
+	if invoice.MilliSat == nil {
+		return 0
+	}
+
+	return invoice.MilliSat.ToSatoshis()
+}
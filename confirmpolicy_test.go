@@ -0,0 +1,122 @@
+// +build !rpctest
+
+package main
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
+)
+
+// TestConfirmationChecker asserts that the confirmation checker flags the
+// destructive requests, including the sends exceeding the threshold.
+func TestConfirmationChecker(t *testing.T) {
+	t.Parallel()
+
+	const threshold = 1000
+	needsConfirm := newConfirmationChecker(threshold)
+
+	testCases := []struct {
+		name    string
+		req     interface{}
+		confirm bool
+	}{
+		{
+			name: "cooperative close",
+			req:  &lnrpc.CloseChannelRequest{},
+		},
+		{
+			name:    "force close",
+			req:     &lnrpc.CloseChannelRequest{Force: true},
+			confirm: true,
+		},
+		{
+			name:    "abandon channel",
+			req:     &lnrpc.AbandonChannelRequest{},
+			confirm: true,
+		},
+		{
+			name:    "delete payments",
+			req:     &lnrpc.DeleteAllPaymentsRequest{},
+			confirm: true,
+		},
+		{
+			name: "send coins below threshold",
+			req:  &lnrpc.SendCoinsRequest{Amount: threshold},
+		},
+		{
+			name:    "send coins above threshold",
+			req:     &lnrpc.SendCoinsRequest{Amount: threshold + 1},
+			confirm: true,
+		},
+		{
+			name:    "sweep all coins",
+			req:     &lnrpc.SendCoinsRequest{SendAll: true},
+			confirm: true,
+		},
+		{
+			name: "send many above threshold",
+			req: &lnrpc.SendManyRequest{
+				AddrToAmount: map[string]int64{
+					"a": threshold / 2,
+					"b": threshold / 2,
+					"c": 1,
+				},
+			},
+			confirm: true,
+		},
+		{
+			name: "send outputs below threshold",
+			req: &walletrpc.SendOutputsRequest{
+				Outputs: []*signrpc.TxOut{{Value: threshold}},
+			},
+		},
+		{
+			name: "send payment below threshold",
+			req:  &lnrpc.SendRequest{Amt: threshold},
+		},
+		{
+			name: "send payment with invalid payment request",
+			req: &lnrpc.SendRequest{
+				PaymentRequest: "invalid",
+			},
+			confirm: true,
+		},
+		{
+			name: "send to route above threshold",
+			req: &lnrpc.SendToRouteRequest{
+				Route: &lnrpc.Route{
+					TotalAmtMsat: (threshold + 1) * 1000,
+				},
+			},
+			confirm: true,
+		},
+		{
+			name: "send to route v2 below threshold",
+			req: &routerrpc.SendToRouteRequest{
+				TotalAmtMsat: threshold * 1000,
+			},
+		},
+		{
+			name: "send to route v2 above threshold",
+			req: &routerrpc.SendToRouteRequest{
+				TotalAmtMsat: (threshold + 1) * 1000,
+			},
+			confirm: true,
+		},
+		{
+			name: "read-only request",
+			req:  &lnrpc.GetInfoRequest{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		if needsConfirm(testCase.req) != testCase.confirm {
+			t.Fatalf("%v: expected confirmation required: %v",
+				testCase.name, testCase.confirm)
+		}
+	}
+}
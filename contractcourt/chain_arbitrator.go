@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/sweep"
 )
@@ -73,6 +74,12 @@ type ChainArbitratorConfig struct {
 	// continually be rebroadcast if needed.
 	PublishTx func(*wire.MsgTx) error
 
+	// BumpCommitFee attempts to bump the fee of a broadcast commitment
+	// transaction of a channel using anchor outputs, by spending our
+	// anchor output within a child transaction that pays for the fee of
+	// both.
+	BumpCommitFee func(*lnwallet.AnchorResolution) error
+
 	// DeliverResolutionMsg is a function that will append an outgoing
 	// message to the "out box" for a ChannelLink. This is used to cancel
 	// backwards any HTLC's that are either dust, we're timing out, or
@@ -125,7 +132,7 @@ type ChainArbitratorConfig struct {
 	Signer input.Signer
 
 	// FeeEstimator will be used to return fee estimates.
-	FeeEstimator lnwallet.FeeEstimator
+	FeeEstimator chainfee.Estimator
 
 	// ChainIO allows us to query the state of the current main chain.
 	ChainIO lnwallet.BlockChainIO
@@ -138,13 +145,36 @@ type ChainArbitratorConfig struct {
 	Sweeper *sweep.UtxoSweeper
 
 	// SettleInvoice attempts to settle an existing invoice on-chain with
-	// the given payment hash. ErrInvoiceNotFound is returned if an invoice
-	// is not found.
-	SettleInvoice func(lntypes.Hash, lnwire.MilliSatoshi) error
+	// the given payment hash by the given HTLC. ErrInvoiceNotFound is
+	// returned if an invoice is not found.
+	SettleInvoice func(lntypes.Hash, channeldb.InvoiceHTLC) error
 
 	// NotifyClosedChannel is a function closure that the ChainArbitrator
 	// will use to notify the ChannelNotifier about a newly closed channel.
 	NotifyClosedChannel func(wire.OutPoint)
+
+	// NotifyRemoteClose is a function closure that the ChainArbitrator
+	// will use to notify the ChannelNotifier that a closing transaction
+	// of a close not initiated by us has been detected for one of our
+	// channels.
+	NotifyRemoteClose func(*channeldb.ChannelCloseSummary)
+
+	// NotifyPendingClose is a function closure that the ChainArbitrator
+	// will use to notify the ChannelNotifier that we've broadcast a
+	// commitment transaction to force close one of our channels.
+	NotifyPendingClose func(*channeldb.OpenChannel, chainhash.Hash,
+		channeldb.ClosureType)
+
+	// MempoolNotifier, if non-nil, is used to detect revoked commitments
+	// broadcast by our channel peers as soon as they enter the mempool,
+	// rather than once they confirm.
+	MempoolNotifier chainntnfs.MempoolWatcher
+
+	// StageBreach is a function closure that the ChainArbitrator will use
+	// to let the breachArbiter prepare the justice transaction of a breach
+	// detected within the mempool, before it confirms. It MUST be set if
+	// MempoolNotifier is.
+	StageBreach func(wire.OutPoint, *lnwallet.BreachRetribution) error
 }
 
 // ChainArbitrator is a sub-system that oversees the on-chain resolution of all
@@ -197,6 +227,25 @@ func NewChainArbitrator(cfg ChainArbitratorConfig,
 	}
 }
 
+// registerBlockEpochs registers for block epoch notifications starting from
+// the current best block, such that all blocks connected from then on are
+// delivered in order, including the ones connected before the notifier
+// processes the registration. If the chain backend is ahead of the notifier,
+// the blocks the notifier is yet to process are delivered as it does so.
+func (c *ChainArbitrator) registerBlockEpochs() (*chainntnfs.BlockEpochEvent,
+	error) {
+
+	bestHash, bestHeight, err := c.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.cfg.Notifier.RegisterBlockEpochNtfn(&chainntnfs.BlockEpoch{
+		Hash:   bestHash,
+		Height: bestHeight,
+	})
+}
+
 // newActiveChannelArbitrator creates a new instance of an active channel
 // arbitrator given the state of the target channel.
 func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
@@ -208,12 +257,9 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 	// We'll start by registering for a block epoch notifications so this
 	// channel can keep track of the current state of the main chain.
 	//
-	// TODO(roasbeef): fetch best height (or pass in) so can ensure block
-	// epoch delivers all the notifications to
-	//
 	// TODO(roasbeef): instead 1 block epoch that multi-plexes to the rest?
 	//  * reduces the number of goroutines
-	blockEpoch, err := c.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	blockEpoch, err := c.registerBlockEpochs()
 	if err != nil {
 		return nil, err
 	}
@@ -248,7 +294,17 @@ func newActiveChannelArbitrator(channel *channeldb.OpenChannel,
 
 			return chanMachine.ForceClose()
 		},
-		MarkCommitmentBroadcasted: channel.MarkCommitmentBroadcasted,
+		MarkCommitmentBroadcasted: func(commitTx *wire.MsgTx) error {
+			err := channel.MarkCommitmentBroadcasted()
+			if err != nil {
+				return err
+			}
+			c.cfg.NotifyPendingClose(
+				channel, commitTx.TxHash(),
+				channeldb.LocalForceClose,
+			)
+			return nil
+		},
 		MarkChannelClosed: func(summary *channeldb.ChannelCloseSummary) error {
 			if err := channel.CloseChannel(summary); err != nil {
 				return err
@@ -363,6 +419,11 @@ func (c *ChainArbitrator) Start() error {
 				contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 					return c.cfg.ContractBreach(chanPoint, retInfo)
 				},
+				notifyRemoteClose: c.cfg.NotifyRemoteClose,
+				mempoolNotifier:   c.cfg.MempoolNotifier,
+				stageBreach: func(retInfo *lnwallet.BreachRetribution) error {
+					return c.cfg.StageBreach(chanPoint, retInfo)
+				},
 			},
 		)
 		if err != nil {
@@ -398,7 +459,7 @@ func (c *ChainArbitrator) Start() error {
 	// the chain any longer, only resolve the contracts on the confirmed
 	// commitment.
 	for _, closeChanInfo := range closingChannels {
-		blockEpoch, err := c.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+		blockEpoch, err := c.registerBlockEpochs()
 		if err != nil {
 			return err
 		}
@@ -698,6 +759,11 @@ func (c *ChainArbitrator) WatchNewChannel(newChan *channeldb.OpenChannel) error
 			contractBreach: func(retInfo *lnwallet.BreachRetribution) error {
 				return c.cfg.ContractBreach(chanPoint, retInfo)
 			},
+			notifyRemoteClose: c.cfg.NotifyRemoteClose,
+			mempoolNotifier:   c.cfg.MempoolNotifier,
+			stageBreach: func(retInfo *lnwallet.BreachRetribution) error {
+				return c.cfg.StageBreach(chanPoint, retInfo)
+			},
 		},
 	)
 	if err != nil {
@@ -105,6 +105,22 @@ type chainWatcherConfig struct {
 	// isOurAddr is a function that returns true if the passed address is
 	// known to us.
 	isOurAddr func(btcutil.Address) bool
+
+	// notifyRemoteClose is called as soon as we detect a closing
+	// transaction for the channel of a close that wasn't initiated by us.
+	// This allows interested parties to learn of the close before it
+	// confirms.
+	notifyRemoteClose func(*channeldb.ChannelCloseSummary)
+
+	// mempoolNotifier, if non-nil, is used to detect a revoked commitment
+	// broadcast by the remote party as soon as it enters the mempool.
+	mempoolNotifier chainntnfs.MempoolWatcher
+
+	// stageBreach is called with the retribution of a revoked commitment
+	// detected within the mempool, allowing the justice transaction to be
+	// prepared before the breach confirms. It MUST be set if
+	// mempoolNotifier is.
+	stageBreach func(*lnwallet.BreachRetribution) error
 }
 
 // chainWatcher is a system that's assigned to every active channel. The duty
@@ -215,6 +231,22 @@ func (c *chainWatcher) Start() error {
 	c.wg.Add(1)
 	go c.closeObserver(spendNtfn)
 
+	// If we're watching the mempool, we'll also register for the funding
+	// output being spent within it, allowing us to prepare for a breach
+	// before it confirms.
+	if c.cfg.mempoolNotifier != nil {
+		memNotifier := c.cfg.mempoolNotifier
+		mempoolNtfn, err := memNotifier.RegisterMempoolSpendNtfn(
+			fundingOut,
+		)
+		if err != nil {
+			return err
+		}
+
+		c.wg.Add(1)
+		go c.mempoolObserver(mempoolNtfn)
+	}
+
 	return nil
 }
 
@@ -497,6 +529,94 @@ func (c *chainWatcher) closeObserver(spendNtfn *chainntnfs.SpendEvent) {
 	}
 }
 
+// mempoolObserver is a dedicated goroutine that watches for a spend of the
+// channel's funding output within the mempool. If the spending transaction is
+// a revoked commitment of the remote party, the breach is staged such that
+// the justice transaction can be broadcast as soon as the breach confirms.
+// The breach itself is still only acted upon by the closeObserver, once it
+// confirms.
+//
+// NOTE: This MUST be run as a goroutine.
+func (c *chainWatcher) mempoolObserver(
+	mempoolNtfn *chainntnfs.MempoolSpendEvent) {
+
+	defer c.wg.Done()
+	defer mempoolNtfn.Cancel()
+
+	var commitSpend *chainntnfs.SpendDetail
+	select {
+	case spend, ok := <-mempoolNtfn.Spend:
+		if !ok {
+			return
+		}
+		commitSpend = spend
+
+	case <-c.quit:
+		return
+	}
+
+	chanPoint := c.cfg.chanState.FundingOutpoint
+	commitTx := commitSpend.SpendingTx
+
+	localCommit, remoteCommit, err := c.cfg.chanState.LatestCommitments()
+	if err != nil {
+		log.Errorf("Unable to fetch channel state for chan_point=%v: %v",
+			chanPoint, err)
+		return
+	}
+
+	// Neither our own commitment nor a cooperative close can be a breach.
+	localCommitHash := localCommit.CommitTx.TxHash()
+	if commitSpend.SpenderTxHash.IsEqual(&localCommitHash) ||
+		commitTx.TxIn[0].Sequence == wire.MaxTxInSequenceNum {
+
+		return
+	}
+
+	broadcastStateNum := lnwallet.GetStateNumHint(
+		commitTx, c.stateHintObfuscator,
+	)
+	if broadcastStateNum >= remoteCommit.CommitHeight {
+		return
+	}
+
+	log.Warnf("Revoked state #%v for ChannelPoint(%v) detected within "+
+		"the mempool, staging justice", broadcastStateNum, chanPoint)
+
+	// Fetching the latest revocation store also populates the channel
+	// state with it, which the breach retribution is derived from. We'll
+	// make sure it holds the secret of the broadcast state, as the breach
+	// can't be punished otherwise.
+	revocationStore, err := c.cfg.chanState.RemoteRevocationStore()
+	if err != nil {
+		log.Errorf("Unable to fetch revocation state for "+
+			"chan_point=%v: %v", chanPoint, err)
+		return
+	}
+	if _, err := revocationStore.LookUp(broadcastStateNum); err != nil {
+		log.Errorf("Unable to find revocation secret of state #%v for "+
+			"chan_point=%v: %v", broadcastStateNum, chanPoint, err)
+		return
+	}
+
+	// As the breach is yet to confirm, there's no breach height to use.
+	// The retribution is only used to prepare the justice transaction
+	// though, which doesn't depend on it.
+	retribution, err := lnwallet.NewBreachRetribution(
+		c.cfg.chanState, broadcastStateNum, 0,
+	)
+	if err != nil {
+		log.Errorf("Unable to create breach retribution for "+
+			"chan_point=%v: %v", chanPoint, err)
+		return
+	}
+
+	if err := c.cfg.stageBreach(retribution); err != nil {
+		log.Errorf("Unable to stage breach for chan_point=%v: %v",
+			chanPoint, err)
+	}
+}
+
 // toSelfAmount takes a transaction and returns the sum of all outputs that pay
 // to a script that the wallet controls. If no outputs pay to us, then we
 // return zero. This is possible as our output may have been trimmed due to
@@ -522,6 +642,23 @@ func (c *chainWatcher) toSelfAmount(tx *wire.MsgTx) btcutil.Amount {
 	return selfAmt
 }
 
+// notifyRemoteClose hands the passed close summary to the notifyRemoteClose
+// callback, if one was provided.
+func (c *chainWatcher) notifyRemoteClose(
+	closeSummary *channeldb.ChannelCloseSummary) {
+
+	if c.cfg.notifyRemoteClose == nil {
+		return
+	}
+
+	log.Debugf("Notifying of %v for ChannelPoint(%v), closing_txid=%v, "+
+		"settled_balance=%v", closeSummary.CloseType,
+		closeSummary.ChanPoint, closeSummary.ClosingTXID,
+		closeSummary.SettledBalance)
+
+	c.cfg.notifyRemoteClose(closeSummary)
+}
+
 // dispatchCooperativeClose processed a detect cooperative channel closure.
 // We'll use the spending transaction to locate our output within the
 // transaction, then clean up the database state. We'll also dispatch a
@@ -565,6 +702,21 @@ func (c *chainWatcher) dispatchCooperativeClose(commitSpend *chainntnfs.SpendDet
 		closeSummary.LastChanSyncMsg = chanSync
 	}
 
+	// As both parties broadcast the final cooperative close transaction,
+	// we'll only let listeners know of a remote close if the close wasn't
+	// initiated by us. This is recorded by the peer once it initiates the
+	// close, so we'll first fetch the latest status of the channel.
+	if err := c.cfg.chanState.RefreshChanStatus(); err != nil {
+		log.Errorf("ChannelPoint(%v): unable to refresh channel "+
+			"status: %v", c.cfg.chanState.FundingOutpoint, err)
+	}
+	localInitiator := c.cfg.chanState.HasChanStatus(
+		channeldb.ChanStatusLocalCloseInitiator,
+	)
+	if !localInitiator {
+		c.notifyRemoteClose(closeSummary)
+	}
+
 	// Create a summary of all the information needed to handle the
 	// cooperative closure.
 	closeInfo := &CooperativeCloseInfo{
@@ -693,6 +845,10 @@ func (c *chainWatcher) dispatchRemoteForceClose(
 		return err
 	}
 
+	// Before handing the summary off to our subscribers, we'll let any
+	// listeners know of the remote close.
+	c.notifyRemoteClose(&uniClose.ChannelCloseSummary)
+
 	// With the event processed, we'll now notify all subscribers of the
 	// event.
 	c.Lock()
@@ -820,6 +976,8 @@ func (c *chainWatcher) dispatchContractBreach(spendEvent *chainntnfs.SpendDetail
 		closeSummary.LastChanSyncMsg = chanSync
 	}
 
+	c.notifyRemoteClose(&closeSummary)
+
 	if err := c.cfg.chanState.CloseChannel(&closeSummary); err != nil {
 		return err
 	}
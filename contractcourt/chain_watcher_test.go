@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
@@ -114,6 +115,187 @@ func TestChainWatcherRemoteUnilateralClose(t *testing.T) {
 	}
 }
 
+// TestChainWatcherNotifyRemoteClose tests that the chain watcher notifies of
+// a remote unilateral close as soon as the spend is detected, passing along
+// the close type and closing txid.
+func TestChainWatcherNotifyRemoteClose(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll create a chain watcher for Alice's channel that will send any
+	// remote close notification over the remoteCloses channel.
+	remoteCloses := make(chan *channeldb.ChannelCloseSummary, 1)
+	aliceNotifier := &mockNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState: aliceChannel.State(),
+		notifier:  aliceNotifier,
+		signer:    aliceChannel.Signer,
+		notifyRemoteClose: func(s *channeldb.ChannelCloseSummary) {
+			remoteCloses <- s
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// Bob will now broadcast his current commitment.
+	bobCommit := bobChannel.State().LocalCommitment.CommitTx
+	bobTxHash := bobCommit.TxHash()
+	bobSpend := &chainntnfs.SpendDetail{
+		SpenderTxHash: &bobTxHash,
+		SpendingTx:    bobCommit,
+	}
+	aliceNotifier.spendChan <- bobSpend
+
+	// The remote close should be reported with the proper close type and
+	// closing txid.
+	var closeSummary *channeldb.ChannelCloseSummary
+	select {
+	case closeSummary = <-remoteCloses:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive remote close notification")
+	}
+	if closeSummary.CloseType != channeldb.RemoteForceClose {
+		t.Fatalf("expected close type %v, got %v",
+			channeldb.RemoteForceClose, closeSummary.CloseType)
+	}
+	if closeSummary.ClosingTXID != bobTxHash {
+		t.Fatalf("expected closing txid %v, got %v", bobTxHash,
+			closeSummary.ClosingTXID)
+	}
+
+	// The subscribers of the chain watcher should still be notified.
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive unilateral close event")
+	}
+}
+
+// TestChainWatcherNotifyRemoteCoopClose tests that the chain watcher only
+// notifies of a cooperative close as a remote close if we didn't initiate it.
+func TestChainWatcherNotifyRemoteCoopClose(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		localInitiator bool
+	}{
+		{
+			name:           "remote initiator",
+			localInitiator: false,
+		},
+		{
+			name:           "local initiator",
+			localInitiator: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			testNotifyRemoteCoopClose(t, testCase.localInitiator)
+		})
+	}
+}
+
+func testNotifyRemoteCoopClose(t *testing.T, localInitiator bool) {
+	aliceChannel, bobChannel, cleanUp, err := lnwallet.CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// If Alice initiated the close, then this is recorded in her channel
+	// state on disk, rather than in the state held by her chain watcher.
+	if localInitiator {
+		// We'll fetch a separate copy of her channel state, as the
+		// peer would use.
+		channels, err := aliceChannel.State().Db.FetchOpenChannels(
+			aliceChannel.State().IdentityPub,
+		)
+		if err != nil {
+			t.Fatalf("unable to fetch channels: %v", err)
+		}
+		err = channels[0].ApplyChanStatus(
+			channeldb.ChanStatusLocalCloseInitiator,
+		)
+		if err != nil {
+			t.Fatalf("unable to apply channel status: %v", err)
+		}
+	}
+
+	remoteCloses := make(chan *channeldb.ChannelCloseSummary, 1)
+	aliceNotifier := &mockNotifier{
+		spendChan: make(chan *chainntnfs.SpendDetail),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState: aliceChannel.State(),
+		notifier:  aliceNotifier,
+		signer:    aliceChannel.Signer,
+		notifyRemoteClose: func(s *channeldb.ChannelCloseSummary) {
+			remoteCloses <- s
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create chain watcher: %v", err)
+	}
+	if err := aliceChainWatcher.Start(); err != nil {
+		t.Fatalf("unable to start chain watcher: %v", err)
+	}
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// We'll simulate a cooperative close by spending the funding output
+	// with a final input.
+	closeTx := bobChannel.State().LocalCommitment.CommitTx.Copy()
+	closeTx.TxIn[0].Sequence = wire.MaxTxInSequenceNum
+	closeTxHash := closeTx.TxHash()
+	aliceNotifier.spendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash: &closeTxHash,
+		SpendingTx:    closeTx,
+	}
+
+	select {
+	case <-chanEvents.CooperativeClosure:
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive cooperative close event")
+	}
+
+	// A remote close should only have been reported if Alice didn't
+	// initiate the close.
+	select {
+	case closeSummary := <-remoteCloses:
+		if localInitiator {
+			t.Fatalf("unexpected remote close notification")
+		}
+		if closeSummary.CloseType != channeldb.CooperativeClose {
+			t.Fatalf("expected close type %v, got %v",
+				channeldb.CooperativeClose,
+				closeSummary.CloseType)
+		}
+
+	default:
+		if !localInitiator {
+			t.Fatalf("didn't receive remote close notification")
+		}
+	}
+}
+
 // TestChainWatcherRemoteUnilateralClosePendingCommit tests that the chain
 // watcher is able to properly detect a unilateral close wherein the remote
 // node broadcasts their newly received commitment, without first revoking the
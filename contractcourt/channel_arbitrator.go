@@ -105,7 +105,8 @@ type ChannelArbitratorConfig struct {
 
 	// MarkCommitmentBroadcasted should mark the channel as the commitment
 	// being broadcast, and we are waiting for the commitment to confirm.
-	MarkCommitmentBroadcasted func() error
+	// The passed transaction is the commitment we've broadcast.
+	MarkCommitmentBroadcasted func(*wire.MsgTx) error
 
 	// MarkChannelClosed marks the channel closed in the database, with the
 	// passed close summary. After this method successfully returns we can
@@ -254,6 +255,11 @@ type ChannelArbitrator struct {
 	// upon start up to decide which actions to take.
 	state ArbitratorState
 
+	// anchorResolution is the anchor output of the commitment we've
+	// broadcast, if the channel uses anchor outputs. It's used to bump
+	// the fee of the commitment with each new block until it confirms.
+	anchorResolution *lnwallet.AnchorResolution
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -480,6 +486,7 @@ func supplementSuccessResolver(r *htlcSuccessResolver,
 		)
 	}
 	r.htlcAmt = htlc.Amt
+	r.htlcIndex = htlc.HtlcIndex
 	return nil
 }
 
@@ -749,7 +756,15 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 			}
 		}
 
-		if err := c.cfg.MarkCommitmentBroadcasted(); err != nil {
+		// If the channel uses anchor outputs, then the commitment
+		// may have been signed using a fee rate which is too low to
+		// confirm in a timely manner. We'll bump its fee through our
+		// anchor, and do so again with each new block until it
+		// confirms.
+		c.anchorResolution = closeSummary.AnchorResolution
+		c.bumpCommitFee()
+
+		if err := c.cfg.MarkCommitmentBroadcasted(closeTx); err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to "+
 				"mark commitment broadcasted: %v",
 				c.cfg.ChanPoint, err)
@@ -1324,6 +1339,7 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 					broadcastHeight: height,
 					payHash:         htlc.RHash,
 					htlcAmt:         htlc.Amt,
+					htlcIndex:       htlc.HtlcIndex,
 					ResolverKit:     resKit,
 				}
 				htlcResolvers = append(htlcResolvers, resolver)
@@ -1386,6 +1402,7 @@ func (c *ChannelArbitrator) prepContractResolutions(htlcActions ChainActionMap,
 						broadcastHeight: height,
 						payHash:         htlc.RHash,
 						htlcAmt:         htlc.Amt,
+						htlcIndex:       htlc.HtlcIndex,
 						ResolverKit:     resKit,
 					},
 				}
@@ -1595,6 +1612,20 @@ func (c *ChannelArbitrator) UpdateContractSignals(newSignals *ContractSignals) {
 	}
 }
 
+// bumpCommitFee bumps the fee of our broadcast commitment through its anchor
+// output, if it has one. Failing to do so isn't fatal, as the commitment may
+// still confirm on its own.
+func (c *ChannelArbitrator) bumpCommitFee() {
+	if c.anchorResolution == nil {
+		return
+	}
+
+	if err := c.cfg.BumpCommitFee(c.anchorResolution); err != nil {
+		log.Warnf("ChannelArbitrator(%v): unable to bump commitment "+
+			"fee: %v", c.cfg.ChanPoint, err)
+	}
+}
+
 // channelAttendant is the primary goroutine that acts at the judicial
 // arbitrator between our channel state, the remote channel peer, and the
 // blockchain Our judge). This goroutine will ensure that we faithfully execute
@@ -1627,6 +1658,14 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			}
 			bestHeight = blockEpoch.Height
 
+			// If our commitment hasn't confirmed yet, then the fee
+			// rate needed to confirm it may have risen since we
+			// last bumped its fee.
+			if c.state == StateCommitmentBroadcasted {
+				c.bumpCommitFee()
+				continue
+			}
+
 			// If we're not in the default state, then we can
 			// ignore this signal as we're waiting for contract
 			// resolution.
@@ -142,6 +142,12 @@ func (*mockChainIO) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
 	return nil, nil
 }
 
+func (*mockChainIO) GetBlockHeader(
+	blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+
+	return nil, nil
+}
+
 func createTestChannelArbitrator(log ArbitratorLog) (*ChannelArbitrator,
 	chan struct{}, error) {
 	blockEpoch := &chainntnfs.BlockEpochEvent{
@@ -177,7 +183,7 @@ func createTestChannelArbitrator(log ArbitratorLog) (*ChannelArbitrator,
 			*lnwallet.IncomingHtlcResolution, uint32) error {
 			return nil
 		},
-		SettleInvoice: func(lntypes.Hash, lnwire.MilliSatoshi) error {
+		SettleInvoice: func(lntypes.Hash, channeldb.InvoiceHTLC) error {
 			return nil
 		},
 	}
@@ -203,7 +209,7 @@ func createTestChannelArbitrator(log ArbitratorLog) (*ChannelArbitrator,
 			}
 			return summary, nil
 		},
-		MarkCommitmentBroadcasted: func() error {
+		MarkCommitmentBroadcasted: func(*wire.MsgTx) error {
 			return nil
 		},
 		MarkChannelClosed: func(*channeldb.ChannelCloseSummary) error {
@@ -1189,3 +1195,107 @@ func TestChannelArbitratorAlreadyForceClosed(t *testing.T) {
 		t.Fatal("expected to receive error response")
 	}
 }
+
+// TestChannelArbitratorAnchorFeeBump asserts that the ChannelArbitrator bumps
+// the fee of a broadcast commitment using anchor outputs upon broadcast, and
+// again with each new block until the commitment confirms.
+func TestChannelArbitratorAnchorFeeBump(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArb, _, err := createTestChannelArbitrator(log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	anchor := &lnwallet.AnchorResolution{}
+	chanArb.cfg.ForceCloseChan = func() (*lnwallet.LocalForceCloseSummary,
+		error) {
+
+		return &lnwallet.LocalForceCloseSummary{
+			CloseTx:          &wire.MsgTx{},
+			HtlcResolutions:  &lnwallet.HtlcResolutions{},
+			AnchorResolution: anchor,
+		}, nil
+	}
+
+	bumps := make(chan *lnwallet.AnchorResolution, 1)
+	chanArb.cfg.BumpCommitFee = func(a *lnwallet.AnchorResolution) error {
+		bumps <- a
+		return nil
+	}
+
+	epochs := make(chan *chainntnfs.BlockEpoch)
+	chanArb.cfg.BlockEpochs.Epochs = epochs
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	assertBump := func() {
+		t.Helper()
+
+		select {
+		case a := <-bumps:
+			if a != anchor {
+				t.Fatalf("fee bumped through unexpected anchor")
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("commitment fee not bumped")
+		}
+	}
+
+	errChan := make(chan error, 1)
+	chanArb.forceCloseReqs <- &forceCloseReq{
+		errResp: errChan,
+		closeTx: make(chan *wire.MsgTx, 1),
+	}
+	assertStateTransitions(
+		t, log.newStates, StateBroadcastCommit,
+		StateCommitmentBroadcasted,
+	)
+
+	// The fee should be bumped upon broadcast.
+	assertBump()
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("error force closing channel: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no response received")
+	}
+
+	// As long as the commitment is unconfirmed, the fee should be bumped
+	// again with every new block.
+	for height := int32(101); height < 104; height++ {
+		epochs <- &chainntnfs.BlockEpoch{Height: height}
+		assertBump()
+	}
+
+	// Once the commitment confirms, new blocks shouldn't cause any more
+	// bumps.
+	closeInfo := &LocalUnilateralCloseInfo{
+		&chainntnfs.SpendDetail{},
+		&lnwallet.LocalForceCloseSummary{
+			CloseTx:         &wire.MsgTx{},
+			HtlcResolutions: &lnwallet.HtlcResolutions{},
+		},
+		&channeldb.ChannelCloseSummary{},
+	}
+	chanArb.cfg.ChainEvents.LocalUnilateralClosure <- closeInfo
+	assertStateTransitions(
+		t, log.newStates, StateContractClosed, StateFullyResolved,
+	)
+
+	select {
+	case <-bumps:
+		t.Fatalf("commitment fee bumped after confirmation")
+	default:
+	}
+}
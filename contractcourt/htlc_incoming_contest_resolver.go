@@ -7,6 +7,7 @@ import (
 	"io"
 
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lntypes"
 )
 
@@ -50,7 +51,7 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 
 	// We'll first check if this HTLC has been timed out, if so, we can
 	// return now and mark ourselves as resolved.
-	_, currentHeight, err := h.ChainIO.GetBestBlock()
+	currentHash, currentHeight, err := h.ChainIO.GetBestBlock()
 	if err != nil {
 		return nil, err
 	}
@@ -97,13 +98,20 @@ func (h *htlcIncomingContestResolver) Resolve() (ContractResolver, error) {
 
 	// If the HTLC hasn't expired yet, then we may still be able to claim
 	// it if we learn of the pre-image, so we'll subscribe to the preimage
-	// database to see if it turns up, or the HTLC times out.
+	// database to see if it turns up, or the HTLC times out. We'll
+	// register for blocks from the height we checked above, so that none
+	// connected since then is missed.
 	//
 	// NOTE: This is done BEFORE opportunistically querying the db, to
 	// ensure the preimage can't be delivered between querying and
 	// registering for the preimage subscription.
 	preimageSubscription := h.PreimageDB.SubscribeUpdates()
-	blockEpochs, err := h.Notifier.RegisterBlockEpochNtfn(nil)
+	blockEpochs, err := h.Notifier.RegisterBlockEpochNtfn(
+		&chainntnfs.BlockEpoch{
+			Hash:   currentHash,
+			Height: currentHeight,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +173,7 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 	// HTLC.
 	//
 	// TODO(roasbeef): use grace period instead?
-	_, currentHeight, err := h.ChainIO.GetBestBlock()
+	currentHash, currentHeight, err := h.ChainIO.GetBestBlock()
 	if err != nil {
 		return nil, err
 	}
@@ -201,8 +201,14 @@ func (h *htlcOutgoingContestResolver) Resolve() (ContractResolver, error) {
 
 	// If we reach this point, then we can't fully act yet, so we'll await
 	// either of our signals triggering: the HTLC expires, or we learn of
-	// the preimage.
-	blockEpochs, err := h.Notifier.RegisterBlockEpochNtfn(nil)
+	// the preimage. We'll register for blocks from the height we just
+	// checked, so that none connected since then is missed.
+	blockEpochs, err := h.Notifier.RegisterBlockEpochNtfn(
+		&chainntnfs.BlockEpoch{
+			Hash:   currentHash,
+			Height: currentHeight,
+		},
+	)
 	if err != nil {
 		return nil, err
 	}
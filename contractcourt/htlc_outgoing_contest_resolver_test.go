@@ -0,0 +1,147 @@
+package contractcourt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// bestBlockChainIO is a mock chain backend reporting a fixed best block.
+type bestBlockChainIO struct {
+	mockChainIO
+
+	bestHash   chainhash.Hash
+	bestHeight int32
+}
+
+func (c *bestBlockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
+	return &c.bestHash, c.bestHeight, nil
+}
+
+// epochRecordingNotifier is a mock notifier that hands out the best block
+// each block epoch registration was made with.
+type epochRecordingNotifier struct {
+	*mockNotifier
+
+	registrations chan *chainntnfs.BlockEpoch
+}
+
+func (n *epochRecordingNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	n.registrations <- bestBlock
+
+	return n.mockNotifier.RegisterBlockEpochNtfn(bestBlock)
+}
+
+// TestHtlcOutgoingContestResolverRestart asserts that a contest resolver
+// resumed after a restart registers for blocks from the height it checked the
+// HTLC's expiry at, such that the notifier replays any block connected in
+// between, and that the HTLC times out once one of them reaches its expiry.
+func TestHtlcOutgoingContestResolverRestart(t *testing.T) {
+	t.Parallel()
+
+	const (
+		expiry     = 110
+		bestHeight = expiry - 5
+	)
+
+	chainIO := &bestBlockChainIO{
+		bestHash:   chainhash.Hash{0x01},
+		bestHeight: bestHeight,
+	}
+	notifier := &epochRecordingNotifier{
+		mockNotifier: &mockNotifier{
+			spendChan: make(chan *chainntnfs.SpendDetail),
+			epochChan: make(chan *chainntnfs.BlockEpoch),
+			confChan:  make(chan *chainntnfs.TxConfirmation),
+		},
+		registrations: make(chan *chainntnfs.BlockEpoch, 1),
+	}
+
+	// The resolver is created as if it was just decoded from disk, which
+	// is how the channel arbitrator resumes it upon restart.
+	resolver := &htlcOutgoingContestResolver{
+		htlcTimeoutResolver: htlcTimeoutResolver{
+			htlcResolution: lnwallet.OutgoingHtlcResolution{
+				Expiry:        expiry,
+				ClaimOutpoint: wire.OutPoint{Index: 2},
+				SweepSignDesc: input.SignDescriptor{
+					Output: &wire.TxOut{
+						PkScript: []byte{0x00},
+					},
+				},
+			},
+			broadcastHeight: expiry - 10,
+			ResolverKit: ResolverKit{
+				ChannelArbitratorConfig: ChannelArbitratorConfig{
+					ChainArbitratorConfig: ChainArbitratorConfig{
+						ChainIO:  chainIO,
+						Notifier: notifier,
+					},
+				},
+				Quit: make(chan struct{}),
+			},
+		},
+	}
+
+	type result struct {
+		next ContractResolver
+		err  error
+	}
+	resultChan := make(chan result, 1)
+	go func() {
+		next, err := resolver.Resolve()
+		resultChan <- result{next, err}
+	}()
+
+	// The resolver should register from the best block it checked the
+	// expiry against, rather than from the notifier's tip.
+	select {
+	case bestBlock := <-notifier.registrations:
+		if bestBlock == nil {
+			t.Fatal("expected registration from best block")
+		}
+		if bestBlock.Height != bestHeight ||
+			*bestBlock.Hash != chainIO.bestHash {
+
+			t.Fatalf("expected registration from block %v at "+
+				"height %v, got %v at height %v",
+				chainIO.bestHash, bestHeight, bestBlock.Hash,
+				bestBlock.Height)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("resolver didn't register for blocks")
+	}
+
+	// The notifier then replays the blocks connected since, which don't
+	// expire the HTLC until it's within one block of its expiry.
+	for height := int32(bestHeight + 1); height < expiry-1; height++ {
+		notifier.epochChan <- &chainntnfs.BlockEpoch{Height: height}
+	}
+	select {
+	case res := <-resultChan:
+		t.Fatalf("unexpected resolution: %v, %v", res.next, res.err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	notifier.epochChan <- &chainntnfs.BlockEpoch{Height: expiry - 1}
+	select {
+	case res := <-resultChan:
+		if res.err != nil {
+			t.Fatalf("unable to resolve contract: %v", res.err)
+		}
+		if res.next != &resolver.htlcTimeoutResolver {
+			t.Fatalf("expected timeout resolver, got %T", res.next)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatal("htlc didn't time out")
+	}
+}
@@ -55,6 +55,9 @@ type htlcSuccessResolver struct {
 	// account any fees that may have to be paid if it goes on chain.
 	htlcAmt lnwire.MilliSatoshi
 
+	// htlcIndex is the index of the htlc within the channel.
+	htlcIndex uint64
+
 	ResolverKit
 }
 
@@ -180,7 +183,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 
 		// With the HTLC claimed, we can attempt to settle its
 		// corresponding invoice if we were the original destination.
-		err = h.SettleInvoice(h.payHash, h.htlcAmt)
+		err = h.SettleInvoice(h.payHash, h.invoiceHtlc())
 		if err != nil && err != channeldb.ErrInvoiceNotFound {
 			log.Errorf("Unable to settle invoice with payment "+
 				"hash %x: %v", h.payHash, err)
@@ -253,7 +256,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 
 	// With the HTLC claimed, we can attempt to settle its corresponding
 	// invoice if we were the original destination.
-	err = h.SettleInvoice(h.payHash, h.htlcAmt)
+	err = h.SettleInvoice(h.payHash, h.invoiceHtlc())
 	if err != nil && err != channeldb.ErrInvoiceNotFound {
 		log.Errorf("Unable to settle invoice with payment "+
 			"hash %x: %v", h.payHash, err)
@@ -279,6 +282,18 @@ func (h *htlcSuccessResolver) IsResolved() bool {
 	return h.resolved
 }
 
+// invoiceHtlc returns the details of the HTLC to record with the invoice it
+// settles. As the HTLC is claimed on-chain, the height the commitment was
+// broadcast at is used as its accept height.
+func (h *htlcSuccessResolver) invoiceHtlc() channeldb.InvoiceHTLC {
+	return channeldb.InvoiceHTLC{
+		ChanID:       h.ShortChanID,
+		HtlcID:       h.htlcIndex,
+		Amt:          h.htlcAmt,
+		AcceptHeight: h.broadcastHeight,
+	}
+}
+
 // Encode writes an encoded version of the ContractResolver into the passed
 // Writer.
 //
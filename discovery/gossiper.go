@@ -15,11 +15,14 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/health"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/multimutex"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+	"golang.org/x/time/rate"
 )
 
 var (
@@ -31,6 +34,21 @@ var (
 	// gossip syncer corresponding to a gossip query message received from
 	// the remote peer.
 	ErrGossipSyncerNotFound = errors.New("gossip syncer not found")
+
+	// ErrPeerQuitting signals that we stopped processing a message from a
+	// remote peer because the peer is disconnecting.
+	ErrPeerQuitting = errors.New("peer is quitting")
+)
+
+const (
+	// DefaultMaxInvalidAnnouncements is the default number of
+	// announcements failing signature or on-chain validation we'll accept
+	// from a single peer before banning it.
+	DefaultMaxInvalidAnnouncements = 25
+
+	// DefaultInvalidAnnDecay is the default interval after which one of
+	// the invalid announcements counted for a peer is forgiven.
+	DefaultInvalidAnnDecay = 10 * time.Minute
 )
 
 // networkMsg couples a routing related wire message with the peer that
@@ -143,6 +161,82 @@ type Config struct {
 	// TODO(roasbeef): extract ann crafting + sign from fundingMgr into
 	// here?
 	AnnSigner lnwallet.MessageSigner
+
+	// Health is the registry through which the gossiper recovers from
+	// panics, such that a panic while processing an announcement doesn't
+	// bring down the entire daemon. If nil, panics aren't recovered from.
+	Health *health.Registry
+
+	// BlockedNodes is the set of nodes whose announcements are never
+	// accepted from the network, and from which no gossip is accepted.
+	BlockedNodes map[routing.Vertex]struct{}
+
+	// NumActiveSyncers is the number of peers for which we should have
+	// active syncers. Active syncers request real-time channel updates
+	// from their peer, while all other syncers only synchronize their
+	// channel graph state with it once upon connecting.
+	NumActiveSyncers int
+
+	// RotateTicker is a ticker responsible for notifying the gossiper
+	// when it should swap one of its active syncers for a passive one. If
+	// nil, the active syncers are rotated every
+	// DefaultSyncerRotationInterval.
+	RotateTicker ticker.Ticker
+
+	// ChannelUpdateInterval is the interval at which we'll accept new
+	// ChannelUpdates from a single peer once it has exhausted its burst
+	// allowance of MaxChannelUpdateBurst updates. Any further updates
+	// from the peer are delayed until it has accumulated a new token. If
+	// zero, ChannelUpdates aren't rate limited.
+	ChannelUpdateInterval time.Duration
+
+	// MaxChannelUpdateBurst is the number of ChannelUpdates we'll accept
+	// from a single peer in quick succession before rate limiting it.
+	MaxChannelUpdateBurst int
+
+	// RebroadcastInterval is the interval at which we'll broadcast the
+	// batch of our own ChannelUpdates collected since the last interval.
+	// Only the latest update of each of our channels is broadcast, and
+	// only if it changes the policy we last broadcast for the channel. If
+	// zero, our own ChannelUpdates are broadcast along with the next
+	// trickle batch.
+	RebroadcastInterval time.Duration
+
+	// MaxPrematureAnnouncements is the maximum number of premature
+	// announcements, referencing a block beyond our chain tip, we'll hold
+	// on to until our chain tip catches up. Any further premature
+	// announcements are dropped. If zero, the number of premature
+	// announcements isn't bounded.
+	MaxPrematureAnnouncements int
+
+	// MaxPrematurePerPeer is the maximum number of premature
+	// announcements we'll hold on to for a single peer, such that a
+	// single peer can't crowd out the premature announcements of all
+	// others. If zero, the number of premature announcements per peer
+	// isn't bounded.
+	MaxPrematurePerPeer int
+
+	// MaxPrematureHeightDelta is the maximum number of blocks beyond our
+	// chain tip a premature announcement may reference for us to hold on
+	// to it. Announcements referencing blocks any further ahead are
+	// dropped. If zero, the referenced block isn't bounded.
+	MaxPrematureHeightDelta uint32
+
+	// MaxInvalidAnnouncements is the number of announcements failing
+	// signature or on-chain validation we'll accept from a single peer
+	// before banning it through BanPeer. If zero, peers are never banned.
+	MaxInvalidAnnouncements uint32
+
+	// InvalidAnnDecayInterval is the interval after which one of the
+	// invalid announcements counted for a peer is forgiven, such that
+	// peers relaying the occasional invalid announcement over a long
+	// period of time aren't banned. If zero, counts never decay.
+	InvalidAnnDecayInterval time.Duration
+
+	// BanPeer bans the given peer for sending us too many invalid
+	// announcements, disconnecting it and refusing any further
+	// connections with it.
+	BanPeer func(peer [33]byte, reason string) error
 }
 
 // AuthenticatedGossiper is a subsystem which is responsible for receiving
@@ -174,15 +268,12 @@ type AuthenticatedGossiper struct {
 	// every new block height.
 	blockEpochs *chainntnfs.BlockEpochEvent
 
-	// prematureAnnouncements maps a block height to a set of network
-	// messages which are "premature" from our PoV. A message is premature
-	// if it claims to be anchored in a block which is beyond the current
-	// main chain tip as we know it. Premature network messages will be
-	// processed once the chain tip as we know it extends to/past the
-	// premature height.
-	//
-	// TODO(roasbeef): limit premature networkMsgs to N
-	prematureAnnouncements map[uint32][]*networkMsg
+	// prematureAnnouncements holds network messages which are
+	// "premature" from our PoV. A message is premature if it claims to be
+	// anchored in a block which is beyond the current main chain tip as we
+	// know it. Premature network messages will be processed once the chain
+	// tip as we know it extends to/past the premature height.
+	prematureAnnouncements *prematureAnnouncements
 
 	// prematureChannelUpdates is a map of ChannelUpdates we have received
 	// that wasn't associated with any channel we know about.  We store
@@ -212,13 +303,34 @@ type AuthenticatedGossiper struct {
 	rejectMtx     sync.RWMutex
 	recentRejects map[uint64]struct{}
 
-	// peerSyncers keeps track of all the gossip syncers we're maintain for
-	// peers that understand this mode of operation. When we go to send out
-	// new updates, for all peers in the map, we'll send the messages
-	// directly to their gossiper, rather than broadcasting them. With this
-	// change, we ensure we filter out all updates properly.
-	syncerMtx   sync.RWMutex
-	peerSyncers map[routing.Vertex]*gossipSyncer
+	// syncMgr is a subsystem responsible for managing the gossip syncers
+	// for peers that understand this mode of operation. When we go to send
+	// out new updates, for all peers with a syncer, we'll send the
+	// messages directly to their gossiper, rather than broadcasting them.
+	// With this change, we ensure we filter out all updates properly.
+	syncMgr *SyncManager
+
+	// updateLimiterMtx guards updateLimiters.
+	updateLimiterMtx sync.Mutex
+
+	// updateLimiters holds a token bucket for each peer, limiting the rate
+	// at which we accept ChannelUpdates from it.
+	updateLimiters map[routing.Vertex]*rate.Limiter
+
+	// invalidAnnsMtx guards invalidAnns.
+	invalidAnnsMtx sync.Mutex
+
+	// invalidAnns counts the announcements failing signature or on-chain
+	// validation each peer has sent us.
+	invalidAnns map[routing.Vertex]*invalidCount
+
+	// localUpdates batches our own ChannelUpdates until the next
+	// rebroadcast. This is nil if our updates aren't batched.
+	localUpdates *localUpdateBatch
+
+	// sigVerifier verifies the signatures of remote announcements on a
+	// pool of worker goroutines.
+	sigVerifier *sigVerifier
 
 	// reliableSender is a subsystem responsible for handling reliable
 	// message send requests to peers.
@@ -236,13 +348,34 @@ func New(cfg Config, selfKey *btcec.PublicKey) *AuthenticatedGossiper {
 		networkMsgs:             make(chan *networkMsg),
 		quit:                    make(chan struct{}),
 		chanPolicyUpdates:       make(chan *chanPolicyUpdateRequest),
-		prematureAnnouncements:  make(map[uint32][]*networkMsg),
 		prematureChannelUpdates: make(map[uint64][]*networkMsg),
 		channelMtx:              multimutex.NewMutex(),
 		recentRejects:           make(map[uint64]struct{}),
-		peerSyncers:             make(map[routing.Vertex]*gossipSyncer),
+		updateLimiters:          make(map[routing.Vertex]*rate.Limiter),
+		invalidAnns:             make(map[routing.Vertex]*invalidCount),
+		sigVerifier:             newSigVerifier(runtime.NumCPU()),
+	}
+
+	gossiper.prematureAnnouncements = newPrematureAnnouncements(
+		cfg.MaxPrematureAnnouncements, cfg.MaxPrematurePerPeer,
+		cfg.MaxPrematureHeightDelta,
+	)
+
+	if cfg.RebroadcastInterval > 0 {
+		gossiper.localUpdates = newLocalUpdateBatch()
 	}
 
+	rotateTicker := cfg.RotateTicker
+	if rotateTicker == nil {
+		rotateTicker = ticker.New(DefaultSyncerRotationInterval)
+	}
+	gossiper.syncMgr = newSyncManager(SyncManagerCfg{
+		ChainHash:        cfg.ChainHash,
+		ChanSeries:       cfg.ChanSeries,
+		NumActiveSyncers: cfg.NumActiveSyncers,
+		RotateTicker:     rotateTicker,
+	})
+
 	gossiper.reliableSender = newReliableSender(&reliableSenderCfg{
 		NotifyWhenOnline:  cfg.NotifyWhenOnline,
 		NotifyWhenOffline: cfg.NotifyWhenOffline,
@@ -358,7 +491,7 @@ func (d *AuthenticatedGossiper) SynchronizeNode(syncPeer lnpeer.Peer) error {
 
 	// With all the announcement messages gathered, send them all in a
 	// single batch to the target peer.
-	return syncPeer.SendMessage(false, announceMessages...)
+	return syncPeer.SendMessageLazy(false, announceMessages...)
 }
 
 // PropagateChanPolicyUpdate signals the AuthenticatedGossiper to update the
@@ -416,6 +549,14 @@ func (d *AuthenticatedGossiper) Start() error {
 		return err
 	}
 
+	if err := d.sigVerifier.Start(); err != nil {
+		return err
+	}
+
+	d.syncMgr.Start()
+
+	d.cfg.Health.Register("gossiper")
+
 	d.wg.Add(1)
 	go d.networkHandler()
 
@@ -432,15 +573,13 @@ func (d *AuthenticatedGossiper) Stop() {
 
 	d.blockEpochs.Cancel()
 
-	d.syncerMtx.RLock()
-	for _, syncer := range d.peerSyncers {
-		syncer.Stop()
-	}
-	d.syncerMtx.RUnlock()
+	d.syncMgr.Stop()
 
 	close(d.quit)
 	d.wg.Wait()
 
+	d.sigVerifier.Stop()
+
 	// We'll stop our reliable sender after all of the gossiper's goroutines
 	// have exited to ensure nothing can cause it to continue executing.
 	d.reliableSender.Stop()
@@ -449,6 +588,93 @@ func (d *AuthenticatedGossiper) Stop() {
 // TODO(roasbeef): need method to get current gossip timestamp?
 //  * using mtx, check time rotate forward is needed?
 
+// isBlocked returns true if the given node is blocked, such that none of its
+// announcements or gossip are to be accepted.
+func (d *AuthenticatedGossiper) isBlocked(node [33]byte) bool {
+	_, ok := d.cfg.BlockedNodes[routing.Vertex(node)]
+	return ok
+}
+
+// invalidCount is the number of invalid announcements a peer has sent us,
+// decayed over time.
+type invalidCount struct {
+	// count is the number of invalid announcements as of lastDecay.
+	count uint32
+
+	// lastDecay is the time at which count was last decayed.
+	lastDecay time.Time
+}
+
+// decay forgives one invalid announcement for each full decay interval that
+// has passed since the count was last decayed.
+func (c *invalidCount) decay(now time.Time, interval time.Duration) {
+	if interval == 0 {
+		return
+	}
+
+	intervals := now.Sub(c.lastDecay) / interval
+	if intervals <= 0 {
+		return
+	}
+
+	if uint64(intervals) >= uint64(c.count) {
+		c.count = 0
+		c.lastDecay = now
+		return
+	}
+
+	c.count -= uint32(intervals)
+	c.lastDecay = c.lastDecay.Add(intervals * interval)
+}
+
+// recordInvalidAnn records that the given remote announcement failed
+// signature or on-chain validation. Once its sender has sent us more than
+// MaxInvalidAnnouncements invalid announcements, it's banned.
+func (d *AuthenticatedGossiper) recordInvalidAnn(nMsg *networkMsg,
+	err error) {
+
+	if !nMsg.isRemote || d.cfg.MaxInvalidAnnouncements == 0 ||
+		d.cfg.BanPeer == nil {
+
+		return
+	}
+
+	peer := routing.NewVertex(nMsg.source)
+	now := time.Now()
+
+	d.invalidAnnsMtx.Lock()
+	invalid, ok := d.invalidAnns[peer]
+	if !ok {
+		invalid = &invalidCount{lastDecay: now}
+		d.invalidAnns[peer] = invalid
+	}
+	invalid.decay(now, d.cfg.InvalidAnnDecayInterval)
+	invalid.count++
+	numInvalid := invalid.count
+	if numInvalid > d.cfg.MaxInvalidAnnouncements {
+		delete(d.invalidAnns, peer)
+	}
+	d.invalidAnnsMtx.Unlock()
+
+	log.Debugf("Peer %v sent invalid %v (%v invalid so far): %v", peer,
+		nMsg.msg.MsgType(), numInvalid, err)
+
+	if numInvalid <= d.cfg.MaxInvalidAnnouncements {
+		return
+	}
+
+	// Banning the peer disconnects it, which may in turn wait on the
+	// gossiper, so we'll do so in a new goroutine.
+	reason := fmt.Sprintf("sent more than %v invalid announcements, "+
+		"last one being %v: %v", d.cfg.MaxInvalidAnnouncements,
+		nMsg.msg.MsgType(), err)
+	go func() {
+		if err := d.cfg.BanPeer(peer, reason); err != nil {
+			log.Errorf("Unable to ban peer %v: %v", peer, err)
+		}
+	}()
+}
+
 // ProcessRemoteAnnouncement sends a new remote announcement message along with
 // the peer that sent the routing message. The announcement will be processed
 // then added to a queue for batched trickled announcement to all connected
@@ -459,6 +685,15 @@ func (d *AuthenticatedGossiper) ProcessRemoteAnnouncement(msg lnwire.Message,
 
 	errChan := make(chan error, 1)
 
+	// We don't accept any gossip from blocked nodes.
+	if d.isBlocked(peer.PubKey()) {
+		log.Debugf("Ignoring %v from blocked peer=%x", msg.MsgType(),
+			peer.PubKey())
+
+		errChan <- nil
+		return errChan
+	}
+
 	// For messages in the known set of channel series queries, we'll
 	// dispatch the message directly to the gossipSyncer, and skip the main
 	// processing loop.
@@ -512,6 +747,26 @@ func (d *AuthenticatedGossiper) ProcessRemoteAnnouncement(msg lnwire.Message,
 		return errChan
 	}
 
+	// We'll throttle the rate at which we accept ChannelUpdates from a
+	// single peer, such that it can't make us burn CPU on validating an
+	// endless stream of them.
+	if _, ok := msg.(*lnwire.ChannelUpdate); ok {
+		if delay := d.channelUpdateDelay(peer.PubKey()); delay > 0 {
+			log.Tracef("Delaying ChannelUpdate from peer=%x by %v",
+				peer.PubKey(), delay)
+
+			select {
+			case <-time.After(delay):
+			case <-peer.QuitSignal():
+				errChan <- ErrPeerQuitting
+				return errChan
+			case <-d.quit:
+				errChan <- ErrGossiperShuttingDown
+				return errChan
+			}
+		}
+	}
+
 	nMsg := &networkMsg{
 		msg:      msg,
 		isRemote: true,
@@ -534,6 +789,31 @@ func (d *AuthenticatedGossiper) ProcessRemoteAnnouncement(msg lnwire.Message,
 	return nMsg.err
 }
 
+// channelUpdateDelay takes a token from the given peer's token bucket for
+// ChannelUpdates, and returns how long we should wait before accepting the
+// peer's ChannelUpdate.
+func (d *AuthenticatedGossiper) channelUpdateDelay(
+	peer [33]byte) time.Duration {
+
+	if d.cfg.ChannelUpdateInterval <= 0 {
+		return 0
+	}
+
+	d.updateLimiterMtx.Lock()
+	defer d.updateLimiterMtx.Unlock()
+
+	limiter, ok := d.updateLimiters[peer]
+	if !ok {
+		limiter = rate.NewLimiter(
+			rate.Every(d.cfg.ChannelUpdateInterval),
+			d.cfg.MaxChannelUpdateBurst,
+		)
+		d.updateLimiters[peer] = limiter
+	}
+
+	return limiter.Reserve().Delay()
+}
+
 // ProcessLocalAnnouncement sends a new remote announcement message along with
 // the peer that sent the routing message. The announcement will be processed
 // then added to a queue for batched trickled announcement to all connected
@@ -561,8 +841,7 @@ func (d *AuthenticatedGossiper) ProcessLocalAnnouncement(msg lnwire.Message,
 }
 
 // channelUpdateID is a unique identifier for ChannelUpdate messages, as
-// channel updates can be identified by the (ShortChannelID, ChannelFlags)
-// tuple.
+// channel updates can be identified by the (ShortChannelID, direction) tuple.
 type channelUpdateID struct {
 	// channelID represents the set of data which is needed to
 	// retrieve all necessary data to validate the channel existence.
@@ -671,12 +950,14 @@ func (d *deDupedAnnouncements) addMsg(message networkMsg) {
 		d.channelAnnouncements[deDupKey] = mws
 
 	// Channel updates are identified by the (short channel id,
-	// channelflags) tuple.
+	// direction) tuple, such that an update replaces any older update
+	// for the same channel direction, even if it e.g. disables the
+	// channel.
 	case *lnwire.ChannelUpdate:
 		sender := routing.NewVertex(message.source)
 		deDupKey := channelUpdateID{
 			msg.ShortChannelID,
-			msg.ChannelFlags,
+			msg.ChannelFlags & lnwire.ChanUpdateDirection,
 		}
 
 		oldTimestamp := uint32(0)
@@ -809,6 +1090,55 @@ func (d *deDupedAnnouncements) Emit() []msgWithSenders {
 	return msgs
 }
 
+// addPrematureAnnouncement holds on to the given premature announcement until
+// our chain tip reaches the given height. If the announcement can't be held on
+// to, it's dropped and the error is returned to its sender.
+func (d *AuthenticatedGossiper) addPrematureAnnouncement(height uint32,
+	nMsg *networkMsg) {
+
+	d.Lock()
+	err := d.prematureAnnouncements.add(
+		height, atomic.LoadUint32(&d.bestHeight), nMsg,
+	)
+	d.Unlock()
+	if err != nil {
+		log.Debugf("Dropping %v: %v", nMsg.msg.MsgType(), err)
+		nMsg.err <- err
+	}
+}
+
+// filterClosedChannels removes the channel announcements and updates of
+// channels that are no longer part of the graph from the given batch. Once the
+// funding output of a channel is spent, the router prunes it from the graph,
+// so there's no point in advertising it to the network any longer.
+func (d *AuthenticatedGossiper) filterClosedChannels(
+	msgs []msgWithSenders) []msgWithSenders {
+
+	filtered := msgs[:0]
+	for _, msg := range msgs {
+		var chanID lnwire.ShortChannelID
+		switch m := msg.msg.(type) {
+		case *lnwire.ChannelAnnouncement:
+			chanID = m.ShortChannelID
+		case *lnwire.ChannelUpdate:
+			chanID = m.ShortChannelID
+		default:
+			filtered = append(filtered, msg)
+			continue
+		}
+
+		if !d.cfg.Router.IsKnownEdge(chanID) {
+			log.Debugf("Skipping announcement for closed "+
+				"short_chan_id=%v", chanID)
+			continue
+		}
+
+		filtered = append(filtered, msg)
+	}
+
+	return filtered
+}
+
 // findGossipSyncer is a utility method used by the gossiper to locate the
 // gossip syncer for an inbound message so we can properly dispatch the
 // incoming message. If a gossip syncer isn't found, then one will be created
@@ -819,9 +1149,7 @@ func (d *AuthenticatedGossiper) findGossipSyncer(pub *btcec.PublicKey) (
 	target := routing.NewVertex(pub)
 
 	// First, we'll try to find an existing gossiper for this peer.
-	d.syncerMtx.RLock()
-	syncer, ok := d.peerSyncers[target]
-	d.syncerMtx.RUnlock()
+	syncer, ok := d.syncMgr.GossipSyncer(target)
 
 	// If one exists, then we'll return it directly.
 	if ok {
@@ -840,6 +1168,18 @@ func (d *AuthenticatedGossiper) findGossipSyncer(pub *btcec.PublicKey) (
 func (d *AuthenticatedGossiper) networkHandler() {
 	defer d.wg.Done()
 
+	// If we panic, we'll restart the handler. Our pending announcement
+	// batch is lost in the process, but will be received again from our
+	// peers.
+	defer d.cfg.Health.Recover("gossiper", func(restart bool) {
+		if !restart {
+			return
+		}
+
+		d.wg.Add(1)
+		go d.networkHandler()
+	})
+
 	// Initialize empty deDupedAnnouncements to store announcement batch.
 	announcements := deDupedAnnouncements{}
 	announcements.Reset()
@@ -850,6 +1190,16 @@ func (d *AuthenticatedGossiper) networkHandler() {
 	trickleTimer := time.NewTicker(d.cfg.TrickleDelay)
 	defer trickleTimer.Stop()
 
+	// If our own ChannelUpdates are batched, we'll add them to the
+	// announcement batch each time the rebroadcast timer ticks.
+	var rebroadcastTicks <-chan time.Time
+	if d.localUpdates != nil {
+		rebroadcastTimer := time.NewTicker(d.cfg.RebroadcastInterval)
+		defer rebroadcastTimer.Stop()
+
+		rebroadcastTicks = rebroadcastTimer.C
+	}
+
 	// To start, we'll first check to see if there are any stale channels
 	// that we need to re-transmit.
 	if err := d.retransmitStaleChannels(); err != nil {
@@ -883,8 +1233,16 @@ func (d *AuthenticatedGossiper) networkHandler() {
 
 			// Finally, with the updates committed, we'll now add
 			// them to the announcement batch to be flushed at the
-			// start of the next epoch.
-			announcements.AddMsgs(newChanUpdates...)
+			// start of the next epoch, unless we batch our own
+			// updates until the next rebroadcast.
+			if d.localUpdates != nil {
+				for _, update := range newChanUpdates {
+					msg := update.msg.(*lnwire.ChannelUpdate)
+					d.localUpdates.add(msg)
+				}
+			} else {
+				announcements.AddMsgs(newChanUpdates...)
+			}
 
 			policyUpdate.errResp <- nil
 
@@ -922,6 +1280,18 @@ func (d *AuthenticatedGossiper) networkHandler() {
 				defer d.wg.Done()
 				defer validationBarrier.CompleteJob()
 
+				// A panic while processing the announcement
+				// only drops the announcement.
+				defer d.cfg.Health.Recover("gossiper", nil)
+
+				// Once done, either successfully or not, we'll
+				// signal any dependent announcements to
+				// continue. This is deferred after Recover, so
+				// it also runs upon a panic.
+				defer validationBarrier.SignalDependants(
+					announcement.msg,
+				)
+
 				// If this message has an existing dependency,
 				// then we'll wait until that has been fully
 				// validated before we proceed.
@@ -947,12 +1317,6 @@ func (d *AuthenticatedGossiper) networkHandler() {
 					announcement,
 				)
 
-				// If this message had any dependencies, then
-				// we can now signal them to continue.
-				validationBarrier.SignalDependants(
-					announcement.msg,
-				)
-
 				// If the announcement was accepted, then add
 				// the emitted announcements to our announce
 				// batch to be broadcast once the trickle timer
@@ -982,22 +1346,22 @@ func (d *AuthenticatedGossiper) networkHandler() {
 			atomic.StoreUint32(&d.bestHeight, blockHeight)
 
 			// Next we check if we have any premature announcements
-			// for this height, if so, then we process them once
-			// more as normal announcements.
+			// for this height, or any lower height in case we
+			// skipped some blocks. If so, then we process them
+			// once more as normal announcements.
 			d.Lock()
-			numPremature := len(d.prematureAnnouncements[blockHeight])
+			premature := d.prematureAnnouncements.take(blockHeight)
 			d.Unlock()
 
 			// Return early if no announcement to process.
-			if numPremature == 0 {
+			if len(premature) == 0 {
 				continue
 			}
 
 			log.Infof("Re-processing %v premature announcements "+
-				"for height %v", numPremature, blockHeight)
+				"for height %v", len(premature), blockHeight)
 
-			d.Lock()
-			for _, ann := range d.prematureAnnouncements[blockHeight] {
+			for _, ann := range premature {
 				emittedAnnouncements := d.processNetworkAnnouncement(ann)
 				if emittedAnnouncements != nil {
 					announcements.AddMsgs(
@@ -1005,8 +1369,6 @@ func (d *AuthenticatedGossiper) networkHandler() {
 					)
 				}
 			}
-			delete(d.prematureAnnouncements, blockHeight)
-			d.Unlock()
 
 		// The trickle timer has ticked, which indicates we should
 		// flush to the network the pending batch of new announcements
@@ -1016,6 +1378,14 @@ func (d *AuthenticatedGossiper) networkHandler() {
 			// deDupedAnnouncements.
 			announcementBatch := announcements.Emit()
 
+			// Channels may have been closed on-chain, and pruned
+			// from the graph, since their announcements were added
+			// to the batch, so we'll make sure not to broadcast
+			// them.
+			announcementBatch = d.filterClosedChannels(
+				announcementBatch,
+			)
+
 			// If the current announcements batch is nil, then we
 			// have no further work here.
 			if len(announcementBatch) == 0 {
@@ -1025,12 +1395,7 @@ func (d *AuthenticatedGossiper) networkHandler() {
 			// For the set of peers that have an active gossip
 			// syncers, we'll collect their pubkeys so we can avoid
 			// sending them the full message blast below.
-			d.syncerMtx.RLock()
-			syncerPeers := make(map[routing.Vertex]*gossipSyncer)
-			for peerPub, syncer := range d.peerSyncers {
-				syncerPeers[peerPub] = syncer
-			}
-			d.syncerMtx.RUnlock()
+			syncerPeers := d.syncMgr.GossipSyncers()
 
 			log.Infof("Broadcasting batch of %v new announcements",
 				len(announcementBatch))
@@ -1061,6 +1426,24 @@ func (d *AuthenticatedGossiper) networkHandler() {
 				}
 			}
 
+		// The rebroadcast timer has ticked, so we'll add the latest of
+		// our own ChannelUpdates to the announcement batch.
+		case <-rebroadcastTicks:
+			updates := d.localUpdates.emit(d.cfg.Router.IsKnownEdge)
+			if len(updates) == 0 {
+				continue
+			}
+
+			log.Debugf("Rebroadcasting %v of our channel updates",
+				len(updates))
+
+			for _, update := range updates {
+				announcements.AddMsgs(networkMsg{
+					source: d.selfKey,
+					msg:    update,
+				})
+			}
+
 		// The retransmission timer has ticked which indicates that we
 		// should check if we need to prune or re-broadcast any of our
 		// personal channels. This addresses the case of "zombie"
@@ -1085,62 +1468,30 @@ func (d *AuthenticatedGossiper) networkHandler() {
 // InitSyncState is called by outside sub-systems when a connection is
 // established to a new peer that understands how to perform channel range
 // queries. We'll allocate a new gossip syncer for it, and start any goroutines
-// needed to handle new queries. The recvUpdates bool indicates if we should
-// continue to receive real-time updates from the remote peer once we've synced
-// channel state.
-func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer,
-	recvUpdates bool) {
-
-	d.syncerMtx.Lock()
-	defer d.syncerMtx.Unlock()
-
-	// If we already have a syncer, then we'll exit early as we don't want
-	// to override it.
-	nodeID := routing.Vertex(syncPeer.PubKey())
-	if _, ok := d.peerSyncers[nodeID]; ok {
-		return
-	}
-
-	log.Infof("Creating new gossipSyncer for peer=%x", nodeID[:])
-
-	encoding := lnwire.EncodingSortedPlain
-	syncer := newGossiperSyncer(gossipSyncerCfg{
-		chainHash:       d.cfg.ChainHash,
-		syncChanUpdates: recvUpdates,
-		channelSeries:   d.cfg.ChanSeries,
-		encodingType:    encoding,
-		chunkSize:       encodingTypeToChunkSize[encoding],
-		sendToPeer: func(msgs ...lnwire.Message) error {
-			return syncPeer.SendMessage(false, msgs...)
-		},
-	})
-	copy(syncer.peerPub[:], nodeID[:])
-	d.peerSyncers[nodeID] = syncer
-
-	syncer.Start()
+// needed to handle new queries. Whether we continue to receive real-time
+// updates from the remote peer once we've synced channel state is decided by
+// the SyncManager, based on the number of active syncers we already have.
+func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer) {
+	d.syncMgr.InitSyncState(syncPeer)
 }
 
 // PruneSyncState is called by outside sub-systems once a peer that we were
 // previously connected to has been disconnected. In this case we can stop the
 // existing gossipSyncer assigned to the peer and free up resources.
 func (d *AuthenticatedGossiper) PruneSyncState(peer *btcec.PublicKey) {
-	d.syncerMtx.Lock()
-	defer d.syncerMtx.Unlock()
-
-	log.Infof("Removing gossipSyncer for peer=%x",
-		peer.SerializeCompressed())
-
 	vertex := routing.NewVertex(peer)
-	syncer, ok := d.peerSyncers[vertex]
-	if !ok {
-		return
-	}
 
-	syncer.Stop()
+	d.syncMgr.PruneSyncState(vertex)
 
-	delete(d.peerSyncers, vertex)
+	d.updateLimiterMtx.Lock()
+	delete(d.updateLimiters, vertex)
+	d.updateLimiterMtx.Unlock()
+}
 
-	return
+// IsGraphSynced returns whether we've completed the initial sync of the
+// channel graph with at least one of our peers.
+func (d *AuthenticatedGossiper) IsGraphSynced() bool {
+	return d.syncMgr.IsGraphSynced()
 }
 
 // isRecentlyRejectedMsg returns true if we recently rejected a message, and
@@ -1310,6 +1661,23 @@ func (d *AuthenticatedGossiper) processChanPolicyUpdate(
 		)
 		edge.TimeLockDelta = uint16(policyUpdate.newSchema.TimeLockDelta)
 
+		if policyUpdate.newSchema.MinHTLC != nil {
+			edge.MinHTLC = *policyUpdate.newSchema.MinHTLC
+		}
+
+		// If a new maximum HTLC size was specified, we'll signal its
+		// presence through the message flags as well.
+		if policyUpdate.newSchema.MaxHTLC != 0 {
+			edge.MessageFlags |= lnwire.ChanUpdateOptionMaxHtlc
+			edge.MaxHTLC = policyUpdate.newSchema.MaxHTLC
+		}
+
+		// The resulting policy must still allow HTLCs to be forwarded
+		// over the channel, so we'll refuse to apply it otherwise.
+		if err := validatePolicyHtlcRange(info, edge); err != nil {
+			return err
+		}
+
 		edgesToUpdate = append(edgesToUpdate, edgeWithInfo{
 			info: info,
 			edge: edge,
@@ -1346,6 +1714,32 @@ func (d *AuthenticatedGossiper) processChanPolicyUpdate(
 	return chanUpdates, nil
 }
 
+// validatePolicyHtlcRange ensures the HTLC range of the given policy is sane
+// for the channel it belongs to: the maximum HTLC size, if set, must not be
+// below the minimum HTLC size nor exceed the capacity of the channel.
+func validatePolicyHtlcRange(info *channeldb.ChannelEdgeInfo,
+	edge *channeldb.ChannelEdgePolicy) error {
+
+	if !edge.MessageFlags.HasMaxHtlc() {
+		return nil
+	}
+
+	capacity := lnwire.NewMSatFromSatoshis(info.Capacity)
+	switch {
+	case edge.MaxHTLC > capacity:
+		return fmt.Errorf("max htlc of %v for channel %v exceeds its "+
+			"capacity of %v", edge.MaxHTLC, info.ChannelPoint,
+			capacity)
+
+	case edge.MaxHTLC < edge.MinHTLC:
+		return fmt.Errorf("max htlc of %v for channel %v is below its "+
+			"min htlc of %v", edge.MaxHTLC, info.ChannelPoint,
+			edge.MinHTLC)
+	}
+
+	return nil
+}
+
 // processRejectedEdge examines a rejected edge to see if we can extract any
 // new announcements from it.  An edge will get rejected if we already added
 // the same edge without AuthProof to the graph. If the received announcement
@@ -1388,7 +1782,7 @@ func (d *AuthenticatedGossiper) processRejectedEdge(
 	if err != nil {
 		return nil, err
 	}
-	err = routing.ValidateChannelAnn(chanAnn)
+	err = d.sigVerifier.validateChannelAnn(chanAnn)
 	if err != nil {
 		err := fmt.Errorf("assembled channel announcement proof "+
 			"for shortChanID=%v isn't valid: %v",
@@ -1455,6 +1849,15 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 	// information about a node in one of the channels we know about, or a
 	// updating previously advertised information.
 	case *lnwire.NodeAnnouncement:
+		// Announcements of blocked nodes are ignored, such that they
+		// don't end up in our graph.
+		if nMsg.isRemote && d.isBlocked(msg.NodeID) {
+			log.Debugf("Ignoring node announcement of blocked "+
+				"node=%x", msg.NodeID)
+			nMsg.err <- nil
+			return nil
+		}
+
 		timestamp := time.Unix(int64(msg.Timestamp), 0)
 
 		// We'll quickly ask the router if it already has a
@@ -1465,10 +1868,11 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
-		if err := routing.ValidateNodeAnn(msg); err != nil {
+		if err := d.sigVerifier.validateNodeAnn(msg); err != nil {
 			err := fmt.Errorf("unable to validate "+
 				"node announcement: %v", err)
 			log.Error(err)
+			d.recordInvalidAnn(nMsg, err)
 			nMsg.err <- err
 			return nil
 		}
@@ -1550,9 +1954,21 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			return nil
 		}
 
+		// Channels of blocked nodes are ignored as well.
+		if nMsg.isRemote && (d.isBlocked(msg.NodeID1) ||
+			d.isBlocked(msg.NodeID2)) {
+
+			log.Debugf("Ignoring announcement of short_chan_id=%v "+
+				"with blocked node", msg.ShortChannelID)
+			nMsg.err <- nil
+			return nil
+		}
+
 		// If the advertised inclusionary block is beyond our knowledge
 		// of the chain tip, then we'll put the announcement in limbo
 		// to be fully verified once we advance forward in the chain.
+		// We'll only do so once its signatures check out though, such
+		// that peers can't fill up our cache with bogus announcements.
 		if nMsg.isRemote && isPremature(msg.ShortChannelID, 0) {
 			blockHeight := msg.ShortChannelID.BlockHeight
 			log.Infof("Announcement for chan_id=(%v), is "+
@@ -1562,12 +1978,23 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 				msg.ShortChannelID.BlockHeight,
 				atomic.LoadUint32(&d.bestHeight))
 
-			d.Lock()
-			d.prematureAnnouncements[blockHeight] = append(
-				d.prematureAnnouncements[blockHeight],
-				nMsg,
-			)
-			d.Unlock()
+			err := d.sigVerifier.validateChannelAnn(msg)
+			if err != nil {
+				err := fmt.Errorf("unable to validate "+
+					"premature announcement: %v", err)
+
+				chanID := msg.ShortChannelID.ToUint64()
+				d.rejectMtx.Lock()
+				d.recentRejects[chanID] = struct{}{}
+				d.rejectMtx.Unlock()
+
+				log.Error(err)
+				d.recordInvalidAnn(nMsg, err)
+				nMsg.err <- err
+				return nil
+			}
+
+			d.addPrematureAnnouncement(blockHeight, nMsg)
 			return nil
 		}
 
@@ -1583,7 +2010,8 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// formed.
 		var proof *channeldb.ChannelAuthProof
 		if nMsg.isRemote {
-			if err := routing.ValidateChannelAnn(msg); err != nil {
+			err := d.sigVerifier.validateChannelAnn(msg)
+			if err != nil {
 				err := fmt.Errorf("unable to validate "+
 					"announcement: %v", err)
 				d.rejectMtx.Lock()
@@ -1591,6 +2019,7 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 				d.rejectMtx.Unlock()
 
 				log.Error(err)
+				d.recordInvalidAnn(nMsg, err)
 				nMsg.err <- err
 				return nil
 			}
@@ -1673,6 +2102,20 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 			} else {
 				log.Tracef("Router rejected channel "+
 					"edge: %v", err)
+
+				// The funding output of the channel doesn't
+				// exist or doesn't match the channel, so its
+				// sender is likely misbehaving. Other failures
+				// aren't counted towards a ban: a spent
+				// funding output may belong to a channel that
+				// closed after it was announced, and our own
+				// chain backend may be lagging behind or be on
+				// a stale fork.
+				if routing.IsError(
+					err, routing.ErrInvalidFundingOutput,
+				) {
+					d.recordInvalidAnn(nMsg, err)
+				}
 			}
 
 			nMsg.err <- err
@@ -1777,12 +2220,7 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 				shortChanID, blockHeight,
 				atomic.LoadUint32(&d.bestHeight))
 
-			d.Lock()
-			d.prematureAnnouncements[blockHeight] = append(
-				d.prematureAnnouncements[blockHeight],
-				nMsg,
-			)
-			d.Unlock()
+			d.addPrematureAnnouncement(blockHeight, nMsg)
 			return nil
 		}
 
@@ -1815,6 +2253,25 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 				fallthrough
 			case channeldb.ErrGraphNoEdgesFound:
 				fallthrough
+			case channeldb.ErrZombieEdge:
+				// If the edge was pruned as a zombie, the
+				// update can only resurrect it if it has been
+				// signed by the correct party, as it wasn't
+				// deemed stale above.
+				if err == channeldb.ErrZombieEdge {
+					err := d.processZombieUpdate(
+						chanInfo, msg,
+					)
+					if err != nil {
+						log.Debug(err)
+						nMsg.err <- err
+						return nil
+					}
+				}
+
+				// As the channel was removed from our graph,
+				// we'll wait for it to be announced again.
+				fallthrough
 			case channeldb.ErrEdgeNotFound:
 				// If the edge corresponding to this
 				// ChannelUpdate was not found in the graph,
@@ -1864,12 +2321,26 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// The least-significant bit in the flag on the channel update
 		// announcement tells us "which" side of the channels directed
 		// edge is being updated.
-		var pubKey *btcec.PublicKey
+		var (
+			pubKey      *btcec.PublicKey
+			pubKeyBytes [33]byte
+		)
 		switch {
 		case msg.ChannelFlags&lnwire.ChanUpdateDirection == 0:
 			pubKey, _ = chanInfo.NodeKey1()
+			pubKeyBytes = chanInfo.NodeKey1Bytes
 		case msg.ChannelFlags&lnwire.ChanUpdateDirection == 1:
 			pubKey, _ = chanInfo.NodeKey2()
+			pubKeyBytes = chanInfo.NodeKey2Bytes
+		}
+
+		// Policies of blocked nodes are ignored, such that we never
+		// route through them.
+		if nMsg.isRemote && d.isBlocked(pubKeyBytes) {
+			log.Debugf("Ignoring update of short_chan_id=%v from "+
+				"blocked node=%x", shortChanID, pubKeyBytes)
+			nMsg.err <- nil
+			return nil
 		}
 
 		// Validate the channel announcement with the expected public key and
@@ -1882,6 +2353,7 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 				spew.Sdump(msg.ShortChannelID), err)
 
 			log.Error(rErr)
+			d.recordInvalidAnn(nMsg, rErr)
 			nMsg.err <- rErr
 			return nil
 		}
@@ -1948,8 +2420,15 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// Channel update announcement was successfully processed and
 		// now it can be broadcast to the rest of the network. However,
 		// we'll only broadcast the channel update announcement if it
-		// has an attached authentication proof.
-		if chanInfo.AuthProof != nil {
+		// has an attached authentication proof. Our own updates may be
+		// held back until the next rebroadcast instead.
+		switch {
+		case chanInfo.AuthProof == nil:
+
+		case !nMsg.isRemote && d.localUpdates != nil:
+			d.localUpdates.add(msg)
+
+		default:
 			announcements = append(announcements, networkMsg{
 				peer:   nMsg.peer,
 				source: nMsg.source,
@@ -1983,16 +2462,11 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		// expected announcement height.  This allows us to be tolerant
 		// to other clients if this constraint was changed.
 		if isPremature(msg.ShortChannelID, d.cfg.ProofMatureDelta) {
-			d.Lock()
-			d.prematureAnnouncements[needBlockHeight] = append(
-				d.prematureAnnouncements[needBlockHeight],
-				nMsg,
-			)
-			d.Unlock()
 			log.Infof("Premature proof announcement, "+
 				"current block height lower than needed: %v <"+
 				" %v, add announcement to reprocessing batch",
 				atomic.LoadUint32(&d.bestHeight), needBlockHeight)
+			d.addPrematureAnnouncement(needBlockHeight, nMsg)
 			return nil
 		}
 
@@ -2294,6 +2768,49 @@ func (d *AuthenticatedGossiper) fetchNodeAnn(
 	return node.NodeAnnouncement(true)
 }
 
+// processZombieUpdate determines whether the provided channel update, which
+// refers to a channel we've pruned as a zombie, has been signed by one of the
+// channel's parties. If so, the channel is removed from our zombie index so
+// that it can be announced again.
+func (d *AuthenticatedGossiper) processZombieUpdate(
+	chanInfo *channeldb.ChannelEdgeInfo, msg *lnwire.ChannelUpdate) error {
+
+	// The least-significant bit in the flag on the channel update tells us
+	// which edge is being updated.
+	isNode1 := msg.ChannelFlags&lnwire.ChanUpdateDirection == 0
+
+	var pubKeyBytes [33]byte
+	if isNode1 {
+		pubKeyBytes = chanInfo.NodeKey1Bytes
+	} else {
+		pubKeyBytes = chanInfo.NodeKey2Bytes
+	}
+
+	pubKey, err := btcec.ParsePubKey(pubKeyBytes[:], btcec.S256())
+	if err != nil {
+		return fmt.Errorf("unable to parse pubkey for zombie "+
+			"short_chan_id=%v: %v", msg.ShortChannelID, err)
+	}
+
+	err = routing.VerifyChannelUpdateSignature(msg, pubKey)
+	if err != nil {
+		return fmt.Errorf("unable to verify channel update signature "+
+			"for zombie short_chan_id=%v: %v",
+			msg.ShortChannelID, err)
+	}
+
+	err = d.cfg.Router.MarkEdgeLive(msg.ShortChannelID)
+	if err != nil && err != channeldb.ErrZombieEdgeNotFound {
+		return fmt.Errorf("unable to resurrect zombie "+
+			"short_chan_id=%v: %v", msg.ShortChannelID, err)
+	}
+
+	log.Debugf("Resurrected zombie channel short_chan_id=%v",
+		msg.ShortChannelID)
+
+	return nil
+}
+
 // isMsgStale determines whether a message retrieved from the backing
 // MessageStore is seen as stale by the current graph.
 func (d *AuthenticatedGossiper) isMsgStale(msg lnwire.Message) bool {
@@ -2306,7 +2823,9 @@ func (d *AuthenticatedGossiper) isMsgStale(msg lnwire.Message) bool {
 		// If the channel cannot be found, it is most likely a leftover
 		// message for a channel that was closed, so we can consider it
 		// stale.
-		if err == channeldb.ErrEdgeNotFound {
+		if err == channeldb.ErrEdgeNotFound ||
+			err == channeldb.ErrZombieEdge {
+
 			return true
 		}
 		if err != nil {
@@ -2326,11 +2845,13 @@ func (d *AuthenticatedGossiper) isMsgStale(msg lnwire.Message) bool {
 		// never be stale. We should still however check if the channel
 		// is part of our graph. If it's not, we can mark it as stale.
 		_, _, _, err := d.cfg.Router.GetChannelByID(msg.ShortChannelID)
-		if err != nil && err != channeldb.ErrEdgeNotFound {
+		isMissing := err == channeldb.ErrEdgeNotFound ||
+			err == channeldb.ErrZombieEdge
+		if err != nil && !isMissing {
 			log.Debugf("Unable to retrieve channel=%v from graph: "+
 				"%v", err)
 		}
-		return err == channeldb.ErrEdgeNotFound
+		return isMissing
 
 	default:
 		// We'll make sure to not mark any unsupported messages as stale
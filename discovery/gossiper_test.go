@@ -315,6 +315,11 @@ func (r *mockGraphSource) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 	}
 }
 
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+func (r *mockGraphSource) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
+	return nil
+}
+
 type mockNotifier struct {
 	clientCounter uint32
 	epochClients  map[uint32]chan *chainntnfs.BlockEpoch
@@ -786,6 +791,75 @@ func TestProcessAnnouncement(t *testing.T) {
 	}
 }
 
+// TestBlockedNodes checks that announcements originating from blocked nodes,
+// and any gossip sent by them, are ignored.
+func TestBlockedNodes(t *testing.T) {
+	t.Parallel()
+
+	timestamp := uint32(123456)
+
+	ctx, cleanup, err := createTestCtx(0)
+	if err != nil {
+		t.Fatalf("can't create context: %v", err)
+	}
+	defer cleanup()
+
+	ctx.gossiper.cfg.BlockedNodes = map[routing.Vertex]struct{}{
+		routing.NewVertex(nodeKeyPub1): {},
+	}
+
+	ca, err := createRemoteChannelAnnouncement(0)
+	if err != nil {
+		t.Fatalf("can't create channel announcement: %v", err)
+	}
+	na1, err := createNodeAnnouncement(nodeKeyPriv1, timestamp)
+	if err != nil {
+		t.Fatalf("can't create node announcement: %v", err)
+	}
+	na2, err := createNodeAnnouncement(nodeKeyPriv2, timestamp)
+	if err != nil {
+		t.Fatalf("can't create node announcement: %v", err)
+	}
+
+	// Announcements of the blocked node, or of its channels, should be
+	// ignored, as should any gossip sent by the blocked node itself.
+	blockedPeer := &mockPeer{nodeKeyPub1, nil, nil}
+	otherPeer := &mockPeer{nodeKeyPub2, nil, nil}
+	anns := []struct {
+		msg  lnwire.Message
+		peer *mockPeer
+	}{
+		{ca, otherPeer},
+		{na1, otherPeer},
+		{na2, blockedPeer},
+	}
+	for _, ann := range anns {
+		select {
+		case err = <-ctx.gossiper.ProcessRemoteAnnouncement(
+			ann.msg, ann.peer,
+		):
+		case <-time.After(2 * time.Second):
+			t.Fatal("remote announcement not processed")
+		}
+		if err != nil {
+			t.Fatalf("can't process remote announcement: %v", err)
+		}
+	}
+
+	select {
+	case <-ctx.broadcastedMessage:
+		t.Fatal("announcement of blocked node was broadcast")
+	case <-time.After(2 * trickleDelay):
+	}
+
+	if len(ctx.router.infos) != 0 {
+		t.Fatalf("edge of blocked node was added to router")
+	}
+	if len(ctx.router.nodes) != 0 {
+		t.Fatalf("node was added to router")
+	}
+}
+
 // TestPrematureAnnouncement checks that premature announcements are
 // not propagated to the router subsystem until block with according
 // block height received.
@@ -871,6 +945,40 @@ func TestPrematureAnnouncement(t *testing.T) {
 	}
 }
 
+// TestPrematureAnnouncementInvalidSig asserts that premature channel
+// announcements with invalid signatures are rejected right away, rather than
+// being held on to until our chain tip reaches their height.
+func TestPrematureAnnouncementInvalidSig(t *testing.T) {
+	t.Parallel()
+
+	ctx, cleanup, err := createTestCtx(0)
+	if err != nil {
+		t.Fatalf("can't create context: %v", err)
+	}
+	defer cleanup()
+
+	nodePeer := &mockPeer{nodeKeyPriv1.PubKey(), nil, nil}
+
+	ca, err := createRemoteChannelAnnouncement(1)
+	if err != nil {
+		t.Fatalf("can't create channel announcement: %v", err)
+	}
+	ca.NodeSig1 = ca.NodeSig2
+
+	select {
+	case err := <-ctx.gossiper.ProcessRemoteAnnouncement(ca, nodePeer):
+		if err == nil {
+			t.Fatal("expected invalid announcement to be rejected")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("invalid premature announcement wasn't rejected")
+	}
+
+	if len(ctx.router.infos) != 0 {
+		t.Fatal("edge was added to router")
+	}
+}
+
 // TestSignatureAnnouncementLocalFirst ensures that the AuthenticatedGossiper
 // properly processes partial and fully announcement signatures message.
 func TestSignatureAnnouncementLocalFirst(t *testing.T) {
@@ -1920,6 +2028,24 @@ func TestDeDuplicatedAnnouncements(t *testing.T) {
 	}
 	assertChannelUpdate(ua3)
 
+	// A later update disabling the same channel direction should replace
+	// the stored one as well, rather than being broadcast alongside it.
+	ua5, err := createUpdateAnnouncement(
+		0, lnwire.ChanUpdateDisabled, nodeKeyPriv1, timestamp+2,
+	)
+	if err != nil {
+		t.Fatalf("can't create update announcement: %v", err)
+	}
+	announcements.AddMsgs(networkMsg{
+		msg:    ua5,
+		peer:   nodePeer,
+		source: nodePeer.IdentityKey(),
+	})
+	if len(announcements.channelUpdates) != 1 {
+		t.Fatal("channel update not replaced in batch")
+	}
+	assertChannelUpdate(ua5)
+
 	// Next well ensure that node announcements are properly de-duplicated.
 	// We'll first add a single instance with a node's private key.
 	na, err := createNodeAnnouncement(nodeKeyPriv1, timestamp)
@@ -2877,6 +3003,68 @@ func TestSendChannelUpdateReliably(t *testing.T) {
 	assertReceivedChannelUpdate(newChanUpdate)
 }
 
+// TestValidatePolicyHtlcRange asserts that policy updates resulting in an
+// invalid HTLC range for a channel are rejected.
+func TestValidatePolicyHtlcRange(t *testing.T) {
+	t.Parallel()
+
+	info := &channeldb.ChannelEdgeInfo{
+		Capacity: 1000,
+	}
+	capacity := lnwire.NewMSatFromSatoshis(info.Capacity)
+
+	tests := []struct {
+		name   string
+		policy *channeldb.ChannelEdgePolicy
+		valid  bool
+	}{
+		{
+			name: "no max htlc",
+			policy: &channeldb.ChannelEdgePolicy{
+				MinHTLC: capacity + 1,
+			},
+			valid: true,
+		},
+		{
+			name: "valid range",
+			policy: &channeldb.ChannelEdgePolicy{
+				MessageFlags: lnwire.ChanUpdateOptionMaxHtlc,
+				MinHTLC:      1,
+				MaxHTLC:      capacity,
+			},
+			valid: true,
+		},
+		{
+			name: "max htlc above capacity",
+			policy: &channeldb.ChannelEdgePolicy{
+				MessageFlags: lnwire.ChanUpdateOptionMaxHtlc,
+				MaxHTLC:      capacity + 1,
+			},
+			valid: false,
+		},
+		{
+			name: "max htlc below min htlc",
+			policy: &channeldb.ChannelEdgePolicy{
+				MessageFlags: lnwire.ChanUpdateOptionMaxHtlc,
+				MinHTLC:      2,
+				MaxHTLC:      1,
+			},
+			valid: false,
+		},
+	}
+
+	for _, test := range tests {
+		err := validatePolicyHtlcRange(info, test.policy)
+		if test.valid && err != nil {
+			t.Fatalf("%v: expected valid policy, got %v", test.name,
+				err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("%v: expected invalid policy", test.name)
+		}
+	}
+}
+
 func assertMessage(t *testing.T, expected, got lnwire.Message) {
 	t.Helper()
 
@@ -2885,3 +3073,230 @@ func assertMessage(t *testing.T, expected, got lnwire.Message) {
 			spew.Sdump(got))
 	}
 }
+
+// TestChannelUpdateRateLimit asserts that ChannelUpdates from a peer are only
+// delayed once the peer has exhausted its burst allowance, and that each peer
+// has its own allowance.
+func TestChannelUpdateRateLimit(t *testing.T) {
+	t.Parallel()
+
+	const burst = 3
+	gossiper := New(Config{
+		ChannelUpdateInterval: time.Hour,
+		MaxChannelUpdateBurst: burst,
+	}, nodeKeyPub1)
+
+	peer1 := randCompressedPubKey(t)
+	peer2 := randCompressedPubKey(t)
+
+	for i := 0; i < burst; i++ {
+		if delay := gossiper.channelUpdateDelay(peer1); delay != 0 {
+			t.Fatalf("expected update %v to be accepted "+
+				"immediately, got delay of %v", i, delay)
+		}
+	}
+
+	if delay := gossiper.channelUpdateDelay(peer1); delay == 0 {
+		t.Fatalf("expected update exceeding burst to be delayed")
+	}
+
+	if delay := gossiper.channelUpdateDelay(peer2); delay != 0 {
+		t.Fatalf("expected update from other peer to be accepted "+
+			"immediately, got delay of %v", delay)
+	}
+
+	// Without an interval, updates shouldn't be rate limited at all.
+	gossiper = New(Config{}, nodeKeyPub1)
+	for i := 0; i < burst*2; i++ {
+		if delay := gossiper.channelUpdateDelay(peer1); delay != 0 {
+			t.Fatalf("expected no delay, got %v", delay)
+		}
+	}
+}
+
+// TestChannelUpdateRateLimitPeerQuit asserts that the error channel returned
+// for a delayed ChannelUpdate is signaled once the sending peer quits, rather
+// than never being sent on.
+func TestChannelUpdateRateLimitPeerQuit(t *testing.T) {
+	t.Parallel()
+
+	gossiper := New(Config{
+		ChannelUpdateInterval: time.Hour,
+		MaxChannelUpdateBurst: 1,
+	}, nodeKeyPub1)
+
+	quit := make(chan struct{})
+	peer := &mockPeer{nodeKeyPub2, nil, quit}
+
+	// Exhaust the peer's burst allowance so that its next ChannelUpdate
+	// is delayed.
+	gossiper.channelUpdateDelay(peer.PubKey())
+
+	errChan := make(chan (chan error), 1)
+	go func() {
+		errChan <- gossiper.ProcessRemoteAnnouncement(
+			&lnwire.ChannelUpdate{}, peer,
+		)
+	}()
+
+	close(quit)
+
+	var processErr chan error
+	select {
+	case processErr = <-errChan:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("ChannelUpdate wasn't abandoned once the peer quit")
+	}
+
+	select {
+	case err := <-processErr:
+		if err != ErrPeerQuitting {
+			t.Fatalf("expected %v, got %v", ErrPeerQuitting, err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("no error sent for the abandoned ChannelUpdate")
+	}
+}
+
+// TestFilterClosedChannels asserts that the announcements of channels that are
+// no longer part of the graph, e.g. because they were closed on-chain, are
+// removed from a batch before it's broadcast.
+func TestFilterClosedChannels(t *testing.T) {
+	t.Parallel()
+
+	router := newMockRouter(0)
+	gossiper := New(Config{Router: router}, nodeKeyPub1)
+
+	openChanID := lnwire.NewShortChanIDFromInt(1)
+	closedChanID := lnwire.NewShortChanIDFromInt(2)
+	router.infos[openChanID.ToUint64()] = channeldb.ChannelEdgeInfo{
+		ChannelID: openChanID.ToUint64(),
+	}
+
+	openChanAnn := &lnwire.ChannelAnnouncement{ShortChannelID: openChanID}
+	openChanUpdate := &lnwire.ChannelUpdate{ShortChannelID: openChanID}
+	nodeAnn := &lnwire.NodeAnnouncement{}
+	msgs := []msgWithSenders{
+		{msg: openChanAnn},
+		{msg: &lnwire.ChannelAnnouncement{ShortChannelID: closedChanID}},
+		{msg: openChanUpdate},
+		{msg: &lnwire.ChannelUpdate{ShortChannelID: closedChanID}},
+		{msg: nodeAnn},
+	}
+
+	filtered := gossiper.filterClosedChannels(msgs)
+	expected := []lnwire.Message{openChanAnn, openChanUpdate, nodeAnn}
+	if len(filtered) != len(expected) {
+		t.Fatalf("expected %v announcements, got %v", len(expected),
+			len(filtered))
+	}
+	for i, msg := range expected {
+		if filtered[i].msg != msg {
+			t.Fatalf("expected announcement %v to be %v, got %v",
+				i, spew.Sdump(msg), spew.Sdump(filtered[i].msg))
+		}
+	}
+}
+
+// TestBanPeerInvalidAnnouncements asserts that a peer is banned once it has
+// sent us more than MaxInvalidAnnouncements invalid announcements, and that
+// our own announcements are never counted.
+func TestBanPeerInvalidAnnouncements(t *testing.T) {
+	t.Parallel()
+
+	const maxInvalid = 2
+	bannedPeers := make(chan [33]byte, 1)
+	gossiper := New(Config{
+		MaxInvalidAnnouncements: maxInvalid,
+		BanPeer: func(peer [33]byte, reason string) error {
+			bannedPeers <- peer
+			return nil
+		},
+	}, nodeKeyPub1)
+
+	remoteMsg := &networkMsg{
+		source:   nodeKeyPub2,
+		msg:      &lnwire.NodeAnnouncement{},
+		isRemote: true,
+	}
+	localMsg := &networkMsg{
+		source: nodeKeyPub1,
+		msg:    &lnwire.NodeAnnouncement{},
+	}
+
+	assertNotBanned := func() {
+		t.Helper()
+
+		select {
+		case peer := <-bannedPeers:
+			t.Fatalf("peer %x unexpectedly banned", peer)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	// Our own invalid announcements shouldn't count towards a ban.
+	for i := 0; i < maxInvalid+1; i++ {
+		gossiper.recordInvalidAnn(localMsg, errors.New("invalid"))
+	}
+	assertNotBanned()
+
+	// The remote peer should only be banned once it exceeds the number of
+	// invalid announcements we tolerate.
+	for i := 0; i < maxInvalid; i++ {
+		gossiper.recordInvalidAnn(remoteMsg, errors.New("invalid"))
+	}
+	assertNotBanned()
+
+	gossiper.recordInvalidAnn(remoteMsg, errors.New("invalid"))
+	select {
+	case peer := <-bannedPeers:
+		if routing.Vertex(peer) != routing.NewVertex(nodeKeyPub2) {
+			t.Fatalf("expected peer %x to be banned, got %x",
+				nodeKeyPub2.SerializeCompressed(), peer)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("peer wasn't banned")
+	}
+}
+
+// TestInvalidAnnCountDecay asserts that one invalid announcement is forgiven
+// for each full decay interval that passes.
+func TestInvalidAnnCountDecay(t *testing.T) {
+	t.Parallel()
+
+	const interval = time.Minute
+	start := time.Unix(1000, 0)
+
+	count := &invalidCount{count: 5, lastDecay: start}
+
+	// Within the first interval, nothing should be forgiven.
+	count.decay(start.Add(interval/2), interval)
+	if count.count != 5 {
+		t.Fatalf("expected count 5, got %v", count.count)
+	}
+
+	// After two and a half intervals, two announcements should be
+	// forgiven, while the remaining half interval still counts towards
+	// the next decay.
+	count.decay(start.Add(5*interval/2), interval)
+	if count.count != 3 {
+		t.Fatalf("expected count 3, got %v", count.count)
+	}
+	count.decay(start.Add(3*interval), interval)
+	if count.count != 2 {
+		t.Fatalf("expected count 2, got %v", count.count)
+	}
+
+	// The count should never decay below zero.
+	count.decay(start.Add(10*interval), interval)
+	if count.count != 0 {
+		t.Fatalf("expected count 0, got %v", count.count)
+	}
+
+	// Without a decay interval, counts never decay.
+	count = &invalidCount{count: 5, lastDecay: start}
+	count.decay(start.Add(10*interval), 0)
+	if count.count != 5 {
+		t.Fatalf("expected count 5, got %v", count.count)
+	}
+}
@@ -0,0 +1,131 @@
+package discovery
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultRebroadcastInterval is the default interval at which we'll
+	// broadcast the batch of our own ChannelUpdates.
+	DefaultRebroadcastInterval = time.Minute
+
+	// DefaultChannelUpdateInterval is the default interval at which we'll
+	// accept ChannelUpdates from a single peer once it has exhausted its
+	// burst allowance.
+	DefaultChannelUpdateInterval = 5 * time.Millisecond
+
+	// DefaultMaxChannelUpdateBurst is the default number of ChannelUpdates
+	// we'll accept from a single peer in quick succession before rate
+	// limiting it.
+	DefaultMaxChannelUpdateBurst = 1000
+)
+
+// localUpdateBatch collects our own ChannelUpdates until they're due to be
+// broadcast. Only the latest update for each channel direction is kept, and
+// updates that don't change the policy we last broadcast for their channel
+// direction are dropped. This ensures that a channel that keeps on being
+// disabled and re-enabled, e.g. because its peer is flapping, doesn't make us
+// spam the network with updates.
+type localUpdateBatch struct {
+	// pending is the latest update for each channel direction that hasn't
+	// been broadcast yet.
+	pending map[channelUpdateID]*lnwire.ChannelUpdate
+
+	// broadcast is the last update we've broadcast for each channel
+	// direction.
+	broadcast map[channelUpdateID]*lnwire.ChannelUpdate
+
+	sync.Mutex
+}
+
+// newLocalUpdateBatch returns a new, empty localUpdateBatch.
+func newLocalUpdateBatch() *localUpdateBatch {
+	return &localUpdateBatch{
+		pending:   make(map[channelUpdateID]*lnwire.ChannelUpdate),
+		broadcast: make(map[channelUpdateID]*lnwire.ChannelUpdate),
+	}
+}
+
+// add adds the given update to the batch, replacing any older pending update
+// for the same channel direction.
+func (b *localUpdateBatch) add(update *lnwire.ChannelUpdate) {
+	b.Lock()
+	defer b.Unlock()
+
+	key := localUpdateKey(update)
+	if prev, ok := b.pending[key]; ok && prev.Timestamp > update.Timestamp {
+		return
+	}
+
+	b.pending[key] = update
+}
+
+// emit returns the pending updates that change the policy we last broadcast
+// for their channel direction, and resets the batch. The returned updates are
+// assumed to be broadcast. Channels for which isOpen returns false, e.g.
+// because their funding output has been spent since their update was added,
+// are dropped from the batch entirely.
+func (b *localUpdateBatch) emit(
+	isOpen func(lnwire.ShortChannelID) bool) []*lnwire.ChannelUpdate {
+
+	b.Lock()
+	defer b.Unlock()
+
+	for key := range b.broadcast {
+		if _, ok := b.pending[key]; !ok && !isOpen(key.channelID) {
+			delete(b.broadcast, key)
+		}
+	}
+
+	var updates []*lnwire.ChannelUpdate
+	for key, update := range b.pending {
+		if !isOpen(key.channelID) {
+			log.Debugf("Skipping update for closed "+
+				"short_chan_id=%v", update.ShortChannelID)
+			delete(b.broadcast, key)
+			continue
+		}
+
+		prev, ok := b.broadcast[key]
+		if ok && sameChannelPolicy(prev, update) {
+			log.Debugf("Skipping redundant update for "+
+				"short_chan_id=%v", update.ShortChannelID)
+			continue
+		}
+
+		b.broadcast[key] = update
+		updates = append(updates, update)
+	}
+
+	b.pending = make(map[channelUpdateID]*lnwire.ChannelUpdate)
+
+	return updates
+}
+
+// localUpdateKey returns the key identifying the channel direction of the
+// given update.
+func localUpdateKey(update *lnwire.ChannelUpdate) channelUpdateID {
+	return channelUpdateID{
+		channelID: update.ShortChannelID,
+		flags:     update.ChannelFlags & lnwire.ChanUpdateDirection,
+	}
+}
+
+// sameChannelPolicy returns whether the two updates advertise the same policy,
+// regardless of their timestamp and signature.
+func sameChannelPolicy(a, b *lnwire.ChannelUpdate) bool {
+	return a.ChainHash == b.ChainHash &&
+		a.ShortChannelID == b.ShortChannelID &&
+		a.MessageFlags == b.MessageFlags &&
+		a.ChannelFlags == b.ChannelFlags &&
+		a.TimeLockDelta == b.TimeLockDelta &&
+		a.HtlcMinimumMsat == b.HtlcMinimumMsat &&
+		a.HtlcMaximumMsat == b.HtlcMaximumMsat &&
+		a.BaseFee == b.BaseFee &&
+		a.FeeRate == b.FeeRate &&
+		bytes.Equal(a.ExtraOpaqueData, b.ExtraOpaqueData)
+}
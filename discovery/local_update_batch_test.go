@@ -0,0 +1,106 @@
+package discovery
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestLocalUpdateBatch asserts that the localUpdateBatch only emits the latest
+// update of each channel direction, and drops updates that don't change the
+// policy last emitted for their channel direction, as well as updates of closed
+// channels.
+func TestLocalUpdateBatch(t *testing.T) {
+	t.Parallel()
+
+	const timestamp = 123456
+
+	newUpdate := func(chanID uint64, flags lnwire.ChanUpdateChanFlags,
+		ts uint32) *lnwire.ChannelUpdate {
+
+		return &lnwire.ChannelUpdate{
+			ShortChannelID: lnwire.NewShortChanIDFromInt(chanID),
+			Timestamp:      ts,
+			ChannelFlags:   flags,
+			BaseFee:        1000,
+			FeeRate:        1,
+		}
+	}
+
+	closedChans := make(map[lnwire.ShortChannelID]struct{})
+	isOpen := func(chanID lnwire.ShortChannelID) bool {
+		_, ok := closedChans[chanID]
+		return !ok
+	}
+
+	assertEmitted := func(batch *localUpdateBatch,
+		expected ...*lnwire.ChannelUpdate) {
+
+		t.Helper()
+
+		emitted := batch.emit(isOpen)
+		if len(emitted) != len(expected) {
+			t.Fatalf("expected %v updates, got %v", len(expected),
+				len(emitted))
+		}
+
+		for _, update := range expected {
+			found := false
+			for _, emittedUpdate := range emitted {
+				if emittedUpdate == update {
+					found = true
+					break
+				}
+			}
+			if !found {
+				t.Fatalf("update %v not emitted",
+					update.ShortChannelID)
+			}
+		}
+	}
+
+	batch := newLocalUpdateBatch()
+
+	// Initially, the updates of both directions of a channel, as well as
+	// the update of another channel, should be emitted.
+	update1 := newUpdate(1, 0, timestamp)
+	update2 := newUpdate(1, lnwire.ChanUpdateDirection, timestamp)
+	update3 := newUpdate(2, 0, timestamp)
+	batch.add(update1)
+	batch.add(update2)
+	batch.add(update3)
+	assertEmitted(batch, update1, update2, update3)
+
+	// The batch is reset once emitted.
+	assertEmitted(batch)
+
+	// We'll now disable the first channel and enable it again. Only the
+	// latest update is kept, and since it doesn't change the policy we
+	// emitted before, it's dropped entirely.
+	batch.add(newUpdate(1, lnwire.ChanUpdateDisabled, timestamp+1))
+	batch.add(newUpdate(1, 0, timestamp+2))
+	assertEmitted(batch)
+
+	// An older update shouldn't replace a newer one within the batch.
+	disabled := newUpdate(1, lnwire.ChanUpdateDisabled, timestamp+4)
+	batch.add(disabled)
+	batch.add(newUpdate(1, 0, timestamp+3))
+	assertEmitted(batch, disabled)
+
+	// Now that the disabled update was emitted, enabling the channel
+	// again changes its policy, so it should be emitted.
+	enabled := newUpdate(1, 0, timestamp+5)
+	batch.add(enabled)
+	assertEmitted(batch, enabled)
+
+	// Once the second channel is closed, its pending update shouldn't be
+	// emitted, and the channel should be forgotten entirely.
+	closedChans[update3.ShortChannelID] = struct{}{}
+	batch.add(newUpdate(2, lnwire.ChanUpdateDisabled, timestamp+1))
+	assertEmitted(batch)
+
+	key := localUpdateKey(update3)
+	if _, ok := batch.broadcast[key]; ok {
+		t.Fatalf("closed channel not removed from batch")
+	}
+}
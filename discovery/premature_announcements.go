@@ -0,0 +1,150 @@
+package discovery
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+const (
+	// DefaultPrematureCacheSize is the default number of premature
+	// announcements we'll hold on to until our chain tip catches up.
+	DefaultPrematureCacheSize = 10000
+
+	// DefaultPrematurePeerQuota is the default number of premature
+	// announcements we'll hold on to for a single peer.
+	DefaultPrematurePeerQuota = 1000
+
+	// DefaultPrematureHeightDelta is the default number of blocks an
+	// announcement may reference beyond our chain tip for it to be held on
+	// to, rather than dropped.
+	DefaultPrematureHeightDelta = 144
+)
+
+// prematureAnnouncements holds on to network messages that are "premature"
+// from our PoV, as they reference a block beyond our current chain tip, until
+// the chain tip reaches that block. This prevents gaps in our graph when our
+// chain backend lags behind the gossip of our peers. The cache is bounded in
+// the number of messages it holds, both in total and for each peer, and in how
+// far beyond our chain tip their referenced block may be, so that peers can't
+// make us hold on to messages indefinitely, or crowd out the messages of other
+// peers. Our own messages aren't subject to these bounds, and are never
+// dropped.
+//
+// NOTE: The cache isn't safe for concurrent use, so it must be guarded by the
+// gossiper's mutex.
+type prematureAnnouncements struct {
+	// maxSize is the maximum number of remote messages held by the cache.
+	// If zero, the number of messages isn't bounded.
+	maxSize int
+
+	// maxPerPeer is the maximum number of messages held by the cache for
+	// a single peer. If zero, the number of messages per peer isn't
+	// bounded.
+	maxPerPeer int
+
+	// maxHeightDelta is the maximum number of blocks the height of a
+	// message may be beyond our chain tip. If zero, the height isn't
+	// bounded.
+	maxHeightDelta uint32
+
+	// msgs maps a block height to the messages that become valid once the
+	// chain tip reaches it.
+	msgs map[uint32][]*networkMsg
+
+	// size is the total number of remote messages held by the cache.
+	size int
+
+	// peerSizes is the number of messages held by the cache for each
+	// peer.
+	peerSizes map[routing.Vertex]int
+}
+
+// newPrematureAnnouncements creates a new, empty cache of premature
+// announcements with the given bounds.
+func newPrematureAnnouncements(maxSize, maxPerPeer int,
+	maxHeightDelta uint32) *prematureAnnouncements {
+
+	return &prematureAnnouncements{
+		maxSize:        maxSize,
+		maxPerPeer:     maxPerPeer,
+		maxHeightDelta: maxHeightDelta,
+		msgs:           make(map[uint32][]*networkMsg),
+		peerSizes:      make(map[routing.Vertex]int),
+	}
+}
+
+// add holds on to the given message until the chain tip reaches the given
+// height. For remote messages, an error is returned if the cache or the quota
+// of the sending peer is full, or if the height is too far beyond the passed
+// chain tip. Local messages are always held on to.
+func (p *prematureAnnouncements) add(height, bestHeight uint32,
+	nMsg *networkMsg) error {
+
+	if !nMsg.isRemote {
+		p.msgs[height] = append(p.msgs[height], nMsg)
+		return nil
+	}
+
+	if p.maxHeightDelta != 0 && height > bestHeight+p.maxHeightDelta {
+		return fmt.Errorf("premature announcement references height "+
+			"%v, which is too far beyond our chain tip at height "+
+			"%v", height, bestHeight)
+	}
+
+	if p.maxSize != 0 && p.size >= p.maxSize {
+		return fmt.Errorf("unable to hold on to premature "+
+			"announcement for height %v: cache is full", height)
+	}
+
+	peer := routing.NewVertex(nMsg.source)
+	if p.maxPerPeer != 0 && p.peerSizes[peer] >= p.maxPerPeer {
+		return fmt.Errorf("unable to hold on to premature "+
+			"announcement for height %v: quota of peer %v is "+
+			"full", height, peer)
+	}
+
+	p.msgs[height] = append(p.msgs[height], nMsg)
+	p.size++
+	p.peerSizes[peer]++
+
+	return nil
+}
+
+// take removes and returns the messages that became valid now that the chain
+// tip has reached the given height, in order of ascending height. This
+// includes the messages of any lower heights, in case we skipped some blocks.
+func (p *prematureAnnouncements) take(bestHeight uint32) []*networkMsg {
+	var heights []uint32
+	for height := range p.msgs {
+		if height <= bestHeight {
+			heights = append(heights, height)
+		}
+	}
+	sort.Slice(heights, func(i, j int) bool {
+		return heights[i] < heights[j]
+	})
+
+	var msgs []*networkMsg
+	for _, height := range heights {
+		msgs = append(msgs, p.msgs[height]...)
+		delete(p.msgs, height)
+	}
+
+	for _, nMsg := range msgs {
+		if !nMsg.isRemote {
+			continue
+		}
+
+		p.size--
+
+		peer := routing.NewVertex(nMsg.source)
+		p.peerSizes[peer]--
+		if p.peerSizes[peer] == 0 {
+			delete(p.peerSizes, peer)
+		}
+	}
+
+	return msgs
+}
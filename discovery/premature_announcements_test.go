@@ -0,0 +1,102 @@
+package discovery
+
+import (
+	"testing"
+)
+
+// TestPrematureAnnouncements asserts that the cache of premature announcements
+// enforces its bounds, and returns the announcements of all heights reached by
+// the chain tip in order.
+func TestPrematureAnnouncements(t *testing.T) {
+	t.Parallel()
+
+	const (
+		maxSize        = 3
+		maxPerPeer     = 2
+		maxHeightDelta = 10
+		bestHeight     = 100
+	)
+
+	cache := newPrematureAnnouncements(maxSize, maxPerPeer, maxHeightDelta)
+
+	remoteMsg := func() *networkMsg {
+		return &networkMsg{source: nodeKeyPub1, isRemote: true}
+	}
+	otherRemoteMsg := func() *networkMsg {
+		return &networkMsg{source: nodeKeyPub2, isRemote: true}
+	}
+
+	// An announcement referencing a height too far beyond our chain tip
+	// should be rejected.
+	err := cache.add(bestHeight+maxHeightDelta+1, bestHeight, remoteMsg())
+	if err == nil {
+		t.Fatalf("expected announcement too far ahead to be rejected")
+	}
+
+	// We'll add announcements of several heights, until the quota of the
+	// first peer is exhausted, after which any further announcement of
+	// that peer should be rejected.
+	msg1, msg2, msg3 := remoteMsg(), remoteMsg(), otherRemoteMsg()
+	if err := cache.add(bestHeight+2, bestHeight, msg2); err != nil {
+		t.Fatalf("unable to add announcement: %v", err)
+	}
+	if err := cache.add(bestHeight+1, bestHeight, msg1); err != nil {
+		t.Fatalf("unable to add announcement: %v", err)
+	}
+	err = cache.add(bestHeight+1, bestHeight, remoteMsg())
+	if err == nil {
+		t.Fatalf("expected announcement to be rejected by peer quota")
+	}
+
+	// The other peer should still be able to fill up the cache, after
+	// which any further remote announcement should be rejected.
+	if err := cache.add(bestHeight+3, bestHeight, msg3); err != nil {
+		t.Fatalf("unable to add announcement: %v", err)
+	}
+	err = cache.add(bestHeight+1, bestHeight, otherRemoteMsg())
+	if err == nil {
+		t.Fatalf("expected announcement to be rejected by full cache")
+	}
+
+	// Our own announcements should never be rejected, regardless of the
+	// bounds of the cache.
+	localMsg := &networkMsg{}
+	err = cache.add(bestHeight+maxHeightDelta+1, bestHeight, localMsg)
+	if err != nil {
+		t.Fatalf("unable to add local announcement: %v", err)
+	}
+
+	// Nothing should be returned for our current height.
+	if msgs := cache.take(bestHeight); len(msgs) != 0 {
+		t.Fatalf("expected no announcements, got %v", len(msgs))
+	}
+
+	// If we skip a block, the announcements of both heights should be
+	// returned in order.
+	msgs := cache.take(bestHeight + 2)
+	if len(msgs) != 2 || msgs[0] != msg1 || msgs[1] != msg2 {
+		t.Fatalf("expected announcements of both heights in order")
+	}
+
+	// Now that the cache and the quota of the first peer have room again,
+	// new announcements should be accepted.
+	msg4 := remoteMsg()
+	if err := cache.add(bestHeight+3, bestHeight+2, msg4); err != nil {
+		t.Fatalf("unable to add announcement: %v", err)
+	}
+
+	msgs = cache.take(bestHeight + 3)
+	if len(msgs) != 2 || msgs[0] != msg3 || msgs[1] != msg4 {
+		t.Fatalf("expected announcements of last height")
+	}
+	if cache.size != 0 || len(cache.peerSizes) != 0 {
+		t.Fatalf("expected empty cache, has size %v", cache.size)
+	}
+
+	// Finally, our own announcement should be returned once its height
+	// is reached.
+	msgs = cache.take(bestHeight + maxHeightDelta + 1)
+	if len(msgs) != 1 || msgs[0] != localMsg {
+		t.Fatalf("expected local announcement")
+	}
+}
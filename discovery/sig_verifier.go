@@ -0,0 +1,103 @@
+package discovery
+
+import (
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/pool"
+	"github.com/lightningnetwork/lnd/routing"
+)
+
+// sigVerifier verifies the signatures of gossip messages on a pool of worker
+// goroutines. The signatures of a single announcement are verified in
+// parallel, while the total number of signatures being verified at any given
+// time is bounded by the number of workers. This allows us to keep up with the
+// flood of announcements received during the initial graph sync on multi-core
+// machines, without starving the rest of the daemon.
+type sigVerifier struct {
+	workerPool *pool.Worker
+}
+
+// newSigVerifier creates a new sigVerifier backed by the given number of
+// worker goroutines.
+func newSigVerifier(numWorkers int) *sigVerifier {
+	return &sigVerifier{
+		workerPool: pool.NewWorker(&pool.WorkerConfig{
+			NewWorkerState: newSigVerifyState,
+			NumWorkers:     numWorkers,
+			WorkerTimeout:  pool.DefaultWorkerTimeout,
+		}),
+	}
+}
+
+// Start spins up the sigVerifier's worker pool.
+func (v *sigVerifier) Start() error {
+	return v.workerPool.Start()
+}
+
+// Stop shuts down the sigVerifier's worker pool.
+func (v *sigVerifier) Stop() error {
+	return v.workerPool.Stop()
+}
+
+// verify verifies the given signature checks in parallel, returning the error
+// of the first invalid signature found.
+func (v *sigVerifier) verify(checks ...*routing.SigCheck) error {
+	errChan := make(chan error, len(checks))
+	for _, check := range checks {
+		check := check
+		go func() {
+			errChan <- v.workerPool.Submit(
+				func(pool.WorkerState) error {
+					return check.Verify()
+				},
+			)
+		}()
+	}
+
+	for range checks {
+		if err := <-errChan; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateChannelAnn validates the four signatures of the given channel
+// announcement in parallel.
+func (v *sigVerifier) validateChannelAnn(a *lnwire.ChannelAnnouncement) error {
+	checks, err := routing.ChannelAnnSigChecks(a)
+	if err != nil {
+		return err
+	}
+
+	return v.verify(checks...)
+}
+
+// validateNodeAnn validates the signature of the given node announcement.
+func (v *sigVerifier) validateNodeAnn(a *lnwire.NodeAnnouncement) error {
+	check, err := routing.NodeAnnSigCheck(a)
+	if err != nil {
+		return err
+	}
+
+	return v.verify(check)
+}
+
+// sigVerifyState is the per-goroutine state of the sigVerifier's workers.
+// Verifying a signature doesn't require any state, so it's empty.
+type sigVerifyState struct{}
+
+// newSigVerifyState returns a new sigVerifyState.
+func newSigVerifyState() pool.WorkerState {
+	return &sigVerifyState{}
+}
+
+// Reset is a no-op, as a sigVerifyState holds no state.
+//
+// NOTE: Part of the pool.WorkerState interface.
+func (s *sigVerifyState) Reset() {}
+
+// Cleanup is a no-op, as a sigVerifyState holds no state.
+//
+// NOTE: Part of the pool.WorkerState interface.
+func (s *sigVerifyState) Cleanup() {}
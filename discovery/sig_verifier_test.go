@@ -0,0 +1,49 @@
+package discovery
+
+import (
+	"testing"
+)
+
+// TestSigVerifier asserts that the sigVerifier accepts validly signed
+// announcements, and rejects announcements with any invalid signature.
+func TestSigVerifier(t *testing.T) {
+	t.Parallel()
+
+	verifier := newSigVerifier(2)
+	if err := verifier.Start(); err != nil {
+		t.Fatalf("unable to start sig verifier: %v", err)
+	}
+	defer verifier.Stop()
+
+	chanAnn, err := createRemoteChannelAnnouncement(0)
+	if err != nil {
+		t.Fatalf("can't create channel announcement: %v", err)
+	}
+	if err := verifier.validateChannelAnn(chanAnn); err != nil {
+		t.Fatalf("unable to validate channel announcement: %v", err)
+	}
+
+	// Swapping the signatures of the second bitcoin key and node makes
+	// both of them invalid.
+	chanAnn.BitcoinSig2, chanAnn.NodeSig2 = chanAnn.NodeSig2,
+		chanAnn.BitcoinSig2
+	if err := verifier.validateChannelAnn(chanAnn); err == nil {
+		t.Fatalf("expected invalid channel announcement to be " +
+			"rejected")
+	}
+
+	nodeAnn, err := createNodeAnnouncement(nodeKeyPriv1, 123456)
+	if err != nil {
+		t.Fatalf("can't create node announcement: %v", err)
+	}
+	if err := verifier.validateNodeAnn(nodeAnn); err != nil {
+		t.Fatalf("unable to validate node announcement: %v", err)
+	}
+
+	// Changing the announcement after it was signed invalidates its
+	// signature.
+	nodeAnn.Timestamp++
+	if err := verifier.validateNodeAnn(nodeAnn); err == nil {
+		t.Fatalf("expected invalid node announcement to be rejected")
+	}
+}
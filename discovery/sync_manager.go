@@ -0,0 +1,340 @@
+package discovery
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultNumActiveSyncers is the default number of peers from which
+	// we'll request real-time channel updates.
+	DefaultNumActiveSyncers = 3
+
+	// DefaultSyncerRotationInterval is the default interval at which we'll
+	// swap one of our active syncers for a passive one.
+	DefaultSyncerRotationInterval = 20 * time.Minute
+)
+
+// SyncManagerCfg contains all of the dependencies required for the
+// SyncManager to carry out its duties.
+type SyncManagerCfg struct {
+	// ChainHash is a hash that indicates the specific network of the
+	// active chain.
+	ChainHash chainhash.Hash
+
+	// ChanSeries is an interface that provides time and block based
+	// queries of the channel graph, used by the gossip syncers we create.
+	ChanSeries ChannelGraphTimeSeries
+
+	// NumActiveSyncers is the maximum number of gossip syncers that will
+	// request real-time channel updates from their peer at any given time.
+	// All other gossip syncers are passive: they'll only synchronize the
+	// channel graph state with their peer once upon connecting.
+	NumActiveSyncers int
+
+	// RotateTicker is a ticker responsible for notifying the SyncManager
+	// when it should rotate one of its active syncers for a passive one.
+	// This prevents us from relying on the same peers for our real-time
+	// channel updates.
+	RotateTicker ticker.Ticker
+}
+
+// SyncManager is a subsystem of the gossiper that manages the gossip syncers
+// for peers currently connected. Upon connecting, a peer is assigned an
+// active syncer if we're still below our target number of active syncers,
+// otherwise it's assigned a passive one. Passive syncers only reconcile the
+// set of channels they know of with their peer, without receiving a full dump
+// of the peer's channel graph or any later channel updates. Periodically, one
+// of the active syncers is swapped for a passive one.
+type SyncManager struct {
+	// graphSynced is set once one of our gossip syncers has completed its
+	// initial sync of the channel graph with its peer. It must be used
+	// atomically.
+	graphSynced int32
+
+	started sync.Once
+	stopped sync.Once
+
+	cfg SyncManagerCfg
+
+	// syncersMtx guards the read and write access to the active and
+	// passive syncer maps.
+	syncersMtx sync.RWMutex
+
+	// activeSyncers is the set of gossip syncers that request real-time
+	// channel updates from their peer.
+	activeSyncers map[routing.Vertex]*gossipSyncer
+
+	// passiveSyncers is the set of gossip syncers that don't request
+	// real-time channel updates from their peer.
+	passiveSyncers map[routing.Vertex]*gossipSyncer
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newSyncManager constructs a new SyncManager backed by the given config.
+func newSyncManager(cfg SyncManagerCfg) *SyncManager {
+	return &SyncManager{
+		cfg:            cfg,
+		activeSyncers:  make(map[routing.Vertex]*gossipSyncer),
+		passiveSyncers: make(map[routing.Vertex]*gossipSyncer),
+		quit:           make(chan struct{}),
+	}
+}
+
+// Start starts the SyncManager in order to properly carry out its duties.
+func (m *SyncManager) Start() {
+	m.started.Do(func() {
+		m.wg.Add(1)
+		go m.syncerHandler()
+	})
+}
+
+// Stop stops the SyncManager along with all of the gossip syncers it manages.
+func (m *SyncManager) Stop() {
+	m.stopped.Do(func() {
+		close(m.quit)
+		m.wg.Wait()
+
+		m.syncersMtx.RLock()
+		defer m.syncersMtx.RUnlock()
+
+		for _, syncer := range m.activeSyncers {
+			syncer.Stop()
+		}
+		for _, syncer := range m.passiveSyncers {
+			syncer.Stop()
+		}
+	})
+}
+
+// syncerHandler is the SyncManager's main event loop, responsible for
+// periodically rotating our active syncers.
+//
+// NOTE: This must be run as a goroutine.
+func (m *SyncManager) syncerHandler() {
+	defer m.wg.Done()
+
+	m.cfg.RotateTicker.Resume()
+	defer m.cfg.RotateTicker.Stop()
+
+	for {
+		select {
+		case <-m.cfg.RotateTicker.Ticks():
+			m.rotateActiveSyncer()
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// rotateActiveSyncer swaps one of our active syncers for one of our passive
+// ones. Only syncers that have already synchronized their channel graph state
+// with their peer are considered.
+func (m *SyncManager) rotateActiveSyncer() {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	activePeer, activeSyncer := chooseSyncedSyncer(m.activeSyncers)
+	if activeSyncer == nil {
+		return
+	}
+	passivePeer, passiveSyncer := chooseSyncedSyncer(m.passiveSyncers)
+	if passiveSyncer == nil {
+		return
+	}
+
+	log.Debugf("Rotating active gossipSyncer(%x) for passive "+
+		"gossipSyncer(%x)", activePeer[:], passivePeer[:])
+
+	activeSyncer.ProcessSyncTransition(passiveSync)
+	delete(m.activeSyncers, activePeer)
+	m.passiveSyncers[activePeer] = activeSyncer
+
+	passiveSyncer.ProcessSyncTransition(activeSync)
+	delete(m.passiveSyncers, passivePeer)
+	m.activeSyncers[passivePeer] = passiveSyncer
+}
+
+// chooseSyncedSyncer returns a random gossip syncer from the given set that
+// has already synchronized its channel graph state with its peer. If there
+// isn't one, then a nil syncer is returned.
+func chooseSyncedSyncer(syncers map[routing.Vertex]*gossipSyncer) (
+	routing.Vertex, *gossipSyncer) {
+
+	// Map iteration order is randomized, so we'll simply pick the first
+	// eligible syncer we come across.
+	for peer, syncer := range syncers {
+		if syncer.SyncState() != chansSynced {
+			continue
+		}
+
+		return peer, syncer
+	}
+
+	return routing.Vertex{}, nil
+}
+
+// InitSyncState is called by the gossiper once a connection has been
+// established to a new peer that understands how to perform channel range
+// queries. We'll allocate a new gossip syncer for it, which will be active if
+// we're still below our target number of active syncers, and passive
+// otherwise.
+func (m *SyncManager) InitSyncState(peer lnpeer.Peer) {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	// If we already have a syncer, then we'll exit early as we don't want
+	// to override it.
+	nodeID := routing.Vertex(peer.PubKey())
+	if _, ok := m.gossipSyncer(nodeID); ok {
+		return
+	}
+
+	syncChanUpdates := len(m.activeSyncers) < m.cfg.NumActiveSyncers
+
+	log.Infof("Creating new gossipSyncer for peer=%x, syncChanUpdates=%v",
+		nodeID[:], syncChanUpdates)
+
+	encoding := lnwire.EncodingSortedPlain
+	syncer := newGossiperSyncer(gossipSyncerCfg{
+		chainHash:       m.cfg.ChainHash,
+		syncChanUpdates: syncChanUpdates,
+		channelSeries:   m.cfg.ChanSeries,
+		encodingType:    encoding,
+		chunkSize:       encodingTypeToChunkSize[encoding],
+		sendToPeer: func(msgs ...lnwire.Message) error {
+			return peer.SendMessageLazy(false, msgs...)
+		},
+	})
+	copy(syncer.peerPub[:], nodeID[:])
+
+	if syncChanUpdates {
+		m.activeSyncers[nodeID] = syncer
+	} else {
+		m.passiveSyncers[nodeID] = syncer
+	}
+
+	syncer.Start()
+}
+
+// PruneSyncState is called by the gossiper once a peer that we were previously
+// connected to has been disconnected. We'll stop the gossip syncer assigned to
+// the peer, and if it was an active one, replace it with one of our passive
+// syncers.
+func (m *SyncManager) PruneSyncState(peer routing.Vertex) {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	syncer, ok := m.gossipSyncer(peer)
+	if !ok {
+		return
+	}
+
+	log.Infof("Removing gossipSyncer for peer=%x", peer[:])
+
+	// Make sure a completed sync isn't forgotten along with the syncer.
+	if syncer.SyncState() == chansSynced {
+		atomic.StoreInt32(&m.graphSynced, 1)
+	}
+
+	syncer.Stop()
+
+	if _, ok := m.passiveSyncers[peer]; ok {
+		delete(m.passiveSyncers, peer)
+		return
+	}
+	delete(m.activeSyncers, peer)
+
+	// Since we've lost one of our active syncers, we'll attempt to promote
+	// a passive one in its place, preferably one that is already synced.
+	newPeer, newSyncer := chooseSyncedSyncer(m.passiveSyncers)
+	if newSyncer == nil {
+		for newPeer, newSyncer = range m.passiveSyncers {
+			break
+		}
+	}
+	if newSyncer == nil {
+		return
+	}
+
+	log.Debugf("Promoting passive gossipSyncer(%x) to replace active "+
+		"gossipSyncer(%x)", newPeer[:], peer[:])
+
+	newSyncer.ProcessSyncTransition(activeSync)
+	delete(m.passiveSyncers, newPeer)
+	m.activeSyncers[newPeer] = newSyncer
+}
+
+// IsGraphSynced returns whether we've completed the initial sync of the
+// channel graph with at least one of our peers since starting up.
+func (m *SyncManager) IsGraphSynced() bool {
+	if atomic.LoadInt32(&m.graphSynced) == 1 {
+		return true
+	}
+
+	m.syncersMtx.RLock()
+	defer m.syncersMtx.RUnlock()
+
+	for _, syncers := range []map[routing.Vertex]*gossipSyncer{
+		m.activeSyncers, m.passiveSyncers,
+	} {
+		for _, syncer := range syncers {
+			if syncer.SyncState() == chansSynced {
+				atomic.StoreInt32(&m.graphSynced, 1)
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// GossipSyncer returns the gossip syncer assigned to the given peer, if any.
+func (m *SyncManager) GossipSyncer(peer routing.Vertex) (*gossipSyncer, bool) {
+	m.syncersMtx.RLock()
+	defer m.syncersMtx.RUnlock()
+
+	return m.gossipSyncer(peer)
+}
+
+// gossipSyncer returns the gossip syncer assigned to the given peer, if any.
+//
+// NOTE: The syncersMtx MUST be held when calling this method.
+func (m *SyncManager) gossipSyncer(peer routing.Vertex) (*gossipSyncer, bool) {
+	if syncer, ok := m.activeSyncers[peer]; ok {
+		return syncer, true
+	}
+
+	syncer, ok := m.passiveSyncers[peer]
+	return syncer, ok
+}
+
+// GossipSyncers returns all of the gossip syncers currently managed, both
+// active and passive, indexed by the peer they're assigned to.
+func (m *SyncManager) GossipSyncers() map[routing.Vertex]*gossipSyncer {
+	m.syncersMtx.RLock()
+	defer m.syncersMtx.RUnlock()
+
+	syncers := make(
+		map[routing.Vertex]*gossipSyncer,
+		len(m.activeSyncers)+len(m.passiveSyncers),
+	)
+	for peer, syncer := range m.activeSyncers {
+		syncers[peer] = syncer
+	}
+	for peer, syncer := range m.passiveSyncers {
+		syncers[peer] = syncer
+	}
+
+	return syncers
+}
@@ -0,0 +1,173 @@
+package discovery
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// newTestSyncManager creates a new SyncManager with a force ticker, allowing
+// the test to control when the active syncers are rotated.
+func newTestSyncManager(numActiveSyncers int) (*SyncManager, *ticker.Force) {
+	rotateTicker := ticker.NewForce(time.Hour)
+	syncMgr := newSyncManager(SyncManagerCfg{
+		ChanSeries: newMockChannelGraphTimeSeries(
+			lnwire.NewShortChanIDFromInt(10),
+		),
+		NumActiveSyncers: numActiveSyncers,
+		RotateTicker:     rotateTicker,
+	})
+
+	return syncMgr, rotateTicker
+}
+
+// assertSyncers asserts that the SyncManager has the expected number of active
+// and passive syncers, and that each of them has the matching sync type.
+func assertSyncers(t *testing.T, syncMgr *SyncManager, numActive,
+	numPassive int) {
+
+	t.Helper()
+
+	syncMgr.syncersMtx.RLock()
+	defer syncMgr.syncersMtx.RUnlock()
+
+	if len(syncMgr.activeSyncers) != numActive {
+		t.Fatalf("expected %v active syncers, got %v", numActive,
+			len(syncMgr.activeSyncers))
+	}
+	if len(syncMgr.passiveSyncers) != numPassive {
+		t.Fatalf("expected %v passive syncers, got %v", numPassive,
+			len(syncMgr.passiveSyncers))
+	}
+
+	for _, syncer := range syncMgr.activeSyncers {
+		if syncer.SyncType() != activeSync {
+			t.Fatalf("expected active syncer, got %v",
+				syncer.SyncType())
+		}
+	}
+	for _, syncer := range syncMgr.passiveSyncers {
+		if syncer.SyncType() != passiveSync {
+			t.Fatalf("expected passive syncer, got %v",
+				syncer.SyncType())
+		}
+	}
+}
+
+// TestSyncManagerActiveSyncers asserts that the SyncManager only assigns active
+// syncers to peers up to its target, promotes a passive syncer once an active
+// one is pruned, and rotates its active syncers once they're synced.
+func TestSyncManagerActiveSyncers(t *testing.T) {
+	t.Parallel()
+
+	const numActiveSyncers = 2
+	syncMgr, rotateTicker := newTestSyncManager(numActiveSyncers)
+	syncMgr.Start()
+	defer syncMgr.Stop()
+
+	// The first two peers to connect should be assigned active syncers,
+	// while all following peers should be assigned passive ones.
+	peers := make([]*mockPeer, numActiveSyncers+2)
+	for i := range peers {
+		peers[i] = &mockPeer{pk: randPubKey(t)}
+		syncMgr.InitSyncState(peers[i])
+	}
+	assertSyncers(t, syncMgr, numActiveSyncers, 2)
+
+	// Initializing the sync state of a peer twice shouldn't result in a
+	// new syncer.
+	syncMgr.InitSyncState(peers[0])
+	assertSyncers(t, syncMgr, numActiveSyncers, 2)
+
+	// Pruning a passive syncer shouldn't affect our active syncers.
+	passivePeer := routing.Vertex(peers[len(peers)-1].PubKey())
+	syncMgr.PruneSyncState(passivePeer)
+	assertSyncers(t, syncMgr, numActiveSyncers, 1)
+
+	// Pruning an active syncer should cause our remaining passive syncer
+	// to be promoted.
+	syncMgr.PruneSyncState(routing.Vertex(peers[0].PubKey()))
+	assertSyncers(t, syncMgr, numActiveSyncers, 0)
+
+	// We'll connect another peer, which should be assigned a passive
+	// syncer as we already have enough active ones.
+	newPeer := &mockPeer{pk: randPubKey(t)}
+	syncMgr.InitSyncState(newPeer)
+	assertSyncers(t, syncMgr, numActiveSyncers, 1)
+
+	newSyncer, ok := syncMgr.GossipSyncer(routing.Vertex(newPeer.PubKey()))
+	if !ok {
+		t.Fatalf("gossip syncer for new peer not found")
+	}
+
+	// None of our syncers have synced their channel graph yet, so a
+	// rotation shouldn't have any effect.
+	rotateTicker.Force <- time.Now()
+	assertSyncers(t, syncMgr, numActiveSyncers, 1)
+	if newSyncer.SyncType() != passiveSync {
+		t.Fatalf("expected new syncer to remain passive")
+	}
+
+	// Once all syncers are synced, a rotation should swap one of our
+	// active syncers for the passive syncer of the new peer.
+	for _, syncer := range syncMgr.GossipSyncers() {
+		atomic.StoreUint32(&syncer.state, uint32(chansSynced))
+	}
+	rotateTicker.Force <- time.Now()
+
+	// The rotation happens asynchronously, so we'll give it some time to
+	// complete.
+	for i := 0; i < 20 && newSyncer.SyncType() != activeSync; i++ {
+		time.Sleep(50 * time.Millisecond)
+	}
+	if newSyncer.SyncType() != activeSync {
+		t.Fatalf("expected new syncer to be rotated in as active")
+	}
+	assertSyncers(t, syncMgr, numActiveSyncers, 1)
+}
+
+// TestSyncManagerIsGraphSynced asserts that the SyncManager reports the graph
+// as synced once any of its syncers has completed its initial sync, and that
+// it keeps doing so after that syncer's peer disconnects.
+func TestSyncManagerIsGraphSynced(t *testing.T) {
+	t.Parallel()
+
+	syncMgr, _ := newTestSyncManager(1)
+	syncMgr.Start()
+	defer syncMgr.Stop()
+
+	peers := []*mockPeer{
+		{pk: randPubKey(t)},
+		{pk: randPubKey(t)},
+	}
+	for _, peer := range peers {
+		syncMgr.InitSyncState(peer)
+	}
+
+	if syncMgr.IsGraphSynced() {
+		t.Fatalf("expected graph to not be synced")
+	}
+
+	// Once the passive syncer has synced, the graph should be reported as
+	// synced.
+	passivePeer := routing.Vertex(peers[1].PubKey())
+	syncer, ok := syncMgr.GossipSyncer(passivePeer)
+	if !ok {
+		t.Fatalf("gossip syncer for passive peer not found")
+	}
+	atomic.StoreUint32(&syncer.state, uint32(chansSynced))
+
+	if !syncMgr.IsGraphSynced() {
+		t.Fatalf("expected graph to be synced")
+	}
+
+	// Disconnecting from the peer we synced with shouldn't change that.
+	syncMgr.PruneSyncState(passivePeer)
+	if !syncMgr.IsGraphSynced() {
+		t.Fatalf("expected graph to remain synced")
+	}
+}
@@ -13,6 +13,37 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// syncerType encapsulates the different types of syncing mechanisms for a
+// gossipSyncer.
+type syncerType uint8
+
+const (
+	// activeSync denotes that a gossipSyncer should request real-time
+	// channel updates from the remote peer once it has synchronized its
+	// channel graph state with it.
+	activeSync syncerType = iota
+
+	// passiveSync denotes that a gossipSyncer should only synchronize its
+	// channel graph state with the remote peer, without requesting any
+	// further real-time channel updates. A passive syncer continues to
+	// answer the queries of the remote peer.
+	passiveSync
+)
+
+// String returns a human readable string describing the target syncerType.
+func (t syncerType) String() string {
+	switch t {
+	case activeSync:
+		return "activeSync"
+
+	case passiveSync:
+		return "passiveSync"
+
+	default:
+		return "UNKNOWN SYNC TYPE"
+	}
+}
+
 // syncerState is an enum that represents the current state of the
 // gossipSyncer.  As the syncer is a state machine, we'll gate our actions
 // based off of the current state and the next incoming message.
@@ -114,7 +145,8 @@ type gossipSyncerCfg struct {
 	chainHash chainhash.Hash
 
 	// syncChanUpdates is a bool that indicates if we should request a
-	// continual channel update stream or not.
+	// continual channel update stream or not. It determines whether the
+	// syncer starts out as an active or passive syncer.
 	syncChanUpdates bool
 
 	// channelSeries is the primary interface that we'll use to generate
@@ -170,6 +202,16 @@ type gossipSyncer struct {
 	// NOTE: This variable MUST be used atomically.
 	state uint32
 
+	// syncType is the current syncerType of the gossipSyncer.
+	//
+	// NOTE: This variable MUST be used atomically.
+	syncType uint32
+
+	// syncTransitions is signalled each time the syncType of the
+	// gossipSyncer is changed, so that the new update horizon can be sent
+	// to the remote peer.
+	syncTransitions chan struct{}
+
 	// gossipMsgs is a channel that all messages from the target peer will
 	// be sent over.
 	gossipMsgs chan lnwire.Message
@@ -225,11 +267,18 @@ func newGossiperSyncer(cfg gossipSyncerCfg) *gossipSyncer {
 		interval, cfg.maxUndelayedQueryReplies,
 	)
 
+	syncType := activeSync
+	if !cfg.syncChanUpdates {
+		syncType = passiveSync
+	}
+
 	return &gossipSyncer{
-		cfg:         cfg,
-		rateLimiter: rateLimiter,
-		gossipMsgs:  make(chan lnwire.Message, 100),
-		quit:        make(chan struct{}),
+		cfg:             cfg,
+		rateLimiter:     rateLimiter,
+		syncType:        uint32(syncType),
+		syncTransitions: make(chan struct{}, 1),
+		gossipMsgs:      make(chan lnwire.Message, 100),
+		quit:            make(chan struct{}),
 	}
 }
 
@@ -401,36 +450,20 @@ func (g *gossipSyncer) channelGraphSyncer() {
 		// This is our final terminal state where we'll only reply to
 		// any further queries by the remote peer.
 		case chansSynced:
-			// If we haven't yet sent out our update horizon, and
-			// we want to receive real-time channel updates, we'll
-			// do so now.
-			if g.localUpdateHorizon == nil && g.cfg.syncChanUpdates {
-				// TODO(roasbeef): query DB for most recent
-				// update?
-
-				// We'll give an hours room in our update
-				// horizon to ensure we don't miss any newer
-				// items.
-				updateHorizon := time.Now().Add(-time.Hour * 1)
-				log.Infof("gossipSyncer(%x): applying "+
-					"gossipFilter(start=%v)", g.peerPub[:],
-					updateHorizon)
-
-				g.localUpdateHorizon = &lnwire.GossipTimestampRange{
-					ChainHash:      g.cfg.chainHash,
-					FirstTimestamp: uint32(updateHorizon.Unix()),
-					TimestampRange: math.MaxUint32,
-				}
-				err := g.cfg.sendToPeer(g.localUpdateHorizon)
-				if err != nil {
-					log.Errorf("unable to send update "+
-						"horizon: %v", err)
-				}
+			// Make sure the update horizon we've sent out matches
+			// our current sync type.
+			if err := g.updateLocalHorizon(); err != nil {
+				log.Errorf("unable to send update horizon: %v",
+					err)
 			}
 
 			// With our horizon set, we'll simply reply to any new
-			// message and exit if needed.
+			// message and exit if needed. If our sync type changes
+			// in the meantime, we'll loop around to send out our
+			// new update horizon.
 			select {
+			case <-g.syncTransitions:
+
 			case msg := <-g.gossipMsgs:
 				err := g.replyPeerQueries(msg)
 				if err != nil && err != ErrGossipSyncerExiting {
@@ -445,6 +478,48 @@ func (g *gossipSyncer) channelGraphSyncer() {
 	}
 }
 
+// updateLocalHorizon sends our update horizon to the remote peer if it doesn't
+// match our current sync type yet. Active syncers request all channel updates
+// from an hour ago onwards, while passive syncers apply an empty update
+// horizon, which signals the remote peer to stop sending us updates. If we
+// never requested any updates, then a passive syncer has nothing to send.
+func (g *gossipSyncer) updateLocalHorizon() error {
+	receivingUpdates := g.localUpdateHorizon != nil &&
+		g.localUpdateHorizon.TimestampRange != 0
+
+	var updateHorizon *lnwire.GossipTimestampRange
+	switch syncType := g.SyncType(); {
+	case syncType == activeSync && !receivingUpdates:
+		// TODO(roasbeef): query DB for most recent update?
+
+		// We'll give an hours room in our update horizon to ensure we
+		// don't miss any newer items.
+		startTime := time.Now().Add(-time.Hour * 1)
+		log.Infof("gossipSyncer(%x): applying gossipFilter(start=%v)",
+			g.peerPub[:], startTime)
+
+		updateHorizon = &lnwire.GossipTimestampRange{
+			ChainHash:      g.cfg.chainHash,
+			FirstTimestamp: uint32(startTime.Unix()),
+			TimestampRange: math.MaxUint32,
+		}
+
+	case syncType == passiveSync && receivingUpdates:
+		log.Infof("gossipSyncer(%x): applying empty gossipFilter",
+			g.peerPub[:])
+
+		updateHorizon = &lnwire.GossipTimestampRange{
+			ChainHash: g.cfg.chainHash,
+		}
+
+	default:
+		return nil
+	}
+
+	g.localUpdateHorizon = updateHorizon
+	return g.cfg.sendToPeer(updateHorizon)
+}
+
 // synchronizeChanIDs is called by the channelGraphSyncer when we need to query
 // the remote peer for its known set of channel IDs within a particular block
 // range. This method will be called continually until the entire range has
@@ -944,3 +1019,30 @@ func (g *gossipSyncer) ProcessQueryMsg(msg lnwire.Message, peerQuit <-chan struc
 func (g *gossipSyncer) SyncState() syncerState {
 	return syncerState(atomic.LoadUint32(&g.state))
 }
+
+// SyncType returns the current syncerType of the target gossipSyncer.
+func (g *gossipSyncer) SyncType() syncerType {
+	return syncerType(atomic.LoadUint32(&g.syncType))
+}
+
+// ProcessSyncTransition transitions the gossipSyncer to the given syncerType.
+// If the syncer has already synchronized its channel graph state with the
+// remote peer, then the update horizon matching the new sync type will be sent
+// out immediately, otherwise it'll be sent once the syncer reaches the
+// chansSynced state.
+func (g *gossipSyncer) ProcessSyncTransition(newSyncType syncerType) {
+	prevSyncType := syncerType(
+		atomic.SwapUint32(&g.syncType, uint32(newSyncType)),
+	)
+	if prevSyncType == newSyncType {
+		return
+	}
+
+	log.Debugf("gossipSyncer(%x): transitioning from %v to %v",
+		g.peerPub[:], prevSyncType, newSyncType)
+
+	select {
+	case g.syncTransitions <- struct{}{}:
+	default:
+	}
+}
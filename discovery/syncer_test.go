@@ -3,6 +3,7 @@ package discovery
 import (
 	"math"
 	"reflect"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -1941,3 +1942,75 @@ func TestGossipSyncerAlreadySynced(t *testing.T) {
 		}
 	}
 }
+
+// TestGossipSyncerSyncTransitions asserts that a synced gossipSyncer sends out
+// the update horizon matching its sync type each time it transitions between
+// an active and a passive syncer.
+func TestGossipSyncerSyncTransitions(t *testing.T) {
+	t.Parallel()
+
+	msgChan, syncer, _ := newTestSyncer(
+		lnwire.NewShortChanIDFromInt(10), defaultEncoding,
+		defaultChunkSize,
+	)
+
+	// We'll start the syncer as if it already synchronized its channel
+	// graph state with the remote peer.
+	atomic.StoreUint32(&syncer.state, uint32(chansSynced))
+	syncer.Start()
+	defer syncer.Stop()
+
+	assertHorizon := func(receiveUpdates bool) {
+		t.Helper()
+
+		var msgs []lnwire.Message
+		select {
+		case msgs = <-msgChan:
+		case <-time.After(time.Second * 2):
+			t.Fatalf("didn't get update horizon from syncer")
+		}
+
+		if len(msgs) != 1 {
+			t.Fatalf("expected 1 msg, got %v", len(msgs))
+		}
+		horizon, ok := msgs[0].(*lnwire.GossipTimestampRange)
+		if !ok {
+			t.Fatalf("wrong message: expected "+
+				"GossipTimestampRange for %T", msgs[0])
+		}
+
+		if receiveUpdates && horizon.TimestampRange != math.MaxUint32 {
+			t.Fatalf("expected unbounded horizon, got range of "+
+				"%v", horizon.TimestampRange)
+		}
+		if !receiveUpdates && horizon.TimestampRange != 0 {
+			t.Fatalf("expected empty horizon, got range of %v",
+				horizon.TimestampRange)
+		}
+	}
+
+	// As the syncer starts out active, it should request all new updates.
+	assertHorizon(true)
+
+	// Once transitioned to a passive syncer, it should send out an empty
+	// horizon so the remote peer stops sending us updates.
+	syncer.ProcessSyncTransition(passiveSync)
+	assertHorizon(false)
+
+	// Transitioning to the sync type it already has shouldn't result in
+	// another horizon being sent.
+	syncer.ProcessSyncTransition(passiveSync)
+	select {
+	case msgs := <-msgChan:
+		t.Fatalf("unexpected msgs sent: %v", spew.Sdump(msgs))
+	case <-time.After(time.Millisecond * 100):
+	}
+
+	// Finally, becoming active again should request updates once more.
+	syncer.ProcessSyncTransition(activeSync)
+	assertHorizon(true)
+
+	if syncer.SyncType() != activeSync {
+		t.Fatalf("expected %v, got %v", activeSync, syncer.SyncType())
+	}
+}
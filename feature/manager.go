@@ -0,0 +1,97 @@
+package feature
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Config houses any runtime modifications to the default set descriptors. For
+// our purposes, this typically means disabling certain features to test
+// legacy protocol interoperability or functionality.
+type Config struct {
+	// NoAnchors unsets any bits signaling support for the anchor outputs
+	// commitment format.
+	NoAnchors bool
+}
+
+// Manager is responsible for generating feature vectors for different
+// requested feature sets.
+type Manager struct {
+	// fsets is a static map of feature set to raw feature vectors. Requests
+	// are fulfilled by cloning these internal feature vectors.
+	fsets map[Set]*lnwire.RawFeatureVector
+}
+
+// NewManager creates a new feature Manager, applying any custom modifications
+// to its feature sets.
+func NewManager(cfg Config) (*Manager, error) {
+	return newManager(cfg, defaultSetDesc)
+}
+
+// newManager creates a new feature Manager, applying any custom modifications
+// to its feature sets. The set descriptor is passed in explicitly to allow
+// testing of custom feature sets.
+func newManager(cfg Config, desc setDesc) (*Manager, error) {
+	fsets := make(map[Set]*lnwire.RawFeatureVector)
+	for bit, sets := range desc {
+		for set := range sets {
+			// Fetch the feature vector for this set, allocating a
+			// new one if it doesn't exist.
+			fv, ok := fsets[set]
+			if !ok {
+				fv = lnwire.NewRawFeatureVector()
+			}
+
+			// Set the configured bit on the feature vector,
+			// ensuring that we don't set two feature bits for the
+			// same pair.
+			if fv.IsSet(bit ^ 1) {
+				return nil, fmt.Errorf("feature bit %d in set "+
+					"%v has conflicting pair", bit, set)
+			}
+			fv.Set(bit)
+
+			// Write the updated feature vector under its set.
+			fsets[set] = fv
+		}
+	}
+
+	// Now, remove any features as directed by the config.
+	for _, raw := range fsets {
+		if cfg.NoAnchors {
+			raw.Unset(lnwire.AnchorOutputsOptional)
+			raw.Unset(lnwire.AnchorOutputsRequired)
+		}
+	}
+
+	return &Manager{
+		fsets: fsets,
+	}, nil
+}
+
+// GetRaw returns a raw feature vector for the passed set. If no set is known,
+// an empty raw feature vector is returned.
+func (m *Manager) GetRaw(set Set) *lnwire.RawFeatureVector {
+	if fv, ok := m.fsets[set]; ok {
+		return fv.Clone()
+	}
+
+	return lnwire.NewRawFeatureVector()
+}
+
+// Get returns a feature vector for the passed set, bound to the names of the
+// features known within that set. If no set is known, an empty feature vector
+// is returned.
+func (m *Manager) Get(set Set) *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(m.GetRaw(set), featureNames(set))
+}
+
+// featureNames returns the names of the features known within the given set.
+func featureNames(set Set) map[lnwire.FeatureBit]string {
+	if set == SetInit {
+		return lnwire.LocalFeatures
+	}
+
+	return lnwire.GlobalFeatures
+}
@@ -0,0 +1,206 @@
+package feature
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+type managerTest struct {
+	name string
+	cfg  Config
+}
+
+const unknownFeature lnwire.FeatureBit = 30
+
+var testSetDesc = setDesc{
+	lnwire.DataLossProtectRequired: {
+		SetInit: {}, // I
+	},
+	lnwire.AnchorOutputsOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	unknownFeature: {
+		SetNodeAnn: {}, // N
+	},
+}
+
+var managerTests = []managerTest{
+	{
+		name: "default",
+		cfg:  Config{},
+	},
+	{
+		name: "no anchors",
+		cfg: Config{
+			NoAnchors: true,
+		},
+	},
+}
+
+// TestManager asserts basic initialization and operation of a feature
+// manager, including that the proper features are removed in response to
+// config changes.
+func TestManager(t *testing.T) {
+	for _, test := range managerTests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testManager(t, test)
+		})
+	}
+}
+
+func testManager(t *testing.T, test managerTest) {
+	m, err := newManager(test.cfg, testSetDesc)
+	if err != nil {
+		t.Fatalf("unable to create feature manager: %v", err)
+	}
+
+	sets := []Set{SetInit, SetLegacyGlobal, SetNodeAnn}
+	for _, set := range sets {
+		raw := m.GetRaw(set)
+
+		// Modifying the returned vector must not affect the vectors
+		// returned subsequently.
+		raw.Set(lnwire.GossipQueriesOptional)
+		if m.GetRaw(set).IsSet(lnwire.GossipQueriesOptional) {
+			t.Fatalf("feature vector of set %v was modified", set)
+		}
+		raw.Unset(lnwire.GossipQueriesOptional)
+
+		for bit, sets := range testSetDesc {
+			_, inSet := sets[set]
+			expSet := inSet
+			if test.cfg.NoAnchors &&
+				bit == lnwire.AnchorOutputsOptional {
+
+				expSet = false
+			}
+
+			if raw.IsSet(bit) != expSet {
+				t.Fatalf("expected bit %v in set %v to be "+
+					"set=%v", bit, set, expSet)
+			}
+		}
+	}
+}
+
+// TestManagerDefaultSets asserts that the features BOLT 9 marks for both the
+// Init message and node announcements are advertised in both, unless disabled.
+func TestManagerDefaultSets(t *testing.T) {
+	bits := []lnwire.FeatureBit{
+		lnwire.DataLossProtectRequired,
+		lnwire.GossipQueriesOptional,
+		lnwire.UpfrontShutdownScriptOptional,
+		lnwire.AnchorOutputsOptional,
+	}
+
+	for _, test := range managerTests {
+		m, err := NewManager(test.cfg)
+		if err != nil {
+			t.Fatalf("unable to create feature manager: %v", err)
+		}
+
+		for _, set := range []Set{SetInit, SetNodeAnn} {
+			raw := m.GetRaw(set)
+			for _, bit := range bits {
+				expSet := !test.cfg.NoAnchors ||
+					bit != lnwire.AnchorOutputsOptional
+
+				if raw.IsSet(bit) != expSet {
+					t.Fatalf("%v: expected bit %v in set "+
+						"%v to be set=%v", test.name,
+						bit, set, expSet)
+				}
+			}
+		}
+	}
+}
+
+// TestManagerConflictingPair asserts that a feature manager can't be created
+// for a set descriptor that sets both bits of a feature pair within the same
+// set.
+func TestManagerConflictingPair(t *testing.T) {
+	desc := setDesc{
+		lnwire.AnchorOutputsRequired: {
+			SetInit: {},
+		},
+		lnwire.AnchorOutputsOptional: {
+			SetInit: {},
+		},
+	}
+	if _, err := newManager(Config{}, desc); err == nil {
+		t.Fatalf("expected conflicting feature pair to be rejected")
+	}
+}
+
+// TestIsNegotiated asserts that a feature is only considered negotiated if
+// both we and the remote peer advertised either bit of its pair.
+func TestIsNegotiated(t *testing.T) {
+	newVector := func(bits ...lnwire.FeatureBit) *lnwire.FeatureVector {
+		return lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(bits...),
+			lnwire.LocalFeatures,
+		)
+	}
+
+	tests := []struct {
+		local    *lnwire.FeatureVector
+		remote   *lnwire.FeatureVector
+		expected bool
+	}{
+		{
+			local:    newVector(lnwire.GossipQueriesOptional),
+			remote:   newVector(lnwire.GossipQueriesOptional),
+			expected: true,
+		},
+		{
+			local:    newVector(lnwire.GossipQueriesOptional),
+			remote:   newVector(lnwire.GossipQueriesRequired),
+			expected: true,
+		},
+		{
+			local:    newVector(lnwire.GossipQueriesOptional),
+			remote:   newVector(),
+			expected: false,
+		},
+		{
+			local:    newVector(),
+			remote:   newVector(lnwire.GossipQueriesOptional),
+			expected: false,
+		},
+	}
+
+	for i, test := range tests {
+		negotiated := IsNegotiated(
+			test.local, test.remote, lnwire.GossipQueriesOptional,
+		)
+		if negotiated != test.expected {
+			t.Fatalf("test #%d: expected negotiated=%v, got %v",
+				i, test.expected, negotiated)
+		}
+	}
+}
+
+// TestValidateRequired asserts that only feature vectors with unknown required
+// bits are rejected.
+func TestValidateRequired(t *testing.T) {
+	known := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.DataLossProtectRequired, unknownFeature+1,
+		),
+		lnwire.LocalFeatures,
+	)
+	if err := ValidateRequired(known); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	unknown := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(unknownFeature),
+		lnwire.LocalFeatures,
+	)
+	if err := ValidateRequired(unknown); err == nil {
+		t.Fatalf("expected unknown required feature to be rejected")
+	}
+}
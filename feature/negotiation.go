@@ -0,0 +1,31 @@
+package feature
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ValidateRequired returns an error if the given feature vector sets any
+// required feature bits that are unknown to us. As required features must be
+// understood by the receiver, we're unable to interact with a peer setting
+// such bits.
+func ValidateRequired(fv *lnwire.FeatureVector) error {
+	unknown := fv.UnknownRequiredFeatures()
+	if len(unknown) > 0 {
+		return fmt.Errorf("feature vector contains unknown required "+
+			"features: %v", unknown)
+	}
+
+	return nil
+}
+
+// IsNegotiated returns true if the feature identified by the given bit was
+// negotiated with a peer, i.e. if both we and the peer advertised either bit
+// of the feature pair. Optional behaviors should only be enabled for a peer if
+// their feature was negotiated.
+func IsNegotiated(local, remote *lnwire.FeatureVector,
+	bit lnwire.FeatureBit) bool {
+
+	return local.HasFeature(bit) && remote.HasFeature(bit)
+}
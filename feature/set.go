@@ -0,0 +1,34 @@
+package feature
+
+// Set is an enum identifying various feature sets, which separates the single
+// feature namespace into distinct categories depending what context a feature
+// vector is being used.
+type Set uint8
+
+const (
+	// SetInit identifies features that should be sent in the local
+	// features of an Init message.
+	SetInit Set = iota
+
+	// SetLegacyGlobal identifies features that should be sent in the
+	// global features of an Init message.
+	SetLegacyGlobal
+
+	// SetNodeAnn identifies features that should be advertised on
+	// NodeAnnouncements.
+	SetNodeAnn
+)
+
+// String returns a human-readable description of a Set.
+func (s Set) String() string {
+	switch s {
+	case SetInit:
+		return "SetInit"
+	case SetLegacyGlobal:
+		return "SetLegacyGlobal"
+	case SetNodeAnn:
+		return "SetNodeAnn"
+	default:
+		return "SetUnknown"
+	}
+}
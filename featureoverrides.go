@@ -0,0 +1,109 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// featureOverrides holds the feature bits configured on top of the ones we
+// advertise by default, along with the ones we require from our peers before
+// opening channels with them. This allows protocol experiments to be run
+// without having to recompile our feature vectors.
+type featureOverrides struct {
+	// advertise are the feature bits advertised to all peers.
+	advertise []lnwire.FeatureBit
+
+	// peerAdvertise are the feature bits advertised only to specific
+	// peers, keyed by their serialized compressed public key.
+	peerAdvertise map[string][]lnwire.FeatureBit
+
+	// require are the feature bits a peer must advertise before we open a
+	// channel with it or accept one from it.
+	require []lnwire.FeatureBit
+}
+
+// newFeatureOverrides parses the feature overrides of the given config.
+func newFeatureOverrides(cfg *featureConfig) (*featureOverrides, error) {
+	f := &featureOverrides{
+		peerAdvertise: make(map[string][]lnwire.FeatureBit),
+	}
+
+	for _, bit := range cfg.Advertise {
+		f.advertise = append(f.advertise, lnwire.FeatureBit(bit))
+	}
+	for _, bit := range cfg.Require {
+		f.require = append(f.require, lnwire.FeatureBit(bit))
+	}
+
+	for _, override := range cfg.PeerAdvertise {
+		parts := strings.Split(override, ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid peer feature %v, "+
+				"expected <pubkey>:<bit>", override)
+		}
+
+		pubKeyBytes, err := hex.DecodeString(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer feature %v: %v",
+				override, err)
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer feature %v: %v",
+				override, err)
+		}
+
+		bit, err := strconv.ParseUint(parts[1], 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer feature %v: %v",
+				override, err)
+		}
+
+		peer := string(pubKey.SerializeCompressed())
+		f.peerAdvertise[peer] = append(
+			f.peerAdvertise[peer], lnwire.FeatureBit(bit),
+		)
+	}
+
+	return f, nil
+}
+
+// apply sets the feature bits we advertise to the given peer on top of the
+// default ones within its local feature vector.
+func (f *featureOverrides) apply(pubKey *btcec.PublicKey,
+	features *lnwire.RawFeatureVector) {
+
+	for _, bit := range f.advertise {
+		features.Set(bit)
+	}
+	peer := string(pubKey.SerializeCompressed())
+	for _, bit := range f.peerAdvertise[peer] {
+		features.Set(bit)
+	}
+}
+
+// missingFeatures returns the feature bits within the given set the peer
+// doesn't advertise. As the experimental features we may require aren't
+// necessarily known to us, either bit of the feature pair satisfies the
+// requirement.
+func missingFeatures(peer lnpeer.Peer,
+	required []lnwire.FeatureBit) []lnwire.FeatureBit {
+
+	remoteFeatures := peer.RemoteLocalFeatures()
+
+	var missing []lnwire.FeatureBit
+	for _, bit := range required {
+		if remoteFeatures.IsSet(bit) || remoteFeatures.IsSet(bit^1) {
+			continue
+		}
+		missing = append(missing, bit)
+	}
+
+	return missing
+}
@@ -0,0 +1,91 @@
+// +build !rpctest
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestFeatureOverrides asserts that the configured feature bits are only
+// advertised to the peers they're configured for, and that invalid overrides
+// are rejected.
+func TestFeatureOverrides(t *testing.T) {
+	t.Parallel()
+
+	peerKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	otherKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	peerHex := hex.EncodeToString(peerKey.PubKey().SerializeCompressed())
+
+	overrides, err := newFeatureOverrides(&featureConfig{
+		Advertise:     []uint16{101},
+		PeerAdvertise: []string{fmt.Sprintf("%v:103", peerHex)},
+		Require:       []uint16{105},
+	})
+	if err != nil {
+		t.Fatalf("unable to parse feature overrides: %v", err)
+	}
+	if !reflect.DeepEqual(overrides.require, []lnwire.FeatureBit{105}) {
+		t.Fatalf("unexpected required features: %v", overrides.require)
+	}
+
+	peerFeatures := lnwire.NewRawFeatureVector()
+	overrides.apply(peerKey.PubKey(), peerFeatures)
+	if !peerFeatures.IsSet(101) || !peerFeatures.IsSet(103) {
+		t.Fatalf("expected bits 101 and 103 to be advertised to peer")
+	}
+
+	otherFeatures := lnwire.NewRawFeatureVector()
+	overrides.apply(otherKey.PubKey(), otherFeatures)
+	if !otherFeatures.IsSet(101) || otherFeatures.IsSet(103) {
+		t.Fatalf("expected only bit 101 to be advertised to other peer")
+	}
+
+	invalid := []string{
+		peerHex,
+		fmt.Sprintf("%v:103:105", peerHex),
+		"zz:103",
+		"0201:103",
+		fmt.Sprintf("%v:70000", peerHex),
+	}
+	for _, override := range invalid {
+		_, err := newFeatureOverrides(&featureConfig{
+			PeerAdvertise: []string{override},
+		})
+		if err == nil {
+			t.Fatalf("expected peer feature %v to be rejected",
+				override)
+		}
+	}
+}
+
+// TestMissingFeatures asserts that either bit of a required feature pair
+// satisfies the requirement.
+func TestMissingFeatures(t *testing.T) {
+	t.Parallel()
+
+	peer := &testNode{}
+
+	required := []lnwire.FeatureBit{
+		lnwire.UpfrontShutdownScriptRequired, 101,
+	}
+	missing := missingFeatures(peer, required)
+	if !reflect.DeepEqual(missing, []lnwire.FeatureBit{101}) {
+		t.Fatalf("expected only bit 101 to be missing, got %v", missing)
+	}
+
+	if missing := missingFeatures(peer, nil); len(missing) != 0 {
+		t.Fatalf("expected no missing features, got %v", missing)
+	}
+}
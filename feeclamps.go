@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// satPerVByteToKW converts a fee rate expressed in sat/vbyte to sat/kw.
+func satPerVByteToKW(satPerVByte uint64) chainfee.SatPerKWeight {
+	return chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+}
+
+// feeClamps holds the fee estimators used by each of the consumers of the
+// fee estimator. Each of them clamps the estimated fee rates within its own
+// bounds, such that an estimator glitch can't produce an absurd commitment
+// fee, while justice transactions are still allowed to bid high.
+type feeClamps struct {
+	// funding is used for the funding transactions of new channels.
+	funding *chainfee.ClampedEstimator
+
+	// coopClose is used for the fee negotiation of cooperative closes.
+	coopClose *chainfee.ClampedEstimator
+
+	// sweep is used to sweep our outputs back into the wallet.
+	sweep *chainfee.ClampedEstimator
+
+	// justice is used for the justice transactions sweeping the outputs
+	// of revoked commitments.
+	justice *chainfee.ClampedEstimator
+
+	// commit is used for the fee rate of commitment transactions, both
+	// for new channels and fee updates of existing ones.
+	commit *chainfee.ClampedEstimator
+}
+
+// newFeeClamps creates the estimators of all the consumers of the given fee
+// estimator, clamping their fee rates within the configured bounds.
+func newFeeClamps(estimator chainfee.Estimator,
+	cfg *feeClampConfig) (*feeClamps, error) {
+
+	newClamped := func(name string, minRate,
+		maxRate uint64) (*chainfee.ClampedEstimator, error) {
+
+		clamped, err := chainfee.NewClampedEstimator(
+			estimator, satPerVByteToKW(minRate),
+			satPerVByteToKW(maxRate),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %v fee clamp: %v",
+				name, err)
+		}
+
+		return clamped, nil
+	}
+
+	var (
+		f   feeClamps
+		err error
+	)
+	f.funding, err = newClamped("funding", cfg.FundingMin, cfg.FundingMax)
+	if err != nil {
+		return nil, err
+	}
+	f.coopClose, err = newClamped(
+		"coop close", cfg.CoopCloseMin, cfg.CoopCloseMax,
+	)
+	if err != nil {
+		return nil, err
+	}
+	f.sweep, err = newClamped("sweep", cfg.SweepMin, cfg.SweepMax)
+	if err != nil {
+		return nil, err
+	}
+	f.justice, err = newClamped("justice", cfg.JusticeMin, cfg.JusticeMax)
+	if err != nil {
+		return nil, err
+	}
+	f.commit, err = newClamped("commit", cfg.CommitMin, cfg.CommitMax)
+	if err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// forConsumer returns the estimator used by the given consumer.
+func (f *feeClamps) forConsumer(
+	consumer lnrpc.FeeConsumer) (*chainfee.ClampedEstimator, error) {
+
+	switch consumer {
+	case lnrpc.FeeConsumer_FUNDING:
+		return f.funding, nil
+
+	case lnrpc.FeeConsumer_COOP_CLOSE:
+		return f.coopClose, nil
+
+	case lnrpc.FeeConsumer_SWEEP:
+		return f.sweep, nil
+
+	case lnrpc.FeeConsumer_JUSTICE:
+		return f.justice, nil
+
+	case lnrpc.FeeConsumer_COMMIT:
+		return f.commit, nil
+
+	default:
+		return nil, fmt.Errorf("unknown fee consumer %v", consumer)
+	}
+}
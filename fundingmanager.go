@@ -8,23 +8,29 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/feature"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/sweep"
 	"golang.org/x/crypto/salsa20"
 	"google.golang.org/grpc"
 )
@@ -233,7 +239,7 @@ type fundingConfig struct {
 
 	// FeeEstimator calculates appropriate fee rates based on historical
 	// transaction information.
-	FeeEstimator lnwallet.FeeEstimator
+	FeeEstimator chainfee.Estimator
 
 	// Notifier is used by the FundingManager to determine when the
 	// channel's funding transaction has been confirmed on the blockchain
@@ -334,9 +340,87 @@ type fundingConfig struct {
 	// due to fees.
 	MinChanSize btcutil.Amount
 
+	// ConstraintsPolicy bounds the channel constraints that the remote
+	// party may dictate for our commitment transaction, such as the CSV
+	// delay on our funds, the reserve we must keep and the limit on the
+	// value of our HTLCs in flight. Channels proposed with constraints
+	// outside of these bounds are rejected.
+	ConstraintsPolicy *lnwallet.ConstraintsPolicy
+
 	// NotifyOpenChannelEvent informs the ChannelNotifier when channels
 	// transition from pending open to open.
 	NotifyOpenChannelEvent func(wire.OutPoint)
+
+	// NotifyPendingOpenChannelEvent informs the ChannelNotifier when
+	// channels enter a pending state.
+	NotifyPendingOpenChannelEvent func(wire.OutPoint, *channeldb.OpenChannel)
+
+	// EnableUpfrontShutdown specifies whether the upfront shutdown script
+	// is enabled. If set, a fresh address will be derived from the wallet
+	// and committed to as our shutdown script for every channel opened
+	// with a peer supporting the option, unless one was provided by the
+	// user.
+	EnableUpfrontShutdown bool
+
+	// GenUpfrontShutdownScript generates a new script within our wallet
+	// that will be used as our upfront shutdown script.
+	GenUpfrontShutdownScript func() (lnwire.DeliveryAddress, error)
+
+	// RequiredFeatures are the feature bits a peer must advertise before
+	// we open a channel with it or accept one from it.
+	RequiredFeatures []lnwire.FeatureBit
+
+	// OpenChannelPredicate is a predicate on the inbound channel requests
+	// that have passed our own checks. It decides whether the channel
+	// should be accepted, and may override some of the parameters we'll
+	// require from the initiator.
+	OpenChannelPredicate chanacceptor.ChannelAcceptor
+}
+
+// errUpfrontShutdownScriptNotSupported is returned when an upfront shutdown
+// script is set for a peer that does not support the feature bit.
+var errUpfrontShutdownScriptNotSupported = errors.New("peer does not " +
+	"support option upfront shutdown script")
+
+// getUpfrontShutdownScript returns the upfront shutdown script we'll commit
+// to in the channel with the given peer. If the peer doesn't support the
+// feature, an error is returned if the user provided a script, otherwise no
+// script is used. If the peer does support it, the user provided script takes
+// precedence over a freshly generated one, which is only used if the upfront
+// shutdown option is enabled.
+func getUpfrontShutdownScript(enableUpfrontShutdown bool, peer lnpeer.Peer,
+	script lnwire.DeliveryAddress,
+	getScript func() (lnwire.DeliveryAddress, error)) (lnwire.DeliveryAddress,
+	error) {
+
+	remoteFeatures := peer.RemoteLocalFeatures()
+	if !remoteFeatures.HasFeature(lnwire.UpfrontShutdownScriptOptional) {
+		if len(script) != 0 {
+			return nil, errUpfrontShutdownScriptNotSupported
+		}
+
+		return nil, nil
+	}
+
+	if len(script) != 0 {
+		return script, nil
+	}
+
+	if !enableUpfrontShutdown {
+		return nil, nil
+	}
+
+	return getScript()
+}
+
+// useAnchors returns true if the channel with the given peer should use the
+// anchor outputs commitment format. This is the case if both we and the peer
+// advertised support for it.
+func useAnchors(peer lnpeer.Peer) bool {
+	return feature.IsNegotiated(
+		peer.LocalFeatures(), peer.RemoteLocalFeatures(),
+		lnwire.AnchorOutputsOptional,
+	)
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -406,6 +490,11 @@ type fundingManager struct {
 	handleFundingLockedMtx      sync.RWMutex
 	handleFundingLockedBarriers map[lnwire.ChannelID]struct{}
 
+	// bumpMtx serializes attempts to bump the fee of funding
+	// transactions, such that no two children spending the same output
+	// are created.
+	bumpMtx sync.Mutex
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -439,6 +528,12 @@ var (
 	// of being opened.
 	channelOpeningStateBucket = []byte("channelOpeningState")
 
+	// fundingBumpBucket is the database bucket used to store the child
+	// transactions that were broadcast to bump the fee of the funding
+	// transaction of a pending channel, such that they can be
+	// rebroadcast upon restart.
+	fundingBumpBucket = []byte("fundingBump")
+
 	// ErrChannelNotFound is an error returned when a channel is not known
 	// to us. In this case of the fundingManager, this error is returned
 	// when the channel in question is not considered being in an opening
@@ -504,7 +599,7 @@ func (f *fundingManager) Start() error {
 		// already broadcast this transaction. Otherwise, we simply log
 		// the error as there isn't anything we can currently do to
 		// recover.
-		if channel.ChanType == channeldb.SingleFunder &&
+		if channel.ChanType.IsSingleFunder() &&
 			channel.IsInitiator {
 
 			err := f.cfg.PublishTransaction(channel.FundingTxn)
@@ -513,6 +608,10 @@ func (f *fundingManager) Start() error {
 					"tx for ChannelPoint(%v): %v",
 					channel.FundingOutpoint, err)
 			}
+
+			// We'll also rebroadcast any children that were
+			// created to bump the fee of the funding transaction.
+			f.rebroadcastFundingBumpTxns(&channel.FundingOutpoint)
 		}
 
 		confChan := make(chan *lnwire.ShortChannelID)
@@ -780,6 +879,88 @@ func (f *fundingManager) PendingChannels() ([]*pendingChannel, error) {
 	}
 }
 
+// cancelFundingReq is a request to cancel a locally initiated funding flow
+// before its funding transaction has been broadcast.
+type cancelFundingReq struct {
+	pendingChanID [32]byte
+	err           chan error
+}
+
+// CancelPendingFunding cancels the locally initiated funding flow identified
+// by the given pending channel ID. A funding flow can only be canceled as
+// long as its funding transaction hasn't been broadcast yet, as the channel
+// can't be abandoned safely afterwards.
+func (f *fundingManager) CancelPendingFunding(pendingChanID [32]byte) error {
+	errChan := make(chan error, 1)
+
+	req := &cancelFundingReq{
+		pendingChanID: pendingChanID,
+		err:           errChan,
+	}
+
+	select {
+	case f.queries <- req:
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-f.quit:
+		return ErrFundingManagerShuttingDown
+	}
+}
+
+// handleCancelFunding cancels the funding flow targeted by the passed
+// request. As this is executed by the reservationCoordinator, the funding
+// flow can't concurrently progress to the point where the funding
+// transaction is broadcast.
+func (f *fundingManager) handleCancelFunding(msg *cancelFundingReq) {
+	var resCtx *reservationWithCtx
+	f.resMtx.RLock()
+	for _, nodeReservations := range f.activeReservations {
+		if ctx, ok := nodeReservations[msg.pendingChanID]; ok {
+			resCtx = ctx
+			break
+		}
+	}
+	f.resMtx.RUnlock()
+
+	// Only funding flows that were initiated by us can be canceled, as
+	// those are the only ones with a local caller awaiting updates.
+	if resCtx == nil || resCtx.updates == nil {
+		msg.err <- fmt.Errorf("no pending funding flow with id %x "+
+			"found", msg.pendingChanID[:])
+		return
+	}
+
+	fndgLog.Infof("Canceling funding flow for pendingID(%x) on user "+
+		"request", msg.pendingChanID[:])
+
+	f.failFundingFlow(
+		resCtx.peer, msg.pendingChanID,
+		fmt.Errorf("funding flow canceled by user"),
+	)
+
+	msg.err <- nil
+}
+
+// pendingChanIDInUse returns true if there's an active reservation with the
+// given pending channel ID with any of our peers.
+func (f *fundingManager) pendingChanIDInUse(pendingChanID [32]byte) bool {
+	f.resMtx.RLock()
+	defer f.resMtx.RUnlock()
+
+	for _, nodeReservations := range f.activeReservations {
+		if _, ok := nodeReservations[pendingChanID]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // CancelPeerReservations cancels all active reservations associated with the
 // passed node. This will ensure any outputs which have been pre committed,
 // (and thus locked from coin selection), are properly freed.
@@ -909,6 +1090,8 @@ func (f *fundingManager) reservationCoordinator() {
 			switch msg := req.(type) {
 			case *pendingChansReq:
 				f.handlePendingChannels(msg)
+			case *cancelFundingReq:
+				f.handleCancelFunding(msg)
 			}
 		case <-f.quit:
 			return
@@ -1046,6 +1229,36 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// We'll also reject the channel if the peer doesn't advertise all of
+	// the features we require from it.
+	missing := missingFeatures(fmsg.peer, f.cfg.RequiredFeatures)
+	if len(missing) > 0 {
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			lnwallet.ErrMissingFeatures(missing),
+		)
+		return
+	}
+
+	// Finally, we'll let the channel acceptors decide whether the channel
+	// should be accepted.
+	anchors := useAnchors(fmsg.peer)
+	chanReq := &chanacceptor.ChannelAcceptRequest{
+		Node:        fmsg.peer.IdentityKey(),
+		OpenChanMsg: fmsg.msg,
+		Anchors:     anchors,
+	}
+	acceptResp := f.cfg.OpenChannelPredicate.Accept(chanReq)
+	if acceptResp.RejectChannel() {
+		fndgLog.Infof("Channel request with pendingId=%x rejected: %v",
+			msg.PendingChannelID, acceptResp.ChanAcceptError)
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			lnwallet.ErrChanRejected(acceptResp.ChanAcceptError),
+		)
+		return
+	}
+
 	fndgLog.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -1063,11 +1276,12 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		NodeAddr:        fmsg.peer.Address(),
 		FundingAmount:   0,
 		Capacity:        amt,
-		CommitFeePerKw:  lnwallet.SatPerKWeight(msg.FeePerKiloWeight),
+		CommitFeePerKw:  chainfee.SatPerKWeight(msg.FeePerKiloWeight),
 		FundingFeePerKw: 0,
 		PushMSat:        msg.PushAmount,
 		Flags:           msg.ChannelFlags,
 		MinConfs:        1,
+		Anchors:         anchors,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -1081,10 +1295,26 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// that we require before both of us consider the channel open. We'll
 	// use out mapping to derive the proper number of confirmations based on
 	// the amount of the channel, and also if any funds are being pushed to
-	// us.
+	// us. The channel acceptors may override this value.
 	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+	if acceptResp.MinAcceptDepth != 0 {
+		numConfsReq = acceptResp.MinAcceptDepth
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
+	// If the peer supports upfront shutdown scripts and we're configured
+	// to use them, we'll commit to a fresh address of ours.
+	shutdown, err := getUpfrontShutdownScript(
+		f.cfg.EnableUpfrontShutdown, fmsg.peer, nil,
+		f.cfg.GenUpfrontShutdownScript,
+	)
+	if err != nil {
+		fndgLog.Errorf("Unable to get upfront shutdown script: %v", err)
+		f.failFundingFlow(fmsg.peer, msg.PendingChannelID, err)
+		return
+	}
+	reservation.SetOurUpfrontShutdown(shutdown)
+
 	// We'll also validate and apply all the constraints the initiating
 	// party is attempting to dictate for our commitment transaction.
 	channelConstraints := &channeldb.ChannelConstraints{
@@ -1095,7 +1325,9 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
 		CsvDelay:         msg.CsvDelay,
 	}
-	err = reservation.CommitConstraints(channelConstraints)
+	err = reservation.CommitConstraints(
+		channelConstraints, f.cfg.ConstraintsPolicy,
+	)
 	if err != nil {
 		fndgLog.Errorf("Unacceptable channel constraints: %v", err)
 		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
@@ -1106,8 +1338,12 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		"amt=%v, push_amt=%v", numConfsReq, fmsg.msg.PendingChannelID,
 		amt, msg.PushAmount)
 
-	// Generate our required constraints for the remote party.
+	// Generate our required constraints for the remote party, using the
+	// CSV delay required by the channel acceptors if any.
 	remoteCsvDelay := f.cfg.RequiredRemoteDelay(amt)
+	if acceptResp.CsvDelay != 0 {
+		remoteCsvDelay = acceptResp.CsvDelay
+	}
 	chanReserve := f.cfg.RequiredRemoteChanReserve(amt, msg.DustLimit)
 	maxValue := f.cfg.RequiredRemoteMaxValue(amt)
 	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(amt)
@@ -1164,6 +1400,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 				PubKey: copyPubKey(msg.HtlcPoint),
 			},
 		},
+		UpfrontShutdown: msg.UpfrontShutdownScript,
 	}
 	err = reservation.ProcessSingleContribution(remoteContribution)
 	if err != nil {
@@ -1181,20 +1418,21 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// contribution in the next message of the workflow.
 	ourContribution := reservation.OurContribution()
 	fundingAccept := lnwire.AcceptChannel{
-		PendingChannelID:     msg.PendingChannelID,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		MinAcceptDepth:       uint32(numConfsReq),
-		HtlcMinimum:          minHtlc,
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
+		PendingChannelID:      msg.PendingChannelID,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		MinAcceptDepth:        uint32(numConfsReq),
+		HtlcMinimum:           minHtlc,
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
 	}
 	if err := fmsg.peer.SendMessage(false, &fundingAccept); err != nil {
 		fndgLog.Errorf("unable to send funding response to peer: %v", err)
@@ -1259,7 +1497,9 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
 		CsvDelay:         msg.CsvDelay,
 	}
-	err = resCtx.reservation.CommitConstraints(channelConstraints)
+	err = resCtx.reservation.CommitConstraints(
+		channelConstraints, f.cfg.ConstraintsPolicy,
+	)
 	if err != nil {
 		fndgLog.Warnf("Unacceptable channel constraints: %v", err)
 		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
@@ -1304,6 +1544,7 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 				PubKey: copyPubKey(msg.HtlcPoint),
 			},
 		},
+		UpfrontShutdown: msg.UpfrontShutdownScript,
 	}
 	err = resCtx.reservation.ProcessContribution(remoteContribution)
 	if err != nil {
@@ -1483,6 +1724,10 @@ func (f *fundingManager) handleFundingCreated(fmsg *fundingCreatedMsg) {
 			"arbitration: %v", fundingOut, err)
 	}
 
+	// Inform the ChannelNotifier that the channel has entered the pending
+	// open state.
+	f.cfg.NotifyPendingOpenChannelEvent(fundingOut, completeChan)
+
 	// Create an entry in the local discovery map so we can ensure that we
 	// process the channel confirmation fully before we receive a funding
 	// locked message.
@@ -1647,6 +1892,10 @@ func (f *fundingManager) handleFundingSigned(fmsg *fundingSignedMsg) {
 			"arbitration: %v", fundingPoint, err)
 	}
 
+	// Inform the ChannelNotifier that the channel has entered the pending
+	// open state.
+	f.cfg.NotifyPendingOpenChannelEvent(*fundingPoint, completeChan)
+
 	fndgLog.Infof("Finalizing pendingID(%x) over ChannelPoint(%v), "+
 		"waiting for channel open on-chain", pendingChanID[:],
 		fundingPoint)
@@ -2011,6 +2260,13 @@ func (f *fundingManager) handleFundingConfirmation(peer lnpeer.Peer,
 
 	fndgLog.Debugf("ChannelID(%v) is now fully confirmed!", chanID)
 
+	// Now that the funding transaction has confirmed, there's no need to
+	// keep around any children that bumped its fee.
+	err = f.deleteFundingBumpTxns(&completeChan.FundingOutpoint)
+	if err != nil {
+		return fmt.Errorf("unable to delete funding bump txns: %v", err)
+	}
+
 	err = f.sendFundingLocked(peer, completeChan, lnChannel, shortChanID)
 	if err != nil {
 		return fmt.Errorf("failed sending fundingLocked: %v", err)
@@ -2267,14 +2523,31 @@ func (f *fundingManager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 		// Wait until 6 confirmations has been reached or the wallet
 		// signals a shutdown.
 		select {
-		case _, ok := <-confNtfn.Confirmed:
+		case confDetails, ok := <-confNtfn.Confirmed:
 			if !ok {
 				return fmt.Errorf("ChainNotifier shutting "+
 					"down, cannot complete funding flow "+
 					"for ChannelPoint(%v)",
 					completeChan.FundingOutpoint)
 			}
-			// Fallthrough.
+
+			// If the funding transaction was reorged out of the
+			// block we marked the channel open at, and confirmed
+			// within another one, the short channel ID we know
+			// the channel by is no longer valid. Announcing it
+			// would only get the announcement rejected, so we'll
+			// switch the channel over to its new short channel ID
+			// before announcing it.
+			if confDetails.BlockHeight != shortChanID.BlockHeight ||
+				confDetails.TxIndex != shortChanID.TxIndex {
+
+				shortChanID, err = f.refreshShortChanID(
+					completeChan, shortChanID, confDetails,
+				)
+				if err != nil {
+					return err
+				}
+			}
 
 		case <-f.quit:
 			return fmt.Errorf("%v, stopping funding flow for "+
@@ -2334,6 +2607,51 @@ func (f *fundingManager) annAfterSixConfs(completeChan *channeldb.OpenChannel,
 	return nil
 }
 
+// refreshShortChanID moves a channel whose funding transaction was reorged
+// into another block after the channel was marked open over to the short
+// channel ID of its new location. The new short channel ID is persisted, the
+// channel's link is instructed to load it, and the channel is added to the
+// router's graph under it. The edge of the stale short channel ID doesn't
+// need to be removed, as the router prunes it once the block it points to is
+// disconnected.
+func (f *fundingManager) refreshShortChanID(completeChan *channeldb.OpenChannel,
+	staleShortChanID *lnwire.ShortChannelID,
+	confDetails *chainntnfs.TxConfirmation) (*lnwire.ShortChannelID, error) {
+
+	fundingPoint := completeChan.FundingOutpoint
+	shortChanID := lnwire.ShortChannelID{
+		BlockHeight: confDetails.BlockHeight,
+		TxIndex:     confDetails.TxIndex,
+		TxPosition:  uint16(fundingPoint.Index),
+	}
+
+	fndgLog.Infof("Funding tx of ChannelPoint(%v) was reorged, updating "+
+		"short_chan_id from %v to %v", &fundingPoint, staleShortChanID,
+		shortChanID)
+
+	if err := completeChan.MarkAsOpen(shortChanID); err != nil {
+		return nil, fmt.Errorf("unable to update short_chan_id of "+
+			"ChannelPoint(%v): %v", &fundingPoint, err)
+	}
+
+	// The link of the channel may still be known to the switch by the
+	// stale short channel ID, so we'll instruct the switch to load the
+	// updated one from disk.
+	if err := f.cfg.ReportShortChanID(fundingPoint); err != nil {
+		fndgLog.Errorf("unable to report short chan id: %v", err)
+	}
+
+	// Adding the channel to the router's graph also stores the new short
+	// channel ID along with the channel's opening state, such that the
+	// announcement is resumed under it after a restart.
+	if err := f.addToRouterGraph(completeChan, &shortChanID); err != nil {
+		return nil, fmt.Errorf("failed adding to router graph: %v",
+			err)
+	}
+
+	return &shortChanID, nil
+}
+
 // processFundingLocked sends a message to the fundingManager allowing it to
 // finish the funding workflow.
 func (f *fundingManager) processFundingLocked(msg *lnwire.FundingLocked,
@@ -2728,6 +3046,14 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		localAmt, msg.pushAmt, capacity, msg.chainHash,
 		peerKey.SerializeCompressed(), ourDustLimit, msg.minConfs)
 
+	// Before going any further, we'll make sure the peer advertises all of
+	// the features we require from it.
+	missing := missingFeatures(msg.peer, f.cfg.RequiredFeatures)
+	if len(missing) > 0 {
+		msg.err <- lnwallet.ErrMissingFeatures(missing)
+		return
+	}
+
 	// First, we'll query the fee estimator for a fee that should get the
 	// commitment transaction confirmed by the next few blocks (conf target
 	// of 3). We target the near blocks here to ensure that we'll be able
@@ -2738,6 +3064,16 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		return
 	}
 
+	// If both we and the peer support anchor outputs, the channel will
+	// use them. As we'll be able to bump the fee of the commitment through
+	// our anchor when needed, we'll cap the fee rate we commit to.
+	anchors := useAnchors(msg.peer)
+	if anchors {
+		commitFeePerKw = lnwallet.CapCommitFeeRate(
+			channeldb.AnchorOutputsBit, commitFeePerKw,
+		)
+	}
+
 	// We set the channel flags to indicate whether we want this channel to
 	// be announced to the network.
 	var channelFlags lnwire.FundingFlag
@@ -2746,6 +3082,18 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		channelFlags = lnwire.FFAnnounceChannel
 	}
 
+	// If the caller chose the pending channel ID of this funding flow
+	// themselves, we'll make sure it isn't already in use before locking
+	// any of our funds.
+	var zeroID [32]byte
+	if msg.pendingChanID != zeroID &&
+		f.pendingChanIDInUse(msg.pendingChanID) {
+
+		msg.err <- fmt.Errorf("pending channel ID %x already in use",
+			msg.pendingChanID[:])
+		return
+	}
+
 	// Initialize a funding reservation with the local wallet. If the
 	// wallet doesn't have enough funds to commit to this channel, then the
 	// request will fail, and be aborted.
@@ -2760,6 +3108,19 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		PushMSat:        msg.pushAmt,
 		Flags:           channelFlags,
 		MinConfs:        msg.minConfs,
+		Anchors:         anchors,
+	}
+
+	// If the peer supports upfront shutdown scripts, we'll commit to
+	// either the script provided by the user, or a freshly generated one
+	// if configured to do so.
+	shutdown, err := getUpfrontShutdownScript(
+		f.cfg.EnableUpfrontShutdown, msg.peer,
+		msg.openChanReq.shutdownScript, f.cfg.GenUpfrontShutdownScript,
+	)
+	if err != nil {
+		msg.err <- err
+		return
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
@@ -2767,10 +3128,15 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		msg.err <- err
 		return
 	}
+	reservation.SetOurUpfrontShutdown(shutdown)
 
 	// Obtain a new pending channel ID which is used to track this
-	// reservation throughout its lifetime.
-	chanID := f.nextPendingChanID()
+	// reservation throughout its lifetime, unless one was provided by the
+	// caller.
+	chanID := msg.pendingChanID
+	if chanID == zeroID {
+		chanID = f.nextPendingChanID()
+	}
 
 	fndgLog.Infof("Target commit tx sat/kw for pendingID(%x): %v", chanID,
 		int64(commitFeePerKw))
@@ -2826,24 +3192,25 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		msg.peer.Address(), chanID)
 
 	fundingOpen := lnwire.OpenChannel{
-		ChainHash:            *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
-		PendingChannelID:     chanID,
-		FundingAmount:        capacity,
-		PushAmount:           msg.pushAmt,
-		DustLimit:            ourContribution.DustLimit,
-		MaxValueInFlight:     maxValue,
-		ChannelReserve:       chanReserve,
-		HtlcMinimum:          minHtlc,
-		FeePerKiloWeight:     uint32(commitFeePerKw),
-		CsvDelay:             remoteCsvDelay,
-		MaxAcceptedHTLCs:     maxHtlcs,
-		FundingKey:           ourContribution.MultiSigKey.PubKey,
-		RevocationPoint:      ourContribution.RevocationBasePoint.PubKey,
-		PaymentPoint:         ourContribution.PaymentBasePoint.PubKey,
-		HtlcPoint:            ourContribution.HtlcBasePoint.PubKey,
-		DelayedPaymentPoint:  ourContribution.DelayBasePoint.PubKey,
-		FirstCommitmentPoint: ourContribution.FirstCommitmentPoint,
-		ChannelFlags:         channelFlags,
+		ChainHash:             *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
+		PendingChannelID:      chanID,
+		FundingAmount:         capacity,
+		PushAmount:            msg.pushAmt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		ChannelReserve:        chanReserve,
+		HtlcMinimum:           minHtlc,
+		FeePerKiloWeight:      uint32(commitFeePerKw),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourContribution.RevocationBasePoint.PubKey,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
 	}
 	if err := msg.peer.SendMessage(false, &fundingOpen); err != nil {
 		e := fmt.Errorf("Unable to send funding request message: %v",
@@ -3158,3 +3525,248 @@ func (f *fundingManager) deleteChannelOpeningState(chanPoint *wire.OutPoint) err
 		return bucket.Delete(outpointBytes.Bytes())
 	})
 }
+
+// BumpFundingFee bumps the fee of the unconfirmed funding transaction of the
+// pending channel with the given channel point to the target fee rate. As
+// replacing the funding transaction would invalidate the commitment
+// transactions signed by the remote party, the fee is bumped by spending our
+// change output in a child transaction instead. Any subsequent bump spends
+// the output of the previous child, such that the fee of the whole package is
+// taken into account. The child is persisted before being broadcast, so that
+// it's rebroadcast along with the funding transaction upon restart.
+func (f *fundingManager) BumpFundingFee(chanPoint *wire.OutPoint,
+	feeRate chainfee.SatPerKWeight) (*wire.MsgTx, error) {
+
+	f.bumpMtx.Lock()
+	defer f.bumpMtx.Unlock()
+
+	pendingChannels, err := f.cfg.Wallet.Cfg.Database.FetchPendingChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	var channel *channeldb.OpenChannel
+	for _, pendingChan := range pendingChannels {
+		if pendingChan.FundingOutpoint == *chanPoint {
+			channel = pendingChan
+			break
+		}
+	}
+	switch {
+	case channel == nil:
+		return nil, ErrChannelNotFound
+
+	case !channel.IsInitiator || !channel.ChanType.IsSingleFunder() ||
+		channel.FundingTxn == nil:
+
+		return nil, fmt.Errorf("funding transaction of ChannelPoint(%v) "+
+			"wasn't created by us", chanPoint)
+	}
+
+	bumpTxns, err := f.fetchFundingBumpTxns(chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// The package we'll bump consists of the funding transaction along
+	// with all children that were broadcast to bump its fee before.
+	pkg := append([]*wire.MsgTx{channel.FundingTxn}, bumpTxns...)
+
+	var (
+		packageWeight int64
+		packageFee    btcutil.Amount
+	)
+	for _, tx := range pkg {
+		packageWeight += blockchain.GetTransactionWeight(
+			btcutil.NewTx(tx),
+		)
+
+		for _, txIn := range tx.TxIn {
+			prevOut, err := f.cfg.Wallet.FetchInputInfo(
+				&txIn.PreviousOutPoint,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("unable to fetch input "+
+					"%v: %v", txIn.PreviousOutPoint, err)
+			}
+			packageFee += btcutil.Amount(prevOut.Value)
+		}
+		for _, txOut := range tx.TxOut {
+			packageFee -= btcutil.Amount(txOut.Value)
+		}
+	}
+
+	// If the fee was bumped before, we'll spend the output of the last
+	// child. Otherwise, we'll spend the change output of the funding
+	// transaction.
+	var spendOutpoint *wire.OutPoint
+	if len(bumpTxns) > 0 {
+		spendOutpoint = &wire.OutPoint{
+			Hash: bumpTxns[len(bumpTxns)-1].TxHash(),
+		}
+	} else {
+		fundingTxHash := channel.FundingTxn.TxHash()
+		for i := range channel.FundingTxn.TxOut {
+			if uint32(i) == chanPoint.Index {
+				continue
+			}
+
+			op := &wire.OutPoint{
+				Hash:  fundingTxHash,
+				Index: uint32(i),
+			}
+			if _, err := f.cfg.Wallet.FetchInputInfo(op); err == nil {
+				spendOutpoint = op
+				break
+			}
+		}
+	}
+	if spendOutpoint == nil {
+		return nil, fmt.Errorf("funding transaction of "+
+			"ChannelPoint(%v) has no change output", chanPoint)
+	}
+
+	deliveryAddr, err := f.cfg.Wallet.NewAddress(
+		lnwallet.WitnessPubKey, false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	deliveryPkScript, err := txscript.PayToAddrScript(deliveryAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	bumpTx, err := sweep.CraftCPFPTx(
+		spendOutpoint, packageWeight, packageFee, feeRate,
+		uint32(bestHeight), deliveryPkScript, f.cfg.Wallet,
+		f.cfg.Wallet.Cfg.Signer,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	fndgLog.Infof("Bumping fee of funding tx for ChannelPoint(%v) to %v "+
+		"sat/kw with child %v", chanPoint, int64(feeRate),
+		bumpTx.TxHash())
+
+	// We'll persist the child before broadcasting it, to ensure it's
+	// rebroadcast upon restart.
+	if err := f.saveFundingBumpTx(chanPoint, bumpTx); err != nil {
+		return nil, err
+	}
+
+	err = f.cfg.PublishTransaction(bumpTx)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		return nil, err
+	}
+
+	return bumpTx, nil
+}
+
+// rebroadcastFundingBumpTxns rebroadcasts all children that were created to
+// bump the fee of the funding transaction of chanPoint. Any errors are only
+// logged, as there isn't anything we can do to recover from them.
+func (f *fundingManager) rebroadcastFundingBumpTxns(chanPoint *wire.OutPoint) {
+	bumpTxns, err := f.fetchFundingBumpTxns(chanPoint)
+	if err != nil {
+		fndgLog.Errorf("Unable to fetch funding bump txns for "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+		return
+	}
+
+	for _, bumpTx := range bumpTxns {
+		err := f.cfg.PublishTransaction(bumpTx)
+		if err != nil && err != lnwallet.ErrDoubleSpend {
+			fndgLog.Errorf("Unable to rebroadcast funding bump "+
+				"tx %v for ChannelPoint(%v): %v",
+				bumpTx.TxHash(), chanPoint, err)
+		}
+	}
+}
+
+// saveFundingBumpTx adds the given child transaction to the set of
+// transactions bumping the fee of the funding transaction of chanPoint.
+func (f *fundingManager) saveFundingBumpTx(chanPoint *wire.OutPoint,
+	bumpTx *wire.MsgTx) error {
+
+	return f.cfg.Wallet.Cfg.Database.Update(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(fundingBumpBucket)
+		if err != nil {
+			return err
+		}
+
+		var outpointBytes bytes.Buffer
+		if err = writeOutpoint(&outpointBytes, chanPoint); err != nil {
+			return err
+		}
+
+		// The children are stored back to back, in the order they
+		// were created.
+		var b bytes.Buffer
+		b.Write(bucket.Get(outpointBytes.Bytes()))
+		if err := bumpTx.Serialize(&b); err != nil {
+			return err
+		}
+
+		return bucket.Put(outpointBytes.Bytes(), b.Bytes())
+	})
+}
+
+// fetchFundingBumpTxns returns the child transactions bumping the fee of the
+// funding transaction of chanPoint, in the order they were created.
+func (f *fundingManager) fetchFundingBumpTxns(
+	chanPoint *wire.OutPoint) ([]*wire.MsgTx, error) {
+
+	var bumpTxns []*wire.MsgTx
+	err := f.cfg.Wallet.Cfg.Database.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(fundingBumpBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		var outpointBytes bytes.Buffer
+		if err := writeOutpoint(&outpointBytes, chanPoint); err != nil {
+			return err
+		}
+
+		r := bytes.NewReader(bucket.Get(outpointBytes.Bytes()))
+		for r.Len() > 0 {
+			bumpTx := &wire.MsgTx{}
+			if err := bumpTx.Deserialize(r); err != nil {
+				return err
+			}
+			bumpTxns = append(bumpTxns, bumpTx)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return bumpTxns, nil
+}
+
+// deleteFundingBumpTxns removes any child transactions bumping the fee of the
+// funding transaction of chanPoint from the database.
+func (f *fundingManager) deleteFundingBumpTxns(chanPoint *wire.OutPoint) error {
+	return f.cfg.Wallet.Cfg.Database.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(fundingBumpBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		var outpointBytes bytes.Buffer
+		if err := writeOutpoint(&outpointBytes, chanPoint); err != nil {
+			return err
+		}
+
+		return bucket.Delete(outpointBytes.Bytes())
+	})
+}
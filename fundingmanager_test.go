@@ -3,6 +3,7 @@
 package main
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -22,6 +23,7 @@ import (
 	"github.com/btcsuite/btcutil"
 
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
@@ -29,6 +31,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -170,6 +173,10 @@ func (n *testNode) SendMessage(_ bool, msg ...lnwire.Message) error {
 	return n.sendMessage(msg[0])
 }
 
+func (n *testNode) SendMessageLazy(sync bool, msgs ...lnwire.Message) error {
+	return n.SendMessage(sync, msgs...)
+}
+
 func (n *testNode) WipeChannel(_ *wire.OutPoint) error {
 	return nil
 }
@@ -178,6 +185,17 @@ func (n *testNode) QuitSignal() <-chan struct{} {
 	return n.shutdownChannel
 }
 
+func (n *testNode) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.UpfrontShutdownScriptOptional),
+		lnwire.LocalFeatures,
+	)
+}
+
+func (n *testNode) LocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
 func (n *testNode) AddNewChannel(channel *channeldb.OpenChannel,
 	quit <-chan struct{}) error {
 
@@ -205,7 +223,7 @@ func createTestWallet(cdb *channeldb.DB, netParams *chaincfg.Params,
 	notifier chainntnfs.ChainNotifier, wc lnwallet.WalletController,
 	signer input.Signer, keyRing keychain.SecretKeyRing,
 	bio lnwallet.BlockChainIO,
-	estimator lnwallet.FeeEstimator) (*lnwallet.LightningWallet, error) {
+	estimator chainfee.Estimator) (*lnwallet.LightningWallet, error) {
 
 	wallet, err := lnwallet.NewLightningWallet(lnwallet.Config{
 		Database:           cdb,
@@ -233,7 +251,7 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 	addr *lnwire.NetAddress, tempTestDir string) (*testNode, error) {
 
 	netParams := activeNetParams.Params
-	estimator := lnwallet.NewStaticFeeEstimator(62500, 0)
+	estimator := chainfee.NewStaticEstimator(62500, 0)
 
 	chainNotifier := &mockNotifier{
 		oneConfChannel: make(chan *chainntnfs.TxConfirmation, 1),
@@ -343,6 +361,8 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		RequiredRemoteMaxHTLCs: func(chanAmt btcutil.Amount) uint16 {
 			return uint16(input.MaxHTLCNumber / 2)
 		},
+		ConstraintsPolicy:    lnwallet.DefaultConstraintsPolicy(),
+		OpenChannelPredicate: chanacceptor.NewChainedAcceptor(),
 		WatchNewChannel: func(*channeldb.OpenChannel, *btcec.PublicKey) error {
 			return nil
 		},
@@ -356,6 +376,9 @@ func createTestFundingManager(t *testing.T, privKey *btcec.PrivateKey,
 		ZombieSweeperInterval:  1 * time.Hour,
 		ReservationTimeout:     1 * time.Nanosecond,
 		NotifyOpenChannelEvent: func(wire.OutPoint) {},
+		NotifyPendingOpenChannelEvent: func(wire.OutPoint,
+			*channeldb.OpenChannel) {
+		},
 	})
 	if err != nil {
 		t.Fatalf("failed creating fundingManager: %v", err)
@@ -436,6 +459,8 @@ func recreateAliceFundingManager(t *testing.T, alice *testNode) {
 			TimeLockDelta: 10,
 		},
 		RequiredRemoteMaxValue: oldCfg.RequiredRemoteMaxValue,
+		ConstraintsPolicy:      oldCfg.ConstraintsPolicy,
+		OpenChannelPredicate:   oldCfg.OpenChannelPredicate,
 		PublishTransaction: func(txn *wire.MsgTx) error {
 			publishChan <- txn
 			return nil
@@ -676,6 +701,8 @@ func assertFundingMsgSent(t *testing.T, msgChan chan lnwire.Message,
 		ok      bool
 	)
 	switch msgType {
+	case "OpenChannel":
+		sentMsg, ok = msg.(*lnwire.OpenChannel)
 	case "AcceptChannel":
 		sentMsg, ok = msg.(*lnwire.AcceptChannel)
 	case "FundingCreated":
@@ -1091,6 +1118,128 @@ func TestFundingManagerNormalWorkflow(t *testing.T) {
 	assertNoChannelState(t, alice, bob, fundingOutPoint)
 }
 
+// TestFundingManagerReorgBeforeAnnouncement checks that a channel whose
+// funding transaction was reorged into another block after the channel was
+// marked open is moved over to its new short channel ID, and announced under
+// it.
+func TestFundingManagerReorgBeforeAnnouncement(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// We will consume the channel updates as we go, so no buffering is needed.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+
+	// Run through the process of opening the channel, up until the funding
+	// transaction is broadcasted.
+	localAmt := btcutil.Amount(500000)
+	pushAmt := btcutil.Amount(0)
+	capacity := localAmt + pushAmt
+	fundingOutPoint := openChannel(t, alice, bob, localAmt, pushAmt, 1,
+		updateChan, true)
+
+	// Track the short channel IDs Alice reports to the switch.
+	reportedChans := make(chan wire.OutPoint, 2)
+	alice.fundingMgr.cfg.ReportShortChanID = func(op wire.OutPoint) error {
+		reportedChans <- op
+		return nil
+	}
+
+	// Notify that transaction was mined, and run through the process of
+	// marking the channel open.
+	alice.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{}
+	bob.mockNotifier.oneConfChannel <- &chainntnfs.TxConfirmation{}
+
+	assertMarkedOpen(t, alice, bob, fundingOutPoint)
+
+	fundingLockedAlice := assertFundingMsgSent(
+		t, alice.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+	fundingLockedBob := assertFundingMsgSent(
+		t, bob.msgChan, "FundingLocked",
+	).(*lnwire.FundingLocked)
+
+	assertFundingLockedSent(t, alice, bob, fundingOutPoint)
+	assertChannelAnnouncements(t, alice, bob, capacity)
+	assertAddedToRouterGraph(t, alice, bob, fundingOutPoint)
+	waitForOpenUpdate(t, updateChan)
+
+	alice.fundingMgr.processFundingLocked(fundingLockedBob, bob)
+	bob.fundingMgr.processFundingLocked(fundingLockedAlice, alice)
+	assertHandleFundingLocked(t, alice, bob)
+
+	select {
+	case <-reportedChans:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("short chan id not reported")
+	}
+
+	// The funding transaction now reaches six confirmations within a
+	// different block than the one the channel was marked open at.
+	newShortChanID := lnwire.ShortChannelID{
+		BlockHeight: 1,
+		TxIndex:     2,
+		TxPosition:  uint16(fundingOutPoint.Index),
+	}
+	alice.mockNotifier.sixConfChannel <- &chainntnfs.TxConfirmation{
+		BlockHeight: newShortChanID.BlockHeight,
+		TxIndex:     newShortChanID.TxIndex,
+	}
+
+	// Alice should first add the channel to the router's graph under its
+	// new short channel ID, and then announce it under the same one.
+	for _, expected := range []string{
+		"ChannelAnnouncement", "ChannelUpdate", "AnnounceSignatures",
+		"NodeAnnouncement",
+	} {
+		var msg lnwire.Message
+		select {
+		case msg = <-alice.announceChan:
+		case <-time.After(time.Second * 5):
+			t.Fatalf("alice did not send %v", expected)
+		}
+
+		var shortChanID lnwire.ShortChannelID
+		switch m := msg.(type) {
+		case *lnwire.ChannelAnnouncement:
+			shortChanID = m.ShortChannelID
+		case *lnwire.ChannelUpdate:
+			shortChanID = m.ShortChannelID
+		case *lnwire.AnnounceSignatures:
+			shortChanID = m.ShortChannelID
+		case *lnwire.NodeAnnouncement:
+			shortChanID = newShortChanID
+		}
+
+		if msg.MsgType().String() != expected {
+			t.Fatalf("expected %v, got %v", expected, msg.MsgType())
+		}
+		if shortChanID != newShortChanID {
+			t.Fatalf("expected %v for short_chan_id=%v, got %v",
+				expected, newShortChanID, shortChanID)
+		}
+	}
+
+	// The switch should have been told to load the new short channel ID,
+	// which should have been persisted.
+	select {
+	case <-reportedChans:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("new short chan id not reported")
+	}
+	chanID := lnwire.NewChanIDFromOutPoint(fundingOutPoint)
+	channel, err := alice.fundingMgr.cfg.FindChannel(chanID)
+	if err != nil {
+		t.Fatalf("unable to find channel: %v", err)
+	}
+	if channel.ShortChannelID != newShortChanID {
+		t.Fatalf("expected short_chan_id=%v to be persisted, got %v",
+			newShortChanID, channel.ShortChannelID)
+	}
+
+	// With the channel announced, its opening state should be removed.
+	assertErrChannelNotFound(t, alice, fundingOutPoint)
+}
+
 func TestFundingManagerRestartBehavior(t *testing.T) {
 	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
 	defer tearDownFundingManagers(t, alice, bob)
@@ -2659,3 +2808,354 @@ func TestFundingManagerMaxConfs(t *testing.T) {
 			string(err.Data))
 	}
 }
+
+// TestFundingManagerConstraintsPolicy ensures that we reject a funding
+// proposal whose constraints for our commitment transaction are outside of the
+// bounds of our constraints policy.
+func TestFundingManagerConstraintsPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		policy      *lnwallet.ConstraintsPolicy
+		expectedErr string
+	}{
+		{
+			// Alice requires a CSV delay of 4 blocks on Bob's
+			// funds.
+			name: "csv delay too large",
+			policy: &lnwallet.ConstraintsPolicy{
+				MaxCsvDelay:           3,
+				MaxChanReservePercent: 20,
+			},
+			expectedErr: "CSV delay too large",
+		},
+		{
+			// Alice requires Bob to keep 1% of the capacity as
+			// reserve.
+			name: "reserve too large",
+			policy: &lnwallet.ConstraintsPolicy{
+				MaxCsvDelay:           10,
+				MaxChanReservePercent: 0,
+			},
+			expectedErr: "Channel reserve is too large",
+		},
+		{
+			// Alice allows Bob to use the full capacity minus the
+			// reserve.
+			name: "max value in flight too small",
+			policy: &lnwallet.ConstraintsPolicy{
+				MaxCsvDelay:           10,
+				MaxChanReservePercent: 20,
+				MinMaxInFlightPercent: 100,
+			},
+			expectedErr: "maxValueInFlight too small",
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			alice, bob := setupFundingManagers(
+				t, defaultMaxPendingChannels,
+			)
+			defer tearDownFundingManagers(t, alice, bob)
+
+			bob.fundingMgr.cfg.ConstraintsPolicy = test.policy
+
+			// Create a funding request and start the workflow.
+			initReq := &openChanReq{
+				targetPubkey:    bob.privKey.PubKey(),
+				chainHash:       *activeNetParams.GenesisHash,
+				localFundingAmt: 500000,
+				updates: make(
+					chan *lnrpc.OpenStatusUpdate,
+				),
+				err: make(chan error, 1),
+			}
+			alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+			// Alice should have sent the OpenChannel message to
+			// Bob, which he should reject.
+			openChannelReq := assertFundingMsgSent(
+				t, alice.msgChan, "OpenChannel",
+			).(*lnwire.OpenChannel)
+			bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+			err := assertFundingMsgSent(
+				t, bob.msgChan, "Error",
+			).(*lnwire.Error)
+			errMsg := string(err.Data)
+			if !strings.Contains(errMsg, test.expectedErr) {
+				t.Fatalf("expected error containing \"%v\", "+
+					"got \"%v\"", test.expectedErr, errMsg)
+			}
+		})
+	}
+}
+
+// mockChanAcceptor is a ChannelAcceptor that answers every channel request
+// with the same response.
+type mockChanAcceptor struct {
+	resp *chanacceptor.ChannelAcceptResponse
+}
+
+// Accept returns the mockChanAcceptor's canned response.
+func (m *mockChanAcceptor) Accept(
+	*chanacceptor.ChannelAcceptRequest) *chanacceptor.ChannelAcceptResponse {
+
+	return m.resp
+}
+
+// TestFundingManagerChannelAcceptor ensures that the channel acceptors are
+// able to reject inbound channel requests, and to override the parameters we
+// require from the initiator.
+func TestFundingManagerChannelAcceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		resp        *chanacceptor.ChannelAcceptResponse
+		expectedErr string
+	}{
+		{
+			name: "rejected",
+			resp: chanacceptor.NewChannelRejectResponse(
+				errors.New("no channels from you"),
+			),
+			expectedErr: "no channels from you",
+		},
+		{
+			name: "overridden parameters",
+			resp: chanacceptor.NewChannelAcceptResponse(5, 200),
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			alice, bob := setupFundingManagers(
+				t, defaultMaxPendingChannels,
+			)
+			defer tearDownFundingManagers(t, alice, bob)
+
+			acceptor := chanacceptor.NewChainedAcceptor()
+			acceptor.AddAcceptor(&mockChanAcceptor{resp: test.resp})
+			bob.fundingMgr.cfg.OpenChannelPredicate = acceptor
+
+			// Create a funding request and start the workflow.
+			initReq := &openChanReq{
+				targetPubkey:    bob.privKey.PubKey(),
+				chainHash:       *activeNetParams.GenesisHash,
+				localFundingAmt: 500000,
+				updates: make(
+					chan *lnrpc.OpenStatusUpdate,
+				),
+				err: make(chan error, 1),
+			}
+			alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+			openChannelReq := assertFundingMsgSent(
+				t, alice.msgChan, "OpenChannel",
+			).(*lnwire.OpenChannel)
+			bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+			// If the acceptor rejects the channel, Bob should
+			// relay the reason to Alice.
+			if test.expectedErr != "" {
+				err := assertFundingMsgSent(
+					t, bob.msgChan, "Error",
+				).(*lnwire.Error)
+				errMsg := string(err.Data)
+				if !strings.Contains(errMsg, test.expectedErr) {
+					t.Fatalf("expected error containing "+
+						"\"%v\", got \"%v\"",
+						test.expectedErr, errMsg)
+				}
+				return
+			}
+
+			// Otherwise, Bob should require the parameters
+			// dictated by the acceptor.
+			acceptChannelResponse := assertFundingMsgSent(
+				t, bob.msgChan, "AcceptChannel",
+			).(*lnwire.AcceptChannel)
+			if acceptChannelResponse.MinAcceptDepth != 5 {
+				t.Fatalf("expected min accept depth 5, got %v",
+					acceptChannelResponse.MinAcceptDepth)
+			}
+			if acceptChannelResponse.CsvDelay != 200 {
+				t.Fatalf("expected csv delay 200, got %v",
+					acceptChannelResponse.CsvDelay)
+			}
+		})
+	}
+}
+
+// TestFundingManagerCancelPendingFunding asserts that a locally initiated
+// funding flow can be canceled using the pending channel ID chosen by the
+// caller, as long as the funding transaction hasn't been broadcast.
+func TestFundingManagerCancelPendingFunding(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	var pendingChanID [32]byte
+	copy(pendingChanID[:], bytes.Repeat([]byte{0x1}, 32))
+
+	// Create a funding request with a custom pending channel ID and start
+	// the workflow.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         false,
+		pendingChanID:   pendingChanID,
+		updates:         updateChan,
+		err:             errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	// Alice should have sent the OpenChannel message to Bob, referencing
+	// the pending channel ID we chose.
+	openChannelReq := assertFundingMsgSent(
+		t, alice.msgChan, "OpenChannel",
+	).(*lnwire.OpenChannel)
+	if openChannelReq.PendingChannelID != pendingChanID {
+		t.Fatalf("expected pending channel ID %x, got %x",
+			pendingChanID, openChannelReq.PendingChannelID)
+	}
+
+	// Reusing the same pending channel ID while the funding flow is
+	// active should be rejected.
+	dupReq := *initReq
+	dupReq.err = make(chan error, 1)
+	alice.fundingMgr.initFundingWorkflow(bob, &dupReq)
+	select {
+	case <-dupReq.err:
+	case <-time.After(time.Second * 5):
+		t.Fatalf("expected duplicate pending channel ID to be rejected")
+	}
+
+	// Canceling a funding flow we don't know of should fail.
+	err := alice.fundingMgr.CancelPendingFunding([32]byte{0x2})
+	if err == nil {
+		t.Fatalf("expected canceling unknown funding flow to fail")
+	}
+
+	// Now, we'll cancel the funding flow. Alice should send an error to
+	// Bob, notify the caller and release the reservation.
+	err = alice.fundingMgr.CancelPendingFunding(pendingChanID)
+	if err != nil {
+		t.Fatalf("unable to cancel funding flow: %v", err)
+	}
+
+	assertErrorSent(t, alice.msgChan)
+
+	select {
+	case err := <-errChan:
+		if !strings.Contains(err.Error(), "canceled") {
+			t.Fatalf("unexpected funding error: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding error not sent to caller")
+	}
+
+	assertNumPendingReservations(t, alice, bob.privKey.PubKey(), 0)
+
+	// Canceling it once more should fail, as it's no longer pending.
+	err = alice.fundingMgr.CancelPendingFunding(pendingChanID)
+	if err == nil {
+		t.Fatalf("expected canceling funding flow twice to fail")
+	}
+}
+
+// upfrontShutdownPeer is a test peer which advertises the given local
+// features, used to test whether option upfront shutdown is honored.
+type upfrontShutdownPeer struct {
+	*testNode
+	features *lnwire.FeatureVector
+}
+
+func (p *upfrontShutdownPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return p.features
+}
+
+// TestGetUpfrontShutdownScript tests that we commit to the expected upfront
+// shutdown script, depending on whether the peer supports the feature, a
+// script was provided by the user and whether the option is enabled.
+func TestGetUpfrontShutdownScript(t *testing.T) {
+	t.Parallel()
+
+	upfrontScript := []byte("upfront script")
+	generatedScript := []byte("generated script")
+
+	getScript := func() (lnwire.DeliveryAddress, error) {
+		return generatedScript, nil
+	}
+
+	supported := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(lnwire.UpfrontShutdownScriptOptional),
+		lnwire.LocalFeatures,
+	)
+	unsupported := lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+
+	tests := []struct {
+		name           string
+		features       *lnwire.FeatureVector
+		upfrontScript  lnwire.DeliveryAddress
+		enableUpfront  bool
+		expectedScript lnwire.DeliveryAddress
+		expectedErr    error
+	}{
+		{
+			name:     "peer does not support, no script",
+			features: unsupported,
+		},
+		{
+			name:          "peer does not support, script set",
+			features:      unsupported,
+			upfrontScript: upfrontScript,
+			expectedErr:   errUpfrontShutdownScriptNotSupported,
+		},
+		{
+			name:     "peer supports, option disabled",
+			features: supported,
+		},
+		{
+			name:           "peer supports, user script",
+			features:       supported,
+			upfrontScript:  upfrontScript,
+			enableUpfront:  true,
+			expectedScript: upfrontScript,
+		},
+		{
+			name:           "peer supports, option enabled",
+			features:       supported,
+			enableUpfront:  true,
+			expectedScript: generatedScript,
+		},
+	}
+
+	for _, test := range tests {
+		peer := &upfrontShutdownPeer{features: test.features}
+
+		script, err := getUpfrontShutdownScript(
+			test.enableUpfront, peer, test.upfrontScript, getScript,
+		)
+		if err != test.expectedErr {
+			t.Fatalf("%v: expected error %v, got %v", test.name,
+				test.expectedErr, err)
+		}
+
+		if !bytes.Equal(script, test.expectedScript) {
+			t.Fatalf("%v: expected script %x, got %x", test.name,
+				test.expectedScript, script)
+		}
+	}
+}
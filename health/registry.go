@@ -0,0 +1,171 @@
+package health
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Status describes the health of a subsystem.
+type Status uint8
+
+const (
+	// StatusHealthy indicates that the subsystem hasn't panicked.
+	StatusHealthy Status = iota
+
+	// StatusDegraded indicates that the subsystem panicked at least once.
+	// It may have been restarted since, but its state may not be fully
+	// consistent anymore, so it requires the attention of the operator.
+	StatusDegraded
+)
+
+// String returns a human readable representation of the status.
+func (s Status) String() string {
+	switch s {
+	case StatusHealthy:
+		return "healthy"
+	case StatusDegraded:
+		return "degraded"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// Report is a snapshot of the health of a subsystem.
+type Report struct {
+	// Subsystem is the name of the subsystem.
+	Subsystem string
+
+	// Status is the current health status of the subsystem.
+	Status Status
+
+	// Panics is the number of times the subsystem panicked.
+	Panics uint32
+
+	// Restarts is the number of times the subsystem was restarted after
+	// a panic.
+	Restarts uint32
+
+	// LastPanic is the value the subsystem last panicked with.
+	LastPanic string
+
+	// LastPanicTime is the time at which the subsystem last panicked.
+	LastPanicTime time.Time
+}
+
+// Registry keeps track of the health of the subsystems of the daemon. Instead
+// of a panic within a single subsystem bringing down the entire daemon along
+// with all of its channels, the subsystem recovers from it through the
+// registry, which marks it as degraded and restarts it if that's safe.
+type Registry struct {
+	// maxRestarts is the number of times a subsystem may be restarted
+	// after panicking. Once exceeded, the subsystem is left stopped.
+	maxRestarts uint32
+
+	mu         sync.Mutex
+	subsystems map[string]*Report
+}
+
+// NewRegistry creates a new health registry, which restarts a subsystem at
+// most maxRestarts times after a panic.
+func NewRegistry(maxRestarts uint32) *Registry {
+	return &Registry{
+		maxRestarts: maxRestarts,
+		subsystems:  make(map[string]*Report),
+	}
+}
+
+// Register adds the subsystem with the given name to the registry, such that
+// it's reported as healthy until it panics.
+func (r *Registry) Register(subsystem string) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.report(subsystem)
+}
+
+// report returns the report of the given subsystem, creating it if it doesn't
+// exist yet.
+//
+// NOTE: The registry's mutex MUST be held when calling this method.
+func (r *Registry) report(subsystem string) *Report {
+	report, ok := r.subsystems[subsystem]
+	if !ok {
+		report = &Report{
+			Subsystem: subsystem,
+			Status:    StatusHealthy,
+		}
+		r.subsystems[subsystem] = report
+	}
+
+	return report
+}
+
+// Recover recovers from a panic within the given subsystem, if any. The
+// diagnostics of the panic are logged and the subsystem is marked as
+// degraded. If onPanic is non-nil, it's called once the panic is recovered
+// from, indicating whether the subsystem should restart itself. This is the
+// case unless it was already restarted the maximum number of times.
+//
+// NOTE: Recover MUST be deferred directly by the goroutine of the subsystem,
+// as it otherwise won't be able to recover from the panic. If the registry is
+// nil, the panic isn't recovered from.
+func (r *Registry) Recover(subsystem string, onPanic func(restart bool)) {
+	if r == nil {
+		return
+	}
+
+	p := recover()
+	if p == nil {
+		return
+	}
+
+	log.Criticalf("Subsystem %v panicked: %v\n%s", subsystem, p,
+		debug.Stack())
+
+	r.mu.Lock()
+	report := r.report(subsystem)
+	report.Status = StatusDegraded
+	report.Panics++
+	report.LastPanic = fmt.Sprint(p)
+	report.LastPanicTime = time.Now()
+
+	restart := onPanic != nil && report.Restarts < r.maxRestarts
+	if restart {
+		report.Restarts++
+	}
+	r.mu.Unlock()
+
+	if restart {
+		log.Infof("Restarting subsystem %v after panic", subsystem)
+	} else {
+		log.Errorf("Not restarting subsystem %v after panic",
+			subsystem)
+	}
+
+	if onPanic != nil {
+		onPanic(restart)
+	}
+}
+
+// Reports returns the health reports of all subsystems, sorted by name.
+func (r *Registry) Reports() []Report {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	reports := make([]Report, 0, len(r.subsystems))
+	for _, report := range r.subsystems {
+		reports = append(reports, *report)
+	}
+	sort.Slice(reports, func(i, j int) bool {
+		return reports[i].Subsystem < reports[j].Subsystem
+	})
+
+	return reports
+}
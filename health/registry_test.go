@@ -0,0 +1,83 @@
+package health
+
+import (
+	"testing"
+)
+
+// TestRegistryRecover asserts that a panicking subsystem is marked degraded
+// and restarted at most the maximum number of times.
+func TestRegistryRecover(t *testing.T) {
+	t.Parallel()
+
+	registry := NewRegistry(1)
+	registry.Register("healthy")
+
+	var (
+		runs     int
+		restarts = make(chan struct{}, 2)
+	)
+	var subsystem func()
+	subsystem = func() {
+		defer registry.Recover("panicky", func(restart bool) {
+			if !restart {
+				return
+			}
+
+			restarts <- struct{}{}
+			subsystem()
+		})
+
+		runs++
+		panic("boom")
+	}
+	subsystem()
+
+	if runs != 2 {
+		t.Fatalf("expected subsystem to run twice, ran %v times", runs)
+	}
+	if len(restarts) != 1 {
+		t.Fatalf("expected 1 restart, got %v", len(restarts))
+	}
+
+	reports := registry.Reports()
+	if len(reports) != 2 {
+		t.Fatalf("expected 2 reports, got %v", len(reports))
+	}
+
+	healthy, panicky := reports[0], reports[1]
+	if healthy.Subsystem != "healthy" ||
+		healthy.Status != StatusHealthy {
+
+		t.Fatalf("unexpected report: %+v", healthy)
+	}
+	if panicky.Subsystem != "panicky" ||
+		panicky.Status != StatusDegraded {
+
+		t.Fatalf("unexpected report: %+v", panicky)
+	}
+	if panicky.Panics != 2 || panicky.Restarts != 1 {
+		t.Fatalf("expected 2 panics and 1 restart, got %v and %v",
+			panicky.Panics, panicky.Restarts)
+	}
+	if panicky.LastPanic != "boom" {
+		t.Fatalf("unexpected last panic: %v", panicky.LastPanic)
+	}
+}
+
+// TestRegistryNilDoesNotRecover asserts that a nil registry doesn't recover
+// from panics, such that subsystems without one behave as before.
+func TestRegistryNilDoesNotRecover(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic to propagate")
+		}
+	}()
+
+	var registry *Registry
+	func() {
+		defer registry.Recover("subsystem", nil)
+		panic("boom")
+	}()
+}
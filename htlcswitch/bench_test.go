@@ -0,0 +1,164 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/fastsha256"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// logPerfStats logs the performance counters collected by the given switch
+// over the course of a benchmark.
+func logPerfStats(b *testing.B, name string, s *Switch) {
+	stats := s.PerfStats()
+	for _, link := range stats.Links {
+		b.Logf("%s: link %v: %.2f htlcs/s, %.2f commit sigs/s", name,
+			link.ChanID, link.HtlcsPerSecond(),
+			link.CommitSigsPerSecond())
+	}
+	b.Logf("%s: %v settles, latency p50=%v p90=%v p99=%v", name,
+		stats.NumSettles, stats.SettleLatencyP50, stats.SettleLatencyP90,
+		stats.SettleLatencyP99)
+}
+
+// BenchmarkSwitchForward benchmarks the hot path of the switch in isolation,
+// by forwarding an HTLC between two mock links and settling it back.
+func BenchmarkSwitchForward(b *testing.B) {
+	alicePeer, err := newMockServer(b, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		b.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(b, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		b.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		b.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		b.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		b.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		b.Fatalf("unable to add bob link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		b.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: uint64(i),
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1,
+			},
+		}
+		if err := s.forward(packet); err != nil {
+			b.Fatalf("unable to forward add: %v", err)
+		}
+
+		select {
+		case pkt := <-bobChannelLink.packets:
+			err := bobChannelLink.completeCircuit(pkt)
+			if err != nil {
+				b.Fatalf("unable to complete payment "+
+					"circuit: %v", err)
+			}
+		case <-time.After(time.Second):
+			b.Fatal("request was not propagated to destination")
+		}
+
+		packet = &htlcPacket{
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			outgoingHTLCID: uint64(i),
+			amount:         1,
+			htlc: &lnwire.UpdateFulfillHTLC{
+				PaymentPreimage: preimage,
+			},
+		}
+		if err := s.forward(packet); err != nil {
+			b.Fatalf("unable to forward settle: %v", err)
+		}
+
+		select {
+		case pkt := <-aliceChannelLink.packets:
+			if err := aliceChannelLink.deleteCircuit(pkt); err != nil {
+				b.Fatalf("unable to remove circuit: %v", err)
+			}
+		case <-time.After(time.Second):
+			b.Fatal("request was not propagated to channelPoint")
+		}
+	}
+
+	b.StopTimer()
+	logPerfStats(b, "switch", s)
+}
+
+// BenchmarkChannelLinkMultiHopPayment benchmarks the end-to-end throughput of
+// payments forwarded over a three hop network of real links, which includes
+// the commitment dance carried out on every hop.
+func BenchmarkChannelLinkMultiHopPayment(b *testing.B) {
+	channels, cleanUp, _, err := createClusterChannels(
+		btcutil.SatoshiPerBitcoin*3,
+		btcutil.SatoshiPerBitcoin*5)
+	if err != nil {
+		b.Fatalf("unable to create channel: %v", err)
+	}
+	defer cleanUp()
+
+	n := newThreeHopNetwork(b, channels.aliceToBob, channels.bobToAlice,
+		channels.bobToCarol, channels.carolToBob, testStartingHeight)
+	if err := n.start(); err != nil {
+		b.Fatal(err)
+	}
+	defer n.stop()
+
+	amount := lnwire.NewMSatFromSatoshis(1000)
+	htlcAmt, totalTimelock, hops := generateHops(amount,
+		testStartingHeight,
+		n.firstBobChannelLink, n.carolChannelLink)
+	firstHop := n.firstBobChannelLink.ShortChanID()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		_, err := makePayment(
+			n.aliceServer, n.carolServer, firstHop, hops, amount,
+			htlcAmt, totalTimelock,
+		).Wait(30 * time.Second)
+		if err != nil {
+			b.Fatalf("unable to send payment: %v", err)
+		}
+	}
+
+	b.StopTimer()
+	logPerfStats(b, "alice", n.aliceServer.htlcSwitch)
+	logPerfStats(b, "bob", n.bobServer.htlcSwitch)
+}
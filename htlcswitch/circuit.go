@@ -67,6 +67,12 @@ func (c *PaymentCircuit) HasKeystone() bool {
 	return c.Outgoing != nil
 }
 
+// IsLocal returns true if the circuit was created for a payment initiated by
+// us, rather than for an HTLC forwarded on behalf of an incoming channel.
+func (c *PaymentCircuit) IsLocal() bool {
+	return c.Incoming.ChanID == sourceHop
+}
+
 // newPaymentCircuit initializes a payment circuit on the heap using the payment
 // hash and an in-memory htlc packet.
 func newPaymentCircuit(hash *[32]byte, pkt *htlcPacket) *PaymentCircuit {
@@ -55,6 +55,12 @@ const (
 	// BogusSettle attempts to settle back any incoming HTLC for which we
 	// are the exit node with a bogus preimage.
 	BogusSettle
+
+	// Revoke drops our revocation of the prior commitment state after a
+	// new commitment signed by the remote peer has been accepted, but
+	// before it's persisted along with the revocation, and the revocation
+	// is sent to the peer.
+	Revoke
 )
 
 // String returns a human-readable identifier for a given Flag.
@@ -78,6 +84,8 @@ func (f Flag) String() string {
 		return "Commit"
 	case BogusSettle:
 		return "BogusSettle"
+	case Revoke:
+		return "Revoke"
 	default:
 		return "UnknownHodlFlag"
 	}
@@ -106,6 +114,8 @@ func (f Flag) Warning() string {
 		msg = "will not commit pending channel updates"
 	case BogusSettle:
 		msg = "will settle HTLC with bogus preimage"
+	case Revoke:
+		msg = "will not revoke prior commitment state"
 	default:
 		msg = "incorrect hodl flag usage"
 	}
@@ -69,6 +69,7 @@ var hodlMaskTests = []struct {
 			hodl.FailOutgoing,
 			hodl.Commit,
 			hodl.BogusSettle,
+			hodl.Revoke,
 		),
 		flags: map[hodl.Flag]struct{}{
 			hodl.ExitSettle:     {},
@@ -80,6 +81,7 @@ var hodlMaskTests = []struct {
 			hodl.FailOutgoing:   {},
 			hodl.Commit:         {},
 			hodl.BogusSettle:    {},
+			hodl.Revoke:         {},
 		},
 	},
 }
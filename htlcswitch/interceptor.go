@@ -0,0 +1,347 @@
+package htlcswitch
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrFwdResolved is returned when attempting to resolve an intercepted
+	// forward that has already been resolved.
+	ErrFwdResolved = errors.New("intercepted forward already resolved")
+
+	// ErrInterceptorActive is returned when attempting to set a forward
+	// interceptor while another one is already active.
+	ErrInterceptorActive = errors.New("forward interceptor already active")
+)
+
+// InterceptedPacket contains the information an interceptor needs to decide
+// on the fate of a forwarded HTLC.
+type InterceptedPacket struct {
+	// IncomingCircuit is the circuit key of the HTLC on the incoming
+	// channel.
+	IncomingCircuit CircuitKey
+
+	// OutgoingChanID is the channel the HTLC was requested to be forwarded
+	// over.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// Hash is the payment hash of the HTLC.
+	Hash lntypes.Hash
+
+	// IncomingAmount is the value of the incoming HTLC.
+	IncomingAmount lnwire.MilliSatoshi
+
+	// IncomingExpiry is the absolute expiry height of the incoming HTLC.
+	IncomingExpiry uint32
+
+	// OutgoingAmount is the value the HTLC was requested to be forwarded
+	// with.
+	OutgoingAmount lnwire.MilliSatoshi
+
+	// OutgoingExpiry is the absolute expiry height the HTLC was requested
+	// to be forwarded with.
+	OutgoingExpiry uint32
+
+	// OnionBlob is the onion packet to be passed on to the next hop.
+	OnionBlob [lnwire.OnionPacketSize]byte
+}
+
+// InterceptedForward is a forwarded HTLC held by the switch until its
+// interceptor decides whether to resume forwarding it, or to settle or fail
+// it back on the incoming channel.
+type InterceptedForward interface {
+	// Packet returns the intercepted HTLC.
+	Packet() InterceptedPacket
+
+	// Resume resumes forwarding the HTLC as if it was never intercepted.
+	Resume() error
+
+	// Settle settles the HTLC on the incoming channel with the given
+	// preimage.
+	Settle(preimage lntypes.Preimage) error
+
+	// Fail fails the HTLC on the incoming channel with the given failure
+	// code.
+	Fail(code lnwire.FailCode) error
+}
+
+// ForwardInterceptor is called by the switch for every HTLC forwarded through
+// it. If it returns true, the interceptor takes ownership of the forward, and
+// the HTLC is held until one of the methods of the InterceptedForward is
+// called. Otherwise, the HTLC is forwarded as usual. HTLCs still held close to
+// their incoming expiry are failed back by the switch, see
+// Config.HeldHtlcExpiryDelta.
+//
+// NOTE: The interceptor is called from the forwarding path of the switch, so
+// it should return promptly.
+type ForwardInterceptor func(InterceptedForward) bool
+
+// SetInterceptor sets the interceptor that'll be handed every HTLC forwarded
+// through the switch from now on. An error is returned if an interceptor is
+// already set.
+func (s *Switch) SetInterceptor(interceptor ForwardInterceptor) error {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	if s.interceptor != nil {
+		return ErrInterceptorActive
+	}
+
+	s.interceptor = interceptor
+
+	return nil
+}
+
+// ClearInterceptor removes the current interceptor, if any. Forwards it has
+// taken ownership of must still be resolved through their
+// InterceptedForward.
+func (s *Switch) ClearInterceptor() {
+	s.interceptorMtx.Lock()
+	s.interceptor = nil
+	s.interceptorMtx.Unlock()
+}
+
+// interceptForward hands the given add packet, whose circuit has already been
+// committed, to the interceptor if one is set. It returns true if the
+// interceptor took ownership of the packet, in which case it MUST NOT be
+// forwarded by the caller.
+func (s *Switch) interceptForward(packet *htlcPacket) bool {
+	s.interceptorMtx.RLock()
+	interceptor := s.interceptor
+	s.interceptorMtx.RUnlock()
+
+	if interceptor == nil {
+		return false
+	}
+
+	// We'll track the forward as held before handing it over, as the
+	// interceptor may resolve it before returning.
+	fwd := &interceptedForward{
+		htlcSwitch: s,
+		packet:     packet,
+	}
+	s.interceptorMtx.Lock()
+	s.heldForwards[packet.inKey()] = fwd
+	s.interceptorMtx.Unlock()
+
+	if interceptor(fwd) {
+		return true
+	}
+
+	s.releaseForward(packet.inKey())
+
+	return false
+}
+
+// releaseForward stops tracking the held forward with the given incoming
+// circuit key.
+func (s *Switch) releaseForward(key CircuitKey) {
+	s.interceptorMtx.Lock()
+	delete(s.heldForwards, key)
+	s.interceptorMtx.Unlock()
+}
+
+// failExpiringForwards fails back the forwards still held by the interceptor
+// whose incoming HTLC expires within HeldHtlcExpiryDelta blocks of the given
+// height. Otherwise, the remote party would eventually force close the
+// incoming channel to time out the HTLC on-chain.
+func (s *Switch) failExpiringForwards(height uint32) {
+	if s.cfg.HeldHtlcExpiryDelta == 0 {
+		return
+	}
+
+	cutoff := height + s.cfg.HeldHtlcExpiryDelta
+
+	var expiring []*interceptedForward
+	s.interceptorMtx.RLock()
+	for _, fwd := range s.heldForwards {
+		if fwd.packet.incomingTimeout > cutoff {
+			continue
+		}
+
+		expiring = append(expiring, fwd)
+	}
+	s.interceptorMtx.RUnlock()
+
+	for _, fwd := range expiring {
+		log.Warnf("Failing back held htlc %v, its expiry of %v is "+
+			"too close to the best height %v", fwd.packet.inKey(),
+			fwd.packet.incomingTimeout, height)
+
+		err := fwd.Fail(lnwire.CodeTemporaryChannelFailure)
+		if err != nil && err != ErrFwdResolved {
+			log.Errorf("Unable to fail held htlc %v: %v",
+				fwd.packet.inKey(), err)
+		}
+	}
+}
+
+// interceptedForward implements the InterceptedForward interface for an add
+// packet held by the switch.
+type interceptedForward struct {
+	htlcSwitch *Switch
+	packet     *htlcPacket
+
+	resolved bool
+	mtx      sync.Mutex
+}
+
+// A compile time check to ensure interceptedForward implements the
+// InterceptedForward interface.
+var _ InterceptedForward = (*interceptedForward)(nil)
+
+// Packet returns the intercepted HTLC.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Packet() InterceptedPacket {
+	htlc := f.packet.htlc.(*lnwire.UpdateAddHTLC)
+
+	return InterceptedPacket{
+		IncomingCircuit: f.packet.inKey(),
+		OutgoingChanID:  f.packet.outgoingChanID,
+		Hash:            htlc.PaymentHash,
+		IncomingAmount:  f.packet.incomingAmount,
+		IncomingExpiry:  f.packet.incomingTimeout,
+		OutgoingAmount:  f.packet.amount,
+		OutgoingExpiry:  f.packet.outgoingTimeout,
+		OnionBlob:       htlc.OnionBlob,
+	}
+}
+
+// Resume resumes forwarding the HTLC as if it was never intercepted.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Resume() error {
+	if err := f.markResolved(); err != nil {
+		return err
+	}
+
+	return f.htlcSwitch.route(f.packet)
+}
+
+// Settle settles the HTLC on the incoming channel with the given preimage.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Settle(preimage lntypes.Preimage) error {
+	htlc := f.packet.htlc.(*lnwire.UpdateAddHTLC)
+	if preimage.Hash() != htlc.PaymentHash {
+		return fmt.Errorf("preimage %v doesn't match payment hash %x",
+			preimage, htlc.PaymentHash[:])
+	}
+
+	// The preimage must be known to the witness beacon before the settle
+	// is sent to the incoming link, otherwise the HTLC couldn't be claimed
+	// on-chain if the incoming channel is closed before the settle is
+	// locked in.
+	err := f.htlcSwitch.cfg.PreimageCache.AddPreimages(preimage)
+	if err != nil {
+		return fmt.Errorf("unable to add preimage %v to cache: %v",
+			preimage, err)
+	}
+
+	if err := f.markResolved(); err != nil {
+		return err
+	}
+
+	settlePkt := &htlcPacket{
+		sourceRef:      f.packet.sourceRef,
+		incomingChanID: f.packet.incomingChanID,
+		incomingHTLCID: f.packet.incomingHTLCID,
+		circuit:        f.packet.circuit,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+		},
+	}
+
+	return f.htlcSwitch.mailOrchestrator.Deliver(
+		settlePkt.incomingChanID, settlePkt,
+	)
+}
+
+// Fail fails the HTLC on the incoming channel with the given failure code.
+//
+// NOTE: Part of the InterceptedForward interface.
+func (f *interceptedForward) Fail(code lnwire.FailCode) error {
+	failure, err := f.failureMessage(code)
+	if err != nil {
+		return err
+	}
+
+	if err := f.markResolved(); err != nil {
+		return err
+	}
+
+	failErr := fmt.Errorf("interceptor failed htlc %v with %v",
+		f.packet.inKey(), code)
+	err = f.htlcSwitch.failAddPacket(f.packet, failure, failErr)
+	if err != failErr {
+		return err
+	}
+
+	return nil
+}
+
+// failureMessage constructs the failure message for the given code. Only the
+// codes that don't require any details of the outgoing HTLC are supported.
+func (f *interceptedForward) failureMessage(
+	code lnwire.FailCode) (lnwire.FailureMessage, error) {
+
+	switch code {
+	case lnwire.CodeTemporaryChannelFailure:
+		update, err := f.htlcSwitch.cfg.FetchLastChannelUpdate(
+			f.packet.outgoingChanID,
+		)
+		if err != nil {
+			return &lnwire.FailTemporaryNodeFailure{}, nil
+		}
+
+		return lnwire.NewTemporaryChannelFailure(update), nil
+
+	case lnwire.CodeTemporaryNodeFailure:
+		return &lnwire.FailTemporaryNodeFailure{}, nil
+
+	case lnwire.CodePermanentNodeFailure:
+		return &lnwire.FailPermanentNodeFailure{}, nil
+
+	case lnwire.CodePermanentChannelFailure:
+		return &lnwire.FailPermanentChannelFailure{}, nil
+
+	case lnwire.CodeRequiredNodeFeatureMissing:
+		return &lnwire.FailRequiredNodeFeatureMissing{}, nil
+
+	case lnwire.CodeRequiredChannelFeatureMissing:
+		return &lnwire.FailRequiredChannelFeatureMissing{}, nil
+
+	case lnwire.CodeUnknownNextPeer:
+		return &lnwire.FailUnknownNextPeer{}, nil
+
+	case lnwire.CodeUnknownPaymentHash:
+		return lnwire.NewFailUnknownPaymentHash(
+			f.packet.incomingAmount,
+		), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported failure code: %v", code)
+	}
+}
+
+// markResolved marks the forward as resolved, returning ErrFwdResolved if it
+// was resolved before.
+func (f *interceptedForward) markResolved() error {
+	f.mtx.Lock()
+	defer f.mtx.Unlock()
+
+	if f.resolved {
+		return ErrFwdResolved
+	}
+	f.resolved = true
+
+	f.htlcSwitch.releaseForward(f.packet.inKey())
+
+	return nil
+}
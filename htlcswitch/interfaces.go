@@ -5,6 +5,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -18,8 +19,8 @@ type InvoiceDatabase interface {
 	LookupInvoice(lntypes.Hash) (channeldb.Invoice, uint32, error)
 
 	// SettleInvoice attempts to mark an invoice corresponding to the
-	// passed payment hash as fully settled.
-	SettleInvoice(payHash lntypes.Hash, paidAmount lnwire.MilliSatoshi) error
+	// passed payment hash as fully settled by the given HTLC.
+	SettleInvoice(payHash lntypes.Hash, htlc channeldb.InvoiceHTLC) error
 
 	// CancelInvoice attempts to cancel the invoice corresponding to the
 	// passed payment hash.
@@ -100,9 +101,25 @@ type ChannelLink interface {
 	// might pass through channel link. The value returned from this method
 	// represents the up to date available flow through the channel. This
 	// takes into account any forwarded but un-cleared HTLC's, and any
-	// HTLC's which have been set to the over flow queue.
+	// HTLC's which have been set to the over flow queue, as well as the
+	// channel reserve and the commitment fees we're required to pay.
 	Bandwidth() lnwire.MilliSatoshi
 
+	// DustSum returns the total value of the dust HTLCs on the local or
+	// remote commitment of the link's channel, depending on the passed
+	// boolean.
+	DustSum(remote bool) lnwire.MilliSatoshi
+
+	// IsDustHtlc returns true if an HTLC of the given amount and direction
+	// would be dust on the local or remote commitment of the link's
+	// channel, depending on the passed boolean.
+	IsDustHtlc(amt lnwire.MilliSatoshi, incoming, remote bool) bool
+
+	// HtlcStatuses returns the status of every HTLC in the update logs of
+	// the link's channel, including the ones that aren't fully locked in
+	// or removed yet.
+	HtlcStatuses() []lnwallet.HtlcStatus
+
 	// Stats return the statistics of channel link. Number of updates,
 	// total sent/received milli-satoshis.
 	Stats() (uint64, lnwire.MilliSatoshi, lnwire.MilliSatoshi)
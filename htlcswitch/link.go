@@ -14,11 +14,13 @@ import (
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/health"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/ticker"
 )
@@ -56,6 +58,22 @@ const (
 	// DefaultMaxLinkFeeUpdateTimeout represents the maximum interval in
 	// which a link should propose to update its commitment fee rate.
 	DefaultMaxLinkFeeUpdateTimeout = 60 * time.Minute
+
+	// DefaultTimeLockDeltaGracePeriod is the default number of blocks
+	// following an increase of a link's time-lock delta during which
+	// HTLCs that conform to the previous time-lock delta are still
+	// accepted.
+	DefaultTimeLockDeltaGracePeriod = 6
+
+	// DefaultBatchInterval is the default maximum duration a link waits
+	// for more updates to coalesce into a batch, before signing a new
+	// commitment covering the pending ones.
+	DefaultBatchInterval = 50 * time.Millisecond
+
+	// DefaultBatchSize is the default number of pending updates after
+	// which a link signs a new commitment, without waiting for the batch
+	// interval to elapse.
+	DefaultBatchSize = 10
 )
 
 // ForwardingPolicy describes the set of constraints that a given ChannelLink
@@ -195,7 +213,7 @@ type ChannelLinkConfig struct {
 	// FeeEstimator is an instance of a live fee estimator which will be
 	// used to dynamically regulate the current fee of the commitment
 	// transaction to ensure timely confirmation.
-	FeeEstimator lnwallet.FeeEstimator
+	FeeEstimator chainfee.Estimator
 
 	// DebugHTLC should be turned on if you want all HTLCs sent to a node
 	// with the debug htlc R-Hash are immediately settled in the next
@@ -242,6 +260,24 @@ type ChannelLinkConfig struct {
 	// fee rate. A random timeout will be selected between these values.
 	MinFeeUpdateTimeout time.Duration
 	MaxFeeUpdateTimeout time.Duration
+
+	// PerfCounters is an optional set of performance counters, shared
+	// with the switch, to which the link reports the commitment
+	// signatures it sends.
+	PerfCounters *PerfCounters
+
+	// TimeLockDeltaGracePeriod is the number of blocks following an
+	// increase of our time-lock delta during which incoming HTLCs that
+	// conform to the previous time-lock delta will still be forwarded. As
+	// senders may still be routing using our stale channel update, this
+	// avoids failing their payments right after a policy change. A value
+	// of zero disables the grace period.
+	TimeLockDeltaGracePeriod uint32
+
+	// Health is an optional registry through which the link recovers
+	// from panics, such that a panic within a single link doesn't bring
+	// down the entire daemon. If nil, panics aren't recovered from.
+	Health *health.Registry
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -297,6 +333,14 @@ type channelLink struct {
 	// which may affect behaviour of the service.
 	cfg ChannelLinkConfig
 
+	// prevTimeLockDelta is the lowest time-lock delta of our forwarding
+	// policy prior to its most recent increase, which is still accepted
+	// until the grace period expires at timeLockDeltaGraceHeight.
+	//
+	// NOTE: These fields MUST be accessed with the link's lock held.
+	prevTimeLockDelta        uint32
+	timeLockDeltaGraceHeight uint32
+
 	// overflowQueue is used to store the htlc add updates which haven't
 	// been processed because of the commitment transaction overflow.
 	overflowQueue *packetQueue
@@ -492,7 +536,7 @@ func (l *channelLink) EligibleToForward() bool {
 // chain in a timely manner. The returned value is expressed in fee-per-kw, as
 // this is the native rate used when computing the fee for commitment
 // transactions, and the second-level HTLC transactions.
-func (l *channelLink) sampleNetworkFee() (lnwallet.SatPerKWeight, error) {
+func (l *channelLink) sampleNetworkFee() (chainfee.SatPerKWeight, error) {
 	// We'll first query for the sat/kw recommended to be confirmed within 3
 	// blocks.
 	feePerKw, err := l.cfg.FeeEstimator.EstimateFeePerKW(3)
@@ -509,7 +553,7 @@ func (l *channelLink) sampleNetworkFee() (lnwallet.SatPerKWeight, error) {
 // shouldAdjustCommitFee returns true if we should update our commitment fee to
 // match that of the network fee. We'll only update our commitment fee if the
 // network fee is +/- 10% to our network fee.
-func shouldAdjustCommitFee(netFee, chanFee lnwallet.SatPerKWeight) bool {
+func shouldAdjustCommitFee(netFee, chanFee chainfee.SatPerKWeight) bool {
 	switch {
 	// If the network fee is greater than the commitment fee, then we'll
 	// switch to it if it's at least 10% greater than the commit fee.
@@ -800,6 +844,21 @@ func (l *channelLink) htlcManager() {
 		log.Infof("ChannelLink(%v) has exited", l)
 	}()
 
+	// If we panic, our in-memory channel state can no longer be trusted,
+	// so we'll fail the link. If allowed, it's restarted from its
+	// persisted state by reconnecting to the peer, which reloads all of
+	// its channels.
+	subsystem := fmt.Sprintf("link(%v)", l.ShortChanID())
+	defer l.cfg.Health.Recover(subsystem, func(restart bool) {
+		l.fail(
+			LinkFailureError{
+				code:      ErrInternalError,
+				Reconnect: restart,
+			},
+			"link panicked",
+		)
+	})
+
 	log.Infof("HTLC manager for ChannelPoint(%v) started, "+
 		"bandwidth=%v", l.channel.ChannelPoint(), l.Bandwidth())
 
@@ -935,6 +994,13 @@ out:
 				continue
 			}
 
+			// Channels using anchor outputs can have their
+			// commitment fee bumped at broadcast time, so we'll
+			// cap the fee rate we commit to.
+			feePerKw = lnwallet.CapCommitFeeRate(
+				l.channel.State().ChanType, feePerKw,
+			)
+
 			// We'll check to see if we should update the fee rate
 			// based on our current set fee rate.
 			commitFee := l.channel.CommitFeeRate()
@@ -1548,6 +1614,15 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			return
 		}
 
+		// If hodl.Revoke mode is active, we will refrain from revoking
+		// our prior state. The new state is only persisted once we
+		// revoke the prior one, so the remote peer will retransmit its
+		// signature for it once the channel is reestablished.
+		if l.cfg.DebugHTLC && l.cfg.HodlMask.Active(hodl.Revoke) {
+			l.warnf(hodl.Revoke.Warning())
+			return
+		}
+
 		// As we've just accepted a new state, we'll now
 		// immediately send the remote peer a revocation for our prior
 		// state.
@@ -1631,7 +1706,7 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 	case *lnwire.UpdateFee:
 		// We received fee update from peer. If we are the initiator we
 		// will fail the channel, if not we will apply the update.
-		fee := lnwallet.SatPerKWeight(msg.FeePerKw)
+		fee := chainfee.SatPerKWeight(msg.FeePerKw)
 		if err := l.channel.ReceiveUpdateFee(fee); err != nil {
 			l.fail(LinkFailureError{code: ErrInvalidUpdate},
 				"error receiving fee update: %v", err)
@@ -1762,6 +1837,10 @@ func (l *channelLink) updateCommitTx() error {
 	}
 	l.cfg.Peer.SendMessage(false, commitSig)
 
+	if l.cfg.PerfCounters != nil {
+		l.cfg.PerfCounters.AddCommitSig(l.ShortChanID())
+	}
+
 	// We've just initiated a state transition, attempt to stop the
 	// logCommitTimer. If the timer already ticked, then we'll consume the
 	// value, dropping
@@ -1867,6 +1946,33 @@ func (l *channelLink) ChanID() lnwire.ChannelID {
 //
 // NOTE: Part of the ChannelLink interface.
 func (l *channelLink) Bandwidth() lnwire.MilliSatoshi {
+	linkBandwidth := l.balanceBandwidth()
+	overflowBandwidth := l.overflowQueue.TotalHtlcAmount()
+
+	// The bandwidth is further bounded by the number and total value of
+	// the HTLCs the remote party allows us to have in flight. HTLCs
+	// exceeding these limits would be rejected by our channel state
+	// machine, so we'll report no bandwidth once they're reached to have
+	// the switch pick another link instead.
+	numAvailable, amtAvailable := l.channel.AvailableHtlcCapacity()
+	if int32(numAvailable) <= l.overflowQueue.Length() ||
+		amtAvailable <= overflowBandwidth {
+
+		return 0
+	}
+
+	amtAvailable -= overflowBandwidth
+	if amtAvailable < linkBandwidth {
+		return amtAvailable
+	}
+
+	return linkBandwidth
+}
+
+// balanceBandwidth returns the amount our balance in the channel allows to
+// flow through the link, without accounting for the limits the remote party
+// imposes on the HTLCs we have in flight.
+func (l *channelLink) balanceBandwidth() lnwire.MilliSatoshi {
 	channelBandwidth := l.channel.AvailableBalance()
 	overflowBandwidth := l.overflowQueue.TotalHtlcAmount()
 
@@ -1886,7 +1992,52 @@ func (l *channelLink) Bandwidth() lnwire.MilliSatoshi {
 	// Else the amount that is available to flow through the link at this
 	// point is the available balance minus the reserve amount we are
 	// required to keep as collateral.
-	return linkBandwidth - reserve
+	linkBandwidth -= reserve
+
+	// If we're the initiator, we also pay the fee for the additional HTLC
+	// output a new non-dust HTLC would add to the commitment transaction,
+	// so we'll keep a buffer for it to not report bandwidth that can't
+	// actually be used.
+	if l.channel.IsInitiator() {
+		feeBuffer := lnwire.NewMSatFromSatoshis(
+			l.channel.CommitFeeRate().FeeForWeight(input.HtlcWeight),
+		)
+		if linkBandwidth <= feeBuffer {
+			return 0
+		}
+
+		linkBandwidth -= feeBuffer
+	}
+
+	return linkBandwidth
+}
+
+// DustSum returns the total value of the dust HTLCs on the local or remote
+// commitment of the link's channel, depending on the passed boolean.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) DustSum(remote bool) lnwire.MilliSatoshi {
+	return l.channel.GetDustSum(remote)
+}
+
+// IsDustHtlc returns true if an HTLC of the given amount and direction would
+// be dust on the local or remote commitment of the link's channel, depending
+// on the passed boolean.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) IsDustHtlc(amt lnwire.MilliSatoshi, incoming,
+	remote bool) bool {
+
+	return l.channel.IsDustHtlc(amt, incoming, remote)
+}
+
+// HtlcStatuses returns the status of every HTLC in the update logs of the
+// link's channel, including the ones that aren't fully locked in or removed
+// yet.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) HtlcStatuses() []lnwallet.HtlcStatus {
+	return l.channel.HtlcStatuses()
 }
 
 // AttachMailBox updates the current mailbox used by this link, and hooks up
@@ -1922,11 +2073,60 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 		l.cfg.FwrdingPolicy.FeeRate = newPolicy.FeeRate
 	}
 	if newPolicy.TimeLockDelta != 0 {
-		l.cfg.FwrdingPolicy.TimeLockDelta = newPolicy.TimeLockDelta
+		l.updateTimeLockDelta(newPolicy.TimeLockDelta)
 	}
 	if newPolicy.MinHTLC != 0 {
 		l.cfg.FwrdingPolicy.MinHTLC = newPolicy.MinHTLC
 	}
+	if newPolicy.MaxHTLC != 0 {
+		l.cfg.FwrdingPolicy.MaxHTLC = newPolicy.MaxHTLC
+	}
+}
+
+// updateTimeLockDelta sets the time-lock delta of our forwarding policy. If
+// the delta is raised, then the previous delta will still be accepted for the
+// configured grace period.
+//
+// NOTE: The link's lock MUST be held when calling this method.
+func (l *channelLink) updateTimeLockDelta(newDelta uint32) {
+	oldDelta := l.cfg.FwrdingPolicy.TimeLockDelta
+	l.cfg.FwrdingPolicy.TimeLockDelta = newDelta
+
+	if newDelta <= oldDelta || l.cfg.TimeLockDeltaGracePeriod == 0 {
+		return
+	}
+
+	// If we're still within the grace period of a prior increase, then
+	// senders may be using either of the previous policies, so we'll
+	// keep accepting the lowest delta.
+	heightNow := l.cfg.Switch.BestHeight()
+	if heightNow >= l.timeLockDeltaGraceHeight ||
+		oldDelta < l.prevTimeLockDelta {
+
+		l.prevTimeLockDelta = oldDelta
+	}
+	l.timeLockDeltaGraceHeight = heightNow + l.cfg.TimeLockDeltaGracePeriod
+
+	log.Infof("ChannelLink(%s) raised time-lock delta from %v to %v, "+
+		"accepting the previous delta until height %v", l.shortChanID,
+		oldDelta, newDelta, l.timeLockDeltaGraceHeight)
+}
+
+// minTimeLockDelta returns the lowest time-lock delta that incoming HTLCs
+// must adhere to at the given height: the delta of our current forwarding
+// policy, or the previous one if we're within the grace period of an
+// increase.
+//
+// NOTE: The link's lock MUST be held when calling this method.
+func (l *channelLink) minTimeLockDelta(heightNow uint32) uint32 {
+	timeDelta := l.cfg.FwrdingPolicy.TimeLockDelta
+	if heightNow < l.timeLockDeltaGraceHeight &&
+		l.prevTimeLockDelta < timeDelta {
+
+		return l.prevTimeLockDelta
+	}
+
+	return timeDelta
 }
 
 // HtlcSatifiesPolicy should return a nil error if the passed HTLC details
@@ -1942,6 +2142,7 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 
 	l.RLock()
 	policy := l.cfg.FwrdingPolicy
+	timeDelta := l.minTimeLockDelta(heightNow)
 	l.RUnlock()
 
 	// As our first sanity check, we'll ensure that the passed HTLC isn't
@@ -2018,8 +2219,8 @@ func (l *channelLink) HtlcSatifiesPolicy(payHash [32]byte,
 
 	// We want to avoid accepting an HTLC which will expire in the near
 	// future, so we'll reject an HTLC if its expiration time is too close
-	// to the current height.
-	timeDelta := policy.TimeLockDelta
+	// to the current height. If we've recently raised our time-lock
+	// delta, then the previous delta is still accepted.
 	if incomingTimeout-timeDelta <= heightNow {
 		l.errorf("htlc(%x) has an expiry that's too soon: "+
 			"outgoing_expiry=%v, best_height=%v", payHash[:],
@@ -2116,7 +2317,7 @@ func (l *channelLink) HandleChannelUpdate(message lnwire.Message) {
 
 // updateChannelFee updates the commitment fee-per-kw on this channel by
 // committing to an update_fee message.
-func (l *channelLink) updateChannelFee(feePerKw lnwallet.SatPerKWeight) error {
+func (l *channelLink) updateChannelFee(feePerKw chainfee.SatPerKWeight) error {
 
 	log.Infof("ChannelPoint(%v): updating commit fee to %v sat/kw", l,
 		feePerKw)
@@ -2544,10 +2745,15 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			}
 
 			// Notify the invoiceRegistry of the invoices we just
-			// settled (with the amount accepted at settle time)
+			// settled (with the HTLC accepted at settle time)
 			// with this latest commitment update.
 			err = l.cfg.Registry.SettleInvoice(
-				invoiceHash, pd.Amount,
+				invoiceHash, channeldb.InvoiceHTLC{
+					ChanID:       l.ShortChanID(),
+					HtlcID:       pd.HtlcIndex,
+					Amt:          pd.Amount,
+					AcceptHeight: heightNow,
+				},
 			)
 			if err != nil {
 				l.fail(LinkFailureError{code: ErrInternalError},
@@ -2606,6 +2812,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					Expiry:      fwdInfo.OutgoingCTLV,
 					Amount:      fwdInfo.AmountToForward,
 					PaymentHash: pd.RHash,
+					Endorsed:    pd.Endorsed,
 				}
 
 				// Finally, we'll encode the onion packet for
@@ -2643,10 +2850,15 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 			// With all our forwarding constraints met, we'll
 			// create the outgoing HTLC using the parameters as
 			// specified in the forwarding info.
+			// The endorsement signal of the incoming HTLC is
+			// carried over, the switch will decide whether the
+			// outgoing HTLC is endorsed based on the reputation of
+			// the incoming channel.
 			addMsg := &lnwire.UpdateAddHTLC{
 				Expiry:      fwdInfo.OutgoingCTLV,
 				Amount:      fwdInfo.AmountToForward,
 				PaymentHash: pd.RHash,
+				Endorsed:    pd.Endorsed,
 			}
 
 			// Finally, we'll encode the onion packet for the
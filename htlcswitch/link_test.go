@@ -31,6 +31,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/ticker"
 )
@@ -1535,6 +1536,14 @@ func (m *mockPeer) QuitSignal() <-chan struct{} {
 	return m.quit
 }
 
+func (m *mockPeer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
+func (m *mockPeer) LocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
 var _ lnpeer.Peer = (*mockPeer)(nil)
 
 func (m *mockPeer) SendMessage(sync bool, msgs ...lnwire.Message) error {
@@ -1549,6 +1558,9 @@ func (m *mockPeer) SendMessage(sync bool, msgs ...lnwire.Message) error {
 	}
 	return nil
 }
+func (m *mockPeer) SendMessageLazy(sync bool, msgs ...lnwire.Message) error {
+	return m.SendMessage(sync, msgs...)
+}
 func (m *mockPeer) AddNewChannel(_ *channeldb.OpenChannel,
 	_ <-chan struct{}) error {
 	return nil
@@ -1677,6 +1689,19 @@ func assertLinkBandwidth(t *testing.T, link ChannelLink,
 	}
 }
 
+// assertBalanceBandwidth checks that the bandwidth the balance of the link
+// allows for, regardless of the remote party's HTLC limits, is as expected.
+func assertBalanceBandwidth(t *testing.T, link *channelLink,
+	expected lnwire.MilliSatoshi) {
+
+	currentBandwidth := link.balanceBandwidth()
+	_, _, line, _ := runtime.Caller(1)
+	if currentBandwidth != expected {
+		t.Fatalf("line %v: alice's balance bandwidth is incorrect: "+
+			"expected %v, got %v", line, expected, currentBandwidth)
+	}
+}
+
 // handleStateUpdate handles the messages sent from the link after
 // the batch ticker has triggered a state update.
 func handleStateUpdate(link *channelLink,
@@ -1864,7 +1889,7 @@ func TestChannelLinkBandwidthConsistency(t *testing.T) {
 	coreLink.cfg.HodlMask = hodl.MaskFromFlags(hodl.ExitSettle)
 	coreLink.cfg.DebugHTLC = true
 
-	estimator := lnwallet.NewStaticFeeEstimator(6000, 0)
+	estimator := chainfee.NewStaticEstimator(6000, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -1874,8 +1899,11 @@ func TestChannelLinkBandwidthConsistency(t *testing.T) {
 	)
 
 	// The starting bandwidth of the channel should be exactly the amount
-	// that we created the channel between her and Bob.
-	expectedBandwidth := lnwire.NewMSatFromSatoshis(chanAmt - defaultCommitFee)
+	// that we created the channel between her and Bob, minus the fee
+	// buffer for an additional HTLC.
+	expectedBandwidth := lnwire.NewMSatFromSatoshis(
+		chanAmt-defaultCommitFee,
+	) - htlcFee
 	assertLinkBandwidth(t, aliceLink, expectedBandwidth)
 
 	// Next, we'll create an HTLC worth 1 BTC, and send it into the link as
@@ -2250,7 +2278,9 @@ func TestChannelLinkBandwidthConsistency(t *testing.T) {
 
 // TestChannelLinkBandwidthConsistencyOverflow tests that in the case of a
 // commitment overflow (no more space for new HTLC's), the bandwidth is updated
-// properly as items are being added and removed from the overflow queue.
+// properly as items are being added and removed from the overflow queue. As
+// the remote party won't accept any more HTLCs, the link itself reports no
+// bandwidth meanwhile.
 func TestChannelLinkBandwidthConsistencyOverflow(t *testing.T) {
 	t.Parallel()
 
@@ -2275,7 +2305,7 @@ func TestChannelLinkBandwidthConsistencyOverflow(t *testing.T) {
 		aliceMsgs              = coreLink.cfg.Peer.(*mockPeer).sentMsgs
 	)
 
-	estimator := lnwallet.NewStaticFeeEstimator(6000, 0)
+	estimator := chainfee.NewStaticEstimator(6000, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -2354,7 +2384,11 @@ func TestChannelLinkBandwidthConsistencyOverflow(t *testing.T) {
 	)
 	expectedBandwidth := aliceStartingBandwidth - totalHtlcAmt - htlcFee
 	expectedBandwidth += lnwire.NewMSatFromSatoshis(defaultCommitFee)
-	assertLinkBandwidth(t, aliceLink, expectedBandwidth)
+	assertBalanceBandwidth(t, coreLink, expectedBandwidth)
+
+	// As the remote party doesn't accept any more HTLCs, the link itself
+	// shouldn't report any bandwidth anymore.
+	assertLinkBandwidth(t, aliceLink, 0)
 
 	// The overflow queue should be empty at this point, as the commitment
 	// transaction should be full, but not yet overflown.
@@ -2384,7 +2418,8 @@ func TestChannelLinkBandwidthConsistencyOverflow(t *testing.T) {
 
 	time.Sleep(time.Second * 2)
 	expectedBandwidth -= (numOverFlowHTLCs * htlcAmt)
-	assertLinkBandwidth(t, aliceLink, expectedBandwidth)
+	assertBalanceBandwidth(t, coreLink, expectedBandwidth)
+	assertLinkBandwidth(t, aliceLink, 0)
 
 	// With the extra HTLC's added, the overflow queue should now be
 	// populated with our 20 additional HTLC's.
@@ -2400,7 +2435,8 @@ func TestChannelLinkBandwidthConsistencyOverflow(t *testing.T) {
 		t.Fatalf("unable to update state: %v", err)
 	}
 	time.Sleep(time.Millisecond * 500)
-	assertLinkBandwidth(t, aliceLink, expectedBandwidth)
+	assertBalanceBandwidth(t, coreLink, expectedBandwidth)
+	assertLinkBandwidth(t, aliceLink, 0)
 
 	// At this point, we'll now settle enough HTLCs to empty the overflow
 	// queue. The resulting bandwidth change should be non-existent as this
@@ -2421,7 +2457,8 @@ func TestChannelLinkBandwidthConsistencyOverflow(t *testing.T) {
 		time.Sleep(time.Millisecond * 50)
 	}
 	time.Sleep(time.Millisecond * 500)
-	assertLinkBandwidth(t, aliceLink, expectedBandwidth)
+	assertBalanceBandwidth(t, coreLink, expectedBandwidth)
+	assertLinkBandwidth(t, aliceLink, 0)
 
 	// We trigger a state update to lock in the Settles.
 	if err := updateState(batchTick, coreLink, bobChannel, false); err != nil {
@@ -2455,7 +2492,8 @@ func TestChannelLinkBandwidthConsistencyOverflow(t *testing.T) {
 	case <-time.After(20 * time.Millisecond):
 	}
 
-	assertLinkBandwidth(t, aliceLink, expectedBandwidth)
+	assertBalanceBandwidth(t, coreLink, expectedBandwidth)
+	assertLinkBandwidth(t, aliceLink, 0)
 
 	// Finally, at this point, the queue itself should be fully empty. As
 	// enough slots have been drained from the commitment transaction to
@@ -2522,7 +2560,7 @@ func TestChannelLinkTrimCircuitsPending(t *testing.T) {
 
 	// Compute the static fees that will be used to determine the
 	// correctness of Alice's bandwidth when forwarding HTLCs.
-	estimator := lnwallet.NewStaticFeeEstimator(6000, 0)
+	estimator := chainfee.NewStaticEstimator(6000, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -2535,8 +2573,10 @@ func TestChannelLinkTrimCircuitsPending(t *testing.T) {
 
 	// The starting bandwidth of the channel should be exactly the amount
 	// that we created the channel between her and Bob, minus the commitment
-	// fee.
-	expectedBandwidth := lnwire.NewMSatFromSatoshis(chanAmt - defaultCommitFee)
+	// fee and the fee buffer for an additional HTLC.
+	expectedBandwidth := lnwire.NewMSatFromSatoshis(
+		chanAmt-defaultCommitFee,
+	) - htlcFee
 	assertLinkBandwidth(t, alice.link, expectedBandwidth)
 
 	// Capture Alice's starting bandwidth to perform later, relative
@@ -2800,7 +2840,7 @@ func TestChannelLinkTrimCircuitsNoCommit(t *testing.T) {
 
 	// Compute the static fees that will be used to determine the
 	// correctness of Alice's bandwidth when forwarding HTLCs.
-	estimator := lnwallet.NewStaticFeeEstimator(6000, 0)
+	estimator := chainfee.NewStaticEstimator(6000, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -2813,8 +2853,10 @@ func TestChannelLinkTrimCircuitsNoCommit(t *testing.T) {
 
 	// The starting bandwidth of the channel should be exactly the amount
 	// that we created the channel between her and Bob, minus the commitment
-	// fee.
-	expectedBandwidth := lnwire.NewMSatFromSatoshis(chanAmt - defaultCommitFee)
+	// fee and the fee buffer for an additional HTLC.
+	expectedBandwidth := lnwire.NewMSatFromSatoshis(
+		chanAmt-defaultCommitFee,
+	) - htlcFee
 	assertLinkBandwidth(t, alice.link, expectedBandwidth)
 
 	// Capture Alice's starting bandwidth to perform later, relative
@@ -3058,7 +3100,7 @@ func TestChannelLinkBandwidthChanReserve(t *testing.T) {
 		aliceMsgs              = coreLink.cfg.Peer.(*mockPeer).sentMsgs
 	)
 
-	estimator := lnwallet.NewStaticFeeEstimator(6000, 0)
+	estimator := chainfee.NewStaticEstimator(6000, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -3069,9 +3111,10 @@ func TestChannelLinkBandwidthChanReserve(t *testing.T) {
 
 	// The starting bandwidth of the channel should be exactly the amount
 	// that we created the channel between her and Bob, minus the channel
-	// reserve.
+	// reserve and the fee buffer for an additional HTLC.
 	expectedBandwidth := lnwire.NewMSatFromSatoshis(
-		chanAmt - defaultCommitFee - chanReserve)
+		chanAmt-defaultCommitFee-chanReserve,
+	) - htlcFee
 	assertLinkBandwidth(t, aliceLink, expectedBandwidth)
 
 	// Next, we'll create an HTLC worth 3 BTC, and send it into the link as
@@ -3445,8 +3488,8 @@ func TestChannelRetransmission(t *testing.T) {
 // deviates from our current fee by more 10% or more.
 func TestShouldAdjustCommitFee(t *testing.T) {
 	tests := []struct {
-		netFee       lnwallet.SatPerKWeight
-		chanFee      lnwallet.SatPerKWeight
+		netFee       chainfee.SatPerKWeight
+		chanFee      chainfee.SatPerKWeight
 		shouldAdjust bool
 	}{
 
@@ -4696,6 +4739,70 @@ func TestChannelLinkWaitForRevocation(t *testing.T) {
 	}
 }
 
+// TestChannelLinkHodlRevoke asserts that a link in hodl.Revoke mode accepts
+// a new commitment from the remote peer, but neither persists it nor revokes
+// its prior commitment.
+func TestChannelLinkHodlRevoke(t *testing.T) {
+	t.Parallel()
+
+	const chanAmt = btcutil.SatoshiPerBitcoin * 5
+	const chanReserve = btcutil.SatoshiPerBitcoin * 1
+	aliceLink, bobChannel, _, start, cleanUp, _, err :=
+		newSingleLinkTestHarness(chanAmt, chanReserve)
+	if err != nil {
+		t.Fatalf("unable to create link: %v", err)
+	}
+	defer cleanUp()
+
+	if err := start(); err != nil {
+		t.Fatalf("unable to start test harness: %v", err)
+	}
+
+	var (
+		coreLink  = aliceLink.(*channelLink)
+		aliceMsgs = coreLink.cfg.Peer.(*mockPeer).sentMsgs
+	)
+
+	coreLink.cfg.DebugHTLC = true
+	coreLink.cfg.HodlMask = hodl.Revoke.Mask()
+
+	fetchCommitHeight := func() uint64 {
+		t.Helper()
+
+		state := coreLink.channel.State()
+		channels, err := state.Db.FetchOpenChannels(state.IdentityPub)
+		if err != nil {
+			t.Fatalf("unable to fetch channels: %v", err)
+		}
+		if len(channels) != 1 {
+			t.Fatalf("expected 1 channel, got %d", len(channels))
+		}
+
+		return channels[0].LocalCommitment.CommitHeight
+	}
+	commitHeight := fetchCommitHeight()
+
+	//  Bob               Alice
+	//   |------ add-1 ----->|
+	//   |------  sig  ----->| accepts add-1, but doesn't revoke
+	htlc := generateHtlc(t, coreLink, bobChannel, 0)
+	sendHtlcBobToAlice(t, aliceLink, bobChannel, htlc)
+	sendCommitSigBobToAlice(t, aliceLink, bobChannel, 1)
+
+	select {
+	case msg := <-aliceMsgs:
+		t.Fatalf("did not expect message from Alice: %T", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// As Alice didn't revoke her prior commitment, the new one shouldn't
+	// have been persisted either.
+	if height := fetchCommitHeight(); height != commitHeight {
+		t.Fatalf("expected persisted commit height %d, got %d",
+			commitHeight, height)
+	}
+}
+
 // TestChannelLinkBatchPreimageWrite asserts that a link will batch preimage
 // writes when just as it receives a CommitSig to lock in any Settles, and also
 // if the link is aware of any uncommitted preimages if the link is stopped,
@@ -5536,6 +5643,93 @@ func TestHtlcSatisfyPolicy(t *testing.T) {
 	})
 }
 
+// TestHtlcSatisfyPolicyTimeLockDeltaGrace tests that HTLCs conforming to the
+// previous time-lock delta of a link are still accepted during the grace
+// period that follows an increase of the delta.
+func TestHtlcSatisfyPolicyTimeLockDeltaGrace(t *testing.T) {
+	t.Parallel()
+
+	fetchLastChannelUpdate := func(lnwire.ShortChannelID) (
+		*lnwire.ChannelUpdate, error) {
+
+		return &lnwire.ChannelUpdate{}, nil
+	}
+
+	s := &Switch{bestHeight: 100}
+	link := channelLink{
+		cfg: ChannelLinkConfig{
+			FwrdingPolicy: ForwardingPolicy{
+				TimeLockDelta: 20,
+				MinHTLC:       500,
+			},
+			FetchLastChannelUpdate:   fetchLastChannelUpdate,
+			Switch:                   s,
+			TimeLockDeltaGracePeriod: 6,
+		},
+	}
+
+	var hash [32]byte
+
+	// assertDelta asserts whether an HTLC offering the given delta is
+	// accepted by the link at the given height.
+	assertDelta := func(delta, height uint32, accepted bool) {
+		t.Helper()
+
+		result := link.HtlcSatifiesPolicy(
+			hash, 1500, 1000, 400, 400-delta, height,
+		)
+		switch {
+		case accepted && result != nil:
+			t.Fatalf("expected delta %v to be accepted at height "+
+				"%v, got %v", delta, height, result)
+
+		case !accepted && result == nil:
+			t.Fatalf("expected delta %v to be rejected at height "+
+				"%v", delta, height)
+
+		case !accepted:
+			_, ok := result.(*lnwire.FailIncorrectCltvExpiry)
+			if !ok {
+				t.Fatalf("expected FailIncorrectCltvExpiry "+
+					"failure code, got %T", result)
+			}
+		}
+	}
+
+	// We'll raise our delta to 30 at height 100. The previous delta of 20
+	// should be accepted until height 106, but nothing below.
+	link.UpdateForwardingPolicy(ForwardingPolicy{TimeLockDelta: 30})
+	assertDelta(30, 100, true)
+	assertDelta(20, 100, true)
+	assertDelta(19, 100, false)
+	assertDelta(20, 105, true)
+	assertDelta(20, 106, false)
+	assertDelta(30, 106, true)
+
+	// Raising the delta again within the grace period of the previous
+	// increase should keep accepting the lowest delta.
+	s.bestHeight = 110
+	link.UpdateForwardingPolicy(ForwardingPolicy{TimeLockDelta: 40})
+	s.bestHeight = 112
+	link.UpdateForwardingPolicy(ForwardingPolicy{TimeLockDelta: 50})
+	assertDelta(30, 117, true)
+	assertDelta(29, 117, false)
+	assertDelta(30, 118, false)
+
+	// Lowering the delta shouldn't start a grace period.
+	s.bestHeight = 120
+	link.UpdateForwardingPolicy(ForwardingPolicy{TimeLockDelta: 35})
+	assertDelta(35, 120, true)
+	assertDelta(34, 120, false)
+
+	// Finally, with the grace period disabled, the new delta should be
+	// enforced immediately.
+	link.cfg.TimeLockDeltaGracePeriod = 0
+	link.UpdateForwardingPolicy(ForwardingPolicy{TimeLockDelta: 45})
+	assertDelta(35, 120, false)
+	assertDelta(45, 120, true)
+}
+
 // TestChannelLinkCanceledInvoice in this test checks the interaction
 // between Alice and Bob for a canceled invoice.
 func TestChannelLinkCanceledInvoice(t *testing.T) {
@@ -51,6 +51,11 @@ type LinkFailureError struct {
 	// SendData is a byte slice that will be sent to the peer. If nil a
 	// generic error will be sent.
 	SendData []byte
+
+	// Reconnect indicates whether we should reconnect to the peer in order
+	// to restart the link from its persisted state, as its in-memory state
+	// can no longer be trusted.
+	Reconnect bool
 }
 
 // A compile time check to ensure LinkFailureError implements the error
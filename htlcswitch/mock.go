@@ -26,6 +26,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/ticker"
 )
@@ -67,13 +68,13 @@ func (m *mockPreimageCache) SubscribeUpdates() *contractcourt.WitnessSubscriptio
 }
 
 type mockFeeEstimator struct {
-	byteFeeIn chan lnwallet.SatPerKWeight
+	byteFeeIn chan chainfee.SatPerKWeight
 
 	quit chan struct{}
 }
 
 func (m *mockFeeEstimator) EstimateFeePerKW(
-	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+	numBlocks uint32) (chainfee.SatPerKWeight, error) {
 
 	select {
 	case feeRate := <-m.byteFeeIn:
@@ -83,7 +84,7 @@ func (m *mockFeeEstimator) EstimateFeePerKW(
 	}
 }
 
-func (m *mockFeeEstimator) RelayFeePerKW() lnwallet.SatPerKWeight {
+func (m *mockFeeEstimator) RelayFeePerKW() chainfee.SatPerKWeight {
 	return 1e3
 }
 
@@ -95,7 +96,7 @@ func (m *mockFeeEstimator) Stop() error {
 	return nil
 }
 
-var _ lnwallet.FeeEstimator = (*mockFeeEstimator)(nil)
+var _ chainfee.Estimator = (*mockFeeEstimator)(nil)
 
 type mockForwardingLog struct {
 	sync.Mutex
@@ -174,6 +175,7 @@ func initSwitchWithDB(startingHeight uint32, db *channeldb.DB) (*Switch, error)
 		LogEventTicker:        ticker.NewForce(DefaultLogInterval),
 		NotifyActiveChannel:   func(wire.OutPoint) {},
 		NotifyInactiveChannel: func(wire.OutPoint) {},
+		PreimageCache:         newMockPreimageCache(),
 	}
 
 	return New(cfg, startingHeight)
@@ -256,6 +258,14 @@ func (s *mockServer) QuitSignal() <-chan struct{} {
 	return s.quit
 }
 
+func (s *mockServer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
+func (s *mockServer) LocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(nil, lnwire.LocalFeatures)
+}
+
 // mockHopIterator represents the test version of hop iterator which instead
 // of encrypting the path in onion blob just stores the path as a list of hops.
 type mockHopIterator struct {
@@ -502,6 +512,10 @@ func (s *mockServer) SendMessage(sync bool, msgs ...lnwire.Message) error {
 	return nil
 }
 
+func (s *mockServer) SendMessageLazy(sync bool, msgs ...lnwire.Message) error {
+	return s.SendMessage(sync, msgs...)
+}
+
 func (s *mockServer) readHandler(message lnwire.Message) error {
 	var targetChan lnwire.ChannelID
 
@@ -587,6 +601,10 @@ type mockChannelLink struct {
 
 	shortChanID lnwire.ShortChannelID
 
+	// liveShortChanID is the short channel ID the link switches to once
+	// UpdateShortChanID is called, mimicking it being loaded from disk.
+	liveShortChanID lnwire.ShortChannelID
+
 	chanID lnwire.ChannelID
 
 	peer lnpeer.Peer
@@ -640,11 +658,12 @@ func newMockChannelLink(htlcSwitch *Switch, chanID lnwire.ChannelID,
 ) *mockChannelLink {
 
 	return &mockChannelLink{
-		htlcSwitch:  htlcSwitch,
-		chanID:      chanID,
-		shortChanID: shortChanID,
-		peer:        peer,
-		eligible:    eligible,
+		htlcSwitch:      htlcSwitch,
+		chanID:          chanID,
+		shortChanID:     shortChanID,
+		liveShortChanID: shortChanID,
+		peer:            peer,
+		eligible:        eligible,
 	}
 }
 
@@ -681,16 +700,26 @@ func (f *mockChannelLink) Start() error {
 func (f *mockChannelLink) ChanID() lnwire.ChannelID                     { return f.chanID }
 func (f *mockChannelLink) ShortChanID() lnwire.ShortChannelID           { return f.shortChanID }
 func (f *mockChannelLink) Bandwidth() lnwire.MilliSatoshi               { return 99999999 }
+func (f *mockChannelLink) DustSum(bool) lnwire.MilliSatoshi             { return 0 }
 func (f *mockChannelLink) Peer() lnpeer.Peer                            { return f.peer }
 func (f *mockChannelLink) ChannelPoint() *wire.OutPoint                 { return &wire.OutPoint{} }
 func (f *mockChannelLink) Stop()                                        {}
 func (f *mockChannelLink) EligibleToForward() bool                      { return f.eligible }
-func (f *mockChannelLink) setLiveShortChanID(sid lnwire.ShortChannelID) { f.shortChanID = sid }
+func (f *mockChannelLink) setLiveShortChanID(sid lnwire.ShortChannelID) { f.liveShortChanID = sid }
 func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	f.eligible = true
+	f.shortChanID = f.liveShortChanID
 	return f.shortChanID, nil
 }
 
+func (f *mockChannelLink) IsDustHtlc(lnwire.MilliSatoshi, bool, bool) bool {
+	return false
+}
+
+func (f *mockChannelLink) HtlcStatuses() []lnwallet.HtlcStatus {
+	return nil
+}
+
 var _ ChannelLink = (*mockChannelLink)(nil)
 
 type mockInvoiceRegistry struct {
@@ -721,7 +750,7 @@ func (i *mockInvoiceRegistry) LookupInvoice(rHash lntypes.Hash) (channeldb.Invoi
 }
 
 func (i *mockInvoiceRegistry) SettleInvoice(rhash lntypes.Hash,
-	amt lnwire.MilliSatoshi) error {
+	htlc channeldb.InvoiceHTLC) error {
 
 	i.Lock()
 	defer i.Unlock()
@@ -736,7 +765,8 @@ func (i *mockInvoiceRegistry) SettleInvoice(rhash lntypes.Hash,
 	}
 
 	invoice.Terms.State = channeldb.ContractSettled
-	invoice.AmtPaid = amt
+	invoice.AmtPaid = htlc.Amt
+	invoice.Htlcs = append(invoice.Htlcs, htlc)
 	i.invoices[rhash] = invoice
 
 	return nil
@@ -0,0 +1,250 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// networkResultStoreBucketKey is used for the root level bucket that
+	// stores the network result of the latest HTLC dispatched for each
+	// payment hash.
+	//
+	// maps:
+	//   paymentHash (32 bytes) -> networkResult
+	networkResultStoreBucketKey = []byte("network-result-store-bucket")
+
+	// ErrPaymentResultNotFound is returned when the result of an HTLC is
+	// requested, which neither is in flight, nor has a known result.
+	ErrPaymentResultNotFound = errors.New("payment result not found")
+)
+
+// PaymentResult wraps a result received from the network after a payment
+// attempt was made.
+type PaymentResult struct {
+	// Preimage is set by the switch in case a sent HTLC was settled.
+	Preimage [32]byte
+
+	// Error is non-nil in case a HTLC send failed, and the HTLC is now
+	// irrevocably canceled. If the payment failed during forwarding, this
+	// error will be a *ForwardingError.
+	Error error
+}
+
+// networkResult is the raw result received from the network after a payment
+// attempt has been made. Since the switch doesn't always have the necessary
+// data to decode the raw message, we store it together with some meta data,
+// and decode it when the router query for the final result.
+type networkResult struct {
+	// msg is the received result. This should be of type UpdateFulfillHTLC
+	// or UpdateFailHTLC.
+	msg lnwire.Message
+
+	// unencrypted indicates whether the failure encoded in the message is
+	// unencrypted, and hence doesn't need to be decrypted.
+	unencrypted bool
+
+	// isResolution indicates whether this is a resolution message, in
+	// which the failure reason might not be included.
+	isResolution bool
+}
+
+// serializeNetworkResult serializes the networkResult.
+func serializeNetworkResult(w io.Writer, n *networkResult) error {
+	return channeldb.WriteElements(w, n.msg, n.unencrypted, n.isResolution)
+}
+
+// deserializeNetworkResult deserializes the networkResult.
+func deserializeNetworkResult(r io.Reader) (*networkResult, error) {
+	n := &networkResult{}
+	err := channeldb.ReadElements(r, &n.msg, &n.unencrypted,
+		&n.isResolution)
+	if err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
+
+// networkResultStore is a persistent store that stores the result of the
+// latest HTLC dispatched for each payment hash, such that the result can be
+// retrieved after a restart, and subscribers can be notified once it is
+// available.
+type networkResultStore struct {
+	db *channeldb.DB
+
+	// subscribers is a map from payment hashes to the channels of the
+	// subscribers waiting for the result of the payment's HTLC.
+	subscribers map[[32]byte][]chan *networkResult
+
+	// mtx ensures that results aren't stored while subscribers are added,
+	// such that no subscriber misses a result.
+	mtx sync.Mutex
+}
+
+// newNetworkResultStore creates a new networkResultStore backed by the passed
+// channel database.
+func newNetworkResultStore(db *channeldb.DB) *networkResultStore {
+	return &networkResultStore{
+		db:          db,
+		subscribers: make(map[[32]byte][]chan *networkResult),
+	}
+}
+
+// storeResult stores the networkResult for the given payment hash, and
+// notifies any subscribers.
+func (store *networkResultStore) storeResult(paymentHash [32]byte,
+	result *networkResult) error {
+
+	var b bytes.Buffer
+	if err := serializeNetworkResult(&b, result); err != nil {
+		return err
+	}
+
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	err := store.db.Batch(func(tx *bbolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(
+			networkResultStoreBucketKey,
+		)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(paymentHash[:], b.Bytes())
+	})
+	if err != nil {
+		return err
+	}
+
+	// Now that the result is stored in the database, we can notify any
+	// active subscribers.
+	for _, res := range store.subscribers[paymentHash] {
+		res <- result
+	}
+	delete(store.subscribers, paymentHash)
+
+	return nil
+}
+
+// subscribeResult is used to get the result of the latest HTLC dispatched for
+// the given payment hash. It returns a channel on which the result will be
+// delivered when ready.
+func (store *networkResultStore) subscribeResult(paymentHash [32]byte) (
+	<-chan *networkResult, error) {
+
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	resultChan := make(chan *networkResult, 1)
+
+	result, err := store.fetchResult(paymentHash)
+	switch {
+
+	// If the result is already available, we can deliver it right away.
+	case err == nil:
+		resultChan <- result
+		return resultChan, nil
+
+	// Otherwise, we'll add the subscriber to be notified once the result
+	// is stored.
+	case err == ErrPaymentResultNotFound:
+		store.subscribers[paymentHash] = append(
+			store.subscribers[paymentHash], resultChan,
+		)
+		return resultChan, nil
+
+	default:
+		return nil, err
+	}
+}
+
+// fetchResult retrieves the stored result of the latest HTLC dispatched for
+// the given payment hash. If no result is stored, ErrPaymentResultNotFound is
+// returned.
+func (store *networkResultStore) fetchResult(paymentHash [32]byte) (
+	*networkResult, error) {
+
+	var result *networkResult
+	err := store.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(networkResultStoreBucketKey)
+		if bucket == nil {
+			return ErrPaymentResultNotFound
+		}
+
+		v := bucket.Get(paymentHash[:])
+		if v == nil {
+			return ErrPaymentResultNotFound
+		}
+
+		var err error
+		result, err = deserializeNetworkResult(bytes.NewReader(v))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// deleteResult removes the stored result for the given payment hash, if any.
+// This must be done before a new HTLC is dispatched for the payment hash, so
+// that the result of a previous HTLC isn't mistaken for its result.
+func (store *networkResultStore) deleteResult(paymentHash [32]byte) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	return store.db.Batch(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(networkResultStoreBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.Delete(paymentHash[:])
+	})
+}
+
+// cleanStore removes the results of all payment hashes that aren't part of
+// the passed set.
+func (store *networkResultStore) cleanStore(keep map[[32]byte]struct{}) error {
+	store.mtx.Lock()
+	defer store.mtx.Unlock()
+
+	return store.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(networkResultStoreBucketKey)
+		if bucket == nil {
+			return nil
+		}
+
+		var toClean [][]byte
+		err := bucket.ForEach(func(k, _ []byte) error {
+			var paymentHash [32]byte
+			copy(paymentHash[:], k)
+
+			if _, ok := keep[paymentHash]; !ok {
+				toClean = append(toClean, k)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range toClean {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,136 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestNetworkResultStore asserts that stored network results are delivered to
+// both existing and new subscribers, and that they persist across restarts.
+func TestNetworkResultStore(t *testing.T) {
+	t.Parallel()
+
+	tempPath, err := ioutil.TempDir("", "networkresultstore")
+	if err != nil {
+		t.Fatalf("unable to create temp path: %v", err)
+	}
+	defer os.RemoveAll(tempPath)
+
+	db, err := channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+
+	store := newNetworkResultStore(db)
+
+	var paymentHash [32]byte
+	copy(paymentHash[:], bytes.Repeat([]byte{1}, 32))
+
+	// Fetching the result of an unknown payment should fail.
+	_, err = store.fetchResult(paymentHash)
+	if err != ErrPaymentResultNotFound {
+		t.Fatalf("expected ErrPaymentResultNotFound, got: %v", err)
+	}
+
+	// We'll subscribe to the result before it is stored, which should
+	// only deliver it once it is.
+	pending, err := store.subscribeResult(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	select {
+	case <-pending:
+		t.Fatalf("result delivered before being stored")
+	default:
+	}
+
+	result := &networkResult{
+		msg: &lnwire.UpdateFailHTLC{
+			Reason: []byte{1, 2, 3},
+		},
+		unencrypted:  true,
+		isResolution: true,
+	}
+	if err := store.storeResult(paymentHash, result); err != nil {
+		t.Fatalf("unable to store result: %v", err)
+	}
+
+	select {
+	case received := <-pending:
+		if received != result {
+			t.Fatalf("unexpected result delivered")
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("result not delivered")
+	}
+
+	// After a restart, the result should be delivered to new subscribers
+	// right away.
+	db.Close()
+	db, err = channeldb.Open(tempPath)
+	if err != nil {
+		t.Fatalf("unable to open channeldb: %v", err)
+	}
+	defer db.Close()
+
+	store = newNetworkResultStore(db)
+
+	stored, err := store.subscribeResult(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+
+	select {
+	case received := <-stored:
+		if !reflect.DeepEqual(received, result) {
+			t.Fatalf("expected result %v, got %v", result,
+				received)
+		}
+
+	default:
+		t.Fatalf("stored result not delivered")
+	}
+
+	// Once deleted, the result should no longer be found.
+	if err := store.deleteResult(paymentHash); err != nil {
+		t.Fatalf("unable to delete result: %v", err)
+	}
+	_, err = store.fetchResult(paymentHash)
+	if err != ErrPaymentResultNotFound {
+		t.Fatalf("expected ErrPaymentResultNotFound, got: %v", err)
+	}
+
+	// Finally, cleaning the store should only retain the results of the
+	// payment hashes we ask it to keep.
+	var keepHash [32]byte
+	copy(keepHash[:], bytes.Repeat([]byte{2}, 32))
+
+	for _, hash := range [][32]byte{paymentHash, keepHash} {
+		if err := store.storeResult(hash, result); err != nil {
+			t.Fatalf("unable to store result: %v", err)
+		}
+	}
+
+	keep := map[[32]byte]struct{}{
+		keepHash: {},
+	}
+	if err := store.cleanStore(keep); err != nil {
+		t.Fatalf("unable to clean store: %v", err)
+	}
+
+	_, err = store.fetchResult(paymentHash)
+	if err != ErrPaymentResultNotFound {
+		t.Fatalf("expected ErrPaymentResultNotFound, got: %v", err)
+	}
+	if _, err := store.fetchResult(keepHash); err != nil {
+		t.Fatalf("unable to fetch kept result: %v", err)
+	}
+}
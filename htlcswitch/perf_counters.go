@@ -0,0 +1,238 @@
+package htlcswitch
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// maxLatencySamples is the number of most recent settle latencies
+	// that are retained to compute the latency percentiles.
+	maxLatencySamples = 1000
+
+	// maxPendingSettles is the maximum number of in flight HTLCs for
+	// which we'll track the time they were forwarded. This bounds the
+	// memory used by the counters, should the resolution of some HTLCs
+	// never be observed by the switch.
+	maxPendingSettles = 10000
+)
+
+// LinkPerfStats describes the throughput of a single link.
+type LinkPerfStats struct {
+	// ChanID is the short channel ID of the link's channel.
+	ChanID lnwire.ShortChannelID
+
+	// NumHtlcs is the number of HTLCs that were forwarded over the link in
+	// either direction.
+	NumHtlcs uint64
+
+	// NumCommitSigs is the number of commitment signatures sent by the
+	// link.
+	NumCommitSigs uint64
+
+	// Elapsed is the time elapsed since the link's counters were created.
+	Elapsed time.Duration
+}
+
+// HtlcsPerSecond returns the average number of HTLCs forwarded over the link
+// per second.
+func (l LinkPerfStats) HtlcsPerSecond() float64 {
+	if l.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(l.NumHtlcs) / l.Elapsed.Seconds()
+}
+
+// CommitSigsPerSecond returns the average number of commitment signatures
+// sent by the link per second.
+func (l LinkPerfStats) CommitSigsPerSecond() float64 {
+	if l.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(l.NumCommitSigs) / l.Elapsed.Seconds()
+}
+
+// PerfStats is a snapshot of the performance counters of the switch.
+type PerfStats struct {
+	// Links holds the throughput of every link that forwarded an HTLC or
+	// sent a commitment signature.
+	Links []LinkPerfStats
+
+	// NumSettles is the total number of HTLCs forwarded through the
+	// switch which were settled.
+	NumSettles uint64
+
+	// SettleLatencyP50, SettleLatencyP90 and SettleLatencyP99 are
+	// percentiles of the time elapsed between an HTLC being forwarded by
+	// the switch and its settle being received back, computed over the
+	// most recent settles.
+	SettleLatencyP50 time.Duration
+	SettleLatencyP90 time.Duration
+	SettleLatencyP99 time.Duration
+}
+
+// linkCounters houses the raw counters of a single link.
+type linkCounters struct {
+	created       time.Time
+	numHtlcs      uint64
+	numCommitSigs uint64
+}
+
+// PerfCounters tracks runtime performance counters of the switch and its
+// links: the number of HTLCs forwarded and commitment signatures sent per
+// link, along with the end-to-end latency of settled HTLCs. These allow
+// regressions in the hot path to be detected, and operators to size their
+// hardware.
+//
+// NOTE: This struct is safe for concurrent use.
+type PerfCounters struct {
+	mu sync.Mutex
+
+	links map[lnwire.ShortChannelID]*linkCounters
+
+	// pending maps the incoming circuit key of every in flight HTLC to
+	// the time it was forwarded.
+	pending map[CircuitKey]time.Time
+
+	// latencies is a ring buffer of the most recent settle latencies.
+	latencies   []time.Duration
+	nextLatency int
+	numSettles  uint64
+
+	// now returns the current time, which allows tests to control the
+	// passage of time.
+	now func() time.Time
+}
+
+// NewPerfCounters creates a new, empty set of performance counters.
+func NewPerfCounters() *PerfCounters {
+	return &PerfCounters{
+		links:   make(map[lnwire.ShortChannelID]*linkCounters),
+		pending: make(map[CircuitKey]time.Time),
+		now:     time.Now,
+	}
+}
+
+// fetchLink returns the counters of the given link, creating them if needed.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (p *PerfCounters) fetchLink(chanID lnwire.ShortChannelID) *linkCounters {
+	counters, ok := p.links[chanID]
+	if !ok {
+		counters = &linkCounters{
+			created: p.now(),
+		}
+		p.links[chanID] = counters
+	}
+
+	return counters
+}
+
+// AddHtlc records an HTLC that was forwarded over the given link. If inKey is
+// non-nil, then the HTLC is considered in flight until ResolveHtlc is called
+// for the same circuit key.
+func (p *PerfCounters) AddHtlc(chanID lnwire.ShortChannelID,
+	inKey *CircuitKey) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.fetchLink(chanID).numHtlcs++
+
+	if inKey != nil && len(p.pending) < maxPendingSettles {
+		p.pending[*inKey] = p.now()
+	}
+}
+
+// ResolveHtlc records the resolution of the in flight HTLC with the given
+// incoming circuit key. The latency of settled HTLCs is sampled.
+func (p *PerfCounters) ResolveHtlc(inKey CircuitKey, settled bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	forwarded, ok := p.pending[inKey]
+	if !ok {
+		return
+	}
+	delete(p.pending, inKey)
+
+	if !settled {
+		return
+	}
+
+	p.numSettles++
+
+	latency := p.now().Sub(forwarded)
+	if len(p.latencies) < maxLatencySamples {
+		p.latencies = append(p.latencies, latency)
+		return
+	}
+
+	p.latencies[p.nextLatency] = latency
+	p.nextLatency = (p.nextLatency + 1) % maxLatencySamples
+}
+
+// AddCommitSig records a commitment signature that was sent by the given link.
+func (p *PerfCounters) AddCommitSig(chanID lnwire.ShortChannelID) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.fetchLink(chanID).numCommitSigs++
+}
+
+// Stats returns a snapshot of the performance counters.
+func (p *PerfCounters) Stats() PerfStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+
+	stats := PerfStats{
+		Links:      make([]LinkPerfStats, 0, len(p.links)),
+		NumSettles: p.numSettles,
+	}
+	for chanID, counters := range p.links {
+		stats.Links = append(stats.Links, LinkPerfStats{
+			ChanID:        chanID,
+			NumHtlcs:      counters.numHtlcs,
+			NumCommitSigs: counters.numCommitSigs,
+			Elapsed:       now.Sub(counters.created),
+		})
+	}
+	sort.Slice(stats.Links, func(i, j int) bool {
+		return stats.Links[i].ChanID.ToUint64() <
+			stats.Links[j].ChanID.ToUint64()
+	})
+
+	if len(p.latencies) == 0 {
+		return stats
+	}
+
+	latencies := make([]time.Duration, len(p.latencies))
+	copy(latencies, p.latencies)
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+
+	stats.SettleLatencyP50 = percentile(latencies, 50)
+	stats.SettleLatencyP90 = percentile(latencies, 90)
+	stats.SettleLatencyP99 = percentile(latencies, 99)
+
+	return stats
+}
+
+// percentile returns the given percentile of the passed sorted, non-empty set
+// of samples, using the nearest-rank method.
+func percentile(sorted []time.Duration, pct int) time.Duration {
+	rank := (pct*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
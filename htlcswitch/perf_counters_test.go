@@ -0,0 +1,139 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestPerfCounters asserts that the performance counters report the
+// throughput of each link, and the settle latency percentiles of the HTLCs
+// forwarded through the switch.
+func TestPerfCounters(t *testing.T) {
+	t.Parallel()
+
+	var (
+		incoming = lnwire.NewShortChanIDFromInt(1)
+		outgoing = lnwire.NewShortChanIDFromInt(2)
+		start    = time.Unix(1000, 0)
+		now      = start
+	)
+
+	p := NewPerfCounters()
+	p.now = func() time.Time {
+		return now
+	}
+
+	// We'll forward 100 HTLCs, taking 1ms up to 100ms to settle
+	// respectively. The link sends a commitment signature for every HTLC.
+	for i := 1; i <= 100; i++ {
+		inKey := CircuitKey{ChanID: incoming, HtlcID: uint64(i)}
+		p.AddHtlc(incoming, nil)
+		p.AddHtlc(outgoing, &inKey)
+		p.AddCommitSig(outgoing)
+
+		now = now.Add(time.Duration(i) * time.Millisecond)
+		p.ResolveHtlc(inKey, true)
+		now = start
+	}
+
+	// A failed HTLC shouldn't be taken into account for the latency.
+	failKey := CircuitKey{ChanID: incoming, HtlcID: 101}
+	p.AddHtlc(outgoing, &failKey)
+	p.ResolveHtlc(failKey, false)
+
+	// Resolving an HTLC we don't know of should be a noop.
+	p.ResolveHtlc(CircuitKey{ChanID: incoming, HtlcID: 102}, true)
+
+	now = start.Add(10 * time.Second)
+	stats := p.Stats()
+
+	if stats.NumSettles != 100 {
+		t.Fatalf("expected 100 settles, got %v", stats.NumSettles)
+	}
+	if len(stats.Links) != 2 {
+		t.Fatalf("expected stats for 2 links, got %v",
+			len(stats.Links))
+	}
+
+	inStats, outStats := stats.Links[0], stats.Links[1]
+	if inStats.ChanID != incoming || outStats.ChanID != outgoing {
+		t.Fatalf("unexpected link order: %v, %v", inStats.ChanID,
+			outStats.ChanID)
+	}
+	if inStats.NumHtlcs != 100 || inStats.NumCommitSigs != 0 {
+		t.Fatalf("unexpected incoming link stats: %+v", inStats)
+	}
+	if outStats.NumHtlcs != 101 || outStats.NumCommitSigs != 100 {
+		t.Fatalf("unexpected outgoing link stats: %+v", outStats)
+	}
+	if outStats.HtlcsPerSecond() != 10.1 {
+		t.Fatalf("expected 10.1 htlcs/s, got %v",
+			outStats.HtlcsPerSecond())
+	}
+	if outStats.CommitSigsPerSecond() != 10 {
+		t.Fatalf("expected 10 commit sigs/s, got %v",
+			outStats.CommitSigsPerSecond())
+	}
+
+	percentiles := []struct {
+		name     string
+		latency  time.Duration
+		expected time.Duration
+	}{
+		{"p50", stats.SettleLatencyP50, 50 * time.Millisecond},
+		{"p90", stats.SettleLatencyP90, 90 * time.Millisecond},
+		{"p99", stats.SettleLatencyP99, 99 * time.Millisecond},
+	}
+	for _, pct := range percentiles {
+		if pct.latency != pct.expected {
+			t.Fatalf("expected %v latency of %v, got %v", pct.name,
+				pct.expected, pct.latency)
+		}
+	}
+}
+
+// TestPerfCountersLatencySamples asserts that only the most recent settle
+// latencies are taken into account.
+func TestPerfCountersLatencySamples(t *testing.T) {
+	t.Parallel()
+
+	var (
+		chanID = lnwire.NewShortChanIDFromInt(1)
+		start  = time.Unix(1000, 0)
+		now    = start
+	)
+
+	p := NewPerfCounters()
+	p.now = func() time.Time {
+		return now
+	}
+
+	settle := func(id uint64, latency time.Duration) {
+		inKey := CircuitKey{ChanID: chanID, HtlcID: id}
+		now = start
+		p.AddHtlc(chanID, &inKey)
+		now = start.Add(latency)
+		p.ResolveHtlc(inKey, true)
+	}
+
+	// We'll first fill up the samples with slow settles, which should
+	// then be overwritten by fast ones.
+	for i := 0; i < maxLatencySamples; i++ {
+		settle(uint64(i), time.Second)
+	}
+	for i := 0; i < maxLatencySamples; i++ {
+		settle(uint64(maxLatencySamples+i), time.Millisecond)
+	}
+
+	stats := p.Stats()
+	if stats.NumSettles != 2*maxLatencySamples {
+		t.Fatalf("expected %v settles, got %v", 2*maxLatencySamples,
+			stats.NumSettles)
+	}
+	if stats.SettleLatencyP99 != time.Millisecond {
+		t.Fatalf("expected p99 latency of 1ms, got %v",
+			stats.SettleLatencyP99)
+	}
+}
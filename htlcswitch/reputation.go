@@ -0,0 +1,175 @@
+package htlcswitch
+
+import (
+	"sync"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ReputationConfig houses the parameters used by the ReputationTracker to
+// decide whether an incoming channel has built up a sufficient reputation for
+// the HTLCs it forwards to us to be endorsed to the next hop.
+type ReputationConfig struct {
+	// MinResolvedHtlcs is the minimum number of HTLCs forwarded on behalf
+	// of an incoming channel that must have been resolved before its
+	// reputation is considered.
+	MinResolvedHtlcs uint64
+
+	// MinSuccessRate is the minimum fraction of the resolved HTLCs
+	// forwarded on behalf of an incoming channel that must have been
+	// settled for it to have a good reputation.
+	MinSuccessRate float64
+}
+
+// DefaultReputationConfig returns the default parameters used to track the
+// reputation of incoming channels.
+func DefaultReputationConfig() ReputationConfig {
+	return ReputationConfig{
+		MinResolvedHtlcs: 10,
+		MinSuccessRate:   0.9,
+	}
+}
+
+// ReputationStats summarizes the HTLCs we've forwarded on behalf of an
+// incoming channel.
+type ReputationStats struct {
+	// IncomingHtlcs is the number of HTLCs received over the channel that
+	// we attempted to forward.
+	IncomingHtlcs uint64
+
+	// EndorsedHtlcs is the number of HTLCs received over the channel that
+	// were endorsed by the peer.
+	EndorsedHtlcs uint64
+
+	// SettledHtlcs is the number of forwarded HTLCs that were settled.
+	SettledHtlcs uint64
+
+	// FailedHtlcs is the number of forwarded HTLCs that were failed.
+	FailedHtlcs uint64
+}
+
+// EndorsementRate returns the fraction of the incoming HTLCs that were
+// endorsed by the peer.
+func (r ReputationStats) EndorsementRate() float64 {
+	if r.IncomingHtlcs == 0 {
+		return 0
+	}
+
+	return float64(r.EndorsedHtlcs) / float64(r.IncomingHtlcs)
+}
+
+// SuccessRate returns the fraction of the resolved HTLCs that were settled.
+func (r ReputationStats) SuccessRate() float64 {
+	resolved := r.SettledHtlcs + r.FailedHtlcs
+	if resolved == 0 {
+		return 0
+	}
+
+	return float64(r.SettledHtlcs) / float64(resolved)
+}
+
+// ReputationTracker keeps track of the outcome of the HTLCs we forward on
+// behalf of our incoming channels. Incoming channels which reliably forward
+// us HTLCs that settle build up a good reputation, and only the endorsed HTLCs
+// received from such channels are endorsed to the next hop. This serves as a
+// building block for channel jamming mitigation.
+//
+// NOTE: This struct is safe for concurrent use.
+type ReputationTracker struct {
+	cfg ReputationConfig
+
+	mu    sync.Mutex
+	stats map[lnwire.ShortChannelID]*ReputationStats
+}
+
+// NewReputationTracker creates a new ReputationTracker using the passed
+// config.
+func NewReputationTracker(cfg ReputationConfig) *ReputationTracker {
+	return &ReputationTracker{
+		cfg:   cfg,
+		stats: make(map[lnwire.ShortChannelID]*ReputationStats),
+	}
+}
+
+// fetchStats returns the stats of the given channel, creating them if needed.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (r *ReputationTracker) fetchStats(
+	chanID lnwire.ShortChannelID) *ReputationStats {
+
+	stats, ok := r.stats[chanID]
+	if !ok {
+		stats = &ReputationStats{}
+		r.stats[chanID] = stats
+	}
+
+	return stats
+}
+
+// AddIncoming records an HTLC received over the given channel which we're
+// attempting to forward.
+func (r *ReputationTracker) AddIncoming(chanID lnwire.ShortChannelID,
+	endorsed bool) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := r.fetchStats(chanID)
+	stats.IncomingHtlcs++
+	if endorsed {
+		stats.EndorsedHtlcs++
+	}
+}
+
+// ResolveIncoming records the resolution of an HTLC received over the given
+// channel that we forwarded.
+func (r *ReputationTracker) ResolveIncoming(chanID lnwire.ShortChannelID,
+	settled bool) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats := r.fetchStats(chanID)
+	if settled {
+		stats.SettledHtlcs++
+	} else {
+		stats.FailedHtlcs++
+	}
+}
+
+// HasGoodReputation returns true if the given incoming channel has built up a
+// sufficient reputation for its endorsed HTLCs to be endorsed to the next
+// hop.
+func (r *ReputationTracker) HasGoodReputation(
+	chanID lnwire.ShortChannelID) bool {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.stats[chanID]
+	if !ok {
+		return r.cfg.MinResolvedHtlcs == 0
+	}
+
+	resolved := stats.SettledHtlcs + stats.FailedHtlcs
+	if resolved < r.cfg.MinResolvedHtlcs {
+		return false
+	}
+
+	return resolved == 0 || stats.SuccessRate() >= r.cfg.MinSuccessRate
+}
+
+// Stats returns a snapshot of the stats of the given incoming channel.
+func (r *ReputationTracker) Stats(
+	chanID lnwire.ShortChannelID) ReputationStats {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stats, ok := r.stats[chanID]
+	if !ok {
+		return ReputationStats{}
+	}
+
+	return *stats
+}
@@ -0,0 +1,58 @@
+package htlcswitch
+
+import (
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestReputationTracker tests that incoming channels only build up a good
+// reputation once enough of the HTLCs forwarded on their behalf settled.
+func TestReputationTracker(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewReputationTracker(ReputationConfig{
+		MinResolvedHtlcs: 4,
+		MinSuccessRate:   0.75,
+	})
+	chanID := lnwire.NewShortChanIDFromInt(1)
+
+	// An unknown channel doesn't have a good reputation.
+	if tracker.HasGoodReputation(chanID) {
+		t.Fatalf("expected unknown channel to not have good reputation")
+	}
+
+	// Forward three endorsed and one unendorsed HTLC, of which only one
+	// fails.
+	for i := 0; i < 4; i++ {
+		tracker.AddIncoming(chanID, i != 0)
+		tracker.ResolveIncoming(chanID, i != 1)
+
+		// The reputation is only considered once enough HTLCs were
+		// resolved.
+		if i < 3 && tracker.HasGoodReputation(chanID) {
+			t.Fatalf("expected channel to not have good reputation "+
+				"after %d resolved htlcs", i+1)
+		}
+	}
+
+	if !tracker.HasGoodReputation(chanID) {
+		t.Fatalf("expected channel to have good reputation")
+	}
+
+	stats := tracker.Stats(chanID)
+	if stats.IncomingHtlcs != 4 || stats.EndorsedHtlcs != 3 {
+		t.Fatalf("unexpected stats: %v", spew.Sdump(stats))
+	}
+	if stats.EndorsementRate() != 0.75 {
+		t.Fatalf("expected endorsement rate of 0.75, got %v",
+			stats.EndorsementRate())
+	}
+
+	// Another failure drops the success rate below the minimum.
+	tracker.ResolveIncoming(chanID, false)
+	if tracker.HasGoodReputation(chanID) {
+		t.Fatalf("expected channel to lose good reputation")
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/ticker"
 )
@@ -30,6 +31,18 @@ const (
 	// DefaultLogInterval is the duration between attempts to log statistics
 	// about forwarding events.
 	DefaultLogInterval = 10 * time.Second
+
+	// DefaultDustThreshold is the default total value of dust HTLCs a
+	// channel's commitment may hold, after which new dust HTLCs are
+	// failed. It is set to 500,000 satoshis.
+	DefaultDustThreshold lnwire.MilliSatoshi = 500000000
+
+	// DefaultHeldHtlcExpiryDelta is the default number of blocks before
+	// its incoming expiry at which an intercepted HTLC that's still held
+	// is failed back. This leaves room to fail it off-chain before the
+	// remote party goes on-chain to time it out, which it typically does
+	// 10 blocks before the expiry.
+	DefaultHeldHtlcExpiryDelta uint32 = 20
 )
 
 var (
@@ -114,7 +127,7 @@ type ChanClose struct {
 	// This value is only utilized if the closure type is CloseRegular.
 	// This will be the starting offered fee when the fee negotiation
 	// process for the cooperative closure transaction kicks off.
-	TargetFeePerKw lnwallet.SatPerKWeight
+	TargetFeePerKw chainfee.SatPerKWeight
 
 	// Updates is used by request creator to receive the notifications about
 	// execution of the close channel request.
@@ -180,6 +193,40 @@ type Config struct {
 	// the ChannelNotifier when channels become active and inactive.
 	NotifyActiveChannel   func(wire.OutPoint)
 	NotifyInactiveChannel func(wire.OutPoint)
+
+	// Reputation houses the parameters used to decide whether an incoming
+	// channel has a sufficient reputation for its endorsed HTLCs to be
+	// endorsed to the next hop.
+	Reputation ReputationConfig
+
+	// DustThreshold is the maximum total value of dust HTLCs that either
+	// commitment of a channel may hold. Dust HTLCs don't have an output of
+	// their own, so their value would be lost to miners if the channel
+	// was force closed with them in flight. Any new dust HTLC exceeding
+	// this threshold is failed. A zero value disables the limit.
+	DustThreshold lnwire.MilliSatoshi
+
+	// HeldHtlcExpiryDelta is the number of blocks before its incoming
+	// expiry at which an HTLC held by the forward interceptor is failed
+	// back, so the incoming channel isn't force closed by the remote
+	// party to time it out. A zero value disables this.
+	HeldHtlcExpiryDelta uint32
+
+	// PreimageCache is a global witness beacon that houses any new
+	// preimages discovered by other links. The preimages HTLCs held by
+	// the forward interceptor are settled with are added to it, such that
+	// the HTLCs can still be claimed on-chain if the incoming channel is
+	// closed before the settle is locked in.
+	PreimageCache contractcourt.WitnessBeacon
+
+	// Throttle houses the limits on the rate at which each peer may add
+	// HTLCs to be forwarded, and on the number of forwards in flight.
+	Throttle ThrottleConfig
+
+	// IsBlocked returns true if HTLCs must never be forwarded to or from
+	// the node identified by the given serialized compressed public key.
+	// If nil, no nodes are blocked.
+	IsBlocked func(node [33]byte) bool
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -218,6 +265,10 @@ type Switch struct {
 	// control provides verification of sending htlc mesages
 	control ControlTower
 
+	// networkResults stores the results of locally initiated HTLCs, such
+	// that they can be retrieved after a restart.
+	networkResults *networkResultStore
+
 	// circuits is storage for payment circuits which are used to
 	// forward the settle/fail htlc updates back to the add htlc initiator.
 	circuits CircuitMap
@@ -281,6 +332,34 @@ type Switch struct {
 	// active ChainNotifier instance. This will be used to retrieve the
 	// lastest height of the chain.
 	blockEpochStream *chainntnfs.BlockEpochEvent
+
+	// reputation tracks the outcome of the HTLCs forwarded on behalf of
+	// our incoming channels, which is used to decide whether we endorse
+	// the HTLCs we forward.
+	reputation *ReputationTracker
+
+	// perf tracks the performance counters of the switch and its links.
+	perf *PerfCounters
+
+	// drainingPeers is the set of peers being drained. No new HTLCs are
+	// forwarded to or from the channels with these peers, allowing their
+	// in-flight HTLCs to resolve.
+	drainingPeers    map[[33]byte]struct{}
+	drainingPeersMtx sync.RWMutex
+
+	// interceptor, if set, is handed every HTLC forwarded through the
+	// switch, and may hold it to decide on its fate.
+	interceptor    ForwardInterceptor
+	interceptorMtx sync.RWMutex
+
+	// heldForwards tracks the forwards the interceptor has taken
+	// ownership of and hasn't resolved yet, keyed by their incoming
+	// circuit key. It's guarded by the interceptorMtx.
+	heldForwards map[CircuitKey]*interceptedForward
+
+	// addThrottle limits the rate at which each peer may add HTLCs to be
+	// forwarded through the switch.
+	addThrottle *AddThrottle
 }
 
 // New creates the new instance of htlc switch.
@@ -304,6 +383,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		circuits:          circuitMap,
 		paymentSequencer:  sequencer,
 		control:           NewPaymentControl(false, cfg.DB),
+		networkResults:    newNetworkResultStore(cfg.DB),
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
 		mailOrchestrator:  newMailOrchestrator(),
 		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
@@ -313,6 +393,11 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		htlcPlex:          make(chan *plexPacket),
 		chanCloseRequests: make(chan *ChanClose),
 		resolutionMsgs:    make(chan *resolutionMsg),
+		reputation:        NewReputationTracker(cfg.Reputation),
+		perf:              NewPerfCounters(),
+		drainingPeers:     make(map[[33]byte]struct{}),
+		heldForwards:      make(map[CircuitKey]*interceptedForward),
+		addThrottle:       NewAddThrottle(cfg.Throttle),
 		quit:              make(chan struct{}),
 	}, nil
 }
@@ -359,6 +444,19 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 	htlc *lnwire.UpdateAddHTLC,
 	deobfuscator ErrorDecrypter) ([sha256.Size]byte, error) {
 
+	return s.SendHTLCNotify(firstHop, htlc, deobfuscator, nil)
+}
+
+// SendHTLCNotify is identical to SendHTLC, but additionally executes the
+// passed onDispatch closure, if non-nil, once the payment has been marked as
+// in flight by the control tower and the htlc has been handed off to the link
+// of the first hop. This allows callers to learn that the payment has left
+// the boundaries of the switch, while still blocking until its result is
+// known.
+func (s *Switch) SendHTLCNotify(firstHop lnwire.ShortChannelID,
+	htlc *lnwire.UpdateAddHTLC, deobfuscator ErrorDecrypter,
+	onDispatch func()) ([sha256.Size]byte, error) {
+
 	// Before sending, double check that we don't already have 1) an
 	// in-flight payment to this payment hash, or 2) a complete payment for
 	// the same hash.
@@ -366,6 +464,16 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 		return zeroPreimage, err
 	}
 
+	// Any result we've stored for this payment hash belongs to a previous
+	// HTLC, so we'll remove it to make room for the result of this one.
+	if err := s.networkResults.deleteResult(htlc.PaymentHash); err != nil {
+		if err := s.control.Fail(htlc.PaymentHash); err != nil {
+			return zeroPreimage, err
+		}
+
+		return zeroPreimage, err
+	}
+
 	// Create payment and add to the map of payment in order later to be
 	// able to retrieve it and return response to the user.
 	payment := &pendingPayment{
@@ -404,6 +512,10 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 		return zeroPreimage, err
 	}
 
+	if onDispatch != nil {
+		onDispatch()
+	}
+
 	// Returns channels so that other subsystem might wait/skip the
 	// waiting of handling of payment.
 	var preimage [sha256.Size]byte
@@ -425,6 +537,95 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 	return preimage, err
 }
 
+// CleanStore removes the stored results of all payment hashes that aren't
+// part of the passed set. It is meant to be called on startup by the owner of
+// the payments, once it knows which results it still needs to retrieve.
+func (s *Switch) CleanStore(keep map[[32]byte]struct{}) error {
+	return s.networkResults.cleanStore(keep)
+}
+
+// GetPaymentResult returns the result of the latest HTLC dispatched for the
+// given payment hash. It is meant to be used to retrieve the result of an
+// HTLC that was dispatched before a restart, in which case the result is
+// delivered once received from the network, or right away if it was received
+// in the meantime. The deobfuscator is used to decrypt any failure returned
+// by the route. If the HTLC never left our node, it is failed and
+// ErrPaymentResultNotFound is returned.
+func (s *Switch) GetPaymentResult(paymentHash [32]byte,
+	deobfuscator ErrorDecrypter) (<-chan *PaymentResult, error) {
+
+	// If the circuit of the HTLC is no longer open, then its result must
+	// have been stored, as results are stored before circuits are torn
+	// down. Otherwise, the HTLC never made it into a commitment, so we'll
+	// mark the payment as failed to allow a subsequent attempt.
+	if !s.hasLocalCircuit(paymentHash) {
+		_, err := s.networkResults.fetchResult(paymentHash)
+		switch {
+		case err == ErrPaymentResultNotFound:
+			err := s.control.Fail(paymentHash)
+			if err != nil && err != ErrPaymentNotInitiated {
+				return nil, err
+			}
+
+			return nil, ErrPaymentResultNotFound
+
+		case err != nil:
+			return nil, err
+		}
+	}
+
+	nChan, err := s.networkResults.subscribeResult(paymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	resultChan := make(chan *PaymentResult, 1)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		var n *networkResult
+		select {
+		case n = <-nChan:
+		case <-s.quit:
+			return
+		}
+
+		result := &PaymentResult{}
+		switch htlc := n.msg.(type) {
+		case *lnwire.UpdateFulfillHTLC:
+			result.Preimage = htlc.PaymentPreimage
+
+		case *lnwire.UpdateFailHTLC:
+			result.Error = s.parseFailedPayment(
+				deobfuscator, paymentHash, n.unencrypted,
+				n.isResolution, htlc,
+			)
+
+		default:
+			result.Error = fmt.Errorf("received unknown response "+
+				"type: %T", n.msg)
+		}
+
+		resultChan <- result
+	}()
+
+	return resultChan, nil
+}
+
+// hasLocalCircuit returns true if an open circuit exists for a locally
+// initiated HTLC with the given payment hash.
+func (s *Switch) hasLocalCircuit(paymentHash [32]byte) bool {
+	for _, circuit := range s.circuits.LookupByPaymentHash(paymentHash) {
+		if circuit.Incoming.ChanID == sourceHop {
+			return true
+		}
+	}
+
+	return false
+}
+
 // UpdateForwardingPolicies sends a message to the switch to update the
 // forwarding policies for the set of target channels. If the set of targeted
 // channels is nil, then the forwarding policies for all active channels with
@@ -642,9 +843,17 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 		}
 	}
 
+	// Fail back the newly added forwards exceeding our limits before they
+	// take up a slot on the outgoing channel.
+	addedPackets = s.throttleAdds(addedPackets)
+
 	// Now, forward any packets for circuits that were successfully added to
-	// the switch's circuit map.
+	// the switch's circuit map, unless they're held by the interceptor.
 	for _, packet := range addedPackets {
+		if s.interceptForward(packet) {
+			continue
+		}
+
 		err := s.routeAsync(packet, fwdChan, linkQuit)
 		if err != nil {
 			return errChan
@@ -784,7 +993,9 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 			}
 		}
 
-		if !link.EligibleToForward() {
+		if !link.EligibleToForward() ||
+			s.IsDraining(link.Peer().PubKey()) {
+
 			err := fmt.Errorf("Link %v is not available to forward",
 				pkt.outgoingChanID)
 			log.Error(err)
@@ -815,7 +1026,30 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 			}
 		}
 
-		return link.HandleSwitchPacket(pkt)
+		if s.dustExceedsThreshold(link, htlc.Amount, false) {
+			err := fmt.Errorf("Link %v would exceed the dust "+
+				"threshold of %v", pkt.outgoingChanID,
+				s.cfg.DustThreshold)
+			log.Error(err)
+
+			// The update does not need to be populated as the error
+			// will be returned back to the router.
+			htlcErr := lnwire.NewTemporaryChannelFailure(nil)
+			return &ForwardingError{
+				ErrorSource:    s.cfg.SelfKey,
+				ExtraMsg:       err.Error(),
+				FailureMessage: htlcErr,
+			}
+		}
+
+		if err := link.HandleSwitchPacket(pkt); err != nil {
+			return err
+		}
+
+		inKey := pkt.inKey()
+		s.perf.AddHtlc(pkt.outgoingChanID, &inKey)
+
+		return nil
 	}
 
 	s.wg.Add(1)
@@ -839,7 +1073,27 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 	defer s.wg.Done()
 
-	// First, we'll clean up any fwdpkg references, circuit entries, and
+	// Before anything else, we'll persist the result of the HTLC, so that
+	// it can be retrieved through GetPaymentResult after a restart. As the
+	// result is stored before the circuit is torn down, it will be
+	// available to anyone that no longer finds the circuit open.
+	//
+	// If the result can't be stored, we'll still carry on with the
+	// teardown and deliver the result to any in-memory payment, as
+	// bailing out would leave the circuit open and the payment stuck
+	// until the response is replayed.
+	result := &networkResult{
+		msg:          pkt.htlc,
+		unencrypted:  pkt.localFailure,
+		isResolution: pkt.isResolution,
+	}
+	err := s.networkResults.storeResult(pkt.circuit.PaymentHash, result)
+	if err != nil {
+		log.Errorf("Unable to store result of payment %x: %v",
+			pkt.circuit.PaymentHash, err)
+	}
+
+	// Next, we'll clean up any fwdpkg references, circuit entries, and
 	// mark in our db that the payment for this payment hash has either
 	// succeeded or failed.
 	//
@@ -907,7 +1161,14 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 			return
 		}
 
-		paymentErr = s.parseFailedPayment(payment, pkt, htlc)
+		var deobfuscator ErrorDecrypter
+		if payment != nil {
+			deobfuscator = payment.deobfuscator
+		}
+		paymentErr = s.parseFailedPayment(
+			deobfuscator, pkt.circuit.PaymentHash,
+			pkt.localFailure, pkt.isResolution, htlc,
+		)
 
 	default:
 		log.Warnf("Received unknown response type: %T", pkt.htlc)
@@ -929,7 +1190,8 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 // 2) A resolution from the chain arbitrator,
 // 3) A failure from the remote party, which will need to be decrypted using the
 //      payment deobfuscator.
-func (s *Switch) parseFailedPayment(payment *pendingPayment, pkt *htlcPacket,
+func (s *Switch) parseFailedPayment(deobfuscator ErrorDecrypter,
+	paymentHash [32]byte, unencrypted, isResolution bool,
 	htlc *lnwire.UpdateFailHTLC) *ForwardingError {
 
 	var failure *ForwardingError
@@ -939,14 +1201,14 @@ func (s *Switch) parseFailedPayment(payment *pendingPayment, pkt *htlcPacket,
 	// The payment never cleared the link, so we don't need to
 	// decrypt the error, simply decode it them report back to the
 	// user.
-	case pkt.localFailure:
+	case unencrypted:
 		var userErr string
 		r := bytes.NewReader(htlc.Reason)
 		failureMsg, err := lnwire.DecodeFailure(r, 0)
 		if err != nil {
 			userErr = fmt.Sprintf("unable to decode onion failure, "+
 				"htlc with hash(%x): %v",
-				pkt.circuit.PaymentHash[:], err)
+				paymentHash[:], err)
 			log.Error(userErr)
 
 			// As this didn't even clear the link, we don't need to
@@ -964,7 +1226,7 @@ func (s *Switch) parseFailedPayment(payment *pendingPayment, pkt *htlcPacket,
 	// the first hop. In this case, we'll report a permanent
 	// channel failure as this means us, or the remote party had to
 	// go on chain.
-	case pkt.isResolution && htlc.Reason == nil:
+	case isResolution && htlc.Reason == nil:
 		userErr := fmt.Sprintf("payment was resolved " +
 			"on-chain, then cancelled back")
 		failure = &ForwardingError{
@@ -973,10 +1235,10 @@ func (s *Switch) parseFailedPayment(payment *pendingPayment, pkt *htlcPacket,
 			FailureMessage: lnwire.FailPermanentChannelFailure{},
 		}
 
-	// If the provided payment is nil, we have discarded the error decryptor
-	// due to a restart. We'll return a fixed error and signal a temporary
-	// channel failure to the router.
-	case payment == nil:
+	// If the provided deobfuscator is nil, we have discarded the error
+	// decryptor due to a restart. We'll return a fixed error and signal a
+	// temporary channel failure to the router.
+	case deobfuscator == nil:
 		userErr := fmt.Sprintf("error decryptor for payment " +
 			"could not be located, likely due to restart")
 		failure = &ForwardingError{
@@ -991,11 +1253,11 @@ func (s *Switch) parseFailedPayment(payment *pendingPayment, pkt *htlcPacket,
 		var err error
 		// We'll attempt to fully decrypt the onion encrypted
 		// error. If we're unable to then we'll bail early.
-		failure, err = payment.deobfuscator.DecryptError(htlc.Reason)
+		failure, err = deobfuscator.DecryptError(htlc.Reason)
 		if err != nil {
 			userErr := fmt.Sprintf("unable to de-obfuscate onion "+
 				"failure, htlc with hash(%x): %v",
-				pkt.circuit.PaymentHash[:], err)
+				paymentHash[:], err)
 			log.Error(userErr)
 			failure = &ForwardingError{
 				ErrorSource:    s.cfg.SelfKey,
@@ -1039,8 +1301,70 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.failAddPacket(packet, failure, addErr)
 		}
 		interfaceLinks, _ := s.getLinks(targetLink.Peer().PubKey())
+		sourceLink, sourceErr := s.getLinkByShortID(
+			packet.incomingChanID,
+		)
 		s.indexMtx.RUnlock()
 
+		// We don't forward HTLCs from or to blocked nodes.
+		if sourceErr == nil && s.isBlocked(sourceLink.Peer().PubKey()) {
+			failure := &lnwire.FailTemporaryNodeFailure{}
+			addErr := fmt.Errorf("incoming link %v is with a "+
+				"blocked node", packet.incomingChanID)
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+		if s.isBlocked(targetLink.Peer().PubKey()) {
+			failure := &lnwire.FailUnknownNextPeer{}
+			addErr := fmt.Errorf("outgoing link %v is with a "+
+				"blocked node", packet.outgoingChanID)
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+
+		// We won't accept any new HTLCs from a peer being drained, so
+		// that its channels can become quiescent.
+		if sourceErr == nil && s.IsDraining(sourceLink.Peer().PubKey()) {
+			failure := &lnwire.FailTemporaryNodeFailure{}
+			addErr := fmt.Errorf("incoming link %v is being drained",
+				packet.incomingChanID)
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+
+		// If the incoming HTLC is dust and pushes the dust exposure of
+		// the incoming channel above our threshold, we'll fail it back
+		// rather than forwarding it.
+		if sourceErr == nil && s.dustExceedsThreshold(
+			sourceLink, packet.incomingAmount, true,
+		) {
+
+			failure := &lnwire.FailTemporaryNodeFailure{}
+			addErr := fmt.Errorf("incoming link %v exceeds the "+
+				"dust threshold of %v", packet.incomingChanID,
+				s.cfg.DustThreshold)
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+
+		// Nor will we forward any new HTLCs to a peer being drained.
+		if s.IsDraining(targetLink.Peer().PubKey()) {
+			var failure lnwire.FailureMessage
+			update, err := s.cfg.FetchLastChannelUpdate(
+				packet.outgoingChanID,
+			)
+			if err != nil {
+				failure = &lnwire.FailTemporaryNodeFailure{}
+			} else {
+				failure = lnwire.NewTemporaryChannelFailure(update)
+			}
+
+			addErr := fmt.Errorf("outgoing link %v is being drained",
+				packet.outgoingChanID)
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+
 		// We'll keep track of any HTLC failures during the link
 		// selection process. This way we can return the error for
 		// precise link that the sender selected, while optimistically
@@ -1138,10 +1462,45 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.failAddPacket(packet, linkErr, addErr)
 		}
 
+		// We also won't forward the HTLC if it would push the dust
+		// exposure of the outgoing channel above our threshold.
+		if s.dustExceedsThreshold(destination, htlc.Amount, false) {
+			var failure lnwire.FailureMessage
+			update, err := s.cfg.FetchLastChannelUpdate(
+				destination.ShortChanID(),
+			)
+			if err != nil {
+				failure = &lnwire.FailTemporaryNodeFailure{}
+			} else {
+				failure = lnwire.NewTemporaryChannelFailure(update)
+			}
+
+			addErr := fmt.Errorf("outgoing link %v exceeds the "+
+				"dust threshold of %v", destination.ShortChanID(),
+				s.cfg.DustThreshold)
+
+			return s.failAddPacket(packet, failure, addErr)
+		}
+
+		// We'll only endorse the outgoing HTLC if the incoming HTLC
+		// was endorsed, and the incoming channel has built up a good
+		// reputation with us.
+		s.reputation.AddIncoming(packet.incomingChanID, htlc.Endorsed)
+		htlc.Endorsed = htlc.Endorsed &&
+			s.reputation.HasGoodReputation(packet.incomingChanID)
+
 		// Send the packet to the destination channel link which
 		// manages the channel.
 		packet.outgoingChanID = destination.ShortChanID()
-		return destination.HandleSwitchPacket(packet)
+		if err := destination.HandleSwitchPacket(packet); err != nil {
+			return err
+		}
+
+		inKey := packet.inKey()
+		s.perf.AddHtlc(packet.incomingChanID, nil)
+		s.perf.AddHtlc(packet.outgoingChanID, &inKey)
+
+		return nil
 
 	case *lnwire.UpdateFailHTLC, *lnwire.UpdateFulfillHTLC:
 		// If the source of this packet has not been set, use the
@@ -1152,6 +1511,18 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		}
 
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
+
+		s.perf.ResolveHtlc(circuit.Incoming, !isFail)
+
+		// If this HTLC was forwarded on behalf of an incoming channel,
+		// we'll record its outcome towards the reputation of the
+		// channel.
+		if circuit.Incoming.ChanID != sourceHop {
+			s.reputation.ResolveIncoming(
+				circuit.Incoming.ChanID, !isFail,
+			)
+		}
+
 		if isFail && !packet.hasSource {
 			switch {
 			case circuit.ErrorEncrypter == nil:
@@ -1219,6 +1590,82 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 	}
 }
 
+// dustExceedsThreshold returns true if the given HTLC is dust on either
+// commitment of the link's channel, and the total value of the dust HTLCs on
+// that commitment exceeds the configured threshold. Incoming HTLCs are expected
+// to already be part of the channel, while outgoing ones are accounted for as
+// if they were added to it.
+func (s *Switch) dustExceedsThreshold(link ChannelLink,
+	amt lnwire.MilliSatoshi, incoming bool) bool {
+
+	if s.cfg.DustThreshold == 0 {
+		return false
+	}
+
+	for _, remote := range []bool{false, true} {
+		if !link.IsDustHtlc(amt, incoming, remote) {
+			continue
+		}
+
+		dustSum := link.DustSum(remote)
+		if !incoming {
+			dustSum += amt
+		}
+
+		if dustSum > s.cfg.DustThreshold {
+			return true
+		}
+	}
+
+	return false
+}
+
+// throttleAdds fails back the given add packets, whose circuits have just
+// been committed, if their incoming peer exceeds its rate of HTLC adds, or if
+// the switch already has the maximum number of forwards in flight. The packets
+// that may be forwarded are returned.
+func (s *Switch) throttleAdds(packets []*htlcPacket) []*htlcPacket {
+	// The circuits of the given packets are already accounted for in the
+	// circuit map.
+	numPending := s.circuits.NumPending() - len(packets)
+	maxPending := s.cfg.Throttle.MaxPendingForwards
+
+	var allowed []*htlcPacket
+	for _, packet := range packets {
+		s.indexMtx.RLock()
+		link, err := s.getLinkByShortID(packet.incomingChanID)
+		s.indexMtx.RUnlock()
+
+		var addErr error
+		switch {
+		case maxPending != 0 && numPending >= maxPending:
+			addErr = fmt.Errorf("unable to forward htlc %v: "+
+				"maximum of %v pending forwards reached",
+				packet.inKey(), maxPending)
+
+		// If the incoming link is gone, the packet will be failed
+		// back when it's routed.
+		case err == nil && !s.addThrottle.Allow(link.Peer().PubKey()):
+			addErr = fmt.Errorf("unable to forward htlc %v: "+
+				"peer exceeded its rate of htlc adds",
+				packet.inKey())
+		}
+
+		if addErr != nil {
+			s.failAddPacket(
+				packet, &lnwire.FailTemporaryNodeFailure{},
+				addErr,
+			)
+			continue
+		}
+
+		numPending++
+		allowed = append(allowed, packet)
+	}
+
+	return allowed
+}
+
 // failAddPacket encrypts a fail packet back to an add packet's source.
 // The ciphertext will be derived from the failure message proivded by context.
 // This method returns the failErr if all other steps complete successfully.
@@ -1419,7 +1866,7 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 // then the last parameter should be the ideal fee-per-kw that will be used as
 // a starting point for close negotiation.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint, closeType ChannelCloseType,
-	targetFeePerKw lnwallet.SatPerKWeight) (chan interface{},
+	targetFeePerKw chainfee.SatPerKWeight) (chan interface{},
 	chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
@@ -1530,6 +1977,10 @@ out:
 
 			atomic.StoreUint32(&s.bestHeight, uint32(blockEpoch.Height))
 
+			// With a new block, some of the HTLCs held by the
+			// interceptor may now be too close to their expiry.
+			s.failExpiringForwards(uint32(blockEpoch.Height))
+
 		// A local close request has arrived, we'll forward this to the
 		// relevant link (if it exists) so the channel can be
 		// cooperatively closed (if possible).
@@ -2059,6 +2510,7 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 		// remove the interface map all together.
 		if len(peerIndex) == 0 {
 			delete(s.interfaceIndex, peerPub)
+			s.addThrottle.RemovePeer(peerPub)
 		}
 	}
 
@@ -2072,11 +2524,16 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	s.indexMtx.Lock()
 	defer s.indexMtx.Unlock()
 
-	// Locate the target link in the pending link index. If no such link
-	// exists, then we will ignore the request.
-	link, ok := s.pendingLinkIndex[chanID]
-	if !ok {
-		return fmt.Errorf("link %v not found", chanID)
+	// Locate the target link in the pending link index, or in the live
+	// link index if its funding transaction was reorged after it went
+	// live. If no such link exists, then we will ignore the request.
+	link, isPending := s.pendingLinkIndex[chanID]
+	if !isPending {
+		var ok bool
+		link, ok = s.linkIndex[chanID]
+		if !ok {
+			return fmt.Errorf("link %v not found", chanID)
+		}
 	}
 
 	oldShortChanID := link.ShortChanID()
@@ -2097,11 +2554,17 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	log.Infof("Updated short_chan_id for ChannelLink(%v): old=%v, new=%v",
 		chanID, oldShortChanID, shortChanID)
 
-	// Since the link was in the pending state before, we will remove it
-	// from the pending link index and add it to the live link index so that
-	// it can be available in forwarding.
-	delete(s.pendingLinkIndex, chanID)
-	s.addLiveLink(link)
+	// If the link was in the pending state before, we will remove it from
+	// the pending link index and add it to the live link index so that it
+	// can be available in forwarding. Otherwise, we'll only move it over
+	// to its new short channel ID within the forwarding index.
+	if isPending {
+		delete(s.pendingLinkIndex, chanID)
+		s.addLiveLink(link)
+	} else {
+		delete(s.forwardingIndex, oldShortChanID)
+		s.forwardingIndex[shortChanID] = link
+	}
 
 	// Finally, alert the mail orchestrator to the change of short channel
 	// ID, and deliver any unclaimed packets to the link.
@@ -2113,6 +2576,47 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	return nil
 }
 
+// DrainPeer starts draining the channels with the given peer: no new HTLCs
+// will be forwarded to or from them, while the in-flight ones are left to
+// resolve. HTLCs paying to us through these channels are still accepted, as
+// they're resolved by the links themselves.
+//
+// NOTE: The draining state is only kept in memory, so it's lost on restart.
+func (s *Switch) DrainPeer(peer [33]byte) {
+	s.drainingPeersMtx.Lock()
+	s.drainingPeers[peer] = struct{}{}
+	s.drainingPeersMtx.Unlock()
+
+	log.Infof("Draining channels with peer %x", peer[:])
+}
+
+// ResumePeer stops draining the channels with the given peer, such that they
+// can forward new HTLCs again.
+func (s *Switch) ResumePeer(peer [33]byte) {
+	s.drainingPeersMtx.Lock()
+	delete(s.drainingPeers, peer)
+	s.drainingPeersMtx.Unlock()
+
+	log.Infof("Resumed forwarding through channels with peer %x",
+		peer[:])
+}
+
+// IsDraining returns true if the channels with the given peer are being
+// drained.
+func (s *Switch) IsDraining(peer [33]byte) bool {
+	s.drainingPeersMtx.RLock()
+	defer s.drainingPeersMtx.RUnlock()
+
+	_, ok := s.drainingPeers[peer]
+	return ok
+}
+
+// isBlocked returns true if HTLCs must not be forwarded to or from the given
+// peer.
+func (s *Switch) isBlocked(peer [33]byte) bool {
+	return s.cfg.IsBlocked != nil && s.cfg.IsBlocked(peer)
+}
+
 // GetLinksByInterface fetches all the links connected to a particular node
 // identified by the serialized compressed form of its public key.
 func (s *Switch) GetLinksByInterface(hop [33]byte) ([]ChannelLink, error) {
@@ -2210,6 +2714,24 @@ func (s *Switch) lookupOpenCircuit(outKey CircuitKey) *PaymentCircuit {
 	return s.circuits.LookupOpenCircuit(outKey)
 }
 
+// HtlcCircuit returns the circuit the HTLC with the given index on the given
+// channel is part of, or nil if there's none. An incoming HTLC is looked up by
+// the incoming key of the circuit it's being forwarded over, while an outgoing
+// HTLC is looked up by the outgoing key of the circuit it was forwarded for.
+func (s *Switch) HtlcCircuit(chanID lnwire.ShortChannelID, htlcIndex uint64,
+	incoming bool) *PaymentCircuit {
+
+	key := CircuitKey{
+		ChanID: chanID,
+		HtlcID: htlcIndex,
+	}
+	if incoming {
+		return s.lookupCircuit(key)
+	}
+
+	return s.lookupOpenCircuit(key)
+}
+
 // FlushForwardingEvents flushes out the set of pending forwarding events to
 // the persistent log. This will be used by the switch to periodically flush
 // out the set of forwarding events to disk. External callers can also use this
@@ -2243,3 +2765,25 @@ func (s *Switch) FlushForwardingEvents() error {
 func (s *Switch) BestHeight() uint32 {
 	return atomic.LoadUint32(&s.bestHeight)
 }
+
+// ReputationStats returns the stats of the HTLCs forwarded on behalf of the
+// given incoming channel, which include the rate at which the HTLCs received
+// over it were endorsed.
+func (s *Switch) ReputationStats(
+	chanID lnwire.ShortChannelID) ReputationStats {
+
+	return s.reputation.Stats(chanID)
+}
+
+// PerfCounters returns the performance counters of the switch, which are
+// shared with the links it manages.
+func (s *Switch) PerfCounters() *PerfCounters {
+	return s.perf
+}
+
+// PerfStats returns a snapshot of the performance counters of the switch and
+// its links, which describe the rate at which HTLCs are forwarded and
+// commitments are signed, along with the end-to-end latency of settled HTLCs.
+func (s *Switch) PerfStats() PerfStats {
+	return s.perf.Stats()
+}
@@ -3,6 +3,7 @@ package htlcswitch
 import (
 	"crypto/rand"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -14,6 +15,8 @@ import (
 	"github.com/btcsuite/fastsha256"
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/ticker"
 )
@@ -83,6 +86,66 @@ func TestSwitchAddDuplicateLink(t *testing.T) {
 	}
 }
 
+// TestSwitchUpdateLiveShortChanID tests that the short channel ID of a live
+// link can be updated, as is needed when its funding transaction is reorged
+// into another block, and that the link is forwarded to under the new one.
+func TestSwitchUpdateLiveShortChanID(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, aliceChanID, _ := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+
+	// Move the live link over to a new short channel ID.
+	newChanID := lnwire.NewShortChanIDFromInt(
+		aliceChanID.ToUint64() + 1,
+	)
+	aliceChannelLink.setLiveShortChanID(newChanID)
+	if err := s.UpdateShortChanID(chanID1); err != nil {
+		t.Fatalf("unable to update alice short_chan_id: %v", err)
+	}
+
+	// The link should only be known by its new short channel ID.
+	s.indexMtx.RLock()
+	_, errOld := s.getLinkByShortID(aliceChanID)
+	link, errNew := s.getLinkByShortID(newChanID)
+	s.indexMtx.RUnlock()
+
+	if errOld != ErrChannelLinkNotFound {
+		t.Fatalf("expected stale short_chan_id to be removed, got: %v",
+			errOld)
+	}
+	if errNew != nil {
+		t.Fatalf("unable to find link by new short_chan_id: %v",
+			errNew)
+	}
+	if link != aliceChannelLink {
+		t.Fatalf("wrong link found by new short_chan_id")
+	}
+	if !s.HasActiveLink(chanID1) {
+		t.Fatalf("link should still be active")
+	}
+}
+
 // TestSwitchHasActiveLink tests the behavior of HasActiveLink, and asserts that
 // it only returns true if a link's short channel id has confirmed (meaning the
 // channel is no longer pending) and it's EligibleToForward method returns true,
@@ -1411,6 +1474,421 @@ func TestSkipIneligibleLinksLocalForward(t *testing.T) {
 	}
 }
 
+// TestSwitchDrainPeer asserts that no HTLCs are forwarded to or from the
+// channels of a peer being drained, and that forwarding resumes once the peer
+// isn't drained anymore.
+func TestSwitchDrainPeer(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// forwardAdd forwards a new HTLC from Alice to Bob, using a distinct
+	// incoming HTLC ID for each of them.
+	var htlcID uint64
+	forwardAdd := func() error {
+		preimage, err := genPreimage()
+		if err != nil {
+			t.Fatalf("unable to generate preimage: %v", err)
+		}
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: fastsha256.Sum256(preimage[:]),
+				Amount:      1,
+			},
+		}
+		htlcID++
+
+		return s.forward(packet)
+	}
+
+	// While Bob is being drained, no HTLC should be forwarded to him.
+	s.DrainPeer(bobPeer.PubKey())
+	if !s.IsDraining(bobPeer.PubKey()) {
+		t.Fatalf("bob should be draining")
+	}
+	if err := forwardAdd(); err == nil {
+		t.Fatalf("forwarding to a draining peer should have failed")
+	}
+
+	// Likewise, while Alice is being drained, no HTLC coming from her
+	// should be forwarded.
+	s.ResumePeer(bobPeer.PubKey())
+	s.DrainPeer(alicePeer.PubKey())
+	if err := forwardAdd(); err == nil {
+		t.Fatalf("forwarding from a draining peer should have failed")
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		t.Fatalf("bob received an HTLC while draining")
+	default:
+	}
+
+	if s.circuits.NumOpen() != 0 {
+		t.Fatal("wrong amount of circuits")
+	}
+
+	// Once Alice isn't drained anymore, HTLCs should be forwarded again.
+	s.ResumePeer(alicePeer.PubKey())
+	if s.IsDraining(alicePeer.PubKey()) {
+		t.Fatalf("alice shouldn't be draining")
+	}
+	if err := forwardAdd(); err != nil {
+		t.Fatalf("unable to forward htlc: %v", err)
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+}
+
+// TestSwitchBlockedNodes asserts that no HTLCs are forwarded to or from the
+// channels of a blocked node.
+func TestSwitchBlockedNodes(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// forwardAdd forwards a new HTLC from Alice to Bob, using a distinct
+	// incoming HTLC ID for each of them.
+	var htlcID uint64
+	forwardAdd := func() error {
+		preimage, err := genPreimage()
+		if err != nil {
+			t.Fatalf("unable to generate preimage: %v", err)
+		}
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: fastsha256.Sum256(preimage[:]),
+				Amount:      1,
+			},
+		}
+		htlcID++
+
+		return s.forward(packet)
+	}
+
+	// Neither with Bob nor with Alice blocked should the HTLC be
+	// forwarded.
+	for _, peer := range []*mockServer{bobPeer, alicePeer} {
+		blocked := peer.PubKey()
+		s.cfg.IsBlocked = func(node [33]byte) bool {
+			return node == blocked
+		}
+		if err := forwardAdd(); err == nil {
+			t.Fatalf("forwarding with blocked node %v should have "+
+				"failed", peer.name)
+		}
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		t.Fatalf("bob received an HTLC while blocked")
+	default:
+	}
+
+	if s.circuits.NumOpen() != 0 {
+		t.Fatal("wrong amount of circuits")
+	}
+
+	// Once neither of them is blocked, HTLCs should be forwarded again.
+	s.cfg.IsBlocked = nil
+	if err := forwardAdd(); err != nil {
+		t.Fatalf("unable to forward htlc: %v", err)
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to destination")
+	}
+}
+
+// failingPreimageCache is a witness beacon that fails to add any preimage.
+type failingPreimageCache struct {
+	contractcourt.WitnessBeacon
+}
+
+func (f *failingPreimageCache) AddPreimages(...lntypes.Preimage) error {
+	return errors.New("unable to add preimages")
+}
+
+// TestSwitchInterceptForward asserts that forwarded HTLCs are held while
+// intercepted, and are resumed, settled or failed as instructed by the
+// interceptor.
+func TestSwitchInterceptForward(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	intercepted := make(chan InterceptedForward, 1)
+	err = s.SetInterceptor(func(fwd InterceptedForward) bool {
+		intercepted <- fwd
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unable to set interceptor: %v", err)
+	}
+	if err := s.SetInterceptor(nil); err != ErrInterceptorActive {
+		t.Fatalf("expected %v, got %v", ErrInterceptorActive, err)
+	}
+
+	// interceptAdd forwards a new HTLC from Alice to Bob, using a distinct
+	// incoming HTLC ID for each of them, and returns the intercepted
+	// forward along with the preimage of the HTLC.
+	var htlcID uint64
+	interceptAdd := func() (InterceptedForward, lntypes.Preimage) {
+		preimage, err := genPreimage()
+		if err != nil {
+			t.Fatalf("unable to generate preimage: %v", err)
+		}
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: fastsha256.Sum256(preimage[:]),
+				Amount:      1,
+			},
+		}
+		htlcID++
+
+		errChan := s.ForwardPackets(nil, packet)
+		for err := range errChan {
+			t.Fatalf("unable to forward htlc: %v", err)
+		}
+
+		select {
+		case fwd := <-intercepted:
+			inKey := fwd.Packet().IncomingCircuit
+			if inKey != packet.inKey() {
+				t.Fatalf("expected htlc %v to be intercepted, "+
+					"got %v", packet.inKey(), inKey)
+			}
+
+			return fwd, preimage
+
+		case <-time.After(time.Second):
+			t.Fatal("htlc was not intercepted")
+		}
+
+		return nil, preimage
+	}
+
+	// assertNoPacket asserts that the given link didn't receive any
+	// packet.
+	assertNoPacket := func(link *mockChannelLink) {
+		select {
+		case <-link.packets:
+			t.Fatalf("unexpected packet received")
+		case <-time.After(50 * time.Millisecond):
+		}
+	}
+
+	// receivePacket returns the next packet received by the given link.
+	receivePacket := func(link *mockChannelLink) *htlcPacket {
+		select {
+		case pkt := <-link.packets:
+			return pkt
+		case <-time.After(time.Second):
+			t.Fatal("packet was not received")
+		}
+
+		return nil
+	}
+
+	// A settled HTLC shouldn't be forwarded to Bob, but should be settled
+	// back to Alice with the given preimage. An invalid preimage should be
+	// rejected.
+	fwd, preimage := interceptAdd()
+	assertNoPacket(bobChannelLink)
+	if err := fwd.Settle(lntypes.Preimage{}); err == nil {
+		t.Fatal("expected settle with invalid preimage to fail")
+	}
+	if err := fwd.Settle(preimage); err != nil {
+		t.Fatalf("unable to settle htlc: %v", err)
+	}
+	pkt := receivePacket(aliceChannelLink)
+	settle, ok := pkt.htlc.(*lnwire.UpdateFulfillHTLC)
+	if !ok {
+		t.Fatal("expected htlc to be settled")
+	}
+	if settle.PaymentPreimage != preimage {
+		t.Fatalf("expected preimage %v, got %v", preimage,
+			settle.PaymentPreimage)
+	}
+	assertNoPacket(bobChannelLink)
+
+	// The preimage should have been added to the witness beacon, so the
+	// HTLC can be claimed on-chain if needed.
+	_, ok = s.cfg.PreimageCache.LookupPreimage(preimage.Hash())
+	if !ok {
+		t.Fatal("expected preimage to be added to the cache")
+	}
+
+	// A forward can only be resolved once.
+	if err := fwd.Resume(); err != ErrFwdResolved {
+		t.Fatalf("expected %v, got %v", ErrFwdResolved, err)
+	}
+
+	// If the preimage can't be added to the witness beacon, the settle
+	// should fail without being sent to Alice, and the forward should
+	// remain held.
+	preimageCache := s.cfg.PreimageCache
+	s.cfg.PreimageCache = &failingPreimageCache{preimageCache}
+	fwd, preimage = interceptAdd()
+	if err := fwd.Settle(preimage); err == nil {
+		t.Fatal("expected settle to fail")
+	}
+	assertNoPacket(aliceChannelLink)
+	s.cfg.PreimageCache = preimageCache
+	if err := fwd.Settle(preimage); err != nil {
+		t.Fatalf("unable to settle htlc: %v", err)
+	}
+	receivePacket(aliceChannelLink)
+
+	// A failed HTLC should be failed back to Alice.
+	fwd, _ = interceptAdd()
+	if err := fwd.Fail(lnwire.CodeExpiryTooSoon); err == nil {
+		t.Fatal("expected unsupported failure code to be rejected")
+	}
+	if err := fwd.Fail(lnwire.CodeTemporaryNodeFailure); err != nil {
+		t.Fatalf("unable to fail htlc: %v", err)
+	}
+	pkt = receivePacket(aliceChannelLink)
+	if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+		t.Fatal("expected htlc to be failed")
+	}
+	assertNoPacket(bobChannelLink)
+
+	// A resumed HTLC should be forwarded to Bob as usual.
+	fwd, _ = interceptAdd()
+	if err := fwd.Resume(); err != nil {
+		t.Fatalf("unable to resume htlc: %v", err)
+	}
+	receivePacket(bobChannelLink)
+
+	// Once the interceptor is cleared, HTLCs should no longer be held.
+	s.ClearInterceptor()
+	packet := &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: htlcID,
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			Amount: 1,
+		},
+	}
+	for err := range s.ForwardPackets(nil, packet) {
+		t.Fatalf("unable to forward htlc: %v", err)
+	}
+	receivePacket(bobChannelLink)
+}
+
 // TestSwitchCancel checks that if htlc was rejected we remove unused
 // circuits.
 func TestSwitchCancel(t *testing.T) {
@@ -2033,3 +2511,219 @@ func TestMultiHopPaymentForwardingEvents(t *testing.T) {
 		}
 	}
 }
+
+// TestSwitchFailExpiringForwards asserts that HTLCs still held by the
+// interceptor are failed back once their incoming expiry is within the
+// configured delta of the best height.
+func TestSwitchFailExpiringForwards(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.HeldHtlcExpiryDelta = 10
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	intercepted := make(chan InterceptedForward, 1)
+	err = s.SetInterceptor(func(fwd InterceptedForward) bool {
+		intercepted <- fwd
+		return true
+	})
+	if err != nil {
+		t.Fatalf("unable to set interceptor: %v", err)
+	}
+
+	// Forward an HTLC from Alice to Bob expiring at height 120, which the
+	// interceptor will hold on to.
+	packet := &htlcPacket{
+		incomingChanID:  aliceChannelLink.ShortChanID(),
+		incomingHTLCID:  0,
+		outgoingChanID:  bobChannelLink.ShortChanID(),
+		incomingTimeout: 120,
+		obfuscator:      NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			Amount: 1,
+		},
+	}
+	for err := range s.ForwardPackets(nil, packet) {
+		t.Fatalf("unable to forward htlc: %v", err)
+	}
+
+	var fwd InterceptedForward
+	select {
+	case fwd = <-intercepted:
+	case <-time.After(time.Second):
+		t.Fatal("htlc was not intercepted")
+	}
+
+	// At a height more than 10 blocks below its expiry, the HTLC should
+	// still be held.
+	s.failExpiringForwards(109)
+	select {
+	case <-aliceChannelLink.packets:
+		t.Fatalf("unexpected packet received")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Once the expiry is within 10 blocks, the HTLC should be failed back
+	// to Alice, and can no longer be resolved by the interceptor.
+	s.failExpiringForwards(110)
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatal("expected htlc to be failed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("packet was not received")
+	}
+
+	if err := fwd.Resume(); err != ErrFwdResolved {
+		t.Fatalf("expected %v, got %v", ErrFwdResolved, err)
+	}
+
+	s.interceptorMtx.RLock()
+	numHeld := len(s.heldForwards)
+	s.interceptorMtx.RUnlock()
+	if numHeld != 0 {
+		t.Fatalf("expected no held forwards, got %v", numHeld)
+	}
+}
+
+// TestSwitchThrottleAdds asserts that forwarded HTLCs exceeding the per-peer
+// rate of adds, or the maximum number of pending forwards, are failed back.
+func TestSwitchThrottleAdds(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		throttle ThrottleConfig
+	}{
+		{
+			name: "add rate",
+			throttle: ThrottleConfig{
+				MaxAddsPerSecond: 0.001,
+				AddBurst:         1,
+			},
+		},
+		{
+			name: "pending forwards",
+			throttle: ThrottleConfig{
+				MaxPendingForwards: 1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testSwitchThrottleAdds(t, test.throttle)
+		})
+	}
+}
+
+func testSwitchThrottleAdds(t *testing.T, throttle ThrottleConfig) {
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.Throttle = throttle
+	s.addThrottle = NewAddThrottle(throttle)
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	// Forward two HTLCs from Alice to Bob, of which only the first one
+	// is within the limits.
+	for i := uint64(0); i < 2; i++ {
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: i,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				Amount: 1,
+			},
+		}
+		for err := range s.ForwardPackets(nil, packet) {
+			t.Fatalf("unable to forward htlc: %v", err)
+		}
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("htlc was not forwarded to bob")
+	}
+
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatal("expected htlc to be failed")
+		}
+		if pkt.incomingHTLCID != 1 {
+			t.Fatalf("expected htlc 1 to be failed, got %v",
+				pkt.incomingHTLCID)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("htlc was not failed back to alice")
+	}
+
+	select {
+	case <-bobChannelLink.packets:
+		t.Fatal("unexpected packet received")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,82 @@
+package htlcswitch
+
+import (
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// ThrottleConfig houses the limits the switch enforces on the HTLCs forwarded
+// through it, protecting the node's resources from misbehaving peers.
+type ThrottleConfig struct {
+	// MaxAddsPerSecond is the sustained rate at which each peer may add
+	// HTLCs to be forwarded. A zero value disables the per-peer limit.
+	MaxAddsPerSecond float64
+
+	// AddBurst is the number of HTLC adds a peer may send in a burst,
+	// beyond its sustained rate.
+	AddBurst int
+
+	// MaxPendingForwards is the maximum number of forwards the switch
+	// will have in flight at any time, across all channels. A zero value
+	// disables the limit.
+	MaxPendingForwards int
+}
+
+// DefaultThrottleConfig returns the default limits on the HTLCs forwarded
+// through the switch.
+func DefaultThrottleConfig() ThrottleConfig {
+	return ThrottleConfig{
+		MaxAddsPerSecond:   20,
+		AddBurst:           100,
+		MaxPendingForwards: 10000,
+	}
+}
+
+// AddThrottle is a per-peer token bucket limiting the rate at which each peer
+// may add HTLCs to be forwarded through the switch.
+//
+// NOTE: This struct is safe for concurrent use.
+type AddThrottle struct {
+	cfg ThrottleConfig
+
+	mu       sync.Mutex
+	limiters map[[33]byte]*rate.Limiter
+}
+
+// NewAddThrottle creates a new AddThrottle using the passed config.
+func NewAddThrottle(cfg ThrottleConfig) *AddThrottle {
+	return &AddThrottle{
+		cfg:      cfg,
+		limiters: make(map[[33]byte]*rate.Limiter),
+	}
+}
+
+// Allow returns true if the given peer may add another HTLC now, consuming one
+// of its tokens if so.
+func (t *AddThrottle) Allow(peer [33]byte) bool {
+	if t.cfg.MaxAddsPerSecond == 0 {
+		return true
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	limiter, ok := t.limiters[peer]
+	if !ok {
+		limiter = rate.NewLimiter(
+			rate.Limit(t.cfg.MaxAddsPerSecond), t.cfg.AddBurst,
+		)
+		t.limiters[peer] = limiter
+	}
+
+	return limiter.Allow()
+}
+
+// RemovePeer forgets the token bucket of the given peer, which will start out
+// full again if it reconnects.
+func (t *AddThrottle) RemovePeer(peer [33]byte) {
+	t.mu.Lock()
+	delete(t.limiters, peer)
+	t.mu.Unlock()
+}
@@ -0,0 +1,46 @@
+package htlcswitch
+
+import "testing"
+
+// TestAddThrottle tests that each peer may only add HTLCs at the configured
+// rate, beyond its initial burst.
+func TestAddThrottle(t *testing.T) {
+	t.Parallel()
+
+	// With such a low rate, no tokens will be refilled during the test.
+	throttle := NewAddThrottle(ThrottleConfig{
+		MaxAddsPerSecond: 0.001,
+		AddBurst:         2,
+	})
+	alice := [33]byte{1}
+	bob := [33]byte{2}
+
+	// Alice may only add as many HTLCs as her burst allows.
+	for i := 0; i < 2; i++ {
+		if !throttle.Allow(alice) {
+			t.Fatalf("expected add %v to be allowed", i)
+		}
+	}
+	if throttle.Allow(alice) {
+		t.Fatalf("expected add exceeding burst to be throttled")
+	}
+
+	// Bob has a separate token bucket.
+	if !throttle.Allow(bob) {
+		t.Fatalf("expected add from other peer to be allowed")
+	}
+
+	// Once removed, Alice starts out with a full bucket again.
+	throttle.RemovePeer(alice)
+	if !throttle.Allow(alice) {
+		t.Fatalf("expected add to be allowed after removing peer")
+	}
+
+	// A zero rate disables the throttle.
+	throttle = NewAddThrottle(ThrottleConfig{})
+	for i := 0; i < 10; i++ {
+		if !throttle.Allow(alice) {
+			t.Fatalf("expected add %v to be allowed", i)
+		}
+	}
+}
@@ -847,6 +847,127 @@ func TestSecondLevelHtlcSpends(t *testing.T) {
 	}
 }
 
+// TestCommitSpendAnchor tests all possible valid+invalid redemption paths of
+// the anchor output found on commitment transactions of channels using anchor
+// outputs.
+func TestCommitSpendAnchor(t *testing.T) {
+	t.Parallel()
+
+	const anchorAmt = btcutil.Amount(330)
+
+	aliceKeyPriv, aliceKeyPub := btcec.PrivKeyFromBytes(btcec.S256(),
+		testWalletPrivKey)
+	bobKeyPriv, _ := btcec.PrivKeyFromBytes(btcec.S256(),
+		bobsPrivKey)
+
+	txid, err := chainhash.NewHash(testHdSeed.CloneBytes())
+	if err != nil {
+		t.Fatalf("unable to create txid: %v", err)
+	}
+	anchorOutPoint := &wire.OutPoint{
+		Hash:  *txid,
+		Index: 0,
+	}
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxIn(wire.NewTxIn(anchorOutPoint, nil, nil))
+	sweepTx.AddTxOut(
+		&wire.TxOut{
+			PkScript: []byte("doesn't matter"),
+			Value:    1,
+		},
+	)
+
+	// The anchor belongs to Alice, and is spendable by her funding key.
+	anchorScript, err := CommitScriptAnchor(aliceKeyPub)
+	if err != nil {
+		t.Fatalf("unable to create anchor script: %v", err)
+	}
+	anchorPkScript, err := WitnessScriptHash(anchorScript)
+	if err != nil {
+		t.Fatalf("unable to create anchor output: %v", err)
+	}
+	anchorOutput := &wire.TxOut{
+		PkScript: anchorPkScript,
+		Value:    int64(anchorAmt),
+	}
+
+	aliceSigner := &MockSigner{Privkeys: []*btcec.PrivateKey{aliceKeyPriv}}
+	bobSigner := &MockSigner{Privkeys: []*btcec.PrivateKey{bobKeyPriv}}
+
+	signWith := func(signer Signer) func() (wire.TxWitness, error) {
+		return func() (wire.TxWitness, error) {
+			signDesc := &SignDescriptor{
+				KeyDesc: keychain.KeyDescriptor{
+					PubKey: aliceKeyPub,
+				},
+				WitnessScript: anchorScript,
+				Output:        anchorOutput,
+				HashType:      txscript.SigHashAll,
+				SigHashes:     txscript.NewTxSigHashes(sweepTx),
+				InputIndex:    0,
+			}
+
+			return CommitSpendAnchor(signer, signDesc, sweepTx)
+		}
+	}
+	spendAnyone := func() (wire.TxWitness, error) {
+		return CommitSpendAnchorAnyone(anchorScript)
+	}
+
+	testCases := []struct {
+		witness  func() wire.TxWitness
+		sequence uint32
+		valid    bool
+	}{
+		{
+			// Alice spends her anchor using her funding key.
+			makeWitnessTestCase(t, signWith(aliceSigner)),
+			wire.MaxTxInSequenceNum,
+			true,
+		},
+		{
+			// Bob attempts to spend Alice's anchor using his key.
+			makeWitnessTestCase(t, signWith(bobSigner)),
+			wire.MaxTxInSequenceNum,
+			false,
+		},
+		{
+			// Anyone attempts to spend the anchor before it has
+			// reached 16 confirmations.
+			makeWitnessTestCase(t, spendAnyone),
+			15,
+			false,
+		},
+		{
+			// Anyone spends the anchor after 16 confirmations.
+			makeWitnessTestCase(t, spendAnyone),
+			16,
+			true,
+		},
+	}
+
+	for i, testCase := range testCases {
+		sweepTx.TxIn[0].Sequence = testCase.sequence
+		sweepTx.TxIn[0].Witness = testCase.witness()
+
+		vm, err := txscript.NewEngine(anchorPkScript,
+			sweepTx, 0, txscript.StandardVerifyFlags, nil,
+			nil, int64(anchorAmt))
+		if err != nil {
+			t.Fatalf("unable to create engine: %v", err)
+		}
+
+		err = vm.Execute()
+		if err != nil && testCase.valid {
+			t.Fatalf("spend test case #%v failed, spend should "+
+				"be valid: %v", i, err)
+		} else if err == nil && !testCase.valid {
+			t.Fatalf("spend test case #%v succeed, spend should "+
+				"be invalid", i)
+		}
+	}
+}
+
 // TestSpecificationKeyDerivation implements the test vectors provided in
 // BOLT-03, Appendix E.
 func TestSpecificationKeyDerivation(t *testing.T) {
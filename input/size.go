@@ -12,6 +12,11 @@ const (
 
 	// HtlcWeight is the weight of an HTLC output.
 	HtlcWeight int64 = 172
+
+	// AnchorCommitWeight is the weight of the base commitment transaction
+	// of a channel using anchor outputs, which includes the two anchor
+	// outputs on top of the regular base commitment.
+	AnchorCommitWeight int64 = CommitWeight + 2*AnchorOutputWeight
 )
 
 const (
@@ -171,6 +176,28 @@ const (
 	// HTLCWeight 172 weight
 	HTLCWeight = witnessScaleFactor * HTLCSize
 
+	// AnchorOutputWeight 172 weight
+	AnchorOutputWeight = witnessScaleFactor * P2WSHOutputSize
+
+	// AnchorScriptSize 40 bytes
+	//      - pubkey_length: 1 byte
+	//      - pubkey: 33 bytes
+	//      - OP_CHECKSIG: 1 byte
+	//      - OP_IFDUP: 1 byte
+	//      - OP_NOTIF: 1 byte
+	//              - OP_16: 1 byte
+	//              - OP_CSV 1 byte
+	//      - OP_ENDIF: 1 byte
+	AnchorScriptSize = 1 + 33 + 6*1
+
+	// AnchorWitnessSize 116 bytes
+	//      - number_of_witnesses_elements: 1 byte
+	//      - signature_length: 1 byte
+	//      - signature: 73 bytes
+	//      - witness_script_length: 1 byte
+	//      - witness_script (anchor_script)
+	AnchorWitnessSize = 1 + 1 + 73 + 1 + AnchorScriptSize
+
 	// HtlcTimeoutWeight is the weight of the HTLC timeout transaction
 	// which will transition an outgoing HTLC to the delay-and-claim state.
 	HtlcTimeoutWeight = 663
@@ -79,6 +79,10 @@ const (
 	// output that sends to a nested P2SH script that pays to a key solely
 	// under our control. The witness generated needs to include the
 	NestedWitnessKeyHash WitnessType = 11
+
+	// CommitmentAnchor is a witness that allows us to spend our anchor on
+	// the commitment transaction.
+	CommitmentAnchor WitnessType = 12
 )
 
 // Stirng returns a human readable version of the target WitnessType.
@@ -114,6 +118,9 @@ func (wt WitnessType) String() string {
 	case HtlcSecondLevelRevoke:
 		return "HtlcSecondLevelRevoke"
 
+	case CommitmentAnchor:
+		return "CommitmentAnchor"
+
 	default:
 		return fmt.Sprintf("Unknown WitnessType: %v", uint32(wt))
 	}
@@ -235,6 +242,16 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 				Witness: witness,
 			}, nil
 
+		case CommitmentAnchor:
+			witness, err := CommitSpendAnchor(signer, desc, tx)
+			if err != nil {
+				return nil, err
+			}
+
+			return &Script{
+				Witness: witness,
+			}, nil
+
 		case WitnessKeyHash:
 			fallthrough
 		case NestedWitnessKeyHash:
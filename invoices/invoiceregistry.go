@@ -439,11 +439,11 @@ func (i *InvoiceRegistry) LookupInvoice(rHash lntypes.Hash) (channeldb.Invoice,
 	return invoice, uint32(payReq.MinFinalCLTVExpiry()), nil
 }
 
-// SettleInvoice attempts to mark an invoice as settled. If the invoice is a
-// debug invoice, then this method is a noop as debug invoices are never fully
-// settled.
+// SettleInvoice attempts to mark an invoice as settled by the given HTLC. If
+// the invoice is a debug invoice, then this method is a noop as debug invoices
+// are never fully settled.
 func (i *InvoiceRegistry) SettleInvoice(rHash lntypes.Hash,
-	amtPaid lnwire.MilliSatoshi) error {
+	htlc channeldb.InvoiceHTLC) error {
 
 	i.Lock()
 	defer i.Unlock()
@@ -460,7 +460,7 @@ func (i *InvoiceRegistry) SettleInvoice(rHash lntypes.Hash,
 
 	// If this isn't a debug invoice, then we'll attempt to settle an
 	// invoice matching this rHash on disk (if one exists).
-	invoice, err := i.cdb.SettleInvoice(rHash, amtPaid)
+	invoice, err := i.cdb.SettleInvoice(rHash, htlc)
 
 	// Implement idempotency by returning success if the invoice was already
 	// settled.
@@ -508,6 +508,33 @@ func (i *InvoiceRegistry) CancelInvoice(payHash lntypes.Hash) error {
 	return nil
 }
 
+// UpdateInvoicePaymentRequest replaces the payment request of the open invoice
+// corresponding to the passed payment hash. Subscribers to the invoice are
+// notified of the updated invoice.
+func (i *InvoiceRegistry) UpdateInvoicePaymentRequest(payHash lntypes.Hash,
+	paymentRequest []byte) (*channeldb.Invoice, error) {
+
+	i.Lock()
+	defer i.Unlock()
+
+	log.Debugf("Updating payment request of invoice %v", payHash)
+
+	invoice, err := i.cdb.UpdateInvoicePaymentRequest(
+		payHash, paymentRequest,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Payment request of invoice %v updated", payHash)
+
+	// As the add index of the invoice remains unchanged, only clients
+	// subscribed to this particular invoice will receive this update.
+	i.notifyClients(payHash, invoice, channeldb.ContractOpen)
+
+	return invoice, nil
+}
+
 // notifyClients notifies all currently registered invoice notification clients
 // of a newly added/settled invoice.
 func (i *InvoiceRegistry) notifyClients(hash lntypes.Hash,
@@ -99,7 +99,12 @@ func TestSettleInvoice(t *testing.T) {
 
 	// Settle invoice with a slightly higher amount.
 	amtPaid := lnwire.MilliSatoshi(100500)
-	err = registry.SettleInvoice(hash, amtPaid)
+	htlc := channeldb.InvoiceHTLC{
+		ChanID:       lnwire.NewShortChanIDFromInt(1),
+		Amt:          amtPaid,
+		AcceptHeight: 100,
+	}
+	err = registry.SettleInvoice(hash, htlc)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -115,6 +120,11 @@ func TestSettleInvoice(t *testing.T) {
 		if update.AmtPaid != amtPaid {
 			t.Fatal("invoice AmtPaid incorrect")
 		}
+		if len(update.Htlcs) != 1 ||
+			update.Htlcs[0].ChanID != htlc.ChanID {
+
+			t.Fatal("invoice Htlcs incorrect")
+		}
 	case <-time.After(testTimeout):
 		t.Fatal("no update received")
 	}
@@ -131,13 +141,14 @@ func TestSettleInvoice(t *testing.T) {
 	}
 
 	// Try to settle again.
-	err = registry.SettleInvoice(hash, amtPaid)
+	err = registry.SettleInvoice(hash, htlc)
 	if err != nil {
 		t.Fatal("expected duplicate settle to succeed")
 	}
 
 	// Try to settle again with a different amount.
-	err = registry.SettleInvoice(hash, amtPaid+600)
+	htlc.Amt = amtPaid + 600
+	err = registry.SettleInvoice(hash, htlc)
 	if err != nil {
 		t.Fatal("expected duplicate settle to succeed")
 	}
@@ -262,7 +273,7 @@ func TestCancelInvoice(t *testing.T) {
 	}
 
 	// Try to settle. This should not be possible.
-	err = registry.SettleInvoice(hash, amt)
+	err = registry.SettleInvoice(hash, channeldb.InvoiceHTLC{Amt: amt})
 	if err != channeldb.ErrInvoiceAlreadyCanceled {
 		t.Fatal("expected settlement of a canceled invoice to fail")
 	}
@@ -0,0 +1,12 @@
+// +build !dev
+
+package lncfg
+
+// ExperimentalProtocol is an empty struct disabling the command line flags of
+// experimental protocol features in production.
+type ExperimentalProtocol struct{}
+
+// AnchorCommitments in production always returns false.
+func (e *ExperimentalProtocol) AnchorCommitments() bool {
+	return false
+}
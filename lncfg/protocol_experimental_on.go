@@ -0,0 +1,18 @@
+// +build dev
+
+package lncfg
+
+// ExperimentalProtocol is a sub-config that houses any experimental protocol
+// features that also require the dev build tag to activate.
+//
+// NOTE: THESE FLAGS ARE INTENDED FOR TESTING PURPOSES ONLY. THE FEATURES THEY
+// ACTIVATE ARE NOT COMPATIBLE WITH OTHER IMPLEMENTATIONS OF THE PROTOCOL.
+type ExperimentalProtocol struct {
+	Anchors bool `long:"anchors" description:"EXPERIMENTAL: signal support for our experimental anchor outputs commitment format. It doesn't follow option_anchor_outputs, so it's only negotiated with other nodes running a dev build"`
+}
+
+// AnchorCommitments returns true if support for the anchor commitment type
+// should be signaled.
+func (e *ExperimentalProtocol) AnchorCommitments() bool {
+	return e.Anchors
+}
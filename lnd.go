@@ -34,6 +34,7 @@ import (
 	"google.golang.org/grpc/credentials"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcwallet/wallet"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	flags "github.com/jessevdk/go-flags"
@@ -123,6 +124,9 @@ func lndMain() error {
 
 	case cfg.Bitcoin.RegTest:
 		network = "regtest"
+
+	case cfg.Bitcoin.SigNet:
+		network = "signet"
 	}
 
 	ltndLog.Infof("Active chain: %v (network=%v)",
@@ -167,6 +171,15 @@ func lndMain() error {
 	}
 	defer chanDB.Close()
 
+	// Make sure the channeldb belongs to the active network, such that we
+	// never mix the state of different networks.
+	err = chanDB.CheckChainHash(*activeNetParams.GenesisHash)
+	if err != nil {
+		ltndLog.Errorf("channeldb at %v can't be used for %v: %v",
+			graphDir, activeNetParams.Name, err)
+		return err
+	}
+
 	// Only process macaroons if --no-macaroons isn't set.
 	ctx := context.Background()
 	ctx, cancel := context.WithCancel(ctx)
@@ -204,13 +217,17 @@ func lndMain() error {
 		unlockedWallet  *wallet.Wallet
 	)
 
+	// The State service reports our progress through the startup, from
+	// the wallet unlocker to the fully started server.
+	stateService := newStateServer(lnrpc.WalletState_NON_EXISTING)
+
 	// We wait until the user provides a password over RPC. In case lnd is
 	// started with the --noseedbackup flag, we use the default password
 	// for wallet encryption.
 	if !cfg.NoSeedBackup {
 		walletInitParams, err := waitForWalletPassword(
 			cfg.RPCListeners, cfg.RESTListeners, serverOpts,
-			proxyOpts, tlsConf,
+			proxyOpts, tlsConf, stateService,
 		)
 		if err != nil {
 			return err
@@ -228,12 +245,20 @@ func lndMain() error {
 				recoveryWindow)
 		}
 	}
+	stateService.setState(lnrpc.WalletState_UNLOCKED)
+
+	if cfg.ResetWalletTransactions {
+		ltndLog.Infof("Dropping all transaction history from on-chain " +
+			"wallet, remember to disable reset-wallet-transactions " +
+			"for the next start of lnd")
+	}
 
 	var macaroonService *macaroons.Service
 	if !cfg.NoMacaroons {
 		// Create the macaroon authentication/authorization service.
 		macaroonService, err = macaroons.NewService(
 			networkDir, macaroons.IPLockChecker,
+			macaroons.CustomChecker,
 		)
 		if err != nil {
 			srvrLog.Errorf("unable to create macaroon service: %v", err)
@@ -262,6 +287,19 @@ func lndMain() error {
 				return err
 			}
 		}
+
+		// The confirmation macaroon is created separately, as it's
+		// only needed if destructive RPCs require a second factor.
+		if cfg.ConfirmDestructive && !fileExists(cfg.ConfirmMacPath) {
+			err = genConfirmMacaroon(
+				ctx, macaroonService, cfg.ConfirmMacPath,
+			)
+			if err != nil {
+				ltndLog.Errorf("unable to create confirmation "+
+					"macaroon file: %v", err)
+				return err
+			}
+		}
 	}
 
 	// With the information parsed from the configuration, create valid
@@ -337,7 +375,7 @@ func lndMain() error {
 	// exported by the rpcServer.
 	rpcServer, err := newRPCServer(
 		server, macaroonService, cfg.SubRPCServers, serverOpts,
-		proxyOpts, atplManager, server.invoices, tlsConf,
+		proxyOpts, atplManager, server.invoices, tlsConf, stateService,
 	)
 	if err != nil {
 		srvrLog.Errorf("unable to start RPC server: %v", err)
@@ -347,6 +385,7 @@ func lndMain() error {
 		return err
 	}
 	defer rpcServer.Stop()
+	stateService.setState(lnrpc.WalletState_RPC_ACTIVE)
 
 	// If we're not in simnet mode, We'll wait until we're fully synced to
 	// continue the start up of the remainder of the daemon. This ensures
@@ -394,6 +433,7 @@ func lndMain() error {
 		return err
 	}
 	defer server.Stop()
+	stateService.setState(lnrpc.WalletState_SERVER_ACTIVE)
 
 	// Now that the server has started, if the autopilot mode is currently
 	// active, then we'll start the autopilot agent immediately. It will be
@@ -632,6 +672,26 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	return nil
 }
 
+// genConfirmMacaroon generates the confirmation macaroon file. This macaroon
+// grants no access on its own, but is required along with a regular macaroon
+// for destructive RPCs if confirmdestructive is set.
+func genConfirmMacaroon(ctx context.Context, svc *macaroons.Service,
+	confirmFile string) error {
+
+	confirmMac, err := svc.Oven.NewMacaroon(
+		ctx, bakery.LatestVersion, nil, macaroons.ConfirmPermissions...,
+	)
+	if err != nil {
+		return err
+	}
+	confirmBytes, err := confirmMac.M().MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(confirmFile, confirmBytes, 0600)
+}
+
 // WalletUnlockParams holds the variables used to parameterize the unlocking of
 // lnd's wallet after it has already been created.
 type WalletUnlockParams struct {
@@ -654,12 +714,54 @@ type WalletUnlockParams struct {
 	Wallet *wallet.Wallet
 }
 
+// unlockWalletFromFile unlocks the existing wallet within the given network
+// directory with the password read from the given file. Trailing newlines of
+// the password are ignored. If the wallet doesn't exist yet, nil is returned,
+// as a new wallet has to be created over RPC for the user to back up its seed.
+func unlockWalletFromFile(netParams *chaincfg.Params, netDir,
+	passwordFile string) (*WalletUnlockParams, error) {
+
+	loader := wallet.NewLoader(netParams, netDir, 0)
+	walletExists, err := loader.WalletExists()
+	if err != nil {
+		return nil, err
+	}
+	if !walletExists {
+		return nil, nil
+	}
+
+	password, err := ioutil.ReadFile(passwordFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read wallet password "+
+			"file: %v", err)
+	}
+	password = bytes.TrimRight(password, "\r\n")
+	if len(password) == 0 {
+		return nil, fmt.Errorf("wallet password file %v is empty",
+			passwordFile)
+	}
+
+	unlockedWallet, err := loader.OpenExistingWallet(password, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unlock wallet with password "+
+			"from %v: %v", passwordFile, err)
+	}
+
+	ltndLog.Infof("Wallet unlocked with password from %v", passwordFile)
+
+	return &WalletUnlockParams{
+		Password: password,
+		Wallet:   unlockedWallet,
+	}, nil
+}
+
 // waitForWalletPassword will spin up gRPC and REST endpoints for the
 // WalletUnlocker server, and block until a password is provided by
-// the user to this RPC server.
+// the user to this RPC server. The State service is served alongside it.
 func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	serverOpts []grpc.ServerOption, proxyOpts []grpc.DialOption,
-	tlsConf *tls.Config) (*WalletUnlockParams, error) {
+	tlsConf *tls.Config, stateService *stateServer) (*WalletUnlockParams,
+	error) {
 
 	// Set up a new PasswordService, which will listen for passwords
 	// provided over RPC.
@@ -678,10 +780,57 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 		filepath.Join(networkDir, macaroons.DBFilename),
 		cfg.AdminMacPath, cfg.ReadMacPath, cfg.InvoiceMacPath,
 	}
+	if cfg.ConfirmMacPath != "" {
+		macaroonFiles = append(macaroonFiles, cfg.ConfirmMacPath)
+	}
 	pwService := walletunlocker.New(
 		chainConfig.ChainDir, activeNetParams.Params, macaroonFiles,
 	)
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
+	lnrpc.RegisterStateServer(grpcServer, stateService)
+
+	// Report whether the wallet still has to be created, or only needs to
+	// be unlocked.
+	netDir := btcwallet.NetworkDir(
+		chainConfig.ChainDir, activeNetParams.Params,
+	)
+	walletExists, err := wallet.NewLoader(
+		activeNetParams.Params, netDir, 0,
+	).WalletExists()
+	if err != nil {
+		return nil, err
+	}
+	if walletExists {
+		stateService.setState(lnrpc.WalletState_LOCKED)
+	}
+
+	// If a password file is configured, an existing wallet is unlocked
+	// right away instead of waiting for the password over RPC. A new
+	// wallet still has to be created over RPC, as its seed must be backed
+	// up by the user.
+	//
+	// A password file that fails to unlock the wallet, for instance
+	// because it's stale after a password change, doesn't prevent lnd from
+	// starting. Instead, we'll fall back to waiting for the password.
+	if cfg.WalletUnlockPasswordFile != "" {
+		unlockParams, err := unlockWalletFromFile(
+			activeNetParams.Params, netDir,
+			cfg.WalletUnlockPasswordFile,
+		)
+		switch {
+		case err != nil:
+			ltndLog.Errorf("Unable to unlock wallet from "+
+				"wallet-unlock-password-file, waiting for "+
+				"password over RPC instead: %v", err)
+
+		case unlockParams != nil:
+			return unlockParams, nil
+
+		default:
+			ltndLog.Infof("Wallet doesn't exist yet, ignoring " +
+				"wallet-unlock-password-file until it's created")
+		}
+	}
 
 	// Use a WaitGroup so we can be sure the instructions on how to input the
 	// password is the last thing to be printed to the console.
@@ -718,7 +867,7 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 
 	mux := proxy.NewServeMux()
 
-	err := lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
+	err = lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
 		ctx, mux, grpcEndpoints[0].String(), proxyOpts,
 	)
 	if err != nil {
@@ -782,9 +931,6 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 				keychain.KeyDerivationVersion)
 		}
 
-		netDir := btcwallet.NetworkDir(
-			chainConfig.ChainDir, activeNetParams.Params,
-		)
 		loader := wallet.NewLoader(
 			activeNetParams.Params, netDir, uint32(recoveryWindow),
 		)
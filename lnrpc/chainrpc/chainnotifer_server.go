@@ -6,6 +6,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -51,6 +52,26 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/chainrpc.ChainNotifier/ListRegistrations": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/chainrpc.ChainNotifier/GetBestBlock": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/chainrpc.ChainNotifier/GetBlockHash": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/chainrpc.ChainNotifier/GetBlockHeader": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/chainrpc.ChainNotifier/GetBlock": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 	}
 
 	// DefaultChainNotifierMacFilename is the default name of the chain
@@ -457,3 +478,150 @@ func (s *Server) RegisterBlockEpochNtfn(in *BlockEpoch,
 		}
 	}
 }
+
+// ListRegistrations returns the live confirmation and spend registrations of
+// each subsystem of lnd, including the ones made through this sub-server.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierService interface.
+func (s *Server) ListRegistrations(ctx context.Context,
+	in *ListRegistrationsRequest) (*ListRegistrationsResponse, error) {
+
+	if s.cfg.RegistrationTracker == nil {
+		return nil, errors.New("registrations are not tracked")
+	}
+
+	registrations := s.cfg.RegistrationTracker.Registrations()
+
+	resp := &ListRegistrationsResponse{
+		Registrations: make([]*Registration, 0, len(registrations)),
+	}
+	for _, reg := range registrations {
+		var regType RegistrationType
+		switch reg.Type {
+		case chainntnfs.ConfRegistration:
+			regType = RegistrationType_CONF
+
+		case chainntnfs.SpendRegistration:
+			regType = RegistrationType_SPEND
+
+		default:
+			return nil, fmt.Errorf("unknown registration type %v",
+				reg.Type)
+		}
+
+		resp.Registrations = append(resp.Registrations, &Registration{
+			Client:         reg.Client,
+			Type:           regType,
+			Request:        reg.Request,
+			NumConfs:       reg.NumConfs,
+			HeightHint:     reg.HeightHint,
+			RegisteredAt:   reg.RegisteredAt.Unix(),
+			NumSubscribers: reg.NumSubscribers,
+			Dispatched:     reg.Dispatched,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetBestBlock returns the hash and height of the best block known to the
+// chain backend of lnd.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierService interface.
+func (s *Server) GetBestBlock(ctx context.Context,
+	in *GetBestBlockRequest) (*GetBestBlockResponse, error) {
+
+	blockHash, blockHeight, err := s.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetBestBlockResponse{
+		BlockHash:   blockHash[:],
+		BlockHeight: blockHeight,
+	}, nil
+}
+
+// GetBlockHash returns the hash of the block in the best chain at the given
+// height.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierService interface.
+func (s *Server) GetBlockHash(ctx context.Context,
+	in *GetBlockHashRequest) (*GetBlockHashResponse, error) {
+
+	if in.BlockHeight < 0 {
+		return nil, errors.New("block height must be non-negative")
+	}
+
+	blockHash, err := s.cfg.Chain.GetBlockHash(in.BlockHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GetBlockHashResponse{
+		BlockHash: blockHash[:],
+	}, nil
+}
+
+// GetBlockHeader returns the header of the block in the main chain with the
+// given hash.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierService interface.
+func (s *Server) GetBlockHeader(ctx context.Context,
+	in *GetBlockHeaderRequest) (*GetBlockHeaderResponse, error) {
+
+	blockHash, err := parseBlockHash(in.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHeader, err := s.cfg.Chain.GetBlockHeader(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var header bytes.Buffer
+	if err := blockHeader.Serialize(&header); err != nil {
+		return nil, err
+	}
+
+	return &GetBlockHeaderResponse{
+		RawBlockHeader: header.Bytes(),
+	}, nil
+}
+
+// GetBlock returns the block in the main chain with the given hash.
+//
+// NOTE: This is part of the chainrpc.ChainNotifierService interface.
+func (s *Server) GetBlock(ctx context.Context,
+	in *GetBlockRequest) (*GetBlockResponse, error) {
+
+	blockHash, err := parseBlockHash(in.BlockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := s.cfg.Chain.GetBlock(blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	var rawBlock bytes.Buffer
+	if err := block.Serialize(&rawBlock); err != nil {
+		return nil, err
+	}
+
+	return &GetBlockResponse{
+		RawBlock: rawBlock.Bytes(),
+	}, nil
+}
+
+// parseBlockHash parses the raw block hash of a request.
+func parseBlockHash(rawHash []byte) (*chainhash.Hash, error) {
+	blockHash, err := chainhash.NewHash(rawHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block hash: %v", err)
+	}
+
+	return blockHash, nil
+}
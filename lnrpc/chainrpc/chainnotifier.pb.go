@@ -23,6 +23,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type RegistrationType int32
+
+const (
+	// A registration for the confirmation of a transaction/output script.
+	RegistrationType_CONF RegistrationType = 0
+	// A registration for the spend of an outpoint/output script.
+	RegistrationType_SPEND RegistrationType = 1
+)
+
+var RegistrationType_name = map[int32]string{
+	0: "CONF",
+	1: "SPEND",
+}
+var RegistrationType_value = map[string]int32{
+	"CONF":  0,
+	"SPEND": 1,
+}
+
+func (x RegistrationType) String() string {
+	return proto.EnumName(RegistrationType_name, int32(x))
+}
+func (RegistrationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{0}
+}
+
 type ConfRequest struct {
 	//
 	// The transaction hash for which we should request a confirmation notification
@@ -53,7 +78,7 @@ func (m *ConfRequest) Reset()         { *m = ConfRequest{} }
 func (m *ConfRequest) String() string { return proto.CompactTextString(m) }
 func (*ConfRequest) ProtoMessage()    {}
 func (*ConfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{0}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{0}
 }
 func (m *ConfRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfRequest.Unmarshal(m, b)
@@ -119,7 +144,7 @@ func (m *ConfDetails) Reset()         { *m = ConfDetails{} }
 func (m *ConfDetails) String() string { return proto.CompactTextString(m) }
 func (*ConfDetails) ProtoMessage()    {}
 func (*ConfDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{1}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{1}
 }
 func (m *ConfDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfDetails.Unmarshal(m, b)
@@ -177,7 +202,7 @@ func (m *Reorg) Reset()         { *m = Reorg{} }
 func (m *Reorg) String() string { return proto.CompactTextString(m) }
 func (*Reorg) ProtoMessage()    {}
 func (*Reorg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{2}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{2}
 }
 func (m *Reorg) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Reorg.Unmarshal(m, b)
@@ -211,7 +236,7 @@ func (m *ConfEvent) Reset()         { *m = ConfEvent{} }
 func (m *ConfEvent) String() string { return proto.CompactTextString(m) }
 func (*ConfEvent) ProtoMessage()    {}
 func (*ConfEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{3}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{3}
 }
 func (m *ConfEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfEvent.Unmarshal(m, b)
@@ -356,7 +381,7 @@ func (m *Outpoint) Reset()         { *m = Outpoint{} }
 func (m *Outpoint) String() string { return proto.CompactTextString(m) }
 func (*Outpoint) ProtoMessage()    {}
 func (*Outpoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{4}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{4}
 }
 func (m *Outpoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Outpoint.Unmarshal(m, b)
@@ -415,7 +440,7 @@ func (m *SpendRequest) Reset()         { *m = SpendRequest{} }
 func (m *SpendRequest) String() string { return proto.CompactTextString(m) }
 func (*SpendRequest) ProtoMessage()    {}
 func (*SpendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{5}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{5}
 }
 func (m *SpendRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SpendRequest.Unmarshal(m, b)
@@ -476,7 +501,7 @@ func (m *SpendDetails) Reset()         { *m = SpendDetails{} }
 func (m *SpendDetails) String() string { return proto.CompactTextString(m) }
 func (*SpendDetails) ProtoMessage()    {}
 func (*SpendDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{6}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{6}
 }
 func (m *SpendDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SpendDetails.Unmarshal(m, b)
@@ -545,7 +570,7 @@ func (m *SpendEvent) Reset()         { *m = SpendEvent{} }
 func (m *SpendEvent) String() string { return proto.CompactTextString(m) }
 func (*SpendEvent) ProtoMessage()    {}
 func (*SpendEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{7}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{7}
 }
 func (m *SpendEvent) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SpendEvent.Unmarshal(m, b)
@@ -690,7 +715,7 @@ func (m *BlockEpoch) Reset()         { *m = BlockEpoch{} }
 func (m *BlockEpoch) String() string { return proto.CompactTextString(m) }
 func (*BlockEpoch) ProtoMessage()    {}
 func (*BlockEpoch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_chainnotifier_7f5a7ad17988064c, []int{8}
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{8}
 }
 func (m *BlockEpoch) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BlockEpoch.Unmarshal(m, b)
@@ -724,6 +749,493 @@ func (m *BlockEpoch) GetHeight() uint32 {
 	return 0
 }
 
+type ListRegistrationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListRegistrationsRequest) Reset()         { *m = ListRegistrationsRequest{} }
+func (m *ListRegistrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListRegistrationsRequest) ProtoMessage()    {}
+func (*ListRegistrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{9}
+}
+func (m *ListRegistrationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRegistrationsRequest.Unmarshal(m, b)
+}
+func (m *ListRegistrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRegistrationsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListRegistrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRegistrationsRequest.Merge(dst, src)
+}
+func (m *ListRegistrationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListRegistrationsRequest.Size(m)
+}
+func (m *ListRegistrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRegistrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRegistrationsRequest proto.InternalMessageInfo
+
+type Registration struct {
+	// The name of the subsystem that made the registration.
+	Client string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	// The type of the registration.
+	Type RegistrationType `protobuf:"varint,2,opt,name=type,proto3,enum=chainrpc.RegistrationType" json:"type,omitempty"`
+	// The txid/outpoint or output script the registration is for.
+	Request string `protobuf:"bytes,3,opt,name=request,proto3" json:"request,omitempty"`
+	// The number of confirmations requested, for confirmation registrations.
+	NumConfs uint32 `protobuf:"varint,4,opt,name=num_confs,json=numConfs,proto3" json:"num_confs,omitempty"`
+	// The height hint the registration was made with.
+	HeightHint uint32 `protobuf:"varint,5,opt,name=height_hint,json=heightHint,proto3" json:"height_hint,omitempty"`
+	// The unix timestamp at which the registration was first made.
+	RegisteredAt int64 `protobuf:"varint,6,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`
+	//
+	// The number of times the subsystem registered for the same notification,
+	// all of which share this registration.
+	NumSubscribers uint32 `protobuf:"varint,7,opt,name=num_subscribers,json=numSubscribers,proto3" json:"num_subscribers,omitempty"`
+	//
+	// Whether the confirmation/spend has been dispatched and hasn't been reorged
+	// out of the chain since.
+	Dispatched           bool     `protobuf:"varint,8,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Registration) Reset()         { *m = Registration{} }
+func (m *Registration) String() string { return proto.CompactTextString(m) }
+func (*Registration) ProtoMessage()    {}
+func (*Registration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{10}
+}
+func (m *Registration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Registration.Unmarshal(m, b)
+}
+func (m *Registration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Registration.Marshal(b, m, deterministic)
+}
+func (dst *Registration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Registration.Merge(dst, src)
+}
+func (m *Registration) XXX_Size() int {
+	return xxx_messageInfo_Registration.Size(m)
+}
+func (m *Registration) XXX_DiscardUnknown() {
+	xxx_messageInfo_Registration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Registration proto.InternalMessageInfo
+
+func (m *Registration) GetClient() string {
+	if m != nil {
+		return m.Client
+	}
+	return ""
+}
+
+func (m *Registration) GetType() RegistrationType {
+	if m != nil {
+		return m.Type
+	}
+	return RegistrationType_CONF
+}
+
+func (m *Registration) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *Registration) GetNumConfs() uint32 {
+	if m != nil {
+		return m.NumConfs
+	}
+	return 0
+}
+
+func (m *Registration) GetHeightHint() uint32 {
+	if m != nil {
+		return m.HeightHint
+	}
+	return 0
+}
+
+func (m *Registration) GetRegisteredAt() int64 {
+	if m != nil {
+		return m.RegisteredAt
+	}
+	return 0
+}
+
+func (m *Registration) GetNumSubscribers() uint32 {
+	if m != nil {
+		return m.NumSubscribers
+	}
+	return 0
+}
+
+func (m *Registration) GetDispatched() bool {
+	if m != nil {
+		return m.Dispatched
+	}
+	return false
+}
+
+type ListRegistrationsResponse struct {
+	// The live registrations, sorted by subsystem and registration time.
+	Registrations        []*Registration `protobuf:"bytes,1,rep,name=registrations,proto3" json:"registrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListRegistrationsResponse) Reset()         { *m = ListRegistrationsResponse{} }
+func (m *ListRegistrationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListRegistrationsResponse) ProtoMessage()    {}
+func (*ListRegistrationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{11}
+}
+func (m *ListRegistrationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListRegistrationsResponse.Unmarshal(m, b)
+}
+func (m *ListRegistrationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListRegistrationsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListRegistrationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListRegistrationsResponse.Merge(dst, src)
+}
+func (m *ListRegistrationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListRegistrationsResponse.Size(m)
+}
+func (m *ListRegistrationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListRegistrationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListRegistrationsResponse proto.InternalMessageInfo
+
+func (m *ListRegistrationsResponse) GetRegistrations() []*Registration {
+	if m != nil {
+		return m.Registrations
+	}
+	return nil
+}
+
+type GetBestBlockRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBestBlockRequest) Reset()         { *m = GetBestBlockRequest{} }
+func (m *GetBestBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBestBlockRequest) ProtoMessage()    {}
+func (*GetBestBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{12}
+}
+func (m *GetBestBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBestBlockRequest.Unmarshal(m, b)
+}
+func (m *GetBestBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBestBlockRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBestBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBestBlockRequest.Merge(dst, src)
+}
+func (m *GetBestBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBestBlockRequest.Size(m)
+}
+func (m *GetBestBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBestBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBestBlockRequest proto.InternalMessageInfo
+
+type GetBestBlockResponse struct {
+	// The hash of the best block.
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// The height of the best block.
+	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBestBlockResponse) Reset()         { *m = GetBestBlockResponse{} }
+func (m *GetBestBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBestBlockResponse) ProtoMessage()    {}
+func (*GetBestBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{13}
+}
+func (m *GetBestBlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBestBlockResponse.Unmarshal(m, b)
+}
+func (m *GetBestBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBestBlockResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBestBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBestBlockResponse.Merge(dst, src)
+}
+func (m *GetBestBlockResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBestBlockResponse.Size(m)
+}
+func (m *GetBestBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBestBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBestBlockResponse proto.InternalMessageInfo
+
+func (m *GetBestBlockResponse) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+func (m *GetBestBlockResponse) GetBlockHeight() int32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type GetBlockHashRequest struct {
+	// The height of the block in the best chain.
+	BlockHeight          int64    `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHashRequest) Reset()         { *m = GetBlockHashRequest{} }
+func (m *GetBlockHashRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHashRequest) ProtoMessage()    {}
+func (*GetBlockHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{14}
+}
+func (m *GetBlockHashRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHashRequest.Unmarshal(m, b)
+}
+func (m *GetBlockHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHashRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBlockHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHashRequest.Merge(dst, src)
+}
+func (m *GetBlockHashRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHashRequest.Size(m)
+}
+func (m *GetBlockHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHashRequest proto.InternalMessageInfo
+
+func (m *GetBlockHashRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+type GetBlockHashResponse struct {
+	// The hash of the block at the requested height.
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHashResponse) Reset()         { *m = GetBlockHashResponse{} }
+func (m *GetBlockHashResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHashResponse) ProtoMessage()    {}
+func (*GetBlockHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{15}
+}
+func (m *GetBlockHashResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHashResponse.Unmarshal(m, b)
+}
+func (m *GetBlockHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHashResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBlockHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHashResponse.Merge(dst, src)
+}
+func (m *GetBlockHashResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHashResponse.Size(m)
+}
+func (m *GetBlockHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHashResponse proto.InternalMessageInfo
+
+func (m *GetBlockHashResponse) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type GetBlockHeaderRequest struct {
+	// The hash of the block in the main chain.
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHeaderRequest) Reset()         { *m = GetBlockHeaderRequest{} }
+func (m *GetBlockHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHeaderRequest) ProtoMessage()    {}
+func (*GetBlockHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{16}
+}
+func (m *GetBlockHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHeaderRequest.Unmarshal(m, b)
+}
+func (m *GetBlockHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHeaderRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBlockHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHeaderRequest.Merge(dst, src)
+}
+func (m *GetBlockHeaderRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHeaderRequest.Size(m)
+}
+func (m *GetBlockHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHeaderRequest proto.InternalMessageInfo
+
+func (m *GetBlockHeaderRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type GetBlockHeaderResponse struct {
+	// The serialized header of the requested block.
+	RawBlockHeader       []byte   `protobuf:"bytes,1,opt,name=raw_block_header,json=rawBlockHeader,proto3" json:"raw_block_header,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockHeaderResponse) Reset()         { *m = GetBlockHeaderResponse{} }
+func (m *GetBlockHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockHeaderResponse) ProtoMessage()    {}
+func (*GetBlockHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{17}
+}
+func (m *GetBlockHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockHeaderResponse.Unmarshal(m, b)
+}
+func (m *GetBlockHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockHeaderResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBlockHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockHeaderResponse.Merge(dst, src)
+}
+func (m *GetBlockHeaderResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockHeaderResponse.Size(m)
+}
+func (m *GetBlockHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockHeaderResponse proto.InternalMessageInfo
+
+func (m *GetBlockHeaderResponse) GetRawBlockHeader() []byte {
+	if m != nil {
+		return m.RawBlockHeader
+	}
+	return nil
+}
+
+type GetBlockRequest struct {
+	// The hash of the block in the main chain.
+	BlockHash            []byte   `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
+func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRequest) ProtoMessage()    {}
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{18}
+}
+func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockRequest.Unmarshal(m, b)
+}
+func (m *GetBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRequest.Merge(dst, src)
+}
+func (m *GetBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockRequest.Size(m)
+}
+func (m *GetBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockRequest proto.InternalMessageInfo
+
+func (m *GetBlockRequest) GetBlockHash() []byte {
+	if m != nil {
+		return m.BlockHash
+	}
+	return nil
+}
+
+type GetBlockResponse struct {
+	// The serialized requested block.
+	RawBlock             []byte   `protobuf:"bytes,1,opt,name=raw_block,json=rawBlock,proto3" json:"raw_block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetBlockResponse) Reset()         { *m = GetBlockResponse{} }
+func (m *GetBlockResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBlockResponse) ProtoMessage()    {}
+func (*GetBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_chainnotifier_55283e9f0f34167d, []int{19}
+}
+func (m *GetBlockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockResponse.Unmarshal(m, b)
+}
+func (m *GetBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockResponse.Merge(dst, src)
+}
+func (m *GetBlockResponse) XXX_Size() int {
+	return xxx_messageInfo_GetBlockResponse.Size(m)
+}
+func (m *GetBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockResponse proto.InternalMessageInfo
+
+func (m *GetBlockResponse) GetRawBlock() []byte {
+	if m != nil {
+		return m.RawBlock
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ConfRequest)(nil), "chainrpc.ConfRequest")
 	proto.RegisterType((*ConfDetails)(nil), "chainrpc.ConfDetails")
@@ -734,6 +1246,18 @@ func init() {
 	proto.RegisterType((*SpendDetails)(nil), "chainrpc.SpendDetails")
 	proto.RegisterType((*SpendEvent)(nil), "chainrpc.SpendEvent")
 	proto.RegisterType((*BlockEpoch)(nil), "chainrpc.BlockEpoch")
+	proto.RegisterType((*ListRegistrationsRequest)(nil), "chainrpc.ListRegistrationsRequest")
+	proto.RegisterType((*Registration)(nil), "chainrpc.Registration")
+	proto.RegisterType((*ListRegistrationsResponse)(nil), "chainrpc.ListRegistrationsResponse")
+	proto.RegisterType((*GetBestBlockRequest)(nil), "chainrpc.GetBestBlockRequest")
+	proto.RegisterType((*GetBestBlockResponse)(nil), "chainrpc.GetBestBlockResponse")
+	proto.RegisterType((*GetBlockHashRequest)(nil), "chainrpc.GetBlockHashRequest")
+	proto.RegisterType((*GetBlockHashResponse)(nil), "chainrpc.GetBlockHashResponse")
+	proto.RegisterType((*GetBlockHeaderRequest)(nil), "chainrpc.GetBlockHeaderRequest")
+	proto.RegisterType((*GetBlockHeaderResponse)(nil), "chainrpc.GetBlockHeaderResponse")
+	proto.RegisterType((*GetBlockRequest)(nil), "chainrpc.GetBlockRequest")
+	proto.RegisterType((*GetBlockResponse)(nil), "chainrpc.GetBlockResponse")
+	proto.RegisterEnum("chainrpc.RegistrationType", RegistrationType_name, RegistrationType_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -776,6 +1300,27 @@ type ChainNotifierClient interface {
 	// point. This allows clients to be idempotent by ensuring that they do not
 	// missing processing a single block within the chain.
 	RegisterBlockEpochNtfn(ctx context.Context, in *BlockEpoch, opts ...grpc.CallOption) (ChainNotifier_RegisterBlockEpochNtfnClient, error)
+	//
+	// ListRegistrations returns the live confirmation and spend registrations of
+	// each subsystem of lnd, including the ones made through this sub-server,
+	// which allows debugging missed notifications. Identical registrations of a
+	// subsystem share a single registration with the chain backend.
+	ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*ListRegistrationsResponse, error)
+	//
+	// GetBestBlock returns the hash and height of the best block known to the
+	// chain backend of lnd.
+	GetBestBlock(ctx context.Context, in *GetBestBlockRequest, opts ...grpc.CallOption) (*GetBestBlockResponse, error)
+	//
+	// GetBlockHash returns the hash of the block in the best chain at the given
+	// height.
+	GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error)
+	//
+	// GetBlockHeader returns the header of the block in the main chain with the
+	// given hash.
+	GetBlockHeader(ctx context.Context, in *GetBlockHeaderRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error)
+	//
+	// GetBlock returns the block in the main chain with the given hash.
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
 }
 
 type chainNotifierClient struct {
@@ -882,6 +1427,51 @@ func (x *chainNotifierRegisterBlockEpochNtfnClient) Recv() (*BlockEpoch, error)
 	return m, nil
 }
 
+func (c *chainNotifierClient) ListRegistrations(ctx context.Context, in *ListRegistrationsRequest, opts ...grpc.CallOption) (*ListRegistrationsResponse, error) {
+	out := new(ListRegistrationsResponse)
+	err := c.cc.Invoke(ctx, "/chainrpc.ChainNotifier/ListRegistrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainNotifierClient) GetBestBlock(ctx context.Context, in *GetBestBlockRequest, opts ...grpc.CallOption) (*GetBestBlockResponse, error) {
+	out := new(GetBestBlockResponse)
+	err := c.cc.Invoke(ctx, "/chainrpc.ChainNotifier/GetBestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainNotifierClient) GetBlockHash(ctx context.Context, in *GetBlockHashRequest, opts ...grpc.CallOption) (*GetBlockHashResponse, error) {
+	out := new(GetBlockHashResponse)
+	err := c.cc.Invoke(ctx, "/chainrpc.ChainNotifier/GetBlockHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainNotifierClient) GetBlockHeader(ctx context.Context, in *GetBlockHeaderRequest, opts ...grpc.CallOption) (*GetBlockHeaderResponse, error) {
+	out := new(GetBlockHeaderResponse)
+	err := c.cc.Invoke(ctx, "/chainrpc.ChainNotifier/GetBlockHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *chainNotifierClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error) {
+	out := new(GetBlockResponse)
+	err := c.cc.Invoke(ctx, "/chainrpc.ChainNotifier/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ChainNotifierServer is the server API for ChainNotifier service.
 type ChainNotifierServer interface {
 	//
@@ -912,6 +1502,27 @@ type ChainNotifierServer interface {
 	// point. This allows clients to be idempotent by ensuring that they do not
 	// missing processing a single block within the chain.
 	RegisterBlockEpochNtfn(*BlockEpoch, ChainNotifier_RegisterBlockEpochNtfnServer) error
+	//
+	// ListRegistrations returns the live confirmation and spend registrations of
+	// each subsystem of lnd, including the ones made through this sub-server,
+	// which allows debugging missed notifications. Identical registrations of a
+	// subsystem share a single registration with the chain backend.
+	ListRegistrations(context.Context, *ListRegistrationsRequest) (*ListRegistrationsResponse, error)
+	//
+	// GetBestBlock returns the hash and height of the best block known to the
+	// chain backend of lnd.
+	GetBestBlock(context.Context, *GetBestBlockRequest) (*GetBestBlockResponse, error)
+	//
+	// GetBlockHash returns the hash of the block in the best chain at the given
+	// height.
+	GetBlockHash(context.Context, *GetBlockHashRequest) (*GetBlockHashResponse, error)
+	//
+	// GetBlockHeader returns the header of the block in the main chain with the
+	// given hash.
+	GetBlockHeader(context.Context, *GetBlockHeaderRequest) (*GetBlockHeaderResponse, error)
+	//
+	// GetBlock returns the block in the main chain with the given hash.
+	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
 }
 
 func RegisterChainNotifierServer(s *grpc.Server, srv ChainNotifierServer) {
@@ -981,10 +1592,121 @@ func (x *chainNotifierRegisterBlockEpochNtfnServer) Send(m *BlockEpoch) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _ChainNotifier_ListRegistrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegistrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainNotifierServer).ListRegistrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainrpc.ChainNotifier/ListRegistrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainNotifierServer).ListRegistrations(ctx, req.(*ListRegistrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainNotifier_GetBestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBestBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainNotifierServer).GetBestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainrpc.ChainNotifier/GetBestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainNotifierServer).GetBestBlock(ctx, req.(*GetBestBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainNotifier_GetBlockHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainNotifierServer).GetBlockHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainrpc.ChainNotifier/GetBlockHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainNotifierServer).GetBlockHash(ctx, req.(*GetBlockHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainNotifier_GetBlockHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainNotifierServer).GetBlockHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainrpc.ChainNotifier/GetBlockHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainNotifierServer).GetBlockHeader(ctx, req.(*GetBlockHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChainNotifier_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChainNotifierServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chainrpc.ChainNotifier/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChainNotifierServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ChainNotifier_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chainrpc.ChainNotifier",
 	HandlerType: (*ChainNotifierServer)(nil),
-	Methods:     []grpc.MethodDesc{},
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRegistrations",
+			Handler:    _ChainNotifier_ListRegistrations_Handler,
+		},
+		{
+			MethodName: "GetBestBlock",
+			Handler:    _ChainNotifier_GetBestBlock_Handler,
+		},
+		{
+			MethodName: "GetBlockHash",
+			Handler:    _ChainNotifier_GetBlockHash_Handler,
+		},
+		{
+			MethodName: "GetBlockHeader",
+			Handler:    _ChainNotifier_GetBlockHeader_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _ChainNotifier_GetBlock_Handler,
+		},
+	},
 	Streams: []grpc.StreamDesc{
 		{
 			StreamName:    "RegisterConfirmationsNtfn",
@@ -1006,45 +1728,69 @@ var _ChainNotifier_serviceDesc = grpc.ServiceDesc{
 }
 
 func init() {
-	proto.RegisterFile("chainrpc/chainnotifier.proto", fileDescriptor_chainnotifier_7f5a7ad17988064c)
-}
-
-var fileDescriptor_chainnotifier_7f5a7ad17988064c = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0x13, 0x3f,
-	0x10, 0xed, 0xb6, 0xdd, 0xfc, 0x99, 0x24, 0xbf, 0xb4, 0xfe, 0xa5, 0x51, 0x5a, 0x40, 0x94, 0x3d,
-	0xd0, 0x48, 0x48, 0x21, 0x0a, 0x1c, 0xb8, 0x21, 0x35, 0x14, 0x25, 0x97, 0x22, 0x6d, 0x7b, 0x5f,
-	0x6d, 0x37, 0x4e, 0xd6, 0xd0, 0xd8, 0x8b, 0xed, 0x90, 0xbd, 0xf2, 0x69, 0xf9, 0x12, 0x1c, 0x90,
-	0x67, 0xed, 0x4d, 0x1a, 0x8a, 0x84, 0xb8, 0x79, 0x66, 0xde, 0x3e, 0xbf, 0xf1, 0x7b, 0x09, 0x3c,
-	0x4d, 0xd2, 0x98, 0x71, 0x99, 0x25, 0xaf, 0xf1, 0xc0, 0x85, 0x66, 0x73, 0x46, 0xe5, 0x20, 0x93,
-	0x42, 0x0b, 0x52, 0x73, 0xd3, 0x60, 0x0d, 0x8d, 0xb1, 0xe0, 0xf3, 0x90, 0x7e, 0x5d, 0x51, 0xa5,
-	0x09, 0x81, 0x43, 0x9d, 0xb3, 0x59, 0xcf, 0x3b, 0xf7, 0xfa, 0xcd, 0x10, 0xcf, 0xa4, 0x0b, 0x15,
-	0x95, 0x48, 0x96, 0xe9, 0xde, 0x3e, 0x76, 0x6d, 0x45, 0x9e, 0x40, 0x9d, 0xaf, 0x96, 0x51, 0x22,
-	0xf8, 0x5c, 0xf5, 0x0e, 0xce, 0xbd, 0x7e, 0x2b, 0xac, 0xf1, 0xd5, 0xd2, 0xd0, 0x29, 0xf2, 0x1c,
-	0x1a, 0x29, 0x65, 0x8b, 0x54, 0x47, 0x29, 0xe3, 0xba, 0x77, 0x88, 0x63, 0x28, 0x5a, 0x13, 0xc6,
-	0x75, 0xf0, 0xdd, 0x2b, 0x6e, 0xfe, 0x40, 0x75, 0xcc, 0xee, 0x15, 0x39, 0x81, 0x8a, 0x8c, 0xd7,
-	0x91, 0xce, 0xed, 0xdd, 0xbe, 0x8c, 0xd7, 0xb7, 0x39, 0x79, 0x06, 0x70, 0x77, 0x2f, 0x92, 0x2f,
-	0x51, 0x1a, 0xab, 0xd4, 0x0a, 0xa8, 0x63, 0x67, 0x12, 0xab, 0x94, 0xbc, 0x80, 0xa6, 0x1d, 0x23,
-	0xb3, 0x95, 0xd1, 0x28, 0x00, 0xd8, 0x22, 0xa7, 0x50, 0xd3, 0x79, 0xc4, 0xf8, 0x8c, 0xe6, 0x56,
-	0x46, 0x55, 0xe7, 0x53, 0x53, 0x06, 0x55, 0xf0, 0x43, 0x2a, 0xe4, 0x22, 0xf8, 0x0c, 0x75, 0xa3,
-	0xe5, 0xea, 0x1b, 0xe5, 0x9a, 0xbc, 0x82, 0x43, 0xb3, 0x13, 0xea, 0x68, 0x8c, 0x4e, 0x06, 0xee,
-	0xad, 0x06, 0x5b, 0x72, 0x27, 0x7b, 0x21, 0x82, 0xc8, 0x05, 0xf8, 0xd2, 0x50, 0xa0, 0xb4, 0xc6,
-	0xa8, 0xbd, 0x41, 0x23, 0xf3, 0x64, 0x2f, 0x2c, 0xe6, 0x97, 0x55, 0xf0, 0xa9, 0xa1, 0x0f, 0xde,
-	0x42, 0xed, 0xd3, 0x4a, 0x67, 0x82, 0x71, 0x7c, 0x6e, 0xdc, 0xcb, 0x3e, 0xb7, 0x39, 0x93, 0x0e,
-	0xf8, 0x85, 0xd8, 0x7d, 0x14, 0x5b, 0x14, 0xc1, 0x1a, 0x9a, 0x37, 0x19, 0xe5, 0x33, 0x67, 0xd4,
-	0x00, 0x6a, 0xc2, 0xb2, 0x58, 0xa1, 0x64, 0x73, 0xb5, 0xe3, 0x0f, 0x4b, 0xcc, 0x1f, 0x4d, 0xdc,
-	0xf1, 0xe9, 0xe0, 0x37, 0x9f, 0x7e, 0x7a, 0xf6, 0x66, 0x67, 0xd4, 0x7b, 0x38, 0x56, 0xa6, 0x66,
-	0x7c, 0x11, 0xfd, 0x85, 0x84, 0x23, 0x07, 0x2e, 0x97, 0x7e, 0x09, 0x6d, 0xe3, 0x74, 0x49, 0xa2,
-	0x73, 0xab, 0xa9, 0x25, 0xe3, 0xf5, 0x8d, 0xed, 0xde, 0xe6, 0xa4, 0x0f, 0x47, 0x5b, 0x98, 0x22,
-	0x00, 0x07, 0x08, 0xfc, 0x4f, 0x95, 0x28, 0x4c, 0xc1, 0x10, 0x3a, 0x25, 0x92, 0xf1, 0x6c, 0xa5,
-	0x1f, 0xd8, 0x4d, 0xdc, 0x6c, 0x6a, 0x46, 0xe8, 0x3c, 0xb9, 0x80, 0x76, 0xf9, 0x85, 0x8d, 0x8e,
-	0x8f, 0xe0, 0x92, 0xba, 0x48, 0x4f, 0xc0, 0x01, 0x50, 0x52, 0x11, 0x8d, 0x01, 0xf8, 0x38, 0xb7,
-	0xfb, 0x76, 0x37, 0xfb, 0x6e, 0x3f, 0x91, 0x31, 0x1d, 0x61, 0xff, 0x90, 0x8e, 0x77, 0x00, 0x97,
-	0x26, 0xbc, 0x57, 0x99, 0x48, 0xd2, 0x47, 0xf3, 0xd1, 0x85, 0x8a, 0x55, 0x5c, 0x04, 0xc4, 0x56,
-	0xa3, 0x1f, 0x1e, 0xb4, 0xc6, 0x86, 0xfe, 0xda, 0xfe, 0xd6, 0xc9, 0x14, 0x4e, 0x43, 0xba, 0x60,
-	0x4a, 0x53, 0x69, 0xa2, 0xcb, 0xe4, 0x32, 0xd6, 0x4c, 0x70, 0x75, 0xad, 0xe7, 0x9c, 0xec, 0xe4,
-	0xda, 0xe6, 0xea, 0xec, 0xff, 0x87, 0x6d, 0x5c, 0x7b, 0xe8, 0x91, 0x31, 0x1c, 0x3b, 0x2a, 0xdc,
-	0x14, 0x29, 0x76, 0xd7, 0x77, 0x1c, 0x9d, 0x9d, 0xbe, 0x23, 0xf9, 0x08, 0x5d, 0x47, 0xb2, 0xd9,
-	0x11, 0x99, 0xb6, 0xbe, 0xd8, 0x4c, 0xce, 0x1e, 0xed, 0x0e, 0xbd, 0xbb, 0x0a, 0xfe, 0x89, 0xbd,
-	0xf9, 0x15, 0x00, 0x00, 0xff, 0xff, 0x65, 0xe6, 0xc2, 0xe4, 0xe4, 0x04, 0x00, 0x00,
+	proto.RegisterFile("chainrpc/chainnotifier.proto", fileDescriptor_chainnotifier_55283e9f0f34167d)
+}
+
+var fileDescriptor_chainnotifier_55283e9f0f34167d = []byte{
+	// 951 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5b, 0x4f, 0x1b, 0x47,
+	0x14, 0x66, 0xb1, 0xd7, 0x5e, 0x1f, 0x1b, 0x30, 0x13, 0xb0, 0x16, 0xa7, 0x49, 0xdc, 0x8d, 0x54,
+	0xac, 0x56, 0x72, 0x10, 0xbd, 0x28, 0x0f, 0x95, 0xaa, 0x40, 0x48, 0x41, 0x6a, 0x49, 0xb5, 0xe6,
+	0xa1, 0x95, 0x2a, 0x59, 0x8b, 0x3d, 0xb0, 0xd3, 0xc2, 0xec, 0x76, 0x66, 0x5c, 0x3b, 0x8f, 0xfd,
+	0x1f, 0xfd, 0xa7, 0xed, 0x43, 0x35, 0xb7, 0xbd, 0x61, 0x02, 0xea, 0x9b, 0xe7, 0x5c, 0xbe, 0xf3,
+	0xed, 0x7c, 0xdf, 0x1c, 0x80, 0x4f, 0xa6, 0x71, 0x44, 0x28, 0x4b, 0xa7, 0xaf, 0xd4, 0x0f, 0x9a,
+	0x08, 0x72, 0x45, 0x30, 0x1b, 0xa5, 0x2c, 0x11, 0x09, 0xf2, 0x6c, 0x36, 0x58, 0x40, 0xfb, 0x38,
+	0xa1, 0x57, 0x21, 0xfe, 0x63, 0x8e, 0xb9, 0x40, 0x08, 0xea, 0x62, 0x49, 0x66, 0xbe, 0x33, 0x70,
+	0x86, 0x9d, 0x50, 0xfd, 0x46, 0x3d, 0x68, 0xf0, 0x29, 0x23, 0xa9, 0xf0, 0xd7, 0x55, 0xd4, 0x9c,
+	0xd0, 0x53, 0x68, 0xd1, 0xf9, 0xed, 0x64, 0x9a, 0xd0, 0x2b, 0xee, 0xd7, 0x06, 0xce, 0x70, 0x23,
+	0xf4, 0xe8, 0xfc, 0x56, 0xc2, 0x71, 0xf4, 0x02, 0xda, 0x31, 0x26, 0xd7, 0xb1, 0x98, 0xc4, 0x84,
+	0x0a, 0xbf, 0xae, 0xd2, 0xa0, 0x43, 0xa7, 0x84, 0x8a, 0xe0, 0x2f, 0x47, 0x4f, 0x7e, 0x8b, 0x45,
+	0x44, 0x6e, 0x38, 0xda, 0x85, 0x06, 0x8b, 0x16, 0x13, 0xb1, 0x34, 0xb3, 0x5d, 0x16, 0x2d, 0x2e,
+	0x96, 0xe8, 0x19, 0xc0, 0xe5, 0x4d, 0x32, 0xfd, 0x7d, 0x12, 0x47, 0x3c, 0x36, 0x04, 0x5a, 0x2a,
+	0x72, 0x1a, 0xf1, 0x18, 0x7d, 0x0a, 0x1d, 0x93, 0x56, 0xc8, 0x86, 0x46, 0x5b, 0x17, 0xa8, 0x10,
+	0xda, 0x03, 0x4f, 0x2c, 0x27, 0x84, 0xce, 0xf0, 0xd2, 0xd0, 0x68, 0x8a, 0xe5, 0x99, 0x3c, 0x06,
+	0x4d, 0x70, 0x43, 0x9c, 0xb0, 0xeb, 0xe0, 0x37, 0x68, 0x49, 0x2e, 0x27, 0x7f, 0x62, 0x2a, 0xd0,
+	0x17, 0x50, 0x97, 0xdf, 0xa4, 0x78, 0xb4, 0x0f, 0x77, 0x47, 0xf6, 0xae, 0x46, 0x05, 0xba, 0xa7,
+	0x6b, 0xa1, 0x2a, 0x42, 0xfb, 0xe0, 0x32, 0x09, 0xa1, 0xa8, 0xb5, 0x0f, 0xb7, 0xf2, 0x6a, 0x85,
+	0x7c, 0xba, 0x16, 0xea, 0xfc, 0x51, 0x13, 0x5c, 0x2c, 0xe1, 0x83, 0xaf, 0xc0, 0x7b, 0x3f, 0x17,
+	0x69, 0x42, 0xa8, 0xba, 0x6e, 0xf5, 0x5d, 0xe6, 0xba, 0xe5, 0x6f, 0xb4, 0x03, 0xae, 0x26, 0xbb,
+	0xae, 0xc8, 0xea, 0x43, 0xb0, 0x80, 0xce, 0x38, 0xc5, 0x74, 0x66, 0x85, 0x1a, 0x81, 0x97, 0x18,
+	0x14, 0x43, 0x14, 0xe5, 0xa3, 0x2d, 0x7e, 0x98, 0xd5, 0xdc, 0x2b, 0x62, 0x45, 0xa7, 0xda, 0x1d,
+	0x9d, 0xfe, 0x75, 0xcc, 0x64, 0x2b, 0xd4, 0x77, 0xb0, 0xcd, 0xe5, 0x99, 0xd0, 0xeb, 0xc9, 0x23,
+	0x28, 0x74, 0x6d, 0x71, 0xf6, 0xd1, 0x9f, 0xc1, 0x96, 0x54, 0x3a, 0x03, 0x11, 0x4b, 0xc3, 0x69,
+	0x83, 0x45, 0x8b, 0xb1, 0x89, 0x5e, 0x2c, 0xd1, 0x10, 0xba, 0x85, 0x1a, 0x6d, 0x80, 0x9a, 0x2a,
+	0xdc, 0xe4, 0x59, 0x95, 0x72, 0xc1, 0x01, 0xec, 0x64, 0x95, 0x84, 0xa6, 0x73, 0x51, 0x92, 0x1b,
+	0xd9, 0xdc, 0x99, 0x4c, 0x29, 0xe5, 0xd1, 0x3e, 0x6c, 0x65, 0x1d, 0xc6, 0x3a, 0xae, 0x2a, 0xce,
+	0xa0, 0xb5, 0x7b, 0x02, 0x0a, 0xa0, 0x28, 0x69, 0x6b, 0x8c, 0xc0, 0x55, 0x79, 0xf3, 0xbd, 0xbd,
+	0xfc, 0x7b, 0x8b, 0x57, 0x24, 0x45, 0x57, 0x65, 0xff, 0xc3, 0x1d, 0xaf, 0x01, 0x8e, 0xa4, 0x79,
+	0x4f, 0xd2, 0x64, 0x1a, 0xaf, 0xf4, 0x47, 0x0f, 0x1a, 0x86, 0xb1, 0x36, 0x88, 0x39, 0x05, 0x7d,
+	0xf0, 0x7f, 0x20, 0x5c, 0x84, 0xf8, 0x9a, 0x70, 0xc1, 0x22, 0x41, 0x12, 0xca, 0x8d, 0x5b, 0x82,
+	0xbf, 0xd7, 0xa1, 0x53, 0x4c, 0x48, 0x90, 0xe9, 0x0d, 0xc1, 0x46, 0xb9, 0x56, 0x68, 0x4e, 0x68,
+	0x04, 0x75, 0xf1, 0x21, 0xc5, 0x0a, 0x7a, 0xf3, 0xb0, 0x5f, 0xe4, 0x9b, 0x77, 0x5f, 0x7c, 0x48,
+	0x71, 0xa8, 0xea, 0x90, 0x0f, 0x4d, 0xa6, 0x67, 0x28, 0x69, 0x5a, 0xa1, 0x3d, 0x96, 0xb7, 0x43,
+	0xfd, 0xe3, 0xdb, 0xc1, 0xad, 0xba, 0x0e, 0xbd, 0x84, 0x0d, 0xa6, 0x26, 0x62, 0x86, 0x67, 0x93,
+	0x48, 0xf8, 0x8d, 0x81, 0x33, 0xac, 0x85, 0x9d, 0x3c, 0xf8, 0x46, 0x48, 0x11, 0xe5, 0x08, 0x3e,
+	0xbf, 0x94, 0x66, 0xbe, 0xc4, 0x8c, 0xfb, 0x4d, 0x2d, 0x22, 0x9d, 0xdf, 0x8e, 0xf3, 0x28, 0x7a,
+	0x0e, 0x30, 0x23, 0x3c, 0x8d, 0xc4, 0x34, 0xc6, 0x33, 0xdf, 0x1b, 0x38, 0x43, 0x2f, 0x2c, 0x44,
+	0x82, 0x5f, 0x60, 0x6f, 0xc5, 0xd5, 0xf1, 0x34, 0xa1, 0x1c, 0xa3, 0x6f, 0x2d, 0x15, 0x93, 0xf0,
+	0x9d, 0x41, 0xad, 0xac, 0x7d, 0xb1, 0x2f, 0x2c, 0x17, 0x07, 0xbb, 0xf0, 0xe4, 0x7b, 0x2c, 0x8e,
+	0x30, 0x17, 0x4a, 0x56, 0x2b, 0xc8, 0xcf, 0xb0, 0x53, 0x0e, 0x9b, 0x61, 0xe5, 0x75, 0xe7, 0x3c,
+	0xb4, 0xee, 0xa4, 0x4c, 0x6e, 0x69, 0xdd, 0x05, 0xaf, 0xf5, 0x40, 0xdb, 0x62, 0xf7, 0x45, 0xb5,
+	0xd3, 0x51, 0xf7, 0x59, 0xea, 0xfc, 0x5a, 0x73, 0xca, 0x3b, 0x1f, 0xc5, 0x29, 0xf8, 0x06, 0x76,
+	0xb3, 0x36, 0x1c, 0xcd, 0x30, 0xb3, 0x23, 0x1f, 0xe8, 0x3b, 0x82, 0x5e, 0xb5, 0xcf, 0x0c, 0x1c,
+	0x42, 0x57, 0x2e, 0x08, 0xcb, 0x57, 0xe6, 0x4c, 0xfb, 0x26, 0x8b, 0x16, 0x85, 0x8e, 0xe0, 0x00,
+	0xb6, 0x2c, 0xc6, 0x23, 0xa7, 0xbe, 0x82, 0x6e, 0xde, 0x61, 0xe6, 0x3d, 0x85, 0x56, 0x36, 0xcf,
+	0x74, 0x78, 0x76, 0xd0, 0xe7, 0xfb, 0xd0, 0xad, 0x7a, 0x1f, 0x79, 0x50, 0x3f, 0x7e, 0x7f, 0xfe,
+	0xae, 0xbb, 0x86, 0x5a, 0xe0, 0x8e, 0x7f, 0x3a, 0x39, 0x7f, 0xdb, 0x75, 0x0e, 0xff, 0xa9, 0xc3,
+	0xc6, 0xb1, 0xb4, 0xc4, 0xb9, 0xf9, 0x5b, 0x8b, 0xce, 0x60, 0x2f, 0x34, 0x7e, 0x95, 0xb6, 0x27,
+	0xec, 0x56, 0x9b, 0xe2, 0x5c, 0x5c, 0x51, 0x54, 0xf9, 0xbb, 0x62, 0xe8, 0xf7, 0x9f, 0x94, 0xc3,
+	0x6a, 0xed, 0x1c, 0x38, 0xe8, 0x18, 0xb6, 0x2d, 0x94, 0xda, 0x34, 0x0a, 0xa2, 0xba, 0x7e, 0x2c,
+	0xc6, 0x4e, 0x25, 0x6e, 0x41, 0xde, 0x41, 0xcf, 0x82, 0xe4, 0x3b, 0x46, 0x21, 0x15, 0x3a, 0xf2,
+	0x4c, 0x7f, 0x65, 0xf4, 0xc0, 0x41, 0xbf, 0xc2, 0xf6, 0x9d, 0xe7, 0x82, 0x82, 0xbc, 0xf8, 0xbe,
+	0x35, 0xd4, 0x7f, 0xf9, 0xd1, 0x1a, 0xa3, 0xc6, 0x8f, 0xd0, 0x29, 0x3e, 0x0d, 0xf4, 0x2c, 0x6f,
+	0x5a, 0xf1, 0x92, 0xfa, 0xcf, 0xef, 0x4b, 0x97, 0xe1, 0xb2, 0x27, 0x54, 0x81, 0xab, 0xbc, 0x93,
+	0x2a, 0xdc, 0x9d, 0xc7, 0x30, 0x86, 0xcd, 0xb2, 0x6b, 0xd1, 0x8b, 0x15, 0x1d, 0xc5, 0x77, 0xd0,
+	0x1f, 0xdc, 0x5f, 0x60, 0x40, 0xdf, 0x80, 0x67, 0x33, 0x68, 0xef, 0x6e, 0xb5, 0x05, 0xea, 0xaf,
+	0x4a, 0x69, 0x88, 0xcb, 0x86, 0xfa, 0xc7, 0xee, 0xcb, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x82,
+	0x6d, 0x3f, 0x77, 0xf8, 0x09, 0x00, 0x00,
 }
@@ -4,6 +4,7 @@ package chainrpc
 
 import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/macaroons"
 )
 
@@ -31,4 +32,14 @@ type Config struct {
 	// notifier RPC server. The job of the chain notifier RPC server is
 	// simply to proxy valid requests to the active chain notifier instance.
 	ChainNotifier chainntnfs.ChainNotifier
+
+	// RegistrationTracker keeps track of the live confirmation and spend
+	// registrations of each subsystem, including the ones made through
+	// the chain notifier RPC server.
+	RegistrationTracker *chainntnfs.RegistrationTracker
+
+	// Chain is the connection to the chain backend of lnd, through which
+	// the block related requests of the chain notifier RPC server are
+	// served.
+	Chain lnwallet.BlockChainIO
 }
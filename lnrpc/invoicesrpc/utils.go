@@ -66,6 +66,17 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 			invoice.Terms.State)
 	}
 
+	rpcHtlcs := make([]*lnrpc.InvoiceHTLC, 0, len(invoice.Htlcs))
+	for _, htlc := range invoice.Htlcs {
+		rpcHtlcs = append(rpcHtlcs, &lnrpc.InvoiceHTLC{
+			ChanId:       htlc.ChanID.ToUint64(),
+			HtlcIndex:    htlc.HtlcID,
+			AmtMsat:      uint64(htlc.Amt),
+			AcceptHeight: htlc.AcceptHeight,
+			ResolveTime:  htlc.ResolveTime.Unix(),
+		})
+	}
+
 	return &lnrpc.Invoice{
 		Memo:            string(invoice.Memo[:]),
 		Receipt:         invoice.Receipt[:],
@@ -88,6 +99,7 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		AmtPaidMsat:     int64(invoice.AmtPaid),
 		AmtPaid:         int64(invoice.AmtPaid),
 		State:           state,
+		Htlcs:           rpcHtlcs,
 	}, nil
 }
 
@@ -3,8 +3,15 @@
 package routerrpc
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/routing"
 )
 
@@ -19,6 +26,21 @@ type Config struct {
 	// directory, named DefaultRouterMacFilename.
 	RouterMacPath string `long:"routermacaroonpath" description:"Path to the router macaroon"`
 
+	// Estimator selects the estimator used to derive the success
+	// probability of payment attempts during path finding.
+	Estimator string `long:"estimator" description:"Probability estimator used in path finding, either apriori or bimodal"`
+
+	// AttemptCost is the virtual cost in satoshis that path finding
+	// assigns to a failed payment attempt, which determines how fees are
+	// traded off against the success probability of routes.
+	AttemptCost int64 `long:"attemptcost" description:"The virtual cost in sats of a failed payment attempt, used to trade off fees against success probability"`
+
+	// Apriori holds the parameters of the apriori estimator.
+	Apriori *AprioriConfig `group:"apriori" namespace:"apriori"`
+
+	// Bimodal holds the parameters of the bimodal estimator.
+	Bimodal *BimodalConfig `group:"bimodal" namespace:"bimodal"`
+
 	// NetworkDir is the main network directory wherein the router rpc
 	// server will find the macaroon named DefaultRouterMacFilename.
 	NetworkDir string
@@ -40,4 +62,89 @@ type Config struct {
 	//
 	// TODO(roasbeef): assumes router handles saving payment state
 	Router *routing.ChannelRouter
+
+	// HtlcSwitch is the switch whose forwarded HTLCs may be intercepted
+	// through the HtlcInterceptor RPC.
+	HtlcSwitch *htlcswitch.Switch
+
+	// ChanStatusMgr manages the disabled bit of our channels, which can
+	// be overridden through the UpdateChanStatus RPC.
+	ChanStatusMgr *netann.ChanStatusManager
+}
+
+// AprioriConfig holds the parameters of the apriori probability estimator.
+type AprioriConfig struct {
+	// HopProbability is the probability assumed for a pair of nodes we
+	// have no record of.
+	HopProbability float64 `long:"hopprob" description:"Assumed success probability of a hop in a route when no other information is available"`
+
+	// Weight is the weight of the apriori hop probability versus the
+	// results of a node's other channels.
+	Weight float64 `long:"weight" description:"Weight of the apriori hop probability versus the results of the node's other channels, between 0 and 1"`
+
+	// PenaltyHalfLife is the time after which the influence of a result
+	// has halved.
+	PenaltyHalfLife time.Duration `long:"penaltyhalflife" description:"Time after which the influence of a payment attempt result on the success probability of a hop has halved"`
+}
+
+// BimodalConfig holds the parameters of the bimodal probability estimator.
+type BimodalConfig struct {
+	// Scale is the amount in millisatoshis over which the liquidity of
+	// channels is assumed to be concentrated at either of their sides.
+	Scale int64 `long:"scale" description:"Amount in msat over which the liquidity of a channel is assumed to be concentrated at either of its sides"`
+
+	// DecayTime is the time after which the influence of a result has
+	// decayed to 1/e.
+	DecayTime time.Duration `long:"decaytime" description:"Time after which the influence of a payment attempt result on the success probability of a hop has decayed to 1/e"`
+}
+
+// DefaultConfig returns the default configuration of the router RPC server,
+// which carries the default parameters of mission control.
+func DefaultConfig() *Config {
+	defaultCfg := routing.DefaultMissionControlConfig()
+
+	return &Config{
+		Estimator:   defaultCfg.Estimator,
+		AttemptCost: int64(defaultCfg.AttemptCost.ToSatoshis()),
+		Apriori: &AprioriConfig{
+			HopProbability:  defaultCfg.Apriori.HopProbability,
+			Weight:          defaultCfg.Apriori.Weight,
+			PenaltyHalfLife: defaultCfg.Apriori.PenaltyHalfLife,
+		},
+		Bimodal: &BimodalConfig{
+			Scale:     int64(defaultCfg.Bimodal.Scale),
+			DecayTime: defaultCfg.Bimodal.DecayTime,
+		},
+	}
+}
+
+// Validate checks that the configured mission control parameters can't
+// overflow when converted to their routing counterparts.
+func (c *Config) Validate() error {
+	if c.AttemptCost < 0 {
+		return fmt.Errorf("attemptcost must not be negative, is %v",
+			c.AttemptCost)
+	}
+
+	return nil
+}
+
+// GetMissionControlConfig returns the mission control configuration that is
+// set by the passed router RPC config.
+func GetMissionControlConfig(cfg *Config) routing.MissionControlConfig {
+	return routing.MissionControlConfig{
+		Estimator: cfg.Estimator,
+		Apriori: routing.AprioriConfig{
+			HopProbability:  cfg.Apriori.HopProbability,
+			Weight:          cfg.Apriori.Weight,
+			PenaltyHalfLife: cfg.Apriori.PenaltyHalfLife,
+		},
+		Bimodal: routing.BimodalConfig{
+			Scale:     lnwire.MilliSatoshi(cfg.Bimodal.Scale),
+			DecayTime: cfg.Bimodal.DecayTime,
+		},
+		AttemptCost: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(cfg.AttemptCost),
+		),
+	}
 }
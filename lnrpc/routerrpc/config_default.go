@@ -2,6 +2,24 @@
 
 package routerrpc
 
+import "github.com/lightningnetwork/lnd/routing"
+
 // Config is the default config for the package. When the build tag isn't
 // specified, then we output a blank config.
 type Config struct{}
+
+// DefaultConfig returns the default config for the package.
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+// Validate is a no-op, as the config has no options without the build tag.
+func (c *Config) Validate() error {
+	return nil
+}
+
+// GetMissionControlConfig returns the default mission control configuration,
+// as its parameters can't be configured without the build tag.
+func GetMissionControlConfig(cfg *Config) routing.MissionControlConfig {
+	return routing.DefaultMissionControlConfig()
+}
@@ -0,0 +1,270 @@
+// +build routerrpc
+
+package routerrpc
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/queue"
+)
+
+var (
+	// errMissingCircuitKey is returned when a client resolves an HTLC
+	// without specifying its circuit key.
+	errMissingCircuitKey = errors.New("incoming circuit key missing")
+)
+
+// forwardInterceptor is a helper struct that handles the lifecycle of an rpc
+// interceptor streaming session. It hands the HTLCs intercepted by the switch
+// to the client, and resolves them according to the client's responses.
+type forwardInterceptor struct {
+	// htlcSwitch is the switch the HTLCs are intercepted from.
+	htlcSwitch *htlcswitch.Switch
+
+	// stream is the bidirectional RPC stream to the client.
+	stream Router_HtlcInterceptorServer
+
+	// holdForwards is the set of HTLCs held on behalf of the client,
+	// keyed by their incoming circuit key.
+	holdForwards map[htlcswitch.CircuitKey]htlcswitch.InterceptedForward
+
+	// intercepted receives the HTLCs intercepted by the switch.
+	intercepted chan htlcswitch.InterceptedForward
+
+	// requests queues the intercepted HTLCs to be sent to the client.
+	// They're sent from a separate goroutine, such that a slow client
+	// doesn't stall the main loop, and with it the switch.
+	requests *queue.ConcurrentQueue
+
+	quit chan struct{}
+}
+
+// newForwardInterceptor creates a new forwardInterceptor for the given stream.
+func newForwardInterceptor(htlcSwitch *htlcswitch.Switch,
+	stream Router_HtlcInterceptorServer) *forwardInterceptor {
+
+	return &forwardInterceptor{
+		htlcSwitch: htlcSwitch,
+		stream:     stream,
+		holdForwards: make(
+			map[htlcswitch.CircuitKey]htlcswitch.InterceptedForward,
+		),
+		intercepted: make(chan htlcswitch.InterceptedForward),
+		requests:    queue.NewConcurrentQueue(20),
+		quit:        make(chan struct{}),
+	}
+}
+
+// run registers the interceptor with the switch, and handles the intercepted
+// HTLCs and the client's responses until the stream is closed. Any HTLCs
+// still held at that point are resumed.
+func (r *forwardInterceptor) run() error {
+	if err := r.htlcSwitch.SetInterceptor(r.onIntercept); err != nil {
+		return err
+	}
+
+	// The deferred calls are executed in reverse order: we'll first stop
+	// accepting HTLCs, then resume the ones still held, and only then
+	// unregister from the switch.
+	defer r.htlcSwitch.ClearInterceptor()
+	defer r.resumeHeldForwards()
+	defer close(r.quit)
+
+	r.requests.Start()
+	defer r.requests.Stop()
+
+	// Both the goroutine sending the intercepted HTLCs and the one
+	// reading the client's responses may report an error.
+	var (
+		responses = make(chan *ForwardHtlcInterceptResponse)
+		errChan   = make(chan error, 2)
+	)
+
+	// Send the intercepted HTLCs to the client in a goroutine, as Send
+	// blocks until the client has room to receive them.
+	go func() {
+		for {
+			select {
+			case item := <-r.requests.ChanOut():
+				req := item.(*ForwardHtlcInterceptRequest)
+				if err := r.stream.Send(req); err != nil {
+					errChan <- err
+					return
+				}
+
+			case <-r.quit:
+				return
+			}
+		}
+	}()
+
+	// Read the client's responses in a goroutine, as Recv blocks until a
+	// response is received or the stream is closed.
+	go func() {
+		for {
+			resp, err := r.stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			select {
+			case responses <- resp:
+			case <-r.quit:
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case fwd := <-r.intercepted:
+			r.holdAndForward(fwd)
+
+		case resp := <-responses:
+			if err := r.resolveFromClient(resp); err != nil {
+				return err
+			}
+
+		case err := <-errChan:
+			if err == io.EOF {
+				return nil
+			}
+
+			return err
+
+		case <-r.stream.Context().Done():
+			return r.stream.Context().Err()
+		}
+	}
+}
+
+// onIntercept is the htlcswitch.ForwardInterceptor registered with the switch.
+// It hands the HTLC over to the main loop, unless the session is shutting
+// down, in which case the HTLC is forwarded as usual. The main loop never
+// waits on the client, so this doesn't stall the forwarding path.
+func (r *forwardInterceptor) onIntercept(
+	fwd htlcswitch.InterceptedForward) bool {
+
+	select {
+	case r.intercepted <- fwd:
+		return true
+
+	case <-r.quit:
+		return false
+	}
+}
+
+// holdAndForward holds the given HTLC and queues it to be sent to the client.
+func (r *forwardInterceptor) holdAndForward(
+	fwd htlcswitch.InterceptedForward) {
+
+	pkt := fwd.Packet()
+	r.holdForwards[pkt.IncomingCircuit] = fwd
+
+	log.Debugf("Holding htlc %v for interception", pkt.IncomingCircuit)
+
+	r.requests.ChanIn() <- &ForwardHtlcInterceptRequest{
+		IncomingCircuitKey: &CircuitKey{
+			ChanId: pkt.IncomingCircuit.ChanID.ToUint64(),
+			HtlcId: pkt.IncomingCircuit.HtlcID,
+		},
+		IncomingAmountMsat:      uint64(pkt.IncomingAmount),
+		IncomingExpiry:          pkt.IncomingExpiry,
+		PaymentHash:             pkt.Hash[:],
+		OutgoingRequestedChanId: pkt.OutgoingChanID.ToUint64(),
+		OutgoingAmountMsat:      uint64(pkt.OutgoingAmount),
+		OutgoingExpiry:          pkt.OutgoingExpiry,
+		OnionBlob:               pkt.OnionBlob[:],
+	}
+}
+
+// resolveFromClient resolves the held HTLC targeted by the client's response.
+func (r *forwardInterceptor) resolveFromClient(
+	in *ForwardHtlcInterceptResponse) error {
+
+	if in.IncomingCircuitKey == nil {
+		return errMissingCircuitKey
+	}
+
+	circuitKey := htlcswitch.CircuitKey{
+		ChanID: lnwire.NewShortChanIDFromInt(
+			in.IncomingCircuitKey.ChanId,
+		),
+		HtlcID: in.IncomingCircuitKey.HtlcId,
+	}
+
+	fwd, ok := r.holdForwards[circuitKey]
+	if !ok {
+		return fmt.Errorf("htlc %v isn't held", circuitKey)
+	}
+
+	log.Debugf("Resolving intercepted htlc %v with action %v",
+		circuitKey, in.Action)
+
+	// The switch fails back held HTLCs approaching their expiry on its
+	// own, in which case the client's response comes too late.
+	err := r.resolve(fwd, in)
+	switch {
+	case err == htlcswitch.ErrFwdResolved:
+		log.Debugf("Intercepted htlc %v already resolved", circuitKey)
+
+	case err != nil:
+		return err
+	}
+
+	delete(r.holdForwards, circuitKey)
+
+	return nil
+}
+
+// resolve resolves the held HTLC according to the action of the client's
+// response.
+func (r *forwardInterceptor) resolve(fwd htlcswitch.InterceptedForward,
+	in *ForwardHtlcInterceptResponse) error {
+
+	switch in.Action {
+	case ResolveHoldForwardAction_RESUME:
+		// Errors forwarding the HTLC are handled by the switch, which
+		// fails it back, so they don't concern the client.
+		err := fwd.Resume()
+		if err != nil && err != htlcswitch.ErrFwdResolved {
+			log.Errorf("Unable to resume htlc %v: %v",
+				fwd.Packet().IncomingCircuit, err)
+		}
+
+		return nil
+
+	case ResolveHoldForwardAction_FAIL:
+		return fwd.Fail(lnwire.FailCode(in.FailureCode))
+
+	case ResolveHoldForwardAction_SETTLE:
+		preimage, err := lntypes.MakePreimage(in.Preimage)
+		if err != nil {
+			return err
+		}
+
+		return fwd.Settle(preimage)
+
+	default:
+		return fmt.Errorf("unrecognized resolve action %v", in.Action)
+	}
+}
+
+// resumeHeldForwards resumes all HTLCs still held on behalf of the client.
+func (r *forwardInterceptor) resumeHeldForwards() {
+	for circuitKey, fwd := range r.holdForwards {
+		log.Debugf("Resuming intercepted htlc %v", circuitKey)
+
+		err := fwd.Resume()
+		if err != nil && err != htlcswitch.ErrFwdResolved {
+			log.Errorf("Unable to resume htlc %v: %v", circuitKey,
+				err)
+		}
+	}
+}
@@ -23,6 +23,104 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type PaymentState int32
+
+const (
+	// *
+	// The payment has been dispatched, but its outcome isn't known yet.
+	PaymentState_IN_FLIGHT PaymentState = 0
+	// *
+	// The payment was settled by the destination.
+	PaymentState_SUCCEEDED PaymentState = 1
+	// *
+	// The payment couldn't be completed.
+	PaymentState_FAILED PaymentState = 2
+)
+
+var PaymentState_name = map[int32]string{
+	0: "IN_FLIGHT",
+	1: "SUCCEEDED",
+	2: "FAILED",
+}
+var PaymentState_value = map[string]int32{
+	"IN_FLIGHT": 0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+}
+
+func (x PaymentState) String() string {
+	return proto.EnumName(PaymentState_name, int32(x))
+}
+func (PaymentState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{0}
+}
+
+type ResolveHoldForwardAction int32
+
+const (
+	// *
+	// Settle the HTLC on the incoming channel with the given preimage.
+	ResolveHoldForwardAction_SETTLE ResolveHoldForwardAction = 0
+	// *
+	// Fail the HTLC on the incoming channel with the given failure code.
+	ResolveHoldForwardAction_FAIL ResolveHoldForwardAction = 1
+	// *
+	// Resume forwarding the HTLC as if it was never intercepted.
+	ResolveHoldForwardAction_RESUME ResolveHoldForwardAction = 2
+)
+
+var ResolveHoldForwardAction_name = map[int32]string{
+	0: "SETTLE",
+	1: "FAIL",
+	2: "RESUME",
+}
+var ResolveHoldForwardAction_value = map[string]int32{
+	"SETTLE": 0,
+	"FAIL":   1,
+	"RESUME": 2,
+}
+
+func (x ResolveHoldForwardAction) String() string {
+	return proto.EnumName(ResolveHoldForwardAction_name, int32(x))
+}
+func (ResolveHoldForwardAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{1}
+}
+
+type ChanStatusAction int32
+
+const (
+	// *
+	// Manually enable the channel, overriding a prior manual disable.
+	ChanStatusAction_ENABLE ChanStatusAction = 0
+	// *
+	// Manually disable the channel. The channel won't be reenabled
+	// automatically when the remote peer comes back online.
+	ChanStatusAction_DISABLE ChanStatusAction = 1
+	// *
+	// Restore automatic management of the channel's status, which enables and
+	// disables the channel depending on whether the remote peer is online.
+	ChanStatusAction_AUTO ChanStatusAction = 2
+)
+
+var ChanStatusAction_name = map[int32]string{
+	0: "ENABLE",
+	1: "DISABLE",
+	2: "AUTO",
+}
+var ChanStatusAction_value = map[string]int32{
+	"ENABLE":  0,
+	"DISABLE": 1,
+	"AUTO":    2,
+}
+
+func (x ChanStatusAction) String() string {
+	return proto.EnumName(ChanStatusAction_name, int32(x))
+}
+func (ChanStatusAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{2}
+}
+
 type PaymentRequest struct {
 	// *
 	// A serialized BOLT-11 payment request that contains all information
@@ -49,7 +147,18 @@ type PaymentRequest struct {
 	// *
 	// The channel id of the channel that must be taken to the first hop. If zero,
 	// any channel may be used.
-	OutgoingChannelId    int64    `protobuf:"varint,5,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
+	OutgoingChannelId int64 `protobuf:"varint,5,opt,name=outgoing_channel_id,json=outgoingChannelId,proto3" json:"outgoing_channel_id,omitempty"`
+	// *
+	// If set, the call returns as soon as the payment has been persisted and its
+	// first HTLC has been dispatched, without waiting for the payment to
+	// complete. The outcome of the payment can then be retrieved through
+	// TrackPayment.
+	NoWait bool `protobuf:"varint,6,opt,name=no_wait,json=noWait,proto3" json:"no_wait,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If empty, any last hop may be
+	// used. Combined with outgoing_channel_id and a payment to ourselves, this
+	// allows rebalancing a specific pair of channels.
+	LastHopPubkey        []byte   `protobuf:"bytes,7,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -59,7 +168,7 @@ func (m *PaymentRequest) Reset()         { *m = PaymentRequest{} }
 func (m *PaymentRequest) String() string { return proto.CompactTextString(m) }
 func (*PaymentRequest) ProtoMessage()    {}
 func (*PaymentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{0}
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{0}
 }
 func (m *PaymentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentRequest.Unmarshal(m, b)
@@ -114,6 +223,20 @@ func (m *PaymentRequest) GetOutgoingChannelId() int64 {
 	return 0
 }
 
+func (m *PaymentRequest) GetNoWait() bool {
+	if m != nil {
+		return m.NoWait
+	}
+	return false
+}
+
+func (m *PaymentRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
 type PaymentResponse struct {
 	// *
 	// The payment hash that we paid to. Provided so callers are able to map
@@ -134,7 +257,7 @@ func (m *PaymentResponse) Reset()         { *m = PaymentResponse{} }
 func (m *PaymentResponse) String() string { return proto.CompactTextString(m) }
 func (*PaymentResponse) ProtoMessage()    {}
 func (*PaymentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{1}
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{1}
 }
 func (m *PaymentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PaymentResponse.Unmarshal(m, b)
@@ -191,7 +314,7 @@ func (m *RouteFeeRequest) Reset()         { *m = RouteFeeRequest{} }
 func (m *RouteFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*RouteFeeRequest) ProtoMessage()    {}
 func (*RouteFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{2}
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{2}
 }
 func (m *RouteFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteFeeRequest.Unmarshal(m, b)
@@ -244,7 +367,7 @@ func (m *RouteFeeResponse) Reset()         { *m = RouteFeeResponse{} }
 func (m *RouteFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*RouteFeeResponse) ProtoMessage()    {}
 func (*RouteFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_router_e218e7c710fe8172, []int{3}
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{3}
 }
 func (m *RouteFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RouteFeeResponse.Unmarshal(m, b)
@@ -278,164 +401,2456 @@ func (m *RouteFeeResponse) GetTimeLockDelay() int64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*PaymentRequest)(nil), "routerrpc.PaymentRequest")
-	proto.RegisterType((*PaymentResponse)(nil), "routerrpc.PaymentResponse")
-	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
-	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
+type TrackPaymentRequest struct {
+	// *
+	// The hash of the payment to track.
+	PaymentHash          []byte   `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *TrackPaymentRequest) Reset()         { *m = TrackPaymentRequest{} }
+func (m *TrackPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*TrackPaymentRequest) ProtoMessage()    {}
+func (*TrackPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{4}
+}
+func (m *TrackPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrackPaymentRequest.Unmarshal(m, b)
+}
+func (m *TrackPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrackPaymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *TrackPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrackPaymentRequest.Merge(dst, src)
+}
+func (m *TrackPaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_TrackPaymentRequest.Size(m)
+}
+func (m *TrackPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrackPaymentRequest.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_TrackPaymentRequest proto.InternalMessageInfo
 
-// RouterClient is the client API for Router service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RouterClient interface {
+func (m *TrackPaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+type PaymentStatus struct {
 	// *
-	// SendPayment attempts to route a payment described by the passed
-	// PaymentRequest to the final destination. If we are unable to route the
-	// payment, or cannot find a route that satisfies the constraints in the
-	// PaymentRequest, then an error will be returned. Otherwise, the payment
-	// pre-image, along with the final route will be returned.
-	SendPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	// The current state of the payment.
+	State PaymentState `protobuf:"varint,1,opt,name=state,proto3,enum=routerrpc.PaymentState" json:"state,omitempty"`
 	// *
-	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
-	// may cost to send an HTLC to the target end destination.
-	EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error)
+	// The pre-image of the payment, if it succeeded. This may not be known for
+	// payments that weren't dispatched asynchronously since the daemon was
+	// started.
+	Preimage []byte `protobuf:"bytes,2,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// If not an empty string, then a string representation of the payment error.
+	PaymentErr           string   `protobuf:"bytes,3,opt,name=payment_err,json=paymentErr,proto3" json:"payment_err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type routerClient struct {
-	cc *grpc.ClientConn
+func (m *PaymentStatus) Reset()         { *m = PaymentStatus{} }
+func (m *PaymentStatus) String() string { return proto.CompactTextString(m) }
+func (*PaymentStatus) ProtoMessage()    {}
+func (*PaymentStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{5}
+}
+func (m *PaymentStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentStatus.Unmarshal(m, b)
+}
+func (m *PaymentStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentStatus.Marshal(b, m, deterministic)
+}
+func (dst *PaymentStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentStatus.Merge(dst, src)
+}
+func (m *PaymentStatus) XXX_Size() int {
+	return xxx_messageInfo_PaymentStatus.Size(m)
+}
+func (m *PaymentStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentStatus.DiscardUnknown(m)
 }
 
-func NewRouterClient(cc *grpc.ClientConn) RouterClient {
-	return &routerClient{cc}
+var xxx_messageInfo_PaymentStatus proto.InternalMessageInfo
+
+func (m *PaymentStatus) GetState() PaymentState {
+	if m != nil {
+		return m.State
+	}
+	return PaymentState_IN_FLIGHT
 }
 
-func (c *routerClient) SendPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
-	out := new(PaymentResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/SendPayment", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PaymentStatus) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
 	}
-	return out, nil
+	return nil
 }
 
-func (c *routerClient) EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error) {
-	out := new(RouteFeeResponse)
-	err := c.cc.Invoke(ctx, "/routerrpc.Router/EstimateRouteFee", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PaymentStatus) GetPaymentErr() string {
+	if m != nil {
+		return m.PaymentErr
 	}
-	return out, nil
+	return ""
 }
 
-// RouterServer is the server API for Router service.
-type RouterServer interface {
+type CircuitKey struct {
 	// *
-	// SendPayment attempts to route a payment described by the passed
-	// PaymentRequest to the final destination. If we are unable to route the
-	// payment, or cannot find a route that satisfies the constraints in the
-	// PaymentRequest, then an error will be returned. Otherwise, the payment
-	// pre-image, along with the final route will be returned.
-	SendPayment(context.Context, *PaymentRequest) (*PaymentResponse, error)
+	// The id of the channel that is part of this circuit.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
 	// *
-	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
-	// may cost to send an HTLC to the target end destination.
-	EstimateRouteFee(context.Context, *RouteFeeRequest) (*RouteFeeResponse, error)
+	// The index of the incoming htlc in the incoming channel.
+	HtlcId               uint64   `protobuf:"varint,2,opt,name=htlc_id,json=htlcId,proto3" json:"htlc_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
-	s.RegisterService(&_Router_serviceDesc, srv)
+func (m *CircuitKey) Reset()         { *m = CircuitKey{} }
+func (m *CircuitKey) String() string { return proto.CompactTextString(m) }
+func (*CircuitKey) ProtoMessage()    {}
+func (*CircuitKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{6}
+}
+func (m *CircuitKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CircuitKey.Unmarshal(m, b)
+}
+func (m *CircuitKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CircuitKey.Marshal(b, m, deterministic)
+}
+func (dst *CircuitKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitKey.Merge(dst, src)
+}
+func (m *CircuitKey) XXX_Size() int {
+	return xxx_messageInfo_CircuitKey.Size(m)
+}
+func (m *CircuitKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitKey.DiscardUnknown(m)
 }
 
-func _Router_SendPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PaymentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_CircuitKey proto.InternalMessageInfo
+
+func (m *CircuitKey) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
 	}
-	if interceptor == nil {
-		return srv.(RouterServer).SendPayment(ctx, in)
+	return 0
+}
+
+func (m *CircuitKey) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/routerrpc.Router/SendPayment",
+	return 0
+}
+
+type ForwardHtlcInterceptRequest struct {
+	// *
+	// The key of this forwarded htlc. It defines the incoming channel id and
+	// the index in this channel.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key,json=incomingCircuitKey,proto3" json:"incoming_circuit_key,omitempty"`
+	// *
+	// The incoming htlc amount.
+	IncomingAmountMsat uint64 `protobuf:"varint,2,opt,name=incoming_amount_msat,json=incomingAmountMsat,proto3" json:"incoming_amount_msat,omitempty"`
+	// *
+	// The incoming htlc expiry.
+	IncomingExpiry uint32 `protobuf:"varint,3,opt,name=incoming_expiry,json=incomingExpiry,proto3" json:"incoming_expiry,omitempty"`
+	// *
+	// The htlc payment hash. This value is not guaranteed to be unique per
+	// request.
+	PaymentHash []byte `protobuf:"bytes,4,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// *
+	// The requested outgoing channel id for this forwarded htlc. Because of
+	// non-strict forwarding, this isn't necessarily the channel over which the
+	// packet will be forwarded eventually. A different channel to the same peer
+	// may be selected as well.
+	OutgoingRequestedChanId uint64 `protobuf:"varint,5,opt,name=outgoing_requested_chan_id,json=outgoingRequestedChanId,proto3" json:"outgoing_requested_chan_id,omitempty"`
+	// *
+	// The outgoing htlc amount.
+	OutgoingAmountMsat uint64 `protobuf:"varint,6,opt,name=outgoing_amount_msat,json=outgoingAmountMsat,proto3" json:"outgoing_amount_msat,omitempty"`
+	// *
+	// The outgoing htlc expiry.
+	OutgoingExpiry uint32 `protobuf:"varint,7,opt,name=outgoing_expiry,json=outgoingExpiry,proto3" json:"outgoing_expiry,omitempty"`
+	// *
+	// The onion packet to be passed on to the next hop.
+	OnionBlob            []byte   `protobuf:"bytes,8,opt,name=onion_blob,json=onionBlob,proto3" json:"onion_blob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardHtlcInterceptRequest) Reset()         { *m = ForwardHtlcInterceptRequest{} }
+func (m *ForwardHtlcInterceptRequest) String() string { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptRequest) ProtoMessage()    {}
+func (*ForwardHtlcInterceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{7}
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Unmarshal(m, b)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Marshal(b, m, deterministic)
+}
+func (dst *ForwardHtlcInterceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHtlcInterceptRequest.Merge(dst, src)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_Size() int {
+	return xxx_messageInfo_ForwardHtlcInterceptRequest.Size(m)
+}
+func (m *ForwardHtlcInterceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHtlcInterceptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardHtlcInterceptRequest proto.InternalMessageInfo
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingCircuitKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingCircuitKey
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterServer).SendPayment(ctx, req.(*PaymentRequest))
+	return nil
+}
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingAmountMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmountMsat
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Router_EstimateRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RouteFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ForwardHtlcInterceptRequest) GetIncomingExpiry() uint32 {
+	if m != nil {
+		return m.IncomingExpiry
 	}
-	if interceptor == nil {
-		return srv.(RouterServer).EstimateRouteFee(ctx, in)
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/routerrpc.Router/EstimateRouteFee",
+	return nil
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingRequestedChanId() uint64 {
+	if m != nil {
+		return m.OutgoingRequestedChanId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouterServer).EstimateRouteFee(ctx, req.(*RouteFeeRequest))
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingAmountMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmountMsat
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Router_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "routerrpc.Router",
-	HandlerType: (*RouterServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendPayment",
-			Handler:    _Router_SendPayment_Handler,
-		},
-		{
-			MethodName: "EstimateRouteFee",
-			Handler:    _Router_EstimateRouteFee_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "routerrpc/router.proto",
+func (m *ForwardHtlcInterceptRequest) GetOutgoingExpiry() uint32 {
+	if m != nil {
+		return m.OutgoingExpiry
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOnionBlob() []byte {
+	if m != nil {
+		return m.OnionBlob
+	}
+	return nil
+}
+
+type ForwardHtlcInterceptResponse struct {
+	// *
+	// The key of this forwarded htlc. It defines the incoming channel id and
+	// the index in this channel.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key,json=incomingCircuitKey,proto3" json:"incoming_circuit_key,omitempty"`
+	// *
+	// The resolve action for this intercepted htlc.
+	Action ResolveHoldForwardAction `protobuf:"varint,2,opt,name=action,proto3,enum=routerrpc.ResolveHoldForwardAction" json:"action,omitempty"`
+	// *
+	// The preimage in case the resolve action is SETTLE.
+	Preimage []byte `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// The BOLT #4 failure code in case the resolve action is FAIL. Only codes
+	// that don't carry any additional data, and temporary_channel_failure, are
+	// supported.
+	FailureCode          uint32   `protobuf:"varint,4,opt,name=failure_code,json=failureCode,proto3" json:"failure_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ForwardHtlcInterceptResponse) Reset()         { *m = ForwardHtlcInterceptResponse{} }
+func (m *ForwardHtlcInterceptResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptResponse) ProtoMessage()    {}
+func (*ForwardHtlcInterceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{8}
+}
+func (m *ForwardHtlcInterceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Unmarshal(m, b)
+}
+func (m *ForwardHtlcInterceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Marshal(b, m, deterministic)
+}
+func (dst *ForwardHtlcInterceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardHtlcInterceptResponse.Merge(dst, src)
 }
+func (m *ForwardHtlcInterceptResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardHtlcInterceptResponse.Size(m)
+}
+func (m *ForwardHtlcInterceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardHtlcInterceptResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardHtlcInterceptResponse proto.InternalMessageInfo
+
+func (m *ForwardHtlcInterceptResponse) GetIncomingCircuitKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingCircuitKey
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetAction() ResolveHoldForwardAction {
+	if m != nil {
+		return m.Action
+	}
+	return ResolveHoldForwardAction_SETTLE
+}
+
+func (m *ForwardHtlcInterceptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetFailureCode() uint32 {
+	if m != nil {
+		return m.FailureCode
+	}
+	return 0
+}
+
+type QueryMissionControlRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryMissionControlRequest) Reset()         { *m = QueryMissionControlRequest{} }
+func (m *QueryMissionControlRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissionControlRequest) ProtoMessage()    {}
+func (*QueryMissionControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{9}
+}
+func (m *QueryMissionControlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMissionControlRequest.Unmarshal(m, b)
+}
+func (m *QueryMissionControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMissionControlRequest.Marshal(b, m, deterministic)
+}
+func (dst *QueryMissionControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissionControlRequest.Merge(dst, src)
+}
+func (m *QueryMissionControlRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryMissionControlRequest.Size(m)
+}
+func (m *QueryMissionControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissionControlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissionControlRequest proto.InternalMessageInfo
+
+type PairHistory struct {
+	// *
+	// The public key of the node that forwards the HTLC.
+	NodeFrom []byte `protobuf:"bytes,1,opt,name=node_from,json=nodeFrom,proto3" json:"node_from,omitempty"`
+	// *
+	// The public key of the node that the HTLC is forwarded to.
+	NodeTo []byte `protobuf:"bytes,2,opt,name=node_to,json=nodeTo,proto3" json:"node_to,omitempty"`
+	// *
+	// The unix timestamp of the latest attempt to forward an HTLC between the
+	// pair of nodes.
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// *
+	// Whether the latest attempt was successful.
+	Success bool `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	// *
+	// The estimated probability that the pair of nodes is able to forward an
+	// HTLC, taking into account the age of the latest result. This field is
+	// ignored when importing mission control data.
+	SuccessProb          float64  `protobuf:"fixed64,5,opt,name=success_prob,json=successProb,proto3" json:"success_prob,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PairHistory) Reset()         { *m = PairHistory{} }
+func (m *PairHistory) String() string { return proto.CompactTextString(m) }
+func (*PairHistory) ProtoMessage()    {}
+func (*PairHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{10}
+}
+func (m *PairHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PairHistory.Unmarshal(m, b)
+}
+func (m *PairHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PairHistory.Marshal(b, m, deterministic)
+}
+func (dst *PairHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairHistory.Merge(dst, src)
+}
+func (m *PairHistory) XXX_Size() int {
+	return xxx_messageInfo_PairHistory.Size(m)
+}
+func (m *PairHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairHistory proto.InternalMessageInfo
+
+func (m *PairHistory) GetNodeFrom() []byte {
+	if m != nil {
+		return m.NodeFrom
+	}
+	return nil
+}
+
+func (m *PairHistory) GetNodeTo() []byte {
+	if m != nil {
+		return m.NodeTo
+	}
+	return nil
+}
+
+func (m *PairHistory) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *PairHistory) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *PairHistory) GetSuccessProb() float64 {
+	if m != nil {
+		return m.SuccessProb
+	}
+	return 0
+}
+
+type QueryMissionControlResponse struct {
+	// *
+	// The reliability memory of mission control for each pair of nodes that an
+	// HTLC was routed through.
+	Pairs                []*PairHistory `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *QueryMissionControlResponse) Reset()         { *m = QueryMissionControlResponse{} }
+func (m *QueryMissionControlResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissionControlResponse) ProtoMessage()    {}
+func (*QueryMissionControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{11}
+}
+func (m *QueryMissionControlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryMissionControlResponse.Unmarshal(m, b)
+}
+func (m *QueryMissionControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryMissionControlResponse.Marshal(b, m, deterministic)
+}
+func (dst *QueryMissionControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissionControlResponse.Merge(dst, src)
+}
+func (m *QueryMissionControlResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryMissionControlResponse.Size(m)
+}
+func (m *QueryMissionControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissionControlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissionControlResponse proto.InternalMessageInfo
+
+func (m *QueryMissionControlResponse) GetPairs() []*PairHistory {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+type ResetMissionControlRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetMissionControlRequest) Reset()         { *m = ResetMissionControlRequest{} }
+func (m *ResetMissionControlRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetMissionControlRequest) ProtoMessage()    {}
+func (*ResetMissionControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{12}
+}
+func (m *ResetMissionControlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetMissionControlRequest.Unmarshal(m, b)
+}
+func (m *ResetMissionControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetMissionControlRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResetMissionControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetMissionControlRequest.Merge(dst, src)
+}
+func (m *ResetMissionControlRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetMissionControlRequest.Size(m)
+}
+func (m *ResetMissionControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetMissionControlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetMissionControlRequest proto.InternalMessageInfo
+
+type ResetMissionControlResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetMissionControlResponse) Reset()         { *m = ResetMissionControlResponse{} }
+func (m *ResetMissionControlResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetMissionControlResponse) ProtoMessage()    {}
+func (*ResetMissionControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{13}
+}
+func (m *ResetMissionControlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetMissionControlResponse.Unmarshal(m, b)
+}
+func (m *ResetMissionControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetMissionControlResponse.Marshal(b, m, deterministic)
+}
+func (dst *ResetMissionControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetMissionControlResponse.Merge(dst, src)
+}
+func (m *ResetMissionControlResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetMissionControlResponse.Size(m)
+}
+func (m *ResetMissionControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetMissionControlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetMissionControlResponse proto.InternalMessageInfo
+
+type XImportMissionControlRequest struct {
+	// *
+	// The pair results to add to the reliability memory of mission control.
+	Pairs                []*PairHistory `protobuf:"bytes,1,rep,name=pairs,proto3" json:"pairs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *XImportMissionControlRequest) Reset()         { *m = XImportMissionControlRequest{} }
+func (m *XImportMissionControlRequest) String() string { return proto.CompactTextString(m) }
+func (*XImportMissionControlRequest) ProtoMessage()    {}
+func (*XImportMissionControlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{14}
+}
+func (m *XImportMissionControlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XImportMissionControlRequest.Unmarshal(m, b)
+}
+func (m *XImportMissionControlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XImportMissionControlRequest.Marshal(b, m, deterministic)
+}
+func (dst *XImportMissionControlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XImportMissionControlRequest.Merge(dst, src)
+}
+func (m *XImportMissionControlRequest) XXX_Size() int {
+	return xxx_messageInfo_XImportMissionControlRequest.Size(m)
+}
+func (m *XImportMissionControlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_XImportMissionControlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XImportMissionControlRequest proto.InternalMessageInfo
+
+func (m *XImportMissionControlRequest) GetPairs() []*PairHistory {
+	if m != nil {
+		return m.Pairs
+	}
+	return nil
+}
+
+type XImportMissionControlResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *XImportMissionControlResponse) Reset()         { *m = XImportMissionControlResponse{} }
+func (m *XImportMissionControlResponse) String() string { return proto.CompactTextString(m) }
+func (*XImportMissionControlResponse) ProtoMessage()    {}
+func (*XImportMissionControlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{15}
+}
+func (m *XImportMissionControlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_XImportMissionControlResponse.Unmarshal(m, b)
+}
+func (m *XImportMissionControlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_XImportMissionControlResponse.Marshal(b, m, deterministic)
+}
+func (dst *XImportMissionControlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_XImportMissionControlResponse.Merge(dst, src)
+}
+func (m *XImportMissionControlResponse) XXX_Size() int {
+	return xxx_messageInfo_XImportMissionControlResponse.Size(m)
+}
+func (m *XImportMissionControlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_XImportMissionControlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_XImportMissionControlResponse proto.InternalMessageInfo
+
+type RouteHop struct {
+	// *
+	// The short channel id of the channel to forward the HTLC over.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// *
+	// The public key of the node at the end of the channel.
+	PubKey []byte `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// *
+	// The amount in milli-satoshis to forward over the channel.
+	AmtToForwardMsat uint64 `protobuf:"varint,3,opt,name=amt_to_forward_msat,json=amtToForwardMsat,proto3" json:"amt_to_forward_msat,omitempty"`
+	// *
+	// The absolute CLTV expiry of the HTLC forwarded over the channel.
+	Expiry               uint32   `protobuf:"varint,4,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RouteHop) Reset()         { *m = RouteHop{} }
+func (m *RouteHop) String() string { return proto.CompactTextString(m) }
+func (*RouteHop) ProtoMessage()    {}
+func (*RouteHop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{16}
+}
+func (m *RouteHop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteHop.Unmarshal(m, b)
+}
+func (m *RouteHop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteHop.Marshal(b, m, deterministic)
+}
+func (dst *RouteHop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteHop.Merge(dst, src)
+}
+func (m *RouteHop) XXX_Size() int {
+	return xxx_messageInfo_RouteHop.Size(m)
+}
+func (m *RouteHop) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteHop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RouteHop proto.InternalMessageInfo
+
+func (m *RouteHop) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *RouteHop) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+func (m *RouteHop) GetAmtToForwardMsat() uint64 {
+	if m != nil {
+		return m.AmtToForwardMsat
+	}
+	return 0
+}
+
+func (m *RouteHop) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+type SendToRouteRequest struct {
+	// *
+	// The payment hash to use for the HTLC.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// *
+	// The amount in milli-satoshis to send to the first hop, including the fees
+	// of all hops along the route.
+	TotalAmtMsat uint64 `protobuf:"varint,2,opt,name=total_amt_msat,json=totalAmtMsat,proto3" json:"total_amt_msat,omitempty"`
+	// *
+	// The absolute CLTV expiry of the HTLC sent to the first hop.
+	TotalTimeLock uint32 `protobuf:"varint,3,opt,name=total_time_lock,json=totalTimeLock,proto3" json:"total_time_lock,omitempty"`
+	// *
+	// The hops of the route, in the order in which they forward the HTLC.
+	Hops                 []*RouteHop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SendToRouteRequest) Reset()         { *m = SendToRouteRequest{} }
+func (m *SendToRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*SendToRouteRequest) ProtoMessage()    {}
+func (*SendToRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{17}
+}
+func (m *SendToRouteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendToRouteRequest.Unmarshal(m, b)
+}
+func (m *SendToRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendToRouteRequest.Marshal(b, m, deterministic)
+}
+func (dst *SendToRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToRouteRequest.Merge(dst, src)
+}
+func (m *SendToRouteRequest) XXX_Size() int {
+	return xxx_messageInfo_SendToRouteRequest.Size(m)
+}
+func (m *SendToRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendToRouteRequest proto.InternalMessageInfo
+
+func (m *SendToRouteRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *SendToRouteRequest) GetTotalAmtMsat() uint64 {
+	if m != nil {
+		return m.TotalAmtMsat
+	}
+	return 0
+}
+
+func (m *SendToRouteRequest) GetTotalTimeLock() uint32 {
+	if m != nil {
+		return m.TotalTimeLock
+	}
+	return 0
+}
+
+func (m *SendToRouteRequest) GetHops() []*RouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+type Failure struct {
+	// *
+	// The BOLT #4 failure code reported for the HTLC.
+	Code uint32 `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	// *
+	// A human readable description of the failure.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// *
+	// The public key of the node that reported the failure.
+	FailureSourcePubkey []byte `protobuf:"bytes,3,opt,name=failure_source_pubkey,json=failureSourcePubkey,proto3" json:"failure_source_pubkey,omitempty"`
+	// *
+	// The position of the node that reported the failure within the route. An
+	// index of 0 refers to our own node, 1 to the node at the end of the first
+	// hop, and so on.
+	FailureSourceIndex   uint32   `protobuf:"varint,4,opt,name=failure_source_index,json=failureSourceIndex,proto3" json:"failure_source_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Failure) Reset()         { *m = Failure{} }
+func (m *Failure) String() string { return proto.CompactTextString(m) }
+func (*Failure) ProtoMessage()    {}
+func (*Failure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{18}
+}
+func (m *Failure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Failure.Unmarshal(m, b)
+}
+func (m *Failure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Failure.Marshal(b, m, deterministic)
+}
+func (dst *Failure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Failure.Merge(dst, src)
+}
+func (m *Failure) XXX_Size() int {
+	return xxx_messageInfo_Failure.Size(m)
+}
+func (m *Failure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Failure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Failure proto.InternalMessageInfo
+
+func (m *Failure) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *Failure) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Failure) GetFailureSourcePubkey() []byte {
+	if m != nil {
+		return m.FailureSourcePubkey
+	}
+	return nil
+}
+
+func (m *Failure) GetFailureSourceIndex() uint32 {
+	if m != nil {
+		return m.FailureSourceIndex
+	}
+	return 0
+}
+
+type HtlcAttempt struct {
+	// *
+	// The time in UNIX nanoseconds at which the HTLC was dispatched.
+	AttemptTimeNs int64 `protobuf:"varint,1,opt,name=attempt_time_ns,json=attemptTimeNs,proto3" json:"attempt_time_ns,omitempty"`
+	// *
+	// The amount in milli-satoshis sent to the first hop, including the fees of
+	// all hops along the route.
+	TotalAmtMsat uint64 `protobuf:"varint,2,opt,name=total_amt_msat,json=totalAmtMsat,proto3" json:"total_amt_msat,omitempty"`
+	// *
+	// The absolute CLTV expiry of the HTLC sent to the first hop.
+	TotalTimeLock uint32 `protobuf:"varint,3,opt,name=total_time_lock,json=totalTimeLock,proto3" json:"total_time_lock,omitempty"`
+	// *
+	// The hops of the route the HTLC was sent along.
+	Hops []*RouteHop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	// *
+	// The time in UNIX nanoseconds at which the HTLC was resolved, or zero if it
+	// is still in flight.
+	ResolveTimeNs int64 `protobuf:"varint,5,opt,name=resolve_time_ns,json=resolveTimeNs,proto3" json:"resolve_time_ns,omitempty"`
+	// *
+	// The failure reported for the HTLC, if it failed.
+	Failure              *Failure `protobuf:"bytes,6,opt,name=failure,proto3" json:"failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HtlcAttempt) Reset()         { *m = HtlcAttempt{} }
+func (m *HtlcAttempt) String() string { return proto.CompactTextString(m) }
+func (*HtlcAttempt) ProtoMessage()    {}
+func (*HtlcAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{19}
+}
+func (m *HtlcAttempt) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HtlcAttempt.Unmarshal(m, b)
+}
+func (m *HtlcAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HtlcAttempt.Marshal(b, m, deterministic)
+}
+func (dst *HtlcAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HtlcAttempt.Merge(dst, src)
+}
+func (m *HtlcAttempt) XXX_Size() int {
+	return xxx_messageInfo_HtlcAttempt.Size(m)
+}
+func (m *HtlcAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_HtlcAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HtlcAttempt proto.InternalMessageInfo
+
+func (m *HtlcAttempt) GetAttemptTimeNs() int64 {
+	if m != nil {
+		return m.AttemptTimeNs
+	}
+	return 0
+}
+
+func (m *HtlcAttempt) GetTotalAmtMsat() uint64 {
+	if m != nil {
+		return m.TotalAmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcAttempt) GetTotalTimeLock() uint32 {
+	if m != nil {
+		return m.TotalTimeLock
+	}
+	return 0
+}
+
+func (m *HtlcAttempt) GetHops() []*RouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *HtlcAttempt) GetResolveTimeNs() int64 {
+	if m != nil {
+		return m.ResolveTimeNs
+	}
+	return 0
+}
+
+func (m *HtlcAttempt) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+type PaymentUpdate struct {
+	// *
+	// The current state of the payment.
+	State PaymentState `protobuf:"varint,1,opt,name=state,proto3,enum=routerrpc.PaymentState" json:"state,omitempty"`
+	// *
+	// The hash of the payment.
+	PaymentHash []byte `protobuf:"bytes,2,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// *
+	// The amount in milli-satoshis to be received by the destination.
+	ValueMsat int64 `protobuf:"varint,3,opt,name=value_msat,json=valueMsat,proto3" json:"value_msat,omitempty"`
+	// *
+	// The time in UNIX nanoseconds at which the payment was initiated.
+	CreationTimeNs int64 `protobuf:"varint,4,opt,name=creation_time_ns,json=creationTimeNs,proto3" json:"creation_time_ns,omitempty"`
+	// *
+	// The HTLCs dispatched for the payment, in the order in which they were
+	// attempted.
+	Htlcs []*HtlcAttempt `protobuf:"bytes,5,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+	// *
+	// The pre-image of the payment, if it succeeded.
+	Preimage []byte `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// If not an empty string, then a string representation of the payment error.
+	PaymentErr           string   `protobuf:"bytes,7,opt,name=payment_err,json=paymentErr,proto3" json:"payment_err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PaymentUpdate) Reset()         { *m = PaymentUpdate{} }
+func (m *PaymentUpdate) String() string { return proto.CompactTextString(m) }
+func (*PaymentUpdate) ProtoMessage()    {}
+func (*PaymentUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{20}
+}
+func (m *PaymentUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentUpdate.Unmarshal(m, b)
+}
+func (m *PaymentUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentUpdate.Marshal(b, m, deterministic)
+}
+func (dst *PaymentUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentUpdate.Merge(dst, src)
+}
+func (m *PaymentUpdate) XXX_Size() int {
+	return xxx_messageInfo_PaymentUpdate.Size(m)
+}
+func (m *PaymentUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaymentUpdate proto.InternalMessageInfo
+
+func (m *PaymentUpdate) GetState() PaymentState {
+	if m != nil {
+		return m.State
+	}
+	return PaymentState_IN_FLIGHT
+}
+
+func (m *PaymentUpdate) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *PaymentUpdate) GetValueMsat() int64 {
+	if m != nil {
+		return m.ValueMsat
+	}
+	return 0
+}
+
+func (m *PaymentUpdate) GetCreationTimeNs() int64 {
+	if m != nil {
+		return m.CreationTimeNs
+	}
+	return 0
+}
+
+func (m *PaymentUpdate) GetHtlcs() []*HtlcAttempt {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+func (m *PaymentUpdate) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *PaymentUpdate) GetPaymentErr() string {
+	if m != nil {
+		return m.PaymentErr
+	}
+	return ""
+}
+
+type SendToRouteResponse struct {
+	// *
+	// The preimage of the payment, if the HTLC was settled.
+	Preimage []byte `protobuf:"bytes,1,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// The failure reported for the HTLC, if it couldn't be completed.
+	Failure              *Failure `protobuf:"bytes,2,opt,name=failure,proto3" json:"failure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SendToRouteResponse) Reset()         { *m = SendToRouteResponse{} }
+func (m *SendToRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*SendToRouteResponse) ProtoMessage()    {}
+func (*SendToRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{21}
+}
+func (m *SendToRouteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SendToRouteResponse.Unmarshal(m, b)
+}
+func (m *SendToRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SendToRouteResponse.Marshal(b, m, deterministic)
+}
+func (dst *SendToRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SendToRouteResponse.Merge(dst, src)
+}
+func (m *SendToRouteResponse) XXX_Size() int {
+	return xxx_messageInfo_SendToRouteResponse.Size(m)
+}
+func (m *SendToRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SendToRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SendToRouteResponse proto.InternalMessageInfo
+
+func (m *SendToRouteResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *SendToRouteResponse) GetFailure() *Failure {
+	if m != nil {
+		return m.Failure
+	}
+	return nil
+}
+
+type BuildRouteRequest struct {
+	// *
+	// The amount in milli-satoshis to be received by the last hop.
+	AmtMsat uint64 `protobuf:"varint,1,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	// *
+	// The CLTV delta of the final hop. If zero, the default delta is used.
+	FinalCltvDelta uint32 `protobuf:"varint,2,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	// *
+	// If non-zero, the short channel id of the channel to use for the first hop.
+	OutgoingChanId uint64 `protobuf:"varint,3,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The public keys of the nodes to traverse, in order, excluding our own node.
+	// The last key is the destination of the route.
+	HopPubkeys           [][]byte `protobuf:"bytes,4,rep,name=hop_pubkeys,json=hopPubkeys,proto3" json:"hop_pubkeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildRouteRequest) Reset()         { *m = BuildRouteRequest{} }
+func (m *BuildRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*BuildRouteRequest) ProtoMessage()    {}
+func (*BuildRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{22}
+}
+func (m *BuildRouteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildRouteRequest.Unmarshal(m, b)
+}
+func (m *BuildRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildRouteRequest.Marshal(b, m, deterministic)
+}
+func (dst *BuildRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildRouteRequest.Merge(dst, src)
+}
+func (m *BuildRouteRequest) XXX_Size() int {
+	return xxx_messageInfo_BuildRouteRequest.Size(m)
+}
+func (m *BuildRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildRouteRequest proto.InternalMessageInfo
+
+func (m *BuildRouteRequest) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *BuildRouteRequest) GetFinalCltvDelta() uint32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
+}
+
+func (m *BuildRouteRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *BuildRouteRequest) GetHopPubkeys() [][]byte {
+	if m != nil {
+		return m.HopPubkeys
+	}
+	return nil
+}
+
+type BuildRouteResponse struct {
+	// *
+	// The amount in milli-satoshis to send to the first hop, including the fees
+	// of all hops along the route.
+	TotalAmtMsat uint64 `protobuf:"varint,1,opt,name=total_amt_msat,json=totalAmtMsat,proto3" json:"total_amt_msat,omitempty"`
+	// *
+	// The absolute CLTV expiry of the HTLC sent to the first hop.
+	TotalTimeLock uint32 `protobuf:"varint,2,opt,name=total_time_lock,json=totalTimeLock,proto3" json:"total_time_lock,omitempty"`
+	// *
+	// The total fees in milli-satoshis paid to the hops along the route.
+	TotalFeesMsat uint64 `protobuf:"varint,3,opt,name=total_fees_msat,json=totalFeesMsat,proto3" json:"total_fees_msat,omitempty"`
+	// *
+	// The hops of the route, in the order in which they forward the HTLC.
+	Hops                 []*RouteHop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *BuildRouteResponse) Reset()         { *m = BuildRouteResponse{} }
+func (m *BuildRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*BuildRouteResponse) ProtoMessage()    {}
+func (*BuildRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{23}
+}
+func (m *BuildRouteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildRouteResponse.Unmarshal(m, b)
+}
+func (m *BuildRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildRouteResponse.Marshal(b, m, deterministic)
+}
+func (dst *BuildRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildRouteResponse.Merge(dst, src)
+}
+func (m *BuildRouteResponse) XXX_Size() int {
+	return xxx_messageInfo_BuildRouteResponse.Size(m)
+}
+func (m *BuildRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildRouteResponse proto.InternalMessageInfo
+
+func (m *BuildRouteResponse) GetTotalAmtMsat() uint64 {
+	if m != nil {
+		return m.TotalAmtMsat
+	}
+	return 0
+}
+
+func (m *BuildRouteResponse) GetTotalTimeLock() uint32 {
+	if m != nil {
+		return m.TotalTimeLock
+	}
+	return 0
+}
+
+func (m *BuildRouteResponse) GetTotalFeesMsat() uint64 {
+	if m != nil {
+		return m.TotalFeesMsat
+	}
+	return 0
+}
+
+func (m *BuildRouteResponse) GetHops() []*RouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+type ProbePaymentRequest struct {
+	// *
+	// The destination of the payment to probe.
+	Dest []byte `protobuf:"bytes,1,opt,name=dest,proto3" json:"dest,omitempty"`
+	// *
+	// The amount in satoshis of the payment to probe.
+	AmtSat int64 `protobuf:"varint,2,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	// *
+	// An absolute limit on the fee in satoshis of the routes that are probed.
+	FeeLimitSat int64 `protobuf:"varint,3,opt,name=fee_limit_sat,json=feeLimitSat,proto3" json:"fee_limit_sat,omitempty"`
+	// *
+	// The CLTV delta of the final hop. If zero, the default delta is used.
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	// *
+	// An absolute limit on the cumulative CLTV value along the routes that are
+	// probed. If zero, no limit is enforced.
+	CltvLimit int32 `protobuf:"varint,5,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	// *
+	// An upper limit in seconds on the amount of time spent probing. If zero,
+	// the default payment timeout is used.
+	TimeoutSeconds       int32    `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProbePaymentRequest) Reset()         { *m = ProbePaymentRequest{} }
+func (m *ProbePaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*ProbePaymentRequest) ProtoMessage()    {}
+func (*ProbePaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{24}
+}
+func (m *ProbePaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbePaymentRequest.Unmarshal(m, b)
+}
+func (m *ProbePaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbePaymentRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProbePaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbePaymentRequest.Merge(dst, src)
+}
+func (m *ProbePaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_ProbePaymentRequest.Size(m)
+}
+func (m *ProbePaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbePaymentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbePaymentRequest proto.InternalMessageInfo
+
+func (m *ProbePaymentRequest) GetDest() []byte {
+	if m != nil {
+		return m.Dest
+	}
+	return nil
+}
+
+func (m *ProbePaymentRequest) GetAmtSat() int64 {
+	if m != nil {
+		return m.AmtSat
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetFeeLimitSat() int64 {
+	if m != nil {
+		return m.FeeLimitSat
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetFinalCltvDelta() int32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetCltvLimit() int32 {
+	if m != nil {
+		return m.CltvLimit
+	}
+	return 0
+}
+
+func (m *ProbePaymentRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type ProbePaymentResponse struct {
+	// *
+	// The fee in milli-satoshis charged along the route that reached the
+	// destination.
+	RoutingFeeMsat int64 `protobuf:"varint,1,opt,name=routing_fee_msat,json=routingFeeMsat,proto3" json:"routing_fee_msat,omitempty"`
+	// *
+	// The total time lock of the route that reached the destination, which is
+	// the absolute CLTV expiry of the HTLC sent to its first hop.
+	TotalTimeLock int64 `protobuf:"varint,2,opt,name=total_time_lock,json=totalTimeLock,proto3" json:"total_time_lock,omitempty"`
+	// *
+	// The estimated probability that a payment along the route succeeds.
+	SuccessProbability float64 `protobuf:"fixed64,3,opt,name=success_probability,json=successProbability,proto3" json:"success_probability,omitempty"`
+	// *
+	// The number of routes that were probed, including the one that reached the
+	// destination.
+	NumAttempts uint32 `protobuf:"varint,4,opt,name=num_attempts,json=numAttempts,proto3" json:"num_attempts,omitempty"`
+	// *
+	// The hops of the route that reached the destination.
+	Hops                 []*RouteHop `protobuf:"bytes,5,rep,name=hops,proto3" json:"hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ProbePaymentResponse) Reset()         { *m = ProbePaymentResponse{} }
+func (m *ProbePaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*ProbePaymentResponse) ProtoMessage()    {}
+func (*ProbePaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{25}
+}
+func (m *ProbePaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProbePaymentResponse.Unmarshal(m, b)
+}
+func (m *ProbePaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProbePaymentResponse.Marshal(b, m, deterministic)
+}
+func (dst *ProbePaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProbePaymentResponse.Merge(dst, src)
+}
+func (m *ProbePaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_ProbePaymentResponse.Size(m)
+}
+func (m *ProbePaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProbePaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProbePaymentResponse proto.InternalMessageInfo
+
+func (m *ProbePaymentResponse) GetRoutingFeeMsat() int64 {
+	if m != nil {
+		return m.RoutingFeeMsat
+	}
+	return 0
+}
+
+func (m *ProbePaymentResponse) GetTotalTimeLock() int64 {
+	if m != nil {
+		return m.TotalTimeLock
+	}
+	return 0
+}
+
+func (m *ProbePaymentResponse) GetSuccessProbability() float64 {
+	if m != nil {
+		return m.SuccessProbability
+	}
+	return 0
+}
+
+func (m *ProbePaymentResponse) GetNumAttempts() uint32 {
+	if m != nil {
+		return m.NumAttempts
+	}
+	return 0
+}
+
+func (m *ProbePaymentResponse) GetHops() []*RouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+type RebalanceRequest struct {
+	// *
+	// The channel id of the channel to move local balance out of.
+	OutgoingChanId uint64 `protobuf:"varint,1,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The channel id of the channel to move local balance into.
+	IncomingChanId uint64 `protobuf:"varint,2,opt,name=incoming_chan_id,json=incomingChanId,proto3" json:"incoming_chan_id,omitempty"`
+	// *
+	// The amount in satoshis to move between the channels.
+	AmtSat int64 `protobuf:"varint,3,opt,name=amt_sat,json=amtSat,proto3" json:"amt_sat,omitempty"`
+	// *
+	// An absolute limit on the fee in satoshis paid to the nodes along the
+	// circular route.
+	FeeLimitSat          int64    `protobuf:"varint,4,opt,name=fee_limit_sat,json=feeLimitSat,proto3" json:"fee_limit_sat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebalanceRequest) Reset()         { *m = RebalanceRequest{} }
+func (m *RebalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*RebalanceRequest) ProtoMessage()    {}
+func (*RebalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{26}
+}
+func (m *RebalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceRequest.Unmarshal(m, b)
+}
+func (m *RebalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *RebalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceRequest.Merge(dst, src)
+}
+func (m *RebalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_RebalanceRequest.Size(m)
+}
+func (m *RebalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceRequest proto.InternalMessageInfo
+
+func (m *RebalanceRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetAmtSat() int64 {
+	if m != nil {
+		return m.AmtSat
+	}
+	return 0
+}
+
+func (m *RebalanceRequest) GetFeeLimitSat() int64 {
+	if m != nil {
+		return m.FeeLimitSat
+	}
+	return 0
+}
+
+type RebalanceResponse struct {
+	// *
+	// The payment hash of the circular payment.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty"`
+	// *
+	// The fee in milli-satoshis paid to the nodes along the circular route.
+	FeeMsat int64 `protobuf:"varint,2,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	// *
+	// The hops of the circular route, ending at our own node.
+	Hops                 []*RouteHop `protobuf:"bytes,3,rep,name=hops,proto3" json:"hops,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RebalanceResponse) Reset()         { *m = RebalanceResponse{} }
+func (m *RebalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*RebalanceResponse) ProtoMessage()    {}
+func (*RebalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{27}
+}
+func (m *RebalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebalanceResponse.Unmarshal(m, b)
+}
+func (m *RebalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebalanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *RebalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebalanceResponse.Merge(dst, src)
+}
+func (m *RebalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_RebalanceResponse.Size(m)
+}
+func (m *RebalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebalanceResponse proto.InternalMessageInfo
+
+func (m *RebalanceResponse) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *RebalanceResponse) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *RebalanceResponse) GetHops() []*RouteHop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+type UpdateChanStatusRequest struct {
+	// *
+	// The outpoint of the channel's funding transaction, formatted as
+	// funding_txid:output_index.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	// *
+	// The action to take on the channel's status.
+	Action               ChanStatusAction `protobuf:"varint,2,opt,name=action,proto3,enum=routerrpc.ChanStatusAction" json:"action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *UpdateChanStatusRequest) Reset()         { *m = UpdateChanStatusRequest{} }
+func (m *UpdateChanStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateChanStatusRequest) ProtoMessage()    {}
+func (*UpdateChanStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{28}
+}
+func (m *UpdateChanStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChanStatusRequest.Unmarshal(m, b)
+}
+func (m *UpdateChanStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChanStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateChanStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChanStatusRequest.Merge(dst, src)
+}
+func (m *UpdateChanStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateChanStatusRequest.Size(m)
+}
+func (m *UpdateChanStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChanStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateChanStatusRequest proto.InternalMessageInfo
+
+func (m *UpdateChanStatusRequest) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *UpdateChanStatusRequest) GetAction() ChanStatusAction {
+	if m != nil {
+		return m.Action
+	}
+	return ChanStatusAction_ENABLE
+}
+
+type UpdateChanStatusResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateChanStatusResponse) Reset()         { *m = UpdateChanStatusResponse{} }
+func (m *UpdateChanStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateChanStatusResponse) ProtoMessage()    {}
+func (*UpdateChanStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_router_5daa69bf124ba6ef, []int{29}
+}
+func (m *UpdateChanStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateChanStatusResponse.Unmarshal(m, b)
+}
+func (m *UpdateChanStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateChanStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateChanStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateChanStatusResponse.Merge(dst, src)
+}
+func (m *UpdateChanStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateChanStatusResponse.Size(m)
+}
+func (m *UpdateChanStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateChanStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateChanStatusResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*PaymentRequest)(nil), "routerrpc.PaymentRequest")
+	proto.RegisterType((*PaymentResponse)(nil), "routerrpc.PaymentResponse")
+	proto.RegisterType((*RouteFeeRequest)(nil), "routerrpc.RouteFeeRequest")
+	proto.RegisterType((*RouteFeeResponse)(nil), "routerrpc.RouteFeeResponse")
+	proto.RegisterType((*TrackPaymentRequest)(nil), "routerrpc.TrackPaymentRequest")
+	proto.RegisterType((*PaymentStatus)(nil), "routerrpc.PaymentStatus")
+	proto.RegisterType((*CircuitKey)(nil), "routerrpc.CircuitKey")
+	proto.RegisterType((*ForwardHtlcInterceptRequest)(nil), "routerrpc.ForwardHtlcInterceptRequest")
+	proto.RegisterType((*ForwardHtlcInterceptResponse)(nil), "routerrpc.ForwardHtlcInterceptResponse")
+	proto.RegisterType((*QueryMissionControlRequest)(nil), "routerrpc.QueryMissionControlRequest")
+	proto.RegisterType((*PairHistory)(nil), "routerrpc.PairHistory")
+	proto.RegisterType((*QueryMissionControlResponse)(nil), "routerrpc.QueryMissionControlResponse")
+	proto.RegisterType((*ResetMissionControlRequest)(nil), "routerrpc.ResetMissionControlRequest")
+	proto.RegisterType((*ResetMissionControlResponse)(nil), "routerrpc.ResetMissionControlResponse")
+	proto.RegisterType((*XImportMissionControlRequest)(nil), "routerrpc.XImportMissionControlRequest")
+	proto.RegisterType((*XImportMissionControlResponse)(nil), "routerrpc.XImportMissionControlResponse")
+	proto.RegisterType((*RouteHop)(nil), "routerrpc.RouteHop")
+	proto.RegisterType((*SendToRouteRequest)(nil), "routerrpc.SendToRouteRequest")
+	proto.RegisterType((*Failure)(nil), "routerrpc.Failure")
+	proto.RegisterType((*HtlcAttempt)(nil), "routerrpc.HtlcAttempt")
+	proto.RegisterType((*PaymentUpdate)(nil), "routerrpc.PaymentUpdate")
+	proto.RegisterType((*SendToRouteResponse)(nil), "routerrpc.SendToRouteResponse")
+	proto.RegisterType((*BuildRouteRequest)(nil), "routerrpc.BuildRouteRequest")
+	proto.RegisterType((*BuildRouteResponse)(nil), "routerrpc.BuildRouteResponse")
+	proto.RegisterType((*ProbePaymentRequest)(nil), "routerrpc.ProbePaymentRequest")
+	proto.RegisterType((*ProbePaymentResponse)(nil), "routerrpc.ProbePaymentResponse")
+	proto.RegisterType((*RebalanceRequest)(nil), "routerrpc.RebalanceRequest")
+	proto.RegisterType((*RebalanceResponse)(nil), "routerrpc.RebalanceResponse")
+	proto.RegisterType((*UpdateChanStatusRequest)(nil), "routerrpc.UpdateChanStatusRequest")
+	proto.RegisterType((*UpdateChanStatusResponse)(nil), "routerrpc.UpdateChanStatusResponse")
+	proto.RegisterEnum("routerrpc.PaymentState", PaymentState_name, PaymentState_value)
+	proto.RegisterEnum("routerrpc.ResolveHoldForwardAction", ResolveHoldForwardAction_name, ResolveHoldForwardAction_value)
+	proto.RegisterEnum("routerrpc.ChanStatusAction", ChanStatusAction_name, ChanStatusAction_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RouterClient is the client API for Router service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RouterClient interface {
+	// *
+	// SendPayment attempts to route a payment described by the passed
+	// PaymentRequest to the final destination. If we are unable to route the
+	// payment, or cannot find a route that satisfies the constraints in the
+	// PaymentRequest, then an error will be returned. Otherwise, the payment
+	// pre-image, along with the final route will be returned.
+	SendPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error)
+	// *
+	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
+	// may cost to send an HTLC to the target end destination.
+	EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error)
+	// *
+	// TrackPayment returns an update stream for the payment identified by the
+	// payment hash. If the payment is still in flight, its current state is sent
+	// first, followed by its final outcome once the payment has completed. The
+	// stream is closed once the final outcome has been sent.
+	TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error)
+	// *
+	// TrackPaymentV2 returns an update stream for a payment that was dispatched
+	// with no_wait set. An update is sent for the current state of the payment,
+	// and subsequently whenever an HTLC of the payment is attempted or fails.
+	// The stream is closed once an update for the final outcome of the payment
+	// has been sent. In-flight payments are resumed across restarts, so their
+	// progress can be tracked again after the daemon was restarted.
+	TrackPaymentV2(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentV2Client, error)
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC in which every
+	// forwarded HTLC is sent to the client, and held until the client responds
+	// with whether it should be settled, failed or resumed. Only a single
+	// interceptor may be active at a time. Once the client disconnects, all HTLCs
+	// held on its behalf are resumed.
+	HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error)
+	// *
+	// QueryMissionControl exposes the internal reliability memory of mission
+	// control: the latest result of routing an HTLC between each pair of nodes.
+	QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error)
+	// *
+	// ResetMissionControl clears all mission control state, both in memory and
+	// on disk.
+	ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error)
+	// *
+	// XImportMissionControl is an experimental API that adds the given pair
+	// results to the reliability memory of mission control. Results that are
+	// older than the ones already known for the same pairs are ignored.
+	XImportMissionControl(ctx context.Context, in *XImportMissionControlRequest, opts ...grpc.CallOption) (*XImportMissionControlResponse, error)
+	// *
+	// SendToRouteV2 dispatches a single HTLC along the given explicit route. In
+	// contrast to SendToRoute of the main RPC service, no further attempts are
+	// made if the HTLC fails. Instead, the decoded failure is returned, along
+	// with the node that reported it.
+	SendToRouteV2(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendToRouteResponse, error)
+	// *
+	// BuildRoute completes a route along the given ordered list of nodes, using
+	// the channel policies of the local graph to fill in the fees and time
+	// locks of each hop. The returned route can be passed to SendToRouteV2 as
+	// is. If multiple channels exist between two consecutive nodes, the one
+	// charging the highest fee is used.
+	BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error)
+	// *
+	// ProbePayment sends a payment with a random payment hash through the
+	// network, which the destination is guaranteed to reject. As no funds are
+	// transferred, this can be used to find a route that is able to carry a
+	// payment and to learn its actual fee and success probability before
+	// committing to the real payment.
+	ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbePaymentResponse, error)
+	// *
+	// Rebalance moves local balance from one of our channels to another by
+	// paying ourselves along a circular route, which leaves through the
+	// outgoing channel and returns through the incoming channel.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error)
+	// *
+	// UpdateChanStatus attempts to manually set the state of a channel
+	// (enabled, disabled, or auto). A manual "disable" request will cause the
+	// channel to stay disabled until a subsequent manual request of either
+	// "enable" or "auto". The manual state is only kept in memory, so all
+	// channels revert to automatic management when lnd is restarted.
+	UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error)
+}
+
+type routerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRouterClient(cc *grpc.ClientConn) RouterClient {
+	return &routerClient{cc}
+}
+
+func (c *routerClient) SendPayment(ctx context.Context, in *PaymentRequest, opts ...grpc.CallOption) (*PaymentResponse, error) {
+	out := new(PaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SendPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) EstimateRouteFee(ctx context.Context, in *RouteFeeRequest, opts ...grpc.CallOption) (*RouteFeeResponse, error) {
+	out := new(RouteFeeResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/EstimateRouteFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[0], "/routerrpc.Router/TrackPayment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerTrackPaymentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_TrackPaymentClient interface {
+	Recv() (*PaymentStatus, error)
+	grpc.ClientStream
+}
+
+type routerTrackPaymentClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerTrackPaymentClient) Recv() (*PaymentStatus, error) {
+	m := new(PaymentStatus)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) TrackPaymentV2(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentV2Client, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[1], "/routerrpc.Router/TrackPaymentV2", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerTrackPaymentV2Client{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_TrackPaymentV2Client interface {
+	Recv() (*PaymentUpdate, error)
+	grpc.ClientStream
+}
+
+type routerTrackPaymentV2Client struct {
+	grpc.ClientStream
+}
+
+func (x *routerTrackPaymentV2Client) Recv() (*PaymentUpdate, error) {
+	m := new(PaymentUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Router_serviceDesc.Streams[2], "/routerrpc.Router/HtlcInterceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerHtlcInterceptorClient{stream}
+	return x, nil
+}
+
+type Router_HtlcInterceptorClient interface {
+	Send(*ForwardHtlcInterceptResponse) error
+	Recv() (*ForwardHtlcInterceptRequest, error)
+	grpc.ClientStream
+}
+
+type routerHtlcInterceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerHtlcInterceptorClient) Send(m *ForwardHtlcInterceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *routerHtlcInterceptorClient) Recv() (*ForwardHtlcInterceptRequest, error) {
+	m := new(ForwardHtlcInterceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *routerClient) QueryMissionControl(ctx context.Context, in *QueryMissionControlRequest, opts ...grpc.CallOption) (*QueryMissionControlResponse, error) {
+	out := new(QueryMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/QueryMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ResetMissionControl(ctx context.Context, in *ResetMissionControlRequest, opts ...grpc.CallOption) (*ResetMissionControlResponse, error) {
+	out := new(ResetMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ResetMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) XImportMissionControl(ctx context.Context, in *XImportMissionControlRequest, opts ...grpc.CallOption) (*XImportMissionControlResponse, error) {
+	out := new(XImportMissionControlResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/XImportMissionControl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SendToRouteV2(ctx context.Context, in *SendToRouteRequest, opts ...grpc.CallOption) (*SendToRouteResponse, error) {
+	out := new(SendToRouteResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SendToRouteV2", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error) {
+	out := new(BuildRouteResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/BuildRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ProbePayment(ctx context.Context, in *ProbePaymentRequest, opts ...grpc.CallOption) (*ProbePaymentResponse, error) {
+	out := new(ProbePaymentResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ProbePayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error) {
+	out := new(RebalanceResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/Rebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) UpdateChanStatus(ctx context.Context, in *UpdateChanStatusRequest, opts ...grpc.CallOption) (*UpdateChanStatusResponse, error) {
+	out := new(UpdateChanStatusResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/UpdateChanStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RouterServer is the server API for Router service.
+type RouterServer interface {
+	// *
+	// SendPayment attempts to route a payment described by the passed
+	// PaymentRequest to the final destination. If we are unable to route the
+	// payment, or cannot find a route that satisfies the constraints in the
+	// PaymentRequest, then an error will be returned. Otherwise, the payment
+	// pre-image, along with the final route will be returned.
+	SendPayment(context.Context, *PaymentRequest) (*PaymentResponse, error)
+	// *
+	// EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
+	// may cost to send an HTLC to the target end destination.
+	EstimateRouteFee(context.Context, *RouteFeeRequest) (*RouteFeeResponse, error)
+	// *
+	// TrackPayment returns an update stream for the payment identified by the
+	// payment hash. If the payment is still in flight, its current state is sent
+	// first, followed by its final outcome once the payment has completed. The
+	// stream is closed once the final outcome has been sent.
+	TrackPayment(*TrackPaymentRequest, Router_TrackPaymentServer) error
+	// *
+	// TrackPaymentV2 returns an update stream for a payment that was dispatched
+	// with no_wait set. An update is sent for the current state of the payment,
+	// and subsequently whenever an HTLC of the payment is attempted or fails.
+	// The stream is closed once an update for the final outcome of the payment
+	// has been sent. In-flight payments are resumed across restarts, so their
+	// progress can be tracked again after the daemon was restarted.
+	TrackPaymentV2(*TrackPaymentRequest, Router_TrackPaymentV2Server) error
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC in which every
+	// forwarded HTLC is sent to the client, and held until the client responds
+	// with whether it should be settled, failed or resumed. Only a single
+	// interceptor may be active at a time. Once the client disconnects, all HTLCs
+	// held on its behalf are resumed.
+	HtlcInterceptor(Router_HtlcInterceptorServer) error
+	// *
+	// QueryMissionControl exposes the internal reliability memory of mission
+	// control: the latest result of routing an HTLC between each pair of nodes.
+	QueryMissionControl(context.Context, *QueryMissionControlRequest) (*QueryMissionControlResponse, error)
+	// *
+	// ResetMissionControl clears all mission control state, both in memory and
+	// on disk.
+	ResetMissionControl(context.Context, *ResetMissionControlRequest) (*ResetMissionControlResponse, error)
+	// *
+	// XImportMissionControl is an experimental API that adds the given pair
+	// results to the reliability memory of mission control. Results that are
+	// older than the ones already known for the same pairs are ignored.
+	XImportMissionControl(context.Context, *XImportMissionControlRequest) (*XImportMissionControlResponse, error)
+	// *
+	// SendToRouteV2 dispatches a single HTLC along the given explicit route. In
+	// contrast to SendToRoute of the main RPC service, no further attempts are
+	// made if the HTLC fails. Instead, the decoded failure is returned, along
+	// with the node that reported it.
+	SendToRouteV2(context.Context, *SendToRouteRequest) (*SendToRouteResponse, error)
+	// *
+	// BuildRoute completes a route along the given ordered list of nodes, using
+	// the channel policies of the local graph to fill in the fees and time
+	// locks of each hop. The returned route can be passed to SendToRouteV2 as
+	// is. If multiple channels exist between two consecutive nodes, the one
+	// charging the highest fee is used.
+	BuildRoute(context.Context, *BuildRouteRequest) (*BuildRouteResponse, error)
+	// *
+	// ProbePayment sends a payment with a random payment hash through the
+	// network, which the destination is guaranteed to reject. As no funds are
+	// transferred, this can be used to find a route that is able to carry a
+	// payment and to learn its actual fee and success probability before
+	// committing to the real payment.
+	ProbePayment(context.Context, *ProbePaymentRequest) (*ProbePaymentResponse, error)
+	// *
+	// Rebalance moves local balance from one of our channels to another by
+	// paying ourselves along a circular route, which leaves through the
+	// outgoing channel and returns through the incoming channel.
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error)
+	// *
+	// UpdateChanStatus attempts to manually set the state of a channel
+	// (enabled, disabled, or auto). A manual "disable" request will cause the
+	// channel to stay disabled until a subsequent manual request of either
+	// "enable" or "auto". The manual state is only kept in memory, so all
+	// channels revert to automatic management when lnd is restarted.
+	UpdateChanStatus(context.Context, *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error)
+}
+
+func RegisterRouterServer(s *grpc.Server, srv RouterServer) {
+	s.RegisterService(&_Router_serviceDesc, srv)
+}
+
+func _Router_SendPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SendPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SendPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SendPayment(ctx, req.(*PaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_EstimateRouteFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RouteFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).EstimateRouteFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/EstimateRouteFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).EstimateRouteFee(ctx, req.(*RouteFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_TrackPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrackPaymentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).TrackPayment(m, &routerTrackPaymentServer{stream})
+}
+
+type Router_TrackPaymentServer interface {
+	Send(*PaymentStatus) error
+	grpc.ServerStream
+}
+
+type routerTrackPaymentServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerTrackPaymentServer) Send(m *PaymentStatus) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_TrackPaymentV2_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrackPaymentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).TrackPaymentV2(m, &routerTrackPaymentV2Server{stream})
+}
+
+type Router_TrackPaymentV2Server interface {
+	Send(*PaymentUpdate) error
+	grpc.ServerStream
+}
+
+type routerTrackPaymentV2Server struct {
+	grpc.ServerStream
+}
+
+func (x *routerTrackPaymentV2Server) Send(m *PaymentUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Router_HtlcInterceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouterServer).HtlcInterceptor(&routerHtlcInterceptorServer{stream})
+}
+
+type Router_HtlcInterceptorServer interface {
+	Send(*ForwardHtlcInterceptRequest) error
+	Recv() (*ForwardHtlcInterceptResponse, error)
+	grpc.ServerStream
+}
+
+type routerHtlcInterceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerHtlcInterceptorServer) Send(m *ForwardHtlcInterceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *routerHtlcInterceptorServer) Recv() (*ForwardHtlcInterceptResponse, error) {
+	m := new(ForwardHtlcInterceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Router_QueryMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).QueryMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/QueryMissionControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).QueryMissionControl(ctx, req.(*QueryMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ResetMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ResetMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ResetMissionControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ResetMissionControl(ctx, req.(*ResetMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_XImportMissionControl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(XImportMissionControlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).XImportMissionControl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/XImportMissionControl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).XImportMissionControl(ctx, req.(*XImportMissionControlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SendToRouteV2_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendToRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SendToRouteV2(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SendToRouteV2",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SendToRouteV2(ctx, req.(*SendToRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_BuildRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).BuildRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/BuildRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).BuildRoute(ctx, req.(*BuildRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ProbePayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbePaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ProbePayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ProbePayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ProbePayment(ctx, req.(*ProbePaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_UpdateChanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateChanStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).UpdateChanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/UpdateChanStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).UpdateChanStatus(ctx, req.(*UpdateChanStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Router_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "routerrpc.Router",
+	HandlerType: (*RouterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendPayment",
+			Handler:    _Router_SendPayment_Handler,
+		},
+		{
+			MethodName: "EstimateRouteFee",
+			Handler:    _Router_EstimateRouteFee_Handler,
+		},
+		{
+			MethodName: "QueryMissionControl",
+			Handler:    _Router_QueryMissionControl_Handler,
+		},
+		{
+			MethodName: "ResetMissionControl",
+			Handler:    _Router_ResetMissionControl_Handler,
+		},
+		{
+			MethodName: "XImportMissionControl",
+			Handler:    _Router_XImportMissionControl_Handler,
+		},
+		{
+			MethodName: "SendToRouteV2",
+			Handler:    _Router_SendToRouteV2_Handler,
+		},
+		{
+			MethodName: "BuildRoute",
+			Handler:    _Router_BuildRoute_Handler,
+		},
+		{
+			MethodName: "ProbePayment",
+			Handler:    _Router_ProbePayment_Handler,
+		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _Router_Rebalance_Handler,
+		},
+		{
+			MethodName: "UpdateChanStatus",
+			Handler:    _Router_UpdateChanStatus_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TrackPayment",
+			Handler:       _Router_TrackPayment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TrackPaymentV2",
+			Handler:       _Router_TrackPaymentV2_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "HtlcInterceptor",
+			Handler:       _Router_HtlcInterceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "routerrpc/router.proto",
+}
+
+func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_5daa69bf124ba6ef) }
 
-func init() { proto.RegisterFile("routerrpc/router.proto", fileDescriptor_router_e218e7c710fe8172) }
-
-var fileDescriptor_router_e218e7c710fe8172 = []byte{
-	// 409 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
-	0x14, 0xc6, 0x15, 0xb6, 0x85, 0xe5, 0x74, 0x6b, 0x8b, 0x91, 0x20, 0xeb, 0x84, 0xa8, 0x72, 0x01,
-	0xb9, 0x0a, 0x12, 0xdc, 0x73, 0xc3, 0x36, 0x31, 0x31, 0x24, 0xe4, 0x3e, 0x80, 0x65, 0x92, 0xb3,
-	0x26, 0x34, 0x8e, 0x5d, 0xdb, 0x41, 0xca, 0xb3, 0xf0, 0x3c, 0xbc, 0x17, 0xb2, 0xe3, 0x96, 0x82,
-	0x7a, 0xe7, 0x7c, 0xe7, 0xef, 0xf7, 0x3b, 0x81, 0x17, 0x5a, 0xf6, 0x16, 0xb5, 0x56, 0xe5, 0xbb,
-	0xf1, 0x55, 0x28, 0x2d, 0xad, 0x24, 0xc9, 0x5e, 0xcf, 0x7e, 0x47, 0x30, 0xfd, 0xc6, 0x07, 0x81,
-	0x9d, 0xa5, 0xb8, 0xed, 0xd1, 0x58, 0xf2, 0x12, 0x9e, 0x2a, 0x3e, 0x30, 0x8d, 0xdb, 0x34, 0x5a,
-	0x46, 0x79, 0x42, 0x63, 0xc5, 0x07, 0x8a, 0x5b, 0x92, 0xc1, 0xe5, 0x23, 0x22, 0x6b, 0x1b, 0xd1,
-	0x58, 0x66, 0xb8, 0x4d, 0x9f, 0x2c, 0xa3, 0xfc, 0x84, 0x4e, 0x1e, 0x11, 0x1f, 0x9c, 0xb6, 0xe2,
-	0x96, 0xbc, 0x02, 0x28, 0x5b, 0xfb, 0x73, 0x4c, 0x4a, 0x4f, 0x96, 0x51, 0x7e, 0x46, 0x13, 0xa7,
-	0xf8, 0x0c, 0xf2, 0x16, 0x66, 0xb6, 0x11, 0x28, 0x7b, 0xcb, 0x0c, 0x96, 0xb2, 0xab, 0x4c, 0x7a,
-	0xea, 0x73, 0xa6, 0x41, 0x5e, 0x8d, 0x2a, 0x29, 0xe0, 0xb9, 0xec, 0xed, 0x5a, 0x36, 0xdd, 0x9a,
-	0x95, 0x35, 0xef, 0x3a, 0x6c, 0x59, 0x53, 0xa5, 0x67, 0x7e, 0xe2, 0xb3, 0x5d, 0xe8, 0xd3, 0x18,
-	0xb9, 0xaf, 0xb2, 0x1f, 0x30, 0xdb, 0xdb, 0x30, 0x4a, 0x76, 0x06, 0xc9, 0x15, 0x9c, 0x3b, 0x1f,
-	0x35, 0x37, 0xb5, 0x37, 0x72, 0x41, 0x9d, 0xaf, 0xcf, 0xdc, 0xd4, 0xe4, 0x1a, 0x12, 0xa5, 0x91,
-	0x35, 0x82, 0xaf, 0xd1, 0xbb, 0xb8, 0xa0, 0xe7, 0x4a, 0xe3, 0xbd, 0xfb, 0x26, 0xaf, 0x61, 0xa2,
-	0xc6, 0x56, 0x0c, 0xb5, 0xf6, 0x1e, 0x12, 0x0a, 0x41, 0xba, 0xd5, 0x3a, 0xfb, 0x08, 0x33, 0xea,
-	0x00, 0xde, 0x21, 0xee, 0x98, 0x11, 0x38, 0xad, 0xd0, 0xd8, 0x30, 0xc7, 0xbf, 0x1d, 0x47, 0x2e,
-	0x0e, 0x41, 0xc5, 0x5c, 0x38, 0x46, 0x59, 0x05, 0xf3, 0xbf, 0xf5, 0x61, 0xd9, 0x1c, 0xe6, 0xee,
-	0x28, 0xce, 0xae, 0x63, 0x2c, 0x5c, 0x55, 0xe4, 0xab, 0xa6, 0x41, 0xbf, 0x43, 0xfc, 0x6a, 0xb8,
-	0x25, 0x6f, 0x46, 0x84, 0xac, 0x95, 0xe5, 0x86, 0x55, 0xd8, 0xf2, 0x21, 0xb4, 0xbf, 0x74, 0xf2,
-	0x83, 0x2c, 0x37, 0x37, 0x4e, 0x7c, 0xff, 0x2b, 0x82, 0xd8, 0x8f, 0xd1, 0xe4, 0x06, 0x26, 0x2b,
-	0xec, 0xaa, 0x00, 0x88, 0x5c, 0x15, 0xfb, 0xfb, 0x17, 0xff, 0xde, 0x7e, 0xb1, 0x38, 0x16, 0x0a,
-	0x2b, 0x7e, 0x81, 0xf9, 0xad, 0xb1, 0x8d, 0xe0, 0x16, 0x77, 0xeb, 0x93, 0xc3, 0xfc, 0xff, 0x98,
-	0x2c, 0xae, 0x8f, 0xc6, 0xc6, 0x66, 0xdf, 0x63, 0xff, 0x27, 0x7e, 0xf8, 0x13, 0x00, 0x00, 0xff,
-	0xff, 0x95, 0xc2, 0xf8, 0xec, 0xa3, 0x02, 0x00, 0x00,
+var fileDescriptor_router_5daa69bf124ba6ef = []byte{
+	// 1914 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x5b, 0x8f, 0xdb, 0xc6,
+	0x15, 0x0e, 0xa5, 0x5d, 0x5d, 0x8e, 0x2e, 0x2b, 0x8f, 0x7c, 0x91, 0xb5, 0xbb, 0xf1, 0x86, 0x69,
+	0x63, 0xc1, 0x48, 0x9c, 0x85, 0x82, 0x02, 0x01, 0x52, 0x14, 0x58, 0x6b, 0xa5, 0xac, 0xea, 0xb5,
+	0xb3, 0xa5, 0xe4, 0xb4, 0x40, 0x1f, 0x88, 0x11, 0x39, 0xf2, 0x32, 0x26, 0x39, 0x34, 0x39, 0xdc,
+	0x44, 0xed, 0x5b, 0x0b, 0xf4, 0x1f, 0xf4, 0xa9, 0x68, 0x5f, 0xf2, 0xde, 0x97, 0xfe, 0x99, 0xbe,
+	0x17, 0xed, 0xef, 0x28, 0xe6, 0x42, 0x2e, 0x29, 0x71, 0x2f, 0x36, 0x0a, 0xf4, 0x4d, 0x3c, 0x73,
+	0xce, 0x99, 0x33, 0xdf, 0xb9, 0x0b, 0xee, 0x87, 0x34, 0x66, 0x24, 0x0c, 0x03, 0xeb, 0x73, 0xf9,
+	0xeb, 0x69, 0x10, 0x52, 0x46, 0x51, 0x3d, 0xa5, 0xeb, 0x7f, 0x2a, 0x41, 0xfb, 0x0c, 0xaf, 0x3c,
+	0xe2, 0x33, 0x83, 0xbc, 0x8d, 0x49, 0xc4, 0xd0, 0x03, 0xa8, 0x06, 0x78, 0x65, 0x86, 0xe4, 0x6d,
+	0x4f, 0x3b, 0xd0, 0x06, 0x75, 0xa3, 0x12, 0xe0, 0x95, 0x41, 0xde, 0x22, 0x1d, 0x5a, 0x4b, 0x42,
+	0x4c, 0xd7, 0xf1, 0x1c, 0x66, 0x46, 0x98, 0xf5, 0x4a, 0x07, 0xda, 0xa0, 0x6c, 0x34, 0x96, 0x84,
+	0x9c, 0x72, 0xda, 0x0c, 0x33, 0xb4, 0x0f, 0x60, 0xb9, 0xec, 0x42, 0x32, 0xf5, 0xca, 0x07, 0xda,
+	0x60, 0xdb, 0xa8, 0x73, 0x8a, 0xe0, 0x40, 0x8f, 0x61, 0x87, 0x39, 0x1e, 0xa1, 0x31, 0x33, 0x23,
+	0x62, 0x51, 0xdf, 0x8e, 0x7a, 0x5b, 0x82, 0xa7, 0xad, 0xc8, 0x33, 0x49, 0x45, 0x4f, 0xa1, 0x4b,
+	0x63, 0xf6, 0x9a, 0x3a, 0xfe, 0x6b, 0xd3, 0x3a, 0xc7, 0xbe, 0x4f, 0x5c, 0xd3, 0xb1, 0x7b, 0xdb,
+	0xe2, 0xc6, 0x3b, 0xc9, 0xd1, 0x48, 0x9e, 0x4c, 0x6d, 0x6e, 0xb4, 0x4f, 0xcd, 0xef, 0xb1, 0xc3,
+	0x7a, 0x95, 0x03, 0x6d, 0x50, 0x33, 0x2a, 0x3e, 0xfd, 0x35, 0x76, 0x18, 0xfa, 0x04, 0x76, 0x5c,
+	0x1c, 0x31, 0xf3, 0x9c, 0x06, 0x66, 0x10, 0x2f, 0xde, 0x90, 0x55, 0xaf, 0x7a, 0xa0, 0x0d, 0x9a,
+	0x46, 0x8b, 0x93, 0x4f, 0x68, 0x70, 0x26, 0x88, 0xfa, 0x77, 0xb0, 0x93, 0xe2, 0x10, 0x05, 0xd4,
+	0x8f, 0x08, 0x7a, 0x08, 0x35, 0x0e, 0xc4, 0x39, 0x8e, 0xce, 0x05, 0x12, 0x4d, 0x83, 0x03, 0x73,
+	0x82, 0xa3, 0x73, 0xb4, 0x0b, 0xf5, 0x20, 0x24, 0xa6, 0xe3, 0xe1, 0xd7, 0x44, 0xc0, 0xd0, 0x34,
+	0x6a, 0x41, 0x48, 0xa6, 0xfc, 0x1b, 0x3d, 0x82, 0x46, 0x20, 0x55, 0x99, 0x24, 0x0c, 0x05, 0x08,
+	0x75, 0x03, 0x14, 0x69, 0x1c, 0x86, 0xfa, 0x2f, 0x60, 0xc7, 0xe0, 0x1e, 0x98, 0x10, 0x92, 0x80,
+	0x8e, 0x60, 0xcb, 0x26, 0x11, 0x53, 0xf7, 0x88, 0xdf, 0xfc, 0x4d, 0xd8, 0xcb, 0x22, 0x5d, 0xc1,
+	0x1e, 0x07, 0x59, 0xb7, 0xa1, 0x73, 0x29, 0xaf, 0x8c, 0x1d, 0x40, 0x87, 0x7b, 0x95, 0xe3, 0xc5,
+	0x9d, 0xe4, 0x71, 0x29, 0x4d, 0x48, 0xb5, 0x15, 0x7d, 0x42, 0xc8, 0x8b, 0x08, 0x0b, 0x44, 0x38,
+	0xd8, 0xa6, 0x4b, 0xad, 0x37, 0xa6, 0x4d, 0x5c, 0xbc, 0x52, 0xea, 0x5b, 0x9c, 0x7c, 0x4a, 0xad,
+	0x37, 0xc7, 0x9c, 0xa8, 0x7f, 0x09, 0xdd, 0x79, 0x88, 0xad, 0x37, 0x6b, 0xe1, 0xf1, 0x11, 0x34,
+	0x93, 0xd7, 0x65, 0x90, 0x49, 0x5e, 0xcc, 0xd1, 0xd1, 0x7f, 0x0f, 0x2d, 0x25, 0x34, 0x63, 0x98,
+	0xc5, 0x11, 0xfa, 0x0c, 0xb6, 0x23, 0x86, 0x19, 0x11, 0xcc, 0xed, 0xe1, 0x83, 0xa7, 0x69, 0x00,
+	0x3e, 0xcd, 0x30, 0x12, 0x43, 0x72, 0xa1, 0x3e, 0x70, 0x30, 0xd7, 0xc1, 0x75, 0x6e, 0x0b, 0x2e,
+	0x8c, 0x9c, 0xd0, 0x8a, 0x1d, 0xf6, 0x9c, 0xac, 0x38, 0x86, 0x3c, 0x7c, 0x78, 0xec, 0xf0, 0xbb,
+	0xb7, 0x8c, 0x0a, 0xff, 0x94, 0x01, 0x73, 0xce, 0x5c, 0x8b, 0x1f, 0x94, 0xe4, 0x01, 0xff, 0x9c,
+	0xda, 0xfa, 0x5f, 0xca, 0xb0, 0x3b, 0xa1, 0xe1, 0xf7, 0x38, 0xb4, 0x4f, 0x38, 0xc5, 0x67, 0x24,
+	0xb4, 0x48, 0x90, 0xbe, 0xff, 0x6b, 0xb8, 0xeb, 0xf8, 0x16, 0xf5, 0x44, 0x64, 0xca, 0x8b, 0x4c,
+	0x1e, 0x55, 0x5c, 0x7d, 0x63, 0x78, 0x2f, 0xf3, 0xb4, 0x4b, 0x33, 0x0c, 0x94, 0x88, 0x64, 0x4c,
+	0x3b, 0xcc, 0x28, 0xc2, 0x1e, 0x8d, 0x7d, 0x26, 0xbd, 0x26, 0xcd, 0x49, 0x25, 0x8e, 0xc4, 0x91,
+	0xf0, 0xdc, 0x63, 0xd8, 0x49, 0x25, 0xc8, 0x0f, 0x81, 0x13, 0xae, 0xc4, 0xfb, 0x5b, 0x46, 0x3b,
+	0x21, 0x8f, 0x05, 0x75, 0xc3, 0x47, 0x5b, 0x1b, 0x3e, 0x42, 0x5f, 0x41, 0x3f, 0x4d, 0xb0, 0x50,
+	0x3e, 0x8d, 0xd8, 0x66, 0x82, 0xd5, 0xb6, 0xb0, 0xe1, 0x41, 0xc2, 0x61, 0x24, 0x0c, 0x23, 0x09,
+	0xde, 0x21, 0xdc, 0x4d, 0x85, 0xb3, 0xa6, 0x57, 0xa4, 0xe9, 0xc9, 0x59, 0xde, 0xf4, 0x54, 0x42,
+	0x99, 0x5e, 0x95, 0xa6, 0x27, 0x64, 0x65, 0xfa, 0x3e, 0x00, 0xf5, 0x1d, 0xea, 0x9b, 0x0b, 0x97,
+	0x2e, 0x7a, 0x35, 0x61, 0x78, 0x5d, 0x50, 0x9e, 0xb9, 0x74, 0xa1, 0xff, 0x4b, 0x83, 0xbd, 0x62,
+	0xef, 0xa8, 0x3c, 0xf8, 0x9f, 0xb9, 0xe7, 0x2b, 0xa8, 0x60, 0x8b, 0x39, 0xd4, 0x17, 0x0e, 0x69,
+	0x0f, 0x3f, 0xce, 0x88, 0x1a, 0x24, 0xa2, 0xee, 0x05, 0x39, 0xa1, 0xae, 0xad, 0x8c, 0x39, 0x12,
+	0xac, 0x86, 0x12, 0xc9, 0x45, 0x70, 0x79, 0x2d, 0x82, 0x3f, 0x82, 0xe6, 0x12, 0x3b, 0x6e, 0x1c,
+	0x12, 0xd3, 0xa2, 0x36, 0x11, 0xce, 0x69, 0x19, 0x0d, 0x45, 0x1b, 0x51, 0x9b, 0xe8, 0x7b, 0xd0,
+	0xff, 0x55, 0x4c, 0xc2, 0xd5, 0x0b, 0x27, 0x8a, 0x1c, 0xea, 0x8f, 0xa8, 0xcf, 0x42, 0xea, 0x2a,
+	0x2f, 0xe8, 0x7f, 0xd3, 0xa0, 0x71, 0x86, 0x9d, 0xf0, 0xc4, 0x89, 0x18, 0x0d, 0x57, 0xbc, 0x18,
+	0xf9, 0xd4, 0x26, 0xe6, 0x32, 0xa4, 0x9e, 0x4a, 0xc7, 0x1a, 0x27, 0x4c, 0x42, 0xea, 0xc9, 0xc2,
+	0x68, 0x13, 0x93, 0x51, 0x95, 0x4a, 0x15, 0xfe, 0x39, 0xa7, 0x68, 0x0f, 0xea, 0x3c, 0xdf, 0x23,
+	0x86, 0xbd, 0x40, 0xd8, 0x58, 0x36, 0x2e, 0x09, 0xa8, 0x07, 0xd5, 0x28, 0xb6, 0x2c, 0x12, 0xc9,
+	0x02, 0x5d, 0x33, 0x92, 0x4f, 0x6e, 0xbe, 0xfa, 0x69, 0x06, 0x21, 0x5d, 0x88, 0x50, 0xd1, 0x8c,
+	0x86, 0xa2, 0x9d, 0x85, 0x74, 0xa1, 0x3f, 0x87, 0xdd, 0x42, 0xf3, 0x95, 0x8b, 0x3e, 0x85, 0xed,
+	0x00, 0x3b, 0x61, 0xd4, 0xd3, 0x0e, 0xca, 0x83, 0xc6, 0xf0, 0x7e, 0xae, 0x1a, 0xa4, 0xcf, 0x32,
+	0x24, 0x13, 0xc7, 0xc2, 0x20, 0x11, 0x61, 0xc5, 0x58, 0xec, 0xc3, 0x6e, 0xe1, 0xa9, 0xbc, 0x4a,
+	0x3f, 0x85, 0xbd, 0xdf, 0x4c, 0xbd, 0x80, 0x86, 0xc5, 0xe2, 0xef, 0x68, 0xca, 0x23, 0xd8, 0xbf,
+	0x42, 0x9b, 0xba, 0xee, 0x8f, 0x1a, 0xd4, 0x44, 0x65, 0x3e, 0xa1, 0xc1, 0xb5, 0xa5, 0x27, 0x88,
+	0x17, 0x22, 0x2a, 0x95, 0x4b, 0x82, 0x78, 0xc1, 0x43, 0xee, 0x33, 0xe8, 0xf2, 0x82, 0xcf, 0xa8,
+	0xb9, 0x94, 0x51, 0x25, 0xb3, 0xaa, 0x2c, 0xa4, 0x3b, 0xd8, 0x63, 0x73, 0xaa, 0xc2, 0x4d, 0xe4,
+	0xd4, 0x7d, 0xa8, 0xa8, 0x54, 0x92, 0x21, 0xa4, 0xbe, 0xf4, 0xbf, 0x6b, 0x80, 0x66, 0xc4, 0xb7,
+	0xe7, 0x54, 0xd8, 0x72, 0xfb, 0xc2, 0x8d, 0x7e, 0x02, 0x6d, 0x46, 0x19, 0x76, 0x4d, 0x6e, 0x46,
+	0xa6, 0x18, 0x35, 0x05, 0xf5, 0xc8, 0x63, 0x69, 0x03, 0x11, 0x5c, 0x69, 0x1b, 0x51, 0x65, 0xa8,
+	0x25, 0xc8, 0x73, 0xd5, 0x45, 0xd0, 0x63, 0xd8, 0x3a, 0xa7, 0x01, 0x0f, 0x20, 0x8e, 0x6d, 0x37,
+	0x9b, 0x3f, 0x0a, 0x23, 0x43, 0x30, 0xe8, 0x7f, 0xd5, 0xa0, 0x3a, 0x91, 0xe1, 0xcf, 0x1b, 0xa1,
+	0xc8, 0x0a, 0x4d, 0x68, 0x14, 0xbf, 0x79, 0x30, 0x7a, 0x24, 0x8a, 0x92, 0x76, 0x50, 0x37, 0x92,
+	0x4f, 0x34, 0x84, 0x7b, 0x49, 0x2e, 0x45, 0x34, 0x0e, 0x2d, 0x92, 0xf4, 0x78, 0x99, 0x74, 0x5d,
+	0x75, 0x38, 0x13, 0x67, 0xb2, 0xd3, 0xf3, 0xe2, 0xb5, 0x26, 0xe3, 0xf8, 0x36, 0xf9, 0x41, 0x81,
+	0x88, 0x72, 0x22, 0x53, 0x7e, 0xa2, 0xff, 0xa1, 0x04, 0x0d, 0x5e, 0x6d, 0x8e, 0x18, 0x23, 0x5e,
+	0x20, 0x00, 0xc0, 0xf2, 0xa7, 0x84, 0xc0, 0x8f, 0x54, 0xab, 0x6d, 0x29, 0x32, 0x87, 0xe0, 0x65,
+	0xf4, 0x7f, 0x82, 0x93, 0x2b, 0x0c, 0x65, 0x81, 0x4a, 0xcd, 0x93, 0x73, 0x53, 0x4b, 0x91, 0x95,
+	0x79, 0x9f, 0x42, 0x55, 0x3d, 0x56, 0x14, 0xee, 0xc6, 0x10, 0x65, 0x74, 0x2a, 0x7f, 0x18, 0x09,
+	0x8b, 0xfe, 0xe7, 0x52, 0xda, 0xd5, 0x5f, 0x05, 0x36, 0x6f, 0xd3, 0xef, 0xd8, 0xd5, 0xd7, 0xe3,
+	0xaf, 0xb4, 0x19, 0x7f, 0xfb, 0x00, 0x17, 0xd8, 0x8d, 0xc9, 0x65, 0xdc, 0x97, 0x8d, 0xba, 0xa0,
+	0x08, 0xa4, 0x06, 0xd0, 0xb1, 0x42, 0x82, 0x79, 0x85, 0x4d, 0x5f, 0xb6, 0x25, 0x67, 0x9c, 0x84,
+	0x9e, 0x3e, 0x6d, 0x9b, 0xb7, 0x73, 0xfe, 0xf0, 0xf5, 0xbc, 0xce, 0x38, 0xd2, 0x90, 0x4c, 0xb9,
+	0x6a, 0x5d, 0xb9, 0x7e, 0xde, 0xa8, 0x6e, 0xcc, 0x1b, 0x26, 0x74, 0x73, 0xc9, 0xa6, 0x8a, 0x5c,
+	0x56, 0xa7, 0xb6, 0xa6, 0x33, 0x03, 0x7c, 0xe9, 0x66, 0xe0, 0x7f, 0xd4, 0xe0, 0xce, 0xb3, 0xd8,
+	0x71, 0xed, 0x5c, 0x36, 0x3f, 0x84, 0x5a, 0x1a, 0x55, 0xb2, 0xbc, 0xf0, 0x61, 0x31, 0x81, 0x69,
+	0xe9, 0xf8, 0xd8, 0x35, 0xc5, 0x24, 0x6e, 0x13, 0x97, 0x61, 0x71, 0x4f, 0xcb, 0x68, 0x0b, 0xfa,
+	0xc8, 0x65, 0x17, 0xc7, 0x9c, 0xca, 0x39, 0x73, 0x53, 0x36, 0xaf, 0x55, 0xb2, 0xda, 0xb4, 0xb3,
+	0x23, 0xf6, 0xd4, 0xe6, 0x30, 0x5c, 0x4e, 0xd0, 0x32, 0x06, 0x9b, 0x06, 0x9c, 0x27, 0xe3, 0x73,
+	0xa4, 0xff, 0x43, 0x03, 0x94, 0xb5, 0x52, 0xc1, 0xb0, 0x99, 0x02, 0xda, 0xed, 0x52, 0xa0, 0x54,
+	0x94, 0x02, 0x29, 0xdf, 0x92, 0x90, 0x28, 0x5b, 0x1c, 0x25, 0xdf, 0x84, 0x90, 0x48, 0x4d, 0x1b,
+	0xb7, 0xac, 0x3c, 0xff, 0xd4, 0xa0, 0xcb, 0x5b, 0x16, 0x59, 0x1b, 0x72, 0xdf, 0x65, 0x1c, 0xdf,
+	0xdc, 0x8b, 0xca, 0x9b, 0x7b, 0x51, 0x91, 0x4f, 0xd4, 0xe6, 0xb3, 0xe6, 0x93, 0xfc, 0x06, 0xb5,
+	0x7d, 0x8b, 0x0d, 0xaa, 0x52, 0xb4, 0x41, 0xe9, 0xff, 0xd6, 0xe0, 0x6e, 0xfe, 0x69, 0xef, 0xb5,
+	0x29, 0x14, 0xb8, 0xa5, 0xbc, 0xee, 0x96, 0xcf, 0xa1, 0x9b, 0x1d, 0x09, 0xf0, 0xc2, 0x71, 0x1d,
+	0x26, 0x6b, 0xb0, 0x66, 0xa0, 0xcc, 0x64, 0xa0, 0x4e, 0x78, 0x29, 0xf0, 0x63, 0xcf, 0x54, 0xd5,
+	0x32, 0x4a, 0x46, 0x20, 0x3f, 0xf6, 0x54, 0x66, 0x46, 0xa9, 0x0b, 0xb7, 0x6f, 0x72, 0xe1, 0x8f,
+	0x1a, 0x74, 0x0c, 0xb2, 0xc0, 0x2e, 0xf6, 0xad, 0x34, 0x3b, 0x8a, 0x02, 0x5b, 0x2b, 0x0c, 0xec,
+	0x01, 0x74, 0x2e, 0xe7, 0x45, 0xc5, 0x29, 0xab, 0x74, 0x3a, 0x54, 0x8f, 0xd2, 0xb6, 0x9d, 0xf8,
+	0xbf, 0x7c, 0xbd, 0xff, 0xb7, 0x36, 0xfc, 0xaf, 0xff, 0x0e, 0xee, 0x64, 0x8c, 0x54, 0x9e, 0xb8,
+	0x45, 0x47, 0x7e, 0x08, 0xb5, 0xd4, 0x49, 0x12, 0xfb, 0xea, 0x52, 0x79, 0x27, 0x41, 0xa8, 0x7c,
+	0x13, 0x42, 0x1e, 0x3c, 0x90, 0x15, 0x9b, 0x3f, 0x44, 0x6e, 0x64, 0x09, 0x4e, 0x3c, 0xd8, 0xf8,
+	0xa3, 0x03, 0xea, 0xf8, 0x4c, 0xad, 0xfb, 0x75, 0x4e, 0x39, 0xe3, 0x04, 0xf4, 0xc5, 0xda, 0x0c,
+	0xbc, 0x9b, 0x1d, 0x9f, 0x53, 0x65, 0xf9, 0xd9, 0x57, 0xef, 0x43, 0x6f, 0xf3, 0x3a, 0xf9, 0xe2,
+	0x27, 0x5f, 0x42, 0x33, 0xdb, 0x1a, 0x50, 0x0b, 0xea, 0xd3, 0x97, 0xe6, 0xe4, 0x74, 0xfa, 0xf5,
+	0xc9, 0xbc, 0xf3, 0x01, 0xff, 0x9c, 0xbd, 0x1a, 0x8d, 0xc6, 0xe3, 0xe3, 0xf1, 0x71, 0x47, 0x43,
+	0x00, 0x95, 0xc9, 0xd1, 0xf4, 0x74, 0x7c, 0xdc, 0x29, 0x3d, 0xf9, 0x39, 0xf4, 0xae, 0x9a, 0xba,
+	0x39, 0xdf, 0x6c, 0x3c, 0x9f, 0x9f, 0x8e, 0x3b, 0x1f, 0xa0, 0x1a, 0x6c, 0x71, 0x19, 0x29, 0x6d,
+	0x8c, 0x67, 0xaf, 0x5e, 0x8c, 0x3b, 0xa5, 0x27, 0x3f, 0x83, 0xce, 0xba, 0xbd, 0xfc, 0x7c, 0xfc,
+	0xf2, 0xe8, 0x99, 0x90, 0x6a, 0x40, 0xf5, 0x78, 0x3a, 0x13, 0x1f, 0x1a, 0x57, 0x71, 0xf4, 0x6a,
+	0xfe, 0x4d, 0xa7, 0x34, 0xfc, 0x4f, 0x0d, 0x2a, 0x02, 0xcc, 0x10, 0x1d, 0x43, 0x83, 0x97, 0x79,
+	0x65, 0x3d, 0x7a, 0xb8, 0xd9, 0xec, 0x14, 0xa6, 0xfd, 0x7e, 0xd1, 0x91, 0xf2, 0xf8, 0x73, 0xe8,
+	0x8c, 0x23, 0xe6, 0x78, 0xbc, 0x2d, 0xaa, 0x0d, 0x1e, 0xf5, 0xd7, 0x3d, 0x77, 0xf9, 0xb7, 0x40,
+	0x7f, 0xb7, 0xf0, 0x4c, 0x29, 0xfb, 0x25, 0x34, 0xb3, 0x0b, 0x3a, 0xfa, 0x30, 0xc3, 0x5c, 0xb0,
+	0xb9, 0xf7, 0x7b, 0xc5, 0x0d, 0x3a, 0x8e, 0x0e, 0x35, 0x74, 0x0a, 0xed, 0xac, 0xc8, 0xb7, 0xc3,
+	0xf7, 0xd1, 0x26, 0xdd, 0x7e, 0xa8, 0xa1, 0x25, 0xec, 0xe4, 0xb6, 0x33, 0x1a, 0xa2, 0xc7, 0xd9,
+	0x16, 0x77, 0xcd, 0x02, 0xd7, 0xff, 0xe4, 0x46, 0x46, 0x71, 0xff, 0x40, 0x3b, 0xd4, 0x90, 0x0d,
+	0xdd, 0x82, 0x45, 0x03, 0xfd, 0x34, 0xa3, 0xe2, 0xea, 0x3d, 0x2a, 0x77, 0xd3, 0x75, 0xfb, 0x8a,
+	0x0d, 0xdd, 0x82, 0x1d, 0x23, 0x77, 0xcb, 0xd5, 0x1b, 0x4a, 0xee, 0x96, 0x6b, 0x56, 0x15, 0xf4,
+	0x1d, 0xdc, 0x2b, 0x5c, 0x2e, 0x72, 0xc8, 0x5d, 0xb7, 0xcc, 0xf4, 0x07, 0x37, 0x33, 0xaa, 0xbb,
+	0x5e, 0x42, 0x2b, 0x33, 0xb3, 0x7c, 0x3b, 0x44, 0xfb, 0x19, 0xd1, 0xcd, 0xd5, 0xa1, 0xff, 0xe1,
+	0x55, 0xc7, 0x4a, 0xdf, 0x14, 0xe0, 0xb2, 0xf7, 0xa3, 0xbd, 0x0c, 0xf7, 0xc6, 0xe0, 0xd2, 0xdf,
+	0xbf, 0xe2, 0x54, 0xa9, 0xfa, 0x06, 0x9a, 0xd9, 0xae, 0x95, 0x0b, 0xc3, 0x82, 0x4e, 0xdd, 0x7f,
+	0x74, 0xe5, 0xb9, 0x52, 0x38, 0x81, 0x7a, 0x5a, 0x79, 0x51, 0x2e, 0x9f, 0xd6, 0x9a, 0x46, 0x7f,
+	0xaf, 0xf8, 0x50, 0xe9, 0xf9, 0x2d, 0x74, 0xd6, 0xcb, 0x1a, 0xd2, 0x33, 0x12, 0x57, 0x94, 0xd8,
+	0xfe, 0xc7, 0xd7, 0xf2, 0x48, 0xe5, 0x8b, 0x8a, 0xf8, 0x63, 0xf6, 0x8b, 0xff, 0x06, 0x00, 0x00,
+	0xff, 0xff, 0x73, 0xe3, 0xb4, 0x6a, 0xb2, 0x15, 0x00, 0x00,
 }
@@ -4,14 +4,21 @@ package routerrpc
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
@@ -47,6 +54,50 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/TrackPayment": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/TrackPaymentV2": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/HtlcInterceptor": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/QueryMissionControl": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/ResetMissionControl": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/XImportMissionControl": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/SendToRouteV2": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/BuildRoute": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/ProbePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/Rebalance": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/UpdateChanStatus": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -210,6 +261,42 @@ func (s *Server) SendPayment(ctx context.Context,
 		payment.OutgoingChannelID = &chanID
 	}
 
+	// Bound the number of blocks our funds may be locked up for if
+	// specified.
+	switch {
+	case req.CltvLimit < 0:
+		return nil, fmt.Errorf("cltv limit must not be negative")
+
+	case req.CltvLimit != 0:
+		cltvLimit := uint32(req.CltvLimit)
+		payment.CltvLimit = &cltvLimit
+	}
+
+	// Pin to a last hop if specified.
+	if len(req.LastHopPubkey) != 0 {
+		lastHop, err := btcec.ParsePubKey(
+			req.LastHopPubkey, btcec.S256(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		lastHopVertex := routing.NewVertex(lastHop)
+		payment.LastHop = &lastHopVertex
+	}
+
+	// If the caller doesn't wish to wait for the outcome of the payment,
+	// then we'll return as soon as its first HTLC has been dispatched.
+	// The outcome can then be retrieved through TrackPayment.
+	if req.NoWait {
+		if err := s.cfg.Router.SendPaymentAsync(&payment); err != nil {
+			return nil, err
+		}
+
+		return &PaymentResponse{
+			PayHash: (*payReq.PaymentHash)[:],
+		}, nil
+	}
+
 	preImage, _, err := s.cfg.Router.SendPayment(&payment)
 	if err != nil {
 		return nil, err
@@ -256,3 +343,530 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 		TimeLockDelay:  int64(routes[0].TotalTimeLock),
 	}, nil
 }
+
+// TrackPayment returns an update stream for the payment identified by the
+// payment hash. If the payment is still in flight, its current state is sent
+// first, followed by its final outcome once the payment has completed.
+func (s *Server) TrackPayment(req *TrackPaymentRequest,
+	stream Router_TrackPaymentServer) error {
+
+	if len(req.PaymentHash) != 32 {
+		return fmt.Errorf("payment hash must be exactly 32 bytes, "+
+			"is instead %v", len(req.PaymentHash))
+	}
+
+	var paymentHash [32]byte
+	copy(paymentHash[:], req.PaymentHash)
+
+	updates, err := s.cfg.Router.TrackPayment(paymentHash)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case result, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			err := stream.Send(marshallPaymentResult(result))
+			if err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// TrackPaymentV2 returns an update stream for a payment that was dispatched
+// with no_wait set. An update is sent for the current state of the payment,
+// and subsequently whenever an HTLC of the payment is attempted or fails. The
+// stream is closed once an update for the final outcome has been sent.
+func (s *Server) TrackPaymentV2(req *TrackPaymentRequest,
+	stream Router_TrackPaymentV2Server) error {
+
+	if len(req.PaymentHash) != 32 {
+		return fmt.Errorf("payment hash must be exactly 32 bytes, "+
+			"is instead %v", len(req.PaymentHash))
+	}
+
+	var paymentHash [32]byte
+	copy(paymentHash[:], req.PaymentHash)
+
+	updates, cancel, err := s.cfg.Router.SubscribePayment(paymentHash)
+	if err != nil {
+		return err
+	}
+	defer cancel()
+
+	for {
+		select {
+		case info, ok := <-updates:
+			if !ok {
+				return nil
+			}
+
+			err := stream.Send(marshallPaymentInfo(info))
+			if err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// HtlcInterceptor is a bidirectional stream for streaming interception
+// requests to the caller. Upon connection, it registers with the switch as its
+// forward interceptor, so that every forwarded HTLC is held until the caller
+// responds with whether it should be settled, failed or resumed. Once the
+// stream is closed, all HTLCs still held are resumed.
+func (s *Server) HtlcInterceptor(stream Router_HtlcInterceptorServer) error {
+	return newForwardInterceptor(s.cfg.HtlcSwitch, stream).run()
+}
+
+// QueryMissionControl exposes the internal reliability memory of mission
+// control: the latest result of routing an HTLC between each pair of nodes.
+func (s *Server) QueryMissionControl(ctx context.Context,
+	req *QueryMissionControlRequest) (*QueryMissionControlResponse, error) {
+
+	history := s.cfg.Router.QueryMissionControl()
+
+	pairs := make([]*PairHistory, 0, len(history))
+	for _, h := range history {
+		// Copy the node keys to prevent them from being aliased by
+		// the loop variable.
+		from, to := h.Pair.From, h.Pair.To
+
+		pairs = append(pairs, &PairHistory{
+			NodeFrom:    from[:],
+			NodeTo:      to[:],
+			Timestamp:   h.Timestamp.Unix(),
+			Success:     h.Success,
+			SuccessProb: h.SuccessProbability,
+		})
+	}
+
+	return &QueryMissionControlResponse{
+		Pairs: pairs,
+	}, nil
+}
+
+// ResetMissionControl clears all mission control state, both in memory and on
+// disk.
+func (s *Server) ResetMissionControl(ctx context.Context,
+	req *ResetMissionControlRequest) (*ResetMissionControlResponse, error) {
+
+	if err := s.cfg.Router.ResetMissionControl(); err != nil {
+		return nil, err
+	}
+
+	return &ResetMissionControlResponse{}, nil
+}
+
+// XImportMissionControl adds the given pair results to the reliability memory
+// of mission control. Results that are older than the ones already known for
+// the same pairs are ignored.
+func (s *Server) XImportMissionControl(ctx context.Context,
+	req *XImportMissionControlRequest) (*XImportMissionControlResponse,
+	error) {
+
+	history := make([]*routing.PairHistory, 0, len(req.Pairs))
+	for _, pair := range req.Pairs {
+		from, err := btcec.ParsePubKey(pair.NodeFrom, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+		to, err := btcec.ParsePubKey(pair.NodeTo, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, &routing.PairHistory{
+			Pair: routing.DirectedNodePair{
+				From: routing.NewVertex(from),
+				To:   routing.NewVertex(to),
+			},
+			Timestamp: time.Unix(pair.Timestamp, 0),
+			Success:   pair.Success,
+		})
+	}
+
+	if err := s.cfg.Router.ImportMissionControl(history); err != nil {
+		return nil, err
+	}
+
+	return &XImportMissionControlResponse{}, nil
+}
+
+// SendToRouteV2 dispatches a single HTLC along the given explicit route. If the
+// HTLC fails along the route, the decoded failure is returned along with the
+// node that reported it, rather than making any further attempts.
+func (s *Server) SendToRouteV2(ctx context.Context,
+	req *SendToRouteRequest) (*SendToRouteResponse, error) {
+
+	if len(req.PaymentHash) != 32 {
+		return nil, fmt.Errorf("payment hash must be exactly 32 "+
+			"bytes, is instead %v", len(req.PaymentHash))
+	}
+	if len(req.Hops) == 0 {
+		return nil, fmt.Errorf("route must contain at least one hop")
+	}
+
+	var paymentHash [32]byte
+	copy(paymentHash[:], req.PaymentHash)
+
+	hops := make([]*routing.Hop, 0, len(req.Hops))
+	for _, hop := range req.Hops {
+		pubKey, err := btcec.ParsePubKey(hop.PubKey, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid pub key of hop over "+
+				"channel %v: %v", hop.ChanId, err)
+		}
+
+		amt := lnwire.MilliSatoshi(hop.AmtToForwardMsat)
+		hops = append(hops, &routing.Hop{
+			PubKeyBytes:      routing.NewVertex(pubKey),
+			ChannelID:        hop.ChanId,
+			OutgoingTimeLock: hop.Expiry,
+			AmtToForward:     amt,
+		})
+	}
+
+	preimage, err := s.cfg.Router.SendToRouteOnce(
+		paymentHash, lnwire.MilliSatoshi(req.TotalAmtMsat),
+		req.TotalTimeLock, hops,
+	)
+	if err == nil {
+		return &SendToRouteResponse{
+			Preimage: preimage[:],
+		}, nil
+	}
+
+	// Failures that didn't occur along the route are returned as an
+	// error.
+	fErr, ok := err.(*htlcswitch.ForwardingError)
+	if !ok {
+		return nil, err
+	}
+
+	return &SendToRouteResponse{
+		Failure: marshallForwardingError(fErr, hops),
+	}, nil
+}
+
+// BuildRoute completes a route along the given ordered list of nodes, using
+// the channel policies of the local graph to fill in the fees and time locks
+// of each hop.
+func (s *Server) BuildRoute(ctx context.Context,
+	req *BuildRouteRequest) (*BuildRouteResponse, error) {
+
+	if req.FinalCltvDelta > math.MaxUint16 {
+		return nil, fmt.Errorf("final cltv delta %v exceeds maximum "+
+			"of %v", req.FinalCltvDelta, math.MaxUint16)
+	}
+
+	hops := make([]routing.Vertex, 0, len(req.HopPubkeys))
+	for _, rawKey := range req.HopPubkeys {
+		pubKey, err := btcec.ParsePubKey(rawKey, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("invalid hop pub key %x: %v",
+				rawKey, err)
+		}
+
+		hops = append(hops, routing.NewVertex(pubKey))
+	}
+
+	var outgoingChan *uint64
+	if req.OutgoingChanId != 0 {
+		outgoingChan = &req.OutgoingChanId
+	}
+
+	route, err := s.cfg.Router.BuildRoute(
+		lnwire.MilliSatoshi(req.AmtMsat), hops, outgoingChan,
+		uint16(req.FinalCltvDelta),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BuildRouteResponse{
+		TotalAmtMsat:  uint64(route.TotalAmount),
+		TotalTimeLock: route.TotalTimeLock,
+		TotalFeesMsat: uint64(route.TotalFees),
+		Hops:          marshallRouteHops(route.Hops),
+	}, nil
+}
+
+// ProbePayment sends a payment with a random payment hash through the network
+// in order to find a route that is able to carry it, without transferring any
+// funds.
+func (s *Server) ProbePayment(ctx context.Context,
+	req *ProbePaymentRequest) (*ProbePaymentResponse, error) {
+
+	destNode, err := btcec.ParsePubKey(req.Dest, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.FinalCltvDelta < 0 || req.FinalCltvDelta > math.MaxUint16:
+		return nil, fmt.Errorf("invalid final cltv delta %v",
+			req.FinalCltvDelta)
+
+	case req.CltvLimit < 0:
+		return nil, fmt.Errorf("cltv limit must not be negative")
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.AmtSat))
+	feeLimit := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.FeeLimitSat))
+	payment := routing.LightningPayment{
+		Target:            destNode,
+		Amount:            amt,
+		FeeLimit:          feeLimit,
+		PayAttemptTimeout: time.Second * time.Duration(req.TimeoutSeconds),
+	}
+
+	if req.FinalCltvDelta != 0 {
+		finalDelta := uint16(req.FinalCltvDelta)
+		payment.FinalCLTVDelta = &finalDelta
+	}
+
+	if req.CltvLimit != 0 {
+		cltvLimit := uint32(req.CltvLimit)
+		payment.CltvLimit = &cltvLimit
+	}
+
+	result, err := s.cfg.Router.ProbePayment(&payment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ProbePaymentResponse{
+		RoutingFeeMsat:     int64(result.Route.TotalFees),
+		TotalTimeLock:      int64(result.Route.TotalTimeLock),
+		SuccessProbability: result.SuccessProbability,
+		NumAttempts:        uint32(result.NumAttempts),
+		Hops:               marshallRouteHops(result.Route.Hops),
+	}, nil
+}
+
+// Rebalance moves local balance from one of our channels to another by paying
+// ourselves along a circular route.
+func (s *Server) Rebalance(ctx context.Context,
+	req *RebalanceRequest) (*RebalanceResponse, error) {
+
+	if req.AmtSat <= 0 {
+		return nil, fmt.Errorf("amount must be positive")
+	}
+	if req.FeeLimitSat < 0 {
+		return nil, fmt.Errorf("fee limit must not be negative")
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.AmtSat))
+	feeLimit := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.FeeLimitSat))
+	preImage, route, err := s.cfg.Router.Rebalance(
+		req.OutgoingChanId, req.IncomingChanId, amt, feeLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentHash := sha256.Sum256(preImage[:])
+	return &RebalanceResponse{
+		PaymentHash: paymentHash[:],
+		FeeMsat:     int64(route.TotalFees),
+		Hops:        marshallRouteHops(route.Hops),
+	}, nil
+}
+
+// UpdateChanStatus attempts to manually set the state of a channel (enabled,
+// disabled, or auto). A manual "disable" request will cause the channel to
+// stay disabled until a subsequent manual request of either "enable" or
+// "auto". The manual state is only kept in memory, so all channels revert to
+// automatic management when lnd is restarted.
+func (s *Server) UpdateChanStatus(ctx context.Context,
+	req *UpdateChanStatusRequest) (*UpdateChanStatusResponse, error) {
+
+	outPoint, err := parseChanPoint(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	switch req.Action {
+	case ChanStatusAction_ENABLE:
+		err = s.cfg.ChanStatusMgr.RequestEnable(*outPoint, true)
+
+	case ChanStatusAction_DISABLE:
+		err = s.cfg.ChanStatusMgr.RequestDisable(*outPoint, true)
+
+	case ChanStatusAction_AUTO:
+		err = s.cfg.ChanStatusMgr.RequestAuto(*outPoint)
+
+	default:
+		return nil, fmt.Errorf("unknown channel status action: %v",
+			req.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateChanStatusResponse{}, nil
+}
+
+// parseChanPoint parses a channel point formatted as funding_txid:output_index.
+func parseChanPoint(chanPoint string) (*wire.OutPoint, error) {
+	parts := strings.Split(chanPoint, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("expected channel point of the form "+
+			"funding_txid:output_index, got %v", chanPoint)
+	}
+
+	txid, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid funding txid: %v", err)
+	}
+
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index: %v", err)
+	}
+
+	return wire.NewOutPoint(txid, uint32(index)), nil
+}
+
+// marshallRouteHops converts the hops of a route into their RPC counterparts.
+func marshallRouteHops(hops []*routing.Hop) []*RouteHop {
+	rpcHops := make([]*RouteHop, 0, len(hops))
+	for _, hop := range hops {
+		pubKey := hop.PubKeyBytes
+		rpcHops = append(rpcHops, &RouteHop{
+			ChanId:           hop.ChannelID,
+			PubKey:           pubKey[:],
+			AmtToForwardMsat: uint64(hop.AmtToForward),
+			Expiry:           hop.OutgoingTimeLock,
+		})
+	}
+
+	return rpcHops
+}
+
+// marshallForwardingError converts a failure that occurred along the given
+// route into its RPC counterpart.
+func marshallForwardingError(fErr *htlcswitch.ForwardingError,
+	hops []*routing.Hop) *Failure {
+
+	source := routing.NewVertex(fErr.ErrorSource)
+
+	return &Failure{
+		Code:                uint32(fErr.FailureMessage.Code()),
+		Message:             fErr.FailureMessage.Error(),
+		FailureSourcePubkey: source[:],
+		FailureSourceIndex:  failureSourceIndex(source, hops),
+	}
+}
+
+// failureSourceIndex returns the position of the node that reported a failure
+// within the given route.
+func failureSourceIndex(source routing.Vertex, hops []*routing.Hop) uint32 {
+	// The failure source is our own node, unless it matches one of the
+	// hops of the route.
+	for i, hop := range hops {
+		if hop.PubKeyBytes == source {
+			return uint32(i + 1)
+		}
+	}
+
+	return 0
+}
+
+// marshallPaymentResult converts the result of a payment into its RPC
+// counterpart.
+func marshallPaymentResult(result *routing.PaymentResult) *PaymentStatus {
+	status := &PaymentStatus{}
+
+	switch result.State {
+	case routing.PaymentInFlight:
+		status.State = PaymentState_IN_FLIGHT
+
+	case routing.PaymentSucceeded:
+		status.State = PaymentState_SUCCEEDED
+
+		// The preimage is unknown for payments that weren't tracked by
+		// the router, in which case we'll leave it unset.
+		if result.Preimage != [32]byte{} {
+			status.Preimage = result.Preimage[:]
+		}
+
+	case routing.PaymentFailed:
+		status.State = PaymentState_FAILED
+		if result.Err != nil {
+			status.PaymentErr = result.Err.Error()
+		}
+	}
+
+	return status
+}
+
+// marshallPaymentInfo converts the state of a payment along with its HTLC
+// attempts into its RPC counterpart.
+func marshallPaymentInfo(info *routing.PaymentInfo) *PaymentUpdate {
+	update := &PaymentUpdate{
+		PaymentHash:    info.PaymentHash[:],
+		ValueMsat:      int64(info.Value),
+		CreationTimeNs: info.CreationTime.UnixNano(),
+	}
+
+	switch info.State {
+	case routing.PaymentInFlight:
+		update.State = PaymentState_IN_FLIGHT
+
+	case routing.PaymentSucceeded:
+		update.State = PaymentState_SUCCEEDED
+		update.Preimage = info.Preimage[:]
+
+	case routing.PaymentFailed:
+		update.State = PaymentState_FAILED
+		update.PaymentErr = info.FailureReason
+	}
+
+	for _, attempt := range info.Attempts {
+		htlc := &HtlcAttempt{
+			AttemptTimeNs: attempt.AttemptTime.UnixNano(),
+			TotalAmtMsat:  uint64(attempt.Route.TotalAmount),
+			TotalTimeLock: attempt.Route.TotalTimeLock,
+			Hops:          marshallRouteHops(attempt.Route.Hops),
+		}
+		if !attempt.ResolveTime.IsZero() {
+			htlc.ResolveTimeNs = attempt.ResolveTime.UnixNano()
+		}
+
+		if failure := attempt.Failure; failure != nil {
+			htlc.Failure = &Failure{
+				Message: failure.Reason,
+			}
+
+			// Failures that weren't reported by a node along the
+			// route don't carry a failure code or source.
+			if failure.Message != nil {
+				source := failure.Source
+				code := failure.Message.Code()
+				index := failureSourceIndex(
+					source, attempt.Route.Hops,
+				)
+
+				htlc.Failure.Code = uint32(code)
+				htlc.Failure.FailureSourcePubkey = source[:]
+				htlc.Failure.FailureSourceIndex = index
+			}
+		}
+
+		update.Htlcs = append(update.Htlcs, htlc)
+	}
+
+	return update
+}
@@ -49,7 +49,209 @@ func (x AddressType) String() string {
 	return proto.EnumName(AddressType_name, int32(x))
 }
 func (AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{0}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{0}
+}
+
+type SubsystemStatus int32
+
+const (
+	// / The subsystem hasn't panicked.
+	SubsystemStatus_HEALTHY SubsystemStatus = 0
+	// / The subsystem panicked at least once, and may have been restarted.
+	SubsystemStatus_DEGRADED SubsystemStatus = 1
+)
+
+var SubsystemStatus_name = map[int32]string{
+	0: "HEALTHY",
+	1: "DEGRADED",
+}
+var SubsystemStatus_value = map[string]int32{
+	"HEALTHY":  0,
+	"DEGRADED": 1,
+}
+
+func (x SubsystemStatus) String() string {
+	return proto.EnumName(SubsystemStatus_name, int32(x))
+}
+func (SubsystemStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{1}
+}
+
+type ChainBackendEventType int32
+
+const (
+	// *
+	// The active chain backend hasn't returned a new block within the stall
+	// timeout.
+	ChainBackendEventType_STALLED ChainBackendEventType = 0
+	// / The stalled chain backend returned a new block.
+	ChainBackendEventType_RECOVERED ChainBackendEventType = 1
+	// *
+	// We're failing over from the stalled chain backend to the fallback one,
+	// which requires lnd to be restarted.
+	ChainBackendEventType_FAILOVER ChainBackendEventType = 2
+)
+
+var ChainBackendEventType_name = map[int32]string{
+	0: "STALLED",
+	1: "RECOVERED",
+	2: "FAILOVER",
+}
+var ChainBackendEventType_value = map[string]int32{
+	"STALLED":   0,
+	"RECOVERED": 1,
+	"FAILOVER":  2,
+}
+
+func (x ChainBackendEventType) String() string {
+	return proto.EnumName(ChainBackendEventType_name, int32(x))
+}
+func (ChainBackendEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{2}
+}
+
+type CommitmentType int32
+
+const (
+	// *
+	// A channel using the legacy commitment format, having tweaked to_remote
+	// keys.
+	CommitmentType_LEGACY CommitmentType = 0
+	// *
+	// A channel that uses the anchor outputs commitment format, having an
+	// additional anchor output for each party to allow fee bumping the
+	// commitment transaction.
+	CommitmentType_ANCHORS CommitmentType = 1
+)
+
+var CommitmentType_name = map[int32]string{
+	0: "LEGACY",
+	1: "ANCHORS",
+}
+var CommitmentType_value = map[string]int32{
+	"LEGACY":  0,
+	"ANCHORS": 1,
+}
+
+func (x CommitmentType) String() string {
+	return proto.EnumName(CommitmentType_name, int32(x))
+}
+func (CommitmentType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{3}
+}
+
+type FeeConsumer int32
+
+const (
+	// / Funding transactions of new channels.
+	FeeConsumer_FUNDING FeeConsumer = 0
+	// / Cooperative close transactions.
+	FeeConsumer_COOP_CLOSE FeeConsumer = 1
+	// / Transactions sweeping our outputs back into the wallet.
+	FeeConsumer_SWEEP FeeConsumer = 2
+	// / Justice transactions sweeping the outputs of revoked commitments.
+	FeeConsumer_JUSTICE FeeConsumer = 3
+	// / Commitment transactions, of new channels and on fee updates.
+	FeeConsumer_COMMIT FeeConsumer = 4
+)
+
+var FeeConsumer_name = map[int32]string{
+	0: "FUNDING",
+	1: "COOP_CLOSE",
+	2: "SWEEP",
+	3: "JUSTICE",
+	4: "COMMIT",
+}
+var FeeConsumer_value = map[string]int32{
+	"FUNDING":    0,
+	"COOP_CLOSE": 1,
+	"SWEEP":      2,
+	"JUSTICE":    3,
+	"COMMIT":     4,
+}
+
+func (x FeeConsumer) String() string {
+	return proto.EnumName(FeeConsumer_name, int32(x))
+}
+func (FeeConsumer) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{4}
+}
+
+type WalletState int32
+
+const (
+	// / The wallet hasn't been created yet.
+	WalletState_NON_EXISTING WalletState = 0
+	// / The wallet exists, but is waiting to be unlocked.
+	WalletState_LOCKED WalletState = 1
+	// / The wallet has been unlocked, and lnd is starting up.
+	WalletState_UNLOCKED WalletState = 2
+	// / The main RPC server is active, but lnd isn't fully started yet.
+	WalletState_RPC_ACTIVE WalletState = 3
+	// / lnd is fully started, and connecting to peers.
+	WalletState_SERVER_ACTIVE WalletState = 4
+)
+
+var WalletState_name = map[int32]string{
+	0: "NON_EXISTING",
+	1: "LOCKED",
+	2: "UNLOCKED",
+	3: "RPC_ACTIVE",
+	4: "SERVER_ACTIVE",
+}
+var WalletState_value = map[string]int32{
+	"NON_EXISTING":  0,
+	"LOCKED":        1,
+	"UNLOCKED":      2,
+	"RPC_ACTIVE":    3,
+	"SERVER_ACTIVE": 4,
+}
+
+func (x WalletState) String() string {
+	return proto.EnumName(WalletState_name, int32(x))
+}
+func (WalletState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{5}
+}
+
+type CheckPeerConnectivityResponse_Stage int32
+
+const (
+	// / Establishing the TCP connection to the peer.
+	CheckPeerConnectivityResponse_TCP CheckPeerConnectivityResponse_Stage = 0
+	// / Sending the first act of the transport handshake.
+	CheckPeerConnectivityResponse_NOISE_ACT_ONE CheckPeerConnectivityResponse_Stage = 1
+	// *
+	// Awaiting the second act of the transport handshake. Failures at this
+	// stage usually indicate the peer isn't in possession of the given
+	// identity pubkey.
+	CheckPeerConnectivityResponse_NOISE_ACT_TWO CheckPeerConnectivityResponse_Stage = 2
+	// / Sending the final act of the transport handshake.
+	CheckPeerConnectivityResponse_NOISE_ACT_THREE CheckPeerConnectivityResponse_Stage = 3
+	// / Exchanging init messages with the peer.
+	CheckPeerConnectivityResponse_INIT CheckPeerConnectivityResponse_Stage = 4
+)
+
+var CheckPeerConnectivityResponse_Stage_name = map[int32]string{
+	0: "TCP",
+	1: "NOISE_ACT_ONE",
+	2: "NOISE_ACT_TWO",
+	3: "NOISE_ACT_THREE",
+	4: "INIT",
+}
+var CheckPeerConnectivityResponse_Stage_value = map[string]int32{
+	"TCP":             0,
+	"NOISE_ACT_ONE":   1,
+	"NOISE_ACT_TWO":   2,
+	"NOISE_ACT_THREE": 3,
+	"INIT":            4,
+}
+
+func (x CheckPeerConnectivityResponse_Stage) String() string {
+	return proto.EnumName(CheckPeerConnectivityResponse_Stage_name, int32(x))
+}
+func (CheckPeerConnectivityResponse_Stage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{36, 0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -84,16 +286,19 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{39, 0}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{45, 0}
 }
 
 type ChannelEventUpdate_UpdateType int32
 
 const (
-	ChannelEventUpdate_OPEN_CHANNEL     ChannelEventUpdate_UpdateType = 0
-	ChannelEventUpdate_CLOSED_CHANNEL   ChannelEventUpdate_UpdateType = 1
-	ChannelEventUpdate_ACTIVE_CHANNEL   ChannelEventUpdate_UpdateType = 2
-	ChannelEventUpdate_INACTIVE_CHANNEL ChannelEventUpdate_UpdateType = 3
+	ChannelEventUpdate_OPEN_CHANNEL          ChannelEventUpdate_UpdateType = 0
+	ChannelEventUpdate_CLOSED_CHANNEL        ChannelEventUpdate_UpdateType = 1
+	ChannelEventUpdate_ACTIVE_CHANNEL        ChannelEventUpdate_UpdateType = 2
+	ChannelEventUpdate_INACTIVE_CHANNEL      ChannelEventUpdate_UpdateType = 3
+	ChannelEventUpdate_REMOTE_CLOSE_CHANNEL  ChannelEventUpdate_UpdateType = 4
+	ChannelEventUpdate_PENDING_OPEN_CHANNEL  ChannelEventUpdate_UpdateType = 5
+	ChannelEventUpdate_PENDING_CLOSE_CHANNEL ChannelEventUpdate_UpdateType = 6
 )
 
 var ChannelEventUpdate_UpdateType_name = map[int32]string{
@@ -101,19 +306,25 @@ var ChannelEventUpdate_UpdateType_name = map[int32]string{
 	1: "CLOSED_CHANNEL",
 	2: "ACTIVE_CHANNEL",
 	3: "INACTIVE_CHANNEL",
+	4: "REMOTE_CLOSE_CHANNEL",
+	5: "PENDING_OPEN_CHANNEL",
+	6: "PENDING_CLOSE_CHANNEL",
 }
 var ChannelEventUpdate_UpdateType_value = map[string]int32{
-	"OPEN_CHANNEL":     0,
-	"CLOSED_CHANNEL":   1,
-	"ACTIVE_CHANNEL":   2,
-	"INACTIVE_CHANNEL": 3,
+	"OPEN_CHANNEL":          0,
+	"CLOSED_CHANNEL":        1,
+	"ACTIVE_CHANNEL":        2,
+	"INACTIVE_CHANNEL":      3,
+	"REMOTE_CLOSE_CHANNEL":  4,
+	"PENDING_OPEN_CHANNEL":  5,
+	"PENDING_CLOSE_CHANNEL": 6,
 }
 
 func (x ChannelEventUpdate_UpdateType) String() string {
 	return proto.EnumName(ChannelEventUpdate_UpdateType_name, int32(x))
 }
 func (ChannelEventUpdate_UpdateType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{60, 0}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{80, 0}
 }
 
 type Invoice_InvoiceState int32
@@ -139,7 +350,50 @@ func (x Invoice_InvoiceState) String() string {
 	return proto.EnumName(Invoice_InvoiceState_name, int32(x))
 }
 func (Invoice_InvoiceState) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{89, 0}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{110, 0}
+}
+
+type InFlightHtlc_State int32
+
+const (
+	// *
+	// The channel has no active link, so the HTLC is only known to be on our
+	// latest commitment transaction.
+	InFlightHtlc_UNKNOWN InFlightHtlc_State = 0
+	// / The HTLC hasn't been locked into both commitment transactions yet.
+	InFlightHtlc_PENDING_ADD InFlightHtlc_State = 1
+	// / The HTLC is locked into both commitment transactions.
+	InFlightHtlc_LOCKED_IN InFlightHtlc_State = 2
+	// *
+	// The HTLC has been settled, but its removal hasn't been locked into both
+	// commitment transactions yet.
+	InFlightHtlc_PENDING_SETTLE InFlightHtlc_State = 3
+	// *
+	// The HTLC has been failed, but its removal hasn't been locked into both
+	// commitment transactions yet.
+	InFlightHtlc_PENDING_FAIL InFlightHtlc_State = 4
+)
+
+var InFlightHtlc_State_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PENDING_ADD",
+	2: "LOCKED_IN",
+	3: "PENDING_SETTLE",
+	4: "PENDING_FAIL",
+}
+var InFlightHtlc_State_value = map[string]int32{
+	"UNKNOWN":        0,
+	"PENDING_ADD":    1,
+	"LOCKED_IN":      2,
+	"PENDING_SETTLE": 3,
+	"PENDING_FAIL":   4,
+}
+
+func (x InFlightHtlc_State) String() string {
+	return proto.EnumName(InFlightHtlc_State_name, int32(x))
+}
+func (InFlightHtlc_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{150, 0}
 }
 
 type GenSeedRequest struct {
@@ -160,7 +414,7 @@ func (m *GenSeedRequest) Reset()         { *m = GenSeedRequest{} }
 func (m *GenSeedRequest) String() string { return proto.CompactTextString(m) }
 func (*GenSeedRequest) ProtoMessage()    {}
 func (*GenSeedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{0}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{0}
 }
 func (m *GenSeedRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedRequest.Unmarshal(m, b)
@@ -215,7 +469,7 @@ func (m *GenSeedResponse) Reset()         { *m = GenSeedResponse{} }
 func (m *GenSeedResponse) String() string { return proto.CompactTextString(m) }
 func (*GenSeedResponse) ProtoMessage()    {}
 func (*GenSeedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{1}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{1}
 }
 func (m *GenSeedResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GenSeedResponse.Unmarshal(m, b)
@@ -280,7 +534,7 @@ func (m *InitWalletRequest) Reset()         { *m = InitWalletRequest{} }
 func (m *InitWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*InitWalletRequest) ProtoMessage()    {}
 func (*InitWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{2}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{2}
 }
 func (m *InitWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletRequest.Unmarshal(m, b)
@@ -338,7 +592,7 @@ func (m *InitWalletResponse) Reset()         { *m = InitWalletResponse{} }
 func (m *InitWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*InitWalletResponse) ProtoMessage()    {}
 func (*InitWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{3}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{3}
 }
 func (m *InitWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_InitWalletResponse.Unmarshal(m, b)
@@ -380,7 +634,7 @@ func (m *UnlockWalletRequest) Reset()         { *m = UnlockWalletRequest{} }
 func (m *UnlockWalletRequest) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletRequest) ProtoMessage()    {}
 func (*UnlockWalletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{4}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{4}
 }
 func (m *UnlockWalletRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletRequest.Unmarshal(m, b)
@@ -424,7 +678,7 @@ func (m *UnlockWalletResponse) Reset()         { *m = UnlockWalletResponse{} }
 func (m *UnlockWalletResponse) String() string { return proto.CompactTextString(m) }
 func (*UnlockWalletResponse) ProtoMessage()    {}
 func (*UnlockWalletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{5}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{5}
 }
 func (m *UnlockWalletResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UnlockWalletResponse.Unmarshal(m, b)
@@ -462,7 +716,7 @@ func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
 func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordRequest) ProtoMessage()    {}
 func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{6}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{6}
 }
 func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
@@ -506,7 +760,7 @@ func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{}
 func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangePasswordResponse) ProtoMessage()    {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{7}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{7}
 }
 func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
@@ -548,7 +802,7 @@ func (m *Utxo) Reset()         { *m = Utxo{} }
 func (m *Utxo) String() string { return proto.CompactTextString(m) }
 func (*Utxo) ProtoMessage()    {}
 func (*Utxo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{8}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{8}
 }
 func (m *Utxo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Utxo.Unmarshal(m, b)
@@ -626,7 +880,9 @@ type Transaction struct {
 	// / Fees paid for this transaction
 	TotalFees int64 `protobuf:"varint,7,opt,name=total_fees,proto3" json:"total_fees,omitempty"`
 	// / Addresses that received funds for this transaction
-	DestAddresses        []string `protobuf:"bytes,8,rep,name=dest_addresses,proto3" json:"dest_addresses,omitempty"`
+	DestAddresses []string `protobuf:"bytes,8,rep,name=dest_addresses,proto3" json:"dest_addresses,omitempty"`
+	// / An optional label that was set on the transaction
+	Label                string   `protobuf:"bytes,9,opt,name=label,proto3" json:"label,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -636,7 +892,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{9}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{9}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -712,6 +968,13 @@ func (m *Transaction) GetDestAddresses() []string {
 	return nil
 }
 
+func (m *Transaction) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
 type GetTransactionsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -722,7 +985,7 @@ func (m *GetTransactionsRequest) Reset()         { *m = GetTransactionsRequest{}
 func (m *GetTransactionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()    {}
 func (*GetTransactionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{10}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{10}
 }
 func (m *GetTransactionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetTransactionsRequest.Unmarshal(m, b)
@@ -754,7 +1017,7 @@ func (m *TransactionDetails) Reset()         { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()    {}
 func (*TransactionDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{11}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{11}
 }
 func (m *TransactionDetails) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_TransactionDetails.Unmarshal(m, b)
@@ -795,7 +1058,7 @@ func (m *FeeLimit) Reset()         { *m = FeeLimit{} }
 func (m *FeeLimit) String() string { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()    {}
 func (*FeeLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{12}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{12}
 }
 func (m *FeeLimit) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FeeLimit.Unmarshal(m, b)
@@ -945,7 +1208,17 @@ type SendRequest struct {
 	// *
 	// The channel id of the channel that must be taken to the first hop. If zero,
 	// any channel may be used.
-	OutgoingChanId       uint64   `protobuf:"varint,9,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	OutgoingChanId uint64 `protobuf:"varint,9,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If empty, any last hop may be
+	// used. Combined with outgoing_chan_id and a payment to ourselves, this
+	// allows rebalancing a specific pair of channels.
+	LastHopPubkey []byte `protobuf:"bytes,10,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// *
+	// The maximum number of blocks the funds of the payment may be locked up for,
+	// including the final CLTV delta. Routes with a higher total time lock are
+	// ignored. If zero, no limit is enforced.
+	CltvLimit            uint32   `protobuf:"varint,11,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -955,7 +1228,7 @@ func (m *SendRequest) Reset()         { *m = SendRequest{} }
 func (m *SendRequest) String() string { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()    {}
 func (*SendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{13}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{13}
 }
 func (m *SendRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendRequest.Unmarshal(m, b)
@@ -1038,6 +1311,20 @@ func (m *SendRequest) GetOutgoingChanId() uint64 {
 	return 0
 }
 
+func (m *SendRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *SendRequest) GetCltvLimit() uint32 {
+	if m != nil {
+		return m.CltvLimit
+	}
+	return 0
+}
+
 type SendResponse struct {
 	PaymentError         string   `protobuf:"bytes,1,opt,name=payment_error,proto3" json:"payment_error,omitempty"`
 	PaymentPreimage      []byte   `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
@@ -1052,7 +1339,7 @@ func (m *SendResponse) Reset()         { *m = SendResponse{} }
 func (m *SendResponse) String() string { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()    {}
 func (*SendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{14}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{14}
 }
 func (m *SendResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendResponse.Unmarshal(m, b)
@@ -1122,7 +1409,7 @@ func (m *SendToRouteRequest) Reset()         { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()    {}
 func (*SendToRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{15}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{15}
 }
 func (m *SendToRouteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendToRouteRequest.Unmarshal(m, b)
@@ -1187,7 +1474,7 @@ func (m *ChannelPoint) Reset()         { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()    {}
 func (*ChannelPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{16}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{16}
 }
 func (m *ChannelPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelPoint.Unmarshal(m, b)
@@ -1333,7 +1620,7 @@ func (m *OutPoint) Reset()         { *m = OutPoint{} }
 func (m *OutPoint) String() string { return proto.CompactTextString(m) }
 func (*OutPoint) ProtoMessage()    {}
 func (*OutPoint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{17}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{17}
 }
 func (m *OutPoint) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OutPoint.Unmarshal(m, b)
@@ -1388,7 +1675,7 @@ func (m *LightningAddress) Reset()         { *m = LightningAddress{} }
 func (m *LightningAddress) String() string { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()    {}
 func (*LightningAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{18}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{18}
 }
 func (m *LightningAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LightningAddress.Unmarshal(m, b)
@@ -1427,8 +1714,24 @@ type SendManyRequest struct {
 	AddrToAmount map[string]int64 `protobuf:"bytes,1,rep,name=AddrToAmount,proto3" json:"AddrToAmount,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	// / The target number of blocks that this transaction should be confirmed by.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
-	SatPerByte           int64    `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// *
+	// Deprecated, use sat_per_vbyte. A manual fee rate set in sat/byte that
+	// should be used when crafting the transaction.
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// *
+	// A manual fee rate set in sat/vbyte that should be used when crafting the
+	// transaction.
+	SatPerVbyte uint64 `protobuf:"varint,6,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// / An optional label for the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,7,opt,name=label,proto3" json:"label,omitempty"`
+	// *
+	// The minimum number of confirmations each one of the inputs used for the
+	// transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,8,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// *
+	// Whether unconfirmed outputs should be used as inputs for the
+	// transaction.
+	SpendUnconfirmed     bool     `protobuf:"varint,9,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1438,7 +1741,7 @@ func (m *SendManyRequest) Reset()         { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()    {}
 func (*SendManyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{19}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{19}
 }
 func (m *SendManyRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyRequest.Unmarshal(m, b)
@@ -1479,6 +1782,34 @@ func (m *SendManyRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *SendManyRequest) GetSatPerVbyte() uint64 {
+	if m != nil {
+		return m.SatPerVbyte
+	}
+	return 0
+}
+
+func (m *SendManyRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SendManyRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *SendManyRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
 type SendManyResponse struct {
 	// / The id of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -1491,7 +1822,7 @@ func (m *SendManyResponse) Reset()         { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()    {}
 func (*SendManyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{20}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{20}
 }
 func (m *SendManyResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendManyResponse.Unmarshal(m, b)
@@ -1525,13 +1856,29 @@ type SendCoinsRequest struct {
 	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 	// / The target number of blocks that this transaction should be confirmed by.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
+	// *
+	// Deprecated, use sat_per_vbyte. A manual fee rate set in sat/byte that
+	// should be used when crafting the transaction.
 	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
 	// *
 	// If set, then the amount field will be ignored, and lnd will attempt to
 	// send all the coins under control of the internal wallet to the specified
 	// address.
-	SendAll              bool     `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	SendAll bool `protobuf:"varint,6,opt,name=send_all,json=sendAll,proto3" json:"send_all,omitempty"`
+	// *
+	// A manual fee rate set in sat/vbyte that should be used when crafting the
+	// transaction.
+	SatPerVbyte uint64 `protobuf:"varint,7,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	// / An optional label for the transaction, limited to 500 characters.
+	Label string `protobuf:"bytes,8,opt,name=label,proto3" json:"label,omitempty"`
+	// *
+	// The minimum number of confirmations each one of the inputs used for the
+	// transaction must satisfy.
+	MinConfs int32 `protobuf:"varint,9,opt,name=min_confs,json=minConfs,proto3" json:"min_confs,omitempty"`
+	// *
+	// Whether unconfirmed outputs should be used as inputs for the
+	// transaction.
+	SpendUnconfirmed     bool     `protobuf:"varint,10,opt,name=spend_unconfirmed,json=spendUnconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1541,7 +1888,7 @@ func (m *SendCoinsRequest) Reset()         { *m = SendCoinsRequest{} }
 func (m *SendCoinsRequest) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsRequest) ProtoMessage()    {}
 func (*SendCoinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{21}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{21}
 }
 func (m *SendCoinsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsRequest.Unmarshal(m, b)
@@ -1596,6 +1943,34 @@ func (m *SendCoinsRequest) GetSendAll() bool {
 	return false
 }
 
+func (m *SendCoinsRequest) GetSatPerVbyte() uint64 {
+	if m != nil {
+		return m.SatPerVbyte
+	}
+	return 0
+}
+
+func (m *SendCoinsRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *SendCoinsRequest) GetMinConfs() int32 {
+	if m != nil {
+		return m.MinConfs
+	}
+	return 0
+}
+
+func (m *SendCoinsRequest) GetSpendUnconfirmed() bool {
+	if m != nil {
+		return m.SpendUnconfirmed
+	}
+	return false
+}
+
 type SendCoinsResponse struct {
 	// / The transaction ID of the transaction
 	Txid                 string   `protobuf:"bytes,1,opt,name=txid,proto3" json:"txid,omitempty"`
@@ -1608,7 +1983,7 @@ func (m *SendCoinsResponse) Reset()         { *m = SendCoinsResponse{} }
 func (m *SendCoinsResponse) String() string { return proto.CompactTextString(m) }
 func (*SendCoinsResponse) ProtoMessage()    {}
 func (*SendCoinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{22}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{22}
 }
 func (m *SendCoinsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendCoinsResponse.Unmarshal(m, b)
@@ -1649,7 +2024,7 @@ func (m *ListUnspentRequest) Reset()         { *m = ListUnspentRequest{} }
 func (m *ListUnspentRequest) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentRequest) ProtoMessage()    {}
 func (*ListUnspentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{23}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{23}
 }
 func (m *ListUnspentRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentRequest.Unmarshal(m, b)
@@ -1695,7 +2070,7 @@ func (m *ListUnspentResponse) Reset()         { *m = ListUnspentResponse{} }
 func (m *ListUnspentResponse) String() string { return proto.CompactTextString(m) }
 func (*ListUnspentResponse) ProtoMessage()    {}
 func (*ListUnspentResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{24}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{24}
 }
 func (m *ListUnspentResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListUnspentResponse.Unmarshal(m, b)
@@ -1734,7 +2109,7 @@ func (m *NewAddressRequest) Reset()         { *m = NewAddressRequest{} }
 func (m *NewAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*NewAddressRequest) ProtoMessage()    {}
 func (*NewAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{25}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{25}
 }
 func (m *NewAddressRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressRequest.Unmarshal(m, b)
@@ -1773,7 +2148,7 @@ func (m *NewAddressResponse) Reset()         { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()    {}
 func (*NewAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{26}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{26}
 }
 func (m *NewAddressResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NewAddressResponse.Unmarshal(m, b)
@@ -1812,7 +2187,7 @@ func (m *SignMessageRequest) Reset()         { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()    {}
 func (*SignMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{27}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{27}
 }
 func (m *SignMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageRequest.Unmarshal(m, b)
@@ -1851,7 +2226,7 @@ func (m *SignMessageResponse) Reset()         { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()    {}
 func (*SignMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{28}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{28}
 }
 func (m *SignMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignMessageResponse.Unmarshal(m, b)
@@ -1892,7 +2267,7 @@ func (m *VerifyMessageRequest) Reset()         { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()    {}
 func (*VerifyMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{29}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{29}
 }
 func (m *VerifyMessageRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageRequest.Unmarshal(m, b)
@@ -1940,7 +2315,7 @@ func (m *VerifyMessageResponse) Reset()         { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()    {}
 func (*VerifyMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{30}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{30}
 }
 func (m *VerifyMessageResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_VerifyMessageResponse.Unmarshal(m, b)
@@ -1989,7 +2364,7 @@ func (m *ConnectPeerRequest) Reset()         { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()    {}
 func (*ConnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{31}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{31}
 }
 func (m *ConnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerRequest.Unmarshal(m, b)
@@ -2033,7 +2408,7 @@ func (m *ConnectPeerResponse) Reset()         { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()    {}
 func (*ConnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{32}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{32}
 }
 func (m *ConnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConnectPeerResponse.Unmarshal(m, b)
@@ -2065,7 +2440,7 @@ func (m *DisconnectPeerRequest) Reset()         { *m = DisconnectPeerRequest{} }
 func (m *DisconnectPeerRequest) String() string { return proto.CompactTextString(m) }
 func (*DisconnectPeerRequest) ProtoMessage()    {}
 func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{33}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{33}
 }
 func (m *DisconnectPeerRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisconnectPeerRequest.Unmarshal(m, b)
@@ -2102,7 +2477,7 @@ func (m *DisconnectPeerResponse) Reset()         { *m = DisconnectPeerResponse{}
 func (m *DisconnectPeerResponse) String() string { return proto.CompactTextString(m) }
 func (*DisconnectPeerResponse) ProtoMessage()    {}
 func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{34}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{34}
 }
 func (m *DisconnectPeerResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DisconnectPeerResponse.Unmarshal(m, b)
@@ -2122,115 +2497,382 @@ func (m *DisconnectPeerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DisconnectPeerResponse proto.InternalMessageInfo
 
-type HTLC struct {
-	Incoming             bool     `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
-	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	HashLock             []byte   `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
-	ExpirationHeight     uint32   `protobuf:"varint,4,opt,name=expiration_height,proto3" json:"expiration_height,omitempty"`
+type CheckPeerConnectivityRequest struct {
+	// / Lightning address of the peer, in the format `<pubkey>@host`
+	Addr                 *LightningAddress `protobuf:"bytes,1,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *CheckPeerConnectivityRequest) Reset()         { *m = CheckPeerConnectivityRequest{} }
+func (m *CheckPeerConnectivityRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckPeerConnectivityRequest) ProtoMessage()    {}
+func (*CheckPeerConnectivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{35}
+}
+func (m *CheckPeerConnectivityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckPeerConnectivityRequest.Unmarshal(m, b)
+}
+func (m *CheckPeerConnectivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckPeerConnectivityRequest.Marshal(b, m, deterministic)
+}
+func (dst *CheckPeerConnectivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckPeerConnectivityRequest.Merge(dst, src)
+}
+func (m *CheckPeerConnectivityRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckPeerConnectivityRequest.Size(m)
+}
+func (m *CheckPeerConnectivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckPeerConnectivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckPeerConnectivityRequest proto.InternalMessageInfo
+
+func (m *CheckPeerConnectivityRequest) GetAddr() *LightningAddress {
+	if m != nil {
+		return m.Addr
+	}
+	return nil
+}
+
+type CheckPeerConnectivityResponse struct {
+	// / Whether all stages of the connection attempt succeeded.
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// / The stage at which the connection attempt failed, if it did.
+	FailureStage CheckPeerConnectivityResponse_Stage `protobuf:"varint,2,opt,name=failure_stage,proto3,enum=lnrpc.CheckPeerConnectivityResponse_Stage" json:"failure_stage,omitempty"`
+	// / The reason the connection attempt failed, if it did.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// / The time in microseconds it took to establish the TCP connection.
+	TcpTime int64 `protobuf:"varint,4,opt,name=tcp_time,proto3" json:"tcp_time,omitempty"`
+	// / The time in microseconds it took to carry out the transport handshake.
+	HandshakeTime int64 `protobuf:"varint,5,opt,name=handshake_time,proto3" json:"handshake_time,omitempty"`
+	// / The time in microseconds it took to exchange init messages.
+	InitTime             int64    `protobuf:"varint,6,opt,name=init_time,proto3" json:"init_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HTLC) Reset()         { *m = HTLC{} }
-func (m *HTLC) String() string { return proto.CompactTextString(m) }
-func (*HTLC) ProtoMessage()    {}
-func (*HTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{35}
+func (m *CheckPeerConnectivityResponse) Reset()         { *m = CheckPeerConnectivityResponse{} }
+func (m *CheckPeerConnectivityResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckPeerConnectivityResponse) ProtoMessage()    {}
+func (*CheckPeerConnectivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{36}
 }
-func (m *HTLC) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HTLC.Unmarshal(m, b)
+func (m *CheckPeerConnectivityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckPeerConnectivityResponse.Unmarshal(m, b)
 }
-func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+func (m *CheckPeerConnectivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckPeerConnectivityResponse.Marshal(b, m, deterministic)
 }
-func (dst *HTLC) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HTLC.Merge(dst, src)
+func (dst *CheckPeerConnectivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckPeerConnectivityResponse.Merge(dst, src)
 }
-func (m *HTLC) XXX_Size() int {
-	return xxx_messageInfo_HTLC.Size(m)
+func (m *CheckPeerConnectivityResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckPeerConnectivityResponse.Size(m)
 }
-func (m *HTLC) XXX_DiscardUnknown() {
-	xxx_messageInfo_HTLC.DiscardUnknown(m)
+func (m *CheckPeerConnectivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckPeerConnectivityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HTLC proto.InternalMessageInfo
+var xxx_messageInfo_CheckPeerConnectivityResponse proto.InternalMessageInfo
 
-func (m *HTLC) GetIncoming() bool {
+func (m *CheckPeerConnectivityResponse) GetSuccess() bool {
 	if m != nil {
-		return m.Incoming
+		return m.Success
 	}
 	return false
 }
 
-func (m *HTLC) GetAmount() int64 {
+func (m *CheckPeerConnectivityResponse) GetFailureStage() CheckPeerConnectivityResponse_Stage {
 	if m != nil {
-		return m.Amount
+		return m.FailureStage
+	}
+	return CheckPeerConnectivityResponse_TCP
+}
+
+func (m *CheckPeerConnectivityResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CheckPeerConnectivityResponse) GetTcpTime() int64 {
+	if m != nil {
+		return m.TcpTime
 	}
 	return 0
 }
 
-func (m *HTLC) GetHashLock() []byte {
+func (m *CheckPeerConnectivityResponse) GetHandshakeTime() int64 {
 	if m != nil {
-		return m.HashLock
+		return m.HandshakeTime
 	}
-	return nil
+	return 0
 }
 
-func (m *HTLC) GetExpirationHeight() uint32 {
+func (m *CheckPeerConnectivityResponse) GetInitTime() int64 {
 	if m != nil {
-		return m.ExpirationHeight
+		return m.InitTime
 	}
 	return 0
 }
 
-type Channel struct {
-	// / Whether this channel is active or not
-	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
-	// / The identity pubkey of the remote node
-	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
-	// *
-	// The outpoint (txid:index) of the funding transaction. With this value, Bob
-	// will be able to generate a signature for Alice's version of the commitment
-	// transaction.
-	ChannelPoint string `protobuf:"bytes,3,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId uint64 `protobuf:"varint,4,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	// / The total amount of funds held in this channel
-	Capacity int64 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	// / This node's current balance in this channel
-	LocalBalance int64 `protobuf:"varint,6,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
-	// / The counterparty's current balance in this channel
-	RemoteBalance int64 `protobuf:"varint,7,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
-	// *
-	// The amount calculated to be paid in fees for the current set of commitment
-	// transactions. The fee amount is persisted with the channel in order to
-	// allow the fee amount to be removed and recalculated with each channel state
-	// update, including updates that happen after a system restart.
-	CommitFee int64 `protobuf:"varint,8,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
-	// / The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,9,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
-	// *
-	// The required number of satoshis per kilo-weight that the requester will pay
-	// at all times, for both the funding transaction and commitment transaction.
-	// This value can later be updated once the channel is open.
-	FeePerKw int64 `protobuf:"varint,10,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
-	// / The unsettled balance in this channel
-	UnsettledBalance int64 `protobuf:"varint,11,opt,name=unsettled_balance,proto3" json:"unsettled_balance,omitempty"`
-	// *
-	// The total number of satoshis we've sent within this channel.
-	TotalSatoshisSent int64 `protobuf:"varint,12,opt,name=total_satoshis_sent,proto3" json:"total_satoshis_sent,omitempty"`
-	// *
-	// The total number of satoshis we've received within this channel.
-	TotalSatoshisReceived int64 `protobuf:"varint,13,opt,name=total_satoshis_received,proto3" json:"total_satoshis_received,omitempty"`
-	// *
-	// The total number of updates conducted within this channel.
-	NumUpdates uint64 `protobuf:"varint,14,opt,name=num_updates,proto3" json:"num_updates,omitempty"`
-	// *
-	// The list of active, uncleared HTLCs currently pending within the channel.
+type DrainPeerRequest struct {
+	// / The pubkey of the peer to drain the channels of.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / Whether to resume forwarding through the channels with the peer.
+	Resume               bool     `protobuf:"varint,2,opt,name=resume,proto3" json:"resume,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainPeerRequest) Reset()         { *m = DrainPeerRequest{} }
+func (m *DrainPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainPeerRequest) ProtoMessage()    {}
+func (*DrainPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{37}
+}
+func (m *DrainPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainPeerRequest.Unmarshal(m, b)
+}
+func (m *DrainPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainPeerRequest.Marshal(b, m, deterministic)
+}
+func (dst *DrainPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainPeerRequest.Merge(dst, src)
+}
+func (m *DrainPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_DrainPeerRequest.Size(m)
+}
+func (m *DrainPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainPeerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainPeerRequest proto.InternalMessageInfo
+
+func (m *DrainPeerRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *DrainPeerRequest) GetResume() bool {
+	if m != nil {
+		return m.Resume
+	}
+	return false
+}
+
+type ChannelDrainState struct {
+	// / The outpoint (txid:index) of the funding transaction.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The number of HTLCs still pending on the channel.
+	NumPendingHtlcs      uint32   `protobuf:"varint,2,opt,name=num_pending_htlcs,proto3" json:"num_pending_htlcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelDrainState) Reset()         { *m = ChannelDrainState{} }
+func (m *ChannelDrainState) String() string { return proto.CompactTextString(m) }
+func (*ChannelDrainState) ProtoMessage()    {}
+func (*ChannelDrainState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{38}
+}
+func (m *ChannelDrainState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelDrainState.Unmarshal(m, b)
+}
+func (m *ChannelDrainState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelDrainState.Marshal(b, m, deterministic)
+}
+func (dst *ChannelDrainState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelDrainState.Merge(dst, src)
+}
+func (m *ChannelDrainState) XXX_Size() int {
+	return xxx_messageInfo_ChannelDrainState.Size(m)
+}
+func (m *ChannelDrainState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelDrainState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelDrainState proto.InternalMessageInfo
+
+func (m *ChannelDrainState) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
+}
+
+func (m *ChannelDrainState) GetNumPendingHtlcs() uint32 {
+	if m != nil {
+		return m.NumPendingHtlcs
+	}
+	return 0
+}
+
+type DrainPeerUpdate struct {
+	// / The state of each of the channels with the peer.
+	Channels []*ChannelDrainState `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// / Whether none of the channels with the peer has any pending HTLC.
+	Quiescent            bool     `protobuf:"varint,2,opt,name=quiescent,proto3" json:"quiescent,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainPeerUpdate) Reset()         { *m = DrainPeerUpdate{} }
+func (m *DrainPeerUpdate) String() string { return proto.CompactTextString(m) }
+func (*DrainPeerUpdate) ProtoMessage()    {}
+func (*DrainPeerUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{39}
+}
+func (m *DrainPeerUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainPeerUpdate.Unmarshal(m, b)
+}
+func (m *DrainPeerUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainPeerUpdate.Marshal(b, m, deterministic)
+}
+func (dst *DrainPeerUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainPeerUpdate.Merge(dst, src)
+}
+func (m *DrainPeerUpdate) XXX_Size() int {
+	return xxx_messageInfo_DrainPeerUpdate.Size(m)
+}
+func (m *DrainPeerUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainPeerUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainPeerUpdate proto.InternalMessageInfo
+
+func (m *DrainPeerUpdate) GetChannels() []*ChannelDrainState {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *DrainPeerUpdate) GetQuiescent() bool {
+	if m != nil {
+		return m.Quiescent
+	}
+	return false
+}
+
+type HTLC struct {
+	Incoming             bool     `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	Amount               int64    `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	HashLock             []byte   `protobuf:"bytes,3,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
+	ExpirationHeight     uint32   `protobuf:"varint,4,opt,name=expiration_height,proto3" json:"expiration_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HTLC) Reset()         { *m = HTLC{} }
+func (m *HTLC) String() string { return proto.CompactTextString(m) }
+func (*HTLC) ProtoMessage()    {}
+func (*HTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{40}
+}
+func (m *HTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HTLC.Unmarshal(m, b)
+}
+func (m *HTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HTLC.Marshal(b, m, deterministic)
+}
+func (dst *HTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HTLC.Merge(dst, src)
+}
+func (m *HTLC) XXX_Size() int {
+	return xxx_messageInfo_HTLC.Size(m)
+}
+func (m *HTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_HTLC.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HTLC proto.InternalMessageInfo
+
+func (m *HTLC) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
+}
+
+func (m *HTLC) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *HTLC) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
+}
+
+func (m *HTLC) GetExpirationHeight() uint32 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+type Channel struct {
+	// / Whether this channel is active or not
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// / The identity pubkey of the remote node
+	RemotePubkey string `protobuf:"bytes,2,opt,name=remote_pubkey,proto3" json:"remote_pubkey,omitempty"`
+	// *
+	// The outpoint (txid:index) of the funding transaction. With this value, Bob
+	// will be able to generate a signature for Alice's version of the commitment
+	// transaction.
+	ChannelPoint string `protobuf:"bytes,3,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId uint64 `protobuf:"varint,4,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The total amount of funds held in this channel
+	Capacity int64 `protobuf:"varint,5,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// / This node's current balance in this channel
+	LocalBalance int64 `protobuf:"varint,6,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
+	// / The counterparty's current balance in this channel
+	RemoteBalance int64 `protobuf:"varint,7,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
+	// *
+	// The amount calculated to be paid in fees for the current set of commitment
+	// transactions. The fee amount is persisted with the channel in order to
+	// allow the fee amount to be removed and recalculated with each channel state
+	// update, including updates that happen after a system restart.
+	CommitFee int64 `protobuf:"varint,8,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
+	// / The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,9,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
+	// *
+	// The required number of satoshis per kilo-weight that the requester will pay
+	// at all times, for both the funding transaction and commitment transaction.
+	// This value can later be updated once the channel is open.
+	FeePerKw int64 `protobuf:"varint,10,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
+	// / The unsettled balance in this channel
+	UnsettledBalance int64 `protobuf:"varint,11,opt,name=unsettled_balance,proto3" json:"unsettled_balance,omitempty"`
+	// *
+	// The total number of satoshis we've sent within this channel.
+	TotalSatoshisSent int64 `protobuf:"varint,12,opt,name=total_satoshis_sent,proto3" json:"total_satoshis_sent,omitempty"`
+	// *
+	// The total number of satoshis we've received within this channel.
+	TotalSatoshisReceived int64 `protobuf:"varint,13,opt,name=total_satoshis_received,proto3" json:"total_satoshis_received,omitempty"`
+	// *
+	// The total number of updates conducted within this channel.
+	NumUpdates uint64 `protobuf:"varint,14,opt,name=num_updates,proto3" json:"num_updates,omitempty"`
+	// *
+	// The list of active, uncleared HTLCs currently pending within the channel.
 	PendingHtlcs []*HTLC `protobuf:"bytes,15,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
 	// *
 	// The CSV delay expressed in relative blocks. If the channel is force
@@ -2240,7 +2882,21 @@ type Channel struct {
 	// / Whether this channel is advertised to the network or not.
 	Private bool `protobuf:"varint,17,opt,name=private,proto3" json:"private,omitempty"`
 	// / True if we were the ones that created the channel.
-	Initiator            bool     `protobuf:"varint,18,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	Initiator bool `protobuf:"varint,18,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	// / The number of HTLCs received over this channel that we attempted to forward.
+	NumIncomingHtlcs uint64 `protobuf:"varint,19,opt,name=num_incoming_htlcs,proto3" json:"num_incoming_htlcs,omitempty"`
+	// / The number of HTLCs received over this channel that were endorsed by the remote peer.
+	NumEndorsedIncomingHtlcs uint64 `protobuf:"varint,20,opt,name=num_endorsed_incoming_htlcs,proto3" json:"num_endorsed_incoming_htlcs,omitempty"`
+	// / The fraction of the HTLCs received over this channel that were endorsed by the remote peer.
+	IncomingEndorsementRate float64 `protobuf:"fixed64,21,opt,name=incoming_endorsement_rate,proto3" json:"incoming_endorsement_rate,omitempty"`
+	// / The constraints the remote party imposes on our commitment transaction.
+	LocalConstraints *ChannelConstraints `protobuf:"bytes,22,opt,name=local_constraints,proto3" json:"local_constraints,omitempty"`
+	// / The constraints we impose on the remote party's commitment transaction.
+	RemoteConstraints *ChannelConstraints `protobuf:"bytes,23,opt,name=remote_constraints,proto3" json:"remote_constraints,omitempty"`
+	// *
+	// The expected fee of a cooperative close of the channel at the current fee
+	// estimate. This fee is paid by the initiator of the channel.
+	CoopCloseFee         int64    `protobuf:"varint,24,opt,name=coop_close_fee,proto3" json:"coop_close_fee,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2250,7 +2906,7 @@ func (m *Channel) Reset()         { *m = Channel{} }
 func (m *Channel) String() string { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()    {}
 func (*Channel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{36}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{41}
 }
 func (m *Channel) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Channel.Unmarshal(m, b)
@@ -2396,72 +3052,200 @@ func (m *Channel) GetInitiator() bool {
 	return false
 }
 
-type ListChannelsRequest struct {
-	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
-	InactiveOnly         bool     `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
-	PublicOnly           bool     `protobuf:"varint,3,opt,name=public_only,json=publicOnly,proto3" json:"public_only,omitempty"`
-	PrivateOnly          bool     `protobuf:"varint,4,opt,name=private_only,json=privateOnly,proto3" json:"private_only,omitempty"`
+func (m *Channel) GetNumIncomingHtlcs() uint64 {
+	if m != nil {
+		return m.NumIncomingHtlcs
+	}
+	return 0
+}
+
+func (m *Channel) GetNumEndorsedIncomingHtlcs() uint64 {
+	if m != nil {
+		return m.NumEndorsedIncomingHtlcs
+	}
+	return 0
+}
+
+func (m *Channel) GetIncomingEndorsementRate() float64 {
+	if m != nil {
+		return m.IncomingEndorsementRate
+	}
+	return 0
+}
+
+func (m *Channel) GetLocalConstraints() *ChannelConstraints {
+	if m != nil {
+		return m.LocalConstraints
+	}
+	return nil
+}
+
+func (m *Channel) GetRemoteConstraints() *ChannelConstraints {
+	if m != nil {
+		return m.RemoteConstraints
+	}
+	return nil
+}
+
+func (m *Channel) GetCoopCloseFee() int64 {
+	if m != nil {
+		return m.CoopCloseFee
+	}
+	return 0
+}
+
+type ChannelConstraints struct {
+	// *
+	// The CSV delay expressed in relative blocks. If the channel is force closed,
+	// we will need to wait for this many blocks before we can regain our funds.
+	CsvDelay uint32 `protobuf:"varint,1,opt,name=csv_delay,proto3" json:"csv_delay,omitempty"`
+	// / The minimum satoshis this node is required to reserve in its balance.
+	ChanReserveSat uint64 `protobuf:"varint,2,opt,name=chan_reserve_sat,proto3" json:"chan_reserve_sat,omitempty"`
+	// / The dust limit (in satoshis) of the initiator's commitment tx.
+	DustLimitSat uint64 `protobuf:"varint,3,opt,name=dust_limit_sat,proto3" json:"dust_limit_sat,omitempty"`
+	// / The maximum amount of coins in millisatoshis that can be pending in this channel.
+	MaxPendingAmtMsat uint64 `protobuf:"varint,4,opt,name=max_pending_amt_msat,proto3" json:"max_pending_amt_msat,omitempty"`
+	// / The smallest HTLC in millisatoshis that the initiator will accept.
+	MinHtlcMsat uint64 `protobuf:"varint,5,opt,name=min_htlc_msat,proto3" json:"min_htlc_msat,omitempty"`
+	// / The total number of incoming HTLC's that the initiator will accept.
+	MaxAcceptedHtlcs     uint32   `protobuf:"varint,6,opt,name=max_accepted_htlcs,proto3" json:"max_accepted_htlcs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
-func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListChannelsRequest) ProtoMessage()    {}
-func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{37}
+func (m *ChannelConstraints) Reset()         { *m = ChannelConstraints{} }
+func (m *ChannelConstraints) String() string { return proto.CompactTextString(m) }
+func (*ChannelConstraints) ProtoMessage()    {}
+func (*ChannelConstraints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{42}
 }
-func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
+func (m *ChannelConstraints) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelConstraints.Unmarshal(m, b)
 }
-func (m *ListChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListChannelsRequest.Marshal(b, m, deterministic)
+func (m *ChannelConstraints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelConstraints.Marshal(b, m, deterministic)
 }
-func (dst *ListChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListChannelsRequest.Merge(dst, src)
+func (dst *ChannelConstraints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelConstraints.Merge(dst, src)
 }
-func (m *ListChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListChannelsRequest.Size(m)
+func (m *ChannelConstraints) XXX_Size() int {
+	return xxx_messageInfo_ChannelConstraints.Size(m)
 }
-func (m *ListChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListChannelsRequest.DiscardUnknown(m)
+func (m *ChannelConstraints) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelConstraints.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListChannelsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ChannelConstraints proto.InternalMessageInfo
 
-func (m *ListChannelsRequest) GetActiveOnly() bool {
+func (m *ChannelConstraints) GetCsvDelay() uint32 {
 	if m != nil {
-		return m.ActiveOnly
+		return m.CsvDelay
 	}
-	return false
+	return 0
 }
 
-func (m *ListChannelsRequest) GetInactiveOnly() bool {
+func (m *ChannelConstraints) GetChanReserveSat() uint64 {
 	if m != nil {
-		return m.InactiveOnly
+		return m.ChanReserveSat
 	}
-	return false
+	return 0
 }
 
-func (m *ListChannelsRequest) GetPublicOnly() bool {
+func (m *ChannelConstraints) GetDustLimitSat() uint64 {
 	if m != nil {
-		return m.PublicOnly
+		return m.DustLimitSat
 	}
-	return false
+	return 0
 }
 
-func (m *ListChannelsRequest) GetPrivateOnly() bool {
+func (m *ChannelConstraints) GetMaxPendingAmtMsat() uint64 {
 	if m != nil {
-		return m.PrivateOnly
+		return m.MaxPendingAmtMsat
 	}
-	return false
+	return 0
 }
 
-type ListChannelsResponse struct {
-	// / The list of active channels
-	Channels             []*Channel `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+func (m *ChannelConstraints) GetMinHtlcMsat() uint64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
+}
+
+func (m *ChannelConstraints) GetMaxAcceptedHtlcs() uint32 {
+	if m != nil {
+		return m.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+type ListChannelsRequest struct {
+	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	InactiveOnly         bool     `protobuf:"varint,2,opt,name=inactive_only,json=inactiveOnly,proto3" json:"inactive_only,omitempty"`
+	PublicOnly           bool     `protobuf:"varint,3,opt,name=public_only,json=publicOnly,proto3" json:"public_only,omitempty"`
+	PrivateOnly          bool     `protobuf:"varint,4,opt,name=private_only,json=privateOnly,proto3" json:"private_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListChannelsRequest) Reset()         { *m = ListChannelsRequest{} }
+func (m *ListChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListChannelsRequest) ProtoMessage()    {}
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{43}
+}
+func (m *ListChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListChannelsRequest.Unmarshal(m, b)
+}
+func (m *ListChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListChannelsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListChannelsRequest.Merge(dst, src)
+}
+func (m *ListChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListChannelsRequest.Size(m)
+}
+func (m *ListChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListChannelsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListChannelsRequest proto.InternalMessageInfo
+
+func (m *ListChannelsRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
+}
+
+func (m *ListChannelsRequest) GetInactiveOnly() bool {
+	if m != nil {
+		return m.InactiveOnly
+	}
+	return false
+}
+
+func (m *ListChannelsRequest) GetPublicOnly() bool {
+	if m != nil {
+		return m.PublicOnly
+	}
+	return false
+}
+
+func (m *ListChannelsRequest) GetPrivateOnly() bool {
+	if m != nil {
+		return m.PrivateOnly
+	}
+	return false
+}
+
+type ListChannelsResponse struct {
+	// / The list of active channels
+	Channels             []*Channel `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
 }
@@ -2470,7 +3254,7 @@ func (m *ListChannelsResponse) Reset()         { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()    {}
 func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{38}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{44}
 }
 func (m *ListChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListChannelsResponse.Unmarshal(m, b)
@@ -2527,7 +3311,7 @@ func (m *ChannelCloseSummary) Reset()         { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()    {}
 func (*ChannelCloseSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{39}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{45}
 }
 func (m *ChannelCloseSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseSummary.Unmarshal(m, b)
@@ -2633,7 +3417,7 @@ func (m *ClosedChannelsRequest) Reset()         { *m = ClosedChannelsRequest{} }
 func (m *ClosedChannelsRequest) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsRequest) ProtoMessage()    {}
 func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{40}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{46}
 }
 func (m *ClosedChannelsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsRequest.Unmarshal(m, b)
@@ -2706,7 +3490,7 @@ func (m *ClosedChannelsResponse) Reset()         { *m = ClosedChannelsResponse{}
 func (m *ClosedChannelsResponse) String() string { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()    {}
 func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{41}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{47}
 }
 func (m *ClosedChannelsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ClosedChannelsResponse.Unmarshal(m, b)
@@ -2759,7 +3543,7 @@ func (m *Peer) Reset()         { *m = Peer{} }
 func (m *Peer) String() string { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()    {}
 func (*Peer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{42}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{48}
 }
 func (m *Peer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Peer.Unmarshal(m, b)
@@ -2845,7 +3629,7 @@ func (m *ListPeersRequest) Reset()         { *m = ListPeersRequest{} }
 func (m *ListPeersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListPeersRequest) ProtoMessage()    {}
 func (*ListPeersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{43}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{49}
 }
 func (m *ListPeersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPeersRequest.Unmarshal(m, b)
@@ -2877,7 +3661,7 @@ func (m *ListPeersResponse) Reset()         { *m = ListPeersResponse{} }
 func (m *ListPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListPeersResponse) ProtoMessage()    {}
 func (*ListPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{44}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{50}
 }
 func (m *ListPeersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListPeersResponse.Unmarshal(m, b)
@@ -2904,6 +3688,360 @@ func (m *ListPeersResponse) GetPeers() []*Peer {
 	return nil
 }
 
+type GetRecoveryInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRecoveryInfoRequest) Reset()         { *m = GetRecoveryInfoRequest{} }
+func (m *GetRecoveryInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoRequest) ProtoMessage()    {}
+func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{51}
+}
+func (m *GetRecoveryInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecoveryInfoRequest.Unmarshal(m, b)
+}
+func (m *GetRecoveryInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecoveryInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetRecoveryInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecoveryInfoRequest.Merge(dst, src)
+}
+func (m *GetRecoveryInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRecoveryInfoRequest.Size(m)
+}
+func (m *GetRecoveryInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecoveryInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRecoveryInfoRequest proto.InternalMessageInfo
+
+type GetRecoveryInfoResponse struct {
+	// / Whether the wallet is in recovery mode
+	RecoveryMode bool `protobuf:"varint,1,opt,name=recovery_mode,proto3" json:"recovery_mode,omitempty"`
+	// / Whether the wallet recovery progress is finished
+	RecoveryFinished bool `protobuf:"varint,2,opt,name=recovery_finished,proto3" json:"recovery_finished,omitempty"`
+	// / The recovery progress, ranging from 0 to 1.
+	Progress             float64  `protobuf:"fixed64,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRecoveryInfoResponse) Reset()         { *m = GetRecoveryInfoResponse{} }
+func (m *GetRecoveryInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoResponse) ProtoMessage()    {}
+func (*GetRecoveryInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{52}
+}
+func (m *GetRecoveryInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRecoveryInfoResponse.Unmarshal(m, b)
+}
+func (m *GetRecoveryInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRecoveryInfoResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetRecoveryInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRecoveryInfoResponse.Merge(dst, src)
+}
+func (m *GetRecoveryInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRecoveryInfoResponse.Size(m)
+}
+func (m *GetRecoveryInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRecoveryInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRecoveryInfoResponse proto.InternalMessageInfo
+
+func (m *GetRecoveryInfoResponse) GetRecoveryMode() bool {
+	if m != nil {
+		return m.RecoveryMode
+	}
+	return false
+}
+
+func (m *GetRecoveryInfoResponse) GetRecoveryFinished() bool {
+	if m != nil {
+		return m.RecoveryFinished
+	}
+	return false
+}
+
+func (m *GetRecoveryInfoResponse) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+type SubsystemHealth struct {
+	// / The name of the subsystem.
+	Subsystem string `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	// / The health status of the subsystem.
+	Status SubsystemStatus `protobuf:"varint,2,opt,name=status,proto3,enum=lnrpc.SubsystemStatus" json:"status,omitempty"`
+	// / The number of times the subsystem panicked.
+	Panics uint32 `protobuf:"varint,3,opt,name=panics,proto3" json:"panics,omitempty"`
+	// / The number of times the subsystem was restarted after a panic.
+	Restarts uint32 `protobuf:"varint,4,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// / The value the subsystem last panicked with.
+	LastPanic string `protobuf:"bytes,5,opt,name=last_panic,proto3" json:"last_panic,omitempty"`
+	// / The unix timestamp at which the subsystem last panicked.
+	LastPanicTime        int64    `protobuf:"varint,6,opt,name=last_panic_time,proto3" json:"last_panic_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubsystemHealth) Reset()         { *m = SubsystemHealth{} }
+func (m *SubsystemHealth) String() string { return proto.CompactTextString(m) }
+func (*SubsystemHealth) ProtoMessage()    {}
+func (*SubsystemHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{53}
+}
+func (m *SubsystemHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubsystemHealth.Unmarshal(m, b)
+}
+func (m *SubsystemHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubsystemHealth.Marshal(b, m, deterministic)
+}
+func (dst *SubsystemHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubsystemHealth.Merge(dst, src)
+}
+func (m *SubsystemHealth) XXX_Size() int {
+	return xxx_messageInfo_SubsystemHealth.Size(m)
+}
+func (m *SubsystemHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubsystemHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubsystemHealth proto.InternalMessageInfo
+
+func (m *SubsystemHealth) GetSubsystem() string {
+	if m != nil {
+		return m.Subsystem
+	}
+	return ""
+}
+
+func (m *SubsystemHealth) GetStatus() SubsystemStatus {
+	if m != nil {
+		return m.Status
+	}
+	return SubsystemStatus_HEALTHY
+}
+
+func (m *SubsystemHealth) GetPanics() uint32 {
+	if m != nil {
+		return m.Panics
+	}
+	return 0
+}
+
+func (m *SubsystemHealth) GetRestarts() uint32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *SubsystemHealth) GetLastPanic() string {
+	if m != nil {
+		return m.LastPanic
+	}
+	return ""
+}
+
+func (m *SubsystemHealth) GetLastPanicTime() int64 {
+	if m != nil {
+		return m.LastPanicTime
+	}
+	return 0
+}
+
+type GetHealthRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetHealthRequest) Reset()         { *m = GetHealthRequest{} }
+func (m *GetHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*GetHealthRequest) ProtoMessage()    {}
+func (*GetHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{54}
+}
+func (m *GetHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHealthRequest.Unmarshal(m, b)
+}
+func (m *GetHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHealthRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHealthRequest.Merge(dst, src)
+}
+func (m *GetHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_GetHealthRequest.Size(m)
+}
+func (m *GetHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHealthRequest proto.InternalMessageInfo
+
+type GetHealthResponse struct {
+	// / The health of each of the subsystems.
+	Subsystems           []*SubsystemHealth `protobuf:"bytes,1,rep,name=subsystems,proto3" json:"subsystems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetHealthResponse) Reset()         { *m = GetHealthResponse{} }
+func (m *GetHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*GetHealthResponse) ProtoMessage()    {}
+func (*GetHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{55}
+}
+func (m *GetHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetHealthResponse.Unmarshal(m, b)
+}
+func (m *GetHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetHealthResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetHealthResponse.Merge(dst, src)
+}
+func (m *GetHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_GetHealthResponse.Size(m)
+}
+func (m *GetHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetHealthResponse proto.InternalMessageInfo
+
+func (m *GetHealthResponse) GetSubsystems() []*SubsystemHealth {
+	if m != nil {
+		return m.Subsystems
+	}
+	return nil
+}
+
+type ChainBackendEventSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainBackendEventSubscription) Reset()         { *m = ChainBackendEventSubscription{} }
+func (m *ChainBackendEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChainBackendEventSubscription) ProtoMessage()    {}
+func (*ChainBackendEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{56}
+}
+func (m *ChainBackendEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainBackendEventSubscription.Unmarshal(m, b)
+}
+func (m *ChainBackendEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainBackendEventSubscription.Marshal(b, m, deterministic)
+}
+func (dst *ChainBackendEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainBackendEventSubscription.Merge(dst, src)
+}
+func (m *ChainBackendEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_ChainBackendEventSubscription.Size(m)
+}
+func (m *ChainBackendEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainBackendEventSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainBackendEventSubscription proto.InternalMessageInfo
+
+type ChainBackendEvent struct {
+	// / The type of the event.
+	Type ChainBackendEventType `protobuf:"varint,1,opt,name=type,proto3,enum=lnrpc.ChainBackendEventType" json:"type,omitempty"`
+	// / The name of the active chain backend the event relates to.
+	Backend string `protobuf:"bytes,2,opt,name=backend,proto3" json:"backend,omitempty"`
+	// / The height of the last block returned by the active chain backend.
+	Height int32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// / The unix timestamp at which a stalled backend last made progress.
+	StalledSince int64 `protobuf:"varint,4,opt,name=stalled_since,proto3" json:"stalled_since,omitempty"`
+	// / The name of the fallback chain backend we're failing over to.
+	FallbackBackend string `protobuf:"bytes,5,opt,name=fallback_backend,proto3" json:"fallback_backend,omitempty"`
+	// / The height of the best block known to the fallback chain backend.
+	FallbackHeight       int32    `protobuf:"varint,6,opt,name=fallback_height,proto3" json:"fallback_height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChainBackendEvent) Reset()         { *m = ChainBackendEvent{} }
+func (m *ChainBackendEvent) String() string { return proto.CompactTextString(m) }
+func (*ChainBackendEvent) ProtoMessage()    {}
+func (*ChainBackendEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{57}
+}
+func (m *ChainBackendEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChainBackendEvent.Unmarshal(m, b)
+}
+func (m *ChainBackendEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChainBackendEvent.Marshal(b, m, deterministic)
+}
+func (dst *ChainBackendEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChainBackendEvent.Merge(dst, src)
+}
+func (m *ChainBackendEvent) XXX_Size() int {
+	return xxx_messageInfo_ChainBackendEvent.Size(m)
+}
+func (m *ChainBackendEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChainBackendEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChainBackendEvent proto.InternalMessageInfo
+
+func (m *ChainBackendEvent) GetType() ChainBackendEventType {
+	if m != nil {
+		return m.Type
+	}
+	return ChainBackendEventType_STALLED
+}
+
+func (m *ChainBackendEvent) GetBackend() string {
+	if m != nil {
+		return m.Backend
+	}
+	return ""
+}
+
+func (m *ChainBackendEvent) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ChainBackendEvent) GetStalledSince() int64 {
+	if m != nil {
+		return m.StalledSince
+	}
+	return 0
+}
+
+func (m *ChainBackendEvent) GetFallbackBackend() string {
+	if m != nil {
+		return m.FallbackBackend
+	}
+	return ""
+}
+
+func (m *ChainBackendEvent) GetFallbackHeight() int32 {
+	if m != nil {
+		return m.FallbackHeight
+	}
+	return 0
+}
+
 type GetInfoRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -2914,7 +4052,7 @@ func (m *GetInfoRequest) Reset()         { *m = GetInfoRequest{} }
 func (m *GetInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetInfoRequest) ProtoMessage()    {}
 func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{45}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{58}
 }
 func (m *GetInfoRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfoRequest.Unmarshal(m, b)
@@ -2964,7 +4102,15 @@ type GetInfoResponse struct {
 	// / Number of inactive channels
 	NumInactiveChannels uint32 `protobuf:"varint,15,opt,name=num_inactive_channels,proto3" json:"num_inactive_channels,omitempty"`
 	// / A list of active chains the node is connected to
-	Chains               []*Chain `protobuf:"bytes,16,rep,name=chains,proto3" json:"chains,omitempty"`
+	Chains []*Chain `protobuf:"bytes,16,rep,name=chains,proto3" json:"chains,omitempty"`
+	// / Whether we've completed the initial sync of the channel graph
+	SyncedToGraph bool `protobuf:"varint,18,opt,name=synced_to_graph,proto3" json:"synced_to_graph,omitempty"`
+	// / The features the node advertises in its node announcement
+	Features []*Feature `protobuf:"bytes,19,rep,name=features,proto3" json:"features,omitempty"`
+	// / The commit hash lnd was built from
+	CommitHash string `protobuf:"bytes,20,opt,name=commit_hash,proto3" json:"commit_hash,omitempty"`
+	// / The build tags lnd was compiled with
+	BuildTags            []string `protobuf:"bytes,21,rep,name=build_tags,proto3" json:"build_tags,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2974,7 +4120,7 @@ func (m *GetInfoResponse) Reset()         { *m = GetInfoResponse{} }
 func (m *GetInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetInfoResponse) ProtoMessage()    {}
 func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{46}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{59}
 }
 func (m *GetInfoResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetInfoResponse.Unmarshal(m, b)
@@ -3093,21 +4239,51 @@ func (m *GetInfoResponse) GetChains() []*Chain {
 	return nil
 }
 
-type Chain struct {
-	// / The blockchain the node is on (eg bitcoin, litecoin)
-	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
-	// / The network the node is on (eg regtest, testnet, mainnet)
-	Network              string   `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetInfoResponse) GetSyncedToGraph() bool {
+	if m != nil {
+		return m.SyncedToGraph
+	}
+	return false
 }
 
-func (m *Chain) Reset()         { *m = Chain{} }
-func (m *Chain) String() string { return proto.CompactTextString(m) }
-func (*Chain) ProtoMessage()    {}
+func (m *GetInfoResponse) GetFeatures() []*Feature {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *GetInfoResponse) GetCommitHash() string {
+	if m != nil {
+		return m.CommitHash
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetBuildTags() []string {
+	if m != nil {
+		return m.BuildTags
+	}
+	return nil
+}
+
+type Chain struct {
+	// / The blockchain the node is on (eg bitcoin, litecoin)
+	Chain string `protobuf:"bytes,1,opt,name=chain,proto3" json:"chain,omitempty"`
+	// / The network the node is on (eg regtest, testnet, mainnet)
+	Network string `protobuf:"bytes,2,opt,name=network,proto3" json:"network,omitempty"`
+	// / Whether the node is synced to this chain
+	Synced               bool     `protobuf:"varint,3,opt,name=synced,proto3" json:"synced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Chain) Reset()         { *m = Chain{} }
+func (m *Chain) String() string { return proto.CompactTextString(m) }
+func (*Chain) ProtoMessage()    {}
 func (*Chain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{47}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{60}
 }
 func (m *Chain) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Chain.Unmarshal(m, b)
@@ -3141,6 +4317,79 @@ func (m *Chain) GetNetwork() string {
 	return ""
 }
 
+func (m *Chain) GetSynced() bool {
+	if m != nil {
+		return m.Synced
+	}
+	return false
+}
+
+type Feature struct {
+	// / The feature bit, even if required and odd if optional.
+	Bit uint32 `protobuf:"varint,1,opt,name=bit,proto3" json:"bit,omitempty"`
+	// / The name of the feature, if known.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// / Whether the feature is required.
+	IsRequired bool `protobuf:"varint,3,opt,name=is_required,proto3" json:"is_required,omitempty"`
+	// / Whether the feature is known to lnd.
+	IsKnown              bool     `protobuf:"varint,4,opt,name=is_known,proto3" json:"is_known,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Feature) Reset()         { *m = Feature{} }
+func (m *Feature) String() string { return proto.CompactTextString(m) }
+func (*Feature) ProtoMessage()    {}
+func (*Feature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{61}
+}
+func (m *Feature) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Feature.Unmarshal(m, b)
+}
+func (m *Feature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Feature.Marshal(b, m, deterministic)
+}
+func (dst *Feature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Feature.Merge(dst, src)
+}
+func (m *Feature) XXX_Size() int {
+	return xxx_messageInfo_Feature.Size(m)
+}
+func (m *Feature) XXX_DiscardUnknown() {
+	xxx_messageInfo_Feature.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Feature proto.InternalMessageInfo
+
+func (m *Feature) GetBit() uint32 {
+	if m != nil {
+		return m.Bit
+	}
+	return 0
+}
+
+func (m *Feature) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Feature) GetIsRequired() bool {
+	if m != nil {
+		return m.IsRequired
+	}
+	return false
+}
+
+func (m *Feature) GetIsKnown() bool {
+	if m != nil {
+		return m.IsKnown
+	}
+	return false
+}
+
 type ConfirmationUpdate struct {
 	BlockSha             []byte   `protobuf:"bytes,1,opt,name=block_sha,json=blockSha,proto3" json:"block_sha,omitempty"`
 	BlockHeight          int32    `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -3154,7 +4403,7 @@ func (m *ConfirmationUpdate) Reset()         { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()    {}
 func (*ConfirmationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{48}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{62}
 }
 func (m *ConfirmationUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ConfirmationUpdate.Unmarshal(m, b)
@@ -3206,7 +4455,7 @@ func (m *ChannelOpenUpdate) Reset()         { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()    {}
 func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{49}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{63}
 }
 func (m *ChannelOpenUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelOpenUpdate.Unmarshal(m, b)
@@ -3245,7 +4494,7 @@ func (m *ChannelCloseUpdate) Reset()         { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()    {}
 func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{50}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{64}
 }
 func (m *ChannelCloseUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ChannelCloseUpdate.Unmarshal(m, b)
@@ -3290,7 +4539,19 @@ type CloseChannelRequest struct {
 	// / The target number of blocks that the closure transaction should be confirmed by.
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
 	// / A manual fee rate set in sat/byte that should be used when crafting the closure transaction.
-	SatPerByte           int64    `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	SatPerByte int64 `protobuf:"varint,4,opt,name=sat_per_byte,json=satPerByte,proto3" json:"sat_per_byte,omitempty"`
+	// *
+	// If true, then a new channel will be opened with the same peer once the
+	// cooperative closure transaction has confirmed, reusing the funds returned
+	// by the close. This allows channels to be resized with a single command.
+	// The fee preference of the closure transaction is also used for the
+	// funding transaction of the new channel.
+	Reopen bool `protobuf:"varint,5,opt,name=reopen,proto3" json:"reopen,omitempty"`
+	// *
+	// The number of satoshis to commit to the reopened channel. If zero, then
+	// the balance returned by the close is committed, minus the fee of the
+	// funding transaction.
+	ReopenLocalAmt       int64    `protobuf:"varint,6,opt,name=reopen_local_amt,json=reopenLocalAmt,proto3" json:"reopen_local_amt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3300,7 +4561,7 @@ func (m *CloseChannelRequest) Reset()         { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()    {}
 func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{51}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{65}
 }
 func (m *CloseChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseChannelRequest.Unmarshal(m, b)
@@ -3348,6 +4609,20 @@ func (m *CloseChannelRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *CloseChannelRequest) GetReopen() bool {
+	if m != nil {
+		return m.Reopen
+	}
+	return false
+}
+
+func (m *CloseChannelRequest) GetReopenLocalAmt() int64 {
+	if m != nil {
+		return m.ReopenLocalAmt
+	}
+	return 0
+}
+
 type CloseStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*CloseStatusUpdate_ClosePending
@@ -3362,7 +4637,7 @@ func (m *CloseStatusUpdate) Reset()         { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()    {}
 func (*CloseStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{52}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{66}
 }
 func (m *CloseStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CloseStatusUpdate.Unmarshal(m, b)
@@ -3505,7 +4780,7 @@ func (m *PendingUpdate) Reset()         { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()    {}
 func (*PendingUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{53}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{67}
 }
 func (m *PendingUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PendingUpdate.Unmarshal(m, b)
@@ -3561,7 +4836,20 @@ type OpenChannelRequest struct {
 	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
 	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs,proto3" json:"min_confs,omitempty"`
 	// / Whether unconfirmed outputs should be used as inputs for the funding transaction.
-	SpendUnconfirmed     bool     `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	SpendUnconfirmed bool `protobuf:"varint,12,opt,name=spend_unconfirmed,proto3" json:"spend_unconfirmed,omitempty"`
+	// *
+	// Close address is an optional address which specifies the address to which
+	// funds should be paid out to upon cooperative close. This field may only be
+	// set if the peer supports the option upfront feature bit (call listpeers
+	// to check). The remote peer will only accept cooperative closes to this
+	// address if it is set.
+	CloseAddress string `protobuf:"bytes,13,opt,name=close_address,proto3" json:"close_address,omitempty"`
+	// *
+	// An optional, unique identifier of 32 random bytes for the funding flow of
+	// the channel. If set, the funding flow can be canceled using the
+	// FundingStateStep call as long as the funding transaction hasn't been
+	// broadcast yet.
+	PendingChanId        []byte   `protobuf:"bytes,14,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3571,7 +4859,7 @@ func (m *OpenChannelRequest) Reset()         { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()    {}
 func (*OpenChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{54}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{68}
 }
 func (m *OpenChannelRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenChannelRequest.Unmarshal(m, b)
@@ -3668,6 +4956,20 @@ func (m *OpenChannelRequest) GetSpendUnconfirmed() bool {
 	return false
 }
 
+func (m *OpenChannelRequest) GetCloseAddress() string {
+	if m != nil {
+		return m.CloseAddress
+	}
+	return ""
+}
+
+func (m *OpenChannelRequest) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
 type OpenStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*OpenStatusUpdate_ChanPending
@@ -3682,7 +4984,7 @@ func (m *OpenStatusUpdate) Reset()         { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()    {}
 func (*OpenStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{55}
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{69}
 }
 func (m *OpenStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_OpenStatusUpdate.Unmarshal(m, b)
@@ -3813,4096 +5115,6497 @@ func _OpenStatusUpdate_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
-type PendingHTLC struct {
-	// / The direction within the channel that the htlc was sent
-	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
-	// / The total value of the htlc
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	// / The final output to be swept back to the user's wallet
-	Outpoint string `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
-	// / The next block height at which we can spend the current stage
-	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
+type ChannelAcceptRequest struct {
+	// / The pubkey of the node that wishes to open an inbound channel.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hash of the genesis block that the proposed channel resides in.
+	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,proto3" json:"chain_hash,omitempty"`
+	// / The pending channel id.
+	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / The funding amount in satoshis that initiator wishes to use in the channel.
+	FundingAmt uint64 `protobuf:"varint,4,opt,name=funding_amt,proto3" json:"funding_amt,omitempty"`
+	// / The push amount of the proposed channel in millisatoshis.
+	PushAmt uint64 `protobuf:"varint,5,opt,name=push_amt,proto3" json:"push_amt,omitempty"`
+	// / The dust limit of the initiator's commitment tx.
+	DustLimit uint64 `protobuf:"varint,6,opt,name=dust_limit,proto3" json:"dust_limit,omitempty"`
+	// / The maximum amount of coins in millisatoshis that can be pending in this channel.
+	MaxValueInFlight uint64 `protobuf:"varint,7,opt,name=max_value_in_flight,proto3" json:"max_value_in_flight,omitempty"`
+	// / The minimum amount of satoshis the initiator requires us to have at all times.
+	ChannelReserve uint64 `protobuf:"varint,8,opt,name=channel_reserve,proto3" json:"channel_reserve,omitempty"`
+	// / The smallest HTLC in millisatoshis that the initiator will accept.
+	MinHtlc uint64 `protobuf:"varint,9,opt,name=min_htlc,proto3" json:"min_htlc,omitempty"`
+	// / The initial fee rate that the initiator suggests for both commitment transactions.
+	FeePerKw uint64 `protobuf:"varint,10,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
 	// *
-	// The number of blocks remaining until the current stage can be swept.
-	// Negative values indicate how many blocks have passed since becoming
-	// mature.
-	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
-	// / Indicates whether the htlc is in its first or second stage of recovery
-	Stage                uint32   `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// The number of blocks to use for the relative time lock in the pay-to-self
+	// output of both commitment transactions.
+	CsvDelay uint32 `protobuf:"varint,11,opt,name=csv_delay,proto3" json:"csv_delay,omitempty"`
+	// / The total number of incoming HTLC's that the initiator will accept.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs,proto3" json:"max_accepted_htlcs,omitempty"`
+	// / A bit-field which the initiator uses to specify proposed channel behavior.
+	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags,proto3" json:"channel_flags,omitempty"`
+	// / The commitment type the initiator wishes to use for the proposed channel.
+	CommitmentType       CommitmentType `protobuf:"varint,14,opt,name=commitment_type,proto3,enum=lnrpc.CommitmentType" json:"commitment_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
-func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
-func (*PendingHTLC) ProtoMessage()    {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{56}
+func (m *ChannelAcceptRequest) Reset()         { *m = ChannelAcceptRequest{} }
+func (m *ChannelAcceptRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelAcceptRequest) ProtoMessage()    {}
+func (*ChannelAcceptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{70}
 }
-func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
+func (m *ChannelAcceptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelAcceptRequest.Unmarshal(m, b)
 }
-func (m *PendingHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingHTLC.Marshal(b, m, deterministic)
+func (m *ChannelAcceptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelAcceptRequest.Marshal(b, m, deterministic)
 }
-func (dst *PendingHTLC) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingHTLC.Merge(dst, src)
+func (dst *ChannelAcceptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelAcceptRequest.Merge(dst, src)
 }
-func (m *PendingHTLC) XXX_Size() int {
-	return xxx_messageInfo_PendingHTLC.Size(m)
+func (m *ChannelAcceptRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelAcceptRequest.Size(m)
 }
-func (m *PendingHTLC) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingHTLC.DiscardUnknown(m)
+func (m *ChannelAcceptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelAcceptRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingHTLC proto.InternalMessageInfo
+var xxx_messageInfo_ChannelAcceptRequest proto.InternalMessageInfo
 
-func (m *PendingHTLC) GetIncoming() bool {
+func (m *ChannelAcceptRequest) GetNodePubkey() []byte {
 	if m != nil {
-		return m.Incoming
+		return m.NodePubkey
 	}
-	return false
+	return nil
 }
 
-func (m *PendingHTLC) GetAmount() int64 {
+func (m *ChannelAcceptRequest) GetChainHash() []byte {
 	if m != nil {
-		return m.Amount
+		return m.ChainHash
 	}
-	return 0
+	return nil
 }
 
-func (m *PendingHTLC) GetOutpoint() string {
+func (m *ChannelAcceptRequest) GetPendingChanId() []byte {
 	if m != nil {
-		return m.Outpoint
+		return m.PendingChanId
 	}
-	return ""
+	return nil
 }
 
-func (m *PendingHTLC) GetMaturityHeight() uint32 {
+func (m *ChannelAcceptRequest) GetFundingAmt() uint64 {
 	if m != nil {
-		return m.MaturityHeight
+		return m.FundingAmt
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
+func (m *ChannelAcceptRequest) GetPushAmt() uint64 {
 	if m != nil {
-		return m.BlocksTilMaturity
+		return m.PushAmt
 	}
 	return 0
 }
 
-func (m *PendingHTLC) GetStage() uint32 {
+func (m *ChannelAcceptRequest) GetDustLimit() uint64 {
 	if m != nil {
-		return m.Stage
+		return m.DustLimit
 	}
 	return 0
 }
 
-type PendingChannelsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ChannelAcceptRequest) GetMaxValueInFlight() uint64 {
+	if m != nil {
+		return m.MaxValueInFlight
+	}
+	return 0
 }
 
-func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{} }
-func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsRequest) ProtoMessage()    {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{57}
+func (m *ChannelAcceptRequest) GetChannelReserve() uint64 {
+	if m != nil {
+		return m.ChannelReserve
+	}
+	return 0
 }
-func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
+
+func (m *ChannelAcceptRequest) GetMinHtlc() uint64 {
+	if m != nil {
+		return m.MinHtlc
+	}
+	return 0
 }
-func (m *PendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsRequest.Marshal(b, m, deterministic)
+
+func (m *ChannelAcceptRequest) GetFeePerKw() uint64 {
+	if m != nil {
+		return m.FeePerKw
+	}
+	return 0
 }
-func (dst *PendingChannelsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsRequest.Merge(dst, src)
+
+func (m *ChannelAcceptRequest) GetCsvDelay() uint32 {
+	if m != nil {
+		return m.CsvDelay
+	}
+	return 0
 }
-func (m *PendingChannelsRequest) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsRequest.Size(m)
+
+func (m *ChannelAcceptRequest) GetMaxAcceptedHtlcs() uint32 {
+	if m != nil {
+		return m.MaxAcceptedHtlcs
+	}
+	return 0
 }
-func (m *PendingChannelsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsRequest.DiscardUnknown(m)
+
+func (m *ChannelAcceptRequest) GetChannelFlags() uint32 {
+	if m != nil {
+		return m.ChannelFlags
+	}
+	return 0
 }
 
-var xxx_messageInfo_PendingChannelsRequest proto.InternalMessageInfo
+func (m *ChannelAcceptRequest) GetCommitmentType() CommitmentType {
+	if m != nil {
+		return m.CommitmentType
+	}
+	return CommitmentType_LEGACY
+}
 
-type PendingChannelsResponse struct {
-	// / The balance in satoshis encumbered in pending channels
-	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance,proto3" json:"total_limbo_balance,omitempty"`
-	// / Channels pending opening
-	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels,proto3" json:"pending_open_channels,omitempty"`
-	// / Channels pending closing
-	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels,proto3" json:"pending_closing_channels,omitempty"`
-	// / Channels pending force closing
-	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
-	// / Channels waiting for closing tx to confirm
-	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+type ChannelAcceptResponse struct {
+	// / Whether or not the client accepts the channel.
+	Accept bool `protobuf:"varint,1,opt,name=accept,proto3" json:"accept,omitempty"`
+	// / The pending channel id to which this response applies.
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// *
+	// An optional error to send the initiating party to indicate why the channel
+	// was rejected. This field should not contain sensitive information, it will
+	// be sent to the initiating party.
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// *
+	// The number of confirmations we require before we consider the channel
+	// open. If zero, the default number of confirmations is required.
+	MinAcceptDepth uint32 `protobuf:"varint,4,opt,name=min_accept_depth,proto3" json:"min_accept_depth,omitempty"`
+	// *
+	// The number of blocks the initiator's funds are locked for in case of a
+	// unilateral close. If zero, the default delay is required.
+	CsvDelay             uint32   `protobuf:"varint,5,opt,name=csv_delay,proto3" json:"csv_delay,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
-func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse) ProtoMessage()    {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{58}
+func (m *ChannelAcceptResponse) Reset()         { *m = ChannelAcceptResponse{} }
+func (m *ChannelAcceptResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelAcceptResponse) ProtoMessage()    {}
+func (*ChannelAcceptResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{71}
 }
-func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
+func (m *ChannelAcceptResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelAcceptResponse.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse.Marshal(b, m, deterministic)
+func (m *ChannelAcceptResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelAcceptResponse.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse.Merge(dst, src)
+func (dst *ChannelAcceptResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelAcceptResponse.Merge(dst, src)
 }
-func (m *PendingChannelsResponse) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse.Size(m)
+func (m *ChannelAcceptResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelAcceptResponse.Size(m)
 }
-func (m *PendingChannelsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse.DiscardUnknown(m)
+func (m *ChannelAcceptResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelAcceptResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ChannelAcceptResponse proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+func (m *ChannelAcceptResponse) GetAccept() bool {
 	if m != nil {
-		return m.TotalLimboBalance
+		return m.Accept
 	}
-	return 0
+	return false
 }
 
-func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+func (m *ChannelAcceptResponse) GetPendingChanId() []byte {
 	if m != nil {
-		return m.PendingOpenChannels
+		return m.PendingChanId
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
+func (m *ChannelAcceptResponse) GetError() string {
 	if m != nil {
-		return m.PendingClosingChannels
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+func (m *ChannelAcceptResponse) GetMinAcceptDepth() uint32 {
 	if m != nil {
-		return m.PendingForceClosingChannels
+		return m.MinAcceptDepth
 	}
-	return nil
+	return 0
 }
 
-func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+func (m *ChannelAcceptResponse) GetCsvDelay() uint32 {
 	if m != nil {
-		return m.WaitingCloseChannels
+		return m.CsvDelay
 	}
-	return nil
+	return 0
 }
 
-type PendingChannelsResponse_PendingChannel struct {
-	RemoteNodePub        string   `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
-	ChannelPoint         string   `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
-	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	LocalBalance         int64    `protobuf:"varint,4,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
-	RemoteBalance        int64    `protobuf:"varint,5,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
+type FundingCancel struct {
+	// / The pending channel ID of the funding flow to cancel.
+	PendingChanId        []byte   `protobuf:"bytes,1,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingChannel) Reset() {
-	*m = PendingChannelsResponse_PendingChannel{}
-}
-func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{58, 0}
-}
-func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
-}
-func (m *PendingChannelsResponse_PendingChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Marshal(b, m, deterministic)
+func (m *FundingCancel) Reset()         { *m = FundingCancel{} }
+func (m *FundingCancel) String() string { return proto.CompactTextString(m) }
+func (*FundingCancel) ProtoMessage()    {}
+func (*FundingCancel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{72}
 }
-func (dst *PendingChannelsResponse_PendingChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_PendingChannel.Merge(dst, src)
-}
-func (m *PendingChannelsResponse_PendingChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Size(m)
+func (m *FundingCancel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingCancel.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_PendingChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_PendingChannel.DiscardUnknown(m)
+func (m *FundingCancel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingCancel.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_PendingChannelsResponse_PendingChannel proto.InternalMessageInfo
-
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
-	if m != nil {
-		return m.RemoteNodePub
-	}
-	return ""
+func (dst *FundingCancel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingCancel.Merge(dst, src)
 }
-
-func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
-	if m != nil {
-		return m.ChannelPoint
-	}
-	return ""
+func (m *FundingCancel) XXX_Size() int {
+	return xxx_messageInfo_FundingCancel.Size(m)
 }
-
-func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
-	}
-	return 0
+func (m *FundingCancel) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingCancel.DiscardUnknown(m)
 }
 
-func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
-	if m != nil {
-		return m.LocalBalance
-	}
-	return 0
-}
+var xxx_messageInfo_FundingCancel proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+func (m *FundingCancel) GetPendingChanId() []byte {
 	if m != nil {
-		return m.RemoteBalance
+		return m.PendingChanId
 	}
-	return 0
+	return nil
 }
 
-type PendingChannelsResponse_PendingOpenChannel struct {
-	// / The pending channel
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The height at which this channel will be confirmed
-	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height,proto3" json:"confirmation_height,omitempty"`
-	// *
-	// The amount calculated to be paid in fees for the current set of
-	// commitment transactions. The fee amount is persisted with the channel
-	// in order to allow the fee amount to be removed and recalculated with
-	// each channel state update, including updates that happen after a system
-	// restart.
-	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
-	// / The weight of the commitment transaction
-	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
-	// *
-	// The required number of satoshis per kilo-weight that the requester will
-	// pay at all times, for both the funding transaction and commitment
-	// transaction. This value can later be updated once the channel is open.
-	FeePerKw             int64    `protobuf:"varint,6,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
+type FundingBump struct {
+	// / The channel point of the pending channel to bump the funding fee of.
+	ChanPoint *ChannelPoint `protobuf:"bytes,1,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	// / The target number of blocks the funding transaction should confirm in.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf,proto3" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte to bump the funding transaction to.
+	SatPerByte           int64    `protobuf:"varint,3,opt,name=sat_per_byte,proto3" json:"sat_per_byte,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
-	*m = PendingChannelsResponse_PendingOpenChannel{}
-}
-func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
-	return proto.CompactTextString(m)
+func (m *FundingBump) Reset()         { *m = FundingBump{} }
+func (m *FundingBump) String() string { return proto.CompactTextString(m) }
+func (*FundingBump) ProtoMessage()    {}
+func (*FundingBump) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{73}
 }
-func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{58, 1}
-}
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
+func (m *FundingBump) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingBump.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Marshal(b, m, deterministic)
+func (m *FundingBump) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingBump.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse_PendingOpenChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Merge(dst, src)
+func (dst *FundingBump) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingBump.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Size(m)
+func (m *FundingBump) XXX_Size() int {
+	return xxx_messageInfo_FundingBump.Size(m)
 }
-func (m *PendingChannelsResponse_PendingOpenChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.DiscardUnknown(m)
+func (m *FundingBump) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingBump.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel proto.InternalMessageInfo
+var xxx_messageInfo_FundingBump proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *FundingBump) GetChanPoint() *ChannelPoint {
 	if m != nil {
-		return m.Channel
+		return m.ChanPoint
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
-	if m != nil {
-		return m.ConfirmationHeight
-	}
-	return 0
-}
-
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
-	if m != nil {
-		return m.CommitFee
-	}
-	return 0
-}
-
-func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
+func (m *FundingBump) GetTargetConf() int32 {
 	if m != nil {
-		return m.CommitWeight
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
+func (m *FundingBump) GetSatPerByte() int64 {
 	if m != nil {
-		return m.FeePerKw
+		return m.SatPerByte
 	}
 	return 0
 }
 
-type PendingChannelsResponse_WaitingCloseChannel struct {
-	// / The pending channel waiting for closing tx to confirm
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The balance in satoshis encumbered in this channel
-	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type FundingTransitionMsg struct {
+	// *
+	// Cancels the funding flow with the given pending channel ID, if its funding
+	// transaction hasn't been broadcast yet. Exactly one of cancel and bump must
+	// be set.
+	Cancel *FundingCancel `protobuf:"bytes,1,opt,name=cancel,proto3" json:"cancel,omitempty"`
+	// *
+	// Bumps the fee of the unconfirmed funding transaction of a pending channel.
+	// Exactly one of cancel and bump must be set.
+	Bump                 *FundingBump `protobuf:"bytes,2,opt,name=bump,proto3" json:"bump,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
-	*m = PendingChannelsResponse_WaitingCloseChannel{}
-}
-func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{58, 2}
+func (m *FundingTransitionMsg) Reset()         { *m = FundingTransitionMsg{} }
+func (m *FundingTransitionMsg) String() string { return proto.CompactTextString(m) }
+func (*FundingTransitionMsg) ProtoMessage()    {}
+func (*FundingTransitionMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{74}
 }
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
+func (m *FundingTransitionMsg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingTransitionMsg.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Marshal(b, m, deterministic)
+func (m *FundingTransitionMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingTransitionMsg.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse_WaitingCloseChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Merge(dst, src)
+func (dst *FundingTransitionMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingTransitionMsg.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Size(m)
+func (m *FundingTransitionMsg) XXX_Size() int {
+	return xxx_messageInfo_FundingTransitionMsg.Size(m)
 }
-func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.DiscardUnknown(m)
+func (m *FundingTransitionMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingTransitionMsg.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel proto.InternalMessageInfo
+var xxx_messageInfo_FundingTransitionMsg proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *FundingTransitionMsg) GetCancel() *FundingCancel {
 	if m != nil {
-		return m.Channel
+		return m.Cancel
 	}
 	return nil
 }
 
-func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
+func (m *FundingTransitionMsg) GetBump() *FundingBump {
 	if m != nil {
-		return m.LimboBalance
+		return m.Bump
 	}
-	return 0
+	return nil
 }
 
-type PendingChannelsResponse_ClosedChannel struct {
-	// / The pending channel to be closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+type FundingStateStepResp struct {
+	// / The txid of the child transaction bumping the funding fee, if any.
+	BumpTxid             string   `protobuf:"bytes,1,opt,name=bump_txid,proto3" json:"bump_txid,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
-func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
-func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
-func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{58, 3}
+func (m *FundingStateStepResp) Reset()         { *m = FundingStateStepResp{} }
+func (m *FundingStateStepResp) String() string { return proto.CompactTextString(m) }
+func (*FundingStateStepResp) ProtoMessage()    {}
+func (*FundingStateStepResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{75}
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
+func (m *FundingStateStepResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingStateStepResp.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Marshal(b, m, deterministic)
+func (m *FundingStateStepResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingStateStepResp.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse_ClosedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Merge(dst, src)
+func (dst *FundingStateStepResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingStateStepResp.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Size(m)
+func (m *FundingStateStepResp) XXX_Size() int {
+	return xxx_messageInfo_FundingStateStepResp.Size(m)
 }
-func (m *PendingChannelsResponse_ClosedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.DiscardUnknown(m)
+func (m *FundingStateStepResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingStateStepResp.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_ClosedChannel proto.InternalMessageInfo
-
-func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
-	if m != nil {
-		return m.Channel
-	}
-	return nil
-}
+var xxx_messageInfo_FundingStateStepResp proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
+func (m *FundingStateStepResp) GetBumpTxid() string {
 	if m != nil {
-		return m.ClosingTxid
+		return m.BumpTxid
 	}
 	return ""
 }
 
-type PendingChannelsResponse_ForceClosedChannel struct {
-	// / The pending channel to be force closed
-	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	// / The transaction id of the closing transaction
-	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
-	// / The balance in satoshis encumbered in this pending channel
-	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
-	// / The height at which funds can be sweeped into the wallet
+type PendingHTLC struct {
+	// / The direction within the channel that the htlc was sent
+	Incoming bool `protobuf:"varint,1,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	// / The total value of the htlc
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// / The final output to be swept back to the user's wallet
+	Outpoint string `protobuf:"bytes,3,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// / The next block height at which we can spend the current stage
 	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
-	//
-	// Remaining # of blocks until the commitment output can be swept.
+	// *
+	// The number of blocks remaining until the current stage can be swept.
 	// Negative values indicate how many blocks have passed since becoming
 	// mature.
 	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
-	// / The total value of funds successfully recovered from this channel
-	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
-	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// / Indicates whether the htlc is in its first or second stage of recovery
+	Stage                uint32   `protobuf:"varint,6,opt,name=stage,proto3" json:"stage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
-	*m = PendingChannelsResponse_ForceClosedChannel{}
-}
-func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
-	return proto.CompactTextString(m)
-}
-func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
-func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{58, 4}
+func (m *PendingHTLC) Reset()         { *m = PendingHTLC{} }
+func (m *PendingHTLC) String() string { return proto.CompactTextString(m) }
+func (*PendingHTLC) ProtoMessage()    {}
+func (*PendingHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{76}
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
+func (m *PendingHTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingHTLC.Unmarshal(m, b)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Marshal(b, m, deterministic)
+func (m *PendingHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingHTLC.Marshal(b, m, deterministic)
 }
-func (dst *PendingChannelsResponse_ForceClosedChannel) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Merge(dst, src)
+func (dst *PendingHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingHTLC.Merge(dst, src)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Size() int {
-	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Size(m)
+func (m *PendingHTLC) XXX_Size() int {
+	return xxx_messageInfo_PendingHTLC.Size(m)
 }
-func (m *PendingChannelsResponse_ForceClosedChannel) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.DiscardUnknown(m)
+func (m *PendingHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingHTLC.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel proto.InternalMessageInfo
+var xxx_messageInfo_PendingHTLC proto.InternalMessageInfo
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+func (m *PendingHTLC) GetIncoming() bool {
 	if m != nil {
-		return m.Channel
+		return m.Incoming
 	}
-	return nil
+	return false
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
+func (m *PendingHTLC) GetAmount() int64 {
 	if m != nil {
-		return m.ClosingTxid
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
+func (m *PendingHTLC) GetOutpoint() string {
 	if m != nil {
-		return m.LimboBalance
+		return m.Outpoint
 	}
-	return 0
+	return ""
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
+func (m *PendingHTLC) GetMaturityHeight() uint32 {
 	if m != nil {
 		return m.MaturityHeight
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
+func (m *PendingHTLC) GetBlocksTilMaturity() int32 {
 	if m != nil {
 		return m.BlocksTilMaturity
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
+func (m *PendingHTLC) GetStage() uint32 {
 	if m != nil {
-		return m.RecoveredBalance
+		return m.Stage
 	}
 	return 0
 }
 
-func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
-	if m != nil {
-		return m.PendingHtlcs
-	}
-	return nil
-}
-
-type ChannelEventSubscription struct {
+type PendingChannelsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
-func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
-func (*ChannelEventSubscription) ProtoMessage()    {}
-func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{59}
+func (m *PendingChannelsRequest) Reset()         { *m = PendingChannelsRequest{} }
+func (m *PendingChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsRequest) ProtoMessage()    {}
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{77}
 }
-func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
+func (m *PendingChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsRequest.Unmarshal(m, b)
 }
-func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
+func (m *PendingChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ChannelEventSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEventSubscription.Merge(dst, src)
+func (dst *PendingChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsRequest.Merge(dst, src)
 }
-func (m *ChannelEventSubscription) XXX_Size() int {
-	return xxx_messageInfo_ChannelEventSubscription.Size(m)
+func (m *PendingChannelsRequest) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsRequest.Size(m)
 }
-func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
+func (m *PendingChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsRequest proto.InternalMessageInfo
 
-type ChannelEventUpdate struct {
-	// Types that are valid to be assigned to Channel:
-	//	*ChannelEventUpdate_OpenChannel
-	//	*ChannelEventUpdate_ClosedChannel
-	//	*ChannelEventUpdate_ActiveChannel
-	//	*ChannelEventUpdate_InactiveChannel
-	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
-	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+type PendingChannelsResponse struct {
+	// / The balance in satoshis encumbered in pending channels
+	TotalLimboBalance int64 `protobuf:"varint,1,opt,name=total_limbo_balance,proto3" json:"total_limbo_balance,omitempty"`
+	// / Channels pending opening
+	PendingOpenChannels []*PendingChannelsResponse_PendingOpenChannel `protobuf:"bytes,2,rep,name=pending_open_channels,proto3" json:"pending_open_channels,omitempty"`
+	// / Channels pending closing
+	PendingClosingChannels []*PendingChannelsResponse_ClosedChannel `protobuf:"bytes,3,rep,name=pending_closing_channels,proto3" json:"pending_closing_channels,omitempty"`
+	// / Channels pending force closing
+	PendingForceClosingChannels []*PendingChannelsResponse_ForceClosedChannel `protobuf:"bytes,4,rep,name=pending_force_closing_channels,proto3" json:"pending_force_closing_channels,omitempty"`
+	// / Channels waiting for closing tx to confirm
+	WaitingCloseChannels []*PendingChannelsResponse_WaitingCloseChannel `protobuf:"bytes,5,rep,name=waiting_close_channels,proto3" json:"waiting_close_channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
+	XXX_unrecognized     []byte                                         `json:"-"`
+	XXX_sizecache        int32                                          `json:"-"`
 }
 
-func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
-func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelEventUpdate) ProtoMessage()    {}
-func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{60}
-}
-func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
-}
-func (m *ChannelEventUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEventUpdate.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse) Reset()         { *m = PendingChannelsResponse{} }
+func (m *PendingChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse) ProtoMessage()    {}
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{78}
 }
-func (dst *ChannelEventUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEventUpdate.Merge(dst, src)
+func (m *PendingChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse.Unmarshal(m, b)
 }
-func (m *ChannelEventUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelEventUpdate.Size(m)
+func (m *PendingChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse.Marshal(b, m, deterministic)
 }
-func (m *ChannelEventUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEventUpdate.DiscardUnknown(m)
+func (dst *PendingChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse.Merge(dst, src)
 }
-
-var xxx_messageInfo_ChannelEventUpdate proto.InternalMessageInfo
-
-type isChannelEventUpdate_Channel interface {
-	isChannelEventUpdate_Channel()
+func (m *PendingChannelsResponse) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse.Size(m)
 }
-
-type ChannelEventUpdate_OpenChannel struct {
-	OpenChannel *Channel `protobuf:"bytes,1,opt,name=open_channel,proto3,oneof"`
+func (m *PendingChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse.DiscardUnknown(m)
 }
 
-type ChannelEventUpdate_ClosedChannel struct {
-	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,2,opt,name=closed_channel,proto3,oneof"`
-}
+var xxx_messageInfo_PendingChannelsResponse proto.InternalMessageInfo
 
-type ChannelEventUpdate_ActiveChannel struct {
-	ActiveChannel *ChannelPoint `protobuf:"bytes,3,opt,name=active_channel,proto3,oneof"`
+func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
+	if m != nil {
+		return m.TotalLimboBalance
+	}
+	return 0
 }
 
-type ChannelEventUpdate_InactiveChannel struct {
-	InactiveChannel *ChannelPoint `protobuf:"bytes,4,opt,name=inactive_channel,proto3,oneof"`
+func (m *PendingChannelsResponse) GetPendingOpenChannels() []*PendingChannelsResponse_PendingOpenChannel {
+	if m != nil {
+		return m.PendingOpenChannels
+	}
+	return nil
 }
 
-func (*ChannelEventUpdate_OpenChannel) isChannelEventUpdate_Channel() {}
-
-func (*ChannelEventUpdate_ClosedChannel) isChannelEventUpdate_Channel() {}
-
-func (*ChannelEventUpdate_ActiveChannel) isChannelEventUpdate_Channel() {}
-
-func (*ChannelEventUpdate_InactiveChannel) isChannelEventUpdate_Channel() {}
-
-func (m *ChannelEventUpdate) GetChannel() isChannelEventUpdate_Channel {
+func (m *PendingChannelsResponse) GetPendingClosingChannels() []*PendingChannelsResponse_ClosedChannel {
 	if m != nil {
-		return m.Channel
+		return m.PendingClosingChannels
 	}
 	return nil
 }
 
-func (m *ChannelEventUpdate) GetOpenChannel() *Channel {
-	if x, ok := m.GetChannel().(*ChannelEventUpdate_OpenChannel); ok {
-		return x.OpenChannel
+func (m *PendingChannelsResponse) GetPendingForceClosingChannels() []*PendingChannelsResponse_ForceClosedChannel {
+	if m != nil {
+		return m.PendingForceClosingChannels
 	}
 	return nil
 }
 
-func (m *ChannelEventUpdate) GetClosedChannel() *ChannelCloseSummary {
-	if x, ok := m.GetChannel().(*ChannelEventUpdate_ClosedChannel); ok {
-		return x.ClosedChannel
+func (m *PendingChannelsResponse) GetWaitingCloseChannels() []*PendingChannelsResponse_WaitingCloseChannel {
+	if m != nil {
+		return m.WaitingCloseChannels
 	}
 	return nil
 }
 
-func (m *ChannelEventUpdate) GetActiveChannel() *ChannelPoint {
-	if x, ok := m.GetChannel().(*ChannelEventUpdate_ActiveChannel); ok {
-		return x.ActiveChannel
+type PendingChannelsResponse_PendingChannel struct {
+	RemoteNodePub        string   `protobuf:"bytes,1,opt,name=remote_node_pub,proto3" json:"remote_node_pub,omitempty"`
+	ChannelPoint         string   `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	LocalBalance         int64    `protobuf:"varint,4,opt,name=local_balance,proto3" json:"local_balance,omitempty"`
+	RemoteBalance        int64    `protobuf:"varint,5,opt,name=remote_balance,proto3" json:"remote_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PendingChannelsResponse_PendingChannel) Reset() {
+	*m = PendingChannelsResponse_PendingChannel{}
+}
+func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{78, 0}
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Unmarshal(m, b)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Marshal(b, m, deterministic)
+}
+func (dst *PendingChannelsResponse_PendingChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_PendingChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_PendingChannel.Size(m)
+}
+func (m *PendingChannelsResponse_PendingChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_PendingChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChannelsResponse_PendingChannel proto.InternalMessageInfo
+
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
+	if m != nil {
+		return m.RemoteNodePub
 	}
-	return nil
+	return ""
 }
 
-func (m *ChannelEventUpdate) GetInactiveChannel() *ChannelPoint {
-	if x, ok := m.GetChannel().(*ChannelEventUpdate_InactiveChannel); ok {
-		return x.InactiveChannel
+func (m *PendingChannelsResponse_PendingChannel) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
 	}
-	return nil
+	return ""
 }
 
-func (m *ChannelEventUpdate) GetType() ChannelEventUpdate_UpdateType {
+func (m *PendingChannelsResponse_PendingChannel) GetCapacity() int64 {
 	if m != nil {
-		return m.Type
+		return m.Capacity
 	}
-	return ChannelEventUpdate_OPEN_CHANNEL
+	return 0
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*ChannelEventUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _ChannelEventUpdate_OneofMarshaler, _ChannelEventUpdate_OneofUnmarshaler, _ChannelEventUpdate_OneofSizer, []interface{}{
-		(*ChannelEventUpdate_OpenChannel)(nil),
-		(*ChannelEventUpdate_ClosedChannel)(nil),
-		(*ChannelEventUpdate_ActiveChannel)(nil),
-		(*ChannelEventUpdate_InactiveChannel)(nil),
+func (m *PendingChannelsResponse_PendingChannel) GetLocalBalance() int64 {
+	if m != nil {
+		return m.LocalBalance
 	}
+	return 0
 }
 
-func _ChannelEventUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*ChannelEventUpdate)
-	// channel
-	switch x := m.Channel.(type) {
-	case *ChannelEventUpdate_OpenChannel:
-		b.EncodeVarint(1<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.OpenChannel); err != nil {
-			return err
-		}
-	case *ChannelEventUpdate_ClosedChannel:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ClosedChannel); err != nil {
-			return err
-		}
-	case *ChannelEventUpdate_ActiveChannel:
-		b.EncodeVarint(3<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ActiveChannel); err != nil {
-			return err
-		}
-	case *ChannelEventUpdate_InactiveChannel:
-		b.EncodeVarint(4<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.InactiveChannel); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("ChannelEventUpdate.Channel has unexpected type %T", x)
+func (m *PendingChannelsResponse_PendingChannel) GetRemoteBalance() int64 {
+	if m != nil {
+		return m.RemoteBalance
 	}
-	return nil
+	return 0
 }
 
-func _ChannelEventUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*ChannelEventUpdate)
-	switch tag {
-	case 1: // channel.open_channel
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(Channel)
-		err := b.DecodeMessage(msg)
-		m.Channel = &ChannelEventUpdate_OpenChannel{msg}
-		return true, err
-	case 2: // channel.closed_channel
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelCloseSummary)
-		err := b.DecodeMessage(msg)
-		m.Channel = &ChannelEventUpdate_ClosedChannel{msg}
-		return true, err
-	case 3: // channel.active_channel
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelPoint)
-		err := b.DecodeMessage(msg)
-		m.Channel = &ChannelEventUpdate_ActiveChannel{msg}
-		return true, err
-	case 4: // channel.inactive_channel
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelPoint)
-		err := b.DecodeMessage(msg)
-		m.Channel = &ChannelEventUpdate_InactiveChannel{msg}
-		return true, err
-	default:
-		return false, nil
-	}
-}
-
-func _ChannelEventUpdate_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*ChannelEventUpdate)
-	// channel
-	switch x := m.Channel.(type) {
-	case *ChannelEventUpdate_OpenChannel:
-		s := proto.Size(x.OpenChannel)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ChannelEventUpdate_ClosedChannel:
-		s := proto.Size(x.ClosedChannel)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ChannelEventUpdate_ActiveChannel:
-		s := proto.Size(x.ActiveChannel)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case *ChannelEventUpdate_InactiveChannel:
-		s := proto.Size(x.InactiveChannel)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
-	}
-	return n
-}
-
-type WalletBalanceRequest struct {
+type PendingChannelsResponse_PendingOpenChannel struct {
+	// / The pending channel
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// *
+	// The earliest possible height at which this channel can be confirmed,
+	// assuming the funding transaction confirms in the block following its
+	// broadcast. Zero if the broadcast height is unknown.
+	ConfirmationHeight uint32 `protobuf:"varint,2,opt,name=confirmation_height,proto3" json:"confirmation_height,omitempty"`
+	// *
+	// The amount calculated to be paid in fees for the current set of
+	// commitment transactions. The fee amount is persisted with the channel
+	// in order to allow the fee amount to be removed and recalculated with
+	// each channel state update, including updates that happen after a system
+	// restart.
+	CommitFee int64 `protobuf:"varint,4,opt,name=commit_fee,proto3" json:"commit_fee,omitempty"`
+	// / The weight of the commitment transaction
+	CommitWeight int64 `protobuf:"varint,5,opt,name=commit_weight,proto3" json:"commit_weight,omitempty"`
+	// *
+	// The required number of satoshis per kilo-weight that the requester will
+	// pay at all times, for both the funding transaction and commitment
+	// transaction. This value can later be updated once the channel is open.
+	FeePerKw             int64    `protobuf:"varint,6,opt,name=fee_per_kw,proto3" json:"fee_per_kw,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
-func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*WalletBalanceRequest) ProtoMessage()    {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{61}
+func (m *PendingChannelsResponse_PendingOpenChannel) Reset() {
+	*m = PendingChannelsResponse_PendingOpenChannel{}
 }
-func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
+func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
+	return proto.CompactTextString(m)
 }
-func (m *WalletBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletBalanceRequest.Marshal(b, m, deterministic)
+func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{78, 1}
 }
-func (dst *WalletBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletBalanceRequest.Merge(dst, src)
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Unmarshal(m, b)
 }
-func (m *WalletBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_WalletBalanceRequest.Size(m)
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Marshal(b, m, deterministic)
 }
-func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletBalanceRequest.DiscardUnknown(m)
+func (dst *PendingChannelsResponse_PendingOpenChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Merge(dst, src)
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.Size(m)
+}
+func (m *PendingChannelsResponse_PendingOpenChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse_PendingOpenChannel proto.InternalMessageInfo
 
-type WalletBalanceResponse struct {
-	// / The balance of the wallet
-	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
-	// / The confirmed balance of a wallet(with >= 1 confirmations)
-	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
-	// / The unconfirmed balance of a wallet(with 0 confirmations)
-	UnconfirmedBalance   int64    `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
 }
 
-func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
-func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*WalletBalanceResponse) ProtoMessage()    {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{62}
-}
-func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
-}
-func (m *WalletBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_WalletBalanceResponse.Marshal(b, m, deterministic)
-}
-func (dst *WalletBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WalletBalanceResponse.Merge(dst, src)
-}
-func (m *WalletBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_WalletBalanceResponse.Size(m)
-}
-func (m *WalletBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_WalletBalanceResponse.DiscardUnknown(m)
+func (m *PendingChannelsResponse_PendingOpenChannel) GetConfirmationHeight() uint32 {
+	if m != nil {
+		return m.ConfirmationHeight
+	}
+	return 0
 }
 
-var xxx_messageInfo_WalletBalanceResponse proto.InternalMessageInfo
-
-func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitFee() int64 {
 	if m != nil {
-		return m.TotalBalance
+		return m.CommitFee
 	}
 	return 0
 }
 
-func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetCommitWeight() int64 {
 	if m != nil {
-		return m.ConfirmedBalance
+		return m.CommitWeight
 	}
 	return 0
 }
 
-func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
+func (m *PendingChannelsResponse_PendingOpenChannel) GetFeePerKw() int64 {
 	if m != nil {
-		return m.UnconfirmedBalance
+		return m.FeePerKw
 	}
 	return 0
 }
 
-type ChannelBalanceRequest struct {
+type PendingChannelsResponse_WaitingCloseChannel struct {
+	// / The pending channel waiting for closing tx to confirm
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The balance in satoshis encumbered in this channel
+	LimboBalance         int64    `protobuf:"varint,2,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
-func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*ChannelBalanceRequest) ProtoMessage()    {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{63}
-}
-func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
-}
-func (m *ChannelBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBalanceRequest.Marshal(b, m, deterministic)
-}
-func (dst *ChannelBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBalanceRequest.Merge(dst, src)
-}
-func (m *ChannelBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_ChannelBalanceRequest.Size(m)
-}
-func (m *ChannelBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBalanceRequest.DiscardUnknown(m)
+func (m *PendingChannelsResponse_WaitingCloseChannel) Reset() {
+	*m = PendingChannelsResponse_WaitingCloseChannel{}
 }
-
-var xxx_messageInfo_ChannelBalanceRequest proto.InternalMessageInfo
-
-type ChannelBalanceResponse struct {
-	// / Sum of channels balances denominated in satoshis
-	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
-	// / Sum of channels pending balances denominated in satoshis
-	PendingOpenBalance   int64    `protobuf:"varint,2,opt,name=pending_open_balance,proto3" json:"pending_open_balance,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{} }
-func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*ChannelBalanceResponse) ProtoMessage()    {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{64}
+func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{78, 2}
 }
-func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Unmarshal(m, b)
 }
-func (m *ChannelBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelBalanceResponse.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Marshal(b, m, deterministic)
 }
-func (dst *ChannelBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelBalanceResponse.Merge(dst, src)
+func (dst *PendingChannelsResponse_WaitingCloseChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Merge(dst, src)
 }
-func (m *ChannelBalanceResponse) XXX_Size() int {
-	return xxx_messageInfo_ChannelBalanceResponse.Size(m)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.Size(m)
 }
-func (m *ChannelBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelBalanceResponse.DiscardUnknown(m)
+func (m *PendingChannelsResponse_WaitingCloseChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse_WaitingCloseChannel proto.InternalMessageInfo
 
-func (m *ChannelBalanceResponse) GetBalance() int64 {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.Balance
+		return m.Channel
 	}
-	return 0
+	return nil
 }
 
-func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
+func (m *PendingChannelsResponse_WaitingCloseChannel) GetLimboBalance() int64 {
 	if m != nil {
-		return m.PendingOpenBalance
+		return m.LimboBalance
 	}
 	return 0
 }
 
-type QueryRoutesRequest struct {
-	// / The 33-byte hex-encoded public key for the payment destination
-	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	// / The amount to send expressed in satoshis
-	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
-	// / The max number of routes to return.
-	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes,proto3" json:"num_routes,omitempty"`
-	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
-	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
-	// *
-	// The maximum number of satoshis that will be paid as a fee of the payment.
-	// This value can be represented either as a percentage of the amount being
-	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
-	// send the payment.
-	FeeLimit             *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type PendingChannelsResponse_ClosedChannel struct {
+	// / The pending channel to be closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid          string   `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
-func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRoutesRequest) ProtoMessage()    {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{65}
+func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingChannelsResponse_ClosedChannel{} }
+func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
+func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
+func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{78, 3}
 }
-func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Unmarshal(m, b)
 }
-func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Marshal(b, m, deterministic)
 }
-func (dst *QueryRoutesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRoutesRequest.Merge(dst, src)
+func (dst *PendingChannelsResponse_ClosedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Merge(dst, src)
 }
-func (m *QueryRoutesRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryRoutesRequest.Size(m)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_ClosedChannel.Size(m)
 }
-func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
+func (m *PendingChannelsResponse_ClosedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_ClosedChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
-
-func (m *QueryRoutesRequest) GetPubKey() string {
-	if m != nil {
-		return m.PubKey
-	}
-	return ""
-}
+var xxx_messageInfo_PendingChannelsResponse_ClosedChannel proto.InternalMessageInfo
 
-func (m *QueryRoutesRequest) GetAmt() int64 {
+func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.Amt
+		return m.Channel
 	}
-	return 0
+	return nil
 }
 
-func (m *QueryRoutesRequest) GetNumRoutes() int32 {
+func (m *PendingChannelsResponse_ClosedChannel) GetClosingTxid() string {
 	if m != nil {
-		return m.NumRoutes
+		return m.ClosingTxid
 	}
-	return 0
+	return ""
 }
 
-func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
-	if m != nil {
-		return m.FinalCltvDelta
-	}
-	return 0
+type PendingChannelsResponse_ForceClosedChannel struct {
+	// / The pending channel to be force closed
+	Channel *PendingChannelsResponse_PendingChannel `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// / The transaction id of the closing transaction
+	ClosingTxid string `protobuf:"bytes,2,opt,name=closing_txid,proto3" json:"closing_txid,omitempty"`
+	// / The balance in satoshis encumbered in this pending channel
+	LimboBalance int64 `protobuf:"varint,3,opt,name=limbo_balance,proto3" json:"limbo_balance,omitempty"`
+	// / The height at which funds can be sweeped into the wallet
+	MaturityHeight uint32 `protobuf:"varint,4,opt,name=maturity_height,proto3" json:"maturity_height,omitempty"`
+	//
+	// Remaining # of blocks until the commitment output can be swept.
+	// Negative values indicate how many blocks have passed since becoming
+	// mature.
+	BlocksTilMaturity int32 `protobuf:"varint,5,opt,name=blocks_til_maturity,proto3" json:"blocks_til_maturity,omitempty"`
+	// / The total value of funds successfully recovered from this channel
+	RecoveredBalance     int64          `protobuf:"varint,6,opt,name=recovered_balance,proto3" json:"recovered_balance,omitempty"`
+	PendingHtlcs         []*PendingHTLC `protobuf:"bytes,8,rep,name=pending_htlcs,proto3" json:"pending_htlcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
-	if m != nil {
-		return m.FeeLimit
-	}
-	return nil
+func (m *PendingChannelsResponse_ForceClosedChannel) Reset() {
+	*m = PendingChannelsResponse_ForceClosedChannel{}
 }
-
-type QueryRoutesResponse struct {
-	Routes               []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
-func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRoutesResponse) ProtoMessage()    {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{66}
+func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
+func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{78, 4}
 }
-func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Unmarshal(m, b)
 }
-func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Marshal(b, m, deterministic)
 }
-func (dst *QueryRoutesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRoutesResponse.Merge(dst, src)
+func (dst *PendingChannelsResponse_ForceClosedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Merge(dst, src)
 }
-func (m *QueryRoutesResponse) XXX_Size() int {
-	return xxx_messageInfo_QueryRoutesResponse.Size(m)
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_Size() int {
+	return xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.Size(m)
 }
-func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
+func (m *PendingChannelsResponse_ForceClosedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
+var xxx_messageInfo_PendingChannelsResponse_ForceClosedChannel proto.InternalMessageInfo
 
-func (m *QueryRoutesResponse) GetRoutes() []*Route {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
 	if m != nil {
-		return m.Routes
+		return m.Channel
 	}
 	return nil
 }
 
-type Hop struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity,proto3" json:"chan_capacity,omitempty"`
-	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward,proto3" json:"amt_to_forward,omitempty"` // Deprecated: Do not use.
-	Fee              int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                       // Deprecated: Do not use.
-	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat,proto3" json:"amt_to_forward_msat,omitempty"`
-	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
-	// *
-	// An optional public key of the hop. If the public key is given, the payment
-	// can be executed without relying on a copy of the channel graph.
-	PubKey               string   `protobuf:"bytes,8,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *Hop) Reset()         { *m = Hop{} }
-func (m *Hop) String() string { return proto.CompactTextString(m) }
-func (*Hop) ProtoMessage()    {}
-func (*Hop) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{67}
-}
-func (m *Hop) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Hop.Unmarshal(m, b)
-}
-func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
-}
-func (dst *Hop) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Hop.Merge(dst, src)
-}
-func (m *Hop) XXX_Size() int {
-	return xxx_messageInfo_Hop.Size(m)
-}
-func (m *Hop) XXX_DiscardUnknown() {
-	xxx_messageInfo_Hop.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Hop proto.InternalMessageInfo
-
-func (m *Hop) GetChanId() uint64 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetClosingTxid() string {
 	if m != nil {
-		return m.ChanId
+		return m.ClosingTxid
 	}
-	return 0
+	return ""
 }
 
-func (m *Hop) GetChanCapacity() int64 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetLimboBalance() int64 {
 	if m != nil {
-		return m.ChanCapacity
+		return m.LimboBalance
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Hop) GetAmtToForward() int64 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetMaturityHeight() uint32 {
 	if m != nil {
-		return m.AmtToForward
+		return m.MaturityHeight
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Hop) GetFee() int64 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetBlocksTilMaturity() int32 {
 	if m != nil {
-		return m.Fee
+		return m.BlocksTilMaturity
 	}
 	return 0
 }
 
-func (m *Hop) GetExpiry() uint32 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetRecoveredBalance() int64 {
 	if m != nil {
-		return m.Expiry
+		return m.RecoveredBalance
 	}
 	return 0
 }
 
-func (m *Hop) GetAmtToForwardMsat() int64 {
+func (m *PendingChannelsResponse_ForceClosedChannel) GetPendingHtlcs() []*PendingHTLC {
 	if m != nil {
-		return m.AmtToForwardMsat
+		return m.PendingHtlcs
 	}
-	return 0
+	return nil
 }
 
-func (m *Hop) GetFeeMsat() int64 {
-	if m != nil {
-		return m.FeeMsat
-	}
-	return 0
+type ChannelEventSubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Hop) GetPubKey() string {
-	if m != nil {
-		return m.PubKey
-	}
-	return ""
+func (m *ChannelEventSubscription) Reset()         { *m = ChannelEventSubscription{} }
+func (m *ChannelEventSubscription) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventSubscription) ProtoMessage()    {}
+func (*ChannelEventSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{79}
+}
+func (m *ChannelEventSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventSubscription.Unmarshal(m, b)
+}
+func (m *ChannelEventSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventSubscription.Marshal(b, m, deterministic)
+}
+func (dst *ChannelEventSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventSubscription.Merge(dst, src)
+}
+func (m *ChannelEventSubscription) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventSubscription.Size(m)
+}
+func (m *ChannelEventSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventSubscription.DiscardUnknown(m)
 }
 
-// *
-// A path through the channel graph which runs over one or more channels in
-// succession. This struct carries all the information required to craft the
-// Sphinx onion packet, and send the payment along the first hop in the path. A
-// route is only selected as valid if all the channels have sufficient capacity to
+var xxx_messageInfo_ChannelEventSubscription proto.InternalMessageInfo
+
+type ChannelEventUpdate struct {
+	// Types that are valid to be assigned to Channel:
+	//	*ChannelEventUpdate_OpenChannel
+	//	*ChannelEventUpdate_ClosedChannel
+	//	*ChannelEventUpdate_ActiveChannel
+	//	*ChannelEventUpdate_InactiveChannel
+	//	*ChannelEventUpdate_RemoteCloseChannel
+	//	*ChannelEventUpdate_PendingOpenChannel
+	//	*ChannelEventUpdate_PendingCloseChannel
+	Channel              isChannelEventUpdate_Channel  `protobuf_oneof:"channel"`
+	Type                 ChannelEventUpdate_UpdateType `protobuf:"varint,5,opt,name=type,proto3,enum=lnrpc.ChannelEventUpdate_UpdateType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ChannelEventUpdate) Reset()         { *m = ChannelEventUpdate{} }
+func (m *ChannelEventUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelEventUpdate) ProtoMessage()    {}
+func (*ChannelEventUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{80}
+}
+func (m *ChannelEventUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEventUpdate.Unmarshal(m, b)
+}
+func (m *ChannelEventUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEventUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ChannelEventUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEventUpdate.Merge(dst, src)
+}
+func (m *ChannelEventUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelEventUpdate.Size(m)
+}
+func (m *ChannelEventUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEventUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEventUpdate proto.InternalMessageInfo
+
+type isChannelEventUpdate_Channel interface {
+	isChannelEventUpdate_Channel()
+}
+
+type ChannelEventUpdate_OpenChannel struct {
+	OpenChannel *Channel `protobuf:"bytes,1,opt,name=open_channel,proto3,oneof"`
+}
+
+type ChannelEventUpdate_ClosedChannel struct {
+	ClosedChannel *ChannelCloseSummary `protobuf:"bytes,2,opt,name=closed_channel,proto3,oneof"`
+}
+
+type ChannelEventUpdate_ActiveChannel struct {
+	ActiveChannel *ChannelPoint `protobuf:"bytes,3,opt,name=active_channel,proto3,oneof"`
+}
+
+type ChannelEventUpdate_InactiveChannel struct {
+	InactiveChannel *ChannelPoint `protobuf:"bytes,4,opt,name=inactive_channel,proto3,oneof"`
+}
+
+type ChannelEventUpdate_RemoteCloseChannel struct {
+	RemoteCloseChannel *ChannelCloseSummary `protobuf:"bytes,6,opt,name=remote_close_channel,proto3,oneof"`
+}
+
+type ChannelEventUpdate_PendingOpenChannel struct {
+	PendingOpenChannel *PendingUpdate `protobuf:"bytes,7,opt,name=pending_open_channel,proto3,oneof"`
+}
+
+type ChannelEventUpdate_PendingCloseChannel struct {
+	PendingCloseChannel *ChannelCloseSummary `protobuf:"bytes,8,opt,name=pending_close_channel,proto3,oneof"`
+}
+
+func (*ChannelEventUpdate_OpenChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_ClosedChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_ActiveChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_InactiveChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_RemoteCloseChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_PendingOpenChannel) isChannelEventUpdate_Channel() {}
+
+func (*ChannelEventUpdate_PendingCloseChannel) isChannelEventUpdate_Channel() {}
+
+func (m *ChannelEventUpdate) GetChannel() isChannelEventUpdate_Channel {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetOpenChannel() *Channel {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_OpenChannel); ok {
+		return x.OpenChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetClosedChannel() *ChannelCloseSummary {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ClosedChannel); ok {
+		return x.ClosedChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetActiveChannel() *ChannelPoint {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_ActiveChannel); ok {
+		return x.ActiveChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetInactiveChannel() *ChannelPoint {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_InactiveChannel); ok {
+		return x.InactiveChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetRemoteCloseChannel() *ChannelCloseSummary {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_RemoteCloseChannel); ok {
+		return x.RemoteCloseChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetPendingOpenChannel() *PendingUpdate {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_PendingOpenChannel); ok {
+		return x.PendingOpenChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetPendingCloseChannel() *ChannelCloseSummary {
+	if x, ok := m.GetChannel().(*ChannelEventUpdate_PendingCloseChannel); ok {
+		return x.PendingCloseChannel
+	}
+	return nil
+}
+
+func (m *ChannelEventUpdate) GetType() ChannelEventUpdate_UpdateType {
+	if m != nil {
+		return m.Type
+	}
+	return ChannelEventUpdate_OPEN_CHANNEL
+}
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*ChannelEventUpdate) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _ChannelEventUpdate_OneofMarshaler, _ChannelEventUpdate_OneofUnmarshaler, _ChannelEventUpdate_OneofSizer, []interface{}{
+		(*ChannelEventUpdate_OpenChannel)(nil),
+		(*ChannelEventUpdate_ClosedChannel)(nil),
+		(*ChannelEventUpdate_ActiveChannel)(nil),
+		(*ChannelEventUpdate_InactiveChannel)(nil),
+		(*ChannelEventUpdate_RemoteCloseChannel)(nil),
+		(*ChannelEventUpdate_PendingOpenChannel)(nil),
+		(*ChannelEventUpdate_PendingCloseChannel)(nil),
+	}
+}
+
+func _ChannelEventUpdate_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*ChannelEventUpdate)
+	// channel
+	switch x := m.Channel.(type) {
+	case *ChannelEventUpdate_OpenChannel:
+		b.EncodeVarint(1<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.OpenChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_ClosedChannel:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ClosedChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_ActiveChannel:
+		b.EncodeVarint(3<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ActiveChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_InactiveChannel:
+		b.EncodeVarint(4<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.InactiveChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_RemoteCloseChannel:
+		b.EncodeVarint(6<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.RemoteCloseChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_PendingOpenChannel:
+		b.EncodeVarint(7<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PendingOpenChannel); err != nil {
+			return err
+		}
+	case *ChannelEventUpdate_PendingCloseChannel:
+		b.EncodeVarint(8<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.PendingCloseChannel); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("ChannelEventUpdate.Channel has unexpected type %T", x)
+	}
+	return nil
+}
+
+func _ChannelEventUpdate_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*ChannelEventUpdate)
+	switch tag {
+	case 1: // channel.open_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(Channel)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_OpenChannel{msg}
+		return true, err
+	case 2: // channel.closed_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseSummary)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_ClosedChannel{msg}
+		return true, err
+	case 3: // channel.active_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_ActiveChannel{msg}
+		return true, err
+	case 4: // channel.inactive_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_InactiveChannel{msg}
+		return true, err
+	case 6: // channel.remote_close_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseSummary)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_RemoteCloseChannel{msg}
+		return true, err
+	case 7: // channel.pending_open_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(PendingUpdate)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_PendingOpenChannel{msg}
+		return true, err
+	case 8: // channel.pending_close_channel
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelCloseSummary)
+		err := b.DecodeMessage(msg)
+		m.Channel = &ChannelEventUpdate_PendingCloseChannel{msg}
+		return true, err
+	default:
+		return false, nil
+	}
+}
+
+func _ChannelEventUpdate_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*ChannelEventUpdate)
+	// channel
+	switch x := m.Channel.(type) {
+	case *ChannelEventUpdate_OpenChannel:
+		s := proto.Size(x.OpenChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_ClosedChannel:
+		s := proto.Size(x.ClosedChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_ActiveChannel:
+		s := proto.Size(x.ActiveChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_InactiveChannel:
+		s := proto.Size(x.InactiveChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_RemoteCloseChannel:
+		s := proto.Size(x.RemoteCloseChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_PendingOpenChannel:
+		s := proto.Size(x.PendingOpenChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case *ChannelEventUpdate_PendingCloseChannel:
+		s := proto.Size(x.PendingCloseChannel)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+	}
+	return n
+}
+
+type WalletBalanceRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletBalanceRequest) Reset()         { *m = WalletBalanceRequest{} }
+func (m *WalletBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*WalletBalanceRequest) ProtoMessage()    {}
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{81}
+}
+func (m *WalletBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalanceRequest.Unmarshal(m, b)
+}
+func (m *WalletBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *WalletBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalanceRequest.Merge(dst, src)
+}
+func (m *WalletBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_WalletBalanceRequest.Size(m)
+}
+func (m *WalletBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletBalanceRequest proto.InternalMessageInfo
+
+type WalletBalanceResponse struct {
+	// / The balance of the wallet
+	TotalBalance int64 `protobuf:"varint,1,opt,name=total_balance,proto3" json:"total_balance,omitempty"`
+	// / The confirmed balance of a wallet(with >= 1 confirmations)
+	ConfirmedBalance int64 `protobuf:"varint,2,opt,name=confirmed_balance,proto3" json:"confirmed_balance,omitempty"`
+	// / The unconfirmed balance of a wallet(with 0 confirmations)
+	UnconfirmedBalance int64 `protobuf:"varint,3,opt,name=unconfirmed_balance,proto3" json:"unconfirmed_balance,omitempty"`
+	// / The balance of outputs locked for pending funding flows or leased
+	LockedBalance        int64    `protobuf:"varint,4,opt,name=locked_balance,proto3" json:"locked_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WalletBalanceResponse) Reset()         { *m = WalletBalanceResponse{} }
+func (m *WalletBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*WalletBalanceResponse) ProtoMessage()    {}
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{82}
+}
+func (m *WalletBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WalletBalanceResponse.Unmarshal(m, b)
+}
+func (m *WalletBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WalletBalanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *WalletBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WalletBalanceResponse.Merge(dst, src)
+}
+func (m *WalletBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_WalletBalanceResponse.Size(m)
+}
+func (m *WalletBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WalletBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WalletBalanceResponse proto.InternalMessageInfo
+
+func (m *WalletBalanceResponse) GetTotalBalance() int64 {
+	if m != nil {
+		return m.TotalBalance
+	}
+	return 0
+}
+
+func (m *WalletBalanceResponse) GetConfirmedBalance() int64 {
+	if m != nil {
+		return m.ConfirmedBalance
+	}
+	return 0
+}
+
+func (m *WalletBalanceResponse) GetUnconfirmedBalance() int64 {
+	if m != nil {
+		return m.UnconfirmedBalance
+	}
+	return 0
+}
+
+func (m *WalletBalanceResponse) GetLockedBalance() int64 {
+	if m != nil {
+		return m.LockedBalance
+	}
+	return 0
+}
+
+type ChannelBalanceRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBalanceRequest) Reset()         { *m = ChannelBalanceRequest{} }
+func (m *ChannelBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceRequest) ProtoMessage()    {}
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{83}
+}
+func (m *ChannelBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceRequest.Unmarshal(m, b)
+}
+func (m *ChannelBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChannelBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceRequest.Merge(dst, src)
+}
+func (m *ChannelBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceRequest.Size(m)
+}
+func (m *ChannelBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBalanceRequest proto.InternalMessageInfo
+
+type ChannelBalanceResponse struct {
+	// / Sum of channels balances denominated in satoshis
+	Balance int64 `protobuf:"varint,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// / Sum of channels pending balances denominated in satoshis
+	PendingOpenBalance   int64    `protobuf:"varint,2,opt,name=pending_open_balance,proto3" json:"pending_open_balance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelBalanceResponse) Reset()         { *m = ChannelBalanceResponse{} }
+func (m *ChannelBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*ChannelBalanceResponse) ProtoMessage()    {}
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{84}
+}
+func (m *ChannelBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelBalanceResponse.Unmarshal(m, b)
+}
+func (m *ChannelBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelBalanceResponse.Marshal(b, m, deterministic)
+}
+func (dst *ChannelBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelBalanceResponse.Merge(dst, src)
+}
+func (m *ChannelBalanceResponse) XXX_Size() int {
+	return xxx_messageInfo_ChannelBalanceResponse.Size(m)
+}
+func (m *ChannelBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelBalanceResponse proto.InternalMessageInfo
+
+func (m *ChannelBalanceResponse) GetBalance() int64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+func (m *ChannelBalanceResponse) GetPendingOpenBalance() int64 {
+	if m != nil {
+		return m.PendingOpenBalance
+	}
+	return 0
+}
+
+type QueryRoutesRequest struct {
+	// / The 33-byte hex-encoded public key for the payment destination
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// / The amount to send expressed in satoshis
+	Amt int64 `protobuf:"varint,2,opt,name=amt,proto3" json:"amt,omitempty"`
+	// / The max number of routes to return.
+	NumRoutes int32 `protobuf:"varint,3,opt,name=num_routes,json=numRoutes,proto3" json:"num_routes,omitempty"`
+	// / An optional CLTV delta from the current height that should be used for the timelock of the final hop
+	FinalCltvDelta int32 `protobuf:"varint,4,opt,name=final_cltv_delta,json=finalCltvDelta,proto3" json:"final_cltv_delta,omitempty"`
+	// *
+	// The maximum number of satoshis that will be paid as a fee of the payment.
+	// This value can be represented either as a percentage of the amount being
+	// sent, or as a fixed amount of the maximum fee the user is willing the pay to
+	// send the payment.
+	FeeLimit *FeeLimit `protobuf:"bytes,5,opt,name=fee_limit,json=feeLimit,proto3" json:"fee_limit,omitempty"`
+	// *
+	// A list of nodes, identified by their serialized public key, that the
+	// returned routes must not pass through.
+	IgnoredNodes [][]byte `protobuf:"bytes,6,rep,name=ignored_nodes,json=ignoredNodes,proto3" json:"ignored_nodes,omitempty"`
+	// *
+	// A list of channels, in a single direction, that the returned routes must
+	// not use.
+	IgnoredEdges []*EdgeLocator `protobuf:"bytes,7,rep,name=ignored_edges,json=ignoredEdges,proto3" json:"ignored_edges,omitempty"`
+	// *
+	// Optional route hints to reach the destination through private channels.
+	RouteHints []*RouteHint `protobuf:"bytes,8,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+	// *
+	// The channel id of the channel that must be taken to the first hop. If zero,
+	// any channel may be used.
+	OutgoingChanId uint64 `protobuf:"varint,9,opt,name=outgoing_chan_id,json=outgoingChanId,proto3" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The pubkey of the last hop of the route. If empty, any last hop may be
+	// used.
+	LastHopPubkey []byte `protobuf:"bytes,10,opt,name=last_hop_pubkey,json=lastHopPubkey,proto3" json:"last_hop_pubkey,omitempty"`
+	// *
+	// The maximum number of blocks the total time lock of a route may exceed the
+	// current height by, including the final CLTV delta. If zero, no limit is
+	// enforced.
+	CltvLimit            uint32   `protobuf:"varint,11,opt,name=cltv_limit,json=cltvLimit,proto3" json:"cltv_limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRoutesRequest) Reset()         { *m = QueryRoutesRequest{} }
+func (m *QueryRoutesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesRequest) ProtoMessage()    {}
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{85}
+}
+func (m *QueryRoutesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRoutesRequest.Unmarshal(m, b)
+}
+func (m *QueryRoutesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRoutesRequest.Marshal(b, m, deterministic)
+}
+func (dst *QueryRoutesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesRequest.Merge(dst, src)
+}
+func (m *QueryRoutesRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRoutesRequest.Size(m)
+}
+func (m *QueryRoutesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesRequest proto.InternalMessageInfo
+
+func (m *QueryRoutesRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *QueryRoutesRequest) GetAmt() int64 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetNumRoutes() int32 {
+	if m != nil {
+		return m.NumRoutes
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetFinalCltvDelta() int32 {
+	if m != nil {
+		return m.FinalCltvDelta
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetFeeLimit() *FeeLimit {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetIgnoredNodes() [][]byte {
+	if m != nil {
+		return m.IgnoredNodes
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetIgnoredEdges() []*EdgeLocator {
+	if m != nil {
+		return m.IgnoredEdges
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetRouteHints() []*RouteHint {
+	if m != nil {
+		return m.RouteHints
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *QueryRoutesRequest) GetLastHopPubkey() []byte {
+	if m != nil {
+		return m.LastHopPubkey
+	}
+	return nil
+}
+
+func (m *QueryRoutesRequest) GetCltvLimit() uint32 {
+	if m != nil {
+		return m.CltvLimit
+	}
+	return 0
+}
+
+type EdgeLocator struct {
+	// / The short channel id of this edge.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// *
+	// The direction of this edge. If direction_reverse is false, the direction
+	// of this edge is from the channel endpoint with the lexicographically
+	// smaller pub key to the endpoint with the larger pub key. If
+	// direction_reverse is is true, the edge goes the other way.
+	DirectionReverse     bool     `protobuf:"varint,2,opt,name=direction_reverse,json=directionReverse,proto3" json:"direction_reverse,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EdgeLocator) Reset()         { *m = EdgeLocator{} }
+func (m *EdgeLocator) String() string { return proto.CompactTextString(m) }
+func (*EdgeLocator) ProtoMessage()    {}
+func (*EdgeLocator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{86}
+}
+func (m *EdgeLocator) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EdgeLocator.Unmarshal(m, b)
+}
+func (m *EdgeLocator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EdgeLocator.Marshal(b, m, deterministic)
+}
+func (dst *EdgeLocator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EdgeLocator.Merge(dst, src)
+}
+func (m *EdgeLocator) XXX_Size() int {
+	return xxx_messageInfo_EdgeLocator.Size(m)
+}
+func (m *EdgeLocator) XXX_DiscardUnknown() {
+	xxx_messageInfo_EdgeLocator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EdgeLocator proto.InternalMessageInfo
+
+func (m *EdgeLocator) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *EdgeLocator) GetDirectionReverse() bool {
+	if m != nil {
+		return m.DirectionReverse
+	}
+	return false
+}
+
+type QueryRoutesResponse struct {
+	Routes               []*Route `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryRoutesResponse) Reset()         { *m = QueryRoutesResponse{} }
+func (m *QueryRoutesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoutesResponse) ProtoMessage()    {}
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{87}
+}
+func (m *QueryRoutesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRoutesResponse.Unmarshal(m, b)
+}
+func (m *QueryRoutesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRoutesResponse.Marshal(b, m, deterministic)
+}
+func (dst *QueryRoutesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoutesResponse.Merge(dst, src)
+}
+func (m *QueryRoutesResponse) XXX_Size() int {
+	return xxx_messageInfo_QueryRoutesResponse.Size(m)
+}
+func (m *QueryRoutesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoutesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoutesResponse proto.InternalMessageInfo
+
+func (m *QueryRoutesResponse) GetRoutes() []*Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+type Hop struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId           uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	ChanCapacity     int64  `protobuf:"varint,2,opt,name=chan_capacity,proto3" json:"chan_capacity,omitempty"`
+	AmtToForward     int64  `protobuf:"varint,3,opt,name=amt_to_forward,proto3" json:"amt_to_forward,omitempty"` // Deprecated: Do not use.
+	Fee              int64  `protobuf:"varint,4,opt,name=fee,proto3" json:"fee,omitempty"`                       // Deprecated: Do not use.
+	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat,proto3" json:"amt_to_forward_msat,omitempty"`
+	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	// *
+	// An optional public key of the hop. If the public key is given, the payment
+	// can be executed without relying on a copy of the channel graph.
+	PubKey               string   `protobuf:"bytes,8,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Hop) Reset()         { *m = Hop{} }
+func (m *Hop) String() string { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()    {}
+func (*Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{88}
+}
+func (m *Hop) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hop.Unmarshal(m, b)
+}
+func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+}
+func (dst *Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hop.Merge(dst, src)
+}
+func (m *Hop) XXX_Size() int {
+	return xxx_messageInfo_Hop.Size(m)
+}
+func (m *Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hop proto.InternalMessageInfo
+
+func (m *Hop) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *Hop) GetChanCapacity() int64 {
+	if m != nil {
+		return m.ChanCapacity
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Hop) GetAmtToForward() int64 {
+	if m != nil {
+		return m.AmtToForward
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Hop) GetFee() int64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *Hop) GetExpiry() uint32 {
+	if m != nil {
+		return m.Expiry
+	}
+	return 0
+}
+
+func (m *Hop) GetAmtToForwardMsat() int64 {
+	if m != nil {
+		return m.AmtToForwardMsat
+	}
+	return 0
+}
+
+func (m *Hop) GetFeeMsat() int64 {
+	if m != nil {
+		return m.FeeMsat
+	}
+	return 0
+}
+
+func (m *Hop) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+// *
+// A path through the channel graph which runs over one or more channels in
+// succession. This struct carries all the information required to craft the
+// Sphinx onion packet, and send the payment along the first hop in the path. A
+// route is only selected as valid if all the channels have sufficient capacity to
 // carry the initial payment amount after fees are accounted for.
 type Route struct {
 	// *
-	// The cumulative (final) time lock across the entire route.  This is the CLTV
-	// value that should be extended to the first hop in the route. All other hops
-	// will decrement the time-lock as advertised, leaving enough time for all
-	// hops to wait for or present the payment preimage to complete the payment.
-	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock,proto3" json:"total_time_lock,omitempty"`
+	// The cumulative (final) time lock across the entire route.  This is the CLTV
+	// value that should be extended to the first hop in the route. All other hops
+	// will decrement the time-lock as advertised, leaving enough time for all
+	// hops to wait for or present the payment preimage to complete the payment.
+	TotalTimeLock uint32 `protobuf:"varint,1,opt,name=total_time_lock,proto3" json:"total_time_lock,omitempty"`
+	// *
+	// The sum of the fees paid at each hop within the final route.  In the case
+	// of a one-hop payment, this value will be zero as we don't need to pay a fee
+	// it ourself.
+	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees,proto3" json:"total_fees,omitempty"` // Deprecated: Do not use.
+	// *
+	// The total amount of funds required to complete a payment over this route.
+	// This value includes the cumulative fees at each hop. As a result, the HTLC
+	// extended to the first-hop in the route will need to have at least this many
+	// satoshis, otherwise the route will fail at an intermediate node due to an
+	// insufficient amount of fees.
+	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt,proto3" json:"total_amt,omitempty"` // Deprecated: Do not use.
+	// *
+	// Contains details concerning the specific forwarding details at each hop.
+	Hops []*Hop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	// *
+	// The total fees in millisatoshis.
+	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat,proto3" json:"total_fees_msat,omitempty"`
+	// *
+	// The total amount in millisatoshis.
+	TotalAmtMsat         int64    `protobuf:"varint,6,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{89}
+}
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Route.Unmarshal(m, b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+}
+func (dst *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(dst, src)
+}
+func (m *Route) XXX_Size() int {
+	return xxx_messageInfo_Route.Size(m)
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetTotalTimeLock() uint32 {
+	if m != nil {
+		return m.TotalTimeLock
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Route) GetTotalFees() int64 {
+	if m != nil {
+		return m.TotalFees
+	}
+	return 0
+}
+
+// Deprecated: Do not use.
+func (m *Route) GetTotalAmt() int64 {
+	if m != nil {
+		return m.TotalAmt
+	}
+	return 0
+}
+
+func (m *Route) GetHops() []*Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *Route) GetTotalFeesMsat() int64 {
+	if m != nil {
+		return m.TotalFeesMsat
+	}
+	return 0
+}
+
+func (m *Route) GetTotalAmtMsat() int64 {
+	if m != nil {
+		return m.TotalAmtMsat
+	}
+	return 0
+}
+
+type NodeInfoRequest struct {
+	// / The 33-byte hex-encoded compressed public of the target node
+	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
+func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeInfoRequest) ProtoMessage()    {}
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{90}
+}
+func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
+}
+func (m *NodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *NodeInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfoRequest.Merge(dst, src)
+}
+func (m *NodeInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_NodeInfoRequest.Size(m)
+}
+func (m *NodeInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInfoRequest proto.InternalMessageInfo
+
+func (m *NodeInfoRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type NodeInfo struct {
+	// *
+	// An individual vertex/node within the channel graph. A node is
+	// connected to other nodes by one or more channel edges emanating from it. As
+	// the graph is directed, a node will also have an incoming edge attached to
+	// it for each outgoing edge.
+	Node                 *LightningNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	NumChannels          uint32         `protobuf:"varint,2,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	TotalCapacity        int64          `protobuf:"varint,3,opt,name=total_capacity,proto3" json:"total_capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
+func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()    {}
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{91}
+}
+func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
+}
+func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+}
+func (dst *NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfo.Merge(dst, src)
+}
+func (m *NodeInfo) XXX_Size() int {
+	return xxx_messageInfo_NodeInfo.Size(m)
+}
+func (m *NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+
+func (m *NodeInfo) GetNode() *LightningNode {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
+func (m *NodeInfo) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *NodeInfo) GetTotalCapacity() int64 {
+	if m != nil {
+		return m.TotalCapacity
+	}
+	return 0
+}
+
+// *
+// An individual vertex/node within the channel graph. A node is
+// connected to other nodes by one or more channel edges emanating from it. As the
+// graph is directed, a node will also have an incoming edge attached to it for
+// each outgoing edge.
+type LightningNode struct {
+	LastUpdate           uint32         `protobuf:"varint,1,opt,name=last_update,proto3" json:"last_update,omitempty"`
+	PubKey               string         `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	Alias                string         `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
+	Addresses            []*NodeAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Color                string         `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *LightningNode) Reset()         { *m = LightningNode{} }
+func (m *LightningNode) String() string { return proto.CompactTextString(m) }
+func (*LightningNode) ProtoMessage()    {}
+func (*LightningNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{92}
+}
+func (m *LightningNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
+}
+func (m *LightningNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LightningNode.Marshal(b, m, deterministic)
+}
+func (dst *LightningNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightningNode.Merge(dst, src)
+}
+func (m *LightningNode) XXX_Size() int {
+	return xxx_messageInfo_LightningNode.Size(m)
+}
+func (m *LightningNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightningNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightningNode proto.InternalMessageInfo
+
+func (m *LightningNode) GetLastUpdate() uint32 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *LightningNode) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *LightningNode) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *LightningNode) GetAddresses() []*NodeAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *LightningNode) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+type NodeAddress struct {
+	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
+	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
+func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
+func (*NodeAddress) ProtoMessage()    {}
+func (*NodeAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{93}
+}
+func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
+}
+func (m *NodeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeAddress.Marshal(b, m, deterministic)
+}
+func (dst *NodeAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAddress.Merge(dst, src)
+}
+func (m *NodeAddress) XXX_Size() int {
+	return xxx_messageInfo_NodeAddress.Size(m)
+}
+func (m *NodeAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeAddress proto.InternalMessageInfo
+
+func (m *NodeAddress) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *NodeAddress) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+type RoutingPolicy struct {
+	TimeLockDelta        uint32   `protobuf:"varint,1,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+	MinHtlc              int64    `protobuf:"varint,2,opt,name=min_htlc,proto3" json:"min_htlc,omitempty"`
+	FeeBaseMsat          int64    `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
+	FeeRateMilliMsat     int64    `protobuf:"varint,4,opt,name=fee_rate_milli_msat,proto3" json:"fee_rate_milli_msat,omitempty"`
+	Disabled             bool     `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MaxHtlcMsat          uint64   `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
+func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
+func (*RoutingPolicy) ProtoMessage()    {}
+func (*RoutingPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{94}
+}
+func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
+}
+func (m *RoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoutingPolicy.Marshal(b, m, deterministic)
+}
+func (dst *RoutingPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoutingPolicy.Merge(dst, src)
+}
+func (m *RoutingPolicy) XXX_Size() int {
+	return xxx_messageInfo_RoutingPolicy.Size(m)
+}
+func (m *RoutingPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoutingPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoutingPolicy proto.InternalMessageInfo
+
+func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
+	if m != nil {
+		return m.TimeLockDelta
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetMinHtlc() int64 {
+	if m != nil {
+		return m.MinHtlc
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
+	if m != nil {
+		return m.FeeBaseMsat
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
+	if m != nil {
+		return m.FeeRateMilliMsat
+	}
+	return 0
+}
+
+func (m *RoutingPolicy) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *RoutingPolicy) GetMaxHtlcMsat() uint64 {
+	if m != nil {
+		return m.MaxHtlcMsat
+	}
+	return 0
+}
+
+// *
+// A fully authenticated channel along with all its unique attributes.
+// Once an authenticated channel announcement has been processed on the network,
+// then an instance of ChannelEdgeInfo encapsulating the channels attributes is
+// stored. The other portions relevant to routing policy of a channel are stored
+// within a ChannelEdgePolicy for each direction of the channel.
+type ChannelEdge struct {
 	// *
-	// The sum of the fees paid at each hop within the final route.  In the case
-	// of a one-hop payment, this value will be zero as we don't need to pay a fee
-	// it ourself.
-	TotalFees int64 `protobuf:"varint,2,opt,name=total_fees,proto3" json:"total_fees,omitempty"` // Deprecated: Do not use.
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChannelId            uint64         `protobuf:"varint,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	ChanPoint            string         `protobuf:"bytes,2,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
+	LastUpdate           uint32         `protobuf:"varint,3,opt,name=last_update,proto3" json:"last_update,omitempty"`
+	Node1Pub             string         `protobuf:"bytes,4,opt,name=node1_pub,proto3" json:"node1_pub,omitempty"`
+	Node2Pub             string         `protobuf:"bytes,5,opt,name=node2_pub,proto3" json:"node2_pub,omitempty"`
+	Capacity             int64          `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Node1Policy          *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy,proto3" json:"node1_policy,omitempty"`
+	Node2Policy          *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy,proto3" json:"node2_policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
+func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
+func (*ChannelEdge) ProtoMessage()    {}
+func (*ChannelEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{95}
+}
+func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
+}
+func (m *ChannelEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEdge.Marshal(b, m, deterministic)
+}
+func (dst *ChannelEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEdge.Merge(dst, src)
+}
+func (m *ChannelEdge) XXX_Size() int {
+	return xxx_messageInfo_ChannelEdge.Size(m)
+}
+func (m *ChannelEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEdge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEdge proto.InternalMessageInfo
+
+func (m *ChannelEdge) GetChannelId() uint64 {
+	if m != nil {
+		return m.ChannelId
+	}
+	return 0
+}
+
+func (m *ChannelEdge) GetChanPoint() string {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return ""
+}
+
+func (m *ChannelEdge) GetLastUpdate() uint32 {
+	if m != nil {
+		return m.LastUpdate
+	}
+	return 0
+}
+
+func (m *ChannelEdge) GetNode1Pub() string {
+	if m != nil {
+		return m.Node1Pub
+	}
+	return ""
+}
+
+func (m *ChannelEdge) GetNode2Pub() string {
+	if m != nil {
+		return m.Node2Pub
+	}
+	return ""
+}
+
+func (m *ChannelEdge) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
+	if m != nil {
+		return m.Node1Policy
+	}
+	return nil
+}
+
+func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
+	if m != nil {
+		return m.Node2Policy
+	}
+	return nil
+}
+
+type ChannelGraphRequest struct {
 	// *
-	// The total amount of funds required to complete a payment over this route.
-	// This value includes the cumulative fees at each hop. As a result, the HTLC
-	// extended to the first-hop in the route will need to have at least this many
-	// satoshis, otherwise the route will fail at an intermediate node due to an
-	// insufficient amount of fees.
-	TotalAmt int64 `protobuf:"varint,3,opt,name=total_amt,proto3" json:"total_amt,omitempty"` // Deprecated: Do not use.
+	// Whether unannounced channels are included in the response or not. If set,
+	// unannounced channels are included. Unannounced channels are both private
+	// channels, and public channels that are not yet announced to the network.
+	IncludeUnannounced   bool     `protobuf:"varint,1,opt,name=include_unannounced,proto3" json:"include_unannounced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
+func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*ChannelGraphRequest) ProtoMessage()    {}
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{96}
+}
+func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
+}
+func (m *ChannelGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelGraphRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChannelGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGraphRequest.Merge(dst, src)
+}
+func (m *ChannelGraphRequest) XXX_Size() int {
+	return xxx_messageInfo_ChannelGraphRequest.Size(m)
+}
+func (m *ChannelGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGraphRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelGraphRequest proto.InternalMessageInfo
+
+func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
+	if m != nil {
+		return m.IncludeUnannounced
+	}
+	return false
+}
+
+// / Returns a new instance of the directed channel graph.
+type ChannelGraph struct {
+	// / The list of `LightningNode`s in this channel graph
+	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// / The list of `ChannelEdge`s in this channel graph
+	Edges                []*ChannelEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
+func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
+func (*ChannelGraph) ProtoMessage()    {}
+func (*ChannelGraph) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{97}
+}
+func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
+}
+func (m *ChannelGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelGraph.Marshal(b, m, deterministic)
+}
+func (dst *ChannelGraph) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelGraph.Merge(dst, src)
+}
+func (m *ChannelGraph) XXX_Size() int {
+	return xxx_messageInfo_ChannelGraph.Size(m)
+}
+func (m *ChannelGraph) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelGraph.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelGraph proto.InternalMessageInfo
+
+func (m *ChannelGraph) GetNodes() []*LightningNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ChannelGraph) GetEdges() []*ChannelEdge {
+	if m != nil {
+		return m.Edges
+	}
+	return nil
+}
+
+type ChanInfoRequest struct {
+	// *
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId               uint64   `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
+func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ChanInfoRequest) ProtoMessage()    {}
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{98}
+}
+func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
+}
+func (m *ChanInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChanInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChanInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChanInfoRequest.Merge(dst, src)
+}
+func (m *ChanInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_ChanInfoRequest.Size(m)
+}
+func (m *ChanInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChanInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChanInfoRequest proto.InternalMessageInfo
+
+func (m *ChanInfoRequest) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+type NetworkInfoRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
+func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*NetworkInfoRequest) ProtoMessage()    {}
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{99}
+}
+func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
+}
+func (m *NetworkInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkInfoRequest.Marshal(b, m, deterministic)
+}
+func (dst *NetworkInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkInfoRequest.Merge(dst, src)
+}
+func (m *NetworkInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_NetworkInfoRequest.Size(m)
+}
+func (m *NetworkInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkInfoRequest proto.InternalMessageInfo
+
+type NetworkInfo struct {
+	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter,proto3" json:"graph_diameter,omitempty"`
+	AvgOutDegree         float64 `protobuf:"fixed64,2,opt,name=avg_out_degree,proto3" json:"avg_out_degree,omitempty"`
+	MaxOutDegree         uint32  `protobuf:"varint,3,opt,name=max_out_degree,proto3" json:"max_out_degree,omitempty"`
+	NumNodes             uint32  `protobuf:"varint,4,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
+	NumChannels          uint32  `protobuf:"varint,5,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
+	TotalNetworkCapacity int64   `protobuf:"varint,6,opt,name=total_network_capacity,proto3" json:"total_network_capacity,omitempty"`
+	AvgChannelSize       float64 `protobuf:"fixed64,7,opt,name=avg_channel_size,proto3" json:"avg_channel_size,omitempty"`
+	MinChannelSize       int64   `protobuf:"varint,8,opt,name=min_channel_size,proto3" json:"min_channel_size,omitempty"`
+	MaxChannelSize       int64   `protobuf:"varint,9,opt,name=max_channel_size,proto3" json:"max_channel_size,omitempty"`
+	// / The number of edges marked as zombies.
+	NumZombieChans       uint64   `protobuf:"varint,10,opt,name=num_zombie_chans,proto3" json:"num_zombie_chans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
+func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
+func (*NetworkInfo) ProtoMessage()    {}
+func (*NetworkInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{100}
+}
+func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
+}
+func (m *NetworkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkInfo.Marshal(b, m, deterministic)
+}
+func (dst *NetworkInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkInfo.Merge(dst, src)
+}
+func (m *NetworkInfo) XXX_Size() int {
+	return xxx_messageInfo_NetworkInfo.Size(m)
+}
+func (m *NetworkInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkInfo proto.InternalMessageInfo
+
+func (m *NetworkInfo) GetGraphDiameter() uint32 {
+	if m != nil {
+		return m.GraphDiameter
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetAvgOutDegree() float64 {
+	if m != nil {
+		return m.AvgOutDegree
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetMaxOutDegree() uint32 {
+	if m != nil {
+		return m.MaxOutDegree
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetNumNodes() uint32 {
+	if m != nil {
+		return m.NumNodes
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
+	if m != nil {
+		return m.TotalNetworkCapacity
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetAvgChannelSize() float64 {
+	if m != nil {
+		return m.AvgChannelSize
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetMinChannelSize() int64 {
+	if m != nil {
+		return m.MinChannelSize
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetMaxChannelSize() int64 {
+	if m != nil {
+		return m.MaxChannelSize
+	}
+	return 0
+}
+
+func (m *NetworkInfo) GetNumZombieChans() uint64 {
+	if m != nil {
+		return m.NumZombieChans
+	}
+	return 0
+}
+
+type StopRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopRequest) Reset()         { *m = StopRequest{} }
+func (m *StopRequest) String() string { return proto.CompactTextString(m) }
+func (*StopRequest) ProtoMessage()    {}
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{101}
+}
+func (m *StopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
+}
+func (m *StopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopRequest.Marshal(b, m, deterministic)
+}
+func (dst *StopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopRequest.Merge(dst, src)
+}
+func (m *StopRequest) XXX_Size() int {
+	return xxx_messageInfo_StopRequest.Size(m)
+}
+func (m *StopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopRequest proto.InternalMessageInfo
+
+type StopResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StopResponse) Reset()         { *m = StopResponse{} }
+func (m *StopResponse) String() string { return proto.CompactTextString(m) }
+func (*StopResponse) ProtoMessage()    {}
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{102}
+}
+func (m *StopResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
+}
+func (m *StopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StopResponse.Marshal(b, m, deterministic)
+}
+func (dst *StopResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StopResponse.Merge(dst, src)
+}
+func (m *StopResponse) XXX_Size() int {
+	return xxx_messageInfo_StopResponse.Size(m)
+}
+func (m *StopResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StopResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StopResponse proto.InternalMessageInfo
+
+type GraphTopologySubscription struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscription{} }
+func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
+func (*GraphTopologySubscription) ProtoMessage()    {}
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{103}
+}
+func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
+}
+func (m *GraphTopologySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTopologySubscription.Marshal(b, m, deterministic)
+}
+func (dst *GraphTopologySubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTopologySubscription.Merge(dst, src)
+}
+func (m *GraphTopologySubscription) XXX_Size() int {
+	return xxx_messageInfo_GraphTopologySubscription.Size(m)
+}
+func (m *GraphTopologySubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTopologySubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTopologySubscription proto.InternalMessageInfo
+
+type GraphTopologyUpdate struct {
+	NodeUpdates          []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates,proto3" json:"node_updates,omitempty"`
+	ChannelUpdates       []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates,proto3" json:"channel_updates,omitempty"`
+	ClosedChans          []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans,proto3" json:"closed_chans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
+func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
+func (*GraphTopologyUpdate) ProtoMessage()    {}
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{104}
+}
+func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
+}
+func (m *GraphTopologyUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphTopologyUpdate.Marshal(b, m, deterministic)
+}
+func (dst *GraphTopologyUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphTopologyUpdate.Merge(dst, src)
+}
+func (m *GraphTopologyUpdate) XXX_Size() int {
+	return xxx_messageInfo_GraphTopologyUpdate.Size(m)
+}
+func (m *GraphTopologyUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphTopologyUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphTopologyUpdate proto.InternalMessageInfo
+
+func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
+	if m != nil {
+		return m.NodeUpdates
+	}
+	return nil
+}
+
+func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
+	if m != nil {
+		return m.ChannelUpdates
+	}
+	return nil
+}
+
+func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+	if m != nil {
+		return m.ClosedChans
+	}
+	return nil
+}
+
+type NodeUpdate struct {
+	Addresses      []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	IdentityKey    string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
+	GlobalFeatures []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
+	Alias          string   `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+	// / The color of the node, in the form #RRGGBB.
+	Color                string   `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
+func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
+func (*NodeUpdate) ProtoMessage()    {}
+func (*NodeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{105}
+}
+func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
+}
+func (m *NodeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeUpdate.Marshal(b, m, deterministic)
+}
+func (dst *NodeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeUpdate.Merge(dst, src)
+}
+func (m *NodeUpdate) XXX_Size() int {
+	return xxx_messageInfo_NodeUpdate.Size(m)
+}
+func (m *NodeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeUpdate proto.InternalMessageInfo
+
+func (m *NodeUpdate) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+func (m *NodeUpdate) GetIdentityKey() string {
+	if m != nil {
+		return m.IdentityKey
+	}
+	return ""
+}
+
+func (m *NodeUpdate) GetGlobalFeatures() []byte {
+	if m != nil {
+		return m.GlobalFeatures
+	}
+	return nil
+}
+
+func (m *NodeUpdate) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *NodeUpdate) GetColor() string {
+	if m != nil {
+		return m.Color
+	}
+	return ""
+}
+
+type ChannelEdgeUpdate struct {
 	// *
-	// Contains details concerning the specific forwarding details at each hop.
-	Hops []*Hop `protobuf:"bytes,4,rep,name=hops,proto3" json:"hops,omitempty"`
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId               uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	ChanPoint            *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	Capacity             int64          `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	RoutingPolicy        *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy,proto3" json:"routing_policy,omitempty"`
+	AdvertisingNode      string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode,proto3" json:"advertising_node,omitempty"`
+	ConnectingNode       string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode,proto3" json:"connecting_node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
+func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelEdgeUpdate) ProtoMessage()    {}
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{106}
+}
+func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
+}
+func (m *ChannelEdgeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelEdgeUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ChannelEdgeUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelEdgeUpdate.Merge(dst, src)
+}
+func (m *ChannelEdgeUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelEdgeUpdate.Size(m)
+}
+func (m *ChannelEdgeUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelEdgeUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelEdgeUpdate proto.InternalMessageInfo
+
+func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+	if m != nil {
+		return m.RoutingPolicy
+	}
+	return nil
+}
+
+func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+	if m != nil {
+		return m.AdvertisingNode
+	}
+	return ""
+}
+
+func (m *ChannelEdgeUpdate) GetConnectingNode() string {
+	if m != nil {
+		return m.ConnectingNode
+	}
+	return ""
+}
+
+type ClosedChannelUpdate struct {
 	// *
-	// The total fees in millisatoshis.
-	TotalFeesMsat int64 `protobuf:"varint,5,opt,name=total_fees_msat,proto3" json:"total_fees_msat,omitempty"`
+	// The unique channel ID for the channel. The first 3 bytes are the block
+	// height, the next 3 the index within the block, and the last 2 bytes are the
+	// output index for the channel.
+	ChanId               uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	Capacity             int64         `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	ClosedHeight         uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
+	ChanPoint            *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
+func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
+func (*ClosedChannelUpdate) ProtoMessage()    {}
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{107}
+}
+func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
+}
+func (m *ClosedChannelUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClosedChannelUpdate.Marshal(b, m, deterministic)
+}
+func (dst *ClosedChannelUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClosedChannelUpdate.Merge(dst, src)
+}
+func (m *ClosedChannelUpdate) XXX_Size() int {
+	return xxx_messageInfo_ClosedChannelUpdate.Size(m)
+}
+func (m *ClosedChannelUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClosedChannelUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClosedChannelUpdate proto.InternalMessageInfo
+
+func (m *ClosedChannelUpdate) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
+	if m != nil {
+		return m.ClosedHeight
+	}
+	return 0
+}
+
+func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
+	if m != nil {
+		return m.ChanPoint
+	}
+	return nil
+}
+
+type HopHint struct {
+	// / The public key of the node at the start of the channel.
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,proto3" json:"node_id,omitempty"`
+	// / The unique identifier of the channel.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The base fee of the channel denominated in millisatoshis.
+	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
 	// *
-	// The total amount in millisatoshis.
-	TotalAmtMsat         int64    `protobuf:"varint,6,opt,name=total_amt_msat,proto3" json:"total_amt_msat,omitempty"`
+	// The fee rate of the channel for sending one satoshi across it denominated in
+	// millionths of a satoshi.
+	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths,proto3" json:"fee_proportional_millionths,omitempty"`
+	// / The time-lock delta of the channel.
+	CltvExpiryDelta      uint32   `protobuf:"varint,5,opt,name=cltv_expiry_delta,proto3" json:"cltv_expiry_delta,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Route) Reset()         { *m = Route{} }
-func (m *Route) String() string { return proto.CompactTextString(m) }
-func (*Route) ProtoMessage()    {}
-func (*Route) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{68}
+func (m *HopHint) Reset()         { *m = HopHint{} }
+func (m *HopHint) String() string { return proto.CompactTextString(m) }
+func (*HopHint) ProtoMessage()    {}
+func (*HopHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{108}
 }
-func (m *Route) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Route.Unmarshal(m, b)
+func (m *HopHint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HopHint.Unmarshal(m, b)
 }
-func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+func (m *HopHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HopHint.Marshal(b, m, deterministic)
 }
-func (dst *Route) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Route.Merge(dst, src)
+func (dst *HopHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopHint.Merge(dst, src)
 }
-func (m *Route) XXX_Size() int {
-	return xxx_messageInfo_Route.Size(m)
+func (m *HopHint) XXX_Size() int {
+	return xxx_messageInfo_HopHint.Size(m)
 }
-func (m *Route) XXX_DiscardUnknown() {
-	xxx_messageInfo_Route.DiscardUnknown(m)
+func (m *HopHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopHint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Route proto.InternalMessageInfo
+var xxx_messageInfo_HopHint proto.InternalMessageInfo
 
-func (m *Route) GetTotalTimeLock() uint32 {
+func (m *HopHint) GetNodeId() string {
 	if m != nil {
-		return m.TotalTimeLock
+		return m.NodeId
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Do not use.
-func (m *Route) GetTotalFees() int64 {
+func (m *HopHint) GetChanId() uint64 {
 	if m != nil {
-		return m.TotalFees
+		return m.ChanId
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Route) GetTotalAmt() int64 {
+func (m *HopHint) GetFeeBaseMsat() uint32 {
 	if m != nil {
-		return m.TotalAmt
+		return m.FeeBaseMsat
 	}
 	return 0
 }
 
-func (m *Route) GetHops() []*Hop {
-	if m != nil {
-		return m.Hops
-	}
-	return nil
-}
-
-func (m *Route) GetTotalFeesMsat() int64 {
+func (m *HopHint) GetFeeProportionalMillionths() uint32 {
 	if m != nil {
-		return m.TotalFeesMsat
+		return m.FeeProportionalMillionths
 	}
 	return 0
 }
 
-func (m *Route) GetTotalAmtMsat() int64 {
+func (m *HopHint) GetCltvExpiryDelta() uint32 {
 	if m != nil {
-		return m.TotalAmtMsat
+		return m.CltvExpiryDelta
 	}
 	return 0
 }
 
-type NodeInfoRequest struct {
-	// / The 33-byte hex-encoded compressed public of the target node
-	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RouteHint struct {
+	// *
+	// A list of hop hints that when chained together can assist in reaching a
+	// specific destination.
+	HopHints             []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,proto3" json:"hop_hints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *NodeInfoRequest) Reset()         { *m = NodeInfoRequest{} }
-func (m *NodeInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*NodeInfoRequest) ProtoMessage()    {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{69}
+func (m *RouteHint) Reset()         { *m = RouteHint{} }
+func (m *RouteHint) String() string { return proto.CompactTextString(m) }
+func (*RouteHint) ProtoMessage()    {}
+func (*RouteHint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{109}
 }
-func (m *NodeInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeInfoRequest.Unmarshal(m, b)
+func (m *RouteHint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
 }
-func (m *NodeInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeInfoRequest.Marshal(b, m, deterministic)
+func (m *RouteHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RouteHint.Marshal(b, m, deterministic)
 }
-func (dst *NodeInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfoRequest.Merge(dst, src)
+func (dst *RouteHint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RouteHint.Merge(dst, src)
 }
-func (m *NodeInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_NodeInfoRequest.Size(m)
+func (m *RouteHint) XXX_Size() int {
+	return xxx_messageInfo_RouteHint.Size(m)
 }
-func (m *NodeInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfoRequest.DiscardUnknown(m)
+func (m *RouteHint) XXX_DiscardUnknown() {
+	xxx_messageInfo_RouteHint.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_RouteHint proto.InternalMessageInfo
 
-func (m *NodeInfoRequest) GetPubKey() string {
+func (m *RouteHint) GetHopHints() []*HopHint {
 	if m != nil {
-		return m.PubKey
+		return m.HopHints
 	}
-	return ""
+	return nil
 }
 
-type NodeInfo struct {
+type Invoice struct {
 	// *
-	// An individual vertex/node within the channel graph. A node is
-	// connected to other nodes by one or more channel edges emanating from it. As
-	// the graph is directed, a node will also have an incoming edge attached to
-	// it for each outgoing edge.
-	Node                 *LightningNode `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	NumChannels          uint32         `protobuf:"varint,2,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
-	TotalCapacity        int64          `protobuf:"varint,3,opt,name=total_capacity,proto3" json:"total_capacity,omitempty"`
+	// An optional memo to attach along with the invoice. Used for record keeping
+	// purposes for the invoice's creator, and will also be set in the description
+	// field of the encoded payment request if the description_hash field is not
+	// being used.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	// * Deprecated. An optional cryptographic receipt of payment which is not
+	// implemented.
+	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"` // Deprecated: Do not use.
+	// *
+	// The hex-encoded preimage (32 byte) which will allow settling an incoming
+	// HTLC payable to this preimage
+	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
+	// / The hash of the preimage
+	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// / The value of this invoice in satoshis
+	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// / Whether this invoice has been fulfilled
+	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"` // Deprecated: Do not use.
+	// / When this invoice was created
+	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
+	// / When this invoice was settled
+	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date,proto3" json:"settle_date,omitempty"`
+	// *
+	// A bare-bones invoice for a payment within the Lightning Network.  With the
+	// details of the invoice, the sender has all the data necessary to send a
+	// payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
+	// *
+	// Hash (SHA-256) of a description of the payment. Used if the description of
+	// payment (memo) is too long to naturally fit within the description field
+	// of an encoded payment request.
+	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
+	Expiry int64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// / Fallback on-chain address.
+	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	// / Delta to use for the time-lock of the CLTV extended to the final hop.
+	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	// *
+	// Route hints that can each be individually used to assist in reaching the
+	// invoice's destination.
+	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
+	// *
+	// Whether this invoice should include routing hints for private channels.
+	// Routing hints are always included for the private channels the invoice
+	// could be paid through, so this no longer needs to be set.
+	Private bool `protobuf:"varint,15,opt,name=private,proto3" json:"private,omitempty"`
+	// *
+	// The "add" index of this invoice. Each newly created invoice will increment
+	// this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all added
+	// invoices with an add_index greater than this one.
+	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
+	// *
+	// The "settle" index of this invoice. Each newly settled invoice will
+	// increment this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all
+	// settled invoices with an settle_index greater than this one.
+	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
+	// / Deprecated, use amt_paid_sat or amt_paid_msat.
+	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid,proto3" json:"amt_paid,omitempty"` // Deprecated: Do not use.
+	// *
+	// The amount that was accepted for this invoice, in satoshis. This will ONLY
+	// be set if this invoice has been settled. We provide this field as if the
+	// invoice was created with a zero value, then we need to record what amount
+	// was ultimately accepted. Additionally, it's possible that the sender paid
+	// MORE that was specified in the original invoice. So we'll record that here
+	// as well.
+	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat,proto3" json:"amt_paid_sat,omitempty"`
+	// *
+	// The amount that was accepted for this invoice, in millisatoshis. This will
+	// ONLY be set if this invoice has been settled. We provide this field as if
+	// the invoice was created with a zero value, then we need to record what
+	// amount was ultimately accepted. Additionally, it's possible that the sender
+	// paid MORE that was specified in the original invoice. So we'll record that
+	// here as well.
+	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat,proto3" json:"amt_paid_msat,omitempty"`
+	// *
+	// The state the invoice is in.
+	State Invoice_InvoiceState `protobuf:"varint,21,opt,name=state,proto3,enum=lnrpc.Invoice_InvoiceState" json:"state,omitempty"`
+	// *
+	// Whether the routing hints of this open invoice have gone stale, as one of
+	// the private channels they refer to has since been closed, or its routing
+	// policy has changed. Such an invoice can be re-issued with fresh routing
+	// hints through RefreshInvoiceHints.
+	StaleHints bool `protobuf:"varint,22,opt,name=stale_hints,proto3" json:"stale_hints,omitempty"`
+	// *
+	// The htlcs that paid this invoice, which can be used to reconcile the
+	// payments received for it.
+	Htlcs                []*InvoiceHTLC `protobuf:"bytes,23,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
 }
 
-func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
-func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
-func (*NodeInfo) ProtoMessage()    {}
-func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{70}
+func (m *Invoice) Reset()         { *m = Invoice{} }
+func (m *Invoice) String() string { return proto.CompactTextString(m) }
+func (*Invoice) ProtoMessage()    {}
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{110}
 }
-func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeInfo.Unmarshal(m, b)
+func (m *Invoice) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Invoice.Unmarshal(m, b)
 }
-func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+func (m *Invoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Invoice.Marshal(b, m, deterministic)
 }
-func (dst *NodeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfo.Merge(dst, src)
+func (dst *Invoice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Invoice.Merge(dst, src)
 }
-func (m *NodeInfo) XXX_Size() int {
-	return xxx_messageInfo_NodeInfo.Size(m)
+func (m *Invoice) XXX_Size() int {
+	return xxx_messageInfo_Invoice.Size(m)
 }
-func (m *NodeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+func (m *Invoice) XXX_DiscardUnknown() {
+	xxx_messageInfo_Invoice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Invoice proto.InternalMessageInfo
+
+func (m *Invoice) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
 }
 
-var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+// Deprecated: Do not use.
+func (m *Invoice) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
 
-func (m *NodeInfo) GetNode() *LightningNode {
+func (m *Invoice) GetRPreimage() []byte {
 	if m != nil {
-		return m.Node
+		return m.RPreimage
 	}
 	return nil
 }
 
-func (m *NodeInfo) GetNumChannels() uint32 {
+func (m *Invoice) GetRHash() []byte {
 	if m != nil {
-		return m.NumChannels
+		return m.RHash
 	}
-	return 0
+	return nil
 }
 
-func (m *NodeInfo) GetTotalCapacity() int64 {
+func (m *Invoice) GetValue() int64 {
 	if m != nil {
-		return m.TotalCapacity
+		return m.Value
 	}
 	return 0
 }
 
-// *
-// An individual vertex/node within the channel graph. A node is
-// connected to other nodes by one or more channel edges emanating from it. As the
-// graph is directed, a node will also have an incoming edge attached to it for
-// each outgoing edge.
-type LightningNode struct {
-	LastUpdate           uint32         `protobuf:"varint,1,opt,name=last_update,proto3" json:"last_update,omitempty"`
-	PubKey               string         `protobuf:"bytes,2,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
-	Alias                string         `protobuf:"bytes,3,opt,name=alias,proto3" json:"alias,omitempty"`
-	Addresses            []*NodeAddress `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Color                string         `protobuf:"bytes,5,opt,name=color,proto3" json:"color,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+// Deprecated: Do not use.
+func (m *Invoice) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
 }
 
-func (m *LightningNode) Reset()         { *m = LightningNode{} }
-func (m *LightningNode) String() string { return proto.CompactTextString(m) }
-func (*LightningNode) ProtoMessage()    {}
-func (*LightningNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{71}
-}
-func (m *LightningNode) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LightningNode.Unmarshal(m, b)
-}
-func (m *LightningNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LightningNode.Marshal(b, m, deterministic)
-}
-func (dst *LightningNode) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LightningNode.Merge(dst, src)
+func (m *Invoice) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
 }
-func (m *LightningNode) XXX_Size() int {
-	return xxx_messageInfo_LightningNode.Size(m)
+
+func (m *Invoice) GetSettleDate() int64 {
+	if m != nil {
+		return m.SettleDate
+	}
+	return 0
 }
-func (m *LightningNode) XXX_DiscardUnknown() {
-	xxx_messageInfo_LightningNode.DiscardUnknown(m)
+
+func (m *Invoice) GetPaymentRequest() string {
+	if m != nil {
+		return m.PaymentRequest
+	}
+	return ""
 }
 
-var xxx_messageInfo_LightningNode proto.InternalMessageInfo
+func (m *Invoice) GetDescriptionHash() []byte {
+	if m != nil {
+		return m.DescriptionHash
+	}
+	return nil
+}
 
-func (m *LightningNode) GetLastUpdate() uint32 {
+func (m *Invoice) GetExpiry() int64 {
 	if m != nil {
-		return m.LastUpdate
+		return m.Expiry
 	}
 	return 0
 }
 
-func (m *LightningNode) GetPubKey() string {
+func (m *Invoice) GetFallbackAddr() string {
 	if m != nil {
-		return m.PubKey
+		return m.FallbackAddr
 	}
 	return ""
 }
 
-func (m *LightningNode) GetAlias() string {
+func (m *Invoice) GetCltvExpiry() uint64 {
 	if m != nil {
-		return m.Alias
+		return m.CltvExpiry
 	}
-	return ""
+	return 0
 }
 
-func (m *LightningNode) GetAddresses() []*NodeAddress {
+func (m *Invoice) GetRouteHints() []*RouteHint {
 	if m != nil {
-		return m.Addresses
+		return m.RouteHints
 	}
 	return nil
 }
 
-func (m *LightningNode) GetColor() string {
+func (m *Invoice) GetPrivate() bool {
 	if m != nil {
-		return m.Color
+		return m.Private
 	}
-	return ""
+	return false
 }
 
-type NodeAddress struct {
-	Network              string   `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
-	Addr                 string   `protobuf:"bytes,2,opt,name=addr,proto3" json:"addr,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Invoice) GetAddIndex() uint64 {
+	if m != nil {
+		return m.AddIndex
+	}
+	return 0
 }
 
-func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
-func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
-func (*NodeAddress) ProtoMessage()    {}
-func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{72}
-}
-func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
-}
-func (m *NodeAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeAddress.Marshal(b, m, deterministic)
+func (m *Invoice) GetSettleIndex() uint64 {
+	if m != nil {
+		return m.SettleIndex
+	}
+	return 0
 }
-func (dst *NodeAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeAddress.Merge(dst, src)
+
+// Deprecated: Do not use.
+func (m *Invoice) GetAmtPaid() int64 {
+	if m != nil {
+		return m.AmtPaid
+	}
+	return 0
 }
-func (m *NodeAddress) XXX_Size() int {
-	return xxx_messageInfo_NodeAddress.Size(m)
+
+func (m *Invoice) GetAmtPaidSat() int64 {
+	if m != nil {
+		return m.AmtPaidSat
+	}
+	return 0
 }
-func (m *NodeAddress) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeAddress.DiscardUnknown(m)
+
+func (m *Invoice) GetAmtPaidMsat() int64 {
+	if m != nil {
+		return m.AmtPaidMsat
+	}
+	return 0
 }
 
-var xxx_messageInfo_NodeAddress proto.InternalMessageInfo
+func (m *Invoice) GetState() Invoice_InvoiceState {
+	if m != nil {
+		return m.State
+	}
+	return Invoice_OPEN
+}
 
-func (m *NodeAddress) GetNetwork() string {
+func (m *Invoice) GetStaleHints() bool {
 	if m != nil {
-		return m.Network
+		return m.StaleHints
 	}
-	return ""
+	return false
 }
 
-func (m *NodeAddress) GetAddr() string {
+func (m *Invoice) GetHtlcs() []*InvoiceHTLC {
 	if m != nil {
-		return m.Addr
+		return m.Htlcs
 	}
-	return ""
+	return nil
 }
 
-type RoutingPolicy struct {
-	TimeLockDelta        uint32   `protobuf:"varint,1,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
-	MinHtlc              int64    `protobuf:"varint,2,opt,name=min_htlc,proto3" json:"min_htlc,omitempty"`
-	FeeBaseMsat          int64    `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
-	FeeRateMilliMsat     int64    `protobuf:"varint,4,opt,name=fee_rate_milli_msat,proto3" json:"fee_rate_milli_msat,omitempty"`
-	Disabled             bool     `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	MaxHtlcMsat          uint64   `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
+// *
+// InvoiceHTLC contains the details of an htlc that paid an invoice.
+type InvoiceHTLC struct {
+	// *
+	// The short channel id of the channel the htlc was received on.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// *
+	// The index of the htlc within the channel it was received on.
+	HtlcIndex uint64 `protobuf:"varint,2,opt,name=htlc_index,proto3" json:"htlc_index,omitempty"`
+	// *
+	// The amount paid by the htlc in milli-satoshis.
+	AmtMsat uint64 `protobuf:"varint,3,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	// *
+	// The block height at which the htlc was accepted.
+	AcceptHeight uint32 `protobuf:"varint,4,opt,name=accept_height,proto3" json:"accept_height,omitempty"`
+	// *
+	// The unix timestamp at which the htlc was used to settle the invoice.
+	ResolveTime          int64    `protobuf:"varint,5,opt,name=resolve_time,proto3" json:"resolve_time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RoutingPolicy) Reset()         { *m = RoutingPolicy{} }
-func (m *RoutingPolicy) String() string { return proto.CompactTextString(m) }
-func (*RoutingPolicy) ProtoMessage()    {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{73}
+func (m *InvoiceHTLC) Reset()         { *m = InvoiceHTLC{} }
+func (m *InvoiceHTLC) String() string { return proto.CompactTextString(m) }
+func (*InvoiceHTLC) ProtoMessage()    {}
+func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{111}
 }
-func (m *RoutingPolicy) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RoutingPolicy.Unmarshal(m, b)
+func (m *InvoiceHTLC) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceHTLC.Unmarshal(m, b)
 }
-func (m *RoutingPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RoutingPolicy.Marshal(b, m, deterministic)
+func (m *InvoiceHTLC) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceHTLC.Marshal(b, m, deterministic)
 }
-func (dst *RoutingPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RoutingPolicy.Merge(dst, src)
+func (dst *InvoiceHTLC) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceHTLC.Merge(dst, src)
 }
-func (m *RoutingPolicy) XXX_Size() int {
-	return xxx_messageInfo_RoutingPolicy.Size(m)
+func (m *InvoiceHTLC) XXX_Size() int {
+	return xxx_messageInfo_InvoiceHTLC.Size(m)
 }
-func (m *RoutingPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_RoutingPolicy.DiscardUnknown(m)
+func (m *InvoiceHTLC) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceHTLC.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RoutingPolicy proto.InternalMessageInfo
+var xxx_messageInfo_InvoiceHTLC proto.InternalMessageInfo
 
-func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
+func (m *InvoiceHTLC) GetChanId() uint64 {
 	if m != nil {
-		return m.TimeLockDelta
+		return m.ChanId
 	}
 	return 0
 }
 
-func (m *RoutingPolicy) GetMinHtlc() int64 {
+func (m *InvoiceHTLC) GetHtlcIndex() uint64 {
 	if m != nil {
-		return m.MinHtlc
+		return m.HtlcIndex
+	}
+	return 0
+}
+
+func (m *InvoiceHTLC) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
+}
+
+func (m *InvoiceHTLC) GetAcceptHeight() uint32 {
+	if m != nil {
+		return m.AcceptHeight
 	}
 	return 0
 }
-
-func (m *RoutingPolicy) GetFeeBaseMsat() int64 {
-	if m != nil {
-		return m.FeeBaseMsat
-	}
-	return 0
+
+func (m *InvoiceHTLC) GetResolveTime() int64 {
+	if m != nil {
+		return m.ResolveTime
+	}
+	return 0
+}
+
+type AddInvoiceResponse struct {
+	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	// *
+	// A bare-bones invoice for a payment within the Lightning Network.  With the
+	// details of the invoice, the sender has all the data necessary to send a
+	// payment to the recipient.
+	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
+	// *
+	// The "add" index of this invoice. Each newly created invoice will increment
+	// this index making it monotonically increasing. Callers to the
+	// SubscribeInvoices call can use this to instantly get notified of all added
+	// invoices with an add_index greater than this one.
+	AddIndex             uint64   `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
+func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*AddInvoiceResponse) ProtoMessage()    {}
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{112}
+}
+func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
+}
+func (m *AddInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddInvoiceResponse.Marshal(b, m, deterministic)
+}
+func (dst *AddInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddInvoiceResponse.Merge(dst, src)
+}
+func (m *AddInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_AddInvoiceResponse.Size(m)
+}
+func (m *AddInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddInvoiceResponse.DiscardUnknown(m)
 }
 
-func (m *RoutingPolicy) GetFeeRateMilliMsat() int64 {
+var xxx_messageInfo_AddInvoiceResponse proto.InternalMessageInfo
+
+func (m *AddInvoiceResponse) GetRHash() []byte {
 	if m != nil {
-		return m.FeeRateMilliMsat
+		return m.RHash
 	}
-	return 0
+	return nil
 }
 
-func (m *RoutingPolicy) GetDisabled() bool {
+func (m *AddInvoiceResponse) GetPaymentRequest() string {
 	if m != nil {
-		return m.Disabled
+		return m.PaymentRequest
 	}
-	return false
+	return ""
 }
 
-func (m *RoutingPolicy) GetMaxHtlcMsat() uint64 {
+func (m *AddInvoiceResponse) GetAddIndex() uint64 {
 	if m != nil {
-		return m.MaxHtlcMsat
+		return m.AddIndex
 	}
 	return 0
 }
 
-// *
-// A fully authenticated channel along with all its unique attributes.
-// Once an authenticated channel announcement has been processed on the network,
-// then an instance of ChannelEdgeInfo encapsulating the channels attributes is
-// stored. The other portions relevant to routing policy of a channel are stored
-// within a ChannelEdgePolicy for each direction of the channel.
-type ChannelEdge struct {
+type PaymentHash struct {
 	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChannelId            uint64         `protobuf:"varint,1,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
-	ChanPoint            string         `protobuf:"bytes,2,opt,name=chan_point,proto3" json:"chan_point,omitempty"`
-	LastUpdate           uint32         `protobuf:"varint,3,opt,name=last_update,proto3" json:"last_update,omitempty"`
-	Node1Pub             string         `protobuf:"bytes,4,opt,name=node1_pub,proto3" json:"node1_pub,omitempty"`
-	Node2Pub             string         `protobuf:"bytes,5,opt,name=node2_pub,proto3" json:"node2_pub,omitempty"`
-	Capacity             int64          `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	Node1Policy          *RoutingPolicy `protobuf:"bytes,7,opt,name=node1_policy,proto3" json:"node1_policy,omitempty"`
-	Node2Policy          *RoutingPolicy `protobuf:"bytes,8,opt,name=node2_policy,proto3" json:"node2_policy,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	// The hex-encoded payment hash of the invoice to be looked up. The passed
+	// payment hash must be exactly 32 bytes, otherwise an error is returned.
+	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str,proto3" json:"r_hash_str,omitempty"`
+	// / The payment hash of the invoice to be looked up.
+	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelEdge) Reset()         { *m = ChannelEdge{} }
-func (m *ChannelEdge) String() string { return proto.CompactTextString(m) }
-func (*ChannelEdge) ProtoMessage()    {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{74}
+func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
+func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
+func (*PaymentHash) ProtoMessage()    {}
+func (*PaymentHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{113}
 }
-func (m *ChannelEdge) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEdge.Unmarshal(m, b)
+func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
 }
-func (m *ChannelEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEdge.Marshal(b, m, deterministic)
+func (m *PaymentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentHash.Marshal(b, m, deterministic)
 }
-func (dst *ChannelEdge) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEdge.Merge(dst, src)
+func (dst *PaymentHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentHash.Merge(dst, src)
 }
-func (m *ChannelEdge) XXX_Size() int {
-	return xxx_messageInfo_ChannelEdge.Size(m)
+func (m *PaymentHash) XXX_Size() int {
+	return xxx_messageInfo_PaymentHash.Size(m)
 }
-func (m *ChannelEdge) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEdge.DiscardUnknown(m)
+func (m *PaymentHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentHash.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelEdge proto.InternalMessageInfo
+var xxx_messageInfo_PaymentHash proto.InternalMessageInfo
 
-func (m *ChannelEdge) GetChannelId() uint64 {
+func (m *PaymentHash) GetRHashStr() string {
 	if m != nil {
-		return m.ChannelId
+		return m.RHashStr
 	}
-	return 0
+	return ""
 }
 
-func (m *ChannelEdge) GetChanPoint() string {
+func (m *PaymentHash) GetRHash() []byte {
 	if m != nil {
-		return m.ChanPoint
+		return m.RHash
 	}
-	return ""
+	return nil
 }
 
-func (m *ChannelEdge) GetLastUpdate() uint32 {
-	if m != nil {
-		return m.LastUpdate
-	}
-	return 0
+type ListInvoiceRequest struct {
+	// / If set, only unsettled invoices will be returned in the response.
+	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only,proto3" json:"pending_only,omitempty"`
+	// *
+	// The index of an invoice that will be used as either the start or end of a
+	// query to determine which invoices should be returned in the response.
+	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
+	// / The max number of invoices to return in the response to this query.
+	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices,proto3" json:"num_max_invoices,omitempty"`
+	// *
+	// If set, the invoices returned will result from seeking backwards from the
+	// specified index offset. This can be used to paginate backwards.
+	Reversed             bool     `protobuf:"varint,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelEdge) GetNode1Pub() string {
-	if m != nil {
-		return m.Node1Pub
-	}
-	return ""
+func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
+func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
+func (*ListInvoiceRequest) ProtoMessage()    {}
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{114}
+}
+func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
+}
+func (m *ListInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvoiceRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListInvoiceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvoiceRequest.Merge(dst, src)
+}
+func (m *ListInvoiceRequest) XXX_Size() int {
+	return xxx_messageInfo_ListInvoiceRequest.Size(m)
+}
+func (m *ListInvoiceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvoiceRequest.DiscardUnknown(m)
 }
 
-func (m *ChannelEdge) GetNode2Pub() string {
+var xxx_messageInfo_ListInvoiceRequest proto.InternalMessageInfo
+
+func (m *ListInvoiceRequest) GetPendingOnly() bool {
 	if m != nil {
-		return m.Node2Pub
+		return m.PendingOnly
 	}
-	return ""
+	return false
 }
 
-func (m *ChannelEdge) GetCapacity() int64 {
+func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
 	if m != nil {
-		return m.Capacity
+		return m.IndexOffset
 	}
 	return 0
 }
 
-func (m *ChannelEdge) GetNode1Policy() *RoutingPolicy {
+func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
 	if m != nil {
-		return m.Node1Policy
+		return m.NumMaxInvoices
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdge) GetNode2Policy() *RoutingPolicy {
+func (m *ListInvoiceRequest) GetReversed() bool {
 	if m != nil {
-		return m.Node2Policy
+		return m.Reversed
 	}
-	return nil
+	return false
 }
 
-type ChannelGraphRequest struct {
+type ListInvoiceResponse struct {
 	// *
-	// Whether unannounced channels are included in the response or not. If set,
-	// unannounced channels are included. Unannounced channels are both private
-	// channels, and public channels that are not yet announced to the network.
-	IncludeUnannounced   bool     `protobuf:"varint,1,opt,name=include_unannounced,proto3" json:"include_unannounced,omitempty"`
+	// A list of invoices from the time slice of the time series specified in the
+	// request.
+	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
+	// *
+	// The index of the last item in the set of returned invoices. This can be used
+	// to seek further, pagination style.
+	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset,proto3" json:"last_index_offset,omitempty"`
+	// *
+	// The index of the last item in the set of returned invoices. This can be used
+	// to seek backwards, pagination style.
+	FirstIndexOffset     uint64   `protobuf:"varint,3,opt,name=first_index_offset,proto3" json:"first_index_offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChannelGraphRequest) Reset()         { *m = ChannelGraphRequest{} }
-func (m *ChannelGraphRequest) String() string { return proto.CompactTextString(m) }
-func (*ChannelGraphRequest) ProtoMessage()    {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{75}
+func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
+func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
+func (*ListInvoiceResponse) ProtoMessage()    {}
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{115}
 }
-func (m *ChannelGraphRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelGraphRequest.Unmarshal(m, b)
+func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
 }
-func (m *ChannelGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelGraphRequest.Marshal(b, m, deterministic)
+func (m *ListInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListInvoiceResponse.Marshal(b, m, deterministic)
 }
-func (dst *ChannelGraphRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelGraphRequest.Merge(dst, src)
+func (dst *ListInvoiceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListInvoiceResponse.Merge(dst, src)
 }
-func (m *ChannelGraphRequest) XXX_Size() int {
-	return xxx_messageInfo_ChannelGraphRequest.Size(m)
+func (m *ListInvoiceResponse) XXX_Size() int {
+	return xxx_messageInfo_ListInvoiceResponse.Size(m)
 }
-func (m *ChannelGraphRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelGraphRequest.DiscardUnknown(m)
+func (m *ListInvoiceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListInvoiceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChannelGraphRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListInvoiceResponse proto.InternalMessageInfo
 
-func (m *ChannelGraphRequest) GetIncludeUnannounced() bool {
+func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
 	if m != nil {
-		return m.IncludeUnannounced
+		return m.Invoices
 	}
-	return false
-}
-
-// / Returns a new instance of the directed channel graph.
-type ChannelGraph struct {
-	// / The list of `LightningNode`s in this channel graph
-	Nodes []*LightningNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
-	// / The list of `ChannelEdge`s in this channel graph
-	Edges                []*ChannelEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
-}
-
-func (m *ChannelGraph) Reset()         { *m = ChannelGraph{} }
-func (m *ChannelGraph) String() string { return proto.CompactTextString(m) }
-func (*ChannelGraph) ProtoMessage()    {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{76}
-}
-func (m *ChannelGraph) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelGraph.Unmarshal(m, b)
-}
-func (m *ChannelGraph) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelGraph.Marshal(b, m, deterministic)
-}
-func (dst *ChannelGraph) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelGraph.Merge(dst, src)
-}
-func (m *ChannelGraph) XXX_Size() int {
-	return xxx_messageInfo_ChannelGraph.Size(m)
-}
-func (m *ChannelGraph) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelGraph.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_ChannelGraph proto.InternalMessageInfo
-
-func (m *ChannelGraph) GetNodes() []*LightningNode {
+func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
 	if m != nil {
-		return m.Nodes
+		return m.LastIndexOffset
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelGraph) GetEdges() []*ChannelEdge {
+func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
 	if m != nil {
-		return m.Edges
+		return m.FirstIndexOffset
 	}
-	return nil
+	return 0
 }
 
-type ChanInfoRequest struct {
+type InvoiceSubscription struct {
 	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId               uint64   `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	// If specified (non-zero), then we'll first start by sending out
+	// notifications for all added indexes with an add_index greater than this
+	// value. This allows callers to catch up on any events they missed while they
+	// weren't connected to the streaming RPC.
+	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index,proto3" json:"add_index,omitempty"`
+	// *
+	// If specified (non-zero), then we'll first start by sending out
+	// notifications for all settled indexes with an settle_index greater than
+	// this value. This allows callers to catch up on any events they missed while
+	// they weren't connected to the streaming RPC.
+	SettleIndex          uint64   `protobuf:"varint,2,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ChanInfoRequest) Reset()         { *m = ChanInfoRequest{} }
-func (m *ChanInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*ChanInfoRequest) ProtoMessage()    {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{77}
+func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
+func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
+func (*InvoiceSubscription) ProtoMessage()    {}
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{116}
 }
-func (m *ChanInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChanInfoRequest.Unmarshal(m, b)
+func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
 }
-func (m *ChanInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChanInfoRequest.Marshal(b, m, deterministic)
+func (m *InvoiceSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvoiceSubscription.Marshal(b, m, deterministic)
 }
-func (dst *ChanInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChanInfoRequest.Merge(dst, src)
+func (dst *InvoiceSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvoiceSubscription.Merge(dst, src)
 }
-func (m *ChanInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_ChanInfoRequest.Size(m)
+func (m *InvoiceSubscription) XXX_Size() int {
+	return xxx_messageInfo_InvoiceSubscription.Size(m)
 }
-func (m *ChanInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChanInfoRequest.DiscardUnknown(m)
+func (m *InvoiceSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvoiceSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ChanInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_InvoiceSubscription proto.InternalMessageInfo
 
-func (m *ChanInfoRequest) GetChanId() uint64 {
+func (m *InvoiceSubscription) GetAddIndex() uint64 {
 	if m != nil {
-		return m.ChanId
+		return m.AddIndex
 	}
 	return 0
 }
 
-type NetworkInfoRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *NetworkInfoRequest) Reset()         { *m = NetworkInfoRequest{} }
-func (m *NetworkInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*NetworkInfoRequest) ProtoMessage()    {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{78}
-}
-func (m *NetworkInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NetworkInfoRequest.Unmarshal(m, b)
-}
-func (m *NetworkInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NetworkInfoRequest.Marshal(b, m, deterministic)
-}
-func (dst *NetworkInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NetworkInfoRequest.Merge(dst, src)
-}
-func (m *NetworkInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_NetworkInfoRequest.Size(m)
-}
-func (m *NetworkInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NetworkInfoRequest.DiscardUnknown(m)
+func (m *InvoiceSubscription) GetSettleIndex() uint64 {
+	if m != nil {
+		return m.SettleIndex
+	}
+	return 0
 }
 
-var xxx_messageInfo_NetworkInfoRequest proto.InternalMessageInfo
-
-type NetworkInfo struct {
-	GraphDiameter        uint32   `protobuf:"varint,1,opt,name=graph_diameter,proto3" json:"graph_diameter,omitempty"`
-	AvgOutDegree         float64  `protobuf:"fixed64,2,opt,name=avg_out_degree,proto3" json:"avg_out_degree,omitempty"`
-	MaxOutDegree         uint32   `protobuf:"varint,3,opt,name=max_out_degree,proto3" json:"max_out_degree,omitempty"`
-	NumNodes             uint32   `protobuf:"varint,4,opt,name=num_nodes,proto3" json:"num_nodes,omitempty"`
-	NumChannels          uint32   `protobuf:"varint,5,opt,name=num_channels,proto3" json:"num_channels,omitempty"`
-	TotalNetworkCapacity int64    `protobuf:"varint,6,opt,name=total_network_capacity,proto3" json:"total_network_capacity,omitempty"`
-	AvgChannelSize       float64  `protobuf:"fixed64,7,opt,name=avg_channel_size,proto3" json:"avg_channel_size,omitempty"`
-	MinChannelSize       int64    `protobuf:"varint,8,opt,name=min_channel_size,proto3" json:"min_channel_size,omitempty"`
-	MaxChannelSize       int64    `protobuf:"varint,9,opt,name=max_channel_size,proto3" json:"max_channel_size,omitempty"`
+type Payment struct {
+	// / The payment hash
+	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / Deprecated, use value_sat or value_msat.
+	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
+	// / The date of this payment
+	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
+	// / The path this payment took
+	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
+	// / The fee paid for this payment in satoshis
+	Fee int64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	// / The payment preimage
+	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
+	// / The value of the payment in satoshis
+	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat,proto3" json:"value_sat,omitempty"`
+	// / The value of the payment in milli-satoshis
+	ValueMsat            int64    `protobuf:"varint,8,opt,name=value_msat,proto3" json:"value_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NetworkInfo) Reset()         { *m = NetworkInfo{} }
-func (m *NetworkInfo) String() string { return proto.CompactTextString(m) }
-func (*NetworkInfo) ProtoMessage()    {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{79}
+func (m *Payment) Reset()         { *m = Payment{} }
+func (m *Payment) String() string { return proto.CompactTextString(m) }
+func (*Payment) ProtoMessage()    {}
+func (*Payment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{117}
 }
-func (m *NetworkInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NetworkInfo.Unmarshal(m, b)
+func (m *Payment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Payment.Unmarshal(m, b)
 }
-func (m *NetworkInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NetworkInfo.Marshal(b, m, deterministic)
+func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
 }
-func (dst *NetworkInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NetworkInfo.Merge(dst, src)
+func (dst *Payment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Payment.Merge(dst, src)
 }
-func (m *NetworkInfo) XXX_Size() int {
-	return xxx_messageInfo_NetworkInfo.Size(m)
+func (m *Payment) XXX_Size() int {
+	return xxx_messageInfo_Payment.Size(m)
 }
-func (m *NetworkInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NetworkInfo.DiscardUnknown(m)
+func (m *Payment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Payment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NetworkInfo proto.InternalMessageInfo
-
-func (m *NetworkInfo) GetGraphDiameter() uint32 {
-	if m != nil {
-		return m.GraphDiameter
-	}
-	return 0
-}
+var xxx_messageInfo_Payment proto.InternalMessageInfo
 
-func (m *NetworkInfo) GetAvgOutDegree() float64 {
+func (m *Payment) GetPaymentHash() string {
 	if m != nil {
-		return m.AvgOutDegree
+		return m.PaymentHash
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetMaxOutDegree() uint32 {
+// Deprecated: Do not use.
+func (m *Payment) GetValue() int64 {
 	if m != nil {
-		return m.MaxOutDegree
+		return m.Value
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetNumNodes() uint32 {
+func (m *Payment) GetCreationDate() int64 {
 	if m != nil {
-		return m.NumNodes
+		return m.CreationDate
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetNumChannels() uint32 {
+func (m *Payment) GetPath() []string {
 	if m != nil {
-		return m.NumChannels
+		return m.Path
 	}
-	return 0
+	return nil
 }
 
-func (m *NetworkInfo) GetTotalNetworkCapacity() int64 {
+func (m *Payment) GetFee() int64 {
 	if m != nil {
-		return m.TotalNetworkCapacity
+		return m.Fee
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetAvgChannelSize() float64 {
+func (m *Payment) GetPaymentPreimage() string {
 	if m != nil {
-		return m.AvgChannelSize
+		return m.PaymentPreimage
 	}
-	return 0
+	return ""
 }
 
-func (m *NetworkInfo) GetMinChannelSize() int64 {
+func (m *Payment) GetValueSat() int64 {
 	if m != nil {
-		return m.MinChannelSize
+		return m.ValueSat
 	}
 	return 0
 }
 
-func (m *NetworkInfo) GetMaxChannelSize() int64 {
+func (m *Payment) GetValueMsat() int64 {
 	if m != nil {
-		return m.MaxChannelSize
+		return m.ValueMsat
 	}
 	return 0
 }
 
-type StopRequest struct {
+type ListPaymentsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopRequest) Reset()         { *m = StopRequest{} }
-func (m *StopRequest) String() string { return proto.CompactTextString(m) }
-func (*StopRequest) ProtoMessage()    {}
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{80}
+func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
+func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPaymentsRequest) ProtoMessage()    {}
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{118}
 }
-func (m *StopRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StopRequest.Unmarshal(m, b)
+func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
 }
-func (m *StopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StopRequest.Marshal(b, m, deterministic)
+func (m *ListPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPaymentsRequest.Marshal(b, m, deterministic)
 }
-func (dst *StopRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopRequest.Merge(dst, src)
+func (dst *ListPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPaymentsRequest.Merge(dst, src)
 }
-func (m *StopRequest) XXX_Size() int {
-	return xxx_messageInfo_StopRequest.Size(m)
+func (m *ListPaymentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPaymentsRequest.Size(m)
 }
-func (m *StopRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopRequest.DiscardUnknown(m)
+func (m *ListPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPaymentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPaymentsRequest proto.InternalMessageInfo
+
+type ListPaymentsResponse struct {
+	// / The list of payments
+	Payments             []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
+func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPaymentsResponse) ProtoMessage()    {}
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{119}
+}
+func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
+}
+func (m *ListPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPaymentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPaymentsResponse.Merge(dst, src)
+}
+func (m *ListPaymentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPaymentsResponse.Size(m)
+}
+func (m *ListPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPaymentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
+
+func (m *ListPaymentsResponse) GetPayments() []*Payment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
 }
 
-var xxx_messageInfo_StopRequest proto.InternalMessageInfo
-
-type StopResponse struct {
+type DeleteAllPaymentsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StopResponse) Reset()         { *m = StopResponse{} }
-func (m *StopResponse) String() string { return proto.CompactTextString(m) }
-func (*StopResponse) ProtoMessage()    {}
-func (*StopResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{81}
+func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsRequest{} }
+func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{120}
 }
-func (m *StopResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StopResponse.Unmarshal(m, b)
+func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
 }
-func (m *StopResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StopResponse.Marshal(b, m, deterministic)
+func (m *DeleteAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Marshal(b, m, deterministic)
 }
-func (dst *StopResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StopResponse.Merge(dst, src)
+func (dst *DeleteAllPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllPaymentsRequest.Merge(dst, src)
 }
-func (m *StopResponse) XXX_Size() int {
-	return xxx_messageInfo_StopResponse.Size(m)
+func (m *DeleteAllPaymentsRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAllPaymentsRequest.Size(m)
 }
-func (m *StopResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StopResponse.DiscardUnknown(m)
+func (m *DeleteAllPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllPaymentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StopResponse proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAllPaymentsRequest proto.InternalMessageInfo
 
-type GraphTopologySubscription struct {
+type DeleteAllPaymentsResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GraphTopologySubscription) Reset()         { *m = GraphTopologySubscription{} }
-func (m *GraphTopologySubscription) String() string { return proto.CompactTextString(m) }
-func (*GraphTopologySubscription) ProtoMessage()    {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{82}
+func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResponse{} }
+func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{121}
 }
-func (m *GraphTopologySubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphTopologySubscription.Unmarshal(m, b)
+func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
 }
-func (m *GraphTopologySubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphTopologySubscription.Marshal(b, m, deterministic)
+func (m *DeleteAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Marshal(b, m, deterministic)
 }
-func (dst *GraphTopologySubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GraphTopologySubscription.Merge(dst, src)
+func (dst *DeleteAllPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAllPaymentsResponse.Merge(dst, src)
 }
-func (m *GraphTopologySubscription) XXX_Size() int {
-	return xxx_messageInfo_GraphTopologySubscription.Size(m)
+func (m *DeleteAllPaymentsResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAllPaymentsResponse.Size(m)
 }
-func (m *GraphTopologySubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_GraphTopologySubscription.DiscardUnknown(m)
+func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAllPaymentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GraphTopologySubscription proto.InternalMessageInfo
+var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
 
-type GraphTopologyUpdate struct {
-	NodeUpdates          []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates,proto3" json:"node_updates,omitempty"`
-	ChannelUpdates       []*ChannelEdgeUpdate   `protobuf:"bytes,2,rep,name=channel_updates,json=channelUpdates,proto3" json:"channel_updates,omitempty"`
-	ClosedChans          []*ClosedChannelUpdate `protobuf:"bytes,3,rep,name=closed_chans,json=closedChans,proto3" json:"closed_chans,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+type ExportPaymentProofRequest struct {
+	// / The hex-encoded hash of the settled payment to export the proof of.
+	PaymentHash          string   `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GraphTopologyUpdate) Reset()         { *m = GraphTopologyUpdate{} }
-func (m *GraphTopologyUpdate) String() string { return proto.CompactTextString(m) }
-func (*GraphTopologyUpdate) ProtoMessage()    {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{83}
-}
-func (m *GraphTopologyUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GraphTopologyUpdate.Unmarshal(m, b)
+func (m *ExportPaymentProofRequest) Reset()         { *m = ExportPaymentProofRequest{} }
+func (m *ExportPaymentProofRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportPaymentProofRequest) ProtoMessage()    {}
+func (*ExportPaymentProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{122}
 }
-func (m *GraphTopologyUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GraphTopologyUpdate.Marshal(b, m, deterministic)
+func (m *ExportPaymentProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportPaymentProofRequest.Unmarshal(m, b)
 }
-func (dst *GraphTopologyUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GraphTopologyUpdate.Merge(dst, src)
+func (m *ExportPaymentProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportPaymentProofRequest.Marshal(b, m, deterministic)
 }
-func (m *GraphTopologyUpdate) XXX_Size() int {
-	return xxx_messageInfo_GraphTopologyUpdate.Size(m)
+func (dst *ExportPaymentProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportPaymentProofRequest.Merge(dst, src)
 }
-func (m *GraphTopologyUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_GraphTopologyUpdate.DiscardUnknown(m)
+func (m *ExportPaymentProofRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportPaymentProofRequest.Size(m)
 }
-
-var xxx_messageInfo_GraphTopologyUpdate proto.InternalMessageInfo
-
-func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
-	if m != nil {
-		return m.NodeUpdates
-	}
-	return nil
+func (m *ExportPaymentProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportPaymentProofRequest.DiscardUnknown(m)
 }
 
-func (m *GraphTopologyUpdate) GetChannelUpdates() []*ChannelEdgeUpdate {
-	if m != nil {
-		return m.ChannelUpdates
-	}
-	return nil
-}
+var xxx_messageInfo_ExportPaymentProofRequest proto.InternalMessageInfo
 
-func (m *GraphTopologyUpdate) GetClosedChans() []*ClosedChannelUpdate {
+func (m *ExportPaymentProofRequest) GetPaymentHash() string {
 	if m != nil {
-		return m.ClosedChans
+		return m.PaymentHash
 	}
-	return nil
+	return ""
 }
 
-type NodeUpdate struct {
-	Addresses            []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	IdentityKey          string   `protobuf:"bytes,2,opt,name=identity_key,json=identityKey,proto3" json:"identity_key,omitempty"`
-	GlobalFeatures       []byte   `protobuf:"bytes,3,opt,name=global_features,json=globalFeatures,proto3" json:"global_features,omitempty"`
-	Alias                string   `protobuf:"bytes,4,opt,name=alias,proto3" json:"alias,omitempty"`
+type PaymentProof struct {
+	// / The hex-encoded payment hash, committed to by the payment request.
+	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The hex-encoded preimage of the payment hash, revealed by the payee.
+	PaymentPreimage string `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
+	// / The payment request, signed by the payee, the payment was made to.
+	PaymentRequest string `protobuf:"bytes,3,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
+	// / The public key of the payee that signed the payment request.
+	Payee string `protobuf:"bytes,4,opt,name=payee,proto3" json:"payee,omitempty"`
+	// *
+	// The path the payment took, ending with the payee. The path isn't signed by
+	// the payee, so it's only checked for consistency with the payment request.
+	Path []string `protobuf:"bytes,5,rep,name=path,proto3" json:"path,omitempty"`
+	// / The value of the payment in milli-satoshis.
+	ValueMsat int64 `protobuf:"varint,6,opt,name=value_msat,proto3" json:"value_msat,omitempty"`
+	// / The fee paid for the payment in milli-satoshis.
+	FeeMsat int64 `protobuf:"varint,7,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+	// / The unix timestamp of the payment.
+	CreationDate         int64    `protobuf:"varint,8,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeUpdate) Reset()         { *m = NodeUpdate{} }
-func (m *NodeUpdate) String() string { return proto.CompactTextString(m) }
-func (*NodeUpdate) ProtoMessage()    {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{84}
+func (m *PaymentProof) Reset()         { *m = PaymentProof{} }
+func (m *PaymentProof) String() string { return proto.CompactTextString(m) }
+func (*PaymentProof) ProtoMessage()    {}
+func (*PaymentProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{123}
 }
-func (m *NodeUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_NodeUpdate.Unmarshal(m, b)
+func (m *PaymentProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaymentProof.Unmarshal(m, b)
 }
-func (m *NodeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_NodeUpdate.Marshal(b, m, deterministic)
+func (m *PaymentProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaymentProof.Marshal(b, m, deterministic)
 }
-func (dst *NodeUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeUpdate.Merge(dst, src)
+func (dst *PaymentProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentProof.Merge(dst, src)
 }
-func (m *NodeUpdate) XXX_Size() int {
-	return xxx_messageInfo_NodeUpdate.Size(m)
+func (m *PaymentProof) XXX_Size() int {
+	return xxx_messageInfo_PaymentProof.Size(m)
 }
-func (m *NodeUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeUpdate.DiscardUnknown(m)
+func (m *PaymentProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentProof.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeUpdate proto.InternalMessageInfo
+var xxx_messageInfo_PaymentProof proto.InternalMessageInfo
 
-func (m *NodeUpdate) GetAddresses() []string {
+func (m *PaymentProof) GetPaymentHash() string {
 	if m != nil {
-		return m.Addresses
+		return m.PaymentHash
 	}
-	return nil
+	return ""
 }
 
-func (m *NodeUpdate) GetIdentityKey() string {
+func (m *PaymentProof) GetPaymentPreimage() string {
 	if m != nil {
-		return m.IdentityKey
+		return m.PaymentPreimage
 	}
 	return ""
 }
 
-func (m *NodeUpdate) GetGlobalFeatures() []byte {
+func (m *PaymentProof) GetPaymentRequest() string {
 	if m != nil {
-		return m.GlobalFeatures
+		return m.PaymentRequest
 	}
-	return nil
+	return ""
 }
 
-func (m *NodeUpdate) GetAlias() string {
+func (m *PaymentProof) GetPayee() string {
 	if m != nil {
-		return m.Alias
+		return m.Payee
 	}
 	return ""
 }
 
-type ChannelEdgeUpdate struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId               uint64         `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	ChanPoint            *ChannelPoint  `protobuf:"bytes,2,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
-	Capacity             int64          `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	RoutingPolicy        *RoutingPolicy `protobuf:"bytes,4,opt,name=routing_policy,json=routingPolicy,proto3" json:"routing_policy,omitempty"`
-	AdvertisingNode      string         `protobuf:"bytes,5,opt,name=advertising_node,json=advertisingNode,proto3" json:"advertising_node,omitempty"`
-	ConnectingNode       string         `protobuf:"bytes,6,opt,name=connecting_node,json=connectingNode,proto3" json:"connecting_node,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
-}
-
-func (m *ChannelEdgeUpdate) Reset()         { *m = ChannelEdgeUpdate{} }
-func (m *ChannelEdgeUpdate) String() string { return proto.CompactTextString(m) }
-func (*ChannelEdgeUpdate) ProtoMessage()    {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{85}
-}
-func (m *ChannelEdgeUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelEdgeUpdate.Unmarshal(m, b)
-}
-func (m *ChannelEdgeUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelEdgeUpdate.Marshal(b, m, deterministic)
-}
-func (dst *ChannelEdgeUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelEdgeUpdate.Merge(dst, src)
-}
-func (m *ChannelEdgeUpdate) XXX_Size() int {
-	return xxx_messageInfo_ChannelEdgeUpdate.Size(m)
-}
-func (m *ChannelEdgeUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelEdgeUpdate.DiscardUnknown(m)
+func (m *PaymentProof) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
 }
 
-var xxx_messageInfo_ChannelEdgeUpdate proto.InternalMessageInfo
-
-func (m *ChannelEdgeUpdate) GetChanId() uint64 {
+func (m *PaymentProof) GetValueMsat() int64 {
 	if m != nil {
-		return m.ChanId
+		return m.ValueMsat
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetChanPoint() *ChannelPoint {
+func (m *PaymentProof) GetFeeMsat() int64 {
 	if m != nil {
-		return m.ChanPoint
+		return m.FeeMsat
 	}
-	return nil
+	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetCapacity() int64 {
+func (m *PaymentProof) GetCreationDate() int64 {
 	if m != nil {
-		return m.Capacity
+		return m.CreationDate
 	}
 	return 0
 }
 
-func (m *ChannelEdgeUpdate) GetRoutingPolicy() *RoutingPolicy {
+type VerifyPaymentProofResponse struct {
+	// / Whether the payment proof is valid.
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// / The public key of the payee, if the proof is valid.
+	Payee string `protobuf:"bytes,2,opt,name=payee,proto3" json:"payee,omitempty"`
+	// / The reason the payment proof is invalid, if it isn't.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyPaymentProofResponse) Reset()         { *m = VerifyPaymentProofResponse{} }
+func (m *VerifyPaymentProofResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyPaymentProofResponse) ProtoMessage()    {}
+func (*VerifyPaymentProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{124}
+}
+func (m *VerifyPaymentProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyPaymentProofResponse.Unmarshal(m, b)
+}
+func (m *VerifyPaymentProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyPaymentProofResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifyPaymentProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyPaymentProofResponse.Merge(dst, src)
+}
+func (m *VerifyPaymentProofResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyPaymentProofResponse.Size(m)
+}
+func (m *VerifyPaymentProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyPaymentProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyPaymentProofResponse proto.InternalMessageInfo
+
+func (m *VerifyPaymentProofResponse) GetValid() bool {
 	if m != nil {
-		return m.RoutingPolicy
+		return m.Valid
 	}
-	return nil
+	return false
 }
 
-func (m *ChannelEdgeUpdate) GetAdvertisingNode() string {
+func (m *VerifyPaymentProofResponse) GetPayee() string {
 	if m != nil {
-		return m.AdvertisingNode
+		return m.Payee
 	}
 	return ""
 }
 
-func (m *ChannelEdgeUpdate) GetConnectingNode() string {
+func (m *VerifyPaymentProofResponse) GetError() string {
 	if m != nil {
-		return m.ConnectingNode
+		return m.Error
 	}
 	return ""
 }
 
-type ClosedChannelUpdate struct {
-	// *
-	// The unique channel ID for the channel. The first 3 bytes are the block
-	// height, the next 3 the index within the block, and the last 2 bytes are the
-	// output index for the channel.
-	ChanId               uint64        `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	Capacity             int64         `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
-	ClosedHeight         uint32        `protobuf:"varint,3,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
-	ChanPoint            *ChannelPoint `protobuf:"bytes,4,opt,name=chan_point,json=chanPoint,proto3" json:"chan_point,omitempty"`
+type AbandonChannelRequest struct {
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *ClosedChannelUpdate) Reset()         { *m = ClosedChannelUpdate{} }
-func (m *ClosedChannelUpdate) String() string { return proto.CompactTextString(m) }
-func (*ClosedChannelUpdate) ProtoMessage()    {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{86}
-}
-func (m *ClosedChannelUpdate) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ClosedChannelUpdate.Unmarshal(m, b)
-}
-func (m *ClosedChannelUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ClosedChannelUpdate.Marshal(b, m, deterministic)
-}
-func (dst *ClosedChannelUpdate) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClosedChannelUpdate.Merge(dst, src)
+func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
+func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
+func (*AbandonChannelRequest) ProtoMessage()    {}
+func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{125}
 }
-func (m *ClosedChannelUpdate) XXX_Size() int {
-	return xxx_messageInfo_ClosedChannelUpdate.Size(m)
+func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
 }
-func (m *ClosedChannelUpdate) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClosedChannelUpdate.DiscardUnknown(m)
+func (m *AbandonChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonChannelRequest.Marshal(b, m, deterministic)
 }
-
-var xxx_messageInfo_ClosedChannelUpdate proto.InternalMessageInfo
-
-func (m *ClosedChannelUpdate) GetChanId() uint64 {
-	if m != nil {
-		return m.ChanId
-	}
-	return 0
+func (dst *AbandonChannelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonChannelRequest.Merge(dst, src)
 }
-
-func (m *ClosedChannelUpdate) GetCapacity() int64 {
-	if m != nil {
-		return m.Capacity
-	}
-	return 0
+func (m *AbandonChannelRequest) XXX_Size() int {
+	return xxx_messageInfo_AbandonChannelRequest.Size(m)
 }
-
-func (m *ClosedChannelUpdate) GetClosedHeight() uint32 {
-	if m != nil {
-		return m.ClosedHeight
-	}
-	return 0
+func (m *AbandonChannelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonChannelRequest.DiscardUnknown(m)
 }
 
-func (m *ClosedChannelUpdate) GetChanPoint() *ChannelPoint {
+var xxx_messageInfo_AbandonChannelRequest proto.InternalMessageInfo
+
+func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.ChanPoint
+		return m.ChannelPoint
 	}
 	return nil
 }
 
-type HopHint struct {
-	// / The public key of the node at the start of the channel.
-	NodeId string `protobuf:"bytes,1,opt,name=node_id,proto3" json:"node_id,omitempty"`
-	// / The unique identifier of the channel.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
-	// / The base fee of the channel denominated in millisatoshis.
-	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,proto3" json:"fee_base_msat,omitempty"`
-	// *
-	// The fee rate of the channel for sending one satoshi across it denominated in
-	// millionths of a satoshi.
-	FeeProportionalMillionths uint32 `protobuf:"varint,4,opt,name=fee_proportional_millionths,proto3" json:"fee_proportional_millionths,omitempty"`
-	// / The time-lock delta of the channel.
-	CltvExpiryDelta      uint32   `protobuf:"varint,5,opt,name=cltv_expiry_delta,proto3" json:"cltv_expiry_delta,omitempty"`
+type AbandonChannelResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HopHint) Reset()         { *m = HopHint{} }
-func (m *HopHint) String() string { return proto.CompactTextString(m) }
-func (*HopHint) ProtoMessage()    {}
-func (*HopHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{87}
+func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{} }
+func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
+func (*AbandonChannelResponse) ProtoMessage()    {}
+func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{126}
 }
-func (m *HopHint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HopHint.Unmarshal(m, b)
+func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
 }
-func (m *HopHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HopHint.Marshal(b, m, deterministic)
+func (m *AbandonChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AbandonChannelResponse.Marshal(b, m, deterministic)
 }
-func (dst *HopHint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HopHint.Merge(dst, src)
+func (dst *AbandonChannelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AbandonChannelResponse.Merge(dst, src)
 }
-func (m *HopHint) XXX_Size() int {
-	return xxx_messageInfo_HopHint.Size(m)
+func (m *AbandonChannelResponse) XXX_Size() int {
+	return xxx_messageInfo_AbandonChannelResponse.Size(m)
 }
-func (m *HopHint) XXX_DiscardUnknown() {
-	xxx_messageInfo_HopHint.DiscardUnknown(m)
+func (m *AbandonChannelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AbandonChannelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HopHint proto.InternalMessageInfo
+var xxx_messageInfo_AbandonChannelResponse proto.InternalMessageInfo
 
-func (m *HopHint) GetNodeId() string {
-	if m != nil {
-		return m.NodeId
-	}
-	return ""
+type DebugLevelRequest struct {
+	Show                 bool     `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
+	LevelSpec            string   `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HopHint) GetChanId() uint64 {
-	if m != nil {
-		return m.ChanId
-	}
-	return 0
+func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
+func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*DebugLevelRequest) ProtoMessage()    {}
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{127}
 }
-
-func (m *HopHint) GetFeeBaseMsat() uint32 {
-	if m != nil {
-		return m.FeeBaseMsat
-	}
-	return 0
+func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
+}
+func (m *DebugLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DebugLevelRequest.Marshal(b, m, deterministic)
+}
+func (dst *DebugLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugLevelRequest.Merge(dst, src)
+}
+func (m *DebugLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_DebugLevelRequest.Size(m)
+}
+func (m *DebugLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugLevelRequest.DiscardUnknown(m)
 }
 
-func (m *HopHint) GetFeeProportionalMillionths() uint32 {
+var xxx_messageInfo_DebugLevelRequest proto.InternalMessageInfo
+
+func (m *DebugLevelRequest) GetShow() bool {
 	if m != nil {
-		return m.FeeProportionalMillionths
+		return m.Show
 	}
-	return 0
+	return false
 }
 
-func (m *HopHint) GetCltvExpiryDelta() uint32 {
+func (m *DebugLevelRequest) GetLevelSpec() string {
 	if m != nil {
-		return m.CltvExpiryDelta
+		return m.LevelSpec
 	}
-	return 0
+	return ""
 }
 
-type RouteHint struct {
-	// *
-	// A list of hop hints that when chained together can assist in reaching a
-	// specific destination.
-	HopHints             []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,proto3" json:"hop_hints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type DebugLevelResponse struct {
+	SubSystems           string   `protobuf:"bytes,1,opt,name=sub_systems,proto3" json:"sub_systems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RouteHint) Reset()         { *m = RouteHint{} }
-func (m *RouteHint) String() string { return proto.CompactTextString(m) }
-func (*RouteHint) ProtoMessage()    {}
-func (*RouteHint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{88}
+func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
+func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
+func (*DebugLevelResponse) ProtoMessage()    {}
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{128}
 }
-func (m *RouteHint) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RouteHint.Unmarshal(m, b)
+func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
 }
-func (m *RouteHint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RouteHint.Marshal(b, m, deterministic)
+func (m *DebugLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DebugLevelResponse.Marshal(b, m, deterministic)
 }
-func (dst *RouteHint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RouteHint.Merge(dst, src)
+func (dst *DebugLevelResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DebugLevelResponse.Merge(dst, src)
 }
-func (m *RouteHint) XXX_Size() int {
-	return xxx_messageInfo_RouteHint.Size(m)
+func (m *DebugLevelResponse) XXX_Size() int {
+	return xxx_messageInfo_DebugLevelResponse.Size(m)
 }
-func (m *RouteHint) XXX_DiscardUnknown() {
-	xxx_messageInfo_RouteHint.DiscardUnknown(m)
+func (m *DebugLevelResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DebugLevelResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RouteHint proto.InternalMessageInfo
+var xxx_messageInfo_DebugLevelResponse proto.InternalMessageInfo
 
-func (m *RouteHint) GetHopHints() []*HopHint {
+func (m *DebugLevelResponse) GetSubSystems() string {
 	if m != nil {
-		return m.HopHints
+		return m.SubSystems
 	}
-	return nil
+	return ""
 }
 
-type Invoice struct {
-	// *
-	// An optional memo to attach along with the invoice. Used for record keeping
-	// purposes for the invoice's creator, and will also be set in the description
-	// field of the encoded payment request if the description_hash field is not
-	// being used.
-	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	// * Deprecated. An optional cryptographic receipt of payment which is not
-	// implemented.
-	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"` // Deprecated: Do not use.
-	// *
-	// The hex-encoded preimage (32 byte) which will allow settling an incoming
-	// HTLC payable to this preimage
-	RPreimage []byte `protobuf:"bytes,3,opt,name=r_preimage,proto3" json:"r_preimage,omitempty"`
-	// / The hash of the preimage
-	RHash []byte `protobuf:"bytes,4,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	// / The value of this invoice in satoshis
-	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
-	// / Whether this invoice has been fulfilled
-	Settled bool `protobuf:"varint,6,opt,name=settled,proto3" json:"settled,omitempty"` // Deprecated: Do not use.
-	// / When this invoice was created
-	CreationDate int64 `protobuf:"varint,7,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
-	// / When this invoice was settled
-	SettleDate int64 `protobuf:"varint,8,opt,name=settle_date,proto3" json:"settle_date,omitempty"`
-	// *
-	// A bare-bones invoice for a payment within the Lightning Network.  With the
-	// details of the invoice, the sender has all the data necessary to send a
-	// payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,9,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
-	// *
-	// Hash (SHA-256) of a description of the payment. Used if the description of
-	// payment (memo) is too long to naturally fit within the description field
-	// of an encoded payment request.
-	DescriptionHash []byte `protobuf:"bytes,10,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	// / Payment request expiry time in seconds. Default is 3600 (1 hour).
-	Expiry int64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	// / Fallback on-chain address.
-	FallbackAddr string `protobuf:"bytes,12,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
-	// / Delta to use for the time-lock of the CLTV extended to the final hop.
-	CltvExpiry uint64 `protobuf:"varint,13,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
-	// *
-	// Route hints that can each be individually used to assist in reaching the
-	// invoice's destination.
-	RouteHints []*RouteHint `protobuf:"bytes,14,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
-	// / Whether this invoice should include routing hints for private channels.
-	Private bool `protobuf:"varint,15,opt,name=private,proto3" json:"private,omitempty"`
+type VerifyChanStateRequest struct {
 	// *
-	// The "add" index of this invoice. Each newly created invoice will increment
-	// this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all added
-	// invoices with an add_index greater than this one.
-	AddIndex uint64 `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
-	// *
-	// The "settle" index of this invoice. Each newly settled invoice will
-	// increment this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all
-	// settled invoices with an settle_index greater than this one.
-	SettleIndex uint64 `protobuf:"varint,17,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
-	// / Deprecated, use amt_paid_sat or amt_paid_msat.
-	AmtPaid int64 `protobuf:"varint,18,opt,name=amt_paid,proto3" json:"amt_paid,omitempty"` // Deprecated: Do not use.
-	// *
-	// The amount that was accepted for this invoice, in satoshis. This will ONLY
-	// be set if this invoice has been settled. We provide this field as if the
-	// invoice was created with a zero value, then we need to record what amount
-	// was ultimately accepted. Additionally, it's possible that the sender paid
-	// MORE that was specified in the original invoice. So we'll record that here
-	// as well.
-	AmtPaidSat int64 `protobuf:"varint,19,opt,name=amt_paid_sat,proto3" json:"amt_paid_sat,omitempty"`
-	// *
-	// The amount that was accepted for this invoice, in millisatoshis. This will
-	// ONLY be set if this invoice has been settled. We provide this field as if
-	// the invoice was created with a zero value, then we need to record what
-	// amount was ultimately accepted. Additionally, it's possible that the sender
-	// paid MORE that was specified in the original invoice. So we'll record that
-	// here as well.
-	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat,proto3" json:"amt_paid_msat,omitempty"`
-	// *
-	// The state the invoice is in.
-	State                Invoice_InvoiceState `protobuf:"varint,21,opt,name=state,proto3,enum=lnrpc.Invoice_InvoiceState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	// The channel to verify the state of. If not set, all of our open channels
+	// are verified.
+	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *Invoice) Reset()         { *m = Invoice{} }
-func (m *Invoice) String() string { return proto.CompactTextString(m) }
-func (*Invoice) ProtoMessage()    {}
-func (*Invoice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{89}
+func (m *VerifyChanStateRequest) Reset()         { *m = VerifyChanStateRequest{} }
+func (m *VerifyChanStateRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyChanStateRequest) ProtoMessage()    {}
+func (*VerifyChanStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{129}
 }
-func (m *Invoice) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Invoice.Unmarshal(m, b)
+func (m *VerifyChanStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyChanStateRequest.Unmarshal(m, b)
 }
-func (m *Invoice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Invoice.Marshal(b, m, deterministic)
+func (m *VerifyChanStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyChanStateRequest.Marshal(b, m, deterministic)
 }
-func (dst *Invoice) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Invoice.Merge(dst, src)
+func (dst *VerifyChanStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyChanStateRequest.Merge(dst, src)
 }
-func (m *Invoice) XXX_Size() int {
-	return xxx_messageInfo_Invoice.Size(m)
+func (m *VerifyChanStateRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyChanStateRequest.Size(m)
 }
-func (m *Invoice) XXX_DiscardUnknown() {
-	xxx_messageInfo_Invoice.DiscardUnknown(m)
+func (m *VerifyChanStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyChanStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Invoice proto.InternalMessageInfo
+var xxx_messageInfo_VerifyChanStateRequest proto.InternalMessageInfo
 
-func (m *Invoice) GetMemo() string {
+func (m *VerifyChanStateRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
-		return m.Memo
+		return m.ChannelPoint
 	}
-	return ""
+	return nil
 }
 
-// Deprecated: Do not use.
-func (m *Invoice) GetReceipt() []byte {
-	if m != nil {
-		return m.Receipt
-	}
-	return nil
+type ChanStateVerification struct {
+	// / The channel point of the verified channel.
+	ChannelPoint string `protobuf:"bytes,1,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / The number of revocation secrets of the remote party verified.
+	NumSecrets uint64 `protobuf:"varint,2,opt,name=num_secrets,proto3" json:"num_secrets,omitempty"`
+	// / The inconsistency found in the state of the channel, if any.
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Invoice) GetRPreimage() []byte {
-	if m != nil {
-		return m.RPreimage
-	}
-	return nil
+func (m *ChanStateVerification) Reset()         { *m = ChanStateVerification{} }
+func (m *ChanStateVerification) String() string { return proto.CompactTextString(m) }
+func (*ChanStateVerification) ProtoMessage()    {}
+func (*ChanStateVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{130}
+}
+func (m *ChanStateVerification) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChanStateVerification.Unmarshal(m, b)
+}
+func (m *ChanStateVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChanStateVerification.Marshal(b, m, deterministic)
+}
+func (dst *ChanStateVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChanStateVerification.Merge(dst, src)
+}
+func (m *ChanStateVerification) XXX_Size() int {
+	return xxx_messageInfo_ChanStateVerification.Size(m)
+}
+func (m *ChanStateVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChanStateVerification.DiscardUnknown(m)
 }
 
-func (m *Invoice) GetRHash() []byte {
+var xxx_messageInfo_ChanStateVerification proto.InternalMessageInfo
+
+func (m *ChanStateVerification) GetChannelPoint() string {
 	if m != nil {
-		return m.RHash
+		return m.ChannelPoint
 	}
-	return nil
+	return ""
 }
 
-func (m *Invoice) GetValue() int64 {
+func (m *ChanStateVerification) GetNumSecrets() uint64 {
 	if m != nil {
-		return m.Value
+		return m.NumSecrets
 	}
 	return 0
 }
 
-// Deprecated: Do not use.
-func (m *Invoice) GetSettled() bool {
+func (m *ChanStateVerification) GetError() string {
 	if m != nil {
-		return m.Settled
+		return m.Error
 	}
-	return false
+	return ""
 }
 
-func (m *Invoice) GetCreationDate() int64 {
-	if m != nil {
-		return m.CreationDate
-	}
-	return 0
+type VerifyChanStateResponse struct {
+	// / The verification result of each channel.
+	Channels             []*ChanStateVerification `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *Invoice) GetSettleDate() int64 {
+func (m *VerifyChanStateResponse) Reset()         { *m = VerifyChanStateResponse{} }
+func (m *VerifyChanStateResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyChanStateResponse) ProtoMessage()    {}
+func (*VerifyChanStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{131}
+}
+func (m *VerifyChanStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyChanStateResponse.Unmarshal(m, b)
+}
+func (m *VerifyChanStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyChanStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *VerifyChanStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyChanStateResponse.Merge(dst, src)
+}
+func (m *VerifyChanStateResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyChanStateResponse.Size(m)
+}
+func (m *VerifyChanStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyChanStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyChanStateResponse proto.InternalMessageInfo
+
+func (m *VerifyChanStateResponse) GetChannels() []*ChanStateVerification {
 	if m != nil {
-		return m.SettleDate
+		return m.Channels
 	}
-	return 0
+	return nil
 }
 
-func (m *Invoice) GetPaymentRequest() string {
+type PayReqString struct {
+	// / The payment request string to be decoded
+	PayReq               string   `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayReqString) Reset()         { *m = PayReqString{} }
+func (m *PayReqString) String() string { return proto.CompactTextString(m) }
+func (*PayReqString) ProtoMessage()    {}
+func (*PayReqString) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{132}
+}
+func (m *PayReqString) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
+}
+func (m *PayReqString) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayReqString.Marshal(b, m, deterministic)
+}
+func (dst *PayReqString) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayReqString.Merge(dst, src)
+}
+func (m *PayReqString) XXX_Size() int {
+	return xxx_messageInfo_PayReqString.Size(m)
+}
+func (m *PayReqString) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayReqString.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayReqString proto.InternalMessageInfo
+
+func (m *PayReqString) GetPayReq() string {
 	if m != nil {
-		return m.PaymentRequest
+		return m.PayReq
 	}
 	return ""
 }
 
-func (m *Invoice) GetDescriptionHash() []byte {
-	if m != nil {
-		return m.DescriptionHash
-	}
-	return nil
+type PayReq struct {
+	Destination          string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	PaymentHash          string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	NumSatoshis          int64        `protobuf:"varint,3,opt,name=num_satoshis,proto3" json:"num_satoshis,omitempty"`
+	Timestamp            int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Expiry               int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	Description          string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	DescriptionHash      string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
+	FallbackAddr         string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
+	CltvExpiry           int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
+	RouteHints           []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *Invoice) GetExpiry() int64 {
-	if m != nil {
-		return m.Expiry
-	}
-	return 0
+func (m *PayReq) Reset()         { *m = PayReq{} }
+func (m *PayReq) String() string { return proto.CompactTextString(m) }
+func (*PayReq) ProtoMessage()    {}
+func (*PayReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{133}
+}
+func (m *PayReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayReq.Unmarshal(m, b)
+}
+func (m *PayReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayReq.Marshal(b, m, deterministic)
+}
+func (dst *PayReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayReq.Merge(dst, src)
+}
+func (m *PayReq) XXX_Size() int {
+	return xxx_messageInfo_PayReq.Size(m)
+}
+func (m *PayReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayReq.DiscardUnknown(m)
 }
 
-func (m *Invoice) GetFallbackAddr() string {
+var xxx_messageInfo_PayReq proto.InternalMessageInfo
+
+func (m *PayReq) GetDestination() string {
 	if m != nil {
-		return m.FallbackAddr
+		return m.Destination
 	}
 	return ""
 }
 
-func (m *Invoice) GetCltvExpiry() uint64 {
+func (m *PayReq) GetPaymentHash() string {
 	if m != nil {
-		return m.CltvExpiry
+		return m.PaymentHash
 	}
-	return 0
+	return ""
 }
 
-func (m *Invoice) GetRouteHints() []*RouteHint {
+func (m *PayReq) GetNumSatoshis() int64 {
 	if m != nil {
-		return m.RouteHints
+		return m.NumSatoshis
 	}
-	return nil
+	return 0
 }
 
-func (m *Invoice) GetPrivate() bool {
+func (m *PayReq) GetTimestamp() int64 {
 	if m != nil {
-		return m.Private
+		return m.Timestamp
 	}
-	return false
+	return 0
 }
 
-func (m *Invoice) GetAddIndex() uint64 {
+func (m *PayReq) GetExpiry() int64 {
 	if m != nil {
-		return m.AddIndex
+		return m.Expiry
 	}
 	return 0
 }
 
-func (m *Invoice) GetSettleIndex() uint64 {
+func (m *PayReq) GetDescription() string {
 	if m != nil {
-		return m.SettleIndex
+		return m.Description
 	}
-	return 0
+	return ""
 }
 
-// Deprecated: Do not use.
-func (m *Invoice) GetAmtPaid() int64 {
+func (m *PayReq) GetDescriptionHash() string {
 	if m != nil {
-		return m.AmtPaid
+		return m.DescriptionHash
 	}
-	return 0
+	return ""
 }
 
-func (m *Invoice) GetAmtPaidSat() int64 {
+func (m *PayReq) GetFallbackAddr() string {
 	if m != nil {
-		return m.AmtPaidSat
+		return m.FallbackAddr
 	}
-	return 0
+	return ""
 }
 
-func (m *Invoice) GetAmtPaidMsat() int64 {
+func (m *PayReq) GetCltvExpiry() int64 {
 	if m != nil {
-		return m.AmtPaidMsat
+		return m.CltvExpiry
 	}
 	return 0
 }
 
-func (m *Invoice) GetState() Invoice_InvoiceState {
+func (m *PayReq) GetRouteHints() []*RouteHint {
 	if m != nil {
-		return m.State
+		return m.RouteHints
 	}
-	return Invoice_OPEN
+	return nil
 }
 
-type AddInvoiceResponse struct {
-	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	// *
-	// A bare-bones invoice for a payment within the Lightning Network.  With the
-	// details of the invoice, the sender has all the data necessary to send a
-	// payment to the recipient.
-	PaymentRequest string `protobuf:"bytes,2,opt,name=payment_request,proto3" json:"payment_request,omitempty"`
-	// *
-	// The "add" index of this invoice. Each newly created invoice will increment
-	// this index making it monotonically increasing. Callers to the
-	// SubscribeInvoices call can use this to instantly get notified of all added
-	// invoices with an add_index greater than this one.
-	AddIndex             uint64   `protobuf:"varint,16,opt,name=add_index,proto3" json:"add_index,omitempty"`
+type FeeReportRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
+func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
+func (*FeeReportRequest) ProtoMessage()    {}
+func (*FeeReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{134}
+}
+func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
+}
+func (m *FeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeReportRequest.Marshal(b, m, deterministic)
+}
+func (dst *FeeReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReportRequest.Merge(dst, src)
+}
+func (m *FeeReportRequest) XXX_Size() int {
+	return xxx_messageInfo_FeeReportRequest.Size(m)
+}
+func (m *FeeReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeReportRequest proto.InternalMessageInfo
+
+type ChannelFeeReport struct {
+	// / The channel that this fee report belongs to.
+	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	// / The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
+	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
+	// / The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
+	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddInvoiceResponse) Reset()         { *m = AddInvoiceResponse{} }
-func (m *AddInvoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*AddInvoiceResponse) ProtoMessage()    {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{90}
+func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
+func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
+func (*ChannelFeeReport) ProtoMessage()    {}
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{135}
 }
-func (m *AddInvoiceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddInvoiceResponse.Unmarshal(m, b)
+func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
 }
-func (m *AddInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddInvoiceResponse.Marshal(b, m, deterministic)
+func (m *ChannelFeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelFeeReport.Marshal(b, m, deterministic)
 }
-func (dst *AddInvoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddInvoiceResponse.Merge(dst, src)
+func (dst *ChannelFeeReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelFeeReport.Merge(dst, src)
 }
-func (m *AddInvoiceResponse) XXX_Size() int {
-	return xxx_messageInfo_AddInvoiceResponse.Size(m)
+func (m *ChannelFeeReport) XXX_Size() int {
+	return xxx_messageInfo_ChannelFeeReport.Size(m)
 }
-func (m *AddInvoiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddInvoiceResponse.DiscardUnknown(m)
+func (m *ChannelFeeReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelFeeReport.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddInvoiceResponse proto.InternalMessageInfo
-
-func (m *AddInvoiceResponse) GetRHash() []byte {
-	if m != nil {
-		return m.RHash
-	}
-	return nil
-}
+var xxx_messageInfo_ChannelFeeReport proto.InternalMessageInfo
 
-func (m *AddInvoiceResponse) GetPaymentRequest() string {
+func (m *ChannelFeeReport) GetChanPoint() string {
 	if m != nil {
-		return m.PaymentRequest
+		return m.ChanPoint
 	}
 	return ""
 }
 
-func (m *AddInvoiceResponse) GetAddIndex() uint64 {
+func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
 	if m != nil {
-		return m.AddIndex
+		return m.BaseFeeMsat
 	}
 	return 0
 }
 
-type PaymentHash struct {
-	// *
-	// The hex-encoded payment hash of the invoice to be looked up. The passed
-	// payment hash must be exactly 32 bytes, otherwise an error is returned.
-	RHashStr string `protobuf:"bytes,1,opt,name=r_hash_str,proto3" json:"r_hash_str,omitempty"`
-	// / The payment hash of the invoice to be looked up.
-	RHash                []byte   `protobuf:"bytes,2,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *PaymentHash) Reset()         { *m = PaymentHash{} }
-func (m *PaymentHash) String() string { return proto.CompactTextString(m) }
-func (*PaymentHash) ProtoMessage()    {}
-func (*PaymentHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{91}
-}
-func (m *PaymentHash) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PaymentHash.Unmarshal(m, b)
-}
-func (m *PaymentHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PaymentHash.Marshal(b, m, deterministic)
-}
-func (dst *PaymentHash) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PaymentHash.Merge(dst, src)
-}
-func (m *PaymentHash) XXX_Size() int {
-	return xxx_messageInfo_PaymentHash.Size(m)
-}
-func (m *PaymentHash) XXX_DiscardUnknown() {
-	xxx_messageInfo_PaymentHash.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PaymentHash proto.InternalMessageInfo
-
-func (m *PaymentHash) GetRHashStr() string {
+func (m *ChannelFeeReport) GetFeePerMil() int64 {
 	if m != nil {
-		return m.RHashStr
+		return m.FeePerMil
 	}
-	return ""
+	return 0
 }
 
-func (m *PaymentHash) GetRHash() []byte {
+func (m *ChannelFeeReport) GetFeeRate() float64 {
 	if m != nil {
-		return m.RHash
+		return m.FeeRate
 	}
-	return nil
+	return 0
 }
 
-type ListInvoiceRequest struct {
-	// / If set, only unsettled invoices will be returned in the response.
-	PendingOnly bool `protobuf:"varint,1,opt,name=pending_only,proto3" json:"pending_only,omitempty"`
-	// *
-	// The index of an invoice that will be used as either the start or end of a
-	// query to determine which invoices should be returned in the response.
-	IndexOffset uint64 `protobuf:"varint,4,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
-	// / The max number of invoices to return in the response to this query.
-	NumMaxInvoices uint64 `protobuf:"varint,5,opt,name=num_max_invoices,proto3" json:"num_max_invoices,omitempty"`
-	// *
-	// If set, the invoices returned will result from seeking backwards from the
-	// specified index offset. This can be used to paginate backwards.
-	Reversed             bool     `protobuf:"varint,6,opt,name=reversed,proto3" json:"reversed,omitempty"`
+type FeeReportResponse struct {
+	// / An array of channel fee reports which describes the current fee schedule for each channel.
+	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
+	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum,proto3" json:"day_fee_sum,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
+	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,proto3" json:"week_fee_sum,omitempty"`
+	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
+	MonthFeeSum          uint64   `protobuf:"varint,4,opt,name=month_fee_sum,proto3" json:"month_fee_sum,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListInvoiceRequest) Reset()         { *m = ListInvoiceRequest{} }
-func (m *ListInvoiceRequest) String() string { return proto.CompactTextString(m) }
-func (*ListInvoiceRequest) ProtoMessage()    {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{92}
+func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
+func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
+func (*FeeReportResponse) ProtoMessage()    {}
+func (*FeeReportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{136}
 }
-func (m *ListInvoiceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListInvoiceRequest.Unmarshal(m, b)
+func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
 }
-func (m *ListInvoiceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListInvoiceRequest.Marshal(b, m, deterministic)
+func (m *FeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeReportResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListInvoiceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListInvoiceRequest.Merge(dst, src)
+func (dst *FeeReportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeReportResponse.Merge(dst, src)
 }
-func (m *ListInvoiceRequest) XXX_Size() int {
-	return xxx_messageInfo_ListInvoiceRequest.Size(m)
+func (m *FeeReportResponse) XXX_Size() int {
+	return xxx_messageInfo_FeeReportResponse.Size(m)
 }
-func (m *ListInvoiceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListInvoiceRequest.DiscardUnknown(m)
+func (m *FeeReportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeReportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListInvoiceRequest proto.InternalMessageInfo
+var xxx_messageInfo_FeeReportResponse proto.InternalMessageInfo
 
-func (m *ListInvoiceRequest) GetPendingOnly() bool {
+func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
 	if m != nil {
-		return m.PendingOnly
+		return m.ChannelFees
 	}
-	return false
+	return nil
 }
 
-func (m *ListInvoiceRequest) GetIndexOffset() uint64 {
+func (m *FeeReportResponse) GetDayFeeSum() uint64 {
 	if m != nil {
-		return m.IndexOffset
+		return m.DayFeeSum
 	}
 	return 0
 }
 
-func (m *ListInvoiceRequest) GetNumMaxInvoices() uint64 {
+func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
 	if m != nil {
-		return m.NumMaxInvoices
+		return m.WeekFeeSum
 	}
 	return 0
 }
 
-func (m *ListInvoiceRequest) GetReversed() bool {
+func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
 	if m != nil {
-		return m.Reversed
+		return m.MonthFeeSum
 	}
-	return false
+	return 0
 }
 
-type ListInvoiceResponse struct {
-	// *
-	// A list of invoices from the time slice of the time series specified in the
-	// request.
-	Invoices []*Invoice `protobuf:"bytes,1,rep,name=invoices,proto3" json:"invoices,omitempty"`
-	// *
-	// The index of the last item in the set of returned invoices. This can be used
-	// to seek further, pagination style.
-	LastIndexOffset uint64 `protobuf:"varint,2,opt,name=last_index_offset,proto3" json:"last_index_offset,omitempty"`
-	// *
-	// The index of the last item in the set of returned invoices. This can be used
-	// to seek backwards, pagination style.
-	FirstIndexOffset     uint64   `protobuf:"varint,3,opt,name=first_index_offset,proto3" json:"first_index_offset,omitempty"`
+type PolicyUpdateRequest struct {
+	// Types that are valid to be assigned to Scope:
+	//	*PolicyUpdateRequest_Global
+	//	*PolicyUpdateRequest_ChanPoint
+	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
+	// / The base fee charged regardless of the number of milli-satoshis sent.
+	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
+	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
+	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+	// / The required timelock delta for HTLCs forwarded over the channel.
+	TimeLockDelta uint32 `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+	// / If set, the maximum HTLC size in milli-satoshis. If unset, the maximum
+	// / HTLC size will be unchanged.
+	MaxHtlcMsat uint64 `protobuf:"varint,6,opt,name=max_htlc_msat,proto3" json:"max_htlc_msat,omitempty"`
+	// / The minimum HTLC size in milli-satoshis. Only applied if
+	// / min_htlc_msat_specified is true.
+	MinHtlcMsat uint64 `protobuf:"varint,7,opt,name=min_htlc_msat,proto3" json:"min_htlc_msat,omitempty"`
+	// / If true, min_htlc_msat is applied.
+	MinHtlcMsatSpecified bool     `protobuf:"varint,8,opt,name=min_htlc_msat_specified,proto3" json:"min_htlc_msat_specified,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListInvoiceResponse) Reset()         { *m = ListInvoiceResponse{} }
-func (m *ListInvoiceResponse) String() string { return proto.CompactTextString(m) }
-func (*ListInvoiceResponse) ProtoMessage()    {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{93}
+func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
+func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*PolicyUpdateRequest) ProtoMessage()    {}
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{137}
 }
-func (m *ListInvoiceResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListInvoiceResponse.Unmarshal(m, b)
+func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
 }
-func (m *ListInvoiceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListInvoiceResponse.Marshal(b, m, deterministic)
+func (m *PolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyUpdateRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListInvoiceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListInvoiceResponse.Merge(dst, src)
+func (dst *PolicyUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyUpdateRequest.Merge(dst, src)
 }
-func (m *ListInvoiceResponse) XXX_Size() int {
-	return xxx_messageInfo_ListInvoiceResponse.Size(m)
+func (m *PolicyUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_PolicyUpdateRequest.Size(m)
 }
-func (m *ListInvoiceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListInvoiceResponse.DiscardUnknown(m)
+func (m *PolicyUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PolicyUpdateRequest proto.InternalMessageInfo
+
+type isPolicyUpdateRequest_Scope interface {
+	isPolicyUpdateRequest_Scope()
+}
+
+type PolicyUpdateRequest_Global struct {
+	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
+}
+
+type PolicyUpdateRequest_ChanPoint struct {
+	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
+}
+
+func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope() {}
+
+func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+
+func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
+	if m != nil {
+		return m.Scope
+	}
+	return nil
+}
+
+func (m *PolicyUpdateRequest) GetGlobal() bool {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
+		return x.Global
+	}
+	return false
+}
+
+func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
+	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
+		return x.ChanPoint
+	}
+	return nil
 }
 
-var xxx_messageInfo_ListInvoiceResponse proto.InternalMessageInfo
-
-func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
+func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
 	if m != nil {
-		return m.Invoices
+		return m.BaseFeeMsat
 	}
-	return nil
+	return 0
 }
 
-func (m *ListInvoiceResponse) GetLastIndexOffset() uint64 {
+func (m *PolicyUpdateRequest) GetFeeRate() float64 {
 	if m != nil {
-		return m.LastIndexOffset
+		return m.FeeRate
 	}
 	return 0
 }
 
-func (m *ListInvoiceResponse) GetFirstIndexOffset() uint64 {
+func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
 	if m != nil {
-		return m.FirstIndexOffset
+		return m.TimeLockDelta
 	}
 	return 0
 }
 
-type InvoiceSubscription struct {
-	// *
-	// If specified (non-zero), then we'll first start by sending out
-	// notifications for all added indexes with an add_index greater than this
-	// value. This allows callers to catch up on any events they missed while they
-	// weren't connected to the streaming RPC.
-	AddIndex uint64 `protobuf:"varint,1,opt,name=add_index,proto3" json:"add_index,omitempty"`
-	// *
-	// If specified (non-zero), then we'll first start by sending out
-	// notifications for all settled indexes with an settle_index greater than
-	// this value. This allows callers to catch up on any events they missed while
-	// they weren't connected to the streaming RPC.
-	SettleIndex          uint64   `protobuf:"varint,2,opt,name=settle_index,proto3" json:"settle_index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PolicyUpdateRequest) GetMaxHtlcMsat() uint64 {
+	if m != nil {
+		return m.MaxHtlcMsat
+	}
+	return 0
 }
 
-func (m *InvoiceSubscription) Reset()         { *m = InvoiceSubscription{} }
-func (m *InvoiceSubscription) String() string { return proto.CompactTextString(m) }
-func (*InvoiceSubscription) ProtoMessage()    {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{94}
-}
-func (m *InvoiceSubscription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InvoiceSubscription.Unmarshal(m, b)
-}
-func (m *InvoiceSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InvoiceSubscription.Marshal(b, m, deterministic)
-}
-func (dst *InvoiceSubscription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InvoiceSubscription.Merge(dst, src)
+func (m *PolicyUpdateRequest) GetMinHtlcMsat() uint64 {
+	if m != nil {
+		return m.MinHtlcMsat
+	}
+	return 0
 }
-func (m *InvoiceSubscription) XXX_Size() int {
-	return xxx_messageInfo_InvoiceSubscription.Size(m)
+
+func (m *PolicyUpdateRequest) GetMinHtlcMsatSpecified() bool {
+	if m != nil {
+		return m.MinHtlcMsatSpecified
+	}
+	return false
 }
-func (m *InvoiceSubscription) XXX_DiscardUnknown() {
-	xxx_messageInfo_InvoiceSubscription.DiscardUnknown(m)
+
+// XXX_OneofFuncs is for the internal use of the proto package.
+func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
+	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
+		(*PolicyUpdateRequest_Global)(nil),
+		(*PolicyUpdateRequest_ChanPoint)(nil),
+	}
 }
 
-var xxx_messageInfo_InvoiceSubscription proto.InternalMessageInfo
+func _PolicyUpdateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
+	m := msg.(*PolicyUpdateRequest)
+	// scope
+	switch x := m.Scope.(type) {
+	case *PolicyUpdateRequest_Global:
+		t := uint64(0)
+		if x.Global {
+			t = 1
+		}
+		b.EncodeVarint(1<<3 | proto.WireVarint)
+		b.EncodeVarint(t)
+	case *PolicyUpdateRequest_ChanPoint:
+		b.EncodeVarint(2<<3 | proto.WireBytes)
+		if err := b.EncodeMessage(x.ChanPoint); err != nil {
+			return err
+		}
+	case nil:
+	default:
+		return fmt.Errorf("PolicyUpdateRequest.Scope has unexpected type %T", x)
+	}
+	return nil
+}
 
-func (m *InvoiceSubscription) GetAddIndex() uint64 {
-	if m != nil {
-		return m.AddIndex
+func _PolicyUpdateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
+	m := msg.(*PolicyUpdateRequest)
+	switch tag {
+	case 1: // scope.global
+		if wire != proto.WireVarint {
+			return true, proto.ErrInternalBadWireType
+		}
+		x, err := b.DecodeVarint()
+		m.Scope = &PolicyUpdateRequest_Global{x != 0}
+		return true, err
+	case 2: // scope.chan_point
+		if wire != proto.WireBytes {
+			return true, proto.ErrInternalBadWireType
+		}
+		msg := new(ChannelPoint)
+		err := b.DecodeMessage(msg)
+		m.Scope = &PolicyUpdateRequest_ChanPoint{msg}
+		return true, err
+	default:
+		return false, nil
 	}
-	return 0
 }
 
-func (m *InvoiceSubscription) GetSettleIndex() uint64 {
-	if m != nil {
-		return m.SettleIndex
+func _PolicyUpdateRequest_OneofSizer(msg proto.Message) (n int) {
+	m := msg.(*PolicyUpdateRequest)
+	// scope
+	switch x := m.Scope.(type) {
+	case *PolicyUpdateRequest_Global:
+		n += 1 // tag and wire
+		n += 1
+	case *PolicyUpdateRequest_ChanPoint:
+		s := proto.Size(x.ChanPoint)
+		n += 1 // tag and wire
+		n += proto.SizeVarint(uint64(s))
+		n += s
+	case nil:
+	default:
+		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
 	}
-	return 0
+	return n
 }
 
-type Payment struct {
-	// / The payment hash
-	PaymentHash string `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
-	// / Deprecated, use value_sat or value_msat.
-	Value int64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"` // Deprecated: Do not use.
-	// / The date of this payment
-	CreationDate int64 `protobuf:"varint,3,opt,name=creation_date,proto3" json:"creation_date,omitempty"`
-	// / The path this payment took
-	Path []string `protobuf:"bytes,4,rep,name=path,proto3" json:"path,omitempty"`
-	// / The fee paid for this payment in satoshis
-	Fee int64 `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
-	// / The payment preimage
-	PaymentPreimage string `protobuf:"bytes,6,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
-	// / The value of the payment in satoshis
-	ValueSat int64 `protobuf:"varint,7,opt,name=value_sat,proto3" json:"value_sat,omitempty"`
-	// / The value of the payment in milli-satoshis
-	ValueMsat            int64    `protobuf:"varint,8,opt,name=value_msat,proto3" json:"value_msat,omitempty"`
+type PolicyUpdateResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Payment) Reset()         { *m = Payment{} }
-func (m *Payment) String() string { return proto.CompactTextString(m) }
-func (*Payment) ProtoMessage()    {}
-func (*Payment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{95}
+func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
+func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*PolicyUpdateResponse) ProtoMessage()    {}
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{138}
 }
-func (m *Payment) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Payment.Unmarshal(m, b)
+func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
 }
-func (m *Payment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Payment.Marshal(b, m, deterministic)
+func (m *PolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PolicyUpdateResponse.Marshal(b, m, deterministic)
 }
-func (dst *Payment) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Payment.Merge(dst, src)
+func (dst *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicyUpdateResponse.Merge(dst, src)
 }
-func (m *Payment) XXX_Size() int {
-	return xxx_messageInfo_Payment.Size(m)
+func (m *PolicyUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
 }
-func (m *Payment) XXX_DiscardUnknown() {
-	xxx_messageInfo_Payment.DiscardUnknown(m)
+func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Payment proto.InternalMessageInfo
+var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
 
-func (m *Payment) GetPaymentHash() string {
-	if m != nil {
-		return m.PaymentHash
-	}
-	return ""
+type FeeClamp struct {
+	// / The consumer of the fee estimator the bounds apply to.
+	Consumer FeeConsumer `protobuf:"varint,1,opt,name=consumer,proto3,enum=lnrpc.FeeConsumer" json:"consumer,omitempty"`
+	// / The minimum fee rate in sat/vbyte, 0 for no minimum.
+	MinSatPerVbyte uint64 `protobuf:"varint,2,opt,name=min_sat_per_vbyte,proto3" json:"min_sat_per_vbyte,omitempty"`
+	// / The maximum fee rate in sat/vbyte, 0 for no maximum.
+	MaxSatPerVbyte       uint64   `protobuf:"varint,3,opt,name=max_sat_per_vbyte,proto3" json:"max_sat_per_vbyte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// Deprecated: Do not use.
-func (m *Payment) GetValue() int64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
+func (m *FeeClamp) Reset()         { *m = FeeClamp{} }
+func (m *FeeClamp) String() string { return proto.CompactTextString(m) }
+func (*FeeClamp) ProtoMessage()    {}
+func (*FeeClamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{139}
 }
-
-func (m *Payment) GetCreationDate() int64 {
-	if m != nil {
-		return m.CreationDate
-	}
-	return 0
+func (m *FeeClamp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FeeClamp.Unmarshal(m, b)
 }
-
-func (m *Payment) GetPath() []string {
-	if m != nil {
-		return m.Path
-	}
-	return nil
+func (m *FeeClamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FeeClamp.Marshal(b, m, deterministic)
 }
-
-func (m *Payment) GetFee() int64 {
-	if m != nil {
-		return m.Fee
-	}
-	return 0
+func (dst *FeeClamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeClamp.Merge(dst, src)
+}
+func (m *FeeClamp) XXX_Size() int {
+	return xxx_messageInfo_FeeClamp.Size(m)
+}
+func (m *FeeClamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeClamp.DiscardUnknown(m)
 }
 
-func (m *Payment) GetPaymentPreimage() string {
+var xxx_messageInfo_FeeClamp proto.InternalMessageInfo
+
+func (m *FeeClamp) GetConsumer() FeeConsumer {
 	if m != nil {
-		return m.PaymentPreimage
+		return m.Consumer
 	}
-	return ""
+	return FeeConsumer_FUNDING
 }
 
-func (m *Payment) GetValueSat() int64 {
+func (m *FeeClamp) GetMinSatPerVbyte() uint64 {
 	if m != nil {
-		return m.ValueSat
+		return m.MinSatPerVbyte
 	}
 	return 0
 }
 
-func (m *Payment) GetValueMsat() int64 {
+func (m *FeeClamp) GetMaxSatPerVbyte() uint64 {
 	if m != nil {
-		return m.ValueMsat
+		return m.MaxSatPerVbyte
 	}
 	return 0
 }
 
-type ListPaymentsRequest struct {
+type ListFeeClampsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ListPaymentsRequest) Reset()         { *m = ListPaymentsRequest{} }
-func (m *ListPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*ListPaymentsRequest) ProtoMessage()    {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{96}
+func (m *ListFeeClampsRequest) Reset()         { *m = ListFeeClampsRequest{} }
+func (m *ListFeeClampsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListFeeClampsRequest) ProtoMessage()    {}
+func (*ListFeeClampsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{140}
 }
-func (m *ListPaymentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPaymentsRequest.Unmarshal(m, b)
+func (m *ListFeeClampsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFeeClampsRequest.Unmarshal(m, b)
 }
-func (m *ListPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPaymentsRequest.Marshal(b, m, deterministic)
+func (m *ListFeeClampsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFeeClampsRequest.Marshal(b, m, deterministic)
 }
-func (dst *ListPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPaymentsRequest.Merge(dst, src)
+func (dst *ListFeeClampsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFeeClampsRequest.Merge(dst, src)
 }
-func (m *ListPaymentsRequest) XXX_Size() int {
-	return xxx_messageInfo_ListPaymentsRequest.Size(m)
+func (m *ListFeeClampsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListFeeClampsRequest.Size(m)
 }
-func (m *ListPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPaymentsRequest.DiscardUnknown(m)
+func (m *ListFeeClampsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFeeClampsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListFeeClampsRequest proto.InternalMessageInfo
 
-type ListPaymentsResponse struct {
-	// / The list of payments
-	Payments             []*Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type ListFeeClampsResponse struct {
+	// / The current bounds of each consumer of the fee estimator.
+	Clamps               []*FeeClamp `protobuf:"bytes,1,rep,name=clamps,proto3" json:"clamps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ListPaymentsResponse) Reset()         { *m = ListPaymentsResponse{} }
-func (m *ListPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*ListPaymentsResponse) ProtoMessage()    {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{97}
+func (m *ListFeeClampsResponse) Reset()         { *m = ListFeeClampsResponse{} }
+func (m *ListFeeClampsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListFeeClampsResponse) ProtoMessage()    {}
+func (*ListFeeClampsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{141}
 }
-func (m *ListPaymentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListPaymentsResponse.Unmarshal(m, b)
+func (m *ListFeeClampsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListFeeClampsResponse.Unmarshal(m, b)
 }
-func (m *ListPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListPaymentsResponse.Marshal(b, m, deterministic)
+func (m *ListFeeClampsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListFeeClampsResponse.Marshal(b, m, deterministic)
 }
-func (dst *ListPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListPaymentsResponse.Merge(dst, src)
+func (dst *ListFeeClampsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListFeeClampsResponse.Merge(dst, src)
 }
-func (m *ListPaymentsResponse) XXX_Size() int {
-	return xxx_messageInfo_ListPaymentsResponse.Size(m)
+func (m *ListFeeClampsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListFeeClampsResponse.Size(m)
 }
-func (m *ListPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListPaymentsResponse.DiscardUnknown(m)
+func (m *ListFeeClampsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListFeeClampsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListPaymentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListFeeClampsResponse proto.InternalMessageInfo
 
-func (m *ListPaymentsResponse) GetPayments() []*Payment {
+func (m *ListFeeClampsResponse) GetClamps() []*FeeClamp {
 	if m != nil {
-		return m.Payments
+		return m.Clamps
 	}
 	return nil
 }
 
-type DeleteAllPaymentsRequest struct {
+type UpdateFeeClampResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAllPaymentsRequest) Reset()         { *m = DeleteAllPaymentsRequest{} }
-func (m *DeleteAllPaymentsRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsRequest) ProtoMessage()    {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{98}
+func (m *UpdateFeeClampResponse) Reset()         { *m = UpdateFeeClampResponse{} }
+func (m *UpdateFeeClampResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateFeeClampResponse) ProtoMessage()    {}
+func (*UpdateFeeClampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{142}
 }
-func (m *DeleteAllPaymentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Unmarshal(m, b)
+func (m *UpdateFeeClampResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateFeeClampResponse.Unmarshal(m, b)
 }
-func (m *DeleteAllPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Marshal(b, m, deterministic)
+func (m *UpdateFeeClampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateFeeClampResponse.Marshal(b, m, deterministic)
 }
-func (dst *DeleteAllPaymentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAllPaymentsRequest.Merge(dst, src)
+func (dst *UpdateFeeClampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateFeeClampResponse.Merge(dst, src)
 }
-func (m *DeleteAllPaymentsRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAllPaymentsRequest.Size(m)
+func (m *UpdateFeeClampResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateFeeClampResponse.Size(m)
 }
-func (m *DeleteAllPaymentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAllPaymentsRequest.DiscardUnknown(m)
+func (m *UpdateFeeClampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateFeeClampResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAllPaymentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateFeeClampResponse proto.InternalMessageInfo
 
-type DeleteAllPaymentsResponse struct {
+type ForwardingHistoryRequest struct {
+	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
+	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
+	// / End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
+	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
+	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
+	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
+	// / The max number of events to return in the response to this query.
+	NumMaxEvents         uint32   `protobuf:"varint,4,opt,name=num_max_events,proto3" json:"num_max_events,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAllPaymentsResponse) Reset()         { *m = DeleteAllPaymentsResponse{} }
-func (m *DeleteAllPaymentsResponse) String() string { return proto.CompactTextString(m) }
-func (*DeleteAllPaymentsResponse) ProtoMessage()    {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{99}
+func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryRequest{} }
+func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryRequest) ProtoMessage()    {}
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{143}
 }
-func (m *DeleteAllPaymentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Unmarshal(m, b)
+func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
 }
-func (m *DeleteAllPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Marshal(b, m, deterministic)
+func (m *ForwardingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryRequest.Marshal(b, m, deterministic)
 }
-func (dst *DeleteAllPaymentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAllPaymentsResponse.Merge(dst, src)
+func (dst *ForwardingHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryRequest.Merge(dst, src)
 }
-func (m *DeleteAllPaymentsResponse) XXX_Size() int {
-	return xxx_messageInfo_DeleteAllPaymentsResponse.Size(m)
+func (m *ForwardingHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryRequest.Size(m)
 }
-func (m *DeleteAllPaymentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAllPaymentsResponse.DiscardUnknown(m)
+func (m *ForwardingHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAllPaymentsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ForwardingHistoryRequest proto.InternalMessageInfo
 
-type AbandonChannelRequest struct {
-	ChannelPoint         *ChannelPoint `protobuf:"bytes,1,opt,name=channel_point,json=channelPoint,proto3" json:"channel_point,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
 
-func (m *AbandonChannelRequest) Reset()         { *m = AbandonChannelRequest{} }
-func (m *AbandonChannelRequest) String() string { return proto.CompactTextString(m) }
-func (*AbandonChannelRequest) ProtoMessage()    {}
-func (*AbandonChannelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{100}
-}
-func (m *AbandonChannelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AbandonChannelRequest.Unmarshal(m, b)
-}
-func (m *AbandonChannelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AbandonChannelRequest.Marshal(b, m, deterministic)
-}
-func (dst *AbandonChannelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbandonChannelRequest.Merge(dst, src)
-}
-func (m *AbandonChannelRequest) XXX_Size() int {
-	return xxx_messageInfo_AbandonChannelRequest.Size(m)
-}
-func (m *AbandonChannelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbandonChannelRequest.DiscardUnknown(m)
+func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
 }
 
-var xxx_messageInfo_AbandonChannelRequest proto.InternalMessageInfo
+func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
 
-func (m *AbandonChannelRequest) GetChannelPoint() *ChannelPoint {
+func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
 	if m != nil {
-		return m.ChannelPoint
+		return m.NumMaxEvents
 	}
-	return nil
+	return 0
 }
 
-type AbandonChannelResponse struct {
+type ForwardingEvent struct {
+	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// / The incoming channel ID that carried the HTLC that created the circuit.
+	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in,proto3" json:"chan_id_in,omitempty"`
+	// / The outgoing channel ID that carried the preimage that completed the circuit.
+	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out,proto3" json:"chan_id_out,omitempty"`
+	// / The total amount (in satoshis) of the incoming HTLC that created half the circuit.
+	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in,proto3" json:"amt_in,omitempty"`
+	// / The total amount (in satoshis) of the outgoing HTLC that created the second half of the circuit.
+	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out,proto3" json:"amt_out,omitempty"`
+	// / The total fee (in satoshis) that this payment circuit carried.
+	Fee uint64 `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	// / The total fee (in milli-satoshis) that this payment circuit carried.
+	FeeMsat              uint64   `protobuf:"varint,8,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AbandonChannelResponse) Reset()         { *m = AbandonChannelResponse{} }
-func (m *AbandonChannelResponse) String() string { return proto.CompactTextString(m) }
-func (*AbandonChannelResponse) ProtoMessage()    {}
-func (*AbandonChannelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{101}
+func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
+func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
+func (*ForwardingEvent) ProtoMessage()    {}
+func (*ForwardingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{144}
 }
-func (m *AbandonChannelResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AbandonChannelResponse.Unmarshal(m, b)
+func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
 }
-func (m *AbandonChannelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AbandonChannelResponse.Marshal(b, m, deterministic)
+func (m *ForwardingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingEvent.Marshal(b, m, deterministic)
 }
-func (dst *AbandonChannelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AbandonChannelResponse.Merge(dst, src)
+func (dst *ForwardingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingEvent.Merge(dst, src)
 }
-func (m *AbandonChannelResponse) XXX_Size() int {
-	return xxx_messageInfo_AbandonChannelResponse.Size(m)
+func (m *ForwardingEvent) XXX_Size() int {
+	return xxx_messageInfo_ForwardingEvent.Size(m)
 }
-func (m *AbandonChannelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_AbandonChannelResponse.DiscardUnknown(m)
+func (m *ForwardingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AbandonChannelResponse proto.InternalMessageInfo
+var xxx_messageInfo_ForwardingEvent proto.InternalMessageInfo
 
-type DebugLevelRequest struct {
-	Show                 bool     `protobuf:"varint,1,opt,name=show,proto3" json:"show,omitempty"`
-	LevelSpec            string   `protobuf:"bytes,2,opt,name=level_spec,json=levelSpec,proto3" json:"level_spec,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ForwardingEvent) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
 }
 
-func (m *DebugLevelRequest) Reset()         { *m = DebugLevelRequest{} }
-func (m *DebugLevelRequest) String() string { return proto.CompactTextString(m) }
-func (*DebugLevelRequest) ProtoMessage()    {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{102}
-}
-func (m *DebugLevelRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DebugLevelRequest.Unmarshal(m, b)
-}
-func (m *DebugLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DebugLevelRequest.Marshal(b, m, deterministic)
-}
-func (dst *DebugLevelRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugLevelRequest.Merge(dst, src)
+func (m *ForwardingEvent) GetChanIdIn() uint64 {
+	if m != nil {
+		return m.ChanIdIn
+	}
+	return 0
 }
-func (m *DebugLevelRequest) XXX_Size() int {
-	return xxx_messageInfo_DebugLevelRequest.Size(m)
+
+func (m *ForwardingEvent) GetChanIdOut() uint64 {
+	if m != nil {
+		return m.ChanIdOut
+	}
+	return 0
 }
-func (m *DebugLevelRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugLevelRequest.DiscardUnknown(m)
+
+func (m *ForwardingEvent) GetAmtIn() uint64 {
+	if m != nil {
+		return m.AmtIn
+	}
+	return 0
 }
 
-var xxx_messageInfo_DebugLevelRequest proto.InternalMessageInfo
+func (m *ForwardingEvent) GetAmtOut() uint64 {
+	if m != nil {
+		return m.AmtOut
+	}
+	return 0
+}
 
-func (m *DebugLevelRequest) GetShow() bool {
+func (m *ForwardingEvent) GetFee() uint64 {
 	if m != nil {
-		return m.Show
+		return m.Fee
 	}
-	return false
+	return 0
 }
 
-func (m *DebugLevelRequest) GetLevelSpec() string {
+func (m *ForwardingEvent) GetFeeMsat() uint64 {
 	if m != nil {
-		return m.LevelSpec
+		return m.FeeMsat
 	}
-	return ""
+	return 0
 }
 
-type DebugLevelResponse struct {
-	SubSystems           string   `protobuf:"bytes,1,opt,name=sub_systems,proto3" json:"sub_systems,omitempty"`
+type ForwardingHistoryResponse struct {
+	// / A list of forwarding events from the time slice of the time series specified in the request.
+	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events,proto3" json:"forwarding_events,omitempty"`
+	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
+	LastOffsetIndex      uint32   `protobuf:"varint,2,opt,name=last_offset_index,proto3" json:"last_offset_index,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DebugLevelResponse) Reset()         { *m = DebugLevelResponse{} }
-func (m *DebugLevelResponse) String() string { return proto.CompactTextString(m) }
-func (*DebugLevelResponse) ProtoMessage()    {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{103}
+func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResponse{} }
+func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ForwardingHistoryResponse) ProtoMessage()    {}
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{145}
 }
-func (m *DebugLevelResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DebugLevelResponse.Unmarshal(m, b)
+func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
 }
-func (m *DebugLevelResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DebugLevelResponse.Marshal(b, m, deterministic)
+func (m *ForwardingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ForwardingHistoryResponse.Marshal(b, m, deterministic)
 }
-func (dst *DebugLevelResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DebugLevelResponse.Merge(dst, src)
+func (dst *ForwardingHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingHistoryResponse.Merge(dst, src)
 }
-func (m *DebugLevelResponse) XXX_Size() int {
-	return xxx_messageInfo_DebugLevelResponse.Size(m)
+func (m *ForwardingHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ForwardingHistoryResponse.Size(m)
 }
-func (m *DebugLevelResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DebugLevelResponse.DiscardUnknown(m)
+func (m *ForwardingHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DebugLevelResponse proto.InternalMessageInfo
+var xxx_messageInfo_ForwardingHistoryResponse proto.InternalMessageInfo
 
-func (m *DebugLevelResponse) GetSubSystems() string {
+func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
 	if m != nil {
-		return m.SubSystems
+		return m.ForwardingEvents
 	}
-	return ""
+	return nil
 }
 
-type PayReqString struct {
-	// / The payment request string to be decoded
-	PayReq               string   `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+	if m != nil {
+		return m.LastOffsetIndex
+	}
+	return 0
+}
+
+type SwitchStatsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PayReqString) Reset()         { *m = PayReqString{} }
-func (m *PayReqString) String() string { return proto.CompactTextString(m) }
-func (*PayReqString) ProtoMessage()    {}
-func (*PayReqString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{104}
+func (m *SwitchStatsRequest) Reset()         { *m = SwitchStatsRequest{} }
+func (m *SwitchStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*SwitchStatsRequest) ProtoMessage()    {}
+func (*SwitchStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{146}
 }
-func (m *PayReqString) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayReqString.Unmarshal(m, b)
+func (m *SwitchStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchStatsRequest.Unmarshal(m, b)
 }
-func (m *PayReqString) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayReqString.Marshal(b, m, deterministic)
+func (m *SwitchStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchStatsRequest.Marshal(b, m, deterministic)
 }
-func (dst *PayReqString) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayReqString.Merge(dst, src)
+func (dst *SwitchStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchStatsRequest.Merge(dst, src)
 }
-func (m *PayReqString) XXX_Size() int {
-	return xxx_messageInfo_PayReqString.Size(m)
+func (m *SwitchStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_SwitchStatsRequest.Size(m)
 }
-func (m *PayReqString) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayReqString.DiscardUnknown(m)
+func (m *SwitchStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchStatsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayReqString proto.InternalMessageInfo
-
-func (m *PayReqString) GetPayReq() string {
-	if m != nil {
-		return m.PayReq
-	}
-	return ""
-}
+var xxx_messageInfo_SwitchStatsRequest proto.InternalMessageInfo
 
-type PayReq struct {
-	Destination          string       `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
-	PaymentHash          string       `protobuf:"bytes,2,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
-	NumSatoshis          int64        `protobuf:"varint,3,opt,name=num_satoshis,proto3" json:"num_satoshis,omitempty"`
-	Timestamp            int64        `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Expiry               int64        `protobuf:"varint,5,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	Description          string       `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	DescriptionHash      string       `protobuf:"bytes,7,opt,name=description_hash,proto3" json:"description_hash,omitempty"`
-	FallbackAddr         string       `protobuf:"bytes,8,opt,name=fallback_addr,proto3" json:"fallback_addr,omitempty"`
-	CltvExpiry           int64        `protobuf:"varint,9,opt,name=cltv_expiry,proto3" json:"cltv_expiry,omitempty"`
-	RouteHints           []*RouteHint `protobuf:"bytes,10,rep,name=route_hints,proto3" json:"route_hints,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+type LinkStats struct {
+	// / The short channel id of the link's channel.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The number of HTLCs forwarded over the link in either direction.
+	NumHtlcs uint64 `protobuf:"varint,2,opt,name=num_htlcs,proto3" json:"num_htlcs,omitempty"`
+	// / The average number of HTLCs forwarded over the link per second.
+	HtlcsPerSec float64 `protobuf:"fixed64,3,opt,name=htlcs_per_sec,proto3" json:"htlcs_per_sec,omitempty"`
+	// / The number of commitment signatures sent by the link.
+	NumCommitSigs uint64 `protobuf:"varint,4,opt,name=num_commit_sigs,proto3" json:"num_commit_sigs,omitempty"`
+	// / The average number of commitment signatures sent per second.
+	CommitSigsPerSec     float64  `protobuf:"fixed64,5,opt,name=commit_sigs_per_sec,proto3" json:"commit_sigs_per_sec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PayReq) Reset()         { *m = PayReq{} }
-func (m *PayReq) String() string { return proto.CompactTextString(m) }
-func (*PayReq) ProtoMessage()    {}
-func (*PayReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{105}
+func (m *LinkStats) Reset()         { *m = LinkStats{} }
+func (m *LinkStats) String() string { return proto.CompactTextString(m) }
+func (*LinkStats) ProtoMessage()    {}
+func (*LinkStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{147}
 }
-func (m *PayReq) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PayReq.Unmarshal(m, b)
+func (m *LinkStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkStats.Unmarshal(m, b)
 }
-func (m *PayReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PayReq.Marshal(b, m, deterministic)
+func (m *LinkStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkStats.Marshal(b, m, deterministic)
 }
-func (dst *PayReq) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PayReq.Merge(dst, src)
+func (dst *LinkStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkStats.Merge(dst, src)
 }
-func (m *PayReq) XXX_Size() int {
-	return xxx_messageInfo_PayReq.Size(m)
+func (m *LinkStats) XXX_Size() int {
+	return xxx_messageInfo_LinkStats.Size(m)
 }
-func (m *PayReq) XXX_DiscardUnknown() {
-	xxx_messageInfo_PayReq.DiscardUnknown(m)
+func (m *LinkStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkStats.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PayReq proto.InternalMessageInfo
+var xxx_messageInfo_LinkStats proto.InternalMessageInfo
 
-func (m *PayReq) GetDestination() string {
+func (m *LinkStats) GetChanId() uint64 {
 	if m != nil {
-		return m.Destination
+		return m.ChanId
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetPaymentHash() string {
+func (m *LinkStats) GetNumHtlcs() uint64 {
 	if m != nil {
-		return m.PaymentHash
+		return m.NumHtlcs
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetNumSatoshis() int64 {
+func (m *LinkStats) GetHtlcsPerSec() float64 {
 	if m != nil {
-		return m.NumSatoshis
+		return m.HtlcsPerSec
 	}
 	return 0
 }
 
-func (m *PayReq) GetTimestamp() int64 {
+func (m *LinkStats) GetNumCommitSigs() uint64 {
 	if m != nil {
-		return m.Timestamp
+		return m.NumCommitSigs
 	}
 	return 0
 }
 
-func (m *PayReq) GetExpiry() int64 {
+func (m *LinkStats) GetCommitSigsPerSec() float64 {
 	if m != nil {
-		return m.Expiry
+		return m.CommitSigsPerSec
 	}
 	return 0
 }
 
-func (m *PayReq) GetDescription() string {
+type SwitchStatsResponse struct {
+	// / The throughput of every link that forwarded HTLCs.
+	Links []*LinkStats `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	// / The number of HTLCs forwarded through the switch that were settled.
+	NumSettles uint64 `protobuf:"varint,2,opt,name=num_settles,proto3" json:"num_settles,omitempty"`
+	// / The median settle latency in microseconds.
+	SettleLatencyP50Us int64 `protobuf:"varint,3,opt,name=settle_latency_p50_us,proto3" json:"settle_latency_p50_us,omitempty"`
+	// / The 90th percentile settle latency in microseconds.
+	SettleLatencyP90Us int64 `protobuf:"varint,4,opt,name=settle_latency_p90_us,proto3" json:"settle_latency_p90_us,omitempty"`
+	// / The 99th percentile settle latency in microseconds.
+	SettleLatencyP99Us   int64    `protobuf:"varint,5,opt,name=settle_latency_p99_us,proto3" json:"settle_latency_p99_us,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SwitchStatsResponse) Reset()         { *m = SwitchStatsResponse{} }
+func (m *SwitchStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*SwitchStatsResponse) ProtoMessage()    {}
+func (*SwitchStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{148}
+}
+func (m *SwitchStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SwitchStatsResponse.Unmarshal(m, b)
+}
+func (m *SwitchStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SwitchStatsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SwitchStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchStatsResponse.Merge(dst, src)
+}
+func (m *SwitchStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_SwitchStatsResponse.Size(m)
+}
+func (m *SwitchStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchStatsResponse proto.InternalMessageInfo
+
+func (m *SwitchStatsResponse) GetLinks() []*LinkStats {
 	if m != nil {
-		return m.Description
+		return m.Links
 	}
-	return ""
+	return nil
 }
 
-func (m *PayReq) GetDescriptionHash() string {
+func (m *SwitchStatsResponse) GetNumSettles() uint64 {
 	if m != nil {
-		return m.DescriptionHash
+		return m.NumSettles
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetFallbackAddr() string {
+func (m *SwitchStatsResponse) GetSettleLatencyP50Us() int64 {
 	if m != nil {
-		return m.FallbackAddr
+		return m.SettleLatencyP50Us
 	}
-	return ""
+	return 0
 }
 
-func (m *PayReq) GetCltvExpiry() int64 {
+func (m *SwitchStatsResponse) GetSettleLatencyP90Us() int64 {
 	if m != nil {
-		return m.CltvExpiry
+		return m.SettleLatencyP90Us
 	}
 	return 0
 }
 
-func (m *PayReq) GetRouteHints() []*RouteHint {
+func (m *SwitchStatsResponse) GetSettleLatencyP99Us() int64 {
 	if m != nil {
-		return m.RouteHints
+		return m.SettleLatencyP99Us
 	}
-	return nil
+	return 0
 }
 
-type FeeReportRequest struct {
+type ListHtlcsRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportRequest) Reset()         { *m = FeeReportRequest{} }
-func (m *FeeReportRequest) String() string { return proto.CompactTextString(m) }
-func (*FeeReportRequest) ProtoMessage()    {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{106}
+func (m *ListHtlcsRequest) Reset()         { *m = ListHtlcsRequest{} }
+func (m *ListHtlcsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListHtlcsRequest) ProtoMessage()    {}
+func (*ListHtlcsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{149}
+}
+func (m *ListHtlcsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHtlcsRequest.Unmarshal(m, b)
+}
+func (m *ListHtlcsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHtlcsRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListHtlcsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHtlcsRequest.Merge(dst, src)
+}
+func (m *ListHtlcsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListHtlcsRequest.Size(m)
+}
+func (m *ListHtlcsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHtlcsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHtlcsRequest proto.InternalMessageInfo
+
+type InFlightHtlc struct {
+	// / The short channel id of the channel the HTLC is on.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,proto3" json:"chan_id,omitempty"`
+	// / The outpoint (txid:index) of the funding transaction of the channel.
+	ChannelPoint string `protobuf:"bytes,2,opt,name=channel_point,proto3" json:"channel_point,omitempty"`
+	// / Whether the HTLC was offered to us by the remote party.
+	Incoming bool `protobuf:"varint,3,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	// / The index of the HTLC within the channel.
+	HtlcIndex uint64 `protobuf:"varint,4,opt,name=htlc_index,proto3" json:"htlc_index,omitempty"`
+	// / The value of the HTLC in milli-satoshis.
+	AmtMsat uint64 `protobuf:"varint,5,opt,name=amt_msat,proto3" json:"amt_msat,omitempty"`
+	// / The payment hash of the HTLC.
+	HashLock []byte `protobuf:"bytes,6,opt,name=hash_lock,proto3" json:"hash_lock,omitempty"`
+	// / The absolute block height at which the HTLC expires.
+	ExpirationHeight uint32 `protobuf:"varint,7,opt,name=expiration_height,proto3" json:"expiration_height,omitempty"`
+	// / The number of blocks left until the HTLC expires.
+	BlocksTillExpiry int32 `protobuf:"varint,8,opt,name=blocks_till_expiry,proto3" json:"blocks_till_expiry,omitempty"`
+	// / The state of the HTLC in the commitment state machine of the channel.
+	State InFlightHtlc_State `protobuf:"varint,9,opt,name=state,proto3,enum=lnrpc.InFlightHtlc_State" json:"state,omitempty"`
+	// *
+	// Whether the HTLC is part of a circuit of the switch, either because it's
+	// being forwarded, or because it was sent for one of our payments.
+	HasCircuit bool `protobuf:"varint,10,opt,name=has_circuit,proto3" json:"has_circuit,omitempty"`
+	// *
+	// The short channel id of the other end of the circuit: the outgoing HTLC
+	// of an incoming HTLC, once it has been forwarded, or the incoming HTLC of
+	// an outgoing one.
+	CircuitChanId uint64 `protobuf:"varint,11,opt,name=circuit_chan_id,proto3" json:"circuit_chan_id,omitempty"`
+	// / The index of the HTLC at the other end of the circuit.
+	CircuitHtlcIndex uint64 `protobuf:"varint,12,opt,name=circuit_htlc_index,proto3" json:"circuit_htlc_index,omitempty"`
+	// / Whether the outgoing HTLC was sent for one of our payments.
+	LocalPayment         bool     `protobuf:"varint,13,opt,name=local_payment,proto3" json:"local_payment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *FeeReportRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FeeReportRequest.Unmarshal(m, b)
+
+func (m *InFlightHtlc) Reset()         { *m = InFlightHtlc{} }
+func (m *InFlightHtlc) String() string { return proto.CompactTextString(m) }
+func (*InFlightHtlc) ProtoMessage()    {}
+func (*InFlightHtlc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{150}
 }
-func (m *FeeReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FeeReportRequest.Marshal(b, m, deterministic)
+func (m *InFlightHtlc) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InFlightHtlc.Unmarshal(m, b)
 }
-func (dst *FeeReportRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeeReportRequest.Merge(dst, src)
+func (m *InFlightHtlc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InFlightHtlc.Marshal(b, m, deterministic)
 }
-func (m *FeeReportRequest) XXX_Size() int {
-	return xxx_messageInfo_FeeReportRequest.Size(m)
+func (dst *InFlightHtlc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightHtlc.Merge(dst, src)
 }
-func (m *FeeReportRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeeReportRequest.DiscardUnknown(m)
+func (m *InFlightHtlc) XXX_Size() int {
+	return xxx_messageInfo_InFlightHtlc.Size(m)
+}
+func (m *InFlightHtlc) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightHtlc.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeeReportRequest proto.InternalMessageInfo
+var xxx_messageInfo_InFlightHtlc proto.InternalMessageInfo
 
-type ChannelFeeReport struct {
-	// / The channel that this fee report belongs to.
-	ChanPoint string `protobuf:"bytes,1,opt,name=chan_point,json=channel_point,proto3" json:"chan_point,omitempty"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,2,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
-	// / The amount charged per milli-satoshis transferred expressed in millionths of a satoshi.
-	FeePerMil int64 `protobuf:"varint,3,opt,name=fee_per_mil,proto3" json:"fee_per_mil,omitempty"`
-	// / The effective fee rate in milli-satoshis. Computed by dividing the fee_per_mil value by 1 million.
-	FeeRate              float64  `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *InFlightHtlc) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
 }
 
-func (m *ChannelFeeReport) Reset()         { *m = ChannelFeeReport{} }
-func (m *ChannelFeeReport) String() string { return proto.CompactTextString(m) }
-func (*ChannelFeeReport) ProtoMessage()    {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{107}
+func (m *InFlightHtlc) GetChannelPoint() string {
+	if m != nil {
+		return m.ChannelPoint
+	}
+	return ""
 }
-func (m *ChannelFeeReport) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ChannelFeeReport.Unmarshal(m, b)
+
+func (m *InFlightHtlc) GetIncoming() bool {
+	if m != nil {
+		return m.Incoming
+	}
+	return false
 }
-func (m *ChannelFeeReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ChannelFeeReport.Marshal(b, m, deterministic)
+
+func (m *InFlightHtlc) GetHtlcIndex() uint64 {
+	if m != nil {
+		return m.HtlcIndex
+	}
+	return 0
 }
-func (dst *ChannelFeeReport) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ChannelFeeReport.Merge(dst, src)
+
+func (m *InFlightHtlc) GetAmtMsat() uint64 {
+	if m != nil {
+		return m.AmtMsat
+	}
+	return 0
 }
-func (m *ChannelFeeReport) XXX_Size() int {
-	return xxx_messageInfo_ChannelFeeReport.Size(m)
+
+func (m *InFlightHtlc) GetHashLock() []byte {
+	if m != nil {
+		return m.HashLock
+	}
+	return nil
 }
-func (m *ChannelFeeReport) XXX_DiscardUnknown() {
-	xxx_messageInfo_ChannelFeeReport.DiscardUnknown(m)
+
+func (m *InFlightHtlc) GetExpirationHeight() uint32 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
 }
 
-var xxx_messageInfo_ChannelFeeReport proto.InternalMessageInfo
+func (m *InFlightHtlc) GetBlocksTillExpiry() int32 {
+	if m != nil {
+		return m.BlocksTillExpiry
+	}
+	return 0
+}
 
-func (m *ChannelFeeReport) GetChanPoint() string {
+func (m *InFlightHtlc) GetState() InFlightHtlc_State {
 	if m != nil {
-		return m.ChanPoint
+		return m.State
 	}
-	return ""
+	return InFlightHtlc_UNKNOWN
 }
 
-func (m *ChannelFeeReport) GetBaseFeeMsat() int64 {
+func (m *InFlightHtlc) GetHasCircuit() bool {
 	if m != nil {
-		return m.BaseFeeMsat
+		return m.HasCircuit
 	}
-	return 0
+	return false
 }
 
-func (m *ChannelFeeReport) GetFeePerMil() int64 {
+func (m *InFlightHtlc) GetCircuitChanId() uint64 {
 	if m != nil {
-		return m.FeePerMil
+		return m.CircuitChanId
 	}
 	return 0
 }
 
-func (m *ChannelFeeReport) GetFeeRate() float64 {
+func (m *InFlightHtlc) GetCircuitHtlcIndex() uint64 {
 	if m != nil {
-		return m.FeeRate
+		return m.CircuitHtlcIndex
 	}
 	return 0
 }
 
-type FeeReportResponse struct {
-	// / An array of channel fee reports which describes the current fee schedule for each channel.
-	ChannelFees []*ChannelFeeReport `protobuf:"bytes,1,rep,name=channel_fees,proto3" json:"channel_fees,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 24 hrs.
-	DayFeeSum uint64 `protobuf:"varint,2,opt,name=day_fee_sum,proto3" json:"day_fee_sum,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 week.
-	WeekFeeSum uint64 `protobuf:"varint,3,opt,name=week_fee_sum,proto3" json:"week_fee_sum,omitempty"`
-	// / The total amount of fee revenue (in satoshis) the switch has collected over the past 1 month.
-	MonthFeeSum          uint64   `protobuf:"varint,4,opt,name=month_fee_sum,proto3" json:"month_fee_sum,omitempty"`
+func (m *InFlightHtlc) GetLocalPayment() bool {
+	if m != nil {
+		return m.LocalPayment
+	}
+	return false
+}
+
+type ListHtlcsResponse struct {
+	// / The HTLCs in flight across all open channels.
+	Htlcs                []*InFlightHtlc `protobuf:"bytes,1,rep,name=htlcs,proto3" json:"htlcs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListHtlcsResponse) Reset()         { *m = ListHtlcsResponse{} }
+func (m *ListHtlcsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListHtlcsResponse) ProtoMessage()    {}
+func (*ListHtlcsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{151}
+}
+func (m *ListHtlcsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHtlcsResponse.Unmarshal(m, b)
+}
+func (m *ListHtlcsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHtlcsResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListHtlcsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHtlcsResponse.Merge(dst, src)
+}
+func (m *ListHtlcsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListHtlcsResponse.Size(m)
+}
+func (m *ListHtlcsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHtlcsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHtlcsResponse proto.InternalMessageInfo
+
+func (m *ListHtlcsResponse) GetHtlcs() []*InFlightHtlc {
+	if m != nil {
+		return m.Htlcs
+	}
+	return nil
+}
+
+type UpdateNodeAnnouncementRequest struct {
+	// / The new alias of the node. If empty, the alias is left unchanged.
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// *
+	// The new color of the node, in the form #RRGGBB. If empty, the color is left
+	// unchanged.
+	Color string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	// / The addresses, in the form host:port, to start advertising.
+	AddAddresses []string `protobuf:"bytes,3,rep,name=add_addresses,proto3" json:"add_addresses,omitempty"`
+	// / The addresses, in the form host:port, to stop advertising.
+	RemoveAddresses      []string `protobuf:"bytes,4,rep,name=remove_addresses,proto3" json:"remove_addresses,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *FeeReportResponse) Reset()         { *m = FeeReportResponse{} }
-func (m *FeeReportResponse) String() string { return proto.CompactTextString(m) }
-func (*FeeReportResponse) ProtoMessage()    {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{108}
+func (m *UpdateNodeAnnouncementRequest) Reset()         { *m = UpdateNodeAnnouncementRequest{} }
+func (m *UpdateNodeAnnouncementRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateNodeAnnouncementRequest) ProtoMessage()    {}
+func (*UpdateNodeAnnouncementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{152}
 }
-func (m *FeeReportResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FeeReportResponse.Unmarshal(m, b)
+func (m *UpdateNodeAnnouncementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNodeAnnouncementRequest.Unmarshal(m, b)
 }
-func (m *FeeReportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FeeReportResponse.Marshal(b, m, deterministic)
+func (m *UpdateNodeAnnouncementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNodeAnnouncementRequest.Marshal(b, m, deterministic)
 }
-func (dst *FeeReportResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FeeReportResponse.Merge(dst, src)
+func (dst *UpdateNodeAnnouncementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNodeAnnouncementRequest.Merge(dst, src)
 }
-func (m *FeeReportResponse) XXX_Size() int {
-	return xxx_messageInfo_FeeReportResponse.Size(m)
+func (m *UpdateNodeAnnouncementRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateNodeAnnouncementRequest.Size(m)
 }
-func (m *FeeReportResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FeeReportResponse.DiscardUnknown(m)
+func (m *UpdateNodeAnnouncementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNodeAnnouncementRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FeeReportResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateNodeAnnouncementRequest proto.InternalMessageInfo
 
-func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
+func (m *UpdateNodeAnnouncementRequest) GetAlias() string {
 	if m != nil {
-		return m.ChannelFees
+		return m.Alias
 	}
-	return nil
+	return ""
 }
 
-func (m *FeeReportResponse) GetDayFeeSum() uint64 {
+func (m *UpdateNodeAnnouncementRequest) GetColor() string {
 	if m != nil {
-		return m.DayFeeSum
+		return m.Color
 	}
-	return 0
+	return ""
 }
 
-func (m *FeeReportResponse) GetWeekFeeSum() uint64 {
+func (m *UpdateNodeAnnouncementRequest) GetAddAddresses() []string {
 	if m != nil {
-		return m.WeekFeeSum
+		return m.AddAddresses
 	}
-	return 0
+	return nil
 }
 
-func (m *FeeReportResponse) GetMonthFeeSum() uint64 {
+func (m *UpdateNodeAnnouncementRequest) GetRemoveAddresses() []string {
 	if m != nil {
-		return m.MonthFeeSum
+		return m.RemoveAddresses
 	}
-	return 0
+	return nil
 }
 
-type PolicyUpdateRequest struct {
-	// Types that are valid to be assigned to Scope:
-	//	*PolicyUpdateRequest_Global
-	//	*PolicyUpdateRequest_ChanPoint
-	Scope isPolicyUpdateRequest_Scope `protobuf_oneof:"scope"`
-	// / The base fee charged regardless of the number of milli-satoshis sent.
-	BaseFeeMsat int64 `protobuf:"varint,3,opt,name=base_fee_msat,proto3" json:"base_fee_msat,omitempty"`
-	// / The effective fee rate in milli-satoshis. The precision of this value goes up to 6 decimal places, so 1e-6.
-	FeeRate float64 `protobuf:"fixed64,4,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
-	// / The required timelock delta for HTLCs forwarded over the channel.
-	TimeLockDelta        uint32   `protobuf:"varint,5,opt,name=time_lock_delta,proto3" json:"time_lock_delta,omitempty"`
+type UpdateNodeAnnouncementResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateRequest) Reset()         { *m = PolicyUpdateRequest{} }
-func (m *PolicyUpdateRequest) String() string { return proto.CompactTextString(m) }
-func (*PolicyUpdateRequest) ProtoMessage()    {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{109}
+func (m *UpdateNodeAnnouncementResponse) Reset()         { *m = UpdateNodeAnnouncementResponse{} }
+func (m *UpdateNodeAnnouncementResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateNodeAnnouncementResponse) ProtoMessage()    {}
+func (*UpdateNodeAnnouncementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{153}
 }
-func (m *PolicyUpdateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyUpdateRequest.Unmarshal(m, b)
+func (m *UpdateNodeAnnouncementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateNodeAnnouncementResponse.Unmarshal(m, b)
 }
-func (m *PolicyUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyUpdateRequest.Marshal(b, m, deterministic)
+func (m *UpdateNodeAnnouncementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateNodeAnnouncementResponse.Marshal(b, m, deterministic)
 }
-func (dst *PolicyUpdateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyUpdateRequest.Merge(dst, src)
+func (dst *UpdateNodeAnnouncementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateNodeAnnouncementResponse.Merge(dst, src)
 }
-func (m *PolicyUpdateRequest) XXX_Size() int {
-	return xxx_messageInfo_PolicyUpdateRequest.Size(m)
+func (m *UpdateNodeAnnouncementResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateNodeAnnouncementResponse.Size(m)
 }
-func (m *PolicyUpdateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateRequest.DiscardUnknown(m)
+func (m *UpdateNodeAnnouncementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateNodeAnnouncementResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyUpdateRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpdateNodeAnnouncementResponse proto.InternalMessageInfo
 
-type isPolicyUpdateRequest_Scope interface {
-	isPolicyUpdateRequest_Scope()
+type ListBannedPeersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type PolicyUpdateRequest_Global struct {
-	Global bool `protobuf:"varint,1,opt,name=global,proto3,oneof"`
+func (m *ListBannedPeersRequest) Reset()         { *m = ListBannedPeersRequest{} }
+func (m *ListBannedPeersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBannedPeersRequest) ProtoMessage()    {}
+func (*ListBannedPeersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{154}
 }
-
-type PolicyUpdateRequest_ChanPoint struct {
-	ChanPoint *ChannelPoint `protobuf:"bytes,2,opt,name=chan_point,proto3,oneof"`
+func (m *ListBannedPeersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBannedPeersRequest.Unmarshal(m, b)
+}
+func (m *ListBannedPeersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBannedPeersRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListBannedPeersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBannedPeersRequest.Merge(dst, src)
+}
+func (m *ListBannedPeersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBannedPeersRequest.Size(m)
+}
+func (m *ListBannedPeersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBannedPeersRequest.DiscardUnknown(m)
 }
 
-func (*PolicyUpdateRequest_Global) isPolicyUpdateRequest_Scope() {}
-
-func (*PolicyUpdateRequest_ChanPoint) isPolicyUpdateRequest_Scope() {}
+var xxx_messageInfo_ListBannedPeersRequest proto.InternalMessageInfo
 
-func (m *PolicyUpdateRequest) GetScope() isPolicyUpdateRequest_Scope {
-	if m != nil {
-		return m.Scope
-	}
-	return nil
+type BannedPeer struct {
+	// / The identity pubkey of the banned peer.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
+	// / The unix timestamp at which the peer was banned.
+	BannedAt int64 `protobuf:"varint,2,opt,name=banned_at,proto3" json:"banned_at,omitempty"`
+	// / Why the peer was banned.
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateRequest) GetGlobal() bool {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_Global); ok {
-		return x.Global
-	}
-	return false
+func (m *BannedPeer) Reset()         { *m = BannedPeer{} }
+func (m *BannedPeer) String() string { return proto.CompactTextString(m) }
+func (*BannedPeer) ProtoMessage()    {}
+func (*BannedPeer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{155}
 }
-
-func (m *PolicyUpdateRequest) GetChanPoint() *ChannelPoint {
-	if x, ok := m.GetScope().(*PolicyUpdateRequest_ChanPoint); ok {
-		return x.ChanPoint
-	}
-	return nil
+func (m *BannedPeer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BannedPeer.Unmarshal(m, b)
+}
+func (m *BannedPeer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BannedPeer.Marshal(b, m, deterministic)
+}
+func (dst *BannedPeer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BannedPeer.Merge(dst, src)
+}
+func (m *BannedPeer) XXX_Size() int {
+	return xxx_messageInfo_BannedPeer.Size(m)
+}
+func (m *BannedPeer) XXX_DiscardUnknown() {
+	xxx_messageInfo_BannedPeer.DiscardUnknown(m)
 }
 
-func (m *PolicyUpdateRequest) GetBaseFeeMsat() int64 {
+var xxx_messageInfo_BannedPeer proto.InternalMessageInfo
+
+func (m *BannedPeer) GetPubKey() string {
 	if m != nil {
-		return m.BaseFeeMsat
+		return m.PubKey
 	}
-	return 0
+	return ""
 }
 
-func (m *PolicyUpdateRequest) GetFeeRate() float64 {
+func (m *BannedPeer) GetBannedAt() int64 {
 	if m != nil {
-		return m.FeeRate
+		return m.BannedAt
 	}
 	return 0
 }
 
-func (m *PolicyUpdateRequest) GetTimeLockDelta() uint32 {
+func (m *BannedPeer) GetReason() string {
 	if m != nil {
-		return m.TimeLockDelta
+		return m.Reason
 	}
-	return 0
+	return ""
 }
 
-// XXX_OneofFuncs is for the internal use of the proto package.
-func (*PolicyUpdateRequest) XXX_OneofFuncs() (func(msg proto.Message, b *proto.Buffer) error, func(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error), func(msg proto.Message) (n int), []interface{}) {
-	return _PolicyUpdateRequest_OneofMarshaler, _PolicyUpdateRequest_OneofUnmarshaler, _PolicyUpdateRequest_OneofSizer, []interface{}{
-		(*PolicyUpdateRequest_Global)(nil),
-		(*PolicyUpdateRequest_ChanPoint)(nil),
-	}
+type ListBannedPeersResponse struct {
+	// / The peers we've banned.
+	Peers                []*BannedPeer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func _PolicyUpdateRequest_OneofMarshaler(msg proto.Message, b *proto.Buffer) error {
-	m := msg.(*PolicyUpdateRequest)
-	// scope
-	switch x := m.Scope.(type) {
-	case *PolicyUpdateRequest_Global:
-		t := uint64(0)
-		if x.Global {
-			t = 1
-		}
-		b.EncodeVarint(1<<3 | proto.WireVarint)
-		b.EncodeVarint(t)
-	case *PolicyUpdateRequest_ChanPoint:
-		b.EncodeVarint(2<<3 | proto.WireBytes)
-		if err := b.EncodeMessage(x.ChanPoint); err != nil {
-			return err
-		}
-	case nil:
-	default:
-		return fmt.Errorf("PolicyUpdateRequest.Scope has unexpected type %T", x)
-	}
-	return nil
+func (m *ListBannedPeersResponse) Reset()         { *m = ListBannedPeersResponse{} }
+func (m *ListBannedPeersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListBannedPeersResponse) ProtoMessage()    {}
+func (*ListBannedPeersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{156}
 }
-
-func _PolicyUpdateRequest_OneofUnmarshaler(msg proto.Message, tag, wire int, b *proto.Buffer) (bool, error) {
-	m := msg.(*PolicyUpdateRequest)
-	switch tag {
-	case 1: // scope.global
-		if wire != proto.WireVarint {
-			return true, proto.ErrInternalBadWireType
-		}
-		x, err := b.DecodeVarint()
-		m.Scope = &PolicyUpdateRequest_Global{x != 0}
-		return true, err
-	case 2: // scope.chan_point
-		if wire != proto.WireBytes {
-			return true, proto.ErrInternalBadWireType
-		}
-		msg := new(ChannelPoint)
-		err := b.DecodeMessage(msg)
-		m.Scope = &PolicyUpdateRequest_ChanPoint{msg}
-		return true, err
-	default:
-		return false, nil
-	}
+func (m *ListBannedPeersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBannedPeersResponse.Unmarshal(m, b)
+}
+func (m *ListBannedPeersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBannedPeersResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListBannedPeersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBannedPeersResponse.Merge(dst, src)
+}
+func (m *ListBannedPeersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListBannedPeersResponse.Size(m)
+}
+func (m *ListBannedPeersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBannedPeersResponse.DiscardUnknown(m)
 }
 
-func _PolicyUpdateRequest_OneofSizer(msg proto.Message) (n int) {
-	m := msg.(*PolicyUpdateRequest)
-	// scope
-	switch x := m.Scope.(type) {
-	case *PolicyUpdateRequest_Global:
-		n += 1 // tag and wire
-		n += 1
-	case *PolicyUpdateRequest_ChanPoint:
-		s := proto.Size(x.ChanPoint)
-		n += 1 // tag and wire
-		n += proto.SizeVarint(uint64(s))
-		n += s
-	case nil:
-	default:
-		panic(fmt.Sprintf("proto: unexpected type %T in oneof", x))
+var xxx_messageInfo_ListBannedPeersResponse proto.InternalMessageInfo
+
+func (m *ListBannedPeersResponse) GetPeers() []*BannedPeer {
+	if m != nil {
+		return m.Peers
 	}
-	return n
+	return nil
 }
 
-type PolicyUpdateResponse struct {
+type UnbanPeerRequest struct {
+	// / The identity pubkey of the peer to unban.
+	PubKey               string   `protobuf:"bytes,1,opt,name=pub_key,proto3" json:"pub_key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PolicyUpdateResponse) Reset()         { *m = PolicyUpdateResponse{} }
-func (m *PolicyUpdateResponse) String() string { return proto.CompactTextString(m) }
-func (*PolicyUpdateResponse) ProtoMessage()    {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{110}
+func (m *UnbanPeerRequest) Reset()         { *m = UnbanPeerRequest{} }
+func (m *UnbanPeerRequest) String() string { return proto.CompactTextString(m) }
+func (*UnbanPeerRequest) ProtoMessage()    {}
+func (*UnbanPeerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{157}
 }
-func (m *PolicyUpdateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PolicyUpdateResponse.Unmarshal(m, b)
+func (m *UnbanPeerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanPeerRequest.Unmarshal(m, b)
 }
-func (m *PolicyUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PolicyUpdateResponse.Marshal(b, m, deterministic)
+func (m *UnbanPeerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanPeerRequest.Marshal(b, m, deterministic)
 }
-func (dst *PolicyUpdateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PolicyUpdateResponse.Merge(dst, src)
+func (dst *UnbanPeerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanPeerRequest.Merge(dst, src)
 }
-func (m *PolicyUpdateResponse) XXX_Size() int {
-	return xxx_messageInfo_PolicyUpdateResponse.Size(m)
+func (m *UnbanPeerRequest) XXX_Size() int {
+	return xxx_messageInfo_UnbanPeerRequest.Size(m)
 }
-func (m *PolicyUpdateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_PolicyUpdateResponse.DiscardUnknown(m)
+func (m *UnbanPeerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanPeerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PolicyUpdateResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnbanPeerRequest proto.InternalMessageInfo
 
-type ForwardingHistoryRequest struct {
-	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
-	StartTime uint64 `protobuf:"varint,1,opt,name=start_time,proto3" json:"start_time,omitempty"`
-	// / End time is the end point of the forwarding history request. The response will carry at most 50k records between the start time and the end time. The index offset can be used to implement pagination.
-	EndTime uint64 `protobuf:"varint,2,opt,name=end_time,proto3" json:"end_time,omitempty"`
-	// / Index offset is the offset in the time series to start at. As each response can only contain 50k records, callers can use this to skip around within a packed time series.
-	IndexOffset uint32 `protobuf:"varint,3,opt,name=index_offset,proto3" json:"index_offset,omitempty"`
-	// / The max number of events to return in the response to this query.
-	NumMaxEvents         uint32   `protobuf:"varint,4,opt,name=num_max_events,proto3" json:"num_max_events,omitempty"`
+func (m *UnbanPeerRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type UnbanPeerResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingHistoryRequest) Reset()         { *m = ForwardingHistoryRequest{} }
-func (m *ForwardingHistoryRequest) String() string { return proto.CompactTextString(m) }
-func (*ForwardingHistoryRequest) ProtoMessage()    {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{111}
+func (m *UnbanPeerResponse) Reset()         { *m = UnbanPeerResponse{} }
+func (m *UnbanPeerResponse) String() string { return proto.CompactTextString(m) }
+func (*UnbanPeerResponse) ProtoMessage()    {}
+func (*UnbanPeerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{158}
 }
-func (m *ForwardingHistoryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingHistoryRequest.Unmarshal(m, b)
+func (m *UnbanPeerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnbanPeerResponse.Unmarshal(m, b)
 }
-func (m *ForwardingHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingHistoryRequest.Marshal(b, m, deterministic)
+func (m *UnbanPeerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnbanPeerResponse.Marshal(b, m, deterministic)
 }
-func (dst *ForwardingHistoryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingHistoryRequest.Merge(dst, src)
+func (dst *UnbanPeerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbanPeerResponse.Merge(dst, src)
 }
-func (m *ForwardingHistoryRequest) XXX_Size() int {
-	return xxx_messageInfo_ForwardingHistoryRequest.Size(m)
+func (m *UnbanPeerResponse) XXX_Size() int {
+	return xxx_messageInfo_UnbanPeerResponse.Size(m)
 }
-func (m *ForwardingHistoryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingHistoryRequest.DiscardUnknown(m)
+func (m *UnbanPeerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbanPeerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbanPeerResponse proto.InternalMessageInfo
+
+type RPCMiddlewareRequest struct {
+	// / The ID of the intercepted message, to be referenced by the feedback.
+	MsgId uint64 `protobuf:"varint,1,opt,name=msg_id,proto3" json:"msg_id,omitempty"`
+	// / The full URI of the intercepted RPC, e.g. /lnrpc.Lightning/GetInfo.
+	FullUri string `protobuf:"bytes,2,opt,name=full_uri,proto3" json:"full_uri,omitempty"`
+	// / Whether the intercepted message is a response rather than a request.
+	IsResponse bool `protobuf:"varint,3,opt,name=is_response,proto3" json:"is_response,omitempty"`
+	// / The fully qualified proto name of the intercepted message.
+	TypeName string `protobuf:"bytes,4,opt,name=type_name,proto3" json:"type_name,omitempty"`
+	// / The intercepted message, serialized in the proto wire format.
+	Serialized []byte `protobuf:"bytes,5,opt,name=serialized,proto3" json:"serialized,omitempty"`
+	// / The macaroon the RPC was authenticated with.
+	RawMacaroon []byte `protobuf:"bytes,6,opt,name=raw_macaroon,proto3" json:"raw_macaroon,omitempty"`
+	// / The condition of the custom caveat the middleware is registered for.
+	CustomCaveatCondition string   `protobuf:"bytes,7,opt,name=custom_caveat_condition,proto3" json:"custom_caveat_condition,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *RPCMiddlewareRequest) Reset()         { *m = RPCMiddlewareRequest{} }
+func (m *RPCMiddlewareRequest) String() string { return proto.CompactTextString(m) }
+func (*RPCMiddlewareRequest) ProtoMessage()    {}
+func (*RPCMiddlewareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{159}
+}
+func (m *RPCMiddlewareRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RPCMiddlewareRequest.Unmarshal(m, b)
+}
+func (m *RPCMiddlewareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RPCMiddlewareRequest.Marshal(b, m, deterministic)
+}
+func (dst *RPCMiddlewareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCMiddlewareRequest.Merge(dst, src)
+}
+func (m *RPCMiddlewareRequest) XXX_Size() int {
+	return xxx_messageInfo_RPCMiddlewareRequest.Size(m)
+}
+func (m *RPCMiddlewareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCMiddlewareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCMiddlewareRequest proto.InternalMessageInfo
+
+func (m *RPCMiddlewareRequest) GetMsgId() uint64 {
+	if m != nil {
+		return m.MsgId
+	}
+	return 0
+}
+
+func (m *RPCMiddlewareRequest) GetFullUri() string {
+	if m != nil {
+		return m.FullUri
+	}
+	return ""
+}
+
+func (m *RPCMiddlewareRequest) GetIsResponse() bool {
+	if m != nil {
+		return m.IsResponse
+	}
+	return false
+}
+
+func (m *RPCMiddlewareRequest) GetTypeName() string {
+	if m != nil {
+		return m.TypeName
+	}
+	return ""
 }
 
-var xxx_messageInfo_ForwardingHistoryRequest proto.InternalMessageInfo
+func (m *RPCMiddlewareRequest) GetSerialized() []byte {
+	if m != nil {
+		return m.Serialized
+	}
+	return nil
+}
 
-func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
+func (m *RPCMiddlewareRequest) GetRawMacaroon() []byte {
 	if m != nil {
-		return m.StartTime
+		return m.RawMacaroon
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetEndTime() uint64 {
+func (m *RPCMiddlewareRequest) GetCustomCaveatCondition() string {
 	if m != nil {
-		return m.EndTime
+		return m.CustomCaveatCondition
 	}
-	return 0
+	return ""
 }
 
-func (m *ForwardingHistoryRequest) GetIndexOffset() uint32 {
+type RPCMiddlewareResponse struct {
+	// *
+	// The registration of the middleware. This must be set in the first
+	// message sent over the stream, and only in that one.
+	Register *MiddlewareRegistration `protobuf:"bytes,1,opt,name=register,proto3" json:"register,omitempty"`
+	// / The feedback for an intercepted message.
+	Feedback             *InterceptFeedback `protobuf:"bytes,2,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *RPCMiddlewareResponse) Reset()         { *m = RPCMiddlewareResponse{} }
+func (m *RPCMiddlewareResponse) String() string { return proto.CompactTextString(m) }
+func (*RPCMiddlewareResponse) ProtoMessage()    {}
+func (*RPCMiddlewareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{160}
+}
+func (m *RPCMiddlewareResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RPCMiddlewareResponse.Unmarshal(m, b)
+}
+func (m *RPCMiddlewareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RPCMiddlewareResponse.Marshal(b, m, deterministic)
+}
+func (dst *RPCMiddlewareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCMiddlewareResponse.Merge(dst, src)
+}
+func (m *RPCMiddlewareResponse) XXX_Size() int {
+	return xxx_messageInfo_RPCMiddlewareResponse.Size(m)
+}
+func (m *RPCMiddlewareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCMiddlewareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCMiddlewareResponse proto.InternalMessageInfo
+
+func (m *RPCMiddlewareResponse) GetRegister() *MiddlewareRegistration {
 	if m != nil {
-		return m.IndexOffset
+		return m.Register
 	}
-	return 0
+	return nil
 }
 
-func (m *ForwardingHistoryRequest) GetNumMaxEvents() uint32 {
+func (m *RPCMiddlewareResponse) GetFeedback() *InterceptFeedback {
 	if m != nil {
-		return m.NumMaxEvents
+		return m.Feedback
 	}
-	return 0
+	return nil
 }
 
-type ForwardingEvent struct {
-	// / Timestamp is the time (unix epoch offset) that this circuit was completed.
-	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	// / The incoming channel ID that carried the HTLC that created the circuit.
-	ChanIdIn uint64 `protobuf:"varint,2,opt,name=chan_id_in,proto3" json:"chan_id_in,omitempty"`
-	// / The outgoing channel ID that carried the preimage that completed the circuit.
-	ChanIdOut uint64 `protobuf:"varint,4,opt,name=chan_id_out,proto3" json:"chan_id_out,omitempty"`
-	// / The total amount (in satoshis) of the incoming HTLC that created half the circuit.
-	AmtIn uint64 `protobuf:"varint,5,opt,name=amt_in,proto3" json:"amt_in,omitempty"`
-	// / The total amount (in satoshis) of the outgoing HTLC that created the second half of the circuit.
-	AmtOut uint64 `protobuf:"varint,6,opt,name=amt_out,proto3" json:"amt_out,omitempty"`
-	// / The total fee (in satoshis) that this payment circuit carried.
-	Fee uint64 `protobuf:"varint,7,opt,name=fee,proto3" json:"fee,omitempty"`
-	// / The total fee (in milli-satoshis) that this payment circuit carried.
-	FeeMsat              uint64   `protobuf:"varint,8,opt,name=fee_msat,proto3" json:"fee_msat,omitempty"`
+type MiddlewareRegistration struct {
+	// / The name of the middleware, used for logging.
+	MiddlewareName string `protobuf:"bytes,1,opt,name=middleware_name,proto3" json:"middleware_name,omitempty"`
+	// *
+	// The name of the custom macaroon caveat the middleware intercepts the
+	// RPCs of. Only one middleware can be registered for each caveat name.
+	CustomCaveatName     string   `protobuf:"bytes,2,opt,name=custom_caveat_name,proto3" json:"custom_caveat_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingEvent) Reset()         { *m = ForwardingEvent{} }
-func (m *ForwardingEvent) String() string { return proto.CompactTextString(m) }
-func (*ForwardingEvent) ProtoMessage()    {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{112}
+func (m *MiddlewareRegistration) Reset()         { *m = MiddlewareRegistration{} }
+func (m *MiddlewareRegistration) String() string { return proto.CompactTextString(m) }
+func (*MiddlewareRegistration) ProtoMessage()    {}
+func (*MiddlewareRegistration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{161}
 }
-func (m *ForwardingEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingEvent.Unmarshal(m, b)
+func (m *MiddlewareRegistration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MiddlewareRegistration.Unmarshal(m, b)
 }
-func (m *ForwardingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingEvent.Marshal(b, m, deterministic)
+func (m *MiddlewareRegistration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MiddlewareRegistration.Marshal(b, m, deterministic)
 }
-func (dst *ForwardingEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingEvent.Merge(dst, src)
+func (dst *MiddlewareRegistration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MiddlewareRegistration.Merge(dst, src)
 }
-func (m *ForwardingEvent) XXX_Size() int {
-	return xxx_messageInfo_ForwardingEvent.Size(m)
+func (m *MiddlewareRegistration) XXX_Size() int {
+	return xxx_messageInfo_MiddlewareRegistration.Size(m)
 }
-func (m *ForwardingEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingEvent.DiscardUnknown(m)
+func (m *MiddlewareRegistration) XXX_DiscardUnknown() {
+	xxx_messageInfo_MiddlewareRegistration.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardingEvent proto.InternalMessageInfo
+var xxx_messageInfo_MiddlewareRegistration proto.InternalMessageInfo
 
-func (m *ForwardingEvent) GetTimestamp() uint64 {
+func (m *MiddlewareRegistration) GetMiddlewareName() string {
 	if m != nil {
-		return m.Timestamp
+		return m.MiddlewareName
 	}
-	return 0
+	return ""
 }
 
-func (m *ForwardingEvent) GetChanIdIn() uint64 {
+func (m *MiddlewareRegistration) GetCustomCaveatName() string {
 	if m != nil {
-		return m.ChanIdIn
+		return m.CustomCaveatName
 	}
-	return 0
+	return ""
 }
 
-func (m *ForwardingEvent) GetChanIdOut() uint64 {
-	if m != nil {
-		return m.ChanIdOut
-	}
-	return 0
+type InterceptFeedback struct {
+	// / The ID of the intercepted message this feedback applies to.
+	RefMsgId uint64 `protobuf:"varint,1,opt,name=ref_msg_id,proto3" json:"ref_msg_id,omitempty"`
+	// *
+	// If non-empty, the intercepted message is rejected, and the RPC fails
+	// with this error.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// / Whether the intercepted message should be replaced.
+	ReplaceMessage bool `protobuf:"varint,3,opt,name=replace_message,proto3" json:"replace_message,omitempty"`
+	// *
+	// The message to replace the intercepted one with, serialized in the proto
+	// wire format. It must be of the same type as the intercepted message.
+	ReplacementSerialized []byte   `protobuf:"bytes,4,opt,name=replacement_serialized,proto3" json:"replacement_serialized,omitempty"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
 }
 
-func (m *ForwardingEvent) GetAmtIn() uint64 {
+func (m *InterceptFeedback) Reset()         { *m = InterceptFeedback{} }
+func (m *InterceptFeedback) String() string { return proto.CompactTextString(m) }
+func (*InterceptFeedback) ProtoMessage()    {}
+func (*InterceptFeedback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{162}
+}
+func (m *InterceptFeedback) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InterceptFeedback.Unmarshal(m, b)
+}
+func (m *InterceptFeedback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InterceptFeedback.Marshal(b, m, deterministic)
+}
+func (dst *InterceptFeedback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InterceptFeedback.Merge(dst, src)
+}
+func (m *InterceptFeedback) XXX_Size() int {
+	return xxx_messageInfo_InterceptFeedback.Size(m)
+}
+func (m *InterceptFeedback) XXX_DiscardUnknown() {
+	xxx_messageInfo_InterceptFeedback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InterceptFeedback proto.InternalMessageInfo
+
+func (m *InterceptFeedback) GetRefMsgId() uint64 {
 	if m != nil {
-		return m.AmtIn
+		return m.RefMsgId
 	}
 	return 0
 }
 
-func (m *ForwardingEvent) GetAmtOut() uint64 {
+func (m *InterceptFeedback) GetError() string {
 	if m != nil {
-		return m.AmtOut
+		return m.Error
 	}
-	return 0
+	return ""
 }
 
-func (m *ForwardingEvent) GetFee() uint64 {
+func (m *InterceptFeedback) GetReplaceMessage() bool {
 	if m != nil {
-		return m.Fee
+		return m.ReplaceMessage
 	}
-	return 0
+	return false
 }
 
-func (m *ForwardingEvent) GetFeeMsat() uint64 {
+func (m *InterceptFeedback) GetReplacementSerialized() []byte {
 	if m != nil {
-		return m.FeeMsat
+		return m.ReplacementSerialized
 	}
-	return 0
+	return nil
 }
 
-type ForwardingHistoryResponse struct {
-	// / A list of forwarding events from the time slice of the time series specified in the request.
-	ForwardingEvents []*ForwardingEvent `protobuf:"bytes,1,rep,name=forwarding_events,proto3" json:"forwarding_events,omitempty"`
-	// / The index of the last time in the set of returned forwarding events. Can be used to seek further, pagination style.
-	LastOffsetIndex      uint32   `protobuf:"varint,2,opt,name=last_offset_index,proto3" json:"last_offset_index,omitempty"`
+type SubscribeStateRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ForwardingHistoryResponse) Reset()         { *m = ForwardingHistoryResponse{} }
-func (m *ForwardingHistoryResponse) String() string { return proto.CompactTextString(m) }
-func (*ForwardingHistoryResponse) ProtoMessage()    {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rpc_cb256459ade06eab, []int{113}
+func (m *SubscribeStateRequest) Reset()         { *m = SubscribeStateRequest{} }
+func (m *SubscribeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeStateRequest) ProtoMessage()    {}
+func (*SubscribeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{163}
 }
-func (m *ForwardingHistoryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ForwardingHistoryResponse.Unmarshal(m, b)
+func (m *SubscribeStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeStateRequest.Unmarshal(m, b)
 }
-func (m *ForwardingHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ForwardingHistoryResponse.Marshal(b, m, deterministic)
+func (m *SubscribeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeStateRequest.Marshal(b, m, deterministic)
 }
-func (dst *ForwardingHistoryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ForwardingHistoryResponse.Merge(dst, src)
+func (dst *SubscribeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeStateRequest.Merge(dst, src)
 }
-func (m *ForwardingHistoryResponse) XXX_Size() int {
-	return xxx_messageInfo_ForwardingHistoryResponse.Size(m)
+func (m *SubscribeStateRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeStateRequest.Size(m)
 }
-func (m *ForwardingHistoryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ForwardingHistoryResponse.DiscardUnknown(m)
+func (m *SubscribeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ForwardingHistoryResponse proto.InternalMessageInfo
+var xxx_messageInfo_SubscribeStateRequest proto.InternalMessageInfo
 
-func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
+type SubscribeStateResponse struct {
+	// / The current state of lnd.
+	State                WalletState `protobuf:"varint,1,opt,name=state,proto3,enum=lnrpc.WalletState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SubscribeStateResponse) Reset()         { *m = SubscribeStateResponse{} }
+func (m *SubscribeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeStateResponse) ProtoMessage()    {}
+func (*SubscribeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{164}
+}
+func (m *SubscribeStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeStateResponse.Unmarshal(m, b)
+}
+func (m *SubscribeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubscribeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeStateResponse.Merge(dst, src)
+}
+func (m *SubscribeStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeStateResponse.Size(m)
+}
+func (m *SubscribeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeStateResponse proto.InternalMessageInfo
+
+func (m *SubscribeStateResponse) GetState() WalletState {
 	if m != nil {
-		return m.ForwardingEvents
+		return m.State
 	}
-	return nil
+	return WalletState_NON_EXISTING
 }
 
-func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
+type GetStateRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStateRequest) Reset()         { *m = GetStateRequest{} }
+func (m *GetStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStateRequest) ProtoMessage()    {}
+func (*GetStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{165}
+}
+func (m *GetStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateRequest.Unmarshal(m, b)
+}
+func (m *GetStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateRequest.Merge(dst, src)
+}
+func (m *GetStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStateRequest.Size(m)
+}
+func (m *GetStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateRequest proto.InternalMessageInfo
+
+type GetStateResponse struct {
+	// / The current state of lnd.
+	State                WalletState `protobuf:"varint,1,opt,name=state,proto3,enum=lnrpc.WalletState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetStateResponse) Reset()         { *m = GetStateResponse{} }
+func (m *GetStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStateResponse) ProtoMessage()    {}
+func (*GetStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_rpc_6ead92cf3f5e6752, []int{166}
+}
+func (m *GetStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStateResponse.Unmarshal(m, b)
+}
+func (m *GetStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStateResponse.Merge(dst, src)
+}
+func (m *GetStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStateResponse.Size(m)
+}
+func (m *GetStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStateResponse proto.InternalMessageInfo
+
+func (m *GetStateResponse) GetState() WalletState {
 	if m != nil {
-		return m.LastOffsetIndex
+		return m.State
 	}
-	return 0
+	return WalletState_NON_EXISTING
 }
 
 func init() {
@@ -7942,8 +11645,14 @@ func init() {
 	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
 	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
 	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
+	proto.RegisterType((*CheckPeerConnectivityRequest)(nil), "lnrpc.CheckPeerConnectivityRequest")
+	proto.RegisterType((*CheckPeerConnectivityResponse)(nil), "lnrpc.CheckPeerConnectivityResponse")
+	proto.RegisterType((*DrainPeerRequest)(nil), "lnrpc.DrainPeerRequest")
+	proto.RegisterType((*ChannelDrainState)(nil), "lnrpc.ChannelDrainState")
+	proto.RegisterType((*DrainPeerUpdate)(nil), "lnrpc.DrainPeerUpdate")
 	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
 	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
+	proto.RegisterType((*ChannelConstraints)(nil), "lnrpc.ChannelConstraints")
 	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
 	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
 	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
@@ -7952,9 +11661,17 @@ func init() {
 	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
 	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
 	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "lnrpc.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "lnrpc.GetRecoveryInfoResponse")
+	proto.RegisterType((*SubsystemHealth)(nil), "lnrpc.SubsystemHealth")
+	proto.RegisterType((*GetHealthRequest)(nil), "lnrpc.GetHealthRequest")
+	proto.RegisterType((*GetHealthResponse)(nil), "lnrpc.GetHealthResponse")
+	proto.RegisterType((*ChainBackendEventSubscription)(nil), "lnrpc.ChainBackendEventSubscription")
+	proto.RegisterType((*ChainBackendEvent)(nil), "lnrpc.ChainBackendEvent")
 	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
 	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
 	proto.RegisterType((*Chain)(nil), "lnrpc.Chain")
+	proto.RegisterType((*Feature)(nil), "lnrpc.Feature")
 	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
 	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
 	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
@@ -7963,6 +11680,12 @@ func init() {
 	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
 	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
 	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
+	proto.RegisterType((*ChannelAcceptRequest)(nil), "lnrpc.ChannelAcceptRequest")
+	proto.RegisterType((*ChannelAcceptResponse)(nil), "lnrpc.ChannelAcceptResponse")
+	proto.RegisterType((*FundingCancel)(nil), "lnrpc.FundingCancel")
+	proto.RegisterType((*FundingBump)(nil), "lnrpc.FundingBump")
+	proto.RegisterType((*FundingTransitionMsg)(nil), "lnrpc.FundingTransitionMsg")
+	proto.RegisterType((*FundingStateStepResp)(nil), "lnrpc.FundingStateStepResp")
 	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
 	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
 	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
@@ -7978,6 +11701,7 @@ func init() {
 	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
 	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
 	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
+	proto.RegisterType((*EdgeLocator)(nil), "lnrpc.EdgeLocator")
 	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
 	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
 	proto.RegisterType((*Route)(nil), "lnrpc.Route")
@@ -8002,6 +11726,7 @@ func init() {
 	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
 	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
 	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
+	proto.RegisterType((*InvoiceHTLC)(nil), "lnrpc.InvoiceHTLC")
 	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
 	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
 	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
@@ -8012,10 +11737,16 @@ func init() {
 	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
 	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
 	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
+	proto.RegisterType((*ExportPaymentProofRequest)(nil), "lnrpc.ExportPaymentProofRequest")
+	proto.RegisterType((*PaymentProof)(nil), "lnrpc.PaymentProof")
+	proto.RegisterType((*VerifyPaymentProofResponse)(nil), "lnrpc.VerifyPaymentProofResponse")
 	proto.RegisterType((*AbandonChannelRequest)(nil), "lnrpc.AbandonChannelRequest")
 	proto.RegisterType((*AbandonChannelResponse)(nil), "lnrpc.AbandonChannelResponse")
 	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
 	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
+	proto.RegisterType((*VerifyChanStateRequest)(nil), "lnrpc.VerifyChanStateRequest")
+	proto.RegisterType((*ChanStateVerification)(nil), "lnrpc.ChanStateVerification")
+	proto.RegisterType((*VerifyChanStateResponse)(nil), "lnrpc.VerifyChanStateResponse")
 	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
 	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
 	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
@@ -8023,13 +11754,45 @@ func init() {
 	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
 	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
 	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*FeeClamp)(nil), "lnrpc.FeeClamp")
+	proto.RegisterType((*ListFeeClampsRequest)(nil), "lnrpc.ListFeeClampsRequest")
+	proto.RegisterType((*ListFeeClampsResponse)(nil), "lnrpc.ListFeeClampsResponse")
+	proto.RegisterType((*UpdateFeeClampResponse)(nil), "lnrpc.UpdateFeeClampResponse")
 	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
 	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
 	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*SwitchStatsRequest)(nil), "lnrpc.SwitchStatsRequest")
+	proto.RegisterType((*LinkStats)(nil), "lnrpc.LinkStats")
+	proto.RegisterType((*SwitchStatsResponse)(nil), "lnrpc.SwitchStatsResponse")
+	proto.RegisterType((*ListHtlcsRequest)(nil), "lnrpc.ListHtlcsRequest")
+	proto.RegisterType((*InFlightHtlc)(nil), "lnrpc.InFlightHtlc")
+	proto.RegisterType((*ListHtlcsResponse)(nil), "lnrpc.ListHtlcsResponse")
+	proto.RegisterType((*UpdateNodeAnnouncementRequest)(nil), "lnrpc.UpdateNodeAnnouncementRequest")
+	proto.RegisterType((*UpdateNodeAnnouncementResponse)(nil), "lnrpc.UpdateNodeAnnouncementResponse")
+	proto.RegisterType((*ListBannedPeersRequest)(nil), "lnrpc.ListBannedPeersRequest")
+	proto.RegisterType((*BannedPeer)(nil), "lnrpc.BannedPeer")
+	proto.RegisterType((*ListBannedPeersResponse)(nil), "lnrpc.ListBannedPeersResponse")
+	proto.RegisterType((*UnbanPeerRequest)(nil), "lnrpc.UnbanPeerRequest")
+	proto.RegisterType((*UnbanPeerResponse)(nil), "lnrpc.UnbanPeerResponse")
+	proto.RegisterType((*RPCMiddlewareRequest)(nil), "lnrpc.RPCMiddlewareRequest")
+	proto.RegisterType((*RPCMiddlewareResponse)(nil), "lnrpc.RPCMiddlewareResponse")
+	proto.RegisterType((*MiddlewareRegistration)(nil), "lnrpc.MiddlewareRegistration")
+	proto.RegisterType((*InterceptFeedback)(nil), "lnrpc.InterceptFeedback")
+	proto.RegisterType((*SubscribeStateRequest)(nil), "lnrpc.SubscribeStateRequest")
+	proto.RegisterType((*SubscribeStateResponse)(nil), "lnrpc.SubscribeStateResponse")
+	proto.RegisterType((*GetStateRequest)(nil), "lnrpc.GetStateRequest")
+	proto.RegisterType((*GetStateResponse)(nil), "lnrpc.GetStateResponse")
 	proto.RegisterEnum("lnrpc.AddressType", AddressType_name, AddressType_value)
+	proto.RegisterEnum("lnrpc.SubsystemStatus", SubsystemStatus_name, SubsystemStatus_value)
+	proto.RegisterEnum("lnrpc.ChainBackendEventType", ChainBackendEventType_name, ChainBackendEventType_value)
+	proto.RegisterEnum("lnrpc.CommitmentType", CommitmentType_name, CommitmentType_value)
+	proto.RegisterEnum("lnrpc.FeeConsumer", FeeConsumer_name, FeeConsumer_value)
+	proto.RegisterEnum("lnrpc.WalletState", WalletState_name, WalletState_value)
+	proto.RegisterEnum("lnrpc.CheckPeerConnectivityResponse_Stage", CheckPeerConnectivityResponse_Stage_name, CheckPeerConnectivityResponse_Stage_value)
 	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
 	proto.RegisterEnum("lnrpc.ChannelEventUpdate_UpdateType", ChannelEventUpdate_UpdateType_name, ChannelEventUpdate_UpdateType_value)
 	proto.RegisterEnum("lnrpc.Invoice_InvoiceState", Invoice_InvoiceState_name, Invoice_InvoiceState_value)
+	proto.RegisterEnum("lnrpc.InFlightHtlc_State", InFlightHtlc_State_name, InFlightHtlc_State_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -8322,6 +12085,23 @@ type LightningClient interface {
 	// given pubKey. In the case that we currently have a pending or active channel
 	// with the target peer, then this action will be not be allowed.
 	DisconnectPeer(ctx context.Context, in *DisconnectPeerRequest, opts ...grpc.CallOption) (*DisconnectPeerResponse, error)
+	// * lncli: `checkpeer`
+	// CheckPeerConnectivity attempts to establish a connection with a remote
+	// peer, carry out the transport handshake and exchange init messages, without
+	// establishing a full peer connection. The returned response details the
+	// stage at which the attempt failed, if it did, along with the time each of
+	// the stages took, which can be used to diagnose why we're unable to connect
+	// to a peer. Peers we're currently connected to can't be checked.
+	CheckPeerConnectivity(ctx context.Context, in *CheckPeerConnectivityRequest, opts ...grpc.CallOption) (*CheckPeerConnectivityResponse, error)
+	// * lncli: `drainpeer`
+	// DrainPeer stops forwarding new HTLCs to or from the channels with the given
+	// peer, while letting their in-flight HTLCs resolve. The stream sends an
+	// update each time the number of pending HTLCs changes, and completes once
+	// all channels with the peer are quiescent, at which point they can be
+	// closed, or the node restarted, without failing payments mid-flight. The
+	// draining state is kept in memory only, and is lifted by calling DrainPeer
+	// with resume set.
+	DrainPeer(ctx context.Context, in *DrainPeerRequest, opts ...grpc.CallOption) (Lightning_DrainPeerClient, error)
 	// * lncli: `listpeers`
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
@@ -8330,6 +12110,23 @@ type LightningClient interface {
 	// it's identity pubkey, alias, the chains it is connected to, and information
 	// concerning the number of open+pending channels.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// * lncli: `getrecoveryinfo`
+	// GetRecoveryInfo returns information concerning the recovery mode including
+	// whether it's in a recovery mode, whether the recovery is finished, and the
+	// progress made so far.
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	// * lncli: `gethealth`
+	// GetHealth returns the health of the subsystems that recover from panics,
+	// such as the gossiper, the breach arbiter and the channel links. A subsystem
+	// that panicked is reported as degraded, along with the diagnostics of its
+	// last panic, and is restarted if that's safe.
+	GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*GetHealthResponse, error)
+	// * lncli: `chainbackendevents`
+	// SubscribeChainBackendEvents creates a uni-directional stream from the
+	// server to the client in which the events of the chain backend monitor are
+	// sent over. Events are sent when the active chain backend stalls, when it
+	// recovers, and when we fail over to the fallback backend.
+	SubscribeChainBackendEvents(ctx context.Context, in *ChainBackendEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChainBackendEventsClient, error)
 	// * lncli: `pendingchannels`
 	// PendingChannels returns a list of all the channels that are currently
 	// considered "pending". A channel is pending if it has finished the funding
@@ -8343,8 +12140,8 @@ type LightningClient interface {
 	// * lncli: `subscribechannelevents`
 	// SubscribeChannelEvents creates a uni-directional stream from the server to
 	// the client in which any updates relevant to the state of the channels are
-	// sent over. Events include new active channels, inactive channels, and closed
-	// channels.
+	// sent over. Events include new pending and active channels, inactive
+	// channels, and pending and fully closed channels.
 	SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error)
 	// * lncli: `closedchannels`
 	// ClosedChannels returns a description of all the closed channels that
@@ -8363,6 +12160,20 @@ type LightningClient interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error)
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+	// OpenChannel requests are sent to the client and the client responds with
+	// a boolean that tells LND whether or not to accept the channel. This allows
+	// node operators to specify their own criteria for accepting inbound
+	// channels through a single persistent connection.
+	ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error)
+	// * lncli: `cancelfunding`, `bumpfundingfee`
+	// FundingStateStep advances the funding flow of a locally initiated pending
+	// channel. It allows canceling the funding flow as long as the funding
+	// transaction hasn't been broadcast yet, and bumping the fee of an
+	// unconfirmed funding transaction by spending its change output in a child
+	// transaction.
+	FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error)
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -8419,6 +12230,14 @@ type LightningClient interface {
 	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
 	// returned.
 	LookupInvoice(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*Invoice, error)
+	// * lncli: `refreshinvoicehints`
+	// RefreshInvoiceHints re-issues the payment request of an open, unexpired
+	// invoice with a fresh set of routing hints for our private channels. This
+	// can be used once the hints of an invoice have gone stale, as signalled by
+	// its stale_hints field, due to a private channel being closed or its
+	// routing policy being changed. Only the payment request is replaced, the
+	// payment hash and all other terms of the invoice remain unchanged.
+	RefreshInvoiceHints(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*AddInvoiceResponse, error)
 	// *
 	// SubscribeInvoices returns a uni-directional stream (server -> client) for
 	// notifying the client of newly added/settled invoices. The caller can
@@ -8441,6 +12260,19 @@ type LightningClient interface {
 	// *
 	// DeleteAllPayments deletes all outgoing payments from DB.
 	DeleteAllPayments(ctx context.Context, in *DeleteAllPaymentsRequest, opts ...grpc.CallOption) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `exportpaymentproof`
+	// ExportPaymentProof exports the proof of a settled payment, which can be
+	// verified offline by a third party, for instance to resolve a dispute. It
+	// consists of the payment request signed by the payee, which commits to the
+	// payment hash, along with the preimage of that hash which the payee only
+	// reveals on settlement. Only payments made to a payment request can be
+	// proven.
+	ExportPaymentProof(ctx context.Context, in *ExportPaymentProofRequest, opts ...grpc.CallOption) (*PaymentProof, error)
+	// * lncli: `verifypaymentproof`
+	// VerifyPaymentProof verifies a payment proof exported by ExportPaymentProof.
+	// The proof is verified without relying on any state of the node, so any
+	// node can verify the proof of a payment it wasn't involved in.
+	VerifyPaymentProof(ctx context.Context, in *PaymentProof, opts ...grpc.CallOption) (*VerifyPaymentProofResponse, error)
 	// * lncli: `describegraph`
 	// DescribeGraph returns a description of the latest graph state from the
 	// point of view of the node. The graph information is partitioned into two
@@ -8488,6 +12320,13 @@ type LightningClient interface {
 	// level, or in a granular fashion to specify the logging for a target
 	// sub-system.
 	DebugLevel(ctx context.Context, in *DebugLevelRequest, opts ...grpc.CallOption) (*DebugLevelResponse, error)
+	// * lncli: `verifychanstate`
+	// VerifyChanState is a debugging RPC that verifies the revocation state of
+	// the remote party of our open channels. Each secret revealed by the remote
+	// party must be derivable from the revocation store, as those are required
+	// to punish the broadcast of a revoked commitment. Detecting a corrupted
+	// store early allows the channel to be closed before it's needed.
+	VerifyChanState(ctx context.Context, in *VerifyChanStateRequest, opts ...grpc.CallOption) (*VerifyChanStateResponse, error)
 	// * lncli: `feereport`
 	// FeeReport allows the caller to obtain a report detailing the current fee
 	// schedule enforced by the node globally for each channel.
@@ -8496,6 +12335,16 @@ type LightningClient interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(ctx context.Context, in *PolicyUpdateRequest, opts ...grpc.CallOption) (*PolicyUpdateResponse, error)
+	// * lncli: `feeclamps`
+	// ListFeeClamps returns the bounds within which the fee rates estimated for
+	// each of the consumers of the fee estimator are currently clamped.
+	ListFeeClamps(ctx context.Context, in *ListFeeClampsRequest, opts ...grpc.CallOption) (*ListFeeClampsResponse, error)
+	// * lncli: `updatefeeclamp`
+	// UpdateFeeClamp updates the bounds within which the fee rates estimated for
+	// one of the consumers of the fee estimator are clamped. This allows, for
+	// example, to cap commitment fee rates while still letting justice
+	// transactions bid high, without restarting the daemon.
+	UpdateFeeClamp(ctx context.Context, in *FeeClamp, opts ...grpc.CallOption) (*UpdateFeeClampResponse, error)
 	// * lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLC's forwarded within the target time range, and integer offset
@@ -8508,6 +12357,41 @@ type LightningClient interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// * lncli: `switchstats`
+	// SwitchStats returns the runtime performance counters of the htlcswitch: the
+	// rate at which HTLCs are forwarded and commitments are signed by each link,
+	// along with percentiles of the end-to-end latency of settled HTLCs. The
+	// rates are averaged over the time since each link's counters were created.
+	SwitchStats(ctx context.Context, in *SwitchStatsRequest, opts ...grpc.CallOption) (*SwitchStatsResponse, error)
+	// * lncli: `listhtlcs`
+	// ListHtlcs returns every HTLC in flight across all open channels, along
+	// with the switch circuit it's part of and its state in the commitment state
+	// machine of its channel. This can be used to diagnose why a payment appears
+	// to be stuck.
+	ListHtlcs(ctx context.Context, in *ListHtlcsRequest, opts ...grpc.CallOption) (*ListHtlcsResponse, error)
+	// * lncli: `updatenodeannouncement`
+	// UpdateNodeAnnouncement updates the alias, color and advertised addresses
+	// of our node announcement, then signs and broadcasts the new announcement
+	// to the network. The changes aren't persisted across restarts, as the
+	// announcement is then rebuilt from the configuration.
+	UpdateNodeAnnouncement(ctx context.Context, in *UpdateNodeAnnouncementRequest, opts ...grpc.CallOption) (*UpdateNodeAnnouncementResponse, error)
+	// * lncli: `listbannedpeers`
+	// ListBannedPeers returns the peers we've banned for sending us too many
+	// gossip messages failing signature validation. We refuse all connections
+	// with banned peers until their ban expires.
+	ListBannedPeers(ctx context.Context, in *ListBannedPeersRequest, opts ...grpc.CallOption) (*ListBannedPeersResponse, error)
+	// * lncli: `unbanpeer`
+	// UnbanPeer lifts the ban of a peer banned for sending us too many invalid
+	// gossip messages, allowing us to connect with it again.
+	UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*UnbanPeerResponse, error)
+	// *
+	// RegisterRPCMiddleware dispatches a bi-directional streaming RPC through
+	// which an external process registers as the middleware for a custom
+	// macaroon caveat. Every RPC authenticated with a macaroon carrying that
+	// caveat then has its requests and responses sent to the middleware, which
+	// may reject or replace them. RPCs using a macaroon with a custom caveat
+	// fail while no middleware is registered for it.
+	RegisterRPCMiddleware(ctx context.Context, opts ...grpc.CallOption) (Lightning_RegisterRPCMiddlewareClient, error)
 }
 
 type lightningClient struct {
@@ -8649,6 +12533,47 @@ func (c *lightningClient) DisconnectPeer(ctx context.Context, in *DisconnectPeer
 	return out, nil
 }
 
+func (c *lightningClient) CheckPeerConnectivity(ctx context.Context, in *CheckPeerConnectivityRequest, opts ...grpc.CallOption) (*CheckPeerConnectivityResponse, error) {
+	out := new(CheckPeerConnectivityResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/CheckPeerConnectivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DrainPeer(ctx context.Context, in *DrainPeerRequest, opts ...grpc.CallOption) (Lightning_DrainPeerClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/DrainPeer", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningDrainPeerClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_DrainPeerClient interface {
+	Recv() (*DrainPeerUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningDrainPeerClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningDrainPeerClient) Recv() (*DrainPeerUpdate, error) {
+	m := new(DrainPeerUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error) {
 	out := new(ListPeersResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListPeers", in, out, opts...)
@@ -8667,6 +12592,56 @@ func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts
 	return out, nil
 }
 
+func (c *lightningClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
+	out := new(GetRecoveryInfoResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetRecoveryInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*GetHealthResponse, error) {
+	out := new(GetHealthResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/GetHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeChainBackendEvents(ctx context.Context, in *ChainBackendEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChainBackendEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/SubscribeChainBackendEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeChainBackendEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeChainBackendEventsClient interface {
+	Recv() (*ChainBackendEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeChainBackendEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeChainBackendEventsClient) Recv() (*ChainBackendEvent, error) {
+	m := new(ChainBackendEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) PendingChannels(ctx context.Context, in *PendingChannelsRequest, opts ...grpc.CallOption) (*PendingChannelsResponse, error) {
 	out := new(PendingChannelsResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/PendingChannels", in, out, opts...)
@@ -8686,7 +12661,7 @@ func (c *lightningClient) ListChannels(ctx context.Context, in *ListChannelsRequ
 }
 
 func (c *lightningClient) SubscribeChannelEvents(ctx context.Context, in *ChannelEventSubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[1], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/SubscribeChannelEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8736,7 +12711,7 @@ func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRe
 }
 
 func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[2], "/lnrpc.Lightning/OpenChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/OpenChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8744,31 +12719,71 @@ func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelReques
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_OpenChannelClient interface {
+	Recv() (*OpenStatusUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningOpenChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
+	m := new(OpenStatusUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/ChannelAcceptor", opts...)
+	if err != nil {
 		return nil, err
 	}
+	x := &lightningChannelAcceptorClient{stream}
 	return x, nil
 }
 
-type Lightning_OpenChannelClient interface {
-	Recv() (*OpenStatusUpdate, error)
+type Lightning_ChannelAcceptorClient interface {
+	Send(*ChannelAcceptResponse) error
+	Recv() (*ChannelAcceptRequest, error)
 	grpc.ClientStream
 }
 
-type lightningOpenChannelClient struct {
+type lightningChannelAcceptorClient struct {
 	grpc.ClientStream
 }
 
-func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
-	m := new(OpenStatusUpdate)
+func (x *lightningChannelAcceptorClient) Send(m *ChannelAcceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
+	m := new(ChannelAcceptRequest)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
+func (c *lightningClient) FundingStateStep(ctx context.Context, in *FundingTransitionMsg, opts ...grpc.CallOption) (*FundingStateStepResp, error) {
+	out := new(FundingStateStepResp)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FundingStateStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[3], "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8809,7 +12824,7 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 }
 
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[4], "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8849,7 +12864,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[5], "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[8], "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8915,8 +12930,17 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 	return out, nil
 }
 
+func (c *lightningClient) RefreshInvoiceHints(ctx context.Context, in *PaymentHash, opts ...grpc.CallOption) (*AddInvoiceResponse, error) {
+	out := new(AddInvoiceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/RefreshInvoiceHints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[6], "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[9], "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -8974,6 +12998,24 @@ func (c *lightningClient) DeleteAllPayments(ctx context.Context, in *DeleteAllPa
 	return out, nil
 }
 
+func (c *lightningClient) ExportPaymentProof(ctx context.Context, in *ExportPaymentProofRequest, opts ...grpc.CallOption) (*PaymentProof, error) {
+	out := new(PaymentProof)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ExportPaymentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) VerifyPaymentProof(ctx context.Context, in *PaymentProof, opts ...grpc.CallOption) (*VerifyPaymentProofResponse, error) {
+	out := new(VerifyPaymentProofResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/VerifyPaymentProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) DescribeGraph(ctx context.Context, in *ChannelGraphRequest, opts ...grpc.CallOption) (*ChannelGraph, error) {
 	out := new(ChannelGraph)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/DescribeGraph", in, out, opts...)
@@ -9029,7 +13071,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[7], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[10], "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -9069,6 +13111,15 @@ func (c *lightningClient) DebugLevel(ctx context.Context, in *DebugLevelRequest,
 	return out, nil
 }
 
+func (c *lightningClient) VerifyChanState(ctx context.Context, in *VerifyChanStateRequest, opts ...grpc.CallOption) (*VerifyChanStateResponse, error) {
+	out := new(VerifyChanStateResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/VerifyChanState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) FeeReport(ctx context.Context, in *FeeReportRequest, opts ...grpc.CallOption) (*FeeReportResponse, error) {
 	out := new(FeeReportResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/FeeReport", in, out, opts...)
@@ -9087,6 +13138,24 @@ func (c *lightningClient) UpdateChannelPolicy(ctx context.Context, in *PolicyUpd
 	return out, nil
 }
 
+func (c *lightningClient) ListFeeClamps(ctx context.Context, in *ListFeeClampsRequest, opts ...grpc.CallOption) (*ListFeeClampsResponse, error) {
+	out := new(ListFeeClampsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListFeeClamps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateFeeClamp(ctx context.Context, in *FeeClamp, opts ...grpc.CallOption) (*UpdateFeeClampResponse, error) {
+	out := new(UpdateFeeClampResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateFeeClamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error) {
 	out := new(ForwardingHistoryResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ForwardingHistory", in, out, opts...)
@@ -9096,6 +13165,82 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) SwitchStats(ctx context.Context, in *SwitchStatsRequest, opts ...grpc.CallOption) (*SwitchStatsResponse, error) {
+	out := new(SwitchStatsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SwitchStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListHtlcs(ctx context.Context, in *ListHtlcsRequest, opts ...grpc.CallOption) (*ListHtlcsResponse, error) {
+	out := new(ListHtlcsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListHtlcs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UpdateNodeAnnouncement(ctx context.Context, in *UpdateNodeAnnouncementRequest, opts ...grpc.CallOption) (*UpdateNodeAnnouncementResponse, error) {
+	out := new(UpdateNodeAnnouncementResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UpdateNodeAnnouncement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListBannedPeers(ctx context.Context, in *ListBannedPeersRequest, opts ...grpc.CallOption) (*ListBannedPeersResponse, error) {
+	out := new(ListBannedPeersResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListBannedPeers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) UnbanPeer(ctx context.Context, in *UnbanPeerRequest, opts ...grpc.CallOption) (*UnbanPeerResponse, error) {
+	out := new(UnbanPeerResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/UnbanPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) RegisterRPCMiddleware(ctx context.Context, opts ...grpc.CallOption) (Lightning_RegisterRPCMiddlewareClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Lightning_serviceDesc.Streams[11], "/lnrpc.Lightning/RegisterRPCMiddleware", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningRegisterRPCMiddlewareClient{stream}
+	return x, nil
+}
+
+type Lightning_RegisterRPCMiddlewareClient interface {
+	Send(*RPCMiddlewareResponse) error
+	Recv() (*RPCMiddlewareRequest, error)
+	grpc.ClientStream
+}
+
+type lightningRegisterRPCMiddlewareClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningRegisterRPCMiddlewareClient) Send(m *RPCMiddlewareResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningRegisterRPCMiddlewareClient) Recv() (*RPCMiddlewareRequest, error) {
+	m := new(RPCMiddlewareRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LightningServer is the server API for Lightning service.
 type LightningServer interface {
 	// * lncli: `walletbalance`
@@ -9157,6 +13302,23 @@ type LightningServer interface {
 	// given pubKey. In the case that we currently have a pending or active channel
 	// with the target peer, then this action will be not be allowed.
 	DisconnectPeer(context.Context, *DisconnectPeerRequest) (*DisconnectPeerResponse, error)
+	// * lncli: `checkpeer`
+	// CheckPeerConnectivity attempts to establish a connection with a remote
+	// peer, carry out the transport handshake and exchange init messages, without
+	// establishing a full peer connection. The returned response details the
+	// stage at which the attempt failed, if it did, along with the time each of
+	// the stages took, which can be used to diagnose why we're unable to connect
+	// to a peer. Peers we're currently connected to can't be checked.
+	CheckPeerConnectivity(context.Context, *CheckPeerConnectivityRequest) (*CheckPeerConnectivityResponse, error)
+	// * lncli: `drainpeer`
+	// DrainPeer stops forwarding new HTLCs to or from the channels with the given
+	// peer, while letting their in-flight HTLCs resolve. The stream sends an
+	// update each time the number of pending HTLCs changes, and completes once
+	// all channels with the peer are quiescent, at which point they can be
+	// closed, or the node restarted, without failing payments mid-flight. The
+	// draining state is kept in memory only, and is lifted by calling DrainPeer
+	// with resume set.
+	DrainPeer(*DrainPeerRequest, Lightning_DrainPeerServer) error
 	// * lncli: `listpeers`
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
@@ -9165,6 +13327,23 @@ type LightningServer interface {
 	// it's identity pubkey, alias, the chains it is connected to, and information
 	// concerning the number of open+pending channels.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// * lncli: `getrecoveryinfo`
+	// GetRecoveryInfo returns information concerning the recovery mode including
+	// whether it's in a recovery mode, whether the recovery is finished, and the
+	// progress made so far.
+	GetRecoveryInfo(context.Context, *GetRecoveryInfoRequest) (*GetRecoveryInfoResponse, error)
+	// * lncli: `gethealth`
+	// GetHealth returns the health of the subsystems that recover from panics,
+	// such as the gossiper, the breach arbiter and the channel links. A subsystem
+	// that panicked is reported as degraded, along with the diagnostics of its
+	// last panic, and is restarted if that's safe.
+	GetHealth(context.Context, *GetHealthRequest) (*GetHealthResponse, error)
+	// * lncli: `chainbackendevents`
+	// SubscribeChainBackendEvents creates a uni-directional stream from the
+	// server to the client in which the events of the chain backend monitor are
+	// sent over. Events are sent when the active chain backend stalls, when it
+	// recovers, and when we fail over to the fallback backend.
+	SubscribeChainBackendEvents(*ChainBackendEventSubscription, Lightning_SubscribeChainBackendEventsServer) error
 	// * lncli: `pendingchannels`
 	// PendingChannels returns a list of all the channels that are currently
 	// considered "pending". A channel is pending if it has finished the funding
@@ -9178,8 +13357,8 @@ type LightningServer interface {
 	// * lncli: `subscribechannelevents`
 	// SubscribeChannelEvents creates a uni-directional stream from the server to
 	// the client in which any updates relevant to the state of the channels are
-	// sent over. Events include new active channels, inactive channels, and closed
-	// channels.
+	// sent over. Events include new pending and active channels, inactive
+	// channels, and pending and fully closed channels.
 	SubscribeChannelEvents(*ChannelEventSubscription, Lightning_SubscribeChannelEventsServer) error
 	// * lncli: `closedchannels`
 	// ClosedChannels returns a description of all the closed channels that
@@ -9198,6 +13377,20 @@ type LightningServer interface {
 	// rate to us for the funding transaction. If neither are specified, then a
 	// lax block confirmation target is used.
 	OpenChannel(*OpenChannelRequest, Lightning_OpenChannelServer) error
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+	// OpenChannel requests are sent to the client and the client responds with
+	// a boolean that tells LND whether or not to accept the channel. This allows
+	// node operators to specify their own criteria for accepting inbound
+	// channels through a single persistent connection.
+	ChannelAcceptor(Lightning_ChannelAcceptorServer) error
+	// * lncli: `cancelfunding`, `bumpfundingfee`
+	// FundingStateStep advances the funding flow of a locally initiated pending
+	// channel. It allows canceling the funding flow as long as the funding
+	// transaction hasn't been broadcast yet, and bumping the fee of an
+	// unconfirmed funding transaction by spending its change output in a child
+	// transaction.
+	FundingStateStep(context.Context, *FundingTransitionMsg) (*FundingStateStepResp, error)
 	// * lncli: `closechannel`
 	// CloseChannel attempts to close an active channel identified by its channel
 	// outpoint (ChannelPoint). The actions of this method can additionally be
@@ -9254,6 +13447,14 @@ type LightningServer interface {
 	// The passed payment hash *must* be exactly 32 bytes, if not, an error is
 	// returned.
 	LookupInvoice(context.Context, *PaymentHash) (*Invoice, error)
+	// * lncli: `refreshinvoicehints`
+	// RefreshInvoiceHints re-issues the payment request of an open, unexpired
+	// invoice with a fresh set of routing hints for our private channels. This
+	// can be used once the hints of an invoice have gone stale, as signalled by
+	// its stale_hints field, due to a private channel being closed or its
+	// routing policy being changed. Only the payment request is replaced, the
+	// payment hash and all other terms of the invoice remain unchanged.
+	RefreshInvoiceHints(context.Context, *PaymentHash) (*AddInvoiceResponse, error)
 	// *
 	// SubscribeInvoices returns a uni-directional stream (server -> client) for
 	// notifying the client of newly added/settled invoices. The caller can
@@ -9276,6 +13477,19 @@ type LightningServer interface {
 	// *
 	// DeleteAllPayments deletes all outgoing payments from DB.
 	DeleteAllPayments(context.Context, *DeleteAllPaymentsRequest) (*DeleteAllPaymentsResponse, error)
+	// * lncli: `exportpaymentproof`
+	// ExportPaymentProof exports the proof of a settled payment, which can be
+	// verified offline by a third party, for instance to resolve a dispute. It
+	// consists of the payment request signed by the payee, which commits to the
+	// payment hash, along with the preimage of that hash which the payee only
+	// reveals on settlement. Only payments made to a payment request can be
+	// proven.
+	ExportPaymentProof(context.Context, *ExportPaymentProofRequest) (*PaymentProof, error)
+	// * lncli: `verifypaymentproof`
+	// VerifyPaymentProof verifies a payment proof exported by ExportPaymentProof.
+	// The proof is verified without relying on any state of the node, so any
+	// node can verify the proof of a payment it wasn't involved in.
+	VerifyPaymentProof(context.Context, *PaymentProof) (*VerifyPaymentProofResponse, error)
 	// * lncli: `describegraph`
 	// DescribeGraph returns a description of the latest graph state from the
 	// point of view of the node. The graph information is partitioned into two
@@ -9323,6 +13537,13 @@ type LightningServer interface {
 	// level, or in a granular fashion to specify the logging for a target
 	// sub-system.
 	DebugLevel(context.Context, *DebugLevelRequest) (*DebugLevelResponse, error)
+	// * lncli: `verifychanstate`
+	// VerifyChanState is a debugging RPC that verifies the revocation state of
+	// the remote party of our open channels. Each secret revealed by the remote
+	// party must be derivable from the revocation store, as those are required
+	// to punish the broadcast of a revoked commitment. Detecting a corrupted
+	// store early allows the channel to be closed before it's needed.
+	VerifyChanState(context.Context, *VerifyChanStateRequest) (*VerifyChanStateResponse, error)
 	// * lncli: `feereport`
 	// FeeReport allows the caller to obtain a report detailing the current fee
 	// schedule enforced by the node globally for each channel.
@@ -9331,6 +13552,16 @@ type LightningServer interface {
 	// UpdateChannelPolicy allows the caller to update the fee schedule and
 	// channel policies for all channels globally, or a particular channel.
 	UpdateChannelPolicy(context.Context, *PolicyUpdateRequest) (*PolicyUpdateResponse, error)
+	// * lncli: `feeclamps`
+	// ListFeeClamps returns the bounds within which the fee rates estimated for
+	// each of the consumers of the fee estimator are currently clamped.
+	ListFeeClamps(context.Context, *ListFeeClampsRequest) (*ListFeeClampsResponse, error)
+	// * lncli: `updatefeeclamp`
+	// UpdateFeeClamp updates the bounds within which the fee rates estimated for
+	// one of the consumers of the fee estimator are clamped. This allows, for
+	// example, to cap commitment fee rates while still letting justice
+	// transactions bid high, without restarting the daemon.
+	UpdateFeeClamp(context.Context, *FeeClamp) (*UpdateFeeClampResponse, error)
 	// * lncli: `fwdinghistory`
 	// ForwardingHistory allows the caller to query the htlcswitch for a record of
 	// all HTLC's forwarded within the target time range, and integer offset
@@ -9343,6 +13574,41 @@ type LightningServer interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// * lncli: `switchstats`
+	// SwitchStats returns the runtime performance counters of the htlcswitch: the
+	// rate at which HTLCs are forwarded and commitments are signed by each link,
+	// along with percentiles of the end-to-end latency of settled HTLCs. The
+	// rates are averaged over the time since each link's counters were created.
+	SwitchStats(context.Context, *SwitchStatsRequest) (*SwitchStatsResponse, error)
+	// * lncli: `listhtlcs`
+	// ListHtlcs returns every HTLC in flight across all open channels, along
+	// with the switch circuit it's part of and its state in the commitment state
+	// machine of its channel. This can be used to diagnose why a payment appears
+	// to be stuck.
+	ListHtlcs(context.Context, *ListHtlcsRequest) (*ListHtlcsResponse, error)
+	// * lncli: `updatenodeannouncement`
+	// UpdateNodeAnnouncement updates the alias, color and advertised addresses
+	// of our node announcement, then signs and broadcasts the new announcement
+	// to the network. The changes aren't persisted across restarts, as the
+	// announcement is then rebuilt from the configuration.
+	UpdateNodeAnnouncement(context.Context, *UpdateNodeAnnouncementRequest) (*UpdateNodeAnnouncementResponse, error)
+	// * lncli: `listbannedpeers`
+	// ListBannedPeers returns the peers we've banned for sending us too many
+	// gossip messages failing signature validation. We refuse all connections
+	// with banned peers until their ban expires.
+	ListBannedPeers(context.Context, *ListBannedPeersRequest) (*ListBannedPeersResponse, error)
+	// * lncli: `unbanpeer`
+	// UnbanPeer lifts the ban of a peer banned for sending us too many invalid
+	// gossip messages, allowing us to connect with it again.
+	UnbanPeer(context.Context, *UnbanPeerRequest) (*UnbanPeerResponse, error)
+	// *
+	// RegisterRPCMiddleware dispatches a bi-directional streaming RPC through
+	// which an external process registers as the middleware for a custom
+	// macaroon caveat. Every RPC authenticated with a macaroon carrying that
+	// caveat then has its requests and responses sent to the middleware, which
+	// may reject or replace them. RPCs using a macaroon with a custom caveat
+	// fail while no middleware is registered for it.
+	RegisterRPCMiddleware(Lightning_RegisterRPCMiddlewareServer) error
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -9568,6 +13834,45 @@ func _Lightning_DisconnectPeer_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_CheckPeerConnectivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPeerConnectivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).CheckPeerConnectivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/CheckPeerConnectivity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).CheckPeerConnectivity(ctx, req.(*CheckPeerConnectivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DrainPeer_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DrainPeerRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).DrainPeer(m, &lightningDrainPeerServer{stream})
+}
+
+type Lightning_DrainPeerServer interface {
+	Send(*DrainPeerUpdate) error
+	grpc.ServerStream
+}
+
+type lightningDrainPeerServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningDrainPeerServer) Send(m *DrainPeerUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPeersRequest)
 	if err := dec(in); err != nil {
@@ -9604,6 +13909,63 @@ func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_GetRecoveryInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecoveryInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetRecoveryInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetRecoveryInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetRecoveryInfo(ctx, req.(*GetRecoveryInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).GetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/GetHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).GetHealth(ctx, req.(*GetHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeChainBackendEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ChainBackendEventSubscription)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeChainBackendEvents(m, &lightningSubscribeChainBackendEventsServer{stream})
+}
+
+type Lightning_SubscribeChainBackendEventsServer interface {
+	Send(*ChainBackendEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeChainBackendEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeChainBackendEventsServer) Send(m *ChainBackendEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_PendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PendingChannelsRequest)
 	if err := dec(in); err != nil {
@@ -9718,6 +14080,50 @@ func (x *lightningOpenChannelServer) Send(m *OpenStatusUpdate) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_ChannelAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).ChannelAcceptor(&lightningChannelAcceptorServer{stream})
+}
+
+type Lightning_ChannelAcceptorServer interface {
+	Send(*ChannelAcceptRequest) error
+	Recv() (*ChannelAcceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningChannelAcceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningChannelAcceptorServer) Send(m *ChannelAcceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
+	m := new(ChannelAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_FundingStateStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FundingTransitionMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).FundingStateStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/FundingStateStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).FundingStateStep(ctx, req.(*FundingTransitionMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(CloseChannelRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -9899,6 +14305,24 @@ func _Lightning_LookupInvoice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_RefreshInvoiceHints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).RefreshInvoiceHints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/RefreshInvoiceHints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).RefreshInvoiceHints(ctx, req.(*PaymentHash))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SubscribeInvoices_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(InvoiceSubscription)
 	if err := stream.RecvMsg(m); err != nil {
@@ -9944,32 +14368,68 @@ func _Lightning_ListPayments_Handler(srv interface{}, ctx context.Context, dec f
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).ListPayments(ctx, in)
+		return srv.(LightningServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAllPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeleteAllPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeleteAllPayments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeleteAllPayments(ctx, req.(*DeleteAllPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ExportPaymentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportPaymentProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ExportPaymentProof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/ListPayments",
+		FullMethod: "/lnrpc.Lightning/ExportPaymentProof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+		return srv.(LightningServer).ExportPaymentProof(ctx, req.(*ExportPaymentProofRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Lightning_DeleteAllPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAllPaymentsRequest)
+func _Lightning_VerifyPaymentProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaymentProof)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).DeleteAllPayments(ctx, in)
+		return srv.(LightningServer).VerifyPaymentProof(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/DeleteAllPayments",
+		FullMethod: "/lnrpc.Lightning/VerifyPaymentProof",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).DeleteAllPayments(ctx, req.(*DeleteAllPaymentsRequest))
+		return srv.(LightningServer).VerifyPaymentProof(ctx, req.(*PaymentProof))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -10121,6 +14581,24 @@ func _Lightning_DebugLevel_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_VerifyChanState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyChanStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).VerifyChanState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/VerifyChanState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).VerifyChanState(ctx, req.(*VerifyChanStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_FeeReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FeeReportRequest)
 	if err := dec(in); err != nil {
@@ -10157,6 +14635,42 @@ func _Lightning_UpdateChannelPolicy_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ListFeeClamps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeeClampsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListFeeClamps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListFeeClamps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListFeeClamps(ctx, req.(*ListFeeClampsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_UpdateFeeClamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FeeClamp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateFeeClamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateFeeClamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateFeeClamp(ctx, req.(*FeeClamp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ForwardingHistoryRequest)
 	if err := dec(in); err != nil {
@@ -10175,6 +14689,122 @@ func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SwitchStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SwitchStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SwitchStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SwitchStats(ctx, req.(*SwitchStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListHtlcs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHtlcsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListHtlcs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListHtlcs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListHtlcs(ctx, req.(*ListHtlcsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_UpdateNodeAnnouncement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNodeAnnouncementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UpdateNodeAnnouncement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UpdateNodeAnnouncement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UpdateNodeAnnouncement(ctx, req.(*UpdateNodeAnnouncementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListBannedPeers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBannedPeersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListBannedPeers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListBannedPeers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListBannedPeers(ctx, req.(*ListBannedPeersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_UnbanPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnbanPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).UnbanPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/UnbanPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).UnbanPeer(ctx, req.(*UnbanPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_RegisterRPCMiddleware_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).RegisterRPCMiddleware(&lightningRegisterRPCMiddlewareServer{stream})
+}
+
+type Lightning_RegisterRPCMiddlewareServer interface {
+	Send(*RPCMiddlewareRequest) error
+	Recv() (*RPCMiddlewareResponse, error)
+	grpc.ServerStream
+}
+
+type lightningRegisterRPCMiddlewareServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningRegisterRPCMiddlewareServer) Send(m *RPCMiddlewareRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningRegisterRPCMiddlewareServer) Recv() (*RPCMiddlewareResponse, error) {
+	m := new(RPCMiddlewareResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Lightning_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.Lightning",
 	HandlerType: (*LightningServer)(nil),
@@ -10223,6 +14853,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DisconnectPeer",
 			Handler:    _Lightning_DisconnectPeer_Handler,
 		},
+		{
+			MethodName: "CheckPeerConnectivity",
+			Handler:    _Lightning_CheckPeerConnectivity_Handler,
+		},
 		{
 			MethodName: "ListPeers",
 			Handler:    _Lightning_ListPeers_Handler,
@@ -10231,6 +14865,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _Lightning_GetInfo_Handler,
 		},
+		{
+			MethodName: "GetRecoveryInfo",
+			Handler:    _Lightning_GetRecoveryInfo_Handler,
+		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _Lightning_GetHealth_Handler,
+		},
 		{
 			MethodName: "PendingChannels",
 			Handler:    _Lightning_PendingChannels_Handler,
@@ -10247,6 +14889,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OpenChannelSync",
 			Handler:    _Lightning_OpenChannelSync_Handler,
 		},
+		{
+			MethodName: "FundingStateStep",
+			Handler:    _Lightning_FundingStateStep_Handler,
+		},
 		{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
@@ -10271,6 +14917,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LookupInvoice",
 			Handler:    _Lightning_LookupInvoice_Handler,
 		},
+		{
+			MethodName: "RefreshInvoiceHints",
+			Handler:    _Lightning_RefreshInvoiceHints_Handler,
+		},
 		{
 			MethodName: "DecodePayReq",
 			Handler:    _Lightning_DecodePayReq_Handler,
@@ -10283,6 +14933,14 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAllPayments",
 			Handler:    _Lightning_DeleteAllPayments_Handler,
 		},
+		{
+			MethodName: "ExportPaymentProof",
+			Handler:    _Lightning_ExportPaymentProof_Handler,
+		},
+		{
+			MethodName: "VerifyPaymentProof",
+			Handler:    _Lightning_VerifyPaymentProof_Handler,
+		},
 		{
 			MethodName: "DescribeGraph",
 			Handler:    _Lightning_DescribeGraph_Handler,
@@ -10311,6 +14969,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DebugLevel",
 			Handler:    _Lightning_DebugLevel_Handler,
 		},
+		{
+			MethodName: "VerifyChanState",
+			Handler:    _Lightning_VerifyChanState_Handler,
+		},
 		{
 			MethodName: "FeeReport",
 			Handler:    _Lightning_FeeReport_Handler,
@@ -10319,10 +14981,38 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateChannelPolicy",
 			Handler:    _Lightning_UpdateChannelPolicy_Handler,
 		},
+		{
+			MethodName: "ListFeeClamps",
+			Handler:    _Lightning_ListFeeClamps_Handler,
+		},
+		{
+			MethodName: "UpdateFeeClamp",
+			Handler:    _Lightning_UpdateFeeClamp_Handler,
+		},
 		{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "SwitchStats",
+			Handler:    _Lightning_SwitchStats_Handler,
+		},
+		{
+			MethodName: "ListHtlcs",
+			Handler:    _Lightning_ListHtlcs_Handler,
+		},
+		{
+			MethodName: "UpdateNodeAnnouncement",
+			Handler:    _Lightning_UpdateNodeAnnouncement_Handler,
+		},
+		{
+			MethodName: "ListBannedPeers",
+			Handler:    _Lightning_ListBannedPeers_Handler,
+		},
+		{
+			MethodName: "UnbanPeer",
+			Handler:    _Lightning_UnbanPeer_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -10330,6 +15020,16 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeTransactions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DrainPeer",
+			Handler:       _Lightning_DrainPeer_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeChainBackendEvents",
+			Handler:       _Lightning_SubscribeChainBackendEvents_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeChannelEvents",
 			Handler:       _Lightning_SubscribeChannelEvents_Handler,
@@ -10340,6 +15040,12 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_OpenChannel_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ChannelAcceptor",
+			Handler:       _Lightning_ChannelAcceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "CloseChannel",
 			Handler:       _Lightning_CloseChannel_Handler,
@@ -10367,451 +15073,787 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RegisterRPCMiddleware",
+			Handler:       _Lightning_RegisterRPCMiddleware_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "rpc.proto",
+}
+
+// StateClient is the client API for State service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StateClient interface {
+	// * lncli: `state`
+	// SubscribeState subscribes to the state of lnd. The current state is sent
+	// immediately, followed by every state change.
+	SubscribeState(ctx context.Context, in *SubscribeStateRequest, opts ...grpc.CallOption) (State_SubscribeStateClient, error)
+	// * lncli: `state`
+	// GetState returns the current state of lnd.
+	GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error)
+}
+
+type stateClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStateClient(cc *grpc.ClientConn) StateClient {
+	return &stateClient{cc}
+}
+
+func (c *stateClient) SubscribeState(ctx context.Context, in *SubscribeStateRequest, opts ...grpc.CallOption) (State_SubscribeStateClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_State_serviceDesc.Streams[0], "/lnrpc.State/SubscribeState", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &stateSubscribeStateClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type State_SubscribeStateClient interface {
+	Recv() (*SubscribeStateResponse, error)
+	grpc.ClientStream
+}
+
+type stateSubscribeStateClient struct {
+	grpc.ClientStream
+}
+
+func (x *stateSubscribeStateClient) Recv() (*SubscribeStateResponse, error) {
+	m := new(SubscribeStateResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *stateClient) GetState(ctx context.Context, in *GetStateRequest, opts ...grpc.CallOption) (*GetStateResponse, error) {
+	out := new(GetStateResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.State/GetState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StateServer is the server API for State service.
+type StateServer interface {
+	// * lncli: `state`
+	// SubscribeState subscribes to the state of lnd. The current state is sent
+	// immediately, followed by every state change.
+	SubscribeState(*SubscribeStateRequest, State_SubscribeStateServer) error
+	// * lncli: `state`
+	// GetState returns the current state of lnd.
+	GetState(context.Context, *GetStateRequest) (*GetStateResponse, error)
+}
+
+func RegisterStateServer(s *grpc.Server, srv StateServer) {
+	s.RegisterService(&_State_serviceDesc, srv)
+}
+
+func _State_SubscribeState_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeStateRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(StateServer).SubscribeState(m, &stateSubscribeStateServer{stream})
+}
+
+type State_SubscribeStateServer interface {
+	Send(*SubscribeStateResponse) error
+	grpc.ServerStream
+}
+
+type stateSubscribeStateServer struct {
+	grpc.ServerStream
+}
+
+func (x *stateSubscribeStateServer) Send(m *SubscribeStateResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _State_GetState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StateServer).GetState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.State/GetState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StateServer).GetState(ctx, req.(*GetStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _State_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "lnrpc.State",
+	HandlerType: (*StateServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetState",
+			Handler:    _State_GetState_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeState",
+			Handler:       _State_SubscribeState_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_cb256459ade06eab) }
-
-var fileDescriptor_rpc_cb256459ade06eab = []byte{
-	// 7015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0x5f, 0x6c, 0x24, 0xc9,
-	0x59, 0xdf, 0x9e, 0x3f, 0xf6, 0xcc, 0x37, 0xe3, 0xf1, 0xb8, 0xfc, 0x67, 0x67, 0xe7, 0xf6, 0xf6,
-	0xf6, 0x3a, 0xcb, 0xad, 0xe3, 0x1c, 0xeb, 0xbd, 0x4d, 0x72, 0x5c, 0xee, 0x48, 0xc0, 0x6b, 0x7b,
-	0xd7, 0x9b, 0xf8, 0xbc, 0x4e, 0x7b, 0x37, 0x4b, 0x2e, 0x41, 0x93, 0xf6, 0x4c, 0x79, 0xdc, 0xb7,
-	0x3d, 0xdd, 0x93, 0xee, 0x1e, 0x7b, 0x27, 0xc7, 0x49, 0x08, 0x10, 0x91, 0x10, 0x08, 0x01, 0x2f,
-	0x04, 0x05, 0x21, 0x05, 0x24, 0xc8, 0x23, 0x0f, 0x89, 0x90, 0x80, 0x37, 0xc4, 0x03, 0x12, 0x42,
-	0x90, 0x47, 0x24, 0x24, 0x04, 0x2f, 0xc0, 0x03, 0x12, 0x12, 0x8f, 0x48, 0xa8, 0xbe, 0xfa, 0xd3,
-	0x55, 0xdd, 0x3d, 0xeb, 0xbd, 0x24, 0xf0, 0x64, 0xd7, 0xaf, 0xbe, 0xae, 0xbf, 0xdf, 0xbf, 0xfa,
-	0xea, 0xab, 0x81, 0x7a, 0x34, 0xee, 0xdf, 0x1a, 0x47, 0x61, 0x12, 0x92, 0xaa, 0x1f, 0x44, 0xe3,
-	0x7e, 0xf7, 0xea, 0x30, 0x0c, 0x87, 0x3e, 0xdd, 0x74, 0xc7, 0xde, 0xa6, 0x1b, 0x04, 0x61, 0xe2,
-	0x26, 0x5e, 0x18, 0xc4, 0x9c, 0xc8, 0xfe, 0x1a, 0xb4, 0xee, 0xd3, 0xe0, 0x88, 0xd2, 0x81, 0x43,
-	0xbf, 0x3e, 0xa1, 0x71, 0x42, 0x3e, 0x01, 0x4b, 0x2e, 0xfd, 0x06, 0xa5, 0x83, 0xde, 0xd8, 0x8d,
-	0xe3, 0xf1, 0x69, 0xe4, 0xc6, 0xb4, 0x63, 0x5d, 0xb7, 0xd6, 0x9b, 0x4e, 0x9b, 0x57, 0x1c, 0x2a,
-	0x9c, 0xbc, 0x0a, 0xcd, 0x98, 0x91, 0xd2, 0x20, 0x89, 0xc2, 0xf1, 0xb4, 0x53, 0x42, 0xba, 0x06,
-	0xc3, 0x76, 0x39, 0x64, 0xfb, 0xb0, 0xa8, 0x7a, 0x88, 0xc7, 0x61, 0x10, 0x53, 0x72, 0x1b, 0x56,
-	0xfa, 0xde, 0xf8, 0x94, 0x46, 0x3d, 0xfc, 0x78, 0x14, 0xd0, 0x51, 0x18, 0x78, 0xfd, 0x8e, 0x75,
-	0xbd, 0xbc, 0x5e, 0x77, 0x08, 0xaf, 0x63, 0x5f, 0xbc, 0x2b, 0x6a, 0xc8, 0x4d, 0x58, 0xa4, 0x01,
-	0xc7, 0xe9, 0x00, 0xbf, 0x12, 0x5d, 0xb5, 0x52, 0x98, 0x7d, 0x60, 0xff, 0x95, 0x05, 0x4b, 0x0f,
-	0x02, 0x2f, 0x79, 0xe2, 0xfa, 0x3e, 0x4d, 0xe4, 0x9c, 0x6e, 0xc2, 0xe2, 0x39, 0x02, 0x38, 0xa7,
-	0xf3, 0x30, 0x1a, 0x88, 0x19, 0xb5, 0x38, 0x7c, 0x28, 0xd0, 0x99, 0x23, 0x2b, 0xcd, 0x1c, 0x59,
-	0xe1, 0x72, 0x95, 0x67, 0x2c, 0xd7, 0x4d, 0x58, 0x8c, 0x68, 0x3f, 0x3c, 0xa3, 0xd1, 0xb4, 0x77,
-	0xee, 0x05, 0x83, 0xf0, 0xbc, 0x53, 0xb9, 0x6e, 0xad, 0x57, 0x9d, 0x96, 0x84, 0x9f, 0x20, 0x6a,
-	0xaf, 0x00, 0xd1, 0x67, 0xc1, 0xd7, 0xcd, 0x1e, 0xc2, 0xf2, 0xe3, 0xc0, 0x0f, 0xfb, 0x4f, 0x7f,
-	0xc8, 0xd9, 0x15, 0x74, 0x5f, 0x2a, 0xec, 0x7e, 0x0d, 0x56, 0xcc, 0x8e, 0xc4, 0x00, 0x28, 0xac,
-	0x6e, 0x9f, 0xba, 0xc1, 0x90, 0xca, 0x26, 0xe5, 0x10, 0x3e, 0x0e, 0xed, 0xfe, 0x24, 0x8a, 0x68,
-	0x90, 0x1b, 0xc3, 0xa2, 0xc0, 0xd5, 0x20, 0x5e, 0x85, 0x66, 0x40, 0xcf, 0x53, 0x32, 0xc1, 0x32,
-	0x01, 0x3d, 0x97, 0x24, 0x76, 0x07, 0xd6, 0xb2, 0xdd, 0x88, 0x01, 0xfc, 0xb3, 0x05, 0x95, 0xc7,
-	0xc9, 0xb3, 0x90, 0xdc, 0x82, 0x4a, 0x32, 0x1d, 0x73, 0xc6, 0x6c, 0xdd, 0x21, 0xb7, 0x90, 0xd7,
-	0x6f, 0x6d, 0x0d, 0x06, 0x11, 0x8d, 0xe3, 0x47, 0xd3, 0x31, 0x75, 0x9a, 0x2e, 0x2f, 0xf4, 0x18,
-	0x1d, 0xe9, 0xc0, 0xbc, 0x28, 0x63, 0x87, 0x75, 0x47, 0x16, 0xc9, 0x35, 0x00, 0x77, 0x14, 0x4e,
-	0x82, 0xa4, 0x17, 0xbb, 0x09, 0xee, 0x5c, 0xd9, 0xd1, 0x10, 0x72, 0x15, 0xea, 0xe3, 0xa7, 0xbd,
-	0xb8, 0x1f, 0x79, 0xe3, 0x04, 0x77, 0xab, 0xee, 0xa4, 0x00, 0xf9, 0x04, 0xd4, 0xc2, 0x49, 0x32,
-	0x0e, 0xbd, 0x20, 0xe9, 0x54, 0xaf, 0x5b, 0xeb, 0x8d, 0x3b, 0x8b, 0x62, 0x2c, 0x0f, 0x27, 0xc9,
-	0x21, 0x83, 0x1d, 0x45, 0x40, 0x6e, 0xc0, 0x42, 0x3f, 0x0c, 0x4e, 0xbc, 0x68, 0xc4, 0x65, 0xb0,
-	0x33, 0x87, 0xbd, 0x99, 0xa0, 0xfd, 0xad, 0x12, 0x34, 0x1e, 0x45, 0x6e, 0x10, 0xbb, 0x7d, 0x06,
-	0xb0, 0xa1, 0x27, 0xcf, 0x7a, 0xa7, 0x6e, 0x7c, 0x8a, 0xb3, 0xad, 0x3b, 0xb2, 0x48, 0xd6, 0x60,
-	0x8e, 0x0f, 0x14, 0xe7, 0x54, 0x76, 0x44, 0x89, 0xbc, 0x0e, 0x4b, 0xc1, 0x64, 0xd4, 0x33, 0xfb,
-	0x2a, 0xe3, 0x4e, 0xe7, 0x2b, 0xd8, 0x02, 0x1c, 0xb3, 0xbd, 0xe6, 0x5d, 0xf0, 0x19, 0x6a, 0x08,
-	0xb1, 0xa1, 0x29, 0x4a, 0xd4, 0x1b, 0x9e, 0xf2, 0x69, 0x56, 0x1d, 0x03, 0x63, 0x6d, 0x24, 0xde,
-	0x88, 0xf6, 0xe2, 0xc4, 0x1d, 0x8d, 0xc5, 0xb4, 0x34, 0x04, 0xeb, 0xc3, 0xc4, 0xf5, 0x7b, 0x27,
-	0x94, 0xc6, 0x9d, 0x79, 0x51, 0xaf, 0x10, 0xf2, 0x1a, 0xb4, 0x06, 0x34, 0x4e, 0x7a, 0x62, 0x53,
-	0x68, 0xdc, 0xa9, 0xa1, 0xc4, 0x65, 0x50, 0xc6, 0x19, 0xf7, 0x69, 0xa2, 0xad, 0x4e, 0x2c, 0x38,
-	0xd0, 0xde, 0x07, 0xa2, 0xc1, 0x3b, 0x34, 0x71, 0x3d, 0x3f, 0x26, 0x6f, 0x42, 0x33, 0xd1, 0x88,
-	0x51, 0xc3, 0x34, 0x14, 0xbb, 0x68, 0x1f, 0x38, 0x06, 0x9d, 0x7d, 0x1f, 0x6a, 0xf7, 0x28, 0xdd,
-	0xf7, 0x46, 0x5e, 0x42, 0xd6, 0xa0, 0x7a, 0xe2, 0x3d, 0xa3, 0x9c, 0xa1, 0xcb, 0x7b, 0x97, 0x1c,
-	0x5e, 0x24, 0x5d, 0x98, 0x1f, 0xd3, 0xa8, 0x4f, 0xe5, 0xf2, 0xef, 0x5d, 0x72, 0x24, 0x70, 0x77,
-	0x1e, 0xaa, 0x3e, 0xfb, 0xd8, 0xfe, 0x87, 0x12, 0x34, 0x8e, 0x68, 0xa0, 0x04, 0x85, 0x40, 0x85,
-	0x4d, 0x49, 0x08, 0x07, 0xfe, 0x4f, 0x5e, 0x81, 0x06, 0x4e, 0x33, 0x4e, 0x22, 0x2f, 0x18, 0x0a,
-	0xfe, 0x04, 0x06, 0x1d, 0x21, 0x42, 0xda, 0x50, 0x76, 0x47, 0x92, 0x37, 0xd9, 0xbf, 0x4c, 0x88,
-	0xc6, 0xee, 0x74, 0xc4, 0xe4, 0x4d, 0xed, 0x5a, 0xd3, 0x69, 0x08, 0x6c, 0x8f, 0x6d, 0xdb, 0x2d,
-	0x58, 0xd6, 0x49, 0x64, 0xeb, 0x55, 0x6c, 0x7d, 0x49, 0xa3, 0x14, 0x9d, 0xdc, 0x84, 0x45, 0x49,
-	0x1f, 0xf1, 0xc1, 0xe2, 0x3e, 0xd6, 0x9d, 0x96, 0x80, 0xe5, 0x14, 0xd6, 0xa1, 0x7d, 0xe2, 0x05,
-	0xae, 0xdf, 0xeb, 0xfb, 0xc9, 0x59, 0x6f, 0x40, 0xfd, 0xc4, 0xc5, 0x1d, 0xad, 0x3a, 0x2d, 0xc4,
-	0xb7, 0xfd, 0xe4, 0x6c, 0x87, 0xa1, 0xe4, 0x75, 0xa8, 0x9f, 0x50, 0xda, 0xc3, 0x95, 0xe8, 0xd4,
-	0x0c, 0xe9, 0x90, 0xab, 0xeb, 0xd4, 0x4e, 0xe4, 0x3a, 0xaf, 0x43, 0x3b, 0x9c, 0x24, 0xc3, 0xd0,
-	0x0b, 0x86, 0xbd, 0xfe, 0xa9, 0x1b, 0xf4, 0xbc, 0x41, 0xa7, 0x7e, 0xdd, 0x5a, 0xaf, 0x38, 0x2d,
-	0x89, 0x33, 0xad, 0xf0, 0x60, 0x60, 0xff, 0x99, 0x05, 0x4d, 0xbe, 0xa8, 0xc2, 0xa0, 0xdc, 0x80,
-	0x05, 0x39, 0x76, 0x1a, 0x45, 0x61, 0x24, 0x04, 0xc5, 0x04, 0xc9, 0x06, 0xb4, 0x25, 0x30, 0x8e,
-	0xa8, 0x37, 0x72, 0x87, 0x54, 0x68, 0x9f, 0x1c, 0x4e, 0xee, 0xa4, 0x2d, 0x46, 0xe1, 0x24, 0xe1,
-	0x2a, 0xbd, 0x71, 0xa7, 0x29, 0x86, 0xef, 0x30, 0xcc, 0x31, 0x49, 0x98, 0xa0, 0x14, 0x6c, 0x8a,
-	0x81, 0xd9, 0xdf, 0xb3, 0x80, 0xb0, 0xa1, 0x3f, 0x0a, 0x79, 0x13, 0x62, 0x4d, 0xb3, 0xfb, 0x69,
-	0xbd, 0xf0, 0x7e, 0x96, 0x66, 0xed, 0xe7, 0x3a, 0xcc, 0xe1, 0xb0, 0x98, 0xe4, 0x97, 0xb3, 0x43,
-	0xbf, 0x5b, 0xea, 0x58, 0x8e, 0xa8, 0x27, 0x36, 0x54, 0xf9, 0x1c, 0x2b, 0x05, 0x73, 0xe4, 0x55,
-	0xf6, 0x77, 0x2c, 0x68, 0xb2, 0xd5, 0x0f, 0xa8, 0x8f, 0x5a, 0x8d, 0xdc, 0x06, 0x72, 0x32, 0x09,
-	0x06, 0x6c, 0xb3, 0x92, 0x67, 0xde, 0xa0, 0x77, 0x3c, 0x65, 0x5d, 0xe1, 0xb8, 0xf7, 0x2e, 0x39,
-	0x05, 0x75, 0xe4, 0x75, 0x68, 0x1b, 0x68, 0x9c, 0x44, 0x7c, 0xf4, 0x7b, 0x97, 0x9c, 0x5c, 0x0d,
-	0x5b, 0x4c, 0xa6, 0x37, 0x27, 0x49, 0xcf, 0x0b, 0x06, 0xf4, 0x19, 0xae, 0xff, 0x82, 0x63, 0x60,
-	0x77, 0x5b, 0xd0, 0xd4, 0xbf, 0xb3, 0xdf, 0x87, 0x9a, 0xd4, 0xba, 0xa8, 0x71, 0x32, 0xe3, 0x72,
-	0x34, 0x84, 0x74, 0xa1, 0x66, 0x8e, 0xc2, 0xa9, 0x7d, 0x94, 0xbe, 0xed, 0xcf, 0x41, 0x7b, 0x9f,
-	0xa9, 0xbe, 0xc0, 0x0b, 0x86, 0xc2, 0xec, 0x30, 0x7d, 0x3c, 0x9e, 0x1c, 0x3f, 0xa5, 0x53, 0xc1,
-	0x7f, 0xa2, 0xc4, 0x84, 0xfe, 0x34, 0x8c, 0x13, 0xd1, 0x0f, 0xfe, 0x6f, 0xff, 0x8b, 0x05, 0x8b,
-	0x8c, 0x11, 0xde, 0x75, 0x83, 0xa9, 0xe4, 0x82, 0x7d, 0x68, 0xb2, 0xa6, 0x1e, 0x85, 0x5b, 0x5c,
-	0xab, 0x73, 0x6d, 0xb5, 0x2e, 0xf6, 0x23, 0x43, 0x7d, 0x4b, 0x27, 0x65, 0xce, 0xd6, 0xd4, 0x31,
-	0xbe, 0x66, 0x6a, 0x25, 0x71, 0xa3, 0x21, 0x4d, 0x50, 0xdf, 0x0b, 0xfd, 0x0f, 0x1c, 0xda, 0x0e,
-	0x83, 0x13, 0x72, 0x1d, 0x9a, 0xb1, 0x9b, 0xf4, 0xc6, 0x34, 0xc2, 0x35, 0x41, 0xd5, 0x50, 0x76,
-	0x20, 0x76, 0x93, 0x43, 0x1a, 0xdd, 0x9d, 0x26, 0xb4, 0xfb, 0x33, 0xb0, 0x94, 0xeb, 0x85, 0x69,
-	0xa3, 0x74, 0x8a, 0xec, 0x5f, 0xb2, 0x02, 0xd5, 0x33, 0xd7, 0x9f, 0x50, 0x61, 0x86, 0x78, 0xe1,
-	0xed, 0xd2, 0x5b, 0x96, 0xfd, 0x1a, 0xb4, 0xd3, 0x61, 0x0b, 0x61, 0x25, 0x50, 0x61, 0x2b, 0x2d,
-	0x1a, 0xc0, 0xff, 0xed, 0x6f, 0x5b, 0x9c, 0x70, 0x3b, 0xf4, 0x94, 0x4a, 0x67, 0x84, 0x4c, 0xf3,
-	0x4b, 0x42, 0xf6, 0xff, 0x4c, 0x93, 0xf7, 0xa3, 0x4f, 0x96, 0x5c, 0x81, 0x5a, 0x4c, 0x83, 0x41,
-	0xcf, 0xf5, 0x7d, 0xd4, 0x7c, 0x35, 0x67, 0x9e, 0x95, 0xb7, 0x7c, 0xdf, 0xbe, 0x09, 0x4b, 0xda,
-	0xe8, 0x9e, 0x33, 0x8f, 0x03, 0x20, 0xfb, 0x5e, 0x9c, 0x3c, 0x0e, 0xe2, 0xb1, 0xa6, 0x31, 0x5f,
-	0x82, 0xfa, 0xc8, 0x0b, 0x70, 0x64, 0x9c, 0x15, 0xab, 0x4e, 0x6d, 0xe4, 0x05, 0x6c, 0x5c, 0x31,
-	0x56, 0xba, 0xcf, 0x44, 0x65, 0x49, 0x54, 0xba, 0xcf, 0xb0, 0xd2, 0x7e, 0x0b, 0x96, 0x8d, 0xf6,
-	0x44, 0xd7, 0xaf, 0x42, 0x75, 0x92, 0x3c, 0x0b, 0xa5, 0x3d, 0x6b, 0x08, 0x0e, 0x61, 0x9e, 0x91,
-	0xc3, 0x6b, 0xec, 0x77, 0x60, 0xe9, 0x80, 0x9e, 0x0b, 0xce, 0x94, 0x03, 0x79, 0xed, 0x42, 0xaf,
-	0x09, 0xeb, 0xed, 0x5b, 0x40, 0xf4, 0x8f, 0x45, 0xaf, 0x9a, 0x0f, 0x65, 0x19, 0x3e, 0x94, 0xfd,
-	0x1a, 0x90, 0x23, 0x6f, 0x18, 0xbc, 0x4b, 0xe3, 0xd8, 0x1d, 0x2a, 0xa5, 0xd6, 0x86, 0xf2, 0x28,
-	0x1e, 0x0a, 0xd9, 0x63, 0xff, 0xda, 0x9f, 0x84, 0x65, 0x83, 0x4e, 0x34, 0x7c, 0x15, 0xea, 0xb1,
-	0x37, 0x0c, 0xdc, 0x64, 0x12, 0x51, 0xd1, 0x74, 0x0a, 0xd8, 0xf7, 0x60, 0xe5, 0x4b, 0x34, 0xf2,
-	0x4e, 0xa6, 0x17, 0x35, 0x6f, 0xb6, 0x53, 0xca, 0xb6, 0xb3, 0x0b, 0xab, 0x99, 0x76, 0x44, 0xf7,
-	0x9c, 0x7d, 0xc5, 0x4e, 0xd6, 0x1c, 0x5e, 0xd0, 0x84, 0xb9, 0xa4, 0x0b, 0xb3, 0xfd, 0x18, 0xc8,
-	0x76, 0x18, 0x04, 0xb4, 0x9f, 0x1c, 0x52, 0x1a, 0xa5, 0xa7, 0xa6, 0x94, 0x57, 0x1b, 0x77, 0x2e,
-	0x8b, 0x95, 0xcd, 0x6a, 0x08, 0xc1, 0xc4, 0x04, 0x2a, 0x63, 0x1a, 0x8d, 0xb0, 0xe1, 0x9a, 0x83,
-	0xff, 0xdb, 0xab, 0xb0, 0x6c, 0x34, 0x2b, 0x1c, 0xde, 0x37, 0x60, 0x75, 0xc7, 0x8b, 0xfb, 0xf9,
-	0x0e, 0x3b, 0x30, 0x3f, 0x9e, 0x1c, 0xf7, 0x52, 0x49, 0x94, 0x45, 0xe6, 0x23, 0x65, 0x3f, 0x11,
-	0x8d, 0xfd, 0xaa, 0x05, 0x95, 0xbd, 0x47, 0xfb, 0xdb, 0x4c, 0xf9, 0x79, 0x41, 0x3f, 0x1c, 0x31,
-	0x03, 0xc2, 0x27, 0xad, 0xca, 0x33, 0x25, 0xec, 0x2a, 0xd4, 0xd1, 0xee, 0x30, 0xb7, 0x4f, 0x1c,
-	0x70, 0x52, 0x80, 0xb9, 0x9c, 0xf4, 0xd9, 0xd8, 0x8b, 0xd0, 0xa7, 0x94, 0x9e, 0x62, 0x05, 0xf5,
-	0x66, 0xbe, 0xc2, 0xfe, 0x76, 0x15, 0xe6, 0x85, 0x35, 0xc1, 0xfe, 0xfa, 0x89, 0x77, 0x46, 0xc5,
-	0x48, 0x44, 0x89, 0xd9, 0xf4, 0x88, 0x8e, 0xc2, 0x84, 0xf6, 0x8c, 0x6d, 0x30, 0x41, 0x74, 0xa9,
-	0x79, 0x43, 0x3d, 0xee, 0x84, 0x97, 0x39, 0x95, 0x01, 0xb2, 0xc5, 0x92, 0x1e, 0x45, 0x05, 0x3d,
-	0x0a, 0x59, 0x64, 0x2b, 0xd1, 0x77, 0xc7, 0x6e, 0xdf, 0x4b, 0xa6, 0x42, 0x25, 0xa8, 0x32, 0x6b,
-	0xdb, 0x0f, 0xfb, 0xae, 0xdf, 0x3b, 0x76, 0x7d, 0x37, 0xe8, 0x53, 0xe9, 0xae, 0x1b, 0x20, 0x73,
-	0x5d, 0xc5, 0x90, 0x24, 0x19, 0x77, 0x6f, 0x33, 0x28, 0x33, 0x48, 0xfd, 0x70, 0x34, 0xf2, 0x12,
-	0xe6, 0xf1, 0xa2, 0x37, 0x54, 0x76, 0x34, 0x84, 0x1f, 0x0e, 0xb0, 0x74, 0xce, 0x57, 0xaf, 0x2e,
-	0x0f, 0x07, 0x1a, 0xc8, 0x5a, 0x61, 0x2e, 0x15, 0x53, 0x63, 0x4f, 0xcf, 0x3b, 0xc0, 0x5b, 0x49,
-	0x11, 0xb6, 0x0f, 0x93, 0x20, 0xa6, 0x49, 0xe2, 0xd3, 0x81, 0x1a, 0x50, 0x03, 0xc9, 0xf2, 0x15,
-	0xe4, 0x36, 0x2c, 0x73, 0x27, 0x3c, 0x76, 0x93, 0x30, 0x3e, 0xf5, 0xe2, 0x5e, 0xcc, 0xdc, 0xd9,
-	0x26, 0xd2, 0x17, 0x55, 0x91, 0xb7, 0xe0, 0x72, 0x06, 0x8e, 0x68, 0x9f, 0x7a, 0x67, 0x74, 0xd0,
-	0x59, 0xc0, 0xaf, 0x66, 0x55, 0x93, 0xeb, 0xd0, 0x60, 0x67, 0x8f, 0xc9, 0x78, 0xe0, 0x32, 0x8b,
-	0xdc, 0xc2, 0x7d, 0xd0, 0x21, 0xf2, 0x06, 0x2c, 0x8c, 0x29, 0x37, 0xe7, 0xa7, 0x89, 0xdf, 0x8f,
-	0x3b, 0x8b, 0x86, 0x76, 0x63, 0x9c, 0xeb, 0x98, 0x14, 0x8c, 0x29, 0xfb, 0x31, 0x3a, 0xa1, 0xee,
-	0xb4, 0xd3, 0x46, 0x76, 0x4b, 0x01, 0x94, 0x91, 0xc8, 0x3b, 0x73, 0x13, 0xda, 0x59, 0xe2, 0x0a,
-	0x5d, 0x14, 0xd9, 0x77, 0x5e, 0xe0, 0x25, 0x9e, 0x9b, 0x84, 0x51, 0x87, 0x60, 0x5d, 0x0a, 0xd8,
-	0x7f, 0x60, 0x71, 0xb5, 0x2b, 0x58, 0x54, 0xa9, 0xcf, 0x57, 0xa0, 0xc1, 0x99, 0xb3, 0x17, 0x06,
-	0xfe, 0x54, 0xf0, 0x2b, 0x70, 0xe8, 0x61, 0xe0, 0x4f, 0xc9, 0xc7, 0x60, 0xc1, 0x0b, 0x74, 0x12,
-	0x2e, 0xe1, 0x4d, 0x09, 0x22, 0xd1, 0x2b, 0xd0, 0x18, 0x4f, 0x8e, 0x7d, 0xaf, 0xcf, 0x49, 0xca,
-	0xbc, 0x15, 0x0e, 0x21, 0x01, 0x73, 0x06, 0xf9, 0x38, 0x39, 0x45, 0x05, 0x29, 0x1a, 0x02, 0x63,
-	0x24, 0xf6, 0x5d, 0x58, 0x31, 0x07, 0x28, 0x54, 0xd9, 0x06, 0xd4, 0x04, 0xe7, 0xc7, 0x9d, 0x06,
-	0xae, 0x5e, 0x4b, 0xac, 0x9e, 0x20, 0x75, 0x54, 0xbd, 0xfd, 0xfd, 0x0a, 0x2c, 0x0b, 0x74, 0xdb,
-	0x0f, 0x63, 0x7a, 0x34, 0x19, 0x8d, 0xdc, 0xa8, 0x40, 0xa4, 0xac, 0x0b, 0x44, 0xaa, 0x64, 0x8a,
-	0x14, 0x63, 0xf4, 0x53, 0xd7, 0x0b, 0xb8, 0x27, 0xcb, 0xe5, 0x51, 0x43, 0xc8, 0x3a, 0x2c, 0xf6,
-	0xfd, 0x30, 0xe6, 0x5e, 0x9b, 0x7e, 0xe8, 0xcc, 0xc2, 0x79, 0x15, 0x50, 0x2d, 0x52, 0x01, 0xba,
-	0x08, 0xcf, 0x65, 0x44, 0xd8, 0x86, 0x26, 0x6b, 0x94, 0x4a, 0x8d, 0x34, 0xcf, 0x3d, 0x39, 0x1d,
-	0x63, 0xe3, 0xc9, 0x0a, 0x0c, 0x97, 0xce, 0xc5, 0x22, 0x71, 0x61, 0x67, 0x5a, 0xa6, 0xf1, 0x34,
-	0xea, 0xba, 0x10, 0x97, 0x7c, 0x15, 0xb9, 0x07, 0xc0, 0xfb, 0x42, 0xb3, 0x0b, 0x68, 0x76, 0x5f,
-	0x33, 0x77, 0x44, 0x5f, 0xfb, 0x5b, 0xac, 0x30, 0x89, 0x28, 0x9a, 0x62, 0xed, 0x4b, 0xfb, 0xd7,
-	0x2c, 0x68, 0x68, 0x75, 0x64, 0x15, 0x96, 0xb6, 0x1f, 0x3e, 0x3c, 0xdc, 0x75, 0xb6, 0x1e, 0x3d,
-	0xf8, 0xd2, 0x6e, 0x6f, 0x7b, 0xff, 0xe1, 0xd1, 0x6e, 0xfb, 0x12, 0x83, 0xf7, 0x1f, 0x6e, 0x6f,
-	0xed, 0xf7, 0xee, 0x3d, 0x74, 0xb6, 0x25, 0x6c, 0x91, 0x35, 0x20, 0xce, 0xee, 0xbb, 0x0f, 0x1f,
-	0xed, 0x1a, 0x78, 0x89, 0xb4, 0xa1, 0x79, 0xd7, 0xd9, 0xdd, 0xda, 0xde, 0x13, 0x48, 0x99, 0xac,
-	0x40, 0xfb, 0xde, 0xe3, 0x83, 0x9d, 0x07, 0x07, 0xf7, 0x7b, 0xdb, 0x5b, 0x07, 0xdb, 0xbb, 0xfb,
-	0xbb, 0x3b, 0xed, 0x0a, 0x59, 0x80, 0xfa, 0xd6, 0xdd, 0xad, 0x83, 0x9d, 0x87, 0x07, 0xbb, 0x3b,
-	0xed, 0xaa, 0xfd, 0x4f, 0x16, 0xac, 0xe2, 0xa8, 0x07, 0x59, 0x01, 0xb9, 0x0e, 0x8d, 0x7e, 0x18,
-	0x8e, 0x29, 0xd3, 0xf6, 0x4a, 0xa1, 0xeb, 0x10, 0x63, 0x7e, 0xae, 0x3e, 0x4f, 0xc2, 0xa8, 0x4f,
-	0x85, 0x7c, 0x00, 0x42, 0xf7, 0x18, 0xc2, 0x98, 0x5f, 0x6c, 0x2f, 0xa7, 0xe0, 0xe2, 0xd1, 0xe0,
-	0x18, 0x27, 0x59, 0x83, 0xb9, 0xe3, 0x88, 0xba, 0xfd, 0x53, 0x21, 0x19, 0xa2, 0x44, 0x3e, 0x9e,
-	0x1e, 0x30, 0xfa, 0x6c, 0xf5, 0x7d, 0x3a, 0x40, 0x8e, 0xa9, 0x39, 0x8b, 0x02, 0xdf, 0x16, 0x30,
-	0x93, 0x7f, 0xf7, 0xd8, 0x0d, 0x06, 0x61, 0x40, 0x07, 0xc2, 0xd9, 0x4b, 0x01, 0xfb, 0x10, 0xd6,
-	0xb2, 0xf3, 0x13, 0xf2, 0xf5, 0xa6, 0x26, 0x5f, 0xdc, 0xf7, 0xea, 0xce, 0xde, 0x4d, 0x4d, 0xd6,
-	0xfe, 0xdd, 0x82, 0x0a, 0x33, 0xc5, 0xb3, 0xcd, 0xb6, 0xee, 0x5d, 0x95, 0x73, 0x11, 0x2a, 0x3c,
-	0xb3, 0x70, 0xe5, 0xcc, 0x0d, 0x98, 0x86, 0xa4, 0xf5, 0x11, 0xed, 0x9f, 0xe1, 0x8c, 0x55, 0x3d,
-	0x43, 0x98, 0x80, 0x30, 0xd7, 0x17, 0xbf, 0x16, 0x02, 0x22, 0xcb, 0xb2, 0x0e, 0xbf, 0x9c, 0x4f,
-	0xeb, 0xf0, 0xbb, 0x0e, 0xcc, 0x7b, 0xc1, 0x71, 0x38, 0x09, 0x06, 0x28, 0x10, 0x35, 0x47, 0x16,
-	0x31, 0x26, 0x86, 0x82, 0xea, 0x8d, 0x24, 0xfb, 0xa7, 0x80, 0x4d, 0xd8, 0xd1, 0x28, 0x46, 0xd7,
-	0x43, 0x85, 0x67, 0xde, 0x84, 0x25, 0x0d, 0x4b, 0xdd, 0xd8, 0x31, 0x03, 0x32, 0x6e, 0x2c, 0xfa,
-	0x2c, 0xbc, 0xc6, 0x6e, 0x43, 0xeb, 0x3e, 0x4d, 0x1e, 0x04, 0x27, 0xa1, 0x6c, 0xe9, 0x8f, 0x2b,
-	0xb0, 0xa8, 0x20, 0xd1, 0xd0, 0x3a, 0x2c, 0x7a, 0x03, 0x1a, 0x24, 0x5e, 0x32, 0xed, 0x19, 0x27,
-	0xb0, 0x2c, 0xcc, 0x7c, 0x3d, 0xd7, 0xf7, 0x5c, 0x19, 0x05, 0xe4, 0x05, 0x72, 0x07, 0x56, 0x98,
-	0x21, 0x92, 0xb6, 0x45, 0x6d, 0x31, 0x3f, 0xf8, 0x15, 0xd6, 0x31, 0x65, 0xc0, 0x70, 0xa1, 0xed,
-	0xd5, 0x27, 0xdc, 0xe7, 0x29, 0xaa, 0x62, 0xab, 0xc6, 0x5b, 0x62, 0x53, 0xae, 0x72, 0x63, 0xa5,
-	0x80, 0x5c, 0x98, 0x6d, 0x8e, 0xab, 0xaa, 0x6c, 0x98, 0x4d, 0x0b, 0xd5, 0xd5, 0x72, 0xa1, 0x3a,
-	0xa6, 0xca, 0xa6, 0x41, 0x9f, 0x0e, 0x7a, 0x49, 0xd8, 0x43, 0x95, 0x8b, 0xbb, 0x53, 0x73, 0xb2,
-	0x30, 0xb9, 0x0a, 0xf3, 0x09, 0x8d, 0x93, 0x80, 0x26, 0xa8, 0x95, 0x6a, 0x18, 0x10, 0x90, 0x10,
-	0x73, 0x50, 0x27, 0x91, 0x17, 0x77, 0x9a, 0x18, 0x84, 0xc3, 0xff, 0xc9, 0xa7, 0x60, 0xf5, 0x98,
-	0xc6, 0x49, 0xef, 0x94, 0xba, 0x03, 0x1a, 0xe1, 0x4e, 0xf3, 0x68, 0x1f, 0xb7, 0xfb, 0xc5, 0x95,
-	0x8c, 0x87, 0xce, 0x68, 0x14, 0x7b, 0x61, 0x80, 0x16, 0xbf, 0xee, 0xc8, 0x22, 0x6b, 0x8f, 0x4d,
-	0x5e, 0xd9, 0x4b, 0xb5, 0x82, 0x8b, 0x38, 0xf1, 0xe2, 0x4a, 0x72, 0x03, 0xe6, 0x70, 0x02, 0x71,
-	0xa7, 0x6d, 0x44, 0x35, 0xb6, 0x19, 0xe8, 0x88, 0xba, 0xcf, 0x57, 0x6a, 0x8d, 0x76, 0xd3, 0xfe,
-	0x29, 0xa8, 0x22, 0xcc, 0x36, 0x9d, 0x2f, 0x06, 0x67, 0x0a, 0x5e, 0x60, 0x43, 0x0b, 0x68, 0x72,
-	0x1e, 0x46, 0x4f, 0x65, 0x48, 0x58, 0x14, 0xed, 0x6f, 0xa0, 0x8b, 0xaf, 0x42, 0xa4, 0x8f, 0xd1,
-	0x3f, 0x61, 0x07, 0x35, 0xbe, 0xd4, 0xf1, 0xa9, 0x2b, 0x4e, 0x1d, 0x35, 0x04, 0x8e, 0x4e, 0x5d,
-	0xa6, 0xb6, 0x8c, 0xdd, 0xe3, 0x07, 0xb9, 0x06, 0x62, 0x7b, 0x7c, 0xf3, 0x6e, 0x40, 0x4b, 0x06,
-	0x5f, 0xe3, 0x9e, 0x4f, 0x4f, 0x12, 0x19, 0x57, 0x08, 0x26, 0x23, 0x3c, 0xed, 0xed, 0xd3, 0x93,
-	0xc4, 0x3e, 0x80, 0x25, 0xa1, 0x4a, 0x1e, 0x8e, 0xa9, 0xec, 0xfa, 0x33, 0x45, 0x26, 0xb9, 0x71,
-	0x67, 0xd9, 0xd4, 0x3d, 0x3c, 0xdc, 0x6c, 0x52, 0xda, 0x0e, 0x10, 0x5d, 0x35, 0x89, 0x06, 0x85,
-	0x5d, 0x94, 0x91, 0x13, 0x31, 0x1d, 0x03, 0x63, 0xeb, 0x13, 0x4f, 0xfa, 0x7d, 0x19, 0x32, 0x67,
-	0xc7, 0x61, 0x5e, 0xb4, 0xff, 0xc4, 0x82, 0x65, 0x6c, 0x4d, 0x3a, 0x15, 0x42, 0xfd, 0xbf, 0xf5,
-	0x11, 0x86, 0xd9, 0xec, 0xeb, 0xd1, 0xa4, 0x15, 0xa8, 0xea, 0x06, 0x81, 0x17, 0x3e, 0xfa, 0xa1,
-	0xbe, 0x92, 0x3d, 0xd4, 0xdb, 0xbf, 0x6b, 0xc1, 0x12, 0xd7, 0xc9, 0x89, 0x9b, 0x4c, 0x62, 0x31,
-	0xfd, 0x9f, 0x86, 0x05, 0x6e, 0x5c, 0x85, 0x54, 0x8b, 0x81, 0xae, 0x28, 0x05, 0x84, 0x28, 0x27,
-	0xde, 0xbb, 0xe4, 0x98, 0xc4, 0xe4, 0x1d, 0x74, 0x70, 0x82, 0x1e, 0xa2, 0x22, 0x30, 0x78, 0xa5,
-	0xc0, 0x0c, 0xa8, 0xef, 0x35, 0xf2, 0xbb, 0x35, 0x98, 0xe3, 0xfe, 0xae, 0x7d, 0x1f, 0x16, 0x8c,
-	0x8e, 0x8c, 0x80, 0x42, 0x93, 0x07, 0x14, 0x72, 0xa1, 0xa8, 0x52, 0x41, 0x28, 0xea, 0x4f, 0xcb,
-	0x40, 0x18, 0xb3, 0x64, 0x76, 0x83, 0x39, 0xdc, 0xe1, 0xc0, 0x38, 0x3e, 0x35, 0x1d, 0x1d, 0x22,
-	0xb7, 0x80, 0x68, 0x45, 0x19, 0x51, 0xe4, 0xd6, 0xa7, 0xa0, 0x86, 0xa9, 0x49, 0x61, 0xbc, 0x85,
-	0x99, 0x15, 0x07, 0x45, 0xbe, 0xec, 0x85, 0x75, 0xcc, 0xc0, 0x8c, 0x27, 0xf1, 0x29, 0x5e, 0xae,
-	0x88, 0x03, 0x96, 0x2c, 0x67, 0xf7, 0x77, 0xee, 0xc2, 0xfd, 0x9d, 0xcf, 0x05, 0x6d, 0x34, 0x17,
-	0xbf, 0x66, 0xba, 0xf8, 0x37, 0x60, 0x61, 0xc4, 0x5c, 0xce, 0xc4, 0xef, 0xf7, 0x46, 0xac, 0x77,
-	0x71, 0x9e, 0x32, 0x40, 0xb2, 0x01, 0x6d, 0xe1, 0x6e, 0xa4, 0xe7, 0x08, 0xc0, 0x35, 0xce, 0xe1,
-	0x4c, 0x7f, 0xa7, 0x61, 0x9c, 0x06, 0x0e, 0x36, 0x05, 0xd8, 0xc9, 0x2b, 0x66, 0x1c, 0xd2, 0x9b,
-	0x04, 0xe2, 0x7e, 0x85, 0x0e, 0xf0, 0x24, 0x55, 0x73, 0xf2, 0x15, 0xf6, 0x6f, 0x5b, 0xd0, 0x66,
-	0x7b, 0x66, 0xb0, 0xe5, 0xdb, 0x80, 0x52, 0xf1, 0x82, 0x5c, 0x69, 0xd0, 0x92, 0xb7, 0xa0, 0x8e,
-	0xe5, 0x70, 0x4c, 0x03, 0xc1, 0x93, 0x1d, 0x93, 0x27, 0x53, 0x7d, 0xb2, 0x77, 0xc9, 0x49, 0x89,
-	0x35, 0x8e, 0xfc, 0x3b, 0x0b, 0x1a, 0xa2, 0x97, 0x1f, 0x3a, 0x4c, 0xd0, 0xd5, 0x2e, 0xc4, 0x38,
-	0x27, 0xa5, 0xf7, 0x5f, 0xeb, 0xb0, 0x38, 0x72, 0x93, 0x49, 0xc4, 0xec, 0xb1, 0x11, 0x22, 0xc8,
-	0xc2, 0xcc, 0xb8, 0xa2, 0xea, 0x8c, 0x7b, 0x89, 0xe7, 0xf7, 0x64, 0xad, 0xb8, 0x7a, 0x2a, 0xaa,
-	0x62, 0x1a, 0x24, 0x4e, 0xdc, 0x21, 0x15, 0x76, 0x93, 0x17, 0xec, 0x0e, 0xac, 0x89, 0x09, 0x65,
-	0x5c, 0x55, 0xfb, 0x2f, 0x9b, 0x70, 0x39, 0x57, 0xa5, 0xee, 0xa7, 0xc5, 0xd9, 0xd7, 0xf7, 0x46,
-	0xc7, 0xa1, 0xf2, 0xf3, 0x2d, 0xfd, 0x58, 0x6c, 0x54, 0x91, 0x21, 0xac, 0x4a, 0x07, 0x81, 0xad,
-	0x69, 0x6a, 0xcc, 0x4a, 0x68, 0xa5, 0xde, 0x30, 0xb7, 0x30, 0xdb, 0xa1, 0xc4, 0x75, 0x21, 0x2e,
-	0x6e, 0x8f, 0x9c, 0x42, 0x47, 0x79, 0x22, 0x42, 0x59, 0x6b, 0xde, 0x0a, 0xeb, 0xeb, 0xf5, 0x0b,
-	0xfa, 0x32, 0x3c, 0x5b, 0x67, 0x66, 0x6b, 0x64, 0x0a, 0xd7, 0x64, 0x1d, 0x6a, 0xe3, 0x7c, 0x7f,
-	0x95, 0x17, 0x9a, 0x1b, 0xfa, 0xec, 0x66, 0xa7, 0x17, 0x34, 0x4c, 0xde, 0x87, 0xb5, 0x73, 0xd7,
-	0x4b, 0xe4, 0xb0, 0x34, 0xdf, 0xa0, 0x8a, 0x5d, 0xde, 0xb9, 0xa0, 0xcb, 0x27, 0xfc, 0x63, 0xc3,
-	0x44, 0xcd, 0x68, 0xb1, 0xfb, 0x37, 0x16, 0xb4, 0xcc, 0x76, 0x18, 0x9b, 0x0a, 0xd9, 0x97, 0x3a,
-	0x50, 0x7a, 0x93, 0x19, 0x38, 0x7f, 0x54, 0x2e, 0x15, 0x1d, 0x95, 0xf5, 0x03, 0x6a, 0xf9, 0xa2,
-	0x18, 0x53, 0xe5, 0xc5, 0x62, 0x4c, 0xd5, 0xa2, 0x18, 0x53, 0xf7, 0xbf, 0x2d, 0x20, 0x79, 0x5e,
-	0x22, 0xf7, 0xf9, 0x59, 0x3d, 0xa0, 0xbe, 0x50, 0x29, 0x3f, 0xf9, 0x62, 0xfc, 0x28, 0xd7, 0x4e,
-	0x7e, 0xcd, 0x04, 0x43, 0xbf, 0x3b, 0xd6, 0x9d, 0x9d, 0x05, 0xa7, 0xa8, 0x2a, 0x13, 0xf5, 0xaa,
-	0x5c, 0x1c, 0xf5, 0xaa, 0x5e, 0x1c, 0xf5, 0x9a, 0xcb, 0x46, 0xbd, 0xba, 0xbf, 0x62, 0xc1, 0x72,
-	0xc1, 0xa6, 0xff, 0xf8, 0x26, 0xce, 0xb6, 0xc9, 0xd0, 0x05, 0x25, 0xb1, 0x4d, 0x3a, 0xd8, 0xfd,
-	0x05, 0x58, 0x30, 0x18, 0xfd, 0xc7, 0xd7, 0x7f, 0xd6, 0x5f, 0xe3, 0x7c, 0x66, 0x60, 0xdd, 0xff,
-	0x28, 0x01, 0xc9, 0x0b, 0xdb, 0xff, 0xeb, 0x18, 0xf2, 0xeb, 0x54, 0x2e, 0x58, 0xa7, 0xff, 0x53,
-	0x3b, 0xf0, 0x3a, 0x2c, 0x89, 0x64, 0x16, 0x2d, 0x42, 0xc3, 0x39, 0x26, 0x5f, 0xc1, 0x3c, 0x56,
-	0x33, 0xe4, 0x58, 0x33, 0x12, 0x04, 0x34, 0x63, 0x98, 0x89, 0x3c, 0xda, 0x5d, 0xe8, 0x88, 0x15,
-	0xda, 0x3d, 0xa3, 0x41, 0x72, 0x34, 0x39, 0xe6, 0x19, 0x21, 0x5e, 0x18, 0xd8, 0xdf, 0x2b, 0x2b,
-	0xa7, 0x1b, 0x2b, 0x85, 0x79, 0xff, 0x14, 0x34, 0x75, 0x65, 0x2e, 0xb6, 0x23, 0x13, 0xa0, 0x63,
-	0x86, 0x5d, 0xa7, 0x22, 0x3b, 0xd0, 0x42, 0x95, 0x35, 0x50, 0xdf, 0x95, 0xf0, 0xbb, 0xe7, 0x04,
-	0x1e, 0xf6, 0x2e, 0x39, 0x99, 0x6f, 0xc8, 0x67, 0xa1, 0x65, 0x1e, 0xa5, 0x84, 0x8f, 0x50, 0xe4,
-	0x9b, 0xb3, 0xcf, 0x4d, 0x62, 0xb2, 0x05, 0xed, 0xec, 0x59, 0x4c, 0xdc, 0x16, 0xcf, 0x68, 0x20,
-	0x47, 0x4e, 0xde, 0x12, 0x77, 0x4f, 0x55, 0x0c, 0x82, 0xdd, 0x30, 0x3f, 0xd3, 0x96, 0xe9, 0x16,
-	0xff, 0xa3, 0xdd, 0x46, 0x7d, 0x15, 0x20, 0xc5, 0x48, 0x1b, 0x9a, 0x0f, 0x0f, 0x77, 0x0f, 0x7a,
-	0xdb, 0x7b, 0x5b, 0x07, 0x07, 0xbb, 0xfb, 0xed, 0x4b, 0x84, 0x40, 0x0b, 0xe3, 0x57, 0x3b, 0x0a,
-	0xb3, 0x18, 0xb6, 0xb5, 0xcd, 0x63, 0x63, 0x02, 0x2b, 0x91, 0x15, 0x68, 0x3f, 0x38, 0xc8, 0xa0,
-	0xe5, 0xbb, 0x75, 0x25, 0x1f, 0xf6, 0x1a, 0xac, 0xf0, 0x84, 0xa7, 0xbb, 0x9c, 0x3d, 0xa4, 0xaf,
-	0xf0, 0xfb, 0x16, 0xac, 0x66, 0x2a, 0xd2, 0xc4, 0x03, 0xee, 0x0e, 0x98, 0x3e, 0x82, 0x09, 0x32,
-	0x9e, 0x54, 0x9e, 0x5f, 0x46, 0x83, 0xe4, 0x2b, 0x18, 0xcf, 0x6b, 0x9e, 0x62, 0x46, 0x92, 0x8a,
-	0xaa, 0xec, 0xcb, 0x3c, 0x2d, 0x2b, 0xa0, 0x7e, 0x66, 0xe0, 0x27, 0x3c, 0x91, 0x4a, 0xaf, 0x48,
-	0xef, 0xf2, 0xcc, 0x21, 0xcb, 0x22, 0x73, 0xf2, 0x0d, 0xd7, 0xc3, 0x1c, 0x6f, 0x61, 0x9d, 0xfd,
-	0x7d, 0x0b, 0xc8, 0x17, 0x27, 0x34, 0x9a, 0x62, 0xce, 0x80, 0x0a, 0x07, 0x5e, 0xce, 0x06, 0xbb,
-	0xe6, 0xc6, 0x93, 0xe3, 0x2f, 0xd0, 0xa9, 0x4c, 0x68, 0x29, 0xa5, 0x09, 0x2d, 0x2f, 0x03, 0xb0,
-	0xc3, 0xb1, 0xca, 0x58, 0x40, 0xe7, 0x3a, 0x98, 0x8c, 0x78, 0x83, 0x85, 0x39, 0x27, 0x95, 0x8b,
-	0x73, 0x4e, 0xaa, 0x17, 0xe4, 0x9c, 0xd8, 0xef, 0xc0, 0xb2, 0x31, 0x6e, 0xb5, 0xad, 0x32, 0x77,
-	0xc2, 0xca, 0xe7, 0x4e, 0xc8, 0xbc, 0x09, 0xfb, 0x9b, 0x25, 0x28, 0xef, 0x85, 0x63, 0x3d, 0x14,
-	0x6e, 0x99, 0xa1, 0x70, 0xe1, 0x1f, 0xf4, 0x94, 0xf9, 0x17, 0x66, 0xc3, 0x00, 0xc9, 0x06, 0xb4,
-	0xdc, 0x51, 0xd2, 0x4b, 0x42, 0xe6, 0x0f, 0x9d, 0xbb, 0xd1, 0x80, 0xef, 0x35, 0x86, 0x64, 0x32,
-	0x35, 0x64, 0x05, 0xca, 0xca, 0x90, 0x22, 0x01, 0x2b, 0x32, 0x67, 0x1c, 0x2f, 0xd9, 0xa6, 0x22,
-	0xac, 0x24, 0x4a, 0x8c, 0x95, 0xcc, 0xef, 0xf9, 0x49, 0x88, 0xab, 0xc3, 0xa2, 0x2a, 0xe6, 0xab,
-	0xb0, 0xe5, 0x43, 0x32, 0x11, 0x0f, 0x94, 0x65, 0x3d, 0x76, 0x59, 0x33, 0xaf, 0x1c, 0xff, 0xcd,
-	0x82, 0x2a, 0xae, 0x0d, 0x53, 0xed, 0x9c, 0xf7, 0x55, 0x34, 0x1c, 0xd7, 0x64, 0xc1, 0xc9, 0xc2,
-	0xc4, 0x36, 0x52, 0xc2, 0x4a, 0x6a, 0x42, 0x7a, 0x5a, 0xd8, 0x75, 0xa8, 0xf3, 0x92, 0x4a, 0x7f,
-	0x42, 0x92, 0x14, 0x24, 0xd7, 0xa0, 0x72, 0x1a, 0x8e, 0xa5, 0x2f, 0x0a, 0xf2, 0xaa, 0x28, 0x1c,
-	0x3b, 0x88, 0xa7, 0xe3, 0x61, 0xed, 0xf1, 0x69, 0x71, 0x0f, 0x23, 0x0b, 0x33, 0x1f, 0x4b, 0x35,
-	0xab, 0x2f, 0x53, 0x06, 0xb5, 0x37, 0x60, 0xf1, 0x20, 0x1c, 0x50, 0x2d, 0x24, 0x39, 0x93, 0xcf,
-	0xed, 0x5f, 0xb4, 0xa0, 0x26, 0x89, 0xc9, 0x3a, 0x54, 0x98, 0xe3, 0x98, 0x39, 0xd5, 0xa9, 0x2b,
-	0x62, 0x46, 0xe7, 0x20, 0x05, 0xb3, 0xb4, 0x18, 0x29, 0x4a, 0x0f, 0x11, 0x32, 0x4e, 0x94, 0xfa,
-	0xc8, 0x6a, 0xb8, 0x19, 0xd7, 0x32, 0x83, 0xda, 0xdf, 0xb5, 0x60, 0xc1, 0xe8, 0x83, 0x5c, 0x87,
-	0x86, 0xef, 0xc6, 0x89, 0xb8, 0x76, 0x13, 0xdb, 0xa3, 0x43, 0xfa, 0x46, 0x97, 0xcc, 0x20, 0xb5,
-	0x0a, 0x9f, 0x96, 0xf5, 0xf0, 0xe9, 0x6d, 0xa8, 0xa7, 0x89, 0x7b, 0x15, 0xc3, 0x82, 0xb2, 0x1e,
-	0xe5, 0xe5, 0x77, 0x4a, 0x84, 0x11, 0xb9, 0xd0, 0x0f, 0x23, 0x71, 0xa3, 0xc3, 0x0b, 0xf6, 0x3b,
-	0xd0, 0xd0, 0xe8, 0xf5, 0x00, 0x9d, 0x65, 0x04, 0xe8, 0x54, 0x66, 0x48, 0x29, 0xcd, 0x0c, 0xb1,
-	0xff, 0xd3, 0x82, 0x05, 0xc6, 0x83, 0x5e, 0x30, 0x3c, 0x0c, 0x7d, 0xaf, 0x3f, 0xc5, 0xbd, 0x97,
-	0xec, 0x26, 0x74, 0x86, 0xe4, 0x45, 0x13, 0x66, 0x5c, 0x2f, 0xc3, 0x02, 0x42, 0x44, 0x55, 0x99,
-	0xc9, 0x30, 0x93, 0x80, 0x63, 0x37, 0x16, 0x62, 0x21, 0x5c, 0x1a, 0x03, 0x64, 0x92, 0xc6, 0x80,
-	0xc8, 0x4d, 0x68, 0x6f, 0xe4, 0xf9, 0xbe, 0xc7, 0x69, 0xb9, 0xc3, 0x5b, 0x54, 0xc5, 0xfa, 0x1c,
-	0x78, 0xb1, 0x7b, 0x9c, 0xde, 0x52, 0xa8, 0x32, 0xc6, 0x2e, 0xdc, 0x67, 0x5a, 0xec, 0x62, 0x0e,
-	0xf5, 0x8a, 0x09, 0xda, 0x7f, 0x5e, 0x82, 0x86, 0xb4, 0x9f, 0x83, 0x21, 0x15, 0x17, 0x6f, 0x78,
-	0xf0, 0x50, 0xaa, 0x48, 0x43, 0x64, 0xbd, 0x71, 0x54, 0xd1, 0x90, 0x2c, 0x63, 0x94, 0xf3, 0x8c,
-	0x71, 0x15, 0xea, 0x8c, 0x41, 0xdf, 0xc0, 0x33, 0x91, 0xc8, 0x85, 0x55, 0x80, 0xac, 0xbd, 0x83,
-	0xb5, 0xd5, 0xb4, 0x16, 0x81, 0xe7, 0x5e, 0xd3, 0xbd, 0x05, 0x4d, 0xd1, 0x0c, 0xee, 0x1c, 0x6a,
-	0x9e, 0x54, 0x44, 0x8c, 0x5d, 0x75, 0x0c, 0x4a, 0xf9, 0xe5, 0x1d, 0xf9, 0x65, 0xed, 0xa2, 0x2f,
-	0x25, 0xa5, 0x7d, 0x5f, 0xdd, 0x7e, 0xde, 0x8f, 0xdc, 0xf1, 0xa9, 0x94, 0xe5, 0xdb, 0xb0, 0xec,
-	0x05, 0x7d, 0x7f, 0x32, 0xa0, 0xbd, 0x49, 0xe0, 0x06, 0x41, 0x38, 0x09, 0xfa, 0x54, 0xa6, 0x86,
-	0x14, 0x55, 0xd9, 0x03, 0x95, 0x19, 0x87, 0x0d, 0x91, 0x0d, 0xa8, 0xb2, 0x8e, 0xa4, 0xed, 0x28,
-	0x16, 0x74, 0x4e, 0x42, 0xd6, 0xa1, 0x4a, 0x07, 0x43, 0x2a, 0xe3, 0x04, 0x24, 0xe3, 0x15, 0x0d,
-	0x86, 0xd4, 0xe1, 0x04, 0x4c, 0xed, 0x60, 0xf6, 0xa3, 0xa9, 0x76, 0x4c, 0xbb, 0x33, 0xd7, 0xe7,
-	0xf9, 0x91, 0x2b, 0x40, 0x0e, 0xb8, 0xa4, 0xe8, 0x17, 0x27, 0xbf, 0x5c, 0x86, 0x86, 0x06, 0x33,
-	0x0d, 0x32, 0x64, 0x03, 0xee, 0x0d, 0x3c, 0x77, 0x44, 0x13, 0x1a, 0x09, 0xe9, 0xc8, 0xa0, 0x8c,
-	0xce, 0x3d, 0x1b, 0xf6, 0xc2, 0x49, 0xd2, 0x1b, 0xd0, 0x61, 0x44, 0xb9, 0x2b, 0xc0, 0x4c, 0x93,
-	0x81, 0x32, 0x3a, 0xc6, 0x9f, 0x1a, 0x1d, 0xe7, 0xa0, 0x0c, 0x2a, 0xaf, 0x41, 0xf8, 0x1a, 0x55,
-	0xd2, 0x6b, 0x10, 0xbe, 0x22, 0x59, 0xdd, 0x57, 0x2d, 0xd0, 0x7d, 0x6f, 0xc2, 0x1a, 0xd7, 0x72,
-	0x42, 0x1f, 0xf4, 0x32, 0x8c, 0x35, 0xa3, 0x96, 0x6c, 0x40, 0x9b, 0x8d, 0x59, 0x8a, 0x44, 0xec,
-	0x7d, 0x83, 0x87, 0x14, 0x2d, 0x27, 0x87, 0x33, 0x5a, 0x8c, 0xed, 0xe9, 0xb4, 0xfc, 0x5a, 0x38,
-	0x87, 0x23, 0xad, 0xfb, 0xcc, 0xa4, 0xad, 0x0b, 0xda, 0x0c, 0x6e, 0x2f, 0x40, 0xe3, 0x28, 0x09,
-	0xc7, 0x72, 0x53, 0x5a, 0xd0, 0xe4, 0x45, 0x91, 0xa2, 0xf3, 0x12, 0x5c, 0x41, 0x2e, 0x7a, 0x14,
-	0x8e, 0x43, 0x3f, 0x1c, 0x4e, 0x8d, 0x73, 0xc5, 0xdf, 0x5a, 0xb0, 0x6c, 0xd4, 0xa6, 0x07, 0x0b,
-	0x0c, 0x49, 0xc8, 0xdc, 0x0a, 0xce, 0x78, 0x4b, 0x9a, 0x0a, 0xe6, 0x84, 0x3c, 0xfa, 0xfb, 0x58,
-	0xa4, 0x5b, 0x6c, 0xc1, 0xa2, 0x1c, 0x99, 0xfc, 0x90, 0x73, 0x61, 0x27, 0xcf, 0x85, 0xe2, 0xfb,
-	0x96, 0xf8, 0x40, 0x36, 0xf1, 0x59, 0x71, 0xbd, 0xce, 0xcf, 0x19, 0x32, 0x02, 0xa5, 0x4e, 0x26,
-	0xfa, 0x39, 0x54, 0x8e, 0xa0, 0xaf, 0xc0, 0xd8, 0xfe, 0x75, 0x0b, 0x20, 0x1d, 0x1d, 0x5e, 0xca,
-	0x2a, 0x33, 0xc2, 0xdf, 0x82, 0x68, 0x26, 0xe3, 0x55, 0x68, 0xaa, 0xcb, 0xbc, 0xd4, 0x32, 0x35,
-	0x24, 0xc6, 0xdc, 0xca, 0x9b, 0xb0, 0x38, 0xf4, 0xc3, 0x63, 0x34, 0xeb, 0x98, 0xf3, 0x15, 0x8b,
-	0x44, 0xa5, 0x16, 0x87, 0xef, 0x09, 0x34, 0x35, 0x63, 0x15, 0xcd, 0x8c, 0xd9, 0xbf, 0x51, 0x52,
-	0x77, 0x2f, 0xe9, 0x9c, 0x67, 0x4a, 0x19, 0xb9, 0x93, 0x53, 0xa7, 0x33, 0xae, 0x3a, 0x30, 0xd6,
-	0x7a, 0x78, 0x61, 0x28, 0xe8, 0x1d, 0x68, 0x45, 0x5c, 0x5f, 0x49, 0x65, 0x56, 0x79, 0x8e, 0x32,
-	0x5b, 0x88, 0x0c, 0x5b, 0xf7, 0x71, 0x68, 0xbb, 0x83, 0x33, 0x1a, 0x25, 0x1e, 0x1e, 0xc6, 0xd1,
-	0xd1, 0xe0, 0x2a, 0x78, 0x51, 0xc3, 0xd1, 0xfe, 0xdf, 0x84, 0x45, 0x91, 0x1c, 0xa6, 0x28, 0x45,
-	0xa2, 0x77, 0x0a, 0x33, 0x42, 0xfb, 0x0f, 0xe5, 0x35, 0x8f, 0xb9, 0x87, 0xb3, 0x57, 0x44, 0x9f,
-	0x5d, 0x29, 0x33, 0xbb, 0x8f, 0x89, 0x2b, 0x97, 0x81, 0x3c, 0xf1, 0x97, 0xb5, 0x54, 0x8c, 0x81,
-	0xb8, 0x22, 0x33, 0x97, 0xb4, 0xf2, 0x22, 0x4b, 0x6a, 0xff, 0xc0, 0x82, 0xf9, 0xbd, 0x70, 0xbc,
-	0x27, 0x92, 0x52, 0x50, 0x10, 0x54, 0x56, 0xa6, 0x2c, 0x3e, 0x27, 0x5d, 0xa5, 0xd0, 0xbe, 0x2f,
-	0x64, 0xed, 0xfb, 0xcf, 0xc2, 0x4b, 0x18, 0x6f, 0x8a, 0xc2, 0x71, 0x18, 0x31, 0x61, 0x74, 0x7d,
-	0x6e, 0xcc, 0xc3, 0x20, 0x39, 0x95, 0x6a, 0xec, 0x79, 0x24, 0x78, 0x08, 0x64, 0x87, 0x17, 0xee,
-	0x9a, 0x0b, 0x7f, 0x84, 0x6b, 0xb7, 0x7c, 0x85, 0xfd, 0x19, 0xa8, 0xa3, 0x43, 0x8d, 0xd3, 0x7a,
-	0x1d, 0xea, 0xa7, 0xe1, 0xb8, 0x77, 0xea, 0x05, 0x89, 0x14, 0xee, 0x56, 0xea, 0xe9, 0xee, 0xe1,
-	0x82, 0x28, 0x02, 0xfb, 0x9b, 0x73, 0x30, 0xff, 0x20, 0x38, 0x0b, 0xbd, 0x3e, 0x5e, 0x29, 0x8d,
-	0xe8, 0x28, 0x94, 0x39, 0xaa, 0xec, 0x7f, 0x72, 0x15, 0xe6, 0x31, 0x29, 0x6b, 0xcc, 0x99, 0xb6,
-	0xc9, 0xaf, 0x7e, 0x05, 0xc4, 0x9c, 0x84, 0x28, 0x4d, 0x8f, 0xe7, 0xe2, 0xa3, 0x21, 0xec, 0xa8,
-	0x11, 0xe9, 0xe9, 0xed, 0xa2, 0x94, 0xe6, 0x00, 0x57, 0xb5, 0x1c, 0x60, 0xd6, 0x97, 0x48, 0xa2,
-	0xe1, 0x59, 0x16, 0xbc, 0x2f, 0x01, 0xe1, 0xf1, 0x28, 0xa2, 0x3c, 0x5e, 0x88, 0x2e, 0xc7, 0xbc,
-	0x38, 0x1e, 0xe9, 0x20, 0x73, 0x4b, 0xf8, 0x07, 0x9c, 0x86, 0x2b, 0x61, 0x1d, 0x62, 0x8e, 0x5e,
-	0xf6, 0xe9, 0x42, 0x9d, 0xf3, 0x7e, 0x06, 0x66, 0x9a, 0x7a, 0x40, 0x95, 0x42, 0xe5, 0xf3, 0x00,
-	0xfe, 0x04, 0x20, 0x8b, 0x6b, 0x87, 0x2a, 0x9e, 0x3f, 0x27, 0x0f, 0x55, 0x8c, 0x61, 0x5c, 0xdf,
-	0x3f, 0x76, 0xfb, 0x4f, 0xf1, 0x65, 0x0a, 0x5e, 0xf2, 0xd4, 0x1d, 0x13, 0xc4, 0x54, 0x98, 0x74,
-	0x57, 0xf1, 0x92, 0xbc, 0xe2, 0xe8, 0x10, 0xb9, 0x03, 0x0d, 0x3c, 0x48, 0x8a, 0x7d, 0x6d, 0xe1,
-	0xbe, 0xb6, 0xf5, 0x93, 0x26, 0xee, 0xac, 0x4e, 0xa4, 0x5f, 0x77, 0x2d, 0xe6, 0x32, 0xda, 0xdc,
-	0xc1, 0x40, 0xdc, 0x12, 0xb6, 0xb1, 0xb7, 0x14, 0x60, 0x56, 0x55, 0x2c, 0x18, 0x27, 0x58, 0x42,
-	0x02, 0x03, 0x23, 0xd7, 0xa0, 0xc6, 0x0e, 0x39, 0x63, 0xd7, 0x1b, 0x60, 0x4a, 0x1c, 0x3f, 0x6b,
-	0x29, 0x8c, 0xb5, 0x21, 0xff, 0xc7, 0xdb, 0xbc, 0x65, 0x5c, 0x15, 0x03, 0x63, 0x6b, 0xa3, 0xca,
-	0x28, 0x4c, 0x2b, 0x7c, 0x47, 0x0d, 0x90, 0xbc, 0x81, 0x77, 0x35, 0x09, 0xed, 0xac, 0x62, 0x2c,
-	0xe8, 0x25, 0x31, 0x67, 0xc1, 0xb4, 0xf2, 0xef, 0x11, 0x23, 0x71, 0x38, 0xa5, 0xfd, 0x49, 0x68,
-	0xea, 0x30, 0xa9, 0x41, 0xe5, 0xe1, 0xe1, 0xee, 0x41, 0xfb, 0x12, 0x69, 0xc0, 0xfc, 0xd1, 0xee,
-	0xa3, 0x47, 0xfb, 0xbb, 0x3b, 0x6d, 0x8b, 0x34, 0xa1, 0xa6, 0xf2, 0x96, 0x4a, 0x76, 0x02, 0x64,
-	0x6b, 0x30, 0x10, 0xdf, 0xa9, 0xc3, 0x7d, 0xca, 0xc1, 0x96, 0xc1, 0xc1, 0x05, 0x5c, 0x54, 0x2a,
-	0xe6, 0xa2, 0xe7, 0xae, 0xb5, 0xbd, 0x0b, 0x8d, 0x43, 0xed, 0xdd, 0x06, 0x0a, 0x94, 0x7c, 0xb1,
-	0x21, 0x04, 0x51, 0x43, 0xb4, 0xe1, 0x94, 0xf4, 0xe1, 0xd8, 0x7f, 0x64, 0xf1, 0x5c, 0x72, 0x35,
-	0x7c, 0xde, 0xb7, 0x0d, 0x4d, 0x15, 0x82, 0x49, 0x93, 0x10, 0x0d, 0x8c, 0xd1, 0xe0, 0x50, 0x7a,
-	0xe1, 0xc9, 0x49, 0x4c, 0x65, 0xca, 0x90, 0x81, 0x31, 0x49, 0x60, 0x3e, 0x15, 0xf3, 0x4f, 0x3c,
-	0xde, 0x43, 0x2c, 0x52, 0x87, 0x72, 0x38, 0xd3, 0xeb, 0x11, 0x3d, 0xa3, 0x51, 0xac, 0x92, 0xa5,
-	0x54, 0x59, 0xe5, 0x4a, 0x66, 0x57, 0x79, 0x03, 0x6a, 0xaa, 0x5d, 0x53, 0x65, 0x49, 0x4a, 0x55,
-	0xcf, 0x54, 0x23, 0x9e, 0x32, 0x8c, 0x41, 0x73, 0x35, 0x9d, 0xaf, 0x20, 0xb7, 0x80, 0x9c, 0x78,
-	0x51, 0x96, 0xbc, 0x8c, 0xe4, 0x05, 0x35, 0xf6, 0x13, 0x58, 0x96, 0xac, 0xa3, 0x39, 0x53, 0xe6,
-	0x26, 0x5a, 0x17, 0x09, 0x4c, 0x29, 0x2f, 0x30, 0xf6, 0xff, 0x58, 0x30, 0x2f, 0x76, 0x3a, 0xf7,
-	0xf6, 0x87, 0xef, 0xb3, 0x81, 0x91, 0x8e, 0xf1, 0x4c, 0x02, 0xa5, 0x4b, 0xa8, 0xc9, 0x9c, 0x22,
-	0x2c, 0x17, 0x29, 0x42, 0x02, 0x95, 0xb1, 0x9b, 0x9c, 0xe2, 0x09, 0xbb, 0xee, 0xe0, 0xff, 0xa4,
-	0xcd, 0xe3, 0x41, 0x5c, 0xe9, 0x62, 0x2c, 0xa8, 0xe8, 0x95, 0x13, 0xb7, 0xef, 0xf9, 0x57, 0x4e,
-	0x57, 0xa1, 0x8e, 0x03, 0xe8, 0xa5, 0xe1, 0x9e, 0x14, 0x60, 0x9c, 0xcb, 0x0b, 0x28, 0xc9, 0x22,
-	0x63, 0x39, 0x45, 0xec, 0x55, 0xbe, 0xf3, 0x62, 0x09, 0xd4, 0xcd, 0xaa, 0xc8, 0x4d, 0x4d, 0xe1,
-	0x94, 0x23, 0xc4, 0x00, 0xb2, 0x1c, 0x21, 0x48, 0x1d, 0x55, 0x6f, 0x77, 0xa1, 0xb3, 0x43, 0x7d,
-	0x9a, 0xd0, 0x2d, 0xdf, 0xcf, 0xb6, 0xff, 0x12, 0x5c, 0x29, 0xa8, 0x13, 0xfe, 0xf3, 0x17, 0x61,
-	0x75, 0x8b, 0xe7, 0xf1, 0xfd, 0xb8, 0x72, 0x53, 0xec, 0x0e, 0xac, 0x65, 0x9b, 0x14, 0x9d, 0xdd,
-	0x83, 0xa5, 0x1d, 0x7a, 0x3c, 0x19, 0xee, 0xd3, 0xb3, 0xb4, 0x23, 0x02, 0x95, 0xf8, 0x34, 0x3c,
-	0x17, 0x82, 0x89, 0xff, 0x93, 0x97, 0x01, 0x7c, 0x46, 0xd3, 0x8b, 0xc7, 0xb4, 0x2f, 0x5f, 0x26,
-	0x20, 0x72, 0x34, 0xa6, 0x7d, 0xfb, 0x4d, 0x20, 0x7a, 0x3b, 0x62, 0xbd, 0x98, 0xdd, 0x9b, 0x1c,
-	0xf7, 0xe2, 0x69, 0x9c, 0xd0, 0x91, 0x7c, 0x72, 0xa1, 0x43, 0xf6, 0x4d, 0x68, 0x1e, 0xba, 0x53,
-	0x87, 0x7e, 0x5d, 0x3c, 0xf9, 0xba, 0x0c, 0xf3, 0x63, 0x77, 0xca, 0xd4, 0x94, 0x8a, 0x43, 0x61,
-	0xb5, 0xfd, 0x5f, 0x25, 0x98, 0xe3, 0x94, 0xac, 0xd5, 0x01, 0x8d, 0x13, 0x2f, 0x40, 0xc6, 0x92,
-	0xad, 0x6a, 0x50, 0x8e, 0x95, 0x4b, 0x05, 0xac, 0x2c, 0x4e, 0x69, 0x32, 0xcb, 0x5b, 0xf0, 0xab,
-	0x81, 0x31, 0xe6, 0x4a, 0x93, 0xc4, 0x78, 0x20, 0x24, 0x05, 0x32, 0x21, 0xcb, 0xd4, 0xba, 0xf2,
-	0xf1, 0x49, 0x29, 0x15, 0x9c, 0xab, 0x43, 0x85, 0x36, 0x7c, 0x9e, 0x33, 0x78, 0xce, 0x86, 0xe7,
-	0x6c, 0x75, 0xed, 0x05, 0x6c, 0x35, 0x3f, 0xba, 0x3d, 0xcf, 0x56, 0xc3, 0x0b, 0xd8, 0x6a, 0x9b,
-	0x40, 0xfb, 0x1e, 0xa5, 0x0e, 0x65, 0xde, 0xa0, 0xe4, 0xdd, 0x6f, 0x59, 0xd0, 0x16, 0x5c, 0xa4,
-	0xea, 0xc8, 0xab, 0x86, 0xd7, 0x5b, 0x98, 0x6d, 0x7d, 0x03, 0x16, 0xd0, 0x17, 0x55, 0xb1, 0x59,
-	0x11, 0x48, 0x36, 0x40, 0x36, 0x0f, 0x79, 0x29, 0x3a, 0xf2, 0x7c, 0xb1, 0x29, 0x3a, 0x24, 0xc3,
-	0xbb, 0x91, 0x2b, 0x92, 0xa5, 0x2c, 0x47, 0x95, 0xed, 0xbf, 0xb0, 0x60, 0x49, 0x1b, 0xb0, 0xe0,
-	0xc2, 0x77, 0x40, 0x4a, 0x03, 0x0f, 0xd4, 0x72, 0xc9, 0xbd, 0x6c, 0x8a, 0x4d, 0xfa, 0x99, 0x41,
-	0x8c, 0x9b, 0xe9, 0x4e, 0x71, 0x80, 0xf1, 0x64, 0x24, 0x94, 0xa8, 0x0e, 0x31, 0x46, 0x3a, 0xa7,
-	0xf4, 0xa9, 0x22, 0xe1, 0x6a, 0xdc, 0xc0, 0x30, 0x1a, 0xc6, 0x7c, 0x68, 0x45, 0x54, 0x11, 0xd1,
-	0x30, 0x1d, 0xb4, 0xff, 0xd1, 0x82, 0x65, 0x7e, 0x18, 0x12, 0x47, 0x4d, 0xf5, 0x50, 0x66, 0x8e,
-	0x9f, 0xfe, 0xb8, 0x44, 0xee, 0x5d, 0x72, 0x44, 0x99, 0x7c, 0xfa, 0x05, 0x0f, 0x70, 0x2a, 0x83,
-	0x6b, 0xc6, 0x5e, 0x94, 0x8b, 0xf6, 0xe2, 0x39, 0x2b, 0x5d, 0x14, 0x98, 0xac, 0x16, 0x06, 0x26,
-	0xef, 0xce, 0x43, 0x35, 0xee, 0x87, 0x63, 0x6a, 0xaf, 0xc1, 0x8a, 0x39, 0x39, 0xa1, 0x82, 0xbe,
-	0x63, 0x41, 0xe7, 0x1e, 0x0f, 0xe0, 0x7b, 0xc1, 0x70, 0xcf, 0x8b, 0x93, 0x30, 0x52, 0xef, 0x09,
-	0xaf, 0x01, 0xc4, 0x89, 0x1b, 0x25, 0x3c, 0x4f, 0x57, 0x04, 0x04, 0x53, 0x84, 0x8d, 0x91, 0x06,
-	0x03, 0x5e, 0xcb, 0xf7, 0x46, 0x95, 0x73, 0x3e, 0x84, 0x38, 0xae, 0x19, 0x96, 0xf8, 0x35, 0x9e,
-	0xd1, 0xc8, 0x7c, 0x05, 0x7a, 0x86, 0x7a, 0x9d, 0x9f, 0x83, 0x32, 0xa8, 0xfd, 0xf7, 0x16, 0x2c,
-	0xa6, 0x83, 0xc4, 0xbb, 0x3e, 0x53, 0x3b, 0x08, 0xf3, 0x9b, 0x6a, 0x07, 0x19, 0xaa, 0xf4, 0x98,
-	0x3d, 0x16, 0x63, 0xd3, 0x10, 0x94, 0x58, 0x51, 0x0a, 0x27, 0xd2, 0xc1, 0xd1, 0x21, 0x9e, 0x9f,
-	0xc4, 0x3c, 0x01, 0xe1, 0xd5, 0x88, 0x12, 0xa6, 0x59, 0x8f, 0x12, 0xfc, 0x8a, 0x07, 0x55, 0x65,
-	0x51, 0x9a, 0xd2, 0x79, 0x44, 0xd1, 0x94, 0xea, 0x97, 0x21, 0x35, 0xbe, 0x3e, 0xb2, 0x6c, 0xff,
-	0xa6, 0x05, 0x57, 0x0a, 0x16, 0x5e, 0x48, 0xcd, 0x0e, 0x2c, 0x9d, 0xa8, 0x4a, 0xb9, 0x38, 0x5c,
-	0x74, 0xd6, 0xe4, 0x6d, 0x94, 0xb9, 0x20, 0x4e, 0xfe, 0x03, 0xe5, 0x17, 0xf1, 0xe5, 0x36, 0x32,
-	0x00, 0xf3, 0x15, 0x1b, 0x9f, 0x83, 0x86, 0xf6, 0x92, 0x8f, 0x5c, 0x86, 0xe5, 0x27, 0x0f, 0x1e,
-	0x1d, 0xec, 0x1e, 0x1d, 0xf5, 0x0e, 0x1f, 0xdf, 0xfd, 0xc2, 0xee, 0x97, 0x7b, 0x7b, 0x5b, 0x47,
-	0x7b, 0xed, 0x4b, 0x64, 0x0d, 0xc8, 0xc1, 0xee, 0xd1, 0xa3, 0xdd, 0x1d, 0x03, 0xb7, 0xee, 0xfc,
-	0x56, 0x19, 0x5a, 0xfc, 0x96, 0x93, 0xff, 0xf6, 0x03, 0x8d, 0xc8, 0xbb, 0x30, 0x2f, 0x7e, 0xbb,
-	0x83, 0xac, 0x8a, 0x61, 0x9b, 0xbf, 0x16, 0xd2, 0x5d, 0xcb, 0xc2, 0x82, 0x2f, 0x97, 0x7f, 0xe9,
-	0x07, 0xff, 0xfa, 0x3b, 0xa5, 0x05, 0xd2, 0xd8, 0x3c, 0x7b, 0x63, 0x73, 0x48, 0x83, 0x98, 0xb5,
-	0xf1, 0x55, 0x80, 0xf4, 0x57, 0x2d, 0x48, 0x47, 0xf9, 0x83, 0x99, 0x9f, 0xeb, 0xe8, 0x5e, 0x29,
-	0xa8, 0x11, 0xed, 0x5e, 0xc1, 0x76, 0x97, 0xed, 0x16, 0x6b, 0xd7, 0x0b, 0xbc, 0x84, 0xff, 0xc4,
-	0xc5, 0xdb, 0xd6, 0x06, 0x19, 0x40, 0x53, 0xff, 0xd1, 0x0a, 0x22, 0xc3, 0x50, 0x05, 0x3f, 0x99,
-	0xd1, 0x7d, 0xa9, 0xb0, 0x4e, 0xc6, 0xe0, 0xb0, 0x8f, 0x55, 0xbb, 0xcd, 0xfa, 0x98, 0x20, 0x45,
-	0xda, 0x8b, 0x0f, 0x2d, 0xf3, 0xb7, 0x29, 0xc8, 0x55, 0x4d, 0x65, 0xe4, 0x7e, 0x19, 0xa3, 0xfb,
-	0xf2, 0x8c, 0x5a, 0xd1, 0xd7, 0xcb, 0xd8, 0xd7, 0x65, 0x9b, 0xb0, 0xbe, 0xfa, 0x48, 0x23, 0x7f,
-	0x19, 0xe3, 0x6d, 0x6b, 0xe3, 0xce, 0x5f, 0x5f, 0x87, 0xba, 0x0a, 0x1c, 0x93, 0xf7, 0x61, 0xc1,
-	0xb8, 0x86, 0x26, 0x72, 0x1a, 0x45, 0xb7, 0xd6, 0xdd, 0xab, 0xc5, 0x95, 0xa2, 0xe3, 0x6b, 0xd8,
-	0x71, 0x87, 0xac, 0xb1, 0x8e, 0xc5, 0x3d, 0xee, 0x26, 0x26, 0x54, 0xf0, 0xec, 0xe8, 0xa7, 0x7c,
-	0x9e, 0xe9, 0xd5, 0xb1, 0x31, 0xcf, 0xdc, 0x55, 0xb3, 0x31, 0xcf, 0xfc, 0x7d, 0xb3, 0x7d, 0x15,
-	0xbb, 0x5b, 0x23, 0x2b, 0x7a, 0x77, 0x2a, 0xa0, 0x4b, 0x31, 0xa5, 0x5f, 0xff, 0x59, 0x07, 0xf2,
-	0xb2, 0x62, 0xac, 0xa2, 0x9f, 0x7b, 0x50, 0x2c, 0x92, 0xff, 0xcd, 0x07, 0xbb, 0x83, 0x5d, 0x11,
-	0x82, 0xdb, 0xa7, 0xff, 0xaa, 0x03, 0xf9, 0x0a, 0xd4, 0xd5, 0x33, 0x5e, 0x72, 0x59, 0x7b, 0x56,
-	0xad, 0x3f, 0x3b, 0xee, 0x76, 0xf2, 0x15, 0x45, 0x8c, 0xa1, 0xb7, 0xcc, 0x18, 0xe3, 0x09, 0x34,
-	0xb4, 0xa7, 0xba, 0xe4, 0x8a, 0x0a, 0xfb, 0x67, 0x9f, 0x03, 0x77, 0xbb, 0x45, 0x55, 0xa2, 0x8b,
-	0x25, 0xec, 0xa2, 0x41, 0xea, 0xc8, 0x7b, 0xc9, 0xb3, 0x30, 0x26, 0xfb, 0xb0, 0x2a, 0x0e, 0x2e,
-	0xc7, 0xf4, 0xa3, 0x2c, 0x51, 0xc1, 0xaf, 0x5c, 0xdc, 0xb6, 0xc8, 0x3b, 0x50, 0x93, 0x2f, 0xb2,
-	0xc9, 0x5a, 0xf1, 0xcb, 0xf2, 0xee, 0xe5, 0x1c, 0x2e, 0xd4, 0xda, 0x97, 0x01, 0xd2, 0x77, 0xc1,
-	0x4a, 0x80, 0x73, 0xef, 0x8c, 0xd5, 0xee, 0xe4, 0x1f, 0x11, 0xdb, 0x6b, 0x38, 0xc1, 0x36, 0x41,
-	0x01, 0x0e, 0xe8, 0xb9, 0x7c, 0xe4, 0xf2, 0x35, 0x68, 0x68, 0x4f, 0x83, 0xd5, 0xf2, 0xe5, 0x9f,
-	0x15, 0xab, 0xe5, 0x2b, 0x78, 0x49, 0x6c, 0x77, 0xb1, 0xf5, 0x15, 0x7b, 0x91, 0xb5, 0x1e, 0x7b,
-	0xc3, 0x60, 0xc4, 0x09, 0xd8, 0x06, 0x9d, 0xc2, 0x82, 0xf1, 0xfe, 0x57, 0x49, 0x4f, 0xd1, 0xeb,
-	0x62, 0x25, 0x3d, 0x85, 0x4f, 0x86, 0x25, 0x3b, 0xdb, 0x4b, 0xac, 0x9f, 0x33, 0x24, 0xd1, 0x7a,
-	0x7a, 0x0f, 0x1a, 0xda, 0x5b, 0x5e, 0x35, 0x97, 0xfc, 0xb3, 0x61, 0x35, 0x97, 0xa2, 0xa7, 0xbf,
-	0x2b, 0xd8, 0x47, 0xcb, 0x46, 0x56, 0xc0, 0x37, 0x22, 0xac, 0xed, 0xf7, 0xa1, 0x65, 0xbe, 0xee,
-	0x55, 0x72, 0x59, 0xf8, 0x4e, 0x58, 0xc9, 0xe5, 0x8c, 0x27, 0xc1, 0x82, 0xa5, 0x37, 0x96, 0x55,
-	0x27, 0x9b, 0x1f, 0x88, 0xcb, 0xde, 0x0f, 0xc9, 0x17, 0x99, 0xf2, 0x11, 0x8f, 0x76, 0xc8, 0x65,
-	0x8d, 0x6b, 0xf5, 0xa7, 0x3d, 0x4a, 0x5e, 0x72, 0xef, 0x7b, 0x4c, 0x66, 0xe6, 0xaf, 0x5c, 0xd0,
-	0xa2, 0xe0, 0xe3, 0x1d, 0xcd, 0xa2, 0xe8, 0xef, 0x7b, 0x34, 0x8b, 0x62, 0xbc, 0xf1, 0xc9, 0x5a,
-	0x94, 0xc4, 0x63, 0x6d, 0x04, 0xb0, 0x98, 0x49, 0x63, 0x53, 0x52, 0x51, 0x9c, 0xf7, 0xdb, 0xbd,
-	0xf6, 0xfc, 0xec, 0x37, 0x53, 0x51, 0x49, 0x05, 0xb5, 0x29, 0xb3, 0xac, 0x7f, 0x1e, 0x9a, 0xfa,
-	0xbb, 0x4b, 0xa2, 0x8b, 0x72, 0xb6, 0xa7, 0x97, 0x0a, 0xeb, 0xcc, 0xcd, 0x25, 0x4d, 0xbd, 0x1b,
-	0xf2, 0x25, 0x58, 0x53, 0xa2, 0xae, 0x67, 0x46, 0xc5, 0xe4, 0x95, 0x82, 0x7c, 0x29, 0x3d, 0x9c,
-	0xd1, 0xbd, 0x32, 0x33, 0xa1, 0xea, 0xb6, 0xc5, 0x98, 0xc6, 0x7c, 0xd0, 0x96, 0x2a, 0xf3, 0xa2,
-	0x77, 0x7c, 0xa9, 0x32, 0x2f, 0x7c, 0x05, 0x27, 0x99, 0x86, 0x2c, 0x1b, 0x6b, 0xc4, 0x23, 0xf9,
-	0xe4, 0x3d, 0x58, 0xd4, 0x72, 0x4f, 0x8f, 0xa6, 0x41, 0x5f, 0x09, 0x40, 0xfe, 0x91, 0x42, 0xb7,
-	0xc8, 0xdf, 0xb6, 0x2f, 0x63, 0xfb, 0x4b, 0xb6, 0xb1, 0x38, 0x8c, 0xf9, 0xb7, 0xa1, 0xa1, 0xe7,
-	0xb5, 0x3e, 0xa7, 0xdd, 0xcb, 0x5a, 0x95, 0x9e, 0x63, 0x7f, 0xdb, 0x22, 0xbf, 0x67, 0x41, 0xd3,
-	0xc8, 0x12, 0x35, 0xee, 0xab, 0x32, 0xed, 0x74, 0xf4, 0x3a, 0xbd, 0x21, 0xdb, 0xc1, 0x41, 0xee,
-	0x6f, 0x7c, 0xde, 0x58, 0x84, 0x0f, 0x8c, 0x73, 0xdb, 0xad, 0xec, 0xcf, 0x9a, 0x7c, 0x98, 0x25,
-	0xd0, 0x1f, 0x72, 0x7c, 0x78, 0xdb, 0x22, 0xdf, 0xb5, 0xa0, 0x65, 0x46, 0x1b, 0xd4, 0x56, 0x15,
-	0xc6, 0x35, 0xd4, 0x56, 0xcd, 0x08, 0x51, 0xbc, 0x87, 0xa3, 0x7c, 0xb4, 0xe1, 0x18, 0xa3, 0x14,
-	0x4f, 0x1d, 0x7f, 0xb4, 0xd1, 0x92, 0xb7, 0xf9, 0x4f, 0x1b, 0xc9, 0x10, 0x18, 0xd1, 0xac, 0x46,
-	0x76, 0x7b, 0xf5, 0x5f, 0xeb, 0x59, 0xb7, 0x6e, 0x5b, 0xe4, 0x6b, 0xfc, 0xd7, 0x4f, 0xc4, 0xb7,
-	0xc8, 0x25, 0x2f, 0xfa, 0xbd, 0x7d, 0x03, 0xe7, 0x74, 0xcd, 0xbe, 0x62, 0xcc, 0x29, 0x6b, 0x8f,
-	0xb7, 0xf8, 0xe8, 0xc4, 0x0f, 0xed, 0xa4, 0x06, 0x25, 0xf7, 0xe3, 0x3b, 0xb3, 0x07, 0x39, 0xe2,
-	0x83, 0x14, 0xe4, 0x06, 0x2b, 0xbf, 0x60, 0x33, 0xf6, 0x06, 0x8e, 0xf5, 0x86, 0xfd, 0xca, 0xcc,
-	0xb1, 0x6e, 0x62, 0xcc, 0x80, 0x8d, 0xf8, 0x10, 0x20, 0x0d, 0x57, 0x93, 0x4c, 0xb8, 0x54, 0x09,
-	0x78, 0x3e, 0xa2, 0x6d, 0xca, 0x8b, 0x8c, 0xaa, 0xb2, 0x16, 0xbf, 0xc2, 0xd5, 0xd5, 0x03, 0x19,
-	0x68, 0xd5, 0x9d, 0x12, 0x33, 0xae, 0x6c, 0x38, 0x25, 0xd9, 0xf6, 0x0d, 0x65, 0xa5, 0xa2, 0xb6,
-	0x8f, 0x61, 0x61, 0x3f, 0x0c, 0x9f, 0x4e, 0xc6, 0xea, 0xb2, 0xc9, 0x0c, 0xe7, 0xed, 0xb9, 0xf1,
-	0x69, 0x37, 0x33, 0x0b, 0xfb, 0x3a, 0x36, 0xd5, 0x25, 0x1d, 0xad, 0xa9, 0xcd, 0x0f, 0xd2, 0x70,
-	0xf8, 0x87, 0xc4, 0x85, 0x25, 0xa5, 0x03, 0xd5, 0xc0, 0xbb, 0x66, 0x33, 0x86, 0xe6, 0xcb, 0x76,
-	0x61, 0x78, 0xb6, 0x72, 0xb4, 0x9b, 0xb1, 0x6c, 0xf3, 0xb6, 0x45, 0x0e, 0xa1, 0xb9, 0x43, 0xfb,
-	0xe1, 0x80, 0x8a, 0x98, 0xd8, 0x72, 0x3a, 0x70, 0x15, 0x4c, 0xeb, 0x2e, 0x18, 0xa0, 0x69, 0x17,
-	0xc6, 0xee, 0x34, 0xa2, 0x5f, 0xdf, 0xfc, 0x40, 0x44, 0xdb, 0x3e, 0x94, 0x76, 0x41, 0x86, 0x23,
-	0x0d, 0xbb, 0x90, 0x89, 0x5f, 0x1a, 0x76, 0x21, 0x17, 0xbf, 0x34, 0x96, 0x5a, 0x86, 0x43, 0x89,
-	0x0f, 0x4b, 0xb9, 0x90, 0xa7, 0x32, 0x09, 0xb3, 0x02, 0xa5, 0xdd, 0xeb, 0xb3, 0x09, 0xcc, 0xde,
-	0x36, 0xcc, 0xde, 0x8e, 0x60, 0x61, 0x87, 0xf2, 0xc5, 0xe2, 0x19, 0x2d, 0x99, 0x54, 0x63, 0x3d,
-	0x5f, 0x26, 0xab, 0xc0, 0xb1, 0xce, 0x34, 0xfc, 0x98, 0x4e, 0x42, 0xbe, 0x02, 0x8d, 0xfb, 0x34,
-	0x91, 0x29, 0x2c, 0xca, 0xf5, 0xcc, 0xe4, 0xb4, 0x74, 0x0b, 0x32, 0x60, 0x4c, 0x9e, 0xc1, 0xd6,
-	0x36, 0xe9, 0x60, 0x48, 0xb9, 0x72, 0xea, 0x79, 0x83, 0x0f, 0xc9, 0xcf, 0x61, 0xe3, 0x2a, 0xd3,
-	0x6e, 0x4d, 0xcb, 0x7c, 0xd0, 0x1b, 0x5f, 0xcc, 0xe0, 0x45, 0x2d, 0x07, 0xe1, 0x80, 0x6a, 0x2e,
-	0x50, 0x00, 0x0d, 0x2d, 0x41, 0x54, 0x09, 0x50, 0x3e, 0xd9, 0x55, 0x09, 0x50, 0x41, 0x3e, 0xa9,
-	0xbd, 0x8e, 0xfd, 0xd8, 0xe4, 0x7a, 0xda, 0x0f, 0xcf, 0x21, 0x4d, 0x7b, 0xda, 0xfc, 0xc0, 0x1d,
-	0x25, 0x1f, 0x92, 0x27, 0xf8, 0xde, 0x59, 0x4f, 0xd3, 0x49, 0x7d, 0xe9, 0x6c, 0x46, 0x8f, 0x5a,
-	0x2c, 0xad, 0xca, 0xf4, 0xaf, 0x79, 0x57, 0xe8, 0x29, 0x7d, 0x1a, 0xe0, 0x28, 0x09, 0xc7, 0x3b,
-	0x2e, 0x1d, 0x85, 0x41, 0xaa, 0x6b, 0xd3, 0x54, 0x94, 0x54, 0x7f, 0x69, 0xf9, 0x28, 0xe4, 0x89,
-	0x76, 0xf8, 0x30, 0xb2, 0x9c, 0x24, 0x73, 0xcd, 0xcc, 0x56, 0x51, 0x0b, 0x52, 0x90, 0xb1, 0x72,
-	0xdb, 0x22, 0x5b, 0x00, 0x69, 0xcc, 0x5b, 0x1d, 0x25, 0x72, 0xe1, 0x74, 0xa5, 0xf6, 0x0a, 0x02,
-	0xe4, 0x87, 0x50, 0x4f, 0x83, 0xa8, 0x97, 0xd3, 0x24, 0x5f, 0x23, 0xe4, 0xaa, 0x2c, 0x78, 0x2e,
-	0xb4, 0x69, 0xb7, 0x71, 0xa9, 0x80, 0xd4, 0xd8, 0x52, 0x61, 0xbc, 0xd2, 0x83, 0x65, 0x3e, 0x40,
-	0xe5, 0x8e, 0x60, 0x72, 0x85, 0x9c, 0x49, 0x41, 0x78, 0x51, 0x49, 0x73, 0x61, 0x74, 0xce, 0x88,
-	0x56, 0x30, 0x6e, 0xe5, 0x89, 0x1d, 0x4c, 0x35, 0x8f, 0x60, 0x29, 0x17, 0x3e, 0x52, 0x22, 0x3d,
-	0x2b, 0xa2, 0xa7, 0x44, 0x7a, 0x66, 0xe4, 0xc9, 0x5e, 0xc5, 0x2e, 0x17, 0x6d, 0xc0, 0x13, 0xd0,
-	0xb9, 0x97, 0xf4, 0x4f, 0xdf, 0xb6, 0x36, 0xee, 0xde, 0x7c, 0xef, 0x27, 0x86, 0x5e, 0x72, 0x3a,
-	0x39, 0xbe, 0xd5, 0x0f, 0x47, 0x9b, 0xbe, 0x0c, 0x29, 0x88, 0x14, 0xa9, 0x4d, 0x3f, 0x18, 0x6c,
-	0x62, 0xcb, 0xc7, 0x73, 0xf8, 0xbb, 0xb0, 0x9f, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x96,
-	0x58, 0x4f, 0x4e, 0x49, 0x56, 0x00, 0x00,
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_rpc_6ead92cf3f5e6752) }
+
+var fileDescriptor_rpc_6ead92cf3f5e6752 = []byte{
+	// 10132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0xbd, 0x5d, 0x6c, 0x24, 0x59,
+	0x96, 0x10, 0x5c, 0x91, 0x3f, 0x76, 0xe6, 0xc9, 0x74, 0x3a, 0x7d, 0xfd, 0x97, 0xe5, 0xaa, 0xae,
+	0xae, 0x8e, 0xa9, 0xed, 0xaa, 0xf1, 0xf4, 0x57, 0x55, 0x5d, 0x33, 0xd3, 0x5f, 0xf7, 0xf4, 0x6c,
+	0xcf, 0xb8, 0xec, 0xac, 0xb2, 0xbb, 0xdd, 0xb6, 0x27, 0xec, 0xea, 0xda, 0xee, 0x19, 0x88, 0x09,
+	0x67, 0x5e, 0xa7, 0x63, 0x2a, 0x33, 0x22, 0x3b, 0x22, 0xd2, 0x55, 0xee, 0xa6, 0x25, 0x16, 0x21,
+	0x18, 0x90, 0x10, 0xbf, 0x8b, 0x58, 0x24, 0x04, 0x1a, 0x78, 0x59, 0xad, 0x56, 0xfb, 0x04, 0x5a,
+	0x04, 0x88, 0x17, 0x78, 0x41, 0x42, 0x68, 0x35, 0x6f, 0x48, 0x20, 0xad, 0x84, 0x84, 0x80, 0x07,
+	0x24, 0x10, 0xe2, 0x09, 0x09, 0xdd, 0x73, 0x7f, 0xe2, 0xde, 0x88, 0x48, 0xdb, 0x33, 0x3b, 0xec,
+	0x93, 0x7d, 0xcf, 0x39, 0x71, 0x7f, 0xcf, 0x3d, 0xf7, 0xfc, 0xdd, 0x9b, 0x50, 0x8f, 0xc6, 0xbd,
+	0xfb, 0xe3, 0x28, 0x4c, 0x42, 0x52, 0x1d, 0x06, 0xd1, 0xb8, 0xb7, 0x76, 0x73, 0x10, 0x86, 0x83,
+	0x21, 0x7d, 0xe0, 0x8d, 0xfd, 0x07, 0x5e, 0x10, 0x84, 0x89, 0x97, 0xf8, 0x61, 0x10, 0x73, 0x22,
+	0xfb, 0xc7, 0xd0, 0x7a, 0x4a, 0x83, 0x43, 0x4a, 0xfb, 0x0e, 0xfd, 0x7c, 0x42, 0xe3, 0x84, 0x7c,
+	0x03, 0x16, 0x3c, 0xfa, 0x05, 0xa5, 0x7d, 0x77, 0xec, 0xc5, 0xf1, 0xf8, 0x34, 0xf2, 0x62, 0xda,
+	0xb1, 0x6e, 0x5b, 0xf7, 0x9a, 0x4e, 0x9b, 0x23, 0x0e, 0x14, 0x9c, 0xbc, 0x01, 0xcd, 0x98, 0x91,
+	0xd2, 0x20, 0x89, 0xc2, 0xf1, 0x79, 0xa7, 0x84, 0x74, 0x0d, 0x06, 0xeb, 0x72, 0x90, 0x3d, 0x84,
+	0x79, 0xd5, 0x42, 0x3c, 0x0e, 0x83, 0x98, 0x92, 0x87, 0xb0, 0xd4, 0xf3, 0xc7, 0xa7, 0x34, 0x72,
+	0xf1, 0xe3, 0x51, 0x40, 0x47, 0x61, 0xe0, 0xf7, 0x3a, 0xd6, 0xed, 0xf2, 0xbd, 0xba, 0x43, 0x38,
+	0x8e, 0x7d, 0xf1, 0xb1, 0xc0, 0x90, 0xbb, 0x30, 0x4f, 0x03, 0x0e, 0xa7, 0x7d, 0xfc, 0x4a, 0x34,
+	0xd5, 0x4a, 0xc1, 0xec, 0x03, 0xfb, 0x5f, 0x59, 0xb0, 0xb0, 0x13, 0xf8, 0xc9, 0x73, 0x6f, 0x38,
+	0xa4, 0x89, 0x1c, 0xd3, 0x5d, 0x98, 0x7f, 0x89, 0x00, 0x1c, 0xd3, 0xcb, 0x30, 0xea, 0x8b, 0x11,
+	0xb5, 0x38, 0xf8, 0x40, 0x40, 0xa7, 0xf6, 0xac, 0x34, 0xb5, 0x67, 0x85, 0xd3, 0x55, 0x9e, 0x32,
+	0x5d, 0x77, 0x61, 0x3e, 0xa2, 0xbd, 0xf0, 0x8c, 0x46, 0xe7, 0xee, 0x4b, 0x3f, 0xe8, 0x87, 0x2f,
+	0x3b, 0x95, 0xdb, 0xd6, 0xbd, 0xaa, 0xd3, 0x92, 0xe0, 0xe7, 0x08, 0xb5, 0x97, 0x80, 0xe8, 0xa3,
+	0xe0, 0xf3, 0x66, 0x0f, 0x60, 0xf1, 0x59, 0x30, 0x0c, 0x7b, 0x2f, 0x7e, 0xc9, 0xd1, 0x15, 0x34,
+	0x5f, 0x2a, 0x6c, 0x7e, 0x05, 0x96, 0xcc, 0x86, 0x44, 0x07, 0x28, 0x2c, 0x6f, 0x9e, 0x7a, 0xc1,
+	0x80, 0xca, 0x2a, 0x65, 0x17, 0xbe, 0x0e, 0xed, 0xde, 0x24, 0x8a, 0x68, 0x90, 0xeb, 0xc3, 0xbc,
+	0x80, 0xab, 0x4e, 0xbc, 0x01, 0xcd, 0x80, 0xbe, 0x4c, 0xc9, 0x04, 0xcb, 0x04, 0xf4, 0xa5, 0x24,
+	0xb1, 0x3b, 0xb0, 0x92, 0x6d, 0x46, 0x74, 0xe0, 0x8f, 0x2c, 0xa8, 0x3c, 0x4b, 0x5e, 0x85, 0xe4,
+	0x3e, 0x54, 0x92, 0xf3, 0x31, 0x67, 0xcc, 0xd6, 0x23, 0x72, 0x1f, 0x79, 0xfd, 0xfe, 0x46, 0xbf,
+	0x1f, 0xd1, 0x38, 0x3e, 0x3a, 0x1f, 0x53, 0xa7, 0xe9, 0xf1, 0x82, 0xcb, 0xe8, 0x48, 0x07, 0x66,
+	0x45, 0x19, 0x1b, 0xac, 0x3b, 0xb2, 0x48, 0x6e, 0x01, 0x78, 0xa3, 0x70, 0x12, 0x24, 0x6e, 0xec,
+	0x25, 0xb8, 0x72, 0x65, 0x47, 0x83, 0x90, 0x9b, 0x50, 0x1f, 0xbf, 0x70, 0xe3, 0x5e, 0xe4, 0x8f,
+	0x13, 0x5c, 0xad, 0xba, 0x93, 0x02, 0xc8, 0x37, 0xa0, 0x16, 0x4e, 0x92, 0x71, 0xe8, 0x07, 0x49,
+	0xa7, 0x7a, 0xdb, 0xba, 0xd7, 0x78, 0x34, 0x2f, 0xfa, 0xb2, 0x3f, 0x49, 0x0e, 0x18, 0xd8, 0x51,
+	0x04, 0xe4, 0x0e, 0xcc, 0xf5, 0xc2, 0xe0, 0xc4, 0x8f, 0x46, 0x7c, 0x0f, 0x76, 0x66, 0xb0, 0x35,
+	0x13, 0x68, 0xff, 0x7e, 0x09, 0x1a, 0x47, 0x91, 0x17, 0xc4, 0x5e, 0x8f, 0x01, 0x58, 0xd7, 0x93,
+	0x57, 0xee, 0xa9, 0x17, 0x9f, 0xe2, 0x68, 0xeb, 0x8e, 0x2c, 0x92, 0x15, 0x98, 0xe1, 0x1d, 0xc5,
+	0x31, 0x95, 0x1d, 0x51, 0x22, 0x6f, 0xc1, 0x42, 0x30, 0x19, 0xb9, 0x66, 0x5b, 0x65, 0x5c, 0xe9,
+	0x3c, 0x82, 0x4d, 0xc0, 0x31, 0x5b, 0x6b, 0xde, 0x04, 0x1f, 0xa1, 0x06, 0x21, 0x36, 0x34, 0x45,
+	0x89, 0xfa, 0x83, 0x53, 0x3e, 0xcc, 0xaa, 0x63, 0xc0, 0x58, 0x1d, 0x89, 0x3f, 0xa2, 0x6e, 0x9c,
+	0x78, 0xa3, 0xb1, 0x18, 0x96, 0x06, 0x41, 0x7c, 0x98, 0x78, 0x43, 0xf7, 0x84, 0xd2, 0xb8, 0x33,
+	0x2b, 0xf0, 0x0a, 0x42, 0xde, 0x84, 0x56, 0x9f, 0xc6, 0x89, 0x2b, 0x16, 0x85, 0xc6, 0x9d, 0x1a,
+	0xee, 0xb8, 0x0c, 0x94, 0x2c, 0x41, 0x75, 0xe8, 0x1d, 0xd3, 0x61, 0xa7, 0x8e, 0xdd, 0xe4, 0x05,
+	0xc6, 0x2f, 0x4f, 0x69, 0xa2, 0xcd, 0x59, 0x2c, 0xf8, 0xd2, 0xde, 0x05, 0xa2, 0x81, 0xb7, 0x68,
+	0xe2, 0xf9, 0xc3, 0x98, 0xbc, 0x03, 0xcd, 0x44, 0x23, 0x46, 0xb9, 0xd3, 0x50, 0x4c, 0xa4, 0x7d,
+	0xe0, 0x18, 0x74, 0xf6, 0x53, 0xa8, 0x3d, 0xa1, 0x74, 0xd7, 0x1f, 0xf9, 0x09, 0x59, 0x81, 0xea,
+	0x89, 0xff, 0x8a, 0x72, 0x36, 0x2f, 0x6f, 0x5f, 0x73, 0x78, 0x91, 0xac, 0xc1, 0xec, 0x98, 0x46,
+	0x3d, 0x2a, 0x17, 0x65, 0xfb, 0x9a, 0x23, 0x01, 0x8f, 0x67, 0xa1, 0x3a, 0x64, 0x1f, 0xdb, 0xbf,
+	0x5d, 0x86, 0xc6, 0x21, 0x0d, 0xd4, 0xf6, 0x21, 0x50, 0x61, 0x03, 0x15, 0x5b, 0x06, 0xff, 0x27,
+	0xaf, 0x43, 0x03, 0x07, 0x1f, 0x27, 0x91, 0x1f, 0x0c, 0x04, 0xd7, 0x02, 0x03, 0x1d, 0x22, 0x84,
+	0xb4, 0xa1, 0xec, 0x8d, 0x24, 0xc7, 0xb2, 0x7f, 0xd9, 0xd6, 0x1a, 0x7b, 0xe7, 0x23, 0xb6, 0x0b,
+	0xd5, 0x5a, 0x36, 0x9d, 0x86, 0x80, 0x6d, 0xb3, 0xc5, 0xbc, 0x0f, 0x8b, 0x3a, 0x89, 0xac, 0xbd,
+	0x8a, 0xb5, 0x2f, 0x68, 0x94, 0xa2, 0x91, 0xbb, 0x30, 0x2f, 0xe9, 0x23, 0xde, 0x59, 0x5c, 0xdd,
+	0xba, 0xd3, 0x12, 0x60, 0x39, 0x84, 0x7b, 0xd0, 0x3e, 0xf1, 0x03, 0x6f, 0xe8, 0xf6, 0x86, 0xc9,
+	0x99, 0xdb, 0xa7, 0xc3, 0xc4, 0xc3, 0x75, 0xae, 0x3a, 0x2d, 0x84, 0x6f, 0x0e, 0x93, 0xb3, 0x2d,
+	0x06, 0x25, 0x6f, 0x41, 0xfd, 0x84, 0x52, 0x17, 0x67, 0xa2, 0x53, 0x33, 0xf6, 0x8c, 0x9c, 0x5d,
+	0xa7, 0x76, 0x22, 0xe7, 0xf9, 0x1e, 0xb4, 0xc3, 0x49, 0x32, 0x08, 0xfd, 0x60, 0xe0, 0xf6, 0x4e,
+	0xbd, 0xc0, 0xf5, 0xfb, 0xb8, 0xf8, 0x15, 0xa7, 0x25, 0xe1, 0x4c, 0x56, 0xec, 0xf4, 0xc9, 0x9b,
+	0x30, 0x3f, 0xf4, 0xe2, 0xc4, 0x3d, 0x0d, 0xc7, 0xee, 0x78, 0x72, 0xfc, 0x82, 0x9e, 0x77, 0x00,
+	0x27, 0x60, 0x8e, 0x81, 0xb7, 0xc3, 0xf1, 0x01, 0x02, 0xc9, 0x6b, 0x00, 0xd8, 0x47, 0xde, 0x81,
+	0xc6, 0x6d, 0xeb, 0xde, 0x9c, 0x53, 0x67, 0x10, 0x6c, 0xd0, 0xfe, 0x03, 0x0b, 0x9a, 0x7c, 0x6d,
+	0xc4, 0x69, 0x75, 0x07, 0xe6, 0xe4, 0x14, 0xd0, 0x28, 0x0a, 0x23, 0xb1, 0x0b, 0x4d, 0x20, 0x59,
+	0x87, 0xb6, 0x04, 0x8c, 0x23, 0xea, 0x8f, 0xbc, 0x01, 0x15, 0xa2, 0x2d, 0x07, 0x27, 0x8f, 0xd2,
+	0x1a, 0xa3, 0x70, 0x92, 0xf0, 0xf3, 0xa2, 0xf1, 0xa8, 0x29, 0x66, 0xc1, 0x61, 0x30, 0xc7, 0x24,
+	0x61, 0xbb, 0xb0, 0x60, 0x6d, 0x0d, 0x98, 0xfd, 0x8f, 0x2d, 0x20, 0xac, 0xeb, 0x47, 0x21, 0xaf,
+	0x42, 0x2c, 0x4d, 0x96, 0x2d, 0xac, 0x2b, 0xb3, 0x45, 0x69, 0x1a, 0x5b, 0xdc, 0x83, 0x19, 0xec,
+	0x16, 0x13, 0x2b, 0xe5, 0x6c, 0xd7, 0x1f, 0x97, 0x3a, 0x96, 0x23, 0xf0, 0xc4, 0x86, 0x2a, 0x1f,
+	0x63, 0xa5, 0x60, 0x8c, 0x1c, 0x65, 0xff, 0xcc, 0x82, 0x26, 0x5b, 0xc4, 0x80, 0x0e, 0x51, 0x64,
+	0x92, 0x87, 0x40, 0x4e, 0x26, 0x41, 0x9f, 0xad, 0x79, 0xf2, 0xca, 0xef, 0xbb, 0xc7, 0xe7, 0xac,
+	0x29, 0xec, 0xf7, 0xf6, 0x35, 0xa7, 0x00, 0x47, 0xde, 0x82, 0xb6, 0x01, 0x8d, 0x93, 0x88, 0xf7,
+	0x7e, 0xfb, 0x9a, 0x93, 0xc3, 0xb0, 0xc9, 0x64, 0x42, 0x79, 0x92, 0xb8, 0x7e, 0xd0, 0xa7, 0xaf,
+	0x70, 0xfe, 0xe7, 0x1c, 0x03, 0xf6, 0xb8, 0x05, 0x4d, 0xfd, 0x3b, 0xfb, 0x27, 0x50, 0x93, 0x22,
+	0x1d, 0xc5, 0x59, 0xa6, 0x5f, 0x8e, 0x06, 0x21, 0x6b, 0x50, 0x33, 0x7b, 0xe1, 0xd4, 0x7e, 0x91,
+	0xb6, 0xed, 0x0f, 0xa0, 0xbd, 0xcb, 0xe4, 0x6a, 0xe0, 0x07, 0x03, 0x71, 0xa6, 0x31, 0x61, 0x2f,
+	0xb8, 0x9a, 0xf3, 0x9f, 0x28, 0x31, 0xd9, 0x71, 0x1a, 0xc6, 0x89, 0x68, 0x07, 0xff, 0xb7, 0xff,
+	0x73, 0x09, 0xe6, 0x19, 0x23, 0x7c, 0xec, 0x05, 0xe7, 0x92, 0x0b, 0x76, 0xa1, 0xc9, 0xaa, 0x3a,
+	0x0a, 0x37, 0xf8, 0x91, 0xc1, 0x85, 0xde, 0x3d, 0xb1, 0x1e, 0x19, 0xea, 0xfb, 0x3a, 0x29, 0xd3,
+	0xe4, 0xce, 0x1d, 0xe3, 0x6b, 0x26, 0x9d, 0x12, 0x2f, 0x1a, 0xd0, 0x04, 0x0f, 0x13, 0x71, 0xb8,
+	0x00, 0x07, 0x6d, 0x86, 0xc1, 0x09, 0xb9, 0x0d, 0xcd, 0xd8, 0x4b, 0xdc, 0x31, 0x8d, 0x70, 0x4e,
+	0x50, 0xc2, 0x94, 0x1d, 0x88, 0xbd, 0xe4, 0x80, 0x46, 0x8f, 0xcf, 0x91, 0xa3, 0xe7, 0x24, 0xc5,
+	0x19, 0x92, 0xcc, 0xe0, 0xb6, 0x6e, 0x70, 0x92, 0x4f, 0x18, 0x28, 0x95, 0xf7, 0xb3, 0x9a, 0xbc,
+	0x27, 0x37, 0xa0, 0x3e, 0xf2, 0x03, 0x6c, 0x39, 0x46, 0x09, 0x52, 0x75, 0x6a, 0x23, 0x3f, 0x60,
+	0xed, 0xc6, 0x4c, 0x21, 0x8b, 0xc7, 0x34, 0xe8, 0xbb, 0x93, 0x40, 0x9c, 0x73, 0x94, 0x4b, 0x8c,
+	0x9a, 0xd3, 0x46, 0xc4, 0xb3, 0x14, 0xbe, 0xf6, 0x3d, 0x58, 0xc8, 0x8d, 0x94, 0x09, 0xd6, 0x74,
+	0x9a, 0xd9, 0xbf, 0xac, 0x1b, 0x67, 0xde, 0x70, 0x42, 0xc5, 0x39, 0xcb, 0x0b, 0xdf, 0x29, 0xbd,
+	0x6b, 0xd9, 0x6f, 0x42, 0x3b, 0x9d, 0x3a, 0x21, 0x30, 0x08, 0x54, 0xd8, 0x6a, 0x8b, 0x0a, 0xf0,
+	0x7f, 0xfb, 0x1f, 0x94, 0x38, 0xe1, 0x66, 0xe8, 0xab, 0xd3, 0x89, 0x11, 0xb2, 0xa3, 0x4d, 0x12,
+	0xb2, 0xff, 0xa7, 0x9e, 0xe9, 0xbf, 0x82, 0x09, 0xbf, 0x0e, 0xb5, 0x98, 0x4d, 0x8c, 0x37, 0x1c,
+	0xe2, 0x5c, 0xd7, 0x9c, 0x59, 0x56, 0xde, 0x18, 0x0e, 0xf3, 0x6b, 0x31, 0x7b, 0xc1, 0x5a, 0xd4,
+	0xa6, 0xae, 0x45, 0xfd, 0x2a, 0x6b, 0x01, 0xc5, 0x6b, 0x61, 0xdf, 0x85, 0x05, 0x6d, 0x86, 0x2e,
+	0x98, 0xcb, 0x3d, 0x20, 0xbb, 0x7e, 0x9c, 0x3c, 0x0b, 0x58, 0x15, 0xea, 0x00, 0x32, 0x3a, 0x62,
+	0x65, 0x3a, 0xc2, 0x90, 0xde, 0x2b, 0x81, 0x2c, 0x09, 0xa4, 0xf7, 0x0a, 0x91, 0xf6, 0xbb, 0xb0,
+	0x68, 0xd4, 0x27, 0x9a, 0x7e, 0x03, 0xaa, 0x93, 0xe4, 0x55, 0x28, 0xd5, 0x83, 0x86, 0xd8, 0x29,
+	0x4c, 0xfd, 0x74, 0x38, 0xc6, 0x7e, 0x1f, 0x16, 0xf6, 0xe8, 0x4b, 0xb1, 0x43, 0x65, 0x47, 0xde,
+	0xbc, 0x54, 0x35, 0x45, 0xbc, 0x7d, 0x1f, 0x88, 0xfe, 0xb1, 0x68, 0x55, 0x53, 0x54, 0x2d, 0x43,
+	0x51, 0xb5, 0xdf, 0x04, 0x72, 0xe8, 0x0f, 0x82, 0x8f, 0x69, 0x1c, 0x7b, 0x03, 0x25, 0xdc, 0xdb,
+	0x50, 0x1e, 0xc5, 0x03, 0x21, 0x83, 0xd8, 0xbf, 0xf6, 0x37, 0x61, 0xd1, 0xa0, 0x13, 0x15, 0xdf,
+	0x84, 0x7a, 0xec, 0x0f, 0x02, 0x2f, 0x99, 0x44, 0x54, 0x54, 0x9d, 0x02, 0xec, 0x27, 0xb0, 0xf4,
+	0x09, 0x8d, 0xfc, 0x93, 0xf3, 0xcb, 0xaa, 0x37, 0xeb, 0x29, 0x65, 0xeb, 0xe9, 0xc2, 0x72, 0xa6,
+	0x1e, 0xd1, 0x3c, 0xdf, 0x42, 0x62, 0x25, 0x6b, 0x0e, 0x2f, 0x68, 0x42, 0xad, 0xa4, 0x0b, 0x35,
+	0xfb, 0x19, 0x90, 0xcd, 0x30, 0x08, 0x68, 0x2f, 0x39, 0xa0, 0x34, 0x4a, 0x4d, 0xd3, 0x74, 0xbf,
+	0x34, 0x1e, 0xad, 0x8a, 0x99, 0xcd, 0x4a, 0x4a, 0xb1, 0x91, 0x08, 0x54, 0xc6, 0x34, 0x1a, 0x61,
+	0xc5, 0x35, 0x07, 0xff, 0xb7, 0x97, 0x61, 0xd1, 0xa8, 0x56, 0x58, 0x15, 0x6f, 0xc3, 0xf2, 0x96,
+	0x1f, 0xf7, 0xf2, 0x0d, 0x76, 0x60, 0x76, 0x3c, 0x39, 0x76, 0x53, 0x69, 0x20, 0x8b, 0x4c, 0xe5,
+	0xcc, 0x7e, 0x22, 0x2a, 0xfb, 0x08, 0x6e, 0x6e, 0x9e, 0xd2, 0xde, 0x0b, 0x06, 0x14, 0x8d, 0xf9,
+	0x67, 0x7e, 0x72, 0xfe, 0xcb, 0x0c, 0xc2, 0xfe, 0xf7, 0x25, 0x78, 0x6d, 0x4a, 0x6d, 0x29, 0xbf,
+	0xc4, 0x93, 0x5e, 0x4f, 0xf2, 0x0b, 0xdb, 0xd3, 0xbc, 0x48, 0x0e, 0x60, 0xee, 0xc4, 0xf3, 0x87,
+	0x93, 0x08, 0x95, 0x70, 0xa1, 0x8e, 0xb4, 0x1e, 0xad, 0x8b, 0x16, 0x2f, 0xac, 0xf6, 0xfe, 0x21,
+	0xfb, 0xc2, 0x31, 0x2b, 0x60, 0x6b, 0xc8, 0x35, 0xa0, 0x32, 0x97, 0x00, 0x5c, 0xf3, 0x61, 0x87,
+	0x5d, 0x6f, 0xec, 0x32, 0x6d, 0x1f, 0x0f, 0xf9, 0xb2, 0xa3, 0xca, 0x4c, 0xaf, 0x3f, 0xf5, 0x82,
+	0x7e, 0x7c, 0xea, 0xbd, 0xa0, 0x9c, 0x82, 0x8b, 0xa5, 0x0c, 0x94, 0x31, 0x95, 0x1f, 0xf8, 0x09,
+	0x27, 0xe1, 0xe6, 0x43, 0x0a, 0xb0, 0x9f, 0x41, 0x15, 0xfb, 0x43, 0x66, 0xa1, 0x7c, 0xb4, 0x79,
+	0xd0, 0xbe, 0x46, 0x16, 0x60, 0x6e, 0x6f, 0x7f, 0xe7, 0xb0, 0xeb, 0x6e, 0x6c, 0x1e, 0xb9, 0xfb,
+	0x7b, 0xdd, 0xb6, 0x65, 0x82, 0x8e, 0x9e, 0xef, 0xb7, 0x4b, 0x64, 0x11, 0xe6, 0x35, 0xd0, 0xb6,
+	0xd3, 0xed, 0xb6, 0xcb, 0xa4, 0x06, 0x95, 0x9d, 0xbd, 0x9d, 0xa3, 0x76, 0xc5, 0xde, 0x82, 0xf6,
+	0x56, 0xe4, 0xf9, 0xc1, 0x95, 0x56, 0x9c, 0xb1, 0x6a, 0x44, 0xe3, 0xc9, 0x88, 0x0a, 0x8e, 0x12,
+	0x25, 0x7b, 0x00, 0x0b, 0x42, 0x77, 0xc1, 0xca, 0x0e, 0x13, 0x2f, 0x41, 0x9d, 0xb1, 0xc7, 0x81,
+	0x2e, 0xb7, 0x0d, 0x85, 0xce, 0x68, 0x00, 0xa5, 0x9d, 0xc6, 0x04, 0x21, 0x53, 0x33, 0x4e, 0x93,
+	0x61, 0x8f, 0x4b, 0xa7, 0x39, 0x27, 0x8f, 0xb0, 0x29, 0xcc, 0xab, 0xee, 0x3e, 0x1b, 0xf7, 0x59,
+	0x33, 0xdf, 0x82, 0x9a, 0xa8, 0x51, 0x4a, 0xa9, 0x8e, 0x5a, 0xdd, 0x4c, 0x97, 0x1c, 0x45, 0xc9,
+	0x26, 0xfb, 0xf3, 0x89, 0x4f, 0x63, 0x65, 0xa4, 0xd4, 0x9c, 0x14, 0x60, 0xff, 0x05, 0x0b, 0x2a,
+	0xdb, 0x47, 0xbb, 0x9b, 0x6c, 0x5d, 0xfd, 0xa0, 0x17, 0x8e, 0x98, 0x22, 0xc8, 0x59, 0x4b, 0x95,
+	0xa7, 0x9e, 0x52, 0x37, 0xa1, 0x8e, 0xfa, 0x23, 0xb3, 0x0d, 0x85, 0x17, 0x24, 0x05, 0xb0, 0xf1,
+	0xd2, 0x57, 0x63, 0x3f, 0x42, 0xc3, 0x53, 0x9a, 0x93, 0x15, 0x3e, 0xde, 0x1c, 0xc2, 0xfe, 0x69,
+	0x0d, 0x66, 0xc5, 0x30, 0xb0, 0x3d, 0xc6, 0xa2, 0x54, 0xf4, 0x44, 0x94, 0xd8, 0x3c, 0x47, 0x74,
+	0x14, 0x26, 0xd4, 0x35, 0xc4, 0x88, 0x09, 0xcc, 0xaf, 0x46, 0xb9, 0x68, 0x35, 0x3a, 0x30, 0x2b,
+	0x0d, 0x8c, 0x0a, 0x9e, 0x7e, 0xb2, 0xc8, 0x66, 0xa2, 0xe7, 0x8d, 0xbd, 0x9e, 0x9f, 0x9c, 0x0b,
+	0xfe, 0x55, 0x65, 0x56, 0xf7, 0x30, 0xec, 0x79, 0x43, 0xf7, 0xd8, 0x1b, 0x7a, 0x41, 0x4f, 0x72,
+	0xaf, 0x09, 0x64, 0xfb, 0x40, 0x74, 0x49, 0x92, 0x71, 0x1b, 0x38, 0x03, 0x65, 0x8a, 0x65, 0x2f,
+	0x1c, 0x8d, 0xfc, 0x84, 0x99, 0xc5, 0x78, 0xd0, 0x96, 0x1d, 0x0d, 0xc2, 0x3d, 0x08, 0x58, 0x7a,
+	0xc9, 0x67, 0xaf, 0x2e, 0x3d, 0x08, 0x1a, 0x90, 0xd5, 0xc2, 0x2c, 0x2c, 0x76, 0x9a, 0xbf, 0x78,
+	0x89, 0xe7, 0x6d, 0xd9, 0xd1, 0x20, 0x6c, 0x1d, 0x26, 0x41, 0x4c, 0x93, 0x64, 0x48, 0xfb, 0xaa,
+	0x43, 0x0d, 0x24, 0xcb, 0x23, 0xc8, 0x43, 0x58, 0xe4, 0x96, 0x7a, 0xec, 0x25, 0x61, 0x7c, 0xea,
+	0xc7, 0x6e, 0xcc, 0x18, 0xa7, 0x89, 0xf4, 0x45, 0x28, 0xf2, 0x2e, 0xac, 0x66, 0xc0, 0x11, 0xed,
+	0x51, 0xff, 0x8c, 0xf6, 0x3b, 0x73, 0xf8, 0xd5, 0x34, 0x34, 0xb9, 0x0d, 0x0d, 0xc6, 0xf8, 0x13,
+	0x64, 0xef, 0xb8, 0xd3, 0xe2, 0x5a, 0x88, 0x06, 0x22, 0x6f, 0xc3, 0x9c, 0xb9, 0x5f, 0xe6, 0x8d,
+	0xd3, 0x99, 0x71, 0xae, 0x63, 0x52, 0x30, 0xa6, 0xec, 0xc5, 0x68, 0x93, 0x7a, 0xe7, 0x9d, 0xb6,
+	0xb0, 0xf7, 0x24, 0x00, 0x77, 0x7c, 0xe4, 0x9f, 0x79, 0x09, 0xed, 0x2c, 0x70, 0x01, 0x2a, 0x8a,
+	0x52, 0x28, 0xf9, 0x5e, 0x12, 0x46, 0x1d, 0xc2, 0xf7, 0x89, 0x02, 0x90, 0xfb, 0x40, 0x58, 0xbf,
+	0xe4, 0x96, 0x10, 0xbd, 0x59, 0xc4, 0x1e, 0x17, 0x60, 0xc8, 0xf7, 0xe1, 0x06, 0x83, 0xd2, 0xa0,
+	0x1f, 0x46, 0x31, 0xed, 0x67, 0x3f, 0x5c, 0xc2, 0x0f, 0x2f, 0x22, 0x21, 0xdf, 0x85, 0xeb, 0x0a,
+	0x22, 0x68, 0xb8, 0x81, 0xc8, 0xfa, 0xbe, 0x7c, 0xdb, 0xba, 0x67, 0x39, 0xd3, 0x09, 0xc8, 0x53,
+	0x58, 0xe0, 0x3c, 0xd9, 0x0b, 0x83, 0x38, 0x61, 0x72, 0x21, 0x89, 0x3b, 0x2b, 0x78, 0x08, 0x5d,
+	0x37, 0x85, 0xc6, 0x66, 0x4a, 0xe0, 0xe4, 0xbf, 0x21, 0x3b, 0x40, 0x04, 0xd7, 0xea, 0x35, 0xad,
+	0x5e, 0x56, 0x53, 0xc1, 0x47, 0x6c, 0x5b, 0xf4, 0xc2, 0x70, 0xec, 0xf6, 0x86, 0x61, 0x4c, 0x91,
+	0xe5, 0x3b, 0x7c, 0x5b, 0x98, 0x50, 0xfb, 0xaf, 0x95, 0x80, 0xe4, 0xab, 0x34, 0x17, 0xd6, 0xca,
+	0x2e, 0xec, 0x3a, 0xb4, 0x71, 0x03, 0x47, 0x34, 0xa6, 0xd1, 0x19, 0x45, 0xf7, 0x5e, 0x09, 0x67,
+	0x39, 0x07, 0x47, 0xff, 0xd3, 0x24, 0x4e, 0xb8, 0x4f, 0x40, 0x39, 0x02, 0x2b, 0x4e, 0x06, 0x4a,
+	0x1e, 0xc1, 0x12, 0xd3, 0x23, 0x25, 0x7f, 0x79, 0xa3, 0xc4, 0x1d, 0x31, 0x6a, 0x2e, 0x30, 0x0a,
+	0x71, 0x6c, 0xcf, 0x32, 0xc5, 0x94, 0xad, 0x21, 0x27, 0xae, 0x22, 0xb1, 0x09, 0x64, 0xec, 0xc4,
+	0xbe, 0xf6, 0x7a, 0x3d, 0x3a, 0x4e, 0x68, 0x5f, 0x70, 0xc5, 0x0c, 0x0e, 0xaa, 0x00, 0x63, 0xff,
+	0x7d, 0x8b, 0x6b, 0xad, 0x62, 0x5a, 0x94, 0xf6, 0xf9, 0x3a, 0x34, 0xb8, 0x6c, 0x74, 0xc3, 0x60,
+	0x78, 0x2e, 0xc4, 0x25, 0x70, 0xd0, 0x7e, 0x30, 0x3c, 0x27, 0x5f, 0x83, 0x39, 0x3f, 0xd0, 0x49,
+	0xf8, 0x09, 0xd0, 0x94, 0x40, 0x24, 0x7a, 0x1d, 0x1a, 0xe3, 0xc9, 0xf1, 0xd0, 0xef, 0x71, 0x92,
+	0x32, 0xaf, 0x85, 0x83, 0x90, 0xe0, 0x0d, 0x68, 0x8a, 0x6d, 0xc2, 0x29, 0x2a, 0x48, 0xd1, 0x10,
+	0x30, 0x46, 0x62, 0x3f, 0x86, 0x25, 0xb3, 0x83, 0x42, 0x63, 0x59, 0xd7, 0x0e, 0xad, 0x06, 0x6e,
+	0xde, 0x96, 0xc9, 0x35, 0xe9, 0x51, 0x65, 0xff, 0x93, 0x0a, 0x2c, 0xca, 0x85, 0x67, 0xdc, 0x70,
+	0x38, 0x19, 0x8d, 0xbc, 0xe8, 0xfc, 0x8a, 0xe7, 0xab, 0x26, 0xd1, 0x4b, 0xa6, 0x44, 0x67, 0x72,
+	0xf6, 0xd4, 0x63, 0x0b, 0xe0, 0xc5, 0xa7, 0xe2, 0x38, 0xd0, 0x20, 0xe4, 0x1e, 0xcc, 0x33, 0xee,
+	0xe3, 0xc6, 0xbf, 0xee, 0x18, 0xcd, 0x82, 0xf3, 0x27, 0x50, 0xb5, 0xe8, 0x04, 0xd2, 0x4f, 0x90,
+	0x99, 0xcc, 0x09, 0x62, 0x43, 0x93, 0x73, 0xba, 0x38, 0x10, 0x67, 0xb9, 0x43, 0x40, 0x87, 0xb1,
+	0xfe, 0x64, 0xe5, 0x35, 0x3f, 0x1c, 0xe6, 0x8b, 0xa4, 0xb5, 0x3f, 0xa2, 0x78, 0xe0, 0x6a, 0xd4,
+	0x75, 0x21, 0xad, 0xf3, 0x28, 0xf2, 0x04, 0x80, 0xb7, 0x85, 0x56, 0x0b, 0xa0, 0x92, 0xf8, 0x66,
+	0x66, 0x1f, 0x6b, 0x73, 0x7f, 0x9f, 0x15, 0x26, 0x11, 0x45, 0x4b, 0x46, 0xfb, 0xd2, 0xfe, 0xcb,
+	0x16, 0x34, 0x34, 0x1c, 0x59, 0x86, 0x85, 0xcd, 0xfd, 0xfd, 0x83, 0xae, 0xb3, 0x71, 0xb4, 0xf3,
+	0x49, 0xd7, 0xdd, 0xdc, 0xdd, 0x3f, 0xec, 0xb6, 0xaf, 0x31, 0xf0, 0xee, 0xfe, 0xe6, 0xc6, 0xae,
+	0xfb, 0x64, 0xdf, 0xd9, 0x94, 0x60, 0x8b, 0xac, 0x00, 0x71, 0xba, 0x1f, 0xef, 0x1f, 0x75, 0x0d,
+	0x78, 0x89, 0xb4, 0xa1, 0xf9, 0xd8, 0xe9, 0x6e, 0x6c, 0x6e, 0x0b, 0x48, 0x99, 0x2c, 0x41, 0xfb,
+	0xc9, 0xb3, 0xbd, 0xad, 0x9d, 0xbd, 0xa7, 0xee, 0xe6, 0xc6, 0xde, 0x66, 0x77, 0xb7, 0xbb, 0xd5,
+	0xae, 0x90, 0x39, 0xa8, 0x6f, 0x3c, 0xde, 0xd8, 0xdb, 0xda, 0xdf, 0xeb, 0x6e, 0xb5, 0xab, 0xf6,
+	0x7f, 0xb4, 0x60, 0x19, 0x7b, 0xdd, 0xcf, 0x6e, 0x90, 0xdb, 0xd0, 0x60, 0xd2, 0x85, 0x32, 0x65,
+	0x43, 0xe9, 0x13, 0x3a, 0x88, 0x31, 0x3f, 0x97, 0x7a, 0x27, 0x61, 0xd4, 0x93, 0xea, 0x1e, 0x20,
+	0xe8, 0x09, 0x83, 0x30, 0xe6, 0x17, 0xcb, 0xcb, 0x29, 0xf8, 0xf6, 0x68, 0x70, 0x18, 0x27, 0x59,
+	0x81, 0x99, 0xe3, 0x88, 0x7a, 0xbd, 0x53, 0xb1, 0x33, 0x44, 0x89, 0x7c, 0x3d, 0xf5, 0x53, 0xf5,
+	0xd8, 0xec, 0x0f, 0x69, 0x1f, 0x39, 0xa6, 0xe6, 0xcc, 0x0b, 0xf8, 0xa6, 0x00, 0x33, 0xe9, 0xe6,
+	0x1d, 0x7b, 0x41, 0x3f, 0x0c, 0x68, 0x5f, 0xd8, 0xeb, 0x29, 0xc0, 0x3e, 0x80, 0x95, 0xec, 0xf8,
+	0xc4, 0xfe, 0x7a, 0x27, 0xa7, 0x14, 0xae, 0x4d, 0x5f, 0x4d, 0x6d, 0xaf, 0xfd, 0x57, 0x0b, 0x2a,
+	0x4c, 0xb7, 0xbc, 0x40, 0x07, 0xd6, 0x8c, 0xd3, 0x72, 0x2e, 0x8a, 0x82, 0xae, 0x2f, 0xae, 0x1b,
+	0x70, 0x71, 0xa8, 0x41, 0x52, 0x7c, 0x44, 0x7b, 0x67, 0x42, 0x02, 0x6a, 0x10, 0xb6, 0x41, 0x62,
+	0x2f, 0xe1, 0x5f, 0x8b, 0x0d, 0x22, 0xcb, 0x12, 0x87, 0x5f, 0xce, 0xa6, 0x38, 0xfc, 0xae, 0x03,
+	0xb3, 0x7e, 0x70, 0x1c, 0x4e, 0x82, 0x3e, 0x6e, 0x88, 0x9a, 0x23, 0x8b, 0x18, 0xb7, 0xc1, 0x8d,
+	0xca, 0x4c, 0x0a, 0xce, 0xfe, 0x29, 0xc0, 0x26, 0xd0, 0x66, 0xc2, 0x89, 0x8d, 0x57, 0x05, 0x0b,
+	0xde, 0x81, 0x05, 0x0d, 0x96, 0x7a, 0x01, 0xc6, 0x0c, 0x90, 0xf1, 0x02, 0xa0, 0xcd, 0xc0, 0x31,
+	0x22, 0xfc, 0xe0, 0x88, 0x10, 0xda, 0x4e, 0x70, 0x12, 0xca, 0x1a, 0xff, 0x92, 0x05, 0xab, 0x39,
+	0x54, 0xea, 0x56, 0x56, 0xc1, 0xb8, 0x51, 0xd8, 0x97, 0x9c, 0x68, 0x02, 0x99, 0xaa, 0xa6, 0x00,
+	0x27, 0x7e, 0xe0, 0xc7, 0xa7, 0x22, 0xf4, 0x59, 0x73, 0xf2, 0x08, 0x36, 0x53, 0xe3, 0x28, 0x1c,
+	0xa8, 0x05, 0xb2, 0x1c, 0x55, 0xb6, 0xff, 0x83, 0x05, 0xf3, 0x87, 0x93, 0xe3, 0xf8, 0x3c, 0x4e,
+	0xe8, 0x68, 0x9b, 0x7a, 0xc3, 0xe4, 0x14, 0x6d, 0x79, 0x09, 0x52, 0x3e, 0x01, 0x09, 0x20, 0xf7,
+	0x61, 0x26, 0x4e, 0xbc, 0x64, 0x12, 0x0b, 0xcb, 0x71, 0x45, 0xfa, 0x0a, 0x25, 0xc5, 0x21, 0x62,
+	0x1d, 0x41, 0x85, 0xc6, 0xbc, 0x17, 0xf8, 0xbd, 0x58, 0xf8, 0x34, 0x45, 0x89, 0xf5, 0x2a, 0xa2,
+	0x71, 0xe2, 0x45, 0x49, 0x2c, 0xb4, 0x7d, 0x55, 0x66, 0x7c, 0x81, 0x4e, 0x7b, 0x24, 0x15, 0xb2,
+	0x53, 0x83, 0x30, 0xc1, 0x97, 0x96, 0x74, 0xf3, 0x30, 0x0b, 0x66, 0x2b, 0xfa, 0x94, 0x26, 0x7c,
+	0x60, 0x72, 0xfe, 0x3f, 0x82, 0x05, 0x0d, 0xa6, 0xf6, 0x07, 0xa8, 0x31, 0xca, 0x65, 0xcd, 0x0d,
+	0x4d, 0x7c, 0xa3, 0x51, 0xda, 0xaf, 0x33, 0x53, 0xdc, 0xf3, 0x83, 0xc7, 0x5e, 0xef, 0x05, 0x0d,
+	0xfa, 0xdd, 0x33, 0x1a, 0x24, 0x8c, 0x1e, 0x03, 0x81, 0x7e, 0x18, 0xd8, 0xff, 0xd3, 0x42, 0x53,
+	0xd0, 0xa4, 0x20, 0x0f, 0x0d, 0x77, 0xd0, 0xcd, 0x74, 0x2b, 0x9a, 0x74, 0xa9, 0x63, 0x88, 0xf1,
+	0xf4, 0x31, 0xc7, 0xc8, 0x58, 0xa5, 0x28, 0xb2, 0x19, 0x16, 0x87, 0x04, 0xf7, 0xff, 0x89, 0x12,
+	0xe3, 0xa5, 0x38, 0xf1, 0x86, 0xec, 0x1c, 0x88, 0x7d, 0x26, 0xee, 0xb9, 0x1d, 0x6e, 0x02, 0x99,
+	0x42, 0x74, 0xe2, 0x0d, 0x87, 0xac, 0x32, 0x57, 0x36, 0xc0, 0x67, 0x3c, 0x07, 0x67, 0xf3, 0xae,
+	0x60, 0xa2, 0xc9, 0x19, 0x6c, 0x32, 0x0b, 0xb6, 0xdb, 0xd0, 0x7a, 0x4a, 0x13, 0x9d, 0xeb, 0xff,
+	0x65, 0x15, 0xe6, 0x15, 0x48, 0x4c, 0xfa, 0x3d, 0x98, 0xf7, 0xfb, 0x34, 0x48, 0xfc, 0xe4, 0xdc,
+	0x35, 0xdc, 0xd8, 0x59, 0x30, 0x59, 0x82, 0xaa, 0x37, 0xf4, 0x3d, 0x19, 0xa7, 0xe5, 0x05, 0xa6,
+	0x78, 0xe9, 0x16, 0xb1, 0x12, 0x70, 0x9c, 0xd3, 0x0a, 0x71, 0xec, 0x28, 0x64, 0x70, 0xa1, 0xeb,
+	0xa8, 0x4f, 0x38, 0x0b, 0x16, 0xa1, 0xd8, 0x7e, 0xe0, 0x35, 0xb1, 0x0d, 0x5f, 0xe5, 0x0a, 0xa5,
+	0x02, 0xe4, 0x02, 0xa1, 0x5c, 0x39, 0xcb, 0x05, 0x42, 0xb5, 0x60, 0x6a, 0x2d, 0x17, 0x4c, 0x65,
+	0x07, 0xf9, 0x79, 0xd0, 0xa3, 0x7d, 0x37, 0x09, 0x5d, 0x54, 0x38, 0x84, 0x6f, 0x3a, 0x0b, 0x26,
+	0x37, 0x61, 0x36, 0xa1, 0x71, 0x12, 0xd0, 0x84, 0x7b, 0x4c, 0x31, 0xaa, 0x22, 0x41, 0x84, 0x40,
+	0x65, 0x12, 0xf9, 0x71, 0xa7, 0x89, 0x61, 0x52, 0xfc, 0x9f, 0x7c, 0x0b, 0x96, 0x8f, 0x69, 0x9c,
+	0xb8, 0xa7, 0xd4, 0xeb, 0xd3, 0x08, 0x77, 0x05, 0x8f, 0xc7, 0x72, 0xa3, 0xab, 0x18, 0xc9, 0xb8,
+	0xed, 0x8c, 0x46, 0xb1, 0x1f, 0x06, 0x68, 0x6e, 0xd5, 0x1d, 0x59, 0x64, 0xf5, 0x71, 0x3b, 0x26,
+	0x3b, 0x83, 0xf3, 0x38, 0xf0, 0x62, 0x24, 0xb9, 0x03, 0x33, 0x38, 0x80, 0xb8, 0xd3, 0x36, 0x42,
+	0x43, 0xc8, 0xf1, 0x8e, 0xc0, 0x99, 0xf3, 0x30, 0x88, 0xbc, 0xf1, 0xa9, 0xb0, 0xb0, 0xb2, 0x60,
+	0xa6, 0x2e, 0x9e, 0x50, 0x74, 0x2e, 0x32, 0xeb, 0x4a, 0x57, 0x17, 0x9f, 0x70, 0xb0, 0xa3, 0xf0,
+	0xfc, 0x6c, 0x47, 0x4b, 0x18, 0xa7, 0x7f, 0x09, 0xc7, 0xa3, 0x83, 0x70, 0x7d, 0x26, 0xfe, 0xb0,
+	0xef, 0x26, 0xde, 0x20, 0xee, 0x2c, 0xe3, 0xec, 0x69, 0x90, 0x0f, 0x2b, 0xb5, 0x46, 0xbb, 0x69,
+	0xef, 0x43, 0x15, 0xbb, 0xcb, 0x98, 0x91, 0x2f, 0x12, 0x67, 0x56, 0x5e, 0x60, 0x53, 0x16, 0xd0,
+	0xe4, 0x65, 0x18, 0xbd, 0x90, 0x1b, 0x54, 0x14, 0xd9, 0x06, 0xe5, 0xfd, 0x17, 0x3a, 0x81, 0x28,
+	0xd9, 0x23, 0x98, 0x15, 0xbd, 0x25, 0x6d, 0x28, 0x1f, 0xfb, 0x89, 0x30, 0x57, 0xd8, 0xbf, 0x6c,
+	0x2d, 0x03, 0x6f, 0x24, 0x9d, 0xa9, 0xf8, 0x3f, 0x1b, 0x09, 0xda, 0xc5, 0x9f, 0x4f, 0xfc, 0x48,
+	0xd5, 0xa6, 0x83, 0xd0, 0x3d, 0x13, 0xbb, 0x2f, 0x82, 0xf0, 0x65, 0x20, 0x74, 0x0c, 0x55, 0xb6,
+	0xbf, 0x40, 0xf7, 0xa9, 0x8a, 0xf1, 0x0b, 0x6f, 0xd1, 0x0d, 0xa8, 0x73, 0x4e, 0x8c, 0x4f, 0x3d,
+	0xe1, 0xd1, 0xad, 0x21, 0xe0, 0xf0, 0xd4, 0x63, 0x3a, 0x8d, 0xc1, 0xdc, 0xdc, 0x49, 0xde, 0x40,
+	0xd8, 0xb6, 0x94, 0x32, 0x2d, 0x99, 0x3d, 0x10, 0xbb, 0x43, 0x7a, 0x92, 0xc8, 0xd8, 0x55, 0x30,
+	0x19, 0xa1, 0x27, 0x7d, 0x97, 0x9e, 0x24, 0xf6, 0x9e, 0xf2, 0x87, 0xed, 0x8f, 0xa9, 0x6c, 0xfa,
+	0xbd, 0x22, 0x7d, 0xbd, 0xf1, 0x68, 0xd1, 0x54, 0x4c, 0x78, 0xbe, 0x84, 0x49, 0x69, 0x3b, 0xa9,
+	0xe9, 0xc7, 0xf4, 0x16, 0x51, 0xa1, 0x50, 0x9a, 0x65, 0x74, 0x4e, 0x0c, 0xc7, 0x80, 0xe9, 0xae,
+	0xd1, 0x92, 0xe1, 0x1a, 0x65, 0xaa, 0xce, 0x22, 0xd6, 0x26, 0x2d, 0x0e, 0xa1, 0x1b, 0xbe, 0xfb,
+	0x0b, 0x74, 0xb3, 0xd9, 0xd3, 0x23, 0x96, 0x4b, 0x50, 0xd5, 0xb5, 0x45, 0x5e, 0xf8, 0xc5, 0x83,
+	0x36, 0x95, 0x5c, 0xd0, 0x06, 0xdd, 0x8e, 0xe1, 0x98, 0x06, 0x42, 0x4d, 0x14, 0x25, 0x72, 0x0f,
+	0xda, 0xfc, 0x3f, 0x97, 0xeb, 0xaa, 0xde, 0x48, 0x2a, 0x4e, 0x2d, 0x0e, 0xdf, 0x65, 0xe0, 0x8d,
+	0x51, 0x62, 0xff, 0x1d, 0x76, 0x2c, 0xa1, 0xca, 0x87, 0xc7, 0xb4, 0x98, 0xc0, 0xef, 0xc2, 0x1c,
+	0xd7, 0xdd, 0x85, 0xd8, 0x14, 0x43, 0x5d, 0x52, 0xfa, 0x0d, 0x42, 0x39, 0xf1, 0xf6, 0x35, 0xc7,
+	0x24, 0x26, 0xef, 0xa3, 0xfd, 0x14, 0x70, 0x13, 0x5d, 0x84, 0xaf, 0xaf, 0x17, 0x68, 0x99, 0xea,
+	0x7b, 0x8d, 0xfc, 0x71, 0x0d, 0x66, 0xb8, 0x37, 0xc7, 0x7e, 0x0a, 0x73, 0x46, 0x43, 0x46, 0xb8,
+	0xa7, 0xc9, 0xc3, 0x3d, 0xb9, 0x80, 0x69, 0xa9, 0x20, 0x60, 0xfa, 0x9b, 0x15, 0x20, 0x8c, 0xdd,
+	0x32, 0xeb, 0x79, 0x1b, 0x1a, 0x41, 0xd8, 0x37, 0x9c, 0x83, 0x4d, 0x47, 0x07, 0xa1, 0x17, 0x27,
+	0x2d, 0xca, 0xb8, 0x37, 0x57, 0x6e, 0x0b, 0x30, 0xec, 0x1c, 0x12, 0xb6, 0xc1, 0x44, 0x9a, 0xf9,
+	0xe8, 0x06, 0xe5, 0x0b, 0x57, 0x88, 0x43, 0xad, 0x6c, 0x12, 0x9f, 0xba, 0xd2, 0xf6, 0x2f, 0x3b,
+	0xaa, 0x9c, 0xe5, 0x90, 0x99, 0x4b, 0x39, 0x64, 0x36, 0xc7, 0x21, 0x9a, 0x03, 0xab, 0x66, 0x3a,
+	0xb0, 0x72, 0x9e, 0x07, 0xe1, 0x2d, 0x34, 0x3d, 0x0f, 0xeb, 0x8c, 0x93, 0xb8, 0x6b, 0x46, 0x39,
+	0x53, 0x00, 0xe7, 0x38, 0x07, 0x67, 0x07, 0x64, 0x1a, 0x64, 0x6b, 0x60, 0x67, 0x53, 0x00, 0x53,
+	0x56, 0xf3, 0xe1, 0xbe, 0x26, 0x57, 0x56, 0x73, 0x08, 0xb4, 0xe1, 0x91, 0xa9, 0xa4, 0x49, 0x31,
+	0x27, 0x6c, 0x78, 0x1d, 0xc8, 0x0e, 0x0a, 0xfd, 0x60, 0x67, 0xb6, 0x7c, 0x8b, 0x27, 0x96, 0x65,
+	0xc0, 0xf6, 0xdf, 0xb0, 0xa0, 0xcd, 0x78, 0xc0, 0x60, 0xf3, 0xef, 0x00, 0xee, 0xd3, 0x2b, 0x72,
+	0xb9, 0x41, 0x4b, 0xde, 0x85, 0x3a, 0x96, 0x71, 0xf7, 0x71, 0x1e, 0xcf, 0xb8, 0xd7, 0x53, 0x09,
+	0xb7, 0x7d, 0xcd, 0x49, 0x89, 0x35, 0x0e, 0xff, 0xbd, 0x0a, 0x2c, 0x09, 0xe2, 0x0d, 0x74, 0xe0,
+	0x4c, 0x61, 0x4d, 0x2b, 0xcf, 0x9a, 0xa6, 0x8f, 0x82, 0xf3, 0x6e, 0xc6, 0x47, 0x91, 0x9d, 0x99,
+	0x72, 0xe1, 0xcc, 0xb0, 0xb6, 0x52, 0x96, 0x94, 0xd6, 0x99, 0x0e, 0x52, 0x2c, 0xca, 0xd0, 0xdc,
+	0x38, 0x53, 0x65, 0xd6, 0x8f, 0xd4, 0x0b, 0x26, 0x82, 0xf4, 0x1a, 0x84, 0xa9, 0x59, 0x23, 0xef,
+	0x95, 0x8b, 0x31, 0x71, 0xd7, 0x0f, 0xdc, 0x93, 0xa1, 0x72, 0x63, 0x54, 0x9c, 0x22, 0x14, 0x7a,
+	0x57, 0x84, 0x98, 0x15, 0x4e, 0x38, 0xe4, 0xdc, 0x8a, 0x93, 0x05, 0xb3, 0x7e, 0x49, 0x66, 0x15,
+	0x59, 0x3f, 0xaa, 0x5c, 0xe0, 0xe5, 0xae, 0x18, 0x5e, 0x6e, 0xc3, 0x3b, 0xd8, 0xc8, 0x7a, 0x07,
+	0x8b, 0xfd, 0x6d, 0xcd, 0x69, 0xfe, 0x36, 0xdd, 0xe3, 0x74, 0x32, 0x64, 0xba, 0xc3, 0x1c, 0x92,
+	0x9a, 0x40, 0xf2, 0x3d, 0x98, 0xe7, 0xda, 0x06, 0xfa, 0x5d, 0x51, 0xef, 0x6f, 0xa1, 0xde, 0xbf,
+	0x2c, 0x19, 0x47, 0x61, 0x51, 0xe1, 0xcf, 0x52, 0xdb, 0xff, 0xd4, 0xe2, 0x29, 0x96, 0x1a, 0xbf,
+	0x08, 0x0d, 0x1a, 0x43, 0x20, 0x0c, 0x92, 0x86, 0x40, 0x58, 0xa9, 0x88, 0x0d, 0x4a, 0xc5, 0x6c,
+	0x50, 0x1c, 0xbe, 0x5b, 0x87, 0x36, 0x9b, 0x52, 0x5e, 0x9b, 0xdb, 0xa7, 0xe3, 0xe4, 0x54, 0xa8,
+	0xc8, 0x39, 0xb8, 0x39, 0xa5, 0xd5, 0xcc, 0x94, 0xda, 0xef, 0xc1, 0xdc, 0x13, 0xdd, 0x87, 0x51,
+	0xd4, 0x35, 0xab, 0x78, 0xef, 0xfe, 0xd4, 0x82, 0x86, 0xf8, 0xf6, 0xf1, 0x64, 0x34, 0x26, 0xdf,
+	0x14, 0xe7, 0xcb, 0xa5, 0xa7, 0xb0, 0x46, 0xc6, 0xd8, 0x5c, 0x97, 0xa5, 0x42, 0x83, 0xd1, 0x40,
+	0xec, 0x28, 0x31, 0x84, 0x29, 0xcf, 0x9d, 0x33, 0x60, 0xf6, 0x10, 0x96, 0x44, 0x4f, 0x30, 0x11,
+	0xd0, 0x67, 0x0a, 0xd4, 0xc7, 0xf1, 0x80, 0xbc, 0x05, 0x33, 0xdc, 0x63, 0x93, 0x91, 0x21, 0xc6,
+	0x90, 0x1d, 0x41, 0x43, 0xde, 0x84, 0xca, 0xf1, 0x64, 0x34, 0xc6, 0x4e, 0xa4, 0xa9, 0x85, 0xda,
+	0x10, 0x1d, 0xc4, 0xdb, 0xdf, 0x52, 0xad, 0x61, 0x94, 0xee, 0x30, 0xa1, 0x63, 0xb6, 0xe2, 0x6c,
+	0xa6, 0x19, 0xde, 0xd5, 0x92, 0x1f, 0x52, 0x80, 0xfd, 0xef, 0x2c, 0x68, 0x08, 0xd9, 0xf5, 0x4b,
+	0x87, 0xea, 0xd6, 0xb4, 0xcc, 0x55, 0xce, 0x10, 0x69, 0xa2, 0xea, 0x3d, 0x98, 0x1f, 0x31, 0x6d,
+	0x95, 0x99, 0x65, 0x46, 0x98, 0x2e, 0x0b, 0x66, 0x9b, 0x1f, 0x55, 0xc4, 0xd8, 0x4d, 0xfc, 0xa1,
+	0x2b, 0xb1, 0x22, 0x47, 0xb4, 0x08, 0xc5, 0xb8, 0x90, 0x87, 0xa3, 0xb9, 0xf9, 0xc4, 0x0b, 0x76,
+	0x07, 0x56, 0xc4, 0x80, 0x32, 0xfe, 0x3a, 0xfb, 0x5f, 0x34, 0x61, 0x35, 0x87, 0x52, 0x89, 0xe4,
+	0x22, 0xfe, 0x34, 0xf4, 0x47, 0xc7, 0xa1, 0x72, 0x76, 0x5a, 0x7a, 0x68, 0xca, 0x40, 0x91, 0x01,
+	0x2c, 0x4b, 0xde, 0x43, 0xe5, 0x49, 0xd9, 0x34, 0x25, 0x34, 0x2d, 0xde, 0x36, 0x0f, 0x86, 0x6c,
+	0x83, 0x12, 0xae, 0xab, 0x1a, 0xc5, 0xf5, 0x91, 0x53, 0xe8, 0x28, 0x26, 0x17, 0x4a, 0xa9, 0x66,
+	0xb4, 0xb2, 0xb6, 0xde, 0xba, 0xa4, 0x2d, 0xc3, 0xbd, 0xe7, 0x4c, 0xad, 0x8d, 0x9c, 0xc3, 0x2d,
+	0x89, 0x43, 0xad, 0x33, 0xdf, 0x5e, 0xe5, 0x4a, 0x63, 0x43, 0xc7, 0xa5, 0xd9, 0xe8, 0x25, 0x15,
+	0x93, 0x9f, 0xc0, 0xca, 0x4b, 0xcf, 0x4f, 0x64, 0xb7, 0x34, 0x13, 0xb1, 0x8a, 0x4d, 0x3e, 0xba,
+	0xa4, 0xc9, 0xe7, 0xfc, 0x63, 0x43, 0x15, 0x9f, 0x52, 0xe3, 0xda, 0xbf, 0xb1, 0xa0, 0x65, 0xd6,
+	0xc3, 0xd8, 0x54, 0x68, 0x28, 0xf2, 0xdc, 0x94, 0x4e, 0x85, 0x0c, 0x38, 0x1f, 0x2f, 0x28, 0x15,
+	0xc5, 0x0b, 0x74, 0x2f, 0x7d, 0xf9, 0xb2, 0x38, 0x6f, 0xe5, 0x6a, 0x71, 0xde, 0x6a, 0x51, 0x9c,
+	0x77, 0xed, 0x7f, 0x59, 0x40, 0xf2, 0xbc, 0x44, 0x9e, 0xf2, 0x80, 0x45, 0xa0, 0x84, 0xcc, 0xff,
+	0x77, 0x35, 0x7e, 0x94, 0x73, 0x27, 0xbf, 0x66, 0x1b, 0x43, 0x4f, 0xf2, 0xd6, 0x8d, 0xba, 0x39,
+	0xa7, 0x08, 0x95, 0x89, 0x3c, 0x57, 0x2e, 0x8f, 0x3c, 0x57, 0x2f, 0x8f, 0x3c, 0xcf, 0x64, 0x23,
+	0xcf, 0x6b, 0x7f, 0xde, 0x82, 0xc5, 0x82, 0x45, 0xff, 0xd5, 0x0d, 0x9c, 0x2d, 0x93, 0x21, 0x0b,
+	0x4a, 0x62, 0x99, 0x74, 0xe0, 0xda, 0x9f, 0x81, 0x39, 0x83, 0xd1, 0x7f, 0x75, 0xed, 0x67, 0xed,
+	0x52, 0xce, 0x67, 0x06, 0x6c, 0xed, 0xbf, 0x95, 0x80, 0xe4, 0x37, 0xdb, 0x9f, 0x68, 0x1f, 0xf2,
+	0xf3, 0x54, 0x2e, 0x98, 0xa7, 0xff, 0xa7, 0xe7, 0x40, 0xea, 0xd9, 0xd6, 0xc2, 0x54, 0x9c, 0x63,
+	0xf2, 0x08, 0x66, 0x99, 0x9b, 0x61, 0xff, 0x9a, 0x91, 0xb3, 0xaf, 0x1d, 0x86, 0x99, 0xe8, 0xbf,
+	0xbd, 0x06, 0x1d, 0x31, 0x43, 0x79, 0x8f, 0xed, 0xff, 0xae, 0x2a, 0xe7, 0x02, 0x22, 0x55, 0x5a,
+	0x4d, 0x53, 0x17, 0xe6, 0x62, 0x39, 0x32, 0x51, 0x4a, 0x66, 0x2e, 0xe8, 0x54, 0x64, 0x0b, 0x5a,
+	0x28, 0xb2, 0xfa, 0xea, 0x3b, 0x7e, 0xf8, 0x5f, 0x10, 0x7d, 0xd9, 0xbe, 0xe6, 0x64, 0xbe, 0x21,
+	0xbf, 0x0e, 0x2d, 0xd3, 0xa3, 0x26, 0x2c, 0x8f, 0x22, 0xed, 0x87, 0x7d, 0x6e, 0x12, 0x93, 0x0d,
+	0x68, 0x67, 0x5d, 0x72, 0x22, 0xf3, 0x7a, 0x4a, 0x05, 0x39, 0x72, 0x72, 0x00, 0x4b, 0xd2, 0xee,
+	0xd3, 0x25, 0x30, 0xae, 0xcd, 0x65, 0xa3, 0x29, 0xfc, 0x92, 0x7c, 0x08, 0x4b, 0x45, 0x87, 0x24,
+	0x9a, 0x08, 0xd3, 0x8d, 0xb1, 0xc2, 0x6f, 0x88, 0x93, 0x1e, 0xe0, 0x66, 0xf7, 0x6a, 0x57, 0xe8,
+	0x5e, 0xf1, 0xa7, 0xe4, 0x5d, 0xe1, 0xa2, 0xaf, 0xa2, 0xaa, 0x7e, 0xc7, 0xac, 0x42, 0x63, 0x8c,
+	0xfb, 0xfc, 0x8f, 0x96, 0xc3, 0xf9, 0xbb, 0x16, 0x40, 0x0a, 0x24, 0x6d, 0x68, 0xee, 0x1f, 0x74,
+	0xf7, 0xdc, 0xcd, 0xed, 0x8d, 0xbd, 0xbd, 0xee, 0x6e, 0xfb, 0x1a, 0x21, 0xd0, 0xc2, 0xb8, 0xe5,
+	0x96, 0x82, 0x59, 0x0c, 0xb6, 0xb1, 0xc9, 0x63, 0xa2, 0x02, 0x56, 0x22, 0x4b, 0xd0, 0xde, 0xd9,
+	0xcb, 0x40, 0xcb, 0xa4, 0x03, 0x4b, 0x22, 0x28, 0x8a, 0x95, 0x28, 0x4c, 0x85, 0x61, 0x0e, 0xba,
+	0x3c, 0x08, 0x6a, 0xb4, 0x58, 0x25, 0xd7, 0x61, 0x59, 0x62, 0xcc, 0x8f, 0x66, 0x1e, 0xd7, 0x95,
+	0x84, 0xb1, 0x57, 0x60, 0x89, 0xdf, 0xed, 0x7a, 0xcc, 0x37, 0x98, 0xd4, 0xb6, 0xfe, 0xb5, 0x05,
+	0xcb, 0x19, 0x44, 0x1a, 0xaf, 0xe2, 0x0a, 0x95, 0xa9, 0x65, 0x99, 0x40, 0xb6, 0xab, 0x95, 0x85,
+	0x9f, 0x91, 0xc1, 0x79, 0x04, 0x93, 0x1a, 0x9a, 0x47, 0x20, 0x23, 0x8b, 0x8a, 0x50, 0xec, 0x80,
+	0xcd, 0x44, 0xb6, 0xf9, 0x51, 0x95, 0x81, 0xda, 0xab, 0xca, 0x8c, 0xca, 0x0c, 0xf0, 0x84, 0xdf,
+	0x2d, 0xd3, 0x11, 0x69, 0x26, 0xa5, 0x39, 0x34, 0x59, 0x24, 0x8f, 0x32, 0xfc, 0x6b, 0x8e, 0xab,
+	0x10, 0x67, 0xff, 0x41, 0x19, 0xc8, 0x0f, 0x26, 0x34, 0x3a, 0xc7, 0x9b, 0x0e, 0x2a, 0xfa, 0xbc,
+	0x9a, 0x8d, 0xad, 0xce, 0x8c, 0x27, 0xc7, 0x1f, 0xd1, 0x73, 0x79, 0x9b, 0xa7, 0x94, 0xde, 0xe6,
+	0x79, 0x0d, 0x20, 0x98, 0x8c, 0x5c, 0x75, 0xcf, 0x02, 0x9d, 0x2d, 0xc1, 0x64, 0xc4, 0x2b, 0x2c,
+	0xbc, 0x70, 0x53, 0xb9, 0xfc, 0xc2, 0x4d, 0xf5, 0xb2, 0x0b, 0x37, 0x5f, 0x83, 0x39, 0x7f, 0x10,
+	0x84, 0x4c, 0xf8, 0x32, 0xf5, 0x29, 0xee, 0xcc, 0xdc, 0x2e, 0xdf, 0x6b, 0x3a, 0x4d, 0x01, 0xdc,
+	0x63, 0x30, 0xf2, 0xff, 0xa7, 0x44, 0xb4, 0x3f, 0xc0, 0x2b, 0x5d, 0xba, 0x38, 0xee, 0xf6, 0x07,
+	0x74, 0x37, 0xec, 0x79, 0x49, 0x18, 0xa9, 0x0f, 0x19, 0x2c, 0x26, 0x6f, 0x43, 0x03, 0x07, 0xe4,
+	0x9e, 0x62, 0xd6, 0x10, 0x97, 0xe2, 0x6d, 0xfd, 0x52, 0xc8, 0x36, 0x9a, 0x75, 0x91, 0xfc, 0x37,
+	0xfe, 0x93, 0xbf, 0x01, 0xf4, 0x29, 0x34, 0xb4, 0x01, 0x20, 0xb5, 0x50, 0x13, 0x85, 0x2d, 0x56,
+	0xe1, 0xbe, 0x9e, 0x80, 0x0e, 0x77, 0xfa, 0xe4, 0x1b, 0xb0, 0xd0, 0xf7, 0x23, 0x8a, 0x57, 0xc4,
+	0xdc, 0x88, 0x9e, 0xd1, 0x28, 0x96, 0x5e, 0xe0, 0xb6, 0x42, 0x38, 0x1c, 0x6e, 0xbf, 0x0f, 0x8b,
+	0x06, 0x53, 0xa8, 0xbd, 0x25, 0xaf, 0xd3, 0x58, 0xf9, 0xeb, 0x34, 0xf2, 0x2a, 0x8d, 0xfd, 0x17,
+	0x4b, 0x50, 0xde, 0x0e, 0xc7, 0x7a, 0x5a, 0x8b, 0x65, 0xa6, 0xb5, 0x08, 0x35, 0xd7, 0x55, 0x5a,
+	0xac, 0xd0, 0x7e, 0x0c, 0x20, 0x59, 0x87, 0x96, 0x37, 0x4a, 0xdc, 0x24, 0x64, 0x6a, 0xfd, 0x4b,
+	0x2f, 0xe2, 0x7e, 0xa3, 0x32, 0x06, 0x98, 0x32, 0x18, 0xb2, 0x04, 0x65, 0xa5, 0x0f, 0x22, 0x01,
+	0x2b, 0x32, 0x9b, 0x12, 0xf3, 0x35, 0xa5, 0x13, 0x40, 0x94, 0xd8, 0x7e, 0x36, 0xbf, 0xe7, 0x6e,
+	0x47, 0x7e, 0xaa, 0x17, 0xa1, 0x98, 0xca, 0xcd, 0x78, 0x13, 0xc9, 0x44, 0x6c, 0x5f, 0x96, 0xf5,
+	0x3c, 0x84, 0x9a, 0x99, 0x7d, 0xfd, 0x5f, 0x2c, 0xa8, 0xe2, 0xdc, 0x30, 0x0d, 0x85, 0x0b, 0x20,
+	0x95, 0xd9, 0x22, 0x22, 0x2b, 0x59, 0x30, 0xb1, 0x8d, 0x2b, 0x88, 0x25, 0x35, 0x20, 0xfd, 0x1a,
+	0xe2, 0x6d, 0xa8, 0xf3, 0x92, 0xba, 0x58, 0x87, 0x24, 0x29, 0x90, 0xdc, 0x82, 0xca, 0x69, 0x38,
+	0x96, 0x26, 0x15, 0xc8, 0xac, 0xc3, 0x70, 0xec, 0x20, 0x3c, 0xed, 0x0f, 0xab, 0x2f, 0x4d, 0xf7,
+	0x2a, 0x3b, 0x59, 0x30, 0x93, 0x64, 0xaa, 0x5a, 0x7d, 0x9a, 0x32, 0x50, 0x7b, 0x1d, 0xe6, 0xd9,
+	0x9e, 0xd3, 0x02, 0xac, 0x53, 0x85, 0x88, 0xfd, 0x67, 0x2d, 0xa8, 0x49, 0x62, 0x72, 0x0f, 0x2a,
+	0x81, 0xcc, 0x2b, 0x48, 0x4f, 0x59, 0x95, 0x68, 0xce, 0xe8, 0x1c, 0xa4, 0x60, 0x0a, 0x23, 0x06,
+	0x76, 0x52, 0x5b, 0x58, 0x86, 0x75, 0x52, 0x53, 0x4f, 0x75, 0x37, 0x63, 0x21, 0x65, 0xa0, 0xf6,
+	0xef, 0x58, 0x30, 0x67, 0xb4, 0x41, 0x6e, 0x43, 0x03, 0x77, 0x25, 0xf7, 0x88, 0x8a, 0xe5, 0xd1,
+	0x41, 0xfa, 0x42, 0x97, 0xcc, 0x84, 0x13, 0x15, 0x0c, 0x2e, 0xeb, 0xc1, 0xe0, 0x87, 0x50, 0x4f,
+	0x2f, 0x8a, 0x56, 0x0c, 0xc9, 0xc3, 0x5a, 0x94, 0x29, 0xf4, 0x75, 0xe3, 0xde, 0x68, 0x2f, 0x1c,
+	0x86, 0x91, 0x88, 0x77, 0xf3, 0x82, 0xfd, 0x3e, 0x34, 0x34, 0x7a, 0x3d, 0xac, 0x67, 0x99, 0x61,
+	0x3d, 0x79, 0x51, 0xa7, 0x94, 0x5e, 0xd4, 0xb1, 0xff, 0xbb, 0x05, 0x73, 0x8c, 0x07, 0xfd, 0x60,
+	0x70, 0x10, 0x0e, 0xfd, 0xde, 0x39, 0xae, 0xbd, 0x64, 0x37, 0x21, 0x90, 0x25, 0x2f, 0x9a, 0x60,
+	0xc3, 0xad, 0xc9, 0xb7, 0x68, 0xea, 0xd6, 0xbc, 0x03, 0x73, 0x6c, 0x07, 0x1c, 0x7b, 0xb1, 0xd8,
+	0x16, 0x42, 0x33, 0x37, 0x80, 0x6c, 0xa7, 0x31, 0x40, 0xe4, 0x25, 0xd4, 0x1d, 0xf9, 0xc3, 0xa1,
+	0x9f, 0xe6, 0x21, 0x96, 0x9d, 0x22, 0x14, 0x6b, 0xb3, 0xef, 0xc7, 0xde, 0x71, 0x9a, 0x71, 0xa4,
+	0xca, 0x18, 0x28, 0xf0, 0x5e, 0x69, 0x81, 0x82, 0x19, 0x91, 0xa2, 0xa8, 0x03, 0xed, 0x7f, 0x56,
+	0x82, 0x86, 0x54, 0x8a, 0xfa, 0x03, 0x2a, 0x1c, 0xd4, 0xa6, 0x60, 0xd4, 0x20, 0x12, 0x6f, 0x58,
+	0xdc, 0x19, 0x7f, 0x9d, 0xce, 0x18, 0xe5, 0x3c, 0x63, 0xdc, 0x84, 0x3a, 0x63, 0xd0, 0xb7, 0xd1,
+	0xb4, 0x17, 0x77, 0xaf, 0x15, 0x40, 0x62, 0x1f, 0x21, 0xb6, 0x9a, 0x62, 0x11, 0x70, 0x61, 0xca,
+	0xdd, 0xbb, 0xd0, 0x14, 0xd5, 0xe0, 0xca, 0x65, 0x14, 0x51, 0x63, 0x55, 0x1d, 0x83, 0x52, 0x7e,
+	0xf9, 0x48, 0x7e, 0x59, 0xbb, 0xec, 0x4b, 0x49, 0x69, 0x3f, 0x55, 0x99, 0x8c, 0x4f, 0x23, 0x6f,
+	0x2c, 0x53, 0x54, 0xd8, 0x42, 0xfa, 0x41, 0x6f, 0x38, 0xe9, 0x53, 0x77, 0x12, 0x78, 0x41, 0x10,
+	0x4e, 0x30, 0x7c, 0xcc, 0xbd, 0x78, 0x45, 0x28, 0xbb, 0xaf, 0x2e, 0x4b, 0x3e, 0x15, 0x01, 0xf2,
+	0x2a, 0x3f, 0xa8, 0xf9, 0xd9, 0x51, 0xbc, 0xd1, 0x39, 0x09, 0xb9, 0x07, 0x55, 0x7e, 0x5e, 0x97,
+	0x8c, 0x5d, 0xa3, 0xad, 0xaa, 0xc3, 0x09, 0x98, 0xd8, 0xc1, 0x53, 0xd5, 0x14, 0x3b, 0xe6, 0xb9,
+	0x33, 0xd3, 0xc3, 0x73, 0xd7, 0x5e, 0x02, 0xb2, 0xc7, 0x77, 0x8a, 0x9e, 0x06, 0xf2, 0xfb, 0x65,
+	0x68, 0x68, 0x60, 0x26, 0x41, 0x30, 0xa2, 0xef, 0xf6, 0x7d, 0x6f, 0x44, 0x13, 0x1a, 0x89, 0xdd,
+	0x91, 0x81, 0x32, 0x3a, 0xef, 0x6c, 0xe0, 0x86, 0x93, 0xc4, 0xed, 0xd3, 0x41, 0x44, 0xf9, 0x69,
+	0xca, 0x8e, 0x26, 0x03, 0xca, 0xe8, 0x18, 0x7f, 0x6a, 0x74, 0x9c, 0x83, 0x32, 0x50, 0x99, 0xd4,
+	0xc1, 0xe7, 0xa8, 0x92, 0x26, 0x75, 0xf0, 0x19, 0xc9, 0xca, 0xbe, 0x6a, 0x81, 0xec, 0x7b, 0x07,
+	0x56, 0xb8, 0x94, 0x13, 0xf2, 0xc0, 0xcd, 0x30, 0xd6, 0x14, 0x2c, 0x59, 0x87, 0x36, 0xeb, 0xb3,
+	0xdc, 0x12, 0xb1, 0xff, 0x05, 0x8f, 0xdf, 0x59, 0x4e, 0x0e, 0x2e, 0xdd, 0xf0, 0x06, 0x2d, 0x4f,
+	0xf1, 0xcc, 0xc1, 0x91, 0xd6, 0x7b, 0x65, 0xd2, 0xd6, 0x05, 0x6d, 0x06, 0xce, 0x68, 0xd9, 0x58,
+	0xbe, 0x08, 0x47, 0xc7, 0x3e, 0xb7, 0x78, 0x62, 0x11, 0x2b, 0xc9, 0xc1, 0xed, 0x39, 0x68, 0x1c,
+	0x26, 0xe1, 0x58, 0x2e, 0x60, 0x0b, 0x9a, 0xbc, 0x28, 0x6e, 0x36, 0xdd, 0x80, 0xeb, 0xc8, 0x71,
+	0x47, 0xe1, 0x38, 0x1c, 0x86, 0x83, 0x73, 0xc3, 0x94, 0xfe, 0xb7, 0x16, 0x2c, 0x1a, 0xd8, 0xd4,
+	0x96, 0x46, 0x2f, 0x9c, 0x4c, 0xe9, 0xe7, 0x4c, 0xba, 0xa0, 0x89, 0x6b, 0x4e, 0xc8, 0x63, 0x5f,
+	0xcf, 0x44, 0x96, 0xff, 0x46, 0x1a, 0x21, 0x92, 0x1f, 0x96, 0x8a, 0xee, 0xb7, 0x30, 0x8e, 0x15,
+	0xdf, 0xb7, 0xc4, 0x07, 0xb2, 0x8a, 0x5f, 0x17, 0x69, 0xb5, 0x7d, 0x31, 0xe8, 0xb2, 0x99, 0x0a,
+	0xa9, 0xbb, 0x5e, 0x64, 0x0f, 0x7a, 0x0a, 0x18, 0xdb, 0x3f, 0xb3, 0x00, 0xd2, 0xde, 0x61, 0x32,
+	0xa6, 0x3a, 0x72, 0xf8, 0x3b, 0x25, 0xda, 0xf1, 0xf2, 0x06, 0x34, 0x55, 0x1a, 0x53, 0x7a, 0x8a,
+	0x35, 0x24, 0x8c, 0xe9, 0xf7, 0x77, 0x61, 0x7e, 0x30, 0x0c, 0x8f, 0x51, 0x05, 0x10, 0xd9, 0x2c,
+	0x3c, 0x5a, 0xd7, 0xe2, 0xe0, 0x27, 0x32, 0x87, 0x45, 0x1d, 0x79, 0x15, 0xfd, 0xc8, 0x2b, 0x3e,
+	0xc0, 0xfe, 0x4a, 0x49, 0x25, 0x5b, 0xa4, 0x33, 0x31, 0x75, 0x9f, 0x92, 0x47, 0x39, 0x81, 0x3c,
+	0x25, 0xaa, 0x82, 0xea, 0xed, 0xc1, 0xa5, 0x3e, 0xd1, 0xf7, 0xa1, 0x15, 0x71, 0x89, 0x27, 0xc5,
+	0x61, 0xe5, 0x02, 0x71, 0x38, 0x17, 0x19, 0xa7, 0xe5, 0xd7, 0xa1, 0xed, 0xf5, 0xcf, 0x68, 0x94,
+	0xf8, 0xe8, 0x95, 0x42, 0x55, 0x85, 0x0f, 0x6e, 0x5e, 0x83, 0xa3, 0x06, 0x71, 0x17, 0xe6, 0xc5,
+	0x4d, 0x3b, 0x45, 0x29, 0x1e, 0x21, 0x48, 0xc1, 0x8c, 0xd0, 0xfe, 0x87, 0x32, 0xaf, 0xc3, 0x5c,
+	0xd9, 0xe9, 0x33, 0xa2, 0x8f, 0xae, 0x94, 0x19, 0xdd, 0xd7, 0x44, 0x7c, 0xba, 0xef, 0x6a, 0x39,
+	0x77, 0x32, 0x31, 0xbb, 0x2f, 0x72, 0x62, 0xcc, 0x29, 0xad, 0x5c, 0x65, 0x4a, 0xed, 0x9f, 0x5b,
+	0x30, 0xbb, 0x1d, 0x8e, 0xb7, 0x45, 0x8a, 0x3a, 0x6e, 0x0f, 0x15, 0xe5, 0x91, 0xc5, 0x0b, 0x92,
+	0xd7, 0x0b, 0x35, 0x84, 0xb9, 0xac, 0x86, 0xf0, 0x7d, 0xb8, 0x81, 0x8e, 0xd7, 0x28, 0x1c, 0x87,
+	0x11, 0xdb, 0xa2, 0xde, 0x90, 0xab, 0x03, 0x61, 0x90, 0x9c, 0x4a, 0x41, 0x78, 0x11, 0x09, 0xda,
+	0xf2, 0xcc, 0x4c, 0xe2, 0xca, 0xbd, 0xd0, 0x68, 0xb8, 0x7c, 0xcc, 0x23, 0xec, 0xf7, 0xa0, 0xae,
+	0xec, 0x37, 0x66, 0x72, 0x32, 0x23, 0x8c, 0x1b, 0x79, 0x96, 0x91, 0xb5, 0x25, 0x46, 0xee, 0xa4,
+	0x04, 0xf6, 0xcf, 0x67, 0x60, 0x76, 0x27, 0x38, 0x0b, 0xfd, 0x1e, 0x66, 0x80, 0x8c, 0xe8, 0x28,
+	0x94, 0x17, 0x7e, 0xd9, 0xff, 0xe4, 0x26, 0xcc, 0xe2, 0x0d, 0xa1, 0x31, 0x67, 0xda, 0x26, 0x4f,
+	0x85, 0x13, 0x20, 0xa6, 0x66, 0x44, 0xe9, 0x9b, 0x0b, 0x7c, 0x53, 0x69, 0x10, 0xcc, 0xa0, 0xd1,
+	0xdf, 0x4c, 0x10, 0xa5, 0xf4, 0x52, 0x77, 0x55, 0xbb, 0xd4, 0xcd, 0xda, 0x12, 0x29, 0xf5, 0x3c,
+	0xe7, 0x9a, 0xb7, 0x25, 0x40, 0x68, 0x60, 0x45, 0x94, 0x3b, 0xce, 0x51, 0x69, 0x99, 0x15, 0x06,
+	0x96, 0x0e, 0x64, 0x8a, 0x0d, 0xff, 0x80, 0xd3, 0x70, 0x31, 0xae, 0x83, 0x30, 0x32, 0x9a, 0x79,
+	0x56, 0x83, 0xbf, 0x68, 0x92, 0x05, 0x33, 0xf9, 0xdd, 0xa7, 0x4a, 0xcc, 0xf2, 0x71, 0x70, 0xa3,
+	0x36, 0x07, 0xd7, 0xcc, 0x32, 0x7e, 0x99, 0x4b, 0x9a, 0x65, 0x8c, 0x61, 0x64, 0xd6, 0x26, 0xea,
+	0xb1, 0x4d, 0x1e, 0xfd, 0x30, 0x80, 0x98, 0x3c, 0x97, 0xae, 0x2a, 0xc6, 0xb7, 0x2b, 0x8e, 0x0e,
+	0x22, 0x8f, 0x4c, 0xe3, 0xbd, 0x35, 0xc5, 0x78, 0xd7, 0x89, 0xf4, 0xec, 0x94, 0xf9, 0xdc, 0xf5,
+	0x2a, 0xaf, 0xdf, 0x17, 0x49, 0x3d, 0x6d, 0x6e, 0x56, 0x2b, 0x00, 0x86, 0x6a, 0xf9, 0x84, 0x71,
+	0x82, 0x05, 0x24, 0x30, 0x60, 0xe4, 0x16, 0xd4, 0x98, 0x99, 0x34, 0xf6, 0xfc, 0x3e, 0x66, 0x0f,
+	0x72, 0x6b, 0x4d, 0xc1, 0x58, 0x1d, 0xf2, 0x7f, 0x4c, 0xbe, 0x59, 0xe4, 0xe1, 0x5e, 0x1d, 0xc6,
+	0xe6, 0x46, 0x95, 0x71, 0x33, 0x2d, 0xf1, 0x15, 0x35, 0x80, 0xe4, 0x6d, 0x0c, 0x5a, 0x8a, 0x6b,
+	0x56, 0xad, 0x47, 0x37, 0xc4, 0x98, 0x05, 0xd3, 0xca, 0xbf, 0xfc, 0xa2, 0x25, 0xa7, 0x44, 0x26,
+	0x48, 0xbc, 0xa1, 0x9c, 0xac, 0x15, 0x9e, 0xc1, 0xa7, 0x81, 0x98, 0x32, 0xc6, 0x7d, 0xd9, 0xab,
+	0x86, 0x32, 0x26, 0x2a, 0x43, 0x5f, 0x36, 0x27, 0xb0, 0xbf, 0x09, 0x4d, 0xbd, 0x09, 0x52, 0x83,
+	0xca, 0xfe, 0x41, 0x77, 0xaf, 0x7d, 0x8d, 0x34, 0x60, 0xf6, 0xb0, 0x7b, 0x74, 0xb4, 0xdb, 0xdd,
+	0x6a, 0x5b, 0xa4, 0x09, 0x35, 0x75, 0x23, 0xa2, 0x64, 0xff, 0x9e, 0x05, 0x0d, 0xad, 0xae, 0x0b,
+	0xdc, 0x06, 0xb7, 0x00, 0x50, 0xcb, 0x4f, 0xf3, 0xab, 0x2a, 0x8e, 0x06, 0x61, 0x52, 0x52, 0x19,
+	0xa9, 0xfc, 0x5e, 0x54, 0x4d, 0xbf, 0xdd, 0x24, 0x52, 0x04, 0x8c, 0x00, 0x81, 0x09, 0x64, 0x2b,
+	0x11, 0xd1, 0x38, 0x1c, 0x9e, 0x19, 0xb7, 0x80, 0x0d, 0x98, 0x9d, 0x00, 0xd9, 0xe8, 0xf7, 0x45,
+	0x8f, 0xf5, 0xb4, 0x87, 0x48, 0x7f, 0xb6, 0x44, 0xee, 0xde, 0x82, 0x1d, 0x54, 0x2a, 0xde, 0x41,
+	0x17, 0xf2, 0x99, 0xdd, 0x85, 0xc6, 0x81, 0xf6, 0x10, 0x0a, 0x0a, 0x13, 0xf9, 0x04, 0x8a, 0x10,
+	0x42, 0x1a, 0x44, 0xeb, 0x4e, 0x49, 0xef, 0x8e, 0xfd, 0x8f, 0x2c, 0xfe, 0x28, 0x81, 0xea, 0x3e,
+	0x6f, 0xdb, 0x86, 0xa6, 0xf2, 0x0e, 0xa6, 0xd7, 0xb1, 0x0c, 0x18, 0xa3, 0xc1, 0xae, 0xb8, 0xe1,
+	0xc9, 0x49, 0x4c, 0x65, 0x7a, 0x8e, 0x01, 0x93, 0x5a, 0x1c, 0xd3, 0xee, 0x7c, 0xde, 0x42, 0x2c,
+	0xf2, 0x74, 0x72, 0x70, 0x9e, 0x6e, 0x8f, 0xee, 0x26, 0x79, 0x6d, 0x44, 0x95, 0xd5, 0xad, 0xb1,
+	0xec, 0x2c, 0xaf, 0x43, 0x4d, 0xd5, 0x6b, 0x8a, 0x6b, 0x49, 0xa9, 0xf0, 0xec, 0x58, 0x40, 0x1b,
+	0xcd, 0xe8, 0x34, 0x67, 0x9a, 0x3c, 0x82, 0xdc, 0x07, 0x72, 0xe2, 0x47, 0x59, 0x72, 0xce, 0x45,
+	0x05, 0x18, 0xfb, 0x39, 0x2c, 0x4a, 0x56, 0xd7, 0xd4, 0x4b, 0x73, 0x11, 0xad, 0xcb, 0x84, 0x45,
+	0x29, 0x2f, 0x2c, 0xec, 0xff, 0x63, 0xc1, 0xac, 0x58, 0xe9, 0xdc, 0x63, 0x3a, 0x7c, 0x9d, 0x0d,
+	0x18, 0xe9, 0x18, 0x6f, 0x7e, 0xa0, 0x64, 0x11, 0x47, 0x44, 0xee, 0x10, 0x28, 0x17, 0x1d, 0x02,
+	0x04, 0x2a, 0x63, 0x0f, 0x73, 0x69, 0x30, 0x43, 0x9b, 0xfd, 0x4f, 0xda, 0xdc, 0x9b, 0xc6, 0xb9,
+	0x1f, 0x3d, 0x69, 0x45, 0xcf, 0x06, 0x71, 0xdd, 0x26, 0xff, 0x6c, 0xd0, 0x4d, 0xa8, 0xf3, 0x4c,
+	0xaa, 0xd4, 0x59, 0x96, 0x02, 0x18, 0xe7, 0xf2, 0x02, 0x6e, 0x53, 0x71, 0x75, 0x38, 0x85, 0xd8,
+	0xcb, 0x7c, 0xe5, 0xc5, 0x14, 0xa8, 0xf4, 0x0a, 0x71, 0x4b, 0x2f, 0x05, 0xa7, 0x1c, 0x21, 0x3a,
+	0x90, 0xe5, 0x08, 0x41, 0xea, 0x28, 0xbc, 0xbd, 0x06, 0x9d, 0x2d, 0x3a, 0xa4, 0x09, 0xdd, 0x18,
+	0x0e, 0xb3, 0xf5, 0xdf, 0x80, 0xeb, 0x05, 0x38, 0x61, 0x51, 0x7c, 0x0f, 0xae, 0x77, 0x5f, 0x31,
+	0xdd, 0x43, 0x60, 0x0e, 0xa2, 0x30, 0x3c, 0xd1, 0xf7, 0xce, 0x25, 0x8b, 0x64, 0xff, 0xad, 0x12,
+	0x34, 0xf5, 0x6f, 0xaf, 0xb4, 0xb2, 0xd3, 0x9e, 0x6a, 0x2a, 0x9a, 0xf3, 0x02, 0x31, 0x53, 0x2e,
+	0x16, 0x33, 0x4b, 0x50, 0x1d, 0x7b, 0xe7, 0xc2, 0x57, 0x5a, 0x77, 0x78, 0x41, 0x71, 0x41, 0x55,
+	0xe3, 0x02, 0x73, 0xa5, 0x66, 0xb2, 0x2b, 0x75, 0xa1, 0x4f, 0x34, 0xc7, 0x7b, 0xb5, 0x02, 0xde,
+	0xb3, 0xff, 0x34, 0xac, 0xf1, 0x57, 0x38, 0xcc, 0x79, 0xbd, 0xf0, 0x29, 0x0e, 0xd5, 0xff, 0x92,
+	0xde, 0xff, 0xc2, 0x9c, 0x31, 0xfb, 0x07, 0xb0, 0xbc, 0xc1, 0x6f, 0xa2, 0xfd, 0xaa, 0x12, 0xa8,
+	0xed, 0x0e, 0xac, 0x64, 0xab, 0x14, 0x4c, 0xf2, 0x04, 0x16, 0xb6, 0xe8, 0xf1, 0x64, 0xb0, 0x4b,
+	0xcf, 0xd2, 0x86, 0x08, 0x54, 0xe2, 0xd3, 0xf0, 0xa5, 0x18, 0x02, 0xfe, 0x4f, 0x5e, 0x03, 0x18,
+	0x32, 0x1a, 0x37, 0x1e, 0xd3, 0x9e, 0x7c, 0x9a, 0x04, 0x21, 0x87, 0x63, 0xda, 0xb3, 0xdf, 0x01,
+	0xa2, 0xd7, 0x23, 0x26, 0x83, 0x1d, 0xd3, 0x93, 0x63, 0x37, 0xbd, 0x0e, 0x84, 0x96, 0x9b, 0x06,
+	0xb2, 0x1d, 0x58, 0xe1, 0x93, 0xc9, 0x3a, 0xc6, 0x8f, 0xf8, 0x3f, 0xf6, 0x68, 0x27, 0x3c, 0x3c,
+	0x85, 0xb5, 0x61, 0xe5, 0x7e, 0x0f, 0x97, 0xef, 0x8a, 0x17, 0x5b, 0xc5, 0x35, 0xf9, 0x98, 0xf6,
+	0x22, 0x9a, 0xc4, 0x42, 0xdc, 0xe9, 0xa0, 0x29, 0xeb, 0x76, 0x08, 0xab, 0xb9, 0xa1, 0x88, 0x79,
+	0x78, 0x37, 0x77, 0x6b, 0x50, 0xbb, 0xaa, 0x94, 0xef, 0xa8, 0x76, 0x6f, 0xf0, 0x2e, 0x6e, 0x41,
+	0x87, 0x7e, 0x2e, 0xde, 0x06, 0x5b, 0x85, 0xd9, 0xb1, 0x77, 0xce, 0xf6, 0x85, 0xf2, 0x4e, 0x23,
+	0xda, 0xfe, 0x1f, 0x25, 0x98, 0xe1, 0x94, 0x6c, 0x00, 0x7d, 0x1a, 0x27, 0x7e, 0x80, 0x95, 0xc9,
+	0x59, 0xd7, 0x40, 0xb9, 0x8d, 0x5c, 0x2a, 0xd8, 0xc8, 0xc2, 0x77, 0x23, 0x9f, 0x11, 0x90, 0xe9,
+	0x7c, 0x3a, 0x8c, 0x09, 0xcd, 0xf4, 0x22, 0x0c, 0x77, 0x8f, 0xa6, 0x80, 0x4c, 0x20, 0x23, 0xd5,
+	0x98, 0x79, 0xff, 0xe4, 0xe9, 0x23, 0x24, 0xb2, 0x0e, 0x2a, 0xd4, 0xcb, 0xf9, 0x23, 0x55, 0x79,
+	0xbd, 0x3c, 0xa7, 0x7f, 0xd7, 0xae, 0xa0, 0x7f, 0x73, 0x87, 0xce, 0x45, 0xfa, 0x37, 0x5c, 0x41,
+	0xff, 0xb6, 0x09, 0xb4, 0x9f, 0x50, 0xea, 0x50, 0x26, 0x65, 0xa5, 0x4c, 0xfe, 0x6d, 0x0b, 0xda,
+	0x82, 0x39, 0x15, 0x8e, 0xbc, 0x91, 0x4b, 0xb9, 0xcc, 0xb1, 0xdd, 0x1d, 0x98, 0x43, 0xfb, 0x52,
+	0x49, 0x27, 0x11, 0x5e, 0x32, 0x80, 0x98, 0x6d, 0x2c, 0x32, 0x7e, 0x46, 0xfe, 0x50, 0x2c, 0x8a,
+	0x0e, 0x92, 0x02, 0x0e, 0xdf, 0x2d, 0xa8, 0xf0, 0x6b, 0x8a, 0xb2, 0x6c, 0xff, 0x73, 0x0b, 0x16,
+	0xb4, 0x0e, 0x0b, 0xee, 0x7c, 0x1f, 0x9a, 0x2a, 0xd1, 0x96, 0x2a, 0x1d, 0x65, 0xd5, 0xdc, 0x68,
+	0xe9, 0x67, 0x06, 0x31, 0x2e, 0xa6, 0x77, 0x8e, 0x1d, 0x8c, 0x27, 0x23, 0xb9, 0x5b, 0x34, 0x10,
+	0x63, 0xa4, 0x97, 0x94, 0xbe, 0x50, 0x24, 0x5c, 0x3d, 0x31, 0x60, 0xe8, 0x23, 0x67, 0x76, 0xb1,
+	0x22, 0xaa, 0x08, 0x1f, 0xb9, 0x0e, 0xb4, 0xff, 0xa8, 0x04, 0x8b, 0xdc, 0xc1, 0x21, 0x9c, 0x4a,
+	0xea, 0x5d, 0x99, 0x19, 0xee, 0xe7, 0xe1, 0x12, 0x6b, 0xfb, 0x9a, 0x23, 0xca, 0xe4, 0xdb, 0x57,
+	0x74, 0xca, 0xa8, 0x4b, 0x14, 0x53, 0xd6, 0xa2, 0x5c, 0xb4, 0x16, 0x17, 0xcc, 0x74, 0x51, 0xb8,
+	0xa2, 0x5a, 0x1c, 0xae, 0xb8, 0x52, 0x78, 0x20, 0x7f, 0xdb, 0x60, 0xb6, 0xe8, 0x9d, 0x83, 0x77,
+	0x61, 0xd5, 0x00, 0xa0, 0xb0, 0xf6, 0x4f, 0x7c, 0x2a, 0x2f, 0xf0, 0x4e, 0x43, 0x3f, 0x9e, 0x85,
+	0x6a, 0xdc, 0x0b, 0xc7, 0xd4, 0x5e, 0x81, 0x25, 0x73, 0x8a, 0xc5, 0x41, 0xf1, 0xb7, 0x2d, 0x7c,
+	0x9f, 0x73, 0x73, 0xc8, 0x76, 0xf6, 0x7d, 0xa8, 0xf5, 0xc2, 0x20, 0x9e, 0x8c, 0x84, 0x9f, 0x39,
+	0x7d, 0x89, 0x8b, 0x91, 0x08, 0x8c, 0xa3, 0x68, 0x98, 0x56, 0xcb, 0x1a, 0x36, 0x5f, 0x41, 0x13,
+	0x5a, 0x6d, 0x0e, 0x81, 0xd4, 0xde, 0xab, 0x0c, 0x75, 0x59, 0x50, 0x67, 0x11, 0xac, 0xc3, 0x4c,
+	0xc7, 0x92, 0x7d, 0x53, 0xba, 0xd1, 0xf7, 0x61, 0x39, 0x03, 0x17, 0xec, 0x7e, 0x17, 0x66, 0x7a,
+	0x08, 0x11, 0x8c, 0xae, 0x85, 0xeb, 0x91, 0xd2, 0x11, 0x68, 0x76, 0x6a, 0xf2, 0x49, 0x50, 0x18,
+	0x39, 0x19, 0x3f, 0xb3, 0xa0, 0xf3, 0x84, 0x47, 0x5a, 0xfd, 0x60, 0xb0, 0xed, 0xc7, 0x49, 0x18,
+	0xa9, 0x37, 0xa8, 0x6e, 0x01, 0xe0, 0xfd, 0x5b, 0x6e, 0x8c, 0x89, 0xc8, 0x4d, 0x0a, 0x61, 0x6c,
+	0x43, 0x83, 0x3e, 0xc7, 0xf2, 0x39, 0x50, 0xe5, 0x9c, 0xb9, 0x22, 0xbc, 0x62, 0x86, 0xd2, 0xff,
+	0x26, 0xbf, 0x29, 0xc6, 0x66, 0x82, 0x9e, 0xa1, 0x0a, 0xc9, 0xad, 0xc2, 0x0c, 0xd4, 0xfe, 0x43,
+	0x0b, 0xe6, 0xd3, 0x4e, 0xf2, 0xfb, 0xb2, 0x86, 0xc0, 0x16, 0x9a, 0x7e, 0x2a, 0xb0, 0x65, 0x4c,
+	0xc9, 0x67, 0xaa, 0xbf, 0x34, 0x55, 0x53, 0x08, 0x0a, 0x51, 0x51, 0x0a, 0x27, 0xea, 0xaa, 0x83,
+	0x06, 0xe2, 0xf9, 0xd0, 0xcc, 0xe8, 0x10, 0x06, 0x94, 0x28, 0xe1, 0xdd, 0xf6, 0x51, 0x82, 0x5f,
+	0x71, 0xf6, 0x96, 0x45, 0xa9, 0xb5, 0x73, 0x76, 0x46, 0xad, 0x5d, 0xd7, 0xd0, 0xf8, 0xcd, 0x05,
+	0x55, 0xb6, 0xff, 0xaa, 0x05, 0xd7, 0x0b, 0x26, 0x5e, 0xac, 0xec, 0x16, 0x2c, 0x9c, 0x28, 0xa4,
+	0x9c, 0x1c, 0xf3, 0x0e, 0x72, 0x66, 0x42, 0x9c, 0xfc, 0x07, 0xca, 0x04, 0xe3, 0xd3, 0x6d, 0xdc,
+	0x8b, 0xca, 0x23, 0xec, 0x25, 0x20, 0x87, 0x2f, 0xfd, 0xa4, 0x77, 0xca, 0x4e, 0xf1, 0x58, 0xcb,
+	0xf4, 0xa9, 0xef, 0xfa, 0xc1, 0x0b, 0x04, 0x5e, 0xe0, 0x1c, 0x10, 0xe1, 0x93, 0xf4, 0x71, 0xaa,
+	0x8a, 0x93, 0x02, 0xd8, 0xa6, 0xc7, 0x7f, 0x90, 0xdb, 0x63, 0xda, 0x13, 0xd7, 0xce, 0x4d, 0x20,
+	0x13, 0x35, 0xfc, 0xe6, 0x20, 0xe6, 0x8a, 0xc6, 0xfe, 0x20, 0x16, 0x2b, 0x93, 0x05, 0xf3, 0xc4,
+	0x55, 0x55, 0x54, 0xb5, 0x56, 0xb1, 0xd6, 0x22, 0x94, 0xfd, 0x9b, 0x25, 0x58, 0x34, 0x86, 0x27,
+	0x66, 0xfa, 0x4d, 0xa8, 0x0e, 0xfd, 0xe0, 0x85, 0x9c, 0xdd, 0xb6, 0x0a, 0x8b, 0x89, 0x21, 0x3b,
+	0x1c, 0x9d, 0xea, 0x52, 0xcc, 0x56, 0xcc, 0xe8, 0x52, 0x08, 0x22, 0xdf, 0x82, 0x65, 0x61, 0x49,
+	0x0e, 0xbd, 0x84, 0x06, 0xbd, 0x73, 0x77, 0xfc, 0xed, 0x87, 0xee, 0x44, 0xea, 0x1b, 0xc5, 0xc8,
+	0xa2, 0xaf, 0xde, 0xc3, 0xaf, 0x2a, 0xc5, 0x5f, 0xbd, 0x37, 0xf5, 0xab, 0xf7, 0xd8, 0x57, 0xd5,
+	0x29, 0x5f, 0x31, 0xa4, 0x7c, 0xcd, 0x60, 0x9b, 0x4d, 0xba, 0x5c, 0xdf, 0x3f, 0xac, 0x40, 0x73,
+	0x27, 0x78, 0x82, 0x37, 0x6e, 0x18, 0xe2, 0xf2, 0xb4, 0x91, 0xcb, 0xb3, 0xa3, 0xd5, 0x25, 0x83,
+	0x72, 0xe6, 0x92, 0x81, 0xe9, 0x41, 0xaa, 0x5c, 0xe8, 0x41, 0xaa, 0x66, 0x3c, 0x48, 0xc6, 0x9b,
+	0x61, 0x33, 0x57, 0x7a, 0x33, 0x6c, 0x76, 0xca, 0x9b, 0x61, 0xe4, 0x3e, 0x90, 0x34, 0xd7, 0x74,
+	0x28, 0x55, 0x29, 0xfe, 0x44, 0x68, 0x01, 0x86, 0x3c, 0x90, 0x7e, 0xbd, 0x3a, 0x1e, 0x11, 0xd7,
+	0x95, 0xd3, 0x23, 0x9d, 0xb7, 0xfb, 0x59, 0xaf, 0xde, 0xa9, 0x17, 0xbb, 0x3d, 0x3f, 0xea, 0x4d,
+	0x7c, 0x71, 0x33, 0xdb, 0xd1, 0x41, 0x78, 0xb9, 0x89, 0xff, 0xab, 0xb2, 0x95, 0x1a, 0xe2, 0x72,
+	0x93, 0x09, 0x66, 0x9d, 0x95, 0x20, 0x6d, 0xf2, 0x9a, 0xdc, 0x35, 0x92, 0xc7, 0xa4, 0x29, 0xe8,
+	0x42, 0x09, 0x46, 0x17, 0x6d, 0xcd, 0x31, 0x81, 0xf6, 0x67, 0xf8, 0x58, 0x5e, 0x42, 0x49, 0x03,
+	0x66, 0x9f, 0xed, 0x7d, 0xb4, 0xb7, 0xff, 0x7c, 0xaf, 0x7d, 0x8d, 0xcc, 0x43, 0x43, 0x66, 0x05,
+	0x6e, 0x6c, 0x6d, 0xb5, 0x2d, 0x32, 0x07, 0xf5, 0xdd, 0xfd, 0xcd, 0x8f, 0xba, 0x5b, 0xee, 0xce,
+	0x5e, 0xbb, 0x44, 0x08, 0xb4, 0x24, 0x9e, 0xfb, 0x13, 0xdb, 0x65, 0xd2, 0x86, 0xa6, 0x84, 0x3d,
+	0xd9, 0xd8, 0xd9, 0x6d, 0x57, 0xec, 0x0f, 0xf8, 0x0b, 0x19, 0x82, 0xcf, 0xc4, 0x46, 0xfb, 0xba,
+	0x74, 0x63, 0xf2, 0x8d, 0xb6, 0x58, 0x30, 0x87, 0xd2, 0x8f, 0xf9, 0xf7, 0x2c, 0x78, 0x8d, 0x9f,
+	0x57, 0x98, 0x77, 0x21, 0x42, 0xda, 0xfa, 0x33, 0xd2, 0x2a, 0xfa, 0x65, 0x15, 0x46, 0xbf, 0x4a,
+	0x5a, 0xf4, 0x0b, 0x5d, 0x8f, 0xfd, 0xbe, 0xf6, 0x66, 0x78, 0x19, 0x8d, 0x6c, 0x13, 0x28, 0xaf,
+	0x37, 0x9e, 0x51, 0xd7, 0xcc, 0x19, 0xa9, 0x3b, 0x39, 0xb8, 0x7d, 0x1b, 0x6e, 0x4d, 0xeb, 0x9e,
+	0x38, 0x56, 0x3b, 0xb0, 0xc2, 0x66, 0xe0, 0x31, 0xdb, 0x18, 0x7d, 0xe3, 0xf5, 0x90, 0x1f, 0x01,
+	0xa4, 0xd0, 0x0b, 0xde, 0x50, 0xb9, 0x09, 0xf5, 0x63, 0xa4, 0x73, 0x95, 0x02, 0x9d, 0x02, 0xf8,
+	0x75, 0x5f, 0x2f, 0x0e, 0x03, 0x61, 0xb8, 0x89, 0x92, 0xfd, 0x18, 0x56, 0x73, 0xed, 0x2a, 0x65,
+	0xc1, 0x78, 0xa1, 0x44, 0x86, 0x56, 0x53, 0x52, 0xf9, 0x4e, 0xc9, 0x5b, 0xd0, 0x7e, 0x16, 0x1c,
+	0x7b, 0x57, 0x7b, 0xef, 0xd0, 0x5e, 0x84, 0x05, 0x8d, 0x5a, 0x0c, 0xff, 0xa7, 0x25, 0x58, 0x72,
+	0x0e, 0x36, 0x3f, 0xf6, 0xfb, 0xfd, 0x21, 0x7d, 0xe9, 0x45, 0x4a, 0xbf, 0x5d, 0x81, 0x99, 0x51,
+	0x3c, 0xd0, 0x02, 0x6c, 0xbc, 0x84, 0x27, 0xe5, 0x64, 0x38, 0x74, 0x27, 0x91, 0x2f, 0x5f, 0x42,
+	0x96, 0x65, 0x75, 0xc7, 0x9d, 0xd7, 0x6d, 0xdc, 0x71, 0x4f, 0xdf, 0x2c, 0x4d, 0xce, 0xc7, 0xd4,
+	0xc5, 0xeb, 0xf1, 0x22, 0xff, 0x43, 0x01, 0x50, 0x8b, 0xa1, 0x91, 0xef, 0x0d, 0xfd, 0x2f, 0x44,
+	0x4e, 0x4b, 0xd3, 0xd1, 0x20, 0xe8, 0x74, 0xf6, 0x5e, 0xba, 0x23, 0xaf, 0xe7, 0x45, 0xa1, 0xb0,
+	0xe2, 0x9a, 0x8e, 0x01, 0x63, 0xea, 0x68, 0x6f, 0x12, 0x27, 0xe1, 0xc8, 0xed, 0x79, 0x67, 0xd4,
+	0xc3, 0x7b, 0x62, 0x7d, 0xbc, 0xf3, 0x25, 0xac, 0xb9, 0x69, 0x68, 0xfb, 0xa7, 0x16, 0x2c, 0x67,
+	0xa6, 0x42, 0xf4, 0xfa, 0x3d, 0xa8, 0x45, 0x74, 0xe0, 0xc7, 0x32, 0xc5, 0xa1, 0xf1, 0xe8, 0x35,
+	0xb1, 0x26, 0x3a, 0x31, 0x23, 0x88, 0x84, 0x2d, 0x2d, 0xc9, 0xc9, 0xb7, 0x50, 0xb1, 0xe8, 0x33,
+	0xa3, 0x50, 0x98, 0x02, 0x1d, 0xb5, 0x9d, 0x12, 0x1a, 0xf5, 0xe8, 0x98, 0x69, 0x8b, 0x88, 0x77,
+	0x14, 0xa5, 0x1d, 0xc1, 0x4a, 0x71, 0xcd, 0x98, 0xc0, 0xaf, 0x30, 0x7c, 0x1a, 0xc5, 0x0d, 0x99,
+	0x0c, 0x18, 0x85, 0x91, 0x31, 0x52, 0xed, 0x49, 0x82, 0x02, 0x8c, 0xfd, 0xbb, 0xf8, 0x43, 0x1b,
+	0x99, 0x3e, 0xa1, 0xfb, 0x9c, 0x9e, 0xb8, 0x06, 0x2b, 0x68, 0x90, 0xd4, 0x2d, 0x51, 0xd2, 0xaf,
+	0x20, 0xe2, 0x3d, 0x9e, 0xf1, 0xd0, 0xeb, 0x51, 0x77, 0xc4, 0x9f, 0x8d, 0x15, 0xcc, 0x90, 0x05,
+	0x93, 0x77, 0x60, 0x45, 0x80, 0xd0, 0x0b, 0xa0, 0x2d, 0x3f, 0x8f, 0xed, 0x4d, 0xc1, 0xda, 0xab,
+	0xb0, 0x2c, 0xdc, 0xc9, 0xc7, 0x54, 0x77, 0xe1, 0xd8, 0x8f, 0x61, 0x25, 0x8b, 0x50, 0x2f, 0x96,
+	0x88, 0xa3, 0xc1, 0xb4, 0x1e, 0x78, 0x72, 0xb4, 0x7e, 0x26, 0xd8, 0x0b, 0xf8, 0xdc, 0x89, 0x51,
+	0xed, 0x77, 0xf1, 0x31, 0x9a, 0x5f, 0xb2, 0xc2, 0xf5, 0x0f, 0xa0, 0xa1, 0x3d, 0x17, 0x4c, 0x56,
+	0x61, 0xf1, 0xf9, 0xce, 0xd1, 0x5e, 0xf7, 0xf0, 0xd0, 0x3d, 0x78, 0xf6, 0xf8, 0xa3, 0xee, 0xa7,
+	0xee, 0xf6, 0xc6, 0xe1, 0x76, 0xfb, 0x1a, 0x59, 0x01, 0xb2, 0xd7, 0x3d, 0x3c, 0xea, 0x6e, 0x19,
+	0x70, 0x6b, 0xfd, 0x2d, 0xed, 0xa5, 0x1f, 0x7e, 0x1b, 0x9a, 0x1d, 0x06, 0xdb, 0xdd, 0x8d, 0xdd,
+	0xa3, 0xed, 0x4f, 0xdb, 0xd7, 0x48, 0x13, 0x6a, 0x5b, 0xdd, 0xa7, 0xce, 0xc6, 0x56, 0x77, 0xab,
+	0x6d, 0xad, 0x6f, 0xa0, 0x2f, 0x2a, 0xff, 0x1a, 0x0d, 0xc6, 0x96, 0x8e, 0x36, 0x76, 0x77, 0xbb,
+	0x5b, 0xed, 0x6b, 0xec, 0xbc, 0x70, 0xba, 0x9b, 0xfb, 0x9f, 0x74, 0x1d, 0x19, 0x6a, 0x62, 0x67,
+	0x02, 0x2b, 0xb7, 0x4b, 0xeb, 0x5f, 0x87, 0x96, 0x79, 0xb1, 0x95, 0x00, 0xcc, 0xec, 0x76, 0x9f,
+	0x6e, 0x6c, 0x7e, 0xca, 0x63, 0x54, 0x1b, 0x7b, 0x9b, 0xdb, 0xfb, 0xce, 0x61, 0xdb, 0x5a, 0xdf,
+	0x83, 0x86, 0x66, 0x80, 0x31, 0x9c, 0x78, 0xcc, 0xab, 0x7d, 0x8d, 0xb4, 0x00, 0x36, 0xf7, 0xf7,
+	0x0f, 0xd4, 0x9b, 0x60, 0x75, 0xa8, 0x1e, 0x3e, 0xef, 0x76, 0x0f, 0xda, 0x25, 0x46, 0xf7, 0xe1,
+	0xb3, 0xc3, 0xa3, 0x9d, 0x4d, 0x76, 0x30, 0x01, 0xcc, 0x6c, 0xee, 0x7f, 0xfc, 0xf1, 0xce, 0x51,
+	0xbb, 0xb2, 0xfe, 0x23, 0x68, 0x68, 0x33, 0xc8, 0xce, 0xac, 0xbd, 0xfd, 0x3d, 0xb7, 0xfb, 0x1b,
+	0x3b, 0x87, 0x47, 0xbc, 0x52, 0xd6, 0x13, 0x3c, 0xe8, 0x78, 0xaf, 0x9f, 0xed, 0x89, 0x52, 0x89,
+	0x35, 0xe7, 0x1c, 0x6c, 0xba, 0x3c, 0xeb, 0xbe, 0x5d, 0x26, 0x0b, 0x30, 0x77, 0xd8, 0x75, 0x3e,
+	0xe9, 0x3a, 0x12, 0x54, 0x79, 0xf4, 0xd7, 0xcb, 0xd0, 0xe2, 0xd5, 0xf3, 0xdf, 0x43, 0xa1, 0x11,
+	0xf9, 0x18, 0x66, 0xc5, 0xef, 0xd9, 0x10, 0x79, 0xa9, 0xd7, 0xfc, 0x05, 0x9d, 0xb5, 0x95, 0x2c,
+	0x58, 0x08, 0xcf, 0xc5, 0x3f, 0xf7, 0xf3, 0xff, 0xf4, 0x37, 0x4b, 0x73, 0xa4, 0xf1, 0xe0, 0xec,
+	0xed, 0x07, 0x03, 0x1a, 0xc4, 0xac, 0x8e, 0x1f, 0x01, 0xa4, 0xbf, 0xf4, 0x42, 0xd2, 0xdd, 0x9e,
+	0xf9, 0x09, 0x9b, 0xb5, 0xeb, 0x05, 0x18, 0x51, 0xef, 0x75, 0xac, 0x77, 0xd1, 0x6e, 0xb1, 0x7a,
+	0xfd, 0xc0, 0x4f, 0xf8, 0xcf, 0xbe, 0x7c, 0xc7, 0x5a, 0x27, 0x7d, 0x68, 0xea, 0x3f, 0xe4, 0x42,
+	0x64, 0xfa, 0x4b, 0xc1, 0xcf, 0xc8, 0xac, 0xdd, 0x28, 0xc4, 0xc9, 0xdc, 0x1f, 0x6c, 0x63, 0xd9,
+	0x6e, 0xb3, 0x36, 0x26, 0x48, 0x91, 0xb6, 0x32, 0x84, 0x96, 0xf9, 0x7b, 0x2d, 0x44, 0xf7, 0x1d,
+	0xe6, 0x7e, 0x2d, 0x66, 0xed, 0xb5, 0x29, 0x58, 0xd1, 0xd6, 0x6b, 0xd8, 0xd6, 0xaa, 0x4d, 0x58,
+	0x5b, 0x3d, 0xa4, 0x91, 0xbf, 0x16, 0xf3, 0x1d, 0x6b, 0xfd, 0xd1, 0x6f, 0xbd, 0xc5, 0x0c, 0x17,
+	0x91, 0xdc, 0x46, 0x7e, 0x02, 0x73, 0xc6, 0x7d, 0x05, 0x72, 0xc3, 0xd8, 0x57, 0x66, 0xf6, 0xff,
+	0xda, 0xcd, 0x62, 0xa4, 0x68, 0xf8, 0x16, 0x36, 0xdc, 0x21, 0x2b, 0xac, 0x61, 0x91, 0xc8, 0xff,
+	0x00, 0xb5, 0x46, 0xfe, 0xee, 0xcb, 0x0b, 0x3e, 0xce, 0xf4, 0xee, 0x80, 0x31, 0xce, 0xdc, 0x5d,
+	0x03, 0x63, 0x9c, 0xf9, 0x0b, 0x07, 0xf6, 0x4d, 0x6c, 0x6e, 0x85, 0x2c, 0xe9, 0xcd, 0xa9, 0xa4,
+	0x33, 0x8a, 0x52, 0x45, 0xff, 0x51, 0x13, 0xf2, 0x9a, 0x62, 0xac, 0xa2, 0x1f, 0x3b, 0x51, 0x2c,
+	0x92, 0xff, 0xc5, 0x13, 0xbb, 0x83, 0x4d, 0x11, 0x82, 0xcb, 0xa7, 0xff, 0xa6, 0x09, 0xf9, 0x21,
+	0xd4, 0xd5, 0xab, 0xeb, 0x64, 0x55, 0xfb, 0x35, 0x00, 0xfd, 0xa5, 0xfa, 0xb5, 0x4e, 0x1e, 0x51,
+	0xc4, 0x18, 0x7a, 0xcd, 0x8c, 0x31, 0x9e, 0x43, 0x43, 0x7b, 0x59, 0x9d, 0x5c, 0x57, 0x36, 0x58,
+	0xf6, 0xf5, 0xf6, 0xb5, 0xb5, 0x22, 0x94, 0x68, 0x62, 0x01, 0x9b, 0x68, 0x90, 0x3a, 0xf2, 0x5e,
+	0xf2, 0x2a, 0x8c, 0xc9, 0xae, 0x26, 0xcf, 0x7f, 0x91, 0x29, 0x2a, 0xf8, 0x8d, 0x97, 0x87, 0x16,
+	0x79, 0x1f, 0x6a, 0xf2, 0x11, 0x7f, 0xb2, 0x52, 0xfc, 0x83, 0x08, 0x6b, 0xab, 0x39, 0xb8, 0x10,
+	0xeb, 0x9f, 0x02, 0xa4, 0xcf, 0xb8, 0xab, 0x0d, 0x9c, 0x7b, 0x16, 0x5e, 0xad, 0x4e, 0xfe, 0xcd,
+	0x77, 0x7b, 0x05, 0x07, 0xd8, 0x26, 0xb8, 0x81, 0x03, 0xfa, 0x52, 0xbe, 0x7d, 0xf1, 0x63, 0x68,
+	0x68, 0x2f, 0xb9, 0xab, 0xe9, 0xcb, 0xbf, 0x02, 0xaf, 0xa6, 0xaf, 0xe0, 0xe1, 0x77, 0x7b, 0x0d,
+	0x6b, 0x5f, 0xb2, 0xe7, 0x59, 0xed, 0xb1, 0x3f, 0x08, 0xc4, 0x61, 0xca, 0x16, 0xe8, 0x14, 0xe6,
+	0x8c, 0xe7, 0xda, 0xd5, 0xee, 0x29, 0x7a, 0x0c, 0x5e, 0xed, 0x9e, 0xc2, 0x17, 0xde, 0x25, 0x3b,
+	0xdb, 0x0b, 0xac, 0x9d, 0x33, 0x24, 0xd1, 0x5a, 0xfa, 0x0c, 0x1a, 0xda, 0xd3, 0xeb, 0x6a, 0x2c,
+	0xf9, 0x57, 0xde, 0xd5, 0x58, 0x8a, 0x5e, 0x6a, 0x5f, 0xc2, 0x36, 0x5a, 0x36, 0xb2, 0x02, 0x6a,
+	0xb5, 0xac, 0xee, 0x9f, 0x40, 0xcb, 0x7c, 0x8c, 0x5d, 0xed, 0xcb, 0xc2, 0x67, 0xdd, 0xd5, 0xbe,
+	0x9c, 0xf2, 0x82, 0xbb, 0x60, 0xe9, 0xf5, 0x45, 0xd5, 0xc8, 0x83, 0x2f, 0x85, 0x56, 0xfc, 0x15,
+	0x39, 0x66, 0xa7, 0x65, 0xc1, 0xcb, 0xe9, 0xe4, 0x6b, 0x17, 0xbf, 0xab, 0xce, 0x5b, 0xbe, 0x73,
+	0x95, 0xc7, 0xd7, 0xc9, 0x07, 0x50, 0x57, 0x2f, 0x7d, 0xab, 0x3d, 0x99, 0x7d, 0xaa, 0x5c, 0x1d,
+	0x33, 0x99, 0x47, 0xc1, 0x1f, 0x5a, 0xe4, 0x07, 0x4c, 0x40, 0x8a, 0x87, 0x0c, 0xc9, 0xaa, 0xb6,
+	0xb3, 0x74, 0x83, 0x45, 0xed, 0xe9, 0xdc, 0x9b, 0x87, 0xe6, 0x86, 0xe3, 0x6f, 0x9f, 0xe1, 0xa9,
+	0x87, 0x4f, 0xba, 0x69, 0xa7, 0x9e, 0xfe, 0xea, 0x9b, 0x76, 0xea, 0x19, 0x2f, 0xbf, 0x65, 0x4f,
+	0xbd, 0xc4, 0x67, 0x75, 0x1c, 0xa0, 0x70, 0xd3, 0xdf, 0x45, 0xd4, 0x77, 0x6e, 0xc1, 0x53, 0x8a,
+	0x6b, 0xb7, 0xa6, 0xa1, 0xd3, 0x39, 0x53, 0x4f, 0xfd, 0xa9, 0x31, 0x67, 0x1f, 0x04, 0x54, 0x63,
+	0xce, 0xbf, 0x0a, 0xe8, 0xc2, 0x0d, 0x25, 0x51, 0x72, 0xea, 0x50, 0x4c, 0xee, 0x4c, 0x7b, 0xb7,
+	0x4f, 0xcf, 0x52, 0x58, 0xeb, 0x4c, 0xa3, 0x7a, 0x68, 0x91, 0x00, 0xe6, 0x33, 0x17, 0x79, 0xd5,
+	0x90, 0x8b, 0x5f, 0x3e, 0x50, 0x43, 0x9e, 0x72, 0xff, 0xd7, 0x3c, 0x3f, 0xe4, 0xb9, 0xf1, 0x40,
+	0xbe, 0x5e, 0xf3, 0xa7, 0xa0, 0xa9, 0x3f, 0xbf, 0x4b, 0x74, 0x09, 0x9b, 0x6d, 0xe9, 0x46, 0x21,
+	0xce, 0xdc, 0x73, 0xa4, 0xa9, 0x37, 0x43, 0x3e, 0xd1, 0x14, 0x67, 0xfd, 0xa6, 0x64, 0x4c, 0x5e,
+	0x2f, 0xb8, 0x3f, 0x69, 0xcc, 0xd2, 0xf5, 0xa9, 0x17, 0x2c, 0x1f, 0x5a, 0x6c, 0x2f, 0x9b, 0xef,
+	0x9a, 0xa6, 0x67, 0x6c, 0xd1, 0x73, 0xae, 0xe9, 0x19, 0x5b, 0xf8, 0x18, 0xaa, 0xdc, 0xcb, 0x64,
+	0xd1, 0x98, 0x23, 0x9e, 0xc2, 0x49, 0x3e, 0x83, 0x79, 0xed, 0xf6, 0xfd, 0xe1, 0x79, 0xd0, 0x53,
+	0x72, 0x29, 0xff, 0x98, 0xd4, 0x5a, 0x51, 0x50, 0xc6, 0x5e, 0xc5, 0xfa, 0x17, 0x6c, 0x63, 0x72,
+	0x98, 0x4c, 0xda, 0x84, 0x86, 0x7e, 0xb3, 0xff, 0x82, 0x7a, 0x57, 0x35, 0x94, 0xfe, 0x76, 0xd1,
+	0x43, 0x8b, 0x6d, 0x13, 0xe3, 0x31, 0x98, 0x30, 0xca, 0x6a, 0x1c, 0xe6, 0x23, 0x31, 0x6a, 0x21,
+	0x8b, 0x9e, 0x1c, 0xba, 0x67, 0x3d, 0xb4, 0xc8, 0x2e, 0xb4, 0xb3, 0xef, 0x8d, 0x28, 0x99, 0x5f,
+	0xf4, 0xec, 0xc9, 0x5a, 0x06, 0x69, 0xbe, 0x52, 0xf2, 0x77, 0x2d, 0x68, 0x1a, 0xf7, 0xf8, 0x8d,
+	0xf4, 0xea, 0xcc, 0x38, 0x3b, 0x3a, 0x4e, 0x1f, 0xa8, 0xed, 0xe0, 0x24, 0xee, 0xae, 0x7f, 0x68,
+	0x2c, 0xd2, 0x97, 0x86, 0xfb, 0xf1, 0x7e, 0xf6, 0x47, 0x9c, 0xbe, 0xca, 0x12, 0xe8, 0x0f, 0x82,
+	0x7d, 0xf5, 0xd0, 0x22, 0xbf, 0x63, 0x41, 0xcb, 0x4c, 0x29, 0x50, 0x93, 0x57, 0x98, 0xbc, 0xa0,
+	0x58, 0x69, 0x4a, 0x1e, 0xc2, 0x67, 0xd8, 0xcb, 0xa3, 0x75, 0xc7, 0xe8, 0xa5, 0x78, 0x91, 0xf7,
+	0x8f, 0xd7, 0x5b, 0xf2, 0x1d, 0xfe, 0x7b, 0x70, 0x32, 0x3f, 0x89, 0x68, 0xca, 0x46, 0x96, 0xfd,
+	0xf4, 0xdf, 0x26, 0xc3, 0x25, 0xfd, 0x31, 0xff, 0xad, 0x27, 0xf1, 0x2d, 0x72, 0xf1, 0x55, 0xbf,
+	0xb7, 0xef, 0xe0, 0x98, 0x6e, 0xd9, 0xd7, 0x8d, 0x31, 0x65, 0xd5, 0xb8, 0x0d, 0xde, 0x3b, 0xf1,
+	0xb3, 0x62, 0xa9, 0x1e, 0x92, 0xfb, 0xa9, 0xb1, 0xe9, 0x9d, 0x1c, 0xf1, 0x4e, 0x0a, 0x72, 0x63,
+	0xab, 0x5d, 0xb1, 0x1a, 0x7b, 0x1d, 0xfb, 0x7a, 0xc7, 0x7e, 0x7d, 0x6a, 0x5f, 0x1f, 0x60, 0xe0,
+	0x9b, 0xf5, 0xf8, 0x00, 0x20, 0xcd, 0x25, 0x24, 0x99, 0x5c, 0x36, 0x25, 0x80, 0xf2, 0xe9, 0x86,
+	0xe6, 0x7e, 0x96, 0x29, 0x6f, 0xac, 0xc6, 0x1f, 0x72, 0x71, 0xba, 0x23, 0xb3, 0xe0, 0x74, 0x5d,
+	0xd6, 0x4c, 0xfa, 0x33, 0x74, 0xd9, 0x6c, 0xfd, 0x86, 0x30, 0x55, 0x29, 0x75, 0xcf, 0x60, 0x6e,
+	0x37, 0x0c, 0x5f, 0x4c, 0xc6, 0x2a, 0x0b, 0xda, 0xcc, 0xb5, 0xda, 0xf6, 0xe2, 0xd3, 0xb5, 0xcc,
+	0x28, 0xec, 0xdb, 0x58, 0xd5, 0x1a, 0xe9, 0x68, 0x55, 0x3d, 0xf8, 0x32, 0xcd, 0x55, 0xfc, 0x8a,
+	0x6c, 0xc1, 0xa2, 0x43, 0x4f, 0x22, 0x1a, 0x9f, 0xca, 0x3c, 0x50, 0xcc, 0x3b, 0x2d, 0xaa, 0x7c,
+	0xfa, 0x94, 0x10, 0x0f, 0x16, 0x94, 0xa4, 0x57, 0xc3, 0x5f, 0x33, 0x3b, 0x63, 0xc8, 0xf7, 0x6c,
+	0x47, 0x0d, 0xb3, 0x4a, 0x8e, 0xf9, 0x41, 0x2c, 0xeb, 0x44, 0x39, 0xd7, 0xdc, 0xa2, 0xbd, 0xb0,
+	0x4f, 0x45, 0x7a, 0xc8, 0x62, 0xda, 0x43, 0x95, 0x57, 0xb2, 0x36, 0x67, 0x00, 0xcd, 0xd3, 0x6f,
+	0xec, 0x9d, 0x47, 0xf4, 0xf3, 0x07, 0x5f, 0x8a, 0xc4, 0x93, 0xaf, 0xe4, 0xe9, 0x27, 0x33, 0xce,
+	0x8c, 0xd3, 0x2f, 0x93, 0xa2, 0x66, 0x9c, 0x7e, 0xb9, 0x14, 0x35, 0x63, 0xc1, 0x64, 0xc6, 0x1b,
+	0x19, 0xc2, 0x42, 0x2e, 0xab, 0x4d, 0x1d, 0x7c, 0xd3, 0x72, 0xe1, 0xd6, 0x6e, 0x4f, 0x27, 0x30,
+	0x5b, 0x5b, 0x37, 0x5b, 0xfb, 0x08, 0x48, 0x3e, 0x4d, 0x8e, 0xc8, 0xda, 0xa6, 0x66, 0xd0, 0xad,
+	0x2d, 0x9a, 0x0b, 0xcd, 0x3f, 0xdb, 0x05, 0x92, 0xcf, 0x0d, 0x23, 0x45, 0xa4, 0x6b, 0x6f, 0x18,
+	0xfa, 0x7e, 0x61, 0x2e, 0xd9, 0x21, 0xcc, 0x6d, 0x51, 0xbe, 0x8e, 0xfc, 0x36, 0x5a, 0xe6, 0x01,
+	0x06, 0xfd, 0xae, 0x5b, 0xf6, 0x04, 0x45, 0x9c, 0xa9, 0x6c, 0xf2, 0x27, 0x5f, 0x7f, 0x08, 0x8d,
+	0xa7, 0x34, 0x91, 0xd7, 0xcf, 0x94, 0x49, 0x96, 0xb9, 0x8f, 0xb6, 0x56, 0x70, 0x7b, 0xcd, 0xdc,
+	0x14, 0x58, 0xdb, 0x03, 0xda, 0x1f, 0x50, 0x2e, 0x7d, 0x5d, 0xbf, 0xff, 0x15, 0xf9, 0x0d, 0xac,
+	0x5c, 0xdd, 0x92, 0x5d, 0xd1, 0x6e, 0x22, 0xe9, 0x95, 0xcf, 0x67, 0xe0, 0x45, 0x35, 0x07, 0x61,
+	0x9f, 0x6a, 0xa6, 0x41, 0x00, 0x0d, 0xed, 0x72, 0xb7, 0x92, 0x10, 0xf9, 0x57, 0x00, 0x94, 0x84,
+	0x28, 0xb8, 0x0b, 0x6e, 0xdf, 0xc3, 0x76, 0x6c, 0x72, 0x3b, 0x6d, 0x87, 0xdf, 0xff, 0x4e, 0x5b,
+	0x7a, 0xf0, 0xa5, 0x37, 0x4a, 0xbe, 0x22, 0xcf, 0xf1, 0xe5, 0x65, 0xfd, 0x8a, 0x5d, 0x6a, 0x63,
+	0x66, 0x6f, 0xe3, 0xa9, 0xc9, 0xd2, 0x50, 0xa6, 0xdd, 0xc9, 0x9b, 0x42, 0xed, 0xfc, 0xdb, 0x00,
+	0x87, 0x49, 0x38, 0xde, 0xf2, 0xe8, 0x28, 0x0c, 0xd2, 0xc3, 0x24, 0xbd, 0x1a, 0x96, 0x0a, 0x68,
+	0xed, 0x7e, 0x18, 0x79, 0xae, 0x19, 0xe5, 0xc6, 0x0d, 0x45, 0xc9, 0xa9, 0x53, 0x6f, 0x8f, 0xa9,
+	0x09, 0x29, 0xb8, 0x41, 0xf6, 0xd0, 0x22, 0x1b, 0x00, 0x69, 0xe6, 0x9e, 0x32, 0xb1, 0x73, 0x49,
+	0x81, 0x4a, 0x88, 0x15, 0xa4, 0xf9, 0x1d, 0xc0, 0x7c, 0x26, 0xf3, 0x4d, 0x69, 0xdf, 0xc5, 0xc9,
+	0x7d, 0x4a, 0xfb, 0x9e, 0x96, 0x30, 0x77, 0x00, 0xf5, 0x34, 0x79, 0x6a, 0x35, 0x4d, 0xd0, 0x30,
+	0x52, 0xad, 0x94, 0xd2, 0x93, 0x4b, 0x69, 0xb2, 0xdb, 0x38, 0xf9, 0x40, 0x6a, 0x6c, 0xf2, 0x31,
+	0x4f, 0xc9, 0x87, 0x45, 0x3e, 0x64, 0xa5, 0x61, 0xe2, 0x45, 0x29, 0x39, 0x37, 0x05, 0x69, 0x45,
+	0x4a, 0x74, 0x15, 0xe6, 0xc3, 0x18, 0x7e, 0x41, 0xc6, 0xff, 0xfc, 0x92, 0x16, 0x3b, 0xcd, 0x3e,
+	0x84, 0x39, 0x23, 0xf3, 0x84, 0xe8, 0x32, 0x30, 0x9b, 0xa7, 0xa2, 0xec, 0xfe, 0xe2, 0x64, 0x95,
+	0xef, 0x43, 0xcb, 0xcc, 0x41, 0x21, 0xd9, 0x74, 0x15, 0xa5, 0x59, 0x15, 0xe7, 0xaa, 0x90, 0x11,
+	0x2c, 0xe4, 0x32, 0x26, 0x94, 0x34, 0x9d, 0x96, 0xc4, 0xa2, 0xa4, 0xe9, 0xd4, 0x64, 0x0b, 0x7b,
+	0x19, 0x27, 0x60, 0xde, 0x06, 0xf4, 0x7c, 0x60, 0x8e, 0x00, 0x1b, 0xfc, 0x16, 0x34, 0xb4, 0x84,
+	0x81, 0x54, 0x0f, 0xc9, 0xe5, 0x48, 0xa4, 0x6e, 0x95, 0x82, 0xfc, 0x82, 0x0f, 0xb8, 0x91, 0x8d,
+	0xb1, 0x50, 0xc3, 0xc8, 0xd6, 0xa3, 0xf0, 0x86, 0x91, 0x6d, 0x86, 0x4d, 0xa9, 0x4c, 0xdd, 0xc9,
+	0xc6, 0x1a, 0x95, 0xad, 0x79, 0x61, 0xa4, 0x74, 0xed, 0xd7, 0x2e, 0xa1, 0x4a, 0x19, 0x3f, 0x13,
+	0x38, 0x54, 0x8c, 0x5f, 0x1c, 0xc8, 0x54, 0x8c, 0x3f, 0x2d, 0xde, 0xf8, 0x01, 0xd4, 0x55, 0x60,
+	0x50, 0x0d, 0x3c, 0x1b, 0x58, 0x54, 0x03, 0xcf, 0xc5, 0x10, 0xc9, 0x27, 0xb0, 0xec, 0x88, 0x78,
+	0x97, 0x11, 0x3f, 0x53, 0xda, 0x79, 0x61, 0x54, 0x4d, 0xb1, 0x7a, 0x51, 0xf8, 0x91, 0xa9, 0x98,
+	0x8f, 0x7e, 0xcb, 0x92, 0x91, 0xef, 0x7d, 0x68, 0x99, 0x41, 0x1d, 0x55, 0x75, 0x61, 0x10, 0x48,
+	0xb1, 0x67, 0x71, 0x24, 0x88, 0x3b, 0x08, 0x65, 0x38, 0x87, 0x68, 0x7e, 0x0e, 0xa3, 0x92, 0xd5,
+	0x1c, 0x9c, 0x7f, 0xfe, 0xf8, 0xee, 0x67, 0xbf, 0x36, 0xf0, 0x93, 0xd3, 0xc9, 0xf1, 0xfd, 0x5e,
+	0x38, 0x7a, 0x30, 0x94, 0x9e, 0x6b, 0x71, 0x5b, 0xf8, 0xc1, 0x30, 0xe8, 0x3f, 0xc0, 0x2f, 0x8f,
+	0x67, 0xf0, 0x27, 0xf9, 0xbf, 0xf9, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x26, 0x1c, 0xf0, 0xdd,
+	0xc4, 0x7f, 0x00, 0x00,
 }
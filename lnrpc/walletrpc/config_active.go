@@ -5,7 +5,9 @@ package walletrpc
 import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 // Config is the primary configuration struct for the WalletKit RPC server. It
@@ -29,13 +31,25 @@ type Config struct {
 
 	// FeeEstimator is an instance of the primary fee estimator instance
 	// the WalletKit will use to respond to fee estimation requests.
-	FeeEstimator lnwallet.FeeEstimator
+	FeeEstimator chainfee.Estimator
 
 	// Wallet is the primary wallet that the WalletKit will use to proxy
 	// any relevant requests to.
 	Wallet lnwallet.WalletController
 
+	// OutputLeaser is used to lease and release the unspent outputs of the
+	// wallet on behalf of clients.
+	OutputLeaser lnwallet.OutputLeaser
+
 	// KeyRing is an interface that the WalletKit will use to derive any
 	// keys due to incoming client requests.
 	KeyRing keychain.KeyRing
+
+	// Sweeper is the central batching engine of lnd. It is used to bump
+	// the fee of unconfirmed outputs of the wallet.
+	Sweeper *sweep.UtxoSweeper
+
+	// Chain is an interface that the WalletKit will use to determine the
+	// current height of the chain.
+	Chain lnwallet.BlockChainIO
 }
@@ -6,6 +6,7 @@ package walletrpc // import "github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import lnrpc "github.com/lightningnetwork/lnd/lnrpc"
 import signrpc "github.com/lightningnetwork/lnd/lnrpc/signrpc"
 
 import (
@@ -43,7 +44,7 @@ func (m *KeyReq) Reset()         { *m = KeyReq{} }
 func (m *KeyReq) String() string { return proto.CompactTextString(m) }
 func (*KeyReq) ProtoMessage()    {}
 func (*KeyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{0}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{0}
 }
 func (m *KeyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_KeyReq.Unmarshal(m, b)
@@ -87,7 +88,7 @@ func (m *AddrRequest) Reset()         { *m = AddrRequest{} }
 func (m *AddrRequest) String() string { return proto.CompactTextString(m) }
 func (*AddrRequest) ProtoMessage()    {}
 func (*AddrRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{1}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{1}
 }
 func (m *AddrRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddrRequest.Unmarshal(m, b)
@@ -120,7 +121,7 @@ func (m *AddrResponse) Reset()         { *m = AddrResponse{} }
 func (m *AddrResponse) String() string { return proto.CompactTextString(m) }
 func (*AddrResponse) ProtoMessage()    {}
 func (*AddrResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{2}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{2}
 }
 func (m *AddrResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AddrResponse.Unmarshal(m, b)
@@ -160,7 +161,7 @@ func (m *Transaction) Reset()         { *m = Transaction{} }
 func (m *Transaction) String() string { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()    {}
 func (*Transaction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{3}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{3}
 }
 func (m *Transaction) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Transaction.Unmarshal(m, b)
@@ -204,7 +205,7 @@ func (m *PublishResponse) Reset()         { *m = PublishResponse{} }
 func (m *PublishResponse) String() string { return proto.CompactTextString(m) }
 func (*PublishResponse) ProtoMessage()    {}
 func (*PublishResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{4}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{4}
 }
 func (m *PublishResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PublishResponse.Unmarshal(m, b)
@@ -248,7 +249,7 @@ func (m *SendOutputsRequest) Reset()         { *m = SendOutputsRequest{} }
 func (m *SendOutputsRequest) String() string { return proto.CompactTextString(m) }
 func (*SendOutputsRequest) ProtoMessage()    {}
 func (*SendOutputsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{5}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{5}
 }
 func (m *SendOutputsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendOutputsRequest.Unmarshal(m, b)
@@ -295,7 +296,7 @@ func (m *SendOutputsResponse) Reset()         { *m = SendOutputsResponse{} }
 func (m *SendOutputsResponse) String() string { return proto.CompactTextString(m) }
 func (*SendOutputsResponse) ProtoMessage()    {}
 func (*SendOutputsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{6}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{6}
 }
 func (m *SendOutputsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SendOutputsResponse.Unmarshal(m, b)
@@ -335,7 +336,7 @@ func (m *EstimateFeeRequest) Reset()         { *m = EstimateFeeRequest{} }
 func (m *EstimateFeeRequest) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeRequest) ProtoMessage()    {}
 func (*EstimateFeeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{7}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{7}
 }
 func (m *EstimateFeeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeRequest.Unmarshal(m, b)
@@ -376,7 +377,7 @@ func (m *EstimateFeeResponse) Reset()         { *m = EstimateFeeResponse{} }
 func (m *EstimateFeeResponse) String() string { return proto.CompactTextString(m) }
 func (*EstimateFeeResponse) ProtoMessage()    {}
 func (*EstimateFeeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_walletkit_ca4e27c2068154e3, []int{8}
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{8}
 }
 func (m *EstimateFeeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EstimateFeeResponse.Unmarshal(m, b)
@@ -403,6 +404,257 @@ func (m *EstimateFeeResponse) GetSatPerKw() int64 {
 	return 0
 }
 
+type LeaseOutputRequest struct {
+	// *
+	// An ID of 32 random bytes that must be unique for each distinct application
+	// using this RPC which will be used to bound the output lease to.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// *
+	// The identifying outpoint of the output being leased.
+	Outpoint             *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LeaseOutputRequest) Reset()         { *m = LeaseOutputRequest{} }
+func (m *LeaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputRequest) ProtoMessage()    {}
+func (*LeaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{9}
+}
+func (m *LeaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputRequest.Unmarshal(m, b)
+}
+func (m *LeaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (dst *LeaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputRequest.Merge(dst, src)
+}
+func (m *LeaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputRequest.Size(m)
+}
+func (m *LeaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputRequest proto.InternalMessageInfo
+
+func (m *LeaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *LeaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+type LeaseOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaseOutputResponse) Reset()         { *m = LeaseOutputResponse{} }
+func (m *LeaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*LeaseOutputResponse) ProtoMessage()    {}
+func (*LeaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{10}
+}
+func (m *LeaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaseOutputResponse.Unmarshal(m, b)
+}
+func (m *LeaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (dst *LeaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseOutputResponse.Merge(dst, src)
+}
+func (m *LeaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_LeaseOutputResponse.Size(m)
+}
+func (m *LeaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaseOutputResponse proto.InternalMessageInfo
+
+type ReleaseOutputRequest struct {
+	// *
+	// The unique ID that was used to lock the output.
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// *
+	// The identifying outpoint of the output being released.
+	Outpoint             *lnrpc.OutPoint `protobuf:"bytes,2,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ReleaseOutputRequest) Reset()         { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()    {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{11}
+}
+func (m *ReleaseOutputRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputRequest.Unmarshal(m, b)
+}
+func (m *ReleaseOutputRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReleaseOutputRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputRequest.Merge(dst, src)
+}
+func (m *ReleaseOutputRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputRequest.Size(m)
+}
+func (m *ReleaseOutputRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputRequest proto.InternalMessageInfo
+
+func (m *ReleaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReleaseOutputRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+type ReleaseOutputResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseOutputResponse) Reset()         { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()    {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{12}
+}
+func (m *ReleaseOutputResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseOutputResponse.Unmarshal(m, b)
+}
+func (m *ReleaseOutputResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseOutputResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReleaseOutputResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseOutputResponse.Merge(dst, src)
+}
+func (m *ReleaseOutputResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseOutputResponse.Size(m)
+}
+func (m *ReleaseOutputResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseOutputResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseOutputResponse proto.InternalMessageInfo
+
+type BumpFeeRequest struct {
+	// / The unconfirmed output whose fee we're attempting to bump.
+	Outpoint *lnrpc.OutPoint `protobuf:"bytes,1,opt,name=outpoint,proto3" json:"outpoint,omitempty"`
+	// *
+	// The target number of blocks that the output should be spent within.
+	TargetConf uint32 `protobuf:"varint,2,opt,name=target_conf,json=targetConf,proto3" json:"target_conf,omitempty"`
+	// *
+	// The fee rate, expressed in sat/vbyte, that should be used to spend the
+	// output with.
+	SatPerVbyte          uint64   `protobuf:"varint,3,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BumpFeeRequest) Reset()         { *m = BumpFeeRequest{} }
+func (m *BumpFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*BumpFeeRequest) ProtoMessage()    {}
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{13}
+}
+func (m *BumpFeeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpFeeRequest.Unmarshal(m, b)
+}
+func (m *BumpFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpFeeRequest.Marshal(b, m, deterministic)
+}
+func (dst *BumpFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpFeeRequest.Merge(dst, src)
+}
+func (m *BumpFeeRequest) XXX_Size() int {
+	return xxx_messageInfo_BumpFeeRequest.Size(m)
+}
+func (m *BumpFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpFeeRequest proto.InternalMessageInfo
+
+func (m *BumpFeeRequest) GetOutpoint() *lnrpc.OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *BumpFeeRequest) GetTargetConf() uint32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *BumpFeeRequest) GetSatPerVbyte() uint64 {
+	if m != nil {
+		return m.SatPerVbyte
+	}
+	return 0
+}
+
+type BumpFeeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BumpFeeResponse) Reset()         { *m = BumpFeeResponse{} }
+func (m *BumpFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*BumpFeeResponse) ProtoMessage()    {}
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_walletkit_3cbffacc9c8fafb3, []int{14}
+}
+func (m *BumpFeeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BumpFeeResponse.Unmarshal(m, b)
+}
+func (m *BumpFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BumpFeeResponse.Marshal(b, m, deterministic)
+}
+func (dst *BumpFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BumpFeeResponse.Merge(dst, src)
+}
+func (m *BumpFeeResponse) XXX_Size() int {
+	return xxx_messageInfo_BumpFeeResponse.Size(m)
+}
+func (m *BumpFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BumpFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BumpFeeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*KeyReq)(nil), "walletrpc.KeyReq")
 	proto.RegisterType((*AddrRequest)(nil), "walletrpc.AddrRequest")
@@ -413,6 +665,12 @@ func init() {
 	proto.RegisterType((*SendOutputsResponse)(nil), "walletrpc.SendOutputsResponse")
 	proto.RegisterType((*EstimateFeeRequest)(nil), "walletrpc.EstimateFeeRequest")
 	proto.RegisterType((*EstimateFeeResponse)(nil), "walletrpc.EstimateFeeResponse")
+	proto.RegisterType((*LeaseOutputRequest)(nil), "walletrpc.LeaseOutputRequest")
+	proto.RegisterType((*LeaseOutputResponse)(nil), "walletrpc.LeaseOutputResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "walletrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "walletrpc.ReleaseOutputResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "walletrpc.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "walletrpc.BumpFeeResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -455,6 +713,25 @@ type WalletKitClient interface {
 	// determine the fee (in sat/kw) to attach to a transaction in order to
 	// achieve the confirmation target.
 	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// *
+	// LeaseOutput locks an output to the given ID, preventing it from being
+	// available for coin selection when funding channels. Only the holder of the
+	// ID is able to release the output again. Leases are only kept in memory, so
+	// all leased outputs are released when lnd restarts.
+	LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error)
+	// *
+	// ReleaseOutput unlocks an output, allowing it to be available for coin
+	// selection if it remains unspent. The ID should match the one used to
+	// originally lock the output.
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
+	// *
+	// BumpFee bumps the fee of an unconfirmed output under control of the
+	// wallet. If the output is already being swept by the sweeper, then the fee
+	// of its sweep transaction is bumped through RBF. Otherwise, the output is
+	// handed to the sweeper, which spends it in a child transaction at the
+	// requested fee rate, raising the effective fee rate of its parent (CPFP).
+	// This allows stuck funding and sweep transactions to be confirmed.
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
 }
 
 type walletKitClient struct {
@@ -519,6 +796,33 @@ func (c *walletKitClient) EstimateFee(ctx context.Context, in *EstimateFeeReques
 	return out, nil
 }
 
+func (c *walletKitClient) LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error) {
+	out := new(LeaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/LeaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/ReleaseOutput", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletKitClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := c.cc.Invoke(ctx, "/walletrpc.WalletKit/BumpFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WalletKitServer is the server API for WalletKit service.
 type WalletKitServer interface {
 	// *
@@ -549,6 +853,25 @@ type WalletKitServer interface {
 	// determine the fee (in sat/kw) to attach to a transaction in order to
 	// achieve the confirmation target.
 	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// *
+	// LeaseOutput locks an output to the given ID, preventing it from being
+	// available for coin selection when funding channels. Only the holder of the
+	// ID is able to release the output again. Leases are only kept in memory, so
+	// all leased outputs are released when lnd restarts.
+	LeaseOutput(context.Context, *LeaseOutputRequest) (*LeaseOutputResponse, error)
+	// *
+	// ReleaseOutput unlocks an output, allowing it to be available for coin
+	// selection if it remains unspent. The ID should match the one used to
+	// originally lock the output.
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
+	// *
+	// BumpFee bumps the fee of an unconfirmed output under control of the
+	// wallet. If the output is already being swept by the sweeper, then the fee
+	// of its sweep transaction is bumped through RBF. Otherwise, the output is
+	// handed to the sweeper, which spends it in a child transaction at the
+	// requested fee rate, raising the effective fee rate of its parent (CPFP).
+	// This allows stuck funding and sweep transactions to be confirmed.
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
 }
 
 func RegisterWalletKitServer(s *grpc.Server, srv WalletKitServer) {
@@ -663,6 +986,60 @@ func _WalletKit_EstimateFee_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletKit_LeaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).LeaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/LeaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).LeaseOutput(ctx, req.(*LeaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/ReleaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletKit_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletKitServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/walletrpc.WalletKit/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletKitServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletKit_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "walletrpc.WalletKit",
 	HandlerType: (*WalletKitServer)(nil),
@@ -691,48 +1068,71 @@ var _WalletKit_serviceDesc = grpc.ServiceDesc{
 			MethodName: "EstimateFee",
 			Handler:    _WalletKit_EstimateFee_Handler,
 		},
+		{
+			MethodName: "LeaseOutput",
+			Handler:    _WalletKit_LeaseOutput_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _WalletKit_ReleaseOutput_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _WalletKit_BumpFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "walletrpc/walletkit.proto",
 }
 
 func init() {
-	proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_walletkit_ca4e27c2068154e3)
-}
-
-var fileDescriptor_walletkit_ca4e27c2068154e3 = []byte{
-	// 524 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x5d, 0x6f, 0xd3, 0x30,
-	0x14, 0xd5, 0x56, 0x56, 0xd6, 0xdb, 0x76, 0x80, 0xcb, 0x46, 0x89, 0x18, 0x4c, 0x81, 0x87, 0x3e,
-	0xa0, 0x54, 0x6c, 0x02, 0x21, 0x78, 0x02, 0x6d, 0xd3, 0xa4, 0x4e, 0xac, 0x84, 0x4a, 0x48, 0x08,
-	0x29, 0x72, 0x93, 0xbb, 0xd4, 0x6a, 0x6a, 0x67, 0xce, 0x0d, 0x49, 0xfe, 0x0f, 0x3f, 0x14, 0xe5,
-	0xa3, 0x5d, 0x4a, 0x19, 0x4f, 0x71, 0x8e, 0xcf, 0x3d, 0xbe, 0xd7, 0xe7, 0x18, 0x9e, 0x26, 0x3c,
-	0x08, 0x90, 0x74, 0xe8, 0x0e, 0xcb, 0xd5, 0x5c, 0x90, 0x15, 0x6a, 0x45, 0x8a, 0xb5, 0x56, 0x5b,
-	0xc6, 0xe3, 0x48, 0xf8, 0x32, 0xe7, 0xe4, 0x5f, 0xd4, 0x25, 0xc1, 0xfc, 0x0a, 0xcd, 0x11, 0x66,
-	0x36, 0xde, 0xb0, 0x01, 0x3c, 0x9c, 0x63, 0xe6, 0x5c, 0x0b, 0xe9, 0xa3, 0x76, 0x42, 0x2d, 0x24,
-	0xf5, 0xb7, 0x8e, 0xb6, 0x06, 0x3b, 0xf6, 0xde, 0x1c, 0xb3, 0xf3, 0x02, 0x1e, 0xe7, 0x28, 0x3b,
-	0x04, 0x28, 0x98, 0x7c, 0x21, 0x82, 0xac, 0xbf, 0x5d, 0x70, 0x5a, 0x39, 0xa7, 0x00, 0xcc, 0x2e,
-	0xb4, 0x3f, 0x79, 0x9e, 0xb6, 0xf1, 0x26, 0xc6, 0x88, 0x4c, 0x13, 0x3a, 0xe5, 0x6f, 0x14, 0x2a,
-	0x19, 0x21, 0x63, 0x70, 0x8f, 0x7b, 0x9e, 0x2e, 0xb4, 0x5b, 0x76, 0xb1, 0x36, 0x5f, 0x41, 0x7b,
-	0xa2, 0xb9, 0x8c, 0xb8, 0x4b, 0x42, 0x49, 0xb6, 0x0f, 0x4d, 0x4a, 0x9d, 0x19, 0xa6, 0x05, 0xa9,
-	0x63, 0xef, 0x50, 0x7a, 0x81, 0xa9, 0xf9, 0x0e, 0x1e, 0x8c, 0xe3, 0x69, 0x20, 0xa2, 0xd9, 0x4a,
-	0xec, 0x25, 0x74, 0xc3, 0x12, 0x72, 0x50, 0x6b, 0xb5, 0x54, 0xed, 0x54, 0xe0, 0x59, 0x8e, 0x99,
-	0x3f, 0x81, 0x7d, 0x43, 0xe9, 0x5d, 0xc5, 0x14, 0xc6, 0x14, 0x55, 0x7d, 0xb1, 0x67, 0x00, 0x11,
-	0x27, 0x27, 0x44, 0xed, 0xcc, 0x93, 0xa2, 0xae, 0x61, 0xef, 0x46, 0x9c, 0xc6, 0xa8, 0x47, 0x09,
-	0x1b, 0xc0, 0x7d, 0x55, 0xf2, 0xfb, 0xdb, 0x47, 0x8d, 0x41, 0xfb, 0x78, 0xcf, 0xaa, 0xee, 0xcf,
-	0x9a, 0xa4, 0x57, 0x31, 0xd9, 0xcb, 0x6d, 0xf3, 0x35, 0xf4, 0xd6, 0xd4, 0xab, 0xce, 0xf6, 0xa1,
-	0xa9, 0x79, 0xe2, 0xd0, 0x6a, 0x06, 0xcd, 0x93, 0x49, 0x6a, 0xbe, 0x05, 0x76, 0x16, 0x91, 0x58,
-	0x70, 0xc2, 0x73, 0xc4, 0x65, 0x2f, 0x2f, 0xa0, 0xed, 0x2a, 0x79, 0xed, 0x10, 0xd7, 0x3e, 0x2e,
-	0xaf, 0x1d, 0x72, 0x68, 0x52, 0x20, 0xe6, 0x09, 0xf4, 0xd6, 0xca, 0xaa, 0x43, 0xfe, 0x3b, 0xc3,
-	0xf1, 0xef, 0x06, 0xb4, 0xbe, 0x17, 0xfe, 0x8f, 0x04, 0xb1, 0x0f, 0xd0, 0x3d, 0x45, 0x2d, 0x7e,
-	0xe1, 0x17, 0x4c, 0x69, 0x84, 0x19, 0x7b, 0x64, 0xad, 0xc2, 0x61, 0x95, 0x19, 0x30, 0x0e, 0x56,
-	0x43, 0x8e, 0x30, 0x3b, 0xc5, 0xc8, 0xd5, 0x22, 0x24, 0xa5, 0xd9, 0x7b, 0x68, 0x95, 0xb5, 0x79,
-	0x5d, 0xaf, 0x4e, 0xba, 0x54, 0x2e, 0x27, 0xa5, 0xef, 0xac, 0xfc, 0x08, 0xbb, 0xf9, 0x79, 0x79,
-	0x02, 0xd8, 0x41, 0xed, 0xc0, 0x5a, 0x42, 0x8c, 0x27, 0x1b, 0x78, 0x35, 0xde, 0x05, 0xb0, 0xca,
-	0xf0, 0x7a, 0x3a, 0xea, 0x32, 0x35, 0xdc, 0x30, 0x6a, 0xf8, 0xdf, 0x39, 0xb9, 0x84, 0x76, 0xcd,
-	0x24, 0x76, 0x58, 0xa3, 0x6e, 0x46, 0xc3, 0x78, 0x7e, 0xd7, 0xf6, 0xad, 0x5a, 0xcd, 0x8d, 0x35,
-	0xb5, 0x4d, 0x73, 0xd7, 0xd4, 0xfe, 0x61, 0xe2, 0xe7, 0x37, 0x3f, 0x86, 0xbe, 0xa0, 0x59, 0x3c,
-	0xb5, 0x5c, 0xb5, 0x18, 0x06, 0xc2, 0x9f, 0x91, 0x14, 0xd2, 0x97, 0x48, 0x89, 0xd2, 0xf3, 0x61,
-	0x20, 0xbd, 0x61, 0x20, 0x6f, 0x1f, 0xb7, 0x0e, 0xdd, 0x69, 0xb3, 0x78, 0xbc, 0x27, 0x7f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x2d, 0xbb, 0xcd, 0x97, 0xfa, 0x03, 0x00, 0x00,
+	proto.RegisterFile("walletrpc/walletkit.proto", fileDescriptor_walletkit_3cbffacc9c8fafb3)
+}
+
+var fileDescriptor_walletkit_3cbffacc9c8fafb3 = []byte{
+	// 702 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x51, 0x4f, 0xda, 0x50,
+	0x14, 0x0e, 0xa8, 0x28, 0xa7, 0x82, 0xf3, 0x22, 0x8a, 0xcd, 0x9c, 0xe4, 0x6e, 0x0f, 0x24, 0x5b,
+	0x20, 0xd3, 0x6c, 0x59, 0xb6, 0x97, 0xcd, 0xa9, 0x31, 0x81, 0x4c, 0xac, 0x64, 0x4b, 0x96, 0x25,
+	0x4d, 0xa1, 0x47, 0xb8, 0xa1, 0xb4, 0xf5, 0xf6, 0x56, 0xe0, 0x75, 0x4f, 0xfb, 0xd9, 0xcb, 0x6d,
+	0x4b, 0x77, 0x2b, 0xba, 0xbd, 0xec, 0x89, 0xf2, 0xdd, 0xef, 0x7c, 0xe7, 0xdc, 0xd3, 0xef, 0x4b,
+	0x61, 0x7f, 0x6a, 0x39, 0x0e, 0x0a, 0xee, 0x0f, 0x5a, 0xf1, 0xd3, 0x98, 0x89, 0xa6, 0xcf, 0x3d,
+	0xe1, 0x91, 0x62, 0x7a, 0xa4, 0x17, 0xb9, 0x3f, 0x88, 0x51, 0x7d, 0x27, 0x60, 0x43, 0x57, 0xd2,
+	0xe5, 0x2f, 0xf2, 0x18, 0xa5, 0x57, 0x50, 0x68, 0xe3, 0xdc, 0xc0, 0x5b, 0xd2, 0x80, 0x27, 0x63,
+	0x9c, 0x9b, 0x37, 0xcc, 0x1d, 0x22, 0x37, 0x7d, 0xce, 0x5c, 0x51, 0xcb, 0xd5, 0x73, 0x8d, 0x35,
+	0xa3, 0x3c, 0xc6, 0xf9, 0x79, 0x04, 0x77, 0x25, 0x4a, 0x0e, 0x00, 0x22, 0xa6, 0x35, 0x61, 0xce,
+	0xbc, 0x96, 0x8f, 0x38, 0x45, 0xc9, 0x89, 0x00, 0x5a, 0x02, 0xed, 0x93, 0x6d, 0x73, 0x03, 0x6f,
+	0x43, 0x0c, 0x04, 0xa5, 0xb0, 0x19, 0xff, 0x0d, 0x7c, 0xcf, 0x0d, 0x90, 0x10, 0x58, 0xb5, 0x6c,
+	0x9b, 0x47, 0xda, 0x45, 0x23, 0x7a, 0xa6, 0x2f, 0x40, 0xeb, 0x71, 0xcb, 0x0d, 0xac, 0x81, 0x60,
+	0x9e, 0x4b, 0xaa, 0x50, 0x10, 0x33, 0x73, 0x84, 0xb3, 0x88, 0xb4, 0x69, 0xac, 0x89, 0xd9, 0x05,
+	0xce, 0xe8, 0x5b, 0xd8, 0xea, 0x86, 0x7d, 0x87, 0x05, 0xa3, 0x54, 0xec, 0x39, 0x94, 0xfc, 0x18,
+	0x32, 0x91, 0x73, 0x6f, 0xa1, 0xba, 0x99, 0x80, 0x67, 0x12, 0xa3, 0x3f, 0x80, 0x5c, 0xa3, 0x6b,
+	0x5f, 0x86, 0xc2, 0x0f, 0x45, 0x90, 0xcc, 0x45, 0x9e, 0x02, 0x04, 0x96, 0x30, 0x7d, 0xe4, 0xe6,
+	0x78, 0x1a, 0xd5, 0xad, 0x18, 0x1b, 0x81, 0x25, 0xba, 0xc8, 0xdb, 0x53, 0xd2, 0x80, 0x75, 0x2f,
+	0xe6, 0xd7, 0xf2, 0xf5, 0x95, 0x86, 0x76, 0x54, 0x6e, 0x26, 0xfb, 0x6b, 0xf6, 0x66, 0x97, 0xa1,
+	0x30, 0x16, 0xc7, 0xf4, 0x15, 0x54, 0x32, 0xea, 0xc9, 0x64, 0x55, 0x28, 0x70, 0x6b, 0x6a, 0x8a,
+	0xf4, 0x0e, 0xdc, 0x9a, 0xf6, 0x66, 0xf4, 0x0d, 0x90, 0xb3, 0x40, 0xb0, 0x89, 0x25, 0xf0, 0x1c,
+	0x71, 0x31, 0xcb, 0x21, 0x68, 0x03, 0xcf, 0xbd, 0x31, 0x85, 0xc5, 0x87, 0xb8, 0x58, 0x3b, 0x48,
+	0xa8, 0x17, 0x21, 0xf4, 0x18, 0x2a, 0x99, 0xb2, 0xa4, 0xc9, 0x5f, 0xef, 0x40, 0xaf, 0x80, 0x74,
+	0xd0, 0x0a, 0x30, 0x1e, 0x6d, 0xd1, 0xab, 0x0c, 0x79, 0x66, 0x27, 0x43, 0xe5, 0x99, 0x4d, 0x5e,
+	0xc2, 0x86, 0xbc, 0x8a, 0x27, 0xdf, 0xb7, 0x7c, 0x97, 0xda, 0xd1, 0x56, 0xd3, 0x89, 0x2e, 0x7a,
+	0x19, 0x8a, 0xae, 0x84, 0x8d, 0x94, 0x40, 0xab, 0x50, 0xc9, 0x48, 0xc6, 0x73, 0xd0, 0x6b, 0xd8,
+	0x31, 0xd0, 0xf9, 0xcf, 0xbd, 0xf6, 0xa0, 0x7a, 0x4f, 0x34, 0xe9, 0xf6, 0x33, 0x07, 0xe5, 0x93,
+	0x70, 0xe2, 0x2b, 0x0b, 0x54, 0x85, 0x73, 0xff, 0x10, 0x96, 0xdb, 0x8e, 0x17, 0x6d, 0xca, 0x0d,
+	0x47, 0x83, 0x94, 0x0c, 0x88, 0xa1, 0xcf, 0x9e, 0x7b, 0x43, 0x28, 0x94, 0x16, 0x6b, 0xbd, 0xeb,
+	0xcf, 0x05, 0xd6, 0x56, 0xea, 0xb9, 0xc6, 0xaa, 0xa1, 0xc5, 0x9b, 0xfd, 0x2a, 0x21, 0xba, 0x0d,
+	0x5b, 0xe9, 0x0c, 0xf1, 0x5c, 0x47, 0xbf, 0xd6, 0xa0, 0xf8, 0x2d, 0x8a, 0x5e, 0x9b, 0x09, 0xf2,
+	0x1e, 0x4a, 0xa7, 0xc8, 0xd9, 0x1d, 0x7e, 0xc1, 0x99, 0x68, 0xe3, 0x9c, 0x6c, 0x37, 0xd3, 0x5c,
+	0x36, 0xe3, 0xcc, 0xe9, 0xbb, 0xa9, 0xa9, 0xda, 0x38, 0x3f, 0xc5, 0x60, 0xc0, 0x99, 0x2f, 0x3c,
+	0x4e, 0xde, 0x41, 0x31, 0xae, 0x95, 0x75, 0x15, 0x95, 0xd4, 0xf1, 0x06, 0x96, 0xf0, 0xf8, 0xa3,
+	0x95, 0x1f, 0x60, 0x43, 0xf6, 0x93, 0x89, 0x23, 0xbb, 0x4a, 0x43, 0x25, 0x91, 0xfa, 0xde, 0x12,
+	0x9e, 0xd8, 0xe9, 0x02, 0x48, 0x12, 0x30, 0x35, 0x8d, 0xaa, 0x8c, 0x82, 0xeb, 0xba, 0x82, 0xdf,
+	0xcf, 0x65, 0x07, 0x34, 0x25, 0x14, 0xe4, 0x40, 0xa1, 0x2e, 0x47, 0x51, 0x7f, 0xf6, 0xd8, 0xf1,
+	0x1f, 0x35, 0xc5, 0xfd, 0x19, 0xb5, 0xe5, 0x30, 0x65, 0xd4, 0x1e, 0x0a, 0x4d, 0x07, 0x34, 0xc5,
+	0xc3, 0x19, 0xb5, 0xe5, 0xb8, 0x64, 0xd4, 0x1e, 0xb0, 0x3e, 0x31, 0xa0, 0x94, 0x71, 0x29, 0x39,
+	0x54, 0x0a, 0x1e, 0x0a, 0x85, 0x5e, 0x7f, 0x9c, 0x90, 0x68, 0x7e, 0x84, 0xf5, 0xc4, 0x5b, 0x64,
+	0x5f, 0x21, 0x67, 0x3d, 0x9f, 0xd9, 0xff, 0x3d, 0x2b, 0x9e, 0xbc, 0xfe, 0xde, 0x1a, 0x32, 0x31,
+	0x0a, 0xfb, 0xcd, 0x81, 0x37, 0x69, 0x39, 0x6c, 0x38, 0x12, 0x2e, 0x73, 0x87, 0x2e, 0x8a, 0xa9,
+	0xc7, 0xc7, 0x2d, 0xc7, 0xb5, 0x5b, 0x51, 0x3c, 0x5a, 0xa9, 0x44, 0xbf, 0x10, 0x7d, 0x10, 0x8e,
+	0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x1b, 0x04, 0x4a, 0xbd, 0x59, 0x06, 0x00, 0x00,
 }
@@ -9,11 +9,16 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/sweep"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -74,6 +79,18 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/walletrpc.WalletKit/LeaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/ReleaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/BumpFee": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
@@ -290,7 +307,7 @@ func (w *WalletKit) SendOutputs(ctx context.Context,
 	// Now that we have the outputs mapped, we can request that the wallet
 	// attempt to create this transaction.
 	tx, err := w.cfg.Wallet.SendOutputs(
-		outputsToCreate, lnwallet.SatPerKWeight(req.SatPerKw),
+		outputsToCreate, chainfee.SatPerKWeight(req.SatPerKw), 1, "",
 	)
 	if err != nil {
 		return nil, err
@@ -331,3 +348,181 @@ func (w *WalletKit) EstimateFee(ctx context.Context,
 		SatPerKw: int64(satPerKw),
 	}, nil
 }
+
+// unmarshallLease parses the lock ID and outpoint of a lease related request.
+func unmarshallLease(rawID []byte,
+	rpcOutPoint *lnrpc.OutPoint) (lnwallet.LockID, *wire.OutPoint, error) {
+
+	var lockID lnwallet.LockID
+	if len(rawID) != len(lockID) {
+		return lockID, nil, fmt.Errorf("id must be %v random bytes",
+			len(lockID))
+	}
+	copy(lockID[:], rawID)
+
+	op, err := unmarshallOutPoint(rpcOutPoint)
+	if err != nil {
+		return lockID, nil, err
+	}
+
+	return lockID, op, nil
+}
+
+// unmarshallOutPoint converts an outpoint from its RPC representation.
+func unmarshallOutPoint(rpcOutPoint *lnrpc.OutPoint) (*wire.OutPoint, error) {
+	if rpcOutPoint == nil {
+		return nil, fmt.Errorf("must specify an outpoint")
+	}
+
+	var (
+		txid *chainhash.Hash
+		err  error
+	)
+	switch {
+	case len(rpcOutPoint.TxidBytes) != 0:
+		txid, err = chainhash.NewHash(rpcOutPoint.TxidBytes)
+	default:
+		txid, err = chainhash.NewHashFromStr(rpcOutPoint.TxidStr)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewOutPoint(txid, rpcOutPoint.OutputIndex), nil
+}
+
+// LeaseOutput locks an output to the given ID, preventing it from being
+// available for coin selection when funding channels. Only the holder of the
+// ID is able to release the output again. Leases are only kept in memory, so
+// all leased outputs are released when lnd restarts.
+func (w *WalletKit) LeaseOutput(ctx context.Context,
+	req *LeaseOutputRequest) (*LeaseOutputResponse, error) {
+
+	lockID, op, err := unmarshallLease(req.Id, req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.cfg.OutputLeaser.LeaseOutput(lockID, *op); err != nil {
+		return nil, err
+	}
+
+	return &LeaseOutputResponse{}, nil
+}
+
+// ReleaseOutput unlocks an output, allowing it to be available for coin
+// selection if it remains unspent. The ID should match the one used to
+// originally lock the output.
+func (w *WalletKit) ReleaseOutput(ctx context.Context,
+	req *ReleaseOutputRequest) (*ReleaseOutputResponse, error) {
+
+	lockID, op, err := unmarshallLease(req.Id, req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := w.cfg.OutputLeaser.ReleaseOutput(lockID, *op); err != nil {
+		return nil, err
+	}
+
+	return &ReleaseOutputResponse{}, nil
+}
+
+// BumpFee bumps the fee of an unconfirmed output under control of the wallet.
+// If the output is already being swept by the sweeper, then the fee of its
+// sweep transaction is bumped through RBF. Otherwise, the output is handed to
+// the sweeper, which spends it in a child transaction at the requested fee
+// rate, raising the effective fee rate of its parent (CPFP).
+func (w *WalletKit) BumpFee(ctx context.Context,
+	in *BumpFeeRequest) (*BumpFeeResponse, error) {
+
+	op, err := unmarshallOutPoint(in.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	satPerKw := chainfee.SatPerKVByte(in.SatPerVbyte * 1000).FeePerKWeight()
+	params := sweep.Params{
+		Fee: sweep.FeePreference{
+			ConfTarget: in.TargetConf,
+			FeeRate:    satPerKw,
+		},
+	}
+
+	// If the sweeper is already attempting to sweep the output, then
+	// updating its parameters bumps the fee of the sweep transaction,
+	// resulting in a replacement transaction (RBF) being broadcast.
+	_, err = w.cfg.Sweeper.UpdateParams(*op, params)
+	switch err {
+	case nil:
+		return &BumpFeeResponse{}, nil
+
+	case sweep.ErrNotPending:
+
+	default:
+		return nil, err
+	}
+
+	// Otherwise, we'll assume the caller is attempting to bump the fee of
+	// an unconfirmed transaction by spending one of its outputs under
+	// control of the wallet at a higher fee rate, essentially performing a
+	// Child-Pays-For-Parent (CPFP). We'll look up the output among the
+	// unconfirmed ones of the wallet, as we can't bump the fee of a
+	// transaction that has already confirmed.
+	utxos, err := w.cfg.Wallet.ListUnspentWitness(0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var utxo *lnwallet.Utxo
+	for _, u := range utxos {
+		if u.OutPoint == *op {
+			utxo = u
+			break
+		}
+	}
+	if utxo == nil {
+		return nil, fmt.Errorf("unconfirmed output %v not found in "+
+			"wallet", op)
+	}
+
+	var witnessType input.WitnessType
+	switch utxo.AddressType {
+	case lnwallet.WitnessPubKey:
+		witnessType = input.WitnessKeyHash
+
+	case lnwallet.NestedWitnessPubKey:
+		witnessType = input.NestedWitnessKeyHash
+
+	default:
+		return nil, fmt.Errorf("unknown witness type of output %v", op)
+	}
+
+	// As the output is under control of the wallet, we only need to
+	// populate the output value and script of the sign descriptor. The
+	// rest will be populated by the sweeper when generating the witness.
+	signDesc := &input.SignDescriptor{
+		Output: &wire.TxOut{
+			PkScript: utxo.PkScript,
+			Value:    int64(utxo.Value),
+		},
+		HashType: txscript.SigHashAll,
+	}
+
+	// We'll use the current height as the height hint since we're dealing
+	// with an unconfirmed output.
+	_, currentHeight, err := w.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve current height: %v",
+			err)
+	}
+
+	inp := input.NewBaseInput(
+		op, witnessType, signDesc, uint32(currentHeight),
+	)
+	if _, err := w.cfg.Sweeper.SweepInput(inp, params); err != nil {
+		return nil, err
+	}
+
+	return &BumpFeeResponse{}, nil
+}
@@ -0,0 +1,212 @@
+package lnwallet
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+const (
+	// anchorSize is the value of each of the two anchor outputs found on
+	// the commitment transactions of channels using anchor outputs.
+	anchorSize = btcutil.Amount(330)
+
+	// DefaultAnchorsCommitMaxFeeRate is the maximum fee rate the initiator
+	// of a channel using anchor outputs will use for its commitment
+	// transactions. As the commitment can always be CPFP'd through its
+	// anchor at the time of broadcast, there's no need to pay for a high
+	// fee rate upfront. This amounts to 10 sat/vbyte.
+	DefaultAnchorsCommitMaxFeeRate = chainfee.SatPerKWeight(2500)
+)
+
+// CommitWeight returns the weight of a commitment transaction of the given
+// channel type carrying the given number of non-dust HTLCs. All fee
+// computations involving commitment transactions should rely on it, such that
+// they account for the outputs specific to each channel type.
+func CommitWeight(htlcCount int, chanType channeldb.ChannelType) int64 {
+	weight := input.CommitWeight
+	if chanType.HasAnchors() {
+		weight = input.AnchorCommitWeight
+	}
+
+	return weight + input.HtlcWeight*int64(htlcCount)
+}
+
+// commitAnchorsAmount returns the total value of the anchor outputs that the
+// initiator pays for on top of the commitment fee for the given channel type.
+// In accordance with the protocol, this amount is always deducted from the
+// balance of the initiator, even if one or both of the anchors end up being
+// omitted from the commitment transaction.
+func commitAnchorsAmount(chanType channeldb.ChannelType) btcutil.Amount {
+	if chanType.HasAnchors() {
+		return 2 * anchorSize
+	}
+
+	return 0
+}
+
+// CommitFeeForWeight returns the total amount the initiator of a channel of
+// the given type needs to set aside from its balance for a commitment
+// transaction of the given weight at the given fee rate. For channels using
+// anchor outputs, this includes the value of both anchor outputs.
+func CommitFeeForWeight(chanType channeldb.ChannelType,
+	feePerKw chainfee.SatPerKWeight, weight int64) btcutil.Amount {
+
+	return feePerKw.FeeForWeight(weight) + commitAnchorsAmount(chanType)
+}
+
+// CapCommitFeeRate caps the passed commitment fee rate to the maximum fee
+// rate that is used for channels of the given type. Only channels using
+// anchor outputs are capped, as other channel types have no way to bump the
+// fee of their commitment once broadcast.
+func CapCommitFeeRate(chanType channeldb.ChannelType,
+	feePerKw chainfee.SatPerKWeight) chainfee.SatPerKWeight {
+
+	if chanType.HasAnchors() && feePerKw > DefaultAnchorsCommitMaxFeeRate {
+		return DefaultAnchorsCommitMaxFeeRate
+	}
+
+	return feePerKw
+}
+
+// addAnchorOutputs adds the anchor outputs of both parties to the passed
+// commitment transaction. The anchor of a party is only added if that party
+// has an output on the commitment, or if there are any untrimmed HTLCs on the
+// commitment, as otherwise there's nothing for that party to protect.
+func addAnchorOutputs(commitTx *wire.MsgTx, localFundingKey,
+	remoteFundingKey *btcec.PublicKey, hasLocalOutput, hasRemoteOutput,
+	hasHtlcs bool) error {
+
+	if hasLocalOutput || hasHtlcs {
+		localAnchor, err := anchorPkScript(localFundingKey)
+		if err != nil {
+			return err
+		}
+
+		commitTx.AddTxOut(&wire.TxOut{
+			PkScript: localAnchor,
+			Value:    int64(anchorSize),
+		})
+	}
+
+	if hasRemoteOutput || hasHtlcs {
+		remoteAnchor, err := anchorPkScript(remoteFundingKey)
+		if err != nil {
+			return err
+		}
+
+		commitTx.AddTxOut(&wire.TxOut{
+			PkScript: remoteAnchor,
+			Value:    int64(anchorSize),
+		})
+	}
+
+	return nil
+}
+
+// anchorPkScript returns the p2wsh output script of the anchor output that is
+// spendable by the given funding key.
+func anchorPkScript(fundingKey *btcec.PublicKey) ([]byte, error) {
+	anchorScript, err := input.CommitScriptAnchor(fundingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return input.WitnessScriptHash(anchorScript)
+}
+
+// AnchorResolution holds the information required to spend our anchor output
+// on a commitment transaction in order to bump its fee through CPFP.
+type AnchorResolution struct {
+	// AnchorSignDescriptor is the sign descriptor for our anchor output.
+	AnchorSignDescriptor input.SignDescriptor
+
+	// CommitAnchor is the anchor outpoint on the commitment transaction.
+	CommitAnchor wire.OutPoint
+
+	// CommitWeight is the weight of the commitment transaction the anchor
+	// output belongs to. It is needed to determine the fee the child
+	// transaction needs to pay to bump the fee rate of the package.
+	CommitWeight int64
+
+	// CommitFee is the fee paid by the commitment transaction the anchor
+	// output belongs to.
+	CommitFee btcutil.Amount
+}
+
+// NewAnchorResolution returns the information that is required to sweep our
+// anchor output on the passed fully signed commitment transaction. If the
+// channel doesn't use anchor outputs, or our anchor isn't present on the
+// commitment, nil is returned.
+func NewAnchorResolution(chanState *channeldb.OpenChannel,
+	commitTx *wire.MsgTx) (*AnchorResolution, error) {
+
+	if !chanState.ChanType.HasAnchors() {
+		return nil, nil
+	}
+
+	localFundingKey := chanState.LocalChanCfg.MultiSigKey
+	anchorScript, err := input.CommitScriptAnchor(localFundingKey.PubKey)
+	if err != nil {
+		return nil, err
+	}
+	anchorPkScript, err := input.WitnessScriptHash(anchorScript)
+	if err != nil {
+		return nil, err
+	}
+
+	// Locate our anchor output on the commitment. If it isn't present,
+	// then there's nothing we can sweep.
+	var (
+		anchorIndex uint32
+		found       bool
+		totalOut    btcutil.Amount
+	)
+	for i, txOut := range commitTx.TxOut {
+		totalOut += btcutil.Amount(txOut.Value)
+
+		if found || !bytes.Equal(txOut.PkScript, anchorPkScript) {
+			continue
+		}
+
+		anchorIndex = uint32(i)
+		found = true
+	}
+	if !found {
+		return nil, nil
+	}
+
+	if totalOut > chanState.Capacity {
+		return nil, fmt.Errorf("commitment %v spends %v while channel "+
+			"capacity is %v", commitTx.TxHash(), totalOut,
+			chanState.Capacity)
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(commitTx))
+
+	return &AnchorResolution{
+		AnchorSignDescriptor: input.SignDescriptor{
+			KeyDesc:       localFundingKey,
+			WitnessScript: anchorScript,
+			Output: &wire.TxOut{
+				PkScript: anchorPkScript,
+				Value:    int64(anchorSize),
+			},
+			HashType: txscript.SigHashAll,
+		},
+		CommitAnchor: wire.OutPoint{
+			Hash:  commitTx.TxHash(),
+			Index: anchorIndex,
+		},
+		CommitWeight: weight,
+		CommitFee:    chanState.Capacity - totalOut,
+	}, nil
+}
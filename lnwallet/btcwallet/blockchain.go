@@ -130,9 +130,23 @@ func (b *BtcWallet) GetUtxo(op *wire.OutPoint, pkScript []byte,
 //
 // This method is a part of the lnwallet.BlockChainIO interface.
 func (b *BtcWallet) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
+	if b.cfg.BlockFetcher != nil {
+		return b.cfg.BlockFetcher.GetBlock(blockHash, b.chain.GetBlock)
+	}
+
 	return b.chain.GetBlock(blockHash)
 }
 
+// GetBlockHeader returns the header of the block with the given hash from the
+// server, without fetching the full block.
+//
+// This method is a part of the lnwallet.BlockChainIO interface.
+func (b *BtcWallet) GetBlockHeader(
+	blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+
+	return b.chain.GetBlockHeader(blockHash)
+}
+
 // GetBlockHash returns the hash of the block in the best blockchain at the
 // given height.
 //
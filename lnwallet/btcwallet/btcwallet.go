@@ -18,8 +18,10 @@ import (
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	base "github.com/btcsuite/btcwallet/wallet"
 	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/btcsuite/btcwallet/wtxmgr"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 const (
@@ -31,6 +33,15 @@ var (
 	// stored within the top-level waleltdb buckets of btcwallet.
 	waddrmgrNamespaceKey = []byte("waddrmgr")
 
+	// wtxmgrNamespaceKey is the namespace key that the wtxmgr state is
+	// stored within the top-level walletdb buckets of btcwallet.
+	wtxmgrNamespaceKey = []byte("wtxmgr")
+
+	// txLabelsBucketKey is the key of the top-level walletdb bucket in
+	// which the labels of the wallet's transactions are stored, keyed by
+	// transaction hash.
+	txLabelsBucketKey = []byte("lnd-tx-labels")
+
 	// lightningAddrSchema is the scope addr schema for all keys that we
 	// derive. We'll treat them all as p2wkh addresses, as atm we must
 	// specify a particular type.
@@ -178,6 +189,14 @@ func (b *BtcWallet) Start() error {
 		}
 	}
 
+	// If requested, we'll drop the wallet's transaction history before
+	// starting it, such that it's rebuilt by rescanning the chain.
+	if b.cfg.ResetWalletTransactions {
+		if err := b.dropTransactionHistory(); err != nil {
+			return err
+		}
+	}
+
 	// Establish an RPC connection in addition to starting the goroutines
 	// in the underlying wallet.
 	if err := b.chain.Start(); err != nil {
@@ -194,6 +213,47 @@ func (b *BtcWallet) Start() error {
 	return nil
 }
 
+// dropTransactionHistory removes all transactions known to the wallet and
+// rewinds its sync state to its birthday block, or to the start block if the
+// birthday block is unknown. Once the wallet is started, this forces a rescan
+// of the chain, during which the transaction history is rebuilt.
+func (b *BtcWallet) dropTransactionHistory() error {
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		err := tx.DeleteTopLevelBucket(wtxmgrNamespaceKey)
+		if err != nil && err != walletdb.ErrBucketNotFound {
+			return err
+		}
+		txmgrNs, err := tx.CreateTopLevelBucket(wtxmgrNamespaceKey)
+		if err != nil {
+			return err
+		}
+		if err := wtxmgr.Create(txmgrNs); err != nil {
+			return err
+		}
+
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+		birthdayBlock, _, err := b.wallet.Manager.BirthdayBlock(
+			addrmgrNs,
+		)
+		if err != nil {
+			// Without a birthday block, we'll fall back to
+			// rescanning from the wallet's start block.
+			return b.wallet.Manager.SetSyncedTo(addrmgrNs, nil)
+		}
+
+		// Clearing the verified flag of the birthday block ensures the
+		// wallet rescans from it once started.
+		err = b.wallet.Manager.SetBirthdayBlock(
+			addrmgrNs, birthdayBlock, false,
+		)
+		if err != nil {
+			return err
+		}
+
+		return b.wallet.Manager.SetSyncedTo(addrmgrNs, &birthdayBlock)
+	})
+}
+
 // Stop signals the wallet for shutdown. Shutdown may entail closing
 // any active sockets, database handles, stopping goroutines, etc.
 //
@@ -268,13 +328,83 @@ func (b *BtcWallet) IsOurAddress(a btcutil.Address) bool {
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) SendOutputs(outputs []*wire.TxOut,
-	feeRate lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+	feeRate chainfee.SatPerKWeight, minconf int32,
+	label string) (*wire.MsgTx, error) {
+
+	if len(label) > lnwallet.TxLabelLimit {
+		return nil, lnwallet.ErrTxLabelTooLong
+	}
 
 	// Convert our fee rate from sat/kw to sat/kb since it's required by
 	// SendOutputs.
 	feeSatPerKB := btcutil.Amount(feeRate.FeePerKVByte())
 
-	return b.wallet.SendOutputs(outputs, defaultAccount, 1, feeSatPerKB)
+	tx, err := b.wallet.SendOutputs(
+		outputs, defaultAccount, minconf, feeSatPerKB,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if label == "" {
+		return tx, nil
+	}
+
+	// The transaction has already been broadcast at this point, so we'll
+	// make sure to include its hash in the error if we fail to label it.
+	txHash := tx.TxHash()
+	if err := b.LabelTransaction(txHash, label, false); err != nil {
+		return nil, fmt.Errorf("transaction %v was broadcast, but "+
+			"could not be labelled: %v", txHash, err)
+	}
+
+	return tx, nil
+}
+
+// LabelTransaction attaches a label to the transaction with the given hash.
+// If the transaction already has a label, ErrTxLabelExists is returned unless
+// overwrite is set.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) LabelTransaction(hash chainhash.Hash, label string,
+	overwrite bool) error {
+
+	if len(label) > lnwallet.TxLabelLimit {
+		return lnwallet.ErrTxLabelTooLong
+	}
+
+	return walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		labels, err := tx.CreateTopLevelBucket(txLabelsBucketKey)
+		if err != nil {
+			return err
+		}
+
+		if !overwrite && labels.Get(hash[:]) != nil {
+			return lnwallet.ErrTxLabelExists
+		}
+
+		return labels.Put(hash[:], []byte(label))
+	})
+}
+
+// fetchTxLabels populates the Label field of the given transaction details
+// with the labels stored for them, if any.
+func (b *BtcWallet) fetchTxLabels(details []*lnwallet.TransactionDetail) error {
+	return walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		labels := tx.ReadBucket(txLabelsBucketKey)
+		if labels == nil {
+			return nil
+		}
+
+		for _, detail := range details {
+			label := labels.Get(detail.Hash[:])
+			if label != nil {
+				detail.Label = string(label)
+			}
+		}
+
+		return nil
+	})
 }
 
 // LockOutpoint marks an outpoint as locked meaning it will no longer be deemed
@@ -589,6 +719,10 @@ func (b *BtcWallet) ListTransactionDetails() ([]*lnwallet.TransactionDetail, err
 		txDetails = append(txDetails, detail)
 	}
 
+	if err := b.fetchTxLabels(txDetails); err != nil {
+		return nil, err
+	}
+
 	return txDetails, nil
 }
 
@@ -753,3 +887,83 @@ func (b *BtcWallet) IsSynced() (bool, int64, error) {
 
 	return true, bestTimestamp, nil
 }
+
+// GetRecoveryInfo returns a boolean indicating whether the wallet is started
+// in recovery mode. It also returns a float64, ranging from 0 to 1,
+// representing the recovery progress made so far.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) GetRecoveryInfo() (bool, float64, error) {
+	var (
+		isRecoveryMode bool
+		progress       float64
+	)
+
+	// A zero value in RecoveryWindow indicates there is no trigger of
+	// recovery mode.
+	if b.cfg.RecoveryWindow == 0 {
+		return isRecoveryMode, progress, nil
+	}
+
+	// Query the wallet's birthday block from the db.
+	var birthdayBlock waddrmgr.BlockStamp
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		var err error
+		birthdayBlock, _, err = b.wallet.Manager.BirthdayBlock(
+			addrmgrNs,
+		)
+		return err
+	})
+	if err != nil {
+		// The wallet won't start until the backend is synced, thus the
+		// birthday block won't be set yet. In that case, the recovery
+		// hasn't made any progress.
+		if waddrmgr.IsError(err, waddrmgr.ErrBirthdayBlockNotSet) {
+			return isRecoveryMode, progress, nil
+		}
+
+		return isRecoveryMode, progress, err
+	}
+
+	// Grab the best chain state the wallet is currently aware of.
+	syncState := b.wallet.Manager.SyncedTo()
+
+	// Next, query the chain backend to grab the info about the tip of the
+	// main chain.
+	//
+	// NOTE: The actual recovery process is handled by btcwallet, and might
+	// finish before the chain backend has synced to the tip of the main
+	// chain.
+	_, bestHeight, err := b.cfg.ChainSource.GetBestBlock()
+	if err != nil {
+		return isRecoveryMode, progress, err
+	}
+
+	isRecoveryMode = true
+
+	// The birthday block height might be greater than the current synced
+	// height in a newly restored wallet, and might be greater than the
+	// chain tip if a rollback happens. In that case, we'll report no
+	// progress.
+	if syncState.Height < birthdayBlock.Height ||
+		bestHeight < birthdayBlock.Height {
+
+		return isRecoveryMode, progress, nil
+	}
+
+	// If the birthday block is the chain tip, then there's nothing left to
+	// scan.
+	if bestHeight == birthdayBlock.Height {
+		return isRecoveryMode, 1, nil
+	}
+
+	progress = float64(syncState.Height-birthdayBlock.Height) /
+		float64(bestHeight-birthdayBlock.Height)
+	if progress > 1 {
+		progress = 1
+	}
+
+	return isRecoveryMode, progress, nil
+}
@@ -0,0 +1,292 @@
+package btcwallet
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/chain"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/btcsuite/btcwallet/wtxmgr"
+)
+
+// mockChainSource is a chain.Interface that only reports a static best block.
+type mockChainSource struct {
+	chain.Interface
+
+	bestHeight int32
+}
+
+func (m *mockChainSource) GetBestBlock() (*chainhash.Hash, int32, error) {
+	return &chainhash.Hash{}, m.bestHeight, nil
+}
+
+// newTestWallet creates a fresh, unstarted BtcWallet within a temporary
+// directory. The returned cleanup function must be called once done.
+func newTestWallet(t *testing.T, recoveryWindow uint32,
+	chainSource chain.Interface) (*BtcWallet, func()) {
+
+	tempDir, err := ioutil.TempDir("", "btcwallet")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+
+	w, err := New(Config{
+		DataDir:        tempDir,
+		PrivatePass:    []byte("private-pass"),
+		HdSeed:         bytes.Repeat([]byte{0x01}, 32),
+		Birthday:       time.Now(),
+		RecoveryWindow: recoveryWindow,
+		ChainSource:    chainSource,
+		NetParams:      &chaincfg.RegressionNetParams,
+	})
+	if err != nil {
+		os.RemoveAll(tempDir)
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+
+	cleanUp := func() {
+		w.db.Close()
+		os.RemoveAll(tempDir)
+	}
+
+	return w, cleanUp
+}
+
+// testBlockStamp returns a block stamp with a unique hash for the given
+// height.
+func testBlockStamp(height int32) waddrmgr.BlockStamp {
+	return waddrmgr.BlockStamp{
+		Height:    height,
+		Hash:      chainhash.Hash{byte(height)},
+		Timestamp: time.Unix(int64(height), 0),
+	}
+}
+
+// setSyncState marks the wallet as synced up to the given height, with its
+// birthday block at the given birthday height.
+func setSyncState(t *testing.T, w *BtcWallet, birthdayHeight,
+	syncedHeight int32) {
+
+	err := walletdb.Update(w.db, func(tx walletdb.ReadWriteTx) error {
+		addrmgrNs := tx.ReadWriteBucket(waddrmgrNamespaceKey)
+
+		// The wallet expects every block up to the synced height to be
+		// known, so we'll sync to each of them in turn.
+		for height := int32(1); height <= syncedHeight; height++ {
+			stamp := testBlockStamp(height)
+			err := w.wallet.Manager.SetSyncedTo(addrmgrNs, &stamp)
+			if err != nil {
+				return err
+			}
+		}
+
+		return w.wallet.Manager.SetBirthdayBlock(
+			addrmgrNs, testBlockStamp(birthdayHeight), true,
+		)
+	})
+	if err != nil {
+		t.Fatalf("unable to set sync state: %v", err)
+	}
+}
+
+// TestGetRecoveryInfo asserts that the recovery progress is reported relative
+// to the wallet's birthday block and the chain backend's tip.
+func TestGetRecoveryInfo(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		recoveryWindow uint32
+		birthdayHeight int32
+		syncedHeight   int32
+		bestHeight     int32
+
+		expectedRecoveryMode bool
+		expectedProgress     float64
+	}{
+		{
+			name:           "no recovery window",
+			recoveryWindow: 0,
+			birthdayHeight: 2,
+			syncedHeight:   4,
+			bestHeight:     6,
+		},
+		{
+			name:                 "halfway",
+			recoveryWindow:       100,
+			birthdayHeight:       2,
+			syncedHeight:         4,
+			bestHeight:           6,
+			expectedRecoveryMode: true,
+			expectedProgress:     0.5,
+		},
+		{
+			name:                 "finished",
+			recoveryWindow:       100,
+			birthdayHeight:       2,
+			syncedHeight:         6,
+			bestHeight:           6,
+			expectedRecoveryMode: true,
+			expectedProgress:     1,
+		},
+		{
+			name:                 "birthday at chain tip",
+			recoveryWindow:       100,
+			birthdayHeight:       6,
+			syncedHeight:         6,
+			bestHeight:           6,
+			expectedRecoveryMode: true,
+			expectedProgress:     1,
+		},
+		{
+			name:                 "synced below birthday",
+			recoveryWindow:       100,
+			birthdayHeight:       4,
+			syncedHeight:         2,
+			bestHeight:           6,
+			expectedRecoveryMode: true,
+		},
+		{
+			name:                 "chain tip below birthday",
+			recoveryWindow:       100,
+			birthdayHeight:       4,
+			syncedHeight:         4,
+			bestHeight:           2,
+			expectedRecoveryMode: true,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			chainSource := &mockChainSource{
+				bestHeight: test.bestHeight,
+			}
+			w, cleanUp := newTestWallet(
+				t, test.recoveryWindow, chainSource,
+			)
+			defer cleanUp()
+
+			setSyncState(t, w, test.birthdayHeight, test.syncedHeight)
+
+			recoveryMode, progress, err := w.GetRecoveryInfo()
+			if err != nil {
+				t.Fatalf("unable to get recovery info: %v", err)
+			}
+			if recoveryMode != test.expectedRecoveryMode {
+				t.Fatalf("expected recovery mode %v, got %v",
+					test.expectedRecoveryMode, recoveryMode)
+			}
+			if progress != test.expectedProgress {
+				t.Fatalf("expected progress %v, got %v",
+					test.expectedProgress, progress)
+			}
+		})
+	}
+}
+
+// TestGetRecoveryInfoNoBirthday asserts that no recovery progress is reported
+// while the wallet's birthday block is yet to be set.
+func TestGetRecoveryInfoNoBirthday(t *testing.T) {
+	t.Parallel()
+
+	w, cleanUp := newTestWallet(t, 100, &mockChainSource{bestHeight: 6})
+	defer cleanUp()
+
+	recoveryMode, progress, err := w.GetRecoveryInfo()
+	if err != nil {
+		t.Fatalf("unable to get recovery info: %v", err)
+	}
+	if recoveryMode || progress != 0 {
+		t.Fatalf("expected no recovery progress, got recovery "+
+			"mode=%v, progress=%v", recoveryMode, progress)
+	}
+}
+
+// TestDropTransactionHistory asserts that dropping the wallet's transaction
+// history removes all known transactions and rewinds the wallet's sync state
+// to its birthday block, such that it is rescanned once started.
+func TestDropTransactionHistory(t *testing.T) {
+	t.Parallel()
+
+	w, cleanUp := newTestWallet(t, 0, nil)
+	defer cleanUp()
+
+	const (
+		birthdayHeight = 2
+		syncedHeight   = 5
+	)
+	setSyncState(t, w, birthdayHeight, syncedHeight)
+
+	// Add an unconfirmed transaction to the wallet, which should be gone
+	// once the history is dropped.
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{})
+	tx.AddTxOut(&wire.TxOut{Value: 1000, PkScript: []byte{0x00}})
+
+	var txBuf bytes.Buffer
+	if err := tx.Serialize(&txBuf); err != nil {
+		t.Fatalf("unable to serialize tx: %v", err)
+	}
+	rec, err := wtxmgr.NewTxRecord(txBuf.Bytes(), time.Now())
+	if err != nil {
+		t.Fatalf("unable to create tx record: %v", err)
+	}
+	err = walletdb.Update(w.db, func(dbTx walletdb.ReadWriteTx) error {
+		txmgrNs := dbTx.ReadWriteBucket(wtxmgrNamespaceKey)
+		return w.wallet.TxStore.InsertTx(txmgrNs, rec, nil)
+	})
+	if err != nil {
+		t.Fatalf("unable to insert tx: %v", err)
+	}
+
+	if err := w.dropTransactionHistory(); err != nil {
+		t.Fatalf("unable to drop transaction history: %v", err)
+	}
+
+	err = walletdb.View(w.db, func(dbTx walletdb.ReadTx) error {
+		txmgrNs := dbTx.ReadBucket(wtxmgrNamespaceKey)
+		unmined, err := w.wallet.TxStore.UnminedTxs(txmgrNs)
+		if err != nil {
+			return err
+		}
+		if len(unmined) != 0 {
+			t.Fatalf("expected no transactions, found %d",
+				len(unmined))
+		}
+
+		addrmgrNs := dbTx.ReadBucket(waddrmgrNamespaceKey)
+		birthdayBlock, verified, err := w.wallet.Manager.BirthdayBlock(
+			addrmgrNs,
+		)
+		if err != nil {
+			return err
+		}
+		if birthdayBlock.Height != birthdayHeight {
+			t.Fatalf("expected birthday height %d, got %d",
+				birthdayHeight, birthdayBlock.Height)
+		}
+		if verified {
+			t.Fatalf("expected birthday block to be unverified")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read wallet state: %v", err)
+	}
+
+	syncedTo := w.wallet.Manager.SyncedTo()
+	if syncedTo.Height != birthdayHeight {
+		t.Fatalf("expected wallet to be synced to height %d, got %d",
+			birthdayHeight, syncedTo.Height)
+	}
+}
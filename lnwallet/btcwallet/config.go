@@ -7,7 +7,9 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/blockfetch"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/btcsuite/btcwallet/wallet"
@@ -74,6 +76,11 @@ type Config struct {
 	// default BIP44 derivation paths.
 	RecoveryWindow uint32
 
+	// ResetWalletTransactions specifies whether the wallet's transaction
+	// history should be dropped on startup, forcing a rescan of the chain
+	// from the wallet's birthday.
+	ResetWalletTransactions bool
+
 	// ChainSource is the primary chain interface. This is used to operate
 	// the wallet and do things such as rescanning, sending transactions,
 	// notifications for received funds, etc.
@@ -82,7 +89,7 @@ type Config struct {
 	// FeeEstimator is an instance of the fee estimator interface which
 	// will be used by the wallet to dynamically set transaction fees when
 	// crafting transactions.
-	FeeEstimator lnwallet.FeeEstimator
+	FeeEstimator chainfee.Estimator
 
 	// NetParams is the net parameters for the target chain.
 	NetParams *chaincfg.Params
@@ -96,6 +103,11 @@ type Config struct {
 	// encrypted at all, in which case it should be attempted to be loaded
 	// normally when creating the BtcWallet.
 	Wallet *wallet.Wallet
+
+	// BlockFetcher is an optional fetcher of the blocks pruned by the
+	// chain backend. If set, the blocks the chain backend pruned are
+	// fetched from its peers instead.
+	BlockFetcher *blockfetch.Fetcher
 }
 
 // NetworkDir returns the directory name of a network directory to hold wallet
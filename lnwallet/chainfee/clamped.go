@@ -0,0 +1,116 @@
+package chainfee
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ClampedEstimator wraps an Estimator to clamp the fee rates it estimates
+// within a range which can be updated at runtime. This allows each consumer
+// of the fee estimator to be protected against absurd estimates, within
+// bounds suited to its own needs.
+//
+// NOTE: The wrapped estimator is meant to be shared with other consumers, so
+// it's neither started nor stopped by the ClampedEstimator.
+type ClampedEstimator struct {
+	// Estimator is the wrapped estimator whose fee rates are clamped.
+	Estimator
+
+	// minFeeRate is the lowest fee rate returned by the estimator. A zero
+	// value means there's no minimum.
+	minFeeRate SatPerKWeight
+
+	// maxFeeRate is the highest fee rate returned by the estimator. A zero
+	// value means there's no maximum.
+	maxFeeRate SatPerKWeight
+
+	mu sync.RWMutex
+}
+
+// A compile-time assertion to ensure that ClampedEstimator implements the
+// Estimator interface.
+var _ Estimator = (*ClampedEstimator)(nil)
+
+// NewClampedEstimator returns a new ClampedEstimator clamping the fee rates of
+// the given estimator within the passed bounds. A zero bound is ignored.
+func NewClampedEstimator(estimator Estimator, minFeeRate,
+	maxFeeRate SatPerKWeight) (*ClampedEstimator, error) {
+
+	c := &ClampedEstimator{Estimator: estimator}
+	if err := c.SetBounds(minFeeRate, maxFeeRate); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// EstimateFeePerKW returns the fee rate estimated by the wrapped estimator for
+// the given confirmation target, clamped within the current bounds.
+//
+// NOTE: This method is part of the Estimator interface.
+func (c *ClampedEstimator) EstimateFeePerKW(
+	numBlocks uint32) (SatPerKWeight, error) {
+
+	feeRate, err := c.Estimator.EstimateFeePerKW(numBlocks)
+	if err != nil {
+		return 0, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.minFeeRate != 0 && feeRate < c.minFeeRate {
+		log.Debugf("Clamping estimated fee rate of %v sat/kw to "+
+			"minimum of %v sat/kw", feeRate, c.minFeeRate)
+		return c.minFeeRate, nil
+	}
+	if c.maxFeeRate != 0 && feeRate > c.maxFeeRate {
+		log.Warnf("Clamping estimated fee rate of %v sat/kw to "+
+			"maximum of %v sat/kw", feeRate, c.maxFeeRate)
+		return c.maxFeeRate, nil
+	}
+
+	return feeRate, nil
+}
+
+// Start is a no-op, as the wrapped estimator is started by its owner.
+//
+// NOTE: This method is part of the Estimator interface.
+func (c *ClampedEstimator) Start() error {
+	return nil
+}
+
+// Stop is a no-op, as the wrapped estimator is stopped by its owner.
+//
+// NOTE: This method is part of the Estimator interface.
+func (c *ClampedEstimator) Stop() error {
+	return nil
+}
+
+// SetBounds updates the bounds within which estimated fee rates are clamped.
+// A zero bound is ignored.
+func (c *ClampedEstimator) SetBounds(minFeeRate,
+	maxFeeRate SatPerKWeight) error {
+
+	if minFeeRate != 0 && maxFeeRate != 0 && minFeeRate > maxFeeRate {
+		return fmt.Errorf("minimum fee rate of %v sat/kw is above "+
+			"maximum fee rate of %v sat/kw", minFeeRate,
+			maxFeeRate)
+	}
+
+	c.mu.Lock()
+	c.minFeeRate = minFeeRate
+	c.maxFeeRate = maxFeeRate
+	c.mu.Unlock()
+
+	return nil
+}
+
+// Bounds returns the minimum and maximum fee rates estimated fee rates are
+// currently clamped within. A zero bound is ignored.
+func (c *ClampedEstimator) Bounds() (SatPerKWeight, SatPerKWeight) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.minFeeRate, c.maxFeeRate
+}
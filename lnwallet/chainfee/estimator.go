@@ -0,0 +1,753 @@
+package chainfee
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/rpcclient"
+	"github.com/btcsuite/btcutil"
+)
+
+const (
+	// maxBlockTarget is the highest number of blocks confirmations that
+	// a WebAPIEstimator will cache fees for. This number is chosen
+	// because it's the highest number of confs bitcoind will return a fee
+	// estimate for.
+	maxBlockTarget uint32 = 1008
+
+	// minBlockTarget is the lowest number of blocks confirmations that
+	// a WebAPIEstimator will cache fees for. Requesting an estimate for
+	// less than this will return the estimate for this target instead.
+	minBlockTarget uint32 = 2
+
+	// webAPIFeeUpdateInterval is the interval at which a WebAPIEstimator
+	// refreshes its cached fee estimates.
+	webAPIFeeUpdateInterval = 10 * time.Minute
+
+	// webAPITimeout is the time we'll wait for the web API to respond to
+	// a request for fee estimates.
+	webAPITimeout = 10 * time.Second
+)
+
+// Estimator provides the ability to estimate on-chain transaction fees for
+// various combinations of transaction sizes and desired confirmation time
+// (measured by number of blocks).
+type Estimator interface {
+	// EstimateFeePerKW takes in a target for the number of blocks until an
+	// initial confirmation and returns the estimated fee expressed in
+	// sat/kw.
+	EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error)
+
+	// Start signals the Estimator to start any processes or goroutines
+	// it needs to perform its duty.
+	Start() error
+
+	// Stop stops any spawned goroutines and cleans up the resources used
+	// by the fee estimator.
+	Stop() error
+
+	// RelayFeePerKW returns the minimum fee rate required for transactions
+	// to be relayed. This is also the basis for calculation of the dust
+	// limit.
+	RelayFeePerKW() SatPerKWeight
+}
+
+// StaticEstimator will return a static value for all fee calculation
+// requests. It is designed to be replaced by a proper fee calculation
+// implementation. The fees are not accessible directly, because changing them
+// would not be thread safe.
+type StaticEstimator struct {
+	// feePerKW is the static fee rate in satoshis-per-vbyte that will be
+	// returned by this fee estimator.
+	feePerKW SatPerKWeight
+
+	// relayFee is the minimum fee rate required for transactions to be
+	// relayed.
+	relayFee SatPerKWeight
+}
+
+// NewStaticEstimator returns a new static fee estimator instance.
+func NewStaticEstimator(feePerKW,
+	relayFee SatPerKWeight) *StaticEstimator {
+
+	return &StaticEstimator{
+		feePerKW: feePerKW,
+		relayFee: relayFee,
+	}
+}
+
+// EstimateFeePerKW will return a static value for fee calculations.
+//
+// NOTE: This method is part of the Estimator interface.
+func (e StaticEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	return e.feePerKW, nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed.
+//
+// NOTE: This method is part of the Estimator interface.
+func (e StaticEstimator) RelayFeePerKW() SatPerKWeight {
+	return e.relayFee
+}
+
+// Start signals the Estimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the Estimator interface.
+func (e StaticEstimator) Start() error {
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the Estimator interface.
+func (e StaticEstimator) Stop() error {
+	return nil
+}
+
+// A compile-time assertion to ensure that StaticEstimator implements the
+// Estimator interface.
+var _ Estimator = (*StaticEstimator)(nil)
+
+// BtcdEstimator is an implementation of the Estimator interface backed
+// by the RPC interface of an active btcd node. This implementation will proxy
+// any fee estimation requests to btcd's RPC interface.
+type BtcdEstimator struct {
+	// fallbackFeePerKW is the fall back fee rate in sat/kw that is returned
+	// if the fee estimator does not yet have enough data to actually
+	// produce fee estimates.
+	fallbackFeePerKW SatPerKWeight
+
+	// minFeePerKW is the minimum fee, in sat/kw, that we should enforce.
+	// This will be used as the default fee rate for a transaction when the
+	// estimated fee rate is too low to allow the transaction to propagate
+	// through the network.
+	minFeePerKW SatPerKWeight
+
+	btcdConn *rpcclient.Client
+}
+
+// NewBtcdEstimator creates a new BtcdEstimator given a fully populated
+// rpc config that is able to successfully connect and authenticate with the
+// btcd node, and also a fall back fee rate. The fallback fee rate is used in
+// the occasion that the estimator has insufficient data, or returns zero for a
+// fee estimate.
+func NewBtcdEstimator(rpcConfig rpcclient.ConnConfig,
+	fallBackFeeRate SatPerKWeight) (*BtcdEstimator, error) {
+
+	rpcConfig.DisableConnectOnNew = true
+	rpcConfig.DisableAutoReconnect = false
+	chainConn, err := rpcclient.New(&rpcConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BtcdEstimator{
+		fallbackFeePerKW: fallBackFeeRate,
+		btcdConn:         chainConn,
+	}, nil
+}
+
+// Start signals the Estimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the Estimator interface.
+func (b *BtcdEstimator) Start() error {
+	if err := b.btcdConn.Connect(20); err != nil {
+		return err
+	}
+
+	// Once the connection to the backend node has been established, we'll
+	// query it for its minimum relay fee.
+	info, err := b.btcdConn.GetInfo()
+	if err != nil {
+		return err
+	}
+
+	relayFee, err := btcutil.NewAmount(info.RelayFee)
+	if err != nil {
+		return err
+	}
+
+	// The fee rate is expressed in sat/kb, so we'll manually convert it to
+	// our desired sat/kw rate.
+	minRelayFeePerKw := SatPerKVByte(relayFee).FeePerKWeight()
+
+	// By default, we'll use the backend node's minimum relay fee as the
+	// minimum fee rate we'll propose for transacations. However, if this
+	// happens to be lower than our fee floor, we'll enforce that instead.
+	b.minFeePerKW = minRelayFeePerKw
+	if b.minFeePerKW < FeePerKwFloor {
+		b.minFeePerKW = FeePerKwFloor
+	}
+
+	log.Debugf("Using minimum fee rate of %v sat/kw",
+		int64(b.minFeePerKW))
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the Estimator interface.
+func (b *BtcdEstimator) Stop() error {
+	b.btcdConn.Shutdown()
+
+	return nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the Estimator interface.
+func (b *BtcdEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	feeEstimate, err := b.fetchEstimate(numBlocks)
+	switch {
+	// If the estimator doesn't have enough data, or returns an error, then
+	// to return a proper value, then we'll return the default fall back
+	// fee rate.
+	case err != nil:
+		log.Errorf("unable to query estimator: %v", err)
+		fallthrough
+
+	case feeEstimate == 0:
+		return b.fallbackFeePerKW, nil
+	}
+
+	return feeEstimate, nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed.
+//
+// NOTE: This method is part of the Estimator interface.
+func (b *BtcdEstimator) RelayFeePerKW() SatPerKWeight {
+	return b.minFeePerKW
+}
+
+// fetchEstimate returns a fee estimate for a transaction to be confirmed in
+// confTarget blocks. The estimate is returned in sat/kw.
+func (b *BtcdEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight, error) {
+	// First, we'll fetch the estimate for our confirmation target.
+	btcPerKB, err := b.btcdConn.EstimateFee(int64(confTarget))
+	if err != nil {
+		return 0, err
+	}
+
+	// Next, we'll convert the returned value to satoshis, as it's
+	// currently returned in BTC.
+	satPerKB, err := btcutil.NewAmount(btcPerKB)
+	if err != nil {
+		return 0, err
+	}
+
+	// Since we use fee rates in sat/kw internally, we'll convert the
+	// estimated fee rate from its sat/kb representation to sat/kw.
+	satPerKw := SatPerKVByte(satPerKB).FeePerKWeight()
+
+	// Finally, we'll enforce our fee floor.
+	if satPerKw < b.minFeePerKW {
+		log.Debugf("Estimated fee rate of %v sat/kw is too low, "+
+			"using fee floor of %v sat/kw instead", satPerKw,
+			b.minFeePerKW)
+		satPerKw = b.minFeePerKW
+	}
+
+	log.Debugf("Returning %v sat/kw for conf target of %v",
+		int64(satPerKw), confTarget)
+
+	return satPerKw, nil
+}
+
+// A compile-time assertion to ensure that BtcdEstimator implements the
+// Estimator interface.
+var _ Estimator = (*BtcdEstimator)(nil)
+
+// BitcoindEstimator is an implementation of the Estimator interface
+// backed by the RPC interface of an active bitcoind node. This implementation
+// will proxy any fee estimation requests to bitcoind's RPC interface.
+type BitcoindEstimator struct {
+	// fallbackFeePerKW is the fallback fee rate in sat/kw that is returned
+	// if the fee estimator does not yet have enough data to actually
+	// produce fee estimates.
+	fallbackFeePerKW SatPerKWeight
+
+	// minFeePerKW is the minimum fee, in sat/kw, that we should enforce.
+	// This will be used as the default fee rate for a transaction when the
+	// estimated fee rate is too low to allow the transaction to propagate
+	// through the network.
+	minFeePerKW SatPerKWeight
+
+	bitcoindConn *rpcclient.Client
+}
+
+// NewBitcoindEstimator creates a new BitcoindEstimator given a fully
+// populated rpc config that is able to successfully connect and authenticate
+// with the bitcoind node, and also a fall back fee rate. The fallback fee rate
+// is used in the occasion that the estimator has insufficient data, or returns
+// zero for a fee estimate.
+func NewBitcoindEstimator(rpcConfig rpcclient.ConnConfig,
+	fallBackFeeRate SatPerKWeight) (*BitcoindEstimator, error) {
+
+	rpcConfig.DisableConnectOnNew = true
+	rpcConfig.DisableAutoReconnect = false
+	rpcConfig.DisableTLS = true
+	rpcConfig.HTTPPostMode = true
+	chainConn, err := rpcclient.New(&rpcConfig, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &BitcoindEstimator{
+		fallbackFeePerKW: fallBackFeeRate,
+		bitcoindConn:     chainConn,
+	}, nil
+}
+
+// Start signals the Estimator to start any processes or goroutines
+// it needs to perform its duty.
+//
+// NOTE: This method is part of the Estimator interface.
+func (b *BitcoindEstimator) Start() error {
+	// Once the connection to the backend node has been established, we'll
+	// query it for its minimum relay fee. Since the `getinfo` RPC has been
+	// deprecated for `bitcoind`, we'll need to send a `getnetworkinfo`
+	// command as a raw request.
+	resp, err := b.bitcoindConn.RawRequest("getnetworkinfo", nil)
+	if err != nil {
+		return err
+	}
+
+	// Parse the response to retrieve the relay fee in sat/KB.
+	info := struct {
+		RelayFee float64 `json:"relayfee"`
+	}{}
+	if err := json.Unmarshal(resp, &info); err != nil {
+		return err
+	}
+
+	relayFee, err := btcutil.NewAmount(info.RelayFee)
+	if err != nil {
+		return err
+	}
+
+	// The fee rate is expressed in sat/kb, so we'll manually convert it to
+	// our desired sat/kw rate.
+	minRelayFeePerKw := SatPerKVByte(relayFee).FeePerKWeight()
+
+	// By default, we'll use the backend node's minimum relay fee as the
+	// minimum fee rate we'll propose for transacations. However, if this
+	// happens to be lower than our fee floor, we'll enforce that instead.
+	b.minFeePerKW = minRelayFeePerKw
+	if b.minFeePerKW < FeePerKwFloor {
+		b.minFeePerKW = FeePerKwFloor
+	}
+
+	log.Debugf("Using minimum fee rate of %v sat/kw",
+		int64(b.minFeePerKW))
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used
+// by the fee estimator.
+//
+// NOTE: This method is part of the Estimator interface.
+func (b *BitcoindEstimator) Stop() error {
+	return nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the Estimator interface.
+func (b *BitcoindEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight, error) {
+	feeEstimate, err := b.fetchEstimate(numBlocks)
+	switch {
+	// If the estimator doesn't have enough data, or returns an error, then
+	// to return a proper value, then we'll return the default fall back
+	// fee rate.
+	case err != nil:
+		log.Errorf("unable to query estimator: %v", err)
+		fallthrough
+
+	case feeEstimate == 0:
+		return b.fallbackFeePerKW, nil
+	}
+
+	return feeEstimate, nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed.
+//
+// NOTE: This method is part of the Estimator interface.
+func (b *BitcoindEstimator) RelayFeePerKW() SatPerKWeight {
+	return b.minFeePerKW
+}
+
+// fetchEstimate returns a fee estimate for a transaction to be confirmed in
+// confTarget blocks. The estimate is returned in sat/kw.
+func (b *BitcoindEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight, error) {
+	// First, we'll send an "estimatesmartfee" command as a raw request,
+	// since it isn't supported by btcd but is available in bitcoind.
+	target, err := json.Marshal(uint64(confTarget))
+	if err != nil {
+		return 0, err
+	}
+	// TODO: Allow selection of economical/conservative modifiers.
+	resp, err := b.bitcoindConn.RawRequest(
+		"estimatesmartfee", []json.RawMessage{target},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	// Next, we'll parse the response to get the BTC per KB.
+	feeEstimate := struct {
+		FeeRate float64 `json:"feerate"`
+	}{}
+	err = json.Unmarshal(resp, &feeEstimate)
+	if err != nil {
+		return 0, err
+	}
+
+	// Next, we'll convert the returned value to satoshis, as it's currently
+	// returned in BTC.
+	satPerKB, err := btcutil.NewAmount(feeEstimate.FeeRate)
+	if err != nil {
+		return 0, err
+	}
+
+	// Since we use fee rates in sat/kw internally, we'll convert the
+	// estimated fee rate from its sat/kb representation to sat/kw.
+	satPerKw := SatPerKVByte(satPerKB).FeePerKWeight()
+
+	// Finally, we'll enforce our fee floor.
+	if satPerKw < b.minFeePerKW {
+		log.Debugf("Estimated fee rate of %v sat/kw is too low, "+
+			"using fee floor of %v sat/kw instead", satPerKw,
+			b.minFeePerKW)
+
+		satPerKw = b.minFeePerKW
+	}
+
+	log.Debugf("Returning %v sat/kw for conf target of %v",
+		int64(satPerKw), confTarget)
+
+	return satPerKw, nil
+}
+
+// A compile-time assertion to ensure that BitcoindEstimator implements the
+// Estimator interface.
+var _ Estimator = (*BitcoindEstimator)(nil)
+
+// WebAPIFeeSource is an interface that allows the WebAPIEstimator to query an
+// arbitrary HTTP-based fee estimation service.
+type WebAPIFeeSource interface {
+	// GenQueryURL generates the full query URL. The value returned by
+	// this method should be able to be used directly as a path for an
+	// HTTP GET request.
+	GenQueryURL() string
+
+	// ParseResponse attempts to parse the body of the response generated
+	// by the above query URL. Typically this will be JSON, but the
+	// specifics are left to the WebAPIFeeSource implementation. The
+	// returned map maps each confirmation target to the corresponding fee
+	// rate in sat/kb.
+	ParseResponse(r io.Reader) (map[uint32]uint32, error)
+}
+
+// SparseConfFeeSource is an implementation of the WebAPIFeeSource interface
+// that expects a response of the following form:
+//
+//   {"fee_by_block_target": {"2": 20000, "6": 10000, "144": 1000}}
+//
+// Fee rates are expressed in sat/kb. As the name suggests, the service
+// doesn't need to return an estimate for every single confirmation target.
+type SparseConfFeeSource struct {
+	// URL is the fee estimation API specified by the user.
+	URL string
+}
+
+// GenQueryURL generates the full query URL. The value returned by this
+// method should be able to be used directly as a path for an HTTP GET
+// request.
+//
+// NOTE: This method is part of the WebAPIFeeSource interface.
+func (s SparseConfFeeSource) GenQueryURL() string {
+	return s.URL
+}
+
+// ParseResponse attempts to parse the body of the response generated by the
+// above query URL.
+//
+// NOTE: This method is part of the WebAPIFeeSource interface.
+func (s SparseConfFeeSource) ParseResponse(r io.Reader) (map[uint32]uint32,
+	error) {
+
+	type jsonResp struct {
+		FeeByBlockTarget map[uint32]uint32 `json:"fee_by_block_target"`
+	}
+
+	resp := jsonResp{
+		FeeByBlockTarget: make(map[uint32]uint32),
+	}
+	jsonReader := json.NewDecoder(r)
+	if err := jsonReader.Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp.FeeByBlockTarget, nil
+}
+
+// A compile-time assertion to ensure that SparseConfFeeSource implements the
+// WebAPIFeeSource interface.
+var _ WebAPIFeeSource = (*SparseConfFeeSource)(nil)
+
+// WebAPIEstimator is an implementation of the Estimator interface that
+// queries an HTTP-based fee estimation service from time to time. The most
+// recent estimates are cached for each confirmation target, such that
+// requests for fee estimates never block on the web API.
+type WebAPIEstimator struct {
+	started int32 // To be used atomically.
+	stopped int32 // To be used atomically.
+
+	// apiSource is the backing web API source we'll use for our queries.
+	apiSource WebAPIFeeSource
+
+	// client is the HTTP client used to query the web API.
+	client *http.Client
+
+	// feeByBlockTarget is our cache for fees pulled from the API. When a
+	// fee estimate request comes in, we pull the estimate from this map
+	// rather than re-querying the API, to ensure we don't hit a rate
+	// limit or otherwise block on the web API.
+	feeByBlockTarget map[uint32]uint32
+	feesMtx          sync.Mutex
+
+	// defaultFeePerKw is a fallback value that we'll use if we're unable
+	// to query the API for any reason.
+	defaultFeePerKw SatPerKWeight
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWebAPIEstimator creates a new WebAPIEstimator from a given URL and a
+// fallback default fee. The fallback fee is used until the first successful
+// query of the web API.
+func NewWebAPIEstimator(api WebAPIFeeSource,
+	defaultFee SatPerKWeight) *WebAPIEstimator {
+
+	return &WebAPIEstimator{
+		apiSource: api,
+		client: &http.Client{
+			Timeout: webAPITimeout,
+		},
+		feeByBlockTarget: make(map[uint32]uint32),
+		defaultFeePerKw:  defaultFee,
+		quit:             make(chan struct{}),
+	}
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the Estimator interface.
+func (w *WebAPIEstimator) EstimateFeePerKW(numBlocks uint32) (SatPerKWeight,
+	error) {
+
+	feePerKb, err := w.getCachedFee(numBlocks)
+	if err != nil {
+		log.Debugf("Unable to use cached fee estimate for conf target "+
+			"of %v: %v, using default fee rate of %v sat/kw",
+			numBlocks, err, int64(w.defaultFeePerKw))
+
+		return w.defaultFeePerKw, nil
+	}
+
+	// The fee rate is expressed in sat/kb, so we'll convert it to sat/kw
+	// and enforce our fee floor.
+	satPerKw := SatPerKVByte(feePerKb).FeePerKWeight()
+	if satPerKw < FeePerKwFloor {
+		satPerKw = FeePerKwFloor
+	}
+
+	log.Debugf("Web API returning %v sat/kw for conf target of %v",
+		int64(satPerKw), numBlocks)
+
+	return satPerKw, nil
+}
+
+// Start signals the Estimator to start any processes or goroutines it needs
+// to perform its duty.
+//
+// NOTE: This method is part of the Estimator interface.
+func (w *WebAPIEstimator) Start() error {
+	if !atomic.CompareAndSwapInt32(&w.started, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Starting web API fee estimator")
+
+	// Once we've started, we'll populate our cache with an initial set of
+	// fee estimates. A failure to do so isn't fatal, as we'll fall back to
+	// the default fee rate until the next attempt succeeds.
+	w.updateFeeEstimates()
+
+	w.wg.Add(1)
+	go w.feeUpdateManager()
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used by the
+// fee estimator.
+//
+// NOTE: This method is part of the Estimator interface.
+func (w *WebAPIEstimator) Stop() error {
+	if !atomic.CompareAndSwapInt32(&w.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Infof("Stopping web API fee estimator")
+
+	close(w.quit)
+	w.wg.Wait()
+
+	return nil
+}
+
+// RelayFeePerKW returns the minimum fee rate required for transactions to be
+// relayed. As the web API doesn't provide this information, our fee floor is
+// used.
+//
+// NOTE: This method is part of the Estimator interface.
+func (w *WebAPIEstimator) RelayFeePerKW() SatPerKWeight {
+	return FeePerKwFloor
+}
+
+// getCachedFee takes a conf target and returns the cached fee rate in sat/kb
+// for it. If no estimate is cached for the exact target, then the estimate of
+// the closest lower target is returned, which yields a conservative fee rate.
+// If no lower target is cached either, then the estimate for the lowest
+// cached target is returned.
+func (w *WebAPIEstimator) getCachedFee(numBlocks uint32) (uint32, error) {
+	w.feesMtx.Lock()
+	defer w.feesMtx.Unlock()
+
+	if len(w.feeByBlockTarget) == 0 {
+		return 0, fmt.Errorf("no fee estimates cached")
+	}
+
+	// We'll first clamp the conf target to the range of targets we cache
+	// fees for.
+	switch {
+	case numBlocks < minBlockTarget:
+		numBlocks = minBlockTarget
+	case numBlocks > maxBlockTarget:
+		numBlocks = maxBlockTarget
+	}
+
+	fee, ok := w.feeByBlockTarget[numBlocks]
+	if ok {
+		return fee, nil
+	}
+
+	targets := make([]uint32, 0, len(w.feeByBlockTarget))
+	for target := range w.feeByBlockTarget {
+		targets = append(targets, target)
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i] < targets[j]
+	})
+
+	closest := targets[0]
+	for _, target := range targets {
+		if target > numBlocks {
+			break
+		}
+		closest = target
+	}
+
+	return w.feeByBlockTarget[closest], nil
+}
+
+// updateFeeEstimates re-queries the web API for the latest fee estimates and
+// replaces our cache with them. If the query fails, the previously cached
+// estimates are retained.
+func (w *WebAPIEstimator) updateFeeEstimates() {
+	targetURL := w.apiSource.GenQueryURL()
+	resp, err := w.client.Get(targetURL)
+	if err != nil {
+		log.Errorf("Unable to query web API for fee estimates: %v", err)
+		return
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		log.Errorf("Unable to query web API for fee estimates: "+
+			"unexpected status %v", resp.Status)
+		return
+	}
+
+	feesByBlockTarget, err := w.apiSource.ParseResponse(resp.Body)
+	if err != nil {
+		log.Errorf("Unable to parse fee estimates of web API: %v", err)
+		return
+	}
+
+	// We'll only cache estimates for targets within our supported range.
+	feeCache := make(map[uint32]uint32, len(feesByBlockTarget))
+	for target, fee := range feesByBlockTarget {
+		if target < minBlockTarget || target > maxBlockTarget {
+			continue
+		}
+		feeCache[target] = fee
+	}
+	if len(feeCache) == 0 {
+		log.Errorf("Web API returned no usable fee estimates")
+		return
+	}
+
+	w.feesMtx.Lock()
+	w.feeByBlockTarget = feeCache
+	w.feesMtx.Unlock()
+
+	log.Debugf("Updated fee estimates of web API for %v conf targets",
+		len(feeCache))
+}
+
+// feeUpdateManager periodically refreshes the cached fee estimates.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (w *WebAPIEstimator) feeUpdateManager() {
+	defer w.wg.Done()
+
+	updateTicker := time.NewTicker(webAPIFeeUpdateInterval)
+	defer updateTicker.Stop()
+
+	for {
+		select {
+		case <-updateTicker.C:
+			w.updateFeeEstimates()
+
+		case <-w.quit:
+			return
+		}
+	}
+}
+
+// A compile-time assertion to ensure that WebAPIEstimator implements the
+// Estimator interface.
+var _ Estimator = (*WebAPIEstimator)(nil)
@@ -0,0 +1,250 @@
+package chainfee
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestStaticFeeEstimator checks that the StaticEstimator
+// returns the expected fee rate.
+func TestStaticFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	const feePerKw = FeePerKwFloor
+
+	feeEstimator := NewStaticEstimator(feePerKw, 0)
+	if err := feeEstimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator: %v", err)
+	}
+	defer feeEstimator.Stop()
+
+	feeRate, err := feeEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to get fee rate: %v", err)
+	}
+
+	if feeRate != feePerKw {
+		t.Fatalf("expected fee rate %v, got %v", feePerKw, feeRate)
+	}
+}
+
+// TestSparseConfFeeSource checks that SparseConfFeeSource generates the
+// correct query URL and parses responses correctly.
+func TestSparseConfFeeSource(t *testing.T) {
+	t.Parallel()
+
+	const testURL = "https://example.com/fees"
+	feeSource := SparseConfFeeSource{URL: testURL}
+	if feeSource.GenQueryURL() != testURL {
+		t.Fatalf("expected query URL %v, got %v", testURL,
+			feeSource.GenQueryURL())
+	}
+
+	resp := `{"fee_by_block_target": {"2": 42, "3": 54321, "6": 7000}}`
+	fees, err := feeSource.ParseResponse(strings.NewReader(resp))
+	if err != nil {
+		t.Fatalf("unable to parse response: %v", err)
+	}
+
+	expected := map[uint32]uint32{2: 42, 3: 54321, 6: 7000}
+	if len(fees) != len(expected) {
+		t.Fatalf("expected %d fees, got %d", len(expected), len(fees))
+	}
+	for target, fee := range expected {
+		if fees[target] != fee {
+			t.Fatalf("expected fee %d for target %d, got %d", fee,
+				target, fees[target])
+		}
+	}
+
+	// A malformed response should result in an error.
+	_, err = feeSource.ParseResponse(strings.NewReader("{"))
+	if err == nil {
+		t.Fatalf("expected malformed response to fail parsing")
+	}
+}
+
+// mockFeeSource is a WebAPIFeeSource that returns a fixed set of fees.
+type mockFeeSource struct {
+	url  string
+	fees map[uint32]uint32
+}
+
+func (m *mockFeeSource) GenQueryURL() string {
+	return m.url
+}
+
+func (m *mockFeeSource) ParseResponse(r io.Reader) (map[uint32]uint32,
+	error) {
+
+	return m.fees, nil
+}
+
+// TestWebAPIFeeEstimator checks that the WebAPIEstimator returns the cached
+// fee rate of the closest conf target, enforces the fee floor, and falls back
+// to the default fee rate if the web API couldn't be queried.
+func TestWebAPIFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, "{}")
+		},
+	))
+	defer server.Close()
+
+	const defaultFee = SatPerKWeight(12500)
+
+	// With a web API that can't be reached, the default fee rate should
+	// be returned.
+	unreachable := NewWebAPIEstimator(
+		&mockFeeSource{url: "http://127.0.0.1:0"}, defaultFee,
+	)
+	if err := unreachable.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator: %v", err)
+	}
+	defer unreachable.Stop()
+
+	feeRate, err := unreachable.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to get fee rate: %v", err)
+	}
+	if feeRate != defaultFee {
+		t.Fatalf("expected default fee rate %v, got %v", defaultFee,
+			feeRate)
+	}
+
+	feeSource := &mockFeeSource{
+		url: server.URL,
+		fees: map[uint32]uint32{
+			3:    40000,
+			6:    20000,
+			144:  100,
+			2000: 10,
+		},
+	}
+	feeEstimator := NewWebAPIEstimator(feeSource, defaultFee)
+	if err := feeEstimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator: %v", err)
+	}
+	defer feeEstimator.Stop()
+
+	testCases := []struct {
+		name      string
+		numBlocks uint32
+		expected  SatPerKWeight
+	}{
+		{
+			name:      "below lowest target",
+			numBlocks: 1,
+			expected:  SatPerKVByte(40000).FeePerKWeight(),
+		},
+		{
+			name:      "exact target",
+			numBlocks: 6,
+			expected:  SatPerKVByte(20000).FeePerKWeight(),
+		},
+		{
+			name:      "between targets",
+			numBlocks: 20,
+			expected:  SatPerKVByte(20000).FeePerKWeight(),
+		},
+		{
+			name:      "below fee floor",
+			numBlocks: 144,
+			expected:  FeePerKwFloor,
+		},
+		{
+			name:      "above max target",
+			numBlocks: 5000,
+			expected:  FeePerKwFloor,
+		},
+	}
+
+	for _, test := range testCases {
+		feeRate, err := feeEstimator.EstimateFeePerKW(test.numBlocks)
+		if err != nil {
+			t.Fatalf("%s: unable to get fee rate: %v", test.name,
+				err)
+		}
+		if feeRate != test.expected {
+			t.Fatalf("%s: expected fee rate %v, got %v", test.name,
+				test.expected, feeRate)
+		}
+	}
+}
+
+// TestClampedEstimator checks that the ClampedEstimator clamps the fee rates
+// of the wrapped estimator within its current bounds.
+func TestClampedEstimator(t *testing.T) {
+	t.Parallel()
+
+	const feePerKw = SatPerKWeight(5000)
+
+	clamped, err := NewClampedEstimator(
+		NewStaticEstimator(feePerKw, 0), 0, 0,
+	)
+	if err != nil {
+		t.Fatalf("unable to create clamped estimator: %v", err)
+	}
+
+	testCases := []struct {
+		name     string
+		min      SatPerKWeight
+		max      SatPerKWeight
+		expected SatPerKWeight
+	}{
+		{
+			name:     "no bounds",
+			expected: feePerKw,
+		},
+		{
+			name:     "within bounds",
+			min:      1000,
+			max:      10000,
+			expected: feePerKw,
+		},
+		{
+			name:     "below minimum",
+			min:      7500,
+			expected: 7500,
+		},
+		{
+			name:     "above maximum",
+			max:      2500,
+			expected: 2500,
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := clamped.SetBounds(testCase.min, testCase.max)
+		if err != nil {
+			t.Fatalf("%v: unable to set bounds: %v",
+				testCase.name, err)
+		}
+
+		feeRate, err := clamped.EstimateFeePerKW(6)
+		if err != nil {
+			t.Fatalf("%v: unable to get fee rate: %v",
+				testCase.name, err)
+		}
+		if feeRate != testCase.expected {
+			t.Fatalf("%v: expected fee rate %v, got %v",
+				testCase.name, testCase.expected, feeRate)
+		}
+	}
+
+	// Finally, a minimum above the maximum should be rejected, leaving the
+	// current bounds untouched.
+	if err := clamped.SetBounds(5000, 2500); err == nil {
+		t.Fatalf("expected invalid bounds to be rejected")
+	}
+	min, max := clamped.Bounds()
+	if min != 0 || max != 2500 {
+		t.Fatalf("expected bounds [0, 2500], got [%v, %v]", min, max)
+	}
+}
@@ -1,10 +1,9 @@
-package lnwallet_test
+package chainfee
 
 import (
 	"testing"
 
 	"github.com/btcsuite/btcutil"
-	"github.com/lightningnetwork/lnd/lnwallet"
 )
 
 // TestFeeRateTypes checks that converting fee rates between the
@@ -19,9 +18,9 @@ func TestFeeRateTypes(t *testing.T) {
 	const weight = vsize * 4
 
 	// Test the conversion from sat/kw to sat/kb.
-	for feePerKw := lnwallet.SatPerKWeight(250); feePerKw < 10000; feePerKw += 50 {
+	for feePerKw := SatPerKWeight(250); feePerKw < 10000; feePerKw += 50 {
 		feePerKB := feePerKw.FeePerKVByte()
-		if feePerKB != lnwallet.SatPerKVByte(feePerKw*4) {
+		if feePerKB != SatPerKVByte(feePerKw*4) {
 			t.Fatalf("expected %d sat/kb, got %d sat/kb when "+
 				"converting from %d sat/kw", feePerKw*4,
 				feePerKB, feePerKw)
@@ -43,9 +42,9 @@ func TestFeeRateTypes(t *testing.T) {
 	}
 
 	// Test the conversion from sat/kb to sat/kw.
-	for feePerKB := lnwallet.SatPerKVByte(1000); feePerKB < 40000; feePerKB += 1000 {
+	for feePerKB := SatPerKVByte(1000); feePerKB < 40000; feePerKB += 1000 {
 		feePerKw := feePerKB.FeePerKWeight()
-		if feePerKw != lnwallet.SatPerKWeight(feePerKB/4) {
+		if feePerKw != SatPerKWeight(feePerKB/4) {
 			t.Fatalf("expected %d sat/kw, got %d sat/kw when "+
 				"converting from %d sat/kb", feePerKB/4,
 				feePerKw, feePerKB)
@@ -66,26 +65,3 @@ func TestFeeRateTypes(t *testing.T) {
 		}
 	}
 }
-
-// TestStaticFeeEstimator checks that the StaticFeeEstimator
-// returns the expected fee rate.
-func TestStaticFeeEstimator(t *testing.T) {
-	t.Parallel()
-
-	const feePerKw = lnwallet.FeePerKwFloor
-
-	feeEstimator := lnwallet.NewStaticFeeEstimator(feePerKw, 0)
-	if err := feeEstimator.Start(); err != nil {
-		t.Fatalf("unable to start fee estimator: %v", err)
-	}
-	defer feeEstimator.Stop()
-
-	feeRate, err := feeEstimator.EstimateFeePerKW(6)
-	if err != nil {
-		t.Fatalf("unable to get fee rate: %v", err)
-	}
-
-	if feeRate != feePerKw {
-		t.Fatalf("expected fee rate %v, got %v", feePerKw, feeRate)
-	}
-}
@@ -20,6 +20,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -306,6 +307,11 @@ type PaymentDescriptor struct {
 	// NOTE: Populated only on add payment descriptor entry types.
 	OnionBlob []byte
 
+	// Endorsed signals whether the sender of the HTLC endorsed it.
+	//
+	// NOTE: Populated only on add payment descriptor entry types.
+	Endorsed bool
+
 	// ShaOnionBlob is a sha of the onion blob.
 	//
 	// NOTE: Populated only in payment descriptor with MalformedFail type.
@@ -387,6 +393,7 @@ func PayDescsFromRemoteLogUpdates(chanID lnwire.ShortChannelID, height uint64,
 			}
 			pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
 			copy(pd.OnionBlob[:], wireMsg.OnionBlob[:])
+			pd.Endorsed = wireMsg.Endorsed
 
 		case *lnwire.UpdateFulfillHTLC:
 			pd = PaymentDescriptor{
@@ -496,7 +503,7 @@ type commitment struct {
 
 	// feePerKw is the fee per kw used to calculate this commitment
 	// transaction's fee.
-	feePerKw SatPerKWeight
+	feePerKw chainfee.SatPerKWeight
 
 	// dustLimit is the limit on the commitment transaction such that no
 	// output values should be below this amount.
@@ -743,7 +750,7 @@ func (c *commitment) toDiskCommit(ourCommit bool) *channeldb.ChannelCommitment {
 // commitment struct and updateLog. This function is used when we need to
 // restore commitment state written do disk back into memory once we need to
 // restart a channel session.
-func (lc *LightningChannel) diskHtlcToPayDesc(feeRate SatPerKWeight,
+func (lc *LightningChannel) diskHtlcToPayDesc(feeRate chainfee.SatPerKWeight,
 	commitHeight uint64, htlc *channeldb.HTLC, localCommitKeys,
 	remoteCommitKeys *CommitmentKeyRing) (PaymentDescriptor, error) {
 
@@ -808,7 +815,7 @@ func (lc *LightningChannel) diskHtlcToPayDesc(feeRate SatPerKWeight,
 // these payment descriptors can be re-inserted into the in-memory updateLog
 // for each side.
 func (lc *LightningChannel) extractPayDescs(commitHeight uint64,
-	feeRate SatPerKWeight, htlcs []channeldb.HTLC, localCommitKeys,
+	feeRate chainfee.SatPerKWeight, htlcs []channeldb.HTLC, localCommitKeys,
 	remoteCommitKeys *CommitmentKeyRing) ([]PaymentDescriptor, []PaymentDescriptor, error) {
 
 	var (
@@ -868,7 +875,7 @@ func (lc *LightningChannel) diskCommitToMemCommit(isLocal bool,
 	// HTLC"s into PaymentDescriptor's so we can re-insert them into our
 	// update log.
 	incomingHtlcs, outgoingHtlcs, err := lc.extractPayDescs(
-		diskCommit.CommitHeight, SatPerKWeight(diskCommit.FeePerKw),
+		diskCommit.CommitHeight, chainfee.SatPerKWeight(diskCommit.FeePerKw),
 		diskCommit.Htlcs, localCommitKeys, remoteCommitKeys,
 	)
 	if err != nil {
@@ -889,7 +896,7 @@ func (lc *LightningChannel) diskCommitToMemCommit(isLocal bool,
 		txn:               diskCommit.CommitTx,
 		sig:               diskCommit.CommitSig,
 		fee:               diskCommit.CommitFee,
-		feePerKw:          SatPerKWeight(diskCommit.FeePerKw),
+		feePerKw:          chainfee.SatPerKWeight(diskCommit.FeePerKw),
 		incomingHTLCs:     incomingHtlcs,
 		outgoingHTLCs:     outgoingHtlcs,
 	}
@@ -1482,7 +1489,7 @@ func (lc *LightningChannel) ResetState() {
 // if nothing happened.
 func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 	remoteUpdateLog *updateLog, commitHeight uint64,
-	feeRate SatPerKWeight, remoteCommitKeys *CommitmentKeyRing,
+	feeRate chainfee.SatPerKWeight, remoteCommitKeys *CommitmentKeyRing,
 	remoteDustLimit btcutil.Amount) (*PaymentDescriptor, error) {
 
 	// Depending on the type of update message we'll map that to a distinct
@@ -1512,6 +1519,7 @@ func (lc *LightningChannel) logUpdateToPayDesc(logUpdate *channeldb.LogUpdate,
 		}
 		pd.OnionBlob = make([]byte, len(wireMsg.OnionBlob))
 		copy(pd.OnionBlob[:], wireMsg.OnionBlob[:])
+		pd.Endorsed = wireMsg.Endorsed
 
 		isDustRemote := htlcIsDust(false, false, feeRate,
 			wireMsg.Amount.ToSatoshis(), remoteDustLimit)
@@ -1798,7 +1806,7 @@ func (lc *LightningChannel) restoreStateLogs(
 	for _, logUpdate := range pendingRemoteCommitDiff.LogUpdates {
 		payDesc, err := lc.logUpdateToPayDesc(
 			&logUpdate, lc.remoteUpdateLog, pendingHeight,
-			SatPerKWeight(pendingCommit.FeePerKw), pendingRemoteKeys,
+			chainfee.SatPerKWeight(pendingCommit.FeePerKw), pendingRemoteKeys,
 			lc.channelState.RemoteChanCfg.DustLimit,
 		)
 		if err != nil {
@@ -2072,7 +2080,7 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 		// an output on the commitment transaction.
 		if htlcIsDust(
 			htlc.Incoming, false,
-			SatPerKWeight(revokedSnapshot.FeePerKw),
+			chainfee.SatPerKWeight(revokedSnapshot.FeePerKw),
 			htlc.Amt.ToSatoshis(), chanState.RemoteChanCfg.DustLimit,
 		) {
 			continue
@@ -2161,13 +2169,13 @@ func NewBreachRetribution(chanState *channeldb.OpenChannel, stateNum uint64,
 
 // htlcTimeoutFee returns the fee in satoshis required for an HTLC timeout
 // transaction based on the current fee rate.
-func htlcTimeoutFee(feePerKw SatPerKWeight) btcutil.Amount {
+func htlcTimeoutFee(feePerKw chainfee.SatPerKWeight) btcutil.Amount {
 	return feePerKw.FeeForWeight(input.HtlcTimeoutWeight)
 }
 
 // htlcSuccessFee returns the fee in satoshis required for an HTLC success
 // transaction based on the current fee rate.
-func htlcSuccessFee(feePerKw SatPerKWeight) btcutil.Amount {
+func htlcSuccessFee(feePerKw chainfee.SatPerKWeight) btcutil.Amount {
 	return feePerKw.FeeForWeight(input.HtlcSuccessWeight)
 }
 
@@ -2177,7 +2185,7 @@ func htlcSuccessFee(feePerKw SatPerKWeight) btcutil.Amount {
 // require as we currently used second-level HTLC transactions as off-chain
 // covenants. Depending on the two bits, we'll either be using a timeout or
 // success transaction which have different weights.
-func htlcIsDust(incoming, ourCommit bool, feePerKw SatPerKWeight,
+func htlcIsDust(incoming, ourCommit bool, feePerKw chainfee.SatPerKWeight,
 	htlcAmt, dustLimit btcutil.Amount) bool {
 
 	// First we'll determine the fee required for this HTLC based on if this is
@@ -2218,7 +2226,7 @@ func htlcIsDust(incoming, ourCommit bool, feePerKw SatPerKWeight,
 type htlcView struct {
 	ourUpdates   []*PaymentDescriptor
 	theirUpdates []*PaymentDescriptor
-	feePerKw     SatPerKWeight
+	feePerKw     chainfee.SatPerKWeight
 }
 
 // fetchHTLCView returns all the candidate HTLC updates which should be
@@ -2341,7 +2349,7 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	ourBalance := c.ourBalance
 	theirBalance := c.theirBalance
 
-	numHTLCs := int64(0)
+	numHTLCs := 0
 	for _, htlc := range filteredHTLCView.ourUpdates {
 		if htlcIsDust(false, c.isOurs, c.feePerKw,
 			htlc.Amount.ToSatoshis(), c.dustLimit) {
@@ -2365,11 +2373,14 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 	// on its total weight. Once we have the total weight, we'll multiply
 	// by the current fee-per-kw, then divide by 1000 to get the proper
 	// fee.
-	totalCommitWeight := input.CommitWeight + (input.HtlcWeight * numHTLCs)
+	chanType := lc.channelState.ChanType
+	totalCommitWeight := CommitWeight(numHTLCs, chanType)
 
 	// With the weight known, we can now calculate the commitment fee,
-	// ensuring that we account for any dust outputs trimmed above.
-	commitFee := c.feePerKw.FeeForWeight(totalCommitWeight)
+	// ensuring that we account for any dust outputs trimmed above. For
+	// channels using anchor outputs, the initiator also pays for the value
+	// of both anchors.
+	commitFee := CommitFeeForWeight(chanType, c.feePerKw, totalCommitWeight)
 	commitFeeMSat := lnwire.NewMSatFromSatoshis(commitFee)
 
 	// Currently, within the protocol, the initiator always pays the fees.
@@ -2412,6 +2423,26 @@ func (lc *LightningChannel) createCommitmentTx(c *commitment,
 		return err
 	}
 
+	// If the channel uses anchor outputs, we'll add an anchor for each
+	// party that has something at stake within this commitment.
+	if chanType.HasAnchors() {
+		localFundingKey := lc.localChanCfg.MultiSigKey.PubKey
+		remoteFundingKey := lc.remoteChanCfg.MultiSigKey.PubKey
+		if !c.isOurs {
+			localFundingKey, remoteFundingKey = remoteFundingKey,
+				localFundingKey
+		}
+
+		err := addAnchorOutputs(
+			commitTx, localFundingKey, remoteFundingKey,
+			delayBalance >= c.dustLimit,
+			p2wkhBalance >= c.dustLimit, numHTLCs > 0,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	// We'll now add all the HTLC outputs to the commitment transaction.
 	// Each output includes an off-chain 2-of-2 covenant clause, so we'll
 	// need the objective local/remote keys for this particular commitment
@@ -2741,7 +2772,7 @@ func processFeeUpdate(feeUpdate *PaymentDescriptor, nextHeight uint64,
 
 	// If the update wasn't already locked in, update the current fee rate
 	// to reflect this update.
-	view.feePerKw = SatPerKWeight(feeUpdate.Amount.ToSatoshis())
+	view.feePerKw = chainfee.SatPerKWeight(feeUpdate.Amount.ToSatoshis())
 
 	if mutateState {
 		*addHeight = nextHeight
@@ -2942,6 +2973,7 @@ func (lc *LightningChannel) createCommitDiff(
 				Amount:      pd.Amount,
 				Expiry:      pd.Timeout,
 				PaymentHash: pd.RHash,
+				Endorsed:    pd.Endorsed,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -3619,16 +3651,16 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 		&theirBalance, nextHeight, remoteChain, updateState)
 	feePerKw := filteredHTLCView.feePerKw
 
-	// Now go through all HTLCs at this stage, to calculate the total
-	// weight, needed to calculate the transaction fee.
-	var totalHtlcWeight int64
+	// Now go through all HTLCs at this stage, to count the non-dust ones,
+	// needed to calculate the total weight and transaction fee.
+	var numHTLCs int
 	for _, htlc := range filteredHTLCView.ourUpdates {
 		if htlcIsDust(remoteChain, !remoteChain, feePerKw,
 			htlc.Amount.ToSatoshis(), dustLimit) {
 			continue
 		}
 
-		totalHtlcWeight += input.HtlcWeight
+		numHTLCs++
 	}
 	for _, htlc := range filteredHTLCView.theirUpdates {
 		if htlcIsDust(!remoteChain, !remoteChain, feePerKw,
@@ -3636,10 +3668,10 @@ func (lc *LightningChannel) computeView(view *htlcView, remoteChain bool,
 			continue
 		}
 
-		totalHtlcWeight += input.HtlcWeight
+		numHTLCs++
 	}
 
-	totalCommitWeight := input.CommitWeight + totalHtlcWeight
+	totalCommitWeight := CommitWeight(numHTLCs, lc.channelState.ChanType)
 	return ourBalance, theirBalance, totalCommitWeight, filteredHTLCView
 }
 
@@ -3678,7 +3710,9 @@ func (lc *LightningChannel) validateCommitmentSanity(theirLogCounter,
 
 	// Calculate the commitment fee, and subtract it from the initiator's
 	// balance.
-	commitFee := feePerKw.FeeForWeight(commitWeight)
+	commitFee := CommitFeeForWeight(
+		lc.channelState.ChanType, feePerKw, commitWeight,
+	)
 	commitFeeMsat := lnwire.NewMSatFromSatoshis(commitFee)
 	if lc.channelState.IsInitiator {
 		ourBalance -= commitFeeMsat
@@ -4245,13 +4279,17 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 	lc.Lock()
 	defer lc.Unlock()
 
-	// Ensure that the new pre-image can be placed in preimage store.
+	// Ensure that the new pre-image can be placed in preimage store. The
+	// revealed secret must be the one of the commitment being revoked, the
+	// tail of the remote commitment chain, otherwise the store would get
+	// out of sync with the commitments it's supposed to revoke.
 	store := lc.channelState.RevocationStore
 	revocation, err := chainhash.NewHash(revMsg.Revocation[:])
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	if err := store.AddNextEntry(revocation); err != nil {
+	revokedHeight := lc.remoteCommitChain.tail().height
+	if err := store.AddEntry(revokedHeight, revocation); err != nil {
 		return nil, nil, nil, err
 	}
 
@@ -4386,6 +4424,7 @@ func (lc *LightningChannel) ReceiveRevocation(revMsg *lnwire.RevokeAndAck) (
 				Amount:      pd.Amount,
 				Expiry:      pd.Timeout,
 				PaymentHash: pd.RHash,
+				Endorsed:    pd.Endorsed,
 			}
 			copy(htlc.OnionBlob[:], pd.OnionBlob)
 			logUpdate.UpdateMsg = htlc
@@ -4532,6 +4571,7 @@ func (lc *LightningChannel) AddHTLC(htlc *lnwire.UpdateAddHTLC,
 		LogIndex:       lc.localUpdateLog.logIndex,
 		HtlcIndex:      lc.localUpdateLog.htlcCounter,
 		OnionBlob:      htlc.OnionBlob[:],
+		Endorsed:       htlc.Endorsed,
 		OpenCircuitKey: openKey,
 	}
 
@@ -4570,6 +4610,7 @@ func (lc *LightningChannel) ReceiveHTLC(htlc *lnwire.UpdateAddHTLC) (uint64, err
 		LogIndex:  lc.remoteUpdateLog.logIndex,
 		HtlcIndex: lc.remoteUpdateLog.htlcCounter,
 		OnionBlob: htlc.OnionBlob[:],
+		Endorsed:  htlc.Endorsed,
 	}
 
 	lc.remoteUpdateLog.appendHtlc(pd)
@@ -5062,7 +5103,7 @@ func NewUnilateralCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	// Next, we'll obtain HTLC resolutions for all the outgoing HTLC's we
 	// had on their commitment transaction.
 	htlcResolutions, err := extractHtlcResolutions(
-		SatPerKWeight(remoteCommit.FeePerKw), false, signer, remoteCommit.Htlcs,
+		chainfee.SatPerKWeight(remoteCommit.FeePerKw), false, signer, remoteCommit.Htlcs,
 		keyRing, &chanState.LocalChanCfg, &chanState.RemoteChanCfg,
 		*commitSpend.SpenderTxHash, pCache,
 	)
@@ -5253,7 +5294,7 @@ type HtlcResolutions struct {
 // the remote party's commitment transaction.
 func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.ChannelConfig,
 	commitHash chainhash.Hash, htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
-	feePerKw SatPerKWeight, dustLimit btcutil.Amount, csvDelay uint32, localCommit bool,
+	feePerKw chainfee.SatPerKWeight, dustLimit btcutil.Amount, csvDelay uint32, localCommit bool,
 ) (*OutgoingHtlcResolution, error) {
 
 	op := wire.OutPoint{
@@ -5393,7 +5434,7 @@ func newOutgoingHtlcResolution(signer input.Signer, localChanCfg *channeldb.Chan
 // TODO(roasbeef) consolidate code with above func
 func newIncomingHtlcResolution(signer input.Signer, localChanCfg *channeldb.ChannelConfig,
 	commitHash chainhash.Hash, htlc *channeldb.HTLC, keyRing *CommitmentKeyRing,
-	feePerKw SatPerKWeight, dustLimit btcutil.Amount, csvDelay uint32,
+	feePerKw chainfee.SatPerKWeight, dustLimit btcutil.Amount, csvDelay uint32,
 	localCommit bool, preimage [32]byte) (*IncomingHtlcResolution, error) {
 
 	op := wire.OutPoint{
@@ -5547,7 +5588,7 @@ func (r *OutgoingHtlcResolution) HtlcPoint() wire.OutPoint {
 // extractHtlcResolutions creates a series of outgoing HTLC resolutions, and
 // the local key used when generating the HTLC scrips. This function is to be
 // used in two cases: force close, or a unilateral close.
-func extractHtlcResolutions(feePerKw SatPerKWeight, ourCommit bool,
+func extractHtlcResolutions(feePerKw chainfee.SatPerKWeight, ourCommit bool,
 	signer input.Signer, htlcs []channeldb.HTLC, keyRing *CommitmentKeyRing,
 	localChanCfg, remoteChanCfg *channeldb.ChannelConfig,
 	commitHash chainhash.Hash, pCache PreimageCache) (*HtlcResolutions, error) {
@@ -5642,6 +5683,14 @@ type LocalForceCloseSummary struct {
 	// HTLC's, we'll need to go to the second level to sweep them fully.
 	HtlcResolutions *HtlcResolutions
 
+	// AnchorResolution contains the data required to sweep our anchor
+	// output in order to bump the fee of the commitment transaction using
+	// CPFP.
+	//
+	// NOTE: This will be nil if the channel doesn't use anchor outputs, or
+	// if our anchor isn't present on the commitment transaction.
+	AnchorResolution *AnchorResolution
+
 	// ChanSnapshot is a snapshot of the final state of the channel at the
 	// time the summary was created.
 	ChanSnapshot channeldb.ChannelSnapshot
@@ -5774,18 +5823,27 @@ func NewLocalForceCloseSummary(chanState *channeldb.OpenChannel, signer input.Si
 	// outgoing HTLC's that we'll need to claim as well.
 	txHash := commitTx.TxHash()
 	htlcResolutions, err := extractHtlcResolutions(
-		SatPerKWeight(localCommit.FeePerKw), true, signer,
+		chainfee.SatPerKWeight(localCommit.FeePerKw), true, signer,
 		localCommit.Htlcs, keyRing, &chanState.LocalChanCfg,
 		&chanState.RemoteChanCfg, txHash, pCache)
 	if err != nil {
 		return nil, err
 	}
 
+	// Finally, if the channel uses anchor outputs, we'll also gather the
+	// information required to bump the fee of the commitment through our
+	// anchor.
+	anchorResolution, err := NewAnchorResolution(chanState, commitTx)
+	if err != nil {
+		return nil, err
+	}
+
 	return &LocalForceCloseSummary{
 		ChanPoint:        chanState.FundingOutpoint,
 		CloseTx:          commitTx,
 		CommitResolution: commitResolution,
 		HtlcResolutions:  htlcResolutions,
+		AnchorResolution: anchorResolution,
 		ChanSnapshot:     *chanState.Snapshot(),
 	}, nil
 }
@@ -5971,7 +6029,9 @@ func (lc *LightningChannel) availableBalance() (lnwire.MilliSatoshi, int64) {
 
 	// If we are the channel initiator, we must remember to subtract the
 	// commitment fee from our available balance.
-	commitFee := filteredView.feePerKw.FeeForWeight(commitWeight)
+	commitFee := CommitFeeForWeight(
+		lc.channelState.ChanType, filteredView.feePerKw, commitWeight,
+	)
 	if lc.channelState.IsInitiator {
 		ourBalance -= lnwire.NewMSatFromSatoshis(commitFee)
 	}
@@ -5979,6 +6039,110 @@ func (lc *LightningChannel) availableBalance() (lnwire.MilliSatoshi, int64) {
 	return ourBalance, commitWeight
 }
 
+// AvailableHtlcCapacity returns the number of HTLCs we can still offer the
+// remote party, and their maximum total value, without exceeding the
+// constraints the remote party imposed on us. Like AvailableBalance, this
+// accounts for all the log entries that would be evaluated if a new
+// commitment were created at this very instance.
+func (lc *LightningChannel) AvailableHtlcCapacity() (uint16,
+	lnwire.MilliSatoshi) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	remoteACKedIndex := lc.localCommitChain.tip().theirMessageIndex
+	htlcView := lc.fetchHTLCView(remoteACKedIndex,
+		lc.localUpdateLog.logIndex)
+	_, _, _, filteredView := lc.computeView(htlcView, false, false)
+
+	// Tally the HTLCs we've offered that are still in flight, the same
+	// way they're validated against our constraints when a new commitment
+	// is created.
+	var (
+		numInFlight uint16
+		amtInFlight lnwire.MilliSatoshi
+	)
+	for _, entry := range filteredView.ourUpdates {
+		if entry.EntryType == Add {
+			numInFlight++
+			amtInFlight += entry.Amount
+		}
+	}
+
+	var (
+		maxHtlcs  = lc.localChanCfg.MaxAcceptedHtlcs
+		maxAmount = lc.localChanCfg.MaxPendingAmount
+
+		numAvailable uint16
+		amtAvailable lnwire.MilliSatoshi
+	)
+	if numInFlight < maxHtlcs {
+		numAvailable = maxHtlcs - numInFlight
+	}
+	if amtInFlight < maxAmount {
+		amtAvailable = maxAmount - amtInFlight
+	}
+
+	return numAvailable, amtAvailable
+}
+
+// GetDustSum returns the total value of the HTLCs in the update logs that are
+// dust on either the local or the remote commitment, depending on the passed
+// boolean. Dust HTLCs don't get an output of their own, so their value would
+// go to miners if the channel was force closed with them in flight.
+func (lc *LightningChannel) GetDustSum(remote bool) lnwire.MilliSatoshi {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	var dustSum lnwire.MilliSatoshi
+
+	// HTLCs we've added are outgoing, while HTLCs the remote party has
+	// added are incoming.
+	for e := lc.localUpdateLog.Front(); e != nil; e = e.Next() {
+		pd := e.Value.(*PaymentDescriptor)
+		if pd.EntryType == Add && lc.isDust(pd.Amount, false, remote) {
+			dustSum += pd.Amount
+		}
+	}
+	for e := lc.remoteUpdateLog.Front(); e != nil; e = e.Next() {
+		pd := e.Value.(*PaymentDescriptor)
+		if pd.EntryType == Add && lc.isDust(pd.Amount, true, remote) {
+			dustSum += pd.Amount
+		}
+	}
+
+	return dustSum
+}
+
+// IsDustHtlc returns true if an HTLC of the given amount and direction would
+// be dust on either the local or the remote commitment, depending on the
+// passed boolean.
+func (lc *LightningChannel) IsDustHtlc(amt lnwire.MilliSatoshi, incoming,
+	remote bool) bool {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.isDust(amt, incoming, remote)
+}
+
+// isDust is the private, non mutexed version of IsDustHtlc.
+func (lc *LightningChannel) isDust(amt lnwire.MilliSatoshi, incoming,
+	remote bool) bool {
+
+	dustLimit := lc.channelState.LocalChanCfg.DustLimit
+	feePerKw := lc.channelState.LocalCommitment.FeePerKw
+	if remote {
+		dustLimit = lc.channelState.RemoteChanCfg.DustLimit
+		feePerKw = lc.channelState.RemoteCommitment.FeePerKw
+	}
+
+	return htlcIsDust(
+		incoming, !remote, chainfee.SatPerKWeight(feePerKw),
+		amt.ToSatoshis(), dustLimit,
+	)
+}
+
 // StateSnapshot returns a snapshot of the current fully committed state within
 // the channel.
 func (lc *LightningChannel) StateSnapshot() *channeldb.ChannelSnapshot {
@@ -5991,7 +6155,7 @@ func (lc *LightningChannel) StateSnapshot() *channeldb.ChannelSnapshot {
 // validateFeeRate ensures that if the passed fee is applied to the channel,
 // and a new commitment is created (which evaluates this fee), then the
 // initiator of the channel does not dip below their reserve.
-func (lc *LightningChannel) validateFeeRate(feePerKw SatPerKWeight) error {
+func (lc *LightningChannel) validateFeeRate(feePerKw chainfee.SatPerKWeight) error {
 	// We'll ensure that we can accommodate this new fee change, yet still
 	// be above our reserve balance. Otherwise, we'll reject the fee
 	// update.
@@ -6006,7 +6170,7 @@ func (lc *LightningChannel) validateFeeRate(feePerKw SatPerKWeight) error {
 	// a commitment now, we'll compute our remaining balance if we apply
 	// this new fee update.
 	newFee := lnwire.NewMSatFromSatoshis(
-		feePerKw.FeeForWeight(txWeight),
+		CommitFeeForWeight(lc.channelState.ChanType, feePerKw, txWeight),
 	)
 
 	// If the total fee exceeds our available balance (taking into account
@@ -6039,7 +6203,7 @@ func (lc *LightningChannel) validateFeeRate(feePerKw SatPerKWeight) error {
 // UpdateFee initiates a fee update for this channel. Must only be called by
 // the channel initiator, and must be called before sending update_fee to
 // the remote.
-func (lc *LightningChannel) UpdateFee(feePerKw SatPerKWeight) error {
+func (lc *LightningChannel) UpdateFee(feePerKw chainfee.SatPerKWeight) error {
 	lc.Lock()
 	defer lc.Unlock()
 
@@ -6067,7 +6231,7 @@ func (lc *LightningChannel) UpdateFee(feePerKw SatPerKWeight) error {
 
 // ReceiveUpdateFee handles an updated fee sent from remote. This method will
 // return an error if called as channel initiator.
-func (lc *LightningChannel) ReceiveUpdateFee(feePerKw SatPerKWeight) error {
+func (lc *LightningChannel) ReceiveUpdateFee(feePerKw chainfee.SatPerKWeight) error {
 	lc.Lock()
 	defer lc.Unlock()
 
@@ -6221,10 +6385,23 @@ func CreateCooperativeCloseTx(fundingTxIn wire.TxIn,
 	return closeTx
 }
 
-// CalcFee returns the commitment fee to use for the given
+// CoopCloseWeight returns the estimated weight of a cooperative close
+// transaction, which spends the 2-of-2 multi-sig funding output to an output
+// for each party. As the delivery scripts may not be known yet, both outputs
+// are assumed to be P2WSH, the larger of the two allowed witness outputs.
+func CoopCloseWeight() int64 {
+	var weightEstimate input.TxWeightEstimator
+	weightEstimate.AddWitnessInput(input.WitnessSize)
+	weightEstimate.AddP2WSHOutput()
+	weightEstimate.AddP2WSHOutput()
+
+	return int64(weightEstimate.Weight())
+}
+
+// CalcFee returns the cooperative close fee to use for the given
 // fee rate (fee-per-kw).
-func (lc *LightningChannel) CalcFee(feeRate SatPerKWeight) btcutil.Amount {
-	return feeRate.FeeForWeight(input.CommitWeight)
+func (lc *LightningChannel) CalcFee(feeRate chainfee.SatPerKWeight) btcutil.Amount {
+	return feeRate.FeeForWeight(CoopCloseWeight())
 }
 
 // RemoteNextRevocation returns the channelState's RemoteNextRevocation.
@@ -6245,13 +6422,31 @@ func (lc *LightningChannel) IsInitiator() bool {
 	return lc.channelState.IsInitiator
 }
 
+// LocalUpfrontShutdownScript returns the local upfront shutdown script for
+// the channel. If it was not set, an empty byte array is returned.
+func (lc *LightningChannel) LocalUpfrontShutdownScript() lnwire.DeliveryAddress {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.channelState.LocalShutdownScript
+}
+
+// RemoteUpfrontShutdownScript returns the remote upfront shutdown script for
+// the channel. If it was not set, an empty byte array is returned.
+func (lc *LightningChannel) RemoteUpfrontShutdownScript() lnwire.DeliveryAddress {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	return lc.channelState.RemoteShutdownScript
+}
+
 // CommitFeeRate returns the current fee rate of the commitment transaction in
 // units of sat-per-kw.
-func (lc *LightningChannel) CommitFeeRate() SatPerKWeight {
+func (lc *LightningChannel) CommitFeeRate() chainfee.SatPerKWeight {
 	lc.RLock()
 	defer lc.RUnlock()
 
-	return SatPerKWeight(lc.channelState.LocalCommitment.FeePerKw)
+	return chainfee.SatPerKWeight(lc.channelState.LocalCommitment.FeePerKw)
 }
 
 // IsPending returns true if the channel's funding transaction has been fully
@@ -6307,6 +6502,134 @@ func (lc *LightningChannel) ActiveHtlcs() []channeldb.HTLC {
 	return activeHtlcs
 }
 
+// HtlcState describes how far along the commitment state machine an HTLC
+// currently is.
+type HtlcState uint8
+
+const (
+	// HtlcPendingAdd denotes an HTLC which hasn't been locked into both
+	// commitment transactions yet.
+	HtlcPendingAdd HtlcState = iota
+
+	// HtlcLockedIn denotes an HTLC which is locked into both commitment
+	// transactions, and hasn't been settled or failed yet.
+	HtlcLockedIn
+
+	// HtlcPendingSettle denotes an HTLC which has been settled, but whose
+	// removal hasn't been locked into both commitment transactions yet.
+	HtlcPendingSettle
+
+	// HtlcPendingFail denotes an HTLC which has been failed, but whose
+	// removal hasn't been locked into both commitment transactions yet.
+	HtlcPendingFail
+)
+
+// String returns a human readable version of the HtlcState.
+func (s HtlcState) String() string {
+	switch s {
+	case HtlcPendingAdd:
+		return "PendingAdd"
+	case HtlcLockedIn:
+		return "LockedIn"
+	case HtlcPendingSettle:
+		return "PendingSettle"
+	case HtlcPendingFail:
+		return "PendingFail"
+	default:
+		return "<unknown>"
+	}
+}
+
+// HtlcStatus describes an HTLC in the update logs of the channel, along with
+// its current state.
+type HtlcStatus struct {
+	// HtlcIndex is the index of the HTLC within the log of the party that
+	// added it.
+	HtlcIndex uint64
+
+	// Incoming is true if the HTLC was added by the remote party.
+	Incoming bool
+
+	// Amount is the value of the HTLC.
+	Amount lnwire.MilliSatoshi
+
+	// RHash is the payment hash of the HTLC.
+	RHash PaymentHash
+
+	// Timeout is the absolute expiry height of the HTLC.
+	Timeout uint32
+
+	// State is how far along the commitment state machine the HTLC is.
+	State HtlcState
+}
+
+// HtlcStatuses returns the status of every HTLC in the update logs of the
+// channel, which includes the HTLCs that have yet to be locked in, or to be
+// fully removed from both commitment transactions.
+func (lc *LightningChannel) HtlcStatuses() []HtlcStatus {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	// An HTLC is removed by the party it was offered to, so we'll first
+	// index the removals in each log by the HTLC they target.
+	removals := func(log *updateLog) map[uint64]updateType {
+		entries := make(map[uint64]updateType)
+		for e := log.Front(); e != nil; e = e.Next() {
+			pd := e.Value.(*PaymentDescriptor)
+			if pd.EntryType != Add && pd.EntryType != FeeUpdate {
+				entries[pd.ParentIndex] = pd.EntryType
+			}
+		}
+
+		return entries
+	}
+	localRemovals := removals(lc.localUpdateLog)
+	remoteRemovals := removals(lc.remoteUpdateLog)
+
+	var statuses []HtlcStatus
+	addStatuses := func(log *updateLog, incoming bool,
+		removals map[uint64]updateType) {
+
+		for e := log.Front(); e != nil; e = e.Next() {
+			pd := e.Value.(*PaymentDescriptor)
+			if pd.EntryType != Add {
+				continue
+			}
+
+			state := HtlcLockedIn
+			removal, ok := removals[pd.HtlcIndex]
+			switch {
+			case ok && removal == Settle:
+				state = HtlcPendingSettle
+
+			case ok:
+				state = HtlcPendingFail
+
+			case pd.addCommitHeightLocal == 0 ||
+				pd.addCommitHeightRemote == 0:
+
+				state = HtlcPendingAdd
+			}
+
+			statuses = append(statuses, HtlcStatus{
+				HtlcIndex: pd.HtlcIndex,
+				Incoming:  incoming,
+				Amount:    pd.Amount,
+				RHash:     pd.RHash,
+				Timeout:   pd.Timeout,
+				State:     state,
+			})
+		}
+	}
+
+	// HTLCs we've added are outgoing, and are removed by the remote party,
+	// while HTLCs the remote party has added are incoming.
+	addStatuses(lc.localUpdateLog, false, remoteRemovals)
+	addStatuses(lc.remoteUpdateLog, true, localRemovals)
+
+	return statuses
+}
+
 // LocalChanReserve returns our local ChanReserve requirement for the remote party.
 func (lc *LightningChannel) LocalChanReserve() btcutil.Amount {
 	return lc.localChanCfg.ChanReserve
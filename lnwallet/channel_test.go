@@ -20,6 +20,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -485,8 +486,8 @@ func TestCooperativeChannelClosure(t *testing.T) {
 	aliceDeliveryScript := bobsPrivKey[:]
 	bobDeliveryScript := testHdSeed[:]
 
-	aliceFeeRate := SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
-	bobFeeRate := SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw)
+	aliceFeeRate := chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
+	bobFeeRate := chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw)
 
 	// We'll store with both Alice and Bob creating a new close proposal
 	// with the same fee.
@@ -627,7 +628,7 @@ func TestForceClose(t *testing.T) {
 	// Factoring in the fee rate, Alice's amount should properly reflect
 	// that we've added two additional HTLC to the commitment transaction.
 	totalCommitWeight := input.CommitWeight + (input.HtlcWeight * 2)
-	feePerKw := SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
+	feePerKw := chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
 	commitFee := feePerKw.FeeForWeight(totalCommitWeight)
 	expectedAmount := (aliceChannel.Capacity / 2) - htlcAmount.ToSatoshis() - commitFee
 	if aliceCommitResolution.SelfOutputSignDesc.Output.Value != int64(expectedAmount) {
@@ -1036,7 +1037,7 @@ func TestHTLCDustLimit(t *testing.T) {
 	// The amount of the HTLC should be above Alice's dust limit and below
 	// Bob's dust limit.
 	htlcSat := (btcutil.Amount(500) + htlcTimeoutFee(
-		SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)))
+		chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)))
 	htlcAmount := lnwire.NewMSatFromSatoshis(htlcSat)
 
 	htlc, preimage := createHTLC(0, htlcAmount)
@@ -1134,7 +1135,7 @@ func TestHTLCSigNumber(t *testing.T) {
 	}
 
 	// Calculate two values that will be below and above Bob's dust limit.
-	estimator := NewStaticFeeEstimator(6000, 0)
+	estimator := chainfee.NewStaticEstimator(6000, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to get fee: %v", err)
@@ -1300,7 +1301,7 @@ func TestChannelBalanceDustLimit(t *testing.T) {
 	aliceBalance := aliceChannel.channelState.LocalCommitment.LocalBalance.ToSatoshis()
 	htlcSat := aliceBalance - defaultFee
 	htlcSat += htlcSuccessFee(
-		SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw),
+		chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw),
 	)
 
 	htlcAmount := lnwire.NewMSatFromSatoshis(htlcSat)
@@ -1399,7 +1400,7 @@ func TestStateUpdatePersistence(t *testing.T) {
 	}
 
 	// Also add a fee update to the update logs.
-	fee := SatPerKWeight(111)
+	fee := chainfee.SatPerKWeight(111)
 	if err := aliceChannel.UpdateFee(fee); err != nil {
 		t.Fatalf("unable to send fee update")
 	}
@@ -1814,8 +1815,8 @@ func TestCooperativeCloseDustAdherence(t *testing.T) {
 	}
 	defer cleanUp()
 
-	aliceFeeRate := SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
-	bobFeeRate := SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw)
+	aliceFeeRate := chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
+	bobFeeRate := chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw)
 
 	setDustLimit := func(dustVal btcutil.Amount) {
 		aliceChannel.channelState.LocalChanCfg.DustLimit = dustVal
@@ -1981,7 +1982,7 @@ func TestUpdateFeeAdjustments(t *testing.T) {
 
 	// We'll first try to increase the fee rate 5x, this should be able to
 	// be committed without any issue.
-	newFee := SatPerKWeight(baseFeeRate * 5)
+	newFee := chainfee.SatPerKWeight(baseFeeRate * 5)
 
 	if err := aliceChannel.UpdateFee(newFee); err != nil {
 		t.Fatalf("unable to alice update fee: %v", err)
@@ -1999,7 +2000,7 @@ func TestUpdateFeeAdjustments(t *testing.T) {
 	// We'll now attempt to increase the fee rate 1,000,000x of the base
 	// fee.  This should result in an error as Alice won't be able to pay
 	// this new fee rate.
-	newFee = SatPerKWeight(baseFeeRate * 1000000)
+	newFee = chainfee.SatPerKWeight(baseFeeRate * 1000000)
 	if err := aliceChannel.UpdateFee(newFee); err == nil {
 		t.Fatalf("alice should reject the fee rate")
 	}
@@ -2007,7 +2008,7 @@ func TestUpdateFeeAdjustments(t *testing.T) {
 	// Finally, we'll attempt to adjust the fee down and use a fee which is
 	// smaller than the initial base fee rate. The fee application and
 	// state transition should proceed without issue.
-	newFee = SatPerKWeight(baseFeeRate / 100)
+	newFee = chainfee.SatPerKWeight(baseFeeRate / 100)
 	if err := aliceChannel.UpdateFee(newFee); err != nil {
 		t.Fatalf("unable to alice update fee: %v", err)
 	}
@@ -2081,7 +2082,7 @@ func TestUpdateFeeConcurrentSig(t *testing.T) {
 	}
 
 	// Simulate Alice sending update fee message to bob.
-	fee := SatPerKWeight(111)
+	fee := chainfee.SatPerKWeight(111)
 	if err := aliceChannel.UpdateFee(fee); err != nil {
 		t.Fatalf("unable to send fee update")
 	}
@@ -2117,7 +2118,7 @@ func TestUpdateFeeConcurrentSig(t *testing.T) {
 		t.Fatalf("bob unable to process alice's new commitment: %v", err)
 	}
 
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) == fee {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) == fee {
 		t.Fatalf("bob's feePerKw was unexpectedly locked in")
 	}
 
@@ -2128,7 +2129,7 @@ func TestUpdateFeeConcurrentSig(t *testing.T) {
 		t.Fatalf("unable to generate bob revocation: %v", err)
 	}
 
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != fee {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != fee {
 		t.Fatalf("bob's feePerKw was not locked in")
 	}
 }
@@ -2167,7 +2168,7 @@ func TestUpdateFeeSenderCommits(t *testing.T) {
 	}
 
 	// Simulate Alice sending update fee message to bob.
-	fee := SatPerKWeight(111)
+	fee := chainfee.SatPerKWeight(111)
 	aliceChannel.UpdateFee(fee)
 	bobChannel.ReceiveUpdateFee(fee)
 
@@ -2187,7 +2188,7 @@ func TestUpdateFeeSenderCommits(t *testing.T) {
 		t.Fatalf("bob unable to process alice's new commitment: %v", err)
 	}
 
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) == fee {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) == fee {
 		t.Fatalf("bob's feePerKw was unexpectedly locked in")
 	}
 
@@ -2198,7 +2199,7 @@ func TestUpdateFeeSenderCommits(t *testing.T) {
 		t.Fatalf("unable to generate bob revocation: %v", err)
 	}
 
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != fee {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != fee {
 		t.Fatalf("bob's feePerKw was not locked in")
 	}
 
@@ -2223,7 +2224,7 @@ func TestUpdateFeeSenderCommits(t *testing.T) {
 		t.Fatalf("alice unable to process bob's new commitment: %v", err)
 	}
 
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) == fee {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) == fee {
 		t.Fatalf("alice's feePerKw was unexpectedly locked in")
 	}
 
@@ -2234,7 +2235,7 @@ func TestUpdateFeeSenderCommits(t *testing.T) {
 		t.Fatalf("unable to revoke alice channel: %v", err)
 	}
 
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != fee {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != fee {
 		t.Fatalf("alice's feePerKw was not locked in")
 	}
 
@@ -2279,7 +2280,7 @@ func TestUpdateFeeReceiverCommits(t *testing.T) {
 	}
 
 	// Simulate Alice sending update fee message to bob
-	fee := SatPerKWeight(111)
+	fee := chainfee.SatPerKWeight(111)
 	aliceChannel.UpdateFee(fee)
 	bobChannel.ReceiveUpdateFee(fee)
 
@@ -2326,7 +2327,7 @@ func TestUpdateFeeReceiverCommits(t *testing.T) {
 		t.Fatalf("alice unable to process bob's new commitment: %v", err)
 	}
 
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) == fee {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) == fee {
 		t.Fatalf("bob's feePerKw was unexpectedly locked in")
 	}
 
@@ -2338,7 +2339,7 @@ func TestUpdateFeeReceiverCommits(t *testing.T) {
 		t.Fatalf("unable to revoke alice channel: %v", err)
 	}
 
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != fee {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != fee {
 		t.Fatalf("bob's feePerKw was not locked in")
 	}
 
@@ -2362,7 +2363,7 @@ func TestUpdateFeeReceiverCommits(t *testing.T) {
 		t.Fatalf("alice unable to process bob's new commitment: %v", err)
 	}
 
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) == fee {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) == fee {
 		t.Fatalf("alice's feePerKw was unexpectedly locked in")
 	}
 
@@ -2373,7 +2374,7 @@ func TestUpdateFeeReceiverCommits(t *testing.T) {
 		t.Fatalf("unable to generate bob revocation: %v", err)
 	}
 
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != fee {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != fee {
 		t.Fatalf("Alice's feePerKw was not locked in")
 	}
 
@@ -2400,7 +2401,7 @@ func TestUpdateFeeReceiverSendsUpdate(t *testing.T) {
 
 	// Since Alice is the channel initiator, she should fail when receiving
 	// fee update
-	fee := SatPerKWeight(111)
+	fee := chainfee.SatPerKWeight(111)
 	err = aliceChannel.ReceiveUpdateFee(fee)
 	if err == nil {
 		t.Fatalf("expected alice to fail receiving fee update")
@@ -2428,9 +2429,9 @@ func TestUpdateFeeMultipleUpdates(t *testing.T) {
 	defer cleanUp()
 
 	// Simulate Alice sending update fee message to bob.
-	fee1 := SatPerKWeight(111)
-	fee2 := SatPerKWeight(222)
-	fee := SatPerKWeight(333)
+	fee1 := chainfee.SatPerKWeight(111)
+	fee2 := chainfee.SatPerKWeight(222)
+	fee := chainfee.SatPerKWeight(333)
 	aliceChannel.UpdateFee(fee1)
 	aliceChannel.UpdateFee(fee2)
 	aliceChannel.UpdateFee(fee)
@@ -2455,15 +2456,15 @@ func TestUpdateFeeMultipleUpdates(t *testing.T) {
 		t.Fatalf("bob unable to process alice's new commitment: %v", err)
 	}
 
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) == fee {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) == fee {
 		t.Fatalf("bob's feePerKw was unexpectedly locked in")
 	}
 
 	// Alice sending more fee updates now should not mess up the old fee
 	// they both committed to.
-	fee3 := SatPerKWeight(444)
-	fee4 := SatPerKWeight(555)
-	fee5 := SatPerKWeight(666)
+	fee3 := chainfee.SatPerKWeight(444)
+	fee4 := chainfee.SatPerKWeight(555)
+	fee5 := chainfee.SatPerKWeight(666)
 	aliceChannel.UpdateFee(fee3)
 	aliceChannel.UpdateFee(fee4)
 	aliceChannel.UpdateFee(fee5)
@@ -2478,7 +2479,7 @@ func TestUpdateFeeMultipleUpdates(t *testing.T) {
 		t.Fatalf("unable to generate bob revocation: %v", err)
 	}
 
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != fee {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != fee {
 		t.Fatalf("bob's feePerKw was not locked in")
 	}
 
@@ -2502,7 +2503,7 @@ func TestUpdateFeeMultipleUpdates(t *testing.T) {
 		t.Fatalf("alice unable to process bob's new commitment: %v", err)
 	}
 
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) == fee {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) == fee {
 		t.Fatalf("alice's feePerKw was unexpectedly locked in")
 	}
 
@@ -2513,7 +2514,7 @@ func TestUpdateFeeMultipleUpdates(t *testing.T) {
 		t.Fatalf("unable to revoke alice channel: %v", err)
 	}
 
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != fee {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != fee {
 		t.Fatalf("alice's feePerKw was not locked in")
 	}
 
@@ -3859,7 +3860,7 @@ func TestFeeUpdateRejectInsaneFee(t *testing.T) {
 
 	// Next, we'll try to add a fee rate to Alice which is 1,000,000x her
 	// starting fee rate.
-	startingFeeRate := SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
+	startingFeeRate := chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
 	newFeeRate := startingFeeRate * 1000000
 
 	// Both Alice and Bob should reject this new fee rate as it is far too
@@ -3885,7 +3886,7 @@ func TestChannelRetransmissionFeeUpdate(t *testing.T) {
 
 	// First, we'll fetch the current fee rate present within the
 	// commitment transactions.
-	startingFeeRate := SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
+	startingFeeRate := chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw)
 
 	// Next, we'll start a commitment update, with Alice sending a new
 	// update to double the fee rate of the commitment.
@@ -4023,10 +4024,10 @@ func TestChannelRetransmissionFeeUpdate(t *testing.T) {
 	}
 
 	// Both parties should now have the latest fee rate locked-in.
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
 		t.Fatalf("alice's feePerKw was not locked in")
 	}
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
 		t.Fatalf("bob's feePerKw was not locked in")
 	}
 
@@ -4107,7 +4108,7 @@ func TestFeeUpdateOldDiskFormat(t *testing.T) {
 
 	// First, we'll fetch the current fee rate present within the
 	// commitment transactions.
-	startingFeeRate := SatPerKWeight(
+	startingFeeRate := chainfee.SatPerKWeight(
 		aliceChannel.channelState.LocalCommitment.FeePerKw,
 	)
 	newFeeRate := startingFeeRate
@@ -4236,10 +4237,10 @@ func TestFeeUpdateOldDiskFormat(t *testing.T) {
 	}
 
 	// Both parties should now have the latest fee rate locked-in.
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
 		t.Fatalf("alice's feePerKw was not locked in")
 	}
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
 		t.Fatalf("bob's feePerKw was not locked in")
 	}
 
@@ -4261,10 +4262,10 @@ func TestFeeUpdateOldDiskFormat(t *testing.T) {
 	assertLogItems(0, numHTLCs+1)
 
 	// ...and the final fee rate locked in.
-	if SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
+	if chainfee.SatPerKWeight(aliceChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
 		t.Fatalf("alice's feePerKw was not locked in")
 	}
-	if SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
+	if chainfee.SatPerKWeight(bobChannel.channelState.LocalCommitment.FeePerKw) != newFeeRate {
 		t.Fatalf("bob's feePerKw was not locked in")
 	}
 }
@@ -5323,6 +5324,222 @@ func TestMaxAcceptedHTLCs(t *testing.T) {
 	}
 }
 
+// TestAvailableHtlcCapacity tests that the number and value of the HTLCs we
+// can still offer are bounded by the constraints imposed by the remote party.
+func TestAvailableHtlcCapacity(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	const maxHtlcs = 3
+	maxPending := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin * 3)
+	aliceChannel.localChanCfg.MaxAcceptedHtlcs = maxHtlcs
+	aliceChannel.localChanCfg.MaxPendingAmount = maxPending
+	bobChannel.remoteChanCfg.MaxAcceptedHtlcs = maxHtlcs
+	bobChannel.remoteChanCfg.MaxPendingAmount = maxPending
+
+	assertCapacity := func(expNum uint16, expAmt lnwire.MilliSatoshi) {
+		t.Helper()
+
+		num, amt := aliceChannel.AvailableHtlcCapacity()
+		if num != expNum || amt != expAmt {
+			t.Fatalf("expected capacity of %v htlcs and %v, got "+
+				"%v htlcs and %v", expNum, expAmt, num, amt)
+		}
+	}
+	assertCapacity(maxHtlcs, maxPending)
+
+	// Each HTLC Alice offers should take up its share of the capacity,
+	// whether or not it has been locked in yet.
+	htlcAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	for i := 0; i < 2; i++ {
+		htlc, _ := createHTLC(i, htlcAmt)
+		if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+			t.Fatalf("unable to add htlc: %v", err)
+		}
+		if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+			t.Fatalf("unable to recv htlc: %v", err)
+		}
+	}
+	assertCapacity(1, maxPending-2*htlcAmt)
+
+	err = forceStateTransition(aliceChannel, bobChannel)
+	if err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+	assertCapacity(1, maxPending-2*htlcAmt)
+
+	// HTLCs offered by Bob don't count towards Alice's capacity.
+	htlc, _ := createHTLC(0, htlcAmt)
+	if _, err := bobChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("unable to add htlc: %v", err)
+	}
+	if _, err := aliceChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("unable to recv htlc: %v", err)
+	}
+	assertCapacity(1, maxPending-2*htlcAmt)
+}
+
+// TestGetDustSum tests that the dust sums of both commitments account for the
+// dust HTLCs offered by either party, and ignore the ones that aren't dust.
+func TestGetDustSum(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	assertDustSum := func(channel *LightningChannel,
+		expected lnwire.MilliSatoshi) {
+
+		t.Helper()
+
+		for _, remote := range []bool{false, true} {
+			dustSum := channel.GetDustSum(remote)
+			if dustSum != expected {
+				t.Fatalf("expected dust sum of %v on remote=%v "+
+					"commitment, got %v", expected, remote,
+					dustSum)
+			}
+		}
+	}
+	assertDustSum(aliceChannel, 0)
+	assertDustSum(bobChannel, 0)
+
+	// Alice offers a dust HTLC along with one that is far above the dust
+	// limit, only the former should be accounted for.
+	dustAmt := lnwire.NewMSatFromSatoshis(100)
+	htlcAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	for i, amt := range []lnwire.MilliSatoshi{dustAmt, htlcAmt} {
+		htlc, _ := createHTLC(i, amt)
+		if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+			t.Fatalf("unable to add htlc: %v", err)
+		}
+		if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+			t.Fatalf("unable to recv htlc: %v", err)
+		}
+	}
+	assertDustSum(aliceChannel, dustAmt)
+	assertDustSum(bobChannel, dustAmt)
+
+	err = forceStateTransition(aliceChannel, bobChannel)
+	if err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+	assertDustSum(aliceChannel, dustAmt)
+	assertDustSum(bobChannel, dustAmt)
+
+	// A dust HTLC offered by Bob should be accounted for as well.
+	htlc, _ := createHTLC(0, dustAmt)
+	if _, err := bobChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("unable to add htlc: %v", err)
+	}
+	if _, err := aliceChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("unable to recv htlc: %v", err)
+	}
+	assertDustSum(aliceChannel, 2*dustAmt)
+	assertDustSum(bobChannel, 2*dustAmt)
+}
+
+// TestHtlcStatuses tests that the reported state of each HTLC follows it
+// through the commitment state machine on both ends of the channel.
+func TestHtlcStatuses(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, cleanUp, err := CreateTestChannels()
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// assertStates asserts that both channels report the HTLCs offered by
+	// Alice in the given states, indexed by HTLC index.
+	assertStates := func(states ...HtlcState) {
+		t.Helper()
+
+		for _, channel := range []*LightningChannel{
+			aliceChannel, bobChannel,
+		} {
+			statuses := channel.HtlcStatuses()
+			if len(statuses) != len(states) {
+				t.Fatalf("expected %v htlcs, got %v",
+					len(states), len(statuses))
+			}
+
+			incoming := channel == bobChannel
+			for _, status := range statuses {
+				if status.Incoming != incoming {
+					t.Fatalf("expected htlc %v to have "+
+						"incoming=%v", status.HtlcIndex,
+						incoming)
+				}
+
+				expected := states[status.HtlcIndex]
+				if status.State != expected {
+					t.Fatalf("expected htlc %v to be %v, "+
+						"got %v", status.HtlcIndex,
+						expected, status.State)
+				}
+			}
+		}
+	}
+	assertStates()
+
+	// Alice offers two HTLCs, which are pending until they're locked in.
+	htlcAmt := lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin)
+	var preimages [][32]byte
+	for i := 0; i < 2; i++ {
+		htlc, preimage := createHTLC(i, htlcAmt)
+		if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+			t.Fatalf("unable to add htlc: %v", err)
+		}
+		if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+			t.Fatalf("unable to recv htlc: %v", err)
+		}
+		preimages = append(preimages, preimage)
+	}
+	assertStates(HtlcPendingAdd, HtlcPendingAdd)
+
+	err = forceStateTransition(aliceChannel, bobChannel)
+	if err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+	assertStates(HtlcLockedIn, HtlcLockedIn)
+
+	// Bob settles the first HTLC and fails the second one, whose removal
+	// is pending until it's locked in.
+	err = bobChannel.SettleHTLC(preimages[0], 0, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("unable to settle htlc: %v", err)
+	}
+	err = aliceChannel.ReceiveHTLCSettle(preimages[0], 0)
+	if err != nil {
+		t.Fatalf("unable to recv settle: %v", err)
+	}
+	err = bobChannel.FailHTLC(1, []byte("failreason"), nil, nil, nil)
+	if err != nil {
+		t.Fatalf("unable to fail htlc: %v", err)
+	}
+	err = aliceChannel.ReceiveFailHTLC(1, []byte("failreason"))
+	if err != nil {
+		t.Fatalf("unable to recv fail: %v", err)
+	}
+	assertStates(HtlcPendingSettle, HtlcPendingFail)
+
+	// Once the removals are locked in, the HTLCs are no longer reported.
+	err = forceStateTransition(bobChannel, aliceChannel)
+	if err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+	assertStates()
+}
+
 // TestMaxPendingAmount tests that the maximum overall pending HTLC value is met
 // given several HTLCs that, combined, exceed this value. An ErrMaxPendingAmount
 // error should be returned.
@@ -6525,3 +6742,118 @@ func TestForceCloseFailLocalDataLoss(t *testing.T) {
 			"chan state")
 	}
 }
+
+// TestAnchorCommitments asserts that the commitments of channels using anchor
+// outputs carry an anchor for each party, that the initiator pays for the
+// value of both anchors, and that we're able to resolve our anchor when force
+// closing the channel.
+func TestAnchorCommitments(t *testing.T) {
+	t.Parallel()
+
+	chanType := channeldb.SingleFunder | channeldb.AnchorOutputsBit
+	aliceChannel, bobChannel, cleanUp, err := createTestChannels(chanType)
+	if err != nil {
+		t.Fatalf("unable to create test channels: %v", err)
+	}
+	defer cleanUp()
+
+	// We'll add an HTLC from Alice to Bob and lock it in, such that both
+	// parties have something at stake within the commitment.
+	htlcAmount := lnwire.NewMSatFromSatoshis(20000)
+	htlc, _ := createHTLC(0, htlcAmount)
+	if _, err := aliceChannel.AddHTLC(htlc, nil); err != nil {
+		t.Fatalf("alice unable to add htlc: %v", err)
+	}
+	if _, err := bobChannel.ReceiveHTLC(htlc); err != nil {
+		t.Fatalf("bob unable to recv add htlc: %v", err)
+	}
+	if err := forceStateTransition(aliceChannel, bobChannel); err != nil {
+		t.Fatalf("unable to complete state update: %v", err)
+	}
+
+	aliceFundingKey := aliceChannel.localChanCfg.MultiSigKey.PubKey
+	bobFundingKey := bobChannel.localChanCfg.MultiSigKey.PubKey
+	aliceAnchor, err := anchorPkScript(aliceFundingKey)
+	if err != nil {
+		t.Fatalf("unable to create anchor script: %v", err)
+	}
+	bobAnchor, err := anchorPkScript(bobFundingKey)
+	if err != nil {
+		t.Fatalf("unable to create anchor script: %v", err)
+	}
+
+	// Both the commitment of Alice and Bob should carry both anchors.
+	assertAnchors := func(commitTx *wire.MsgTx) {
+		t.Helper()
+
+		var numAnchors int
+		for _, txOut := range commitTx.TxOut {
+			if !bytes.Equal(txOut.PkScript, aliceAnchor) &&
+				!bytes.Equal(txOut.PkScript, bobAnchor) {
+
+				continue
+			}
+
+			if txOut.Value != int64(anchorSize) {
+				t.Fatalf("expected anchor value %v, got %v",
+					anchorSize, txOut.Value)
+			}
+			numAnchors++
+		}
+
+		if numAnchors != 2 {
+			t.Fatalf("expected 2 anchors, got %v", numAnchors)
+		}
+	}
+	assertAnchors(aliceChannel.localCommitChain.tip().txn)
+	assertAnchors(bobChannel.localCommitChain.tip().txn)
+
+	// Alice, as the initiator, should pay for both the commitment fee and
+	// the value of the anchors.
+	feePerKw := aliceChannel.localCommitChain.tip().feePerKw
+	expectedFee := feePerKw.FeeForWeight(
+		input.AnchorCommitWeight+input.HtlcWeight,
+	) + 2*anchorSize
+	aliceCommit := aliceChannel.localCommitChain.tip()
+	if aliceCommit.fee != expectedFee {
+		t.Fatalf("expected commit fee %v, got %v", expectedFee,
+			aliceCommit.fee)
+	}
+
+	expectedBalance := lnwire.NewMSatFromSatoshis(
+		aliceChannel.channelState.Capacity/2-expectedFee,
+	) - htlcAmount
+	if aliceCommit.ourBalance != expectedBalance {
+		t.Fatalf("expected alice balance %v, got %v", expectedBalance,
+			aliceCommit.ourBalance)
+	}
+
+	// Finally, force closing the channel should give Alice the means to
+	// bump the fee of her commitment through her anchor.
+	closeSummary, err := aliceChannel.ForceClose()
+	if err != nil {
+		t.Fatalf("unable to force close channel: %v", err)
+	}
+
+	anchorRes := closeSummary.AnchorResolution
+	if anchorRes == nil {
+		t.Fatalf("expected anchor resolution")
+	}
+
+	closeTx := closeSummary.CloseTx
+	if anchorRes.CommitAnchor.Hash != closeTx.TxHash() {
+		t.Fatalf("anchor doesn't point to the commitment")
+	}
+	anchorOut := closeTx.TxOut[anchorRes.CommitAnchor.Index]
+	if !bytes.Equal(anchorOut.PkScript, aliceAnchor) {
+		t.Fatalf("anchor resolution doesn't point to alice's anchor")
+	}
+
+	commitFee := feePerKw.FeeForWeight(
+		input.AnchorCommitWeight + input.HtlcWeight,
+	)
+	if anchorRes.CommitFee != commitFee {
+		t.Fatalf("expected commit fee %v, got %v", commitFee,
+			anchorRes.CommitFee)
+	}
+}
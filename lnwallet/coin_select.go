@@ -0,0 +1,119 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+)
+
+// CoinSelectionStrategy determines the order in which the unspent outputs of
+// the wallet are considered when performing coin selection.
+type CoinSelectionStrategy uint8
+
+const (
+	// CoinSelectionLargest orders the available coins by descending
+	// value, minimizing the number of inputs used to fund a transaction.
+	CoinSelectionLargest CoinSelectionStrategy = iota
+
+	// CoinSelectionRandom orders the available coins randomly, which
+	// makes it harder for an observer to fingerprint the wallet based on
+	// the inputs it selects.
+	CoinSelectionRandom
+
+	// CoinSelectionSmallest orders the available coins by ascending value,
+	// consolidating small outputs when funding transactions.
+	CoinSelectionSmallest
+)
+
+// String returns a human readable version of the coin selection strategy.
+func (c CoinSelectionStrategy) String() string {
+	switch c {
+	case CoinSelectionLargest:
+		return "largest"
+	case CoinSelectionRandom:
+		return "random"
+	case CoinSelectionSmallest:
+		return "smallest"
+	default:
+		return fmt.Sprintf("unknown<%d>", uint8(c))
+	}
+}
+
+// ParseCoinSelectionStrategy parses the string representation of a coin
+// selection strategy, as returned by its String method.
+func ParseCoinSelectionStrategy(s string) (CoinSelectionStrategy, error) {
+	switch s {
+	case "largest":
+		return CoinSelectionLargest, nil
+	case "random":
+		return CoinSelectionRandom, nil
+	case "smallest":
+		return CoinSelectionSmallest, nil
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy: %v", s)
+	}
+}
+
+// orderCoins returns a copy of the passed coins, ordered according to the
+// coin selection strategy.
+func orderCoins(strategy CoinSelectionStrategy, coins []*Utxo) []*Utxo {
+	ordered := make([]*Utxo, len(coins))
+	copy(ordered, coins)
+
+	switch strategy {
+	case CoinSelectionRandom:
+		r := rand.New(rand.NewSource(time.Now().UnixNano()))
+		r.Shuffle(len(ordered), func(i, j int) {
+			ordered[i], ordered[j] = ordered[j], ordered[i]
+		})
+
+	case CoinSelectionSmallest:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].Value < ordered[j].Value
+		})
+
+	default:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].Value > ordered[j].Value
+		})
+	}
+
+	return ordered
+}
+
+// LockID is a unique identifier chosen by the caller of LeaseOutput. Only the
+// holder of the ID a lease was acquired with is able to release it.
+type LockID [32]byte
+
+var (
+	// ErrOutputAlreadyLeased is returned when attempting to lease an
+	// output that is currently leased under a different LockID, or that
+	// has been locked for a pending channel funding.
+	ErrOutputAlreadyLeased = errors.New("output already leased")
+
+	// ErrOutputNotLeased is returned when attempting to release an output
+	// that isn't leased under the given LockID.
+	ErrOutputNotLeased = errors.New("output not leased")
+)
+
+// OutputLeaser is an interface that allows callers to reserve unspent outputs
+// of the wallet for external use. Leased outputs won't be considered during
+// coin selection until they're released. Leases are only held in memory, so
+// all outputs are released when the wallet restarts.
+type OutputLeaser interface {
+	// LeaseOutput locks the given output under the passed LockID. Leasing
+	// an output already leased with the same LockID is a no-op.
+	LeaseOutput(id LockID, op wire.OutPoint) error
+
+	// ReleaseOutput unlocks an output previously leased with LeaseOutput
+	// using the same LockID.
+	ReleaseOutput(id LockID, op wire.OutPoint) error
+}
+
+// A compile time check to ensure LightningWallet implements the OutputLeaser
+// interface.
+var _ OutputLeaser = (*LightningWallet)(nil)
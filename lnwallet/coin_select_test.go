@@ -0,0 +1,170 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// TestOrderCoins tests that the coins are ordered according to the coin
+// selection strategy, and that the passed slice is left untouched.
+func TestOrderCoins(t *testing.T) {
+	t.Parallel()
+
+	coins := []*Utxo{
+		{Value: 2 * btcutil.SatoshiPerBitcoin},
+		{Value: 1 * btcutil.SatoshiPerBitcoin},
+		{Value: 3 * btcutil.SatoshiPerBitcoin},
+	}
+
+	largest := orderCoins(CoinSelectionLargest, coins)
+	for i := 1; i < len(largest); i++ {
+		if largest[i-1].Value < largest[i].Value {
+			t.Fatalf("coins not ordered by descending value")
+		}
+	}
+
+	smallest := orderCoins(CoinSelectionSmallest, coins)
+	for i := 1; i < len(smallest); i++ {
+		if smallest[i-1].Value > smallest[i].Value {
+			t.Fatalf("coins not ordered by ascending value")
+		}
+	}
+
+	random := orderCoins(CoinSelectionRandom, coins)
+	if len(random) != len(coins) {
+		t.Fatalf("expected %v coins, got %v", len(coins), len(random))
+	}
+
+	if coins[0].Value != 2*btcutil.SatoshiPerBitcoin {
+		t.Fatalf("passed coins were modified")
+	}
+}
+
+// TestParseCoinSelectionStrategy tests that each strategy can be parsed back
+// from its string representation.
+func TestParseCoinSelectionStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategies := []CoinSelectionStrategy{
+		CoinSelectionLargest, CoinSelectionRandom, CoinSelectionSmallest,
+	}
+	for _, strategy := range strategies {
+		parsed, err := ParseCoinSelectionStrategy(strategy.String())
+		if err != nil {
+			t.Fatalf("unable to parse %v: %v", strategy, err)
+		}
+		if parsed != strategy {
+			t.Fatalf("expected %v, got %v", strategy, parsed)
+		}
+	}
+
+	if _, err := ParseCoinSelectionStrategy("unknown"); err == nil {
+		t.Fatalf("expected unknown strategy to fail parsing")
+	}
+}
+
+// mockLeaseWallet is a WalletController that only implements the methods
+// needed to lease outputs.
+type mockLeaseWallet struct {
+	WalletController
+
+	utxos  []*Utxo
+	locked map[wire.OutPoint]struct{}
+}
+
+func (w *mockLeaseWallet) ListUnspentWitness(_, _ int32) ([]*Utxo, error) {
+	return w.utxos, nil
+}
+
+func (w *mockLeaseWallet) LockOutpoint(op wire.OutPoint) {
+	w.locked[op] = struct{}{}
+}
+
+func (w *mockLeaseWallet) UnlockOutpoint(op wire.OutPoint) {
+	delete(w.locked, op)
+}
+
+// TestLeaseOutput tests that outputs can only be leased if they belong to the
+// wallet and aren't locked already, and that only the holder of a lease can
+// release it.
+func TestLeaseOutput(t *testing.T) {
+	t.Parallel()
+
+	ours := wire.OutPoint{Index: 1}
+	pending := wire.OutPoint{Index: 2}
+	unknown := wire.OutPoint{Index: 3}
+
+	wallet := &mockLeaseWallet{
+		utxos: []*Utxo{
+			{OutPoint: ours},
+			{OutPoint: pending},
+		},
+		locked: make(map[wire.OutPoint]struct{}),
+	}
+	l := &LightningWallet{
+		WalletController: wallet,
+		lockedOutPoints: map[wire.OutPoint]struct{}{
+			pending: {},
+		},
+		leasedOutPoints: make(map[wire.OutPoint]LockID),
+	}
+
+	id1 := LockID{1}
+	id2 := LockID{2}
+
+	// Outputs that don't belong to the wallet can't be leased, and
+	// neither can outputs selected for a pending funding transaction.
+	if err := l.LeaseOutput(id1, unknown); err != ErrNotMine {
+		t.Fatalf("expected ErrNotMine, got %v", err)
+	}
+	err := l.LeaseOutput(id1, pending)
+	if err != ErrOutputAlreadyLeased {
+		t.Fatalf("expected ErrOutputAlreadyLeased, got %v", err)
+	}
+
+	// Leasing our output should lock it within the wallet. Leasing it
+	// again under the same ID is a no-op, while other IDs are refused.
+	if err := l.LeaseOutput(id1, ours); err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	if _, ok := wallet.locked[ours]; !ok {
+		t.Fatalf("expected leased output to be locked")
+	}
+	if err := l.LeaseOutput(id1, ours); err != nil {
+		t.Fatalf("unable to lease output again: %v", err)
+	}
+	err = l.LeaseOutput(id2, ours)
+	if err != ErrOutputAlreadyLeased {
+		t.Fatalf("expected ErrOutputAlreadyLeased, got %v", err)
+	}
+
+	// Only the holder of the lease should be able to release it, after
+	// which the output is unlocked.
+	err = l.ReleaseOutput(id2, ours)
+	if err != ErrOutputNotLeased {
+		t.Fatalf("expected ErrOutputNotLeased, got %v", err)
+	}
+	if err := l.ReleaseOutput(id1, ours); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	if _, ok := wallet.locked[ours]; ok {
+		t.Fatalf("expected released output to be unlocked")
+	}
+	err = l.ReleaseOutput(id1, ours)
+	if err != ErrOutputNotLeased {
+		t.Fatalf("expected ErrOutputNotLeased, got %v", err)
+	}
+
+	// Once released, the output can be leased under another ID.
+	if err := l.LeaseOutput(id2, ours); err != nil {
+		t.Fatalf("unable to lease released output: %v", err)
+	}
+
+	// Resetting the reservations must leave the lease in place.
+	l.ResetReservations()
+	if err := l.LeaseOutput(id1, ours); err != ErrOutputAlreadyLeased {
+		t.Fatalf("expected lease to survive reset, got %v", err)
+	}
+}
@@ -85,6 +85,16 @@ func ErrNonZeroPushAmount() ReservationError {
 	return ReservationError{errors.New("Non-zero push amounts are disabled")}
 }
 
+// ErrMissingFeatures returns an error indicating that the remote peer doesn't
+// advertise the given feature bits, which we require before opening channels
+// with it.
+func ErrMissingFeatures(missing []lnwire.FeatureBit) ReservationError {
+	return ReservationError{
+		fmt.Errorf("Peer doesn't advertise required features: %v",
+			missing),
+	}
+}
+
 // ErrMinHtlcTooLarge returns an error indicating that the MinHTLC value the
 // remote required is too large to be accepted.
 func ErrMinHtlcTooLarge(minHtlc,
@@ -142,6 +152,14 @@ func ErrChanTooSmall(chanSize, minChanSize btcutil.Amount) ReservationError {
 	}
 }
 
+// ErrChanRejected returns an error indicating that an incoming channel request
+// was rejected by one of our channel acceptors, for the given reason.
+func ErrChanRejected(reason error) ReservationError {
+	return ReservationError{
+		fmt.Errorf("channel rejected: %v", reason),
+	}
+}
+
 // ErrHtlcIndexAlreadyFailed is returned when the HTLC index has already been
 // failed, but has not been committed by our commitment state.
 type ErrHtlcIndexAlreadyFailed uint64
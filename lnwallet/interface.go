@@ -10,6 +10,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 // AddressType is an enum-like type which denotes the possible address types
@@ -29,6 +30,10 @@ const (
 	UnknownAddressType
 )
 
+// TxLabelLimit is the maximum length of the labels that can be attached to
+// transactions.
+const TxLabelLimit = 500
+
 var (
 	// DefaultPublicPassphrase is the default public passphrase used for the
 	// wallet.
@@ -46,6 +51,15 @@ var (
 	// ErrNotMine is an error denoting that a WalletController instance is
 	// unable to spend a specified output.
 	ErrNotMine = errors.New("the passed output doesn't belong to the wallet")
+
+	// ErrTxLabelExists is returned when attempting to label a transaction
+	// that already has a label without overwriting it.
+	ErrTxLabelExists = errors.New("transaction already labelled")
+
+	// ErrTxLabelTooLong is returned when attempting to label a transaction
+	// with a label exceeding TxLabelLimit.
+	ErrTxLabelTooLong = fmt.Errorf("transaction label exceeds limit of "+
+		"%v characters", TxLabelLimit)
 )
 
 // Utxo is an unspent output denoted by its outpoint, and output value of the
@@ -96,6 +110,9 @@ type TransactionDetail struct {
 
 	// DestAddresses are the destinations for a transaction
 	DestAddresses []btcutil.Address
+
+	// Label is an optional label set on the transaction by the user.
+	Label string
 }
 
 // TransactionSubscription is an interface which describes an object capable of
@@ -157,9 +174,17 @@ type WalletController interface {
 	// out to the specified outputs. In the case the wallet has insufficient
 	// funds, or the outputs are non-standard, an error should be returned.
 	// This method also takes the target fee expressed in sat/kw that should
-	// be used when crafting the transaction.
-	SendOutputs(outputs []*wire.TxOut,
-		feeRate SatPerKWeight) (*wire.MsgTx, error)
+	// be used when crafting the transaction, the minimum number of
+	// confirmations each input should have, and an optional label to
+	// attach to the transaction.
+	SendOutputs(outputs []*wire.TxOut, feeRate chainfee.SatPerKWeight,
+		minconf int32, label string) (*wire.MsgTx, error)
+
+	// LabelTransaction attaches a label to the transaction with the given
+	// hash. If the transaction already has a label, ErrTxLabelExists is
+	// returned unless overwrite is set.
+	LabelTransaction(hash chainhash.Hash, label string,
+		overwrite bool) error
 
 	// ListUnspentWitness returns all unspent outputs which are version 0
 	// witness programs. The 'minconfirms' and 'maxconfirms' parameters
@@ -210,6 +235,11 @@ type WalletController interface {
 	// known to the wallet, expressed in Unix epoch time
 	IsSynced() (bool, int64, error)
 
+	// GetRecoveryInfo returns a boolean indicating whether the wallet is
+	// started in recovery mode. It also returns a float64, ranging from 0
+	// to 1, representing the recovery progress made so far.
+	GetRecoveryInfo() (bool, float64, error)
+
 	// Start initializes the wallet, making any necessary connections,
 	// starting up required goroutines etc.
 	Start() error
@@ -252,6 +282,10 @@ type BlockChainIO interface {
 	// GetBlock returns the block in the main chain identified by the given
 	// hash.
 	GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
+
+	// GetBlockHeader returns the header of the block in the main chain
+	// identified by the given hash.
+	GetBlockHeader(blockHash *chainhash.Hash) (*wire.BlockHeader, error)
 }
 
 // MessageSigner represents an abstract object capable of signing arbitrary
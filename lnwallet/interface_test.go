@@ -39,6 +39,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -191,11 +192,11 @@ func newPkScript(t *testing.T, w *lnwallet.LightningWallet,
 // parties to send on-chain funds to each other.
 func sendCoins(t *testing.T, miner *rpctest.Harness,
 	sender, receiver *lnwallet.LightningWallet, output *wire.TxOut,
-	feeRate lnwallet.SatPerKWeight) *wire.MsgTx {
+	feeRate chainfee.SatPerKWeight) *wire.MsgTx {
 
 	t.Helper()
 
-	tx, err := sender.SendOutputs([]*wire.TxOut{output}, 2500)
+	tx, err := sender.SendOutputs([]*wire.TxOut{output}, 2500, 1, "")
 	if err != nil {
 		t.Fatalf("unable to send transaction: %v", err)
 	}
@@ -369,7 +370,7 @@ func createTestWallet(tempTestDir string, miningNode *rpctest.Harness,
 		WalletController: wc,
 		Signer:           signer,
 		ChainIO:          bio,
-		FeeEstimator:     lnwallet.NewStaticFeeEstimator(2500, 0),
+		FeeEstimator:     chainfee.NewStaticEstimator(2500, 0),
 		DefaultConstraints: channeldb.ChannelConstraints{
 			DustLimit:        500,
 			MaxPendingAmount: lnwire.NewMSatFromSatoshis(btcutil.SatoshiPerBitcoin) * 100,
@@ -438,7 +439,9 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 		MaxAcceptedHtlcs: input.MaxHTLCNumber / 2,
 		CsvDelay:         csvDelay,
 	}
-	err = aliceChanReservation.CommitConstraints(channelConstraints)
+	err = aliceChanReservation.CommitConstraints(
+		channelConstraints, lnwallet.DefaultConstraintsPolicy(),
+	)
 	if err != nil {
 		t.Fatalf("unable to verify constraints: %v", err)
 	}
@@ -472,7 +475,9 @@ func testDualFundingReservationWorkflow(miner *rpctest.Harness,
 	if err != nil {
 		t.Fatalf("bob unable to init channel reservation: %v", err)
 	}
-	err = bobChanReservation.CommitConstraints(channelConstraints)
+	err = bobChanReservation.CommitConstraints(
+		channelConstraints, lnwallet.DefaultConstraintsPolicy(),
+	)
 	if err != nil {
 		t.Fatalf("unable to verify constraints: %v", err)
 	}
@@ -737,7 +742,7 @@ func testCancelNonExistentReservation(miner *rpctest.Harness,
 	// Create our own reservation, give it some ID.
 	res, err := lnwallet.NewChannelReservation(
 		10000, 10000, feePerKw, alice, 22, 10, &testHdSeed,
-		lnwire.FFAnnounceChannel,
+		lnwire.FFAnnounceChannel, false,
 	)
 	if err != nil {
 		t.Fatalf("unable to create res: %v", err)
@@ -762,7 +767,7 @@ func testReservationInitiatorBalanceBelowDustCancel(miner *rpctest.Harness,
 		t.Fatalf("unable to create amt: %v", err)
 	}
 
-	feePerKw := lnwallet.SatPerKWeight(
+	feePerKw := chainfee.SatPerKWeight(
 		numBTC * numBTC * btcutil.SatoshiPerBitcoin,
 	)
 	req := &lnwallet.InitFundingReserveMsg{
@@ -870,7 +875,9 @@ func testSingleFunderReservationWorkflow(miner *rpctest.Harness,
 		MaxAcceptedHtlcs: input.MaxHTLCNumber / 2,
 		CsvDelay:         csvDelay,
 	}
-	err = aliceChanReservation.CommitConstraints(channelConstraints)
+	err = aliceChanReservation.CommitConstraints(
+		channelConstraints, lnwallet.DefaultConstraintsPolicy(),
+	)
 	if err != nil {
 		t.Fatalf("unable to verify constraints: %v", err)
 	}
@@ -904,7 +911,9 @@ func testSingleFunderReservationWorkflow(miner *rpctest.Harness,
 	if err != nil {
 		t.Fatalf("unable to create bob reservation: %v", err)
 	}
-	err = bobChanReservation.CommitConstraints(channelConstraints)
+	err = bobChanReservation.CommitConstraints(
+		channelConstraints, lnwallet.DefaultConstraintsPolicy(),
+	)
 	if err != nil {
 		t.Fatalf("unable to verify constraints: %v", err)
 	}
@@ -1187,8 +1196,13 @@ func testListTransactionDetails(miner *rpctest.Harness,
 	if err != nil {
 		t.Fatalf("unable to make output script: %v", err)
 	}
+	// We'll also label it to ensure the label is returned along with its
+	// details.
+	const burnLabel = "burn"
 	burnOutput := wire.NewTxOut(outputAmt, outputScript)
-	burnTX, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500)
+	burnTX, err := alice.SendOutputs(
+		[]*wire.TxOut{burnOutput}, 2500, 1, burnLabel,
+	)
 	if err != nil {
 		t.Fatalf("unable to create burn tx: %v", err)
 	}
@@ -1271,10 +1285,25 @@ func testListTransactionDetails(miner *rpctest.Harness,
 			t.Fatalf("block hash mismatch, got %v expected %v",
 				txDetail.BlockHash, burnBlock[0])
 		}
+		if txDetail.Label != burnLabel {
+			t.Fatalf("label mismatch, got %v expected %v",
+				txDetail.Label, burnLabel)
+		}
 	}
 	if !burnTxFound {
 		t.Fatal("tx burning btc not found")
 	}
+
+	// Labelling the transaction again should fail unless we overwrite the
+	// existing label.
+	err = alice.LabelTransaction(burnTXID, "new label", false)
+	if err != lnwallet.ErrTxLabelExists {
+		t.Fatalf("expected ErrTxLabelExists, got %v", err)
+	}
+	err = alice.LabelTransaction(burnTXID, "new label", true)
+	if err != nil {
+		t.Fatalf("unable to overwrite label: %v", err)
+	}
 }
 
 func testTransactionSubscriptions(miner *rpctest.Harness,
@@ -1401,7 +1430,7 @@ func testTransactionSubscriptions(miner *rpctest.Harness,
 		t.Fatalf("unable to make output script: %v", err)
 	}
 	burnOutput := wire.NewTxOut(outputAmt, outputScript)
-	tx, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500)
+	tx, err := alice.SendOutputs([]*wire.TxOut{burnOutput}, 2500, 1, "")
 	if err != nil {
 		t.Fatalf("unable to create burn tx: %v", err)
 	}
@@ -1578,7 +1607,9 @@ func testPublishTransaction(r *rpctest.Harness,
 			Value:    btcutil.SatoshiPerBitcoin,
 			PkScript: keyScript,
 		}
-		tx, err := alice.SendOutputs([]*wire.TxOut{newOutput}, 2500)
+		tx, err := alice.SendOutputs(
+			[]*wire.TxOut{newOutput}, 2500, 1, "",
+		)
 		if err != nil {
 			t.Fatalf("unable to create output: %v", err)
 		}
@@ -1820,7 +1851,9 @@ func testSignOutputUsingTweaks(r *rpctest.Harness,
 			Value:    btcutil.SatoshiPerBitcoin,
 			PkScript: keyScript,
 		}
-		tx, err := alice.SendOutputs([]*wire.TxOut{newOutput}, 2500)
+		tx, err := alice.SendOutputs(
+			[]*wire.TxOut{newOutput}, 2500, 1, "",
+		)
 		if err != nil {
 			t.Fatalf("unable to create output: %v", err)
 		}
@@ -1942,7 +1975,7 @@ func testReorgWalletBalance(r *rpctest.Harness, w *lnwallet.LightningWallet,
 		Value:    1e8,
 		PkScript: script,
 	}
-	tx, err := w.SendOutputs([]*wire.TxOut{output}, 2500)
+	tx, err := w.SendOutputs([]*wire.TxOut{output}, 2500, 1, "")
 	if err != nil {
 		t.Fatalf("unable to send outputs: %v", err)
 	}
@@ -2106,7 +2139,7 @@ func testChangeOutputSpendConfirmation(r *rpctest.Harness,
 	//
 	// TODO(wilmer): replace this once SendOutputs easily supports sending
 	// all funds in one transaction.
-	txFeeRate := lnwallet.SatPerKWeight(2500)
+	txFeeRate := chainfee.SatPerKWeight(2500)
 	txFee := btcutil.Amount(14380)
 	output := &wire.TxOut{
 		Value:    int64(aliceBalance - txFee),
@@ -2402,7 +2435,7 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 		aliceWalletController lnwallet.WalletController
 		bobWalletController   lnwallet.WalletController
 
-		feeEstimator lnwallet.FeeEstimator
+		feeEstimator chainfee.Estimator
 	)
 
 	tempTestDirAlice, err := ioutil.TempDir("", "lnwallet")
@@ -2423,7 +2456,7 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 		var aliceClient, bobClient chain.Interface
 		switch backEnd {
 		case "btcd":
-			feeEstimator, err = lnwallet.NewBtcdFeeEstimator(
+			feeEstimator, err = chainfee.NewBtcdEstimator(
 				rpcConfig, 250)
 			if err != nil {
 				t.Fatalf("unable to create btcd fee estimator: %v",
@@ -2443,7 +2476,7 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 			}
 
 		case "neutrino":
-			feeEstimator = lnwallet.NewStaticFeeEstimator(62500, 0)
+			feeEstimator = chainfee.NewStaticEstimator(62500, 0)
 
 			// Set some package-level variable to speed up
 			// operation for tests.
@@ -2506,7 +2539,7 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 			)
 
 		case "bitcoind":
-			feeEstimator, err = lnwallet.NewBitcoindFeeEstimator(
+			feeEstimator, err = chainfee.NewBitcoindEstimator(
 				rpcConfig, 250)
 			if err != nil {
 				t.Fatalf("unable to create bitcoind fee estimator: %v",
@@ -10,9 +10,54 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
+const (
+	// DefaultMaxCsvDelay is the default largest CSV delay that we'll
+	// accept the remote party to require on our funds.
+	//
+	// TODO(halseth): find a more scientific choice of value.
+	DefaultMaxCsvDelay = 10000
+
+	// DefaultMaxChanReservePercent is the default largest channel reserve,
+	// expressed as a percentage of the channel capacity, that we'll accept
+	// the remote party to require us to keep.
+	DefaultMaxChanReservePercent = 20
+)
+
+// ConstraintsPolicy bounds the channel constraints that the remote party may
+// dictate for our commitment transaction. These bounds are evaluated by
+// CommitConstraints.
+type ConstraintsPolicy struct {
+	// MaxCsvDelay is the largest CSV delay that we'll accept the remote
+	// party to require on our funds in case of a unilateral close.
+	MaxCsvDelay uint16
+
+	// MaxChanReservePercent is the largest channel reserve, expressed as
+	// a percentage of the channel capacity, that we'll accept the remote
+	// party to require us to keep.
+	MaxChanReservePercent uint32
+
+	// MinMaxInFlightPercent is the smallest limit on the total value
+	// of our HTLCs in flight, expressed as a percentage of the channel
+	// capacity, that we'll accept the remote party to impose on us. A
+	// value of zero only requires the limit to allow a handful of HTLCs
+	// of the minimum size.
+	MinMaxInFlightPercent uint32
+}
+
+// DefaultConstraintsPolicy returns the default bounds on the channel
+// constraints that the remote party may dictate for our commitment
+// transaction.
+func DefaultConstraintsPolicy() *ConstraintsPolicy {
+	return &ConstraintsPolicy{
+		MaxCsvDelay:           DefaultMaxCsvDelay,
+		MaxChanReservePercent: DefaultMaxChanReservePercent,
+	}
+}
+
 // ChannelContribution is the primary constituent of the funding workflow
 // within lnwallet. Each side first exchanges their respective contributions
 // along with channel specific parameters like the min fee/KB. Once
@@ -42,6 +87,10 @@ type ChannelContribution struct {
 	// such as the min HTLC, and also all the keys which will be used for
 	// the duration of the channel.
 	*channeldb.ChannelConfig
+
+	// UpfrontShutdown is an optional address to which the channel should
+	// be paid out to on cooperative close.
+	UpfrontShutdown lnwire.DeliveryAddress
 }
 
 // toChanConfig returns the raw channel configuration generated by a node's
@@ -128,17 +177,26 @@ type ChannelReservation struct {
 // creation of all channel reservations should be carried out via the
 // lnwallet.InitChannelReservation interface.
 func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
-	commitFeePerKw SatPerKWeight, wallet *LightningWallet,
+	commitFeePerKw chainfee.SatPerKWeight, wallet *LightningWallet,
 	id uint64, pushMSat lnwire.MilliSatoshi, chainHash *chainhash.Hash,
-	flags lnwire.FundingFlag) (*ChannelReservation, error) {
+	flags lnwire.FundingFlag, anchors bool) (*ChannelReservation, error) {
 
 	var (
 		ourBalance   lnwire.MilliSatoshi
 		theirBalance lnwire.MilliSatoshi
 		initiator    bool
+		commitType   channeldb.ChannelType
 	)
 
-	commitFee := commitFeePerKw.FeeForWeight(input.CommitWeight)
+	// If the channel uses anchor outputs, then the initiator will also
+	// need to pay for the value of both anchors on top of the fee of the
+	// initial commitment.
+	if anchors {
+		commitType = channeldb.AnchorOutputsBit
+	}
+	commitFee := CommitFeeForWeight(
+		commitType, commitFeePerKw, CommitWeight(0, commitType),
+	)
 	fundingMSat := lnwire.NewMSatFromSatoshis(fundingAmt)
 	capacityMSat := lnwire.NewMSatFromSatoshis(capacity)
 	feeMSat := lnwire.NewMSatFromSatoshis(commitFee)
@@ -218,6 +276,7 @@ func NewChannelReservation(capacity, fundingAmt btcutil.Amount,
 		initiator = false
 		chanType = channeldb.DualFunder
 	}
+	chanType |= commitType
 
 	return &ChannelReservation{
 		ourContribution: &ChannelContribution{
@@ -269,21 +328,31 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
+// SetOurUpfrontShutdown sets the upfront shutdown address on our contribution
+// to the channel. If set, the channel can only be cooperatively closed to this
+// address.
+func (r *ChannelReservation) SetOurUpfrontShutdown(shutdown lnwire.DeliveryAddress) {
+	r.Lock()
+	defer r.Unlock()
+
+	r.ourContribution.UpfrontShutdown = shutdown
+}
+
 // CommitConstraints takes the constraints that the remote party specifies for
 // the type of commitments that we can generate for them. These constraints
 // include several parameters that serve as flow control restricting the amount
 // of satoshis that can be transferred in a single commitment. This function
-// will also attempt to verify the constraints for sanity, returning an error
-// if the parameters are seemed unsound.
-func (r *ChannelReservation) CommitConstraints(c *channeldb.ChannelConstraints) error {
+// will also attempt to verify the constraints for sanity against the passed
+// policy, returning an error if the parameters are seemed unsound.
+func (r *ChannelReservation) CommitConstraints(c *channeldb.ChannelConstraints,
+	policy *ConstraintsPolicy) error {
+
 	r.Lock()
 	defer r.Unlock()
 
 	// Fail if we consider csvDelay excessively large.
-	// TODO(halseth): find a more scientific choice of value.
-	const maxDelay = 10000
-	if c.CsvDelay > maxDelay {
-		return ErrCsvDelayTooLarge(c.CsvDelay, maxDelay)
+	if c.CsvDelay > policy.MaxCsvDelay {
+		return ErrCsvDelayTooLarge(c.CsvDelay, policy.MaxCsvDelay)
 	}
 
 	// The dust limit should always be greater or equal to the channel
@@ -292,9 +361,11 @@ func (r *ChannelReservation) CommitConstraints(c *channeldb.ChannelConstraints)
 		return ErrChanReserveTooSmall(c.ChanReserve, c.DustLimit)
 	}
 
-	// Fail if we consider the channel reserve to be too large.  We
-	// currently fail if it is greater than 20% of the channel capacity.
-	maxChanReserve := r.partialState.Capacity / 5
+	// Fail if we consider the channel reserve to be too large, which is
+	// the case if it's greater than the percentage of the channel capacity
+	// allowed by our policy.
+	maxChanReserve := r.partialState.Capacity *
+		btcutil.Amount(policy.MaxChanReservePercent) / 100
 	if c.ChanReserve > maxChanReserve {
 		return ErrChanReserveTooLarge(c.ChanReserve, maxChanReserve)
 	}
@@ -322,11 +393,19 @@ func (r *ChannelReservation) CommitConstraints(c *channeldb.ChannelConstraints)
 		return ErrMaxHtlcNumTooSmall(c.MaxAcceptedHtlcs, minNumHtlc)
 	}
 
-	// Fail if we consider maxValueInFlight too small. We currently require
-	// the remote to at least allow minNumHtlc * minHtlc in flight.
-	if c.MaxPendingAmount < minNumHtlc*c.MinHTLC {
+	// Fail if we consider maxValueInFlight too small. We require the
+	// remote to at least allow minNumHtlc * minHtlc in flight, and the
+	// percentage of the channel capacity required by our policy.
+	minMaxValueInFlight := minNumHtlc * c.MinHTLC
+	capacity := lnwire.NewMSatFromSatoshis(r.partialState.Capacity)
+	policyMin := capacity *
+		lnwire.MilliSatoshi(policy.MinMaxInFlightPercent) / 100
+	if policyMin > minMaxValueInFlight {
+		minMaxValueInFlight = policyMin
+	}
+	if c.MaxPendingAmount < minMaxValueInFlight {
 		return ErrMaxValueInFlightTooSmall(
-			c.MaxPendingAmount, minNumHtlc*c.MinHTLC,
+			c.MaxPendingAmount, minMaxValueInFlight,
 		)
 	}
 
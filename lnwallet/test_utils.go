@@ -18,6 +18,7 @@ import (
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/shachain"
 )
@@ -96,6 +97,14 @@ var (
 // the test has been finalized. The clean up function will remote all temporary
 // files created
 func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error) {
+	return createTestChannels(channeldb.SingleFunder)
+}
+
+// createTestChannels creates two fully populated channels of the given type,
+// as described in CreateTestChannels.
+func createTestChannels(chanType channeldb.ChannelType) (*LightningChannel,
+	*LightningChannel, func(), error) {
+
 	channelCapacity, err := btcutil.NewAmount(10)
 	if err != nil {
 		return nil, nil, nil, err
@@ -210,7 +219,7 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 
 	aliceCommitTx, bobCommitTx, err := CreateCommitmentTxns(channelBal,
 		channelBal, &aliceCfg, &bobCfg, aliceCommitPoint, bobCommitPoint,
-		*fundingTxIn)
+		*fundingTxIn, chanType)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -227,12 +236,14 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 		return nil, nil, nil, err
 	}
 
-	estimator := NewStaticFeeEstimator(6000, 0)
+	estimator := chainfee.NewStaticEstimator(6000, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		return nil, nil, nil, err
 	}
-	commitFee := calcStaticFee(0)
+	commitFee := CommitFeeForWeight(
+		chanType, feePerKw, CommitWeight(0, chanType),
+	)
 
 	aliceCommit := channeldb.ChannelCommitment{
 		CommitHeight:  0,
@@ -268,7 +279,7 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 		IdentityPub:             aliceKeys[0].PubKey(),
 		FundingOutpoint:         *prevOut,
 		ShortChannelID:          shortChanID,
-		ChanType:                channeldb.SingleFunder,
+		ChanType:                chanType,
 		IsInitiator:             true,
 		Capacity:                channelCapacity,
 		RemoteCurrentRevocation: bobCommitPoint,
@@ -286,7 +297,7 @@ func CreateTestChannels() (*LightningChannel, *LightningChannel, func(), error)
 		IdentityPub:             bobKeys[0].PubKey(),
 		FundingOutpoint:         *prevOut,
 		ShortChannelID:          shortChanID,
-		ChanType:                channeldb.SingleFunder,
+		ChanType:                chanType,
 		IsInitiator:             false,
 		Capacity:                channelCapacity,
 		RemoteCurrentRevocation: aliceCommitPoint,
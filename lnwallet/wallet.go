@@ -21,6 +21,7 @@ import (
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/shachain"
 )
@@ -80,11 +81,11 @@ type InitFundingReserveMsg struct {
 	// of initial commitment transactions. In order to ensure timely
 	// confirmation, it is recommended that this fee should be generous,
 	// paying some multiple of the accepted base fee rate of the network.
-	CommitFeePerKw SatPerKWeight
+	CommitFeePerKw chainfee.SatPerKWeight
 
 	// FundingFeePerKw is the fee rate in sat/kw to use for the initial
 	// funding transaction.
-	FundingFeePerKw SatPerKWeight
+	FundingFeePerKw chainfee.SatPerKWeight
 
 	// PushMSat is the number of milli-satoshis that should be pushed over
 	// the responder as part of the initial channel creation.
@@ -98,6 +99,10 @@ type InitFundingReserveMsg struct {
 	// output selected to fund the channel should satisfy.
 	MinConfs int32
 
+	// Anchors should be set to true if the channel should use the anchor
+	// outputs commitment format.
+	Anchors bool
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -271,6 +276,12 @@ type LightningWallet struct {
 	// the currently locked outpoints.
 	lockedOutPoints map[wire.OutPoint]struct{}
 
+	// leasedOutPoints maps each output leased for external use to the
+	// LockID it was leased under. Unlike lockedOutPoints, these aren't
+	// released when reservations are reset, but they're only held in
+	// memory and don't survive a restart.
+	leasedOutPoints map[wire.OutPoint]LockID
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -291,6 +302,7 @@ func NewLightningWallet(Cfg Config) (*LightningWallet, error) {
 		nextFundingID:    0,
 		fundingLimbo:     make(map[uint64]*ChannelReservation),
 		lockedOutPoints:  make(map[wire.OutPoint]struct{}),
+		leasedOutPoints:  make(map[wire.OutPoint]LockID),
 		quit:             make(chan struct{}),
 	}, nil
 }
@@ -342,6 +354,102 @@ func (l *LightningWallet) LockedOutpoints() []*wire.OutPoint {
 	return outPoints
 }
 
+// LockedBalance returns the total value of all outputs currently locked,
+// either because they've been selected for a pending funding flow or because
+// they've been leased for external use. These outputs aren't reflected in
+// ConfirmedBalance. Outputs that can no longer be found within the wallet,
+// as they've already been spent, are skipped.
+func (l *LightningWallet) LockedBalance() (btcutil.Amount, error) {
+	l.coinSelectMtx.RLock()
+	defer l.coinSelectMtx.RUnlock()
+
+	var balance btcutil.Amount
+	sumOutput := func(op wire.OutPoint) {
+		txOut, err := l.FetchInputInfo(&op)
+		if err != nil {
+			walletLog.Debugf("Skipping locked output %v: %v", op,
+				err)
+			return
+		}
+
+		balance += btcutil.Amount(txOut.Value)
+	}
+
+	for op := range l.lockedOutPoints {
+		sumOutput(op)
+	}
+	for op := range l.leasedOutPoints {
+		sumOutput(op)
+	}
+
+	return balance, nil
+}
+
+// LeaseOutput locks the given output under the passed LockID, reserving it
+// for external use. Leased outputs won't be considered during coin selection
+// until they're released. Leasing an output already leased with the same
+// LockID is a no-op. The lease isn't persisted, and is lost on restart.
+//
+// NOTE: This is part of the OutputLeaser interface.
+func (l *LightningWallet) LeaseOutput(id LockID, op wire.OutPoint) error {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	if leaseID, ok := l.leasedOutPoints[op]; ok {
+		if leaseID != id {
+			return ErrOutputAlreadyLeased
+		}
+		return nil
+	}
+
+	// If the output has been selected for a pending funding transaction,
+	// then it can't be leased.
+	if _, ok := l.lockedOutPoints[op]; ok {
+		return ErrOutputAlreadyLeased
+	}
+
+	// Ensure the output is actually one of our unspent outputs before
+	// locking it.
+	coins, err := l.ListUnspentWitness(0, math.MaxInt32)
+	if err != nil {
+		return err
+	}
+	var found bool
+	for _, coin := range coins {
+		if coin.OutPoint == op {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrNotMine
+	}
+
+	l.leasedOutPoints[op] = id
+	l.LockOutpoint(op)
+
+	return nil
+}
+
+// ReleaseOutput unlocks an output previously leased with LeaseOutput, making
+// it available to coin selection again.
+//
+// NOTE: This is part of the OutputLeaser interface.
+func (l *LightningWallet) ReleaseOutput(id LockID, op wire.OutPoint) error {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	leaseID, ok := l.leasedOutPoints[op]
+	if !ok || leaseID != id {
+		return ErrOutputNotLeased
+	}
+
+	delete(l.leasedOutPoints, op)
+	l.UnlockOutpoint(op)
+
+	return nil
+}
+
 // ResetReservations reset the volatile wallet state which tracks all currently
 // active reservations.
 func (l *LightningWallet) ResetReservations() {
@@ -453,6 +561,7 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	reservation, err := NewChannelReservation(
 		req.Capacity, req.FundingAmount, req.CommitFeePerKw, l, id,
 		req.PushMSat, l.Cfg.NetParams.GenesisHash, req.Flags,
+		req.Anchors,
 	)
 	if err != nil {
 		req.err <- err
@@ -622,15 +731,31 @@ func (l *LightningWallet) handleFundingCancelRequest(req *fundingReserveCancelMs
 	req.err <- nil
 }
 
+// pruneLockedInputs removes the given funding inputs from the set of locked
+// outputs once the funding flow they were selected for has completed. They
+// remain locked within the wallet, such that they can't be selected again
+// before the funding transaction spending them is broadcast.
+func (l *LightningWallet) pruneLockedInputs(inputs []*wire.TxIn) {
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	for _, input := range inputs {
+		delete(l.lockedOutPoints, input.PreviousOutPoint)
+	}
+}
+
 // CreateCommitmentTxns is a helper function that creates the initial
 // commitment transaction for both parties. This function is used during the
 // initial funding workflow as both sides must generate a signature for the
 // remote party's commitment transaction, and verify the signature for their
-// version of the commitment transaction.
+// version of the commitment transaction. If the channel type uses anchor
+// outputs, both commitments will carry the anchors of the parties that have an
+// output on them.
 func CreateCommitmentTxns(localBalance, remoteBalance btcutil.Amount,
 	ourChanCfg, theirChanCfg *channeldb.ChannelConfig,
 	localCommitPoint, remoteCommitPoint *btcec.PublicKey,
-	fundingTxIn wire.TxIn) (*wire.MsgTx, *wire.MsgTx, error) {
+	fundingTxIn wire.TxIn, chanType channeldb.ChannelType) (*wire.MsgTx,
+	*wire.MsgTx, error) {
 
 	localCommitmentKeys := deriveCommitmentKeys(localCommitPoint, true,
 		ourChanCfg, theirChanCfg)
@@ -643,6 +768,17 @@ func CreateCommitmentTxns(localBalance, remoteBalance btcutil.Amount,
 	if err != nil {
 		return nil, nil, err
 	}
+	if chanType.HasAnchors() {
+		err := addAnchorOutputs(
+			ourCommitTx, ourChanCfg.MultiSigKey.PubKey,
+			theirChanCfg.MultiSigKey.PubKey,
+			localBalance >= ourChanCfg.DustLimit,
+			remoteBalance >= ourChanCfg.DustLimit, false,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 
 	otxn := btcutil.NewTx(ourCommitTx)
 	if err := blockchain.CheckTransactionSanity(otxn); err != nil {
@@ -655,6 +791,17 @@ func CreateCommitmentTxns(localBalance, remoteBalance btcutil.Amount,
 	if err != nil {
 		return nil, nil, err
 	}
+	if chanType.HasAnchors() {
+		err := addAnchorOutputs(
+			theirCommitTx, theirChanCfg.MultiSigKey.PubKey,
+			ourChanCfg.MultiSigKey.PubKey,
+			remoteBalance >= theirChanCfg.DustLimit,
+			localBalance >= theirChanCfg.DustLimit, false,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 
 	ttxn := btcutil.NewTx(theirCommitTx)
 	if err := blockchain.CheckTransactionSanity(ttxn); err != nil {
@@ -804,6 +951,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		theirContribution.ChannelConfig,
 		ourContribution.FirstCommitmentPoint,
 		theirContribution.FirstCommitmentPoint, fundingTxIn,
+		pendingReservation.partialState.ChanType,
 	)
 	if err != nil {
 		req.err <- err
@@ -814,7 +962,7 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 	// obfuscator then use it to encode the current state number within
 	// both commitment transactions.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType == channeldb.SingleFunder {
+	if chanState.ChanType.IsSingleFunder() {
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
@@ -1057,6 +1205,10 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	delete(l.fundingLimbo, res.reservationID)
 	l.limboMtx.Unlock()
 
+	// The inputs we contributed are now spent by the funding transaction,
+	// so they no longer need to be tracked as locked.
+	l.pruneLockedInputs(res.ourContribution.Inputs)
+
 	// As we're about to broadcast the funding transaction, we'll take note
 	// of the current height for record keeping purposes.
 	//
@@ -1075,6 +1227,11 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 	res.partialState.LocalChanCfg = res.ourContribution.toChanConfig()
 	res.partialState.RemoteChanCfg = res.theirContribution.toChanConfig()
 
+	// Record the upfront shutdown scripts, if any, that were committed to
+	// by both parties.
+	res.partialState.LocalShutdownScript = res.ourContribution.UpfrontShutdown
+	res.partialState.RemoteShutdownScript = res.theirContribution.UpfrontShutdown
+
 	// We'll also record the finalized funding txn, which will allow us to
 	// rebroadcast on startup in case we fail.
 	res.partialState.FundingTxn = fundingTx
@@ -1127,7 +1284,7 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 		pendingReservation.theirContribution.ChannelConfig,
 		pendingReservation.ourContribution.FirstCommitmentPoint,
 		pendingReservation.theirContribution.FirstCommitmentPoint,
-		*fundingTxIn,
+		*fundingTxIn, pendingReservation.partialState.ChanType,
 	)
 	if err != nil {
 		req.err <- err
@@ -1238,6 +1395,8 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 	// which will be used for the lifetime of this channel.
 	chanState.LocalChanCfg = pendingReservation.ourContribution.toChanConfig()
 	chanState.RemoteChanCfg = pendingReservation.theirContribution.toChanConfig()
+	chanState.LocalShutdownScript = pendingReservation.ourContribution.UpfrontShutdown
+	chanState.RemoteShutdownScript = pendingReservation.theirContribution.UpfrontShutdown
 	err = chanState.SyncPending(pendingReservation.nodeAddr, uint32(bestHeight))
 	if err != nil {
 		req.err <- err
@@ -1269,7 +1428,7 @@ func (l *LightningWallet) WithCoinSelectLock(f func() error) error {
 // selection is successful/possible, then the selected coins are available
 // within the passed contribution's inputs. If necessary, a change address will
 // also be generated.
-func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
+func (l *LightningWallet) selectCoinsAndChange(feeRate chainfee.SatPerKWeight,
 	amt btcutil.Amount, minConfs int32,
 	contribution *ChannelContribution) error {
 
@@ -1280,7 +1439,8 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	defer l.coinSelectMtx.Unlock()
 
 	walletLog.Infof("Performing funding tx coin selection using %v "+
-		"sat/kw as fee rate", int64(feeRate))
+		"sat/kw as fee rate, strategy=%v", int64(feeRate),
+		l.Cfg.CoinSelectionStrategy)
 
 	// Find all unlocked unspent witness outputs that satisfy the minimum
 	// number of confirmations required.
@@ -1289,9 +1449,10 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 		return err
 	}
 
-	// Perform coin selection over our available, unlocked unspent outputs
-	// in order to find enough coins to meet the funding amount
-	// requirements.
+	// Order the coins according to the configured strategy, then perform
+	// coin selection over our available, unlocked unspent outputs in
+	// order to find enough coins to meet the funding amount requirements.
+	coins = orderCoins(l.Cfg.CoinSelectionStrategy, coins)
 	selectedCoins, changeAmt, err := coinSelect(feeRate, amt, coins)
 	if err != nil {
 		return err
@@ -1390,7 +1551,7 @@ func selectInputs(amt btcutil.Amount, coins []*Utxo) (btcutil.Amount, []*Utxo, e
 // change output to fund amt satoshis, adhering to the specified fee rate. The
 // specified fee rate should be expressed in sat/kw for coin selection to
 // function properly.
-func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
+func coinSelect(feeRate chainfee.SatPerKWeight, amt btcutil.Amount,
 	coins []*Utxo) ([]*Utxo, btcutil.Amount, error) {
 
 	amtNeeded := amt
@@ -86,6 +86,12 @@ type AcceptChannel struct {
 	// base point in order to derive the revocation keys that are placed
 	// within the commitment transaction of the sender.
 	FirstCommitmentPoint *btcec.PublicKey
+
+	// UpfrontShutdownScript is the script to which the sender's funds
+	// will be paid out when the channel is cooperatively closed. This
+	// field is always sent, but is left empty when the sender doesn't wish
+	// to commit to a shutdown script.
+	UpfrontShutdownScript DeliveryAddress
 }
 
 // A compile time check to ensure AcceptChannel implements the lnwire.Message
@@ -98,7 +104,7 @@ var _ Message = (*AcceptChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		a.PendingChannelID[:],
 		a.DustLimit,
 		a.MaxValueInFlight,
@@ -114,6 +120,14 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 		a.HtlcPoint,
 		a.FirstCommitmentPoint,
 	)
+	if err != nil {
+		return err
+	}
+
+	// As we always signal support for option_upfront_shutdown_script, the
+	// field must always be present. If the sender doesn't wish to commit
+	// to a shutdown script, it's written out with a zero length.
+	return WriteElement(w, a.UpfrontShutdownScript)
 }
 
 // Decode deserializes the serialized AcceptChannel stored in the passed
@@ -122,7 +136,7 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		a.PendingChannelID[:],
 		&a.DustLimit,
 		&a.MaxValueInFlight,
@@ -138,6 +152,18 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 		&a.HtlcPoint,
 		&a.FirstCommitmentPoint,
 	)
+	if err != nil {
+		return err
+	}
+
+	// The upfront shutdown script is optional, so if we're at the EOF,
+	// then this means the field wasn't included.
+	err = ReadElement(r, &a.UpfrontShutdownScript)
+	if err == io.EOF {
+		return nil
+	}
+
+	return err
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -153,6 +179,6 @@ func (a *AcceptChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) MaxPayloadLength(uint32) uint32 {
-	// 32 + (8 * 4) + (4 * 1) + (2 * 2) + (33 * 6)
-	return 270
+	// 32 + (8 * 4) + (4 * 1) + (2 * 2) + (33 * 6) + 2 + 34
+	return 306
 }
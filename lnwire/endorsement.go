@@ -0,0 +1,180 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// EndorsementRecordType is the experimental TLV type within the
+// update_add_htlc TLV stream that is used to signal whether the sender of an
+// HTLC endorses it. Nodes use this signal as an indication that the HTLC is
+// expected to resolve quickly, as a building block for channel jamming
+// mitigation.
+const EndorsementRecordType uint64 = 106823
+
+// endorsementEndorsed is the value of the endorsement record for an HTLC
+// that is endorsed by its sender. Any other value signals that the HTLC isn't
+// endorsed.
+const endorsementEndorsed byte = 1
+
+// ErrUnknownRequiredRecord is returned when decoding a TLV stream that
+// contains an even record type that we don't understand.
+type ErrUnknownRequiredRecord uint64
+
+// Error returns a human readable description of the error.
+func (e ErrUnknownRequiredRecord) Error() string {
+	return fmt.Sprintf("unknown required tlv record type: %d", uint64(e))
+}
+
+// writeBigSize writes the passed integer using the BigSize variable length
+// encoding used within TLV streams.
+func writeBigSize(w io.Writer, val uint64) error {
+	var b [9]byte
+	var n int
+
+	switch {
+	case val < 0xfd:
+		b[0] = uint8(val)
+		n = 1
+
+	case val <= 0xffff:
+		b[0] = 0xfd
+		binary.BigEndian.PutUint16(b[1:3], uint16(val))
+		n = 3
+
+	case val <= 0xffffffff:
+		b[0] = 0xfe
+		binary.BigEndian.PutUint32(b[1:5], uint32(val))
+		n = 5
+
+	default:
+		b[0] = 0xff
+		binary.BigEndian.PutUint64(b[1:9], val)
+		n = 9
+	}
+
+	_, err := w.Write(b[:n])
+	return err
+}
+
+// readBigSize reads an integer encoded using the BigSize variable length
+// encoding. Non-canonical encodings are rejected.
+func readBigSize(r io.Reader) (uint64, error) {
+	var b [8]byte
+	if _, err := io.ReadFull(r, b[:1]); err != nil {
+		return 0, err
+	}
+
+	var (
+		val uint64
+		min uint64
+	)
+	switch b[0] {
+	case 0xfd:
+		if _, err := io.ReadFull(r, b[:2]); err != nil {
+			return 0, err
+		}
+		val = uint64(binary.BigEndian.Uint16(b[:2]))
+		min = 0xfd
+
+	case 0xfe:
+		if _, err := io.ReadFull(r, b[:4]); err != nil {
+			return 0, err
+		}
+		val = uint64(binary.BigEndian.Uint32(b[:4]))
+		min = 0x10000
+
+	case 0xff:
+		if _, err := io.ReadFull(r, b[:8]); err != nil {
+			return 0, err
+		}
+		val = binary.BigEndian.Uint64(b[:8])
+		min = 0x100000000
+
+	default:
+		return uint64(b[0]), nil
+	}
+
+	if val < min {
+		return 0, fmt.Errorf("non-canonical BigSize encoding: %d", val)
+	}
+
+	return val, nil
+}
+
+// encodeEndorsement writes the TLV record signalling an endorsed HTLC to the
+// passed writer.
+func encodeEndorsement(w io.Writer) error {
+	if err := writeBigSize(w, EndorsementRecordType); err != nil {
+		return err
+	}
+	if err := writeBigSize(w, 1); err != nil {
+		return err
+	}
+
+	_, err := w.Write([]byte{endorsementEndorsed})
+	return err
+}
+
+// decodeEndorsement reads the remainder of the passed reader as a TLV stream
+// and returns whether it carries an endorsement record signalling an endorsed
+// HTLC. Unknown odd records are ignored, while unknown even records result in
+// an ErrUnknownRequiredRecord error.
+func decodeEndorsement(r io.Reader) (bool, error) {
+	stream, err := ioutil.ReadAll(r)
+	if err != nil {
+		return false, err
+	}
+	streamReader := bytes.NewReader(stream)
+
+	var (
+		endorsed bool
+		lastType uint64
+		first    = true
+	)
+	for streamReader.Len() > 0 {
+		recordType, err := readBigSize(streamReader)
+		if err != nil {
+			return false, err
+		}
+
+		// Records must be sent in strictly increasing order of their
+		// type.
+		if !first && recordType <= lastType {
+			return false, fmt.Errorf("tlv record type %d not in "+
+				"increasing order", recordType)
+		}
+		first = false
+		lastType = recordType
+
+		length, err := readBigSize(streamReader)
+		if err != nil {
+			return false, err
+		}
+		if length > uint64(streamReader.Len()) {
+			return false, io.ErrUnexpectedEOF
+		}
+
+		value := make([]byte, length)
+		if _, err := io.ReadFull(streamReader, value); err != nil {
+			return false, err
+		}
+
+		switch {
+		case recordType == EndorsementRecordType:
+			if length != 1 {
+				return false, fmt.Errorf("invalid endorsement "+
+					"record length: %d", length)
+			}
+			endorsed = value[0] == endorsementEndorsed
+
+		case recordType%2 == 0:
+			return false, ErrUnknownRequiredRecord(recordType)
+		}
+	}
+
+	return endorsed, nil
+}
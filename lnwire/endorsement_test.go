@@ -0,0 +1,118 @@
+package lnwire
+
+import (
+	"bytes"
+	"testing"
+)
+
+// TestBigSizeEncoding tests that integers are encoded using the minimal
+// BigSize encoding, and that they can be decoded back.
+func TestBigSizeEncoding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		val  uint64
+		size int
+	}{
+		{0, 1},
+		{0xfc, 1},
+		{0xfd, 3},
+		{0xffff, 3},
+		{0x10000, 5},
+		{EndorsementRecordType, 5},
+		{0xffffffff, 5},
+		{0x100000000, 9},
+	}
+
+	for _, test := range tests {
+		var b bytes.Buffer
+		if err := writeBigSize(&b, test.val); err != nil {
+			t.Fatalf("unable to write %d: %v", test.val, err)
+		}
+		if b.Len() != test.size {
+			t.Fatalf("expected %d to be encoded using %d bytes, "+
+				"got %d", test.val, test.size, b.Len())
+		}
+
+		val, err := readBigSize(&b)
+		if err != nil {
+			t.Fatalf("unable to read %d: %v", test.val, err)
+		}
+		if val != test.val {
+			t.Fatalf("expected %d, got %d", test.val, val)
+		}
+	}
+
+	// A value that could have been encoded using less bytes must be
+	// rejected.
+	nonCanonical := bytes.NewReader([]byte{0xfd, 0x00, 0xfc})
+	if _, err := readBigSize(nonCanonical); err == nil {
+		t.Fatalf("expected non-canonical encoding to be rejected")
+	}
+}
+
+// TestDecodeEndorsement tests that the endorsement signal is properly decoded
+// from the TLV stream of an UpdateAddHTLC message.
+func TestDecodeEndorsement(t *testing.T) {
+	t.Parallel()
+
+	var endorsedStream bytes.Buffer
+	if err := encodeEndorsement(&endorsedStream); err != nil {
+		t.Fatalf("unable to encode endorsement: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		stream   []byte
+		endorsed bool
+		fail     bool
+	}{
+		{
+			name: "empty stream",
+		},
+		{
+			name:     "endorsed",
+			stream:   endorsedStream.Bytes(),
+			endorsed: true,
+		},
+		{
+			name: "unendorsed",
+			stream: []byte{
+				0xfe, 0x00, 0x01, 0xa1, 0x47, 0x01, 0x00,
+			},
+		},
+		{
+			name:   "unknown odd record",
+			stream: []byte{0x01, 0x02, 0xaa, 0xbb},
+		},
+		{
+			name:   "unknown even record",
+			stream: []byte{0x02, 0x01, 0xaa},
+			fail:   true,
+		},
+		{
+			name:   "invalid length",
+			stream: []byte{0x01, 0x05, 0xaa},
+			fail:   true,
+		},
+	}
+
+	for _, test := range tests {
+		endorsed, err := decodeEndorsement(bytes.NewReader(test.stream))
+		if test.fail {
+			if err == nil {
+				t.Fatalf("%v: expected decoding to fail",
+					test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("%v: unable to decode: %v", test.name, err)
+		}
+
+		if endorsed != test.endorsed {
+			t.Fatalf("%v: expected endorsed=%v, got %v",
+				test.name, test.endorsed, endorsed)
+		}
+	}
+}
@@ -30,6 +30,18 @@ const (
 	// data to recover their settled funds of the latest commitment state.
 	DataLossProtectOptional FeatureBit = 1
 
+	// UpfrontShutdownScriptRequired is a feature bit that signals that the
+	// sending peer requires that the remote party commits to a shutdown
+	// script during channel funding, and that this script is used when the
+	// channel is closed cooperatively.
+	UpfrontShutdownScriptRequired FeatureBit = 4
+
+	// UpfrontShutdownScriptOptional is an optional feature bit that signals
+	// that the sending peer is able to commit to a shutdown script during
+	// channel funding, and will enforce any script committed to by the
+	// remote party.
+	UpfrontShutdownScriptOptional FeatureBit = 5
+
 	// InitialRoutingSync is a local feature bit meaning that the receiving
 	// node should send a complete dump of routing information when a new
 	// connection is established.
@@ -46,6 +58,23 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// AnchorOutputsRequired is a required feature bit that signals that
+	// the node requires channels to be made using commitments having
+	// anchor outputs.
+	//
+	// NOTE: Our anchor commitment format doesn't follow the one of
+	// option_anchor_outputs yet, so we use an experimental bit rather than
+	// the one assigned by BOLT-09 to make sure we only negotiate it with
+	// peers that implement the same format. Support for it is only
+	// signaled by dev builds.
+	AnchorOutputsRequired FeatureBit = 1336
+
+	// AnchorOutputsOptional is an optional feature bit that signals that
+	// the node supports channels to be made using commitments having
+	// anchor outputs. See AnchorOutputsRequired for why this isn't the
+	// option_anchor_outputs bit.
+	AnchorOutputsOptional FeatureBit = 1337
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -72,6 +101,12 @@ var LocalFeatures = map[FeatureBit]string{
 	InitialRoutingSync:      "initial-routing-sync",
 	GossipQueriesRequired:   "gossip-queries",
 	GossipQueriesOptional:   "gossip-queries",
+
+	UpfrontShutdownScriptRequired: "upfront-shutdown-script",
+	UpfrontShutdownScriptOptional: "upfront-shutdown-script",
+
+	AnchorOutputsRequired: "anchor-commitments",
+	AnchorOutputsOptional: "anchor-commitments",
 }
 
 // GlobalFeatures is a mapping of known global feature bits to a descriptive
@@ -114,6 +149,15 @@ func (fv *RawFeatureVector) Unset(feature FeatureBit) {
 	delete(fv.features, feature)
 }
 
+// Clone makes a copy of a feature vector.
+func (fv *RawFeatureVector) Clone() *RawFeatureVector {
+	newFeatures := NewRawFeatureVector()
+	for bit := range fv.features {
+		newFeatures.Set(bit)
+	}
+	return newFeatures
+}
+
 // SerializeSize returns the number of bytes needed to represent feature vector
 // in byte format.
 func (fv *RawFeatureVector) SerializeSize() int {
@@ -253,6 +297,15 @@ func (fv *FeatureVector) IsKnown(bit FeatureBit) bool {
 	return known
 }
 
+// Features returns the set of feature bits enabled in the vector.
+func (fv *FeatureVector) Features() map[FeatureBit]struct{} {
+	features := make(map[FeatureBit]struct{}, len(fv.features))
+	for feature := range fv.features {
+		features[feature] = struct{}{}
+	}
+	return features
+}
+
 // isFeatureBitPair returns whether this feature bit and its corresponding
 // even/odd bit both represent the same feature. This may often be the case as
 // bits are generally assigned in pairs, first being assigned an odd bit
@@ -260,3 +260,26 @@ func TestFeatureNames(t *testing.T) {
 		}
 	}
 }
+
+// TestFeatures asserts that Features returns exactly the set of enabled
+// feature bits, and that the returned set is a copy.
+func TestFeatures(t *testing.T) {
+	t.Parallel()
+
+	fv := NewFeatureVector(nil, testFeatureNames)
+	fv.Set(0)
+	fv.Set(3)
+	fv.Set(6)
+	fv.Unset(3)
+
+	expected := map[FeatureBit]struct{}{0: {}, 6: {}}
+	features := fv.Features()
+	if !reflect.DeepEqual(features, expected) {
+		t.Fatalf("expected features %v, got %v", expected, features)
+	}
+
+	delete(features, 0)
+	if !fv.IsSet(0) {
+		t.Fatalf("modifying the returned set changed the vector")
+	}
+}
@@ -74,6 +74,13 @@ func randRawKey() ([33]byte, error) {
 	return n, nil
 }
 
+func randDeliveryAddress(r *rand.Rand) DeliveryAddress {
+	// Generate a p2wkh or p2wsh sized script.
+	addr := make(DeliveryAddress, 22+r.Intn(2)*12)
+	r.Read(addr)
+	return addr
+}
+
 func randRawFeatureVector(r *rand.Rand) *RawFeatureVector {
 	featureVec := NewRawFeatureVector()
 	for i := 0; i < 10000; i++ {
@@ -360,6 +367,14 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			// The upfront shutdown script is optional, so we'll
+			// only populate it half of the time. Otherwise it's
+			// encoded with a zero length, and decoded as such.
+			req.UpfrontShutdownScript = DeliveryAddress{}
+			if r.Intn(2) == 0 {
+				req.UpfrontShutdownScript = randDeliveryAddress(r)
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgAcceptChannel: func(v []reflect.Value, r *rand.Rand) {
@@ -410,6 +425,14 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			// The upfront shutdown script is optional, so we'll
+			// only populate it half of the time. Otherwise it's
+			// encoded with a zero length, and decoded as such.
+			req.UpfrontShutdownScript = DeliveryAddress{}
+			if r.Intn(2) == 0 {
+				req.UpfrontShutdownScript = randDeliveryAddress(r)
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgFundingCreated: func(v []reflect.Value, r *rand.Rand) {
@@ -122,6 +122,12 @@ type OpenChannel struct {
 	// Currently, the least significant bit of this bit field indicates the
 	// initiator of the channel wishes to advertise this channel publicly.
 	ChannelFlags FundingFlag
+
+	// UpfrontShutdownScript is the script to which the sender's funds
+	// will be paid out when the channel is cooperatively closed. This
+	// field is always sent, but is left empty when the sender doesn't wish
+	// to commit to a shutdown script.
+	UpfrontShutdownScript DeliveryAddress
 }
 
 // A compile time check to ensure OpenChannel implements the lnwire.Message
@@ -134,7 +140,7 @@ var _ Message = (*OpenChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		o.FundingAmount,
@@ -154,6 +160,14 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 		o.FirstCommitmentPoint,
 		o.ChannelFlags,
 	)
+	if err != nil {
+		return err
+	}
+
+	// As we always signal support for option_upfront_shutdown_script, the
+	// field must always be present. If the sender doesn't wish to commit
+	// to a shutdown script, it's written out with a zero length.
+	return WriteElement(w, o.UpfrontShutdownScript)
 }
 
 // Decode deserializes the serialized OpenChannel stored in the passed
@@ -162,7 +176,7 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		&o.FundingAmount,
@@ -182,6 +196,18 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 		&o.FirstCommitmentPoint,
 		&o.ChannelFlags,
 	)
+	if err != nil {
+		return err
+	}
+
+	// The upfront shutdown script is optional, so if we're at the EOF,
+	// then this means the field wasn't included.
+	err = ReadElement(r, &o.UpfrontShutdownScript)
+	if err == io.EOF {
+		return nil
+	}
+
+	return err
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -197,6 +223,6 @@ func (o *OpenChannel) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) MaxPayloadLength(uint32) uint32 {
-	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1
-	return 319
+	// (32 * 2) + (8 * 6) + (4 * 1) + (2 * 2) + (33 * 6) + 1 + 2 + 34
+	return 355
 }
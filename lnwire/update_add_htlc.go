@@ -50,6 +50,11 @@ type UpdateAddHTLC struct {
 	// should strip off a layer of encryption, exposing the next hop to be
 	// used in the subsequent UpdateAddHTLC message.
 	OnionBlob [OnionPacketSize]byte
+
+	// Endorsed signals whether the sender of the HTLC endorses it, as
+	// communicated by the experimental endorsement TLV record. This field
+	// is optional, and is only written to the wire if set.
+	Endorsed bool
 }
 
 // NewUpdateAddHTLC returns a new empty UpdateAddHTLC message.
@@ -66,7 +71,7 @@ var _ Message = (*UpdateAddHTLC)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		&c.ChanID,
 		&c.ID,
 		&c.Amount,
@@ -74,6 +79,14 @@ func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
 		&c.Expiry,
 		c.OnionBlob[:],
 	)
+	if err != nil {
+		return err
+	}
+
+	// Any remaining bytes make up the TLV stream of the message, which
+	// may carry the endorsement signal of the HTLC.
+	c.Endorsed, err = decodeEndorsement(r)
+	return err
 }
 
 // Encode serializes the target UpdateAddHTLC into the passed io.Writer observing
@@ -81,7 +94,7 @@ func (c *UpdateAddHTLC) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		c.ChanID,
 		c.ID,
 		c.Amount,
@@ -89,6 +102,17 @@ func (c *UpdateAddHTLC) Encode(w io.Writer, pver uint32) error {
 		c.Expiry,
 		c.OnionBlob[:],
 	)
+	if err != nil {
+		return err
+	}
+
+	// We'll only write the endorsement record if the HTLC is endorsed, to
+	// remain compatible with nodes that don't understand the TLV stream.
+	if !c.Endorsed {
+		return nil
+	}
+
+	return encodeEndorsement(w)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -104,6 +128,6 @@ func (c *UpdateAddHTLC) MsgType() MessageType {
 //
 // This is part of the lnwire.Message interface.
 func (c *UpdateAddHTLC) MaxPayloadLength(uint32) uint32 {
-	// 1450
-	return 32 + 8 + 4 + 8 + 32 + 1366
+	// 1457
+	return 32 + 8 + 4 + 8 + 32 + 1366 + 7
 }
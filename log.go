@@ -12,12 +12,15 @@ import (
 	"github.com/lightninglabs/neutrino"
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/blockfetch"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chainmonitor"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/health"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
@@ -26,6 +29,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
@@ -82,6 +86,10 @@ var (
 	ntfrLog = build.NewSubLogger("NTFR", backendLog.Logger)
 	irpcLog = build.NewSubLogger("IRPC", backendLog.Logger)
 	chnfLog = build.NewSubLogger("CHNF", backendLog.Logger)
+	chmnLog = build.NewSubLogger("CHMN", backendLog.Logger)
+	cfeeLog = build.NewSubLogger("CFEE", backendLog.Logger)
+	hlthLog = build.NewSubLogger("HLTH", backendLog.Logger)
+	bfchLog = build.NewSubLogger("BFCH", backendLog.Logger)
 )
 
 // Initialize package-global logger variables.
@@ -108,6 +116,10 @@ func init() {
 	chainrpc.UseLogger(ntfrLog)
 	invoicesrpc.UseLogger(irpcLog)
 	channelnotifier.UseLogger(chnfLog)
+	chainmonitor.UseLogger(chmnLog)
+	chainfee.UseLogger(cfeeLog)
+	health.UseLogger(hlthLog)
+	blockfetch.UseLogger(bfchLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -140,6 +152,10 @@ var subsystemLoggers = map[string]btclog.Logger{
 	"NTFR": ntfnLog,
 	"IRPC": irpcLog,
 	"CHNF": chnfLog,
+	"CHMN": chmnLog,
+	"CFEE": cfeeLog,
+	"HLTH": hlthLog,
+	"BFCH": bfchLog,
 }
 
 // initLogRotator initializes the logging rotator to write logs to logFile and
@@ -43,3 +43,34 @@ func NewMacaroonCredential(m *macaroon.Macaroon) MacaroonCredential {
 	ms.Macaroon = m.Clone()
 	return ms
 }
+
+// ConfirmMacaroonCredential wraps a confirmation macaroon to implement the
+// credentials.PerRPCCredentials interface.
+type ConfirmMacaroonCredential struct {
+	MacaroonCredential
+}
+
+// GetRequestMetadata implements the PerRPCCredentials interface. This method
+// passes the wrapped confirmation macaroon into the gRPC context under the
+// ConfirmMacaroonKey key.
+func (m ConfirmMacaroonCredential) GetRequestMetadata(ctx context.Context,
+	uri ...string) (map[string]string, error) {
+
+	macBytes, err := m.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	md := make(map[string]string)
+	md[ConfirmMacaroonKey] = hex.EncodeToString(macBytes)
+	return md, nil
+}
+
+// NewConfirmMacaroonCredential returns a copy of the passed confirmation
+// macaroon wrapped in a ConfirmMacaroonCredential struct which implements
+// PerRPCCredentials.
+func NewConfirmMacaroonCredential(
+	m *macaroon.Macaroon) ConfirmMacaroonCredential {
+
+	return ConfirmMacaroonCredential{NewMacaroonCredential(m)}
+}
@@ -3,6 +3,7 @@ package macaroons
 import (
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/peer"
@@ -13,6 +14,14 @@ import (
 	"golang.org/x/net/context"
 )
 
+const (
+	// CondLndCustom is the condition of custom caveats, which aren't
+	// enforced by lnd itself but by the RPC middleware registered for
+	// their name. The argument of a custom caveat is its name, followed
+	// by a space and its own condition.
+	CondLndCustom = "lnd-custom"
+)
+
 // Constraint type adds a layer of indirection over macaroon caveats.
 type Constraint func(*macaroon.Macaroon) error
 
@@ -88,3 +97,81 @@ func IPLockChecker() (string, checkers.Func) {
 		return nil
 	}
 }
+
+// CustomConstraint adds a custom caveat with the given name and condition to
+// the macaroon. The caveat is enforced by the RPC middleware registered for
+// its name.
+func CustomConstraint(name, condition string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if name == "" || strings.Contains(name, " ") {
+			return fmt.Errorf("invalid custom caveat name %q", name)
+		}
+
+		caveat := checkers.Condition(
+			CondLndCustom, fmt.Sprintf("%s %s", name, condition),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// CustomChecker accepts all well-formed custom caveats, as they're enforced by
+// the RPC middleware registered for their name rather than by the bakery. It
+// is of the `Checker` type.
+func CustomChecker() (string, checkers.Func) {
+	return CondLndCustom, func(ctx context.Context, cond, arg string) error {
+		if _, _, ok := parseCustomCaveatArg(arg); !ok {
+			return fmt.Errorf("invalid custom caveat %q", arg)
+		}
+
+		return nil
+	}
+}
+
+// CustomCaveat is a custom caveat of a macaroon.
+type CustomCaveat struct {
+	// Name is the name of the caveat, which determines the RPC middleware
+	// enforcing it.
+	Name string
+
+	// Condition is the condition of the caveat, which is only
+	// interpreted by the RPC middleware.
+	Condition string
+}
+
+// CustomCaveats returns the custom caveats of the macaroon, in the order they
+// were added.
+func CustomCaveats(mac *macaroon.Macaroon) []CustomCaveat {
+	var caveats []CustomCaveat
+	for _, caveat := range mac.Caveats() {
+		cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+		if err != nil || cond != CondLndCustom {
+			continue
+		}
+
+		name, condition, ok := parseCustomCaveatArg(arg)
+		if !ok {
+			continue
+		}
+
+		caveats = append(caveats, CustomCaveat{
+			Name:      name,
+			Condition: condition,
+		})
+	}
+
+	return caveats
+}
+
+// parseCustomCaveatArg splits the argument of a custom caveat into its name
+// and condition.
+func parseCustomCaveatArg(arg string) (string, string, bool) {
+	parts := strings.SplitN(arg, " ", 2)
+	if parts[0] == "" {
+		return "", "", false
+	}
+	if len(parts) == 1 {
+		return parts[0], "", true
+	}
+
+	return parts[0], parts[1], true
+}
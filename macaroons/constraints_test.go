@@ -107,3 +107,55 @@ func TestIPLockBadIP(t *testing.T) {
 		t.Fatalf("IPLockConstraint with bad IP should fail.")
 	}
 }
+
+// TestCustomConstraint tests that custom caveats are added to a macaroon, and
+// that they're returned by CustomCaveats in the order they were added.
+func TestCustomConstraint(t *testing.T) {
+	testMacaroon := createDummyMacaroon(t)
+
+	constraints := []macaroons.Constraint{
+		macaroons.IPLockConstraint("127.0.0.1"),
+		macaroons.CustomConstraint("account", "id 1234"),
+		macaroons.CustomConstraint("audit", ""),
+	}
+	for _, constraint := range constraints {
+		if err := constraint(testMacaroon); err != nil {
+			t.Fatalf("Error applying constraint: %v", err)
+		}
+	}
+
+	caveatID := string(testMacaroon.Caveats()[1].Id)
+	if caveatID != "lnd-custom account id 1234" {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			testMacaroon.Caveats()[1].Id)
+	}
+
+	caveats := macaroons.CustomCaveats(testMacaroon)
+	expected := []macaroons.CustomCaveat{
+		{Name: "account", Condition: "id 1234"},
+		{Name: "audit", Condition: ""},
+	}
+	if len(caveats) != len(expected) {
+		t.Fatalf("Expected %d custom caveats, got %d", len(expected),
+			len(caveats))
+	}
+	for i := range expected {
+		if caveats[i] != expected[i] {
+			t.Fatalf("Expected custom caveat %v, got %v",
+				expected[i], caveats[i])
+		}
+	}
+}
+
+// TestCustomConstraintBadName tests that a custom caveat cannot be added if
+// its name is empty or contains a space.
+func TestCustomConstraintBadName(t *testing.T) {
+	for _, name := range []string{"", "bad name"} {
+		constraintFunc := macaroons.CustomConstraint(name, "cond")
+		testMacaroon := createDummyMacaroon(t)
+		if err := constraintFunc(testMacaroon); err == nil {
+			t.Fatalf("CustomConstraint with name %q should fail.",
+				name)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"time"
 
 	"github.com/coreos/bbolt"
 	"google.golang.org/grpc"
@@ -17,12 +18,39 @@ import (
 	"golang.org/x/net/context"
 )
 
+const (
+	// ConfirmMacaroonKey is the request metadata key under which the
+	// confirmation macaroon is expected for the requests requiring it as
+	// a second authentication factor.
+	ConfirmMacaroonKey = "confirmmacaroon"
+
+	// MaxConfirmTimeout is the longest a confirmation macaroon may remain
+	// valid for. Confirmation macaroons must be constrained by a timeout
+	// of at most this duration, so a macaroon captured from a request
+	// can't be reused later on.
+	MaxConfirmTimeout = 5 * time.Minute
+)
+
 var (
 	// DBFilename is the filename within the data directory which contains
 	// the macaroon stores.
 	DBFilename = "macaroons.db"
+
+	// ConfirmPermissions are the permissions granted by the confirmation
+	// macaroon. These aren't granted by any other macaroon, which allows
+	// the confirmation macaroon to act as a second authentication factor.
+	ConfirmPermissions = []bakery.Op{
+		{
+			Entity: "confirm",
+			Action: "write",
+		},
+	}
 )
 
+// ConfirmationChecker determines whether a request requires the confirmation
+// macaroon as a second authentication factor, e.g. because it's destructive.
+type ConfirmationChecker func(req interface{}) bool
+
 // Service encapsulates bakery.Bakery and adds a Close() method that zeroes the
 // root key service encryption keys, as well as utility methods to validate a
 // macaroon against the bakery and gRPC middleware for macaroon-based auth.
@@ -104,9 +132,11 @@ func isRegistered(c *checkers.Checker, name string) bool {
 }
 
 // UnaryServerInterceptor is a GRPC interceptor that checks whether the
-// request is authorized by the included macaroons.
+// request is authorized by the included macaroons. If needsConfirm is non-nil,
+// the requests it flags also require a valid confirmation macaroon.
 func (svc *Service) UnaryServerInterceptor(
-	permissionMap map[string][]bakery.Op) grpc.UnaryServerInterceptor {
+	permissionMap map[string][]bakery.Op,
+	needsConfirm ConfirmationChecker) grpc.UnaryServerInterceptor {
 
 	return func(ctx context.Context, req interface{},
 		info *grpc.UnaryServerInfo,
@@ -122,14 +152,23 @@ func (svc *Service) UnaryServerInterceptor(
 			return nil, err
 		}
 
+		if needsConfirm != nil && needsConfirm(req) {
+			if err := svc.ValidateConfirmMacaroon(ctx); err != nil {
+				return nil, err
+			}
+		}
+
 		return handler(ctx, req)
 	}
 }
 
 // StreamServerInterceptor is a GRPC interceptor that checks whether the
-// request is authorized by the included macaroons.
+// request is authorized by the included macaroons. If needsConfirm is non-nil,
+// the requests it flags among those received over the stream also require a
+// valid confirmation macaroon.
 func (svc *Service) StreamServerInterceptor(
-	permissionMap map[string][]bakery.Op) grpc.StreamServerInterceptor {
+	permissionMap map[string][]bakery.Op,
+	needsConfirm ConfirmationChecker) grpc.StreamServerInterceptor {
 
 	return func(srv interface{}, ss grpc.ServerStream,
 		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
@@ -146,10 +185,44 @@ func (svc *Service) StreamServerInterceptor(
 			return err
 		}
 
+		// As the requests of a stream are only received by the handler,
+		// we'll check whether they require confirmation as they're
+		// received.
+		if needsConfirm != nil {
+			ss = &confirmServerStream{
+				ServerStream: ss,
+				svc:          svc,
+				needsConfirm: needsConfirm,
+			}
+		}
+
 		return handler(srv, ss)
 	}
 }
 
+// confirmServerStream wraps a grpc.ServerStream to require a valid
+// confirmation macaroon for the requests received over it that need one.
+type confirmServerStream struct {
+	grpc.ServerStream
+
+	svc          *Service
+	needsConfirm ConfirmationChecker
+}
+
+// RecvMsg receives the next request of the stream, and ensures a valid
+// confirmation macaroon was provided if the request requires one.
+func (s *confirmServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	if !s.needsConfirm(m) {
+		return nil
+	}
+
+	return s.svc.ValidateConfirmMacaroon(s.Context())
+}
+
 // ValidateMacaroon validates the capabilities of a given request given a
 // bakery service, context, and uri. Within the passed context.Context, we
 // expect a macaroon to be encoded as request metadata using the key
@@ -157,34 +230,116 @@ func (svc *Service) StreamServerInterceptor(
 func (svc *Service) ValidateMacaroon(ctx context.Context,
 	requiredPermissions []bakery.Op) error {
 
+	return svc.validateMacaroon(ctx, "macaroon", requiredPermissions)
+}
+
+// ValidateConfirmMacaroon validates the confirmation macaroon encoded as
+// request metadata using the key ConfirmMacaroonKey within the passed
+// context.Context. The confirmation macaroon must be constrained by a timeout
+// of at most MaxConfirmTimeout.
+func (svc *Service) ValidateConfirmMacaroon(ctx context.Context) error {
+	mac, err := MacaroonFromContext(ctx, ConfirmMacaroonKey)
+	if err != nil {
+		return fmt.Errorf("confirmation macaroon required: %v", err)
+	}
+
+	expiry, ok := expiryTime(mac)
+	if !ok {
+		return fmt.Errorf("confirmation macaroon must have a timeout")
+	}
+	if time.Until(expiry) > MaxConfirmTimeout {
+		return fmt.Errorf("confirmation macaroon timeout exceeds %v",
+			MaxConfirmTimeout)
+	}
+
+	err = svc.checkMacaroon(ctx, mac, ConfirmPermissions)
+	if err != nil {
+		return fmt.Errorf("confirmation macaroon required: %v", err)
+	}
+
+	return nil
+}
+
+// expiryTime returns the earliest time set by the time-before caveats of the
+// macaroon, and false if it has none.
+func expiryTime(mac *macaroon.Macaroon) (time.Time, bool) {
+	var (
+		expiry time.Time
+		found  bool
+	)
+	for _, caveat := range mac.Caveats() {
+		cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+		if err != nil || cond != checkers.CondTimeBefore {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339Nano, arg)
+		if err != nil {
+			continue
+		}
+		if !found || t.Before(expiry) {
+			expiry = t
+			found = true
+		}
+	}
+
+	return expiry, found
+}
+
+// validateMacaroon validates the macaroon encoded as request metadata using
+// the given key within the passed context.Context against the required
+// permissions.
+func (svc *Service) validateMacaroon(ctx context.Context, key string,
+	requiredPermissions []bakery.Op) error {
+
+	mac, err := MacaroonFromContext(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return svc.checkMacaroon(ctx, mac, requiredPermissions)
+}
+
+// checkMacaroon checks the given macaroon against the required permissions.
+func (svc *Service) checkMacaroon(ctx context.Context, mac *macaroon.Macaroon,
+	requiredPermissions []bakery.Op) error {
+
+	// Check the method being called against the permitted operation and
+	// the expiration time and IP address and return the result.
+	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
+	_, err := authChecker.Allow(ctx, requiredPermissions...)
+	return err
+}
+
+// MacaroonFromContext decodes the macaroon encoded as request metadata using
+// the given key within the passed context.Context.
+func MacaroonFromContext(ctx context.Context,
+	key string) (*macaroon.Macaroon, error) {
+
 	// Get macaroon bytes from context and unmarshal into macaroon.
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return fmt.Errorf("unable to get metadata from context")
+		return nil, fmt.Errorf("unable to get metadata from context")
 	}
-	if len(md["macaroon"]) != 1 {
-		return fmt.Errorf("expected 1 macaroon, got %d",
-			len(md["macaroon"]))
+	if len(md[key]) != 1 {
+		return nil, fmt.Errorf("expected 1 %v, got %d", key,
+			len(md[key]))
 	}
 
 	// With the macaroon obtained, we'll now decode the hex-string
 	// encoding, then unmarshal it from binary into its concrete struct
 	// representation.
-	macBytes, err := hex.DecodeString(md["macaroon"][0])
+	macBytes, err := hex.DecodeString(md[key][0])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mac := &macaroon.Macaroon{}
 	err = mac.UnmarshalBinary(macBytes)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Check the method being called against the permitted operation and
-	// the expiration time and IP address and return the result.
-	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
-	_, err = authChecker.Allow(ctx, requiredPermissions...)
-	return err
+	return mac, nil
 }
 
 // Close closes the database that underlies the RootKeyStore and zeroes the
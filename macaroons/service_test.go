@@ -10,6 +10,7 @@ import (
 
 	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
@@ -134,3 +135,133 @@ func TestValidateMacaroon(t *testing.T) {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
 }
+
+// TestConfirmMacaroon tests that the requests flagged by the confirmation
+// checker of the unary interceptor require a valid confirmation macaroon,
+// which can't be substituted by a regular macaroon.
+func TestConfirmMacaroon(t *testing.T) {
+	// First, initialize the service and unlock it.
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir, macaroons.IPLockChecker)
+	defer service.Close()
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	// Then, create both regular and confirmation macaroons, constrained
+	// by the given timeout in seconds unless it's zero.
+	newMacaroon := func(timeout int64, ops ...bakery.Op) string {
+		mac, err := service.Oven.NewMacaroon(
+			nil, bakery.LatestVersion, nil, ops...,
+		)
+		if err != nil {
+			t.Fatalf("Error creating macaroon from service: %v",
+				err)
+		}
+		constrainedMac := mac.M()
+		if timeout != 0 {
+			constrainedMac, err = macaroons.AddConstraints(
+				constrainedMac,
+				macaroons.TimeoutConstraint(timeout),
+			)
+			if err != nil {
+				t.Fatalf("Error adding timeout: %v", err)
+			}
+		}
+		macBinary, err := constrainedMac.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Error serializing macaroon: %v", err)
+		}
+
+		return hex.EncodeToString(macBinary)
+	}
+	maxTimeout := int64(macaroons.MaxConfirmTimeout.Seconds())
+	regularMac := newMacaroon(0, testOperation)
+	confirmMac := newMacaroon(60, macaroons.ConfirmPermissions...)
+	noTimeoutMac := newMacaroon(0, macaroons.ConfirmPermissions...)
+	longTimeoutMac := newMacaroon(
+		maxTimeout+60, macaroons.ConfirmPermissions...,
+	)
+	expiredMac := newMacaroon(-60, macaroons.ConfirmPermissions...)
+
+	const testMethod = "/test/Method"
+	interceptor := service.UnaryServerInterceptor(
+		map[string][]bakery.Op{testMethod: {testOperation}},
+		func(req interface{}) bool {
+			return req.(string) == "destructive"
+		},
+	)
+	info := &grpc.UnaryServerInfo{FullMethod: testMethod}
+	handler := func(_ context.Context, req interface{}) (
+		interface{}, error) {
+
+		return req, nil
+	}
+
+	testCases := []struct {
+		name       string
+		req        string
+		confirmMac string
+		expectErr  bool
+	}{
+		{
+			name: "not destructive",
+			req:  "harmless",
+		},
+		{
+			name:      "missing confirmation",
+			req:       "destructive",
+			expectErr: true,
+		},
+		{
+			name:       "regular macaroon as confirmation",
+			req:        "destructive",
+			confirmMac: regularMac,
+			expectErr:  true,
+		},
+		{
+			name:       "confirmation without timeout",
+			req:        "destructive",
+			confirmMac: noTimeoutMac,
+			expectErr:  true,
+		},
+		{
+			name:       "confirmation timeout too long",
+			req:        "destructive",
+			confirmMac: longTimeoutMac,
+			expectErr:  true,
+		},
+		{
+			name:       "expired confirmation",
+			req:        "destructive",
+			confirmMac: expiredMac,
+			expectErr:  true,
+		},
+		{
+			name:       "confirmed",
+			req:        "destructive",
+			confirmMac: confirmMac,
+		},
+	}
+
+	for _, testCase := range testCases {
+		md := metadata.New(map[string]string{"macaroon": regularMac})
+		if testCase.confirmMac != "" {
+			md.Set(macaroons.ConfirmMacaroonKey, testCase.confirmMac)
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+
+		_, err := interceptor(ctx, testCase.req, info, handler)
+		if testCase.expectErr && err == nil {
+			t.Fatalf("%v: expected error", testCase.name)
+		}
+		if !testCase.expectErr && err != nil {
+			t.Fatalf("%v: unexpected error: %v", testCase.name, err)
+		}
+	}
+}
@@ -30,6 +30,12 @@ var (
 	// the time of the request.
 	ErrEnableInactiveChan = errors.New("unable to enable channel which " +
 		"is not currently active")
+
+	// ErrEnableManuallyDisabledChan signals that an automatic request to
+	// enable a channel could not be completed because the channel was
+	// manually disabled.
+	ErrEnableManuallyDisabledChan = errors.New("unable to enable channel " +
+		"which was manually disabled")
 )
 
 // ChanStatusConfig holds parameters and resources required by the
@@ -106,6 +112,10 @@ type ChanStatusManager struct {
 	// primary event loop.
 	disableRequests chan statusRequest
 
+	// autoRequests pipes external requests to restore automatic management
+	// of a channel's status into the primary event loop.
+	autoRequests chan statusRequest
+
 	// statusSampleTicker fires at the interval prescribed by
 	// ChanStatusSampleInterval to check if channels in chanStates have
 	// become inactive.
@@ -149,6 +159,7 @@ func NewChanStatusManager(cfg *ChanStatusConfig) (*ChanStatusManager, error) {
 		statusSampleTicker: time.NewTicker(cfg.ChanStatusSampleInterval),
 		enableRequests:     make(chan statusRequest),
 		disableRequests:    make(chan statusRequest),
+		autoRequests:       make(chan statusRequest),
 		quit:               make(chan struct{}),
 	}, nil
 }
@@ -209,39 +220,64 @@ func (m *ChanStatusManager) Stop() error {
 // channel is found to be disabled, a new announcement will be signed with the
 // disabled bit cleared and broadcast to the network.
 //
-// NOTE: RequestEnable should only be called after a stable connection with the
-// channel's peer has lasted at least the ChanEnableTimeout. Failure to do so
-// may result in behavior that deviates from the expected behavior of the state
-// machine.
-func (m *ChanStatusManager) RequestEnable(outpoint wire.OutPoint) error {
-	return m.submitRequest(m.enableRequests, outpoint)
+// The manual flag signals whether the request was made explicitly by the user.
+// Automatic requests fail with ErrEnableManuallyDisabledChan for channels that
+// were manually disabled, while manual requests override the manual disable.
+//
+// NOTE: Automatic calls to RequestEnable should only be made after a stable
+// connection with the channel's peer has lasted at least the
+// ChanEnableTimeout. Failure to do so may result in behavior that deviates
+// from the expected behavior of the state machine.
+func (m *ChanStatusManager) RequestEnable(outpoint wire.OutPoint,
+	manual bool) error {
+
+	return m.submitRequest(m.enableRequests, outpoint, manual)
 }
 
 // RequestDisable submits a request to immediately disable a channel identified
 // by the provided outpoint. If the channel is already disabled, no action will
 // be taken. Otherwise, a new announcement will be signed with the disabled bit
 // set and broadcast to the network.
-func (m *ChanStatusManager) RequestDisable(outpoint wire.OutPoint) error {
-	return m.submitRequest(m.disableRequests, outpoint)
+//
+// The manual flag signals whether the request was made explicitly by the user.
+// A manually disabled channel won't be reenabled automatically, until either
+// a manual RequestEnable or a RequestAuto is made for it. The manual state is
+// not persisted, and is lost when the ChanStatusManager is restarted.
+func (m *ChanStatusManager) RequestDisable(outpoint wire.OutPoint,
+	manual bool) error {
+
+	return m.submitRequest(m.disableRequests, outpoint, manual)
+}
+
+// RequestAuto submits a request to restore automatic management of the status
+// of a channel identified by the provided outpoint. If the channel was
+// manually disabled, it will be reenabled if it's currently active, and will
+// otherwise be reenabled once it becomes active again. For any other channel,
+// no action will be taken.
+func (m *ChanStatusManager) RequestAuto(outpoint wire.OutPoint) error {
+	return m.submitRequest(m.autoRequests, outpoint, true)
 }
 
 // statusRequest is passed to the statusManager to request a change in status
 // for a particular channel point.  The exact action is governed by passing the
-// request through one of the enableRequests or disableRequests channels.
+// request through one of the enableRequests, disableRequests or autoRequests
+// channels.
 type statusRequest struct {
 	outpoint wire.OutPoint
+	manual   bool
 	errChan  chan error
 }
 
 // submitRequest sends a request for either enabling or disabling a particular
 // outpoint and awaits an error response. The request type is dictated by the
-// reqChan passed in, which can be either of the enableRequests or
-// disableRequests channels.
+// reqChan passed in, which can be any of the enableRequests, disableRequests
+// or autoRequests channels.
 func (m *ChanStatusManager) submitRequest(reqChan chan statusRequest,
-	outpoint wire.OutPoint) error {
+	outpoint wire.OutPoint, manual bool) error {
 
 	req := statusRequest{
 		outpoint: outpoint,
+		manual:   manual,
 		errChan:  make(chan error, 1),
 	}
 
@@ -275,11 +311,20 @@ func (m *ChanStatusManager) statusManager() {
 
 		// Process any requests to mark channel as enabled.
 		case req := <-m.enableRequests:
-			req.errChan <- m.processEnableRequest(req.outpoint)
+			req.errChan <- m.processEnableRequest(
+				req.outpoint, req.manual,
+			)
 
 		// Process any requests to mark channel as disabled.
 		case req := <-m.disableRequests:
-			req.errChan <- m.processDisableRequest(req.outpoint)
+			req.errChan <- m.processDisableRequest(
+				req.outpoint, req.manual,
+			)
+
+		// Process any requests to restore automatic management of a
+		// channel's status.
+		case req := <-m.autoRequests:
+			req.errChan <- m.processAutoRequest(req.outpoint)
 
 		// Use long-polling to detect when channels become inactive.
 		case <-m.statusSampleTicker.C:
@@ -306,13 +351,22 @@ func (m *ChanStatusManager) statusManager() {
 // ChanStatusEnabled. If the channel is not active at the time of the request,
 // ErrEnableInactiveChan will be returned. An update will be broadcast only if
 // the channel is currently disabled, otherwise no update will be sent on the
-// network.
-func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint) error {
+// network. If the channel was manually disabled, only a manual request will
+// enable it, otherwise ErrEnableManuallyDisabledChan is returned.
+func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint,
+	manual bool) error {
+
 	curState, err := m.getOrInitChanStatus(outpoint)
 	if err != nil {
 		return err
 	}
 
+	// Automatic requests may not override the user's explicit request to
+	// keep the channel disabled.
+	if !manual && curState.Status == ChanStatusManuallyDisabled {
+		return ErrEnableManuallyDisabledChan
+	}
+
 	// Quickly check to see if the requested channel is active within the
 	// htlcswitch and return an error if it isn't.
 	chanID := lnwire.NewChanIDFromOutPoint(&outpoint)
@@ -333,7 +387,7 @@ func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint) error {
 			"disable", outpoint)
 
 	// We'll sign a new update if the channel is still disabled.
-	case ChanStatusDisabled:
+	case ChanStatusDisabled, ChanStatusManuallyDisabled:
 		log.Infof("Announcing channel(%v) enabled", outpoint)
 
 		err := m.signAndSendNextUpdate(outpoint, false)
@@ -349,10 +403,12 @@ func (m *ChanStatusManager) processEnableRequest(outpoint wire.OutPoint) error {
 
 // processDisableRequest attempts to disable the given outpoint. If the method
 // returns nil, the status of the channel in chanStates will be
-// ChanStatusDisabled. An update will only be sent if the channel has a status
-// other than ChanStatusEnabled, otherwise no update will be sent on the
-// network.
-func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error {
+// ChanStatusDisabled, or ChanStatusManuallyDisabled for manual requests. An
+// update will only be sent if the channel is currently enabled or
+// pending-disabled, otherwise no update will be sent on the network.
+func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint,
+	manual bool) error {
+
 	curState, err := m.getOrInitChanStatus(outpoint)
 	if err != nil {
 		return err
@@ -360,8 +416,16 @@ func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error
 
 	switch curState.Status {
 
-	// Channel is already disabled, nothing to do.
+	// Channel is already disabled. Unless the user requests to keep it
+	// disabled, there's nothing to do.
 	case ChanStatusDisabled:
+		if !manual {
+			return nil
+		}
+
+	// Channel was already manually disabled, which an automatic request
+	// must not forget about.
+	case ChanStatusManuallyDisabled:
 		return nil
 
 	// We'll sign a new update disabling the channel if the current status
@@ -376,6 +440,13 @@ func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error
 		}
 	}
 
+	// If the user requested the disable, we'll remember it so that the
+	// channel isn't reenabled automatically.
+	if manual {
+		m.chanStates.markManuallyDisabled(outpoint)
+		return nil
+	}
+
 	// If the disable was requested via the manager's public interface, we
 	// will remove the output from our map of channel states. Typically this
 	// signals that the channel is being closed, so this frees up the space
@@ -387,6 +458,33 @@ func (m *ChanStatusManager) processDisableRequest(outpoint wire.OutPoint) error
 	return nil
 }
 
+// processAutoRequest restores automatic management of the status of the given
+// outpoint. If the channel was manually disabled, it will be marked
+// ChanStatusDisabled, and reenabled right away if it is currently active.
+// Otherwise it will be reenabled by the next automatic enable request.
+func (m *ChanStatusManager) processAutoRequest(outpoint wire.OutPoint) error {
+	curState, err := m.getOrInitChanStatus(outpoint)
+	if err != nil {
+		return err
+	}
+
+	// Any other status is already managed automatically.
+	if curState.Status != ChanStatusManuallyDisabled {
+		return nil
+	}
+
+	m.chanStates.markDisabled(outpoint)
+
+	// If the channel is active, there's no need to wait for the next
+	// automatic enable request, as the peer is already online.
+	chanID := lnwire.NewChanIDFromOutPoint(&outpoint)
+	if !m.cfg.IsChannelActive(chanID) {
+		return nil
+	}
+
+	return m.processEnableRequest(outpoint, false)
+}
+
 // markPendingInactiveChannels performs a sweep of the database's active
 // channels and determines which, if any, should have a disable announcement
 // scheduled. Once an active channel is determined to be pending-inactive, one
@@ -429,7 +429,7 @@ func (h *testHarness) assertDisables(channels []*channeldb.OpenChannel, expErr e
 func (h *testHarness) assertEnable(outpoint wire.OutPoint, expErr error) {
 	h.t.Helper()
 
-	err := h.mgr.RequestEnable(outpoint)
+	err := h.mgr.RequestEnable(outpoint, false)
 	if err != expErr {
 		h.t.Fatalf("expected enable error: %v, got %v", expErr, err)
 	}
@@ -440,12 +440,45 @@ func (h *testHarness) assertEnable(outpoint wire.OutPoint, expErr error) {
 func (h *testHarness) assertDisable(outpoint wire.OutPoint, expErr error) {
 	h.t.Helper()
 
-	err := h.mgr.RequestDisable(outpoint)
+	err := h.mgr.RequestDisable(outpoint, false)
 	if err != expErr {
 		h.t.Fatalf("expected disable error: %v, got %v", expErr, err)
 	}
 }
 
+// assertManualDisables requests manual disables for all of the passed
+// channels, and asserts that the errors returned from RequestDisable match
+// expErr.
+func (h *testHarness) assertManualDisables(channels []*channeldb.OpenChannel,
+	expErr error) {
+
+	h.t.Helper()
+
+	for _, channel := range channels {
+		err := h.mgr.RequestDisable(channel.FundingOutpoint, true)
+		if err != expErr {
+			h.t.Fatalf("expected disable error: %v, got %v",
+				expErr, err)
+		}
+	}
+}
+
+// assertAutos requests automatic management for all of the passed channels,
+// and asserts that the errors returned from RequestAuto match expErr.
+func (h *testHarness) assertAutos(channels []*channeldb.OpenChannel,
+	expErr error) {
+
+	h.t.Helper()
+
+	for _, channel := range channels {
+		err := h.mgr.RequestAuto(channel.FundingOutpoint)
+		if err != expErr {
+			h.t.Fatalf("expected auto error: %v, got %v", expErr,
+				err)
+		}
+	}
+}
+
 // assertNoUpdates waits for the specified duration, and asserts that no updates
 // are announced on the network.
 func (h *testHarness) assertNoUpdates(duration time.Duration) {
@@ -792,6 +825,65 @@ var stateMachineTests = []stateMachineTest{
 			h.assertNoUpdates(h.safeDisableTimeout)
 		},
 	},
+	{
+		name:         "manual disable is not reenabled automatically",
+		startActive:  true,
+		startEnabled: true,
+		fn: func(h testHarness) {
+			// Manually disable all channels, and expect to see
+			// them all disabled on the network.
+			h.assertManualDisables(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), false, h.safeDisableTimeout,
+			)
+
+			// Automatic requests to enable the channels should be
+			// refused, even though the channels are active.
+			h.assertEnables(
+				h.graph.chans(),
+				netann.ErrEnableManuallyDisabledChan,
+			)
+			h.assertNoUpdates(h.safeDisableTimeout)
+
+			// Once automatic management is restored, the active
+			// channels should be reenabled right away.
+			h.assertAutos(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), true, h.safeDisableTimeout,
+			)
+		},
+	},
+	{
+		name:         "manual enable overrides manual disable",
+		startActive:  true,
+		startEnabled: true,
+		fn: func(h testHarness) {
+			// Manually disable all channels, and expect to see
+			// them all disabled on the network.
+			h.assertManualDisables(h.graph.chans(), nil)
+			h.assertUpdates(
+				h.graph.chans(), false, h.safeDisableTimeout,
+			)
+
+			// Manually enabling the channels should succeed.
+			for _, c := range h.graph.chans() {
+				err := h.mgr.RequestEnable(
+					c.FundingOutpoint, true,
+				)
+				if err != nil {
+					h.t.Fatalf("unable to enable: %v", err)
+				}
+			}
+			h.assertUpdates(
+				h.graph.chans(), true, h.safeDisableTimeout,
+			)
+
+			// Subsequent automatic requests should be handled as
+			// usual.
+			h.assertEnables(h.graph.chans(), nil)
+			h.assertNoUpdates(h.safeDisableTimeout)
+		},
+	},
 }
 
 // TestChanStatusManagerStateMachine tests the possible state transitions that
@@ -26,6 +26,14 @@ const (
 	// ChanStatusDisabled indicates that the channel's last announcement has
 	// the disabled bit set.
 	ChanStatusDisabled
+
+	// ChanStatusManuallyDisabled indicates that the channel's last
+	// announcement has the disabled bit set, and that the channel was
+	// disabled by an explicit request of the user. Channels in this state
+	// won't be reenabled automatically when the remote peer is online,
+	// until the user either reenables the channel or restores automatic
+	// management of its status.
+	ChanStatusManuallyDisabled
 )
 
 // ChannelState describes the ChanStatusManager's view of a channel, and
@@ -63,6 +71,14 @@ func (s *channelStates) markDisabled(outpoint wire.OutPoint) {
 	}
 }
 
+// markManuallyDisabled creates a channelState using
+// ChanStatusManuallyDisabled.
+func (s *channelStates) markManuallyDisabled(outpoint wire.OutPoint) {
+	(*s)[outpoint] = ChannelState{
+		Status: ChanStatusManuallyDisabled,
+	}
+}
+
 // markPendingDisabled creates a channelState using ChanStatusPendingDisabled
 // and sets the ChannelState's SendDisableTime to sendDisableTime.
 func (s *channelStates) markPendingDisabled(outpoint wire.OutPoint,
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+var (
+	// errNoPaymentRequest is returned when exporting the proof of a
+	// payment that wasn't made to a payment request, as there's then no
+	// signature of the payee committing to the payment hash.
+	errNoPaymentRequest = errors.New("payment was not made to a payment " +
+		"request, unable to prove it")
+)
+
+// newPaymentProof creates the proof of the given settled payment, which
+// consists of the payment request signed by the payee, committing to the
+// payment hash, along with the preimage of that hash which the payee only
+// reveals on settlement.
+func newPaymentProof(payment *channeldb.OutgoingPayment,
+	params *chaincfg.Params) (*lnrpc.PaymentProof, error) {
+
+	if len(payment.PaymentRequest) == 0 {
+		return nil, errNoPaymentRequest
+	}
+
+	payReq, err := zpay32.Decode(string(payment.PaymentRequest), params)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode payment request: %v",
+			err)
+	}
+
+	path := make([]string, len(payment.Path))
+	for i, hop := range payment.Path {
+		path[i] = hex.EncodeToString(hop[:])
+	}
+
+	preimage := lntypes.Preimage(payment.PaymentPreimage)
+	proof := &lnrpc.PaymentProof{
+		PaymentHash:     preimage.Hash().String(),
+		PaymentPreimage: preimage.String(),
+		PaymentRequest:  string(payment.PaymentRequest),
+		Payee: hex.EncodeToString(
+			payReq.Destination.SerializeCompressed(),
+		),
+		Path:         path,
+		ValueMsat:    int64(payment.Terms.Value),
+		FeeMsat:      int64(payment.Fee),
+		CreationDate: payment.CreationDate.Unix(),
+	}
+
+	// As a sanity check, we'll make sure the proof verifies before handing
+	// it out.
+	if _, err := verifyPaymentProof(proof, params); err != nil {
+		return nil, err
+	}
+
+	return proof, nil
+}
+
+// verifyPaymentProof verifies the given payment proof without relying on any
+// state, such that anyone can verify the proof of a payment offline. The proof
+// is valid if the payment request is signed by the payee and commits to the
+// payment hash, and if the preimage matches the hash. Upon success, the public
+// key of the payee is returned.
+//
+// NOTE: The path and fees of the payment aren't signed by the payee, so they
+// are only checked for consistency with the payment request.
+func verifyPaymentProof(proof *lnrpc.PaymentProof,
+	params *chaincfg.Params) (*btcec.PublicKey, error) {
+
+	hashBytes, err := hex.DecodeString(proof.PaymentHash)
+	if err != nil || len(hashBytes) != 32 {
+		return nil, fmt.Errorf("invalid payment hash: %v",
+			proof.PaymentHash)
+	}
+	var hash [32]byte
+	copy(hash[:], hashBytes)
+
+	preimage, err := lntypes.MakePreimageFromStr(proof.PaymentPreimage)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment preimage: %v", err)
+	}
+	if preimage.Hash() != hash {
+		return nil, errors.New("payment preimage doesn't match " +
+			"payment hash")
+	}
+
+	// Decoding the payment request verifies its signature, recovering the
+	// public key of the payee if it isn't included.
+	payReq, err := zpay32.Decode(proof.PaymentRequest, params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payment request: %v", err)
+	}
+	if payReq.PaymentHash == nil || *payReq.PaymentHash != hash {
+		return nil, errors.New("payment request doesn't commit to " +
+			"payment hash")
+	}
+
+	payee := hex.EncodeToString(payReq.Destination.SerializeCompressed())
+	if proof.Payee != "" && proof.Payee != payee {
+		return nil, fmt.Errorf("payment request is signed by %v, not "+
+			"payee %v", payee, proof.Payee)
+	}
+	if len(proof.Path) > 0 && proof.Path[len(proof.Path)-1] != payee {
+		return nil, fmt.Errorf("payment path doesn't end at payee %v",
+			payee)
+	}
+	if payReq.MilliSat != nil && proof.ValueMsat < int64(*payReq.MilliSat) {
+		return nil, fmt.Errorf("payment value of %v mSAT is below "+
+			"the %v requested", proof.ValueMsat, *payReq.MilliSat)
+	}
+
+	return payReq.Destination, nil
+}
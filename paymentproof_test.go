@@ -0,0 +1,163 @@
+// +build !rpctest
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+// TestPaymentProof asserts that the proof of a payment made to a payment
+// request verifies, and that tampering with any of its signed or committed to
+// parts invalidates it.
+func TestPaymentProof(t *testing.T) {
+	t.Parallel()
+
+	params := &chaincfg.TestNet3Params
+
+	payeeKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	payee := hex.EncodeToString(payeeKey.PubKey().SerializeCompressed())
+
+	preimage := [32]byte{1, 2, 3}
+	paymentHash := sha256.Sum256(preimage[:])
+
+	payReq, err := zpay32.NewInvoice(
+		params, paymentHash, time.Now(),
+		zpay32.Amount(lnwire.MilliSatoshi(100000)),
+		zpay32.Description("coffee"),
+	)
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	payReqString, err := payReq.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return btcec.SignCompact(
+				btcec.S256(), payeeKey, hash, true,
+			)
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	var payeePub [33]byte
+	copy(payeePub[:], payeeKey.PubKey().SerializeCompressed())
+
+	payment := &channeldb.OutgoingPayment{
+		Invoice: channeldb.Invoice{
+			Terms: channeldb.ContractTerm{
+				Value: 100000,
+			},
+			CreationDate:   time.Now(),
+			PaymentRequest: []byte(payReqString),
+		},
+		Fee:             1000,
+		Path:            [][33]byte{payeePub},
+		PaymentPreimage: preimage,
+	}
+
+	proof, err := newPaymentProof(payment, params)
+	if err != nil {
+		t.Fatalf("unable to create payment proof: %v", err)
+	}
+	if proof.Payee != payee {
+		t.Fatalf("expected payee %v, got %v", payee, proof.Payee)
+	}
+
+	// A payment that wasn't made to a payment request can't be proven.
+	noPayReq := *payment
+	noPayReq.PaymentRequest = nil
+	_, err = newPaymentProof(&noPayReq, params)
+	if err != errNoPaymentRequest {
+		t.Fatalf("expected errNoPaymentRequest, got %v", err)
+	}
+
+	otherKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	otherPub := hex.EncodeToString(otherKey.PubKey().SerializeCompressed())
+
+	testCases := []struct {
+		name   string
+		tamper func(proof *lnrpc.PaymentProof)
+		valid  bool
+	}{
+		{
+			name:   "valid proof",
+			tamper: func(*lnrpc.PaymentProof) {},
+			valid:  true,
+		},
+		{
+			name: "wrong preimage",
+			tamper: func(p *lnrpc.PaymentProof) {
+				p.PaymentPreimage = hex.EncodeToString(
+					make([]byte, 32),
+				)
+			},
+		},
+		{
+			name: "wrong payment hash",
+			tamper: func(p *lnrpc.PaymentProof) {
+				hash := sha256.Sum256(make([]byte, 32))
+				p.PaymentHash = hex.EncodeToString(hash[:])
+				p.PaymentPreimage = hex.EncodeToString(
+					make([]byte, 32),
+				)
+			},
+		},
+		{
+			name: "tampered payment request",
+			tamper: func(p *lnrpc.PaymentProof) {
+				b := []byte(p.PaymentRequest)
+				b[len(b)-10] ^= 1
+				p.PaymentRequest = string(b)
+			},
+		},
+		{
+			name: "wrong payee",
+			tamper: func(p *lnrpc.PaymentProof) {
+				p.Payee = otherPub
+			},
+		},
+		{
+			name: "path not ending at payee",
+			tamper: func(p *lnrpc.PaymentProof) {
+				p.Path = []string{otherPub}
+			},
+		},
+		{
+			name: "value below requested amount",
+			tamper: func(p *lnrpc.PaymentProof) {
+				p.ValueMsat = 99999
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		tampered := *proof
+		testCase.tamper(&tampered)
+
+		_, err := verifyPaymentProof(&tampered, params)
+		if testCase.valid && err != nil {
+			t.Fatalf("%v: expected proof to be valid, got: %v",
+				testCase.name, err)
+		}
+		if !testCase.valid && err == nil {
+			t.Fatalf("%v: expected proof to be invalid",
+				testCase.name)
+		}
+	}
+}
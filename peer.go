@@ -22,10 +22,12 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/feature"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/pool"
 	"github.com/lightningnetwork/lnd/ticker"
 )
@@ -64,8 +66,9 @@ const (
 // a buffered channel which will be sent upon once the write is complete. This
 // buffered channel acts as a semaphore to be used for synchronization purposes.
 type outgoingMsg struct {
-	msg     lnwire.Message
-	errChan chan error // MUST be buffered.
+	priority bool
+	msg      lnwire.Message
+	errChan  chan error // MUST be buffered.
 }
 
 // newChannelMsg packages a channeldb.OpenChannel with a channel that allows
@@ -374,29 +377,27 @@ func (p *peer) Start() error {
 func (p *peer) initGossipSync() {
 	switch {
 
-	// If the remote peer knows of the new gossip queries feature, then
-	// we'll create a new gossipSyncer in the AuthenticatedGossiper for it.
-	case p.remoteLocalFeatures.HasFeature(lnwire.GossipQueriesOptional):
+	// If we negotiated the new gossip queries feature with the remote
+	// peer, then we'll create a new gossipSyncer in the
+	// AuthenticatedGossiper for it.
+	case feature.IsNegotiated(
+		p.LocalFeatures(), p.remoteLocalFeatures,
+		lnwire.GossipQueriesOptional,
+	):
 		srvrLog.Infof("Negotiated chan series queries with %x",
 			p.pubKeyBytes[:])
 
-		// We'll only request channel updates from the remote peer if
-		// its enabled in the config, or we're already getting updates
-		// from enough peers.
-		//
-		// TODO(roasbeef): craft s.t. we only get updates from a few
-		// peers
-		recvUpdates := !cfg.NoChanUpdates
-
 		// Register the this peer's for gossip syncer with the gossiper.
 		// This is blocks synchronously to ensure the gossip syncer is
 		// registered with the gossiper before attempting to read
-		// messages from the remote peer.
-		p.server.authGossiper.InitSyncState(p, recvUpdates)
+		// messages from the remote peer. The gossiper decides whether
+		// we'll request real-time channel updates from this peer,
+		// based on how many peers we're already getting them from.
+		p.server.authGossiper.InitSyncState(p)
 
 	// If the remote peer has the initial sync feature bit set, then we'll
 	// being the synchronization protocol to exchange authenticated channel
-	// graph edges/vertexes, but only if they don't know of the new gossip
+	// graph edges/vertexes, but only if we didn't negotiate the new gossip
 	// queries.
 	case p.remoteLocalFeatures.HasFeature(lnwire.InitialRoutingSync):
 		srvrLog.Infof("Requesting full table sync with %x",
@@ -416,6 +417,22 @@ func (p *peer) QuitSignal() <-chan struct{} {
 	return p.quit
 }
 
+// RemoteLocalFeatures returns the local feature vector advertised by the
+// remote peer during the initial handshake.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) RemoteLocalFeatures() *lnwire.FeatureVector {
+	return p.remoteLocalFeatures
+}
+
+// LocalFeatures returns the local feature vector we advertised to the remote
+// peer during the initial handshake.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) LocalFeatures() *lnwire.FeatureVector {
+	return lnwire.NewFeatureVector(p.localFeatures, lnwire.LocalFeatures)
+}
+
 // loadActiveChannels creates indexes within the peer for tracking all active
 // channels returned by the database.
 func (p *peer) loadActiveChannels(chans []*channeldb.OpenChannel) error {
@@ -570,7 +587,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		Circuits:               p.server.htlcSwitch.CircuitModifier(),
 		ForwardPackets:         p.server.htlcSwitch.ForwardPackets,
 		FwrdingPolicy:          *forwardingPolicy,
-		FeeEstimator:           p.server.cc.feeEstimator,
+		FeeEstimator:           p.server.feeClamps.commit,
 		PreimageCache:          p.server.witnessBeacon,
 		ChainEvents:            chainEvents,
 		UpdateContractSignals: func(signals *contractcourt.ContractSignals) error {
@@ -580,12 +597,16 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		},
 		OnChannelFailure:    onChannelFailure,
 		SyncStates:          syncStates,
-		BatchTicker:         ticker.New(50 * time.Millisecond),
+		BatchTicker:         ticker.New(cfg.ChannelCommitInterval),
 		FwdPkgGCTicker:      ticker.New(time.Minute),
-		BatchSize:           10,
+		BatchSize:           cfg.ChannelCommitBatchSize,
 		UnsafeReplay:        cfg.UnsafeReplay,
 		MinFeeUpdateTimeout: htlcswitch.DefaultMinLinkFeeUpdateTimeout,
 		MaxFeeUpdateTimeout: htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
+		PerfCounters:        p.server.htlcSwitch.PerfCounters(),
+
+		TimeLockDeltaGracePeriod: cfg.TimeLockDeltaGracePeriod,
+		Health:                   p.server.health,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -1479,24 +1500,52 @@ out:
 func (p *peer) queueHandler() {
 	defer p.wg.Done()
 
-	// pendingMsgs will hold all messages waiting to be added
-	// to the sendQueue.
-	pendingMsgs := list.New()
+	// priorityMsgs holds an in order list of messages deemed high-priority
+	// to be added to the sendQueue. This predominately includes messages
+	// from the funding manager and htlcswitch, such as the commitment
+	// updates of our channels.
+	priorityMsgs := list.New()
+
+	// lazyMsgs holds an in order list of messages deemed low-priority to
+	// be added to the sendQueue only after all high-priority messages have
+	// been queued. This predominately includes messages from the gossiper,
+	// which may dump large parts of the channel graph on the peer.
+	lazyMsgs := list.New()
+
+	// addMsg adds a message to the queue matching its priority.
+	addMsg := func(msg outgoingMsg) {
+		if msg.priority {
+			priorityMsgs.PushBack(msg)
+		} else {
+			lazyMsgs.PushBack(msg)
+		}
+	}
 
 	for {
-		// Examine the front of the queue.
-		elem := pendingMsgs.Front()
+		// Examine the front of the priority queue, if it is empty
+		// check the low priority queue.
+		elem := priorityMsgs.Front()
+		if elem == nil {
+			elem = lazyMsgs.Front()
+		}
+
 		if elem != nil {
+			front := elem.Value.(outgoingMsg)
+
 			// There's an element on the queue, try adding
 			// it to the sendQueue. We also watch for
 			// messages on the outgoingQueue, in case the
 			// writeHandler cannot accept messages on the
 			// sendQueue.
 			select {
-			case p.sendQueue <- elem.Value.(outgoingMsg):
-				pendingMsgs.Remove(elem)
+			case p.sendQueue <- front:
+				if front.priority {
+					priorityMsgs.Remove(elem)
+				} else {
+					lazyMsgs.Remove(elem)
+				}
 			case msg := <-p.outgoingQueue:
-				pendingMsgs.PushBack(msg)
+				addMsg(msg)
 			case <-p.quit:
 				return
 			}
@@ -1506,7 +1555,7 @@ func (p *peer) queueHandler() {
 			// into the queue from outside sub-systems.
 			select {
 			case msg := <-p.outgoingQueue:
-				pendingMsgs.PushBack(msg)
+				addMsg(msg)
 			case <-p.quit:
 				return
 			}
@@ -1544,13 +1593,26 @@ func (p *peer) PingTime() int64 {
 	return atomic.LoadInt64(&p.pingTime)
 }
 
-// queueMsg queues a new lnwire.Message to be eventually sent out on the
-// wire. It returns an error if we failed to queue the message. An error
-// is sent on errChan if the message fails being sent to the peer, or
-// nil otherwise.
+// queueMsg adds the lnwire.Message to the back of the high priority send queue.
+// If the errChan is non-nil, an error is sent back if the msg failed to queue
+// or failed to write, and nil otherwise.
 func (p *peer) queueMsg(msg lnwire.Message, errChan chan error) {
+	p.queue(true, msg, errChan)
+}
+
+// queueMsgLazy adds the lnwire.Message to the back of the low priority send
+// queue. If the errChan is non-nil, an error is sent back if the msg failed to
+// queue or failed to write, and nil otherwise.
+func (p *peer) queueMsgLazy(msg lnwire.Message, errChan chan error) {
+	p.queue(false, msg, errChan)
+}
+
+// queue sends a given message to the queueHandler using the passed priority.
+// If the errChan is non-nil, an error is sent back if the msg failed to queue
+// or failed to write, and nil otherwise.
+func (p *peer) queue(priority bool, msg lnwire.Message, errChan chan error) {
 	select {
-	case p.outgoingQueue <- outgoingMsg{msg, errChan}:
+	case p.outgoingQueue <- outgoingMsg{priority, msg, errChan}:
 	case <-p.quit:
 		peerLog.Tracef("Peer shutting down, could not enqueue msg.")
 		if errChan != nil {
@@ -1882,8 +1944,15 @@ func (p *peer) reenableActiveChannels() {
 	// disabled bit to false and send out a new ChannelUpdate. If this
 	// channel is already active, the update won't be sent.
 	for _, chanPoint := range activePublicChans {
-		err := p.server.chanStatusMgr.RequestEnable(chanPoint)
-		if err != nil {
+		err := p.server.chanStatusMgr.RequestEnable(chanPoint, false)
+		switch {
+
+		// Channels the user manually disabled are to remain disabled.
+		case err == netann.ErrEnableManuallyDisabledChan:
+			srvrLog.Debugf("Channel %v was manually disabled, not "+
+				"enabling", chanPoint)
+
+		case err != nil:
 			srvrLog.Errorf("Unable to enable channel %v: %v",
 				chanPoint, err)
 		}
@@ -1918,18 +1987,25 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 		}
 
 		// We'll create a valid closing state machine in order to
-		// respond to the initiated cooperative channel closure.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
-			peerLog.Errorf("unable to gen delivery script: %v", err)
+		// respond to the initiated cooperative channel closure. If we
+		// committed to an upfront shutdown script, we'll use it as our
+		// delivery address, otherwise we'll generate a fresh one.
+		var err error
+		deliveryAddr := channel.LocalUpfrontShutdownScript()
+		if len(deliveryAddr) == 0 {
+			deliveryAddr, err = p.genDeliveryScript()
+			if err != nil {
+				peerLog.Errorf("unable to gen delivery script: "+
+					"%v", err)
 
-			return nil, fmt.Errorf("close addr unavailable")
+				return nil, fmt.Errorf("close addr unavailable")
+			}
 		}
 
 		// In order to begin fee negotiations, we'll first compute our
 		// target ideal fee-per-kw. We'll set this to a lax value, as
 		// we weren't the ones that initiated the channel closure.
-		feePerKw, err := p.server.cc.feeEstimator.EstimateFeePerKW(6)
+		feePerKw, err := p.server.feeClamps.coopClose.EstimateFeePerKW(6)
 		if err != nil {
 			peerLog.Errorf("unable to query fee estimator: %v", err)
 
@@ -1943,13 +2019,7 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 		}
 
 		chanCloser = newChannelCloser(
-			chanCloseCfg{
-				channel:           channel,
-				unregisterChannel: p.server.htlcSwitch.RemoveLink,
-				broadcastTx:       p.server.cc.wallet.PublishTransaction,
-				disableChannel:    p.server.chanStatusMgr.RequestDisable,
-				quit:              p.quit,
-			},
+			p.newChanCloseCfg(channel),
 			deliveryAddr,
 			feePerKw,
 			uint32(startingHeight),
@@ -1961,6 +2031,31 @@ func (p *peer) fetchActiveChanCloser(chanID lnwire.ChannelID) (*channelCloser, e
 	return chanCloser, nil
 }
 
+// newChanCloseCfg returns the configuration of a channelCloser for the given
+// channel with this peer.
+func (p *peer) newChanCloseCfg(
+	channel *lnwallet.LightningChannel) chanCloseCfg {
+
+	return chanCloseCfg{
+		channel:           channel,
+		unregisterChannel: p.server.htlcSwitch.RemoveLink,
+		broadcastTx:       p.server.cc.wallet.PublishTransaction,
+		disableChannel: func(op wire.OutPoint) error {
+			return p.server.chanStatusMgr.RequestDisable(op, false)
+		},
+		notifyPendingClose: func(closeTx *wire.MsgTx) {
+			p.server.channelNotifier.NotifyPendingCloseChannelEvent(
+				channel.State(), closeTx.TxHash(),
+				channeldb.CooperativeClose,
+			)
+		},
+		disconnect: func() error {
+			return p.server.DisconnectPeer(p.IdentityKey())
+		},
+		quit: p.quit,
+	}
+}
+
 // handleLocalCloseReq kicks-off the workflow to execute a cooperative or
 // forced unilateral closure of the channel initiated by a local subsystem.
 func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
@@ -1983,14 +2078,19 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 	// out this channel on-chain, so we execute the cooperative channel
 	// closure workflow.
 	case htlcswitch.CloseRegular:
-		// First, we'll fetch a fresh delivery address that we'll use
-		// to send the funds to in the case of a successful
-		// negotiation.
-		deliveryAddr, err := p.genDeliveryScript()
-		if err != nil {
-			peerLog.Errorf(err.Error())
-			req.Err <- err
-			return
+		// First, we'll fetch the delivery address that we'll use to
+		// send the funds to in the case of a successful negotiation.
+		// If we committed to an upfront shutdown script, we must use
+		// it, otherwise we'll generate a fresh address.
+		var err error
+		deliveryAddr := channel.LocalUpfrontShutdownScript()
+		if len(deliveryAddr) == 0 {
+			deliveryAddr, err = p.genDeliveryScript()
+			if err != nil {
+				peerLog.Errorf(err.Error())
+				req.Err <- err
+				return
+			}
 		}
 
 		// Next, we'll create a new channel closer state machine to
@@ -2003,13 +2103,7 @@ func (p *peer) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 		}
 
 		chanCloser := newChannelCloser(
-			chanCloseCfg{
-				channel:           channel,
-				unregisterChannel: p.server.htlcSwitch.RemoveLink,
-				broadcastTx:       p.server.cc.wallet.PublishTransaction,
-				disableChannel:    p.server.chanStatusMgr.RequestDisable,
-				quit:              p.quit,
-			},
+			p.newChanCloseCfg(channel),
 			deliveryAddr,
 			req.TargetFeePerKw,
 			uint32(startingHeight),
@@ -2117,6 +2211,14 @@ func (p *peer) handleLinkFailure(failure linkFailureReport) {
 				"remote peer: %v", err)
 		}
 	}
+
+	// If the link should be restarted, we'll disconnect from the peer.
+	// Once we reconnect, all of its channels are reloaded from their
+	// persisted state.
+	if failure.linkErr.Reconnect {
+		p.Disconnect(fmt.Errorf("restarting link(%v)",
+			failure.shortChanID))
+	}
 }
 
 // finalizeChanClosure performs the final clean up steps once the cooperative
@@ -2249,17 +2351,13 @@ func (p *peer) handleInitMsg(msg *lnwire.Init) error {
 
 	// Now that we have their features loaded, we'll ensure that they
 	// didn't set any required bits that we don't know of.
-	unknownLocalFeatures := p.remoteLocalFeatures.UnknownRequiredFeatures()
-	if len(unknownLocalFeatures) > 0 {
-		err := fmt.Errorf("Peer set unknown local feature bits: %v",
-			unknownLocalFeatures)
-		return err
+	err := feature.ValidateRequired(p.remoteLocalFeatures)
+	if err != nil {
+		return fmt.Errorf("invalid local features: %v", err)
 	}
-	unknownGlobalFeatures := p.remoteGlobalFeatures.UnknownRequiredFeatures()
-	if len(unknownGlobalFeatures) > 0 {
-		err := fmt.Errorf("Peer set unknown global feature bits: %v",
-			unknownGlobalFeatures)
-		return err
+	err = feature.ValidateRequired(p.remoteGlobalFeatures)
+	if err != nil {
+		return fmt.Errorf("invalid global features: %v", err)
 	}
 
 	// Now that we know we understand their requirements, we'll check to
@@ -2276,7 +2374,7 @@ func (p *peer) handleInitMsg(msg *lnwire.Init) error {
 // supported local and global features.
 func (p *peer) sendInitMsg() error {
 	msg := lnwire.NewInitMessage(
-		p.server.globalFeatures.RawFeatureVector,
+		p.server.featureMgr.GetRaw(feature.SetLegacyGlobal),
 		p.localFeatures,
 	)
 
@@ -2312,12 +2410,31 @@ func (p *peer) resendChanSyncMsg(cid lnwire.ChannelID) error {
 	return nil
 }
 
-// SendMessage sends a variadic number of message to remote peer. The first
-// argument denotes if the method should block until the message has been sent
-// to the remote peer.
+// SendMessage sends a variadic number of high-priority message to remote peer.
+// The first argument denotes if the method should block until the messages
+// have been sent to the remote peer or an error is returned, otherwise it
+// returns immediately after queuing.
 //
 // NOTE: Part of the lnpeer.Peer interface.
 func (p *peer) SendMessage(sync bool, msgs ...lnwire.Message) error {
+	return p.sendMessage(sync, true, msgs...)
+}
+
+// SendMessageLazy sends a variadic number of low-priority message to remote
+// peer. The first argument denotes if the method should block until the
+// messages have been sent to the remote peer or an error is returned,
+// otherwise it returns immediately after queueing.
+//
+// NOTE: Part of the lnpeer.Peer interface.
+func (p *peer) SendMessageLazy(sync bool, msgs ...lnwire.Message) error {
+	return p.sendMessage(sync, false, msgs...)
+}
+
+// sendMessage queues a variadic number of messages using the passed priority
+// to the remote peer. If sync is true, this method will block until the
+// messages have been sent to the remote peer or an error is returned,
+// otherwise it returns immediately after queueing.
+func (p *peer) sendMessage(sync, priority bool, msgs ...lnwire.Message) error {
 	// Add all incoming messages to the outgoing queue. A list of error
 	// chans is populated for each message if the caller requested a sync
 	// send.
@@ -2331,7 +2448,11 @@ func (p *peer) SendMessage(sync bool, msgs ...lnwire.Message) error {
 			errChans = append(errChans, errChan)
 		}
 
-		p.queueMsg(msg, errChan)
+		if priority {
+			p.queueMsg(msg, errChan)
+		} else {
+			p.queueMsgLazy(msg, errChan)
+		}
 	}
 
 	// Wait for all replies from the writeHandler. For async sends, this
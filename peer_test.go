@@ -9,8 +9,9 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
-	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
@@ -98,6 +99,14 @@ func TestPeerChannelClosureAcceptFeeResponder(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 
+	// As the remote party initiated the close, the channel shouldn't be
+	// marked as having a locally initiated close.
+	if responderChan.State().HasChanStatus(
+		channeldb.ChanStatusLocalCloseInitiator,
+	) {
+		t.Fatalf("responder marked close as locally initiated")
+	}
+
 	// And the initiator should be waiting for a confirmation notification.
 	notifier.confChannel <- &chainntnfs.TxConfirmation{}
 }
@@ -147,6 +156,14 @@ func TestPeerChannelClosureAcceptFeeInitiator(t *testing.T) {
 
 	initiatorDeliveryScript := shutdownMsg.Address
 
+	// The close is yet to be negotiated, so the channel shouldn't be
+	// marked as having a locally initiated close yet.
+	if initiatorChan.State().HasChanStatus(
+		channeldb.ChanStatusLocalCloseInitiator,
+	) {
+		t.Fatalf("close marked as locally initiated before broadcast")
+	}
+
 	// We'll answer the shutdown message with our own Shutdown, and then a
 	// ClosingSigned message.
 	chanID := shutdownMsg.ChannelID
@@ -156,7 +173,7 @@ func TestPeerChannelClosureAcceptFeeInitiator(t *testing.T) {
 			dummyDeliveryScript),
 	}
 
-	estimator := lnwallet.NewStaticFeeEstimator(12500, 0)
+	estimator := chainfee.NewStaticEstimator(12500, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -206,6 +223,14 @@ func TestPeerChannelClosureAcceptFeeInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 
+	// Now that the closing transaction has been broadcast, the channel
+	// should be marked as having a locally initiated close.
+	if !initiatorChan.State().HasChanStatus(
+		channeldb.ChanStatusLocalCloseInitiator,
+	) {
+		t.Fatalf("initiator didn't mark close as locally initiated")
+	}
+
 	// And the initiator should be waiting for a confirmation notification.
 	notifier.confChannel <- &chainntnfs.TxConfirmation{}
 }
@@ -446,7 +471,7 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		msg: respShutdown,
 	}
 
-	estimator := lnwallet.NewStaticFeeEstimator(12500, 0)
+	estimator := chainfee.NewStaticEstimator(12500, 0)
 	initiatorIdealFeeRate, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		t.Fatalf("unable to query fee estimator: %v", err)
@@ -588,3 +613,105 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestMaybeMatchScript tests that we only disconnect from a peer whose
+// shutdown script doesn't match the upfront shutdown script they committed
+// to.
+func TestMaybeMatchScript(t *testing.T) {
+	t.Parallel()
+
+	upfrontScript := []byte("upfront script")
+
+	tests := []struct {
+		name          string
+		upfrontScript lnwire.DeliveryAddress
+		shutdown      lnwire.DeliveryAddress
+		expectedErr   error
+	}{
+		{
+			name:     "no upfront shutdown set",
+			shutdown: []byte("any script"),
+		},
+		{
+			name:          "shutdown matches upfront",
+			upfrontScript: upfrontScript,
+			shutdown:      upfrontScript,
+		},
+		{
+			name:          "shutdown does not match upfront",
+			upfrontScript: upfrontScript,
+			shutdown:      []byte("other script"),
+			expectedErr:   ErrUpfrontShutdownScriptMismatch,
+		},
+	}
+
+	for _, test := range tests {
+		var disconnected bool
+		disconnect := func() error {
+			disconnected = true
+			return nil
+		}
+
+		err := maybeMatchScript(
+			disconnect, test.upfrontScript, test.shutdown,
+		)
+		if err != test.expectedErr {
+			t.Fatalf("%v: expected error %v, got %v", test.name,
+				test.expectedErr, err)
+		}
+
+		if disconnected != (test.expectedErr != nil) {
+			t.Fatalf("%v: expected disconnect: %v, got: %v",
+				test.name, test.expectedErr != nil,
+				disconnected)
+		}
+	}
+}
+
+// TestPeerQueuePrioritization asserts that the high-priority messages queued
+// for a peer are written to the wire before any of the low-priority ones,
+// while the messages within each queue are kept in order.
+func TestPeerQueuePrioritization(t *testing.T) {
+	t.Parallel()
+
+	// We use an unbuffered outgoing queue, such that the queueHandler has
+	// received every message once we're done queueing them.
+	p := &peer{
+		sendQueue:     make(chan outgoingMsg),
+		outgoingQueue: make(chan outgoingMsg),
+		quit:          make(chan struct{}),
+	}
+	p.wg.Add(1)
+	go p.queueHandler()
+	defer func() {
+		close(p.quit)
+		p.wg.Wait()
+	}()
+
+	// Queue gossip messages as low-priority, interleaved with the
+	// commitment updates of a channel.
+	gossip1 := &lnwire.ChannelUpdate{Timestamp: 1}
+	gossip2 := &lnwire.ChannelUpdate{Timestamp: 2}
+	htlc := &lnwire.UpdateAddHTLC{ID: 1}
+	commitSig := &lnwire.CommitSig{}
+
+	p.queueMsgLazy(gossip1, nil)
+	p.queueMsg(htlc, nil)
+	p.queueMsgLazy(gossip2, nil)
+	p.queueMsg(commitSig, nil)
+
+	expected := []lnwire.Message{htlc, commitSig, gossip1, gossip2}
+	for i, expectedMsg := range expected {
+		select {
+		case outMsg := <-p.sendQueue:
+			if outMsg.msg != expectedMsg {
+				t.Fatalf("expected message %d to be %v, got %v",
+					i, expectedMsg.MsgType(),
+					outMsg.msg.MsgType())
+			}
+
+		case <-time.After(time.Second):
+			t.Fatalf("message %d not sent", i)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strings"
+)
+
+// gzipResponseWriter wraps an http.ResponseWriter to compress the body of the
+// response with gzip.
+type gzipResponseWriter struct {
+	http.ResponseWriter
+
+	gz *gzip.Writer
+}
+
+// Write compresses the given bytes and writes them to the response.
+func (w *gzipResponseWriter) Write(b []byte) (int, error) {
+	// As the content type would otherwise be detected from the compressed
+	// bytes, we'll detect it from the uncompressed ones.
+	if w.Header().Get("Content-Type") == "" {
+		w.Header().Set("Content-Type", http.DetectContentType(b))
+	}
+
+	return w.gz.Write(b)
+}
+
+// WriteHeader sends the header of the response, dropping its content length
+// as it doesn't match the length of the compressed body.
+func (w *gzipResponseWriter) WriteHeader(code int) {
+	w.Header().Del("Content-Length")
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Flush sends the data compressed so far to the client. This is required for
+// streaming responses.
+//
+// NOTE: This is part of the http.Flusher interface.
+func (w *gzipResponseWriter) Flush() {
+	w.gz.Flush()
+
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// CloseNotify returns a channel that receives a value once the client goes
+// away, which is used by the REST proxy to cancel the calls of streaming
+// responses.
+//
+// NOTE: This is part of the http.CloseNotifier interface.
+func (w *gzipResponseWriter) CloseNotify() <-chan bool {
+	if cn, ok := w.ResponseWriter.(http.CloseNotifier); ok {
+		return cn.CloseNotify()
+	}
+
+	return nil
+}
+
+// acceptsGzip returns true if the client accepts responses compressed with
+// gzip.
+func acceptsGzip(r *http.Request) bool {
+	for _, encoding := range strings.Split(
+		r.Header.Get("Accept-Encoding"), ",",
+	) {
+		// Each encoding may come with a quality value, which we ignore
+		// unless it explicitly disables the encoding.
+		parts := strings.Split(encoding, ";")
+		if strings.TrimSpace(parts[0]) != "gzip" {
+			continue
+		}
+
+		if len(parts) > 1 && strings.TrimSpace(parts[1]) == "q=0" {
+			return false
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// newCompressionHandler wraps the given handler to compress its responses with
+// gzip, if the client accepts it. Compression is negotiated for each call, so
+// that clients on slow links can opt into it for large responses such as the
+// ones of DescribeGraph and ForwardingHistory.
+func newCompressionHandler(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Add("Vary", "Accept-Encoding")
+
+		if !acceptsGzip(r) {
+			h.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Set("Content-Encoding", "gzip")
+
+		gz := gzip.NewWriter(w)
+		defer gz.Close()
+
+		h.ServeHTTP(&gzipResponseWriter{ResponseWriter: w, gz: gz}, r)
+	})
+}
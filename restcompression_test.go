@@ -0,0 +1,93 @@
+// +build !rpctest
+
+package main
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// TestCompressionHandler asserts that REST responses are only compressed if
+// the client accepts gzip, and that compressed responses decode to the
+// original body.
+func TestCompressionHandler(t *testing.T) {
+	t.Parallel()
+
+	body := strings.Repeat(`{"nodes": []}`, 1000)
+	handler := newCompressionHandler(http.HandlerFunc(
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(body))
+		},
+	))
+
+	testCases := []struct {
+		name           string
+		acceptEncoding string
+		compressed     bool
+	}{
+		{
+			name: "no encoding accepted",
+		},
+		{
+			name:           "gzip not accepted",
+			acceptEncoding: "deflate, br",
+		},
+		{
+			name:           "gzip disabled",
+			acceptEncoding: "gzip;q=0, deflate",
+		},
+		{
+			name:           "gzip accepted",
+			acceptEncoding: "deflate, gzip;q=1.0",
+			compressed:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		req := httptest.NewRequest("GET", "/v1/graph", nil)
+		if testCase.acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", testCase.acceptEncoding)
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		encoding := rec.Header().Get("Content-Encoding")
+		if testCase.compressed != (encoding == "gzip") {
+			t.Fatalf("%v: unexpected content encoding %q",
+				testCase.name, encoding)
+		}
+		if rec.Header().Get("Content-Type") != "application/json" {
+			t.Fatalf("%v: unexpected content type %q",
+				testCase.name, rec.Header().Get("Content-Type"))
+		}
+
+		respBody := rec.Body.Bytes()
+		if testCase.compressed {
+			if len(respBody) >= len(body) {
+				t.Fatalf("%v: response not compressed",
+					testCase.name)
+			}
+
+			gz, err := gzip.NewReader(rec.Body)
+			if err != nil {
+				t.Fatalf("%v: unable to read gzip: %v",
+					testCase.name, err)
+			}
+			respBody, err = ioutil.ReadAll(gz)
+			if err != nil {
+				t.Fatalf("%v: unable to decompress: %v",
+					testCase.name, err)
+			}
+		}
+
+		if string(respBody) != body {
+			t.Fatalf("%v: unexpected response body", testCase.name)
+		}
+	}
+}
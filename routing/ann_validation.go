@@ -11,111 +11,168 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 )
 
-// ValidateChannelAnn validates the channel announcement message and checks
-// that node signatures covers the announcement message, and that the bitcoin
-// signatures covers the node keys.
-func ValidateChannelAnn(a *lnwire.ChannelAnnouncement) error {
+// SigCheck is a single signature that is to be verified over the digest of an
+// announcement under the given public key.
+type SigCheck struct {
+	// Sig is the signature to verify.
+	Sig *btcec.Signature
+
+	// PubKey is the public key the signature should be valid under.
+	PubKey *btcec.PublicKey
+
+	// Hash is the digest covered by the signature.
+	Hash []byte
+
+	// Err is the error returned by Verify if the signature is invalid.
+	Err error
+}
+
+// Verify verifies the signature of the check, returning the check's error if
+// it's invalid.
+func (c *SigCheck) Verify() error {
+	if !c.Sig.Verify(c.Hash, c.PubKey) {
+		return c.Err
+	}
+
+	return nil
+}
+
+// newSigCheck parses the given signature and public key into a SigCheck over
+// the passed digest.
+func newSigCheck(sig lnwire.Sig, pubKey [33]byte, hash []byte,
+	checkErr error) (*SigCheck, error) {
+
+	signature, err := sig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+	key, err := btcec.ParsePubKey(pubKey[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	return &SigCheck{
+		Sig:    signature,
+		PubKey: key,
+		Hash:   hash,
+		Err:    checkErr,
+	}, nil
+}
+
+// ChannelAnnSigChecks returns the checks of the four signatures of the given
+// channel announcement: the two bitcoin signatures followed by the two node
+// signatures. The checks are independent of one another, so they may be
+// verified in parallel.
+func ChannelAnnSigChecks(a *lnwire.ChannelAnnouncement) ([]*SigCheck, error) {
 	// First, we'll compute the digest (h) which is to be signed by each of
 	// the keys included within the node announcement message. This hash
 	// digest includes all the keys, so the (up to 4 signatures) will
 	// attest to the validity of each of the keys.
 	data, err := a.DataToSign()
 	if err != nil {
-		return err
+		return nil, err
 	}
 	dataHash := chainhash.DoubleHashB(data)
 
-	// First we'll verify that the passed bitcoin key signature is indeed a
-	// signature over the computed hash digest.
-	bitcoinSig1, err := a.BitcoinSig1.ToSignature()
-	if err != nil {
-		return err
-	}
-	bitcoinKey1, err := btcec.ParsePubKey(a.BitcoinKey1[:], btcec.S256())
-	if err != nil {
-		return err
-	}
-	if !bitcoinSig1.Verify(dataHash, bitcoinKey1) {
-		return errors.New("can't verify first bitcoin signature")
+	// The bitcoin signatures prove ownership of the keys of the funding
+	// output, while the node signatures attest to the announcement itself.
+	sigs := []struct {
+		sig    lnwire.Sig
+		pubKey [33]byte
+		err    error
+	}{
+		{
+			sig:    a.BitcoinSig1,
+			pubKey: a.BitcoinKey1,
+			err: errors.New("can't verify first bitcoin " +
+				"signature"),
+		},
+		{
+			sig:    a.BitcoinSig2,
+			pubKey: a.BitcoinKey2,
+			err: errors.New("can't verify second bitcoin " +
+				"signature"),
+		},
+		{
+			sig:    a.NodeSig1,
+			pubKey: a.NodeID1,
+			err: errors.New("can't verify data in first node " +
+				"signature"),
+		},
+		{
+			sig:    a.NodeSig2,
+			pubKey: a.NodeID2,
+			err: errors.New("can't verify data in second node " +
+				"signature"),
+		},
+	}
+
+	checks := make([]*SigCheck, 0, len(sigs))
+	for _, sig := range sigs {
+		check, err := newSigCheck(sig.sig, sig.pubKey, dataHash, sig.err)
+		if err != nil {
+			return nil, err
+		}
+		checks = append(checks, check)
 	}
 
-	// If that checks out, then we'll verify that the second bitcoin
-	// signature is a valid signature of the bitcoin public key over hash
-	// digest as well.
-	bitcoinSig2, err := a.BitcoinSig2.ToSignature()
-	if err != nil {
-		return err
-	}
-	bitcoinKey2, err := btcec.ParsePubKey(a.BitcoinKey2[:], btcec.S256())
-	if err != nil {
-		return err
-	}
-	if !bitcoinSig2.Verify(dataHash, bitcoinKey2) {
-		return errors.New("can't verify second bitcoin signature")
-	}
+	return checks, nil
+}
 
-	// Both node signatures attached should indeed be a valid signature
-	// over the selected digest of the channel announcement signature.
-	nodeSig1, err := a.NodeSig1.ToSignature()
-	if err != nil {
-		return err
-	}
-	nodeKey1, err := btcec.ParsePubKey(a.NodeID1[:], btcec.S256())
+// ValidateChannelAnn validates the channel announcement message and checks
+// that node signatures covers the announcement message, and that the bitcoin
+// signatures covers the node keys.
+func ValidateChannelAnn(a *lnwire.ChannelAnnouncement) error {
+	checks, err := ChannelAnnSigChecks(a)
 	if err != nil {
 		return err
 	}
-	if !nodeSig1.Verify(dataHash, nodeKey1) {
-		return errors.New("can't verify data in first node signature")
-	}
 
-	nodeSig2, err := a.NodeSig2.ToSignature()
-	if err != nil {
-		return err
-	}
-	nodeKey2, err := btcec.ParsePubKey(a.NodeID2[:], btcec.S256())
-	if err != nil {
-		return err
-	}
-	if !nodeSig2.Verify(dataHash, nodeKey2) {
-		return errors.New("can't verify data in second node signature")
+	for _, check := range checks {
+		if err := check.Verify(); err != nil {
+			return err
+		}
 	}
 
 	return nil
-
 }
 
-// ValidateNodeAnn validates the node announcement by ensuring that the
-// attached signature is needed a signature of the node announcement under the
-// specified node public key.
-func ValidateNodeAnn(a *lnwire.NodeAnnouncement) error {
+// NodeAnnSigCheck returns the check of the signature of the given node
+// announcement.
+func NodeAnnSigCheck(a *lnwire.NodeAnnouncement) (*SigCheck, error) {
 	// Reconstruct the data of announcement which should be covered by the
 	// signature so we can verify the signature shortly below
 	data, err := a.DataToSign()
 	if err != nil {
-		return err
+		return nil, err
 	}
+	dataHash := chainhash.DoubleHashB(data)
 
-	nodeSig, err := a.Signature.ToSignature()
-	if err != nil {
-		return err
-	}
-	nodeKey, err := btcec.ParsePubKey(a.NodeID[:], btcec.S256())
+	checkErr := errors.Errorf("signature on NodeAnnouncement(%x) is "+
+		"invalid", a.NodeID[:])
+
+	return newSigCheck(a.Signature, a.NodeID, dataHash, checkErr)
+}
+
+// ValidateNodeAnn validates the node announcement by ensuring that the
+// attached signature is needed a signature of the node announcement under the
+// specified node public key.
+func ValidateNodeAnn(a *lnwire.NodeAnnouncement) error {
+	check, err := NodeAnnSigCheck(a)
 	if err != nil {
 		return err
 	}
 
 	// Finally ensure that the passed signature is valid, if not we'll
 	// return an error so this node announcement can be rejected.
-	dataHash := chainhash.DoubleHashB(data)
-	if !nodeSig.Verify(dataHash, nodeKey) {
+	if err := check.Verify(); err != nil {
 		var msgBuf bytes.Buffer
 		if _, err := lnwire.WriteMessage(&msgBuf, a, 0); err != nil {
 			return err
 		}
 
 		return errors.Errorf("signature on NodeAnnouncement(%x) is "+
-			"invalid: %x", nodeKey.SerializeCompressed(),
-			msgBuf.Bytes())
+			"invalid: %x", a.NodeID[:], msgBuf.Bytes())
 	}
 
 	return nil
@@ -132,20 +189,28 @@ func ValidateChannelUpdateAnn(pubKey *btcec.PublicKey, capacity btcutil.Amount,
 		return err
 	}
 
-	data, err := a.DataToSign()
+	return VerifyChannelUpdateSignature(a, pubKey)
+}
+
+// VerifyChannelUpdateSignature verifies that the channel update message was
+// signed by the party with the given node public key.
+func VerifyChannelUpdateSignature(msg *lnwire.ChannelUpdate,
+	pubKey *btcec.PublicKey) error {
+
+	data, err := msg.DataToSign()
 	if err != nil {
 		return errors.Errorf("unable to reconstruct message: %v", err)
 	}
 	dataHash := chainhash.DoubleHashB(data)
 
-	nodeSig, err := a.Signature.ToSignature()
+	nodeSig, err := msg.Signature.ToSignature()
 	if err != nil {
 		return err
 	}
 
 	if !nodeSig.Verify(dataHash, pubKey) {
 		return errors.Errorf("invalid signature for channel "+
-			"update %v", spew.Sdump(a))
+			"update %v", spew.Sdump(msg))
 	}
 
 	return nil
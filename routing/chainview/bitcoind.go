@@ -12,6 +12,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcwallet/chain"
 	"github.com/btcsuite/btcwallet/wtxmgr"
+	"github.com/lightningnetwork/lnd/blockfetch"
 	"github.com/lightningnetwork/lnd/channeldb"
 )
 
@@ -50,6 +51,10 @@ type BitcoindFilteredChainView struct {
 	// blocks will be sent over.
 	filterBlockReqs chan *filterBlockReq
 
+	// blockFetcher is used to fetch the blocks pruned by bitcoind from
+	// its peers.
+	blockFetcher *blockfetch.Fetcher
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -60,13 +65,14 @@ var _ FilteredChainView = (*BitcoindFilteredChainView)(nil)
 
 // NewBitcoindFilteredChainView creates a new instance of a FilteredChainView
 // from RPC credentials and a ZMQ socket address for a bitcoind instance.
-func NewBitcoindFilteredChainView(
-	chainConn *chain.BitcoindConn) *BitcoindFilteredChainView {
+func NewBitcoindFilteredChainView(chainConn *chain.BitcoindConn,
+	blockFetcher *blockfetch.Fetcher) *BitcoindFilteredChainView {
 
 	chainView := &BitcoindFilteredChainView{
 		chainFilter:     make(map[wire.OutPoint]struct{}),
 		filterUpdates:   make(chan filterUpdate),
 		filterBlockReqs: make(chan *filterBlockReq),
+		blockFetcher:    blockFetcher,
 		quit:            make(chan struct{}),
 	}
 
@@ -390,7 +396,9 @@ func (b *BitcoindFilteredChainView) chainFilterer() {
 		case req := <-b.filterBlockReqs:
 			// First we'll fetch the block itself as well as some
 			// additional information including its height.
-			block, err := b.chainClient.GetBlock(req.blockHash)
+			block, err := b.blockFetcher.GetBlock(
+				req.blockHash, b.chainClient.GetBlock,
+			)
 			if err != nil {
 				req.err <- err
 				req.resp <- nil
@@ -26,6 +26,7 @@ import (
 	_ "github.com/btcsuite/btcwallet/walletdb/bdb" // Required to register the boltdb walletdb implementation.
 
 	"github.com/lightninglabs/neutrino"
+	"github.com/lightningnetwork/lnd/blockfetch"
 	"github.com/lightningnetwork/lnd/channeldb"
 )
 
@@ -832,7 +833,9 @@ var interfaceImpls = []struct {
 				cleanUp2()
 			}
 
-			chainView := NewBitcoindFilteredChainView(chainConn)
+			chainView := NewBitcoindFilteredChainView(
+				chainConn, blockfetch.New(&blockfetch.Config{}),
+			)
 
 			return cleanUp3, chainView, nil
 		},
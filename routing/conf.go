@@ -2,9 +2,13 @@
 
 package routing
 
-// Conf provides the command line routing configuration. There are no fields in
-// the production build so that this section is hidden by default.
-type Conf struct{}
+// Conf provides the command line routing configuration. The experimental
+// options are hidden in the production build.
+type Conf struct {
+	StrictZombiePruning bool `long:"strictgraphpruning" description:"If true, then the graph will be pruned more aggressively for zombies. In practice this means that edges with a single stale edge will be considered a zombie."`
+
+	NoShadowRoute bool `long:"noshadowroute" description:"If true, then outgoing payments won't be padded with a random shadow route. This lowers the time-lock and fees of payments, at the cost of revealing the destination of a payment to the final hop of its route."`
+}
 
 // UseAssumeChannelValid always returns false when not in experimental builds.
 func (c *Conf) UseAssumeChannelValid() bool {
@@ -5,6 +5,10 @@ package routing
 // Conf exposes the experimental command line routing configurations.
 type Conf struct {
 	AssumeChannelValid bool `long:"assumechanvalid" description:"Skip checking channel spentness during graph validation. (default: false)"`
+
+	StrictZombiePruning bool `long:"strictgraphpruning" description:"If true, then the graph will be pruned more aggressively for zombies. In practice this means that edges with a single stale edge will be considered a zombie."`
+
+	NoShadowRoute bool `long:"noshadowroute" description:"If true, then outgoing payments won't be padded with a random shadow route. This lowers the time-lock and fees of payments, at the cost of revealing the destination of a payment to the final hop of its route."`
 }
 
 // UseAssumeChannelValid returns true if the router should skip checking for
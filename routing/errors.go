@@ -51,6 +51,16 @@ const (
 	// ErrFeeLimitExceeded is returned when the total fees of a route exceed
 	// the user-specified fee limit.
 	ErrFeeLimitExceeded
+
+	// ErrCltvLimitExceeded is returned when a path to the target
+	// destination exists, but its total time lock exceeds the
+	// user-specified CLTV limit.
+	ErrCltvLimitExceeded
+
+	// ErrInvalidFundingOutput is returned when a channel announcement
+	// references a funding output that doesn't exist on-chain, or that
+	// doesn't pay to the multi-sig script of the channel's bitcoin keys.
+	ErrInvalidFundingOutput
 )
 
 // routerError is a structure that represent the error inside the routing package,
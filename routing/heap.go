@@ -23,6 +23,15 @@ type nodeWithDist struct {
 
 	// fee is the fee that this node is charging for forwarding.
 	fee lnwire.MilliSatoshi
+
+	// incomingCltv is the sum of the time lock deltas charged by this
+	// node and all nodes after it on the path to the target, excluding
+	// the final CLTV delta of the target.
+	incomingCltv uint32
+
+	// hops is the number of hops on the path from this node to the
+	// target.
+	hops int
 }
 
 // distanceHeap is a min-distance heap that's used within our path finding
@@ -29,6 +29,45 @@ const (
 	edgeDecay = time.Duration(time.Second * 5)
 )
 
+// DirectedNodePair is a pair of nodes, in the direction in which an HTLC is
+// forwarded from one to the other.
+type DirectedNodePair struct {
+	// From is the node that forwards the HTLC.
+	From Vertex
+
+	// To is the node that the HTLC is forwarded to.
+	To Vertex
+}
+
+// pairResult is the outcome of the latest attempt to forward an HTLC between
+// a pair of nodes.
+type pairResult struct {
+	// timestamp is the time at which the result was recorded.
+	timestamp time.Time
+
+	// success indicates whether the HTLC was forwarded successfully.
+	success bool
+}
+
+// PairHistory is the reliability memory of mission control for a single pair
+// of nodes.
+type PairHistory struct {
+	// Pair is the pair of nodes the history applies to.
+	Pair DirectedNodePair
+
+	// Timestamp is the time of the latest attempt to forward an HTLC
+	// between the pair.
+	Timestamp time.Time
+
+	// Success indicates whether the latest attempt succeeded.
+	Success bool
+
+	// SuccessProbability is the current estimate of the probability that
+	// the pair is able to forward an HTLC, taking into account the decay
+	// of the latest result. It is ignored when importing history.
+	SuccessProbability float64
+}
+
 // missionControl contains state which summarizes the past attempts of HTLC
 // routing by external callers when sending payments throughout the network.
 // missionControl remembers the outcome of these past routing attempts (success
@@ -54,12 +93,29 @@ type missionControl struct {
 	// to that particular vertex.
 	failedVertexes map[Vertex]time.Time
 
+	// pairResults maps each node that we've attempted to route an HTLC
+	// through, to the outcome of the latest attempt to forward to each of
+	// the nodes it has channels with. In contrast to the prune view, these
+	// results are persisted, and gradually lose their influence on path
+	// finding as they age.
+	pairResults map[Vertex]nodeResults
+
+	store *missionControlStore
+
+	cfg MissionControlConfig
+
+	estimator probabilityEstimator
+
 	graph *channeldb.ChannelGraph
 
 	selfNode *channeldb.LightningNode
 
 	queryBandwidth func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi
 
+	// blockedNodes is the set of nodes that payments are never routed
+	// through.
+	blockedNodes map[Vertex]struct{}
+
 	sync.Mutex
 
 	// TODO(roasbeef): further counters, if vertex continually unavailable,
@@ -68,19 +124,46 @@ type missionControl struct {
 	// TODO(roasbeef): also add favorable metrics for nodes
 }
 
-// newMissionControl returns a new instance of missionControl.
-//
-// TODO(roasbeef): persist memory
+// newMissionControl returns a new instance of missionControl, restoring the
+// pair results persisted within the database of the channel graph.
 func newMissionControl(g *channeldb.ChannelGraph, selfNode *channeldb.LightningNode,
-	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi) *missionControl {
+	qb func(*channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi,
+	blockedNodes map[Vertex]struct{},
+	cfg MissionControlConfig) (*missionControl, error) {
 
-	return &missionControl{
+	estimator, err := newProbabilityEstimator(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	store, err := newMissionControlStore(g.Database())
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := store.fetchResults()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Mission Control restored results of %v node pairs using "+
+		"the %v estimator", len(results), cfg.Estimator)
+
+	m := &missionControl{
 		failedEdges:    make(map[edgeLocator]time.Time),
 		failedVertexes: make(map[Vertex]time.Time),
+		pairResults:    make(map[Vertex]nodeResults),
+		store:          store,
+		cfg:            cfg,
+		estimator:      estimator,
 		selfNode:       selfNode,
 		queryBandwidth: qb,
+		blockedNodes:   blockedNodes,
 		graph:          g,
 	}
+	m.setPairResults(results)
+
+	return m, nil
 }
 
 // graphPruneView is a filter of sorts that path finding routines should
@@ -157,6 +240,38 @@ func (m *missionControl) NewPaymentSession(routeHints [][]HopHint,
 
 	viewSnapshot := m.GraphPruneView()
 
+	edges := routeHintEdges(routeHints, target)
+
+	// We'll also obtain a set of bandwidthHints from the lower layer for
+	// each of our outbound channels. This will allow the path finding to
+	// skip any links that aren't active or just don't have enough
+	// bandwidth to carry the payment.
+	sourceNode, err := m.graph.SourceNode()
+	if err != nil {
+		return nil, err
+	}
+	bandwidthHints, err := generateBandwidthHints(
+		sourceNode, m.queryBandwidth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &paymentSession{
+		pruneViewSnapshot:    viewSnapshot,
+		additionalEdges:      edges,
+		bandwidthHints:       bandwidthHints,
+		errFailedPolicyChans: make(map[edgeLocator]struct{}),
+		mc:                   m,
+	}, nil
+}
+
+// routeHintEdges converts the given routing hints into the channel edges they
+// describe, indexed by the public key of the channel's starting node, so that
+// they can be considered during path finding in addition to the channel graph.
+func routeHintEdges(routeHints [][]HopHint,
+	target *btcec.PublicKey) map[Vertex][]*channeldb.ChannelEdgePolicy {
+
 	edges := make(map[Vertex][]*channeldb.ChannelEdgePolicy)
 
 	// Traverse through all of the available hop hints and include them in
@@ -198,28 +313,7 @@ func (m *missionControl) NewPaymentSession(routeHints [][]HopHint,
 		}
 	}
 
-	// We'll also obtain a set of bandwidthHints from the lower layer for
-	// each of our outbound channels. This will allow the path finding to
-	// skip any links that aren't active or just don't have enough
-	// bandwidth to carry the payment.
-	sourceNode, err := m.graph.SourceNode()
-	if err != nil {
-		return nil, err
-	}
-	bandwidthHints, err := generateBandwidthHints(
-		sourceNode, m.queryBandwidth,
-	)
-	if err != nil {
-		return nil, err
-	}
-
-	return &paymentSession{
-		pruneViewSnapshot:    viewSnapshot,
-		additionalEdges:      edges,
-		bandwidthHints:       bandwidthHints,
-		errFailedPolicyChans: make(map[edgeLocator]struct{}),
-		mc:                   m,
-	}, nil
+	return edges
 }
 
 // NewPaymentSessionFromRoutes creates a new paymentSession instance that will
@@ -273,9 +367,158 @@ func generateBandwidthHints(sourceNode *channeldb.LightningNode,
 
 // ResetHistory resets the history of missionControl returning it to a state as
 // if no payment attempts have been made.
-func (m *missionControl) ResetHistory() {
+func (m *missionControl) ResetHistory() error {
 	m.Lock()
+	defer m.Unlock()
+
+	if err := m.store.clear(); err != nil {
+		return err
+	}
+
 	m.failedEdges = make(map[edgeLocator]time.Time)
 	m.failedVertexes = make(map[Vertex]time.Time)
-	m.Unlock()
+	m.pairResults = make(map[Vertex]nodeResults)
+
+	return nil
+}
+
+// getPairProbability returns the probability that the given pair of nodes is
+// able to forward an HTLC of the given amount through a channel of the given
+// capacity, as estimated from the past attempts to route through the nodes. A
+// zero capacity indicates that the capacity is unknown.
+func (m *missionControl) getPairProbability(from, to Vertex, amt,
+	capacity lnwire.MilliSatoshi) float64 {
+
+	m.Lock()
+	defer m.Unlock()
+
+	return m.estimator.pairProbability(
+		time.Now(), m.pairResults[from], to, amt, capacity,
+	)
+}
+
+// setPairResults adds the given pair results to the in-memory reliability
+// memory. The caller must hold the mission control lock.
+func (m *missionControl) setPairResults(
+	results map[DirectedNodePair]pairResult) {
+
+	for pair, result := range results {
+		if _, ok := m.pairResults[pair.From]; !ok {
+			m.pairResults[pair.From] = make(nodeResults)
+		}
+		m.pairResults[pair.From][pair.To] = result
+	}
+}
+
+// reportRouteResult records the outcome of an attempt to route an HTLC along
+// the given route. If errSource is nil, the HTLC reached its destination, so
+// every pair of nodes along the route is recorded as a success. Otherwise, the
+// pairs leading up to errSource are recorded as successes, and if
+// penalizeErrSource is set, the pair formed by errSource and the next hop as a
+// failure.
+func (m *missionControl) reportRouteResult(route *Route, errSource *Vertex,
+	penalizeErrSource bool) {
+
+	result := pairResult{timestamp: time.Now(), success: true}
+	results := make(map[DirectedNodePair]pairResult)
+
+	fromNode := route.SourcePubKey
+	for _, hop := range route.Hops {
+		pair := DirectedNodePair{From: fromNode, To: hop.PubKeyBytes}
+
+		if errSource != nil && fromNode == *errSource {
+			if penalizeErrSource {
+				result.success = false
+				results[pair] = result
+			}
+			break
+		}
+
+		results[pair] = result
+		fromNode = hop.PubKeyBytes
+	}
+
+	if err := m.addPairResults(results); err != nil {
+		log.Errorf("Unable to persist mission control results: %v",
+			err)
+	}
+}
+
+// addPairResults persists the given pair results, and adds them to the
+// in-memory reliability memory.
+func (m *missionControl) addPairResults(
+	results map[DirectedNodePair]pairResult) error {
+
+	m.Lock()
+	defer m.Unlock()
+
+	if err := m.store.addResults(results); err != nil {
+		return err
+	}
+
+	m.setPairResults(results)
+
+	return nil
+}
+
+// GetHistorySnapshot returns the reliability memory of mission control for
+// all pairs of nodes we have a record of.
+func (m *missionControl) GetHistorySnapshot() []*PairHistory {
+	now := time.Now()
+
+	m.Lock()
+	defer m.Unlock()
+
+	var history []*PairHistory
+	for from, results := range m.pairResults {
+		for to, result := range results {
+			prob := m.estimator.pairProbability(
+				now, results, to, 0, 0,
+			)
+
+			history = append(history, &PairHistory{
+				Pair: DirectedNodePair{
+					From: from,
+					To:   to,
+				},
+				Timestamp:          result.timestamp,
+				Success:            result.success,
+				SuccessProbability: prob,
+			})
+		}
+	}
+
+	return history
+}
+
+// ImportHistory adds the given pair history to the reliability memory of
+// mission control. Results that are older than the ones already recorded for
+// the same pairs are ignored.
+func (m *missionControl) ImportHistory(history []*PairHistory) error {
+	m.Lock()
+	defer m.Unlock()
+
+	results := make(map[DirectedNodePair]pairResult)
+	for _, h := range history {
+		current, ok := m.pairResults[h.Pair.From][h.Pair.To]
+		if ok && !h.Timestamp.After(current.timestamp) {
+			continue
+		}
+
+		results[h.Pair] = pairResult{
+			timestamp: h.Timestamp,
+			success:   h.Success,
+		}
+	}
+
+	if err := m.store.addResults(results); err != nil {
+		return err
+	}
+
+	m.setPairResults(results)
+
+	log.Debugf("Mission Control imported results of %v node pairs",
+		len(results))
+
+	return nil
 }
@@ -0,0 +1,125 @@
+package routing
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+var (
+	// missionControlBucket is a key used to create a top level bucket in
+	// the channel database, used to persist the latest result of routing
+	// an HTLC between each pair of nodes, so that mission control doesn't
+	// lose its memory across restarts.
+	//
+	// maps:
+	//   fromNode (33 bytes) + toNode (33 bytes) -> timestamp (8 bytes) +
+	//   success (1 byte)
+	missionControlBucket = []byte("mission-control-results")
+)
+
+// missionControlStore persists the pair results of mission control within
+// the channel database.
+type missionControlStore struct {
+	db *channeldb.DB
+}
+
+// newMissionControlStore creates a new mission control store backed by the
+// passed channel database.
+func newMissionControlStore(db *channeldb.DB) (*missionControlStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(missionControlBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create required buckets: %v",
+			err)
+	}
+
+	return &missionControlStore{db: db}, nil
+}
+
+// pairKey returns the database key of the given node pair.
+func pairKey(pair DirectedNodePair) []byte {
+	var k [33 + 33]byte
+	copy(k[:33], pair.From[:])
+	copy(k[33:], pair.To[:])
+
+	return k[:]
+}
+
+// addResults persists the given pair results, overwriting any result
+// previously stored for the same pairs.
+func (s *missionControlStore) addResults(
+	results map[DirectedNodePair]pairResult) error {
+
+	return s.db.Batch(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(missionControlBucket)
+
+		for pair, result := range results {
+			var v [8 + 1]byte
+			binary.BigEndian.PutUint64(
+				v[:8], uint64(result.timestamp.UnixNano()),
+			)
+			if result.success {
+				v[8] = 1
+			}
+
+			if err := bucket.Put(pairKey(pair), v[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// fetchResults returns all pair results within the store.
+func (s *missionControlStore) fetchResults() (
+	map[DirectedNodePair]pairResult, error) {
+
+	results := make(map[DirectedNodePair]pairResult)
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(missionControlBucket)
+
+		return bucket.ForEach(func(k, v []byte) error {
+			if len(k) != 33+33 || len(v) != 8+1 {
+				return fmt.Errorf("invalid mission control "+
+					"result with key %x", k)
+			}
+
+			var pair DirectedNodePair
+			copy(pair.From[:], k[:33])
+			copy(pair.To[:], k[33:])
+
+			nanos := int64(binary.BigEndian.Uint64(v[:8]))
+			results[pair] = pairResult{
+				timestamp: time.Unix(0, nanos),
+				success:   v[8] == 1,
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
+// clear removes all pair results from the store.
+func (s *missionControlStore) clear() error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		err := tx.DeleteBucket(missionControlBucket)
+		if err != nil && err != bbolt.ErrBucketNotFound {
+			return err
+		}
+
+		_, err = tx.CreateBucket(missionControlBucket)
+		return err
+	})
+}
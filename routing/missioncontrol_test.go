@@ -0,0 +1,127 @@
+package routing
+
+import (
+	"testing"
+	"time"
+)
+
+// TestMissionControlPairResults asserts that mission control records the
+// outcome of routing attempts per pair of nodes, persists them across
+// restarts, and is able to import and reset its memory.
+func TestMissionControlPairResults(t *testing.T) {
+	t.Parallel()
+
+	graph, cleanUp, err := makeTestGraph()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer cleanUp()
+
+	mc, err := newMissionControl(
+		graph, nil, nil, nil, DefaultMissionControlConfig(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create mission control: %v", err)
+	}
+
+	source, bob, carol, dave := Vertex{1}, Vertex{2}, Vertex{3}, Vertex{4}
+	aprioriProb := mc.estimator.aprioriProbability()
+	route := &Route{
+		SourcePubKey: source,
+		Hops: []*Hop{
+			{PubKeyBytes: bob},
+			{PubKeyBytes: carol},
+			{PubKeyBytes: dave},
+		},
+	}
+
+	// Carol reports a failure, so all pairs leading up to her should be
+	// recorded as successes, and her outgoing pair as a failure.
+	mc.reportRouteResult(route, &carol, true)
+
+	assertProbability := func(from, to Vertex, success bool) {
+		t.Helper()
+
+		prob := mc.getPairProbability(from, to, 0, 0)
+		if success && prob <= aprioriProb {
+			t.Fatalf("expected success probability for pair "+
+				"%v -> %v, got %v", from, to, prob)
+		}
+		if !success && prob >= minHopProbability {
+			t.Fatalf("expected failure probability for pair "+
+				"%v -> %v, got %v", from, to, prob)
+		}
+	}
+	assertProbability(source, bob, true)
+	assertProbability(bob, carol, true)
+	assertProbability(carol, dave, false)
+
+	// Pairs we have no history of should have the apriori probability.
+	if prob := mc.getPairProbability(dave, carol, 0, 0); prob !=
+		aprioriProb {
+
+		t.Fatalf("expected apriori probability, got %v", prob)
+	}
+
+	// Upon restart, the results should be restored from disk.
+	mc, err = newMissionControl(
+		graph, nil, nil, nil, DefaultMissionControlConfig(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create mission control: %v", err)
+	}
+	if len(mc.GetHistorySnapshot()) != 3 {
+		t.Fatalf("expected 3 pairs, got %v",
+			len(mc.GetHistorySnapshot()))
+	}
+	assertProbability(carol, dave, false)
+
+	// Importing an older success of the failed pair should have no effect,
+	// while an unknown pair should be added.
+	err = mc.ImportHistory([]*PairHistory{
+		{
+			Pair:      DirectedNodePair{From: carol, To: dave},
+			Timestamp: time.Now().Add(-time.Hour),
+			Success:   true,
+		},
+		{
+			Pair:      DirectedNodePair{From: dave, To: carol},
+			Timestamp: time.Now(),
+			Success:   true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to import history: %v", err)
+	}
+	assertProbability(carol, dave, false)
+	assertProbability(dave, carol, true)
+
+	// A failure that isn't penalized should only record the successful
+	// pairs leading up to the error source.
+	route.SourcePubKey = Vertex{5}
+	mc.reportRouteResult(route, &bob, false)
+	assertProbability(Vertex{5}, bob, true)
+	if len(mc.GetHistorySnapshot()) != 5 {
+		t.Fatalf("expected 5 pairs, got %v",
+			len(mc.GetHistorySnapshot()))
+	}
+
+	// Finally, resetting mission control should clear its memory, both in
+	// memory and on disk.
+	if err := mc.ResetHistory(); err != nil {
+		t.Fatalf("unable to reset history: %v", err)
+	}
+	if len(mc.GetHistorySnapshot()) != 0 {
+		t.Fatalf("expected empty history after reset")
+	}
+
+	mc, err = newMissionControl(
+		graph, nil, nil, nil, DefaultMissionControlConfig(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create mission control: %v", err)
+	}
+	if len(mc.GetHistorySnapshot()) != 0 {
+		t.Fatalf("expected empty history after restart")
+	}
+}
@@ -1,7 +1,9 @@
 package routing
 
 import (
+	"bytes"
 	"fmt"
+	"image/color"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -240,6 +242,9 @@ type NetworkNodeUpdate struct {
 
 	// Alias is the alias or nick name of the node.
 	Alias string
+
+	// Color is the color of the node.
+	Color color.RGBA
 }
 
 // ChannelEdgeUpdate is an update for a new channel within the ChannelGraph.
@@ -311,10 +316,20 @@ func addToTopologyChange(graph *channeldb.ChannelGraph, update *TopologyChange,
 		if err != nil {
 			return err
 		}
+		var features bytes.Buffer
+		if m.Features != nil {
+			err := m.Features.Encode(&features)
+			if err != nil {
+				return err
+			}
+		}
+
 		nodeUpdate := &NetworkNodeUpdate{
-			Addresses:   m.Addresses,
-			IdentityKey: pubKey,
-			Alias:       m.Alias,
+			Addresses:      m.Addresses,
+			IdentityKey:    pubKey,
+			GlobalFeatures: features.Bytes(),
+			Alias:          m.Alias,
+			Color:          m.Color,
 		}
 		nodeUpdate.IdentityKey.Curve = nil
 
@@ -1,6 +1,7 @@
 package routing
 
 import (
+	"bytes"
 	"fmt"
 	"image/color"
 	"net"
@@ -180,6 +181,13 @@ func (m *mockChain) addUtxo(op wire.OutPoint, out *wire.TxOut) {
 	m.utxos[op] = *out
 	m.Unlock()
 }
+
+func (m *mockChain) spendUtxo(op wire.OutPoint) {
+	m.Lock()
+	delete(m.utxos, op)
+	m.Unlock()
+}
+
 func (m *mockChain) GetUtxo(op *wire.OutPoint, _ []byte, _ uint32) (*wire.TxOut, error) {
 	m.RLock()
 	defer m.RUnlock()
@@ -212,6 +220,20 @@ func (m *mockChain) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error)
 	return block, nil
 }
 
+func (m *mockChain) GetBlockHeader(
+	blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+
+	m.RLock()
+	defer m.RUnlock()
+
+	block, ok := m.blocks[*blockHash]
+	if !ok {
+		return nil, fmt.Errorf("block not found")
+	}
+
+	return &block.Header, nil
+}
+
 type mockChainView struct {
 	sync.RWMutex
 
@@ -626,6 +648,20 @@ func TestNodeUpdateNotification(t *testing.T) {
 			t.Fatalf("node alias doesn't match: expected %v, got %v",
 				ann.Alias, nodeUpdate.Alias)
 		}
+		if nodeUpdate.Color != ann.Color {
+			t.Fatalf("node color doesn't match: expected %v, got %v",
+				ann.Color, nodeUpdate.Color)
+		}
+
+		var features bytes.Buffer
+		if err := ann.Features.Encode(&features); err != nil {
+			t.Fatalf("unable to encode features: %v", err)
+		}
+		if !bytes.Equal(nodeUpdate.GlobalFeatures, features.Bytes()) {
+			t.Fatalf("node features don't match: expected %x, "+
+				"got %x", features.Bytes(),
+				nodeUpdate.GlobalFeatures)
+		}
 	}
 
 	// Create lookup map for notifications we are intending to receive. Entries
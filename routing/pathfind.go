@@ -5,11 +5,11 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math"
+	"sort"
 
 	"container/heap"
 
 	"github.com/btcsuite/btcd/btcec"
-	"github.com/coreos/bbolt"
 
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/channeldb"
@@ -38,6 +38,10 @@ const (
 	// some effect with smaller time lock values. The value may need
 	// tweaking and/or be made configurable in the future.
 	RiskFactorBillionths = 15
+
+	// minHopProbability is the lower bound of the success probability used
+	// to penalize an edge, which caps the penalty of recently failed edges.
+	minHopProbability = 0.01
 )
 
 // HopHint is a routing hint that contains the minimum information of a channel
@@ -330,6 +334,13 @@ func NewRouteFromHops(amtToSend lnwire.MilliSatoshi, timeLock uint32,
 		return nil, ErrNoRouteHopsProvided
 	}
 
+	// The onion packet can only encode up to HopLimit hops, so we refuse
+	// to create a route that can't be sent along.
+	if len(hops) > HopLimit {
+		return nil, newErrf(ErrMaxHopsExceeded, "route has %v hops, "+
+			"exceeding the limit of %v", len(hops), HopLimit)
+	}
+
 	// First, we'll create a route struct and populate it with the fields
 	// for which the values are provided as arguments of this function.
 	// TotalFees is determined based on the difference between the amount
@@ -381,13 +392,29 @@ func edgeWeight(lockedAmt lnwire.MilliSatoshi, fee lnwire.MilliSatoshi,
 	return int64(fee) + timeLockPenalty
 }
 
+// probabilityPenalty returns the weight that is added to an edge with the
+// given success probability. The attempt cost is scaled by how much more
+// likely the edge is to fail than an edge with the apriori probability. Edges
+// that are at least as likely to succeed aren't penalized, which keeps path
+// finding unchanged in the absence of any information on the edge.
+func probabilityPenalty(prob, aprioriProb float64,
+	attemptCost lnwire.MilliSatoshi) int64 {
+
+	if prob >= aprioriProb {
+		return 0
+	}
+	if prob < minHopProbability {
+		prob = minHopProbability
+	}
+
+	penalty := float64(attemptCost)
+	return int64(penalty * (aprioriProb/prob - 1))
+}
+
 // graphParams wraps the set of graph parameters passed to findPath.
 type graphParams struct {
-	// tx can be set to an existing db transaction. If not set, a new
-	// transaction will be started.
-	tx *bbolt.Tx
-
-	// graph is the ChannelGraph to be used during path finding.
+	// graph is the ChannelGraph to be used during path finding. The graph
+	// is traversed through its in-memory cache.
 	graph *channeldb.ChannelGraph
 
 	// additionalEdges is an optional set of edges that should be
@@ -403,6 +430,10 @@ type graphParams struct {
 	// set to the current available sending bandwidth for active local
 	// channels, and 0 for inactive channels.
 	bandwidthHints map[uint64]lnwire.MilliSatoshi
+
+	// blockedNodes is an optional set of nodes that payments must never be
+	// routed through.
+	blockedNodes map[Vertex]struct{}
 }
 
 // restrictParams wraps the set of restrictions passed to findPath that the
@@ -423,6 +454,37 @@ type restrictParams struct {
 	// outgoingChannelID is the channel that needs to be taken to the first
 	// hop. If nil, any channel may be used.
 	outgoingChannelID *uint64
+
+	// lastHop is the node that the path must reach the target through. If
+	// nil, any node may be used.
+	lastHop *Vertex
+
+	// incomingChannelID is the channel through which the last hop must
+	// forward to the target. It is only supported for paths back to the
+	// source node. If nil, any channel may be used.
+	incomingChannelID *uint64
+
+	// cltvLimit is the maximum sum of the time lock deltas charged by the
+	// nodes along the path, excluding the final CLTV delta of the target.
+	// If nil, no limit is enforced.
+	cltvLimit *uint32
+
+	// probabilitySource is an optional callback that returns the
+	// probability that an HTLC of the given amount is successfully
+	// forwarded from one node to another, through a channel of the given
+	// capacity. A zero capacity indicates that it is unknown. If set,
+	// edges are penalized in accordance with their probability of failure.
+	probabilitySource func(fromNode, toNode Vertex,
+		amt, capacity lnwire.MilliSatoshi) float64
+
+	// aprioriProbability is the probability of an edge we have no
+	// information about. Edges that are less likely to succeed are
+	// penalized.
+	aprioriProbability float64
+
+	// attemptCost is the virtual cost of having to make another payment
+	// attempt, which is used to penalize edges that are likely to fail.
+	attemptCost lnwire.MilliSatoshi
 }
 
 // findPath attempts to find a path from the source node within the
@@ -440,14 +502,11 @@ func findPath(g *graphParams, r *restrictParams,
 	sourceNode *channeldb.LightningNode, target *btcec.PublicKey,
 	amt lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy, error) {
 
-	var err error
-	tx := g.tx
-	if tx == nil {
-		tx, err = g.graph.Database().Begin(false)
-		if err != nil {
-			return nil, err
-		}
-		defer tx.Rollback()
+	// A path from ourselves back to ourselves, as used to rebalance our
+	// channels, can't be found by the backwards search below, as it would
+	// terminate immediately at the target.
+	if Vertex(sourceNode.PubKeyBytes) == NewVertex(target) {
+		return findCircularPath(g, r, sourceNode, amt)
 	}
 
 	// First we'll initialize an empty heap which'll help us to quickly
@@ -456,14 +515,13 @@ func findPath(g *graphParams, r *restrictParams,
 	var nodeHeap distanceHeap
 
 	// For each node in the graph, we create an entry in the distance map
-	// for the node set with a distance of "infinity". graph.ForEachNode
-	// also returns the source node, so there is no need to add the source
+	// for the node set with a distance of "infinity". The graph cache also
+	// contains the source node, so there is no need to add the source
 	// node explicitly.
 	distance := make(map[Vertex]nodeWithDist)
-	if err := g.graph.ForEachNode(tx, func(_ *bbolt.Tx,
+	if err := g.graph.ForEachCachedNode(func(
 		node *channeldb.LightningNode) error {
-		// TODO(roasbeef): with larger graph can just use disk seeks
-		// with a visited map
+
 		distance[Vertex(node.PubKeyBytes)] = nodeWithDist{
 			dist: infinity,
 			node: node,
@@ -520,11 +578,15 @@ func findPath(g *graphParams, r *restrictParams,
 	// mapped to within `next`.
 	next := make(map[Vertex]*channeldb.ChannelEdgePolicy)
 
+	// We'll keep track of whether any edges were skipped solely due to
+	// the hop or CLTV limit, so that we can report why no path was found.
+	var hopLimitHit, cltvLimitHit bool
+
 	// processEdge is a helper closure that will be used to make sure edges
 	// satisfy our specific requirements.
 	processEdge := func(fromNode *channeldb.LightningNode,
 		edge *channeldb.ChannelEdgePolicy,
-		bandwidth lnwire.MilliSatoshi, toNode Vertex) {
+		bandwidth, capacity lnwire.MilliSatoshi, toNode Vertex) {
 
 		fromVertex := Vertex(fromNode.PubKeyBytes)
 
@@ -549,11 +611,22 @@ func findPath(g *graphParams, r *restrictParams,
 			return
 		}
 
+		// If we have a last hop restriction and this edge leads to the
+		// target from any other node, skip it.
+		if r.lastHop != nil && toNode == targetVertex &&
+			fromVertex != *r.lastHop {
+
+			return
+		}
+
 		// If this vertex or edge has been black listed, then we'll
 		// skip exploring this edge.
 		if _, ok := r.ignoredNodes[fromVertex]; ok {
 			return
 		}
+		if _, ok := g.blockedNodes[fromVertex]; ok {
+			return
+		}
 
 		locator := newEdgeLocator(edge)
 		if _, ok := r.ignoredEdges[*locator]; ok {
@@ -613,6 +686,36 @@ func findPath(g *graphParams, r *restrictParams,
 		// the HTLC that is handed out to fromNode.
 		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
 
+		// We'll also account for the probability that this edge fails
+		// to forward the payment, as estimated from its capacity and
+		// our record of past attempts to route through this pair of
+		// nodes.
+		if r.probabilitySource != nil {
+			prob := r.probabilitySource(
+				fromVertex, toNode, amountToSend, capacity,
+			)
+			weight += probabilityPenalty(
+				prob, r.aprioriProbability, r.attemptCost,
+			)
+		}
+
+		// The onion packet can only encode up to HopLimit hops, so
+		// we'll refrain from exploring paths any longer than that.
+		hops := toNodeDist.hops + 1
+		if hops > HopLimit {
+			hopLimitHit = true
+			return
+		}
+
+		// Also make sure that the time lock deltas charged along the
+		// path don't exceed the limit the caller is willing to have
+		// their funds locked up for.
+		incomingCltv := toNodeDist.incomingCltv + uint32(timeLockDelta)
+		if r.cltvLimit != nil && incomingCltv > *r.cltvLimit {
+			cltvLimitHit = true
+			return
+		}
+
 		// Compute the tentative distance to this new channel/edge
 		// which is the distance from our toNode to the target node
 		// plus the weight of this edge.
@@ -643,6 +746,8 @@ func findPath(g *graphParams, r *restrictParams,
 			node:            fromNode,
 			amountToReceive: amountToReceive,
 			fee:             fee,
+			incomingCltv:    incomingCltv,
+			hops:            hops,
 		}
 
 		next[fromVertex] = edge
@@ -674,11 +779,15 @@ func findPath(g *graphParams, r *restrictParams,
 
 		// Now that we've found the next potential step to take we'll
 		// examine all the incoming edges (channels) from this node to
-		// further our graph traversal.
+		// further our graph traversal. The graph cache also provides
+		// us with the node on the _other_ end of each channel, which
+		// we may later need to iterate over the incoming edges of if
+		// we explore it further.
 		pivot := Vertex(bestNode.PubKeyBytes)
-		err := bestNode.ForEachChannel(tx, func(tx *bbolt.Tx,
+		err := g.graph.ForEachCachedNodeChannel(pivot, func(
 			edgeInfo *channeldb.ChannelEdgeInfo,
-			_, inEdge *channeldb.ChannelEdgePolicy) error {
+			_, inEdge *channeldb.ChannelEdgePolicy,
+			channelSource *channeldb.LightningNode) error {
 
 			// If there is no edge policy for this candidate
 			// node, skip. Note that we are searching backwards
@@ -690,31 +799,28 @@ func findPath(g *graphParams, r *restrictParams,
 
 			// We'll query the lower layer to see if we can obtain
 			// any more up to date information concerning the
-			// bandwidth of this edge.
+			// bandwidth of this edge. If so, the capacity of the
+			// edge tells us nothing more about its probability of
+			// success, so we'll treat it as unknown.
+			capacity := lnwire.NewMSatFromSatoshis(
+				edgeInfo.Capacity,
+			)
 			edgeBandwidth, ok := g.bandwidthHints[edgeInfo.ChannelID]
-			if !ok {
+			if ok {
+				capacity = 0
+			} else {
 				// If we don't have a hint for this edge, then
 				// we'll just use the known Capacity as the
 				// available bandwidth.
-				edgeBandwidth = lnwire.NewMSatFromSatoshis(
-					edgeInfo.Capacity,
-				)
-			}
-
-			// Before we can process the edge, we'll need to fetch
-			// the node on the _other_ end of this channel as we
-			// may later need to iterate over the incoming edges of
-			// this node if we explore it further.
-			channelSource, err := edgeInfo.FetchOtherNode(
-				tx, pivot[:],
-			)
-			if err != nil {
-				return err
+				edgeBandwidth = capacity
 			}
 
 			// Check if this candidate node is better than what we
 			// already have.
-			processEdge(channelSource, inEdge, edgeBandwidth, pivot)
+			processEdge(
+				channelSource, inEdge, edgeBandwidth, capacity,
+				pivot,
+			)
 			return nil
 		})
 		if err != nil {
@@ -725,17 +831,30 @@ func findPath(g *graphParams, r *restrictParams,
 		// we're currently visiting. Since we don't know the capacity
 		// of the private channel, we'll assume it was selected as a
 		// routing hint due to having enough capacity for the payment
-		// and use the payment amount as its capacity.
+		// and use the payment amount as its bandwidth, while leaving
+		// its capacity unknown.
 		bandWidth := partialPath.amountToReceive
 		for _, reverseEdge := range additionalEdgesWithSrc[bestNode.PubKeyBytes] {
 			processEdge(reverseEdge.sourceNode, reverseEdge.edge,
-				bandWidth, pivot)
+				bandWidth, 0, pivot)
 		}
 	}
 
 	// If the source node isn't found in the next hop map, then a path
 	// doesn't exist, so we terminate in an error.
 	if _, ok := next[sourceVertex]; !ok {
+		switch {
+		case cltvLimitHit:
+			return nil, newErrf(ErrCltvLimitExceeded, "unable to "+
+				"find a path to destination within cltv limit "+
+				"of %v", *r.cltvLimit)
+
+		case hopLimitHit:
+			return nil, newErrf(ErrMaxHopsExceeded, "unable to "+
+				"find a path to destination within %v hops",
+				HopLimit)
+		}
+
 		return nil, newErrf(ErrNoPathFound, "unable to find a path to "+
 			"destination")
 	}
@@ -768,6 +887,139 @@ func findPath(g *graphParams, r *restrictParams,
 	return pathEdges, nil
 }
 
+// findCircularPath attempts to find a path from the source node back to
+// itself, capable of carrying a payment of `amt` value. Such a path must
+// return to the source node through the last hop of the passed restrictions,
+// so we complete the cheapest path to the last hop with one of the channels
+// the last hop has with the source node.
+func findCircularPath(g *graphParams, r *restrictParams,
+	sourceNode *channeldb.LightningNode,
+	amt lnwire.MilliSatoshi) ([]*channeldb.ChannelEdgePolicy, error) {
+
+	if r.lastHop == nil {
+		return nil, newErr(ErrNoPathFound, "a last hop is required "+
+			"to find a path to ourselves")
+	}
+	lastHop := *r.lastHop
+
+	lastHopKey, err := btcec.ParsePubKey(lastHop[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	// Gather all channels through which the last hop is able to forward
+	// the payment back to us.
+	var lastEdges []*channeldb.ChannelEdgePolicy
+	err = g.graph.ForEachCachedNodeChannel(sourceNode.PubKeyBytes, func(
+		edgeInfo *channeldb.ChannelEdgeInfo,
+		_, inEdge *channeldb.ChannelEdgePolicy,
+		_ *channeldb.LightningNode) error {
+
+		if inEdge == nil {
+			return nil
+		}
+
+		if edgeInfo.NodeKey1Bytes != lastHop &&
+			edgeInfo.NodeKey2Bytes != lastHop {
+
+			return nil
+		}
+
+		if r.incomingChannelID != nil &&
+			*r.incomingChannelID != edgeInfo.ChannelID {
+
+			return nil
+		}
+
+		if inEdge.ChannelFlags&lnwire.ChanUpdateDisabled != 0 {
+			return nil
+		}
+
+		if _, ok := r.ignoredEdges[*newEdgeLocator(inEdge)]; ok {
+			return nil
+		}
+
+		capacity := lnwire.NewMSatFromSatoshis(edgeInfo.Capacity)
+		if amt > capacity || amt < inEdge.MinHTLC {
+			return nil
+		}
+
+		lastEdges = append(lastEdges, inEdge)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Try the channels in order of the fee the last hop charges, and
+	// return the first complete path found.
+	sort.Slice(lastEdges, func(i, j int) bool {
+		return computeFee(amt, lastEdges[i]) <
+			computeFee(amt, lastEdges[j])
+	})
+
+	for _, lastEdge := range lastEdges {
+		fee := computeFee(amt, lastEdge)
+		if fee > r.feeLimit {
+			continue
+		}
+
+		// Paying out through the very channel the payment returns
+		// on would be pointless, so we'll ignore its other direction.
+		ignoredEdges := make(map[edgeLocator]struct{})
+		for edge := range r.ignoredEdges {
+			ignoredEdges[edge] = struct{}{}
+		}
+		outLocator := newEdgeLocator(lastEdge)
+		outLocator.direction ^= 1
+		ignoredEdges[*outLocator] = struct{}{}
+
+		// The last hop charges its time lock delta for forwarding back
+		// to us, leaving less room for the rest of the path.
+		var cltvLimit *uint32
+		if r.cltvLimit != nil {
+			delta := uint32(lastEdge.TimeLockDelta)
+			if delta > *r.cltvLimit {
+				continue
+			}
+
+			limit := *r.cltvLimit - delta
+			cltvLimit = &limit
+		}
+
+		path, err := findPath(
+			g, &restrictParams{
+				ignoredNodes:       r.ignoredNodes,
+				ignoredEdges:       ignoredEdges,
+				feeLimit:           r.feeLimit - fee,
+				outgoingChannelID:  r.outgoingChannelID,
+				cltvLimit:          cltvLimit,
+				probabilitySource:  r.probabilitySource,
+				aprioriProbability: r.aprioriProbability,
+				attemptCost:        r.attemptCost,
+			}, sourceNode, lastHopKey, amt+fee,
+		)
+		switch {
+		case IsError(err, ErrNoPathFound, ErrMaxHopsExceeded,
+			ErrCltvLimitExceeded):
+
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		path = append(path, lastEdge)
+		if len(path) > HopLimit {
+			continue
+		}
+
+		return path, nil
+	}
+
+	return nil, newErrf(ErrNoPathFound, "unable to find a path back to "+
+		"ourselves through %x", lastHop[:])
+}
+
 // findPaths implements a k-shortest paths algorithm to find all the reachable
 // paths between the passed source and target. The algorithm will continue to
 // traverse the graph until all possible candidate paths have been depleted.
@@ -778,14 +1030,11 @@ func findPath(g *graphParams, r *restrictParams,
 // will be ignored by our modified Dijkstra's algorithm. With this approach, we
 // make our inner path finding algorithm aware of our k-shortest paths
 // algorithm, rather than attempting to use an unmodified path finding
-// algorithm in a block box manner.
-func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
+// algorithm in a block box manner. The nodes and edges ignored by the passed
+// restrictions are excluded from all paths.
+func findPaths(g *graphParams, r *restrictParams,
 	source *channeldb.LightningNode, target *btcec.PublicKey,
-	amt lnwire.MilliSatoshi, feeLimit lnwire.MilliSatoshi, numPaths uint32,
-	bandwidthHints map[uint64]lnwire.MilliSatoshi) ([][]*channeldb.ChannelEdgePolicy, error) {
-
-	ignoredEdges := make(map[edgeLocator]struct{})
-	ignoredVertexes := make(map[Vertex]struct{})
+	amt lnwire.MilliSatoshi, numPaths uint32) ([][]*channeldb.ChannelEdgePolicy, error) {
 
 	// TODO(roasbeef): modifying ordering within heap to eliminate final
 	// sorting step?
@@ -797,19 +1046,7 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 	// First we'll find a single shortest path from the source (our
 	// selfNode) to the target destination that's capable of carrying amt
 	// satoshis along the path before fees are calculated.
-	startingPath, err := findPath(
-		&graphParams{
-			tx:             tx,
-			graph:          graph,
-			bandwidthHints: bandwidthHints,
-		},
-		&restrictParams{
-			ignoredNodes: ignoredVertexes,
-			ignoredEdges: ignoredEdges,
-			feeLimit:     feeLimit,
-		},
-		source, target, amt,
-	)
+	startingPath, err := findPath(g, r, source, target, amt)
 	if err != nil {
 		log.Errorf("Unable to find path: %v", err)
 		return nil, err
@@ -838,9 +1075,16 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 			// These two maps will mark the edges and Vertexes
 			// we'll exclude from the next path finding attempt.
 			// These are required to ensure the paths are unique
-			// and loopless.
-			ignoredEdges = make(map[edgeLocator]struct{})
-			ignoredVertexes = make(map[Vertex]struct{})
+			// and loopless, and start out with the ones the caller
+			// wishes to exclude.
+			ignoredEdges := make(map[edgeLocator]struct{})
+			for edge := range r.ignoredEdges {
+				ignoredEdges[edge] = struct{}{}
+			}
+			ignoredVertexes := make(map[Vertex]struct{})
+			for vertex := range r.ignoredNodes {
+				ignoredVertexes[vertex] = struct{}{}
+			}
 
 			// Our spur node is the i-th node in the prior shortest
 			// path, and our root path will be all nodes in the
@@ -881,27 +1125,44 @@ func findPaths(tx *bbolt.Tx, graph *channeldb.ChannelGraph,
 			// the Vertexes (other than the spur path) within the
 			// root path removed, we'll attempt to find another
 			// shortest path from the spur node to the destination.
+			// The outgoing channel restriction only applies if
+			// we're deviating from the path at our own node, as
+			// the root path already contains the first hop
+			// otherwise.
+			var outgoingChannelID *uint64
+			if i == 0 {
+				outgoingChannelID = r.outgoingChannelID
+			}
+
 			spurPath, err := findPath(
-				&graphParams{
-					tx:             tx,
-					graph:          graph,
-					bandwidthHints: bandwidthHints,
-				},
-				&restrictParams{
-					ignoredNodes: ignoredVertexes,
-					ignoredEdges: ignoredEdges,
-					feeLimit:     feeLimit,
+				g, &restrictParams{
+					ignoredNodes:      ignoredVertexes,
+					ignoredEdges:      ignoredEdges,
+					feeLimit:          r.feeLimit,
+					outgoingChannelID: outgoingChannelID,
+					lastHop:           r.lastHop,
+					cltvLimit:         r.cltvLimit,
 				}, spurNode, target, amt,
 			)
 
 			// If we weren't able to find a path, we'll continue to
 			// the next round.
-			if IsError(err, ErrNoPathFound) {
+			if IsError(err, ErrNoPathFound, ErrMaxHopsExceeded,
+				ErrCltvLimitExceeded) {
+
 				continue
 			} else if err != nil {
 				return nil, err
 			}
 
+			// The spur path was found within the hop limit, but
+			// together with the root path it may exceed it. The
+			// root path starts with the self edge, which isn't a
+			// hop of its own.
+			if len(rootPath)-1+len(spurPath) > HopLimit {
+				continue
+			}
+
 			// Create the new combined path by concatenating the
 			// rootPath to the spurPath.
 			newPathLen := len(rootPath) + len(spurPath)
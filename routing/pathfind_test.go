@@ -944,8 +944,13 @@ func TestKShortestPathFinding(t *testing.T) {
 	paymentAmt := lnwire.NewMSatFromSatoshis(100)
 	target := graph.aliasMap["luoji"]
 	paths, err := findPaths(
-		nil, graph.graph, sourceNode, target, paymentAmt, noFeeLimit, 100,
-		nil,
+		&graphParams{
+			graph: graph.graph,
+		},
+		&restrictParams{
+			feeLimit: noFeeLimit,
+		},
+		sourceNode, target, paymentAmt, 100,
 	)
 	if err != nil {
 		t.Fatalf("unable to find paths between roasbeef and "+
@@ -2010,3 +2015,378 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 			"but channel %v was selected instead", route.Hops[0].ChannelID)
 	}
 }
+
+// TestRestrictLastHop asserts that a last hop restriction is obeyed by the
+// path finding algorithm, also when finding a circular path back to the
+// source node.
+func TestRestrictLastHop(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which the path from roasbeef to target through
+	// b is more expensive than the path through a.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 800,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	lastHop := NewVertex(testGraphInstance.aliasMap["b"])
+
+	findRestrictedPath := func(target *btcec.PublicKey,
+		outgoingChannelID *uint64) ([]*channeldb.ChannelEdgePolicy,
+		error) {
+
+		ignoredEdges := make(map[edgeLocator]struct{})
+		ignoredVertexes := make(map[Vertex]struct{})
+
+		return findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&restrictParams{
+				ignoredNodes:      ignoredVertexes,
+				ignoredEdges:      ignoredEdges,
+				feeLimit:          noFeeLimit,
+				outgoingChannelID: outgoingChannelID,
+				lastHop:           &lastHop,
+			},
+			sourceNode, target, paymentAmt,
+		)
+	}
+
+	assertChannels := func(path []*channeldb.ChannelEdgePolicy,
+		expected ...uint64) {
+
+		t.Helper()
+
+		if len(path) != len(expected) {
+			t.Fatalf("expected path of length %v, got %v",
+				len(expected), len(path))
+		}
+		for i, edge := range path {
+			if edge.ChannelID != expected[i] {
+				t.Fatalf("expected channel %v at hop %v, "+
+					"got %v", expected[i], i,
+					edge.ChannelID)
+			}
+		}
+	}
+
+	// The path to target should pass through b, even though the path
+	// through a is cheaper.
+	path, err := findRestrictedPath(
+		testGraphInstance.aliasMap["target"], nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertChannels(path, 3, 4)
+
+	// A circular path leaving through a should return through b.
+	outgoingChannelID := uint64(1)
+	path, err = findRestrictedPath(
+		testGraphInstance.aliasMap["roasbeef"], &outgoingChannelID,
+	)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	assertChannels(path, 1, 2, 4, 3)
+
+	// Without a last hop, no circular path can be found.
+	_, err = findPath(
+		&graphParams{
+			graph: testGraphInstance.graph,
+		},
+		&restrictParams{
+			ignoredNodes: make(map[Vertex]struct{}),
+			ignoredEdges: make(map[edgeLocator]struct{}),
+			feeLimit:     noFeeLimit,
+		},
+		sourceNode, testGraphInstance.aliasMap["roasbeef"], paymentAmt,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected no path to be found, got: %v", err)
+	}
+}
+
+// TestCltvLimit asserts that the path finding algorithm only returns paths
+// whose time lock deltas are within the given CLTV limit.
+func TestCltvLimit(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which the path from roasbeef to target
+	// through a is cheaper, while the path through b has a lower time
+	// lock delta.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  40,
+			FeeRate: 600,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  40,
+			FeeRate: 600,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := testGraphInstance.aliasMap["target"]
+
+	findLimitedPath := func(cltvLimit *uint32) (
+		[]*channeldb.ChannelEdgePolicy, error) {
+
+		return findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&restrictParams{
+				ignoredNodes: make(map[Vertex]struct{}),
+				ignoredEdges: make(map[edgeLocator]struct{}),
+				feeLimit:     noFeeLimit,
+				cltvLimit:    cltvLimit,
+			},
+			sourceNode, target, paymentAmt,
+		)
+	}
+
+	// Without a limit, the cheaper path through a should be taken.
+	path, err := findLimitedPath(nil)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if path[0].ChannelID != 1 {
+		t.Fatalf("expected path through a, got channel %v",
+			path[0].ChannelID)
+	}
+
+	// With a limit below the time lock delta of a, the path through b
+	// should be taken instead.
+	cltvLimit := uint32(100)
+	path, err = findLimitedPath(&cltvLimit)
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if path[0].ChannelID != 3 {
+		t.Fatalf("expected path through b, got channel %v",
+			path[0].ChannelID)
+	}
+
+	// A limit below the time lock delta of both paths should result in
+	// no path being found.
+	cltvLimit = 39
+	_, err = findLimitedPath(&cltvLimit)
+	if !IsError(err, ErrCltvLimitExceeded) {
+		t.Fatalf("expected ErrCltvLimitExceeded, got: %v", err)
+	}
+}
+
+// TestHopLimit asserts that the path finding algorithm doesn't return paths
+// that exceed the number of hops an onion packet is able to encode.
+func TestHopLimit(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which the nodes form a single chain that
+	// extends one hop beyond the hop limit.
+	var testChannels []*testChannel
+	prev := "roasbeef"
+	for i := 1; i <= HopLimit+1; i++ {
+		next := fmt.Sprintf("node%v", i)
+		testChannels = append(testChannels, symmetricTestChannel(
+			prev, next, 100000, &testChannelPolicy{
+				Expiry:  144,
+				FeeRate: 400,
+				MinHTLC: 1,
+			},
+		))
+		prev = next
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	findPathTo := func(alias string) ([]*channeldb.ChannelEdgePolicy,
+		error) {
+
+		return findPath(
+			&graphParams{
+				graph: testGraphInstance.graph,
+			},
+			&restrictParams{
+				ignoredNodes: make(map[Vertex]struct{}),
+				ignoredEdges: make(map[edgeLocator]struct{}),
+				feeLimit:     noFeeLimit,
+			},
+			sourceNode, testGraphInstance.aliasMap[alias],
+			paymentAmt,
+		)
+	}
+
+	// A node at exactly the hop limit should be reachable.
+	path, err := findPathTo(fmt.Sprintf("node%v", HopLimit))
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if len(path) != HopLimit {
+		t.Fatalf("expected path of %v hops, got %v", HopLimit,
+			len(path))
+	}
+
+	// The node beyond it shouldn't be.
+	_, err = findPathTo(fmt.Sprintf("node%v", HopLimit+1))
+	if !IsError(err, ErrMaxHopsExceeded) {
+		t.Fatalf("expected ErrMaxHopsExceeded, got: %v", err)
+	}
+}
+
+// TestBlockedNodes asserts that the path finding algorithm never returns paths
+// that are routed through a blocked node, while still allowing the blocked
+// node to be the target of a path.
+func TestBlockedNodes(t *testing.T) {
+	t.Parallel()
+
+	// Set up a test graph in which the path from roasbeef to target
+	// through a is cheaper than the one through b.
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "a", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 1),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel("roasbeef", "b", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 600,
+			MinHTLC: 1,
+		}, 3),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 600,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	sourceNode, err := testGraphInstance.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	findBlockedPath := func(target string, blocked ...string) (
+		[]*channeldb.ChannelEdgePolicy, error) {
+
+		blockedNodes := make(map[Vertex]struct{})
+		for _, alias := range blocked {
+			node := NewVertex(testGraphInstance.aliasMap[alias])
+			blockedNodes[node] = struct{}{}
+		}
+
+		return findPath(
+			&graphParams{
+				graph:        testGraphInstance.graph,
+				blockedNodes: blockedNodes,
+			},
+			&restrictParams{
+				ignoredNodes: make(map[Vertex]struct{}),
+				ignoredEdges: make(map[edgeLocator]struct{}),
+				feeLimit:     noFeeLimit,
+			},
+			sourceNode, testGraphInstance.aliasMap[target],
+			paymentAmt,
+		)
+	}
+
+	// With a blocked, the more expensive path through b should be taken.
+	path, err := findBlockedPath("target", "a")
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if path[0].ChannelID != 3 {
+		t.Fatalf("expected path through b, got channel %v",
+			path[0].ChannelID)
+	}
+
+	// With both a and b blocked, the target can't be reached.
+	_, err = findBlockedPath("target", "a", "b")
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected ErrNoPathFound, got: %v", err)
+	}
+
+	// A blocked node itself can still be paid directly.
+	path, err = findBlockedPath("a", "a")
+	if err != nil {
+		t.Fatalf("unable to find path: %v", err)
+	}
+	if len(path) != 1 || path[0].ChannelID != 1 {
+		t.Fatalf("expected direct path to a")
+	}
+}
@@ -132,6 +132,11 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 	// during the duration of this payment session, never shrinking.
 	pruneView := p.pruneViewSnapshot
 
+	cltvLimit, err := pathCltvLimit(payment.CltvLimit, finalCltvDelta)
+	if err != nil {
+		return nil, err
+	}
+
 	log.Debugf("Mission Control session using prune view of %v "+
 		"edges, %v vertexes", len(pruneView.edges),
 		len(pruneView.vertexes))
@@ -146,12 +151,19 @@ func (p *paymentSession) RequestRoute(payment *LightningPayment,
 			graph:           p.mc.graph,
 			additionalEdges: p.additionalEdges,
 			bandwidthHints:  p.bandwidthHints,
+			blockedNodes:    p.mc.blockedNodes,
 		},
 		&restrictParams{
-			ignoredNodes:      pruneView.vertexes,
-			ignoredEdges:      pruneView.edges,
-			feeLimit:          payment.FeeLimit,
-			outgoingChannelID: payment.OutgoingChannelID,
+			ignoredNodes:       pruneView.vertexes,
+			ignoredEdges:       pruneView.edges,
+			feeLimit:           payment.FeeLimit,
+			outgoingChannelID:  payment.OutgoingChannelID,
+			lastHop:            payment.LastHop,
+			incomingChannelID:  payment.IncomingChannelID,
+			cltvLimit:          cltvLimit,
+			probabilitySource:  p.mc.getPairProbability,
+			aprioriProbability: p.mc.estimator.aprioriProbability(),
+			attemptCost:        p.mc.cfg.AttemptCost,
 		},
 		p.mc.selfNode, payment.Target, payment.Amount,
 	)
@@ -0,0 +1,509 @@
+package routing
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// routerPaymentsBucket is a key used to create a top level bucket in
+	// the channel database, used to persist the payments dispatched
+	// through SendPaymentAsync along with their HTLC attempts, so that
+	// in-flight payments can be resumed after a restart.
+	//
+	// maps:
+	//   paymentHash (32 bytes) -> PaymentInfo
+	routerPaymentsBucket = []byte("router-payments")
+)
+
+// AttemptFailure describes why an HTLC attempt of a payment failed.
+type AttemptFailure struct {
+	// Source is the node that reported the failure. It is only set if
+	// Message is non-nil.
+	Source Vertex
+
+	// Message is the failure message reported by Source. It is nil if the
+	// HTLC failed without a node along the route reporting it, for
+	// example because it couldn't be handed off to the switch.
+	Message lnwire.FailureMessage
+
+	// Reason is a human readable description of the failure.
+	Reason string
+}
+
+// newAttemptFailure creates an AttemptFailure from the error returned for an
+// HTLC attempt.
+func newAttemptFailure(err error) *AttemptFailure {
+	failure := &AttemptFailure{
+		Reason: err.Error(),
+	}
+
+	if fErr, ok := err.(*htlcswitch.ForwardingError); ok {
+		failure.Source = NewVertex(fErr.ErrorSource)
+		failure.Message = fErr.FailureMessage
+	}
+
+	return failure
+}
+
+// PaymentAttempt describes a single HTLC dispatched for a payment.
+type PaymentAttempt struct {
+	// Route is the route the HTLC was sent along.
+	Route *Route
+
+	// SessionKey is the ephemeral key of the HTLC's onion packet, which
+	// is required to decrypt any failure returned by the route.
+	SessionKey *btcec.PrivateKey
+
+	// AttemptTime is the time at which the HTLC was dispatched.
+	AttemptTime time.Time
+
+	// ResolveTime is the time at which the HTLC was settled or failed. It
+	// is the zero time while the HTLC is in flight.
+	ResolveTime time.Time
+
+	// Failure is the reason the HTLC failed, if it did.
+	Failure *AttemptFailure
+}
+
+// PaymentInfo describes a payment dispatched through SendPaymentAsync, along
+// with all HTLCs that have been attempted for it.
+type PaymentInfo struct {
+	// PaymentHash is the hash of the payment.
+	PaymentHash [32]byte
+
+	// Value is the amount sent to the destination, excluding fees.
+	Value lnwire.MilliSatoshi
+
+	// CreationTime is the time at which the payment was initiated.
+	CreationTime time.Time
+
+	// State is the state of the payment.
+	State PaymentState
+
+	// Attempts are the HTLCs that have been dispatched for the payment,
+	// in the order they were dispatched.
+	Attempts []*PaymentAttempt
+
+	// Preimage is the preimage of the payment hash, if the payment
+	// succeeded. It is unset if the preimage couldn't be retrieved.
+	Preimage [32]byte
+
+	// FailureReason is the reason the payment failed, if it did.
+	FailureReason string
+}
+
+// copy returns a copy of the payment, such that it can be handed out to
+// subscribers while the payment progresses.
+func (p *PaymentInfo) copy() *PaymentInfo {
+	c := *p
+	c.Attempts = make([]*PaymentAttempt, len(p.Attempts))
+	for i, attempt := range p.Attempts {
+		a := *attempt
+		c.Attempts[i] = &a
+	}
+
+	return &c
+}
+
+// result returns the PaymentResult corresponding to the payment.
+func (p *PaymentInfo) result() *PaymentResult {
+	result := &PaymentResult{
+		State:    p.State,
+		Preimage: p.Preimage,
+	}
+
+	switch p.State {
+	case PaymentSucceeded:
+		if len(p.Attempts) > 0 {
+			result.Route = p.Attempts[len(p.Attempts)-1].Route
+		}
+
+	case PaymentFailed:
+		result.Err = errors.New(p.FailureReason)
+	}
+
+	return result
+}
+
+// paymentStore persists the payments dispatched through SendPaymentAsync
+// within the channel database.
+type paymentStore struct {
+	db *channeldb.DB
+}
+
+// newPaymentStore creates a new payment store backed by the passed channel
+// database.
+func newPaymentStore(db *channeldb.DB) (*paymentStore, error) {
+	err := db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(routerPaymentsBucket)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create required buckets: %v",
+			err)
+	}
+
+	return &paymentStore{db: db}, nil
+}
+
+// storePayment persists the given payment, overwriting any payment
+// previously stored for the same payment hash.
+func (s *paymentStore) storePayment(payment *PaymentInfo) error {
+	var b bytes.Buffer
+	if err := serializePaymentInfo(&b, payment); err != nil {
+		return err
+	}
+
+	return s.db.Batch(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(routerPaymentsBucket)
+		return bucket.Put(payment.PaymentHash[:], b.Bytes())
+	})
+}
+
+// fetchPayment returns the payment stored for the given payment hash. If no
+// payment is stored, ErrPaymentNotFound is returned.
+func (s *paymentStore) fetchPayment(paymentHash [32]byte) (*PaymentInfo,
+	error) {
+
+	var payment *PaymentInfo
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(routerPaymentsBucket)
+
+		v := bucket.Get(paymentHash[:])
+		if v == nil {
+			return ErrPaymentNotFound
+		}
+
+		var err error
+		payment, err = deserializePaymentInfo(bytes.NewReader(v))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// fetchInFlightPayments returns all stored payments that are still in flight.
+func (s *paymentStore) fetchInFlightPayments() ([]*PaymentInfo, error) {
+	var payments []*PaymentInfo
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(routerPaymentsBucket)
+
+		return bucket.ForEach(func(k, v []byte) error {
+			payment, err := deserializePaymentInfo(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			if payment.State == PaymentInFlight {
+				payments = append(payments, payment)
+			}
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payments, nil
+}
+
+// prunePayments removes all concluded payments that were created before the
+// given time.
+func (s *paymentStore) prunePayments(createdBefore time.Time) error {
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		bucket := tx.Bucket(routerPaymentsBucket)
+
+		var toPrune [][]byte
+		err := bucket.ForEach(func(k, v []byte) error {
+			payment, err := deserializePaymentInfo(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			if payment.State != PaymentInFlight &&
+				payment.CreationTime.Before(createdBefore) {
+
+				toPrune = append(toPrune, k)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, k := range toPrune {
+			if err := bucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// serializeTime serializes a time as the number of nanoseconds since the unix
+// epoch, where the zero time is serialized as zero.
+func serializeTime(w io.Writer, t time.Time) error {
+	var nanos uint64
+	if !t.IsZero() {
+		nanos = uint64(t.UnixNano())
+	}
+
+	return channeldb.WriteElement(w, nanos)
+}
+
+// deserializeTime deserializes a time serialized by serializeTime.
+func deserializeTime(r io.Reader) (time.Time, error) {
+	var nanos uint64
+	if err := channeldb.ReadElement(r, &nanos); err != nil {
+		return time.Time{}, err
+	}
+
+	if nanos == 0 {
+		return time.Time{}, nil
+	}
+
+	return time.Unix(0, int64(nanos)), nil
+}
+
+// serializePaymentInfo serializes the given payment.
+func serializePaymentInfo(w io.Writer, p *PaymentInfo) error {
+	err := channeldb.WriteElements(
+		w, p.PaymentHash, p.Value, uint32(p.State), p.Preimage,
+		[]byte(p.FailureReason),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := serializeTime(w, p.CreationTime); err != nil {
+		return err
+	}
+
+	err = channeldb.WriteElement(w, uint32(len(p.Attempts)))
+	if err != nil {
+		return err
+	}
+	for _, attempt := range p.Attempts {
+		if err := serializePaymentAttempt(w, attempt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializePaymentInfo deserializes a payment serialized by
+// serializePaymentInfo.
+func deserializePaymentInfo(r io.Reader) (*PaymentInfo, error) {
+	p := &PaymentInfo{}
+
+	var (
+		state         uint32
+		failureReason []byte
+	)
+	err := channeldb.ReadElements(
+		r, &p.PaymentHash, &p.Value, &state, &p.Preimage,
+		&failureReason,
+	)
+	if err != nil {
+		return nil, err
+	}
+	p.State = PaymentState(state)
+	p.FailureReason = string(failureReason)
+
+	p.CreationTime, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var numAttempts uint32
+	if err := channeldb.ReadElement(r, &numAttempts); err != nil {
+		return nil, err
+	}
+	for i := uint32(0); i < numAttempts; i++ {
+		attempt, err := deserializePaymentAttempt(r)
+		if err != nil {
+			return nil, err
+		}
+		p.Attempts = append(p.Attempts, attempt)
+	}
+
+	return p, nil
+}
+
+// serializePaymentAttempt serializes the given HTLC attempt.
+func serializePaymentAttempt(w io.Writer, a *PaymentAttempt) error {
+	if err := serializeRoute(w, a.Route); err != nil {
+		return err
+	}
+
+	var sessionKey [32]byte
+	copy(sessionKey[:], a.SessionKey.Serialize())
+	if err := channeldb.WriteElement(w, sessionKey); err != nil {
+		return err
+	}
+
+	if err := serializeTime(w, a.AttemptTime); err != nil {
+		return err
+	}
+	if err := serializeTime(w, a.ResolveTime); err != nil {
+		return err
+	}
+
+	if err := channeldb.WriteElement(w, a.Failure != nil); err != nil {
+		return err
+	}
+	if a.Failure == nil {
+		return nil
+	}
+
+	// The failure message is encoded as is, with an empty encoding
+	// indicating that no node along the route reported the failure.
+	var msg bytes.Buffer
+	if a.Failure.Message != nil {
+		err := lnwire.EncodeFailure(&msg, a.Failure.Message, 0)
+		if err != nil {
+			return err
+		}
+	}
+
+	return channeldb.WriteElements(
+		w, a.Failure.Source[:], msg.Bytes(), []byte(a.Failure.Reason),
+	)
+}
+
+// deserializePaymentAttempt deserializes an HTLC attempt serialized by
+// serializePaymentAttempt.
+func deserializePaymentAttempt(r io.Reader) (*PaymentAttempt, error) {
+	a := &PaymentAttempt{}
+
+	var err error
+	a.Route, err = deserializeRoute(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var sessionKey [32]byte
+	if err := channeldb.ReadElement(r, &sessionKey); err != nil {
+		return nil, err
+	}
+	a.SessionKey, _ = btcec.PrivKeyFromBytes(btcec.S256(), sessionKey[:])
+
+	a.AttemptTime, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+	a.ResolveTime, err = deserializeTime(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var hasFailure bool
+	if err := channeldb.ReadElement(r, &hasFailure); err != nil {
+		return nil, err
+	}
+	if !hasFailure {
+		return a, nil
+	}
+
+	var source, msg, reason []byte
+	err = channeldb.ReadElements(r, &source, &msg, &reason)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Failure = &AttemptFailure{
+		Reason: string(reason),
+	}
+	copy(a.Failure.Source[:], source)
+
+	if len(msg) != 0 {
+		a.Failure.Message, err = lnwire.DecodeFailure(
+			bytes.NewReader(msg), 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a, nil
+}
+
+// serializeRoute serializes the given route.
+func serializeRoute(w io.Writer, route *Route) error {
+	err := channeldb.WriteElements(
+		w, route.TotalTimeLock, route.TotalFees, route.TotalAmount,
+		route.SourcePubKey[:], uint32(len(route.Hops)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, hop := range route.Hops {
+		err := channeldb.WriteElements(
+			w, hop.PubKeyBytes[:], hop.ChannelID,
+			hop.OutgoingTimeLock, hop.AmtToForward,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeRoute deserializes a route serialized by serializeRoute.
+func deserializeRoute(r io.Reader) (*Route, error) {
+	route := &Route{}
+
+	var (
+		sourcePubKey []byte
+		numHops      uint32
+	)
+	err := channeldb.ReadElements(
+		r, &route.TotalTimeLock, &route.TotalFees, &route.TotalAmount,
+		&sourcePubKey, &numHops,
+	)
+	if err != nil {
+		return nil, err
+	}
+	copy(route.SourcePubKey[:], sourcePubKey)
+
+	for i := uint32(0); i < numHops; i++ {
+		hop := &Hop{}
+
+		var pubKey []byte
+		err := channeldb.ReadElements(
+			r, &pubKey, &hop.ChannelID, &hop.OutgoingTimeLock,
+			&hop.AmtToForward,
+		)
+		if err != nil {
+			return nil, err
+		}
+		copy(hop.PubKeyBytes[:], pubKey)
+
+		route.Hops = append(route.Hops, hop)
+	}
+
+	return route, nil
+}
@@ -0,0 +1,719 @@
+package routing
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+)
+
+const (
+	// paymentResultRetention is the duration for which a concluded
+	// asynchronous payment is retained in memory. Afterwards, its result
+	// can still be retrieved from the payment store.
+	paymentResultRetention = time.Hour
+
+	// paymentRecordRetention is the duration for which a concluded
+	// asynchronous payment is retained in the payment store. Payments
+	// created before are removed from the store on startup.
+	paymentRecordRetention = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrPaymentNotFound is returned when the result of a payment is
+	// requested for which we have no record.
+	ErrPaymentNotFound = errors.New("payment not found")
+)
+
+// PaymentState describes the state of a payment dispatched by the router.
+type PaymentState uint8
+
+const (
+	// PaymentInFlight indicates that the payment has been dispatched, but
+	// that its outcome isn't known yet.
+	PaymentInFlight PaymentState = iota
+
+	// PaymentSucceeded indicates that the payment was settled by the
+	// destination.
+	PaymentSucceeded
+
+	// PaymentFailed indicates that the payment couldn't be completed.
+	PaymentFailed
+)
+
+// String returns a human readable representation of the payment state.
+func (s PaymentState) String() string {
+	switch s {
+	case PaymentInFlight:
+		return "InFlight"
+
+	case PaymentSucceeded:
+		return "Succeeded"
+
+	case PaymentFailed:
+		return "Failed"
+
+	default:
+		return fmt.Sprintf("Unknown(%d)", uint8(s))
+	}
+}
+
+// PaymentResult describes the state of a payment dispatched by the router,
+// along with its outcome once it has concluded.
+type PaymentResult struct {
+	// State is the state of the payment.
+	State PaymentState
+
+	// Preimage is the preimage of the payment hash, which is only known if
+	// the payment succeeded. It may be unset for payments that succeeded
+	// before the router was started.
+	Preimage [32]byte
+
+	// Route is the route the successful payment traversed, if known.
+	Route *Route
+
+	// Err is the reason the payment failed, if it did.
+	Err error
+}
+
+// trackedPayment couples an asynchronous payment with a channel that is closed
+// once the payment has concluded.
+type trackedPayment struct {
+	// info is the current state of the payment, as persisted in the
+	// payment store. It must only be accessed while holding the tracker's
+	// mutex.
+	info *PaymentInfo
+
+	// subscribers are signaled whenever the payment's info changes.
+	subscribers map[uint64]chan struct{}
+
+	// result is the final result of the payment. It must only be read
+	// once the done channel has been closed.
+	result *PaymentResult
+
+	// concluded is the time at which the payment concluded.
+	concluded time.Time
+
+	done chan struct{}
+}
+
+// paymentTracker keeps track of the payments that have been dispatched
+// asynchronously by the router, and persists their progress in the payment
+// store. Concluded payments are retained in memory for
+// paymentResultRetention.
+type paymentTracker struct {
+	store *paymentStore
+
+	payments map[[32]byte]*trackedPayment
+
+	nextSubscriberID uint64
+
+	mu sync.Mutex
+}
+
+// newPaymentTracker creates a new, empty paymentTracker backed by the passed
+// payment store.
+func newPaymentTracker(store *paymentStore) *paymentTracker {
+	return &paymentTracker{
+		store:    store,
+		payments: make(map[[32]byte]*trackedPayment),
+	}
+}
+
+// add starts tracking the given payment, and persists it as in flight. An
+// error is returned if a payment to the same hash is still in flight.
+func (p *paymentTracker) add(info *PaymentInfo) (*trackedPayment, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	// We'll take this opportunity to prune any payments that concluded a
+	// while ago.
+	now := time.Now()
+	for hash, payment := range p.payments {
+		select {
+		case <-payment.done:
+			if now.Sub(payment.concluded) > paymentResultRetention {
+				delete(p.payments, hash)
+			}
+		default:
+		}
+	}
+
+	if payment, ok := p.payments[info.PaymentHash]; ok {
+		select {
+		case <-payment.done:
+		default:
+			return nil, htlcswitch.ErrPaymentInFlight
+		}
+	}
+
+	if err := p.store.storePayment(info); err != nil {
+		return nil, err
+	}
+
+	payment := &trackedPayment{
+		info:        info,
+		subscribers: make(map[uint64]chan struct{}),
+		done:        make(chan struct{}),
+	}
+	p.payments[info.PaymentHash] = payment
+
+	return payment, nil
+}
+
+// update applies the passed modification to the info of a tracked payment,
+// persists the result and notifies all subscribers of the payment.
+func (p *paymentTracker) update(payment *trackedPayment,
+	modify func(info *PaymentInfo)) error {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.updateLocked(payment, modify)
+}
+
+// updateLocked is identical to update, but expects the tracker's mutex to be
+// held by the caller.
+func (p *paymentTracker) updateLocked(payment *trackedPayment,
+	modify func(info *PaymentInfo)) error {
+
+	info := payment.info.copy()
+	modify(info)
+
+	if err := p.store.storePayment(info); err != nil {
+		return err
+	}
+	payment.info = info
+
+	for _, signal := range payment.subscribers {
+		select {
+		case signal <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
+// conclude records the final result of a tracked payment.
+func (p *paymentTracker) conclude(payment *trackedPayment,
+	result *PaymentResult) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	err := p.updateLocked(payment, func(info *PaymentInfo) {
+		info.State = result.State
+		info.Preimage = result.Preimage
+		if result.Err != nil {
+			info.FailureReason = result.Err.Error()
+		}
+
+		// The HTLC that settled the payment is resolved as well.
+		if result.State == PaymentSucceeded && len(info.Attempts) > 0 {
+			last := info.Attempts[len(info.Attempts)-1]
+			if last.ResolveTime.IsZero() {
+				last.ResolveTime = time.Now()
+			}
+		}
+	})
+	if err != nil {
+		log.Errorf("Unable to persist result of payment %x: %v",
+			payment.info.PaymentHash, err)
+	}
+
+	payment.result = result
+	payment.concluded = time.Now()
+	close(payment.done)
+}
+
+// lookup returns the tracked payment to the given payment hash, if any.
+func (p *paymentTracker) lookup(paymentHash [32]byte) (*trackedPayment, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	payment, ok := p.payments[paymentHash]
+	return payment, ok
+}
+
+// subscribe registers a new subscriber of the passed payment. It returns a
+// channel that is signaled whenever the payment's info changes, along with a
+// closure to cancel the subscription.
+func (p *paymentTracker) subscribe(
+	payment *trackedPayment) (<-chan struct{}, func()) {
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	id := p.nextSubscriberID
+	p.nextSubscriberID++
+
+	signal := make(chan struct{}, 1)
+	payment.subscribers[id] = signal
+
+	cancel := func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+
+		delete(payment.subscribers, id)
+	}
+
+	return signal, cancel
+}
+
+// snapshot returns a copy of the current info of a tracked payment.
+func (p *paymentTracker) snapshot(payment *trackedPayment) *PaymentInfo {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return payment.info.copy()
+}
+
+// SendPaymentAsync dispatches a payment as described within the passed
+// LightningPayment, without waiting for its outcome. In contrast to
+// SendPayment, this method returns as soon as the payment has been persisted
+// as in flight and its first HTLC has been handed off to the switch, or once
+// the payment failed before an HTLC could be dispatched. The outcome of the
+// payment can be retrieved through TrackPayment, while its progress can be
+// followed through SubscribePayment. If we restart while the payment is in
+// flight, then the result of its HTLC is awaited on startup.
+func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
+	paySession, err := r.missionControl.NewPaymentSession(
+		payment.RouteHints, payment.Target,
+	)
+	if err != nil {
+		return err
+	}
+
+	tracked, err := r.payments.add(&PaymentInfo{
+		PaymentHash:  payment.PaymentHash,
+		Value:        payment.Amount,
+		CreationTime: time.Now(),
+		State:        PaymentInFlight,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The switch notifies us of every HTLC of the payment it dispatches,
+	// though we're only interested in the first one.
+	dispatched := make(chan struct{})
+	var dispatchOnce sync.Once
+
+	// We'll persist every HTLC attempt before it is handed off to the
+	// switch, so that we're able to retrieve its result after a restart.
+	hooks := &attemptHooks{
+		onAttempt: func(route *Route, circuit *sphinx.Circuit) error {
+			attempt := &PaymentAttempt{
+				Route:       route,
+				SessionKey:  circuit.SessionKey,
+				AttemptTime: time.Now(),
+			}
+
+			return r.payments.update(tracked, func(p *PaymentInfo) {
+				p.Attempts = append(p.Attempts, attempt)
+			})
+		},
+		onDispatch: func() {
+			dispatchOnce.Do(func() {
+				close(dispatched)
+			})
+		},
+		onFailure: func(sendErr error) {
+			// HTLCs that fail as we're shutting down are left in
+			// flight, and awaited again once we restart.
+			if r.shuttingDown() {
+				return
+			}
+
+			err := r.payments.update(tracked, func(p *PaymentInfo) {
+				last := p.Attempts[len(p.Attempts)-1]
+				last.ResolveTime = time.Now()
+				last.Failure = newAttemptFailure(sendErr)
+			})
+			if err != nil {
+				log.Errorf("Unable to persist failed attempt "+
+					"of payment %x: %v", payment.PaymentHash,
+					err)
+			}
+		},
+	}
+
+	// The payment is sent from a goroutine tracked by the router's wait
+	// group, such that it can't touch the payment store once the router
+	// has been stopped. sendPayment stops waiting for the HTLC it handed
+	// off to the switch as soon as we're shutting down.
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		preimage, route, err := r.sendPayment(
+			payment, paySession, hooks,
+		)
+
+		// If the payment was aborted due to us shutting down, then it
+		// remains in flight, and is resumed once we restart.
+		if r.shuttingDown() {
+			return
+		}
+
+		result := &PaymentResult{
+			State:    PaymentSucceeded,
+			Preimage: preimage,
+			Route:    route,
+		}
+		if err != nil {
+			log.Debugf("Asynchronous payment %x failed: %v",
+				payment.PaymentHash, err)
+
+			result = &PaymentResult{
+				State: PaymentFailed,
+				Err:   err,
+			}
+		}
+
+		r.payments.conclude(tracked, result)
+	}()
+
+	select {
+	case <-dispatched:
+		return nil
+
+	// If the payment concluded before any of its HTLCs were dispatched,
+	// then we'll return its error directly to the caller.
+	case <-tracked.done:
+		return tracked.result.Err
+
+	case <-r.quit:
+		return fmt.Errorf("router shutting down")
+	}
+}
+
+// shuttingDown returns true if the router has been signaled to stop.
+func (r *ChannelRouter) shuttingDown() bool {
+	select {
+	case <-r.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+// resumePayments resumes the asynchronous payments that were still in flight
+// when we last shut down. For each of them, the result of the latest HTLC
+// attempt is awaited. Resumed payments aren't retried with alternative
+// routes, as the parameters required to find them aren't persisted.
+func (r *ChannelRouter) resumePayments() error {
+	payments, err := r.payments.store.fetchInFlightPayments()
+	if err != nil {
+		return err
+	}
+
+	// The switch only needs to retain the results of the HTLCs we're
+	// about to await. All other results have either been retrieved
+	// already, or will never be.
+	keep := make(map[[32]byte]struct{}, len(payments))
+	for _, info := range payments {
+		keep[info.PaymentHash] = struct{}{}
+	}
+	if err := r.cfg.CleanPaymentResults(keep); err != nil {
+		return err
+	}
+
+	for _, info := range payments {
+		log.Infof("Resuming payment %x", info.PaymentHash)
+
+		r.payments.mu.Lock()
+		tracked := &trackedPayment{
+			info:        info,
+			subscribers: make(map[uint64]chan struct{}),
+			done:        make(chan struct{}),
+		}
+		r.payments.payments[info.PaymentHash] = tracked
+		r.payments.mu.Unlock()
+
+		r.wg.Add(1)
+		go r.resumePayment(tracked)
+	}
+
+	return nil
+}
+
+// resumePayment awaits the result of the latest HTLC attempt of a payment
+// that was in flight when we last shut down, and concludes the payment
+// accordingly.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (r *ChannelRouter) resumePayment(tracked *trackedPayment) {
+	defer r.wg.Done()
+
+	info := r.payments.snapshot(tracked)
+	paymentHash := info.PaymentHash
+
+	fail := func(err error) {
+		r.payments.conclude(tracked, &PaymentResult{
+			State: PaymentFailed,
+			Err:   err,
+		})
+	}
+
+	// If we shut down before the HTLC of the latest attempt was
+	// dispatched, or after it failed, then there's nothing left to await.
+	if len(info.Attempts) == 0 ||
+		!info.Attempts[len(info.Attempts)-1].ResolveTime.IsZero() {
+
+		fail(fmt.Errorf("payment interrupted by restart"))
+		return
+	}
+	attempt := info.Attempts[len(info.Attempts)-1]
+
+	circuit, err := newSphinxCircuit(attempt.Route, attempt.SessionKey)
+	if err != nil {
+		fail(err)
+		return
+	}
+
+	resultChan, err := r.cfg.GetPaymentResult(paymentHash, circuit)
+	if err != nil {
+		log.Debugf("Unable to retrieve result of payment %x: %v",
+			paymentHash, err)
+
+		// Payments that completed before their results were stored by
+		// the switch can only be concluded from their status.
+		db := r.cfg.Graph.Database()
+		status, statusErr := db.FetchPaymentStatus(paymentHash)
+		if statusErr == nil && status == channeldb.StatusCompleted {
+			r.payments.conclude(tracked, &PaymentResult{
+				State: PaymentSucceeded,
+				Route: attempt.Route,
+			})
+			return
+		}
+
+		r.recordResumedFailure(tracked, err)
+		fail(err)
+		return
+	}
+
+	var result *htlcswitch.PaymentResult
+	select {
+	case result = <-resultChan:
+	case <-r.quit:
+		return
+	}
+
+	if result.Error != nil {
+		log.Debugf("Resumed payment %x failed: %v", paymentHash,
+			result.Error)
+
+		if fErr, ok := result.Error.(*htlcswitch.ForwardingError); ok {
+			errVertex := NewVertex(fErr.ErrorSource)
+			r.missionControl.reportRouteResult(
+				attempt.Route, &errVertex,
+				!isPolicyFailure(fErr.FailureMessage),
+			)
+		}
+
+		r.recordResumedFailure(tracked, result.Error)
+		fail(result.Error)
+		return
+	}
+
+	r.missionControl.reportRouteResult(attempt.Route, nil, false)
+
+	r.payments.conclude(tracked, &PaymentResult{
+		State:    PaymentSucceeded,
+		Preimage: result.Preimage,
+		Route:    attempt.Route,
+	})
+}
+
+// recordResumedFailure records the failure of the latest HTLC attempt of a
+// resumed payment.
+func (r *ChannelRouter) recordResumedFailure(tracked *trackedPayment,
+	attemptErr error) {
+
+	err := r.payments.update(tracked, func(info *PaymentInfo) {
+		last := info.Attempts[len(info.Attempts)-1]
+		last.ResolveTime = time.Now()
+		last.Failure = newAttemptFailure(attemptErr)
+	})
+	if err != nil {
+		log.Errorf("Unable to persist failed attempt of payment %x: %v",
+			tracked.info.PaymentHash, err)
+	}
+}
+
+// newSphinxCircuit reconstructs the sphinx circuit of an HTLC sent along the
+// given route, using the session key of its onion packet.
+func newSphinxCircuit(route *Route,
+	sessionKey *btcec.PrivateKey) (*sphinx.Circuit, error) {
+
+	nodes := make([]*btcec.PublicKey, len(route.Hops))
+	for i, hop := range route.Hops {
+		pub, err := btcec.ParsePubKey(hop.PubKeyBytes[:], btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		nodes[i] = pub
+	}
+
+	return &sphinx.Circuit{
+		SessionKey:  sessionKey,
+		PaymentPath: nodes,
+	}, nil
+}
+
+// TrackPayment returns a channel over which the state of the payment to the
+// given payment hash is delivered. If the payment is still in flight, then
+// its current state is sent first, followed by its final result once it has
+// concluded. The channel is closed once the final result has been delivered.
+//
+// Payments dispatched through SendPaymentAsync or SendToRouteOnce are tracked
+// along with their outcome. For any other payment, the state persisted by the
+// switch is delivered, which doesn't carry the payment preimage or failure
+// reason.
+func (r *ChannelRouter) TrackPayment(
+	paymentHash [32]byte) (<-chan *PaymentResult, error) {
+
+	updates := make(chan *PaymentResult, 2)
+
+	tracked, ok := r.payments.lookup(paymentHash)
+	if !ok {
+		// The payment may have been dispatched asynchronously a while
+		// ago, in which case we'll find its outcome in the store.
+		info, err := r.payments.store.fetchPayment(paymentHash)
+		if err == nil {
+			updates <- info.result()
+			close(updates)
+			return updates, nil
+		}
+		if err != ErrPaymentNotFound {
+			return nil, err
+		}
+
+		db := r.cfg.Graph.Database()
+		status, err := db.FetchPaymentStatus(paymentHash)
+		if err != nil {
+			return nil, err
+		}
+
+		switch status {
+		case channeldb.StatusInFlight:
+			updates <- &PaymentResult{State: PaymentInFlight}
+
+		case channeldb.StatusCompleted:
+			updates <- &PaymentResult{State: PaymentSucceeded}
+
+		// As the status of payments we have no record of defaults to
+		// grounded, we're unable to tell whether such a payment
+		// failed, or never existed at all.
+		default:
+			return nil, ErrPaymentNotFound
+		}
+
+		close(updates)
+		return updates, nil
+	}
+
+	select {
+	case <-tracked.done:
+		updates <- tracked.result
+		close(updates)
+		return updates, nil
+
+	default:
+		updates <- &PaymentResult{State: PaymentInFlight}
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer close(updates)
+
+		select {
+		case <-tracked.done:
+			updates <- tracked.result
+
+		case <-r.quit:
+		}
+	}()
+
+	return updates, nil
+}
+
+// SubscribePayment returns a channel over which snapshots of the payment to
+// the given payment hash are delivered, covering its HTLC attempts along with
+// their failures, and its final outcome. The current snapshot is sent first,
+// followed by a new one whenever the payment progresses. The channel is
+// closed once a snapshot of the concluded payment has been delivered, or once
+// the returned cancel closure is called.
+//
+// Only payments dispatched through SendPaymentAsync or SendToRouteOnce can be
+// subscribed to.
+func (r *ChannelRouter) SubscribePayment(
+	paymentHash [32]byte) (<-chan *PaymentInfo, func(), error) {
+
+	updates := make(chan *PaymentInfo, 1)
+
+	tracked, ok := r.payments.lookup(paymentHash)
+	if !ok {
+		info, err := r.payments.store.fetchPayment(paymentHash)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		updates <- info
+		close(updates)
+		return updates, func() {}, nil
+	}
+
+	signal, cancelSubscription := r.payments.subscribe(tracked)
+
+	cancelChan := make(chan struct{})
+	var cancelOnce sync.Once
+	cancel := func() {
+		cancelOnce.Do(func() {
+			close(cancelChan)
+		})
+	}
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer close(updates)
+		defer cancelSubscription()
+
+		for {
+			info := r.payments.snapshot(tracked)
+
+			select {
+			case updates <- info:
+			case <-cancelChan:
+				return
+			case <-r.quit:
+				return
+			}
+
+			if info.State != PaymentInFlight {
+				return
+			}
+
+			select {
+			case <-signal:
+			case <-cancelChan:
+				return
+			case <-r.quit:
+				return
+			}
+		}
+	}()
+
+	return updates, cancel, nil
+}
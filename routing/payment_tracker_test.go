@@ -0,0 +1,460 @@
+package routing
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/davecgh/go-spew/spew"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// receivePaymentResult waits for the next payment result to be delivered over
+// the passed channel.
+func receivePaymentResult(t *testing.T,
+	updates <-chan *PaymentResult) *PaymentResult {
+
+	t.Helper()
+
+	select {
+	case result, ok := <-updates:
+		if !ok {
+			t.Fatalf("payment updates closed unexpectedly")
+		}
+		return result
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no payment result received")
+	}
+
+	return nil
+}
+
+// TestSendPaymentAsync asserts that SendPaymentAsync returns as soon as the
+// first HTLC of a payment has been dispatched, and that the outcome of the
+// payment is delivered through TrackPayment.
+func TestSendPaymentAsync(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var payHash [32]byte
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	var preImage [32]byte
+	copy(preImage[:], bytes.Repeat([]byte{9}, 32))
+
+	// We'll modify the SendToSwitch method to signal the dispatch of the
+	// HTLC, and then block until we release the payment.
+	release := make(chan struct{})
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		onDispatch func()) ([32]byte, error) {
+
+		onDispatch()
+		<-release
+
+		return preImage, nil
+	}
+
+	if err := ctx.router.SendPaymentAsync(&payment); err != nil {
+		t.Fatalf("unable to send payment: %v", err)
+	}
+
+	// As the payment is still in flight, a second attempt to pay the same
+	// payment hash should be rejected.
+	err = ctx.router.SendPaymentAsync(&payment)
+	if err != htlcswitch.ErrPaymentInFlight {
+		t.Fatalf("expected ErrPaymentInFlight, got: %v", err)
+	}
+
+	updates, err := ctx.router.TrackPayment(payHash)
+	if err != nil {
+		t.Fatalf("unable to track payment: %v", err)
+	}
+
+	result := receivePaymentResult(t, updates)
+	if result.State != PaymentInFlight {
+		t.Fatalf("expected payment to be in flight, got %v",
+			result.State)
+	}
+
+	// Once the payment is released, its preimage should be delivered.
+	close(release)
+
+	result = receivePaymentResult(t, updates)
+	if result.State != PaymentSucceeded {
+		t.Fatalf("expected payment to succeed, got %v: %v",
+			result.State, result.Err)
+	}
+	if result.Preimage != preImage {
+		t.Fatalf("expected preimage %x, got %x", preImage,
+			result.Preimage)
+	}
+
+	if _, ok := <-updates; ok {
+		t.Fatalf("expected payment updates to be closed")
+	}
+
+	// Tracking the concluded payment should deliver its result right
+	// away.
+	updates, err = ctx.router.TrackPayment(payHash)
+	if err != nil {
+		t.Fatalf("unable to track payment: %v", err)
+	}
+	result = receivePaymentResult(t, updates)
+	if result.State != PaymentSucceeded {
+		t.Fatalf("expected payment to succeed, got %v", result.State)
+	}
+}
+
+// TestSendPaymentAsyncFailure asserts that SendPaymentAsync returns the
+// error of a payment that failed before any of its HTLCs were dispatched.
+func TestSendPaymentAsyncFailure(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var payHash [32]byte
+	copy(payHash[:], bytes.Repeat([]byte{1}, 32))
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	sendErr := fmt.Errorf("unable to dispatch htlc")
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
+
+		return [32]byte{}, sendErr
+	}
+
+	err = ctx.router.SendPaymentAsync(&payment)
+	if err != sendErr {
+		t.Fatalf("expected error %v, got: %v", sendErr, err)
+	}
+
+	updates, err := ctx.router.TrackPayment(payHash)
+	if err != nil {
+		t.Fatalf("unable to track payment: %v", err)
+	}
+	result := receivePaymentResult(t, updates)
+	if result.State != PaymentFailed || result.Err != sendErr {
+		t.Fatalf("expected payment to fail with %v, got %v: %v",
+			sendErr, result.State, result.Err)
+	}
+}
+
+// TestPaymentInfoSerialization asserts that a payment along with its HTLC
+// attempts survives a round trip through the payment store.
+func TestPaymentInfoSerialization(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	sessionKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate session key: %v", err)
+	}
+
+	// We'll strip the monotonic clock reading from the timestamps, as it
+	// isn't persisted.
+	now := time.Unix(0, time.Now().UnixNano())
+
+	songoku := NewVertex(ctx.aliases["songoku"])
+	luoji := NewVertex(ctx.aliases["luoji"])
+	route := &Route{
+		TotalTimeLock: 150,
+		TotalFees:     10,
+		TotalAmount:   1010,
+		SourcePubKey:  NewVertex(ctx.aliases["roasbeef"]),
+		Hops: []*Hop{
+			{
+				PubKeyBytes:      songoku,
+				ChannelID:        12345,
+				OutgoingTimeLock: 140,
+				AmtToForward:     1000,
+			},
+			{
+				PubKeyBytes:      luoji,
+				ChannelID:        54321,
+				OutgoingTimeLock: 140,
+				AmtToForward:     1000,
+			},
+		},
+	}
+
+	failure := &lnwire.FailPermanentChannelFailure{}
+	info := &PaymentInfo{
+		Value:        1000,
+		CreationTime: now,
+		State:        PaymentFailed,
+		Attempts: []*PaymentAttempt{
+			{
+				Route:       route,
+				SessionKey:  sessionKey,
+				AttemptTime: now,
+				ResolveTime: now.Add(time.Second),
+				Failure: &AttemptFailure{
+					Source:  songoku,
+					Message: failure,
+					Reason:  "permanent channel failure",
+				},
+			},
+			{
+				Route:       route,
+				SessionKey:  sessionKey,
+				AttemptTime: now.Add(time.Second),
+				ResolveTime: now.Add(2 * time.Second),
+				Failure: &AttemptFailure{
+					Reason: "unable to dispatch htlc",
+				},
+			},
+		},
+		FailureReason: "unable to route payment",
+	}
+	copy(info.PaymentHash[:], bytes.Repeat([]byte{2}, 32))
+
+	store := ctx.router.payments.store
+	if err := store.storePayment(info); err != nil {
+		t.Fatalf("unable to store payment: %v", err)
+	}
+
+	stored, err := store.fetchPayment(info.PaymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment: %v", err)
+	}
+	if !reflect.DeepEqual(info, stored) {
+		t.Fatalf("stored payment doesn't match: expected %v, got %v",
+			spew.Sdump(info), spew.Sdump(stored))
+	}
+
+	// As the payment has concluded, it shouldn't be resumed.
+	inFlight, err := store.fetchInFlightPayments()
+	if err != nil {
+		t.Fatalf("unable to fetch in flight payments: %v", err)
+	}
+	if len(inFlight) != 0 {
+		t.Fatalf("expected no in flight payments, got %v",
+			len(inFlight))
+	}
+
+	_, err = store.fetchPayment([32]byte{})
+	if err != ErrPaymentNotFound {
+		t.Fatalf("expected ErrPaymentNotFound, got: %v", err)
+	}
+}
+
+// TestResumePayment asserts that a payment that was in flight when the router
+// was stopped is resumed on restart, and concluded with the result of its
+// HTLC.
+func TestResumePayment(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var payHash [32]byte
+	copy(payHash[:], bytes.Repeat([]byte{3}, 32))
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	// We'll have the switch hold on to the HTLC until after the router
+	// has been stopped.
+	release := make(chan struct{})
+	defer close(release)
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		onDispatch func()) ([32]byte, error) {
+
+		onDispatch()
+		<-release
+
+		return [32]byte{}, htlcswitch.ErrSwitchExiting
+	}
+
+	if err := ctx.router.SendPaymentAsync(&payment); err != nil {
+		t.Fatalf("unable to send payment: %v", err)
+	}
+	if err := ctx.router.Stop(); err != nil {
+		t.Fatalf("unable to stop router: %v", err)
+	}
+
+	// Upon restart, the router should retrieve the result of the HTLC it
+	// dispatched before shutting down.
+	var preimage [32]byte
+	copy(preimage[:], bytes.Repeat([]byte{4}, 32))
+
+	ctx.chainView.Reset()
+	router, err := New(Config{
+		Graph:     ctx.graph,
+		Chain:     ctx.chain,
+		ChainView: ctx.chainView,
+		SendToSwitch: func(_ lnwire.ShortChannelID,
+			_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+			_ func()) ([32]byte, error) {
+
+			return [32]byte{}, nil
+		},
+		GetPaymentResult: func(paymentHash [32]byte,
+			circuit *sphinx.Circuit) (
+			<-chan *htlcswitch.PaymentResult, error) {
+
+			if paymentHash != payHash {
+				return nil, htlcswitch.ErrPaymentResultNotFound
+			}
+
+			results := make(chan *htlcswitch.PaymentResult, 1)
+			results <- &htlcswitch.PaymentResult{
+				Preimage: preimage,
+			}
+			return results, nil
+		},
+		CleanPaymentResults: func(map[[32]byte]struct{}) error {
+			return nil
+		},
+		ChannelPruneExpiry: time.Hour * 24,
+		GraphPruneInterval: time.Hour * 2,
+		MissionControl:     DefaultMissionControlConfig(),
+		DisableShadowRoute: true,
+		QueryBandwidth: func(e *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
+			return lnwire.NewMSatFromSatoshis(e.Capacity)
+		},
+	})
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	if err := router.Start(); err != nil {
+		t.Fatalf("unable to start router: %v", err)
+	}
+	ctx.router = router
+
+	updates, _, err := router.SubscribePayment(payHash)
+	if err != nil {
+		t.Fatalf("unable to subscribe to payment: %v", err)
+	}
+
+	var info *PaymentInfo
+	for info = range updates {
+	}
+	if info == nil {
+		t.Fatalf("no payment update received")
+	}
+
+	if info.State != PaymentSucceeded {
+		t.Fatalf("expected payment to succeed, got %v: %v",
+			info.State, info.FailureReason)
+	}
+	if info.Preimage != preimage {
+		t.Fatalf("expected preimage %x, got %x", preimage,
+			info.Preimage)
+	}
+	if len(info.Attempts) != 1 {
+		t.Fatalf("expected 1 attempt, got %v", len(info.Attempts))
+	}
+	if info.Attempts[0].ResolveTime.IsZero() {
+		t.Fatalf("expected attempt to be resolved")
+	}
+}
+
+// TestPrunePayments asserts that only concluded payments created before the
+// given time are pruned from the payment store.
+func TestPrunePayments(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	now := time.Now()
+	cutoff := now.Add(-paymentRecordRetention)
+
+	payments := []*PaymentInfo{
+		{
+			CreationTime: cutoff.Add(-time.Hour),
+			State:        PaymentSucceeded,
+		},
+		{
+			CreationTime: cutoff.Add(-time.Hour),
+			State:        PaymentInFlight,
+		},
+		{
+			CreationTime: now,
+			State:        PaymentFailed,
+		},
+	}
+
+	store := ctx.router.payments.store
+	for i, info := range payments {
+		info.PaymentHash[0] = byte(i + 1)
+		if err := store.storePayment(info); err != nil {
+			t.Fatalf("unable to store payment: %v", err)
+		}
+	}
+
+	if err := store.prunePayments(cutoff); err != nil {
+		t.Fatalf("unable to prune payments: %v", err)
+	}
+
+	// Only the old concluded payment should have been pruned.
+	for i, info := range payments {
+		_, err := store.fetchPayment(info.PaymentHash)
+		switch {
+		case i == 0 && err != ErrPaymentNotFound:
+			t.Fatalf("expected ErrPaymentNotFound, got: %v", err)
+
+		case i != 0 && err != nil:
+			t.Fatalf("unable to fetch payment %v: %v", i, err)
+		}
+	}
+}
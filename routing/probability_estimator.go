@@ -0,0 +1,304 @@
+package routing
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// AprioriEstimatorName is the name of the estimator that assumes a
+	// fixed probability for pairs of nodes we have no record of, blended
+	// with the results of the node's other pairs.
+	AprioriEstimatorName = "apriori"
+
+	// BimodalEstimatorName is the name of the estimator that derives the
+	// probability of a pair of nodes from the capacity of the channel and
+	// the amount to send, assuming that the liquidity of channels is
+	// mostly concentrated on either of their sides.
+	BimodalEstimatorName = "bimodal"
+)
+
+// AprioriConfig houses the parameters of the apriori probability estimator.
+type AprioriConfig struct {
+	// HopProbability is the probability we assume a pair of nodes is able
+	// to forward an HTLC, if we have no record of routing through the
+	// node before.
+	HopProbability float64
+
+	// Weight is the weight of the apriori hop probability versus the
+	// results of a node's other pairs, when estimating the probability of
+	// a pair we have no record of. A weight of 1 ignores the results of
+	// the node's other pairs altogether.
+	Weight float64
+
+	// PenaltyHalfLife is the time after which the influence of a result
+	// on the probability of a pair has halved.
+	PenaltyHalfLife time.Duration
+}
+
+// BimodalConfig houses the parameters of the bimodal probability estimator.
+type BimodalConfig struct {
+	// Scale is the amount over which the liquidity of a channel is
+	// assumed to be concentrated at either of its sides.
+	Scale lnwire.MilliSatoshi
+
+	// DecayTime is the time after which the influence of a result on the
+	// probability of a pair has decayed to 1/e of its initial value.
+	DecayTime time.Duration
+}
+
+// MissionControlConfig defines the way mission control estimates the
+// probability that a payment attempt succeeds, and how path finding trades
+// off that probability against fees and time locks.
+type MissionControlConfig struct {
+	// Estimator is the name of the probability estimator to use, either
+	// AprioriEstimatorName or BimodalEstimatorName.
+	Estimator string
+
+	// Apriori are the parameters of the apriori estimator.
+	Apriori AprioriConfig
+
+	// Bimodal are the parameters of the bimodal estimator.
+	Bimodal BimodalConfig
+
+	// AttemptCost is the virtual cost that path finding assigns to having
+	// to make another payment attempt. Edges are penalized by this cost,
+	// scaled by how much more likely they are to fail than a pair of nodes
+	// we have no information about.
+	AttemptCost lnwire.MilliSatoshi
+}
+
+// DefaultMissionControlConfig returns the default configuration of mission
+// control.
+func DefaultMissionControlConfig() MissionControlConfig {
+	return MissionControlConfig{
+		Estimator: AprioriEstimatorName,
+		Apriori: AprioriConfig{
+			HopProbability:  0.6,
+			Weight:          0.5,
+			PenaltyHalfLife: time.Hour,
+		},
+		Bimodal: BimodalConfig{
+			Scale:     lnwire.NewMSatFromSatoshis(300000),
+			DecayTime: 7 * 24 * time.Hour,
+		},
+		AttemptCost: lnwire.NewMSatFromSatoshis(100),
+	}
+}
+
+// nodeResults are the latest results of the pairs formed by a node and the
+// nodes it has forwarded HTLCs to, indexed by the latter.
+type nodeResults map[Vertex]pairResult
+
+// probabilityEstimator estimates the probability that a pair of nodes is able
+// to forward an HTLC.
+type probabilityEstimator interface {
+	// pairProbability returns the probability that a node with the given
+	// results is able to forward an HTLC of the given amount to toNode,
+	// through a channel of the given capacity. A zero capacity indicates
+	// that the capacity is unknown.
+	pairProbability(now time.Time, results nodeResults, toNode Vertex,
+		amt, capacity lnwire.MilliSatoshi) float64
+
+	// aprioriProbability returns the probability assigned to a pair of
+	// nodes we have no record of, through a channel of unknown capacity.
+	aprioriProbability() float64
+}
+
+// newProbabilityEstimator validates the passed config and returns the
+// probability estimator it selects.
+func newProbabilityEstimator(cfg MissionControlConfig) (probabilityEstimator,
+	error) {
+
+	switch cfg.Estimator {
+	case AprioriEstimatorName:
+		c := cfg.Apriori
+		switch {
+		case c.HopProbability <= 0 || c.HopProbability > 1:
+			return nil, fmt.Errorf("apriori hop probability must be "+
+				"in (0, 1], is %v", c.HopProbability)
+
+		case c.Weight < 0 || c.Weight > 1:
+			return nil, fmt.Errorf("apriori weight must be in "+
+				"[0, 1], is %v", c.Weight)
+
+		case c.PenaltyHalfLife <= 0:
+			return nil, fmt.Errorf("penalty half life must be "+
+				"positive, is %v", c.PenaltyHalfLife)
+		}
+
+		return &aprioriEstimator{AprioriConfig: c}, nil
+
+	case BimodalEstimatorName:
+		c := cfg.Bimodal
+		switch {
+		case c.Scale == 0:
+			return nil, fmt.Errorf("bimodal scale must be positive")
+
+		case c.DecayTime <= 0:
+			return nil, fmt.Errorf("bimodal decay time must be "+
+				"positive, is %v", c.DecayTime)
+		}
+
+		return &bimodalEstimator{BimodalConfig: c}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown probability estimator: %v",
+			cfg.Estimator)
+	}
+}
+
+// aprioriEstimator assumes a fixed probability for pairs of nodes we have no
+// record of. Once a node has forwarded HTLCs, the results of its pairs are
+// blended into the probability of its other pairs.
+type aprioriEstimator struct {
+	AprioriConfig
+}
+
+// decay returns the remaining influence of a result recorded at the given
+// time.
+func (a *aprioriEstimator) decay(now, timestamp time.Time) float64 {
+	age := now.Sub(timestamp)
+	if age < 0 {
+		age = 0
+	}
+
+	return math.Pow(2, -float64(age)/float64(a.PenaltyHalfLife))
+}
+
+// nodeProbability returns the probability that a node with the given results
+// is able to forward an HTLC to toNode, disregarding the result of that very
+// pair. The apriori hop probability counts as a number of results that is
+// determined by its weight.
+func (a *aprioriEstimator) nodeProbability(now time.Time, results nodeResults,
+	toNode Vertex) float64 {
+
+	if a.Weight >= 1 {
+		return a.HopProbability
+	}
+
+	aprioriFactor := 1/(1-a.Weight) - 1
+	totalWeight := aprioriFactor
+	totalProbability := aprioriFactor * a.HopProbability
+	for to, result := range results {
+		if to == toNode {
+			continue
+		}
+
+		weight := a.decay(now, result.timestamp)
+		totalWeight += weight
+		if result.success {
+			totalProbability += weight
+		}
+	}
+
+	if totalWeight == 0 {
+		return a.HopProbability
+	}
+
+	return totalProbability / totalWeight
+}
+
+// pairProbability returns the probability that a node with the given results
+// is able to forward an HTLC to toNode. The influence of the latest result of
+// the pair decays exponentially with its age, so that the probability moves
+// back towards the probability of the node over time.
+//
+// NOTE: This is part of the probabilityEstimator interface.
+func (a *aprioriEstimator) pairProbability(now time.Time, results nodeResults,
+	toNode Vertex, _, _ lnwire.MilliSatoshi) float64 {
+
+	nodeProbability := a.nodeProbability(now, results, toNode)
+
+	result, ok := results[toNode]
+	if !ok {
+		return nodeProbability
+	}
+
+	decay := a.decay(now, result.timestamp)
+	if result.success {
+		return nodeProbability + (1-nodeProbability)*decay
+	}
+
+	return nodeProbability * (1 - decay)
+}
+
+// aprioriProbability returns the apriori hop probability.
+//
+// NOTE: This is part of the probabilityEstimator interface.
+func (a *aprioriEstimator) aprioriProbability() float64 {
+	return a.HopProbability
+}
+
+// bimodalEstimator derives the probability of a pair of nodes from the
+// capacity of their channel, assuming that the liquidity of channels is
+// mostly concentrated on either of their sides. The latest result of the pair
+// moves the probability towards success or failure, with an influence that
+// decays over time.
+type bimodalEstimator struct {
+	BimodalConfig
+}
+
+// capacityProbability returns the probability that a channel of the given
+// capacity holds enough liquidity to forward amt. The liquidity is assumed to
+// be distributed according to a density proportional to
+// exp(-x/s) + exp((x-c)/s), with s being the scale and c the capacity.
+func (b *bimodalEstimator) capacityProbability(amt,
+	capacity lnwire.MilliSatoshi) float64 {
+
+	if capacity == 0 {
+		return 1
+	}
+	if amt >= capacity {
+		return 0
+	}
+
+	s := float64(b.Scale)
+	c := float64(capacity)
+	x := float64(amt)
+
+	total := 2 * (1 - math.Exp(-c/s))
+	available := 1 - math.Exp(-c/s) + math.Exp(-x/s) - math.Exp((x-c)/s)
+
+	return available / total
+}
+
+// pairProbability returns the probability that a node with the given results
+// is able to forward an HTLC of the given amount to toNode, through a channel
+// of the given capacity.
+//
+// NOTE: This is part of the probabilityEstimator interface.
+func (b *bimodalEstimator) pairProbability(now time.Time, results nodeResults,
+	toNode Vertex, amt, capacity lnwire.MilliSatoshi) float64 {
+
+	probability := b.capacityProbability(amt, capacity)
+
+	result, ok := results[toNode]
+	if !ok {
+		return probability
+	}
+
+	age := now.Sub(result.timestamp)
+	if age < 0 {
+		age = 0
+	}
+	decay := math.Exp(-float64(age) / float64(b.DecayTime))
+
+	if result.success {
+		return probability + (1-probability)*decay
+	}
+
+	return probability * (1 - decay)
+}
+
+// aprioriProbability returns the probability of a pair we have no record of,
+// through a channel of unknown capacity, which the bimodal estimator deems
+// certain to succeed.
+//
+// NOTE: This is part of the probabilityEstimator interface.
+func (b *bimodalEstimator) aprioriProbability() float64 {
+	return 1
+}
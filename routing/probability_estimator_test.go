@@ -0,0 +1,200 @@
+package routing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestAprioriEstimator asserts that the influence of the latest result of a
+// pair of nodes on its success probability decays over time, and that the
+// results of a node's other pairs are blended into the apriori probability.
+func TestAprioriEstimator(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultMissionControlConfig().Apriori
+	estimator := &aprioriEstimator{AprioriConfig: cfg}
+
+	now := time.Now()
+	halfLifeAgo := now.Add(-cfg.PenaltyHalfLife)
+	hopProb := cfg.HopProbability
+	bob, carol := Vertex{2}, Vertex{3}
+
+	tests := []struct {
+		name    string
+		results nodeResults
+		prob    float64
+	}{
+		{
+			name: "no results",
+			prob: hopProb,
+		},
+		{
+			name: "recent failure",
+			results: nodeResults{
+				carol: {timestamp: now},
+			},
+			prob: 0,
+		},
+		{
+			name: "decayed failure",
+			results: nodeResults{
+				carol: {timestamp: halfLifeAgo},
+			},
+			prob: hopProb / 2,
+		},
+		{
+			name: "recent success",
+			results: nodeResults{
+				carol: {timestamp: now, success: true},
+			},
+			prob: 1,
+		},
+		{
+			name: "decayed success",
+			results: nodeResults{
+				carol: {timestamp: halfLifeAgo, success: true},
+			},
+			prob: hopProb + (1-hopProb)/2,
+		},
+		{
+			// With the default weight, the apriori probability
+			// counts as much as a single recent result of one of
+			// the node's other pairs.
+			name: "recent success of other pair",
+			results: nodeResults{
+				bob: {timestamp: now, success: true},
+			},
+			prob: (hopProb + 1) / 2,
+		},
+	}
+
+	for _, test := range tests {
+		prob := estimator.pairProbability(
+			now, test.results, carol, 0, 0,
+		)
+		if math.Abs(prob-test.prob) > 1e-9 {
+			t.Fatalf("%v: expected probability %v, got %v",
+				test.name, test.prob, prob)
+		}
+	}
+}
+
+// TestBimodalEstimator asserts that the bimodal estimator derives the
+// probability of a pair from the channel capacity and the amount to send, and
+// moves it towards the latest result of the pair.
+func TestBimodalEstimator(t *testing.T) {
+	t.Parallel()
+
+	cfg := DefaultMissionControlConfig().Bimodal
+	estimator := &bimodalEstimator{BimodalConfig: cfg}
+
+	now := time.Now()
+	capacity := lnwire.NewMSatFromSatoshis(1000000)
+	carol := Vertex{3}
+
+	tests := []struct {
+		name     string
+		results  nodeResults
+		amt      lnwire.MilliSatoshi
+		capacity lnwire.MilliSatoshi
+		prob     float64
+	}{
+		{
+			name: "unknown capacity",
+			amt:  capacity,
+			prob: 1,
+		},
+		{
+			name:     "zero amount",
+			capacity: capacity,
+			prob:     1,
+		},
+		{
+			name:     "half capacity",
+			amt:      capacity / 2,
+			capacity: capacity,
+			prob:     0.5,
+		},
+		{
+			name:     "exceeding capacity",
+			amt:      capacity + 1,
+			capacity: capacity,
+			prob:     0,
+		},
+		{
+			name: "recent failure",
+			results: nodeResults{
+				carol: {timestamp: now},
+			},
+			amt:      1,
+			capacity: capacity,
+			prob:     0,
+		},
+		{
+			name: "recent success",
+			results: nodeResults{
+				carol: {timestamp: now, success: true},
+			},
+			amt:      capacity - 1,
+			capacity: capacity,
+			prob:     1,
+		},
+	}
+
+	for _, test := range tests {
+		prob := estimator.pairProbability(
+			now, test.results, carol, test.amt, test.capacity,
+		)
+		if math.Abs(prob-test.prob) > 1e-9 {
+			t.Fatalf("%v: expected probability %v, got %v",
+				test.name, test.prob, prob)
+		}
+	}
+
+	// Small amounts should be much more likely to succeed than amounts
+	// close to the capacity.
+	small := estimator.capacityProbability(capacity/10, capacity)
+	large := estimator.capacityProbability(capacity*9/10, capacity)
+	if small <= large {
+		t.Fatalf("expected small amount to be more likely to "+
+			"succeed: %v <= %v", small, large)
+	}
+}
+
+// TestNewProbabilityEstimator asserts that invalid estimator configurations
+// are rejected.
+func TestNewProbabilityEstimator(t *testing.T) {
+	t.Parallel()
+
+	if _, err := newProbabilityEstimator(
+		DefaultMissionControlConfig(),
+	); err != nil {
+		t.Fatalf("expected default config to be valid: %v", err)
+	}
+
+	cfg := DefaultMissionControlConfig()
+	cfg.Estimator = BimodalEstimatorName
+	if _, err := newProbabilityEstimator(cfg); err != nil {
+		t.Fatalf("expected bimodal config to be valid: %v", err)
+	}
+
+	cfg.Bimodal.Scale = 0
+	if _, err := newProbabilityEstimator(cfg); err == nil {
+		t.Fatalf("expected zero scale to be rejected")
+	}
+
+	cfg = DefaultMissionControlConfig()
+	cfg.Apriori.HopProbability = 1.5
+	if _, err := newProbabilityEstimator(cfg); err == nil {
+		t.Fatalf("expected invalid hop probability to be rejected")
+	}
+
+	cfg = DefaultMissionControlConfig()
+	cfg.Estimator = "unknown"
+	if _, err := newProbabilityEstimator(cfg); err == nil {
+		t.Fatalf("expected unknown estimator to be rejected")
+	}
+}
@@ -0,0 +1,111 @@
+package routing
+
+import (
+	"crypto/rand"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ProbeResult describes the outcome of probing the network for a route that
+// is able to carry a payment.
+type ProbeResult struct {
+	// Route is the route along which the probe reached its destination.
+	Route *Route
+
+	// SuccessProbability is the probability that a payment along Route
+	// succeeds, as estimated by mission control after the probe.
+	SuccessProbability float64
+
+	// NumAttempts is the number of routes that were probed, including
+	// the one that reached the destination.
+	NumAttempts int
+}
+
+// ProbePayment sends the passed payment through the network without actually
+// paying, in order to find a route that is able to carry it, and to learn the
+// fees charged along that route. To this end, the payment hash is replaced by
+// a random one, which guarantees that the destination fails the probe once it
+// arrives. Failures reported by intermediate nodes are processed just like for
+// a real payment, so any subsequent payment benefits from what the probe
+// learned about the network.
+func (r *ChannelRouter) ProbePayment(payment *LightningPayment) (*ProbeResult,
+	error) {
+
+	probe := *payment
+	if _, err := rand.Read(probe.PaymentHash[:]); err != nil {
+		return nil, err
+	}
+
+	// The probe should find a route for the payment exactly as it would
+	// be sent, so it isn't padded with a shadow route, whose random fee
+	// and time lock would be reported as part of the route.
+	probe.DisableShadowRoute = true
+
+	paySession, err := r.missionControl.NewPaymentSession(
+		probe.RouteHints, probe.Target,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll keep track of the last route that was attempted, as that's
+	// the one that reached the destination if the probe succeeds.
+	result := &ProbeResult{}
+	hooks := &attemptHooks{
+		onAttempt: func(route *Route, _ *sphinx.Circuit) error {
+			result.Route = route
+			result.NumAttempts++
+			return nil
+		},
+	}
+
+	_, _, err = r.sendPayment(&probe, paySession, hooks)
+	if !probeReachedTarget(err, NewVertex(probe.Target)) {
+		return nil, err
+	}
+
+	// As the probe made it all the way to the destination, mission
+	// control now knows each pair of nodes along the route to be able to
+	// forward it, which we'll use to estimate the success probability of
+	// a payment along the route.
+	result.SuccessProbability = 1
+	fromNode := result.Route.SourcePubKey
+	for _, hop := range result.Route.Hops {
+		prob := r.missionControl.getPairProbability(
+			fromNode, hop.PubKeyBytes, hop.AmtToForward, 0,
+		)
+		result.SuccessProbability *= prob
+		fromNode = hop.PubKeyBytes
+	}
+
+	return result, nil
+}
+
+// probeReachedTarget returns true if the passed payment error indicates that
+// a probe reached its target, which must have rejected it for its unknown
+// payment hash.
+func probeReachedTarget(err error, target Vertex) bool {
+	// A probe can't actually be settled, but if it somehow was, it did
+	// reach its target as well.
+	if err == nil {
+		return true
+	}
+
+	fErr, ok := err.(*htlcswitch.ForwardingError)
+	if !ok || NewVertex(fErr.ErrorSource) != target {
+		return false
+	}
+
+	switch fErr.FailureMessage.(type) {
+	case *lnwire.FailUnknownPaymentHash:
+		return true
+
+	case *lnwire.FailIncorrectPaymentAmount:
+		return true
+
+	default:
+		return false
+	}
+}
@@ -0,0 +1,102 @@
+package routing
+
+import (
+	"testing"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestProbePayment asserts that a probe is sent with a random payment hash,
+// that it moves on to the next route when an intermediate node fails it, and
+// that it reports the route along which it reached the destination.
+func TestProbePayment(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var payHash [32]byte
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	// The direct channel to luo ji will fail the probe, forcing the router
+	// to fall back to the route through satoshi, after which luo ji
+	// rejects the probe for its unknown payment hash.
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
+
+		if htlcAdd.PaymentHash == payHash {
+			t.Fatalf("probe sent with real payment hash")
+		}
+
+		roasbeefLuoji := lnwire.NewShortChanIDFromInt(689530843)
+		if firstHop == roasbeefLuoji {
+			pub, err := ctx.router.selfNode.PubKey()
+			if err != nil {
+				return [32]byte{}, err
+			}
+			failure := &lnwire.FailTemporaryChannelFailure{}
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    pub,
+				FailureMessage: failure,
+			}
+		}
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    ctx.aliases["luoji"],
+			FailureMessage: &lnwire.FailUnknownPaymentHash{},
+		}
+	}
+
+	result, err := ctx.router.ProbePayment(&payment)
+	if err != nil {
+		t.Fatalf("unable to probe payment: %v", err)
+	}
+
+	if result.NumAttempts != 2 {
+		t.Fatalf("expected 2 attempts, got %v", result.NumAttempts)
+	}
+	if len(result.Route.Hops) != 2 {
+		t.Fatalf("expected route of 2 hops, got %v",
+			len(result.Route.Hops))
+	}
+	satoshi := NewVertex(ctx.aliases["satoshi"])
+	if result.Route.Hops[0].PubKeyBytes != satoshi {
+		t.Fatalf("expected route through satoshi, got %v",
+			getAliasFromPubKey(result.Route.Hops[0].PubKeyBytes[:],
+				ctx.aliases))
+	}
+	if result.SuccessProbability <= 0 || result.SuccessProbability > 1 {
+		t.Fatalf("invalid success probability %v",
+			result.SuccessProbability)
+	}
+
+	// A probe that is failed by an intermediate node on every route
+	// didn't reach the destination, so its error is returned.
+	ctx.router.cfg.SendToSwitch = func(_ lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    ctx.aliases["satoshi"],
+			FailureMessage: &lnwire.FailUnknownPaymentHash{},
+		}
+	}
+
+	if _, err := ctx.router.ProbePayment(&payment); err == nil {
+		t.Fatalf("expected probe to fail")
+	}
+}
@@ -0,0 +1,84 @@
+package routing
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Rebalance shifts amt of our local balance from the outgoing channel to the
+// incoming channel by paying ourselves along a circular route. The route
+// leaves through the outgoing channel and returns through the incoming one,
+// spending at most feeLimit on the fees of the nodes in between. If the
+// payment succeeds, its preimage and the route it took are returned.
+func (r *ChannelRouter) Rebalance(outgoingChanID, incomingChanID uint64,
+	amt, feeLimit lnwire.MilliSatoshi) ([32]byte, *Route, error) {
+
+	if outgoingChanID == incomingChanID {
+		return [32]byte{}, nil, fmt.Errorf("unable to rebalance "+
+			"channel %v with itself", outgoingChanID)
+	}
+
+	if r.cfg.AddInvoice == nil {
+		return [32]byte{}, nil, fmt.Errorf("rebalancing isn't " +
+			"supported without an invoice registry")
+	}
+
+	// Both channels must be ours. The peer of the incoming channel is the
+	// last hop of the route, as it forwards the payment back to us.
+	if _, err := r.channelPeer(outgoingChanID); err != nil {
+		return [32]byte{}, nil, err
+	}
+	lastHop, err := r.channelPeer(incomingChanID)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+
+	selfKey, err := r.selfNode.PubKey()
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+
+	memo := fmt.Sprintf("rebalance %v -> %v", outgoingChanID,
+		incomingChanID)
+	finalCLTVDelta := uint16(DefaultFinalCLTVDelta)
+	paymentHash, err := r.cfg.AddInvoice(amt, finalCLTVDelta, memo)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+
+	log.Infof("Rebalancing %v from channel %v to channel %v with "+
+		"payment %x", amt, outgoingChanID, incomingChanID, paymentHash)
+
+	return r.SendPayment(&LightningPayment{
+		Target:            selfKey,
+		Amount:            amt,
+		FeeLimit:          feeLimit,
+		PaymentHash:       paymentHash,
+		FinalCLTVDelta:    &finalCLTVDelta,
+		OutgoingChannelID: &outgoingChanID,
+		LastHop:           &lastHop,
+		IncomingChannelID: &incomingChanID,
+	})
+}
+
+// channelPeer returns the node on the other end of one of our channels.
+func (r *ChannelRouter) channelPeer(chanID uint64) (Vertex, error) {
+	info, _, _, err := r.cfg.Graph.FetchChannelEdgesByID(chanID)
+	if err != nil {
+		return Vertex{}, fmt.Errorf("unable to fetch channel %v: %v",
+			chanID, err)
+	}
+
+	switch r.selfNode.PubKeyBytes {
+	case info.NodeKey1Bytes:
+		return Vertex(info.NodeKey2Bytes), nil
+
+	case info.NodeKey2Bytes:
+		return Vertex(info.NodeKey1Bytes), nil
+
+	default:
+		return Vertex{}, fmt.Errorf("channel %v isn't one of ours",
+			chanID)
+	}
+}
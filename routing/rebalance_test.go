@@ -0,0 +1,105 @@
+package routing
+
+import (
+	"bytes"
+	"testing"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestRebalance asserts that a rebalance pays an invoice of our own along a
+// circular route that leaves and returns through the requested channels.
+func TestRebalance(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	const (
+		roasbeefLuoji    = 689530843
+		luojiSatoshi     = 523452362
+		roasbeefSatoshi  = 2340213491
+		elstSophon       = 15433
+		rebalanceAmtSats = 1000
+	)
+	amt := lnwire.NewMSatFromSatoshis(rebalanceAmtSats)
+
+	var payHash [32]byte
+	copy(payHash[:], bytes.Repeat([]byte{1}, 32))
+
+	var invoiceAmt lnwire.MilliSatoshi
+	ctx.router.cfg.AddInvoice = func(invAmt lnwire.MilliSatoshi,
+		_ uint16, _ string) ([32]byte, error) {
+
+		invoiceAmt = invAmt
+		return payHash, nil
+	}
+
+	var preImage [32]byte
+	copy(preImage[:], bytes.Repeat([]byte{9}, 32))
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		htlcAdd *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
+
+		if htlcAdd.PaymentHash != payHash {
+			t.Fatalf("expected payment hash %x, got %x", payHash,
+				htlcAdd.PaymentHash)
+		}
+
+		return preImage, nil
+	}
+
+	paymentPreImage, route, err := ctx.router.Rebalance(
+		roasbeefLuoji, roasbeefSatoshi, amt, noFeeLimit,
+	)
+	if err != nil {
+		t.Fatalf("unable to rebalance: %v", err)
+	}
+
+	if paymentPreImage != preImage {
+		t.Fatalf("expected preimage %x, got %x", preImage,
+			paymentPreImage)
+	}
+	if invoiceAmt != amt {
+		t.Fatalf("expected invoice of %v, got %v", amt, invoiceAmt)
+	}
+
+	expectedChans := []uint64{roasbeefLuoji, luojiSatoshi, roasbeefSatoshi}
+	if len(route.Hops) != len(expectedChans) {
+		t.Fatalf("expected route of %v hops, got %v",
+			len(expectedChans), len(route.Hops))
+	}
+	for i, hop := range route.Hops {
+		if hop.ChannelID != expectedChans[i] {
+			t.Fatalf("expected channel %v at hop %v, got %v",
+				expectedChans[i], i, hop.ChannelID)
+		}
+	}
+	self := Vertex(ctx.router.selfNode.PubKeyBytes)
+	if route.Hops[len(route.Hops)-1].PubKeyBytes != self {
+		t.Fatalf("expected route to end at our own node")
+	}
+
+	// Rebalancing a channel with itself, or a channel that isn't ours,
+	// should be rejected.
+	_, _, err = ctx.router.Rebalance(
+		roasbeefLuoji, roasbeefLuoji, amt, noFeeLimit,
+	)
+	if err == nil {
+		t.Fatalf("expected rebalance of a channel with itself to fail")
+	}
+
+	_, _, err = ctx.router.Rebalance(
+		roasbeefLuoji, elstSophon, amt, noFeeLimit,
+	)
+	if err == nil {
+		t.Fatalf("expected rebalance through foreign channel to fail")
+	}
+}
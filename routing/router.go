@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"fmt"
+	"math"
 	"runtime"
 	"sort"
 	"sync"
@@ -36,6 +37,11 @@ const (
 	// if we should give up on a payment attempt. This will be used if a
 	// value isn't specified in the LightningNode struct.
 	defaultPayAttemptTimeout = time.Duration(time.Second * 60)
+
+	// blockInterval is the expected time between two blocks, used to
+	// estimate the age of a channel from the height of its funding
+	// transaction.
+	blockInterval = 10 * time.Minute
 )
 
 var (
@@ -88,6 +94,10 @@ type ChannelGraphSource interface {
 	IsStaleEdgePolicy(chanID lnwire.ShortChannelID, timestamp time.Time,
 		flags lnwire.ChanUpdateChanFlags) bool
 
+	// MarkEdgeLive clears an edge from our zombie index, deeming it as
+	// live.
+	MarkEdgeLive(chanID lnwire.ShortChannelID) error
+
 	// ForAllOutgoingChannels is used to iterate over all channels
 	// emanating from the "source" node which is the center of the
 	// star-graph.
@@ -142,6 +152,16 @@ type ChannelPolicy struct {
 	// TimeLockDelta is the required HTLC timelock delta to be used
 	// when forwarding payments.
 	TimeLockDelta uint32
+
+	// MaxHTLC is the maximum HTLC size including fees we are allowed to
+	// forward over this channel. A zero value leaves the current maximum
+	// unchanged.
+	MaxHTLC lnwire.MilliSatoshi
+
+	// MinHTLC is the minimum HTLC size including fees we are allowed to
+	// forward over this channel. If nil, the current minimum is left
+	// unchanged.
+	MinHTLC *lnwire.MilliSatoshi
 }
 
 // Config defines the configuration for the ChannelRouter. ALL elements within
@@ -166,10 +186,32 @@ type Config struct {
 	// SendToSwitch is a function that directs a link-layer switch to
 	// forward a fully encoded payment to the first hop in the route
 	// denoted by its public key. A non-nil error is to be returned if the
-	// payment was unsuccessful.
+	// payment was unsuccessful. If non-nil, the onDispatch closure is to
+	// be executed once the payment has been persisted as in flight and
+	// the HTLC has been handed off to the first hop.
 	SendToSwitch func(firstHop lnwire.ShortChannelID,
-		htlcAdd *lnwire.UpdateAddHTLC,
-		circuit *sphinx.Circuit) ([sha256.Size]byte, error)
+		htlcAdd *lnwire.UpdateAddHTLC, circuit *sphinx.Circuit,
+		onDispatch func()) ([sha256.Size]byte, error)
+
+	// GetPaymentResult returns a channel over which the result of the
+	// latest HTLC dispatched for the given payment hash is delivered. The
+	// circuit of the HTLC is used to decrypt any failure it encountered.
+	// This allows the results of HTLCs dispatched before a restart to be
+	// retrieved.
+	GetPaymentResult func(paymentHash [32]byte, circuit *sphinx.Circuit) (
+		<-chan *htlcswitch.PaymentResult, error)
+
+	// CleanPaymentResults removes the results the switch stored for all
+	// payment hashes that aren't part of the passed set. It's called on
+	// startup with the payments we're resuming, as the results of all
+	// other payments will never be retrieved.
+	CleanPaymentResults func(keep map[[32]byte]struct{}) error
+
+	// AddInvoice adds an invoice for the given amount and final CLTV delta
+	// to our invoice registry, and returns its payment hash. It's used to
+	// pay ourselves when rebalancing our channels.
+	AddInvoice func(amt lnwire.MilliSatoshi, finalCltvDelta uint16,
+		memo string) ([32]byte, error)
 
 	// ChannelPruneExpiry is the duration used to determine if a channel
 	// should be pruned or not. If the delta between now and when the
@@ -181,6 +223,17 @@ type Config struct {
 	// should examine the channel graph to garbage collect zombie channels.
 	GraphPruneInterval time.Duration
 
+	// StrictZombiePruning determines whether a channel is already
+	// considered a zombie if only one of its edges hasn't been updated
+	// for ChannelPruneExpiry, rather than both.
+	StrictZombiePruning bool
+
+	// DisableShadowRoute disables padding the final CLTV delta and amount
+	// of outgoing payments with a random shadow route. This is meant for
+	// users that prefer lower time-locks and fees over the privacy of the
+	// destination of their payments.
+	DisableShadowRoute bool
+
 	// QueryBandwidth is a method that allows the router to query the lower
 	// link layer to determine the up to date available bandwidth at a
 	// prospective link to be traversed. If the  link isn't available, then
@@ -194,6 +247,15 @@ type Config struct {
 	// from blocking initial usage of the wallet. This should only be
 	// enabled on testnet.
 	AssumeChannelValid bool
+
+	// MissionControl defines how mission control estimates the success
+	// probability of payment attempts, and how path finding trades off
+	// that probability against fees.
+	MissionControl MissionControlConfig
+
+	// BlockedNodes is the set of nodes that payments are never routed
+	// through.
+	BlockedNodes map[Vertex]struct{}
 }
 
 // routeTuple is an entry within the ChannelRouter's route cache. We cache
@@ -323,6 +385,11 @@ type ChannelRouter struct {
 	// gained to the next execution.
 	missionControl *missionControl
 
+	// payments tracks the payments that have been dispatched through
+	// SendPaymentAsync, such that their results can be retrieved through
+	// TrackPayment once they've concluded.
+	payments *paymentTracker
+
 	// channelEdgeMtx is a mutex we use to make sure we process only one
 	// ChannelEdgePolicy at a time for a given channelID, to ensure
 	// consistency between the various database accesses.
@@ -353,6 +420,11 @@ func New(cfg Config) (*ChannelRouter, error) {
 		return nil, err
 	}
 
+	store, err := newPaymentStore(cfg.Graph.Database())
+	if err != nil {
+		return nil, err
+	}
+
 	r := &ChannelRouter{
 		cfg:               &cfg,
 		networkUpdates:    make(chan *routingMsg),
@@ -362,12 +434,17 @@ func New(cfg Config) (*ChannelRouter, error) {
 		selfNode:          selfNode,
 		routeCache:        make(map[routeTuple][]*Route),
 		rejectCache:       make(map[uint64]struct{}),
+		payments:          newPaymentTracker(store),
 		quit:              make(chan struct{}),
 	}
 
-	r.missionControl = newMissionControl(
-		cfg.Graph, selfNode, cfg.QueryBandwidth,
+	r.missionControl, err = newMissionControl(
+		cfg.Graph, selfNode, cfg.QueryBandwidth, cfg.BlockedNodes,
+		cfg.MissionControl,
 	)
+	if err != nil {
+		return nil, err
+	}
 
 	return r, nil
 }
@@ -452,6 +529,19 @@ func (r *ChannelRouter) Start() error {
 		return err
 	}
 
+	// With the graph synced, we'll remove the asynchronous payments that
+	// concluded long ago, and resume those that were still in flight when
+	// we last shut down.
+	err = r.payments.store.prunePayments(
+		time.Now().Add(-paymentRecordRetention),
+	)
+	if err != nil {
+		return err
+	}
+	if err := r.resumePayments(); err != nil {
+		return err
+	}
+
 	r.wg.Add(1)
 	go r.networkHandler()
 
@@ -614,10 +704,12 @@ func (r *ChannelRouter) syncGraphWithChain() error {
 
 // pruneZombieChans is a method that will be called periodically to prune out
 // any "zombie" channels. We consider channels zombies if *both* edges haven't
-// been updated since our zombie horizon. We do this periodically to keep a
-// health, lively routing table.
+// been updated since our zombie horizon, or if StrictZombiePruning is set, if
+// *either* of them hasn't. We do this periodically to keep a health, lively
+// routing table. Pruned channels are added to the zombie index, so that we
+// don't accept them again unless a fresh update resurrects them.
 func (r *ChannelRouter) pruneZombieChans() error {
-	var chansToPrune []wire.OutPoint
+	var chansToPrune []*channeldb.ChannelEdgeInfo
 	chanExpiry := r.cfg.ChannelPruneExpiry
 
 	log.Infof("Examining Channel Graph for zombie channels")
@@ -638,8 +730,13 @@ func (r *ChannelRouter) pruneZombieChans() error {
 
 		// If *both* edges haven't been updated for a period of
 		// chanExpiry, then we'll mark the channel itself as eligible
-		// for graph pruning.
-		e1Zombie, e2Zombie := true, true
+		// for graph pruning. With strict pruning, a single stale edge
+		// suffices. An edge we haven't received a policy for yet is
+		// only considered stale if the channel itself is older than
+		// chanExpiry, as its policy may simply not have reached us
+		// yet.
+		chanIsOld := r.chanAge(info.ChannelID) >= chanExpiry
+		e1Zombie, e2Zombie := chanIsOld, chanIsOld
 		if e1 != nil {
 			e1Zombie = time.Since(e1.LastUpdate) >= chanExpiry
 			if e1Zombie {
@@ -656,26 +753,22 @@ func (r *ChannelRouter) pruneZombieChans() error {
 					info.ChannelPoint, e2.LastUpdate)
 			}
 		}
-		if e1Zombie && e2Zombie {
+		isZombie := e1Zombie && e2Zombie
+		if r.cfg.StrictZombiePruning {
+			isZombie = e1Zombie || e2Zombie
+		}
+		if isZombie {
 			log.Debugf("ChannelPoint(%v) is a zombie, collecting "+
 				"to prune", info.ChannelPoint)
 
 			// TODO(roasbeef): add ability to delete single
 			// directional edge
-			chansToPrune = append(chansToPrune, info.ChannelPoint)
-
-			// As we're detecting this as a zombie channel, we'll
-			// add this to the set of recently rejected items so we
-			// don't re-accept it shortly after.
-			r.rejectCache[info.ChannelID] = struct{}{}
+			chansToPrune = append(chansToPrune, info)
 		}
 
 		return nil
 	}
 
-	r.rejectMtx.Lock()
-	defer r.rejectMtx.Unlock()
-
 	err := r.cfg.Graph.ForEachChannel(filterPruneChans)
 	if err != nil {
 		return fmt.Errorf("Unable to filter local zombie "+
@@ -685,11 +778,23 @@ func (r *ChannelRouter) pruneZombieChans() error {
 	log.Infof("Pruning %v Zombie Channels", len(chansToPrune))
 
 	// With the set zombie-like channels obtained, we'll do another pass to
-	// delete al zombie channels from the channel graph.
+	// delete al zombie channels from the channel graph. As we're detecting
+	// these as zombie channels, we'll first add them to the zombie index
+	// so we don't re-accept them unless they're resurrected.
 	for _, chanToPrune := range chansToPrune {
-		log.Tracef("Pruning zombie chan ChannelPoint(%v)", chanToPrune)
+		log.Tracef("Pruning zombie chan ChannelPoint(%v)",
+			chanToPrune.ChannelPoint)
 
-		err := r.cfg.Graph.DeleteChannelEdge(&chanToPrune)
+		err := r.cfg.Graph.MarkEdgeZombie(
+			chanToPrune.ChannelID, chanToPrune.NodeKey1Bytes,
+			chanToPrune.NodeKey2Bytes,
+		)
+		if err != nil {
+			return fmt.Errorf("Unable to mark zombie chan: %v",
+				err)
+		}
+
+		err = r.cfg.Graph.DeleteChannelEdge(&chanToPrune.ChannelPoint)
 		if err != nil {
 			return fmt.Errorf("Unable to prune zombie "+
 				"chans: %v", err)
@@ -699,6 +804,18 @@ func (r *ChannelRouter) pruneZombieChans() error {
 	return nil
 }
 
+// chanAge estimates the age of the channel with the given ID from the number
+// of blocks mined on top of its funding transaction.
+func (r *ChannelRouter) chanAge(chanID uint64) time.Duration {
+	fundingHeight := lnwire.NewShortChanIDFromInt(chanID).BlockHeight
+	bestHeight := atomic.LoadUint32(&r.bestHeight)
+	if bestHeight <= fundingHeight {
+		return 0
+	}
+
+	return time.Duration(bestHeight-fundingHeight) * blockInterval
+}
+
 // networkHandler is the primary goroutine for the ChannelRouter. The roles of
 // this goroutine include answering queries related to the state of the
 // network, pruning the graph on new block notification, applying network
@@ -1008,6 +1125,18 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 		}
 		r.rejectMtx.RUnlock()
 
+		// We'll also ignore channels we've pruned as zombies, unless
+		// they've since been resurrected by a fresh update.
+		isZombie, _, _, err := r.cfg.Graph.IsZombieEdge(msg.ChannelID)
+		if err != nil {
+			return errors.Errorf("unable to check for zombie "+
+				"edge: %v", err)
+		}
+		if isZombie {
+			return newErrf(ErrIgnored, "Ignoring msg for zombie "+
+				"chan_id=%v", msg.ChannelID)
+		}
+
 		// Prior to processing the announcement we first check if we
 		// already know of this channel, if so, then we can exit early.
 		_, _, exists, err := r.cfg.Graph.HasChannelEdge(msg.ChannelID)
@@ -1029,6 +1158,12 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 			r.rejectCache[msg.ChannelID] = struct{}{}
 			r.rejectMtx.Unlock()
 
+			// Keep the error code of invalid funding outputs, so
+			// callers can tell them apart from other failures.
+			if IsError(err, ErrInvalidFundingOutput) {
+				return err
+			}
+
 			return errors.Errorf("unable to fetch chan point for "+
 				"chan_id=%v: %v", msg.ChannelID, err)
 		}
@@ -1077,8 +1212,9 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 		// channel edge and also that the announced channel value is
 		// right.
 		if !bytes.Equal(fundingPkScript, chanUtxo.PkScript) {
-			return errors.Errorf("pkScript mismatch: expected %x, "+
-				"got %x", fundingPkScript, chanUtxo.PkScript)
+			return newErrf(ErrInvalidFundingOutput, "pkScript "+
+				"mismatch: expected %x, got %x", fundingPkScript,
+				chanUtxo.PkScript)
 		}
 
 		// TODO(roasbeef): this is a hack, needs to be removed
@@ -1236,14 +1372,23 @@ func (r *ChannelRouter) fetchChanPoint(
 	// block.
 	numTxns := uint32(len(fundingBlock.Transactions))
 	if chanID.TxIndex > numTxns-1 {
-		return nil, nil, fmt.Errorf("tx_index=#%v is out of range "+
-			"(max_index=%v), network_chan_id=%v\n", chanID.TxIndex,
+		return nil, nil, newErrf(ErrInvalidFundingOutput,
+			"tx_index=#%v is out of range (max_index=%v), "+
+				"network_chan_id=%v\n", chanID.TxIndex,
 			numTxns-1, spew.Sdump(chanID))
 	}
 
 	// Finally once we have the block itself, we seek to the targeted
-	// transaction index to obtain the funding output and txout.
+	// transaction index to obtain the funding output and txout, making
+	// sure the output index is within bounds as well.
 	fundingTx := fundingBlock.Transactions[chanID.TxIndex]
+	numOutputs := uint16(len(fundingTx.TxOut))
+	if chanID.TxPosition >= numOutputs {
+		return nil, nil, newErrf(ErrInvalidFundingOutput,
+			"output_index=%v is out of range (max_index=%v), "+
+				"network_chan_id=%v\n", chanID.TxPosition,
+			numOutputs-1, spew.Sdump(chanID))
+	}
 	outPoint := &wire.OutPoint{
 		Hash:  fundingTx.TxHash(),
 		Index: uint32(chanID.TxPosition),
@@ -1315,6 +1460,75 @@ func pathsToFeeSortedRoutes(source Vertex, paths [][]*channeldb.ChannelEdgePolic
 	return validRoutes, nil
 }
 
+// IgnoredEdge identifies a channel, in a single direction, that must not be
+// used by the routes found by FindRestrictedRoutes.
+type IgnoredEdge struct {
+	// ChannelID is the short channel ID of the channel.
+	ChannelID uint64
+
+	// Direction is the direction of the channel. Identical in definition
+	// to the channel direction flag, a value of 0 means the direction from
+	// the node with the lower pubkey to the higher.
+	Direction uint8
+}
+
+// RouteRestrictions houses the restrictions that the routes found by
+// FindRestrictedRoutes must adhere to.
+type RouteRestrictions struct {
+	// FeeLimit is the maximum total fee of a route.
+	FeeLimit lnwire.MilliSatoshi
+
+	// FinalCLTVDelta is the CLTV delta of the final hop of a route. If
+	// zero, DefaultFinalCLTVDelta is used.
+	FinalCLTVDelta uint16
+
+	// IgnoredNodes is an optional set of nodes that routes must not pass
+	// through.
+	IgnoredNodes []Vertex
+
+	// IgnoredEdges is an optional set of channels, in a single direction,
+	// that routes must not use.
+	IgnoredEdges []IgnoredEdge
+
+	// RouteHints is an optional set of routing hints to private channels
+	// that are considered in addition to the channel graph, in order to
+	// reach destinations that aren't publicly advertised.
+	RouteHints [][]HopHint
+
+	// OutgoingChannelID is the channel that routes must take to the first
+	// hop. If nil, any channel may be used.
+	OutgoingChannelID *uint64
+
+	// LastHop is the node that routes must reach the destination through.
+	// If nil, any node may be used.
+	LastHop *Vertex
+
+	// CltvLimit is the maximum number of blocks the total time lock of a
+	// route may exceed the current height by, including the final CLTV
+	// delta. If nil, no limit is enforced.
+	CltvLimit *uint32
+}
+
+// pathCltvLimit converts a limit on the total time lock delta of a route into
+// a limit on the time lock deltas charged by the nodes along its path, which
+// excludes the final CLTV delta.
+func pathCltvLimit(cltvLimit *uint32, finalCLTVDelta uint16) (*uint32,
+	error) {
+
+	if cltvLimit == nil {
+		return nil, nil
+	}
+
+	if *cltvLimit < uint32(finalCLTVDelta) {
+		return nil, newErrf(ErrCltvLimitExceeded, "cltv limit of %v "+
+			"is below the final cltv delta of %v", *cltvLimit,
+			finalCLTVDelta)
+	}
+
+	limit := *cltvLimit - uint32(finalCLTVDelta)
+	return &limit, nil
+}
+
 // FindRoutes attempts to query the ChannelRouter for a bounded number
 // available paths to a particular target destination which is able to send
 // `amt` after factoring in channel capacities and cumulative fees along each
@@ -1328,16 +1542,40 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	amt, feeLimit lnwire.MilliSatoshi, numPaths uint32,
 	finalExpiry ...uint16) ([]*Route, error) {
 
-	var finalCLTVDelta uint16
-	if len(finalExpiry) == 0 {
+	restrictions := &RouteRestrictions{
+		FeeLimit: feeLimit,
+	}
+	if len(finalExpiry) != 0 {
+		restrictions.FinalCLTVDelta = finalExpiry[0]
+	}
+
+	return r.FindRestrictedRoutes(target, amt, restrictions, numPaths)
+}
+
+// FindRestrictedRoutes is identical to FindRoutes, but allows the caller to
+// further restrict the routes found, by excluding nodes and channels, and to
+// provide routing hints to private channels leading up to the destination.
+func (r *ChannelRouter) FindRestrictedRoutes(target *btcec.PublicKey,
+	amt lnwire.MilliSatoshi, restrictions *RouteRestrictions,
+	numPaths uint32) ([]*Route, error) {
+
+	finalCLTVDelta := restrictions.FinalCLTVDelta
+	if finalCLTVDelta == 0 {
 		finalCLTVDelta = DefaultFinalCLTVDelta
-	} else {
-		finalCLTVDelta = finalExpiry[0]
 	}
 
 	dest := target.SerializeCompressed()
 	log.Debugf("Searching for path to %x, sending %v", dest, amt)
 
+	// The route cache only holds routes through the public graph, so it
+	// can't be used if the caller restricted the routes any further.
+	cacheable := len(restrictions.IgnoredNodes) == 0 &&
+		len(restrictions.IgnoredEdges) == 0 &&
+		len(restrictions.RouteHints) == 0 &&
+		restrictions.OutgoingChannelID == nil &&
+		restrictions.LastHop == nil &&
+		restrictions.CltvLimit == nil
+
 	// Before attempting to perform a series of graph traversals to find
 	// the k-shortest paths to the destination, we'll first consult our
 	// path cache
@@ -1349,7 +1587,7 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	// If we already have a cached route, and it contains at least the
 	// number of paths requested, then we'll return it directly as there's
 	// no need to repeat the computation.
-	if ok && uint32(len(routes)) >= numPaths {
+	if cacheable && ok && uint32(len(routes)) >= numPaths {
 		return routes, nil
 	}
 
@@ -1359,11 +1597,13 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	// returned.
 
 	// We can short circuit the routing by opportunistically checking to
-	// see if the target vertex event exists in the current graph.
+	// see if the target vertex event exists in the current graph. If we
+	// were given routing hints, the target may be a private node that
+	// can only be reached through them.
 	targetVertex := NewVertex(target)
 	if _, exists, err := r.cfg.Graph.HasLightningNode(targetVertex); err != nil {
 		return nil, err
-	} else if !exists {
+	} else if !exists && len(restrictions.RouteHints) == 0 {
 		log.Debugf("Target %x is not in known graph", dest)
 		return nil, newErrf(ErrTargetNotInNetwork, "target not found")
 	}
@@ -1375,9 +1615,14 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 		return nil, err
 	}
 
-	// Before we open the db transaction below, we'll attempt to obtain a
-	// set of bandwidth hints that can help us eliminate certain routes
-	// early on in the path finding process.
+	cltvLimit, err := pathCltvLimit(restrictions.CltvLimit, finalCLTVDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	// Before we start path finding, we'll attempt to obtain a set of
+	// bandwidth hints that can help us eliminate certain routes early on
+	// in the path finding process.
 	bandwidthHints, err := generateBandwidthHints(
 		r.selfNode, r.cfg.QueryBandwidth,
 	)
@@ -1385,26 +1630,45 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 		return nil, err
 	}
 
-	tx, err := r.cfg.Graph.Database().Begin(false)
-	if err != nil {
-		tx.Rollback()
-		return nil, err
+	ignoredNodes := make(map[Vertex]struct{})
+	for _, vertex := range restrictions.IgnoredNodes {
+		ignoredNodes[vertex] = struct{}{}
+	}
+	ignoredEdges := make(map[edgeLocator]struct{})
+	for _, edge := range restrictions.IgnoredEdges {
+		locator := edgeLocator{
+			channelID: edge.ChannelID,
+			direction: edge.Direction,
+		}
+		ignoredEdges[locator] = struct{}{}
 	}
 
 	// Now that we know the destination is reachable within the graph,
 	// we'll execute our KSP algorithm to find the k-shortest paths from
 	// our source to the destination.
 	shortestPaths, err := findPaths(
-		tx, r.cfg.Graph, r.selfNode, target, amt, feeLimit, numPaths,
-		bandwidthHints,
+		&graphParams{
+			graph: r.cfg.Graph,
+			additionalEdges: routeHintEdges(
+				restrictions.RouteHints, target,
+			),
+			bandwidthHints: bandwidthHints,
+			blockedNodes:   r.cfg.BlockedNodes,
+		},
+		&restrictParams{
+			ignoredNodes:      ignoredNodes,
+			ignoredEdges:      ignoredEdges,
+			feeLimit:          restrictions.FeeLimit,
+			outgoingChannelID: restrictions.OutgoingChannelID,
+			lastHop:           restrictions.LastHop,
+			cltvLimit:         cltvLimit,
+		},
+		r.selfNode, target, amt, numPaths,
 	)
 	if err != nil {
-		tx.Rollback()
 		return nil, err
 	}
 
-	tx.Rollback()
-
 	// Now that we have a set of paths, we'll need to turn them into
 	// *routes* by computing the required time-lock and fee information for
 	// each path. During this process, some paths may be discarded if they
@@ -1412,13 +1676,33 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	// factored in.
 	sourceVertex := Vertex(r.selfNode.PubKeyBytes)
 	validRoutes, err := pathsToFeeSortedRoutes(
-		sourceVertex, shortestPaths, finalCLTVDelta, amt, feeLimit,
-		uint32(currentHeight),
+		sourceVertex, shortestPaths, finalCLTVDelta, amt,
+		restrictions.FeeLimit, uint32(currentHeight),
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	// As the CLTV limit is only approximated while finding alternative
+	// paths, we'll drop any routes that exceed it.
+	if restrictions.CltvLimit != nil {
+		maxTimeLock := uint32(currentHeight) + *restrictions.CltvLimit
+
+		filtered := validRoutes[:0]
+		for _, route := range validRoutes {
+			if route.TotalTimeLock <= maxTimeLock {
+				filtered = append(filtered, route)
+			}
+		}
+		validRoutes = filtered
+
+		if len(validRoutes) == 0 {
+			return nil, newErrf(ErrCltvLimitExceeded, "unable to "+
+				"find a route within cltv limit of %v",
+				*restrictions.CltvLimit)
+		}
+	}
+
 	go log.Tracef("Obtained %v paths sending %v to %x: %v", len(validRoutes),
 		amt, dest, newLogClosure(func() string {
 			return spew.Sdump(validRoutes)
@@ -1427,9 +1711,11 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 
 	// Populate the cache with this set of fresh routes so we can reuse
 	// them in the future.
-	r.routeCacheMtx.Lock()
-	r.routeCache[rt] = validRoutes
-	r.routeCacheMtx.Unlock()
+	if cacheable {
+		r.routeCacheMtx.Lock()
+		r.routeCache[rt] = validRoutes
+		r.routeCacheMtx.Unlock()
+	}
 
 	return validRoutes, nil
 }
@@ -1554,6 +1840,28 @@ type LightningPayment struct {
 	// hop. If nil, any channel may be used.
 	OutgoingChannelID *uint64
 
+	// LastHop is the node that the payment must reach its destination
+	// through. If nil, any node may be used. Together with
+	// OutgoingChannelID, this allows a circular payment to rebalance a
+	// specific pair of channels.
+	LastHop *Vertex
+
+	// IncomingChannelID is the channel through which LastHop must forward
+	// the payment to its destination. It's only supported for payments to
+	// ourselves. If nil, any channel with LastHop may be used.
+	IncomingChannelID *uint64
+
+	// CltvLimit is the maximum number of blocks the funds of the payment
+	// may be locked up for, which bounds the total time lock of the
+	// routes used, including the final CLTV delta. If nil, no limit is
+	// enforced.
+	CltvLimit *uint32
+
+	// DisableShadowRoute disables padding this payment with a random
+	// shadow route, regardless of the router's configuration. Payments to
+	// ourselves are never padded.
+	DisableShadowRoute bool
+
 	// TODO(roasbeef): add e2e message?
 }
 
@@ -1575,7 +1883,7 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte, *Route
 		return [32]byte{}, nil, err
 	}
 
-	return r.sendPayment(payment, paySession)
+	return r.sendPayment(payment, paySession, nil)
 }
 
 // SendToRoute attempts to send a payment as described within the passed
@@ -1592,7 +1900,305 @@ func (r *ChannelRouter) SendToRoute(routes []*Route,
 		routes,
 	)
 
-	return r.sendPayment(payment, paySession)
+	return r.sendPayment(payment, paySession, nil)
+}
+
+// SendToRouteOnce dispatches a single HTLC with the given payment hash along
+// the route described by the given hops, starting from our own node. In
+// contrast to SendToRoute, no further attempts are made if the HTLC fails, and
+// the failure is returned as is. If the failure originated along the route,
+// it is an *htlcswitch.ForwardingError, which holds the decoded failure message
+// along with the node that reported it. The payment is recorded in the payment
+// store, so its outcome can be retrieved through TrackPayment.
+func (r *ChannelRouter) SendToRouteOnce(paymentHash [32]byte,
+	amt lnwire.MilliSatoshi, timeLock uint32,
+	hops []*Hop) ([32]byte, error) {
+
+	route, err := NewRouteFromHops(
+		amt, timeLock, Vertex(r.selfNode.PubKeyBytes), hops,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	onionBlob, circuit, err := generateSphinxPacket(
+		route, paymentHash[:],
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	htlcAdd := &lnwire.UpdateAddHTLC{
+		Amount:      route.TotalAmount,
+		Expiry:      route.TotalTimeLock,
+		PaymentHash: paymentHash,
+	}
+	copy(htlcAdd.OnionBlob[:], onionBlob)
+
+	// The payment is tracked just like the ones sent through
+	// SendPaymentAsync, such that its outcome can be retrieved through
+	// TrackPayment, and its HTLC is awaited again if we restart while it
+	// is in flight.
+	tracked, err := r.payments.add(&PaymentInfo{
+		PaymentHash:  paymentHash,
+		Value:        route.Hops[len(route.Hops)-1].AmtToForward,
+		CreationTime: time.Now(),
+		State:        PaymentInFlight,
+		Attempts: []*PaymentAttempt{{
+			Route:       route,
+			SessionKey:  circuit.SessionKey,
+			AttemptTime: time.Now(),
+		}},
+	})
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	firstHop := lnwire.NewShortChanIDFromInt(route.Hops[0].ChannelID)
+	preImage, err := r.sendToSwitch(firstHop, htlcAdd, circuit, nil)
+
+	// If we're shutting down, then the payment remains in flight, and is
+	// resumed once we restart.
+	if r.shuttingDown() {
+		return [32]byte{}, fmt.Errorf("router shutting down")
+	}
+
+	if err != nil {
+		log.Errorf("Attempt to send payment %x failed: %v",
+			paymentHash, err)
+
+		updateErr := r.payments.update(tracked, func(p *PaymentInfo) {
+			last := p.Attempts[len(p.Attempts)-1]
+			last.ResolveTime = time.Now()
+			last.Failure = newAttemptFailure(err)
+		})
+		if updateErr != nil {
+			log.Errorf("Unable to persist failed attempt of "+
+				"payment %x: %v", paymentHash, updateErr)
+		}
+		r.payments.conclude(tracked, &PaymentResult{
+			State: PaymentFailed,
+			Err:   err,
+		})
+
+		// We'll still let mission control learn from the failure, if
+		// it occurred along the route.
+		if fErr, ok := err.(*htlcswitch.ForwardingError); ok {
+			errVertex := NewVertex(fErr.ErrorSource)
+			r.missionControl.reportRouteResult(
+				route, &errVertex,
+				!isPolicyFailure(fErr.FailureMessage),
+			)
+		}
+
+		return [32]byte{}, err
+	}
+
+	r.missionControl.reportRouteResult(route, nil, false)
+
+	r.payments.conclude(tracked, &PaymentResult{
+		State:    PaymentSucceeded,
+		Preimage: preImage,
+		Route:    route,
+	})
+
+	return preImage, nil
+}
+
+// BuildRoute constructs a route that delivers the given amount to the last of
+// the passed hops, traversing all of them in order, starting from our own
+// node. Channel policies are taken from the local graph to fill in the fees
+// and time locks of each hop. If multiple channels exist between a pair of
+// hops, the one charging the highest fee is used, so that the route remains
+// valid for any channel the hop chooses to forward over. If non-nil, the
+// first hop is restricted to the given outgoing channel.
+func (r *ChannelRouter) BuildRoute(amt lnwire.MilliSatoshi, hops []Vertex,
+	outgoingChan *uint64, finalCLTVDelta uint16) (*Route, error) {
+
+	if len(hops) == 0 {
+		return nil, ErrNoRouteHopsProvided
+	}
+	if len(hops) > HopLimit {
+		return nil, newErrf(ErrMaxHopsExceeded, "route has %v hops, "+
+			"exceeding the limit of %v", len(hops), HopLimit)
+	}
+
+	if finalCLTVDelta == 0 {
+		finalCLTVDelta = DefaultFinalCLTVDelta
+	}
+
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	bandwidthHints, err := generateBandwidthHints(
+		r.selfNode, r.cfg.QueryBandwidth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll walk the hops backwards, as the amount each channel needs to
+	// carry depends on the fees charged by all hops that come after it.
+	source := Vertex(r.selfNode.PubKeyBytes)
+	pathEdges := make([]*channeldb.ChannelEdgePolicy, len(hops))
+	runningAmt := amt
+	for i := len(hops) - 1; i >= 0; i-- {
+		from := source
+		if i > 0 {
+			from = hops[i-1]
+		}
+
+		edge, err := r.selectChannel(
+			from, hops[i], runningAmt, bandwidthHints,
+			from == source, outgoingChan,
+		)
+		if err != nil {
+			return nil, err
+		}
+		pathEdges[i] = edge
+
+		// The node at the start of this channel charges a fee for
+		// forwarding over it, unless it's us.
+		if i > 0 {
+			runningAmt += computeFee(runningAmt, edge)
+		}
+	}
+
+	return newRoute(
+		amt, lnwire.MilliSatoshi(math.MaxUint64), source, pathEdges,
+		uint32(currentHeight), finalCLTVDelta,
+	)
+}
+
+// selectChannel returns the policy of the channel from one node to another
+// that is able to carry the given amount, while charging the highest fee to
+// do so. Our own channels are only considered if they have sufficient
+// bandwidth, and, if restricted, are the outgoing channel.
+func (r *ChannelRouter) selectChannel(from, to Vertex,
+	amt lnwire.MilliSatoshi, bandwidthHints map[uint64]lnwire.MilliSatoshi,
+	local bool, outgoingChan *uint64) (*channeldb.ChannelEdgePolicy,
+	error) {
+
+	node, err := r.FetchLightningNode(from)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch node %x: %v", from[:],
+			err)
+	}
+
+	var (
+		bestEdge *channeldb.ChannelEdgePolicy
+		bestFee  lnwire.MilliSatoshi
+	)
+	err = node.ForEachChannel(nil, func(_ *bbolt.Tx,
+		info *channeldb.ChannelEdgeInfo, edge,
+		_ *channeldb.ChannelEdgePolicy) error {
+
+		if edge == nil || Vertex(edge.Node.PubKeyBytes) != to {
+			return nil
+		}
+
+		if edge.ChannelFlags&lnwire.ChanUpdateDisabled != 0 {
+			return nil
+		}
+		capacity := lnwire.NewMSatFromSatoshis(info.Capacity)
+		if amt < edge.MinHTLC || amt > capacity {
+			return nil
+		}
+
+		if local {
+			if outgoingChan != nil &&
+				edge.ChannelID != *outgoingChan {
+
+				return nil
+			}
+
+			bandwidth, ok := bandwidthHints[edge.ChannelID]
+			if ok && amt > bandwidth {
+				return nil
+			}
+		}
+
+		fee := computeFee(amt, edge)
+		if bestEdge == nil || fee > bestFee {
+			bestEdge = edge
+			bestFee = fee
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if bestEdge == nil {
+		return nil, newErrf(ErrNoPathFound, "no channel from %x to %x "+
+			"able to carry %v", from[:], to[:], amt)
+	}
+
+	return bestEdge, nil
+}
+
+// isPolicyFailure returns true if the given failure indicates that we used an
+// outdated policy of the failing channel, rather than that the channel is
+// unable to forward.
+func isPolicyFailure(msg lnwire.FailureMessage) bool {
+	switch msg.(type) {
+	case *lnwire.FailAmountBelowMinimum,
+		*lnwire.FailFeeInsufficient,
+		*lnwire.FailIncorrectCltvExpiry:
+
+		return true
+	}
+
+	return false
+}
+
+// attemptHooks is a set of optional closures that are executed by
+// sendPayment as the HTLCs of a payment progress.
+type attemptHooks struct {
+	// onAttempt is executed before the HTLC along the given route is
+	// handed off to the switch. If it returns an error, the payment is
+	// aborted.
+	onAttempt func(route *Route, circuit *sphinx.Circuit) error
+
+	// onDispatch is executed once the HTLC has been persisted as in
+	// flight and handed off to the switch's link of the first hop.
+	onDispatch func()
+
+	// onFailure is executed when the HTLC failed with the given error.
+	onFailure func(err error)
+}
+
+// sendToSwitch hands the HTLC off to the switch and waits for its result.
+// If the router is shutting down in the meantime, then we'll stop waiting, as
+// the switch only gives up on the HTLC once it is stopped itself, which
+// happens after the router has been stopped.
+func (r *ChannelRouter) sendToSwitch(firstHop lnwire.ShortChannelID,
+	htlcAdd *lnwire.UpdateAddHTLC, circuit *sphinx.Circuit,
+	onDispatch func()) ([32]byte, error) {
+
+	type switchResult struct {
+		preimage [32]byte
+		err      error
+	}
+
+	resultChan := make(chan *switchResult, 1)
+	go func() {
+		preimage, err := r.cfg.SendToSwitch(
+			firstHop, htlcAdd, circuit, onDispatch,
+		)
+		resultChan <- &switchResult{preimage: preimage, err: err}
+	}()
+
+	select {
+	case result := <-resultChan:
+		return result.preimage, result.err
+
+	case <-r.quit:
+		return [32]byte{}, fmt.Errorf("router shutting down")
+	}
 }
 
 // sendPayment attempts to send a payment as described within the passed
@@ -1601,9 +2207,15 @@ func (r *ChannelRouter) SendToRoute(routes []*Route,
 // resulted in a failed payment. If the payment succeeds, then a non-nil Route
 // will be returned which describes the path the successful payment traversed
 // within the network to reach the destination. Additionally, the payment
-// preimage will also be returned.
+// preimage will also be returned. If non-nil, the passed hooks are executed
+// as the HTLCs of the payment progress.
 func (r *ChannelRouter) sendPayment(payment *LightningPayment,
-	paySession *paymentSession) ([32]byte, *Route, error) {
+	paySession *paymentSession, hooks *attemptHooks) ([32]byte, *Route,
+	error) {
+
+	if hooks == nil {
+		hooks = &attemptHooks{}
+	}
 
 	log.Tracef("Dispatching route for lightning payment: %v",
 		newLogClosure(func() string {
@@ -1641,6 +2253,23 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		finalCLTVDelta = *payment.FinalCLTVDelta
 	}
 
+	// Unless disabled, we'll pad the payment with a random shadow route,
+	// such that the final hop can't infer that it is the destination from
+	// the time-lock and amount of the HTLC it receives. Pre-built routes
+	// are sent exactly as they were specified, and payments to ourselves
+	// have no final hop to hide from.
+	isSelfPayment := NewVertex(payment.Target) ==
+		Vertex(r.selfNode.PubKeyBytes)
+	if !r.cfg.DisableShadowRoute && !payment.DisableShadowRoute &&
+		!paySession.haveRoutes && !isSelfPayment {
+		payment, finalCLTVDelta, err = r.addShadowRoute(
+			payment, finalCLTVDelta,
+		)
+		if err != nil {
+			return [32]byte{}, nil, err
+		}
+	}
+
 	var payAttemptTimeout time.Duration
 	if payment.PayAttemptTimeout == time.Duration(0) {
 		payAttemptTimeout = defaultPayAttemptTimeout
@@ -1721,10 +2350,19 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		firstHop := lnwire.NewShortChanIDFromInt(
 			route.Hops[0].ChannelID,
 		)
-		preImage, sendError = r.cfg.SendToSwitch(
-			firstHop, htlcAdd, circuit,
+		if hooks.onAttempt != nil {
+			if err := hooks.onAttempt(route, circuit); err != nil {
+				return preImage, nil, err
+			}
+		}
+		preImage, sendError = r.sendToSwitch(
+			firstHop, htlcAdd, circuit, hooks.onDispatch,
 		)
 		if sendError != nil {
+			if hooks.onFailure != nil {
+				hooks.onFailure(sendError)
+			}
+
 			// An error occurred when attempting to send the
 			// payment, depending on the error type, we'll either
 			// continue to send using alternative routes, or simply
@@ -1750,6 +2388,15 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 				return preImage, nil, err
 			}
 
+			// Let mission control know which pairs of nodes were
+			// able to forward the HTLC. A policy failure doesn't
+			// tell us that the channel is unable to forward, so we
+			// won't count it as a failure of the pair.
+			r.missionControl.reportRouteResult(
+				route, &errVertex,
+				!isPolicyFailure(fErr.FailureMessage),
+			)
+
 			// processChannelUpdateAndRetry is a closure that
 			// handles a failure message containing a channel
 			// update. This function always tries to apply the
@@ -1952,6 +2599,8 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 			}
 		}
 
+		r.missionControl.reportRouteResult(route, nil, false)
+
 		return preImage, route, nil
 	}
 }
@@ -2211,12 +2860,17 @@ func (r *ChannelRouter) IsPublicNode(node Vertex) (bool, error) {
 }
 
 // IsKnownEdge returns true if the graph source already knows of the passed
-// channel ID.
+// channel ID either as a live or zombie edge.
 //
 // NOTE: This method is part of the ChannelGraphSource interface.
 func (r *ChannelRouter) IsKnownEdge(chanID lnwire.ShortChannelID) bool {
 	_, _, exists, _ := r.cfg.Graph.HasChannelEdge(chanID.ToUint64())
-	return exists
+	if exists {
+		return true
+	}
+
+	isZombie, _, _, _ := r.cfg.Graph.IsZombieEdge(chanID.ToUint64())
+	return isZombie
 }
 
 // IsStaleEdgePolicy returns true if the graph soruce has a channel edge for
@@ -2234,6 +2888,17 @@ func (r *ChannelRouter) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 
 	}
 
+	// If we know of the edge as a zombie, then the policy is only fresh if
+	// it's recent enough to resurrect the channel.
+	if !exists {
+		isZombie, _, _, err := r.cfg.Graph.IsZombieEdge(
+			chanID.ToUint64(),
+		)
+		if err == nil && isZombie {
+			return time.Since(timestamp) >= r.cfg.ChannelPruneExpiry
+		}
+	}
+
 	// If we don't know of the edge, then it means it's fresh (thus not
 	// stale).
 	if !exists {
@@ -2259,3 +2924,31 @@ func (r *ChannelRouter) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 
 	return false
 }
+
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+//
+// NOTE: This method is part of the ChannelGraphSource interface.
+func (r *ChannelRouter) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
+	return r.cfg.Graph.MarkEdgeLive(chanID.ToUint64())
+}
+
+// QueryMissionControl returns the reliability memory of mission control: the
+// latest result of routing an HTLC between each pair of nodes, along with the
+// current estimate of the probability that the pair is able to forward.
+func (r *ChannelRouter) QueryMissionControl() []*PairHistory {
+	return r.missionControl.GetHistorySnapshot()
+}
+
+// ResetMissionControl resets all of mission control's memory, both in memory
+// and on disk, returning it to a state as if no payment attempts have been
+// made.
+func (r *ChannelRouter) ResetMissionControl() error {
+	return r.missionControl.ResetHistory()
+}
+
+// ImportMissionControl adds the given pair history to mission control's
+// reliability memory. Results that are older than the ones already recorded
+// for the same pairs are ignored.
+func (r *ChannelRouter) ImportMissionControl(history []*PairHistory) error {
+	return r.missionControl.ImportHistory(history)
+}
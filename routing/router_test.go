@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"image/color"
 	"math/rand"
+	"reflect"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -48,11 +50,17 @@ func (c *testCtx) RestartRouter() error {
 		Chain:     c.chain,
 		ChainView: c.chainView,
 		SendToSwitch: func(_ lnwire.ShortChannelID,
-			_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+			_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+			_ func()) ([32]byte, error) {
 			return [32]byte{}, nil
 		},
+		CleanPaymentResults: func(map[[32]byte]struct{}) error {
+			return nil
+		},
 		ChannelPruneExpiry: time.Hour * 24,
 		GraphPruneInterval: time.Hour * 2,
+		MissionControl:     DefaultMissionControlConfig(),
+		DisableShadowRoute: true,
 	})
 	if err != nil {
 		return fmt.Errorf("unable to create router %v", err)
@@ -89,12 +97,18 @@ func createTestCtxFromGraphInstance(startingHeight uint32, graphInstance *testGr
 		Chain:     chain,
 		ChainView: chainView,
 		SendToSwitch: func(_ lnwire.ShortChannelID,
-			_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+			_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+			_ func()) ([32]byte, error) {
 
 			return [32]byte{}, nil
 		},
+		CleanPaymentResults: func(map[[32]byte]struct{}) error {
+			return nil
+		},
 		ChannelPruneExpiry: time.Hour * 24,
 		GraphPruneInterval: time.Hour * 2,
+		MissionControl:     DefaultMissionControlConfig(),
+		DisableShadowRoute: true,
 		QueryBandwidth: func(e *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
 			return lnwire.NewMSatFromSatoshis(e.Capacity)
 		},
@@ -271,6 +285,298 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 	}
 }
 
+// TestFindRestrictedRoutes asserts that routes found by the
+// FindRestrictedRoutes method avoid the ignored nodes and edges, and are able
+// to reach private destinations through routing hints.
+func TestFindRestrictedRoutes(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	target := ctx.aliases["sophon"]
+	songoku := NewVertex(ctx.aliases["songoku"])
+	sophon := NewVertex(target)
+
+	// When ignoring songoku, none of the routes to sophon should pass
+	// through it.
+	routes, err := ctx.router.FindRestrictedRoutes(
+		target, paymentAmt, &RouteRestrictions{
+			FeeLimit:     noFeeLimit,
+			IgnoredNodes: []Vertex{songoku},
+		}, defaultNumRoutes,
+	)
+	if err != nil {
+		t.Fatalf("unable to find any routes: %v", err)
+	}
+	for _, route := range routes {
+		for _, hop := range route.Hops {
+			if hop.PubKeyBytes == songoku {
+				t.Fatalf("route passes through ignored node: "+
+					"%v", spew.Sdump(route))
+			}
+		}
+	}
+
+	// When ignoring the channel from songoku to sophon, none of the routes
+	// should use it in that direction.
+	const songokuSophon = 3495345
+	locator := newEdgeLocatorByPubkeys(songokuSophon, &songoku, &sophon)
+	routes, err = ctx.router.FindRestrictedRoutes(
+		target, paymentAmt, &RouteRestrictions{
+			FeeLimit: noFeeLimit,
+			IgnoredEdges: []IgnoredEdge{{
+				ChannelID: songokuSophon,
+				Direction: locator.direction,
+			}},
+		}, defaultNumRoutes,
+	)
+	if err != nil {
+		t.Fatalf("unable to find any routes: %v", err)
+	}
+	for _, route := range routes {
+		for _, hop := range route.Hops {
+			if hop.ChannelID == songokuSophon {
+				t.Fatalf("route uses ignored edge: %v",
+					spew.Sdump(route))
+			}
+		}
+	}
+
+	// Finally, a private node that isn't part of the graph should be
+	// reachable through a routing hint from sophon.
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to create private key: %v", err)
+	}
+	privateNode := privKey.PubKey()
+
+	routes, err = ctx.router.FindRestrictedRoutes(
+		privateNode, paymentAmt, &RouteRestrictions{
+			FeeLimit: noFeeLimit,
+			RouteHints: [][]HopHint{{{
+				NodeID:          target,
+				ChannelID:       1,
+				CLTVExpiryDelta: 9,
+			}}},
+		}, defaultNumRoutes,
+	)
+	if err != nil {
+		t.Fatalf("unable to find any routes: %v", err)
+	}
+	if len(routes) == 0 {
+		t.Fatalf("expected routes to private node")
+	}
+	for _, route := range routes {
+		numHops := len(route.Hops)
+		if route.Hops[numHops-1].PubKeyBytes != NewVertex(privateNode) ||
+			route.Hops[numHops-1].ChannelID != 1 {
+
+			t.Fatalf("route doesn't use routing hint: %v",
+				spew.Sdump(route))
+		}
+	}
+}
+
+// TestSendToRouteOnce asserts that SendToRouteOnce dispatches a single HTLC
+// along the given route, and returns its failure as is.
+func TestSendToRouteOnce(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	songoku := NewVertex(ctx.aliases["songoku"])
+	sophon := NewVertex(ctx.aliases["sophon"])
+	hops := []*Hop{
+		{
+			PubKeyBytes:      songoku,
+			ChannelID:        12345,
+			AmtToForward:     lnwire.NewMSatFromSatoshis(100),
+			OutgoingTimeLock: 150,
+		},
+		{
+			PubKeyBytes:      sophon,
+			ChannelID:        3495345,
+			AmtToForward:     lnwire.NewMSatFromSatoshis(100),
+			OutgoingTimeLock: 150,
+		},
+	}
+
+	var preImage [32]byte
+	copy(preImage[:], bytes.Repeat([]byte{9}, 32))
+
+	// First, we'll have songoku report a failure, which should be returned
+	// without any further attempts.
+	var numAttempts int
+	fwdErr := &htlcswitch.ForwardingError{
+		ErrorSource:    ctx.aliases["songoku"],
+		FailureMessage: &lnwire.FailTemporaryChannelFailure{},
+	}
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
+
+		numAttempts++
+		if firstHop != lnwire.NewShortChanIDFromInt(12345) {
+			t.Fatalf("unexpected first hop %v", firstHop)
+		}
+
+		return [32]byte{}, fwdErr
+	}
+
+	_, err = ctx.router.SendToRouteOnce(
+		[32]byte{1}, lnwire.NewMSatFromSatoshis(100), 200, hops,
+	)
+	if err != fwdErr {
+		t.Fatalf("expected forwarding error, got %v", err)
+	}
+	if numAttempts != 1 {
+		t.Fatalf("expected a single attempt, got %v", numAttempts)
+	}
+
+	// The failed payment should have been recorded along with its
+	// attempt.
+	info, err := ctx.router.payments.store.fetchPayment([32]byte{1})
+	if err != nil {
+		t.Fatalf("unable to fetch payment: %v", err)
+	}
+	if info.State != PaymentFailed {
+		t.Fatalf("expected payment to be failed, got %v", info.State)
+	}
+	if len(info.Attempts) != 1 || info.Attempts[0].Failure == nil {
+		t.Fatalf("expected a single failed attempt, got %v",
+			spew.Sdump(info.Attempts))
+	}
+
+	// Mission control should have learned that songoku wasn't able to
+	// forward to sophon.
+	mc := ctx.router.missionControl
+	prob := mc.getPairProbability(songoku, sophon, 0, 0)
+	if prob >= mc.estimator.aprioriProbability() {
+		t.Fatalf("expected failure to be recorded, got probability "+
+			"%v", prob)
+	}
+
+	// Once the HTLC is settled, the preimage should be returned.
+	ctx.router.cfg.SendToSwitch = func(_ lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
+
+		return preImage, nil
+	}
+
+	paymentPreImage, err := ctx.router.SendToRouteOnce(
+		[32]byte{1}, lnwire.NewMSatFromSatoshis(100), 200, hops,
+	)
+	if err != nil {
+		t.Fatalf("unable to send to route: %v", err)
+	}
+	if paymentPreImage != preImage {
+		t.Fatalf("expected preimage %x, got %x", preImage,
+			paymentPreImage)
+	}
+
+	// Its outcome should be available through TrackPayment.
+	updates, err := ctx.router.TrackPayment([32]byte{1})
+	if err != nil {
+		t.Fatalf("unable to track payment: %v", err)
+	}
+	result := <-updates
+	if result.State != PaymentSucceeded || result.Preimage != preImage {
+		t.Fatalf("unexpected payment result: %v", spew.Sdump(result))
+	}
+}
+
+// TestBuildRoute asserts that BuildRoute completes a route along the given
+// hops using the channel policies of the graph.
+func TestBuildRoute(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(
+		startingBlockHeight, basicGraphFilePath,
+	)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	songoku := NewVertex(ctx.aliases["songoku"])
+	sophon := NewVertex(ctx.aliases["sophon"])
+
+	// We'll build a route to sophon through songoku, which charges a base
+	// fee of 10 msat and a fee rate of 1000 ppm, along with a time lock
+	// delta of 1.
+	amt := lnwire.NewMSatFromSatoshis(100)
+	route, err := ctx.router.BuildRoute(
+		amt, []Vertex{songoku, sophon}, nil, 10,
+	)
+	if err != nil {
+		t.Fatalf("unable to build route: %v", err)
+	}
+
+	expectedHops := []*Hop{
+		{
+			PubKeyBytes:      songoku,
+			ChannelID:        12345,
+			AmtToForward:     amt,
+			OutgoingTimeLock: startingBlockHeight + 10,
+		},
+		{
+			PubKeyBytes:      sophon,
+			ChannelID:        3495345,
+			AmtToForward:     amt,
+			OutgoingTimeLock: startingBlockHeight + 10,
+		},
+	}
+	if !reflect.DeepEqual(route.Hops, expectedHops) {
+		t.Fatalf("expected hops %v, got %v", spew.Sdump(expectedHops),
+			spew.Sdump(route.Hops))
+	}
+	if route.TotalFees != 110 {
+		t.Fatalf("expected fees of 110 msat, got %v", route.TotalFees)
+	}
+	if route.TotalAmount != amt+110 {
+		t.Fatalf("expected total amount %v, got %v", amt+110,
+			route.TotalAmount)
+	}
+	if route.TotalTimeLock != startingBlockHeight+11 {
+		t.Fatalf("expected total time lock %v, got %v",
+			startingBlockHeight+11, route.TotalTimeLock)
+	}
+
+	// As we don't have a channel with sophon, a route to it that doesn't
+	// pass through any other node can't be built.
+	_, err = ctx.router.BuildRoute(amt, []Vertex{sophon}, nil, 10)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected ErrNoPathFound, got: %v", err)
+	}
+
+	// Restricting the first hop to a channel that doesn't lead to songoku
+	// should fail as well.
+	outgoingChan := uint64(999991)
+	_, err = ctx.router.BuildRoute(
+		amt, []Vertex{songoku, sophon}, &outgoingChan, 10,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected ErrNoPathFound, got: %v", err)
+	}
+}
+
 // TestSendPaymentRouteFailureFallback tests that when sending a payment, if
 // one of the target routes is seen as unavailable, then the next route in the
 // queue is used instead. This process should continue until either a payment
@@ -306,7 +612,8 @@ func TestSendPaymentRouteFailureFallback(t *testing.T) {
 	// first hop. This should force the router to instead take the
 	// available two hop path (through satoshi).
 	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
-		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
 
 		roasbeefLuoji := lnwire.NewShortChanIDFromInt(689530843)
 		if firstHop == roasbeefLuoji {
@@ -443,7 +750,8 @@ func TestChannelUpdateValidation(t *testing.T) {
 	// payment with an error originating from the first hop of the route.
 	// The unsigned channel update is attached to the failure message.
 	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
-		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
 
 		return [32]byte{}, &htlcswitch.ForwardingError{
 			ErrorSource: ctx.aliases["b"],
@@ -565,7 +873,8 @@ func TestSendPaymentErrorRepeatedFeeInsufficient(t *testing.T) {
 	// outgoing channel to Son goku. This will be a fee related error, so
 	// it should only cause the edge to be pruned after the second attempt.
 	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
-		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
 
 		roasbeefSongoku := lnwire.NewShortChanIDFromInt(chanID)
 		if firstHop == roasbeefSongoku {
@@ -673,7 +982,8 @@ func TestSendPaymentErrorNonFinalTimeLockErrors(t *testing.T) {
 	// error, we should fail the payment flow all together, as Goku is the
 	// only channel to Sophon.
 	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
-		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
 
 		if firstHop == roasbeefSongoku {
 			return [32]byte{}, &htlcswitch.ForwardingError{
@@ -728,7 +1038,8 @@ func TestSendPaymentErrorNonFinalTimeLockErrors(t *testing.T) {
 	// instead, this should result in the same behavior of roasbeef routing
 	// around the faulty Son Goku node.
 	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
-		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
 
 		if firstHop == roasbeefSongoku {
 			return [32]byte{}, &htlcswitch.ForwardingError{
@@ -798,7 +1109,8 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 	// TODO(roasbeef): filtering should be intelligent enough so just not
 	// go through satoshi at all at this point.
 	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
-		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
 
 		if firstHop == roasbeefLuoji {
 			// We'll first simulate an error from the first
@@ -845,7 +1157,8 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 	// wasn't originally online. This should also halt the send all
 	// together as all paths contain luoji and he can't be reached.
 	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
-		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
 
 		if firstHop == roasbeefLuoji {
 			return [32]byte{}, &htlcswitch.ForwardingError{
@@ -889,7 +1202,8 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 	// roasbeef -> luoji channel has insufficient capacity. This should
 	// again cause us to instead go via the satoshi route.
 	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
-		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+		_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+		_ func()) ([32]byte, error) {
 
 		if firstHop == roasbeefLuoji {
 			// We'll first simulate an error from the first
@@ -1544,11 +1858,17 @@ func TestWakeUpOnStaleBranch(t *testing.T) {
 		Chain:     ctx.chain,
 		ChainView: ctx.chainView,
 		SendToSwitch: func(_ lnwire.ShortChannelID,
-			_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit) ([32]byte, error) {
+			_ *lnwire.UpdateAddHTLC, _ *sphinx.Circuit,
+			_ func()) ([32]byte, error) {
 			return [32]byte{}, nil
 		},
+		CleanPaymentResults: func(map[[32]byte]struct{}) error {
+			return nil
+		},
 		ChannelPruneExpiry: time.Hour * 24,
 		GraphPruneInterval: time.Hour * 2,
+		MissionControl:     DefaultMissionControlConfig(),
+		DisableShadowRoute: true,
 	})
 	if err != nil {
 		t.Fatalf("unable to create router %v", err)
@@ -2220,6 +2540,212 @@ func TestIsStaleEdgePolicy(t *testing.T) {
 	}
 }
 
+// TestPruneZombieChans tests that channels with stale edges are pruned from
+// the graph and added to the zombie index, and that they can only be added
+// back once they've been resurrected.
+func TestPruneZombieChans(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxSingleNode(startingBlockHeight)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var (
+		pub1 [33]byte
+		pub2 [33]byte
+	)
+	copy(pub1[:], priv1.PubKey().SerializeCompressed())
+	copy(pub2[:], priv2.PubKey().SerializeCompressed())
+
+	fundingTx, _, chanID, err := createChannelEdge(ctx,
+		bitcoinKey1.SerializeCompressed(),
+		bitcoinKey2.SerializeCompressed(),
+		10000, 500)
+	if err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: pub1,
+		BitcoinKey2Bytes: pub2,
+		AuthProof:        nil,
+	}
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+
+	// We'll add a fresh policy for the first direction, and a stale one
+	// for the second.
+	staleTimestamp := time.Now().Add(-ctx.router.cfg.ChannelPruneExpiry * 2)
+	for i, lastUpdate := range []time.Time{time.Now(), staleTimestamp} {
+		edgePolicy := &channeldb.ChannelEdgePolicy{
+			SigBytes:                  testSig.Serialize(),
+			ChannelID:                 edge.ChannelID,
+			LastUpdate:                lastUpdate,
+			TimeLockDelta:             10,
+			MinHTLC:                   1,
+			FeeBaseMSat:               10,
+			FeeProportionalMillionths: 10000,
+			ChannelFlags:              lnwire.ChanUpdateChanFlags(i),
+		}
+		if err := ctx.router.UpdateEdge(edgePolicy); err != nil {
+			t.Fatalf("unable to update edge policy: %v", err)
+		}
+	}
+
+	// As only one of the edges is stale, the channel shouldn't be pruned
+	// by default.
+	if err := ctx.router.pruneZombieChans(); err != nil {
+		t.Fatalf("unable to prune zombie chans: %v", err)
+	}
+	if !ctx.router.IsKnownEdge(*chanID) {
+		t.Fatalf("expected channel to not be pruned")
+	}
+	isZombie, _, _, err := ctx.graph.IsZombieEdge(edge.ChannelID)
+	if err != nil {
+		t.Fatalf("unable to query zombie index: %v", err)
+	}
+	if isZombie {
+		t.Fatalf("expected channel to not be a zombie")
+	}
+
+	// With strict pruning, a single stale edge suffices to prune the
+	// channel and mark it as a zombie.
+	ctx.router.cfg.StrictZombiePruning = true
+	if err := ctx.router.pruneZombieChans(); err != nil {
+		t.Fatalf("unable to prune zombie chans: %v", err)
+	}
+	_, _, exists, err := ctx.graph.HasChannelEdge(edge.ChannelID)
+	if err != nil {
+		t.Fatalf("unable to query for edge: %v", err)
+	}
+	if exists {
+		t.Fatalf("expected channel to be pruned")
+	}
+	isZombie, _, _, err = ctx.graph.IsZombieEdge(edge.ChannelID)
+	if err != nil {
+		t.Fatalf("unable to query zombie index: %v", err)
+	}
+	if !isZombie {
+		t.Fatalf("expected channel to be a zombie")
+	}
+
+	// The zombie should still be known, so that we don't request it from
+	// our peers, and only recent updates may resurrect it.
+	if !ctx.router.IsKnownEdge(*chanID) {
+		t.Fatalf("expected zombie channel to be known")
+	}
+	if !ctx.router.IsStaleEdgePolicy(*chanID, staleTimestamp, 1) {
+		t.Fatalf("expected stale update for zombie to be stale")
+	}
+	if ctx.router.IsStaleEdgePolicy(*chanID, time.Now(), 1) {
+		t.Fatalf("expected fresh update for zombie to be fresh")
+	}
+
+	// Re-adding the channel should be ignored while it's a zombie.
+	err = ctx.router.AddEdge(edge)
+	if !IsError(err, ErrIgnored) {
+		t.Fatalf("expected zombie channel to be ignored, got: %v", err)
+	}
+
+	// Once resurrected, the channel can be added again.
+	if err := ctx.router.MarkEdgeLive(*chanID); err != nil {
+		t.Fatalf("unable to mark edge live: %v", err)
+	}
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+}
+
+// TestPruneZombieChansNoPolicies tests that channels we haven't received any
+// policies for are only pruned as zombies once the channel itself is older
+// than the prune expiry.
+func TestPruneZombieChansNoPolicies(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxSingleNode(startingBlockHeight)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var (
+		pub1 [33]byte
+		pub2 [33]byte
+	)
+	copy(pub1[:], priv1.PubKey().SerializeCompressed())
+	copy(pub2[:], priv2.PubKey().SerializeCompressed())
+
+	const fundingHeight = 500
+	fundingTx, _, chanID, err := createChannelEdge(ctx,
+		bitcoinKey1.SerializeCompressed(),
+		bitcoinKey2.SerializeCompressed(),
+		10000, fundingHeight)
+	if err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: pub1,
+		BitcoinKey2Bytes: pub2,
+		AuthProof:        nil,
+	}
+	if err := ctx.router.AddEdge(edge); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+
+	// A recent channel without any policies shouldn't be pruned, as its
+	// policies may simply not have reached us yet.
+	atomic.StoreUint32(&ctx.router.bestHeight, fundingHeight+6)
+	if err := ctx.router.pruneZombieChans(); err != nil {
+		t.Fatalf("unable to prune zombie chans: %v", err)
+	}
+	_, _, exists, err := ctx.graph.HasChannelEdge(edge.ChannelID)
+	if err != nil {
+		t.Fatalf("unable to query for edge: %v", err)
+	}
+	if !exists {
+		t.Fatalf("expected recent channel to not be pruned")
+	}
+
+	// Once the channel is older than the prune expiry, it should be
+	// pruned, as we still haven't received any policies for it.
+	expiryBlocks := uint32(
+		ctx.router.cfg.ChannelPruneExpiry / blockInterval,
+	)
+	atomic.StoreUint32(
+		&ctx.router.bestHeight, fundingHeight+expiryBlocks,
+	)
+	if err := ctx.router.pruneZombieChans(); err != nil {
+		t.Fatalf("unable to prune zombie chans: %v", err)
+	}
+	_, _, exists, err = ctx.graph.HasChannelEdge(edge.ChannelID)
+	if err != nil {
+		t.Fatalf("unable to query for edge: %v", err)
+	}
+	if exists {
+		t.Fatalf("expected old channel to be pruned")
+	}
+}
+
 // TestEmptyRoutesGenerateSphinxPacket tests that the generateSphinxPacket
 // function is able to gracefully handle being passed a nil set of hops for the
 // route by the caller.
@@ -2232,3 +2758,91 @@ func TestEmptyRoutesGenerateSphinxPacket(t *testing.T) {
 		t.Fatalf("expected empty hops error: instead got: %v", err)
 	}
 }
+
+// TestAddEdgeInvalidFundingOutput asserts that channel edges referencing a
+// funding output that doesn't exist, or that doesn't pay to the multi-sig
+// script of the channel's bitcoin keys, are rejected with
+// ErrInvalidFundingOutput, while edges whose funding output has since been
+// spent are rejected without it.
+func TestAddEdgeInvalidFundingOutput(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight,
+		basicGraphFilePath)
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+	defer cleanUp()
+
+	var pub1, pub2 [33]byte
+	copy(pub1[:], bitcoinKey1.SerializeCompressed())
+	copy(pub2[:], bitcoinKey2.SerializeCompressed())
+
+	fundingTx, chanUtxo, chanID, err := createChannelEdge(ctx, pub1[:],
+		pub2[:], 10000, 500)
+	if err != nil {
+		t.Fatalf("unable to create channel edge: %v", err)
+	}
+	fundingBlock := &wire.MsgBlock{
+		Transactions: []*wire.MsgTx{fundingTx},
+	}
+	ctx.chain.addBlock(fundingBlock, chanID.BlockHeight, chanID.BlockHeight)
+
+	// The funding transaction only has a single output, so an edge
+	// referencing its second output should be rejected.
+	invalidChanID := *chanID
+	invalidChanID.TxPosition = 1
+	edge := &channeldb.ChannelEdgeInfo{
+		ChannelID:        invalidChanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: pub1,
+		BitcoinKey2Bytes: pub2,
+	}
+	err = ctx.router.AddEdge(edge)
+	if !IsError(err, ErrInvalidFundingOutput) {
+		t.Fatalf("expected ErrInvalidFundingOutput, got: %v", err)
+	}
+
+	// An edge with bitcoin keys that don't match the funding output
+	// should be rejected as well.
+	priv3, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	var pub3 [33]byte
+	copy(pub3[:], priv3.PubKey().SerializeCompressed())
+
+	edge = &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: pub1,
+		BitcoinKey2Bytes: pub3,
+	}
+	err = ctx.router.AddEdge(edge)
+	if !IsError(err, ErrInvalidFundingOutput) {
+		t.Fatalf("expected ErrInvalidFundingOutput, got: %v", err)
+	}
+
+	// Finally, a valid edge whose funding output has already been spent
+	// should be rejected too, but not as invalid, as the channel may have
+	// been closed after it was announced.
+	ctx.chain.spendUtxo(*chanUtxo)
+	edge = &channeldb.ChannelEdgeInfo{
+		ChannelID:        chanID.ToUint64(),
+		NodeKey1Bytes:    pub1,
+		NodeKey2Bytes:    pub2,
+		BitcoinKey1Bytes: pub1,
+		BitcoinKey2Bytes: pub2,
+	}
+	err = ctx.router.AddEdge(edge)
+	if err == nil {
+		t.Fatal("expected edge with spent funding output to be rejected")
+	}
+	if IsError(err, ErrInvalidFundingOutput) {
+		t.Fatalf("expected spent funding output not to be reported "+
+			"as invalid, got: %v", err)
+	}
+}
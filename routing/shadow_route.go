@@ -0,0 +1,193 @@
+package routing
+
+import (
+	prand "math/rand"
+	"sync"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// maxShadowHops is the maximum number of channels that a shadow route
+	// will extend beyond the actual destination of a payment.
+	maxShadowHops = 3
+
+	// maxShadowCltvDelta is the maximum number of blocks that a shadow
+	// route will add to the final CLTV delta of a payment.
+	maxShadowCltvDelta = 288
+
+	// shadowFeeBudgetDivisor determines the fraction of a payment's fee
+	// limit that may be spent on the fee padding of a shadow route.
+	shadowFeeBudgetDivisor = 10
+)
+
+var (
+	// shadowRand is the source of randomness for the walks of shadow
+	// routes. It is kept local to the package to not interfere with users
+	// of the global source.
+	shadowRand = prand.New(prand.NewSource(time.Now().UnixNano()))
+
+	// shadowRandMtx guards shadowRand, which isn't safe for concurrent
+	// use, against payments that are being sent in parallel.
+	shadowRandMtx sync.Mutex
+)
+
+// shadowIntn returns a random number in [0, n) drawn from shadowRand.
+func shadowIntn(n int) int {
+	shadowRandMtx.Lock()
+	defer shadowRandMtx.Unlock()
+
+	return shadowRand.Intn(n)
+}
+
+// shadowRoute describes the padding that is added to a payment in order to
+// make it look like the payment is forwarded beyond its actual destination.
+// Without this padding, the final hop of a route can trivially infer that it
+// is the destination of the payment from the minimal time-lock delta and the
+// exact amount it is sent.
+type shadowRoute struct {
+	// cltvDelta is the number of blocks that is added to the final CLTV
+	// delta of the payment.
+	cltvDelta uint16
+
+	// fee is the amount that is added to the value of the payment that
+	// the destination receives, mimicking the fees of the shadow route.
+	fee lnwire.MilliSatoshi
+}
+
+// buildShadowRoute constructs the padding for a payment of amt to target by
+// taking a random walk through the graph starting at the target, summing up
+// the time-lock deltas and fees of the channels that are traversed. At each
+// step, the walk ends with a probability of one half. Channels that would
+// push the padding beyond either maxCltvDelta or maxFee end the walk as well,
+// such that the returned padding never exceeds those limits.
+func buildShadowRoute(graph *channeldb.ChannelGraph, target *btcec.PublicKey,
+	amt lnwire.MilliSatoshi, maxCltvDelta uint16,
+	maxFee lnwire.MilliSatoshi) (*shadowRoute, error) {
+
+	shadow := &shadowRoute{}
+
+	// If the target isn't part of our graph, for example because it is
+	// only reachable through private channels, then it has no channels to
+	// walk along, leaving the payment without padding.
+	node := NewVertex(target)
+	visited := map[Vertex]struct{}{
+		node: {},
+	}
+
+	for i := 0; i < maxShadowHops; i++ {
+		if shadowIntn(2) == 0 {
+			break
+		}
+
+		// Collect all channels out of the current node that are
+		// eligible to extend the shadow route with.
+		type candidate struct {
+			peer   Vertex
+			policy *channeldb.ChannelEdgePolicy
+		}
+		var candidates []candidate
+		err := graph.ForEachCachedNodeChannel(node, func(
+			_ *channeldb.ChannelEdgeInfo,
+			outEdge, _ *channeldb.ChannelEdgePolicy,
+			peer *channeldb.LightningNode) error {
+
+			if outEdge == nil {
+				return nil
+			}
+
+			isDisabled := outEdge.ChannelFlags &
+				lnwire.ChanUpdateDisabled
+			if isDisabled != 0 {
+				return nil
+			}
+
+			if amt < outEdge.MinHTLC {
+				return nil
+			}
+
+			cltvDelta := uint32(shadow.cltvDelta) +
+				uint32(outEdge.TimeLockDelta)
+			if cltvDelta > uint32(maxCltvDelta) {
+				return nil
+			}
+
+			fee := shadow.fee + computeFee(amt, outEdge)
+			if fee > maxFee {
+				return nil
+			}
+
+			candidates = append(candidates, candidate{
+				peer:   Vertex(peer.PubKeyBytes),
+				policy: outEdge,
+			})
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+
+		next := candidates[shadowIntn(len(candidates))]
+
+		// Walking back to a node we already visited would create a
+		// loop, which can't be part of a real route.
+		if _, ok := visited[next.peer]; ok {
+			break
+		}
+		visited[next.peer] = struct{}{}
+
+		shadow.cltvDelta += next.policy.TimeLockDelta
+		shadow.fee += computeFee(amt, next.policy)
+		node = next.peer
+	}
+
+	return shadow, nil
+}
+
+// addShadowRoute returns a copy of the payment that is padded with a random
+// shadow route, together with the padded final CLTV delta. The padding is
+// bounded such that most of the CLTV limit and fee limit of the payment
+// remain available to the actual route.
+func (r *ChannelRouter) addShadowRoute(payment *LightningPayment,
+	finalCLTVDelta uint16) (*LightningPayment, uint16, error) {
+
+	maxCltvDelta := uint16(maxShadowCltvDelta)
+	if payment.CltvLimit != nil {
+		// If the limit can't even accommodate the final CLTV delta,
+		// we'll leave it to path finding to reject the payment.
+		if *payment.CltvLimit <= uint32(finalCLTVDelta) {
+			return payment, finalCLTVDelta, nil
+		}
+
+		budget := (*payment.CltvLimit - uint32(finalCLTVDelta)) / 4
+		if budget < uint32(maxCltvDelta) {
+			maxCltvDelta = uint16(budget)
+		}
+	}
+	maxFee := payment.FeeLimit / shadowFeeBudgetDivisor
+
+	shadow, err := buildShadowRoute(
+		r.cfg.Graph, payment.Target, payment.Amount, maxCltvDelta,
+		maxFee,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	log.Debugf("Padding payment %x with shadow route: cltv_delta=%v, "+
+		"fee=%v", payment.PaymentHash, shadow.cltvDelta, shadow.fee)
+
+	padded := *payment
+	padded.Amount += shadow.fee
+	padded.FeeLimit -= shadow.fee
+
+	return &padded, finalCLTVDelta + shadow.cltvDelta, nil
+}
@@ -0,0 +1,127 @@
+package routing
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestBuildShadowRoute asserts that the padding of a shadow route corresponds
+// to a random walk from the target, and that it respects the given limits.
+func TestBuildShadowRoute(t *testing.T) {
+	t.Parallel()
+
+	// Set up a chain of channels behind the target that all share the
+	// same policy, such that the padding of a walk of n hops is exactly n
+	// times the time-lock delta and fee of that policy.
+	policy := &testChannelPolicy{
+		Expiry:  40,
+		FeeRate: 1000,
+		MinHTLC: 1,
+	}
+	testChannels := []*testChannel{
+		symmetricTestChannel("roasbeef", "target", 100000, policy, 1),
+		symmetricTestChannel("target", "a", 100000, policy, 2),
+		symmetricTestChannel("a", "b", 100000, policy, 3),
+		symmetricTestChannel("b", "c", 100000, policy, 4),
+	}
+
+	testGraphInstance, err := createTestGraphFromChannels(testChannels)
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+	defer testGraphInstance.cleanUp()
+
+	graph := testGraphInstance.graph
+	target := testGraphInstance.aliasMap["target"]
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+	hopFee := paymentAmt * policy.FeeRate / 1000000
+
+	testCases := []struct {
+		name         string
+		maxCltvDelta uint16
+		maxFee       lnwire.MilliSatoshi
+		maxHops      uint16
+	}{
+		{
+			name:         "unrestricted",
+			maxCltvDelta: maxShadowCltvDelta,
+			maxFee:       noFeeLimit,
+			maxHops:      maxShadowHops,
+		},
+		{
+			name:         "cltv limited",
+			maxCltvDelta: 2*policy.Expiry + 1,
+			maxFee:       noFeeLimit,
+			maxHops:      2,
+		},
+		{
+			name:         "fee limited",
+			maxCltvDelta: maxShadowCltvDelta,
+			maxFee:       hopFee,
+			maxHops:      1,
+		},
+		{
+			name:         "no budget",
+			maxCltvDelta: 0,
+			maxFee:       0,
+			maxHops:      0,
+		},
+	}
+
+	for _, test := range testCases {
+		var padded bool
+		for i := 0; i < 200; i++ {
+			shadow, err := buildShadowRoute(
+				graph, target, paymentAmt, test.maxCltvDelta,
+				test.maxFee,
+			)
+			if err != nil {
+				t.Fatalf("%v: unable to build shadow route: %v",
+					test.name, err)
+			}
+
+			hops := shadow.cltvDelta / policy.Expiry
+			if shadow.cltvDelta%policy.Expiry != 0 ||
+				hops > test.maxHops {
+
+				t.Fatalf("%v: unexpected cltv delta %v",
+					test.name, shadow.cltvDelta)
+			}
+			expectedFee := lnwire.MilliSatoshi(hops) * hopFee
+			if shadow.fee != expectedFee {
+				t.Fatalf("%v: expected fee %v for %v hops, "+
+					"got %v", test.name, expectedFee, hops,
+					shadow.fee)
+			}
+
+			if hops > 0 {
+				padded = true
+			}
+		}
+
+		if test.maxHops > 0 && !padded {
+			t.Fatalf("%v: payment never padded", test.name)
+		}
+	}
+
+	// A target that isn't part of the graph can't be padded.
+	privKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to create private key: %v", err)
+	}
+	shadow, err := buildShadowRoute(
+		graph, privKey.PubKey(), paymentAmt, maxShadowCltvDelta,
+		noFeeLimit,
+	)
+	if err != nil {
+		t.Fatalf("unable to build shadow route: %v", err)
+	}
+	if shadow.cltvDelta != 0 || shadow.fee != 0 {
+		t.Fatalf("expected no padding for unknown target, got "+
+			"cltv delta %v and fee %v", shadow.cltvDelta,
+			shadow.fee)
+	}
+}
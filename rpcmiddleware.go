@@ -0,0 +1,427 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+const (
+	// middlewareFeedbackTimeout is the time we'll wait for a middleware to
+	// respond to an intercepted message before failing the RPC.
+	middlewareFeedbackTimeout = 5 * time.Second
+
+	// registerMiddlewareURI is the URI of the RPC middlewares register
+	// through, which is never intercepted itself.
+	registerMiddlewareURI = "/lnrpc.Lightning/RegisterRPCMiddleware"
+)
+
+var (
+	// errMiddlewareTimeout is returned when a middleware doesn't respond to
+	// an intercepted message in time.
+	errMiddlewareTimeout = errors.New("timeout waiting for rpc " +
+		"middleware feedback")
+
+	// errMiddlewareShutdown is returned when a middleware unregisters
+	// while an intercepted message is waiting for its feedback.
+	errMiddlewareShutdown = errors.New("rpc middleware shutting down")
+)
+
+// rpcMiddlewareRegistry keeps track of the registered RPC middlewares, and
+// hands them the requests and responses of the RPCs authenticated with a
+// macaroon carrying the custom caveat they're registered for.
+type rpcMiddlewareRegistry struct {
+	// middlewares maps the custom caveat names to the middleware
+	// registered for them.
+	middlewares map[string]*rpcMiddleware
+	mtx         sync.RWMutex
+}
+
+// newRPCMiddlewareRegistry creates a new registry without any middlewares.
+func newRPCMiddlewareRegistry() *rpcMiddlewareRegistry {
+	return &rpcMiddlewareRegistry{
+		middlewares: make(map[string]*rpcMiddleware),
+	}
+}
+
+// register registers the middleware for its custom caveat name. An error is
+// returned if another middleware is already registered for it.
+func (r *rpcMiddlewareRegistry) register(mw *rpcMiddleware) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if _, ok := r.middlewares[mw.caveatName]; ok {
+		return fmt.Errorf("rpc middleware already registered for "+
+			"custom caveat %v", mw.caveatName)
+	}
+
+	r.middlewares[mw.caveatName] = mw
+
+	return nil
+}
+
+// unregister removes the middleware from the registry.
+func (r *rpcMiddlewareRegistry) unregister(mw *rpcMiddleware) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.middlewares[mw.caveatName] == mw {
+		delete(r.middlewares, mw.caveatName)
+	}
+}
+
+// intercept hands the message to the middleware registered for each custom
+// caveat of the macaroon the RPC was authenticated with, in the order the
+// caveats were added. The returned message is the one to proceed with, which
+// may have been replaced by a middleware.
+func (r *rpcMiddlewareRegistry) intercept(ctx context.Context, fullURI string,
+	msg proto.Message, isResponse bool) (proto.Message, error) {
+
+	if fullURI == registerMiddlewareURI {
+		return msg, nil
+	}
+
+	mac, err := macaroons.MacaroonFromContext(ctx, "macaroon")
+	if err != nil {
+		return nil, err
+	}
+
+	caveats := macaroons.CustomCaveats(mac)
+	if len(caveats) == 0 {
+		return msg, nil
+	}
+
+	rawMac, err := mac.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, caveat := range caveats {
+		// If no middleware is registered for a caveat, we can't
+		// enforce it, so the RPC must fail.
+		r.mtx.RLock()
+		mw, ok := r.middlewares[caveat.Name]
+		r.mtx.RUnlock()
+		if !ok {
+			return nil, fmt.Errorf("no rpc middleware registered "+
+				"for custom caveat %v", caveat.Name)
+		}
+
+		msg, err = mw.intercept(
+			fullURI, msg, isResponse, rawMac, caveat.Condition,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return msg, nil
+}
+
+// newMiddlewareUnaryInterceptor returns a gRPC interceptor that hands the
+// request and response of unary RPCs to the middlewares of the registry they
+// should be intercepted by.
+func newMiddlewareUnaryInterceptor(
+	r *rpcMiddlewareRegistry) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		reqMsg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		reqMsg, err := r.intercept(ctx, info.FullMethod, reqMsg, false)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := handler(ctx, reqMsg)
+		if err != nil {
+			return nil, err
+		}
+
+		respMsg, ok := resp.(proto.Message)
+		if !ok {
+			return resp, nil
+		}
+
+		return r.intercept(ctx, info.FullMethod, respMsg, true)
+	}
+}
+
+// newMiddlewareStreamInterceptor returns a gRPC interceptor that hands the
+// requests and responses sent over streaming RPCs to the middlewares of the
+// registry they should be intercepted by.
+func newMiddlewareStreamInterceptor(
+	r *rpcMiddlewareRegistry) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		return handler(srv, &middlewareServerStream{
+			ServerStream: ss,
+			registry:     r,
+			fullURI:      info.FullMethod,
+		})
+	}
+}
+
+// middlewareServerStream wraps a grpc.ServerStream to hand the messages sent
+// over it to the middlewares they should be intercepted by.
+type middlewareServerStream struct {
+	grpc.ServerStream
+
+	registry *rpcMiddlewareRegistry
+	fullURI  string
+}
+
+// RecvMsg receives the next request of the stream, and replaces it with the
+// one returned by the middlewares.
+func (s *middlewareServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	newMsg, err := s.registry.intercept(s.Context(), s.fullURI, msg, false)
+	if err != nil {
+		return err
+	}
+
+	// The request is received into the message passed by the handler, so
+	// a replacement needs to be copied over.
+	if newMsg != msg {
+		msg.Reset()
+		proto.Merge(msg, newMsg)
+	}
+
+	return nil
+}
+
+// SendMsg sends the response returned by the middlewares in place of the
+// given one.
+func (s *middlewareServerStream) SendMsg(m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return s.ServerStream.SendMsg(m)
+	}
+
+	newMsg, err := s.registry.intercept(s.Context(), s.fullURI, msg, true)
+	if err != nil {
+		return err
+	}
+
+	return s.ServerStream.SendMsg(newMsg)
+}
+
+// rpcMiddleware is a middleware registered through a RegisterRPCMiddleware
+// stream.
+type rpcMiddleware struct {
+	// nextMsgID is the ID of the last intercepted message. It must be
+	// used atomically, and is kept first for 64-bit alignment.
+	nextMsgID uint64
+
+	// name is the name the middleware registered with.
+	name string
+
+	// caveatName is the name of the custom caveat the middleware
+	// intercepts the RPCs of.
+	caveatName string
+
+	// stream is the bidirectional RPC stream to the middleware. Sends
+	// over it must be serialized, as gRPC streams don't support
+	// concurrent sends.
+	stream  lnrpc.Lightning_RegisterRPCMiddlewareServer
+	sendMtx sync.Mutex
+
+	// feedbacks maps the IDs of the intercepted messages awaiting the
+	// middleware's feedback to the channels it's delivered over.
+	feedbacks map[uint64]chan *lnrpc.InterceptFeedback
+	mtx       sync.Mutex
+
+	// feedbackTimeout is the time we'll wait for the middleware to
+	// respond to an intercepted message.
+	feedbackTimeout time.Duration
+
+	quit chan struct{}
+}
+
+// newRPCMiddleware creates a new middleware for the given stream.
+func newRPCMiddleware(name, caveatName string,
+	stream lnrpc.Lightning_RegisterRPCMiddlewareServer) *rpcMiddleware {
+
+	return &rpcMiddleware{
+		name:            name,
+		caveatName:      caveatName,
+		stream:          stream,
+		feedbacks:       make(map[uint64]chan *lnrpc.InterceptFeedback),
+		feedbackTimeout: middlewareFeedbackTimeout,
+		quit:            make(chan struct{}),
+	}
+}
+
+// run delivers the middleware's feedback to the intercepted messages awaiting
+// it until the stream is closed.
+func (m *rpcMiddleware) run() error {
+	defer close(m.quit)
+
+	for {
+		resp, err := m.stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case resp.Register != nil:
+			return fmt.Errorf("rpc middleware %v already "+
+				"registered", m.name)
+
+		case resp.Feedback == nil:
+			return fmt.Errorf("rpc middleware %v sent no "+
+				"feedback", m.name)
+		}
+
+		m.deliverFeedback(resp.Feedback)
+	}
+}
+
+// deliverFeedback delivers the feedback to the intercepted message it
+// references. Feedback for messages that are no longer awaiting it, e.g.
+// because it came too late, is ignored.
+func (m *rpcMiddleware) deliverFeedback(feedback *lnrpc.InterceptFeedback) {
+	m.mtx.Lock()
+	feedbackChan, ok := m.feedbacks[feedback.RefMsgId]
+	delete(m.feedbacks, feedback.RefMsgId)
+	m.mtx.Unlock()
+
+	if !ok {
+		rpcsLog.Warnf("Ignoring feedback of rpc middleware %v for "+
+			"unknown message %v", m.name, feedback.RefMsgId)
+		return
+	}
+
+	feedbackChan <- feedback
+}
+
+// intercept sends the message to the middleware and waits for its feedback.
+// The returned message is either the original one or the middleware's
+// replacement for it.
+func (m *rpcMiddleware) intercept(fullURI string, msg proto.Message,
+	isResponse bool, rawMac []byte, condition string) (proto.Message,
+	error) {
+
+	serialized, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	msgID := atomic.AddUint64(&m.nextMsgID, 1)
+	feedbackChan := make(chan *lnrpc.InterceptFeedback, 1)
+
+	m.mtx.Lock()
+	m.feedbacks[msgID] = feedbackChan
+	m.mtx.Unlock()
+
+	defer func() {
+		m.mtx.Lock()
+		delete(m.feedbacks, msgID)
+		m.mtx.Unlock()
+	}()
+
+	m.sendMtx.Lock()
+	err = m.stream.Send(&lnrpc.RPCMiddlewareRequest{
+		MsgId:                 msgID,
+		FullUri:               fullURI,
+		IsResponse:            isResponse,
+		TypeName:              proto.MessageName(msg),
+		Serialized:            serialized,
+		RawMacaroon:           rawMac,
+		CustomCaveatCondition: condition,
+	})
+	m.sendMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var feedback *lnrpc.InterceptFeedback
+	select {
+	case feedback = <-feedbackChan:
+	case <-time.After(m.feedbackTimeout):
+		return nil, errMiddlewareTimeout
+	case <-m.quit:
+		return nil, errMiddlewareShutdown
+	}
+
+	if feedback.Error != "" {
+		return nil, fmt.Errorf("rejected by rpc middleware %v: %v",
+			m.name, feedback.Error)
+	}
+
+	if !feedback.ReplaceMessage {
+		return msg, nil
+	}
+
+	replacement := proto.Clone(msg)
+	replacement.Reset()
+	err = proto.Unmarshal(feedback.ReplacementSerialized, replacement)
+	if err != nil {
+		return nil, fmt.Errorf("invalid replacement from rpc "+
+			"middleware %v: %v", m.name, err)
+	}
+
+	return replacement, nil
+}
+
+// chainUnaryServerInterceptors returns a unary interceptor calling the outer
+// interceptor first, which then passes the request on to the inner one. This
+// is needed as a gRPC server only supports a single unary interceptor.
+func chainUnaryServerInterceptors(outer,
+	inner grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		return outer(ctx, req, info, func(ctx context.Context,
+			req interface{}) (interface{}, error) {
+
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+// chainStreamServerInterceptors returns a stream interceptor calling the
+// outer interceptor first, which then passes the stream on to the inner one.
+// This is needed as a gRPC server only supports a single stream interceptor.
+func chainStreamServerInterceptors(outer,
+	inner grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		return outer(srv, ss, info, func(srv interface{},
+			ss grpc.ServerStream) error {
+
+			return inner(srv, ss, info, handler)
+		})
+	}
+}
@@ -0,0 +1,631 @@
+// +build !rpctest
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/macaroons"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	macaroon "gopkg.in/macaroon.v2"
+)
+
+const testMiddlewareURI = "/lnrpc.Lightning/GetInfo"
+
+// mockMiddlewareStream is a mock RegisterRPCMiddleware stream, which hands
+// the intercepted messages sent over it to the test, and receives the
+// feedback sent by the test. Closing quit disconnects the middleware.
+type mockMiddlewareStream struct {
+	grpc.ServerStream
+
+	requests  chan *lnrpc.RPCMiddlewareRequest
+	responses chan *lnrpc.RPCMiddlewareResponse
+	quit      chan struct{}
+}
+
+func newMockMiddlewareStream() *mockMiddlewareStream {
+	return &mockMiddlewareStream{
+		requests:  make(chan *lnrpc.RPCMiddlewareRequest, 10),
+		responses: make(chan *lnrpc.RPCMiddlewareResponse, 10),
+		quit:      make(chan struct{}),
+	}
+}
+
+func (s *mockMiddlewareStream) Send(req *lnrpc.RPCMiddlewareRequest) error {
+	select {
+	case s.requests <- req:
+		return nil
+	case <-s.quit:
+		return io.EOF
+	}
+}
+
+func (s *mockMiddlewareStream) Recv() (*lnrpc.RPCMiddlewareResponse, error) {
+	select {
+	case resp := <-s.responses:
+		return resp, nil
+	case <-s.quit:
+		return nil, io.EOF
+	}
+}
+
+// receive returns the next message intercepted by the middleware.
+func (s *mockMiddlewareStream) receive(
+	t *testing.T) *lnrpc.RPCMiddlewareRequest {
+
+	t.Helper()
+
+	select {
+	case req := <-s.requests:
+		return req
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no message intercepted")
+		return nil
+	}
+}
+
+// replace sends the middleware's feedback replacing the referenced message
+// with the passed one.
+func (s *mockMiddlewareStream) replace(t *testing.T, msgID uint64,
+	replacement proto.Message) {
+
+	t.Helper()
+
+	serialized, err := proto.Marshal(replacement)
+	if err != nil {
+		t.Fatalf("unable to serialize replacement: %v", err)
+	}
+
+	s.responses <- &lnrpc.RPCMiddlewareResponse{
+		Feedback: &lnrpc.InterceptFeedback{
+			RefMsgId:              msgID,
+			ReplaceMessage:        true,
+			ReplacementSerialized: serialized,
+		},
+	}
+}
+
+// registerTestMiddleware registers a middleware for the custom caveat with
+// the passed name, and runs it until its stream is closed.
+func registerTestMiddleware(t *testing.T, registry *rpcMiddlewareRegistry,
+	caveatName string) (*rpcMiddleware, *mockMiddlewareStream) {
+
+	stream := newMockMiddlewareStream()
+	mw := newRPCMiddleware("test-"+caveatName, caveatName, stream)
+	if err := registry.register(mw); err != nil {
+		t.Fatalf("unable to register middleware: %v", err)
+	}
+
+	go func() {
+		defer registry.unregister(mw)
+		_ = mw.run()
+	}()
+
+	return mw, stream
+}
+
+// middlewareTestContext returns the context of an RPC authenticated with a
+// macaroon carrying a custom caveat for each of the passed names, in order.
+func middlewareTestContext(t *testing.T,
+	caveatNames ...string) context.Context {
+
+	mac, err := macaroon.New(
+		[]byte("dummyRootKey"), []byte("dummyId"), "lnd",
+		macaroon.LatestVersion,
+	)
+	if err != nil {
+		t.Fatalf("unable to create macaroon: %v", err)
+	}
+
+	for _, name := range caveatNames {
+		constraint := macaroons.CustomConstraint(name, "cond-"+name)
+		if err := constraint(mac); err != nil {
+			t.Fatalf("unable to add custom caveat: %v", err)
+		}
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("unable to serialize macaroon: %v", err)
+	}
+
+	md := metadata.Pairs("macaroon", hex.EncodeToString(macBytes))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// TestRPCMiddlewareFeedbackRouting asserts that the feedback of a middleware
+// is delivered to the intercepted message it references, even if feedback
+// for concurrently intercepted messages arrives out of order.
+func TestRPCMiddlewareFeedbackRouting(t *testing.T) {
+	t.Parallel()
+
+	registry := newRPCMiddlewareRegistry()
+	_, stream := registerTestMiddleware(t, registry, "test")
+	defer close(stream.quit)
+
+	ctx := middlewareTestContext(t, "test")
+
+	type result struct {
+		msg proto.Message
+		err error
+	}
+
+	aliases := []string{"first", "second"}
+	results := make(map[string]chan result)
+	for _, alias := range aliases {
+		resultChan := make(chan result, 1)
+		results[alias] = resultChan
+
+		go func(alias string) {
+			msg, err := registry.intercept(
+				ctx, testMiddlewareURI,
+				&lnrpc.GetInfoResponse{Alias: alias}, true,
+			)
+			resultChan <- result{msg: msg, err: err}
+		}(alias)
+	}
+
+	// Wait for both messages to be intercepted, then reply to them in the
+	// reverse order.
+	reqs := []*lnrpc.RPCMiddlewareRequest{
+		stream.receive(t), stream.receive(t),
+	}
+	for i := len(reqs) - 1; i >= 0; i-- {
+		req := reqs[i]
+
+		switch {
+		case req.FullUri != testMiddlewareURI:
+			t.Fatalf("expected uri %v, got %v", testMiddlewareURI,
+				req.FullUri)
+
+		case !req.IsResponse:
+			t.Fatalf("expected response to be intercepted")
+
+		case req.TypeName != "lnrpc.GetInfoResponse":
+			t.Fatalf("unexpected type name %v", req.TypeName)
+
+		case req.CustomCaveatCondition != "cond-test":
+			t.Fatalf("unexpected caveat condition %v",
+				req.CustomCaveatCondition)
+		}
+
+		var intercepted lnrpc.GetInfoResponse
+		err := proto.Unmarshal(req.Serialized, &intercepted)
+		if err != nil {
+			t.Fatalf("unable to parse intercepted message: %v",
+				err)
+		}
+
+		stream.replace(t, req.MsgId, &lnrpc.GetInfoResponse{
+			Alias: intercepted.Alias + "-replaced",
+		})
+	}
+
+	for _, alias := range aliases {
+		select {
+		case res := <-results[alias]:
+			if res.err != nil {
+				t.Fatalf("unable to intercept message: %v",
+					res.err)
+			}
+
+			got := res.msg.(*lnrpc.GetInfoResponse).Alias
+			if got != alias+"-replaced" {
+				t.Fatalf("expected alias %v-replaced, got %v",
+					alias, got)
+			}
+
+		case <-time.After(5 * time.Second):
+			t.Fatalf("message %v not delivered", alias)
+		}
+	}
+}
+
+// TestRPCMiddlewareTimeout asserts that an RPC fails if its middleware doesn't
+// respond in time.
+func TestRPCMiddlewareTimeout(t *testing.T) {
+	t.Parallel()
+
+	registry := newRPCMiddlewareRegistry()
+	mw, stream := registerTestMiddleware(t, registry, "test")
+	defer close(stream.quit)
+
+	mw.feedbackTimeout = 10 * time.Millisecond
+
+	_, err := registry.intercept(
+		middlewareTestContext(t, "test"), testMiddlewareURI,
+		&lnrpc.GetInfoRequest{}, false,
+	)
+	if err != errMiddlewareTimeout {
+		t.Fatalf("expected timeout, got %v", err)
+	}
+
+	// The timed out message should no longer await feedback.
+	mw.mtx.Lock()
+	numPending := len(mw.feedbacks)
+	mw.mtx.Unlock()
+	if numPending != 0 {
+		t.Fatalf("expected no pending feedback, got %v", numPending)
+	}
+}
+
+// TestRPCMiddlewareShutdown asserts that RPCs awaiting the feedback of a
+// middleware fail once the middleware disconnects.
+func TestRPCMiddlewareShutdown(t *testing.T) {
+	t.Parallel()
+
+	registry := newRPCMiddlewareRegistry()
+	_, stream := registerTestMiddleware(t, registry, "test")
+
+	ctx := middlewareTestContext(t, "test")
+	errChan := make(chan error, 1)
+	go func() {
+		_, err := registry.intercept(
+			ctx, testMiddlewareURI, &lnrpc.GetInfoRequest{}, false,
+		)
+		errChan <- err
+	}()
+
+	// Once the message has been intercepted, the middleware disconnects
+	// without responding to it.
+	stream.receive(t)
+	close(stream.quit)
+
+	select {
+	case err := <-errChan:
+		if err != errMiddlewareShutdown {
+			t.Fatalf("expected shutdown error, got %v", err)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("intercepted message not failed")
+	}
+}
+
+// TestRPCMiddlewareUnaryReplacement asserts that both the request and the
+// response of a unary RPC can be replaced by a middleware.
+func TestRPCMiddlewareUnaryReplacement(t *testing.T) {
+	t.Parallel()
+
+	registry := newRPCMiddlewareRegistry()
+	_, stream := registerTestMiddleware(t, registry, "test")
+	defer close(stream.quit)
+
+	interceptor := newMiddlewareUnaryInterceptor(registry)
+	info := &grpc.UnaryServerInfo{
+		FullMethod: "/lnrpc.Lightning/ListChannels",
+	}
+	handler := func(ctx context.Context,
+		req interface{}) (interface{}, error) {
+
+		if !req.(*lnrpc.ListChannelsRequest).InactiveOnly {
+			return nil, fmt.Errorf("request not replaced")
+		}
+
+		return &lnrpc.ListChannelsResponse{}, nil
+	}
+
+	type result struct {
+		resp interface{}
+		err  error
+	}
+	ctx := middlewareTestContext(t, "test")
+	resultChan := make(chan result, 1)
+	go func() {
+		resp, err := interceptor(
+			ctx, &lnrpc.ListChannelsRequest{ActiveOnly: true},
+			info, handler,
+		)
+		resultChan <- result{resp: resp, err: err}
+	}()
+
+	req := stream.receive(t)
+	if req.IsResponse {
+		t.Fatalf("expected request to be intercepted first")
+	}
+	stream.replace(t, req.MsgId, &lnrpc.ListChannelsRequest{
+		InactiveOnly: true,
+	})
+
+	req = stream.receive(t)
+	if !req.IsResponse {
+		t.Fatalf("expected response to be intercepted")
+	}
+	stream.replace(t, req.MsgId, &lnrpc.ListChannelsResponse{
+		Channels: []*lnrpc.Channel{{ChanId: 1}},
+	})
+
+	select {
+	case res := <-resultChan:
+		if res.err != nil {
+			t.Fatalf("unable to call rpc: %v", res.err)
+		}
+
+		resp := res.resp.(*lnrpc.ListChannelsResponse)
+		if len(resp.Channels) != 1 || resp.Channels[0].ChanId != 1 {
+			t.Fatalf("response not replaced: %v", resp)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("rpc didn't complete")
+	}
+}
+
+// mockServerStream is a mock grpc.ServerStream of a streaming RPC, which
+// receives the given requests and records the responses sent over it.
+type mockServerStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	reqs []proto.Message
+	sent []interface{}
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockServerStream) RecvMsg(m interface{}) error {
+	if len(s.reqs) == 0 {
+		return io.EOF
+	}
+
+	proto.Merge(m.(proto.Message), s.reqs[0])
+	s.reqs = s.reqs[1:]
+
+	return nil
+}
+
+func (s *mockServerStream) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// TestRPCMiddlewareStreamReplacement asserts that both the requests and the
+// responses sent over a streaming RPC can be replaced by a middleware.
+func TestRPCMiddlewareStreamReplacement(t *testing.T) {
+	t.Parallel()
+
+	registry := newRPCMiddlewareRegistry()
+	_, stream := registerTestMiddleware(t, registry, "test")
+	defer close(stream.quit)
+
+	interceptor := newMiddlewareStreamInterceptor(registry)
+	info := &grpc.StreamServerInfo{
+		FullMethod: "/lnrpc.Lightning/SendPayment",
+	}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		var req lnrpc.SendRequest
+		if err := ss.RecvMsg(&req); err != nil {
+			return err
+		}
+		if req.PaymentRequest != "replaced" {
+			return fmt.Errorf("request not replaced")
+		}
+
+		return ss.SendMsg(&lnrpc.SendResponse{
+			PaymentError: "original",
+		})
+	}
+
+	ss := &mockServerStream{
+		ctx: middlewareTestContext(t, "test"),
+		reqs: []proto.Message{
+			&lnrpc.SendRequest{PaymentRequest: "original"},
+		},
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- interceptor(nil, ss, info, handler)
+	}()
+
+	req := stream.receive(t)
+	if req.IsResponse {
+		t.Fatalf("expected request to be intercepted first")
+	}
+	stream.replace(t, req.MsgId, &lnrpc.SendRequest{
+		PaymentRequest: "replaced",
+	})
+
+	req = stream.receive(t)
+	if !req.IsResponse {
+		t.Fatalf("expected response to be intercepted")
+	}
+	stream.replace(t, req.MsgId, &lnrpc.SendResponse{
+		PaymentError: "replaced",
+	})
+
+	select {
+	case err := <-errChan:
+		if err != nil {
+			t.Fatalf("unable to call rpc: %v", err)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("rpc didn't complete")
+	}
+
+	if len(ss.sent) != 1 {
+		t.Fatalf("expected 1 response, got %v", len(ss.sent))
+	}
+	resp := ss.sent[0].(*lnrpc.SendResponse)
+	if resp.PaymentError != "replaced" {
+		t.Fatalf("response not replaced: %v", resp)
+	}
+}
+
+// TestRPCMiddlewareNotRegistered asserts that RPCs authenticated with a
+// macaroon carrying a custom caveat are rejected if no middleware is
+// registered for it.
+func TestRPCMiddlewareNotRegistered(t *testing.T) {
+	t.Parallel()
+
+	registry := newRPCMiddlewareRegistry()
+	msg := &lnrpc.GetInfoRequest{}
+
+	// Without any custom caveats, the message is passed on untouched.
+	newMsg, err := registry.intercept(
+		middlewareTestContext(t), testMiddlewareURI, msg, false,
+	)
+	if err != nil {
+		t.Fatalf("unable to intercept message: %v", err)
+	}
+	if newMsg != msg {
+		t.Fatalf("message unexpectedly replaced")
+	}
+
+	ctx := middlewareTestContext(t, "unknown")
+	_, err = registry.intercept(ctx, testMiddlewareURI, msg, false)
+	if err == nil {
+		t.Fatalf("expected rpc to be rejected")
+	}
+
+	// Middlewares must be able to register with such a macaroon though.
+	newMsg, err = registry.intercept(ctx, registerMiddlewareURI, msg, false)
+	if err != nil {
+		t.Fatalf("unable to intercept message: %v", err)
+	}
+	if newMsg != msg {
+		t.Fatalf("message unexpectedly replaced")
+	}
+}
+
+// TestRPCMiddlewareCaveatOrder asserts that the middlewares of multiple custom
+// caveats intercept a message in the order the caveats were added, each
+// receiving the replacement of the previous one.
+func TestRPCMiddlewareCaveatOrder(t *testing.T) {
+	t.Parallel()
+
+	registry := newRPCMiddlewareRegistry()
+	_, firstStream := registerTestMiddleware(t, registry, "first")
+	defer close(firstStream.quit)
+	_, secondStream := registerTestMiddleware(t, registry, "second")
+	defer close(secondStream.quit)
+
+	type result struct {
+		msg proto.Message
+		err error
+	}
+	ctx := middlewareTestContext(t, "first", "second")
+	resultChan := make(chan result, 1)
+	go func() {
+		msg, err := registry.intercept(
+			ctx, testMiddlewareURI,
+			&lnrpc.GetInfoResponse{Alias: "a"}, true,
+		)
+		resultChan <- result{msg: msg, err: err}
+	}()
+
+	for _, mw := range []struct {
+		name   string
+		stream *mockMiddlewareStream
+	}{
+		{name: "first", stream: firstStream},
+		{name: "second", stream: secondStream},
+	} {
+		req := mw.stream.receive(t)
+
+		var intercepted lnrpc.GetInfoResponse
+		err := proto.Unmarshal(req.Serialized, &intercepted)
+		if err != nil {
+			t.Fatalf("unable to parse intercepted message: %v",
+				err)
+		}
+
+		mw.stream.replace(t, req.MsgId, &lnrpc.GetInfoResponse{
+			Alias: intercepted.Alias + "-" + mw.name,
+		})
+	}
+
+	select {
+	case res := <-resultChan:
+		if res.err != nil {
+			t.Fatalf("unable to intercept message: %v", res.err)
+		}
+
+		alias := res.msg.(*lnrpc.GetInfoResponse).Alias
+		if alias != "a-first-second" {
+			t.Fatalf("expected alias a-first-second, got %v",
+				alias)
+		}
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("message not delivered")
+	}
+}
+
+// TestChainedInterceptors asserts that chained interceptors call the outer
+// interceptor first, followed by the inner one and the handler.
+func TestChainedInterceptors(t *testing.T) {
+	t.Parallel()
+
+	expectedOrder := []string{"outer", "inner", "handler"}
+
+	var unaryOrder []string
+	unaryInterceptor := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{},
+			info *grpc.UnaryServerInfo,
+			handler grpc.UnaryHandler) (interface{}, error) {
+
+			unaryOrder = append(unaryOrder, name)
+			return handler(ctx, req)
+		}
+	}
+
+	unary := chainUnaryServerInterceptors(
+		unaryInterceptor("outer"), unaryInterceptor("inner"),
+	)
+	_, err := unary(context.Background(), nil, &grpc.UnaryServerInfo{},
+		func(ctx context.Context, req interface{}) (interface{},
+			error) {
+
+			unaryOrder = append(unaryOrder, "handler")
+			return nil, nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to call unary interceptors: %v", err)
+	}
+	if !reflect.DeepEqual(unaryOrder, expectedOrder) {
+		t.Fatalf("expected unary order %v, got %v", expectedOrder,
+			unaryOrder)
+	}
+
+	var streamOrder []string
+	streamInterceptor := func(name string) grpc.StreamServerInterceptor {
+		return func(srv interface{}, ss grpc.ServerStream,
+			info *grpc.StreamServerInfo,
+			handler grpc.StreamHandler) error {
+
+			streamOrder = append(streamOrder, name)
+			return handler(srv, ss)
+		}
+	}
+
+	stream := chainStreamServerInterceptors(
+		streamInterceptor("outer"), streamInterceptor("inner"),
+	)
+	err = stream(nil, &mockServerStream{}, &grpc.StreamServerInfo{},
+		func(srv interface{}, ss grpc.ServerStream) error {
+			streamOrder = append(streamOrder, "handler")
+			return nil
+		},
+	)
+	if err != nil {
+		t.Fatalf("unable to call stream interceptors: %v", err)
+	}
+	if !reflect.DeepEqual(streamOrder, expectedOrder) {
+		t.Fatalf("expected stream order %v, got %v", expectedOrder,
+			streamOrder)
+	}
+}
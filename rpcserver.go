@@ -10,8 +10,10 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"net"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -28,16 +30,23 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/brontide"
 	"github.com/lightningnetwork/lnd/build"
+	"github.com/lightningnetwork/lnd/chainmonitor"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channelnotifier"
+	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/health"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/routing"
@@ -47,6 +56,7 @@ import (
 	"github.com/tv42/zbase32"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/encoding/gzip" // Register the gzip compressor.
 	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
@@ -139,6 +149,10 @@ var (
 			Entity: "signer",
 			Action: "generate",
 		},
+		{
+			Entity: "macaroon",
+			Action: "write",
+		},
 	}
 
 	// invoicePermissions is a slice of all the entities that allows a user
@@ -197,6 +211,14 @@ var (
 			Entity: "peers",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/CheckPeerConnectivity": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/DrainPeer": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/OpenChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -204,6 +226,13 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/ChannelAcceptor": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/OpenChannelSync": {{
 			Entity: "onchain",
 			Action: "write",
@@ -211,6 +240,13 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/FundingStateStep": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/CloseChannel": {{
 			Entity: "onchain",
 			Action: "write",
@@ -226,6 +262,18 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/GetRecoveryInfo": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/GetHealth": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SubscribeChainBackendEvents": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/ListPeers": {{
 			Entity: "peers",
 			Action: "read",
@@ -278,6 +326,10 @@ var (
 			Entity: "invoices",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/RefreshInvoiceHints": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ListInvoices": {{
 			Entity: "invoices",
 			Action: "read",
@@ -330,10 +382,22 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/ExportPaymentProof": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/VerifyPaymentProof": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/DebugLevel": {{
 			Entity: "info",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/VerifyChanState": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/DecodePayReq": {{
 			Entity: "offchain",
 			Action: "read",
@@ -346,10 +410,42 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/ListFeeClamps": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/UpdateFeeClamp": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ForwardingHistory": {{
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SwitchStats": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ListHtlcs": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/UpdateNodeAnnouncement": {{
+			Entity: "info",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListBannedPeers": {{
+			Entity: "peers",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/UnbanPeer": {{
+			Entity: "peers",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/RegisterRPCMiddleware": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
 	}
 )
 
@@ -388,6 +484,11 @@ type rpcServer struct {
 	// connect to the main gRPC server to proxy all incoming requests.
 	tlsCfg *tls.Config
 
+	// middlewareRegistry keeps track of the middlewares registered through
+	// RegisterRPCMiddleware. It's nil if macaroons are disabled, as
+	// middlewares are selected by the custom caveats of macaroons.
+	middlewareRegistry *rpcMiddlewareRegistry
+
 	quit chan struct{}
 }
 
@@ -403,8 +504,8 @@ var _ lnrpc.LightningServer = (*rpcServer)(nil)
 func newRPCServer(s *server, macService *macaroons.Service,
 	subServerCgs *subRPCServerConfigs, serverOpts []grpc.ServerOption,
 	restServerOpts []grpc.DialOption, atpl *autopilot.Manager,
-	invoiceRegistry *invoices.InvoiceRegistry,
-	tlsCfg *tls.Config) (*rpcServer, error) {
+	invoiceRegistry *invoices.InvoiceRegistry, tlsCfg *tls.Config,
+	stateService *stateServer) (*rpcServer, error) {
 
 	var (
 		subServers     []lnrpc.SubServer
@@ -416,7 +517,8 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	// server configuration struct.
 	err := subServerCgs.PopulateDependencies(
 		s.cc, networkDir, macService, atpl, invoiceRegistry,
-		activeNetParams.Params, s.chanRouter,
+		activeNetParams.Params, s.chanRouter, s.htlcSwitch, s.sweeper,
+		s.ntfnTracker, s.chanStatusMgr,
 	)
 	if err != nil {
 		return nil, err
@@ -458,12 +560,44 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	// If macaroons aren't disabled (a non-nil service), then we'll set up
 	// our set of interceptors which will allow us handle the macaroon
 	// authentication in a single location .
+	var middlewareRegistry *rpcMiddlewareRegistry
 	if macService != nil {
+		// If destructive RPCs require a second authentication factor,
+		// the interceptors will also enforce it.
+		var needsConfirm macaroons.ConfirmationChecker
+		if cfg.ConfirmDestructive {
+			needsConfirm = newConfirmationChecker(
+				btcutil.Amount(cfg.ConfirmSendThreshold),
+			)
+		}
+
+		// Once authenticated, requests are handed to the RPC
+		// middlewares they should be intercepted by. The State service
+		// bypasses both, as it doesn't require a macaroon.
+		middlewareRegistry = newRPCMiddlewareRegistry()
 		unaryInterceptor := grpc.UnaryInterceptor(
-			macService.UnaryServerInterceptor(permissions),
+			skipUnaryInterceptorForState(
+				chainUnaryServerInterceptors(
+					macService.UnaryServerInterceptor(
+						permissions, needsConfirm,
+					),
+					newMiddlewareUnaryInterceptor(
+						middlewareRegistry,
+					),
+				),
+			),
 		)
 		streamInterceptor := grpc.StreamInterceptor(
-			macService.StreamServerInterceptor(permissions),
+			skipStreamInterceptorForState(
+				chainStreamServerInterceptors(
+					macService.StreamServerInterceptor(
+						permissions, needsConfirm,
+					),
+					newMiddlewareStreamInterceptor(
+						middlewareRegistry,
+					),
+				),
+			),
 		)
 
 		serverOpts = append(serverOpts,
@@ -475,14 +609,16 @@ func newRPCServer(s *server, macService *macaroons.Service,
 	// gRPC server, and register the main lnrpc server along side.
 	grpcServer := grpc.NewServer(serverOpts...)
 	rootRPCServer := &rpcServer{
-		restServerOpts: restServerOpts,
-		subServers:     subServers,
-		tlsCfg:         tlsCfg,
-		grpcServer:     grpcServer,
-		server:         s,
-		quit:           make(chan struct{}, 1),
+		restServerOpts:     restServerOpts,
+		subServers:         subServers,
+		tlsCfg:             tlsCfg,
+		grpcServer:         grpcServer,
+		server:             s,
+		middlewareRegistry: middlewareRegistry,
+		quit:               make(chan struct{}, 1),
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
+	lnrpc.RegisterStateServer(grpcServer, stateService)
 
 	// Now the main RPC server has been registered, we'll iterate through
 	// all the sub-RPC servers and register them to ensure that requests
@@ -542,7 +678,8 @@ func (r *rpcServer) Start() error {
 	// Finally, start the REST proxy for our gRPC server above. We'll ensure
 	// we direct LND to connect to its loopback address rather than a
 	// wildcard to prevent certificate issues when accessing the proxy
-	// externally.
+	// externally. Its responses are compressed for the clients accepting
+	// it, as some of them can be quite large.
 	//
 	// TODO(roasbeef): eventually also allow the sub-servers to themselves
 	// have a REST proxy.
@@ -578,7 +715,7 @@ func (r *rpcServer) Start() error {
 
 		go func() {
 			rpcsLog.Infof("gRPC proxy started at %s", lis.Addr())
-			http.Serve(lis, mux)
+			http.Serve(lis, newCompressionHandler(mux))
 		}()
 	}
 
@@ -643,16 +780,21 @@ func addrPairsToOutputs(addrPairs map[string]int64) ([]*wire.TxOut, error) {
 
 // sendCoinsOnChain makes an on-chain transaction in or to send coins to one or
 // more addresses specified in the passed payment map. The payment map maps an
-// address to a specified output value to be sent to that address.
+// address to a specified output value to be sent to that address. Only inputs
+// with at least minConfs confirmations are used, and the transaction is
+// labelled with the given label, if any.
 func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+	feeRate chainfee.SatPerKWeight, minConfs int32,
+	label string) (*chainhash.Hash, error) {
 
 	outputs, err := addrPairsToOutputs(paymentMap)
 	if err != nil {
 		return nil, err
 	}
 
-	tx, err := r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	tx, err := r.server.cc.wallet.SendOutputs(
+		outputs, feeRate, minConfs, label,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -770,27 +912,60 @@ func (r *rpcServer) ListUnspent(ctx context.Context,
 	return resp, nil
 }
 
-// SendCoins executes a request to send coins to a particular address. Unlike
-// SendMany, this RPC call only allows creating a single output at a time.
-func (r *rpcServer) SendCoins(ctx context.Context,
-	in *lnrpc.SendCoinsRequest) (*lnrpc.SendCoinsResponse, error) {
+// calculateFeeRate determines the fee rate of an on-chain transaction from the
+// fee related parameters of a SendCoins or SendMany request. Only one of the
+// deprecated sat/byte fee rate and the sat/vbyte fee rate may be set. If
+// neither is, the fee rate is estimated using the confirmation target.
+func (r *rpcServer) calculateFeeRate(satPerByte int64, satPerVByte uint64,
+	targetConf int32) (chainfee.SatPerKWeight, error) {
+
+	switch {
+	case satPerByte < 0:
+		return 0, errors.New("sat_per_byte must be non-negative")
+
+	case satPerByte != 0 && satPerVByte != 0:
+		return 0, errors.New("either sat_per_byte or sat_per_vbyte " +
+			"should be set, but not both")
+
+	case satPerByte != 0:
+		satPerVByte = uint64(satPerByte)
+	}
 
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for this transaction.
-	satPerKw := lnwallet.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
-	feePerKw, err := sweep.DetermineFeePerKw(
+	satPerKw := chainfee.SatPerKVByte(satPerVByte * 1000).FeePerKWeight()
+	return sweep.DetermineFeePerKw(
 		r.server.cc.feeEstimator, sweep.FeePreference{
-			ConfTarget: uint32(in.TargetConf),
+			ConfTarget: uint32(targetConf),
 			FeeRate:    satPerKw,
 		},
 	)
+}
+
+// SendCoins executes a request to send coins to a particular address. Unlike
+// SendMany, this RPC call only allows creating a single output at a time.
+func (r *rpcServer) SendCoins(ctx context.Context,
+	in *lnrpc.SendCoinsRequest) (*lnrpc.SendCoinsResponse, error) {
+
+	feePerKw, err := r.calculateFeeRate(
+		in.SatPerByte, in.SatPerVbyte, in.TargetConf,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, sat/kw=%v, sweep_all=%v",
-		in.Addr, btcutil.Amount(in.Amount), int64(feePerKw),
-		in.SendAll)
+	if len(in.Label) > lnwallet.TxLabelLimit {
+		return nil, lnwallet.ErrTxLabelTooLong
+	}
+
+	rpcsLog.Infof("[sendcoins] addr=%v, amt=%v, sat/kw=%v, min_confs=%v, "+
+		"sweep_all=%v", in.Addr, btcutil.Amount(in.Amount),
+		int64(feePerKw), minConfs, in.SendAll)
 
 	var txid *chainhash.Hash
 
@@ -827,9 +1002,10 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		// single transaction. This will be generated in a concurrent
 		// safe manner, so no need to worry about locking.
 		sweepTxPkg, err := sweep.CraftSweepAllTx(
-			feePerKw, uint32(bestHeight), sweepAddr, wallet,
-			wallet.WalletController, wallet.WalletController,
-			r.server.cc.feeEstimator, r.server.cc.signer,
+			feePerKw, minConfs, uint32(bestHeight), sweepAddr,
+			wallet, wallet.WalletController,
+			wallet.WalletController, r.server.cc.feeEstimator,
+			r.server.cc.signer,
 		)
 		if err != nil {
 			return nil, err
@@ -851,6 +1027,19 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 
 		sweepTXID := sweepTxPkg.SweepTx.TxHash()
 		txid = &sweepTXID
+
+		// The sweep transaction is crafted outside of the wallet, so
+		// we'll label it ourselves now that it's been broadcast.
+		if in.Label != "" {
+			err := wallet.LabelTransaction(
+				sweepTXID, in.Label, false,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("transaction %v was "+
+					"broadcast, but could not be labelled: "+
+					"%v", sweepTXID, err)
+			}
+		}
 	} else {
 
 		// We'll now construct out payment map, and use the wallet's
@@ -859,7 +1048,9 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		// while we instruct the wallet to send this transaction.
 		paymentMap := map[string]int64{in.Addr: in.Amount}
 		err := wallet.WithCoinSelectLock(func() error {
-			newTXID, err := r.sendCoinsOnChain(paymentMap, feePerKw)
+			newTXID, err := r.sendCoinsOnChain(
+				paymentMap, feePerKw, minConfs, in.Label,
+			)
 			if err != nil {
 				return err
 			}
@@ -883,21 +1074,24 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 func (r *rpcServer) SendMany(ctx context.Context,
 	in *lnrpc.SendManyRequest) (*lnrpc.SendManyResponse, error) {
 
-	// Based on the passed fee related parameters, we'll determine an
-	// appropriate fee rate for this transaction.
-	satPerKw := lnwallet.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
-	feePerKw, err := sweep.DetermineFeePerKw(
-		r.server.cc.feeEstimator, sweep.FeePreference{
-			ConfTarget: uint32(in.TargetConf),
-			FeeRate:    satPerKw,
-		},
+	feePerKw, err := r.calculateFeeRate(
+		in.SatPerByte, in.SatPerVbyte, in.TargetConf,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	rpcsLog.Infof("[sendmany] outputs=%v, sat/kw=%v",
-		spew.Sdump(in.AddrToAmount), int64(feePerKw))
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.Label) > lnwallet.TxLabelLimit {
+		return nil, lnwallet.ErrTxLabelTooLong
+	}
+
+	rpcsLog.Infof("[sendmany] outputs=%v, sat/kw=%v, min_confs=%v",
+		spew.Sdump(in.AddrToAmount), int64(feePerKw), minConfs)
 
 	var txid *chainhash.Hash
 
@@ -907,7 +1101,7 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	wallet := r.server.cc.wallet
 	err = wallet.WithCoinSelectLock(func() error {
 		sendManyTXID, err := r.sendCoinsOnChain(
-			in.AddrToAmount, feePerKw,
+			in.AddrToAmount, feePerKw, minConfs, in.Label,
 		)
 		if err != nil {
 			return err
@@ -958,6 +1152,16 @@ var (
 	signedMsgPrefix = []byte("Lightning Signed Message:")
 )
 
+// prefixSignedMsg returns a fresh copy of the passed message prepended with
+// signedMsgPrefix. A new slice is always allocated, as appending directly to
+// the shared prefix could cause concurrent calls to overwrite each other's
+// messages if the prefix has spare capacity.
+func prefixSignedMsg(msg []byte) []byte {
+	prefixedMsg := make([]byte, 0, len(signedMsgPrefix)+len(msg))
+	prefixedMsg = append(prefixedMsg, signedMsgPrefix...)
+	return append(prefixedMsg, msg...)
+}
+
 // SignMessage signs a message with the resident node's private key. The
 // returned signature string is zbase32 encoded and pubkey recoverable, meaning
 // that only the message digest and signature are needed for verification.
@@ -968,8 +1172,7 @@ func (r *rpcServer) SignMessage(ctx context.Context,
 		return nil, fmt.Errorf("need a message to sign")
 	}
 
-	in.Msg = append(signedMsgPrefix, in.Msg...)
-	sigBytes, err := r.server.nodeSigner.SignCompact(in.Msg)
+	sigBytes, err := r.server.nodeSigner.SignCompact(prefixSignedMsg(in.Msg))
 	if err != nil {
 		return nil, err
 	}
@@ -996,8 +1199,7 @@ func (r *rpcServer) VerifyMessage(ctx context.Context,
 	}
 
 	// The signature is over the double-sha256 hash of the message.
-	in.Msg = append(signedMsgPrefix, in.Msg...)
-	digest := chainhash.DoubleHashB(in.Msg)
+	digest := chainhash.DoubleHashB(prefixSignedMsg(in.Msg))
 
 	// RecoverCompact both recovers the pubkey and validates the signature.
 	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), sig, digest)
@@ -1077,6 +1279,104 @@ func (r *rpcServer) ConnectPeer(ctx context.Context,
 	return &lnrpc.ConnectPeerResponse{}, nil
 }
 
+// CheckPeerConnectivity attempts to establish a connection with a remote peer,
+// carry out the transport handshake and exchange init messages, without
+// establishing a full peer connection. The response details the stage at
+// which the attempt failed, if it did, along with the time each of the stages
+// took.
+func (r *rpcServer) CheckPeerConnectivity(ctx context.Context,
+	in *lnrpc.CheckPeerConnectivityRequest) (
+	*lnrpc.CheckPeerConnectivityResponse, error) {
+
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	if in.Addr == nil {
+		return nil, fmt.Errorf("need: lnc pubkeyhash@hostname")
+	}
+
+	pubkeyHex, err := hex.DecodeString(in.Addr.Pubkey)
+	if err != nil {
+		return nil, err
+	}
+	pubKey, err := btcec.ParsePubKey(pubkeyHex, btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	if pubKey.IsEqual(r.server.identityPriv.PubKey()) {
+		return nil, fmt.Errorf("cannot make connection to self")
+	}
+
+	addr, err := parseAddr(in.Addr.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	peerAddr := &lnwire.NetAddress{
+		IdentityKey: pubKey,
+		Address:     addr,
+		ChainNet:    activeNetParams.Net,
+	}
+
+	rpcsLog.Debugf("[checkpeer] checking connectivity to %x@%s",
+		peerAddr.IdentityKey.SerializeCompressed(), peerAddr.Address)
+
+	report, err := r.server.CheckPeerConnectivity(peerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.CheckPeerConnectivityResponse{
+		Success:       true,
+		TcpTime:       report.tcpTime.Nanoseconds() / 1000,
+		HandshakeTime: report.handshakeTime.Nanoseconds() / 1000,
+		InitTime:      report.initTime.Nanoseconds() / 1000,
+	}
+
+	switch {
+	case report.handshakeErr != nil:
+		resp.Success = false
+		resp.Error = report.handshakeErr.Error()
+		resp.FailureStage = marshallHandshakeStage(
+			report.handshakeErr.Stage,
+		)
+
+	case report.initErr != nil:
+		resp.Success = false
+		resp.Error = report.initErr.Error()
+		resp.FailureStage = lnrpc.CheckPeerConnectivityResponse_INIT
+	}
+
+	rpcsLog.Debugf("[checkpeer] connectivity check to %v completed: "+
+		"success=%v, stage=%v, err=%v", peerAddr, resp.Success,
+		resp.FailureStage, resp.Error)
+
+	return resp, nil
+}
+
+// marshallHandshakeStage translates the stage of a failed brontide handshake
+// into its RPC counterpart.
+func marshallHandshakeStage(
+	stage brontide.HandshakeStage) lnrpc.CheckPeerConnectivityResponse_Stage {
+
+	switch stage {
+	case brontide.HandshakeStageActOne:
+		return lnrpc.CheckPeerConnectivityResponse_NOISE_ACT_ONE
+
+	case brontide.HandshakeStageActTwo:
+		return lnrpc.CheckPeerConnectivityResponse_NOISE_ACT_TWO
+
+	case brontide.HandshakeStageActThree:
+		return lnrpc.CheckPeerConnectivityResponse_NOISE_ACT_THREE
+
+	default:
+		return lnrpc.CheckPeerConnectivityResponse_TCP
+	}
+}
+
 // DisconnectPeer attempts to disconnect one peer from another identified by a
 // given pubKey. In the case that we currently have a pending or active channel
 // with the target peer, this action will be disallowed.
@@ -1127,39 +1427,207 @@ func (r *rpcServer) DisconnectPeer(ctx context.Context,
 	return &lnrpc.DisconnectPeerResponse{}, nil
 }
 
-// extractOpenChannelMinConfs extracts the minimum number of confirmations from
-// the OpenChannelRequest that each output used to fund the channel's funding
-// transaction should satisfy.
-func extractOpenChannelMinConfs(in *lnrpc.OpenChannelRequest) (int32, error) {
+// drainPollInterval is the interval at which the channels of a peer being
+// drained are checked for pending HTLCs.
+const drainPollInterval = 5 * time.Second
+
+// DrainPeer stops forwarding new HTLCs to or from the channels with the given
+// peer, and sends an update each time the number of HTLCs pending on them
+// changes, until they're all quiescent. If resume is set, the peer is no longer
+// drained, and a single update is sent.
+func (r *rpcServer) DrainPeer(in *lnrpc.DrainPeerRequest,
+	updateStream lnrpc.Lightning_DrainPeerServer) error {
+
+	rpcsLog.Debugf("[drainpeer] peer=%s, resume=%v", in.PubKey, in.Resume)
+
+	pubKeyBytes, err := hex.DecodeString(in.PubKey)
+	if err != nil {
+		return fmt.Errorf("unable to decode pubkey bytes: %v", err)
+	}
+	peerPubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+	if err != nil {
+		return fmt.Errorf("unable to parse pubkey: %v", err)
+	}
+
+	var peer [33]byte
+	copy(peer[:], peerPubKey.SerializeCompressed())
+
+	if in.Resume {
+		r.server.htlcSwitch.ResumePeer(peer)
+
+		update, err := r.fetchDrainState(peerPubKey)
+		if err != nil {
+			return err
+		}
+
+		return updateStream.Send(update)
+	}
+
+	r.server.htlcSwitch.DrainPeer(peer)
+
+	ticker := time.NewTicker(drainPollInterval)
+	defer ticker.Stop()
+
+	var lastUpdate *lnrpc.DrainPeerUpdate
+	for {
+		update, err := r.fetchDrainState(peerPubKey)
+		if err != nil {
+			return err
+		}
+
+		// We'll only send an update if the number of pending HTLCs of
+		// any of the channels changed since the last one.
+		if drainStateChanged(lastUpdate, update) {
+			if err := updateStream.Send(update); err != nil {
+				return err
+			}
+			lastUpdate = update
+		}
+
+		if update.Quiescent {
+			rpcsLog.Infof("Channels with peer %x are quiescent",
+				pubKeyBytes)
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// fetchDrainState returns the number of HTLCs pending on each of the channels
+// with the given peer.
+func (r *rpcServer) fetchDrainState(
+	peer *btcec.PublicKey) (*lnrpc.DrainPeerUpdate, error) {
+
+	channels, err := r.server.chanDB.FetchOpenChannels(peer)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch channels for peer: %v",
+			err)
+	}
+
+	update := &lnrpc.DrainPeerUpdate{
+		Quiescent: true,
+	}
+	for _, channel := range channels {
+		// An HTLC is pending until it has been removed from both
+		// commitments.
+		numHtlcs := len(channel.LocalCommitment.Htlcs)
+		if len(channel.RemoteCommitment.Htlcs) > numHtlcs {
+			numHtlcs = len(channel.RemoteCommitment.Htlcs)
+		}
+		if numHtlcs != 0 {
+			update.Quiescent = false
+		}
+
+		drainState := &lnrpc.ChannelDrainState{
+			ChannelPoint:    channel.FundingOutpoint.String(),
+			NumPendingHtlcs: uint32(numHtlcs),
+		}
+		update.Channels = append(update.Channels, drainState)
+	}
+
+	return update, nil
+}
+
+// drainStateChanged returns true if the number of pending HTLCs of any of the
+// channels differs between the two drain updates.
+func drainStateChanged(prev, cur *lnrpc.DrainPeerUpdate) bool {
+	if prev == nil || len(prev.Channels) != len(cur.Channels) {
+		return true
+	}
+
+	for i, channel := range cur.Channels {
+		prevChannel := prev.Channels[i]
+		if channel.ChannelPoint != prevChannel.ChannelPoint ||
+			channel.NumPendingHtlcs != prevChannel.NumPendingHtlcs {
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// parseUpfrontShutdownAddress attempts to parse an upfront shutdown address.
+// If the address is empty, it returns nil. If it successfully decoded the
+// address, it returns a script that pays out to the address.
+func parseUpfrontShutdownAddress(address string) (lnwire.DeliveryAddress, error) {
+	if len(address) == 0 {
+		return nil, nil
+	}
+
+	addr, err := btcutil.DecodeAddress(address, activeNetParams.Params)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %v", err)
+	}
+
+	return txscript.PayToAddrScript(addr)
+}
+
+// extractMinConfs extracts the minimum number of confirmations that each
+// output used to fund a transaction, such as a channel's funding transaction,
+// should satisfy from the MinConfs and SpendUnconfirmed request parameters.
+func extractMinConfs(minConfs int32, spendUnconfirmed bool) (int32, error) {
 	switch {
 	// Ensure that the MinConfs parameter is non-negative.
-	case in.MinConfs < 0:
+	case minConfs < 0:
 		return 0, errors.New("minimum number of confirmations must " +
 			"be a non-negative number")
 
-	// The funding transaction should not be funded with unconfirmed outputs
-	// unless explicitly specified by SpendUnconfirmed. We do this to
-	// provide sane defaults to the OpenChannel RPC, as otherwise, if the
-	// MinConfs field isn't explicitly set by the caller, we'll use
-	// unconfirmed outputs without the caller being aware.
-	case in.MinConfs == 0 && !in.SpendUnconfirmed:
+	// The transaction should not be funded with unconfirmed outputs unless
+	// explicitly specified by SpendUnconfirmed. We do this to provide sane
+	// defaults to the RPCs, as otherwise, if the MinConfs field isn't
+	// explicitly set by the caller, we'll use unconfirmed outputs without
+	// the caller being aware.
+	case minConfs == 0 && !spendUnconfirmed:
 		return 1, nil
 
 	// In the event that the caller set MinConfs > 0 and SpendUnconfirmed to
 	// true, we'll return an error to indicate the conflict.
-	case in.MinConfs > 0 && in.SpendUnconfirmed:
+	case minConfs > 0 && spendUnconfirmed:
 		return 0, errors.New("SpendUnconfirmed set to true with " +
 			"MinConfs > 0")
 
-	// The funding transaction of the new channel to be created can be
-	// funded with unconfirmed outputs.
-	case in.SpendUnconfirmed:
+	// The transaction can be funded with unconfirmed outputs.
+	case spendUnconfirmed:
 		return 0, nil
 
 	// If none of the above cases matched, we'll return the value set
 	// explicitly by the caller.
 	default:
-		return in.MinConfs, nil
+		return minConfs, nil
+	}
+}
+
+// extractPendingChanID extracts the optional pending channel ID the caller
+// chose for the funding flow of a new channel. If no ID is set, then an all
+// zero ID is returned, indicating a random one should be used.
+func extractPendingChanID(rawID []byte) ([32]byte, error) {
+	var pendingChanID [32]byte
+	switch len(rawID) {
+	case 0:
+		return pendingChanID, nil
+
+	case 32:
+		copy(pendingChanID[:], rawID)
+		if pendingChanID == ([32]byte{}) {
+			return pendingChanID, errors.New("pending channel ID " +
+				"must not be all zeroes")
+		}
+
+		return pendingChanID, nil
+
+	default:
+		return pendingChanID, fmt.Errorf("pending channel ID must be "+
+			"32 bytes, got %v", len(rawID))
 	}
 }
 
@@ -1211,12 +1679,24 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	// Then, we'll extract the minimum number of confirmations that each
 	// output we use to fund the channel's funding transaction should
 	// satisfy.
-	minConfs, err := extractOpenChannelMinConfs(in)
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
 	if err != nil {
 		return err
 	}
 
-	var (
+	// If the user has provided a close address, we'll parse it into the
+	// script we'll commit to as our upfront shutdown script.
+	script, err := parseUpfrontShutdownAddress(in.CloseAddress)
+	if err != nil {
+		return fmt.Errorf("error parsing upfront shutdown: %v", err)
+	}
+
+	pendingChanID, err := extractPendingChanID(in.PendingChanId)
+	if err != nil {
+		return err
+	}
+
+	var (
 		nodePubKey      *btcec.PublicKey
 		nodePubKeyBytes []byte
 	)
@@ -1245,9 +1725,9 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for the funding transaction.
-	satPerKw := lnwallet.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
+	satPerKw := chainfee.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
 	feeRate, err := sweep.DetermineFeePerKw(
-		r.server.cc.feeEstimator, sweep.FeePreference{
+		r.server.feeClamps.funding, sweep.FeePreference{
 			ConfTarget: uint32(in.TargetConf),
 			FeeRate:    satPerKw,
 		},
@@ -1272,6 +1752,8 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		shutdownScript:  script,
+		pendingChanID:   pendingChanID,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1323,6 +1805,218 @@ out:
 	return nil
 }
 
+// chanAcceptInfo houses a channel request received by the RPCAcceptor of a
+// ChannelAcceptor stream, along with the channel its response should be
+// delivered over.
+type chanAcceptInfo struct {
+	chanReq      *chanacceptor.ChannelAcceptRequest
+	responseChan chan *chanacceptor.ChannelAcceptResponse
+}
+
+// ChannelAcceptor dispatches a bi-directional streaming RPC in which
+// OpenChannel requests are sent to the client and the client responds with
+// a boolean that tells LND whether or not to accept the channel. This allows
+// node operators to specify their own criteria for accepting inbound channels
+// through a single persistent connection.
+func (r *rpcServer) ChannelAcceptor(
+	stream lnrpc.Lightning_ChannelAcceptorServer) error {
+
+	chainedAcceptor := r.server.chanPredicate
+
+	// Create two channels to handle requests and responses respectively.
+	newRequests := make(chan *chanAcceptInfo)
+	responses := make(chan *lnrpc.ChannelAcceptResponse)
+
+	// Define a quit channel that will be used to signal to the
+	// RPCAcceptor's closure whether the stream still exists.
+	quit := make(chan struct{})
+
+	// rejectResp returns a response rejecting the channel for the given
+	// reason, which will be sent to the initiator.
+	rejectResp := func(reason string) *chanacceptor.ChannelAcceptResponse {
+		return chanacceptor.NewChannelRejectResponse(errors.New(reason))
+	}
+	invalidParamsReason := "invalid channel parameters"
+
+	// demultiplexReq is a closure that will be passed to the RPCAcceptor
+	// and acts as an intermediary between the RPCAcceptor and the stream.
+	demultiplexReq := func(req *chanacceptor.ChannelAcceptRequest) (
+		acceptResp *chanacceptor.ChannelAcceptResponse) {
+
+		respChan := make(chan *chanacceptor.ChannelAcceptResponse, 1)
+
+		newRequest := &chanAcceptInfo{
+			chanReq:      req,
+			responseChan: respChan,
+		}
+
+		// timeout is the time after which ChannelAcceptRequests
+		// expire.
+		timeout := time.After(cfg.AcceptorTimeout)
+
+		// Send the request to the newRequests channel.
+		select {
+		case newRequests <- newRequest:
+		case <-timeout:
+			rpcsLog.Errorf("RPCAcceptor rejected channel - reached "+
+				"timeout of %v", cfg.AcceptorTimeout)
+			return rejectResp("channel acceptor timed out")
+		case <-quit:
+			return rejectResp("channel acceptor disconnected")
+		case <-r.quit:
+			return rejectResp("shutting down")
+		}
+
+		// Receive the response and return it. If no response has been
+		// received in AcceptorTimeout, then reject the channel.
+		select {
+		case resp := <-respChan:
+			return resp
+		case <-timeout:
+			rpcsLog.Errorf("RPCAcceptor rejected channel - reached "+
+				"timeout of %v", cfg.AcceptorTimeout)
+			return rejectResp("channel acceptor timed out")
+		case <-quit:
+			return rejectResp("channel acceptor disconnected")
+		case <-r.quit:
+			return rejectResp("shutting down")
+		}
+	}
+
+	// Create a new RPCAcceptor and add it to the ChainedAcceptor, making
+	// sure it is removed once the stream is gone. Note that the quit
+	// channel must be closed first, as the ChainedAcceptor can't remove
+	// the RPCAcceptor while it is waiting for a response.
+	rpcAcceptor := chanacceptor.NewRPCAcceptor(demultiplexReq)
+	id := chainedAcceptor.AddAcceptor(rpcAcceptor)
+	defer chainedAcceptor.RemoveAcceptor(id)
+	defer close(quit)
+
+	// errChan is used by the receive loop to signal any errors that occur
+	// during reading from the stream. This is primarily used to shutdown
+	// the send loop in the case of an RPC client disconnecting.
+	errChan := make(chan error, 1)
+
+	// We need to have the stream.Recv() in a goroutine since the call is
+	// blocking and would prevent us from sending more
+	// ChannelAcceptRequests to the RPC client.
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			// Now that we have the response from the RPC client,
+			// send it to the responses chan.
+			select {
+			case responses <- resp:
+			case <-quit:
+				return
+			case <-r.quit:
+				return
+			}
+		}
+	}()
+
+	acceptRequests := make(
+		map[[32]byte]chan *chanacceptor.ChannelAcceptResponse,
+	)
+
+	for {
+		select {
+		case newRequest := <-newRequests:
+			req := newRequest.chanReq
+			msg := req.OpenChanMsg
+			pendingChanID := msg.PendingChannelID
+
+			acceptRequests[pendingChanID] = newRequest.responseChan
+
+			commitmentType := lnrpc.CommitmentType_LEGACY
+			if req.Anchors {
+				commitmentType = lnrpc.CommitmentType_ANCHORS
+			}
+
+			// A ChannelAcceptRequest has been received, send it to
+			// the client.
+			chanAcceptReq := &lnrpc.ChannelAcceptRequest{
+				NodePubkey:       req.Node.SerializeCompressed(),
+				ChainHash:        msg.ChainHash[:],
+				PendingChanId:    pendingChanID[:],
+				FundingAmt:       uint64(msg.FundingAmount),
+				PushAmt:          uint64(msg.PushAmount),
+				DustLimit:        uint64(msg.DustLimit),
+				MaxValueInFlight: uint64(msg.MaxValueInFlight),
+				ChannelReserve:   uint64(msg.ChannelReserve),
+				MinHtlc:          uint64(msg.HtlcMinimum),
+				FeePerKw:         uint64(msg.FeePerKiloWeight),
+				CsvDelay:         uint32(msg.CsvDelay),
+				MaxAcceptedHtlcs: uint32(msg.MaxAcceptedHTLCs),
+				ChannelFlags:     uint32(msg.ChannelFlags),
+				CommitmentType:   commitmentType,
+			}
+
+			if err := stream.Send(chanAcceptReq); err != nil {
+				return err
+			}
+
+		case resp := <-responses:
+			// Look up the appropriate channel to send on given the
+			// pending ID. If a channel is found, send the response
+			// over it.
+			var pendingID [32]byte
+			copy(pendingID[:], resp.PendingChanId)
+			respChan, ok := acceptRequests[pendingID]
+			if !ok {
+				continue
+			}
+			delete(acceptRequests, pendingID)
+
+			// Convert the response of the client, making sure the
+			// parameters it overrides fit within the wire types.
+			var chanResp *chanacceptor.ChannelAcceptResponse
+			switch {
+			case !resp.Accept:
+				reason := resp.Error
+				if reason == "" {
+					reason = "channel rejected by acceptor"
+				}
+				chanResp = rejectResp(reason)
+
+			case resp.MinAcceptDepth > math.MaxUint16:
+				rpcsLog.Errorf("RPCAcceptor rejected channel - "+
+					"invalid min accept depth: %v",
+					resp.MinAcceptDepth)
+				chanResp = rejectResp(invalidParamsReason)
+
+			case resp.CsvDelay > math.MaxUint16:
+				rpcsLog.Errorf("RPCAcceptor rejected channel - "+
+					"invalid csv delay: %v", resp.CsvDelay)
+				chanResp = rejectResp(invalidParamsReason)
+
+			default:
+				chanResp = chanacceptor.NewChannelAcceptResponse(
+					uint16(resp.MinAcceptDepth),
+					uint16(resp.CsvDelay),
+				)
+			}
+
+			// Send the response over the buffered response
+			// channel.
+			respChan <- chanResp
+
+		case err := <-errChan:
+			rpcsLog.Errorf("Received an error: %v, shutting down",
+				err)
+			return err
+
+		case <-r.quit:
+			return fmt.Errorf("RPC server is shutting down")
+		}
+	}
+}
+
 // OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 // call is meant to be consumed by clients to the REST proxy. As with all other
 // sync calls, all byte slices are instead to be populated as hex encoded
@@ -1389,16 +2083,29 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	// Then, we'll extract the minimum number of confirmations that each
 	// output we use to fund the channel's funding transaction should
 	// satisfy.
-	minConfs, err := extractOpenChannelMinConfs(in)
+	minConfs, err := extractMinConfs(in.MinConfs, in.SpendUnconfirmed)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the user has provided a close address, we'll parse it into the
+	// script we'll commit to as our upfront shutdown script.
+	script, err := parseUpfrontShutdownAddress(in.CloseAddress)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing upfront shutdown: %v",
+			err)
+	}
+
+	pendingChanID, err := extractPendingChanID(in.PendingChanId)
 	if err != nil {
 		return nil, err
 	}
 
 	// Based on the passed fee related parameters, we'll determine an
 	// appropriate fee rate for the funding transaction.
-	satPerKw := lnwallet.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
+	satPerKw := chainfee.SatPerKVByte(in.SatPerByte * 1000).FeePerKWeight()
 	feeRate, err := sweep.DetermineFeePerKw(
-		r.server.cc.feeEstimator, sweep.FeePreference{
+		r.server.feeClamps.funding, sweep.FeePreference{
 			ConfTarget: uint32(in.TargetConf),
 			FeeRate:    satPerKw,
 		},
@@ -1420,6 +2127,8 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        minConfs,
+		shutdownScript:  script,
+		pendingChanID:   pendingChanID,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1453,6 +2162,88 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	}
 }
 
+// FundingStateStep advances the funding flow of a locally initiated pending
+// channel. It allows canceling the funding flow as long as the funding
+// transaction hasn't been broadcast yet, and bumping the fee of an
+// unconfirmed funding transaction.
+func (r *rpcServer) FundingStateStep(ctx context.Context,
+	in *lnrpc.FundingTransitionMsg) (*lnrpc.FundingStateStepResp, error) {
+
+	switch {
+	case in.Cancel != nil && in.Bump != nil:
+		return nil, errors.New("only one of cancel and bump may be set")
+
+	case in.Cancel != nil:
+		if len(in.Cancel.PendingChanId) != 32 {
+			return nil, errors.New("pending channel ID must be 32 " +
+				"bytes")
+		}
+
+		var pendingChanID [32]byte
+		copy(pendingChanID[:], in.Cancel.PendingChanId)
+
+		rpcsLog.Debugf("[fundingstatestep] canceling funding flow "+
+			"with pendingID(%x)", pendingChanID[:])
+
+		err := r.server.fundingMgr.CancelPendingFunding(pendingChanID)
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.FundingStateStepResp{}, nil
+
+	case in.Bump != nil:
+		if in.Bump.ChanPoint == nil {
+			return nil, errors.New("channel point must be set")
+		}
+		txid, err := getChanPointFundingTxid(in.Bump.ChanPoint)
+		if err != nil {
+			return nil, err
+		}
+		txHash, err := chainhash.NewHash(txid)
+		if err != nil {
+			return nil, err
+		}
+		chanPoint := &wire.OutPoint{
+			Hash:  *txHash,
+			Index: in.Bump.ChanPoint.OutputIndex,
+		}
+
+		// Based on the passed fee related parameters, we'll determine
+		// the fee rate we should bump the funding transaction to.
+		satPerKw := chainfee.SatPerKVByte(
+			in.Bump.SatPerByte * 1000,
+		).FeePerKWeight()
+		feeRate, err := sweep.DetermineFeePerKw(
+			r.server.feeClamps.funding, sweep.FeePreference{
+				ConfTarget: uint32(in.Bump.TargetConf),
+				FeeRate:    satPerKw,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rpcsLog.Debugf("[fundingstatestep] bumping funding fee of "+
+			"ChannelPoint(%v) to %v sat/kw", chanPoint,
+			int64(feeRate))
+
+		bumpTx, err := r.server.fundingMgr.BumpFundingFee(
+			chanPoint, feeRate,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnrpc.FundingStateStepResp{
+			BumpTxid: bumpTx.TxHash().String(),
+		}, nil
+
+	default:
+		return nil, errors.New("either cancel or bump must be set")
+	}
+}
+
 // getChanPointFundingTxid returns the given channel point's funding txid in
 // raw bytes.
 func getChanPointFundingTxid(chanPoint *lnrpc.ChannelPoint) ([]byte, error) {
@@ -1505,6 +2296,19 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	rpcsLog.Tracef("[closechannel] request for ChannelPoint(%v), force=%v",
 		chanPoint, force)
 
+	// A channel can only be reopened after a cooperative closure, as our
+	// funds are otherwise time locked.
+	switch {
+	case in.Reopen && force:
+		return fmt.Errorf("cannot reopen a force closed channel")
+
+	case in.ReopenLocalAmt != 0 && !in.Reopen:
+		return fmt.Errorf("reopen_local_amt requires reopen to be set")
+
+	case in.ReopenLocalAmt < 0:
+		return fmt.Errorf("reopen_local_amt cannot be negative")
+	}
+
 	var (
 		updateChan chan interface{}
 		errChan    chan error
@@ -1589,11 +2393,11 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		// Based on the passed fee related parameters, we'll determine
 		// an appropriate fee rate for the cooperative closure
 		// transaction.
-		satPerKw := lnwallet.SatPerKVByte(
+		satPerKw := chainfee.SatPerKVByte(
 			in.SatPerByte * 1000,
 		).FeePerKWeight()
 		feeRate, err := sweep.DetermineFeePerKw(
-			r.server.cc.feeEstimator, sweep.FeePreference{
+			r.server.feeClamps.coopClose, sweep.FeePreference{
 				ConfTarget: uint32(in.TargetConf),
 				FeeRate:    satPerKw,
 			},
@@ -1613,6 +2417,29 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 				"with active htlcs")
 		}
 
+		// If requested, we'll reopen a channel with the same peer once
+		// the closure transaction has confirmed, using the same fee
+		// preference for its funding transaction.
+		if in.Reopen {
+			state := channel.State()
+			chanFlags := state.ChannelFlags
+			isPrivate := chanFlags&lnwire.FFAnnounceChannel == 0
+			reopenReq := &chanReopenRequest{
+				chanPoint: *chanPoint,
+				peer:      state.IdentityPub,
+				localAmt:  btcutil.Amount(in.ReopenLocalAmt),
+				private:   isPrivate,
+				feePref: sweep.FeePreference{
+					ConfTarget: uint32(in.TargetConf),
+					FeeRate:    satPerKw,
+				},
+			}
+			err := r.server.chanReopener.RegisterReopen(reopenReq)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Otherwise, the caller has requested a regular interactive
 		// cooperative channel closure. So we'll forward the request to
 		// the htlc switch which will handle the negotiation and
@@ -1627,6 +2454,10 @@ out:
 		case err := <-errChan:
 			rpcsLog.Errorf("[closechannel] unable to close "+
 				"ChannelPoint(%v): %v", chanPoint, err)
+
+			// As the channel wasn't closed, there's nothing to
+			// reopen.
+			r.server.chanReopener.CancelReopen(*chanPoint)
 			return err
 		case closingUpdate := <-updateChan:
 			rpcClosingUpdate, err := createRPCCloseUpdate(
@@ -1818,8 +2649,8 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		activeChains[i] = &lnrpc.Chain{
 			Chain:   chain.String(),
 			Network: network,
+			Synced:  isSynced,
 		}
-
 	}
 
 	// Check if external IP addresses were provided to lnd and use them
@@ -1835,6 +2666,8 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		uris[i] = fmt.Sprintf("%s@%s", encodedIDPub, addr.String())
 	}
 
+	features := marshalFeatures(advertisedFeatures(r.server.featureMgr))
+
 	// TODO(roasbeef): add synced height n stuff
 	return &lnrpc.GetInfoResponse{
 		IdentityPubkey:      encodedIDPub,
@@ -1851,9 +2684,117 @@ func (r *rpcServer) GetInfo(ctx context.Context,
 		Alias:               nodeAnn.Alias.String(),
 		BestHeaderTimestamp: int64(bestHeaderTimestamp),
 		Version:             build.Version(),
+		SyncedToGraph:       r.server.authGossiper.IsGraphSynced(),
+		Features:            features,
+		CommitHash:          build.Commit,
+		BuildTags:           build.Tags(),
+	}, nil
+}
+
+// advertisedFeatures returns the features we advertise to the network, both
+// in the Init messages sent to our peers and in our node announcement. The
+// feature manager names the node announcement features after the global
+// features, none of which are defined, so they're all named after the local
+// features instead.
+func advertisedFeatures(featureMgr *feature.Manager) *lnwire.FeatureVector {
+	features := featureMgr.GetRaw(feature.SetInit)
+	for bit := range featureMgr.Get(feature.SetNodeAnn).Features() {
+		features.Set(bit)
+	}
+
+	return lnwire.NewFeatureVector(features, lnwire.LocalFeatures)
+}
+
+// marshalFeatures converts a feature vector into the list of its enabled
+// features, sorted by feature bit.
+func marshalFeatures(fv *lnwire.FeatureVector) []*lnrpc.Feature {
+	bits := make([]lnwire.FeatureBit, 0, len(fv.Features()))
+	for bit := range fv.Features() {
+		bits = append(bits, bit)
+	}
+	sort.Slice(bits, func(i, j int) bool {
+		return bits[i] < bits[j]
+	})
+
+	features := make([]*lnrpc.Feature, 0, len(bits))
+	for _, bit := range bits {
+		var name string
+		if fv.IsKnown(bit) {
+			name = lnwire.LocalFeatures[bit]
+		}
+
+		features = append(features, &lnrpc.Feature{
+			Bit:        uint32(bit),
+			Name:       name,
+			IsRequired: bit%2 == 0,
+			IsKnown:    fv.IsKnown(bit),
+		})
+	}
+
+	return features
+}
+
+// GetRecoveryInfo returns a boolean indicating whether the wallet is started
+// in recovery mode, whether the recovery is finished, and a float64 indicating
+// the recovery progress made so far.
+func (r *rpcServer) GetRecoveryInfo(ctx context.Context,
+	in *lnrpc.GetRecoveryInfoRequest) (*lnrpc.GetRecoveryInfoResponse, error) {
+
+	isRecoveryMode, progress, err := r.server.cc.wallet.GetRecoveryInfo()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get wallet recovery info: %v",
+			err)
+	}
+
+	rpcsLog.Debugf("[getrecoveryinfo] is recovery mode=%v, progress=%v",
+		isRecoveryMode, progress)
+
+	return &lnrpc.GetRecoveryInfoResponse{
+		RecoveryMode:     isRecoveryMode,
+		RecoveryFinished: progress == 1,
+		Progress:         progress,
 	}, nil
 }
 
+// GetHealth returns the health of the subsystems that recover from panics,
+// along with the diagnostics of their last panic.
+func (r *rpcServer) GetHealth(ctx context.Context,
+	in *lnrpc.GetHealthRequest) (*lnrpc.GetHealthResponse, error) {
+
+	rpcsLog.Tracef("[gethealth] request")
+
+	reports := r.server.health.Reports()
+	resp := &lnrpc.GetHealthResponse{
+		Subsystems: make([]*lnrpc.SubsystemHealth, 0, len(reports)),
+	}
+	for _, report := range reports {
+		var (
+			status        lnrpc.SubsystemStatus
+			lastPanicTime int64
+		)
+		switch report.Status {
+		case health.StatusHealthy:
+			status = lnrpc.SubsystemStatus_HEALTHY
+		case health.StatusDegraded:
+			status = lnrpc.SubsystemStatus_DEGRADED
+		}
+		if !report.LastPanicTime.IsZero() {
+			lastPanicTime = report.LastPanicTime.Unix()
+		}
+
+		resp.Subsystems = append(resp.Subsystems, &lnrpc.SubsystemHealth{
+			Subsystem:     report.Subsystem,
+			Status:        status,
+			Panics:        report.Panics,
+			Restarts:      report.Restarts,
+			LastPanic:     report.LastPanic,
+			LastPanicTime: lastPanicTime,
+		})
+	}
+
+	return resp, nil
+}
+
 // ListPeers returns a verbose listing of all currently active peers.
 func (r *rpcServer) ListPeers(ctx context.Context,
 	in *lnrpc.ListPeersRequest) (*lnrpc.ListPeersResponse, error) {
@@ -1925,12 +2866,21 @@ func (r *rpcServer) WalletBalance(ctx context.Context,
 	// Get unconfirmed balance, from txs with 0 confirmations.
 	unconfirmedBal := totalBal - confirmedBal
 
-	rpcsLog.Debugf("[walletbalance] Total balance=%v", totalBal)
+	// Locked outputs are excluded from the balances above, so we report
+	// them separately.
+	lockedBal, err := r.server.cc.wallet.LockedBalance()
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[walletbalance] Total balance=%v, locked balance=%v",
+		totalBal, lockedBal)
 
 	return &lnrpc.WalletBalanceResponse{
 		TotalBalance:       int64(totalBal),
 		ConfirmedBalance:   int64(confirmedBal),
 		UnconfirmedBalance: int64(unconfirmedBal),
+		LockedBalance:      int64(lockedBal),
 	}, nil
 }
 
@@ -2001,6 +2951,17 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 		commitBaseWeight := blockchain.GetTransactionWeight(utx)
 		commitWeight := commitBaseWeight + input.WitnessCommitmentTxWeight
 
+		// The funding transaction can confirm in the block following
+		// its broadcast at the earliest, so we'll report the height at
+		// which it has gathered the required confirmations in that
+		// case. Channels persisted before the broadcast height was
+		// tracked have no such height.
+		var confHeight uint32
+		if pendingChan.FundingBroadcastHeight != 0 {
+			confHeight = pendingChan.FundingBroadcastHeight +
+				uint32(pendingChan.NumConfsRequired)
+		}
+
 		resp.PendingOpenChannels[i] = &lnrpc.PendingChannelsResponse_PendingOpenChannel{
 			Channel: &lnrpc.PendingChannelsResponse_PendingChannel{
 				RemoteNodePub: hex.EncodeToString(pub),
@@ -2009,10 +2970,10 @@ func (r *rpcServer) PendingChannels(ctx context.Context,
 				LocalBalance:  int64(localCommitment.LocalBalance.ToSatoshis()),
 				RemoteBalance: int64(localCommitment.RemoteBalance.ToSatoshis()),
 			},
-			CommitWeight: commitWeight,
-			CommitFee:    int64(localCommitment.CommitFee),
-			FeePerKw:     int64(localCommitment.FeePerKw),
-			// TODO(roasbeef): need to track confirmation height
+			ConfirmationHeight: confHeight,
+			CommitWeight:       commitWeight,
+			CommitFee:          int64(localCommitment.CommitFee),
+			FeePerKw:           int64(localCommitment.FeePerKw),
 		}
 	}
 
@@ -2320,6 +3281,14 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 	rpcsLog.Infof("[listchannels] fetched %v channels from DB",
 		len(dbChannels))
 
+	// We'll also estimate the fee rate a cooperative close would start
+	// negotiating at, such that the expected close fee of each channel can
+	// be reported.
+	closeFeeRate, err := r.server.feeClamps.coopClose.EstimateFeePerKW(6)
+	if err != nil {
+		return nil, err
+	}
+
 	for _, dbChannel := range dbChannels {
 		nodePub := dbChannel.IdentityPub
 		chanPoint := dbChannel.FundingOutpoint
@@ -2343,6 +3312,14 @@ func (r *rpcServer) ListChannels(ctx context.Context,
 		isActive := peerOnline && linkActive
 		channel := createRPCOpenChannel(r, graph, dbChannel, isActive)
 
+		// The fee we'd propose for a cooperative close is capped by the
+		// fee of the current commitment.
+		closeFee := closeFeeRate.FeeForWeight(lnwallet.CoopCloseWeight())
+		if closeFee > dbChannel.LocalCommitment.CommitFee {
+			closeFee = dbChannel.LocalCommitment.CommitFee
+		}
+		channel.CoopCloseFee = int64(closeFee)
+
 		// We'll only skip returning this channel if we were requested
 		// for a specific kind and this channel doesn't satisfy it.
 		switch {
@@ -2422,6 +3399,12 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		PendingHtlcs:          make([]*lnrpc.HTLC, len(localCommit.Htlcs)),
 		CsvDelay:              uint32(dbChannel.LocalChanCfg.CsvDelay),
 		Initiator:             dbChannel.IsInitiator,
+		LocalConstraints: createChannelConstraint(
+			&dbChannel.LocalChanCfg,
+		),
+		RemoteConstraints: createChannelConstraint(
+			&dbChannel.RemoteChanCfg,
+		),
 	}
 
 	for i, htlc := range localCommit.Htlcs {
@@ -2438,9 +3421,32 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		channel.UnsettledBalance += channel.PendingHtlcs[i].Amount
 	}
 
+	// Finally, we'll populate the stats of the HTLCs we've forwarded on
+	// behalf of the channel, exposing the rate at which the remote peer
+	// endorsed them.
+	stats := r.server.htlcSwitch.ReputationStats(dbChannel.ShortChannelID)
+	channel.NumIncomingHtlcs = stats.IncomingHtlcs
+	channel.NumEndorsedIncomingHtlcs = stats.EndorsedHtlcs
+	channel.IncomingEndorsementRate = stats.EndorsementRate()
+
 	return channel
 }
 
+// createChannelConstraint creates an *lnrpc.ChannelConstraints from the
+// constraints of the given *channeldb.ChannelConfig.
+func createChannelConstraint(
+	chanCfg *channeldb.ChannelConfig) *lnrpc.ChannelConstraints {
+
+	return &lnrpc.ChannelConstraints{
+		CsvDelay:          uint32(chanCfg.CsvDelay),
+		ChanReserveSat:    uint64(chanCfg.ChanReserve),
+		DustLimitSat:      uint64(chanCfg.DustLimit),
+		MaxPendingAmtMsat: uint64(chanCfg.MaxPendingAmount),
+		MinHtlcMsat:       uint64(chanCfg.MinHTLC),
+		MaxAcceptedHtlcs:  uint32(chanCfg.MaxAcceptedHtlcs),
+	}
+}
+
 // createRPCClosedChannel creates an *lnrpc.ClosedChannelSummary from a
 // *channeldb.ChannelCloseSummary.
 func createRPCClosedChannel(
@@ -2520,6 +3526,32 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 						ClosedChannel: closedChannel,
 					},
 				}
+			case channelnotifier.PendingOpenChannelEvent:
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_PENDING_OPEN_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_PendingOpenChannel{
+						PendingOpenChannel: &lnrpc.PendingUpdate{
+							Txid:        event.ChannelPoint.Hash[:],
+							OutputIndex: event.ChannelPoint.Index,
+						},
+					},
+				}
+			case channelnotifier.PendingCloseChannelEvent:
+				closedChannel := createRPCClosedChannel(event.CloseSummary)
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_PENDING_CLOSE_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_PendingCloseChannel{
+						PendingCloseChannel: closedChannel,
+					},
+				}
+			case channelnotifier.RemoteCloseChannelEvent:
+				closedChannel := createRPCClosedChannel(event.CloseSummary)
+				update = &lnrpc.ChannelEventUpdate{
+					Type: lnrpc.ChannelEventUpdate_REMOTE_CLOSE_CHANNEL,
+					Channel: &lnrpc.ChannelEventUpdate_RemoteCloseChannel{
+						RemoteCloseChannel: closedChannel,
+					},
+				}
 			case channelnotifier.ActiveChannelEvent:
 				update = &lnrpc.ChannelEventUpdate{
 					Type: lnrpc.ChannelEventUpdate_ACTIVE_CHANNEL,
@@ -2557,10 +3589,72 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 	}
 }
 
+// SubscribeChainBackendEvents returns a uni-directional stream (server ->
+// client) for notifying the client of stalled and recovered chain backends, and
+// of failing over to the fallback backend.
+func (r *rpcServer) SubscribeChainBackendEvents(
+	req *lnrpc.ChainBackendEventSubscription,
+	updateStream lnrpc.Lightning_SubscribeChainBackendEventsServer) error {
+
+	if r.server.chainMonitor == nil {
+		return errors.New("chain backend monitoring is disabled, set " +
+			"chainstalltimeout to enable it")
+	}
+
+	chainEventSub, err := r.server.chainMonitor.SubscribeChainBackendEvents()
+	if err != nil {
+		return err
+	}
+
+	// Ensure that the resources for the client is cleaned up once either
+	// the server, or client exits.
+	defer chainEventSub.Cancel()
+
+	for {
+		select {
+		case e := <-chainEventSub.Updates():
+			var update *lnrpc.ChainBackendEvent
+			switch event := e.(type) {
+			case chainmonitor.StalledEvent:
+				update = &lnrpc.ChainBackendEvent{
+					Type:         lnrpc.ChainBackendEventType_STALLED,
+					Backend:      event.Backend,
+					Height:       event.Height,
+					StalledSince: event.Since.Unix(),
+				}
+			case chainmonitor.RecoveredEvent:
+				update = &lnrpc.ChainBackendEvent{
+					Type:    lnrpc.ChainBackendEventType_RECOVERED,
+					Backend: event.Backend,
+					Height:  event.Height,
+				}
+			case chainmonitor.FailoverEvent:
+				update = &lnrpc.ChainBackendEvent{
+					Type:            lnrpc.ChainBackendEventType_FAILOVER,
+					Backend:         event.From,
+					Height:          event.Height,
+					FallbackBackend: event.To,
+					FallbackHeight:  event.FallbackHeight,
+				}
+			default:
+				return fmt.Errorf("unexpected chain backend "+
+					"event: %v", event)
+			}
+
+			if err := updateStream.Send(update); err != nil {
+				return err
+			}
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
 // savePayment saves a successfully completed payment to the database for
-// historical record keeping.
+// historical record keeping. The payment request the payment was made to, if
+// any, is kept along with it, as it's needed to prove the payment.
 func (r *rpcServer) savePayment(route *routing.Route,
-	amount lnwire.MilliSatoshi, preImage []byte) error {
+	amount lnwire.MilliSatoshi, preImage, payReq []byte) error {
 
 	paymentPath := make([][33]byte, len(route.Hops))
 	for i, hop := range route.Hops {
@@ -2573,7 +3667,8 @@ func (r *rpcServer) savePayment(route *routing.Route,
 			Terms: channeldb.ContractTerm{
 				Value: amount,
 			},
-			CreationDate: time.Now(),
+			CreationDate:   time.Now(),
+			PaymentRequest: payReq,
 		},
 		Path:           paymentPath,
 		Fee:            route.TotalFees,
@@ -2739,6 +3834,9 @@ type rpcPaymentIntent struct {
 	cltvDelta         uint16
 	routeHints        [][]routing.HopHint
 	outgoingChannelID *uint64
+	lastHop           *routing.Vertex
+	cltvLimit         *uint32
+	payReq            []byte
 
 	routes []*routing.Route
 }
@@ -2778,6 +3876,24 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		payIntent.outgoingChannelID = &rpcPayReq.OutgoingChanId
 	}
 
+	// Likewise, pass along a last hop restriction if specified.
+	if len(rpcPayReq.LastHopPubkey) != 0 {
+		lastHop, err := btcec.ParsePubKey(
+			rpcPayReq.LastHopPubkey, btcec.S256(),
+		)
+		if err != nil {
+			return payIntent, err
+		}
+		lastHopVertex := routing.NewVertex(lastHop)
+		payIntent.lastHop = &lastHopVertex
+	}
+
+	// Bound the number of blocks the funds may be locked up for if
+	// requested.
+	if rpcPayReq.CltvLimit != 0 {
+		payIntent.cltvLimit = &rpcPayReq.CltvLimit
+	}
+
 	// If the payment request field isn't blank, then the details of the
 	// invoice are encoded entirely within the encoded payReq.  So we'll
 	// attempt to decode it, populating the payment accordingly.
@@ -2822,6 +3938,7 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 		payIntent.dest = payReq.Destination
 		payIntent.cltvDelta = uint16(payReq.MinFinalCLTVExpiry())
 		payIntent.routeHints = payReq.RouteHints
+		payIntent.payReq = []byte(rpcPayReq.PaymentRequest)
 
 		return payIntent, nil
 	}
@@ -2933,6 +4050,8 @@ func (r *rpcServer) dispatchPaymentIntent(
 			PaymentHash:       payIntent.rHash,
 			RouteHints:        payIntent.routeHints,
 			OutgoingChannelID: payIntent.outgoingChannelID,
+			LastHop:           payIntent.lastHop,
+			CltvLimit:         payIntent.cltvLimit,
 		}
 
 		// If the final CLTV value was specified, then we'll use that
@@ -2973,7 +4092,7 @@ func (r *rpcServer) dispatchPaymentIntent(
 
 	// Save the completed payment to the database for record keeping
 	// purposes.
-	err := r.savePayment(route, amt, preImage[:])
+	err := r.savePayment(route, amt, preImage[:], payIntent.payReq)
 	if err != nil {
 		// We weren't able to save the payment, so we return the save
 		// err, but a nil routing err.
@@ -3355,129 +4474,20 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		options = append(options, zpay32.CLTVExpiry(uint64(defaultDelta)))
 	}
 
-	// If we were requested to include routing hints in the invoice, then
-	// we'll fetch all of our available private channels and create routing
-	// hints for them.
-	if invoice.Private {
-		openChannels, err := r.server.chanDB.FetchAllChannels()
-		if err != nil {
-			return nil, fmt.Errorf("could not fetch all channels")
-		}
-
-		graph := r.server.chanDB.ChannelGraph()
-
-		numHints := 0
-		for _, channel := range openChannels {
-			// We'll restrict the number of individual route hints
-			// to 20 to avoid creating overly large invoices.
-			if numHints > 20 {
-				break
-			}
-
-			// Since we're only interested in our private channels,
-			// we'll skip public ones.
-			isPublic := channel.ChannelFlags&lnwire.FFAnnounceChannel != 0
-			if isPublic {
-				continue
-			}
-
-			// Make sure the counterparty has enough balance in the
-			// channel for our amount. We do this in order to reduce
-			// payment errors when attempting to use this channel
-			// as a hint.
-			chanPoint := lnwire.NewChanIDFromOutPoint(
-				&channel.FundingOutpoint,
-			)
-			if amtMSat >= channel.LocalCommitment.RemoteBalance {
-				rpcsLog.Debugf("Skipping channel %v due to "+
-					"not having enough remote balance",
-					chanPoint)
-				continue
-			}
-
-			// Make sure the channel is active.
-			link, err := r.server.htlcSwitch.GetLink(chanPoint)
-			if err != nil {
-				rpcsLog.Errorf("Unable to get link for "+
-					"channel %v: %v", chanPoint, err)
-				continue
-			}
-
-			if !link.EligibleToForward() {
-				rpcsLog.Debugf("Skipping channel %v due to not "+
-					"being eligible to forward payments",
-					chanPoint)
-				continue
-			}
-
-			// To ensure we don't leak unadvertised nodes, we'll
-			// make sure our counterparty is publicly advertised
-			// within the network. Otherwise, we'll end up leaking
-			// information about nodes that intend to stay
-			// unadvertised, like in the case of a node only having
-			// private channels.
-			var remotePub [33]byte
-			copy(remotePub[:], channel.IdentityPub.SerializeCompressed())
-			isRemoteNodePublic, err := graph.IsPublicNode(remotePub)
-			if err != nil {
-				rpcsLog.Errorf("Unable to determine if node %x "+
-					"is advertised: %v", remotePub, err)
-				continue
-			}
-
-			if !isRemoteNodePublic {
-				rpcsLog.Debugf("Skipping channel %v due to "+
-					"counterparty %x being unadvertised",
-					chanPoint, remotePub)
-				continue
-			}
-
-			// Fetch the policies for each end of the channel.
-			chanID := channel.ShortChanID().ToUint64()
-			info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
-			if err != nil {
-				rpcsLog.Errorf("Unable to fetch the routing "+
-					"policies for the edges of the channel "+
-					"%v: %v", chanPoint, err)
-				continue
-			}
-
-			// Now, we'll need to determine which is the correct
-			// policy for HTLCs being sent from the remote node.
-			var remotePolicy *channeldb.ChannelEdgePolicy
-			if bytes.Equal(remotePub[:], info.NodeKey1Bytes[:]) {
-				remotePolicy = p1
-			} else {
-				remotePolicy = p2
-			}
-
-			// If for some reason we don't yet have the edge for
-			// the remote party, then we'll just skip adding this
-			// channel as a routing hint.
-			if remotePolicy == nil {
-				continue
-			}
-
-			// Finally, create the routing hint for this channel and
-			// add it to our list of route hints.
-			hint := routing.HopHint{
-				NodeID:      channel.IdentityPub,
-				ChannelID:   chanID,
-				FeeBaseMSat: uint32(remotePolicy.FeeBaseMSat),
-				FeeProportionalMillionths: uint32(
-					remotePolicy.FeeProportionalMillionths,
-				),
-				CLTVExpiryDelta: remotePolicy.TimeLockDelta,
-			}
-
-			// Include the route hint in our set of options that
-			// will be used when creating the invoice.
-			routeHint := []routing.HopHint{hint}
-			options = append(options, zpay32.RouteHint(routeHint))
-
-			numHints++
-		}
+	// We'll fetch all of our private channels the invoice could be paid
+	// through, and create routing hints for them. This is done regardless
+	// of whether we have public channels, as the payer couldn't use our
+	// private channels otherwise.
+	hopHints, err := r.selectHopHints(amtMSat)
+	if err != nil {
+		return nil, err
+	}
 
+	// Include each route hint in our set of options that will be used
+	// when creating the invoice.
+	for _, hint := range hopHints {
+		routeHint := []routing.HopHint{hint}
+		options = append(options, zpay32.RouteHint(routeHint))
 	}
 
 	// Create and encode the payment request as a bech32 (zpay32) string.
@@ -3528,6 +4538,257 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 	}, nil
 }
 
+// remoteChanPolicy returns the routing policy of the remote party for HTLCs
+// sent towards us through the passed channel. If the remote party hasn't
+// advertised its policy yet, then nil is returned.
+func (r *rpcServer) remoteChanPolicy(
+	channel *channeldb.OpenChannel) (*channeldb.ChannelEdgePolicy, error) {
+
+	graph := r.server.chanDB.ChannelGraph()
+
+	// Fetch the policies for each end of the channel.
+	chanID := channel.ShortChanID().ToUint64()
+	info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Now, we'll need to determine which is the correct policy for HTLCs
+	// being sent from the remote node.
+	remotePub := channel.IdentityPub.SerializeCompressed()
+	if bytes.Equal(remotePub, info.NodeKey1Bytes[:]) {
+		return p1, nil
+	}
+
+	return p2, nil
+}
+
+// selectHopHints returns the routing hints for the private channels that are
+// able to receive a payment of the given amount, to be included within an
+// invoice.
+func (r *rpcServer) selectHopHints(
+	amtMSat lnwire.MilliSatoshi) ([]routing.HopHint, error) {
+
+	openChannels, err := r.server.chanDB.FetchAllChannels()
+	if err != nil {
+		return nil, fmt.Errorf("could not fetch all channels")
+	}
+
+	graph := r.server.chanDB.ChannelGraph()
+	src := &hopHintSource{
+		isPublicNode: graph.IsPublicNode,
+		isEligible: func(chanPoint lnwire.ChannelID) bool {
+			link, err := r.server.htlcSwitch.GetLink(chanPoint)
+			if err != nil {
+				rpcsLog.Errorf("Unable to get link for "+
+					"channel %v: %v", chanPoint, err)
+				return false
+			}
+
+			return link.EligibleToForward()
+		},
+		remotePolicy: r.remoteChanPolicy,
+	}
+
+	return filterHopHints(amtMSat, openChannels, src), nil
+}
+
+// hopHintSource provides the state of our channels and of the graph that
+// determines which of our private channels are used as hop hints.
+type hopHintSource struct {
+	// isPublicNode returns whether the given node is publicly advertised
+	// within the network.
+	isPublicNode func(pubKey [33]byte) (bool, error)
+
+	// isEligible returns whether the link of the given channel is active
+	// and eligible to forward payments.
+	isEligible func(chanPoint lnwire.ChannelID) bool
+
+	// remotePolicy returns the routing policy of the remote party for
+	// HTLCs sent to us over the given channel, or nil if unknown.
+	remotePolicy func(
+		*channeldb.OpenChannel) (*channeldb.ChannelEdgePolicy, error)
+}
+
+// filterHopHints returns the routing hints for those of the given channels
+// that are private and able to receive a payment of the given amount.
+func filterHopHints(amtMSat lnwire.MilliSatoshi,
+	openChannels []*channeldb.OpenChannel,
+	src *hopHintSource) []routing.HopHint {
+
+	var hopHints []routing.HopHint
+	for _, channel := range openChannels {
+		// We'll restrict the number of individual route hints to 20 to
+		// avoid creating overly large invoices.
+		if len(hopHints) > 20 {
+			break
+		}
+
+		// Since we're only interested in our private channels, we'll
+		// skip public ones.
+		isPublic := channel.ChannelFlags&lnwire.FFAnnounceChannel != 0
+		if isPublic {
+			continue
+		}
+
+		// Make sure the counterparty has enough balance in the channel
+		// for our amount. We do this in order to reduce payment errors
+		// when attempting to use this channel as a hint.
+		chanPoint := lnwire.NewChanIDFromOutPoint(
+			&channel.FundingOutpoint,
+		)
+		if amtMSat >= channel.LocalCommitment.RemoteBalance {
+			rpcsLog.Debugf("Skipping channel %v due to not "+
+				"having enough remote balance", chanPoint)
+			continue
+		}
+
+		// Make sure the channel is active.
+		if !src.isEligible(chanPoint) {
+			rpcsLog.Debugf("Skipping channel %v due to not "+
+				"being eligible to forward payments",
+				chanPoint)
+			continue
+		}
+
+		// To ensure we don't leak unadvertised nodes, we'll make sure
+		// our counterparty is publicly advertised within the network.
+		// Otherwise, we'll end up leaking information about nodes that
+		// intend to stay unadvertised, like in the case of a node only
+		// having private channels.
+		var remotePub [33]byte
+		copy(remotePub[:], channel.IdentityPub.SerializeCompressed())
+		isRemoteNodePublic, err := src.isPublicNode(remotePub)
+		if err != nil {
+			rpcsLog.Errorf("Unable to determine if node %x "+
+				"is advertised: %v", remotePub, err)
+			continue
+		}
+
+		if !isRemoteNodePublic {
+			rpcsLog.Debugf("Skipping channel %v due to "+
+				"counterparty %x being unadvertised",
+				chanPoint, remotePub)
+			continue
+		}
+
+		// Fetch the policy for HTLCs being sent from the remote node.
+		remotePolicy, err := src.remotePolicy(channel)
+		if err != nil {
+			rpcsLog.Errorf("Unable to fetch the routing "+
+				"policies for the edges of the channel "+
+				"%v: %v", chanPoint, err)
+			continue
+		}
+
+		// If for some reason we don't yet have the edge for the remote
+		// party, then we'll just skip adding this channel as a routing
+		// hint.
+		if remotePolicy == nil {
+			continue
+		}
+
+		// Finally, create the routing hint for this channel and add it
+		// to our list of route hints.
+		hopHints = append(hopHints, routing.HopHint{
+			NodeID:      channel.IdentityPub,
+			ChannelID:   channel.ShortChanID().ToUint64(),
+			FeeBaseMSat: uint32(remotePolicy.FeeBaseMSat),
+			FeeProportionalMillionths: uint32(
+				remotePolicy.FeeProportionalMillionths,
+			),
+			CLTVExpiryDelta: remotePolicy.TimeLockDelta,
+		})
+	}
+
+	return hopHints
+}
+
+// fetchPrivateChannels returns all of our private channels, indexed by their
+// short channel ID.
+func (r *rpcServer) fetchPrivateChannels() (map[uint64]*channeldb.OpenChannel,
+	error) {
+
+	openChannels, err := r.server.chanDB.FetchAllChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	privateChans := make(map[uint64]*channeldb.OpenChannel)
+	for _, channel := range openChannels {
+		if channel.ChannelFlags&lnwire.FFAnnounceChannel != 0 {
+			continue
+		}
+
+		privateChans[channel.ShortChanID().ToUint64()] = channel
+	}
+
+	return privateChans, nil
+}
+
+// hopHintsStale returns true if any of the passed routing hints no longer
+// matches one of our private channels, either because the channel has been
+// closed, or because its routing policy has changed since the hints were
+// created.
+func (r *rpcServer) hopHintsStale(routeHints []*lnrpc.RouteHint,
+	privateChans map[uint64]*channeldb.OpenChannel) bool {
+
+	for _, routeHint := range routeHints {
+		for _, hopHint := range routeHint.HopHints {
+			channel, ok := privateChans[hopHint.ChanId]
+			if !ok {
+				return true
+			}
+
+			remotePolicy, err := r.remoteChanPolicy(channel)
+			if err != nil || remotePolicy == nil {
+				return true
+			}
+
+			feeBase := uint32(remotePolicy.FeeBaseMSat)
+			feeRate := uint32(remotePolicy.FeeProportionalMillionths)
+			cltvDelta := uint32(remotePolicy.TimeLockDelta)
+			if hopHint.FeeBaseMsat != feeBase ||
+				hopHint.FeeProportionalMillionths != feeRate ||
+				hopHint.CltvExpiryDelta != cltvDelta {
+
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// markStaleHints sets the stale_hints field of every open invoice within the
+// passed set whose routing hints have gone stale.
+func (r *rpcServer) markStaleHints(invoices []*lnrpc.Invoice) error {
+	var privateChans map[uint64]*channeldb.OpenChannel
+	for _, invoice := range invoices {
+		if invoice.State != lnrpc.Invoice_OPEN ||
+			len(invoice.RouteHints) == 0 {
+
+			continue
+		}
+
+		// We'll only fetch our private channels once we come across
+		// the first invoice carrying routing hints.
+		if privateChans == nil {
+			var err error
+			privateChans, err = r.fetchPrivateChannels()
+			if err != nil {
+				return err
+			}
+		}
+
+		invoice.StaleHints = r.hopHintsStale(
+			invoice.RouteHints, privateChans,
+		)
+	}
+
+	return nil
+}
+
 // LookupInvoice attempts to look up an invoice according to its payment hash.
 // The passed payment hash *must* be exactly 32 bytes, if not an error is
 // returned.
@@ -3577,9 +4838,117 @@ func (r *rpcServer) LookupInvoice(ctx context.Context,
 		return nil, err
 	}
 
+	err = r.markStaleHints([]*lnrpc.Invoice{rpcInvoice})
+	if err != nil {
+		return nil, err
+	}
+
 	return rpcInvoice, nil
 }
 
+// RefreshInvoiceHints re-issues the payment request of an open, unexpired
+// invoice with a fresh set of routing hints for our private channels. Only the
+// payment request is replaced, the payment hash and all other terms of the
+// invoice remain unchanged.
+func (r *rpcServer) RefreshInvoiceHints(ctx context.Context,
+	req *lnrpc.PaymentHash) (*lnrpc.AddInvoiceResponse, error) {
+
+	var (
+		rHash []byte
+		err   error
+	)
+
+	// If the RHash as a raw string was provided, then decode that and use
+	// that directly. Otherwise, we use the raw bytes provided.
+	if req.RHashStr != "" {
+		rHash, err = hex.DecodeString(req.RHashStr)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		rHash = req.RHash
+	}
+
+	hash, err := lntypes.NewHash(rHash)
+	if err != nil {
+		return nil, err
+	}
+	payHash := *hash
+
+	invoice, _, err := r.server.invoices.LookupInvoice(payHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if invoice.Terms.State != channeldb.ContractOpen {
+		return nil, fmt.Errorf("unable to refresh hints of invoice "+
+			"in state %v", invoice.Terms.State)
+	}
+
+	payReq, err := zpay32.Decode(
+		string(invoice.PaymentRequest), activeNetParams.Params,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode payment request: %v",
+			err)
+	}
+
+	// There's no point in refreshing the hints of an invoice that can no
+	// longer be paid. We'll also refuse to add hints to an invoice that
+	// didn't include any before, as it wasn't meant to reveal any of our
+	// private channels.
+	if time.Now().After(payReq.Timestamp.Add(payReq.Expiry())) {
+		return nil, fmt.Errorf("invoice %v has expired", payHash)
+	}
+	if len(payReq.RouteHints) == 0 {
+		return nil, fmt.Errorf("invoice %v has no routing hints",
+			payHash)
+	}
+
+	amtMSat := invoice.Terms.Value
+	hopHints, err := r.selectHopHints(amtMSat)
+	if err != nil {
+		return nil, err
+	}
+
+	// With the new set of hints selected, we'll re-encode the payment
+	// request, leaving all of its other fields untouched. The destination
+	// is cleared again, as it was recovered from the signature while
+	// decoding rather than being included explicitly.
+	payReq.Destination = nil
+	payReq.RouteHints = make([][]routing.HopHint, 0, len(hopHints))
+	for _, hint := range hopHints {
+		payReq.RouteHints = append(
+			payReq.RouteHints, []routing.HopHint{hint},
+		)
+	}
+
+	payReqString, err := payReq.Encode(
+		zpay32.MessageSigner{
+			SignCompact: r.server.nodeSigner.SignDigestCompact,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedInvoice, err := r.server.invoices.UpdateInvoicePaymentRequest(
+		payHash, []byte(payReqString),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[refreshinvoicehints] refreshed invoice %v with %d "+
+		"routing hints", payHash, len(hopHints))
+
+	return &lnrpc.AddInvoiceResponse{
+		RHash:          payHash[:],
+		PaymentRequest: payReqString,
+		AddIndex:       updatedInvoice.AddIndex,
+	}, nil
+}
+
 // ListInvoices returns a list of all the invoices currently stored within the
 // database. Any active debug invoices are ignored.
 func (r *rpcServer) ListInvoices(ctx context.Context,
@@ -3620,6 +4989,10 @@ func (r *rpcServer) ListInvoices(ctx context.Context,
 		}
 	}
 
+	if err := r.markStaleHints(resp.Invoices); err != nil {
+		return nil, err
+	}
+
 	return resp, nil
 }
 
@@ -3677,28 +5050,19 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 	}
 	defer txClient.Cancel()
 
+	// A transaction is first sent when it enters the mempool, and then
+	// again once it confirms, so clients can track changes to its
+	// confirmation status by its hash.
 	for {
 		select {
 		case tx := <-txClient.ConfirmedTransactions():
-			detail := &lnrpc.Transaction{
-				TxHash:           tx.Hash.String(),
-				Amount:           int64(tx.Value),
-				NumConfirmations: tx.NumConfirmations,
-				BlockHash:        tx.BlockHash.String(),
-				TimeStamp:        tx.Timestamp,
-				TotalFees:        tx.TotalFees,
-			}
+			detail := marshalTransaction(tx)
 			if err := updateStream.Send(detail); err != nil {
 				return err
 			}
 
 		case tx := <-txClient.UnconfirmedTransactions():
-			detail := &lnrpc.Transaction{
-				TxHash:    tx.Hash.String(),
-				Amount:    int64(tx.Value),
-				TimeStamp: tx.Timestamp,
-				TotalFees: tx.TotalFees,
-			}
+			detail := marshalTransaction(tx)
 			if err := updateStream.Send(detail); err != nil {
 				return err
 			}
@@ -3724,31 +5088,39 @@ func (r *rpcServer) GetTransactions(ctx context.Context,
 		Transactions: make([]*lnrpc.Transaction, len(transactions)),
 	}
 	for i, tx := range transactions {
-		var destAddresses []string
-		for _, destAddress := range tx.DestAddresses {
-			destAddresses = append(destAddresses, destAddress.EncodeAddress())
-		}
+		txDetails.Transactions[i] = marshalTransaction(tx)
+	}
 
-		// We also get unconfirmed transactions, so BlockHash can be
-		// nil.
-		blockHash := ""
-		if tx.BlockHash != nil {
-			blockHash = tx.BlockHash.String()
-		}
+	return txDetails, nil
+}
 
-		txDetails.Transactions[i] = &lnrpc.Transaction{
-			TxHash:           tx.Hash.String(),
-			Amount:           int64(tx.Value),
-			NumConfirmations: tx.NumConfirmations,
-			BlockHash:        blockHash,
-			BlockHeight:      tx.BlockHeight,
-			TimeStamp:        tx.Timestamp,
-			TotalFees:        tx.TotalFees,
-			DestAddresses:    destAddresses,
-		}
+// marshalTransaction converts a wallet transaction into its RPC
+// representation.
+func marshalTransaction(tx *lnwallet.TransactionDetail) *lnrpc.Transaction {
+	var destAddresses []string
+	for _, destAddress := range tx.DestAddresses {
+		destAddresses = append(
+			destAddresses, destAddress.EncodeAddress(),
+		)
 	}
 
-	return txDetails, nil
+	// We also get unconfirmed transactions, so BlockHash can be nil.
+	blockHash := ""
+	if tx.BlockHash != nil {
+		blockHash = tx.BlockHash.String()
+	}
+
+	return &lnrpc.Transaction{
+		TxHash:           tx.Hash.String(),
+		Amount:           int64(tx.Value),
+		NumConfirmations: tx.NumConfirmations,
+		BlockHash:        blockHash,
+		BlockHeight:      tx.BlockHeight,
+		TimeStamp:        tx.Timestamp,
+		TotalFees:        tx.TotalFees,
+		DestAddresses:    destAddresses,
+		Label:            tx.Label,
+	}
 }
 
 // DescribeGraph returns a description of the latest graph state from the PoV
@@ -3992,7 +5364,59 @@ func (r *rpcServer) QueryRoutes(ctx context.Context,
 			"allowed is %v", amt, maxPaymentMSat.ToSatoshis())
 	}
 
-	feeLimit := calculateFeeLimit(in.FeeLimit, amtMSat)
+	restrictions := &routing.RouteRestrictions{
+		FeeLimit:       calculateFeeLimit(in.FeeLimit, amtMSat),
+		FinalCLTVDelta: uint16(in.FinalCltvDelta),
+	}
+
+	// Collect the nodes and channels the caller wishes to avoid, along
+	// with any routing hints to reach a private destination.
+	for _, nodeBytes := range in.IgnoredNodes {
+		if len(nodeBytes) != 33 {
+			return nil, fmt.Errorf("ignored node pubkey must be "+
+				"33 bytes, is instead %v", len(nodeBytes))
+		}
+
+		var node routing.Vertex
+		copy(node[:], nodeBytes)
+		restrictions.IgnoredNodes = append(
+			restrictions.IgnoredNodes, node,
+		)
+	}
+	for _, edge := range in.IgnoredEdges {
+		ignoredEdge := routing.IgnoredEdge{
+			ChannelID: edge.ChannelId,
+		}
+		if edge.DirectionReverse {
+			ignoredEdge.Direction = 1
+		}
+
+		restrictions.IgnoredEdges = append(
+			restrictions.IgnoredEdges, ignoredEdge,
+		)
+	}
+	restrictions.RouteHints, err = unmarshallRouteHints(in.RouteHints)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pin the routes to a first and last hop if requested.
+	if in.OutgoingChanId != 0 {
+		restrictions.OutgoingChannelID = &in.OutgoingChanId
+	}
+	if len(in.LastHopPubkey) != 0 {
+		lastHop, err := btcec.ParsePubKey(
+			in.LastHopPubkey, btcec.S256(),
+		)
+		if err != nil {
+			return nil, err
+		}
+		lastHopVertex := routing.NewVertex(lastHop)
+		restrictions.LastHop = &lastHopVertex
+	}
+	if in.CltvLimit != 0 {
+		restrictions.CltvLimit = &in.CltvLimit
+	}
 
 	// numRoutes will default to 10 if not specified explicitly.
 	numRoutesIn := uint32(in.NumRoutes)
@@ -4003,22 +5427,11 @@ func (r *rpcServer) QueryRoutes(ctx context.Context,
 	// Query the channel router for a possible path to the destination that
 	// can carry `in.Amt` satoshis _including_ the total fee required on
 	// the route.
-	var (
-		routes  []*routing.Route
-		findErr error
+	routes, err := r.server.chanRouter.FindRestrictedRoutes(
+		pubKey, amtMSat, restrictions, numRoutesIn,
 	)
-	if in.FinalCltvDelta == 0 {
-		routes, findErr = r.server.chanRouter.FindRoutes(
-			pubKey, amtMSat, feeLimit, numRoutesIn,
-		)
-	} else {
-		routes, findErr = r.server.chanRouter.FindRoutes(
-			pubKey, amtMSat, feeLimit, numRoutesIn,
-			uint16(in.FinalCltvDelta),
-		)
-	}
-	if findErr != nil {
-		return nil, findErr
+	if err != nil {
+		return nil, err
 	}
 
 	// As the number of returned routes can be less than the number of
@@ -4088,6 +5501,43 @@ func (r *rpcServer) marshallRoute(route *routing.Route) *lnrpc.Route {
 	return resp
 }
 
+// unmarshallRouteHints converts the given rpc route hints into the hop hints
+// used by the router.
+func unmarshallRouteHints(
+	rpcHints []*lnrpc.RouteHint) ([][]routing.HopHint, error) {
+
+	routeHints := make([][]routing.HopHint, 0, len(rpcHints))
+	for _, rpcHint := range rpcHints {
+		routeHint := make([]routing.HopHint, 0, len(rpcHint.HopHints))
+		for _, hopHint := range rpcHint.HopHints {
+			pubKeyBytes, err := hex.DecodeString(hopHint.NodeId)
+			if err != nil {
+				return nil, err
+			}
+			pubKey, err := btcec.ParsePubKey(
+				pubKeyBytes, btcec.S256(),
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			feeRate := hopHint.FeeProportionalMillionths
+			cltvDelta := uint16(hopHint.CltvExpiryDelta)
+			routeHint = append(routeHint, routing.HopHint{
+				NodeID:                    pubKey,
+				ChannelID:                 hopHint.ChanId,
+				FeeBaseMSat:               hopHint.FeeBaseMsat,
+				FeeProportionalMillionths: feeRate,
+				CLTVExpiryDelta:           cltvDelta,
+			})
+		}
+
+		routeHints = append(routeHints, routeHint)
+	}
+
+	return routeHints, nil
+}
+
 // unmarshallHopByChannelLookup unmarshalls an rpc hop for which the pub key is
 // not known. This function will query the channel graph with channel id to
 // retrieve both endpoints and determine the hop pubkey using the previous hop
@@ -4283,6 +5733,13 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 		minChannelSize = 0
 	}
 
+	// Finally, we'll query the graph for the number of channels we've
+	// pruned as zombies.
+	numZombies, err := graph.NumZombies()
+	if err != nil {
+		return nil, err
+	}
+
 	// TODO(roasbeef): graph diameter
 
 	// TODO(roasbeef): also add oldest channel?
@@ -4297,6 +5754,7 @@ func (r *rpcServer) GetNetworkInfo(ctx context.Context,
 
 		MinChannelSize: int64(minChannelSize),
 		MaxChannelSize: int64(maxChannelSize),
+		NumZombieChans: numZombies,
 	}
 
 	// Similarly, if we don't have any channels, then we'll also set the
@@ -4388,11 +5846,15 @@ func marshallTopologyChange(topChange *routing.TopologyChange) *lnrpc.GraphTopol
 			addrs[i] = addr.String()
 		}
 
+		nodeColor := fmt.Sprintf("#%02x%02x%02x", nodeUpdate.Color.R,
+			nodeUpdate.Color.G, nodeUpdate.Color.B)
+
 		nodeUpdates[i] = &lnrpc.NodeUpdate{
 			Addresses:      addrs,
 			IdentityKey:    encodeKey(nodeUpdate.IdentityKey),
 			GlobalFeatures: nodeUpdate.GlobalFeatures,
 			Alias:          nodeUpdate.Alias,
+			Color:          nodeColor,
 		}
 	}
 
@@ -4494,6 +5956,60 @@ func (r *rpcServer) DeleteAllPayments(ctx context.Context,
 	return &lnrpc.DeleteAllPaymentsResponse{}, nil
 }
 
+// ExportPaymentProof exports the proof of a settled payment, which can be
+// verified offline by a third party.
+func (r *rpcServer) ExportPaymentProof(ctx context.Context,
+	req *lnrpc.ExportPaymentProofRequest) (*lnrpc.PaymentProof, error) {
+
+	rpcsLog.Debugf("[exportpaymentproof] payment_hash=%v", req.PaymentHash)
+
+	paymentHash, err := lntypes.NewHashFromStr(req.PaymentHash)
+	if err != nil {
+		return nil, err
+	}
+
+	payments, err := r.server.chanDB.FetchAllPayments()
+	if err != nil && err != channeldb.ErrNoPaymentsCreated {
+		return nil, err
+	}
+
+	// Only settled payments are stored, so we'll look for the one whose
+	// preimage matches the payment hash.
+	for _, payment := range payments {
+		preimage := lntypes.Preimage(payment.PaymentPreimage)
+		if preimage.Hash() != *paymentHash {
+			continue
+		}
+
+		return newPaymentProof(payment, activeNetParams.Params)
+	}
+
+	return nil, fmt.Errorf("no settled payment found with payment hash %v",
+		paymentHash)
+}
+
+// VerifyPaymentProof verifies a payment proof exported by ExportPaymentProof.
+// As the verification doesn't rely on any state, any node can verify the proof
+// of a payment it wasn't involved in.
+func (r *rpcServer) VerifyPaymentProof(ctx context.Context,
+	proof *lnrpc.PaymentProof) (*lnrpc.VerifyPaymentProofResponse, error) {
+
+	rpcsLog.Debugf("[verifypaymentproof] payment_hash=%v",
+		proof.PaymentHash)
+
+	payee, err := verifyPaymentProof(proof, activeNetParams.Params)
+	if err != nil {
+		return &lnrpc.VerifyPaymentProofResponse{
+			Error: err.Error(),
+		}, nil
+	}
+
+	return &lnrpc.VerifyPaymentProofResponse{
+		Valid: true,
+		Payee: hex.EncodeToString(payee.SerializeCompressed()),
+	}, nil
+}
+
 // DebugLevel allows a caller to programmatically set the logging verbosity of
 // lnd. The logging can be targeted according to a coarse daemon-wide logging
 // level, or in a granular fashion to specify the logging for a target
@@ -4520,6 +6036,59 @@ func (r *rpcServer) DebugLevel(ctx context.Context,
 	return &lnrpc.DebugLevelResponse{}, nil
 }
 
+// VerifyChanState is a debugging RPC that verifies the revocation state of the
+// remote party of our open channels, ensuring all the secrets it revealed so
+// far can be derived from our revocation store.
+func (r *rpcServer) VerifyChanState(ctx context.Context,
+	req *lnrpc.VerifyChanStateRequest) (*lnrpc.VerifyChanStateResponse,
+	error) {
+
+	var channels []*channeldb.OpenChannel
+	if req.ChannelPoint != nil {
+		txidHash, err := getChanPointFundingTxid(req.ChannelPoint)
+		if err != nil {
+			return nil, err
+		}
+		txid, err := chainhash.NewHash(txidHash)
+		if err != nil {
+			return nil, err
+		}
+		chanPoint := wire.NewOutPoint(txid, req.ChannelPoint.OutputIndex)
+
+		dbChan, err := r.server.chanDB.FetchChannel(*chanPoint)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, dbChan)
+	} else {
+		var err error
+		channels, err = r.server.chanDB.FetchAllOpenChannels()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &lnrpc.VerifyChanStateResponse{}
+	for _, dbChan := range channels {
+		numSecrets, err := dbChan.VerifyRevocationState()
+		result := &lnrpc.ChanStateVerification{
+			ChannelPoint: dbChan.FundingOutpoint.String(),
+			NumSecrets:   numSecrets,
+		}
+		if err != nil {
+			rpcsLog.Errorf("[verifychanstate] ChannelPoint(%v) has "+
+				"inconsistent revocation state: %v",
+				dbChan.FundingOutpoint, err)
+
+			result.Error = err.Error()
+		}
+
+		resp.Channels = append(resp.Channels, result)
+	}
+
+	return resp, nil
+}
+
 // DecodePayReq takes an encoded payment request string and attempts to decode
 // it, returning a full description of the conditions encoded within the
 // payment request.
@@ -4788,12 +6357,31 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	chanPolicy := routing.ChannelPolicy{
 		FeeSchema:     feeSchema,
 		TimeLockDelta: req.TimeLockDelta,
+		MaxHTLC:       lnwire.MilliSatoshi(req.MaxHtlcMsat),
+	}
+
+	// The minimum HTLC size is only updated if explicitly requested, as
+	// its zero value can't be told apart from it being unset.
+	var minHtlc lnwire.MilliSatoshi
+	if req.MinHtlcMsatSpecified {
+		if req.MinHtlcMsat == 0 {
+			return nil, fmt.Errorf("min htlc must be positive")
+		}
+
+		minHtlc = lnwire.MilliSatoshi(req.MinHtlcMsat)
+		chanPolicy.MinHTLC = &minHtlc
+
+		err := r.validatePolicyMinHtlc(minHtlc, targetChans)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy base_fee=%v, "+
-		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, targets=%v",
+		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, "+
+		"min_htlc=%v, max_htlc=%v, targets=%v",
 		req.BaseFeeMsat, req.FeeRate, feeRateFixed, req.TimeLockDelta,
-		spew.Sdump(targetChans))
+		minHtlc, chanPolicy.MaxHTLC, spew.Sdump(targetChans))
 
 	// With the scope resolved, we'll now send this to the
 	// AuthenticatedGossiper so it can propagate the new policy for our
@@ -4814,6 +6402,8 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 		BaseFee:       baseFeeMsat,
 		FeeRate:       lnwire.MilliSatoshi(feeRateFixed),
 		TimeLockDelta: req.TimeLockDelta,
+		MinHTLC:       minHtlc,
+		MaxHTLC:       chanPolicy.MaxHTLC,
 	}
 	err = r.server.htlcSwitch.UpdateForwardingPolicies(p, targetChans...)
 	if err != nil {
@@ -4826,6 +6416,98 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	return &lnrpc.PolicyUpdateResponse{}, nil
 }
 
+// validatePolicyMinHtlc ensures that the given minimum HTLC size isn't below
+// the min_htlc constraint the remote party imposed on any of the target
+// channels, as it wouldn't accept the smaller HTLCs we'd advertise to forward.
+// An empty set of target channels denotes all of our channels.
+func (r *rpcServer) validatePolicyMinHtlc(minHtlc lnwire.MilliSatoshi,
+	targetChans []wire.OutPoint) error {
+
+	targets := make(map[wire.OutPoint]struct{}, len(targetChans))
+	for _, chanPoint := range targetChans {
+		targets[chanPoint] = struct{}{}
+	}
+
+	openChannels, err := r.server.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range openChannels {
+		_, ok := targets[channel.FundingOutpoint]
+		if len(targets) != 0 && !ok {
+			continue
+		}
+
+		if minHtlc < channel.LocalChanCfg.MinHTLC {
+			return fmt.Errorf("min htlc of %v for channel %v is "+
+				"below its min_htlc constraint of %v", minHtlc,
+				channel.FundingOutpoint,
+				channel.LocalChanCfg.MinHTLC)
+		}
+	}
+
+	return nil
+}
+
+// ListFeeClamps returns the bounds within which the fee rates used by each of
+// the consumers of the fee estimator are clamped.
+func (r *rpcServer) ListFeeClamps(ctx context.Context,
+	req *lnrpc.ListFeeClampsRequest) (*lnrpc.ListFeeClampsResponse, error) {
+
+	var clamps []*lnrpc.FeeClamp
+	for i := range lnrpc.FeeConsumer_name {
+		consumer := lnrpc.FeeConsumer(i)
+		estimator, err := r.server.feeClamps.forConsumer(consumer)
+		if err != nil {
+			return nil, err
+		}
+
+		minFeeRate, maxFeeRate := estimator.Bounds()
+		clamps = append(clamps, &lnrpc.FeeClamp{
+			Consumer:       consumer,
+			MinSatPerVbyte: uint64(minFeeRate.FeePerKVByte() / 1000),
+			MaxSatPerVbyte: uint64(maxFeeRate.FeePerKVByte() / 1000),
+		})
+	}
+
+	// Map iteration order is random, so we'll sort the clamps by consumer
+	// to return them in a consistent order.
+	sort.Slice(clamps, func(i, j int) bool {
+		return clamps[i].Consumer < clamps[j].Consumer
+	})
+
+	return &lnrpc.ListFeeClampsResponse{
+		Clamps: clamps,
+	}, nil
+}
+
+// UpdateFeeClamp updates the bounds within which the fee rates used by a
+// consumer of the fee estimator are clamped. The new bounds apply from the next
+// fee estimation of the consumer, but aren't persisted across restarts.
+func (r *rpcServer) UpdateFeeClamp(ctx context.Context,
+	req *lnrpc.FeeClamp) (*lnrpc.UpdateFeeClampResponse, error) {
+
+	estimator, err := r.server.feeClamps.forConsumer(req.Consumer)
+	if err != nil {
+		return nil, err
+	}
+
+	err = estimator.SetBounds(
+		satPerVByteToKW(req.MinSatPerVbyte),
+		satPerVByteToKW(req.MaxSatPerVbyte),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Updated %v fee clamp: min=%v sat/vbyte, "+
+		"max=%v sat/vbyte", req.Consumer, req.MinSatPerVbyte,
+		req.MaxSatPerVbyte)
+
+	return &lnrpc.UpdateFeeClampResponse{}, nil
+}
+
 // ForwardingHistory allows the caller to query the htlcswitch for a record of
 // all HTLC's forwarded within the target time range, and integer offset within
 // that time range. If no time-range is specified, then the first chunk of the
@@ -4916,3 +6598,377 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 
 	return resp, nil
 }
+
+// SwitchStats returns the runtime performance counters of the htlcswitch: the
+// rate at which HTLCs are forwarded and commitments are signed by each link,
+// along with percentiles of the end-to-end latency of settled HTLCs.
+func (r *rpcServer) SwitchStats(ctx context.Context,
+	req *lnrpc.SwitchStatsRequest) (*lnrpc.SwitchStatsResponse, error) {
+
+	stats := r.server.htlcSwitch.PerfStats()
+
+	resp := &lnrpc.SwitchStatsResponse{
+		Links:              make([]*lnrpc.LinkStats, len(stats.Links)),
+		NumSettles:         stats.NumSettles,
+		SettleLatencyP50Us: stats.SettleLatencyP50.Nanoseconds() / 1000,
+		SettleLatencyP90Us: stats.SettleLatencyP90.Nanoseconds() / 1000,
+		SettleLatencyP99Us: stats.SettleLatencyP99.Nanoseconds() / 1000,
+	}
+	for i, link := range stats.Links {
+		resp.Links[i] = &lnrpc.LinkStats{
+			ChanId:           link.ChanID.ToUint64(),
+			NumHtlcs:         link.NumHtlcs,
+			HtlcsPerSec:      link.HtlcsPerSecond(),
+			NumCommitSigs:    link.NumCommitSigs,
+			CommitSigsPerSec: link.CommitSigsPerSecond(),
+		}
+	}
+
+	return resp, nil
+}
+
+// ListHtlcs returns every HTLC in flight across all open channels, along with
+// the switch circuit it's part of and its state in the commitment state
+// machine of its channel.
+func (r *rpcServer) ListHtlcs(ctx context.Context,
+	req *lnrpc.ListHtlcsRequest) (*lnrpc.ListHtlcsResponse, error) {
+
+	dbChannels, err := r.server.chanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	htlcSwitch := r.server.htlcSwitch
+	bestHeight := htlcSwitch.BestHeight()
+
+	resp := &lnrpc.ListHtlcsResponse{}
+	for _, dbChannel := range dbChannels {
+		chanPoint := dbChannel.FundingOutpoint
+		shortChanID := dbChannel.ShortChanID()
+
+		// If the channel has an active link, we'll fetch the HTLCs
+		// from its update logs, which know how far along the state
+		// machine each of them is. Otherwise, we can only report the
+		// HTLCs on our latest commitment.
+		var statuses []lnwallet.HtlcStatus
+		chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+		link, err := htlcSwitch.GetLink(chanID)
+		linkActive := err == nil
+		if linkActive {
+			statuses = link.HtlcStatuses()
+		} else {
+			for _, htlc := range dbChannel.LocalCommitment.Htlcs {
+				statuses = append(statuses, lnwallet.HtlcStatus{
+					HtlcIndex: htlc.HtlcIndex,
+					Incoming:  htlc.Incoming,
+					Amount:    htlc.Amt,
+					RHash:     htlc.RHash,
+					Timeout:   htlc.RefundTimeout,
+				})
+			}
+		}
+
+		for _, status := range statuses {
+			rHash := status.RHash
+			rpcHtlc := &lnrpc.InFlightHtlc{
+				ChanId:           shortChanID.ToUint64(),
+				ChannelPoint:     chanPoint.String(),
+				Incoming:         status.Incoming,
+				HtlcIndex:        status.HtlcIndex,
+				AmtMsat:          uint64(status.Amount),
+				HashLock:         rHash[:],
+				ExpirationHeight: status.Timeout,
+				BlocksTillExpiry: int32(status.Timeout) -
+					int32(bestHeight),
+			}
+
+			// Without an active link, the state of the HTLC is
+			// unknown.
+			if linkActive {
+				rpcHtlc.State = marshallHtlcState(status.State)
+			}
+
+			// An incoming HTLC is the incoming end of its circuit,
+			// while an outgoing HTLC is the outgoing end.
+			circuit := htlcSwitch.HtlcCircuit(
+				shortChanID, status.HtlcIndex, status.Incoming,
+			)
+			if circuit != nil {
+				marshallHtlcCircuit(rpcHtlc, circuit)
+			}
+
+			resp.Htlcs = append(resp.Htlcs, rpcHtlc)
+		}
+	}
+
+	return resp, nil
+}
+
+// marshallHtlcCircuit populates the given HTLC with the circuit it's part of.
+func marshallHtlcCircuit(rpcHtlc *lnrpc.InFlightHtlc,
+	circuit *htlcswitch.PaymentCircuit) {
+
+	isLocal := !rpcHtlc.Incoming && circuit.IsLocal()
+	rpcHtlc.HasCircuit = true
+	rpcHtlc.LocalPayment = isLocal
+
+	// An incoming HTLC only has an outgoing end once it has been
+	// forwarded, while our payments don't have an incoming end.
+	var otherEnd *htlcswitch.CircuitKey
+	switch {
+	case rpcHtlc.Incoming:
+		otherEnd = circuit.Outgoing
+	case !isLocal:
+		otherEnd = &circuit.Incoming
+	}
+
+	if otherEnd != nil {
+		rpcHtlc.CircuitChanId = otherEnd.ChanID.ToUint64()
+		rpcHtlc.CircuitHtlcIndex = otherEnd.HtlcID
+	}
+}
+
+// marshallHtlcState translates the state of an HTLC in the commitment state
+// machine to its RPC counterpart.
+func marshallHtlcState(state lnwallet.HtlcState) lnrpc.InFlightHtlc_State {
+	switch state {
+	case lnwallet.HtlcPendingAdd:
+		return lnrpc.InFlightHtlc_PENDING_ADD
+	case lnwallet.HtlcLockedIn:
+		return lnrpc.InFlightHtlc_LOCKED_IN
+	case lnwallet.HtlcPendingSettle:
+		return lnrpc.InFlightHtlc_PENDING_SETTLE
+	case lnwallet.HtlcPendingFail:
+		return lnrpc.InFlightHtlc_PENDING_FAIL
+	default:
+		return lnrpc.InFlightHtlc_UNKNOWN
+	}
+}
+
+// UpdateNodeAnnouncement updates the alias, color and advertised addresses of
+// our node announcement, then signs and broadcasts the new announcement to the
+// network.
+func (r *rpcServer) UpdateNodeAnnouncement(ctx context.Context,
+	req *lnrpc.UpdateNodeAnnouncementRequest) (
+	*lnrpc.UpdateNodeAnnouncementResponse, error) {
+
+	currentNodeAnn, err := r.server.genNodeAnnouncement(false)
+	if err != nil {
+		return nil, err
+	}
+
+	updates, err := nodeAnnUpdates(
+		req, currentNodeAnn.Addresses, cfg.net.ResolveTCPAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.server.updateAndBroadcastNodeAnn(updates...); err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("Updated and broadcast node announcement")
+
+	return &lnrpc.UpdateNodeAnnouncementResponse{}, nil
+}
+
+// nodeAnnUpdates validates the given request and returns the modifications to
+// apply to our node announcement. The addresses to be removed must be among
+// the currently advertised addresses.
+func nodeAnnUpdates(req *lnrpc.UpdateNodeAnnouncementRequest,
+	advertisedAddrs []net.Addr,
+	resolver func(string, string) (*net.TCPAddr, error)) (
+	[]func(*lnwire.NodeAnnouncement), error) {
+
+	if req.Alias == "" && req.Color == "" && len(req.AddAddresses) == 0 &&
+		len(req.RemoveAddresses) == 0 {
+
+		return nil, errors.New("no node announcement updates specified")
+	}
+
+	var updates []func(*lnwire.NodeAnnouncement)
+
+	if req.Alias != "" {
+		alias, err := lnwire.NewNodeAlias(req.Alias)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, func(ann *lnwire.NodeAnnouncement) {
+			ann.Alias = alias
+		})
+	}
+
+	if req.Color != "" {
+		color, err := parseHexColor(req.Color)
+		if err != nil {
+			return nil, err
+		}
+
+		updates = append(updates, func(ann *lnwire.NodeAnnouncement) {
+			ann.RGBColor = color
+		})
+	}
+
+	if len(req.AddAddresses) > 0 || len(req.RemoveAddresses) > 0 {
+		addAddrs, err := lncfg.NormalizeAddresses(
+			req.AddAddresses, strconv.Itoa(defaultPeerPort),
+			resolver,
+		)
+		if err != nil {
+			return nil, err
+		}
+		removeAddrs, err := lncfg.NormalizeAddresses(
+			req.RemoveAddresses, strconv.Itoa(defaultPeerPort),
+			resolver,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// We'll make sure we're actually advertising the addresses to
+		// be removed, as a typo would otherwise go unnoticed.
+		advertised := make(map[string]struct{})
+		for _, addr := range advertisedAddrs {
+			advertised[addr.String()] = struct{}{}
+		}
+		removals := make(map[string]struct{})
+		for _, addr := range removeAddrs {
+			if _, ok := advertised[addr.String()]; !ok {
+				return nil, fmt.Errorf("address %v is not "+
+					"advertised", addr)
+			}
+			removals[addr.String()] = struct{}{}
+		}
+
+		updates = append(updates, func(ann *lnwire.NodeAnnouncement) {
+			addrs := make([]net.Addr, 0, len(ann.Addresses))
+			present := make(map[string]struct{})
+			for _, addr := range ann.Addresses {
+				if _, ok := removals[addr.String()]; ok {
+					continue
+				}
+				addrs = append(addrs, addr)
+				present[addr.String()] = struct{}{}
+			}
+			for _, addr := range addAddrs {
+				if _, ok := present[addr.String()]; ok {
+					continue
+				}
+				addrs = append(addrs, addr)
+				present[addr.String()] = struct{}{}
+			}
+
+			ann.Addresses = addrs
+		})
+	}
+
+	return updates, nil
+}
+
+// ListBannedPeers returns the peers we've banned for sending us too many
+// gossip messages failing signature validation.
+func (r *rpcServer) ListBannedPeers(ctx context.Context,
+	req *lnrpc.ListBannedPeersRequest) (*lnrpc.ListBannedPeersResponse,
+	error) {
+
+	bannedPeers, err := r.server.chanDB.FetchBannedPeers()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListBannedPeersResponse{
+		Peers: make([]*lnrpc.BannedPeer, 0, len(bannedPeers)),
+	}
+	for _, peer := range bannedPeers {
+		// Expired bans are only removed from the database on
+		// restart, so we'll skip them here.
+		if banExpired(peer.BannedAt) {
+			continue
+		}
+
+		resp.Peers = append(resp.Peers, &lnrpc.BannedPeer{
+			PubKey:   hex.EncodeToString(peer.PubKey[:]),
+			BannedAt: peer.BannedAt.Unix(),
+			Reason:   peer.Reason,
+		})
+	}
+
+	return resp, nil
+}
+
+// UnbanPeer lifts the ban of a peer banned for sending us too many invalid
+// gossip messages.
+func (r *rpcServer) UnbanPeer(ctx context.Context,
+	req *lnrpc.UnbanPeerRequest) (*lnrpc.UnbanPeerResponse, error) {
+
+	pubKeyBytes, err := hex.DecodeString(req.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode pubkey: %v", err)
+	}
+	if len(pubKeyBytes) != 33 {
+		return nil, fmt.Errorf("invalid pubkey length: expected 33 "+
+			"bytes, got %v", len(pubKeyBytes))
+	}
+
+	var pubKey [33]byte
+	copy(pubKey[:], pubKeyBytes)
+
+	if err := r.server.unbanPeer(pubKey); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.UnbanPeerResponse{}, nil
+}
+
+// RegisterRPCMiddleware dispatches a bi-directional streaming RPC through
+// which an external process registers as the middleware for a custom macaroon
+// caveat. Every RPC authenticated with a macaroon carrying that caveat then
+// has its requests and responses sent to the middleware, which may reject or
+// replace them.
+func (r *rpcServer) RegisterRPCMiddleware(
+	stream lnrpc.Lightning_RegisterRPCMiddlewareServer) error {
+
+	if r.middlewareRegistry == nil {
+		return errors.New("rpc middleware requires macaroons to be " +
+			"enabled")
+	}
+
+	// The first message sent by the middleware must be its registration.
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	registration := msg.Register
+	switch {
+	case registration == nil:
+		return errors.New("first message must be the middleware " +
+			"registration")
+
+	case registration.MiddlewareName == "":
+		return errors.New("middleware name must be set")
+
+	case registration.CustomCaveatName == "" ||
+		strings.Contains(registration.CustomCaveatName, " "):
+
+		return fmt.Errorf("invalid custom caveat name %q",
+			registration.CustomCaveatName)
+	}
+
+	mw := newRPCMiddleware(
+		registration.MiddlewareName, registration.CustomCaveatName,
+		stream,
+	)
+	if err := r.middlewareRegistry.register(mw); err != nil {
+		return err
+	}
+	defer r.middlewareRegistry.unregister(mw)
+
+	rpcsLog.Infof("RPC middleware %v registered for custom caveat %v",
+		mw.name, mw.caveatName)
+	defer rpcsLog.Infof("RPC middleware %v unregistered", mw.name)
+
+	return mw.run()
+}
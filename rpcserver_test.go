@@ -0,0 +1,379 @@
+// +build !rpctest
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"image/color"
+	"net"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/netann"
+	"github.com/tv42/zbase32"
+)
+
+// TestPrefixSignedMsg asserts that messages are prefixed before being signed,
+// and that the resulting signature matches a known vector.
+func TestPrefixSignedMsg(t *testing.T) {
+	t.Parallel()
+
+	msg := []byte("Hello, Lightning!")
+
+	const (
+		expectedPrefixedMsg = "4c696768746e696e67205369676e6564204d657" +
+			"3736167653a48656c6c6f2c204c696768746e696e6721"
+
+		expectedSig = "dhdwenj5mkkg5wgkspdde5kw5pcfgeew9h6883s8gj4kw" +
+			"hxx1mhrnk5hzwiyf8fntwt8ya6kzdzr96doe8khfsfbyhyzq7y3" +
+			"brctp9h6"
+
+		expectedPubKey = "039997a497d964fc1a62885b05a51166a65a90df004" +
+			"92c8d7cf61d6accf54803be"
+	)
+
+	prefixedMsg := prefixSignedMsg(msg)
+	if hex.EncodeToString(prefixedMsg) != expectedPrefixedMsg {
+		t.Fatalf("expected prefixed message %v, got %x",
+			expectedPrefixedMsg, prefixedMsg)
+	}
+
+	// Prefixing another message must not alter the one prefixed before.
+	prefixSignedMsg([]byte("another message"))
+	if hex.EncodeToString(prefixedMsg) != expectedPrefixedMsg {
+		t.Fatalf("prefixed message was overwritten: %x", prefixedMsg)
+	}
+	if !bytes.Equal(signedMsgPrefix, []byte("Lightning Signed Message:")) {
+		t.Fatalf("signed message prefix was modified: %s",
+			signedMsgPrefix)
+	}
+
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), alicesPrivKey)
+	signer := netann.NewNodeSigner(privKey)
+
+	sigBytes, err := signer.SignCompact(prefixedMsg)
+	if err != nil {
+		t.Fatalf("unable to sign message: %v", err)
+	}
+	sig := zbase32.EncodeToString(sigBytes)
+	if sig != expectedSig {
+		t.Fatalf("expected signature %v, got %v", expectedSig, sig)
+	}
+
+	// The signer's public key should be recoverable from the signature
+	// over the prefixed message only.
+	digest := chainhash.DoubleHashB(prefixedMsg)
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), sigBytes, digest)
+	if err != nil {
+		t.Fatalf("unable to recover public key: %v", err)
+	}
+	if hex.EncodeToString(pubKey.SerializeCompressed()) != expectedPubKey {
+		t.Fatalf("expected public key %v, got %x", expectedPubKey,
+			pubKey.SerializeCompressed())
+	}
+
+	digest = chainhash.DoubleHashB(msg)
+	pubKey, _, err = btcec.RecoverCompact(btcec.S256(), sigBytes, digest)
+	if err == nil && pubKey.IsEqual(privKey.PubKey()) {
+		t.Fatalf("signature should not be valid for unprefixed message")
+	}
+}
+
+// TestNodeAnnUpdates asserts that the updates requested through
+// UpdateNodeAnnouncement are validated and applied to our node announcement.
+func TestNodeAnnUpdates(t *testing.T) {
+	t.Parallel()
+
+	currentAlias, err := lnwire.NewNodeAlias("alice")
+	if err != nil {
+		t.Fatalf("unable to create alias: %v", err)
+	}
+	newAlias, err := lnwire.NewNodeAlias("bob")
+	if err != nil {
+		t.Fatalf("unable to create alias: %v", err)
+	}
+
+	currentColor := color.RGBA{R: 0x33, G: 0x99, B: 0xff}
+	currentAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 9735}
+	otherAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 9735}
+	customPortAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.3"), Port: 9736}
+
+	testCases := []struct {
+		name string
+		req  *lnrpc.UpdateNodeAnnouncementRequest
+
+		expectErr   bool
+		expectAlias lnwire.NodeAlias
+		expectColor color.RGBA
+		expectAddrs []net.Addr
+	}{
+		{
+			name:      "no updates",
+			req:       &lnrpc.UpdateNodeAnnouncementRequest{},
+			expectErr: true,
+		},
+		{
+			name: "update alias",
+			req: &lnrpc.UpdateNodeAnnouncementRequest{
+				Alias: "bob",
+			},
+			expectAlias: newAlias,
+			expectColor: currentColor,
+			expectAddrs: []net.Addr{currentAddr},
+		},
+		{
+			name: "alias too long",
+			req: &lnrpc.UpdateNodeAnnouncementRequest{
+				Alias: "this alias is way too long to fit " +
+					"into a node announcement",
+			},
+			expectErr: true,
+		},
+		{
+			name: "update color",
+			req: &lnrpc.UpdateNodeAnnouncementRequest{
+				Color: "#ff0000",
+			},
+			expectAlias: currentAlias,
+			expectColor: color.RGBA{R: 0xff},
+			expectAddrs: []net.Addr{currentAddr},
+		},
+		{
+			name: "invalid color",
+			req: &lnrpc.UpdateNodeAnnouncementRequest{
+				Color: "red",
+			},
+			expectErr: true,
+		},
+		{
+			name: "add addresses",
+			req: &lnrpc.UpdateNodeAnnouncementRequest{
+				AddAddresses: []string{
+					"10.0.0.1", "10.0.0.2", "10.0.0.3:9736",
+				},
+			},
+			expectAlias: currentAlias,
+			expectColor: currentColor,
+			expectAddrs: []net.Addr{
+				currentAddr, otherAddr, customPortAddr,
+			},
+		},
+		{
+			name: "replace address",
+			req: &lnrpc.UpdateNodeAnnouncementRequest{
+				AddAddresses:    []string{"10.0.0.2"},
+				RemoveAddresses: []string{"10.0.0.1:9735"},
+			},
+			expectAlias: currentAlias,
+			expectColor: currentColor,
+			expectAddrs: []net.Addr{otherAddr},
+		},
+		{
+			name: "remove address not advertised",
+			req: &lnrpc.UpdateNodeAnnouncementRequest{
+				RemoveAddresses: []string{"10.0.0.2"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid address",
+			req: &lnrpc.UpdateNodeAnnouncementRequest{
+				AddAddresses: []string{"10.0.0.2:notaport"},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			updates, err := nodeAnnUpdates(
+				test.req, []net.Addr{currentAddr},
+				net.ResolveTCPAddr,
+			)
+			if test.expectErr {
+				if err == nil {
+					t.Fatalf("expected request to be rejected")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unable to get updates: %v", err)
+			}
+
+			nodeAnn := &lnwire.NodeAnnouncement{
+				Alias:     currentAlias,
+				RGBColor:  currentColor,
+				Addresses: []net.Addr{currentAddr},
+			}
+			for _, update := range updates {
+				update(nodeAnn)
+			}
+
+			if nodeAnn.Alias != test.expectAlias {
+				t.Fatalf("expected alias %v, got %v",
+					test.expectAlias, nodeAnn.Alias)
+			}
+			if nodeAnn.RGBColor != test.expectColor {
+				t.Fatalf("expected color %v, got %v",
+					test.expectColor, nodeAnn.RGBColor)
+			}
+			if len(nodeAnn.Addresses) != len(test.expectAddrs) {
+				t.Fatalf("expected addresses %v, got %v",
+					test.expectAddrs, nodeAnn.Addresses)
+			}
+			for i, addr := range nodeAnn.Addresses {
+				if addr.String() != test.expectAddrs[i].String() {
+					t.Fatalf("expected addresses %v, got %v",
+						test.expectAddrs, nodeAnn.Addresses)
+				}
+			}
+		})
+	}
+}
+
+// TestGetInfoFeatures asserts that the features reported by GetInfo are the
+// ones we advertise in both our Init messages and our node announcement,
+// named after the local features.
+func TestGetInfoFeatures(t *testing.T) {
+	t.Parallel()
+
+	featureMgr, err := feature.NewManager(feature.Config{})
+	if err != nil {
+		t.Fatalf("unable to create feature manager: %v", err)
+	}
+
+	expected := featureMgr.Get(feature.SetInit).Features()
+	for bit := range featureMgr.Get(feature.SetNodeAnn).Features() {
+		expected[bit] = struct{}{}
+	}
+
+	features := marshalFeatures(advertisedFeatures(featureMgr))
+	if len(features) != len(expected) {
+		t.Fatalf("expected %d features, got %d", len(expected),
+			len(features))
+	}
+	for i, f := range features {
+		bit := lnwire.FeatureBit(f.Bit)
+		if _, ok := expected[bit]; !ok {
+			t.Fatalf("unexpected feature %v", bit)
+		}
+		if i > 0 && features[i-1].Bit >= f.Bit {
+			t.Fatalf("features not sorted: %v before %v",
+				features[i-1].Bit, f.Bit)
+		}
+
+		name, known := lnwire.LocalFeatures[bit]
+		if f.Name != name || f.IsKnown != known {
+			t.Fatalf("expected feature %v to be named %q with "+
+				"known=%v, got %q with known=%v", bit, name,
+				known, f.Name, f.IsKnown)
+		}
+		if f.IsRequired != (bit%2 == 0) {
+			t.Fatalf("expected feature %v to be required=%v",
+				bit, bit%2 == 0)
+		}
+	}
+}
+
+// TestFilterHopHints asserts that the private channels an invoice could be
+// paid through are included as hop hints, while public channels, and private
+// channels that can't receive the payment, aren't.
+func TestFilterHopHints(t *testing.T) {
+	t.Parallel()
+
+	const amt = lnwire.MilliSatoshi(100000)
+
+	newChannel := func(index uint32, public bool,
+		remoteBalance lnwire.MilliSatoshi) *channeldb.OpenChannel {
+
+		priv, err := btcec.NewPrivateKey(btcec.S256())
+		if err != nil {
+			t.Fatalf("unable to generate key: %v", err)
+		}
+
+		channel := &channeldb.OpenChannel{
+			IdentityPub: priv.PubKey(),
+			FundingOutpoint: wire.OutPoint{
+				Index: index,
+			},
+			ShortChannelID: lnwire.NewShortChanIDFromInt(
+				uint64(index),
+			),
+			LocalCommitment: channeldb.ChannelCommitment{
+				RemoteBalance: remoteBalance,
+			},
+		}
+		if public {
+			channel.ChannelFlags |= lnwire.FFAnnounceChannel
+		}
+
+		return channel
+	}
+
+	var (
+		publicChan      = newChannel(1, true, amt*2)
+		privateChan     = newChannel(2, false, amt*2)
+		lowBalanceChan  = newChannel(3, false, amt)
+		inactiveChan    = newChannel(4, false, amt*2)
+		privatePeerChan = newChannel(5, false, amt*2)
+		unknownEdgeChan = newChannel(6, false, amt*2)
+		inactivePoint   = lnwire.NewChanIDFromOutPoint(
+			&inactiveChan.FundingOutpoint,
+		)
+		privatePeer [33]byte
+	)
+	copy(privatePeer[:], privatePeerChan.IdentityPub.SerializeCompressed())
+
+	policy := &channeldb.ChannelEdgePolicy{
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 10,
+		TimeLockDelta:             40,
+	}
+	src := &hopHintSource{
+		isPublicNode: func(pubKey [33]byte) (bool, error) {
+			return pubKey != privatePeer, nil
+		},
+		isEligible: func(chanPoint lnwire.ChannelID) bool {
+			return chanPoint != inactivePoint
+		},
+		remotePolicy: func(channel *channeldb.OpenChannel) (
+			*channeldb.ChannelEdgePolicy, error) {
+
+			if channel == unknownEdgeChan {
+				return nil, nil
+			}
+
+			return policy, nil
+		},
+	}
+
+	// Even though we have a public channel, the private channel the
+	// invoice can be paid through should be included as a hint.
+	hopHints := filterHopHints(amt, []*channeldb.OpenChannel{
+		publicChan, privateChan, lowBalanceChan, inactiveChan,
+		privatePeerChan, unknownEdgeChan,
+	}, src)
+	if len(hopHints) != 1 {
+		t.Fatalf("expected 1 hop hint, got %d", len(hopHints))
+	}
+
+	hint := hopHints[0]
+	if !hint.NodeID.IsEqual(privateChan.IdentityPub) ||
+		hint.ChannelID != privateChan.ShortChannelID.ToUint64() ||
+		hint.FeeBaseMSat != uint32(policy.FeeBaseMSat) ||
+		hint.FeeProportionalMillionths !=
+			uint32(policy.FeeProportionalMillionths) ||
+		hint.CLTVExpiryDelta != policy.TimeLockDelta {
+
+		t.Fatalf("unexpected hop hint: %v", hint)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"image/color"
@@ -27,25 +28,34 @@ import (
 	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
+	"github.com/lightningnetwork/lnd/chainmonitor"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/health"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/pool"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/zpay32"
 )
 
 const (
@@ -58,6 +68,19 @@ const (
 	// durations exceeding this value will be eligible to have their
 	// backoffs reduced.
 	defaultStableConnDuration = 10 * time.Minute
+
+	// defaultAnchorCPFPConfTarget is the confirmation target used to
+	// determine the fee rate to bump a broadcast commitment transaction
+	// to through its anchor output.
+	defaultAnchorCPFPConfTarget = 6
+
+	// maxSubsystemRestarts is the number of times a subsystem is restarted
+	// after panicking, before it's left stopped.
+	maxSubsystemRestarts = 3
+
+	// chainMonitorInterval is the interval at which the chain backends are
+	// polled to determine whether the active one stalled.
+	chainMonitorInterval = time.Minute
 )
 
 var (
@@ -151,6 +174,45 @@ type server struct {
 
 	channelNotifier *channelnotifier.ChannelNotifier
 
+	// chanPredicate decides whether the inbound channel requests should
+	// be accepted, based on the channel acceptors registered over RPC.
+	chanPredicate *chanacceptor.ChainedAcceptor
+
+	chanReopener *chanReopener
+
+	// feeClamps holds the fee estimators of each of the consumers of the
+	// fee estimator, clamping their fee rates within their own bounds.
+	feeClamps *feeClamps
+
+	// featureOverrides holds the feature bits we advertise on top of the
+	// default ones, and the ones we require from our peers.
+	featureOverrides *featureOverrides
+
+	// blockedNodes is the set of nodes we never route payments through,
+	// forward HTLCs to or from, accept gossip from, or connect with unless
+	// we have channels with them. See isBlocked.
+	blockedNodes map[routing.Vertex]struct{}
+
+	// bannedPeers maps the peers we've banned for misbehaving to the time
+	// at which they were banned. We never connect with them until their
+	// ban expires.
+	bannedPeers    map[routing.Vertex]time.Time
+	bannedPeersMtx sync.RWMutex
+
+	// ntfnTracker keeps track of the confirmation and spend registrations
+	// each subsystem makes with the chain notifier, coalescing identical
+	// ones.
+	ntfnTracker *chainntnfs.RegistrationTracker
+
+	// health keeps track of the health of the subsystems that recover
+	// from panics, restarting them where that's safe.
+	health *health.Registry
+
+	// chainMonitor monitors the progress of the chain backend, failing
+	// over to the standby one once it stalls. It's nil if monitoring is
+	// disabled.
+	chainMonitor *chainmonitor.Monitor
+
 	witnessBeacon contractcourt.WitnessBeacon
 
 	breachArbiter *breachArbiter
@@ -175,9 +237,9 @@ type server struct {
 
 	readPool *pool.Read
 
-	// globalFeatures feature vector which affects HTLCs and thus are also
-	// advertised to other nodes.
-	globalFeatures *lnwire.FeatureVector
+	// featureMgr dispatches the feature vectors we advertise within our
+	// init messages and node announcements.
+	featureMgr *feature.Manager
 
 	// currentNodeAnn is the node announcement that has been broadcast to
 	// the network upon startup, if the attributes of the node (us) has
@@ -254,7 +316,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		}
 	}
 
-	globalFeatures := lnwire.NewRawFeatureVector()
+	featureMgr, err := feature.NewManager(feature.Config{
+		NoAnchors: !cfg.ExperimentalProtocol.AnchorCommitments(),
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	var serializedPubKey [33]byte
 	copy(serializedPubKey[:], privKey.PubKey().SerializeCompressed())
@@ -295,6 +362,10 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 
 		channelNotifier: channelnotifier.New(chanDB),
 
+		ntfnTracker: chainntnfs.NewRegistrationTracker(),
+
+		health: health.NewRegistry(maxSubsystemRestarts),
+
 		identityPriv: privKey,
 		nodeSigner:   netann.NewNodeSigner(privKey),
 
@@ -317,9 +388,41 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		peerConnectedListeners:    make(map[string][]chan<- lnpeer.Peer),
 		peerDisconnectedListeners: make(map[string][]chan<- struct{}),
 
-		globalFeatures: lnwire.NewFeatureVector(globalFeatures,
-			lnwire.GlobalFeatures),
-		quit: make(chan struct{}),
+		featureMgr: featureMgr,
+		quit:       make(chan struct{}),
+	}
+
+	s.feeClamps, err = newFeeClamps(cc.feeEstimator, cfg.FeeClamps)
+	if err != nil {
+		return nil, err
+	}
+
+	s.featureOverrides, err = newFeatureOverrides(cfg.Features)
+	if err != nil {
+		return nil, err
+	}
+
+	s.blockedNodes, err = parseBlockedNodes(cfg.BlockedNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	bannedPeers, err := chanDB.FetchBannedPeers()
+	if err != nil {
+		return nil, err
+	}
+	s.bannedPeers = make(map[routing.Vertex]time.Time)
+	for _, peer := range bannedPeers {
+		// Bans that expired while we were down are removed right
+		// away.
+		if banExpired(peer.BannedAt) {
+			if err := chanDB.UnbanPeer(peer.PubKey); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		s.bannedPeers[routing.Vertex(peer.PubKey)] = peer.BannedAt
 	}
 
 	s.witnessBeacon = &preimageBeacon{
@@ -372,13 +475,28 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		SwitchPackager:         channeldb.NewSwitchPackager(),
 		ExtractErrorEncrypter:  s.sphinx.ExtractErrorEncrypter,
 		FetchLastChannelUpdate: s.fetchLastChanUpdate(),
-		Notifier:               s.cc.chainNotifier,
+		Notifier:               s.trackedNotifier("htlcswitch"),
 		FwdEventTicker: ticker.New(
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
 		NotifyActiveChannel:   s.channelNotifier.NotifyActiveChannelEvent,
 		NotifyInactiveChannel: s.channelNotifier.NotifyInactiveChannelEvent,
+		Reputation:            htlcswitch.DefaultReputationConfig(),
+		DustThreshold: lnwire.NewMSatFromSatoshis(
+			btcutil.Amount(cfg.DustThreshold),
+		),
+		HeldHtlcExpiryDelta: htlcswitch.DefaultHeldHtlcExpiryDelta,
+		PreimageCache:       s.witnessBeacon,
+		Throttle: htlcswitch.ThrottleConfig{
+			MaxAddsPerSecond:   cfg.HtlcSwitch.MaxAddsPerSecond,
+			AddBurst:           cfg.HtlcSwitch.AddBurst,
+			MaxPendingForwards: cfg.HtlcSwitch.MaxPendingForwards,
+		},
+		IsBlocked: func(node [33]byte) bool {
+			_, ok := s.blockedNodes[routing.Vertex(node)]
+			return ok
+		},
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -519,7 +637,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		LastUpdate:           time.Now(),
 		Addresses:            selfAddrs,
 		Alias:                nodeAlias.String(),
-		Features:             s.globalFeatures,
+		Features:             s.featureMgr.Get(feature.SetNodeAnn),
 		Color:                color,
 	}
 	copy(selfNode.PubKeyBytes[:], privKey.PubKey().SerializeCompressed())
@@ -561,8 +679,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		Chain:     cc.chainIO,
 		ChainView: cc.chainView,
 		SendToSwitch: func(firstHop lnwire.ShortChannelID,
-			htlcAdd *lnwire.UpdateAddHTLC,
-			circuit *sphinx.Circuit) ([32]byte, error) {
+			htlcAdd *lnwire.UpdateAddHTLC, circuit *sphinx.Circuit,
+			onDispatch func()) ([32]byte, error) {
 
 			// Using the created circuit, initialize the error
 			// decrypter so we can parse+decode any failures
@@ -571,12 +689,29 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
 			}
 
-			return s.htlcSwitch.SendHTLC(
-				firstHop, htlcAdd, errorDecryptor,
+			return s.htlcSwitch.SendHTLCNotify(
+				firstHop, htlcAdd, errorDecryptor, onDispatch,
+			)
+		},
+		GetPaymentResult: func(paymentHash [32]byte,
+			circuit *sphinx.Circuit) (
+			<-chan *htlcswitch.PaymentResult, error) {
+
+			errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
+				OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(circuit),
+			}
+
+			return s.htlcSwitch.GetPaymentResult(
+				paymentHash, errorDecryptor,
 			)
 		},
-		ChannelPruneExpiry: time.Duration(time.Hour * 24 * 14),
-		GraphPruneInterval: time.Duration(time.Hour),
+		CleanPaymentResults: s.htlcSwitch.CleanStore,
+		ChannelPruneExpiry:  time.Duration(time.Hour * 24 * 14),
+		GraphPruneInterval:  time.Duration(time.Hour),
+		StrictZombiePruning: cfg.Routing.StrictZombiePruning,
+		DisableShadowRoute:  cfg.Routing.NoShadowRoute,
+		AddInvoice:          s.addInvoice,
+		BlockedNodes:        s.blockedNodes,
 		QueryBandwidth: func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi {
 			// If we aren't on either side of this edge, then we'll
 			// just thread through the capacity of the edge as we
@@ -607,6 +742,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			return link.Bandwidth()
 		},
 		AssumeChannelValid: cfg.Routing.UseAssumeChannelValid(),
+		MissionControl: routerrpc.GetMissionControlConfig(
+			cfg.SubRPCServers.RouterRPC,
+		),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %v", err)
@@ -625,9 +763,16 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	// If we shouldn't request real-time channel updates from any of our
+	// peers, then all of our gossip syncers will be passive.
+	numActiveSyncers := cfg.NumGraphSyncPeers
+	if cfg.NoChanUpdates {
+		numActiveSyncers = 0
+	}
+
 	s.authGossiper = discovery.New(discovery.Config{
 		Router:            s.chanRouter,
-		Notifier:          s.cc.chainNotifier,
+		Notifier:          s.trackedNotifier("gossiper"),
 		ChainHash:         *activeNetParams.GenesisHash,
 		Broadcast:         s.BroadcastMessage,
 		ChanSeries:        chanSeries,
@@ -639,6 +784,20 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		WaitingProofStore: waitingProofStore,
 		MessageStore:      gossipMessageStore,
 		AnnSigner:         s.nodeSigner,
+		Health:            s.health,
+		BlockedNodes:      s.blockedNodes,
+		NumActiveSyncers:  numActiveSyncers,
+
+		ChannelUpdateInterval: discovery.DefaultChannelUpdateInterval,
+		MaxChannelUpdateBurst: discovery.DefaultMaxChannelUpdateBurst,
+		RebroadcastInterval:   discovery.DefaultRebroadcastInterval,
+
+		MaxPrematureAnnouncements: discovery.DefaultPrematureCacheSize,
+		MaxPrematurePerPeer:       discovery.DefaultPrematurePeerQuota,
+		MaxPrematureHeightDelta:   discovery.DefaultPrematureHeightDelta,
+		MaxInvalidAnnouncements:   cfg.MaxInvalidGossip,
+		InvalidAnnDecayInterval:   discovery.DefaultInvalidAnnDecay,
+		BanPeer:                   s.banPeer,
 	},
 		s.identityPriv.PubKey(),
 	)
@@ -661,7 +820,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	}
 
 	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
-		FeeEstimator: cc.feeEstimator,
+		FeeEstimator: s.feeClamps.sweep,
 		GenSweepScript: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
@@ -670,13 +829,13 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		NewBatchTimer: func() <-chan time.Time {
 			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
 		},
-		SweepTxConfTarget:    6,
-		Notifier:             cc.chainNotifier,
+		Notifier:             s.trackedNotifier("sweeper"),
 		ChainIO:              cc.chainIO,
 		Store:                sweeperStore,
 		MaxInputsPerTx:       sweep.DefaultMaxInputsPerTx,
 		MaxSweepAttempts:     sweep.DefaultMaxSweepAttempts,
 		NextAttemptDeltaFunc: sweep.DefaultNextAttemptDeltaFunc,
+		FeeRateBucketSize:    sweep.DefaultFeeRateBucketSize,
 	})
 
 	s.utxoNursery = newUtxoNursery(&NurseryConfig{
@@ -684,7 +843,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ConfDepth:           1,
 		FetchClosedChannels: chanDB.FetchClosedChannels,
 		FetchClosedChannel:  chanDB.FetchClosedChannel,
-		Notifier:            cc.chainNotifier,
+		Notifier:            s.trackedNotifier("nursery"),
 		PublishTransaction:  cc.wallet.PublishTransaction,
 		Store:               utxnStore,
 		SweepInput:          s.sweeper.SweepInput,
@@ -702,6 +861,18 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	// breach events from the ChannelArbitrator to the breachArbiter,
 	contractBreaches := make(chan *ContractBreachEvent, 1)
 
+	// If requested, we'll detect breaches as soon as they enter the
+	// mempool, which is only possible if our chain backend exposes it.
+	var mempoolNotifier chainntnfs.MempoolWatcher
+	if cfg.WatchMempool {
+		watcher, ok := cc.chainNotifier.(chainntnfs.MempoolWatcher)
+		if !ok {
+			return nil, fmt.Errorf("watchmempool isn't supported " +
+				"by the chain backend")
+		}
+		mempoolNotifier = watcher
+	}
+
 	s.chainArb = contractcourt.NewChainArbitrator(contractcourt.ChainArbitratorConfig{
 		ChainHash: *activeNetParams.GenesisHash,
 		// TODO(roasbeef): properly configure
@@ -710,7 +881,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		NewSweepAddr: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
-		PublishTx: cc.wallet.PublishTransaction,
+		PublishTx:     cc.wallet.PublishTransaction,
+		BumpCommitFee: newCommitFeeBumper(cc).bumpCommitFee,
 		DeliverResolutionMsg: func(msgs ...contractcourt.ResolutionMsg) error {
 			for _, msg := range msgs {
 				err := s.htlcSwitch.ProcessContractResolution(msg)
@@ -743,9 +915,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			)
 		},
 		PreimageDB:   s.witnessBeacon,
-		Notifier:     cc.chainNotifier,
+		Notifier:     s.trackedNotifier("chainarb"),
 		Signer:       cc.wallet.Cfg.Signer,
-		FeeEstimator: cc.feeEstimator,
+		FeeEstimator: s.feeClamps.sweep,
 		ChainIO:      cc.chainIO,
 		MarkLinkInactive: func(chanPoint wire.OutPoint) error {
 			chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
@@ -776,24 +948,36 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 				return ErrServerShuttingDown
 			}
 		},
-		DisableChannel:      s.chanStatusMgr.RequestDisable,
+		DisableChannel: func(op wire.OutPoint) error {
+			return s.chanStatusMgr.RequestDisable(op, false)
+		},
 		Sweeper:             s.sweeper,
 		SettleInvoice:       s.invoices.SettleInvoice,
 		NotifyClosedChannel: s.channelNotifier.NotifyClosedChannelEvent,
+		NotifyRemoteClose:   s.channelNotifier.NotifyRemoteCloseEvent,
+		MempoolNotifier:     mempoolNotifier,
+		StageBreach: func(chanPoint wire.OutPoint,
+			breachRet *lnwallet.BreachRetribution) error {
+
+			return s.breachArbiter.StageBreach(chanPoint, breachRet)
+		},
+		NotifyPendingClose: s.channelNotifier.
+			NotifyPendingCloseChannelEvent,
 	}, chanDB)
 
 	s.breachArbiter = newBreachArbiter(&BreachConfig{
 		CloseLink: closeLink,
 		DB:        chanDB,
-		Estimator: s.cc.feeEstimator,
+		Estimator: s.feeClamps.justice,
 		GenSweepScript: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
-		Notifier:           cc.chainNotifier,
+		Notifier:           s.trackedNotifier("breacharbiter"),
 		PublishTransaction: cc.wallet.PublishTransaction,
 		ContractBreaches:   contractBreaches,
 		Signer:             cc.wallet.Cfg.Signer,
 		Store:              newRetributionStore(chanDB),
+		Health:             s.health,
 	})
 
 	// Select the configuration and furnding parameters for Bitcoin or
@@ -808,6 +992,20 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		maxRemoteDelay = maxLtcRemoteDelay
 	}
 
+	// The channel policy dictates the reserve we require from the remote
+	// party, as well as the bounds on the constraints it may require from
+	// us.
+	chanReservePercent := btcutil.Amount(cfg.ChanPolicy.ReservePercent)
+	constraintsPolicy := &lnwallet.ConstraintsPolicy{
+		MaxCsvDelay:           cfg.ChanPolicy.MaxCsvDelay,
+		MaxChanReservePercent: cfg.ChanPolicy.MaxReservePercent,
+		MinMaxInFlightPercent: cfg.ChanPolicy.MinMaxInFlightPercent,
+	}
+
+	// The channel predicate starts out without any acceptor, accepting
+	// every channel request, until applications register their own.
+	s.chanPredicate = chanacceptor.NewChainedAcceptor()
+
 	var chanIDSeed [32]byte
 	if _, err := rand.Read(chanIDSeed[:]); err != nil {
 		return nil, err
@@ -816,8 +1014,8 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		IDKey:              privKey.PubKey(),
 		Wallet:             cc.wallet,
 		PublishTransaction: cc.wallet.PublishTransaction,
-		Notifier:           cc.chainNotifier,
-		FeeEstimator:       cc.feeEstimator,
+		Notifier:           s.trackedNotifier("fundingmgr"),
+		FeeEstimator:       s.feeClamps.commit,
 		SignMessage: func(pubKey *btcec.PublicKey,
 			msg []byte) (*btcec.Signature, error) {
 
@@ -941,12 +1139,13 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		RequiredRemoteChanReserve: func(chanAmt,
 			dustLimit btcutil.Amount) btcutil.Amount {
 
-			// By default, we'll require the remote peer to maintain
-			// at least 1% of the total channel capacity at all
-			// times. If this value ends up dipping below the dust
-			// limit, then we'll use the dust limit itself as the
-			// reserve as required by BOLT #2.
-			reserve := chanAmt / 100
+			// We'll require the remote peer to maintain at least
+			// the configured percentage of the total channel
+			// capacity at all times, which defaults to 1%. If this
+			// value ends up dipping below the dust limit, then
+			// we'll use the dust limit itself as the reserve as
+			// required by BOLT #2.
+			reserve := chanAmt * chanReservePercent / 100
 			if reserve < dustLimit {
 				reserve = dustLimit
 			}
@@ -957,7 +1156,9 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			// By default, we'll allow the remote peer to fully
 			// utilize the full bandwidth of the channel, minus our
 			// required reserve.
-			reserve := lnwire.NewMSatFromSatoshis(chanAmt / 100)
+			reserve := lnwire.NewMSatFromSatoshis(
+				chanAmt * chanReservePercent / 100,
+			)
 			return lnwire.NewMSatFromSatoshis(chanAmt) - reserve
 		},
 		RequiredRemoteMaxHTLCs: func(chanAmt btcutil.Amount) uint16 {
@@ -968,12 +1169,57 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ZombieSweeperInterval:  1 * time.Minute,
 		ReservationTimeout:     10 * time.Minute,
 		MinChanSize:            btcutil.Amount(cfg.MinChanSize),
+		ConstraintsPolicy:      constraintsPolicy,
 		NotifyOpenChannelEvent: s.channelNotifier.NotifyOpenChannelEvent,
+		EnableUpfrontShutdown:  cfg.EnableUpfrontShutdown,
+		RequiredFeatures:       s.featureOverrides.require,
+		GenUpfrontShutdownScript: func() (lnwire.DeliveryAddress,
+			error) {
+
+			return newSweepPkScript(cc.wallet)
+		},
+		OpenChannelPredicate: s.chanPredicate,
+		NotifyPendingOpenChannelEvent: s.channelNotifier.
+			NotifyPendingOpenChannelEvent,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	s.chanReopener = newChanReopener(chanReopenerConfig{
+		ChainHash:              *activeNetParams.GenesisHash,
+		SubscribeChannelEvents: s.channelNotifier.SubscribeChannelEvents,
+		NotifyWhenOnline:       s.NotifyWhenOnline,
+		OpenChannel:            s.OpenChannel,
+		FeeEstimator:           s.feeClamps.funding,
+	})
+
+	// If enabled, we'll monitor the progress of our chain backend. If it
+	// stalls while the standby backend makes progress, we'll shut down,
+	// such that we fail over to the standby once we're restarted, as the
+	// chain backend can't be swapped out while we're running.
+	if cfg.ChainStallTimeout > 0 {
+		bestHeight := func() (int32, error) {
+			_, height, err := cc.chainIO.GetBestBlock()
+			return height, err
+		}
+
+		s.chainMonitor = chainmonitor.New(&chainmonitor.Config{
+			Active: chainmonitor.Backend{
+				Name:       cc.backendName,
+				BestHeight: bestHeight,
+			},
+			Fallback:     cc.standbyBackend,
+			StallTimeout: cfg.ChainStallTimeout,
+			Ticker:       ticker.New(chainMonitorInterval),
+			RequestShutdown: func() {
+				srvrLog.Warnf("Shutting down to fail over to " +
+					"the standby chain backend")
+				signal.RequestShutdown()
+			},
+		})
+	}
+
 	// Create the connection manager which will be responsible for
 	// maintaining persistent outbound connections and also accepting new
 	// incoming connections
@@ -993,6 +1239,12 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	return s, nil
 }
 
+// trackedNotifier returns the chain notifier to be used by the given
+// subsystem, whose registrations are tracked under its name.
+func (s *server) trackedNotifier(subsystem string) chainntnfs.ChainNotifier {
+	return s.ntfnTracker.Track(subsystem, s.cc.chainNotifier)
+}
+
 // Started returns true if the server has been started, and false otherwise.
 // NOTE: This function is safe for concurrent access.
 func (s *server) Started() bool {
@@ -1039,6 +1291,11 @@ func (s *server) Start() error {
 	if err := s.channelNotifier.Start(); err != nil {
 		return err
 	}
+	if s.chainMonitor != nil {
+		if err := s.chainMonitor.Start(); err != nil {
+			return err
+		}
+	}
 	if err := s.sphinx.Start(); err != nil {
 		return err
 	}
@@ -1066,6 +1323,9 @@ func (s *server) Start() error {
 	if err := s.fundingMgr.Start(); err != nil {
 		return err
 	}
+	if err := s.chanReopener.Start(); err != nil {
+		return err
+	}
 	s.connMgr.Start()
 
 	if err := s.invoices.Start(); err != nil {
@@ -1135,11 +1395,15 @@ func (s *server) Stop() error {
 	s.chainArb.Stop()
 	s.sweeper.Stop()
 	s.channelNotifier.Stop()
+	if s.chainMonitor != nil {
+		s.chainMonitor.Stop()
+	}
 	s.cc.wallet.Shutdown()
 	s.cc.chainView.Stop()
 	s.connMgr.Stop()
 	s.cc.feeEstimator.Stop()
 	s.invoices.Stop()
+	s.chanReopener.Stop()
 	s.fundingMgr.Stop()
 
 	// Disconnect from each active peers to ensure that
@@ -1631,16 +1895,22 @@ func (s *server) initTorController() error {
 
 	// Finally, we'll update the on-disk version of our announcement so it
 	// will eventually propagate to nodes in the network.
+	return s.setSelfNode(&newNodeAnn)
+}
+
+// setSelfNode updates the on-disk version of our node to match the given
+// node announcement.
+func (s *server) setSelfNode(nodeAnn *lnwire.NodeAnnouncement) error {
 	selfNode := &channeldb.LightningNode{
 		HaveNodeAnnouncement: true,
-		LastUpdate:           time.Unix(int64(newNodeAnn.Timestamp), 0),
-		Addresses:            newNodeAnn.Addresses,
-		Alias:                newNodeAnn.Alias.String(),
+		LastUpdate:           time.Unix(int64(nodeAnn.Timestamp), 0),
+		Addresses:            nodeAnn.Addresses,
+		Alias:                nodeAnn.Alias.String(),
 		Features: lnwire.NewFeatureVector(
-			newNodeAnn.Features, lnwire.GlobalFeatures,
+			nodeAnn.Features, lnwire.GlobalFeatures,
 		),
-		Color:        newNodeAnn.RGBColor,
-		AuthSigBytes: newNodeAnn.Signature.ToSignatureBytes(),
+		Color:        nodeAnn.RGBColor,
+		AuthSigBytes: nodeAnn.Signature.ToSignatureBytes(),
 	}
 	copy(selfNode.PubKeyBytes[:], s.identityPriv.PubKey().SerializeCompressed())
 	if err := s.chanDB.ChannelGraph().SetSourceNode(selfNode); err != nil {
@@ -1650,6 +1920,30 @@ func (s *server) initTorController() error {
 	return nil
 }
 
+// updateAndBroadcastNodeAnn applies the given updates to our node
+// announcement, then signs it, stores it on disk and broadcasts it to all of
+// our peers so that it propagates throughout the network.
+func (s *server) updateAndBroadcastNodeAnn(
+	updates ...func(*lnwire.NodeAnnouncement)) error {
+
+	newNodeAnn, err := s.genNodeAnnouncement(true, updates...)
+	if err != nil {
+		return fmt.Errorf("unable to generate new node announcement: "+
+			"%v", err)
+	}
+
+	if err := s.setSelfNode(&newNodeAnn); err != nil {
+		return err
+	}
+
+	if err := s.BroadcastMessage(nil, &newNodeAnn); err != nil {
+		return fmt.Errorf("unable to broadcast new node announcement: "+
+			"%v", err)
+	}
+
+	return nil
+}
+
 // genNodeAnnouncement generates and returns the current fully signed node
 // announcement. If refresh is true, then the time stamp of the announcement
 // will be updated in order to ensure it propagates through the network.
@@ -2080,7 +2374,13 @@ func (s *server) sendPeerMessages(
 			errChans = append(errChans, errChan)
 		}
 
-		targetPeer.queueMsg(msg, errChan)
+		// Broadcasts consist of gossip messages, which shouldn't
+		// delay the channel updates queued for the peer.
+		if isBroadcast {
+			targetPeer.queueMsgLazy(msg, errChan)
+		} else {
+			targetPeer.queueMsg(msg, errChan)
+		}
 	}
 
 	return errChans
@@ -2198,6 +2498,15 @@ func (s *server) InboundPeerConnected(conn net.Conn) {
 	nodePub := conn.(*brontide.Conn).RemotePub()
 	pubStr := string(nodePub.SerializeCompressed())
 
+	// Connections from blocked nodes are refused.
+	if s.isBlocked(nodePub) {
+		srvrLog.Debugf("Refusing inbound connection from blocked "+
+			"node %x", nodePub.SerializeCompressed())
+
+		conn.Close()
+		return
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -2281,6 +2590,18 @@ func (s *server) OutboundPeerConnected(connReq *connmgr.ConnReq, conn net.Conn)
 	nodePub := conn.(*brontide.Conn).RemotePub()
 	pubStr := string(nodePub.SerializeCompressed())
 
+	// Connections to blocked nodes are refused as well.
+	if s.isBlocked(nodePub) {
+		srvrLog.Debugf("Refusing outbound connection to blocked "+
+			"node %x", nodePub.SerializeCompressed())
+
+		if connReq != nil {
+			s.connMgr.Remove(connReq.ID())
+		}
+		conn.Close()
+		return
+	}
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -2426,6 +2747,22 @@ func (s *server) cancelConnReqs(pubStr string, skip *uint64) {
 	delete(s.persistentConnReqs, pubStr)
 }
 
+// newLocalFeatureVector returns the local feature vector we advertise to the
+// remote node with the given public key within our init message.
+func (s *server) newLocalFeatureVector(
+	pubKey *btcec.PublicKey) *lnwire.RawFeatureVector {
+
+	// We'll start out with the features we advertise to all peers in our
+	// init messages.
+	localFeatures := s.featureMgr.GetRaw(feature.SetInit)
+
+	// Then, we'll add any experimental feature bits we've been
+	// configured to advertise to this peer.
+	s.featureOverrides.apply(pubKey, localFeatures)
+
+	return localFeatures
+}
+
 // peerConnected is a function that handles initialization a newly connected
 // peer by adding it to the server's global list of all active peers, and
 // starting all the goroutines the peer needs to function properly. The inbound
@@ -2448,12 +2785,7 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 
 	// With the brontide connection established, we'll now craft the local
 	// feature vector to advertise to the remote node.
-	localFeatures := lnwire.NewRawFeatureVector()
-
-	// We'll signal that we understand the data loss protection feature,
-	// and also that we support the new gossip query features.
-	localFeatures.Set(lnwire.DataLossProtectRequired)
-	localFeatures.Set(lnwire.GossipQueriesOptional)
+	localFeatures := s.newLocalFeatureVector(pubKey)
 
 	// Now that we've established a connection, create a peer, and it to
 	// the set of currently active peers.
@@ -2771,7 +3103,7 @@ type openChanReq struct {
 
 	pushAmt lnwire.MilliSatoshi
 
-	fundingFeePerKw lnwallet.SatPerKWeight
+	fundingFeePerKw chainfee.SatPerKWeight
 
 	private bool
 
@@ -2783,6 +3115,15 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// shutdownScript is an optional upfront shutdown script for the channel.
+	// This value is optional, so may be nil.
+	shutdownScript lnwire.DeliveryAddress
+
+	// pendingChanID is an optional pending channel ID chosen by the
+	// caller, which can be used to cancel the funding flow before the
+	// funding transaction is broadcast. If unset, a random one is used.
+	pendingChanID [32]byte
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	updates chan *lnrpc.OpenStatusUpdate
@@ -2796,6 +3137,11 @@ type openChanReq struct {
 // NOTE: This function is safe for concurrent access.
 func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
 
+	if s.isBlocked(addr.IdentityKey) {
+		return fmt.Errorf("peer %x is blocked",
+			addr.IdentityKey.SerializeCompressed())
+	}
+
 	targetPub := string(addr.IdentityKey.SerializeCompressed())
 
 	// Acquire mutex, but use explicit unlocking instead of defer for
@@ -2879,6 +3225,145 @@ func (s *server) connectToPeer(addr *lnwire.NetAddress, errChan chan<- error) {
 	s.OutboundPeerConnected(nil, conn)
 }
 
+// connectivityReport describes the outcome of a connectivity check carried out
+// towards a remote peer, along with the time each of its stages took.
+type connectivityReport struct {
+	// handshakeErr is set if either the TCP connection or the brontide
+	// handshake with the remote peer failed.
+	handshakeErr *brontide.HandshakeError
+
+	// initErr is set if the handshake succeeded, but the remote peer
+	// failed to respond with an acceptable init message.
+	initErr error
+
+	// tcpTime is the time it took to establish the TCP connection.
+	tcpTime time.Duration
+
+	// handshakeTime is the time it took to carry out the brontide
+	// handshake once the TCP connection was established.
+	handshakeTime time.Duration
+
+	// initTime is the time it took to exchange init messages once the
+	// handshake completed.
+	initTime time.Duration
+}
+
+// CheckPeerConnectivity attempts to establish a connection with the remote
+// peer at the passed address, carry out the brontide handshake and exchange
+// init messages, without establishing a full peer connection. Once done, the
+// connection is closed again. The returned report details at which stage the
+// attempt failed, if it did, which is useful to diagnose why we're unable to
+// connect to a peer.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) CheckPeerConnectivity(
+	addr *lnwire.NetAddress) (*connectivityReport, error) {
+
+	// As the remote peer will consider us connected once the init messages
+	// have been exchanged, it may tear down an existing connection with
+	// us, so we'll refuse to check peers we're already connected to.
+	if _, err := s.FindPeer(addr.IdentityKey); err == nil {
+		return nil, fmt.Errorf("already connected to peer %x",
+			addr.IdentityKey.SerializeCompressed())
+	}
+
+	report := &connectivityReport{}
+
+	// We'll wrap our dialer in order to be able to tell how long it took to
+	// establish the TCP connection, apart from the handshake.
+	start := time.Now()
+	tcpDone := start
+	dialer := func(network, address string) (net.Conn, error) {
+		conn, err := cfg.net.Dial(network, address)
+		tcpDone = time.Now()
+		return conn, err
+	}
+
+	conn, err := brontide.Dial(s.identityPriv, addr, dialer)
+	report.tcpTime = tcpDone.Sub(start)
+	if err != nil {
+		hsErr, ok := err.(*brontide.HandshakeError)
+		if !ok {
+			return nil, err
+		}
+
+		report.handshakeErr = hsErr
+		if hsErr.Stage != brontide.HandshakeStageTCP {
+			report.handshakeTime = time.Since(tcpDone)
+		}
+
+		return report, nil
+	}
+	defer conn.Close()
+
+	handshakeDone := time.Now()
+	report.handshakeTime = handshakeDone.Sub(tcpDone)
+
+	report.initErr = exchangeInitMsgs(
+		conn, s.featureMgr.GetRaw(feature.SetLegacyGlobal),
+		s.newLocalFeatureVector(addr.IdentityKey),
+	)
+	report.initTime = time.Since(handshakeDone)
+
+	return report, nil
+}
+
+// exchangeInitMsgs sends an init message carrying the passed features over the
+// given connection, and awaits the init message of the remote peer. An error
+// is returned if the remote peer fails to respond in time, or requires
+// features we don't understand.
+func exchangeInitMsgs(conn *brontide.Conn, globalFeatures,
+	localFeatures *lnwire.RawFeatureVector) error {
+
+	err := conn.SetDeadline(time.Now().Add(handshakeTimeout))
+	if err != nil {
+		return err
+	}
+
+	var b bytes.Buffer
+	msg := lnwire.NewInitMessage(globalFeatures, localFeatures)
+	if _, err := lnwire.WriteMessage(&b, msg, 0); err != nil {
+		return err
+	}
+	if _, err := conn.Write(b.Bytes()); err != nil {
+		return fmt.Errorf("unable to send init msg: %v", err)
+	}
+
+	rawMsg, err := conn.ReadNextMessage()
+	if err != nil {
+		return fmt.Errorf("unable to read init msg: %v", err)
+	}
+	remoteMsg, err := lnwire.ReadMessage(bytes.NewReader(rawMsg), 0)
+	if err != nil {
+		return fmt.Errorf("unable to parse init msg: %v", err)
+	}
+
+	remoteInit, ok := remoteMsg.(*lnwire.Init)
+	if !ok {
+		return fmt.Errorf("very first message between nodes must be "+
+			"init message, got %v", remoteMsg.MsgType())
+	}
+
+	// Finally, we'll make sure the remote peer doesn't require any features
+	// we don't know of, as we'd be unable to establish a connection with
+	// it otherwise.
+	remoteLocalFeatures := lnwire.NewFeatureVector(
+		remoteInit.LocalFeatures, lnwire.LocalFeatures,
+	)
+	if err := feature.ValidateRequired(remoteLocalFeatures); err != nil {
+		return fmt.Errorf("invalid local features: %v", err)
+	}
+
+	remoteGlobalFeatures := lnwire.NewFeatureVector(
+		remoteInit.GlobalFeatures, lnwire.GlobalFeatures,
+	)
+	if err := feature.ValidateRequired(remoteGlobalFeatures); err != nil {
+		return fmt.Errorf("invalid global features: %v", err)
+	}
+
+	return nil
+}
+
 // DisconnectPeer sends the request to server to close the connection with peer
 // identified by public key.
 //
@@ -2946,7 +3431,7 @@ func (s *server) OpenChannel(
 	// If the fee rate wasn't specified, then we'll use a default
 	// confirmation target.
 	if req.fundingFeePerKw == 0 {
-		estimator := s.cc.feeEstimator
+		estimator := s.feeClamps.funding
 		feeRate, err := estimator.EstimateFeePerKW(6)
 		if err != nil {
 			req.err <- err
@@ -3041,6 +3526,103 @@ func (s *server) fetchNodeAdvertisedAddr(pub *btcec.PublicKey) (net.Addr, error)
 	return node.Addresses[0], nil
 }
 
+// isBlocked returns true if the given node is one we never connect with,
+// either because it's blocked through our configuration, or because we've
+// banned it for misbehaving.
+//
+// NOTE: As an exception, nodes blocked through our configuration that we have
+// channels with remain connected, such that those channels can still be
+// updated and closed. Only channel-level messages are serviced over such a
+// connection: the gossiper ignores all gossip from blocked nodes, and the
+// switch refuses to forward HTLCs to or from them.
+func (s *server) isBlocked(pubKey *btcec.PublicKey) bool {
+	node := routing.NewVertex(pubKey)
+	if _, ok := s.blockedNodes[node]; ok {
+		channels, err := s.chanDB.FetchOpenChannels(pubKey)
+		if err != nil {
+			srvrLog.Errorf("Unable to fetch channels with blocked "+
+				"node %x: %v", node[:], err)
+			return true
+		}
+
+		return len(channels) == 0
+	}
+
+	s.bannedPeersMtx.RLock()
+	defer s.bannedPeersMtx.RUnlock()
+
+	bannedAt, ok := s.bannedPeers[node]
+	return ok && !banExpired(bannedAt)
+}
+
+// banExpired returns true if the ban of a peer banned at the given time has
+// expired.
+func banExpired(bannedAt time.Time) bool {
+	return cfg.GossipBanDuration != 0 &&
+		time.Since(bannedAt) >= cfg.GossipBanDuration
+}
+
+// banPeer bans the given peer for misbehaving. The ban is persisted, the peer
+// is disconnected if we're connected to it, and any further connections with
+// it are refused until the ban expires. Peers we have channels with are never
+// banned, as disconnecting them would prevent us from updating our channels.
+func (s *server) banPeer(pubKey [33]byte, reason string) error {
+	peerKey, err := btcec.ParsePubKey(pubKey[:], btcec.S256())
+	if err != nil {
+		return err
+	}
+
+	channels, err := s.chanDB.FetchOpenChannels(peerKey)
+	if err != nil {
+		return err
+	}
+	if len(channels) != 0 {
+		srvrLog.Warnf("Not banning peer %x we have %v channels with: "+
+			"%v", pubKey[:], len(channels), reason)
+		return nil
+	}
+
+	srvrLog.Warnf("Banning peer %x: %v", pubKey[:], reason)
+
+	bannedAt := time.Now()
+	err = s.chanDB.BanPeer(&channeldb.BannedPeer{
+		PubKey:   pubKey,
+		BannedAt: bannedAt,
+		Reason:   reason,
+	})
+	if err != nil {
+		return err
+	}
+
+	s.bannedPeersMtx.Lock()
+	s.bannedPeers[routing.Vertex(pubKey)] = bannedAt
+	s.bannedPeersMtx.Unlock()
+
+	// The peer may have disconnected in the meantime, in which case
+	// there's nothing left to do.
+	if _, err := s.FindPeer(peerKey); err == ErrPeerNotConnected {
+		return nil
+	}
+
+	return s.DisconnectPeer(peerKey)
+}
+
+// unbanPeer removes the ban of the given peer, allowing us to connect with it
+// again.
+func (s *server) unbanPeer(pubKey [33]byte) error {
+	if err := s.chanDB.UnbanPeer(pubKey); err != nil {
+		return err
+	}
+
+	s.bannedPeersMtx.Lock()
+	delete(s.bannedPeers, routing.Vertex(pubKey))
+	s.bannedPeersMtx.Unlock()
+
+	srvrLog.Infof("Unbanned peer %x", pubKey[:])
+
+	return nil
+}
+
 // fetchLastChanUpdate returns a function which is able to retrieve our latest
 // channel update for a target channel.
 func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
@@ -3071,3 +3653,162 @@ func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
 		return ErrServerShuttingDown
 	}
 }
+
+// addInvoice adds an invoice for the given amount and final CLTV delta to our
+// invoice registry, and returns its payment hash. It allows the router to pay
+// ourselves when rebalancing our channels.
+func (s *server) addInvoice(amt lnwire.MilliSatoshi, finalCltvDelta uint16,
+	memo string) ([32]byte, error) {
+
+	var paymentPreimage [32]byte
+	if _, err := rand.Read(paymentPreimage[:]); err != nil {
+		return [32]byte{}, err
+	}
+	rHash := sha256.Sum256(paymentPreimage[:])
+
+	creationDate := time.Now()
+	payReq, err := zpay32.NewInvoice(
+		activeNetParams.Params, rHash, creationDate,
+		zpay32.Amount(amt), zpay32.Description(memo),
+		zpay32.CLTVExpiry(uint64(finalCltvDelta)),
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	payReqString, err := payReq.Encode(
+		zpay32.MessageSigner{
+			SignCompact: s.nodeSigner.SignDigestCompact,
+		},
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	invoice := &channeldb.Invoice{
+		CreationDate:   creationDate,
+		Memo:           []byte(memo),
+		PaymentRequest: []byte(payReqString),
+		Terms: channeldb.ContractTerm{
+			Value:           amt,
+			PaymentPreimage: paymentPreimage,
+		},
+	}
+	if _, err := s.invoices.AddInvoice(invoice, rHash); err != nil {
+		return [32]byte{}, err
+	}
+
+	return rHash, nil
+}
+
+// anchorCPFP describes the CPFP transaction we've last broadcast to bump the
+// fee of a commitment transaction through its anchor output.
+type anchorCPFP struct {
+	// feeRate is the fee rate the package was bumped to.
+	feeRate chainfee.SatPerKWeight
+
+	// cancel unlocks the wallet outputs spent by the CPFP transaction.
+	cancel func()
+}
+
+// commitFeeBumper bumps the fee of broadcast commitment transactions through
+// their anchor outputs. As the fee rate needed to confirm a commitment may
+// rise while it's unconfirmed, a commitment may be bumped repeatedly, in
+// which case each CPFP transaction replaces the previous one.
+type commitFeeBumper struct {
+	cc *chainControl
+
+	// cpfps maps the anchor outputs we've bumped the fee of a commitment
+	// through to the CPFP transaction we last broadcast.
+	cpfps map[wire.OutPoint]*anchorCPFP
+	mu    sync.Mutex
+}
+
+// newCommitFeeBumper creates a new commitFeeBumper backed by the given chain
+// control.
+func newCommitFeeBumper(cc *chainControl) *commitFeeBumper {
+	return &commitFeeBumper{
+		cc:    cc,
+		cpfps: make(map[wire.OutPoint]*anchorCPFP),
+	}
+}
+
+// bumpCommitFee attempts to bump the fee of a broadcast commitment
+// transaction through the given anchor output. The child transaction spends
+// the anchor along with wallet funds, and pays for the fee of the package at
+// the fee rate determined by our default confirmation target. If the
+// commitment, or the child we've previously broadcast for it, already pays
+// this fee rate, then nothing is done.
+func (b *commitFeeBumper) bumpCommitFee(
+	anchor *lnwallet.AnchorResolution) error {
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	cc := b.cc
+	feePerKw, err := sweep.DetermineFeePerKw(
+		cc.feeEstimator, sweep.FeePreference{
+			ConfTarget: defaultAnchorCPFPConfTarget,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	prevCPFP, ok := b.cpfps[anchor.CommitAnchor]
+	if ok && prevCPFP.feeRate >= feePerKw {
+		srvrLog.Debugf("Commitment %v already bumped to %v sat/kw, "+
+			"not bumping fee", anchor.CommitAnchor.Hash,
+			int64(prevCPFP.feeRate))
+		return nil
+	}
+
+	_, bestHeight, err := cc.chainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+
+	deliveryPkScript, err := newSweepPkScript(cc.wallet)
+	if err != nil {
+		return err
+	}
+
+	// As the outputs spent by a previous child are still locked, they
+	// won't be selected again, so the new child only conflicts with the
+	// previous one through the anchor output.
+	cpfpPkg, err := sweep.CraftAnchorCPFPTx(
+		anchor, feePerKw, uint32(bestHeight), deliveryPkScript,
+		cc.wallet, cc.wallet.WalletController,
+		cc.wallet.WalletController, cc.signer,
+	)
+	switch {
+	case err == sweep.ErrCPFPNotNeeded:
+		srvrLog.Debugf("Commitment %v already pays %v sat/kw, not "+
+			"bumping fee", anchor.CommitAnchor.Hash, int64(feePerKw))
+		return nil
+
+	case err != nil:
+		return err
+	}
+
+	srvrLog.Infof("Bumping fee of commitment %v using anchor %v, "+
+		"cpfp_txid=%v", anchor.CommitAnchor.Hash, anchor.CommitAnchor,
+		cpfpPkg.CPFPTx.TxHash())
+
+	if err := cc.wallet.PublishTransaction(cpfpPkg.CPFPTx); err != nil {
+		cpfpPkg.CancelCPFPAttempt()
+		return err
+	}
+
+	// The new child replaced the previous one, so the outputs spent by
+	// the latter are available again.
+	if ok {
+		prevCPFP.cancel()
+	}
+	b.cpfps[anchor.CommitAnchor] = &anchorCPFP{
+		feeRate: feePerKw,
+		cancel:  cpfpPkg.CancelCPFPAttempt,
+	}
+
+	return nil
+}
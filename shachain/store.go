@@ -28,6 +28,19 @@ type Store interface {
 	// order they're produced by a shachain.Producer.
 	AddNextEntry(*chainhash.Hash) error
 
+	// AddEntry attempts to store the given hash as the secret with the
+	// given index, failing if it isn't the index of the next secret
+	// expected by the store.
+	AddEntry(uint64, *chainhash.Hash) error
+
+	// NextIndex returns the index of the next secret expected by the store,
+	// which is also the number of secrets stored so far.
+	NextIndex() uint64
+
+	// Verify checks the consistency of the stored secrets, ensuring all
+	// the secrets received so far can be derived from them.
+	Verify() error
+
 	// Encode writes a binary serialization of the shachain elements
 	// currently saved by implementation of shachain.Store to the passed
 	// io.Writer.
@@ -156,6 +169,79 @@ func (store *RevocationStore) AddNextEntry(hash *chainhash.Hash) error {
 	return nil
 }
 
+// AddEntry attempts to store the given hash as the secret with the given
+// index. Unlike AddNextEntry, which trusts the hash to be the next one in the
+// chain, the index claimed for the hash is checked against the index of the
+// next secret expected by the store.
+//
+// NOTE: This function is part of the Store interface.
+func (store *RevocationStore) AddEntry(v uint64, hash *chainhash.Hash) error {
+	if v != store.NextIndex() {
+		return errors.Errorf("unexpected secret #%v, expected #%v", v,
+			store.NextIndex())
+	}
+
+	return store.AddNextEntry(hash)
+}
+
+// NextIndex returns the index of the next secret expected by the store, which
+// is also the number of secrets stored so far.
+//
+// NOTE: This function is part of the Store interface.
+func (store *RevocationStore) NextIndex() uint64 {
+	return uint64(startIndex - store.index)
+}
+
+// Verify checks the consistency of the stored elements. Each bucket must hold
+// an element with as many trailing zeros as the bucket number, which was
+// received before the next expected index, and which matches the elements of
+// the higher buckets it can be derived from. As all the secrets received so
+// far are derived from these elements, this ensures a corrupted store is
+// detected before any of them is needed.
+//
+// NOTE: This function is part of the Store interface.
+func (store *RevocationStore) Verify() error {
+	if store.index > startIndex {
+		return errors.Errorf("invalid next index %v",
+			uint64(store.index))
+	}
+	if store.lenBuckets > maxHeight {
+		return errors.Errorf("invalid number of buckets %v",
+			store.lenBuckets)
+	}
+
+	for i := uint8(0); i < store.lenBuckets; i++ {
+		e := &store.buckets[i]
+
+		if countTrailingZeros(e.index) != i {
+			return errors.Errorf("bucket %v holds element with "+
+				"invalid index %v", i, uint64(e.index))
+		}
+		if e.index <= store.index {
+			return errors.Errorf("bucket %v holds element with "+
+				"index %v which wasn't received yet", i,
+				uint64(e.index))
+		}
+
+		// The elements of the lower buckets that were received before
+		// this one must be derivable from it, otherwise their secrets
+		// were replaced by a different chain.
+		for j := uint8(0); j < i; j++ {
+			derived, err := e.derive(store.buckets[j].index)
+			if err != nil {
+				continue
+			}
+
+			if !derived.isEqual(&store.buckets[j]) {
+				return errors.Errorf("element of bucket %v "+
+					"isn't derivable from bucket %v", j, i)
+			}
+		}
+	}
+
+	return nil
+}
+
 // Encode writes a binary serialization of the shachain elements currently
 // saved by implementation of shachain.Store to the passed io.Writer.
 //
@@ -471,3 +471,111 @@ func TestShaChainStore(t *testing.T) {
 		}
 	}
 }
+
+// TestShaChainStoreAddEntry checks that the shachain store only accepts the
+// secrets claimed for the index of the next secret it expects.
+func TestShaChainStoreAddEntry(t *testing.T) {
+	t.Parallel()
+
+	seed := chainhash.DoubleHashH([]byte("shachaintest"))
+
+	sender := NewRevocationProducer(seed)
+	receiver := NewRevocationStore()
+
+	for n := uint64(0); n < 100; n++ {
+		sha, err := sender.AtIndex(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := receiver.AddEntry(n+1, sha); err == nil {
+			t.Fatalf("secret #%v accepted as #%v", n, n+1)
+		}
+		if err := receiver.AddEntry(n, sha); err != nil {
+			t.Fatal(err)
+		}
+
+		if receiver.NextIndex() != n+1 {
+			t.Fatalf("expected next index %v, got %v", n+1,
+				receiver.NextIndex())
+		}
+	}
+}
+
+// TestShaChainStoreVerify checks that the verification of the shachain store
+// detects corrupted elements.
+func TestShaChainStoreVerify(t *testing.T) {
+	t.Parallel()
+
+	seed := chainhash.DoubleHashH([]byte("shachaintest"))
+
+	sender := NewRevocationProducer(seed)
+	receiver := NewRevocationStore()
+
+	// We'll add 512 secrets, such that the last one is stored in the
+	// highest bucket and derives the elements of all the lower buckets.
+	for n := uint64(0); n < 512; n++ {
+		sha, err := sender.AtIndex(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err = receiver.AddNextEntry(sha); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var b bytes.Buffer
+	if err := receiver.Encode(&b); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		name    string
+		corrupt func(*RevocationStore)
+		valid   bool
+	}{
+		{
+			name:    "valid store",
+			corrupt: func(*RevocationStore) {},
+			valid:   true,
+		},
+		{
+			name: "corrupted secret",
+			corrupt: func(s *RevocationStore) {
+				s.buckets[0].hash[0] ^= 1
+			},
+		},
+		{
+			name: "invalid bucket index",
+			corrupt: func(s *RevocationStore) {
+				s.buckets[1].index = s.buckets[0].index
+			},
+		},
+		{
+			name: "secret not received yet",
+			corrupt: func(s *RevocationStore) {
+				s.index++
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		store, err := NewRevocationStoreFromBytes(
+			bytes.NewReader(b.Bytes()),
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		testCase.corrupt(store)
+
+		err = store.Verify()
+		if testCase.valid && err != nil {
+			t.Fatalf("%v: unexpected error: %v", testCase.name, err)
+		}
+		if !testCase.valid && err == nil {
+			t.Fatalf("%v: expected error", testCase.name)
+		}
+	}
+}
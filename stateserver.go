@@ -0,0 +1,146 @@
+package main
+
+import (
+	"sync"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// stateServiceMethods are the methods of the State service. They don't
+// require a macaroon, as the state is reported before the macaroons are even
+// created.
+var stateServiceMethods = map[string]struct{}{
+	"/lnrpc.State/SubscribeState": {},
+	"/lnrpc.State/GetState":       {},
+}
+
+// stateServer implements the State service, reporting the lifecycle state of
+// lnd. It's registered with both the wallet unlocker and the main gRPC
+// servers, so the state can be queried throughout the startup of lnd.
+type stateServer struct {
+	state lnrpc.WalletState
+
+	// subscribers maps the IDs of the active SubscribeState streams to
+	// the channels their state changes are delivered over.
+	subscribers map[uint64]chan lnrpc.WalletState
+	nextID      uint64
+
+	mtx sync.Mutex
+}
+
+// A compile time check to ensure that stateServer fully implements the
+// StateServer gRPC service.
+var _ lnrpc.StateServer = (*stateServer)(nil)
+
+// newStateServer creates a new stateServer in the given initial state.
+func newStateServer(state lnrpc.WalletState) *stateServer {
+	return &stateServer{
+		state:       state,
+		subscribers: make(map[uint64]chan lnrpc.WalletState),
+	}
+}
+
+// setState updates the state of lnd, and notifies all subscribers of it.
+func (s *stateServer) setState(state lnrpc.WalletState) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if state == s.state {
+		return
+	}
+
+	ltndLog.Debugf("Changing state from %v to %v", s.state, state)
+	s.state = state
+
+	for _, stateChan := range s.subscribers {
+		// The state only ever advances, so a subscriber's buffer can
+		// hold every state change it may miss.
+		select {
+		case stateChan <- state:
+		default:
+		}
+	}
+}
+
+// GetState returns the current state of lnd.
+func (s *stateServer) GetState(ctx context.Context,
+	_ *lnrpc.GetStateRequest) (*lnrpc.GetStateResponse, error) {
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return &lnrpc.GetStateResponse{
+		State: s.state,
+	}, nil
+}
+
+// SubscribeState subscribes to the state of lnd. The current state is sent
+// immediately, followed by every state change.
+func (s *stateServer) SubscribeState(_ *lnrpc.SubscribeStateRequest,
+	stream lnrpc.State_SubscribeStateServer) error {
+
+	stateChan := make(chan lnrpc.WalletState, len(lnrpc.WalletState_name))
+
+	s.mtx.Lock()
+	id := s.nextID
+	s.nextID++
+	s.subscribers[id] = stateChan
+	stateChan <- s.state
+	s.mtx.Unlock()
+
+	defer func() {
+		s.mtx.Lock()
+		delete(s.subscribers, id)
+		s.mtx.Unlock()
+	}()
+
+	for {
+		select {
+		case state := <-stateChan:
+			err := stream.Send(&lnrpc.SubscribeStateResponse{
+				State: state,
+			})
+			if err != nil {
+				return err
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// skipUnaryInterceptorForState returns a unary interceptor that bypasses the
+// given one for the methods of the State service.
+func skipUnaryInterceptorForState(
+	interceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		if _, ok := stateServiceMethods[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		return interceptor(ctx, req, info, handler)
+	}
+}
+
+// skipStreamInterceptorForState returns a stream interceptor that bypasses
+// the given one for the methods of the State service.
+func skipStreamInterceptorForState(
+	interceptor grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		if _, ok := stateServiceMethods[info.FullMethod]; ok {
+			return handler(srv, ss)
+		}
+
+		return interceptor(srv, ss, info, handler)
+	}
+}
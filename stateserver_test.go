@@ -0,0 +1,117 @@
+// +build !rpctest
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+)
+
+// mockStateStream is a mock State_SubscribeStateServer delivering the sent
+// states over a channel.
+type mockStateStream struct {
+	grpc.ServerStream
+
+	ctx    context.Context
+	states chan lnrpc.WalletState
+}
+
+func (m *mockStateStream) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockStateStream) Send(resp *lnrpc.SubscribeStateResponse) error {
+	m.states <- resp.State
+	return nil
+}
+
+// TestStateServer asserts that the state server reports the current state,
+// and notifies subscribers of every state change.
+func TestStateServer(t *testing.T) {
+	t.Parallel()
+
+	stateService := newStateServer(lnrpc.WalletState_NON_EXISTING)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream := &mockStateStream{
+		ctx:    ctx,
+		states: make(chan lnrpc.WalletState),
+	}
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- stateService.SubscribeState(
+			&lnrpc.SubscribeStateRequest{}, stream,
+		)
+	}()
+
+	assertState := func(expected lnrpc.WalletState) {
+		t.Helper()
+
+		resp, err := stateService.GetState(
+			context.Background(), &lnrpc.GetStateRequest{},
+		)
+		if err != nil {
+			t.Fatalf("unable to get state: %v", err)
+		}
+		if resp.State != expected {
+			t.Fatalf("expected state %v, got %v", expected,
+				resp.State)
+		}
+
+		select {
+		case state := <-stream.states:
+			if state != expected {
+				t.Fatalf("expected state update %v, got %v",
+					expected, state)
+			}
+
+		case <-time.After(time.Second):
+			t.Fatalf("state update %v not received", expected)
+		}
+	}
+
+	// The current state is sent upon subscribing, followed by every
+	// state change, even those made before the subscriber reads them.
+	assertState(lnrpc.WalletState_NON_EXISTING)
+
+	stateService.setState(lnrpc.WalletState_LOCKED)
+	assertState(lnrpc.WalletState_LOCKED)
+
+	stateService.setState(lnrpc.WalletState_UNLOCKED)
+	stateService.setState(lnrpc.WalletState_RPC_ACTIVE)
+	select {
+	case state := <-stream.states:
+		if state != lnrpc.WalletState_UNLOCKED {
+			t.Fatalf("expected state update %v, got %v",
+				lnrpc.WalletState_UNLOCKED, state)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("state update %v not received",
+			lnrpc.WalletState_UNLOCKED)
+	}
+	assertState(lnrpc.WalletState_RPC_ACTIVE)
+
+	// Setting the current state again doesn't notify subscribers.
+	stateService.setState(lnrpc.WalletState_RPC_ACTIVE)
+	stateService.setState(lnrpc.WalletState_SERVER_ACTIVE)
+	assertState(lnrpc.WalletState_SERVER_ACTIVE)
+
+	// Once the stream's context is canceled, the subscription ends.
+	cancel()
+	select {
+	case err := <-errChan:
+		if err != context.Canceled {
+			t.Fatalf("expected context canceled, got %v", err)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("subscription didn't end")
+	}
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
@@ -14,7 +16,9 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/walletrpc"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 // subRPCServerConfigs is special sub-config in the main configuration that
@@ -65,7 +69,11 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 	atpl *autopilot.Manager,
 	invoiceRegistry *invoices.InvoiceRegistry,
 	activeNetParams *chaincfg.Params,
-	chanRouter *routing.ChannelRouter) error {
+	chanRouter *routing.ChannelRouter,
+	htlcSwitch *htlcswitch.Switch,
+	sweeper *sweep.UtxoSweeper,
+	ntfnTracker *chainntnfs.RegistrationTracker,
+	chanStatusMgr *netann.ChanStatusManager) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
 	// that allows us to programmatically inspect its fields.
@@ -119,9 +127,18 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("Wallet").Set(
 				reflect.ValueOf(cc.wallet),
 			)
+			subCfgValue.FieldByName("OutputLeaser").Set(
+				reflect.ValueOf(cc.wallet),
+			)
 			subCfgValue.FieldByName("KeyRing").Set(
 				reflect.ValueOf(cc.keyRing),
 			)
+			subCfgValue.FieldByName("Sweeper").Set(
+				reflect.ValueOf(sweeper),
+			)
+			subCfgValue.FieldByName("Chain").Set(
+				reflect.ValueOf(cc.chainIO),
+			)
 
 		case *autopilotrpc.Config:
 			subCfgValue := extractReflectValue(cfg)
@@ -140,7 +157,15 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 				reflect.ValueOf(macService),
 			)
 			subCfgValue.FieldByName("ChainNotifier").Set(
-				reflect.ValueOf(cc.chainNotifier),
+				reflect.ValueOf(ntfnTracker.Track(
+					"chainrpc", cc.chainNotifier,
+				)),
+			)
+			subCfgValue.FieldByName("RegistrationTracker").Set(
+				reflect.ValueOf(ntfnTracker),
+			)
+			subCfgValue.FieldByName("Chain").Set(
+				reflect.ValueOf(cc.chainIO),
 			)
 
 		case *invoicesrpc.Config:
@@ -174,6 +199,12 @@ func (s *subRPCServerConfigs) PopulateDependencies(cc *chainControl,
 			subCfgValue.FieldByName("Router").Set(
 				reflect.ValueOf(chanRouter),
 			)
+			subCfgValue.FieldByName("HtlcSwitch").Set(
+				reflect.ValueOf(htlcSwitch),
+			)
+			subCfgValue.FieldByName("ChanStatusMgr").Set(
+				reflect.ValueOf(chanStatusMgr),
+			)
 
 		default:
 			return fmt.Errorf("unknown field: %v, %T", fieldName,
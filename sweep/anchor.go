@@ -0,0 +1,166 @@
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrCPFPNotNeeded is returned when attempting to bump the fee of a
+	// commitment transaction that already pays the target fee rate on its
+	// own.
+	ErrCPFPNotNeeded = errors.New("commitment already pays target fee " +
+		"rate")
+
+	// ErrInsufficientCPFPFunds is returned when the wallet doesn't hold
+	// enough funds to bump the fee of a commitment transaction to the
+	// target fee rate.
+	ErrInsufficientCPFPFunds = errors.New("insufficient wallet funds " +
+		"to bump commitment fee")
+)
+
+// AnchorCPFPPackage is a package that gives the caller the ability to bump
+// the fee of a commitment transaction by spending our anchor output in a
+// child transaction. We also package a function closure that allows one to
+// abort the operation.
+type AnchorCPFPPackage struct {
+	// CPFPTx is a fully signed, and valid transaction that spends our
+	// anchor output along with wallet funds, paying for the fee of both
+	// itself and the parent commitment transaction at the target fee
+	// rate.
+	CPFPTx *wire.MsgTx
+
+	// CancelCPFPAttempt allows the caller to cancel the CPFP attempt.
+	//
+	// NOTE: If the CPFP transaction isn't or cannot be broadcast, then
+	// this closure MUST be called, otherwise all selected utxos will be
+	// unable to be used.
+	CancelCPFPAttempt func()
+}
+
+// CraftAnchorCPFPTx attempts to craft an AnchorCPFPPackage which spends our
+// anchor output on a broadcast commitment transaction, so that the package
+// consisting of the commitment and the child transaction pays the target fee
+// rate. As the value of the anchor is too small to pay for the fee of the
+// package, wallet outputs are selected from the utxoSource to fund it, and any
+// remaining funds are sent back to the delivery script.
+func CraftAnchorCPFPTx(anchor *lnwallet.AnchorResolution,
+	feeRate chainfee.SatPerKWeight, blockHeight uint32,
+	deliveryPkScript []byte, coinSelectLocker CoinSelectionLocker,
+	utxoSource UtxoSource, outpointLocker OutpointLocker,
+	signer input.Signer) (*AnchorCPFPPackage, error) {
+
+	// If the commitment already pays the target fee rate by itself, then
+	// there's no need to bump it.
+	commitFeeRate := chainfee.SatPerKWeight(
+		anchor.CommitFee * 1000 / btcutil.Amount(anchor.CommitWeight),
+	)
+	if commitFeeRate >= feeRate {
+		return nil, ErrCPFPNotNeeded
+	}
+
+	anchorInput := input.MakeBaseInput(
+		&anchor.CommitAnchor, input.CommitmentAnchor,
+		&anchor.AnchorSignDescriptor, 0,
+	)
+
+	var (
+		selectedOutputs []*lnwallet.Utxo
+		inputs          = []input.Input{&anchorInput}
+		packageFee      btcutil.Amount
+	)
+
+	// We'll make a function closure up front that allows us to unlock all
+	// selected outputs to ensure that they become available again in the
+	// case of an error after the outputs have been locked, but before the
+	// child transaction has been broadcast.
+	unlockOutputs := func() {
+		for _, utxo := range selectedOutputs {
+			outpointLocker.UnlockOutpoint(utxo.OutPoint)
+		}
+	}
+
+	// We'll use the coinSelectLocker to ensure that no other coin
+	// selection takes place while we select and lock the wallet outputs
+	// used to fund the child transaction.
+	err := coinSelectLocker.WithCoinSelectLock(func() error {
+		utxos, err := utxoSource.ListUnspentWitness(
+			1, math.MaxInt32,
+		)
+		if err != nil {
+			return err
+		}
+
+		// We'll select the largest outputs first, in order to keep
+		// the size of the child transaction to a minimum.
+		sort.Slice(utxos, func(i, j int) bool {
+			return utxos[i].Value > utxos[j].Value
+		})
+
+		totalIn := btcutil.Amount(anchor.AnchorSignDescriptor.Output.Value)
+		for _, utxo := range utxos {
+			walletInput, err := newWalletInput(
+				utxoSource, &utxo.OutPoint,
+			)
+			if err != nil {
+				log.Debugf("Skipping output %v for CPFP: %v",
+					utxo.OutPoint, err)
+				continue
+			}
+
+			outpointLocker.LockOutpoint(utxo.OutPoint)
+			selectedOutputs = append(selectedOutputs, utxo)
+			inputs = append(inputs, walletInput)
+			totalIn += utxo.Value
+
+			// The child needs to pay for the weight of the whole
+			// package, minus the fee that's already paid by the
+			// commitment transaction itself.
+			_, childWeight, _, _ := getWeightEstimate(inputs)
+			packageFee = feeRate.FeeForWeight(
+				anchor.CommitWeight+childWeight,
+			) - anchor.CommitFee
+
+			// We'll stop once the selected outputs are able to
+			// pay for the fee, without creating a dust change
+			// output.
+			if totalIn-packageFee >= lnwallet.DefaultDustLimit() {
+				return nil
+			}
+		}
+
+		return ErrInsufficientCPFPFunds
+	})
+	if err != nil {
+		unlockOutputs()
+
+		return nil, err
+	}
+
+	log.Infof("Creating CPFP transaction for anchor %v using %v wallet "+
+		"inputs, paying %v for package at %v sat/kw",
+		anchor.CommitAnchor, len(selectedOutputs), packageFee,
+		int64(feeRate))
+
+	cpfpTx, err := assembleSweepTx(
+		inputs, deliveryPkScript, blockHeight, packageFee, signer,
+	)
+	if err != nil {
+		unlockOutputs()
+
+		return nil, fmt.Errorf("unable to create CPFP tx: %v", err)
+	}
+
+	return &AnchorCPFPPackage{
+		CPFPTx:            cpfpTx,
+		CancelCPFPAttempt: unlockOutputs,
+	}, nil
+}
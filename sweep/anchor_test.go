@@ -0,0 +1,176 @@
+package sweep
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// newTestAnchorResolution creates an anchor resolution for a commitment of
+// the given weight paying the given fee.
+func newTestAnchorResolution(t *testing.T, commitWeight int64,
+	commitFee btcutil.Amount) *lnwallet.AnchorResolution {
+
+	_, pubKey := btcec.PrivKeyFromBytes(btcec.S256(), bytes.Repeat(
+		[]byte{0x1}, 32,
+	))
+	anchorScript, err := input.CommitScriptAnchor(pubKey)
+	if err != nil {
+		t.Fatalf("unable to create anchor script: %v", err)
+	}
+	anchorPkScript, err := input.WitnessScriptHash(anchorScript)
+	if err != nil {
+		t.Fatalf("unable to create anchor pkscript: %v", err)
+	}
+
+	return &lnwallet.AnchorResolution{
+		AnchorSignDescriptor: input.SignDescriptor{
+			KeyDesc: keychain.KeyDescriptor{
+				PubKey: pubKey,
+			},
+			WitnessScript: anchorScript,
+			Output: &wire.TxOut{
+				PkScript: anchorPkScript,
+				Value:    330,
+			},
+			HashType: txscript.SigHashAll,
+		},
+		CommitAnchor: wire.OutPoint{
+			Index: 10,
+		},
+		CommitWeight: commitWeight,
+		CommitFee:    commitFee,
+	}
+}
+
+// TestCraftAnchorCPFPTxNotNeeded asserts that we won't attempt to bump the fee
+// of a commitment that already pays the target fee rate.
+func TestCraftAnchorCPFPTxNotNeeded(t *testing.T) {
+	t.Parallel()
+
+	anchor := newTestAnchorResolution(t, 1000, 2000)
+	utxoSource := newMockUtxoSource(
+		append([]*lnwallet.Utxo{}, testUtxos[:2]...),
+	)
+	utxoLocker := newMockOutpointLocker()
+
+	_, err := CraftAnchorCPFPTx(
+		anchor, 2000, 100, sweepScript, &mockCoinSelectionLocker{},
+		utxoSource, utxoLocker, &mockSigner{},
+	)
+	if err != ErrCPFPNotNeeded {
+		t.Fatalf("expected ErrCPFPNotNeeded, got: %v", err)
+	}
+
+	if len(utxoLocker.lockedOutpoints) != 0 {
+		t.Fatalf("no outputs should have been locked")
+	}
+}
+
+// TestCraftAnchorCPFPTxInsufficientFunds asserts that any locked outputs are
+// unlocked again if the wallet doesn't hold enough funds to bump the fee of
+// the commitment.
+func TestCraftAnchorCPFPTxInsufficientFunds(t *testing.T) {
+	t.Parallel()
+
+	targetUtxos := append([]*lnwallet.Utxo{}, testUtxos[:2]...)
+	anchor := newTestAnchorResolution(t, 1000, 0)
+	utxoSource := newMockUtxoSource(targetUtxos)
+	utxoLocker := newMockOutpointLocker()
+
+	_, err := CraftAnchorCPFPTx(
+		anchor, 5000, 100, sweepScript, &mockCoinSelectionLocker{},
+		utxoSource, utxoLocker, &mockSigner{},
+	)
+	if err != ErrInsufficientCPFPFunds {
+		t.Fatalf("expected ErrInsufficientCPFPFunds, got: %v", err)
+	}
+
+	assertUtxosLockedAndUnlocked(t, utxoLocker, targetUtxos)
+}
+
+// TestCraftAnchorCPFPTx asserts that the child transaction spends our anchor
+// along with enough wallet funds to pay for the fee of the package at the
+// target fee rate.
+func TestCraftAnchorCPFPTx(t *testing.T) {
+	t.Parallel()
+
+	const (
+		commitWeight = 1000
+		commitFee    = 200
+		feeRate      = chainfee.SatPerKWeight(1200)
+	)
+
+	targetUtxos := append([]*lnwallet.Utxo{}, testUtxos[:2]...)
+	anchor := newTestAnchorResolution(t, commitWeight, commitFee)
+	utxoSource := newMockUtxoSource(targetUtxos)
+	utxoLocker := newMockOutpointLocker()
+
+	cpfpPkg, err := CraftAnchorCPFPTx(
+		anchor, feeRate, 100, sweepScript, &mockCoinSelectionLocker{},
+		utxoSource, utxoLocker, &mockSigner{},
+	)
+	if err != nil {
+		t.Fatalf("unable to craft cpfp tx: %v", err)
+	}
+
+	// Neither of the wallet outputs is able to pay for the fee of the
+	// package on its own, so both of them should be locked.
+	assertUtxosLocked(t, utxoLocker, targetUtxos)
+	assertNoUtxosUnlocked(t, utxoLocker, targetUtxos)
+
+	// The anchor should be spent along with both wallet outputs.
+	cpfpTx := cpfpPkg.CPFPTx
+	if len(cpfpTx.TxIn) != 3 {
+		t.Fatalf("expected 3 inputs, got %v", len(cpfpTx.TxIn))
+	}
+	if cpfpTx.TxIn[0].PreviousOutPoint != anchor.CommitAnchor {
+		t.Fatalf("expected anchor %v to be spent, got %v",
+			anchor.CommitAnchor, cpfpTx.TxIn[0].PreviousOutPoint)
+	}
+
+	// The child should pay for the fee of the whole package, minus what's
+	// already paid by the commitment. Wallet outputs are selected largest
+	// first, so the np2wkh output is added before the p2wkh one.
+	anchorInput := input.MakeBaseInput(
+		&anchor.CommitAnchor, input.CommitmentAnchor,
+		&anchor.AnchorSignDescriptor, 0,
+	)
+	inputs := []input.Input{&anchorInput}
+	for _, utxo := range []*lnwallet.Utxo{testUtxos[1], testUtxos[0]} {
+		walletInput, err := newWalletInput(utxoSource, &utxo.OutPoint)
+		if err != nil {
+			t.Fatalf("unable to create wallet input: %v", err)
+		}
+		inputs = append(inputs, walletInput)
+	}
+	_, childWeight, _, _ := getWeightEstimate(inputs)
+	packageFee := feeRate.FeeForWeight(commitWeight+childWeight) - commitFee
+
+	expectedValue := int64(330+1000+2000) - int64(packageFee)
+	if len(cpfpTx.TxOut) != 1 {
+		t.Fatalf("expected 1 output, got %v", len(cpfpTx.TxOut))
+	}
+	output := cpfpTx.TxOut[0]
+	switch {
+	case output.Value != expectedValue:
+		t.Fatalf("expected output value %v, got %v", expectedValue,
+			output.Value)
+
+	case !bytes.Equal(sweepScript, output.PkScript):
+		t.Fatalf("expected %x sweep script, instead got %x",
+			sweepScript, output.PkScript)
+	}
+
+	// Cancelling the attempt should unlock the wallet outputs again.
+	cpfpPkg.CancelCPFPAttempt()
+	assertUtxosUnlocked(t, utxoLocker, targetUtxos)
+}
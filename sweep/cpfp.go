@@ -0,0 +1,78 @@
+package sweep
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrPackageFeeSufficient is returned when attempting to bump the fee
+	// of a package of unconfirmed transactions that already pays the
+	// target fee rate.
+	ErrPackageFeeSufficient = errors.New("package already pays target " +
+		"fee rate")
+
+	// ErrCPFPOutputTooSmall is returned when the value of the output
+	// spent by a child transaction isn't large enough to pay for the fee
+	// of its package at the target fee rate.
+	ErrCPFPOutputTooSmall = errors.New("output value too small to bump " +
+		"package fee")
+)
+
+// CraftCPFPTx crafts a child transaction that spends the given wallet
+// controlled output of an unconfirmed transaction, such that the package
+// consisting of the child and all of its unconfirmed ancestors pays the
+// target fee rate. The packageWeight and packageFee describe the ancestors of
+// the child, and the child pays for the difference between the target fee of
+// the whole package and the fee already paid by them. The remaining value of
+// the output is sent to the delivery script.
+func CraftCPFPTx(outpoint *wire.OutPoint, packageWeight int64,
+	packageFee btcutil.Amount, feeRate chainfee.SatPerKWeight,
+	blockHeight uint32, deliveryPkScript []byte, utxoSource UtxoSource,
+	signer input.Signer) (*wire.MsgTx, error) {
+
+	// If the ancestors already pay the target fee rate by themselves, then
+	// there's no need to bump them.
+	if packageWeight > 0 {
+		packageFeeRate := chainfee.SatPerKWeight(
+			packageFee * 1000 / btcutil.Amount(packageWeight),
+		)
+		if packageFeeRate >= feeRate {
+			return nil, ErrPackageFeeSufficient
+		}
+	}
+
+	walletInput, err := newWalletInput(utxoSource, outpoint)
+	if err != nil {
+		return nil, err
+	}
+	inputs := []input.Input{walletInput}
+
+	_, childWeight, _, _ := getWeightEstimate(inputs)
+	childFee := feeRate.FeeForWeight(packageWeight+childWeight) - packageFee
+
+	// We'll refuse to create a child that would leave us with a dust
+	// output after paying for the fee of the package.
+	outputValue := btcutil.Amount(walletInput.SignDesc().Output.Value)
+	if outputValue-childFee < lnwallet.DefaultDustLimit() {
+		return nil, ErrCPFPOutputTooSmall
+	}
+
+	log.Infof("Creating CPFP transaction spending %v, paying %v for "+
+		"package at %v sat/kw", outpoint, childFee, int64(feeRate))
+
+	cpfpTx, err := assembleSweepTx(
+		inputs, deliveryPkScript, blockHeight, childFee, signer,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create CPFP tx: %v", err)
+	}
+
+	return cpfpTx, nil
+}
@@ -0,0 +1,107 @@
+package sweep
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+)
+
+// TestCraftCPFPTxNotNeeded asserts that we won't attempt to bump the fee of a
+// package that already pays the target fee rate.
+func TestCraftCPFPTxNotNeeded(t *testing.T) {
+	t.Parallel()
+
+	utxoSource := newMockUtxoSource(
+		append([]*lnwallet.Utxo{}, testUtxos[:2]...),
+	)
+
+	_, err := CraftCPFPTx(
+		&testUtxos[1].OutPoint, 1000, 2000, 2000, 100, sweepScript,
+		utxoSource, &mockSigner{},
+	)
+	if err != ErrPackageFeeSufficient {
+		t.Fatalf("expected ErrPackageFeeSufficient, got: %v", err)
+	}
+}
+
+// TestCraftCPFPTxOutputTooSmall asserts that we won't create a child
+// transaction that would be left with a dust output after paying for the fee
+// of its package.
+func TestCraftCPFPTxOutputTooSmall(t *testing.T) {
+	t.Parallel()
+
+	utxoSource := newMockUtxoSource(
+		append([]*lnwallet.Utxo{}, testUtxos[:2]...),
+	)
+
+	_, err := CraftCPFPTx(
+		&testUtxos[1].OutPoint, 1000, 0, 5000, 100, sweepScript,
+		utxoSource, &mockSigner{},
+	)
+	if err != ErrCPFPOutputTooSmall {
+		t.Fatalf("expected ErrCPFPOutputTooSmall, got: %v", err)
+	}
+}
+
+// TestCraftCPFPTx asserts that the child transaction spends the target output
+// and pays for the fee of the package at the target fee rate.
+func TestCraftCPFPTx(t *testing.T) {
+	t.Parallel()
+
+	const (
+		packageWeight = 1000
+		packageFee    = 200
+		feeRate       = chainfee.SatPerKWeight(1000)
+	)
+
+	utxo := testUtxos[1]
+	utxoSource := newMockUtxoSource(
+		append([]*lnwallet.Utxo{}, testUtxos[:2]...),
+	)
+
+	cpfpTx, err := CraftCPFPTx(
+		&utxo.OutPoint, packageWeight, packageFee, feeRate, 100,
+		sweepScript, utxoSource, &mockSigner{},
+	)
+	if err != nil {
+		t.Fatalf("unable to craft cpfp tx: %v", err)
+	}
+
+	if len(cpfpTx.TxIn) != 1 {
+		t.Fatalf("expected 1 input, got %v", len(cpfpTx.TxIn))
+	}
+	if cpfpTx.TxIn[0].PreviousOutPoint != utxo.OutPoint {
+		t.Fatalf("expected output %v to be spent, got %v",
+			utxo.OutPoint, cpfpTx.TxIn[0].PreviousOutPoint)
+	}
+
+	// The child should pay for the fee of the whole package, minus what's
+	// already paid by its ancestors.
+	walletInput, err := newWalletInput(utxoSource, &utxo.OutPoint)
+	if err != nil {
+		t.Fatalf("unable to create wallet input: %v", err)
+	}
+	_, childWeight, _, _ := getWeightEstimate(
+		[]input.Input{walletInput},
+	)
+	childFee := feeRate.FeeForWeight(packageWeight+childWeight) -
+		packageFee
+
+	expectedValue := int64(utxo.Value) - int64(childFee)
+	if len(cpfpTx.TxOut) != 1 {
+		t.Fatalf("expected 1 output, got %v", len(cpfpTx.TxOut))
+	}
+	output := cpfpTx.TxOut[0]
+	switch {
+	case output.Value != expectedValue:
+		t.Fatalf("expected output value %v, got %v", expectedValue,
+			output.Value)
+
+	case !bytes.Equal(sweepScript, output.PkScript):
+		t.Fatalf("expected %x sweep script, instead got %x",
+			sweepScript, output.PkScript)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 var (
@@ -25,12 +27,40 @@ var (
 	// for the configured max number of attempts.
 	ErrTooManyAttempts = errors.New("sweep failed after max attempts")
 
+	// ErrNotPending is returned when attempting to update the parameters of
+	// an input that isn't currently pending to be swept by the sweeper.
+	ErrNotPending = errors.New("input not pending sweep")
+
+	// ErrSweeperShuttingDown is returned when a request is made to the
+	// sweeper while it is shutting down.
+	ErrSweeperShuttingDown = errors.New("sweeper shutting down")
+
 	// DefaultMaxSweepAttempts specifies the default maximum number of times
 	// an input is included in a publish attempt before giving up and
 	// returning an error to the caller.
 	DefaultMaxSweepAttempts = 10
+
+	// DefaultFeeRateBucketSize is the default size, in sat/kw, of the fee
+	// rate buckets we'll use to cluster inputs with similar fee rates into
+	// the same sweep transaction.
+	DefaultFeeRateBucketSize = 10
 )
 
+// Params contains the parameters that control the sweeping process of an
+// input.
+type Params struct {
+	// Fee is the fee preference of the client who requested the input to
+	// be swept. If a confirmation target is specified, then we'll map it
+	// into a fee rate whenever we attempt to cluster inputs for a sweep.
+	Fee FeePreference
+}
+
+// String returns a human readable interpretation of the sweep parameters.
+func (p Params) String() string {
+	return fmt.Sprintf("fee_rate=%v, conf_target=%v", p.Fee.FeeRate,
+		p.Fee.ConfTarget)
+}
+
 // pendingInput is created when an input reaches the main loop for the first
 // time. It tracks all relevant state that is needed for sweeping.
 type pendingInput struct {
@@ -53,6 +83,74 @@ type pendingInput struct {
 	// publishAttempts records the number of attempts that have already been
 	// made to sweep this tx.
 	publishAttempts int
+
+	// params contains the parameters that control the sweeping process.
+	params Params
+
+	// lastFeeRate is the most recent fee rate used for this input within a
+	// transaction broadcast to the network.
+	lastFeeRate chainfee.SatPerKWeight
+}
+
+// pendingInputs is a type alias for a set of pending inputs.
+type pendingInputs = map[wire.OutPoint]*pendingInput
+
+// inputCluster is a helper struct to gather a set of pending inputs that
+// should be swept with the specified fee rate.
+type inputCluster struct {
+	sweepFeeRate chainfee.SatPerKWeight
+	inputs       pendingInputs
+}
+
+// PendingInput contains information about an input that is currently being
+// swept by the UtxoSweeper.
+type PendingInput struct {
+	// OutPoint is the identifying outpoint of the input being swept.
+	OutPoint wire.OutPoint
+
+	// WitnessType is the witness type of the input being swept.
+	WitnessType input.WitnessType
+
+	// Amount is the amount of the input being swept.
+	Amount btcutil.Amount
+
+	// LastFeeRate is the most recent fee rate used for the input being
+	// swept within a transaction broadcast to the network.
+	LastFeeRate chainfee.SatPerKWeight
+
+	// BroadcastAttempts is the number of attempts we've made to sweep the
+	// input.
+	BroadcastAttempts int
+
+	// NextBroadcastHeight is the next height of the chain at which we'll
+	// attempt to broadcast a transaction sweeping the input.
+	NextBroadcastHeight uint32
+
+	// Params contains the parameters that control the sweeping process of
+	// the input.
+	Params Params
+}
+
+// updateReq is an internal message we'll use to represent an external caller's
+// intent to update the sweep parameters of a given input.
+type updateReq struct {
+	input        wire.OutPoint
+	params       Params
+	responseChan chan *updateResp
+}
+
+// updateResp is an internal message we'll use to hand off the response of an
+// updateReq from the UtxoSweeper's main event loop back to the caller.
+type updateResp struct {
+	resultChan chan Result
+	err        error
+}
+
+// pendingSweepsReq is an internal message we'll use to represent an external
+// caller's intent to retrieve all of the pending inputs the UtxoSweeper is
+// attempting to sweep.
+type pendingSweepsReq struct {
+	respChan chan map[wire.OutPoint]*PendingInput
 }
 
 // UtxoSweeper is responsible for sweeping outputs back into the wallet
@@ -65,7 +163,18 @@ type UtxoSweeper struct {
 	newInputs chan *sweepInputMessage
 	spendChan chan *chainntnfs.SpendDetail
 
-	pendingInputs map[wire.OutPoint]*pendingInput
+	// pendingSweepsReq is a channel that will be sent requests by external
+	// callers in order to retrieve the set of pending inputs the
+	// UtxoSweeper is attempting to sweep.
+	pendingSweepsReqs chan *pendingSweepsReq
+
+	// updateReqs is a channel that will be sent requests by external
+	// callers who wish to bump the fee rate of a given input.
+	updateReqs chan *updateReq
+
+	// pendingInputs is the set of inputs that the UtxoSweeper is currently
+	// attempting to sweep.
+	pendingInputs pendingInputs
 
 	// timer is the channel that signals expiry of the sweep batch timer.
 	timer <-chan time.Time
@@ -74,7 +183,7 @@ type UtxoSweeper struct {
 
 	currentOutputScript []byte
 
-	relayFeePerKW lnwallet.SatPerKWeight
+	relayFeePerKW chainfee.SatPerKWeight
 
 	quit chan struct{}
 	wg   sync.WaitGroup
@@ -89,7 +198,7 @@ type UtxoSweeperConfig struct {
 	// FeeEstimator is used when crafting sweep transactions to estimate
 	// the necessary fee relative to the expected size of the sweep
 	// transaction.
-	FeeEstimator lnwallet.FeeEstimator
+	FeeEstimator chainfee.Estimator
 
 	// PublishTransaction facilitates the process of broadcasting a signed
 	// transaction to the appropriate network.
@@ -114,10 +223,6 @@ type UtxoSweeperConfig struct {
 	// time the incubated outputs need to be spent.
 	Signer input.Signer
 
-	// SweepTxConfTarget assigns a confirmation target for sweep txes on
-	// which the fee calculation will be based.
-	SweepTxConfTarget uint32
-
 	// MaxInputsPerTx specifies the default maximum number of inputs allowed
 	// in a single sweep tx. If more need to be swept, multiple txes are
 	// created and published.
@@ -131,6 +236,11 @@ type UtxoSweeperConfig struct {
 	// NextAttemptDeltaFunc returns given the number of already attempted
 	// sweeps, how many blocks to wait before retrying to sweep.
 	NextAttemptDeltaFunc func(int) int32
+
+	// FeeRateBucketSize is the size, in sat/kw, of the fee rate buckets
+	// we'll use to cluster inputs with similar fee rates into the same
+	// sweep transaction.
+	FeeRateBucketSize int
 }
 
 // Result is the struct that is pushed through the result channel. Callers can
@@ -150,6 +260,7 @@ type Result struct {
 // SweepInput call and the sweeper main loop.
 type sweepInputMessage struct {
 	input      input.Input
+	params     Params
 	resultChan chan Result
 }
 
@@ -157,11 +268,13 @@ type sweepInputMessage struct {
 func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
 
 	return &UtxoSweeper{
-		cfg:           cfg,
-		newInputs:     make(chan *sweepInputMessage),
-		spendChan:     make(chan *chainntnfs.SpendDetail),
-		quit:          make(chan struct{}),
-		pendingInputs: make(map[wire.OutPoint]*pendingInput),
+		cfg:               cfg,
+		newInputs:         make(chan *sweepInputMessage),
+		spendChan:         make(chan *chainntnfs.SpendDetail),
+		updateReqs:        make(chan *updateReq),
+		pendingSweepsReqs: make(chan *pendingSweepsReq),
+		quit:              make(chan struct{}),
+		pendingInputs:     make(pendingInputs),
 	}
 }
 
@@ -252,23 +365,32 @@ func (s *UtxoSweeper) Stop() error {
 }
 
 // SweepInput sweeps inputs back into the wallet. The inputs will be batched and
-// swept after the batch time window ends.
+// swept after the batch time window ends. Inputs with similar fee preferences
+// are clustered together into the same sweep transaction.
 //
 // NOTE: Extreme care needs to be taken that input isn't changed externally.
 // Because it is an interface and we don't know what is exactly behind it, we
 // cannot make a local copy in sweeper.
-func (s *UtxoSweeper) SweepInput(input input.Input) (chan Result, error) {
+func (s *UtxoSweeper) SweepInput(input input.Input,
+	params Params) (chan Result, error) {
+
 	if input == nil || input.OutPoint() == nil || input.SignDesc() == nil {
 		return nil, errors.New("nil input received")
 	}
 
+	// Ensure the client provided a sane fee preference.
+	if _, err := s.feeRateForPreference(params.Fee); err != nil {
+		return nil, err
+	}
+
 	log.Infof("Sweep request received: out_point=%v, witness_type=%v, "+
-		"time_lock=%v, size=%v", input.OutPoint(), input.WitnessType(),
-		input.BlocksToMaturity(),
-		btcutil.Amount(input.SignDesc().Output.Value))
+		"time_lock=%v, amount=%v, params=(%v)", input.OutPoint(),
+		input.WitnessType(), input.BlocksToMaturity(),
+		btcutil.Amount(input.SignDesc().Output.Value), params)
 
 	sweeperInput := &sweepInputMessage{
 		input:      input,
+		params:     params,
 		resultChan: make(chan Result, 1),
 	}
 
@@ -276,12 +398,39 @@ func (s *UtxoSweeper) SweepInput(input input.Input) (chan Result, error) {
 	select {
 	case s.newInputs <- sweeperInput:
 	case <-s.quit:
-		return nil, fmt.Errorf("sweeper shutting down")
+		return nil, ErrSweeperShuttingDown
 	}
 
 	return sweeperInput.resultChan, nil
 }
 
+// feeRateForPreference returns a fee rate for the given fee preference. It
+// ensures that the fee rate respects the bounds of the UtxoSweeper.
+func (s *UtxoSweeper) feeRateForPreference(
+	feePreference FeePreference) (chainfee.SatPerKWeight, error) {
+
+	// Ensure a type of fee preference is specified to prevent using a
+	// default below.
+	if feePreference.FeeRate == 0 && feePreference.ConfTarget == 0 {
+		return 0, errors.New("no fee preference specified")
+	}
+
+	feeRate, err := DetermineFeePerKw(s.cfg.FeeEstimator, feePreference)
+	if err != nil {
+		return 0, err
+	}
+
+	// The relay fee rate is only known once the sweeper has been started,
+	// so we'll query it directly to validate early requests as well.
+	relayFeeRate := s.cfg.FeeEstimator.RelayFeePerKW()
+	if feeRate < relayFeeRate {
+		return 0, fmt.Errorf("fee preference resulted in invalid fee "+
+			"rate %v, minimum is %v", feeRate, relayFeeRate)
+	}
+
+	return feeRate, nil
+}
+
 // collector is the sweeper main loop. It processes new inputs, spend
 // notifications and counts down to publication of the sweep tx.
 func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
@@ -315,6 +464,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 				listeners:        []chan Result{input.resultChan},
 				input:            input.input,
 				minPublishHeight: bestHeight,
+				params:           input.params,
 			}
 			s.pendingInputs[outpoint] = pendInput
 
@@ -406,32 +556,31 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 			// be started when new inputs arrive.
 			s.timer = nil
 
-			// Retrieve fee estimate for input filtering and final
-			// tx fee calculation.
-			satPerKW, err := s.cfg.FeeEstimator.EstimateFeePerKW(
-				s.cfg.SweepTxConfTarget,
-			)
-			if err != nil {
-				log.Errorf("estimate fee: %v", err)
-				continue
-			}
-
-			// Examine pending inputs and try to construct lists of
-			// inputs.
-			inputLists, err := s.getInputLists(bestHeight, satPerKW)
-			if err != nil {
-				log.Errorf("get input lists: %v", err)
-				continue
-			}
-
-			// Sweep selected inputs.
-			for _, inputs := range inputLists {
-				err := s.sweep(inputs, satPerKW, bestHeight)
+			// We'll then determine which of the inputs can be
+			// swept, and cluster them by their fee rate. Each
+			// cluster is swept at its own fee rate.
+			clusters := s.clusterBySweepFeeRate(bestHeight)
+			for _, cluster := range clusters {
+				err := s.sweepCluster(cluster, bestHeight)
 				if err != nil {
-					log.Errorf("sweep: %v", err)
+					log.Errorf("sweep cluster: %v", err)
 				}
 			}
 
+		// A new external request has been received to retrieve all of
+		// the inputs we're currently attempting to sweep.
+		case req := <-s.pendingSweepsReqs:
+			req.respChan <- s.handlePendingSweepsReq()
+
+		// A new external request has been received to bump the fee
+		// rate of a given input.
+		case req := <-s.updateReqs:
+			resultChan, err := s.handleUpdateReq(req, bestHeight)
+			req.responseChan <- &updateResp{
+				resultChan: resultChan,
+				err:        err,
+			}
+
 		// A new block comes in. Things may have changed, so we retry a
 		// sweep.
 		case epoch, ok := <-blockEpochs:
@@ -454,6 +603,108 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch,
 	}
 }
 
+// sweepCluster tries to sweep the inputs of the given cluster at the fee rate
+// of the cluster.
+func (s *UtxoSweeper) sweepCluster(cluster inputCluster,
+	currentHeight int32) error {
+
+	// Examine pending inputs and try to construct lists of inputs.
+	inputLists, err := s.getInputLists(cluster, currentHeight)
+	if err != nil {
+		return fmt.Errorf("get input lists: %v", err)
+	}
+
+	// Sweep selected inputs.
+	for _, inputs := range inputLists {
+		err := s.sweep(inputs, cluster.sweepFeeRate, currentHeight)
+		if err != nil {
+			log.Errorf("sweep: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// bucketForFeeRate determines the proper bucket for a fee rate. This is done
+// in order to batch inputs with similar fee rates together.
+func (s *UtxoSweeper) bucketForFeeRate(
+	feeRate chainfee.SatPerKWeight) chainfee.SatPerKWeight {
+
+	// Create an isolated bucket for sweeps at the minimum fee rate. This is
+	// to prevent very small outputs from becoming uneconomical if their fee
+	// rate would be averaged with higher fee rate inputs in a regular
+	// bucket.
+	if feeRate == s.relayFeePerKW {
+		return 0
+	}
+
+	return 1 + (feeRate-s.relayFeePerKW)/
+		chainfee.SatPerKWeight(s.cfg.FeeRateBucketSize)
+}
+
+// clusterBySweepFeeRate takes the set of pending inputs within the UtxoSweeper
+// that are eligible to be swept at the current height, and clusters those
+// together with similar fee rates. The fee rate of each cluster is the average
+// fee rate of its inputs. Clusters are returned in order of descending fee
+// rate.
+func (s *UtxoSweeper) clusterBySweepFeeRate(
+	currentHeight int32) []inputCluster {
+
+	bucketInputs := make(map[chainfee.SatPerKWeight]pendingInputs)
+	bucketFeeRates := make(
+		map[chainfee.SatPerKWeight][]chainfee.SatPerKWeight,
+	)
+
+	// First, we'll group together all inputs with similar fee rates. This
+	// is done by determining the fee rate bucket they should belong in.
+	for op, input := range s.pendingInputs {
+		// Skip inputs that have a minimum publish height that is not
+		// yet reached.
+		if input.minPublishHeight > currentHeight {
+			continue
+		}
+
+		feeRate, err := s.feeRateForPreference(input.params.Fee)
+		if err != nil {
+			log.Warnf("Skipping input %v: %v", op, err)
+			continue
+		}
+
+		bucket := s.bucketForFeeRate(feeRate)
+		if _, ok := bucketInputs[bucket]; !ok {
+			bucketInputs[bucket] = make(pendingInputs)
+		}
+		bucketInputs[bucket][op] = input
+		bucketFeeRates[bucket] = append(bucketFeeRates[bucket], feeRate)
+	}
+
+	// Now that we've put each input into its proper bucket, we'll
+	// determine the fee rate each cluster should be swept at by taking the
+	// average fee rate of its inputs.
+	inputClusters := make([]inputCluster, 0, len(bucketInputs))
+	for bucket, inputs := range bucketInputs {
+		var totalFeeRate chainfee.SatPerKWeight
+		for _, feeRate := range bucketFeeRates[bucket] {
+			totalFeeRate += feeRate
+		}
+		numFeeRates := chainfee.SatPerKWeight(
+			len(bucketFeeRates[bucket]),
+		)
+
+		inputClusters = append(inputClusters, inputCluster{
+			sweepFeeRate: totalFeeRate / numFeeRates,
+			inputs:       inputs,
+		})
+	}
+
+	sort.Slice(inputClusters, func(i, j int) bool {
+		return inputClusters[i].sweepFeeRate >
+			inputClusters[j].sweepFeeRate
+	})
+
+	return inputClusters
+}
+
 // scheduleSweep starts the sweep timer to create an opportunity for more inputs
 // to be added.
 func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
@@ -464,27 +715,25 @@ func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
 		return nil
 	}
 
-	// Retrieve fee estimate for input filtering and final tx fee
-	// calculation.
-	satPerKW, err := s.cfg.FeeEstimator.EstimateFeePerKW(
-		s.cfg.SweepTxConfTarget,
-	)
-	if err != nil {
-		return fmt.Errorf("estimate fee: %v", err)
-	}
-
-	// Examine pending inputs and try to construct lists of inputs.
-	inputLists, err := s.getInputLists(currentHeight, satPerKW)
-	if err != nil {
-		return fmt.Errorf("get input lists: %v", err)
+	// We'll then determine if any of the inputs that are currently pending
+	// can be swept at the current block height.
+	var numTxns int
+	for _, cluster := range s.clusterBySweepFeeRate(currentHeight) {
+		// Examine pending inputs and try to construct lists of
+		// inputs.
+		inputLists, err := s.getInputLists(cluster, currentHeight)
+		if err != nil {
+			return fmt.Errorf("get input lists: %v", err)
+		}
+		numTxns += len(inputLists)
 	}
 
 	log.Infof("Sweep candidates at height=%v, yield %v distinct txns",
-		currentHeight, len(inputLists))
+		currentHeight, numTxns)
 
 	// If there are no input sets, there is nothing sweepable and we can
 	// return without starting the timer.
-	if len(inputLists) == 0 {
+	if numTxns == 0 {
 		return nil
 	}
 
@@ -533,13 +782,13 @@ func (s *UtxoSweeper) signalAndRemove(outpoint *wire.OutPoint, result Result) {
 	delete(s.pendingInputs, *outpoint)
 }
 
-// getInputLists goes through all pending inputs and constructs sweep lists,
-// each up to the configured maximum number of inputs. Negative yield inputs are
-// skipped. Transactions with an output below the dust limit are not published.
-// Those inputs remain pending and will be bundled with future inputs if
-// possible.
-func (s *UtxoSweeper) getInputLists(currentHeight int32,
-	satPerKW lnwallet.SatPerKWeight) ([]inputSet, error) {
+// getInputLists goes through the given input cluster and constructs sweep
+// lists, each up to the configured maximum number of inputs. Negative yield
+// inputs are skipped. Transactions with an output below the dust limit are not
+// published. Those inputs remain pending and will be bundled with future
+// inputs if possible.
+func (s *UtxoSweeper) getInputLists(cluster inputCluster,
+	currentHeight int32) ([]inputSet, error) {
 
 	// Filter for inputs that need to be swept. Create two lists: all
 	// sweepable inputs and a list containing only the new, never tried
@@ -552,13 +801,7 @@ func (s *UtxoSweeper) getInputLists(currentHeight int32,
 	// consisting of only new inputs to the list, to make sure that new
 	// inputs are given a good, isolated chance of being published.
 	var newInputs, retryInputs []input.Input
-	for _, input := range s.pendingInputs {
-		// Skip inputs that have a minimum publish height that is not
-		// yet reached.
-		if input.minPublishHeight > currentHeight {
-			continue
-		}
-
+	for _, input := range cluster.inputs {
 		// Add input to the either one of the lists.
 		if input.publishAttempts == 0 {
 			newInputs = append(newInputs, input.input)
@@ -574,7 +817,7 @@ func (s *UtxoSweeper) getInputLists(currentHeight int32,
 		var err error
 		allSets, err = generateInputPartitionings(
 			append(retryInputs, newInputs...),
-			s.relayFeePerKW, satPerKW,
+			s.relayFeePerKW, cluster.sweepFeeRate,
 			s.cfg.MaxInputsPerTx,
 		)
 		if err != nil {
@@ -585,7 +828,7 @@ func (s *UtxoSweeper) getInputLists(currentHeight int32,
 	// Create sets for just the new inputs.
 	newSets, err := generateInputPartitionings(
 		newInputs,
-		s.relayFeePerKW, satPerKW,
+		s.relayFeePerKW, cluster.sweepFeeRate,
 		s.cfg.MaxInputsPerTx,
 	)
 	if err != nil {
@@ -603,7 +846,7 @@ func (s *UtxoSweeper) getInputLists(currentHeight int32,
 // sweep takes a set of preselected inputs, creates a sweep tx and publishes the
 // tx. The output address is only marked as used if the publish succeeds.
 func (s *UtxoSweeper) sweep(inputs inputSet,
-	satPerKW lnwallet.SatPerKWeight, currentHeight int32) error {
+	satPerKW chainfee.SatPerKWeight, currentHeight int32) error {
 
 	var err error
 
@@ -669,6 +912,7 @@ func (s *UtxoSweeper) sweep(inputs inputSet,
 
 		// Record another publish attempt.
 		pi.publishAttempts++
+		pi.lastFeeRate = satPerKW
 
 		// We don't care what the result of the publish call was. Even
 		// if it is published successfully, it can still be that it
@@ -736,6 +980,126 @@ func (s *UtxoSweeper) waitForSpend(outpoint wire.OutPoint,
 	return spendEvent.Cancel, nil
 }
 
+// PendingInputs returns the set of inputs that the UtxoSweeper is currently
+// attempting to sweep.
+func (s *UtxoSweeper) PendingInputs() (map[wire.OutPoint]*PendingInput, error) {
+	respChan := make(chan map[wire.OutPoint]*PendingInput, 1)
+	select {
+	case s.pendingSweepsReqs <- &pendingSweepsReq{
+		respChan: respChan,
+	}:
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+
+	select {
+	case pendingSweeps := <-respChan:
+		return pendingSweeps, nil
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+}
+
+// handlePendingSweepsReq handles a request to retrieve all pending inputs the
+// UtxoSweeper is attempting to sweep.
+func (s *UtxoSweeper) handlePendingSweepsReq() map[wire.OutPoint]*PendingInput {
+
+	pendingSweeps := make(
+		map[wire.OutPoint]*PendingInput, len(s.pendingInputs),
+	)
+	for op, pendInput := range s.pendingInputs {
+		// Only the exported fields are set, as we expect the response
+		// to only be consumed externally.
+		pendingSweeps[op] = &PendingInput{
+			OutPoint:    op,
+			WitnessType: pendInput.input.WitnessType(),
+			Amount: btcutil.Amount(
+				pendInput.input.SignDesc().Output.Value,
+			),
+			LastFeeRate:         pendInput.lastFeeRate,
+			BroadcastAttempts:   pendInput.publishAttempts,
+			NextBroadcastHeight: uint32(pendInput.minPublishHeight),
+			Params:              pendInput.params,
+		}
+	}
+
+	return pendingSweeps
+}
+
+// UpdateParams allows updating the sweep parameters of a pending input in the
+// UtxoSweeper. This function can be used to provide an updated fee preference
+// that will be used for a new sweep transaction of the input that will act as
+// a replacement transaction (RBF) of the original sweeping transaction, if
+// any.
+//
+// NOTE: This currently doesn't do any fee rate validation to ensure that a bump
+// is actually successful. The responsibility of doing so should be handled by
+// the caller.
+func (s *UtxoSweeper) UpdateParams(input wire.OutPoint,
+	params Params) (chan Result, error) {
+
+	// Ensure the client provided a sane fee preference.
+	if _, err := s.feeRateForPreference(params.Fee); err != nil {
+		return nil, err
+	}
+
+	responseChan := make(chan *updateResp, 1)
+	select {
+	case s.updateReqs <- &updateReq{
+		input:        input,
+		params:       params,
+		responseChan: responseChan,
+	}:
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+
+	select {
+	case response := <-responseChan:
+		return response.resultChan, response.err
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+}
+
+// handleUpdateReq handles an update request by simply updating the sweep
+// parameters of the pending input. Currently, no validation is done on the new
+// fee preference to ensure it will properly create a replacement transaction.
+func (s *UtxoSweeper) handleUpdateReq(req *updateReq, bestHeight int32) (
+	chan Result, error) {
+
+	// If the UtxoSweeper is already trying to sweep this input, then we can
+	// simply just increase its fee rate. This will allow the input to be
+	// batched with others which also have a similar fee rate, creating a
+	// higher fee rate transaction that replaces the original input's
+	// sweeping transaction.
+	pendInput, ok := s.pendingInputs[req.input]
+	if !ok {
+		return nil, ErrNotPending
+	}
+
+	log.Debugf("Updating sweep parameters for %v from (%v) to (%v)",
+		req.input, pendInput.params, req.params)
+
+	pendInput.params = req.params
+
+	// We'll reset the input's publish height to the current so that a new
+	// transaction can be created right away that replaces the transaction
+	// currently spending the input.
+	if pendInput.publishAttempts > 0 {
+		pendInput.minPublishHeight = bestHeight
+	}
+
+	if err := s.scheduleSweep(bestHeight); err != nil {
+		log.Errorf("schedule sweep: %v", err)
+	}
+
+	resultChan := make(chan Result, 1)
+	pendInput.listeners = append(pendInput.listeners, resultChan)
+
+	return resultChan, nil
+}
+
 // CreateSweepTx accepts a list of inputs and signs and generates a txn that
 // spends from them. This method also makes an accurate fee estimate before
 // generating the required witnesses.
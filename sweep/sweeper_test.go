@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 var (
@@ -22,6 +23,8 @@ var (
 	testMaxSweepAttempts = 3
 
 	testMaxInputsPerTx = 3
+
+	defaultFeePref = Params{Fee: FeePreference{ConfTarget: 1}}
 )
 
 type sweeperTestContext struct {
@@ -127,10 +130,9 @@ func createSweeperTestContext(t *testing.T) *sweeperTestContext {
 			ctx.timeoutChan <- c
 			return c
 		},
-		Store:             store,
-		Signer:            &mockSigner{},
-		SweepTxConfTarget: 1,
-		ChainIO:           &mockChainIO{},
+		Store:   store,
+		Signer:  &mockSigner{},
+		ChainIO: &mockChainIO{},
 		GenSweepScript: func() ([]byte, error) {
 			script := []byte{outputScriptCount}
 			outputScriptCount++
@@ -143,6 +145,7 @@ func createSweeperTestContext(t *testing.T) *sweeperTestContext {
 			// Use delta func without random factor.
 			return 1 << uint(attempts-1)
 		},
+		FeeRateBucketSize: DefaultFeeRateBucketSize,
 	})
 
 	ctx.sweeper.Start()
@@ -255,7 +258,9 @@ func (ctx *sweeperTestContext) expectResult(c chan Result, expected error) {
 func TestSuccess(t *testing.T) {
 	ctx := createSweeperTestContext(t)
 
-	resultChan, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	resultChan, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -305,7 +310,7 @@ func TestDust(t *testing.T) {
 	// sweep tx output script (P2WPKH).
 	dustInput := createTestInput(5260, input.CommitmentTimeLock)
 
-	_, err := ctx.sweeper.SweepInput(&dustInput)
+	_, err := ctx.sweeper.SweepInput(&dustInput, defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -316,7 +321,7 @@ func TestDust(t *testing.T) {
 	// Sweep another input that brings the tx output above the dust limit.
 	largeInput := createTestInput(100000, input.CommitmentTimeLock)
 
-	_, err = ctx.sweeper.SweepInput(&largeInput)
+	_, err = ctx.sweeper.SweepInput(&largeInput, defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -345,7 +350,9 @@ func TestNegativeInput(t *testing.T) {
 	// Sweep an input large enough to cover fees, so in any case the tx
 	// output will be above the dust limit.
 	largeInput := createTestInput(100000, input.CommitmentNoDelay)
-	largeInputResult, err := ctx.sweeper.SweepInput(&largeInput)
+	largeInputResult, err := ctx.sweeper.SweepInput(
+		&largeInput, defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -354,7 +361,7 @@ func TestNegativeInput(t *testing.T) {
 	// the HtlcAcceptedRemoteSuccess input type adds more in fees than its
 	// value at the current fee level.
 	negInput := createTestInput(2900, input.HtlcOfferedRemoteTimeout)
-	negInputResult, err := ctx.sweeper.SweepInput(&negInput)
+	negInputResult, err := ctx.sweeper.SweepInput(&negInput, defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -362,7 +369,9 @@ func TestNegativeInput(t *testing.T) {
 	// Sweep a third input that has a smaller output than the previous one,
 	// but yields positively because of its lower weight.
 	positiveInput := createTestInput(2800, input.CommitmentNoDelay)
-	positiveInputResult, err := ctx.sweeper.SweepInput(&positiveInput)
+	positiveInputResult, err := ctx.sweeper.SweepInput(
+		&positiveInput, defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -391,7 +400,9 @@ func TestNegativeInput(t *testing.T) {
 
 	// Create another large input
 	secondLargeInput := createTestInput(100000, input.CommitmentNoDelay)
-	secondLargeInputResult, err := ctx.sweeper.SweepInput(&secondLargeInput)
+	secondLargeInputResult, err := ctx.sweeper.SweepInput(
+		&secondLargeInput, defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -438,7 +449,7 @@ func TestChunks(t *testing.T) {
 
 	// Sweep five inputs.
 	for _, input := range spendableInputs[:5] {
-		_, err := ctx.sweeper.SweepInput(input)
+		_, err := ctx.sweeper.SweepInput(input, defaultFeePref)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -479,12 +490,16 @@ func TestRemoteSpend(t *testing.T) {
 func testRemoteSpend(t *testing.T, postSweep bool) {
 	ctx := createSweeperTestContext(t)
 
-	resultChan1, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	resultChan1, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	resultChan2, err := ctx.sweeper.SweepInput(spendableInputs[1])
+	resultChan2, err := ctx.sweeper.SweepInput(
+		spendableInputs[1], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -557,12 +572,16 @@ func testRemoteSpend(t *testing.T, postSweep bool) {
 func TestIdempotency(t *testing.T) {
 	ctx := createSweeperTestContext(t)
 
-	resultChan1, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	resultChan1, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	resultChan2, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	resultChan2, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -571,7 +590,9 @@ func TestIdempotency(t *testing.T) {
 
 	ctx.receiveTx()
 
-	resultChan3, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	resultChan3, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -588,7 +609,9 @@ func TestIdempotency(t *testing.T) {
 	// immediately receive the spend notification with a spending tx hash.
 	// Because the sweeper kept track of all of its sweep txes, it will
 	// recognize the spend as its own.
-	resultChan4, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	resultChan4, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -615,7 +638,7 @@ func TestRestart(t *testing.T) {
 	ctx := createSweeperTestContext(t)
 
 	// Sweep input and expect sweep tx.
-	_, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	_, err := ctx.sweeper.SweepInput(spendableInputs[0], defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -633,12 +656,16 @@ func TestRestart(t *testing.T) {
 	ctx.receiveTx()
 
 	// Simulate other subsystem (eg contract resolver) re-offering inputs.
-	spendChan1, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	spendChan1, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	spendChan2, err := ctx.sweeper.SweepInput(spendableInputs[1])
+	spendChan2, err := ctx.sweeper.SweepInput(
+		spendableInputs[1], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -693,13 +720,13 @@ func TestRestartRemoteSpend(t *testing.T) {
 	ctx := createSweeperTestContext(t)
 
 	// Sweep input.
-	_, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	_, err := ctx.sweeper.SweepInput(spendableInputs[0], defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	// Sweep another input.
-	_, err = ctx.sweeper.SweepInput(spendableInputs[1])
+	_, err = ctx.sweeper.SweepInput(spendableInputs[1], defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -736,7 +763,9 @@ func TestRestartRemoteSpend(t *testing.T) {
 	ctx.backend.mine()
 
 	// Simulate other subsystem (eg contract resolver) re-offering input 0.
-	spendChan, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	spendChan, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -760,7 +789,7 @@ func TestRestartConfirmed(t *testing.T) {
 	ctx := createSweeperTestContext(t)
 
 	// Sweep input.
-	_, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	_, err := ctx.sweeper.SweepInput(spendableInputs[0], defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -782,7 +811,9 @@ func TestRestartConfirmed(t *testing.T) {
 	ctx.backend.mine()
 
 	// Simulate other subsystem (eg contract resolver) re-offering input 0.
-	spendChan, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	spendChan, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -801,7 +832,7 @@ func TestRestartConfirmed(t *testing.T) {
 func TestRestartRepublish(t *testing.T) {
 	ctx := createSweeperTestContext(t)
 
-	_, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	_, err := ctx.sweeper.SweepInput(spendableInputs[0], defaultFeePref)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -831,7 +862,9 @@ func TestRestartRepublish(t *testing.T) {
 func TestRetry(t *testing.T) {
 	ctx := createSweeperTestContext(t)
 
-	resultChan0, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	resultChan0, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -846,7 +879,9 @@ func TestRetry(t *testing.T) {
 	ctx.notifier.NotifyEpoch(1000)
 
 	// Offer a fresh input.
-	resultChan1, err := ctx.sweeper.SweepInput(spendableInputs[1])
+	resultChan1, err := ctx.sweeper.SweepInput(
+		spendableInputs[1], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -871,7 +906,9 @@ func TestRetry(t *testing.T) {
 func TestGiveUp(t *testing.T) {
 	ctx := createSweeperTestContext(t)
 
-	resultChan0, err := ctx.sweeper.SweepInput(spendableInputs[0])
+	resultChan0, err := ctx.sweeper.SweepInput(
+		spendableInputs[0], defaultFeePref,
+	)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -902,3 +939,206 @@ func TestGiveUp(t *testing.T) {
 
 	ctx.finish(1)
 }
+
+// TestDifferentFeePreferences ensures that the sweeper can have different
+// transactions for different fee preferences. These transactions should be
+// broadcast from highest to lowest fee rate.
+func TestDifferentFeePreferences(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// Throughout this test, we'll be attempting to sweep three inputs, two
+	// with the higher fee preference, and the last with the lower. We do
+	// this to ensure the sweeper can broadcast distinct transactions for
+	// each sweep with a different fee preference.
+	lowFeePref := FeePreference{ConfTarget: 12}
+	ctx.estimator.blocksToFee[lowFeePref.ConfTarget] = 5000
+	highFeePref := FeePreference{ConfTarget: 6}
+	ctx.estimator.blocksToFee[highFeePref.ConfTarget] = 10000
+
+	input1 := spendableInputs[0]
+	resultChan1, err := ctx.sweeper.SweepInput(
+		input1, Params{Fee: highFeePref},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input2 := spendableInputs[1]
+	resultChan2, err := ctx.sweeper.SweepInput(
+		input2, Params{Fee: highFeePref},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	input3 := spendableInputs[2]
+	resultChan3, err := ctx.sweeper.SweepInput(
+		input3, Params{Fee: lowFeePref},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Start the sweeper's batch ticker, which should cause the sweep
+	// transactions to be broadcast in order of high to low fee preference.
+	ctx.tick()
+
+	sweepTx1 := ctx.receiveTx()
+	if !testTxIns(&sweepTx1, []*wire.OutPoint{
+		input1.OutPoint(), input2.OutPoint(),
+	}) {
+		t.Fatal("expected high fee rate inputs in first sweep tx")
+	}
+
+	sweepTx2 := ctx.receiveTx()
+	if !testTxIns(&sweepTx2, []*wire.OutPoint{input3.OutPoint()}) {
+		t.Fatal("expected low fee rate input in second sweep tx")
+	}
+
+	// Each of the inputs should be reported as pending, along with the fee
+	// rate it was swept at.
+	pendingInputs, err := ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatalf("unable to retrieve pending inputs: %v", err)
+	}
+	expectedFeeRates := map[wire.OutPoint]chainfee.SatPerKWeight{
+		*input1.OutPoint(): 10000,
+		*input2.OutPoint(): 10000,
+		*input3.OutPoint(): 5000,
+	}
+	if len(pendingInputs) != len(expectedFeeRates) {
+		t.Fatalf("expected %v pending inputs, got %v",
+			len(expectedFeeRates), len(pendingInputs))
+	}
+	for op, feeRate := range expectedFeeRates {
+		pendingInput, ok := pendingInputs[op]
+		if !ok {
+			t.Fatalf("expected input %v to be pending", op)
+		}
+		if pendingInput.LastFeeRate != feeRate {
+			t.Fatalf("expected fee rate %v for input %v, got %v",
+				feeRate, op, pendingInput.LastFeeRate)
+		}
+		if pendingInput.BroadcastAttempts != 1 {
+			t.Fatalf("expected 1 broadcast attempt for input %v, "+
+				"got %v", op, pendingInput.BroadcastAttempts)
+		}
+	}
+
+	// With both transactions broadcast, we'll mine a block to confirm
+	// them.
+	ctx.backend.mine()
+
+	ctx.expectResult(resultChan1, nil)
+	ctx.expectResult(resultChan2, nil)
+	ctx.expectResult(resultChan3, nil)
+
+	ctx.finish(1)
+}
+
+// TestUpdateParams ensures that the fee preference of a pending input can be
+// updated, which results in a replacement sweep transaction being broadcast
+// at the new fee rate.
+func TestUpdateParams(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	lowFeePref := FeePreference{ConfTarget: 144}
+	ctx.estimator.blocksToFee[lowFeePref.ConfTarget] = 5000
+	highFeePref := FeePreference{ConfTarget: 6}
+	ctx.estimator.blocksToFee[highFeePref.ConfTarget] = 10000
+
+	testInput := spendableInputs[0]
+	resultChan, err := ctx.sweeper.SweepInput(
+		testInput, Params{Fee: lowFeePref},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+
+	// Updating the parameters of an input that isn't pending should fail.
+	_, err = ctx.sweeper.UpdateParams(
+		*spendableInputs[1].OutPoint(), Params{Fee: highFeePref},
+	)
+	if err != ErrNotPending {
+		t.Fatalf("expected ErrNotPending, got: %v", err)
+	}
+
+	// Bumping the fee of our input should result in a replacement
+	// transaction being broadcast right away, without having to wait for
+	// the next block.
+	bumpResultChan, err := ctx.sweeper.UpdateParams(
+		*testInput.OutPoint(), Params{Fee: highFeePref},
+	)
+	if err != nil {
+		t.Fatalf("unable to update params: %v", err)
+	}
+
+	ctx.tick()
+	replacementTx := ctx.receiveTx()
+	if !testTxIns(&replacementTx, []*wire.OutPoint{testInput.OutPoint()}) {
+		t.Fatal("expected replacement tx to spend the bumped input")
+	}
+	if replacementTx.TxOut[0].Value >= sweepTx.TxOut[0].Value {
+		t.Fatalf("expected replacement tx to pay a higher fee")
+	}
+
+	pendingInputs, err := ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatalf("unable to retrieve pending inputs: %v", err)
+	}
+	pendingInput, ok := pendingInputs[*testInput.OutPoint()]
+	if !ok {
+		t.Fatalf("expected input %v to be pending", testInput.OutPoint())
+	}
+	if pendingInput.LastFeeRate != 10000 {
+		t.Fatalf("expected fee rate 10000, got %v",
+			pendingInput.LastFeeRate)
+	}
+	if pendingInput.Params.Fee != highFeePref {
+		t.Fatalf("expected fee preference %v, got %v", highFeePref,
+			pendingInput.Params.Fee)
+	}
+
+	// Our mock backend doesn't support replacements, so the original
+	// sweep transaction confirms instead. Both listeners should be
+	// notified of the spend regardless.
+	ctx.backend.mine()
+
+	ctx.expectResult(resultChan, nil)
+	ctx.expectResult(bumpResultChan, nil)
+
+	ctx.finish(1)
+}
+
+// TestUpdateParamsSweptInput asserts that the fee preference of an input can
+// no longer be updated once its sweep transaction has confirmed.
+func TestUpdateParamsSweptInput(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	highFeePref := FeePreference{ConfTarget: 6}
+	ctx.estimator.blocksToFee[highFeePref.ConfTarget] = 10000
+
+	testInput := spendableInputs[0]
+	resultChan, err := ctx.sweeper.SweepInput(testInput, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+	ctx.receiveTx()
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan, nil)
+
+	// Now that the input has been swept, it is no longer pending and
+	// bumping its fee should fail.
+	_, err = ctx.sweeper.UpdateParams(
+		*testInput.OutPoint(), Params{Fee: highFeePref},
+	)
+	if err != ErrNotPending {
+		t.Fatalf("expected ErrNotPending, got: %v", err)
+	}
+
+	ctx.finish(1)
+}
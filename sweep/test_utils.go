@@ -254,3 +254,9 @@ func (m *mockChainIO) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
 func (m *mockChainIO) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock, error) {
 	return nil, nil
 }
+
+func (m *mockChainIO) GetBlockHeader(
+	blockHash *chainhash.Hash) (*wire.BlockHeader, error) {
+
+	return nil, nil
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/btcsuite/btcwallet/wallet/txrules"
 	"github.com/lightningnetwork/lnd/input"
-	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 var (
@@ -30,7 +30,7 @@ type inputSet []input.Input
 // inputs are skipped. No input sets with a total value after fees below the
 // dust limit are returned.
 func generateInputPartitionings(sweepableInputs []input.Input,
-	relayFeePerKW, feePerKW lnwallet.SatPerKWeight,
+	relayFeePerKW, feePerKW chainfee.SatPerKWeight,
 	maxInputsPerTx int) ([]inputSet, error) {
 
 	// Calculate dust limit based on the P2WPKH output script of the sweep
@@ -115,7 +115,7 @@ func generateInputPartitionings(sweepableInputs []input.Input,
 // minimizing any negative externalities we cause for the Bitcoin system as a
 // whole.
 func getPositiveYieldInputs(sweepableInputs []input.Input, maxInputs int,
-	feePerKW lnwallet.SatPerKWeight) (int, btcutil.Amount) {
+	feePerKW chainfee.SatPerKWeight) (int, btcutil.Amount) {
 
 	var weightEstimate input.TxWeightEstimator
 
@@ -168,7 +168,7 @@ func getPositiveYieldInputs(sweepableInputs []input.Input, maxInputs int,
 
 // createSweepTx builds a signed tx spending the inputs to a the output script.
 func createSweepTx(inputs []input.Input, outputPkScript []byte,
-	currentBlockHeight uint32, feePerKw lnwallet.SatPerKWeight,
+	currentBlockHeight uint32, feePerKw chainfee.SatPerKWeight,
 	signer input.Signer) (*wire.MsgTx, error) {
 
 	inputs, txWeight, csvCount, cltvCount := getWeightEstimate(inputs)
@@ -179,6 +179,17 @@ func createSweepTx(inputs []input.Input, outputPkScript []byte,
 
 	txFee := feePerKw.FeeForWeight(txWeight)
 
+	return assembleSweepTx(
+		inputs, outputPkScript, currentBlockHeight, txFee, signer,
+	)
+}
+
+// assembleSweepTx builds a signed tx spending the inputs to the output script,
+// paying the given absolute fee.
+func assembleSweepTx(inputs []input.Input, outputPkScript []byte,
+	currentBlockHeight uint32, txFee btcutil.Amount,
+	signer input.Signer) (*wire.MsgTx, error) {
+
 	// Sum up the total value contained in the inputs.
 	var totalSum btcutil.Amount
 	for _, o := range inputs {
@@ -296,6 +307,11 @@ func getInputWitnessSizeUpperBound(inp input.Input) (int, bool, error) {
 	// including the sigScript.
 	case input.NestedWitnessKeyHash:
 		return input.P2WKHWitnessSize, true, nil
+
+	// The anchor output on a commitment transaction that we spend using
+	// our funding key.
+	case input.CommitmentAnchor:
+		return input.AnchorWitnessSize, false, nil
 	}
 
 	return 0, false, fmt.Errorf("unexpected witness type: %v",
@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 )
 
 const (
@@ -27,15 +28,15 @@ type FeePreference struct {
 
 	// FeeRate if non-zero, signals a fee pre fence expressed in the fee
 	// rate expressed in sat/kw for a particular transaction.
-	FeeRate lnwallet.SatPerKWeight
+	FeeRate chainfee.SatPerKWeight
 }
 
 // DetermineFeePerKw will determine the fee in sat/kw that should be paid given
 // an estimator, a confirmation target, and a manual value for sat/byte. A
 // value is chosen based on the two free parameters as one, or both of them can
 // be zero.
-func DetermineFeePerKw(feeEstimator lnwallet.FeeEstimator,
-	feePref FeePreference) (lnwallet.SatPerKWeight, error) {
+func DetermineFeePerKw(feeEstimator chainfee.Estimator,
+	feePref FeePreference) (chainfee.SatPerKWeight, error) {
 
 	switch {
 	// If both values are set, then we'll return an error as we require a
@@ -62,12 +63,12 @@ func DetermineFeePerKw(feeEstimator lnwallet.FeeEstimator,
 	// internally.
 	case feePref.FeeRate != 0:
 		feePerKW := feePref.FeeRate
-		if feePerKW < lnwallet.FeePerKwFloor {
+		if feePerKW < chainfee.FeePerKwFloor {
 			log.Infof("Manual fee rate input of %d sat/kw is "+
 				"too low, using %d sat/kw instead", feePerKW,
-				lnwallet.FeePerKwFloor)
+				chainfee.FeePerKwFloor)
 
-			feePerKW = lnwallet.FeePerKwFloor
+			feePerKW = chainfee.FeePerKwFloor
 		}
 
 		return feePerKW, nil
@@ -148,11 +149,13 @@ type WalletSweepPackage struct {
 // caller to sweep ALL outputs within the wallet to a single UTXO, as specified
 // by the delivery address. The sweep transaction will be crafted with the
 // target fee rate, and will use the utxoSource and outpointLocker as sources
-// for wallet funds.
-func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
-	deliveryAddr btcutil.Address, coinSelectLocker CoinSelectionLocker,
+// for wallet funds. Only outputs with at least minConfs confirmations are
+// swept.
+func CraftSweepAllTx(feeRate chainfee.SatPerKWeight, minConfs int32,
+	blockHeight uint32, deliveryAddr btcutil.Address,
+	coinSelectLocker CoinSelectionLocker,
 	utxoSource UtxoSource, outpointLocker OutpointLocker,
-	feeEstimator lnwallet.FeeEstimator,
+	feeEstimator chainfee.Estimator,
 	signer input.Signer) (*WalletSweepPackage, error) {
 
 	// TODO(roasbeef): turn off ATPL as well when available?
@@ -178,7 +181,7 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 		// operations are going on, we can grab a clean snapshot of the
 		// current UTXO state of the wallet.
 		utxos, err := utxoSource.ListUnspentWitness(
-			1, math.MaxInt32,
+			minConfs, math.MaxInt32,
 		)
 		if err != nil {
 			return err
@@ -209,57 +212,14 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 	// sweeper to generate and sign a transaction for us.
 	var inputsToSweep []input.Input
 	for _, output := range allOutputs {
-		// We'll consult the utxoSource for information concerning this
-		// outpoint, we'll need to properly populate a signDescriptor
-		// for this output.
-		outputInfo, err := utxoSource.FetchInputInfo(&output.OutPoint)
+		input, err := newWalletInput(utxoSource, &output.OutPoint)
 		if err != nil {
 			unlockOutputs()
 
 			return nil, err
 		}
 
-		// As we'll be signing for outputs under control of the wallet,
-		// we only need to populate the output value and output script.
-		// The rest of the items will be populated internally within
-		// the sweeper via the witness generation function.
-		signDesc := &input.SignDescriptor{
-			Output:   outputInfo,
-			HashType: txscript.SigHashAll,
-		}
-
-		pkScript := outputInfo.PkScript
-
-		// Based on the output type, we'll map it to the proper witness
-		// type so we can generate the set of input scripts needed to
-		// sweep the output.
-		var witnessType input.WitnessType
-		switch {
-
-		// If this is a p2wkh output, then we'll assume it's a witness
-		// key hash witness type.
-		case txscript.IsPayToWitnessPubKeyHash(pkScript):
-			witnessType = input.WitnessKeyHash
-
-		// If this is a p2sh output, then as since it's under control
-		// of the wallet, we'll assume it's a nested p2sh output.
-		case txscript.IsPayToScriptHash(pkScript):
-			witnessType = input.NestedWitnessKeyHash
-
-		// All other output types we count as unknown and will fail to
-		// sweep.
-		default:
-			unlockOutputs()
-
-			return nil, fmt.Errorf("unable to sweep coins, "+
-				"unknown script: %x", pkScript[:])
-		}
-
-		// Now that we've constructed the items required, we'll make an
-		// input which can be passed to the sweeper for ultimate
-		// sweeping.
-		input := input.MakeBaseInput(&output.OutPoint, witnessType, signDesc, 0)
-		inputsToSweep = append(inputsToSweep, &input)
+		inputsToSweep = append(inputsToSweep, input)
 	}
 
 	// Next, we'll convert the delivery addr to a pkScript that we can use
@@ -287,3 +247,55 @@ func CraftSweepAllTx(feeRate lnwallet.SatPerKWeight, blockHeight uint32,
 		CancelSweepAttempt: unlockOutputs,
 	}, nil
 }
+
+// newWalletInput assembles an input for the given wallet controlled outpoint,
+// which can be passed to the sweeper to generate and sign a transaction
+// spending it.
+func newWalletInput(utxoSource UtxoSource,
+	outpoint *wire.OutPoint) (input.Input, error) {
+
+	// We'll consult the utxoSource for information concerning this
+	// outpoint, we'll need to properly populate a signDescriptor for this
+	// output.
+	outputInfo, err := utxoSource.FetchInputInfo(outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// As we'll be signing for outputs under control of the wallet, we only
+	// need to populate the output value and output script. The rest of
+	// the items will be populated internally within the sweeper via the
+	// witness generation function.
+	signDesc := &input.SignDescriptor{
+		Output:   outputInfo,
+		HashType: txscript.SigHashAll,
+	}
+
+	pkScript := outputInfo.PkScript
+
+	// Based on the output type, we'll map it to the proper witness type so
+	// we can generate the set of input scripts needed to sweep the output.
+	var witnessType input.WitnessType
+	switch {
+
+	// If this is a p2wkh output, then we'll assume it's a witness key hash
+	// witness type.
+	case txscript.IsPayToWitnessPubKeyHash(pkScript):
+		witnessType = input.WitnessKeyHash
+
+	// If this is a p2sh output, then as since it's under control of the
+	// wallet, we'll assume it's a nested p2sh output.
+	case txscript.IsPayToScriptHash(pkScript):
+		witnessType = input.NestedWitnessKeyHash
+
+	// All other output types we count as unknown and will fail to sweep.
+	default:
+		return nil, fmt.Errorf("unable to sweep coins, unknown "+
+			"script: %x", pkScript[:])
+	}
+
+	// Now that we've constructed the items required, we'll make an input
+	// which can be passed to the sweeper for ultimate sweeping.
+	inp := input.MakeBaseInput(outpoint, witnessType, signDesc, 0)
+	return &inp, nil
+}
@@ -17,11 +17,13 @@ import (
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/channelnotifier"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/input"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/shachain"
@@ -188,7 +190,7 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 
 	aliceCommitTx, bobCommitTx, err := lnwallet.CreateCommitmentTxns(channelBal,
 		channelBal, &aliceCfg, &bobCfg, aliceCommitPoint, bobCommitPoint,
-		*fundingTxIn)
+		*fundingTxIn, channeldb.SingleFunder)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
@@ -205,19 +207,20 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		return nil, nil, nil, nil, err
 	}
 
-	estimator := lnwallet.NewStaticFeeEstimator(12500, 0)
+	estimator := chainfee.NewStaticEstimator(12500, 0)
 	feePerKw, err := estimator.EstimateFeePerKW(1)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
 	// TODO(roasbeef): need to factor in commit fee?
+	commitWeight := lnwallet.CommitWeight(0, channeldb.SingleFunder)
 	aliceCommit := channeldb.ChannelCommitment{
 		CommitHeight:  0,
 		LocalBalance:  lnwire.NewMSatFromSatoshis(channelBal),
 		RemoteBalance: lnwire.NewMSatFromSatoshis(channelBal),
 		FeePerKw:      btcutil.Amount(feePerKw),
-		CommitFee:     feePerKw.FeeForWeight(input.CommitWeight),
+		CommitFee:     feePerKw.FeeForWeight(commitWeight),
 		CommitTx:      aliceCommitTx,
 		CommitSig:     bytes.Repeat([]byte{1}, 71),
 	}
@@ -226,7 +229,7 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 		LocalBalance:  lnwire.NewMSatFromSatoshis(channelBal),
 		RemoteBalance: lnwire.NewMSatFromSatoshis(channelBal),
 		FeePerKw:      btcutil.Amount(feePerKw),
-		CommitFee:     feePerKw.FeeForWeight(input.CommitWeight),
+		CommitFee:     feePerKw.FeeForWeight(commitWeight),
 		CommitTx:      bobCommitTx,
 		CommitSig:     bytes.Repeat([]byte{1}, 71),
 	}
@@ -345,11 +348,17 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 	)
 	chainArb.WatchNewChannel(aliceChannelState)
 
+	feeClamps, err := newFeeClamps(estimator, &feeClampConfig{})
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
 	s := &server{
 		chanDB:        dbAlice,
 		cc:            cc,
 		breachArbiter: breachArbiter,
 		chainArb:      chainArb,
+		feeClamps:     feeClamps,
 	}
 
 	_, currentHeight, err := s.cc.chainIO.GetBestBlock()
@@ -394,6 +403,12 @@ func createTestPeer(notifier chainntnfs.ChainNotifier,
 	}
 	s.chanStatusMgr = chanStatusMgr
 
+	channelNotifier := channelnotifier.New(dbAlice)
+	if err := channelNotifier.Start(); err != nil {
+		return nil, nil, nil, nil, err
+	}
+	s.channelNotifier = channelNotifier
+
 	alicePeer := &peer{
 		addr: &lnwire.NetAddress{
 			IdentityKey: aliceKeyPub,
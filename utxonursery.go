@@ -155,6 +155,12 @@ import (
 
 var byteOrder = binary.BigEndian
 
+const (
+	// kgtnOutputConfTarget is the default confirmation target we'll use
+	// for sweeps of CSV delayed outputs.
+	kgtnOutputConfTarget = 6
+)
+
 var (
 	// ErrContractNotFound is returned when the nursery is unable to
 	// retrieve information about a queried contract.
@@ -196,7 +202,7 @@ type NurseryConfig struct {
 	Store NurseryStore
 
 	// Sweep sweeps an input back to the wallet.
-	SweepInput func(input input.Input) (chan sweep.Result, error)
+	SweepInput func(input.Input, sweep.Params) (chan sweep.Result, error)
 }
 
 // utxoNursery is a system dedicated to incubating time-locked outputs created
@@ -807,7 +813,13 @@ func (u *utxoNursery) sweepMatureOutputs(classHeight uint32,
 		// passed in with disastruous consequences.
 		local := output
 
-		resultChan, err := u.cfg.SweepInput(&local)
+		resultChan, err := u.cfg.SweepInput(
+			&local, sweep.Params{
+				Fee: sweep.FeePreference{
+					ConfTarget: kgtnOutputConfTarget,
+				},
+			},
+		)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,152 @@
+// +build !rpctest
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcwallet/wallet"
+)
+
+var (
+	testWalletPassword = []byte("test-password")
+	testWalletSeed     = bytes.Repeat([]byte{0x01}, 32)
+)
+
+// createWalletWithPassword creates a new wallet within the given network
+// directory, protected by the given password.
+func createWalletWithPassword(t *testing.T, netDir string, password []byte) {
+	loader := wallet.NewLoader(&chaincfg.RegressionNetParams, netDir, 0)
+	_, err := loader.CreateNewWallet(
+		password, password, testWalletSeed, time.Time{},
+	)
+	if err != nil {
+		t.Fatalf("unable to create wallet: %v", err)
+	}
+	if err := loader.UnloadWallet(); err != nil {
+		t.Fatalf("unable to unload wallet: %v", err)
+	}
+}
+
+// TestUnlockWalletFromFile asserts that an existing wallet is unlocked with
+// the password read from the password file, and that the file is ignored if
+// the wallet doesn't exist yet.
+func TestUnlockWalletFromFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		walletExists bool
+		fileContents []byte
+
+		expectErr bool
+	}{
+		{
+			name:         "no wallet",
+			walletExists: false,
+			fileContents: testWalletPassword,
+		},
+		{
+			name:         "no wallet, no password file",
+			walletExists: false,
+		},
+		{
+			name:         "correct password",
+			walletExists: true,
+			fileContents: testWalletPassword,
+		},
+		{
+			name:         "trailing newlines",
+			walletExists: true,
+			fileContents: append(testWalletPassword, "\r\n\n"...),
+		},
+		{
+			name:         "empty file",
+			walletExists: true,
+			fileContents: []byte("\n"),
+			expectErr:    true,
+		},
+		{
+			name:         "wrong password",
+			walletExists: true,
+			fileContents: []byte("wrong-password"),
+			expectErr:    true,
+		},
+		{
+			name:         "no password file",
+			walletExists: true,
+			expectErr:    true,
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			testDir, err := ioutil.TempDir("", "unlockwallet")
+			if err != nil {
+				t.Fatalf("unable to create temp dir: %v", err)
+			}
+			defer os.RemoveAll(testDir)
+
+			netDir := filepath.Join(testDir, "regtest")
+			if test.walletExists {
+				createWalletWithPassword(
+					t, netDir, testWalletPassword,
+				)
+			}
+
+			passwordFile := filepath.Join(testDir, "password")
+			if test.fileContents != nil {
+				err := ioutil.WriteFile(
+					passwordFile, test.fileContents, 0600,
+				)
+				if err != nil {
+					t.Fatalf("unable to write password "+
+						"file: %v", err)
+				}
+			}
+
+			unlockParams, err := unlockWalletFromFile(
+				&chaincfg.RegressionNetParams, netDir,
+				passwordFile,
+			)
+			switch {
+			case test.expectErr && err == nil:
+				t.Fatalf("expected wallet unlock to fail")
+
+			case test.expectErr:
+				return
+
+			case err != nil:
+				t.Fatalf("unable to unlock wallet: %v", err)
+			}
+
+			if !test.walletExists {
+				if unlockParams != nil {
+					t.Fatalf("expected password file to " +
+						"be ignored")
+				}
+				return
+			}
+
+			defer unlockParams.Wallet.Database().Close()
+
+			if !bytes.Equal(unlockParams.Password, testWalletPassword) {
+				t.Fatalf("expected password %s, got %s",
+					testWalletPassword, unlockParams.Password)
+			}
+			if unlockParams.Wallet == nil {
+				t.Fatalf("expected unlocked wallet")
+			}
+		})
+	}
+}
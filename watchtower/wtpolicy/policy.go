@@ -7,6 +7,7 @@ import (
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/watchtower/blob"
 )
 
@@ -52,7 +53,7 @@ func DefaultPolicy() Policy {
 		BlobType:   blob.TypeDefault,
 		MaxUpdates: DefaultMaxUpdates,
 		RewardRate: DefaultRewardRate,
-		SweepFeeRate: lnwallet.SatPerKWeight(
+		SweepFeeRate: chainfee.SatPerKWeight(
 			DefaultSweepFeeRate,
 		),
 	}
@@ -85,7 +86,7 @@ type Policy struct {
 	// constructing the justice transaction. All sweep transactions created
 	// for this session must use this value during construction, and the
 	// signatures must implicitly commit to the resulting output values.
-	SweepFeeRate lnwallet.SatPerKWeight
+	SweepFeeRate chainfee.SatPerKWeight
 }
 
 // String returns a human-readable description of the current policy.